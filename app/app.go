@@ -98,6 +98,7 @@ import (
 
 	farmingparams "github.com/cosmosquad-labs/squad/v3/app/params"
 	v2_0_0 "github.com/cosmosquad-labs/squad/v3/app/upgrades/mainnet/v2.0.0"
+	v3_0_0 "github.com/cosmosquad-labs/squad/v3/app/upgrades/mainnet/v3.0.0"
 	"github.com/cosmosquad-labs/squad/v3/x/claim"
 	claimkeeper "github.com/cosmosquad-labs/squad/v3/x/claim/keeper"
 	claimtypes "github.com/cosmosquad-labs/squad/v3/x/claim/types"
@@ -109,6 +110,7 @@ import (
 	liquidfarmingkeeper "github.com/cosmosquad-labs/squad/v3/x/liquidfarming/keeper"
 	liquidfarmingtypes "github.com/cosmosquad-labs/squad/v3/x/liquidfarming/types"
 	"github.com/cosmosquad-labs/squad/v3/x/liquidity"
+	liquidityclient "github.com/cosmosquad-labs/squad/v3/x/liquidity/client"
 	liquiditykeeper "github.com/cosmosquad-labs/squad/v3/x/liquidity/keeper"
 	liquiditytypes "github.com/cosmosquad-labs/squad/v3/x/liquidity/types"
 	"github.com/cosmosquad-labs/squad/v3/x/liquidstaking"
@@ -155,6 +157,8 @@ var (
 			farmingclient.ProposalHandler,
 			marketmakerclient.ProposalHandler,
 			lpfarmclient.ProposalHandler,
+			liquidityclient.SwapFeeRateProposalHandler,
+			liquidityclient.AmplificationProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -459,6 +463,7 @@ func NewApp(
 		app.GetSubspace(marketmakertypes.ModuleName),
 		app.AccountKeeper,
 		app.BankKeeper,
+		app.LiquidityKeeper,
 	)
 	app.LPFarmKeeper = lpfarmkeeper.NewKeeper(
 		appCodec,
@@ -479,7 +484,8 @@ func NewApp(
 		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper)).
 		AddRoute(farmingtypes.RouterKey, farming.NewPublicPlanProposalHandler(app.FarmingKeeper)).
 		AddRoute(marketmakertypes.RouterKey, marketmaker.NewMarketMakerProposalHandler(app.MarketMakerKeeper)).
-		AddRoute(lpfarmtypes.RouterKey, lpfarm.NewFarmingPlanProposalHandler(app.LPFarmKeeper))
+		AddRoute(lpfarmtypes.RouterKey, lpfarm.NewFarmingPlanProposalHandler(app.LPFarmKeeper)).
+		AddRoute(liquiditytypes.RouterKey, liquidity.NewProposalHandler(app.LiquidityKeeper))
 
 	app.GovKeeper = govkeeper.NewKeeper(
 		appCodec,
@@ -523,11 +529,13 @@ func NewApp(
 	app.ClaimKeeper = claimkeeper.NewKeeper(
 		appCodec,
 		keys[claimtypes.StoreKey],
+		app.GetSubspace(claimtypes.ModuleName),
 		app.BankKeeper,
 		app.DistrKeeper,
 		app.GovKeeper,
 		app.LiquidityKeeper,
 		app.LiquidStakingKeeper,
+		app.LPFarmKeeper,
 	)
 
 	app.TransferKeeper = ibctransferkeeper.NewKeeper(
@@ -938,6 +946,7 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(liquidfarmingtypes.ModuleName)
 	paramsKeeper.Subspace(marketmakertypes.ModuleName)
 	paramsKeeper.Subspace(lpfarmtypes.ModuleName)
+	paramsKeeper.Subspace(claimtypes.ModuleName)
 
 	return paramsKeeper
 }
@@ -954,10 +963,15 @@ func (app *App) SetUpgradeStoreLoaders() {
 		// configure store loader that checks if version == upgradeHeight and applies store upgrades
 		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &v2_0_0.StoreUpgrades))
 	}
+	if upgradeInfo.Name == v3_0_0.UpgradeName && !app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &v3_0_0.StoreUpgrades))
+	}
 }
 
 func (app *App) SetUpgradeHandlers(mm *module.Manager, configurator module.Configurator) {
 	// mainnet upgrade handlers
 	app.UpgradeKeeper.SetUpgradeHandler(
 		v2_0_0.UpgradeName, v2_0_0.UpgradeHandler(mm, configurator, app.BudgetKeeper))
+	app.UpgradeKeeper.SetUpgradeHandler(
+		v3_0_0.UpgradeName, v3_0_0.UpgradeHandler(mm, configurator))
 }
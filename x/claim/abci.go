@@ -13,12 +13,19 @@ import (
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 
-	// Terminate airdrop if the airdrop end time has passed
-	for _, airdrop := range k.GetAllAirdrops(ctx) {
-		if !ctx.BlockTime().Before(airdrop.EndTime) { // BlockTime >= EndTime
-			if err := k.TerminateAirdrop(ctx, airdrop); err != nil {
-				panic(err)
-			}
+	// Terminate airdrops whose end time has passed
+	var airdropIds []uint64
+	k.IterateAirdropQueueUntil(ctx, ctx.BlockTime(), func(_ time.Time, airdropId uint64) (stop bool) {
+		airdropIds = append(airdropIds, airdropId)
+		return false
+	})
+	for _, airdropId := range airdropIds {
+		airdrop, found := k.GetAirdrop(ctx, airdropId)
+		if !found { // Sanity check
+			panic("airdrop not found")
+		}
+		if err := k.TerminateAirdrop(ctx, airdrop); err != nil {
+			panic(err)
 		}
 	}
 }
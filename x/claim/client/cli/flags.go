@@ -0,0 +1,9 @@
+package cli
+
+// DONTCOVER
+
+const (
+	FlagInitialClaimableCoins = "initial-claimable-coins"
+	FlagProof                 = "proof"
+	FlagRequirements          = "requirements"
+)
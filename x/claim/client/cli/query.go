@@ -26,6 +26,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	}
 
 	cmd.AddCommand(
+		NewQueryParamsCmd(),
 		NewQueryAirdropsCmd(),
 		NewQueryAirdropCmd(),
 		NewQueryClaimRecordCmd(),
@@ -34,6 +35,43 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	return cmd
 }
 
+// NewQueryParamsCmd implements the params query command.
+func NewQueryParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Args:  cobra.NoArgs,
+		Short: "Query the current claim parameters",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the current claim parameters.
+
+Example:
+$ %s query %s params
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			resp, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&resp.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func NewQueryAirdropsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "airdrops",
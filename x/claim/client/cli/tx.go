@@ -1,15 +1,18 @@
 package cli
 
 import (
+	"encoding/hex"
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/cosmosquad-labs/squad/v3/x/claim/types"
@@ -27,6 +30,7 @@ func GetTxCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		NewClaimCmd(),
+		NewCreateAirdropCmd(),
 	)
 
 	return cmd
@@ -43,16 +47,21 @@ Full allocation can be claimed by completing all tasks in core network activitie
 There are 4 different tasks (condition types) and you must complete the task before claiming the amount. 
 Reference the spec docs to understand the mechanism. 
 
+The first claim of an airdrop created with a merkle root requires the recipient's
+initial claimable coins and the merkle proof of the recipient's leaf.
+
 Example:
 $ %s tx %s claim 1 deposit --from mykey
 $ %s tx %s claim 1 swap --from mykey
 $ %s tx %s claim 1 liquidstake --from mykey
 $ %s tx %s claim 1 vote --from mykey
+$ %s tx %s claim 2 deposit --initial-claimable-coins=1000000stake --proof=<hex>,<hex> --from mykey
 `,
 				version.AppName, types.ModuleName,
 				version.AppName, types.ModuleName,
 				version.AppName, types.ModuleName,
 				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -71,16 +80,143 @@ $ %s tx %s claim 1 vote --from mykey
 				return fmt.Errorf("unknown condition type %s", args[0])
 			}
 
-			msg := types.NewMsgClaim(
+			coinsStr, _ := cmd.Flags().GetString(FlagInitialClaimableCoins)
+			initialClaimableCoins, err := sdk.ParseCoinsNormalized(coinsStr)
+			if err != nil {
+				return fmt.Errorf("invalid initial claimable coins: %w", err)
+			}
+
+			proofStr, _ := cmd.Flags().GetString(FlagProof)
+			proof, err := ParseMerkleProof(proofStr)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgClaimWithProof(
 				airdropId,
 				clientCtx.GetFromAddress(),
 				condType,
+				initialClaimableCoins,
+				proof,
 			)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().String(FlagInitialClaimableCoins, "", "The initial claimable coins of the merkle leaf")
+	cmd.Flags().String(FlagProof, "", "Comma-separated hex-encoded merkle proof of the leaf")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewCreateAirdropCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-airdrop [amount] [conditions] [merkle-root] [start-time] [end-time]",
+		Args:  cobra.ExactArgs(5),
+		Short: "Create a new merkle airdrop",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a new merkle airdrop.
+The newly created airdrop's source address is automatically generated and
+funded with the amount from the creator.
+Recipients claim with the merkle proof of their sha256(0x00 || "{recipient},{coins}") leaf.
+Unclaimed coins are transferred to the community pool when the airdrop ends.
+
+[amount]: the coins to fund the airdrop with
+[conditions]: comma-separated list of condition types
+[merkle-root]: hex-encoded merkle root over the recipients' leaves
+[start-time]: the time at which the airdrop begins, in RFC3339 format
+[end-time]: the time at which the airdrop ends, in RFC3339 format
+
+Requirements replacing the default checks of the conditions can be specified
+in a JSON file with the --requirements flag. Each requirement combines its
+criteria with either CRITERIA_OPERATOR_AND or CRITERIA_OPERATOR_OR.
+
+Example:
+$ %s tx %s create-airdrop 1000000000stake deposit,swap 9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08 2022-01-01T00:00:00Z 2022-07-01T00:00:00Z --from mykey
+$ %s tx %s create-airdrop 1000000000stake deposit,vote 9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08 2022-01-01T00:00:00Z 2022-07-01T00:00:00Z --requirements=requirements.json --from mykey
+
+Where requirements.json contains:
+{
+  "requirements": [
+    {
+      "condition_type": "CONDITION_TYPE_DEPOSIT",
+      "operator": "CRITERIA_OPERATOR_OR",
+      "criteria": [
+        {
+          "condition_type": "CONDITION_TYPE_DEPOSIT",
+          "pool_id": "1",
+          "min_amount": "1000000000000"
+        },
+        {
+          "condition_type": "CONDITION_TYPE_FARMING",
+          "pool_id": "1",
+          "min_amount": "1000000000000",
+          "min_farming_duration": "604800s"
+        }
+      ]
+    },
+    {
+      "condition_type": "CONDITION_TYPE_VOTE",
+      "operator": "CRITERIA_OPERATOR_AND",
+      "criteria": [
+        {
+          "condition_type": "CONDITION_TYPE_VOTE",
+          "proposal_id": "10"
+        }
+      ]
+    }
+  ]
+}
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amt, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid amount: %w", err)
+			}
+			conditions, err := ParseConditionTypes(args[1])
+			if err != nil {
+				return err
+			}
+			merkleRoot, err := hex.DecodeString(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid merkle root: %w", err)
+			}
+			startTime, err := time.Parse(time.RFC3339, args[3])
+			if err != nil {
+				return fmt.Errorf("invalid start time: %w", err)
+			}
+			endTime, err := time.Parse(time.RFC3339, args[4])
+			if err != nil {
+				return fmt.Errorf("invalid end time: %w", err)
+			}
+
+			var requirements []types.ConditionRequirement
+			if requirementsFile, _ := cmd.Flags().GetString(FlagRequirements); requirementsFile != "" {
+				requirements, err = ParseRequirements(clientCtx.Codec, requirementsFile)
+				if err != nil {
+					return fmt.Errorf("invalid requirements: %w", err)
+				}
+			}
+
+			msg := types.NewMsgCreateAirdrop(
+				clientCtx.GetFromAddress(), amt, conditions, merkleRoot, startTime, endTime, requirements)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagRequirements, "", "The JSON file of the requirements replacing the default checks of the conditions")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -1,8 +1,13 @@
 package cli
 
 import (
+	"encoding/hex"
+	"fmt"
+	"os"
 	"strings"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+
 	"github.com/cosmosquad-labs/squad/v3/x/claim/types"
 )
 
@@ -17,7 +22,54 @@ func NormalizeConditionType(ConditionType string) types.ConditionType {
 		return types.ConditionTypeLiquidStake
 	case "v", "vote":
 		return types.ConditionTypeVote
+	case "f", "farming":
+		return types.ConditionTypeFarming
 	default:
 		return types.ConditionTypeUnspecified
 	}
 }
+
+// ParseConditionTypes parses comma-separated condition types.
+func ParseConditionTypes(s string) ([]types.ConditionType, error) {
+	var conditions []types.ConditionType
+	for _, str := range strings.Split(s, ",") {
+		ct := NormalizeConditionType(strings.TrimSpace(str))
+		if ct == types.ConditionTypeUnspecified {
+			return nil, fmt.Errorf("unknown condition type %s", str)
+		}
+		conditions = append(conditions, ct)
+	}
+	return conditions, nil
+}
+
+// ParseMerkleProof parses comma-separated hex-encoded merkle proof elements.
+func ParseMerkleProof(s string) ([][]byte, error) {
+	if s == "" {
+		return nil, nil
+	}
+	var proof [][]byte
+	for _, str := range strings.Split(s, ",") {
+		bz, err := hex.DecodeString(strings.TrimSpace(str))
+		if err != nil {
+			return nil, fmt.Errorf("invalid proof element %s: %w", str, err)
+		}
+		proof = append(proof, bz)
+	}
+	return proof, nil
+}
+
+// ParseRequirements reads and parses the requirements of an airdrop from a JSON file.
+// The file contains an object with the "requirements" field of MsgCreateAirdrop.
+func ParseRequirements(cdc codec.JSONCodec, requirementsFile string) ([]types.ConditionRequirement, error) {
+	contents, err := os.ReadFile(requirementsFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var msg types.MsgCreateAirdrop
+	if err := cdc.UnmarshalJSON(contents, &msg); err != nil {
+		return nil, err
+	}
+
+	return msg.Requirements, nil
+}
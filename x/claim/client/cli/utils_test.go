@@ -1,10 +1,15 @@
 package cli_test
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/testutil"
+
+	"github.com/cosmosquad-labs/squad/v3/app/params"
 	"github.com/cosmosquad-labs/squad/v3/x/claim/client/cli"
 	"github.com/cosmosquad-labs/squad/v3/x/claim/types"
 )
@@ -24,6 +29,8 @@ func TestNormalizeConditionType(t *testing.T) {
 		{"liquidstake", "ls", types.ConditionTypeLiquidStake},
 		{"vote", "vote", types.ConditionTypeVote},
 		{"vote", "v", types.ConditionTypeVote},
+		{"farming", "farming", types.ConditionTypeFarming},
+		{"farming", "f", types.ConditionTypeFarming},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -31,3 +38,79 @@ func TestNormalizeConditionType(t *testing.T) {
 		})
 	}
 }
+
+func TestParseMerkleProof(t *testing.T) {
+	proof, err := cli.ParseMerkleProof("")
+	require.NoError(t, err)
+	require.Nil(t, proof)
+
+	h1, h2 := strings.Repeat("ab", 32), strings.Repeat("cd", 32)
+	proof, err = cli.ParseMerkleProof(h1 + "," + h2)
+	require.NoError(t, err)
+	require.Len(t, proof, 2)
+	require.Equal(t, byte(0xab), proof[0][0])
+	require.Equal(t, byte(0xcd), proof[1][31])
+
+	_, err = cli.ParseMerkleProof("zz")
+	require.Error(t, err)
+}
+
+func TestParseConditionTypes(t *testing.T) {
+	conditions, err := cli.ParseConditionTypes("deposit,s,ls")
+	require.NoError(t, err)
+	require.Equal(t, []types.ConditionType{
+		types.ConditionTypeDeposit, types.ConditionTypeSwap, types.ConditionTypeLiquidStake,
+	}, conditions)
+
+	_, err = cli.ParseConditionTypes("deposit,order")
+	require.EqualError(t, err, "unknown condition type order")
+}
+
+func TestParseRequirements(t *testing.T) {
+	okJSON := testutil.WriteToNewTempFile(t, `
+{
+  "requirements": [
+    {
+      "condition_type": "CONDITION_TYPE_DEPOSIT",
+      "operator": "CRITERIA_OPERATOR_OR",
+      "criteria": [
+        {
+          "condition_type": "CONDITION_TYPE_DEPOSIT",
+          "pool_id": "1",
+          "min_amount": "1000000000000"
+        },
+        {
+          "condition_type": "CONDITION_TYPE_FARMING",
+          "pool_id": "1",
+          "min_amount": "1000000000000",
+          "min_farming_duration": "604800s"
+        }
+      ]
+    },
+    {
+      "condition_type": "CONDITION_TYPE_VOTE",
+      "operator": "CRITERIA_OPERATOR_AND",
+      "criteria": [
+        {
+          "condition_type": "CONDITION_TYPE_VOTE",
+          "proposal_id": "10"
+        }
+      ]
+    }
+  ]
+}
+`)
+
+	requirements, err := cli.ParseRequirements(params.MakeTestEncodingConfig().Marshaler, okJSON.Name())
+	require.NoError(t, err)
+	require.NoError(t, types.ValidateRequirements(
+		[]types.ConditionType{types.ConditionTypeDeposit, types.ConditionTypeVote}, requirements))
+
+	require.Len(t, requirements, 2)
+	require.Equal(t, types.CriteriaOperatorOr, requirements[0].Operator)
+	require.Len(t, requirements[0].Criteria, 2)
+	require.Equal(t, types.ConditionTypeFarming, requirements[0].Criteria[1].ConditionType)
+	require.Equal(t, "1000000000000", requirements[0].Criteria[1].MinAmount.String())
+	require.Equal(t, 7*24*time.Hour, requirements[0].Criteria[1].MinFarmingDuration)
+	require.EqualValues(t, 10, requirements[1].Criteria[0].ProposalId)
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"encoding/hex"
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -8,21 +9,96 @@ import (
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/cosmosquad-labs/squad/v3/x/claim/types"
+	liquiditytypes "github.com/cosmosquad-labs/squad/v3/x/liquidity/types"
+	lpfarmtypes "github.com/cosmosquad-labs/squad/v3/x/lpfarm/types"
 )
 
+// CreateAirdrop handles types.MsgCreateAirdrop and creates a new airdrop
+// whose claim records are proven by the merkle root.
+// The creator pays the airdrop creation fee to the community pool and
+// funds the airdrop's source address with the amount.
+func (k Keeper) CreateAirdrop(ctx sdk.Context, msg *types.MsgCreateAirdrop) (types.Airdrop, error) {
+	if !msg.EndTime.After(ctx.BlockTime()) {
+		return types.Airdrop{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "end time must be after the current block time: %s", msg.EndTime)
+	}
+
+	creatorAddr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return types.Airdrop{}, err
+	}
+
+	if fee := k.GetAirdropCreationFee(ctx); !fee.IsZero() {
+		if err := k.distrKeeper.FundCommunityPool(ctx, fee, creatorAddr); err != nil {
+			return types.Airdrop{}, sdkerrors.Wrap(err, "failed to pay the airdrop creation fee")
+		}
+	}
+
+	id := k.getNextAirdropIdWithUpdate(ctx)
+	sourceAddr := types.DeriveAirdropSourceAddress(id)
+	if err := k.bankKeeper.SendCoins(ctx, creatorAddr, sourceAddr, msg.Amount); err != nil {
+		return types.Airdrop{}, sdkerrors.Wrap(err, "failed to fund the airdrop source address")
+	}
+
+	airdrop := types.Airdrop{
+		Id:            id,
+		SourceAddress: sourceAddr.String(),
+		Conditions:    msg.Conditions,
+		StartTime:     msg.StartTime,
+		EndTime:       msg.EndTime,
+		MerkleRoot:    msg.MerkleRoot,
+	}
+	k.SetAirdrop(ctx, airdrop)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCreateAirdrop,
+			sdk.NewAttribute(types.AttributeKeyAirdropId, fmt.Sprint(airdrop.Id)),
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeySourceAddress, airdrop.SourceAddress),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyMerkleRoot, hex.EncodeToString(airdrop.MerkleRoot)),
+		),
+	})
+
+	return airdrop, nil
+}
+
 func (k Keeper) Claim(ctx sdk.Context, msg *types.MsgClaim) (types.ClaimRecord, error) {
 	airdrop, found := k.GetAirdrop(ctx, msg.AirdropId)
 	if !found {
 		return types.ClaimRecord{}, sdkerrors.Wrap(sdkerrors.ErrNotFound, "airdrop not found")
 	}
 
-	if !airdrop.EndTime.After(ctx.BlockTime()) {
+	if ctx.BlockTime().Before(airdrop.StartTime) {
+		return types.ClaimRecord{}, types.ErrAirdropNotStarted
+	}
+
+	if airdrop.IsTerminated || !airdrop.EndTime.After(ctx.BlockTime()) {
 		return types.ClaimRecord{}, types.ErrTerminatedAirdrop
 	}
 
+	if !airdrop.HasCondition(msg.ConditionType) {
+		return types.ClaimRecord{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "airdrop has no %s condition", msg.ConditionType)
+	}
+
 	record, found := k.GetClaimRecordByRecipient(ctx, airdrop.Id, msg.GetRecipient())
 	if !found {
-		return types.ClaimRecord{}, sdkerrors.Wrap(sdkerrors.ErrNotFound, "claim record not found")
+		if !airdrop.HasMerkleRoot() {
+			return types.ClaimRecord{}, sdkerrors.Wrap(sdkerrors.ErrNotFound, "claim record not found")
+		}
+		// The first claim of a merkle airdrop creates the claim record
+		// after verifying the recipient's leaf against the merkle root.
+		leaf := types.MerkleLeaf(msg.GetRecipient(), msg.InitialClaimableCoins)
+		if !types.VerifyMerkleProof(airdrop.MerkleRoot, leaf, msg.Proof) {
+			return types.ClaimRecord{}, types.ErrInvalidMerkleProof
+		}
+		record = types.ClaimRecord{
+			AirdropId:             airdrop.Id,
+			Recipient:             msg.Recipient,
+			InitialClaimableCoins: msg.InitialClaimableCoins,
+			ClaimableCoins:        msg.InitialClaimableCoins,
+			ClaimedConditions:     []types.ConditionType{},
+		}
 	}
 
 	for _, c := range record.ClaimedConditions {
@@ -32,7 +108,11 @@ func (k Keeper) Claim(ctx sdk.Context, msg *types.MsgClaim) (types.ClaimRecord,
 	}
 
 	// Validate whether or not the recipient has executed the condition
-	if err := k.ValidateCondition(ctx, record.GetRecipient(), msg.ConditionType); err != nil {
+	if req, found := airdrop.GetRequirement(msg.ConditionType); found {
+		if err := k.ValidateRequirement(ctx, record.GetRecipient(), req); err != nil {
+			return types.ClaimRecord{}, err
+		}
+	} else if err := k.ValidateCondition(ctx, record.GetRecipient(), msg.ConditionType); err != nil {
 		return types.ClaimRecord{}, err
 	}
 
@@ -98,6 +178,15 @@ func (k Keeper) ValidateCondition(ctx sdk.Context, recipient sdk.AccAddress, ct
 			}
 			return false
 		})
+
+	case types.ConditionTypeFarming:
+		k.lpFarmKeeper.IteratePositionsByFarmer(ctx, recipient, func(position lpfarmtypes.Position) (stop bool) {
+			if position.FarmingAmount.IsPositive() {
+				ok = true
+				return true
+			}
+			return false
+		})
 	}
 
 	if !ok {
@@ -107,6 +196,76 @@ func (k Keeper) ValidateCondition(ctx sdk.Context, recipient sdk.AccAddress, ct
 	return nil
 }
 
+// ValidateRequirement validates if the recipient meets the requirement
+// by combining its criteria with the requirement's operator.
+func (k Keeper) ValidateRequirement(ctx sdk.Context, recipient sdk.AccAddress, req types.ConditionRequirement) error {
+	switch req.Operator {
+	case types.CriteriaOperatorAnd:
+		for _, c := range req.Criteria {
+			if err := k.ValidateCriterion(ctx, recipient, c); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case types.CriteriaOperatorOr:
+		for _, c := range req.Criteria {
+			if err := k.ValidateCriterion(ctx, recipient, c); err == nil {
+				return nil
+			}
+		}
+		return sdkerrors.Wrap(types.ErrConditionRequired, "none of the criteria is met")
+
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid criteria operator: %s", req.Operator)
+	}
+}
+
+// ValidateCriterion validates if the recipient meets the parameterized criterion.
+func (k Keeper) ValidateCriterion(ctx sdk.Context, recipient sdk.AccAddress, c types.Criterion) error {
+	ok := false
+
+	switch c.ConditionType {
+	case types.ConditionTypeDeposit:
+		// Pool coins being farmed in lpfarm are counted as well as the pool coins held
+		poolCoinDenom := liquiditytypes.PoolCoinDenom(c.PoolId)
+		amt := k.bankKeeper.SpendableCoins(ctx, recipient).AmountOf(poolCoinDenom)
+		if position, found := k.lpFarmKeeper.GetPosition(ctx, recipient, poolCoinDenom); found {
+			amt = amt.Add(position.FarmingAmount)
+		}
+		ok = amt.IsPositive() && amt.GTE(c.GetMinAmount())
+
+	case types.ConditionTypeSwap:
+		return k.ValidateCondition(ctx, recipient, c.ConditionType)
+
+	case types.ConditionTypeLiquidStake:
+		params := k.liquidStakingKeeper.GetParams(ctx)
+		amt := k.bankKeeper.SpendableCoins(ctx, recipient).AmountOf(params.LiquidBondDenom)
+		ok = amt.IsPositive() && amt.GTE(c.GetMinAmount())
+
+	case types.ConditionTypeVote:
+		// Votes are deleted by gov when the proposal is tallied,
+		// so the criterion can only be met during the voting period of the proposal
+		_, ok = k.govKeeper.GetVote(ctx, c.ProposalId, recipient)
+
+	case types.ConditionTypeFarming:
+		position, found := k.lpFarmKeeper.GetPosition(ctx, recipient, liquiditytypes.PoolCoinDenom(c.PoolId))
+		ok = found && position.FarmingAmount.IsPositive() && position.FarmingAmount.GTE(c.GetMinAmount())
+		// The position must have been farming for at least the min farming duration, so that
+		// a position farmed right before the claim doesn't meet the criterion.
+		// Farming more into the position moves its farming start time forward proportionally.
+		if ok && c.MinFarmingDuration > 0 {
+			ok = !position.FarmingStartTime.After(ctx.BlockTime().Add(-c.MinFarmingDuration))
+		}
+	}
+
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrConditionRequired, "%s criterion is not met", c.ConditionType)
+	}
+
+	return nil
+}
+
 // TerminateAirdrop terminates the airdrop and transfer the remaining coins to the community pool.
 func (k Keeper) TerminateAirdrop(ctx sdk.Context, airdrop types.Airdrop) error {
 	amt := k.bankKeeper.SpendableCoins(ctx, airdrop.GetSourceAddress())
@@ -115,5 +274,7 @@ func (k Keeper) TerminateAirdrop(ctx sdk.Context, airdrop types.Airdrop) error {
 			return sdkerrors.Wrap(err, "failed to transfer the remaining coins to the community pool")
 		}
 	}
+	airdrop.IsTerminated = true
+	k.SetAirdrop(ctx, airdrop)
 	return nil
 }
@@ -1,7 +1,10 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	utils "github.com/cosmosquad-labs/squad/v3/types"
@@ -558,3 +561,323 @@ func (s *KeeperTestSuite) TestSimulateGasUsage_VoteCondition() {
 		s.Require().LessOrEqual(gasConsumed, expConsumedGasLimit)
 	}
 }
+
+func (s *KeeperTestSuite) TestCreateAirdrop() {
+	leaves := [][]byte{
+		types.MerkleLeaf(s.addr(1), utils.ParseCoins("600000000denom1")),
+		types.MerkleLeaf(s.addr(2), utils.ParseCoins("400000000denom1")),
+	}
+	merkleRoot := types.MerkleRoot(leaves)
+
+	// An airdrop created in genesis already takes the id 1
+	s.createAirdrop(
+		1, s.addr(0), utils.ParseCoins("1000000000denom1"),
+		[]types.ConditionType{types.ConditionTypeDeposit},
+		s.ctx.BlockTime(), s.ctx.BlockTime().AddDate(0, 1, 0), true)
+
+	creator := s.addr(3)
+	fee := s.keeper.GetAirdropCreationFee(s.ctx)
+	s.fundAddr(creator, utils.ParseCoins("1000000000denom1").Add(fee...))
+	airdrop, err := s.keeper.CreateAirdrop(s.ctx, types.NewMsgCreateAirdrop(
+		creator, utils.ParseCoins("1000000000denom1"),
+		[]types.ConditionType{types.ConditionTypeDeposit, types.ConditionTypeSwap},
+		merkleRoot, s.ctx.BlockTime(), s.ctx.BlockTime().AddDate(0, 1, 0), nil))
+	s.Require().NoError(err)
+	s.Require().EqualValues(2, airdrop.Id)
+	s.Require().Equal(types.DeriveAirdropSourceAddress(2).String(), airdrop.SourceAddress)
+	s.Require().Equal(merkleRoot, airdrop.MerkleRoot)
+	s.Require().Equal(uint64(2), s.keeper.GetLastAirdropId(s.ctx))
+
+	// The source address is funded by the creator and the fee goes to the community pool
+	s.Require().True(coinsEq(utils.ParseCoins("1000000000denom1"), s.getAllBalances(airdrop.GetSourceAddress())))
+	s.Require().True(s.getAllBalances(creator).IsZero())
+	feePool := s.app.DistrKeeper.GetFeePool(s.ctx)
+	s.Require().Equal(sdk.NewDecCoinsFromCoins(fee...), feePool.CommunityPool)
+
+	// Insufficient funds for the creation fee
+	s.fundAddr(creator, utils.ParseCoins("1000000000denom1"))
+	_, err = s.keeper.CreateAirdrop(s.ctx, types.NewMsgCreateAirdrop(
+		creator, utils.ParseCoins("1000000000denom1"),
+		[]types.ConditionType{types.ConditionTypeDeposit},
+		merkleRoot, s.ctx.BlockTime(), s.ctx.BlockTime().AddDate(0, 1, 0), nil))
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	// Insufficient funds for the amount
+	s.fundAddr(creator, fee)
+	_, err = s.keeper.CreateAirdrop(s.ctx, types.NewMsgCreateAirdrop(
+		creator, utils.ParseCoins("2000000000denom1"),
+		[]types.ConditionType{types.ConditionTypeDeposit},
+		merkleRoot, s.ctx.BlockTime(), s.ctx.BlockTime().AddDate(0, 1, 0), nil))
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	// End time must be in the future
+	s.fundAddr(creator, utils.ParseCoins("1000000000denom1"))
+	_, err = s.keeper.CreateAirdrop(s.ctx, types.NewMsgCreateAirdrop(
+		creator, utils.ParseCoins("1000000000denom1"),
+		[]types.ConditionType{types.ConditionTypeDeposit},
+		merkleRoot, s.ctx.BlockTime().AddDate(0, -2, 0), s.ctx.BlockTime().AddDate(0, -1, 0), nil))
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+}
+
+func (s *KeeperTestSuite) TestClaim_MerkleAirdrop() {
+	recipient := s.addr(1)
+	leaves := [][]byte{
+		types.MerkleLeaf(recipient, utils.ParseCoins("600000000denom1")),
+		types.MerkleLeaf(s.addr(2), utils.ParseCoins("300000000denom1")),
+		types.MerkleLeaf(s.addr(3), utils.ParseCoins("100000000denom1")),
+	}
+	proof := types.MerkleProof(leaves, 0)
+
+	creator := s.addr(0)
+	fee := s.keeper.GetAirdropCreationFee(s.ctx)
+	s.fundAddr(creator, utils.ParseCoins("1000000000denom1").Add(fee...))
+	airdrop, err := s.keeper.CreateAirdrop(s.ctx, types.NewMsgCreateAirdrop(
+		creator, utils.ParseCoins("1000000000denom1"),
+		[]types.ConditionType{types.ConditionTypeDeposit, types.ConditionTypeSwap},
+		types.MerkleRoot(leaves), s.ctx.BlockTime(), s.ctx.BlockTime().AddDate(0, 1, 0), nil))
+	s.Require().NoError(err)
+
+	// The recipient makes a deposit
+	pairCreator := s.addr(4)
+	s.createPair(pairCreator, "denom3", "denom4", true)
+	s.createPool(pairCreator, 1, utils.ParseCoins("1000000denom3,1000000denom4"), true)
+	s.deposit(recipient, 1, utils.ParseCoins("500000denom3,500000denom4"), true)
+	liquidity.EndBlocker(s.ctx, s.app.LiquidityKeeper)
+
+	// Claim without a proof
+	_, err = s.keeper.Claim(s.ctx, types.NewMsgClaim(airdrop.Id, recipient, types.ConditionTypeDeposit))
+	s.Require().ErrorIs(err, types.ErrInvalidMerkleProof)
+
+	// Claim with coins different from the leaf
+	_, err = s.keeper.Claim(s.ctx, types.NewMsgClaimWithProof(
+		airdrop.Id, recipient, types.ConditionTypeDeposit, utils.ParseCoins("900000000denom1"), proof))
+	s.Require().ErrorIs(err, types.ErrInvalidMerkleProof)
+
+	// Claim with a proof of another recipient
+	_, err = s.keeper.Claim(s.ctx, types.NewMsgClaimWithProof(
+		airdrop.Id, recipient, types.ConditionTypeDeposit, utils.ParseCoins("300000000denom1"), types.MerkleProof(leaves, 1)))
+	s.Require().ErrorIs(err, types.ErrInvalidMerkleProof)
+
+	// The condition is not a part of the airdrop
+	_, err = s.keeper.Claim(s.ctx, types.NewMsgClaimWithProof(
+		airdrop.Id, recipient, types.ConditionTypeVote, utils.ParseCoins("600000000denom1"), proof))
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	record, err := s.keeper.Claim(s.ctx, types.NewMsgClaimWithProof(
+		airdrop.Id, recipient, types.ConditionTypeDeposit, utils.ParseCoins("600000000denom1"), proof))
+	s.Require().NoError(err)
+	s.Require().True(coinsEq(utils.ParseCoins("600000000denom1"), record.InitialClaimableCoins))
+	s.Require().True(coinsEq(utils.ParseCoins("300000000denom1"), record.ClaimableCoins))
+	s.Require().Equal([]types.ConditionType{types.ConditionTypeDeposit}, record.ClaimedConditions)
+	s.Require().True(coinsEq(utils.ParseCoins("300000000denom1"), sdk.NewCoins(s.getBalance(recipient, "denom1"))))
+
+	// The claim record is now stored, so a proof is no longer needed
+	_, err = s.keeper.Claim(s.ctx, types.NewMsgClaim(airdrop.Id, recipient, types.ConditionTypeDeposit))
+	s.Require().ErrorIs(err, types.ErrAlreadyClaimed)
+
+	s.sellLimitOrder(recipient, 1, utils.ParseDec("1.0"), sdk.NewInt(1000), 10, true)
+	liquidity.EndBlocker(s.ctx, s.app.LiquidityKeeper)
+	_, err = s.keeper.Claim(s.ctx, types.NewMsgClaim(airdrop.Id, recipient, types.ConditionTypeSwap))
+	s.Require().NoError(err)
+	s.Require().True(coinsEq(utils.ParseCoins("600000000denom1"), sdk.NewCoins(s.getBalance(recipient, "denom1"))))
+
+	// Terminate the airdrop
+	s.ctx = s.ctx.WithBlockTime(airdrop.EndTime)
+	claim.EndBlocker(s.ctx, s.keeper)
+
+	s.Require().True(s.getAllBalances(airdrop.GetSourceAddress()).IsZero())
+	feePool := s.app.DistrKeeper.GetFeePool(s.ctx)
+	s.Require().Equal(
+		sdk.NewDecCoinsFromCoins(utils.ParseCoins("400000000denom1").Add(fee...)...), feePool.CommunityPool)
+
+	// The airdrop is marked as terminated and removed from the queue
+	airdrop, _ = s.keeper.GetAirdrop(s.ctx, airdrop.Id)
+	s.Require().True(airdrop.IsTerminated)
+	s.keeper.IterateAirdropQueueUntil(s.ctx, airdrop.EndTime.AddDate(1, 0, 0), func(_ time.Time, airdropId uint64) (stop bool) {
+		s.Require().NotEqual(airdrop.Id, airdropId)
+		return false
+	})
+
+	_, err = s.keeper.Claim(s.ctx, types.NewMsgClaim(airdrop.Id, recipient, types.ConditionTypeSwap))
+	s.Require().ErrorIs(err, types.ErrTerminatedAirdrop)
+}
+
+func (s *KeeperTestSuite) TestEndBlocker_AirdropQueue() {
+	endTime := s.ctx.BlockTime().AddDate(0, 1, 0)
+	airdrop1 := s.createAirdrop(
+		1, s.addr(0), utils.ParseCoins("1000000000denom1"),
+		[]types.ConditionType{types.ConditionTypeDeposit},
+		s.ctx.BlockTime(), endTime, true)
+	airdrop2 := s.createAirdrop(
+		2, s.addr(1), utils.ParseCoins("1000000000denom1"),
+		[]types.ConditionType{types.ConditionTypeDeposit},
+		s.ctx.BlockTime(), endTime.AddDate(0, 1, 0), true)
+
+	// Nothing happens before the end time
+	s.ctx = s.ctx.WithBlockTime(endTime.Add(-time.Second))
+	claim.EndBlocker(s.ctx, s.keeper)
+	for _, airdrop := range s.keeper.GetAllAirdrops(s.ctx) {
+		s.Require().False(airdrop.IsTerminated)
+	}
+
+	// Only the first airdrop ends
+	s.ctx = s.ctx.WithBlockTime(endTime)
+	claim.EndBlocker(s.ctx, s.keeper)
+	airdrop1, _ = s.keeper.GetAirdrop(s.ctx, airdrop1.Id)
+	s.Require().True(airdrop1.IsTerminated)
+	airdrop2, _ = s.keeper.GetAirdrop(s.ctx, airdrop2.Id)
+	s.Require().False(airdrop2.IsTerminated)
+	feePool := s.app.DistrKeeper.GetFeePool(s.ctx)
+	s.Require().Equal("1000000000.000000000000000000denom1", feePool.CommunityPool.String())
+
+	// A terminated airdrop is not visited again even if its source is funded
+	s.fundAddr(airdrop1.GetSourceAddress(), utils.ParseCoins("1000000denom1"))
+	s.ctx = s.ctx.WithBlockTime(endTime.AddDate(0, 0, 1))
+	claim.EndBlocker(s.ctx, s.keeper)
+	s.Require().True(coinsEq(utils.ParseCoins("1000000denom1"), s.getAllBalances(airdrop1.GetSourceAddress())))
+
+	var airdropIds []uint64
+	s.keeper.IterateAirdropQueueUntil(s.ctx, endTime.AddDate(1, 0, 0), func(_ time.Time, airdropId uint64) (stop bool) {
+		airdropIds = append(airdropIds, airdropId)
+		return false
+	})
+	s.Require().Equal([]uint64{airdrop2.Id}, airdropIds)
+}
+
+func (s *KeeperTestSuite) TestClaim_AirdropNotStarted() {
+	airdrop := s.createAirdrop(
+		1, s.addr(0), utils.ParseCoins("1000000000denom1"),
+		[]types.ConditionType{types.ConditionTypeDeposit},
+		s.ctx.BlockTime().AddDate(0, 0, 1), s.ctx.BlockTime().AddDate(0, 1, 0), true)
+	recipient := s.addr(1)
+	s.createClaimRecord(
+		airdrop.Id, recipient, utils.ParseCoins("1000000denom1"), utils.ParseCoins("1000000denom1"),
+		[]types.ConditionType{})
+
+	_, err := s.keeper.Claim(s.ctx, types.NewMsgClaim(airdrop.Id, recipient, types.ConditionTypeDeposit))
+	s.Require().ErrorIs(err, types.ErrAirdropNotStarted)
+}
+
+func (s *KeeperTestSuite) TestClaim_Requirements() {
+	airdrop := s.createAirdrop(
+		1, s.addr(0), utils.ParseCoins("1000000000denom1"),
+		[]types.ConditionType{types.ConditionTypeDeposit, types.ConditionTypeVote},
+		s.ctx.BlockTime(), s.ctx.BlockTime().AddDate(0, 1, 0), true)
+	recipient := s.addr(1)
+	s.createClaimRecord(
+		airdrop.Id, recipient, utils.ParseCoins("1000000000denom1"), utils.ParseCoins("1000000000denom1"),
+		[]types.ConditionType{})
+
+	creator := s.addr(2)
+	s.createPair(creator, "denom3", "denom4", true)
+	pool := s.createPool(creator, 1, utils.ParseCoins("1000000denom3,1000000denom4"), true)
+
+	// A dust deposit
+	s.deposit(recipient, pool.Id, utils.ParseCoins("1000denom3,1000denom4"), true)
+	liquidity.EndBlocker(s.ctx, s.app.LiquidityKeeper)
+	poolCoinBalance := s.getBalance(recipient, pool.PoolCoinDenom).Amount
+	s.Require().True(poolCoinBalance.IsPositive())
+
+	// Deposit at least 100000000000 pool coins or have farmed them for at least 7 days.
+	// Vote on the proposal 2.
+	airdrop.Requirements = []types.ConditionRequirement{
+		{
+			ConditionType: types.ConditionTypeDeposit,
+			Operator:      types.CriteriaOperatorOr,
+			Criteria: []types.Criterion{
+				{
+					ConditionType: types.ConditionTypeDeposit,
+					PoolId:        pool.Id,
+					MinAmount:     sdk.NewInt(100_000_000_000),
+				},
+				{
+					ConditionType:      types.ConditionTypeFarming,
+					PoolId:             pool.Id,
+					MinAmount:          sdk.NewInt(1000000),
+					MinFarmingDuration: 7 * 24 * time.Hour,
+				},
+			},
+		},
+		{
+			ConditionType: types.ConditionTypeVote,
+			Operator:      types.CriteriaOperatorAnd,
+			Criteria: []types.Criterion{
+				{ConditionType: types.ConditionTypeVote, ProposalId: 2, MinAmount: sdk.ZeroInt()},
+				{ConditionType: types.ConditionTypeDeposit, PoolId: pool.Id, MinAmount: sdk.NewInt(1)},
+			},
+		},
+	}
+	s.Require().NoError(airdrop.Validate())
+	s.keeper.SetAirdrop(s.ctx, airdrop)
+
+	// The default deposit condition is met, but the requirement is not
+	s.Require().NoError(s.keeper.ValidateCondition(s.ctx, recipient, types.ConditionTypeDeposit))
+	_, err := s.keeper.Claim(s.ctx, types.NewMsgClaim(airdrop.Id, recipient, types.ConditionTypeDeposit))
+	s.Require().ErrorIs(err, types.ErrConditionRequired)
+
+	// A position farmed right before the claim doesn't meet the requirement
+	farmingAmt := sdk.NewCoin(pool.PoolCoinDenom, sdk.NewInt(1000000))
+	s.fundAddr(recipient, sdk.NewCoins(farmingAmt))
+	_, err = s.app.LPFarmKeeper.Farm(s.ctx, recipient, farmingAmt)
+	s.Require().NoError(err)
+	_, err = s.keeper.Claim(s.ctx, types.NewMsgClaim(airdrop.Id, recipient, types.ConditionTypeDeposit))
+	s.Require().ErrorIs(err, types.ErrConditionRequired)
+
+	// Farming the same amount again after 6 days moves the farming start time 3 days forward
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(6 * 24 * time.Hour))
+	s.fundAddr(recipient, sdk.NewCoins(farmingAmt))
+	_, err = s.app.LPFarmKeeper.Farm(s.ctx, recipient, farmingAmt)
+	s.Require().NoError(err)
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(3 * 24 * time.Hour))
+	_, err = s.keeper.Claim(s.ctx, types.NewMsgClaim(airdrop.Id, recipient, types.ConditionTypeDeposit))
+	s.Require().ErrorIs(err, types.ErrConditionRequired)
+
+	// The position has been farming for 7 days
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(24 * time.Hour))
+	_, err = s.keeper.Claim(s.ctx, types.NewMsgClaim(airdrop.Id, recipient, types.ConditionTypeDeposit))
+	s.Require().NoError(err)
+
+	// A vote on another proposal doesn't meet the requirement
+	s.createTextProposal(s.addr(0), "Text1", "Description")
+	s.createTextProposal(s.addr(0), "Text2", "Description")
+	s.vote(recipient, 1, govtypes.OptionYes)
+	s.Require().NoError(s.keeper.ValidateCondition(s.ctx, recipient, types.ConditionTypeVote))
+	_, err = s.keeper.Claim(s.ctx, types.NewMsgClaim(airdrop.Id, recipient, types.ConditionTypeVote))
+	s.Require().ErrorIs(err, types.ErrConditionRequired)
+
+	s.vote(recipient, 2, govtypes.OptionYes)
+	_, err = s.keeper.Claim(s.ctx, types.NewMsgClaim(airdrop.Id, recipient, types.ConditionTypeVote))
+	s.Require().NoError(err)
+	s.Require().True(coinsEq(utils.ParseCoins("1000000000denom1"), sdk.NewCoins(s.getBalance(recipient, "denom1"))))
+}
+
+func (s *KeeperTestSuite) TestValidateCriterion_LiquidStake() {
+	s.createWhitelistedValidators([]int64{1000000, 1000000, 1000000})
+	recipient := s.addr(1)
+	criterion := types.Criterion{
+		ConditionType: types.ConditionTypeLiquidStake,
+		MinAmount:     sdk.NewInt(100_000_000),
+	}
+
+	s.liquidStaking(recipient, sdk.NewInt(1_000_000), true)
+	s.Require().NoError(s.keeper.ValidateCondition(s.ctx, recipient, types.ConditionTypeLiquidStake))
+	s.Require().ErrorIs(s.keeper.ValidateCriterion(s.ctx, recipient, criterion), types.ErrConditionRequired)
+
+	s.liquidStaking(recipient, sdk.NewInt(100_000_000), true)
+	s.Require().NoError(s.keeper.ValidateCriterion(s.ctx, recipient, criterion))
+}
+
+func (s *KeeperTestSuite) TestValidateCondition_Farming() {
+	recipient := s.addr(1)
+	s.Require().ErrorIs(s.keeper.ValidateCondition(s.ctx, recipient, types.ConditionTypeFarming), types.ErrConditionRequired)
+
+	creator := s.addr(2)
+	s.createPair(creator, "denom3", "denom4", true)
+	pool := s.createPool(creator, 1, utils.ParseCoins("1000000denom3,1000000denom4"), true)
+
+	farmingAmt := sdk.NewCoin(pool.PoolCoinDenom, sdk.NewInt(1000000))
+	s.fundAddr(recipient, sdk.NewCoins(farmingAmt))
+	_, err := s.app.LPFarmKeeper.Farm(s.ctx, recipient, farmingAmt)
+	s.Require().NoError(err)
+	s.Require().NoError(s.keeper.ValidateCondition(s.ctx, recipient, types.ConditionTypeFarming))
+}
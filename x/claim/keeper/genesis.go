@@ -12,13 +12,20 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		panic(err)
 	}
 
+	k.SetParams(ctx, genState.Params)
+
+	lastAirdropId := genState.LastAirdropId
 	for _, a := range genState.Airdrops {
 		_, found := k.GetAirdrop(ctx, a.Id)
 		if found {
 			panic("airdrop already exists")
 		}
 		k.SetAirdrop(ctx, a)
+		if a.Id > lastAirdropId {
+			lastAirdropId = a.Id
+		}
 	}
+	k.SetLastAirdropId(ctx, lastAirdropId)
 
 	for _, r := range genState.ClaimRecords {
 		k.SetClaimRecord(ctx, r)
@@ -35,7 +42,9 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	}
 
 	return &types.GenesisState{
-		Airdrops:     airdrops,
-		ClaimRecords: records,
+		Airdrops:      airdrops,
+		ClaimRecords:  records,
+		LastAirdropId: k.GetLastAirdropId(ctx),
+		Params:        k.GetParams(ctx),
 	}
 }
@@ -146,6 +146,7 @@ func (s *KeeperTestSuite) TestImportExportGenesis() {
 	})
 	s.Require().Len(genState.Airdrops, 2)
 	s.Require().Len(genState.ClaimRecords, 5)
+	s.Require().EqualValues(2, genState.LastAirdropId)
 
 	// Reinitialize exported genesis
 	s.Require().NotPanics(func() {
@@ -174,3 +175,27 @@ func (s *KeeperTestSuite) TestImportExportGenesisEmpty() {
 	s.Require().Equal(*genState, genState2)
 	s.Require().Equal(genState2, *genState3)
 }
+
+func (s *KeeperTestSuite) TestImportExportGenesis_MerkleAirdrop() {
+	leaves := [][]byte{
+		types.MerkleLeaf(s.addr(1), utils.ParseCoins("600000000denom1")),
+		types.MerkleLeaf(s.addr(2), utils.ParseCoins("400000000denom1")),
+	}
+	creator := s.addr(0)
+	s.fundAddr(creator, utils.ParseCoins("1000000000denom1").Add(s.keeper.GetAirdropCreationFee(s.ctx)...))
+	_, err := s.keeper.CreateAirdrop(s.ctx, types.NewMsgCreateAirdrop(
+		creator, utils.ParseCoins("1000000000denom1"),
+		[]types.ConditionType{types.ConditionTypeDeposit},
+		types.MerkleRoot(leaves), s.ctx.BlockTime(), s.ctx.BlockTime().AddDate(0, 1, 0), nil))
+	s.Require().NoError(err)
+
+	genState := s.keeper.ExportGenesis(s.ctx)
+	s.Require().NoError(genState.Validate())
+
+	s.app = chain.Setup(false)
+	s.ctx = s.app.BaseApp.NewContext(false, tmproto.Header{})
+	s.keeper = s.app.ClaimKeeper
+	s.keeper.InitGenesis(s.ctx, *genState)
+	s.Require().Equal(genState, s.keeper.ExportGenesis(s.ctx))
+	s.Require().EqualValues(1, s.keeper.GetLastAirdropId(s.ctx))
+}
@@ -20,6 +20,12 @@ type Querier struct {
 
 var _ types.QueryServer = Querier{}
 
+// Params queries the parameters of the claim module.
+func (k Querier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
 // Airdrops queries all the existing airdrops.
 func (k Querier) Airdrops(c context.Context, req *types.QueryAirdropsRequest) (*types.QueryAirdropsResponse, error) {
 	if req == nil {
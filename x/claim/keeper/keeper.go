@@ -5,6 +5,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/cosmosquad-labs/squad/v3/x/claim/types"
@@ -13,30 +14,41 @@ import (
 type Keeper struct {
 	cdc                 codec.BinaryCodec
 	storeKey            sdk.StoreKey
+	paramSpace          paramtypes.Subspace
 	bankKeeper          types.BankKeeper
 	distrKeeper         types.DistrKeeper
 	govKeeper           types.GovKeeper
 	liquidityKeeper     types.LiquidityKeeper
 	liquidStakingKeeper types.LiquidStakingKeeper
+	lpFarmKeeper        types.LPFarmKeeper
 }
 
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeKey sdk.StoreKey,
+	paramSpace paramtypes.Subspace,
 	bk types.BankKeeper,
 	dk types.DistrKeeper,
 	gk types.GovKeeper,
 	lk types.LiquidityKeeper,
 	lsk types.LiquidStakingKeeper,
+	lfk types.LPFarmKeeper,
 ) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return Keeper{
 		cdc:                 cdc,
 		storeKey:            storeKey,
+		paramSpace:          paramSpace,
 		bankKeeper:          bk,
 		distrKeeper:         dk,
 		govKeeper:           gk,
 		liquidityKeeper:     lk,
 		liquidStakingKeeper: lsk,
+		lpFarmKeeper:        lfk,
 	}
 }
 
@@ -30,3 +30,15 @@ func (m msgServer) Claim(goCtx context.Context, msg *types.MsgClaim) (*types.Msg
 
 	return &types.MsgClaimResponse{}, nil
 }
+
+// CreateAirdrop defines a method to create a new merkle airdrop.
+func (m msgServer) CreateAirdrop(goCtx context.Context, msg *types.MsgCreateAirdrop) (*types.MsgCreateAirdropResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	airdrop, err := m.Keeper.CreateAirdrop(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateAirdropResponse{AirdropId: airdrop.Id}, nil
+}
@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmosquad-labs/squad/v3/x/claim/types"
+)
+
+// GetParams returns the parameters for the module.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return
+}
+
+// SetParams sets the parameters for the module.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetAirdropCreationFee returns the fee for creating an airdrop.
+func (k Keeper) GetAirdropCreationFee(ctx sdk.Context) (fee sdk.Coins) {
+	k.paramSpace.Get(ctx, types.KeyAirdropCreationFee, &fee)
+	return
+}
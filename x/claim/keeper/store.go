@@ -1,11 +1,49 @@
 package keeper
 
 import (
+	"time"
+
+	gogotypes "github.com/gogo/protobuf/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmosquad-labs/squad/v3/x/claim/types"
 )
 
+// GetLastAirdropId returns the last airdrop id.
+func (k Keeper) GetLastAirdropId(ctx sdk.Context) (id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastAirdropIdKey)
+	if bz == nil {
+		return 0
+	}
+	var val gogotypes.UInt64Value
+	k.cdc.MustUnmarshal(bz, &val)
+	return val.GetValue()
+}
+
+// SetLastAirdropId stores the last airdrop id.
+func (k Keeper) SetLastAirdropId(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: id})
+	store.Set(types.LastAirdropIdKey, bz)
+}
+
+// getNextAirdropIdWithUpdate increments the last airdrop id and returns it.
+// Ids already taken by airdrops stored before the last airdrop id was
+// tracked are skipped.
+func (k Keeper) getNextAirdropIdWithUpdate(ctx sdk.Context) uint64 {
+	id := k.GetLastAirdropId(ctx) + 1
+	for {
+		if _, found := k.GetAirdrop(ctx, id); !found {
+			break
+		}
+		id++
+	}
+	k.SetLastAirdropId(ctx, id)
+	return id
+}
+
 // GetAirdrop returns the airdrop object from the airdrop id.
 func (k Keeper) GetAirdrop(ctx sdk.Context, airdropId uint64) (airdrop types.Airdrop, found bool) {
 	store := ctx.KVStore(k.storeKey)
@@ -18,10 +56,32 @@ func (k Keeper) GetAirdrop(ctx sdk.Context, airdropId uint64) (airdrop types.Air
 }
 
 // SetAirdrop sets start and end times and stores the airdrop.
+// The airdrop is queued for the termination at its end time unless it has
+// been terminated.
 func (k Keeper) SetAirdrop(ctx sdk.Context, airdrop types.Airdrop) {
 	store := ctx.KVStore(k.storeKey)
 	bz := k.cdc.MustMarshal(&airdrop)
 	store.Set(types.GetAirdropKey(airdrop.Id), bz)
+	if airdrop.IsTerminated {
+		store.Delete(types.GetAirdropQueueKey(airdrop.EndTime, airdrop.Id))
+	} else {
+		store.Set(types.GetAirdropQueueKey(airdrop.EndTime, airdrop.Id), []byte{})
+	}
+}
+
+// IterateAirdropQueueUntil iterates through the airdrop queue entries whose
+// end time is equal to or before the given time t.
+func (k Keeper) IterateAirdropQueueUntil(ctx sdk.Context, t time.Time, cb func(endTime time.Time, airdropId uint64) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(
+		types.AirdropQueueKeyPrefix, sdk.PrefixEndBytes(types.GetAirdropQueueTimeKeyPrefix(t)))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		endTime, airdropId := types.ParseAirdropQueueKey(iter.Key())
+		if cb(endTime, airdropId) {
+			break
+		}
+	}
 }
 
 // GetClaimRecordByRecipient returns the claim record for the given airdrop id and the recipient address.
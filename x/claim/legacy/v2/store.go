@@ -0,0 +1,42 @@
+package v2
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/cosmosquad-labs/squad/v3/x/claim/types"
+)
+
+// MigrateParams sets the params added in v2 to their default values.
+func MigrateParams(ctx sdk.Context, paramSpace paramstypes.Subspace) {
+	paramSpace.Set(ctx, types.KeyAirdropCreationFee, types.DefaultAirdropCreationFee)
+}
+
+// MigrateAirdropQueue adds the airdrops which are not terminated to the
+// airdrop queue, so that they are terminated at their end time.
+func MigrateAirdropQueue(store sdk.KVStore, cdc codec.BinaryCodec) error {
+	iter := sdk.KVStorePrefixIterator(store, types.AirdropKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var airdrop types.Airdrop
+		if err := cdc.Unmarshal(iter.Value(), &airdrop); err != nil {
+			return err
+		}
+		if !airdrop.IsTerminated {
+			store.Set(types.GetAirdropQueueKey(airdrop.EndTime, airdrop.Id), []byte{})
+		}
+	}
+
+	return nil
+}
+
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryCodec, paramSpace paramstypes.Subspace) error {
+	MigrateParams(ctx, paramSpace)
+	store := ctx.KVStore(storeKey)
+	if err := MigrateAirdropQueue(store, cdc); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	chain "github.com/cosmosquad-labs/squad/v3/app"
+	utils "github.com/cosmosquad-labs/squad/v3/types"
+	v2claim "github.com/cosmosquad-labs/squad/v3/x/claim/legacy/v2"
+	"github.com/cosmosquad-labs/squad/v3/x/claim/types"
+)
+
+func TestMigrateParams(t *testing.T) {
+	encCfg := chain.MakeTestEncodingConfig()
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	paramsKey := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+
+	cms := store.NewCommitMultiStore(dbm.NewMemDB())
+	cms.MountStoreWithDB(storeKey, sdk.StoreTypeIAVL, nil)
+	cms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, nil)
+	cms.MountStoreWithDB(paramsTKey, sdk.StoreTypeTransient, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+	ctx := sdk.NewContext(cms, tmproto.Header{}, false, log.NewNopLogger())
+
+	paramSpace := paramstypes.NewSubspace(
+		encCfg.Marshaler, encCfg.Amino, paramsKey, paramsTKey, types.ModuleName).WithKeyTable(types.ParamKeyTable())
+
+	require.NoError(t, v2claim.MigrateStore(ctx, storeKey, encCfg.Marshaler, paramSpace))
+
+	var airdropCreationFee sdk.Coins
+	paramSpace.Get(ctx, types.KeyAirdropCreationFee, &airdropCreationFee)
+	require.Equal(t, types.DefaultAirdropCreationFee, airdropCreationFee)
+}
+
+func TestMigrateAirdropQueue(t *testing.T) {
+	cdc := chain.MakeTestEncodingConfig().Marshaler
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(storeKey)
+
+	endTime := utils.ParseTime("2023-01-01T00:00:00Z")
+	for _, airdrop := range []types.Airdrop{
+		{Id: 1, SourceAddress: utils.TestAddress(0).String(), EndTime: endTime},
+		{Id: 2, SourceAddress: utils.TestAddress(1).String(), EndTime: endTime, IsTerminated: true},
+	} {
+		store.Set(types.GetAirdropKey(airdrop.Id), cdc.MustMarshal(&airdrop))
+	}
+
+	require.NoError(t, v2claim.MigrateAirdropQueue(store, cdc))
+
+	require.True(t, store.Has(types.GetAirdropQueueKey(endTime, 1)))
+	require.False(t, store.Has(types.GetAirdropQueueKey(endTime, 2)))
+}
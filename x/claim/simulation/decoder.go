@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"fmt"
 
+	gogotypes "github.com/gogo/protobuf/types"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 
@@ -27,6 +29,17 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &crB)
 			return fmt.Sprintf("%v\n%v", crA, crB)
 
+		case bytes.Equal(kvA.Key[:1], types.LastAirdropIdKey):
+			var idA, idB gogotypes.UInt64Value
+			cdc.MustUnmarshal(kvA.Value, &idA)
+			cdc.MustUnmarshal(kvB.Value, &idB)
+			return fmt.Sprintf("%v\n%v", idA.Value, idB.Value)
+
+		case bytes.Equal(kvA.Key[:1], types.AirdropQueueKeyPrefix):
+			endTimeA, airdropIdA := types.ParseAirdropQueueKey(kvA.Key)
+			endTimeB, airdropIdB := types.ParseAirdropQueueKey(kvB.Key)
+			return fmt.Sprintf("%v %v\n%v %v", endTimeA, airdropIdA, endTimeB, airdropIdB)
+
 		default:
 			panic(fmt.Sprintf("invalid claim key prefix %X", kvA.Key[:1]))
 		}
@@ -6,6 +6,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	gogotypes "github.com/gogo/protobuf/types"
+
 	"github.com/cosmos/cosmos-sdk/types/kv"
 
 	chain "github.com/cosmosquad-labs/squad/v3/app"
@@ -45,6 +47,7 @@ func TestDecodeClaimStore(t *testing.T) {
 		Pairs: []kv.Pair{
 			{Key: types.AirdropKeyPrefix, Value: cdc.MustMarshal(&airdrop)},
 			{Key: types.ClaimRecordKeyPrefix, Value: cdc.MustMarshal(&claimRecord)},
+			{Key: types.LastAirdropIdKey, Value: cdc.MustMarshal(&gogotypes.UInt64Value{Value: 2})},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -55,6 +58,7 @@ func TestDecodeClaimStore(t *testing.T) {
 	}{
 		{"Airdrop", fmt.Sprintf("%v\n%v", airdrop, airdrop)},
 		{"ClaimRecord", fmt.Sprintf("%v\n%v", claimRecord, claimRecord)},
+		{"LastAirdropId", "2\n2"},
 		{"other", ""},
 	}
 	for i, tt := range tests {
@@ -95,6 +95,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 	genState := &types.GenesisState{
 		Airdrops:     airdrops,
 		ClaimRecords: claimRecords,
+		Params:       types.DefaultParams(),
 	}
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genState)
 
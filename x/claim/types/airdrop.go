@@ -1,9 +1,18 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
+// DeriveAirdropSourceAddress returns the source address of an airdrop
+// created by MsgCreateAirdrop.
+func DeriveAirdropSourceAddress(airdropId uint64) sdk.AccAddress {
+	return address.Module(ModuleName, []byte(fmt.Sprintf("AirdropSource/%d", airdropId)))
+}
+
 func (a Airdrop) GetSourceAddress() sdk.AccAddress {
 	addr, err := sdk.AccAddressFromBech32(a.SourceAddress)
 	if err != nil {
@@ -12,6 +21,22 @@ func (a Airdrop) GetSourceAddress() sdk.AccAddress {
 	return addr
 }
 
+// HasMerkleRoot returns whether the claim records of the airdrop are
+// proven by a merkle root instead of being stored on-chain.
+func (a Airdrop) HasMerkleRoot() bool {
+	return len(a.MerkleRoot) > 0
+}
+
+// HasCondition returns whether the airdrop has the condition.
+func (a Airdrop) HasCondition(ct ConditionType) bool {
+	for _, c := range a.Conditions {
+		if c == ct {
+			return true
+		}
+	}
+	return false
+}
+
 func (r ClaimRecord) GetRecipient() sdk.AccAddress {
 	addr, err := sdk.AccAddressFromBech32(r.Recipient)
 	if err != nil {
@@ -10,6 +10,7 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -43,6 +44,8 @@ const (
 	ConditionTypeLiquidStake ConditionType = 3
 	// CONDITION_TYPE_VOTE specifies governance vote condition type
 	ConditionTypeVote ConditionType = 4
+	// CONDITION_TYPE_FARMING specifies lpfarm farming condition type
+	ConditionTypeFarming ConditionType = 5
 )
 
 var ConditionType_name = map[int32]string{
@@ -51,6 +54,7 @@ var ConditionType_name = map[int32]string{
 	2: "CONDITION_TYPE_SWAP",
 	3: "CONDITION_TYPE_LIQUIDSTAKE",
 	4: "CONDITION_TYPE_VOTE",
+	5: "CONDITION_TYPE_FARMING",
 }
 
 var ConditionType_value = map[string]int32{
@@ -59,6 +63,7 @@ var ConditionType_value = map[string]int32{
 	"CONDITION_TYPE_SWAP":        2,
 	"CONDITION_TYPE_LIQUIDSTAKE": 3,
 	"CONDITION_TYPE_VOTE":        4,
+	"CONDITION_TYPE_FARMING":     5,
 }
 
 func (x ConditionType) String() string {
@@ -69,6 +74,77 @@ func (ConditionType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_84886eaa62c7639a, []int{0}
 }
 
+// CriteriaOperator defines how the criteria of a requirement are combined.
+type CriteriaOperator int32
+
+const (
+	// CRITERIA_OPERATOR_UNSPECIFIED specifies an unknown operator
+	CriteriaOperatorUnspecified CriteriaOperator = 0
+	// CRITERIA_OPERATOR_AND specifies that all criteria must be met
+	CriteriaOperatorAnd CriteriaOperator = 1
+	// CRITERIA_OPERATOR_OR specifies that any of the criteria must be met
+	CriteriaOperatorOr CriteriaOperator = 2
+)
+
+var CriteriaOperator_name = map[int32]string{
+	0: "CRITERIA_OPERATOR_UNSPECIFIED",
+	1: "CRITERIA_OPERATOR_AND",
+	2: "CRITERIA_OPERATOR_OR",
+}
+
+var CriteriaOperator_value = map[string]int32{
+	"CRITERIA_OPERATOR_UNSPECIFIED": 0,
+	"CRITERIA_OPERATOR_AND":         1,
+	"CRITERIA_OPERATOR_OR":          2,
+}
+
+func (x CriteriaOperator) String() string {
+	return proto.EnumName(CriteriaOperator_name, int32(x))
+}
+
+func (CriteriaOperator) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_84886eaa62c7639a, []int{1}
+}
+
+// Params defines the parameters for the claim module.
+type Params struct {
+	// airdrop_creation_fee specifies the fee for creating an airdrop by MsgCreateAirdrop
+	AirdropCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=airdrop_creation_fee,json=airdropCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"airdrop_creation_fee"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84886eaa62c7639a, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
 // Airdrop defines airdrop information.
 type Airdrop struct {
 	// id specifies index of the airdrop
@@ -82,13 +158,21 @@ type Airdrop struct {
 	StartTime time.Time `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
 	// end_time specifies the start time of the airdrop
 	EndTime time.Time `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	// merkle_root specifies the root of the merkle tree over (recipient, coins) leaves
+	// claim records of the airdrop are created lazily when recipients claim with a proof
+	// empty when claim records are stored on-chain
+	MerkleRoot []byte `protobuf:"bytes,6,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	// requirements specifies a list of requirements that replace the default checks of the conditions
+	Requirements []ConditionRequirement `protobuf:"bytes,7,rep,name=requirements,proto3" json:"requirements"`
+	// is_terminated specifies whether the airdrop has been terminated
+	IsTerminated bool `protobuf:"varint,8,opt,name=is_terminated,json=isTerminated,proto3" json:"is_terminated,omitempty"`
 }
 
 func (m *Airdrop) Reset()         { *m = Airdrop{} }
 func (m *Airdrop) String() string { return proto.CompactTextString(m) }
 func (*Airdrop) ProtoMessage()    {}
 func (*Airdrop) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84886eaa62c7639a, []int{0}
+	return fileDescriptor_84886eaa62c7639a, []int{1}
 }
 func (m *Airdrop) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -117,6 +201,99 @@ func (m *Airdrop) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Airdrop proto.InternalMessageInfo
 
+// ConditionRequirement defines the requirement that a recipient must meet to claim
+// the condition of the airdrop.
+type ConditionRequirement struct {
+	// condition_type specifies the condition of the airdrop the requirement applies to
+	ConditionType ConditionType `protobuf:"varint,1,opt,name=condition_type,json=conditionType,proto3,enum=squad.claim.v1beta1.ConditionType" json:"condition_type,omitempty"`
+	// operator specifies how the criteria are combined
+	Operator CriteriaOperator `protobuf:"varint,2,opt,name=operator,proto3,enum=squad.claim.v1beta1.CriteriaOperator" json:"operator,omitempty"`
+	// criteria specifies a list of criteria
+	Criteria []Criterion `protobuf:"bytes,3,rep,name=criteria,proto3" json:"criteria"`
+}
+
+func (m *ConditionRequirement) Reset()         { *m = ConditionRequirement{} }
+func (m *ConditionRequirement) String() string { return proto.CompactTextString(m) }
+func (*ConditionRequirement) ProtoMessage()    {}
+func (*ConditionRequirement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84886eaa62c7639a, []int{2}
+}
+func (m *ConditionRequirement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConditionRequirement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConditionRequirement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConditionRequirement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConditionRequirement.Merge(m, src)
+}
+func (m *ConditionRequirement) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConditionRequirement) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConditionRequirement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConditionRequirement proto.InternalMessageInfo
+
+// Criterion defines a parameterized check of a condition type.
+type Criterion struct {
+	// condition_type specifies the condition type to check
+	ConditionType ConditionType `protobuf:"varint,1,opt,name=condition_type,json=conditionType,proto3,enum=squad.claim.v1beta1.ConditionType" json:"condition_type,omitempty"`
+	// pool_id specifies the pool for deposit and farming criteria
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// proposal_id specifies the proposal for vote criteria, which must be in voting period when claiming
+	ProposalId uint64 `protobuf:"varint,3,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// min_amount specifies the minimum amount of pool coins for deposit and farming criteria
+	// and the minimum amount of bTokens for liquid stake criteria
+	MinAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=min_amount,json=minAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_amount"`
+	// min_farming_duration specifies the minimum duration the farming position must have been farming
+	// for before the claim for farming criteria
+	MinFarmingDuration time.Duration `protobuf:"bytes,5,opt,name=min_farming_duration,json=minFarmingDuration,proto3,stdduration" json:"min_farming_duration"`
+}
+
+func (m *Criterion) Reset()         { *m = Criterion{} }
+func (m *Criterion) String() string { return proto.CompactTextString(m) }
+func (*Criterion) ProtoMessage()    {}
+func (*Criterion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84886eaa62c7639a, []int{3}
+}
+func (m *Criterion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Criterion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Criterion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Criterion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Criterion.Merge(m, src)
+}
+func (m *Criterion) XXX_Size() int {
+	return m.Size()
+}
+func (m *Criterion) XXX_DiscardUnknown() {
+	xxx_messageInfo_Criterion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Criterion proto.InternalMessageInfo
+
 // ClaimRecord defines claim record that corresponds to the airdrop.
 type ClaimRecord struct {
 	// airdrop_id specifies airdrop id
@@ -136,7 +313,7 @@ func (m *ClaimRecord) Reset()         { *m = ClaimRecord{} }
 func (m *ClaimRecord) String() string { return proto.CompactTextString(m) }
 func (*ClaimRecord) ProtoMessage()    {}
 func (*ClaimRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84886eaa62c7639a, []int{1}
+	return fileDescriptor_84886eaa62c7639a, []int{4}
 }
 func (m *ClaimRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -167,54 +344,120 @@ var xxx_messageInfo_ClaimRecord proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterEnum("squad.claim.v1beta1.ConditionType", ConditionType_name, ConditionType_value)
+	proto.RegisterEnum("squad.claim.v1beta1.CriteriaOperator", CriteriaOperator_name, CriteriaOperator_value)
+	proto.RegisterType((*Params)(nil), "squad.claim.v1beta1.Params")
 	proto.RegisterType((*Airdrop)(nil), "squad.claim.v1beta1.Airdrop")
+	proto.RegisterType((*ConditionRequirement)(nil), "squad.claim.v1beta1.ConditionRequirement")
+	proto.RegisterType((*Criterion)(nil), "squad.claim.v1beta1.Criterion")
 	proto.RegisterType((*ClaimRecord)(nil), "squad.claim.v1beta1.ClaimRecord")
 }
 
 func init() { proto.RegisterFile("squad/claim/v1beta1/claim.proto", fileDescriptor_84886eaa62c7639a) }
 
 var fileDescriptor_84886eaa62c7639a = []byte{
-	// 637 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcf, 0x4f, 0x13, 0x4f,
-	0x1c, 0xdd, 0x6d, 0xcb, 0x17, 0x3a, 0x84, 0x7e, 0xcb, 0x00, 0x5a, 0x37, 0xb8, 0xdd, 0x90, 0x98,
-	0x34, 0x26, 0xec, 0x0a, 0x7a, 0x34, 0x31, 0xfd, 0x65, 0xb2, 0x91, 0xd0, 0xd2, 0x2e, 0x18, 0xbd,
-	0x6c, 0x66, 0x77, 0x86, 0x3a, 0xa1, 0xdd, 0x59, 0x76, 0xa6, 0x2a, 0x67, 0x2f, 0xa6, 0x27, 0xae,
-	0x1e, 0x38, 0x79, 0x30, 0xf1, 0x2f, 0xe1, 0xc8, 0xd1, 0x93, 0x28, 0xfc, 0x23, 0x66, 0x77, 0xb6,
-	0x40, 0xb1, 0x07, 0x4d, 0x3c, 0xb5, 0xf3, 0xe6, 0xf3, 0xe6, 0x7d, 0x3e, 0xef, 0xcd, 0x0e, 0x28,
-	0xf3, 0xc3, 0x21, 0xc2, 0x96, 0xdf, 0x47, 0x74, 0x60, 0xbd, 0xdd, 0xf0, 0x88, 0x40, 0x1b, 0x72,
-	0x65, 0x86, 0x11, 0x13, 0x0c, 0x2e, 0x25, 0x05, 0xa6, 0x84, 0xd2, 0x02, 0x6d, 0xb9, 0xc7, 0x7a,
-	0x2c, 0xd9, 0xb7, 0xe2, 0x7f, 0xb2, 0x54, 0x2b, 0xf7, 0x18, 0xeb, 0xf5, 0x89, 0x95, 0xac, 0xbc,
-	0xe1, 0xbe, 0x25, 0xe8, 0x80, 0x70, 0x81, 0x06, 0x61, 0x5a, 0xa0, 0xfb, 0x8c, 0x0f, 0x18, 0xb7,
-	0x3c, 0xc4, 0xc9, 0xb5, 0x18, 0xa3, 0x81, 0xdc, 0x5f, 0x1b, 0x65, 0xc0, 0x6c, 0x95, 0x46, 0x38,
-	0x62, 0x21, 0x2c, 0x80, 0x0c, 0xc5, 0x25, 0xd5, 0x50, 0x2b, 0xb9, 0x4e, 0x86, 0x62, 0xf8, 0x00,
-	0x14, 0x38, 0x1b, 0x46, 0x3e, 0x71, 0x11, 0xc6, 0x11, 0xe1, 0xbc, 0x94, 0x31, 0xd4, 0x4a, 0xbe,
-	0xb3, 0x20, 0xd1, 0xaa, 0x04, 0x61, 0x0d, 0x00, 0x9f, 0x05, 0x98, 0x0a, 0xca, 0x02, 0x5e, 0xca,
-	0x1a, 0xd9, 0x4a, 0x61, 0x73, 0xcd, 0x9c, 0x32, 0x83, 0x59, 0x1f, 0x97, 0x39, 0x47, 0x21, 0xe9,
-	0xdc, 0x60, 0xc1, 0x3a, 0x00, 0x5c, 0xa0, 0x48, 0xb8, 0x71, 0xff, 0xa5, 0x9c, 0xa1, 0x56, 0xe6,
-	0x37, 0x35, 0x53, 0x0e, 0x67, 0x8e, 0x87, 0x33, 0x9d, 0xf1, 0x70, 0xb5, 0xb9, 0xd3, 0xef, 0x65,
-	0xe5, 0xf8, 0xbc, 0xac, 0x76, 0xf2, 0x09, 0x2f, 0xde, 0x81, 0xcf, 0xc0, 0x1c, 0x09, 0xb0, 0x3c,
-	0x62, 0xe6, 0x2f, 0x8e, 0x98, 0x25, 0x01, 0x8e, 0xf1, 0xb5, 0x4f, 0x59, 0x30, 0x5f, 0x8f, 0x3b,
-	0xee, 0x10, 0x9f, 0x45, 0x18, 0xde, 0x07, 0x00, 0x49, 0x6f, 0xdc, 0x2b, 0x63, 0xf2, 0x29, 0x62,
-	0x63, 0xb8, 0x0a, 0xf2, 0x11, 0xf1, 0x69, 0x48, 0x49, 0x20, 0x52, 0x6b, 0xae, 0x01, 0xf8, 0x41,
-	0x05, 0x77, 0x69, 0x40, 0x05, 0x45, 0x7d, 0x37, 0xb1, 0x01, 0x79, 0x7d, 0xe2, 0xc6, 0xd6, 0x4b,
-	0x93, 0xe6, 0x37, 0xef, 0x99, 0x32, 0x1c, 0x33, 0x0e, 0xe7, 0x86, 0x49, 0x34, 0xa8, 0x3d, 0x8a,
-	0x9b, 0xfb, 0x7a, 0x5e, 0xae, 0xf4, 0xa8, 0x78, 0x33, 0xf4, 0x4c, 0x9f, 0x0d, 0xac, 0x34, 0x49,
-	0xf9, 0xb3, 0xce, 0xf1, 0x81, 0x25, 0x8e, 0x42, 0xc2, 0x13, 0x02, 0xef, 0xac, 0xa4, 0x5a, 0xf5,
-	0xb1, 0x54, 0x02, 0x43, 0x01, 0xfe, 0xbf, 0x2d, 0x9e, 0xfb, 0xf7, 0xe2, 0x05, 0x7f, 0x52, 0x75,
-	0x07, 0xc0, 0x04, 0x21, 0xd8, 0xbd, 0x71, 0x35, 0x66, 0xfe, 0xf8, 0x6a, 0x2c, 0xa6, 0xec, 0x2b,
-	0x94, 0x3f, 0xfc, 0x92, 0x01, 0x0b, 0x13, 0x45, 0xf0, 0x29, 0xd0, 0xea, 0xad, 0xed, 0x86, 0xed,
-	0xd8, 0xad, 0x6d, 0xd7, 0x79, 0xd5, 0x6e, 0xba, 0xbb, 0xdb, 0xdd, 0x76, 0xb3, 0x6e, 0x3f, 0xb7,
-	0x9b, 0x8d, 0xa2, 0xa2, 0xad, 0x8e, 0x4e, 0x8c, 0xd2, 0x04, 0x65, 0x37, 0xe0, 0x21, 0xf1, 0xe9,
-	0x3e, 0x25, 0x18, 0x3e, 0x01, 0x77, 0x6e, 0xb1, 0x1b, 0xcd, 0x76, 0xab, 0x6b, 0x3b, 0x45, 0x55,
-	0x2b, 0x8d, 0x4e, 0x8c, 0xe5, 0x09, 0x66, 0x83, 0x84, 0x8c, 0x53, 0x01, 0x4d, 0xb0, 0x74, 0x8b,
-	0xd5, 0x7d, 0x59, 0x6d, 0x17, 0x33, 0xda, 0xca, 0xe8, 0xc4, 0x58, 0x9c, 0xa0, 0x74, 0xdf, 0xa1,
-	0x70, 0x4a, 0x8f, 0x5b, 0xf6, 0xce, 0xae, 0xdd, 0xe8, 0x3a, 0xd5, 0x17, 0xcd, 0x62, 0x76, 0x4a,
-	0x8f, 0x5b, 0xf4, 0x70, 0x48, 0x71, 0x57, 0xa0, 0x03, 0x32, 0x45, 0x6d, 0xaf, 0xe5, 0x34, 0x8b,
-	0xb9, 0x29, 0x6a, 0x7b, 0x4c, 0x10, 0x2d, 0xf7, 0xf1, 0xb3, 0xae, 0xd4, 0xb6, 0x4e, 0x7f, 0xea,
-	0xca, 0xe9, 0x85, 0xae, 0x9e, 0x5d, 0xe8, 0xea, 0x8f, 0x0b, 0x5d, 0x3d, 0xbe, 0xd4, 0x95, 0xb3,
-	0x4b, 0x5d, 0xf9, 0x76, 0xa9, 0x2b, 0xaf, 0xcd, 0xdf, 0x42, 0x8d, 0xd3, 0x58, 0xef, 0x23, 0x8f,
-	0x5b, 0xf2, 0x61, 0x7a, 0x9f, 0x3e, 0x4d, 0x49, 0xc0, 0xde, 0x7f, 0xc9, 0xa7, 0xf3, 0xf8, 0x57,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xb0, 0x03, 0x43, 0x2f, 0xb6, 0x04, 0x00, 0x00,
+	// 1035 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0xda, 0x4e, 0x62, 0x4f, 0x12, 0xe3, 0x4e, 0x9c, 0xc6, 0x35, 0xad, 0xbd, 0x32, 0x2a,
+	0x32, 0x95, 0xba, 0x6e, 0x0d, 0x47, 0x24, 0x58, 0xff, 0x09, 0x5a, 0x91, 0xda, 0xee, 0xda, 0x29,
+	0x82, 0xcb, 0x6a, 0xbc, 0x3b, 0x31, 0xa3, 0x78, 0x77, 0x36, 0x33, 0x63, 0xa0, 0x07, 0x4e, 0x1c,
+	0x40, 0x3e, 0xf5, 0x08, 0x07, 0x9f, 0x10, 0x17, 0x3e, 0x00, 0x1f, 0x80, 0x53, 0x8e, 0x3d, 0x22,
+	0x24, 0x5a, 0x48, 0x24, 0x3e, 0x07, 0xda, 0xd9, 0xb5, 0x13, 0x3b, 0x56, 0x15, 0xa4, 0x9c, 0xec,
+	0xf9, 0xbd, 0xf7, 0xfb, 0xbd, 0x79, 0x7f, 0xe6, 0x69, 0x41, 0x89, 0x9f, 0x8c, 0x91, 0x53, 0xb5,
+	0x47, 0x88, 0xb8, 0xd5, 0xaf, 0x1e, 0x0f, 0xb0, 0x40, 0x8f, 0xc3, 0x93, 0xe6, 0x33, 0x2a, 0x28,
+	0xdc, 0x91, 0x0e, 0x5a, 0x08, 0x45, 0x0e, 0x85, 0xdc, 0x90, 0x0e, 0xa9, 0xb4, 0x57, 0x83, 0x7f,
+	0xa1, 0x6b, 0xa1, 0x34, 0xa4, 0x74, 0x38, 0xc2, 0x55, 0x79, 0x1a, 0x8c, 0x8f, 0xaa, 0x82, 0xb8,
+	0x98, 0x0b, 0xe4, 0xfa, 0x91, 0x43, 0x71, 0xd9, 0xc1, 0x19, 0x33, 0x24, 0x08, 0xf5, 0x66, 0x76,
+	0x9b, 0x72, 0x97, 0xf2, 0xea, 0x00, 0x71, 0x7c, 0x71, 0x19, 0x4a, 0x22, 0x7b, 0xf9, 0x7b, 0x05,
+	0xac, 0x77, 0x11, 0x43, 0x2e, 0x87, 0xdf, 0x82, 0x1c, 0x22, 0xcc, 0x61, 0xd4, 0xb7, 0x6c, 0x86,
+	0xa5, 0x88, 0x75, 0x84, 0x71, 0x5e, 0x51, 0x13, 0x95, 0xcd, 0xda, 0x1d, 0x2d, 0x54, 0xd2, 0x02,
+	0xa5, 0xd9, 0xad, 0xb5, 0x06, 0x25, 0x5e, 0xfd, 0xd1, 0xe9, 0xab, 0x52, 0xec, 0xd7, 0xd7, 0xa5,
+	0xca, 0x90, 0x88, 0x2f, 0xc7, 0x03, 0xcd, 0xa6, 0x6e, 0x35, 0x0a, 0x1b, 0xfe, 0x3c, 0xe4, 0xce,
+	0x71, 0x55, 0x3c, 0xf7, 0x31, 0x97, 0x04, 0x6e, 0xc2, 0x28, 0x50, 0x23, 0x8a, 0xb3, 0x8f, 0x71,
+	0xf9, 0x97, 0x04, 0xd8, 0xd0, 0x43, 0x18, 0x66, 0x40, 0x9c, 0x38, 0x79, 0x45, 0x55, 0x2a, 0x49,
+	0x33, 0x4e, 0x1c, 0x78, 0x1f, 0x64, 0x38, 0x1d, 0x33, 0x1b, 0x5b, 0xc8, 0x71, 0x18, 0xe6, 0x3c,
+	0x1f, 0x57, 0x95, 0x4a, 0xda, 0xdc, 0x0e, 0x51, 0x3d, 0x04, 0x61, 0x1d, 0x00, 0x9b, 0x7a, 0x0e,
+	0x09, 0x24, 0x79, 0x3e, 0xa1, 0x26, 0x2a, 0x99, 0x5a, 0x59, 0x5b, 0x51, 0x6d, 0xad, 0x31, 0x73,
+	0xeb, 0x3f, 0xf7, 0xb1, 0x79, 0x89, 0x05, 0x1b, 0x00, 0x70, 0x81, 0x98, 0xb0, 0x82, 0x4a, 0xe7,
+	0x93, 0xaa, 0x52, 0xd9, 0xac, 0x15, 0xb4, 0xb0, 0xca, 0xda, 0xac, 0xca, 0x5a, 0x7f, 0xd6, 0x86,
+	0x7a, 0x2a, 0x48, 0xfe, 0xc5, 0xeb, 0x92, 0x62, 0xa6, 0x25, 0x2f, 0xb0, 0xc0, 0x8f, 0x40, 0x0a,
+	0x7b, 0x4e, 0x28, 0xb1, 0xf6, 0x3f, 0x24, 0x36, 0xb0, 0xe7, 0x48, 0x81, 0x12, 0xd8, 0x74, 0x31,
+	0x3b, 0x1e, 0x61, 0x8b, 0x51, 0x2a, 0xf2, 0xeb, 0xaa, 0x52, 0xd9, 0x32, 0x41, 0x08, 0x99, 0x94,
+	0x0a, 0xd8, 0x03, 0x5b, 0x0c, 0x9f, 0x8c, 0x09, 0xc3, 0x2e, 0xf6, 0x04, 0xcf, 0x6f, 0xc8, 0x26,
+	0xbd, 0xf7, 0xe6, 0x64, 0xcd, 0x0b, 0x46, 0x3d, 0x19, 0x04, 0x35, 0x17, 0x44, 0xe0, 0x3b, 0x60,
+	0x9b, 0x70, 0x4b, 0x60, 0xe6, 0x12, 0x0f, 0x09, 0xec, 0xe4, 0x53, 0xaa, 0x52, 0x49, 0x99, 0x5b,
+	0x84, 0xf7, 0xe7, 0x58, 0xf9, 0x5f, 0x05, 0xe4, 0x56, 0x29, 0x42, 0x03, 0x64, 0xe6, 0x75, 0xb4,
+	0x82, 0x6e, 0xcb, 0x06, 0x5e, 0xaf, 0x03, 0xdb, 0xf6, 0xe5, 0x23, 0xd4, 0x41, 0x8a, 0xfa, 0x98,
+	0x21, 0x41, 0x99, 0xec, 0x74, 0xa6, 0x76, 0x7f, 0xb5, 0x08, 0x23, 0x02, 0x33, 0x82, 0x3a, 0x91,
+	0xb3, 0x39, 0xa7, 0xc1, 0x8f, 0x41, 0xca, 0x8e, 0xac, 0x72, 0x12, 0x36, 0x6b, 0xc5, 0x37, 0x49,
+	0x50, 0x2f, 0xaa, 0xc8, 0x9c, 0x55, 0xfe, 0x2d, 0x0e, 0xd2, 0x73, 0xeb, 0x4d, 0x66, 0xb7, 0x07,
+	0x36, 0x7c, 0x4a, 0x47, 0x16, 0x71, 0x64, 0x72, 0x49, 0x73, 0x3d, 0x38, 0x1a, 0x4e, 0xd0, 0x75,
+	0x9f, 0x51, 0x9f, 0x72, 0x24, 0x8d, 0x09, 0x69, 0x04, 0x33, 0xc8, 0x70, 0xe0, 0x13, 0x00, 0x5c,
+	0xe2, 0x59, 0xc8, 0xa5, 0x63, 0x4f, 0xc8, 0xe1, 0x4c, 0xd7, 0xb5, 0xe0, 0xda, 0x7f, 0xbe, 0x2a,
+	0xbd, 0x7b, 0x8d, 0xd7, 0x67, 0x78, 0xc2, 0x4c, 0xbb, 0xc4, 0xd3, 0xa5, 0x00, 0x3c, 0x04, 0xb9,
+	0x40, 0xee, 0x08, 0x05, 0xcd, 0x1d, 0x5a, 0xb3, 0xd5, 0x11, 0x8d, 0xec, 0x9d, 0x2b, 0x23, 0xdb,
+	0x8c, 0x1c, 0xc2, 0x89, 0xfd, 0x31, 0x98, 0x58, 0xe8, 0x12, 0x6f, 0x3f, 0xe4, 0xcf, 0xac, 0xe5,
+	0x9f, 0x12, 0x60, 0xb3, 0x11, 0x94, 0xc3, 0xc4, 0x36, 0x65, 0x0e, 0xbc, 0x07, 0xc0, 0x6c, 0xb1,
+	0xcc, 0x5f, 0x75, 0x3a, 0x42, 0x0c, 0x07, 0xde, 0x05, 0x69, 0x86, 0x6d, 0xe2, 0x13, 0xec, 0x89,
+	0xe8, 0x5d, 0x5f, 0x00, 0xf0, 0x3b, 0x05, 0xec, 0x11, 0x8f, 0x08, 0x82, 0x46, 0x96, 0xac, 0x31,
+	0x1a, 0x8c, 0xb0, 0x15, 0x6c, 0x30, 0x1e, 0xf5, 0xf5, 0x46, 0x37, 0xd3, 0x6e, 0x14, 0xab, 0x31,
+	0x0b, 0x25, 0x61, 0x28, 0xc0, 0x5b, 0xcb, 0xc1, 0x93, 0x37, 0x1f, 0x3c, 0x63, 0x2f, 0x46, 0x7d,
+	0x0a, 0xa0, 0x44, 0xb0, 0x63, 0x5d, 0xda, 0x6b, 0x6b, 0xd7, 0xde, 0x6b, 0xb7, 0x22, 0xf6, 0x1c,
+	0xe5, 0x0f, 0xfe, 0x8a, 0x83, 0xed, 0x05, 0x27, 0xf8, 0x21, 0x28, 0x34, 0x3a, 0xed, 0xa6, 0xd1,
+	0x37, 0x3a, 0x6d, 0xab, 0xff, 0x79, 0xb7, 0x65, 0x1d, 0xb6, 0x7b, 0xdd, 0x56, 0xc3, 0xd8, 0x37,
+	0x5a, 0xcd, 0x6c, 0xac, 0x70, 0x77, 0x32, 0x55, 0xf3, 0x0b, 0x94, 0x43, 0x8f, 0xfb, 0xd8, 0x26,
+	0x47, 0x04, 0x3b, 0xf0, 0x03, 0x70, 0x7b, 0x89, 0xdd, 0x6c, 0x75, 0x3b, 0x3d, 0xa3, 0x9f, 0x55,
+	0x0a, 0xf9, 0xc9, 0x54, 0xcd, 0x2d, 0x30, 0x9b, 0xd8, 0xa7, 0x9c, 0x08, 0xa8, 0x81, 0x9d, 0x25,
+	0x56, 0xef, 0x33, 0xbd, 0x9b, 0x8d, 0x17, 0x76, 0x27, 0x53, 0xf5, 0xd6, 0x02, 0xa5, 0xf7, 0x35,
+	0xf2, 0x57, 0xdc, 0xf1, 0xc0, 0x78, 0x7a, 0x68, 0x34, 0x7b, 0x7d, 0xfd, 0xd3, 0x56, 0x36, 0xb1,
+	0xe2, 0x8e, 0x07, 0xe4, 0x64, 0x4c, 0x9c, 0x9e, 0x40, 0xc7, 0x78, 0x45, 0xb4, 0x67, 0x9d, 0x7e,
+	0x2b, 0x9b, 0x5c, 0x11, 0xed, 0x19, 0x15, 0x78, 0x45, 0x4e, 0xfb, 0xba, 0xf9, 0xc4, 0x68, 0x7f,
+	0x92, 0x5d, 0x5b, 0x91, 0x53, 0x34, 0xfd, 0x85, 0xe4, 0x0f, 0x3f, 0x17, 0x63, 0x0f, 0x7e, 0x57,
+	0x40, 0x76, 0x79, 0x2b, 0xc1, 0x3a, 0xb8, 0xd7, 0x30, 0x8d, 0x7e, 0xcb, 0x34, 0x74, 0xab, 0xd3,
+	0x6d, 0x99, 0x7a, 0xbf, 0x63, 0x2e, 0x55, 0xb9, 0x34, 0x99, 0xaa, 0x6f, 0x2f, 0x13, 0x2f, 0x17,
+	0xba, 0x06, 0x76, 0xaf, 0x6a, 0xe8, 0xed, 0x66, 0x56, 0x29, 0xec, 0x4d, 0xa6, 0xea, 0xce, 0x32,
+	0x57, 0xf7, 0x1c, 0xf8, 0x08, 0xe4, 0xae, 0x72, 0x3a, 0x66, 0x36, 0x5e, 0xb8, 0x3d, 0x99, 0xaa,
+	0x70, 0x99, 0xd2, 0x61, 0x61, 0x12, 0xf5, 0x83, 0xd3, 0x7f, 0x8a, 0xb1, 0xd3, 0xb3, 0xa2, 0xf2,
+	0xf2, 0xac, 0xa8, 0xfc, 0x7d, 0x56, 0x54, 0x5e, 0x9c, 0x17, 0x63, 0x2f, 0xcf, 0x8b, 0xb1, 0x3f,
+	0xce, 0x8b, 0xb1, 0x2f, 0xb4, 0x2b, 0xf3, 0x1c, 0x0c, 0xe2, 0xc3, 0x11, 0x1a, 0xf0, 0x6a, 0xf8,
+	0xe9, 0xf3, 0x4d, 0xf4, 0xf1, 0x23, 0x67, 0x7b, 0xb0, 0x2e, 0xf7, 0xc7, 0xfb, 0xff, 0x05, 0x00,
+	0x00, 0xff, 0xff, 0xf3, 0x6e, 0x10, 0x9f, 0x18, 0x09, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AirdropCreationFee) > 0 {
+		for iNdEx := len(m.AirdropCreationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AirdropCreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintClaim(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
 func (m *Airdrop) Marshal() (dAtA []byte, err error) {
@@ -237,6 +480,37 @@ func (m *Airdrop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.IsTerminated {
+		i--
+		if m.IsTerminated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Requirements) > 0 {
+		for iNdEx := len(m.Requirements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requirements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintClaim(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintClaim(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0x32
+	}
 	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
 	if err1 != nil {
 		return 0, err1
@@ -286,6 +560,109 @@ func (m *Airdrop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ConditionRequirement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConditionRequirement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConditionRequirement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Criteria) > 0 {
+		for iNdEx := len(m.Criteria) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Criteria[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintClaim(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Operator != 0 {
+		i = encodeVarintClaim(dAtA, i, uint64(m.Operator))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ConditionType != 0 {
+		i = encodeVarintClaim(dAtA, i, uint64(m.ConditionType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Criterion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Criterion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Criterion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MinFarmingDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinFarmingDuration):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintClaim(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MinAmount.Size()
+		i -= size
+		if _, err := m.MinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintClaim(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.ProposalId != 0 {
+		i = encodeVarintClaim(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintClaim(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ConditionType != 0 {
+		i = encodeVarintClaim(dAtA, i, uint64(m.ConditionType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ClaimRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -307,20 +684,20 @@ func (m *ClaimRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if len(m.ClaimedConditions) > 0 {
-		dAtA6 := make([]byte, len(m.ClaimedConditions)*10)
-		var j5 int
+		dAtA7 := make([]byte, len(m.ClaimedConditions)*10)
+		var j6 int
 		for _, num := range m.ClaimedConditions {
 			for num >= 1<<7 {
-				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j5++
+				j6++
 			}
-			dAtA6[j5] = uint8(num)
-			j5++
+			dAtA7[j6] = uint8(num)
+			j6++
 		}
-		i -= j5
-		copy(dAtA[i:], dAtA6[:j5])
-		i = encodeVarintClaim(dAtA, i, uint64(j5))
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintClaim(dAtA, i, uint64(j6))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -378,6 +755,21 @@ func encodeVarintClaim(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AirdropCreationFee) > 0 {
+		for _, e := range m.AirdropCreationFee {
+			l = e.Size()
+			n += 1 + l + sovClaim(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Airdrop) Size() (n int) {
 	if m == nil {
 		return 0
@@ -402,6 +794,62 @@ func (m *Airdrop) Size() (n int) {
 	n += 1 + l + sovClaim(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
 	n += 1 + l + sovClaim(uint64(l))
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovClaim(uint64(l))
+	}
+	if len(m.Requirements) > 0 {
+		for _, e := range m.Requirements {
+			l = e.Size()
+			n += 1 + l + sovClaim(uint64(l))
+		}
+	}
+	if m.IsTerminated {
+		n += 2
+	}
+	return n
+}
+
+func (m *ConditionRequirement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConditionType != 0 {
+		n += 1 + sovClaim(uint64(m.ConditionType))
+	}
+	if m.Operator != 0 {
+		n += 1 + sovClaim(uint64(m.Operator))
+	}
+	if len(m.Criteria) > 0 {
+		for _, e := range m.Criteria {
+			l = e.Size()
+			n += 1 + l + sovClaim(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Criterion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConditionType != 0 {
+		n += 1 + sovClaim(uint64(m.ConditionType))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovClaim(uint64(m.PoolId))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovClaim(uint64(m.ProposalId))
+	}
+	l = m.MinAmount.Size()
+	n += 1 + l + sovClaim(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinFarmingDuration)
+	n += 1 + l + sovClaim(uint64(l))
 	return n
 }
 
@@ -446,7 +894,7 @@ func sovClaim(x uint64) (n int) {
 func sozClaim(x uint64) (n int) {
 	return sovClaim(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *Airdrop) Unmarshal(dAtA []byte) error {
+func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -469,17 +917,17 @@ func (m *Airdrop) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Airdrop: wiretype end group for non-group")
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Airdrop: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AirdropCreationFee", wireType)
 			}
-			m.Id = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClaim
@@ -489,14 +937,98 @@ func (m *Airdrop) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceAddress", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AirdropCreationFee = append(m.AirdropCreationFee, types.Coin{})
+			if err := m.AirdropCreationFee[len(m.AirdropCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClaim(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Airdrop) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClaim
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Airdrop: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Airdrop: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -661,6 +1193,390 @@ func (m *Airdrop) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleRoot = append(m.MerkleRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.MerkleRoot == nil {
+				m.MerkleRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requirements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requirements = append(m.Requirements, ConditionRequirement{})
+			if err := m.Requirements[len(m.Requirements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsTerminated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsTerminated = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClaim(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConditionRequirement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClaim
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConditionRequirement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConditionRequirement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConditionType", wireType)
+			}
+			m.ConditionType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConditionType |= ConditionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			m.Operator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Operator |= CriteriaOperator(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Criteria", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Criteria = append(m.Criteria, Criterion{})
+			if err := m.Criteria[len(m.Criteria)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClaim(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Criterion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClaim
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Criterion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Criterion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConditionType", wireType)
+			}
+			m.ConditionType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConditionType |= ConditionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFarmingDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaim
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClaim
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaim
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MinFarmingDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClaim(dAtA[iNdEx:])
@@ -8,7 +8,9 @@ import (
 
 // x/claim module sentinel errors
 var (
-	ErrAlreadyClaimed    = sdkerrors.Register(ModuleName, 2, "already claimed condition")
-	ErrTerminatedAirdrop = sdkerrors.Register(ModuleName, 3, "terminated airdrop event")
-	ErrConditionRequired = sdkerrors.Register(ModuleName, 4, "condition must be executed first")
+	ErrAlreadyClaimed     = sdkerrors.Register(ModuleName, 2, "already claimed condition")
+	ErrTerminatedAirdrop  = sdkerrors.Register(ModuleName, 3, "terminated airdrop event")
+	ErrConditionRequired  = sdkerrors.Register(ModuleName, 4, "condition must be executed first")
+	ErrInvalidMerkleProof = sdkerrors.Register(ModuleName, 5, "invalid merkle proof")
+	ErrAirdropNotStarted  = sdkerrors.Register(ModuleName, 6, "airdrop not started")
 )
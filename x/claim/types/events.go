@@ -2,7 +2,8 @@ package types
 
 // Event types for the claim module.
 const (
-	EventTypeClaim = "claim"
+	EventTypeClaim         = "claim"
+	EventTypeCreateAirdrop = "create_airdrop"
 
 	AttributeKeyAirdropId             = "airdrop_id"
 	AttributeKeyRecipient             = "recipient"
@@ -10,4 +11,8 @@ const (
 	AttributeKeyClaimableCoins        = "claimable_coins"
 	AttributeKeyConditionType         = "condition_type"
 	AttributeKeyClaimed               = "claimed"
+	AttributeKeyCreator               = "creator"
+	AttributeKeySourceAddress         = "source_address"
+	AttributeKeyAmount                = "amount"
+	AttributeKeyMerkleRoot            = "merkle_root"
 )
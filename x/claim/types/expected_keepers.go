@@ -7,6 +7,7 @@ import (
 
 	liquiditytypes "github.com/cosmosquad-labs/squad/v3/x/liquidity/types"
 	liquidstakingtypes "github.com/cosmosquad-labs/squad/v3/x/liquidstaking/types"
+	lpfarmtypes "github.com/cosmosquad-labs/squad/v3/x/lpfarm/types"
 )
 
 // AccountKeeper is the expected x/auth module keeper.
@@ -42,3 +43,9 @@ type LiquidityKeeper interface {
 type LiquidStakingKeeper interface {
 	GetParams(ctx sdk.Context) (params liquidstakingtypes.Params)
 }
+
+// LPFarmKeeper defines the expected interface needed to check the farming condition.
+type LPFarmKeeper interface {
+	GetPosition(ctx sdk.Context, farmerAddr sdk.AccAddress, denom string) (position lpfarmtypes.Position, found bool)
+	IteratePositionsByFarmer(ctx sdk.Context, farmerAddr sdk.AccAddress, cb func(position lpfarmtypes.Position) (stop bool))
+}
@@ -12,12 +12,17 @@ func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		Airdrops:     []Airdrop{},
 		ClaimRecords: []ClaimRecord{},
+		Params:       DefaultParams(),
 	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
 	for _, a := range gs.Airdrops {
 		if err := a.Validate(); err != nil {
 			return err
@@ -46,11 +51,21 @@ func (a Airdrop) Validate() error {
 	for _, c := range a.Conditions {
 		switch c {
 		case ConditionTypeDeposit, ConditionTypeSwap,
-			ConditionTypeLiquidStake, ConditionTypeVote:
+			ConditionTypeLiquidStake, ConditionTypeVote, ConditionTypeFarming:
 		default:
 			return fmt.Errorf("unknown condition type %T", c)
 		}
 	}
+
+	if a.HasMerkleRoot() {
+		if err := ValidateMerkleRoot(a.MerkleRoot); err != nil {
+			return err
+		}
+	}
+
+	if err := ValidateRequirements(a.Conditions, a.Requirements); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -30,6 +30,10 @@ type GenesisState struct {
 	Airdrops []Airdrop `protobuf:"bytes,1,rep,name=airdrops,proto3" json:"airdrops"`
 	// claim_records specifies a list of claim records
 	ClaimRecords []ClaimRecord `protobuf:"bytes,2,rep,name=claim_records,json=claimRecords,proto3" json:"claim_records"`
+	// last_airdrop_id specifies the last airdrop id
+	LastAirdropId uint64 `protobuf:"varint,3,opt,name=last_airdrop_id,json=lastAirdropId,proto3" json:"last_airdrop_id,omitempty"`
+	// params defines all the parameters for the claim module
+	Params Params `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,6 +83,20 @@ func (m *GenesisState) GetClaimRecords() []ClaimRecord {
 	return nil
 }
 
+func (m *GenesisState) GetLastAirdropId() uint64 {
+	if m != nil {
+		return m.LastAirdropId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "squad.claim.v1beta1.GenesisState")
 }
@@ -86,24 +104,27 @@ func init() {
 func init() { proto.RegisterFile("squad/claim/v1beta1/genesis.proto", fileDescriptor_065bc953461971b0) }
 
 var fileDescriptor_065bc953461971b0 = []byte{
-	// 261 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2c, 0x2e, 0x2c, 0x4d,
-	0x4c, 0xd1, 0x4f, 0xce, 0x49, 0xcc, 0xcc, 0xd5, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x06,
-	0x2b, 0xd1, 0x03, 0x2b, 0xd1, 0x83, 0x2a, 0x91, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xeb,
-	0x83, 0x58, 0x10, 0xa5, 0x52, 0x72, 0xc9, 0xf9, 0xc5, 0xb9, 0xf9, 0xc5, 0xfa, 0x49, 0x89, 0xc5,
-	0xa9, 0x70, 0xd3, 0x92, 0xf3, 0x33, 0xf3, 0xa0, 0xf2, 0xf2, 0xd8, 0x6c, 0x83, 0x18, 0x0c, 0x56,
-	0xa0, 0x34, 0x9b, 0x91, 0x8b, 0xc7, 0x1d, 0x62, 0x7b, 0x70, 0x49, 0x62, 0x49, 0xaa, 0x90, 0x1d,
-	0x17, 0x47, 0x62, 0x66, 0x51, 0x4a, 0x51, 0x7e, 0x41, 0xb1, 0x04, 0xa3, 0x02, 0xb3, 0x06, 0xb7,
-	0x91, 0x8c, 0x1e, 0x16, 0xf7, 0xe8, 0x39, 0x42, 0x14, 0x39, 0xb1, 0x9c, 0xb8, 0x27, 0xcf, 0x10,
-	0x04, 0xd7, 0x23, 0xe4, 0xcd, 0xc5, 0x0b, 0x56, 0x18, 0x5f, 0x94, 0x9a, 0x9c, 0x5f, 0x94, 0x52,
-	0x2c, 0xc1, 0x04, 0x36, 0x44, 0x01, 0xab, 0x21, 0xce, 0x20, 0x5e, 0x10, 0x58, 0x21, 0xd4, 0x20,
-	0x9e, 0x64, 0x84, 0x50, 0xb1, 0x93, 0xc7, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e,
-	0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31,
-	0x44, 0xe9, 0xa5, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x43, 0xc2, 0x00,
-	0x64, 0xbc, 0x6e, 0x4e, 0x62, 0x52, 0xb1, 0x3e, 0xc4, 0xcf, 0x15, 0x50, 0x5f, 0x97, 0x54, 0x16,
-	0xa4, 0x16, 0x27, 0xb1, 0x81, 0xbd, 0x6b, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x57, 0x80, 0x23,
-	0x93, 0x7f, 0x01, 0x00, 0x00,
+	// 313 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xb1, 0x4e, 0xeb, 0x30,
+	0x14, 0x86, 0xe3, 0xdb, 0xaa, 0xba, 0x4a, 0x5b, 0x21, 0x05, 0x86, 0xa8, 0x20, 0x37, 0x30, 0xa0,
+	0x2e, 0xd8, 0x6a, 0x99, 0x58, 0x90, 0x28, 0x03, 0x20, 0x16, 0x54, 0x36, 0x96, 0xca, 0x71, 0xac,
+	0x60, 0xa9, 0xa9, 0x83, 0x8f, 0x8b, 0xe0, 0x2d, 0x78, 0xac, 0x8e, 0x1d, 0x99, 0x10, 0x6a, 0xde,
+	0x03, 0xa1, 0xd8, 0x56, 0x59, 0xb2, 0x25, 0x3e, 0xdf, 0xf9, 0xfe, 0xa3, 0x3f, 0x3c, 0x86, 0x97,
+	0x15, 0xcb, 0x28, 0x5f, 0x30, 0x59, 0xd0, 0xd7, 0x71, 0x2a, 0x0c, 0x1b, 0xd3, 0x5c, 0x2c, 0x05,
+	0x48, 0x20, 0xa5, 0x56, 0x46, 0x45, 0xfb, 0x16, 0x21, 0x16, 0x21, 0x1e, 0x19, 0x1c, 0xe4, 0x2a,
+	0x57, 0x76, 0x4e, 0xeb, 0x2f, 0x87, 0x0e, 0x30, 0x57, 0x50, 0x28, 0xa0, 0x29, 0x03, 0xb1, 0xb3,
+	0x71, 0x25, 0x97, 0x7e, 0x3e, 0x6c, 0x4a, 0x73, 0x62, 0x0b, 0x9c, 0xfc, 0xa0, 0xb0, 0x77, 0xe3,
+	0xd2, 0x1f, 0x0d, 0x33, 0x22, 0xba, 0x0c, 0xff, 0x33, 0xa9, 0x33, 0xad, 0x4a, 0x88, 0x51, 0xd2,
+	0x1a, 0x75, 0x27, 0x47, 0xa4, 0xe1, 0x1e, 0x72, 0xe5, 0xa0, 0x69, 0x7b, 0xfd, 0x35, 0x0c, 0x66,
+	0xbb, 0x9d, 0xe8, 0x3e, 0xec, 0x5b, 0x70, 0xae, 0x05, 0x57, 0x3a, 0x83, 0xf8, 0x9f, 0x95, 0x24,
+	0x8d, 0x92, 0xeb, 0xfa, 0x6f, 0x66, 0x41, 0x2f, 0xea, 0xf1, 0xbf, 0x27, 0x88, 0x4e, 0xc3, 0xbd,
+	0x05, 0x03, 0x33, 0xf7, 0xf6, 0xb9, 0xcc, 0xe2, 0x56, 0x82, 0x46, 0xed, 0x59, 0xbf, 0x7e, 0xf6,
+	0x27, 0xdc, 0x65, 0xd1, 0x45, 0xd8, 0x29, 0x99, 0x66, 0x05, 0xc4, 0xed, 0x04, 0x8d, 0xba, 0x93,
+	0xc3, 0xc6, 0xb4, 0x07, 0x8b, 0xf8, 0x20, 0xbf, 0x30, 0xbd, 0x5d, 0x6f, 0x31, 0xda, 0x6c, 0x31,
+	0xfa, 0xde, 0x62, 0xf4, 0x51, 0xe1, 0x60, 0x53, 0xe1, 0xe0, 0xb3, 0xc2, 0xc1, 0x13, 0xc9, 0xa5,
+	0x79, 0x5e, 0xa5, 0x84, 0xab, 0x82, 0xba, 0x9a, 0x6b, 0xe7, 0xd9, 0x82, 0xa5, 0x40, 0x5d, 0xad,
+	0x6f, 0xbe, 0x58, 0xf3, 0x5e, 0x0a, 0x48, 0x3b, 0xb6, 0xd1, 0xf3, 0xdf, 0x00, 0x00, 0x00, 0xff,
+	0xff, 0x2e, 0x2d, 0x1c, 0x50, 0xe2, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -126,6 +147,21 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.LastAirdropId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastAirdropId))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.ClaimRecords) > 0 {
 		for iNdEx := len(m.ClaimRecords) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -186,6 +222,11 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.LastAirdropId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastAirdropId))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -292,6 +333,58 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAirdropId", wireType)
+			}
+			m.LastAirdropId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastAirdropId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -60,6 +60,22 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: true,
 		},
+		{
+			desc: "invalid merkle root",
+			genState: &types.GenesisState{
+				Airdrops: []types.Airdrop{
+					{
+						Id:            1,
+						SourceAddress: sdk.AccAddress(crypto.AddressHash([]byte("sourceAddress"))).String(),
+						Conditions:    []types.ConditionType{types.ConditionTypeDeposit},
+						StartTime:     time.Now(),
+						EndTime:       time.Now().AddDate(0, 1, 0),
+						MerkleRoot:    []byte{0x01, 0x02},
+					},
+				},
+			},
+			valid: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()
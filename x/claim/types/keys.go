@@ -1,6 +1,9 @@
 package types
 
 import (
+	"bytes"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 )
@@ -23,6 +26,9 @@ const (
 var (
 	AirdropKeyPrefix     = []byte{0xd5}
 	ClaimRecordKeyPrefix = []byte{0xd6}
+	LastAirdropIdKey     = []byte{0xd7}
+
+	AirdropQueueKeyPrefix = []byte{0xd8}
 )
 
 // GetAirdropKey returns the store key to retrieve the airdrop object from the airdrop id.
@@ -39,3 +45,29 @@ func GetClaimRecordsByAirdropKeyPrefix(airdropId uint64) []byte {
 func GetClaimRecordKey(airdropId uint64, recipient sdk.AccAddress) []byte {
 	return append(append(ClaimRecordKeyPrefix, sdk.Uint64ToBigEndian(airdropId)...), address.MustLengthPrefix(recipient)...)
 }
+
+// GetAirdropQueueKey returns the key for the airdrop queue entry of an
+// airdrop, which is used to terminate the airdrop at its end time.
+func GetAirdropQueueKey(endTime time.Time, airdropId uint64) []byte {
+	return append(GetAirdropQueueTimeKeyPrefix(endTime), sdk.Uint64ToBigEndian(airdropId)...)
+}
+
+// GetAirdropQueueTimeKeyPrefix returns the key prefix for iterating through
+// the airdrop queue entries by the end time.
+func GetAirdropQueueTimeKeyPrefix(endTime time.Time) []byte {
+	return append(AirdropQueueKeyPrefix, sdk.FormatTimeBytes(endTime)...)
+}
+
+// ParseAirdropQueueKey parses the airdrop queue key.
+func ParseAirdropQueueKey(key []byte) (endTime time.Time, airdropId uint64) {
+	if !bytes.HasPrefix(key, AirdropQueueKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+	timeBz := key[len(AirdropQueueKeyPrefix) : len(key)-8]
+	endTime, err := sdk.ParseTimeBytes(timeBz)
+	if err != nil {
+		panic(err)
+	}
+	airdropId = sdk.BigEndianToUint64(key[len(key)-8:])
+	return
+}
@@ -0,0 +1,102 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MerkleHashLength is the length of a merkle root, a leaf and a proof element.
+const MerkleHashLength = sha256.Size
+
+// Domain separation prefixes which prevent an inner node from being
+// proven as a leaf and vice versa.
+const (
+	MerkleLeafPrefix   byte = 0x00
+	MerkleParentPrefix byte = 0x01
+)
+
+// MerkleLeaf returns the merkle leaf hash for the recipient and the claimable coins.
+// The leaf is computed as sha256(0x00 || "{recipient},{coins}") where coins is the
+// canonical string representation of sdk.Coins.
+func MerkleLeaf(recipient sdk.AccAddress, coins sdk.Coins) []byte {
+	h := sha256.Sum256(append([]byte{MerkleLeafPrefix}, fmt.Sprintf("%s,%s", recipient, coins)...))
+	return h[:]
+}
+
+// MerkleParent returns the parent hash of two sibling nodes, which is
+// computed as sha256(0x01 || a || b).
+// Siblings are sorted before being hashed so that proofs don't need to
+// specify on which side each sibling resides.
+func MerkleParent(a, b []byte) []byte {
+	if bytes.Compare(a, b) > 0 {
+		a, b = b, a
+	}
+	h := sha256.Sum256(append(append([]byte{MerkleParentPrefix}, a...), b...))
+	return h[:]
+}
+
+// MerkleRoot returns the merkle root of the leaves.
+// A node without a sibling is carried up to the next level as is.
+// It is mainly used for testing and off-chain tree construction.
+func MerkleRoot(leaves [][]byte) []byte {
+	if len(leaves) == 0 {
+		return nil
+	}
+	level := leaves
+	for len(level) > 1 {
+		var next [][]byte
+		for i := 0; i < len(level); i += 2 {
+			if i+1 < len(level) {
+				next = append(next, MerkleParent(level[i], level[i+1]))
+			} else {
+				next = append(next, level[i])
+			}
+		}
+		level = next
+	}
+	return level[0]
+}
+
+// MerkleProof returns the merkle proof of the leaf at the index.
+// It is mainly used for testing and off-chain tree construction.
+func MerkleProof(leaves [][]byte, index int) [][]byte {
+	var proof [][]byte
+	level := leaves
+	for len(level) > 1 {
+		if sibling := index ^ 1; sibling < len(level) {
+			proof = append(proof, level[sibling])
+		}
+		var next [][]byte
+		for i := 0; i < len(level); i += 2 {
+			if i+1 < len(level) {
+				next = append(next, MerkleParent(level[i], level[i+1]))
+			} else {
+				next = append(next, level[i])
+			}
+		}
+		level = next
+		index /= 2
+	}
+	return proof
+}
+
+// VerifyMerkleProof reports whether the proof proves that the leaf is
+// included in the merkle tree with the given root.
+func VerifyMerkleProof(root, leaf []byte, proof [][]byte) bool {
+	node := leaf
+	for _, p := range proof {
+		node = MerkleParent(node, p)
+	}
+	return bytes.Equal(node, root)
+}
+
+// ValidateMerkleRoot validates the merkle root.
+func ValidateMerkleRoot(root []byte) error {
+	if len(root) != MerkleHashLength {
+		return fmt.Errorf("merkle root must be %d bytes long: %d", MerkleHashLength, len(root))
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package types_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	utils "github.com/cosmosquad-labs/squad/v3/types"
+	"github.com/cosmosquad-labs/squad/v3/x/claim/types"
+)
+
+func TestMerkleProof(t *testing.T) {
+	for _, n := range []int{1, 2, 3, 4, 5, 8, 13} {
+		t.Run(fmt.Sprintf("%d leaves", n), func(t *testing.T) {
+			var leaves [][]byte
+			for i := 0; i < n; i++ {
+				leaves = append(leaves, types.MerkleLeaf(utils.TestAddress(i), utils.ParseCoins(fmt.Sprintf("%ddenom1", 1000+i))))
+			}
+			root := types.MerkleRoot(leaves)
+			require.NoError(t, types.ValidateMerkleRoot(root))
+
+			for i, leaf := range leaves {
+				proof := types.MerkleProof(leaves, i)
+				require.True(t, types.VerifyMerkleProof(root, leaf, proof))
+
+				// A leaf with different coins must not be proven
+				otherLeaf := types.MerkleLeaf(utils.TestAddress(i), utils.ParseCoins("1denom1"))
+				require.False(t, types.VerifyMerkleProof(root, otherLeaf, proof))
+			}
+		})
+	}
+}
+
+func TestMerkleLeaf(t *testing.T) {
+	// Coins are hashed in their canonical form
+	leaf := types.MerkleLeaf(utils.TestAddress(0), utils.ParseCoins("2000denom2,1000denom1"))
+	require.Len(t, leaf, types.MerkleHashLength)
+	require.Equal(t, leaf, types.MerkleLeaf(utils.TestAddress(0), utils.ParseCoins("1000denom1,2000denom2")))
+	require.NotEqual(t, leaf, types.MerkleLeaf(utils.TestAddress(1), utils.ParseCoins("1000denom1,2000denom2")))
+}
+
+func TestMerkleDomainSeparation(t *testing.T) {
+	recipient, coins := utils.TestAddress(0), utils.ParseCoins("1000denom1")
+	leaf := types.MerkleLeaf(recipient, coins)
+	data := []byte(fmt.Sprintf("%s,%s", recipient, coins))
+	h := sha256.Sum256(append([]byte{0x00}, data...))
+	require.Equal(t, h[:], leaf)
+
+	// A parent is hashed with a different prefix than a leaf
+	a, b := types.MerkleLeaf(utils.TestAddress(1), coins), types.MerkleLeaf(utils.TestAddress(2), coins)
+	if bytes.Compare(a, b) > 0 {
+		a, b = b, a
+	}
+	h = sha256.Sum256(append(append([]byte{0x01}, a...), b...))
+	require.Equal(t, h[:], types.MerkleParent(a, b))
+	h = sha256.Sum256(append(append([]byte{0x00}, a...), b...))
+	require.NotEqual(t, h[:], types.MerkleParent(a, b))
+}
+
+func TestValidateMerkleRoot(t *testing.T) {
+	require.EqualError(t, types.ValidateMerkleRoot(nil), "merkle root must be 32 bytes long: 0")
+	require.EqualError(t, types.ValidateMerkleRoot(make([]byte, 31)), "merkle root must be 32 bytes long: 31")
+	require.NoError(t, types.ValidateMerkleRoot(make([]byte, 32)))
+}
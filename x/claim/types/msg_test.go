@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -38,6 +39,29 @@ func TestMsgClaim(t *testing.T) {
 			},
 			"invalid condition type: CONDITION_TYPE_UNSPECIFIED: invalid request",
 		},
+		{
+			"valid proof",
+			func(msg *types.MsgClaim) {
+				msg.InitialClaimableCoins = sdk.NewCoins(sdk.NewInt64Coin("denom1", 1000000))
+				msg.Proof = [][]byte{make([]byte, 32), make([]byte, 32)}
+			},
+			"",
+		},
+		{
+			"invalid proof element",
+			func(msg *types.MsgClaim) {
+				msg.InitialClaimableCoins = sdk.NewCoins(sdk.NewInt64Coin("denom1", 1000000))
+				msg.Proof = [][]byte{make([]byte, 32), make([]byte, 20)}
+			},
+			"proof element must be 32 bytes long: 20: invalid request",
+		},
+		{
+			"empty initial claimable coins with proof",
+			func(msg *types.MsgClaim) {
+				msg.Proof = [][]byte{make([]byte, 32)}
+			},
+			"initial claimable coins must be positive: : invalid request",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			msg := types.NewMsgClaim(1, testAddr, types.ConditionTypeDeposit)
@@ -56,3 +80,105 @@ func TestMsgClaim(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgCreateAirdrop(t *testing.T) {
+	var testAddr = sdk.AccAddress(crypto.AddressHash([]byte("test")))
+
+	for _, tc := range []struct {
+		name        string
+		malleate    func(msg *types.MsgCreateAirdrop)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(msg *types.MsgCreateAirdrop) {},
+			"",
+		},
+		{
+			"invalid creator",
+			func(msg *types.MsgCreateAirdrop) {
+				msg.Creator = "invalidaddr"
+			},
+			"invalid creator address: decoding bech32 failed: invalid separator index -1: invalid address",
+		},
+		{
+			"empty amount",
+			func(msg *types.MsgCreateAirdrop) {
+				msg.Amount = sdk.Coins{}
+			},
+			"amount must not be empty: invalid request",
+		},
+		{
+			"empty conditions",
+			func(msg *types.MsgCreateAirdrop) {
+				msg.Conditions = nil
+			},
+			"conditions must not be empty: invalid request",
+		},
+		{
+			"duplicate conditions",
+			func(msg *types.MsgCreateAirdrop) {
+				msg.Conditions = []types.ConditionType{types.ConditionTypeDeposit, types.ConditionTypeDeposit}
+			},
+			"duplicate condition type CONDITION_TYPE_DEPOSIT: invalid request",
+		},
+		{
+			"unknown condition",
+			func(msg *types.MsgCreateAirdrop) {
+				msg.Conditions = []types.ConditionType{types.ConditionTypeUnspecified}
+			},
+			"unknown condition type CONDITION_TYPE_UNSPECIFIED: invalid request",
+		},
+		{
+			"invalid merkle root",
+			func(msg *types.MsgCreateAirdrop) {
+				msg.MerkleRoot = []byte{0x01}
+			},
+			"merkle root must be 32 bytes long: 1: invalid request",
+		},
+		{
+			"invalid end time",
+			func(msg *types.MsgCreateAirdrop) {
+				msg.EndTime = msg.StartTime
+			},
+			"end time must be after start time: 2022-01-01 00:00:00 +0000 UTC: invalid request",
+		},
+		{
+			"invalid requirement",
+			func(msg *types.MsgCreateAirdrop) {
+				msg.Requirements = []types.ConditionRequirement{
+					{
+						ConditionType: types.ConditionTypeVote,
+						Operator:      types.CriteriaOperatorAnd,
+						Criteria: []types.Criterion{
+							{ConditionType: types.ConditionTypeVote, ProposalId: 1, MinAmount: sdk.ZeroInt()},
+						},
+					},
+				}
+			},
+			"requirement for CONDITION_TYPE_VOTE which is not a condition of the airdrop: invalid request",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgCreateAirdrop(
+				testAddr, sdk.NewCoins(sdk.NewInt64Coin("denom1", 1000000)),
+				[]types.ConditionType{types.ConditionTypeDeposit, types.ConditionTypeSwap},
+				make([]byte, 32),
+				time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2022, 7, 1, 0, 0, 0, 0, time.UTC),
+				nil)
+			tc.malleate(msg)
+			require.Equal(t, types.TypeMsgCreateAirdrop, msg.Type())
+			require.Equal(t, types.RouterKey, msg.Route())
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				signers := msg.GetSigners()
+				require.Len(t, signers, 1)
+				require.Equal(t, testAddr, signers[0])
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
@@ -1,17 +1,22 @@
 package types
 
 import (
+	"fmt"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var (
 	_ sdk.Msg = (*MsgClaim)(nil)
+	_ sdk.Msg = (*MsgCreateAirdrop)(nil)
 )
 
 // Message types for the claim module.
 const (
-	TypeMsgClaim = "claim"
+	TypeMsgClaim         = "claim"
+	TypeMsgCreateAirdrop = "create_airdrop"
 )
 
 // NewMsgClaim creates a new MsgClaim.
@@ -23,6 +28,22 @@ func NewMsgClaim(airdropId uint64, recipient sdk.AccAddress, ConditionType Condi
 	}
 }
 
+// NewMsgClaimWithProof creates a new MsgClaim with the merkle proof
+// of the recipient's leaf, which is required for the first claim of an
+// airdrop with a merkle root.
+func NewMsgClaimWithProof(
+	airdropId uint64, recipient sdk.AccAddress, conditionType ConditionType,
+	initialClaimableCoins sdk.Coins, proof [][]byte,
+) *MsgClaim {
+	return &MsgClaim{
+		AirdropId:             airdropId,
+		Recipient:             recipient.String(),
+		ConditionType:         conditionType,
+		InitialClaimableCoins: initialClaimableCoins,
+		Proof:                 proof,
+	}
+}
+
 func (msg MsgClaim) Route() string { return RouterKey }
 
 func (msg MsgClaim) Type() string { return TypeMsgClaim }
@@ -34,11 +55,22 @@ func (msg MsgClaim) ValidateBasic() error {
 
 	switch msg.ConditionType {
 	case ConditionTypeDeposit, ConditionTypeSwap,
-		ConditionTypeLiquidStake, ConditionTypeVote:
+		ConditionTypeLiquidStake, ConditionTypeVote, ConditionTypeFarming:
 	default:
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid condition type: %s", msg.ConditionType.String())
 	}
 
+	if len(msg.Proof) > 0 {
+		if !msg.InitialClaimableCoins.IsAllPositive() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "initial claimable coins must be positive: %s", msg.InitialClaimableCoins)
+		}
+		for _, p := range msg.Proof {
+			if len(p) != MerkleHashLength {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "proof element must be %d bytes long: %d", MerkleHashLength, len(p))
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -61,3 +93,82 @@ func (msg MsgClaim) GetRecipient() sdk.AccAddress {
 	}
 	return addr
 }
+
+// NewMsgCreateAirdrop creates a new MsgCreateAirdrop.
+func NewMsgCreateAirdrop(
+	creator sdk.AccAddress, amt sdk.Coins, conditions []ConditionType,
+	merkleRoot []byte, startTime, endTime time.Time, requirements []ConditionRequirement,
+) *MsgCreateAirdrop {
+	return &MsgCreateAirdrop{
+		Creator:      creator.String(),
+		Amount:       amt,
+		Conditions:   conditions,
+		MerkleRoot:   merkleRoot,
+		StartTime:    startTime,
+		EndTime:      endTime,
+		Requirements: requirements,
+	}
+}
+
+func (msg MsgCreateAirdrop) Route() string { return RouterKey }
+
+func (msg MsgCreateAirdrop) Type() string { return TypeMsgCreateAirdrop }
+
+func (msg MsgCreateAirdrop) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address: %v", err)
+	}
+	if err := msg.Amount.Validate(); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid amount: %v", err)
+	}
+	if msg.Amount.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "amount must not be empty")
+	}
+	if err := ValidateConditions(msg.Conditions); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if err := ValidateMerkleRoot(msg.MerkleRoot); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if !msg.EndTime.After(msg.StartTime) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "end time must be after start time: %s", msg.EndTime)
+	}
+	if err := ValidateRequirements(msg.Conditions, msg.Requirements); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+func (msg MsgCreateAirdrop) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCreateAirdrop) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateConditions validates the airdrop conditions, which must not be
+// empty nor contain duplicates.
+func ValidateConditions(conditions []ConditionType) error {
+	if len(conditions) == 0 {
+		return fmt.Errorf("conditions must not be empty")
+	}
+	seen := map[ConditionType]struct{}{}
+	for _, c := range conditions {
+		switch c {
+		case ConditionTypeDeposit, ConditionTypeSwap,
+			ConditionTypeLiquidStake, ConditionTypeVote, ConditionTypeFarming:
+		default:
+			return fmt.Errorf("unknown condition type %s", c)
+		}
+		if _, ok := seen[c]; ok {
+			return fmt.Errorf("duplicate condition type %s", c)
+		}
+		seen[c] = struct{}{}
+	}
+	return nil
+}
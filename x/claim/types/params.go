@@ -0,0 +1,62 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+var _ paramstypes.ParamSet = (*Params)(nil)
+
+var (
+	KeyAirdropCreationFee = []byte("AirdropCreationFee")
+)
+
+var (
+	DefaultAirdropCreationFee = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100_000000))
+)
+
+func ParamKeyTable() paramstypes.KeyTable {
+	return paramstypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// DefaultParams returns a default params for the module.
+func DefaultParams() Params {
+	return Params{
+		AirdropCreationFee: DefaultAirdropCreationFee,
+	}
+}
+
+// ParamSetPairs implements ParamSet.
+func (params *Params) ParamSetPairs() paramstypes.ParamSetPairs {
+	return paramstypes.ParamSetPairs{
+		paramstypes.NewParamSetPair(KeyAirdropCreationFee, &params.AirdropCreationFee, validateAirdropCreationFee),
+	}
+}
+
+// Validate validates Params.
+func (params Params) Validate() error {
+	for _, field := range []struct {
+		val          interface{}
+		validateFunc func(i interface{}) error
+	}{
+		{params.AirdropCreationFee, validateAirdropCreationFee},
+	} {
+		if err := field.validateFunc(field.val); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateAirdropCreationFee(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("invalid airdrop creation fee: %w", err)
+	}
+	return nil
+}
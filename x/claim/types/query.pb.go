@@ -30,6 +30,88 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// QueryParamsRequest is request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_685a7facd32d9034, []int{0}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_685a7facd32d9034, []int{1}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 // QueryAirdropsRequest is request type for the Query/Airdrops RPC method.
 type QueryAirdropsRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
@@ -39,7 +121,7 @@ func (m *QueryAirdropsRequest) Reset()         { *m = QueryAirdropsRequest{} }
 func (m *QueryAirdropsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAirdropsRequest) ProtoMessage()    {}
 func (*QueryAirdropsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_685a7facd32d9034, []int{0}
+	return fileDescriptor_685a7facd32d9034, []int{2}
 }
 func (m *QueryAirdropsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -85,7 +167,7 @@ func (m *QueryAirdropsResponse) Reset()         { *m = QueryAirdropsResponse{} }
 func (m *QueryAirdropsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAirdropsResponse) ProtoMessage()    {}
 func (*QueryAirdropsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_685a7facd32d9034, []int{1}
+	return fileDescriptor_685a7facd32d9034, []int{3}
 }
 func (m *QueryAirdropsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -137,7 +219,7 @@ func (m *QueryAirdropRequest) Reset()         { *m = QueryAirdropRequest{} }
 func (m *QueryAirdropRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAirdropRequest) ProtoMessage()    {}
 func (*QueryAirdropRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_685a7facd32d9034, []int{2}
+	return fileDescriptor_685a7facd32d9034, []int{4}
 }
 func (m *QueryAirdropRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -182,7 +264,7 @@ func (m *QueryAirdropResponse) Reset()         { *m = QueryAirdropResponse{} }
 func (m *QueryAirdropResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAirdropResponse) ProtoMessage()    {}
 func (*QueryAirdropResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_685a7facd32d9034, []int{3}
+	return fileDescriptor_685a7facd32d9034, []int{5}
 }
 func (m *QueryAirdropResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -228,7 +310,7 @@ func (m *QueryClaimRecordRequest) Reset()         { *m = QueryClaimRecordRequest
 func (m *QueryClaimRecordRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryClaimRecordRequest) ProtoMessage()    {}
 func (*QueryClaimRecordRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_685a7facd32d9034, []int{4}
+	return fileDescriptor_685a7facd32d9034, []int{6}
 }
 func (m *QueryClaimRecordRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -280,7 +362,7 @@ func (m *QueryClaimRecordResponse) Reset()         { *m = QueryClaimRecordRespon
 func (m *QueryClaimRecordResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryClaimRecordResponse) ProtoMessage()    {}
 func (*QueryClaimRecordResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_685a7facd32d9034, []int{5}
+	return fileDescriptor_685a7facd32d9034, []int{7}
 }
 func (m *QueryClaimRecordResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -317,6 +399,8 @@ func (m *QueryClaimRecordResponse) GetClaimRecord() ClaimRecord {
 }
 
 func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "squad.claim.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "squad.claim.v1beta1.QueryParamsResponse")
 	proto.RegisterType((*QueryAirdropsRequest)(nil), "squad.claim.v1beta1.QueryAirdropsRequest")
 	proto.RegisterType((*QueryAirdropsResponse)(nil), "squad.claim.v1beta1.QueryAirdropsResponse")
 	proto.RegisterType((*QueryAirdropRequest)(nil), "squad.claim.v1beta1.QueryAirdropRequest")
@@ -328,41 +412,45 @@ func init() {
 func init() { proto.RegisterFile("squad/claim/v1beta1/query.proto", fileDescriptor_685a7facd32d9034) }
 
 var fileDescriptor_685a7facd32d9034 = []byte{
-	// 535 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xc1, 0x6e, 0xd3, 0x4c,
-	0x14, 0x85, 0x33, 0x6d, 0xff, 0xbf, 0xcd, 0x84, 0xd5, 0xb4, 0x88, 0x28, 0x4a, 0x9d, 0xc8, 0x12,
-	0x90, 0x46, 0xed, 0x8c, 0x1a, 0x58, 0x22, 0x24, 0x0a, 0x2a, 0x54, 0x62, 0x01, 0x16, 0x62, 0xc1,
-	0x82, 0x6a, 0x6c, 0x8f, 0xcc, 0x48, 0x89, 0xc7, 0xf1, 0x38, 0x88, 0xaa, 0xea, 0x86, 0x15, 0x4b,
-	0x04, 0x2f, 0xc0, 0x63, 0xf0, 0x08, 0x5d, 0x56, 0x62, 0xc3, 0x0a, 0xa1, 0x84, 0x07, 0x41, 0x1e,
-	0xdf, 0x38, 0x0e, 0x35, 0xd4, 0xbb, 0xe8, 0xce, 0xb9, 0xf7, 0x7c, 0x73, 0xe6, 0xc6, 0xb8, 0xa3,
-	0xc7, 0x13, 0xee, 0x33, 0x6f, 0xc8, 0xe5, 0x88, 0xbd, 0xdd, 0x77, 0x45, 0xc2, 0xf7, 0xd9, 0x78,
-	0x22, 0xe2, 0x13, 0x1a, 0xc5, 0x2a, 0x51, 0x64, 0xd3, 0x08, 0xa8, 0x11, 0x50, 0x10, 0xb4, 0xb6,
-	0x02, 0x15, 0x28, 0x73, 0xce, 0xd2, 0x5f, 0x99, 0xb4, 0xd5, 0x0e, 0x94, 0x0a, 0x86, 0x82, 0xf1,
-	0x48, 0x32, 0x1e, 0x86, 0x2a, 0xe1, 0x89, 0x54, 0xa1, 0x86, 0xd3, 0xbe, 0xa7, 0xf4, 0x48, 0x69,
-	0xe6, 0x72, 0x2d, 0x32, 0x87, 0xdc, 0x2f, 0xe2, 0x81, 0x0c, 0x8d, 0x18, 0xb4, 0xa5, 0x54, 0x19,
-	0x82, 0x11, 0xd8, 0xaf, 0xf1, 0xd6, 0xf3, 0x74, 0xc4, 0x03, 0x19, 0xfb, 0xb1, 0x8a, 0xb4, 0x23,
-	0xc6, 0x13, 0xa1, 0x13, 0x72, 0x88, 0xf1, 0x62, 0x58, 0x13, 0x75, 0x51, 0xaf, 0x31, 0xb8, 0x45,
-	0x33, 0x67, 0x9a, 0x3a, 0xd3, 0xec, 0x6e, 0x30, 0x93, 0x3e, 0xe3, 0x81, 0x80, 0x5e, 0xa7, 0xd0,
-	0x69, 0x7f, 0x41, 0xf8, 0xfa, 0x1f, 0x06, 0x3a, 0x52, 0xa1, 0x16, 0xe4, 0x3e, 0xde, 0xe0, 0x50,
-	0x6b, 0xa2, 0xee, 0x6a, 0xaf, 0x31, 0x68, 0xd3, 0x92, 0x88, 0x28, 0x34, 0x1e, 0xac, 0x9d, 0xff,
-	0xe8, 0xd4, 0x9c, 0xbc, 0x87, 0x3c, 0x5e, 0x22, 0x5c, 0x31, 0x84, 0xb7, 0xaf, 0x24, 0xcc, 0xcc,
-	0x97, 0x10, 0xef, 0xe2, 0xcd, 0x22, 0xe1, 0x3c, 0x81, 0x6d, 0x8c, 0xc1, 0xeb, 0x58, 0xfa, 0x26,
-	0x81, 0x35, 0xa7, 0x0e, 0x95, 0x23, 0xdf, 0x7e, 0xb1, 0x1c, 0x5c, 0x7e, 0xad, 0x7b, 0x78, 0x1d,
-	0x44, 0x90, 0x5a, 0x95, 0x5b, 0xcd, 0x5b, 0xec, 0x97, 0xf8, 0x86, 0x99, 0xfa, 0x30, 0x15, 0x3b,
-	0xc2, 0x53, 0xb1, 0x5f, 0x8d, 0x87, 0xb4, 0x71, 0x3d, 0x16, 0x9e, 0x8c, 0xa4, 0x08, 0x13, 0x93,
-	0x46, 0xdd, 0x59, 0x14, 0x6c, 0x81, 0x9b, 0x97, 0xe7, 0x02, 0xf1, 0x11, 0xbe, 0x66, 0xd8, 0x8e,
-	0x63, 0x53, 0x07, 0xec, 0x6e, 0x29, 0x76, 0xa1, 0x1f, 0xd0, 0x1b, 0xde, 0xa2, 0x34, 0x98, 0xae,
-	0xe2, 0xff, 0x8c, 0x0f, 0xf9, 0x80, 0xf0, 0xc6, 0xfc, 0xc9, 0xc9, 0x4e, 0xe9, 0xac, 0xb2, 0xbd,
-	0x6b, 0xf5, 0xab, 0x48, 0x33, 0x70, 0xfb, 0xe6, 0xfb, 0x6f, 0xbf, 0x3e, 0xaf, 0x74, 0xc8, 0x36,
-	0x2b, 0xdb, 0xf2, 0x7c, 0x51, 0x3e, 0x21, 0xbc, 0x0e, 0xbd, 0xa4, 0x77, 0xe5, 0xf8, 0x39, 0xc8,
-	0x4e, 0x05, 0x25, 0x70, 0x0c, 0x0c, 0xc7, 0x2e, 0xe9, 0xff, 0x93, 0x83, 0x9d, 0x2e, 0x9e, 0xef,
-	0x8c, 0x7c, 0x45, 0xb8, 0x51, 0x08, 0x93, 0xec, 0xfe, 0xdd, 0xee, 0xf2, 0x2e, 0xb4, 0xf6, 0x2a,
-	0xaa, 0x01, 0xf0, 0xa9, 0x01, 0x3c, 0x24, 0x8f, 0xaa, 0x03, 0xb2, 0xe2, 0x4a, 0x68, 0x76, 0x9a,
-	0xaf, 0xd2, 0xd9, 0xc1, 0x93, 0xf3, 0xa9, 0x85, 0x2e, 0xa6, 0x16, 0xfa, 0x39, 0xb5, 0xd0, 0xc7,
-	0x99, 0x55, 0xbb, 0x98, 0x59, 0xb5, 0xef, 0x33, 0xab, 0xf6, 0x8a, 0x06, 0x32, 0x79, 0x33, 0x71,
-	0xa9, 0xa7, 0x46, 0x2c, 0xfb, 0x23, 0xa6, 0x76, 0x7b, 0x43, 0xee, 0x6a, 0x70, 0x7e, 0x07, 0xde,
-	0xc9, 0x49, 0x24, 0xb4, 0xfb, 0xbf, 0xf9, 0x06, 0xdd, 0xf9, 0x1d, 0x00, 0x00, 0xff, 0xff, 0xad,
-	0xa8, 0xf2, 0x7a, 0x3c, 0x05, 0x00, 0x00,
+	// 594 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x41, 0x6b, 0x13, 0x41,
+	0x14, 0xc7, 0x33, 0x35, 0xa6, 0xcd, 0xc4, 0xd3, 0x34, 0x62, 0x48, 0x93, 0x4d, 0x58, 0xd1, 0xa6,
+	0xa1, 0xdd, 0xa1, 0xd1, 0x8b, 0x20, 0x82, 0x55, 0xaa, 0x05, 0x0f, 0x75, 0x11, 0x0f, 0x1e, 0x2c,
+	0x93, 0xcd, 0xb0, 0x2e, 0x24, 0x3b, 0x9b, 0x9d, 0x8d, 0x58, 0x4a, 0x41, 0x3c, 0x79, 0x14, 0xfd,
+	0x02, 0x7e, 0x0c, 0x3f, 0x42, 0x8f, 0x05, 0x2f, 0x9e, 0x44, 0x12, 0xfd, 0x1e, 0x92, 0x99, 0x97,
+	0x6c, 0xd2, 0x6c, 0x9b, 0xbd, 0x85, 0xb7, 0xff, 0xf7, 0xfe, 0xbf, 0xf7, 0xf2, 0xdf, 0xc5, 0x35,
+	0xd9, 0x1f, 0xb0, 0x0e, 0x75, 0xba, 0xcc, 0xeb, 0xd1, 0xf7, 0xbb, 0x6d, 0x1e, 0xb1, 0x5d, 0xda,
+	0x1f, 0xf0, 0xf0, 0xd8, 0x0a, 0x42, 0x11, 0x09, 0xb2, 0xae, 0x04, 0x96, 0x12, 0x58, 0x20, 0x28,
+	0x17, 0x5d, 0xe1, 0x0a, 0xf5, 0x9c, 0x8e, 0x7f, 0x69, 0x69, 0xb9, 0xe2, 0x0a, 0xe1, 0x76, 0x39,
+	0x65, 0x81, 0x47, 0x99, 0xef, 0x8b, 0x88, 0x45, 0x9e, 0xf0, 0x25, 0x3c, 0x6d, 0x3a, 0x42, 0xf6,
+	0x84, 0xa4, 0x6d, 0x26, 0xb9, 0x76, 0x98, 0xfa, 0x05, 0xcc, 0xf5, 0x7c, 0x25, 0x06, 0x6d, 0x22,
+	0x95, 0x46, 0x50, 0x02, 0xb3, 0x88, 0xc9, 0xcb, 0xf1, 0x88, 0x43, 0x16, 0xb2, 0x9e, 0xb4, 0x79,
+	0x7f, 0xc0, 0x65, 0x64, 0x1e, 0xe2, 0xf5, 0xb9, 0xaa, 0x0c, 0x84, 0x2f, 0x39, 0x79, 0x80, 0x73,
+	0x81, 0xaa, 0x94, 0x50, 0x1d, 0x35, 0x0a, 0xad, 0x0d, 0x2b, 0x61, 0x27, 0x4b, 0x37, 0xed, 0x65,
+	0xcf, 0x7e, 0xd7, 0x32, 0x36, 0x34, 0x98, 0x6f, 0x71, 0x51, 0x4d, 0x7c, 0xec, 0x85, 0x9d, 0x50,
+	0x04, 0x13, 0x27, 0xb2, 0x8f, 0x71, 0x0c, 0x0d, 0x63, 0xef, 0x5a, 0x7a, 0x43, 0x6b, 0xbc, 0xa1,
+	0xa5, 0x6f, 0x18, 0x0f, 0x77, 0x39, 0xf4, 0xda, 0x33, 0x9d, 0xe6, 0x77, 0x84, 0x6f, 0x5e, 0x30,
+	0x00, 0xe8, 0x47, 0x78, 0x8d, 0x41, 0xad, 0x84, 0xea, 0xd7, 0x1a, 0x85, 0x56, 0x25, 0x11, 0x1b,
+	0x1a, 0x81, 0x7b, 0xda, 0x43, 0x9e, 0xcd, 0x11, 0xae, 0x28, 0xc2, 0xcd, 0xa5, 0x84, 0xda, 0x7c,
+	0x0e, 0xf1, 0x3e, 0x1c, 0x15, 0x8c, 0x26, 0x17, 0xa8, 0x62, 0x0c, 0x5e, 0x47, 0x5e, 0x47, 0x5d,
+	0x20, 0x6b, 0xe7, 0xa1, 0x72, 0xd0, 0x31, 0x5f, 0xcd, 0x1f, 0x6e, 0xba, 0xd6, 0x43, 0xbc, 0x0a,
+	0x22, 0xb8, 0x5a, 0x9a, 0xad, 0x26, 0x2d, 0xe6, 0x6b, 0x7c, 0x4b, 0x4d, 0x7d, 0x32, 0x16, 0xdb,
+	0xdc, 0x11, 0x61, 0x27, 0x1d, 0x0f, 0xa9, 0xe0, 0x7c, 0xc8, 0x1d, 0x2f, 0xf0, 0xb8, 0x1f, 0xa9,
+	0x6b, 0xe4, 0xed, 0xb8, 0x60, 0x72, 0x5c, 0x5a, 0x9c, 0x0b, 0xc4, 0x07, 0xf8, 0x86, 0x62, 0x3b,
+	0x0a, 0x55, 0x1d, 0xb0, 0xeb, 0x89, 0xd8, 0x33, 0xfd, 0x80, 0x5e, 0x70, 0xe2, 0x52, 0xeb, 0x5f,
+	0x16, 0x5f, 0x57, 0x3e, 0xe4, 0x23, 0xc2, 0x39, 0x1d, 0x38, 0xb2, 0x99, 0x38, 0x69, 0x31, 0xdd,
+	0xe5, 0xc6, 0x72, 0xa1, 0x46, 0x36, 0x6f, 0x7f, 0xfa, 0xf9, 0xf7, 0xdb, 0x4a, 0x95, 0x6c, 0xd0,
+	0xa4, 0xf7, 0x48, 0x47, 0x9b, 0x7c, 0x46, 0x78, 0x6d, 0x92, 0x3a, 0xb2, 0x75, 0xf9, 0xec, 0x0b,
+	0xd1, 0x2f, 0x37, 0xd3, 0x48, 0x01, 0xe4, 0x8e, 0x02, 0xa9, 0x91, 0x6a, 0x22, 0xc8, 0x34, 0xab,
+	0x5f, 0x11, 0x5e, 0x85, 0x5e, 0xd2, 0x58, 0x3a, 0x7e, 0x02, 0xb2, 0x95, 0x42, 0x09, 0x1c, 0x2d,
+	0xc5, 0xb1, 0x4d, 0x9a, 0x57, 0x72, 0xd0, 0x93, 0x38, 0x41, 0xa7, 0xe4, 0x07, 0xc2, 0x85, 0x99,
+	0xff, 0x93, 0x6c, 0x5f, 0x6e, 0xb7, 0x18, 0xc7, 0xf2, 0x4e, 0x4a, 0x35, 0x00, 0xbe, 0x50, 0x80,
+	0xfb, 0xe4, 0x69, 0x7a, 0x40, 0x3a, 0x9b, 0x4a, 0x49, 0x4f, 0xa6, 0x69, 0x3e, 0xdd, 0x7b, 0x7e,
+	0x36, 0x34, 0xd0, 0xf9, 0xd0, 0x40, 0x7f, 0x86, 0x06, 0xfa, 0x32, 0x32, 0x32, 0xe7, 0x23, 0x23,
+	0xf3, 0x6b, 0x64, 0x64, 0xde, 0x58, 0xae, 0x17, 0xbd, 0x1b, 0xb4, 0x2d, 0x47, 0xf4, 0xa8, 0xfe,
+	0x16, 0x8c, 0xed, 0x76, 0xba, 0xac, 0x2d, 0xc1, 0xf9, 0x03, 0x78, 0x47, 0xc7, 0x01, 0x97, 0xed,
+	0x9c, 0xfa, 0xdc, 0xde, 0xfb, 0x1f, 0x00, 0x00, 0xff, 0xff, 0x2d, 0x67, 0xd6, 0x9f, 0x27, 0x06,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -377,6 +465,8 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type QueryClient interface {
+	// Params returns parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// Airdrops returns all airdrops.
 	Airdrops(ctx context.Context, in *QueryAirdropsRequest, opts ...grpc.CallOption) (*QueryAirdropsResponse, error)
 	// Airdrop returns the specific airdrop.
@@ -393,6 +483,15 @@ func NewQueryClient(cc grpc1.ClientConn) QueryClient {
 	return &queryClient{cc}
 }
 
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/squad.claim.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Airdrops(ctx context.Context, in *QueryAirdropsRequest, opts ...grpc.CallOption) (*QueryAirdropsResponse, error) {
 	out := new(QueryAirdropsResponse)
 	err := c.cc.Invoke(ctx, "/squad.claim.v1beta1.Query/Airdrops", in, out, opts...)
@@ -422,6 +521,8 @@ func (c *queryClient) ClaimRecord(ctx context.Context, in *QueryClaimRecordReque
 
 // QueryServer is the server API for Query service.
 type QueryServer interface {
+	// Params returns parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// Airdrops returns all airdrops.
 	Airdrops(context.Context, *QueryAirdropsRequest) (*QueryAirdropsResponse, error)
 	// Airdrop returns the specific airdrop.
@@ -434,6 +535,9 @@ type QueryServer interface {
 type UnimplementedQueryServer struct {
 }
 
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 func (*UnimplementedQueryServer) Airdrops(ctx context.Context, req *QueryAirdropsRequest) (*QueryAirdropsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Airdrops not implemented")
 }
@@ -448,6 +552,24 @@ func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squad.claim.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Airdrops_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryAirdropsRequest)
 	if err := dec(in); err != nil {
@@ -506,6 +628,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "squad.claim.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
 		{
 			MethodName: "Airdrops",
 			Handler:    _Query_Airdrops_Handler,
@@ -523,6 +649,62 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 	Metadata: "squad/claim/v1beta1/query.proto",
 }
 
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryAirdropsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -747,6 +929,26 @@ func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *QueryAirdropsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -835,6 +1037,139 @@ func sovQuery(x uint64) (n int) {
 func sozQuery(x uint64) (n int) {
 	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryAirdropsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
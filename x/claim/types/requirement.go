@@ -0,0 +1,113 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetRequirement returns the requirement of the airdrop for the condition.
+func (a Airdrop) GetRequirement(ct ConditionType) (req ConditionRequirement, found bool) {
+	for _, r := range a.Requirements {
+		if r.ConditionType == ct {
+			return r, true
+		}
+	}
+	return req, false
+}
+
+// ValidateRequirements validates the requirements against the airdrop conditions.
+// Each requirement must apply to one of the conditions and at most one
+// requirement can apply to a condition.
+func ValidateRequirements(conditions []ConditionType, requirements []ConditionRequirement) error {
+	conditionSet := map[ConditionType]struct{}{}
+	for _, c := range conditions {
+		conditionSet[c] = struct{}{}
+	}
+	seen := map[ConditionType]struct{}{}
+	for _, r := range requirements {
+		if _, ok := conditionSet[r.ConditionType]; !ok {
+			return fmt.Errorf("requirement for %s which is not a condition of the airdrop", r.ConditionType)
+		}
+		if _, ok := seen[r.ConditionType]; ok {
+			return fmt.Errorf("duplicate requirement for %s", r.ConditionType)
+		}
+		seen[r.ConditionType] = struct{}{}
+		if err := r.Validate(); err != nil {
+			return fmt.Errorf("invalid requirement for %s: %w", r.ConditionType, err)
+		}
+	}
+	return nil
+}
+
+// Validate validates the requirement.
+func (r ConditionRequirement) Validate() error {
+	switch r.Operator {
+	case CriteriaOperatorAnd, CriteriaOperatorOr:
+	default:
+		return fmt.Errorf("invalid criteria operator: %s", r.Operator)
+	}
+	if len(r.Criteria) == 0 {
+		return fmt.Errorf("criteria must not be empty")
+	}
+	for _, c := range r.Criteria {
+		if err := c.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetMinAmount returns the min amount of the criterion, which is zero if not specified.
+func (c Criterion) GetMinAmount() sdk.Int {
+	if c.MinAmount.IsNil() {
+		return sdk.ZeroInt()
+	}
+	return c.MinAmount
+}
+
+// Validate validates the criterion.
+// Parameters that don't apply to the criterion's condition type must be left empty.
+func (c Criterion) Validate() error {
+	if c.GetMinAmount().IsNegative() {
+		return fmt.Errorf("min amount must not be negative: %s", c.MinAmount)
+	}
+	if c.MinFarmingDuration < 0 {
+		return fmt.Errorf("min farming duration must not be negative: %s", c.MinFarmingDuration)
+	}
+
+	var usesPool, usesProposal, usesMinAmount, usesMinFarmingDuration bool
+	switch c.ConditionType {
+	case ConditionTypeDeposit:
+		usesPool, usesMinAmount = true, true
+	case ConditionTypeSwap:
+	case ConditionTypeLiquidStake:
+		usesMinAmount = true
+	case ConditionTypeVote:
+		usesProposal = true
+	case ConditionTypeFarming:
+		usesPool, usesMinAmount, usesMinFarmingDuration = true, true, true
+	default:
+		return fmt.Errorf("unknown condition type %s", c.ConditionType)
+	}
+
+	if usesPool && c.PoolId == 0 {
+		return fmt.Errorf("pool id must be specified for %s criterion", c.ConditionType)
+	}
+	if !usesPool && c.PoolId != 0 {
+		return fmt.Errorf("pool id must not be specified for %s criterion", c.ConditionType)
+	}
+	if usesProposal && c.ProposalId == 0 {
+		return fmt.Errorf("proposal id must be specified for %s criterion", c.ConditionType)
+	}
+	if !usesProposal && c.ProposalId != 0 {
+		return fmt.Errorf("proposal id must not be specified for %s criterion", c.ConditionType)
+	}
+	if !usesMinAmount && !c.GetMinAmount().IsZero() {
+		return fmt.Errorf("min amount must not be specified for %s criterion", c.ConditionType)
+	}
+	if !usesMinFarmingDuration && c.MinFarmingDuration != 0 {
+		return fmt.Errorf("min farming duration must not be specified for %s criterion", c.ConditionType)
+	}
+	return nil
+}
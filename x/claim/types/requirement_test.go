@@ -0,0 +1,219 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmosquad-labs/squad/v3/x/claim/types"
+)
+
+func TestCriterion_Validate(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		criterion   types.Criterion
+		expectedErr string
+	}{
+		{
+			"deposit",
+			types.Criterion{ConditionType: types.ConditionTypeDeposit, PoolId: 1, MinAmount: sdk.NewInt(1000)},
+			"",
+		},
+		{
+			"swap",
+			types.Criterion{ConditionType: types.ConditionTypeSwap, MinAmount: sdk.ZeroInt()},
+			"",
+		},
+		{
+			"liquid stake",
+			types.Criterion{ConditionType: types.ConditionTypeLiquidStake, MinAmount: sdk.NewInt(1000)},
+			"",
+		},
+		{
+			"vote",
+			types.Criterion{ConditionType: types.ConditionTypeVote, ProposalId: 1, MinAmount: sdk.ZeroInt()},
+			"",
+		},
+		{
+			"farming",
+			types.Criterion{
+				ConditionType: types.ConditionTypeFarming, PoolId: 1,
+				MinAmount: sdk.NewInt(1000), MinFarmingDuration: 7 * 24 * time.Hour,
+			},
+			"",
+		},
+		{
+			"unknown condition type",
+			types.Criterion{ConditionType: types.ConditionTypeUnspecified, MinAmount: sdk.ZeroInt()},
+			"unknown condition type CONDITION_TYPE_UNSPECIFIED",
+		},
+		{
+			"unspecified min amount",
+			types.Criterion{ConditionType: types.ConditionTypeSwap},
+			"",
+		},
+		{
+			"negative min amount",
+			types.Criterion{ConditionType: types.ConditionTypeLiquidStake, MinAmount: sdk.NewInt(-1)},
+			"min amount must not be negative: -1",
+		},
+		{
+			"negative min farming duration",
+			types.Criterion{
+				ConditionType: types.ConditionTypeFarming, PoolId: 1,
+				MinAmount: sdk.ZeroInt(), MinFarmingDuration: -time.Hour,
+			},
+			"min farming duration must not be negative: -1h0m0s",
+		},
+		{
+			"deposit without pool id",
+			types.Criterion{ConditionType: types.ConditionTypeDeposit, MinAmount: sdk.NewInt(1000)},
+			"pool id must be specified for CONDITION_TYPE_DEPOSIT criterion",
+		},
+		{
+			"vote without proposal id",
+			types.Criterion{ConditionType: types.ConditionTypeVote, MinAmount: sdk.ZeroInt()},
+			"proposal id must be specified for CONDITION_TYPE_VOTE criterion",
+		},
+		{
+			"liquid stake with pool id",
+			types.Criterion{ConditionType: types.ConditionTypeLiquidStake, PoolId: 1, MinAmount: sdk.ZeroInt()},
+			"pool id must not be specified for CONDITION_TYPE_LIQUIDSTAKE criterion",
+		},
+		{
+			"deposit with proposal id",
+			types.Criterion{ConditionType: types.ConditionTypeDeposit, PoolId: 1, ProposalId: 1, MinAmount: sdk.ZeroInt()},
+			"proposal id must not be specified for CONDITION_TYPE_DEPOSIT criterion",
+		},
+		{
+			"vote with min amount",
+			types.Criterion{ConditionType: types.ConditionTypeVote, ProposalId: 1, MinAmount: sdk.NewInt(1)},
+			"min amount must not be specified for CONDITION_TYPE_VOTE criterion",
+		},
+		{
+			"deposit with min farming duration",
+			types.Criterion{
+				ConditionType: types.ConditionTypeDeposit, PoolId: 1,
+				MinAmount: sdk.ZeroInt(), MinFarmingDuration: time.Hour,
+			},
+			"min farming duration must not be specified for CONDITION_TYPE_DEPOSIT criterion",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.criterion.Validate()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestValidateRequirements(t *testing.T) {
+	conditions := []types.ConditionType{types.ConditionTypeDeposit, types.ConditionTypeVote}
+	voteCriterion := types.Criterion{ConditionType: types.ConditionTypeVote, ProposalId: 1, MinAmount: sdk.ZeroInt()}
+
+	for _, tc := range []struct {
+		name         string
+		requirements []types.ConditionRequirement
+		expectedErr  string
+	}{
+		{
+			"no requirements",
+			nil,
+			"",
+		},
+		{
+			"happy case",
+			[]types.ConditionRequirement{
+				{
+					ConditionType: types.ConditionTypeDeposit,
+					Operator:      types.CriteriaOperatorOr,
+					Criteria: []types.Criterion{
+						{ConditionType: types.ConditionTypeDeposit, PoolId: 1, MinAmount: sdk.NewInt(1000)},
+						{ConditionType: types.ConditionTypeFarming, PoolId: 1, MinAmount: sdk.NewInt(1000)},
+					},
+				},
+				{
+					ConditionType: types.ConditionTypeVote,
+					Operator:      types.CriteriaOperatorAnd,
+					Criteria:      []types.Criterion{voteCriterion},
+				},
+			},
+			"",
+		},
+		{
+			"requirement for a condition not in the airdrop",
+			[]types.ConditionRequirement{
+				{
+					ConditionType: types.ConditionTypeSwap,
+					Operator:      types.CriteriaOperatorAnd,
+					Criteria:      []types.Criterion{voteCriterion},
+				},
+			},
+			"requirement for CONDITION_TYPE_SWAP which is not a condition of the airdrop",
+		},
+		{
+			"duplicate requirements",
+			[]types.ConditionRequirement{
+				{
+					ConditionType: types.ConditionTypeVote,
+					Operator:      types.CriteriaOperatorAnd,
+					Criteria:      []types.Criterion{voteCriterion},
+				},
+				{
+					ConditionType: types.ConditionTypeVote,
+					Operator:      types.CriteriaOperatorOr,
+					Criteria:      []types.Criterion{voteCriterion},
+				},
+			},
+			"duplicate requirement for CONDITION_TYPE_VOTE",
+		},
+		{
+			"unspecified operator",
+			[]types.ConditionRequirement{
+				{
+					ConditionType: types.ConditionTypeVote,
+					Criteria:      []types.Criterion{voteCriterion},
+				},
+			},
+			"invalid requirement for CONDITION_TYPE_VOTE: invalid criteria operator: CRITERIA_OPERATOR_UNSPECIFIED",
+		},
+		{
+			"empty criteria",
+			[]types.ConditionRequirement{
+				{
+					ConditionType: types.ConditionTypeVote,
+					Operator:      types.CriteriaOperatorAnd,
+				},
+			},
+			"invalid requirement for CONDITION_TYPE_VOTE: criteria must not be empty",
+		},
+		{
+			"invalid criterion",
+			[]types.ConditionRequirement{
+				{
+					ConditionType: types.ConditionTypeVote,
+					Operator:      types.CriteriaOperatorAnd,
+					Criteria: []types.Criterion{
+						{ConditionType: types.ConditionTypeVote, MinAmount: sdk.ZeroInt()},
+					},
+				},
+			},
+			"invalid requirement for CONDITION_TYPE_VOTE: proposal id must be specified for CONDITION_TYPE_VOTE criterion",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.ValidateRequirements(conditions, tc.requirements)
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
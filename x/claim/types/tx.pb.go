@@ -6,21 +6,27 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -36,6 +42,12 @@ type MsgClaim struct {
 	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
 	// condition_type specifies the condition type
 	ConditionType ConditionType `protobuf:"varint,3,opt,name=condition_type,json=conditionType,proto3,enum=squad.claim.v1beta1.ConditionType" json:"condition_type,omitempty"`
+	// initial_claimable_coins specifies the coins of the recipient's merkle leaf
+	// only required for the first claim of an airdrop with a merkle root
+	InitialClaimableCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=initial_claimable_coins,json=initialClaimableCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"initial_claimable_coins"`
+	// proof specifies the merkle proof of the recipient's leaf
+	// only required for the first claim of an airdrop with a merkle root
+	Proof [][]byte `protobuf:"bytes,5,rep,name=proof,proto3" json:"proof,omitempty"`
 }
 
 func (m *MsgClaim) Reset()         { *m = MsgClaim{} }
@@ -107,34 +119,143 @@ func (m *MsgClaimResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgClaimResponse proto.InternalMessageInfo
 
+// MsgCreateAirdrop defines a SDK message for creating a merkle airdrop.
+type MsgCreateAirdrop struct {
+	// creator specifies the bech32-encoded address that funds the airdrop
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// amount specifies the coins to fund the airdrop source address with
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// conditions specifies a list of conditions
+	Conditions []ConditionType `protobuf:"varint,3,rep,packed,name=conditions,proto3,enum=squad.claim.v1beta1.ConditionType" json:"conditions,omitempty"`
+	// merkle_root specifies the root of the merkle tree over (recipient, coins) leaves
+	MerkleRoot []byte `protobuf:"bytes,4,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	// start_time specifies the start time of the airdrop
+	StartTime time.Time `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// end_time specifies the end time of the airdrop
+	EndTime time.Time `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	// requirements specifies a list of requirements that replace the default checks of the conditions
+	Requirements []ConditionRequirement `protobuf:"bytes,7,rep,name=requirements,proto3" json:"requirements"`
+}
+
+func (m *MsgCreateAirdrop) Reset()         { *m = MsgCreateAirdrop{} }
+func (m *MsgCreateAirdrop) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateAirdrop) ProtoMessage()    {}
+func (*MsgCreateAirdrop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12e19c33cffd5712, []int{2}
+}
+func (m *MsgCreateAirdrop) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateAirdrop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateAirdrop.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateAirdrop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateAirdrop.Merge(m, src)
+}
+func (m *MsgCreateAirdrop) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateAirdrop) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateAirdrop.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateAirdrop proto.InternalMessageInfo
+
+type MsgCreateAirdropResponse struct {
+	AirdropId uint64 `protobuf:"varint,1,opt,name=airdrop_id,json=airdropId,proto3" json:"airdrop_id,omitempty"`
+}
+
+func (m *MsgCreateAirdropResponse) Reset()         { *m = MsgCreateAirdropResponse{} }
+func (m *MsgCreateAirdropResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateAirdropResponse) ProtoMessage()    {}
+func (*MsgCreateAirdropResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12e19c33cffd5712, []int{3}
+}
+func (m *MsgCreateAirdropResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateAirdropResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateAirdropResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateAirdropResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateAirdropResponse.Merge(m, src)
+}
+func (m *MsgCreateAirdropResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateAirdropResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateAirdropResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateAirdropResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgClaim)(nil), "squad.claim.v1beta1.MsgClaim")
 	proto.RegisterType((*MsgClaimResponse)(nil), "squad.claim.v1beta1.MsgClaimResponse")
+	proto.RegisterType((*MsgCreateAirdrop)(nil), "squad.claim.v1beta1.MsgCreateAirdrop")
+	proto.RegisterType((*MsgCreateAirdropResponse)(nil), "squad.claim.v1beta1.MsgCreateAirdropResponse")
 }
 
 func init() { proto.RegisterFile("squad/claim/v1beta1/tx.proto", fileDescriptor_12e19c33cffd5712) }
 
 var fileDescriptor_12e19c33cffd5712 = []byte{
-	// 295 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0x41, 0x4b, 0xf3, 0x30,
-	0x1c, 0xc6, 0x9b, 0x77, 0xaf, 0xe2, 0x02, 0x0e, 0x89, 0x1e, 0xc6, 0x98, 0xb1, 0x14, 0x84, 0x5e,
-	0x4c, 0xd8, 0xfc, 0x06, 0xee, 0x34, 0xb0, 0x97, 0xb2, 0x93, 0x97, 0xd1, 0xa6, 0xa1, 0x06, 0xd6,
-	0x26, 0x36, 0x99, 0x6c, 0xdf, 0x42, 0xfc, 0x54, 0x3b, 0xee, 0xe8, 0x51, 0xdb, 0x2f, 0x22, 0x4d,
-	0x5b, 0xf4, 0x50, 0xbc, 0xb5, 0xcf, 0xef, 0xf9, 0x3f, 0x3c, 0x79, 0xe0, 0x54, 0xbf, 0x6c, 0xa3,
-	0x84, 0xb2, 0x4d, 0x24, 0x32, 0xfa, 0x3a, 0x8b, 0xb9, 0x89, 0x66, 0xd4, 0xec, 0x88, 0x2a, 0xa4,
-	0x91, 0xe8, 0xd2, 0x52, 0x62, 0x29, 0x69, 0xe9, 0xe4, 0x2a, 0x95, 0xa9, 0xb4, 0x9c, 0xd6, 0x5f,
-	0x8d, 0x75, 0x72, 0xd3, 0x17, 0xd4, 0x1c, 0x5a, 0x83, 0xf7, 0x0e, 0xe0, 0x59, 0xa0, 0xd3, 0x45,
-	0x2d, 0xa1, 0x6b, 0x08, 0x23, 0x51, 0x24, 0x85, 0x54, 0x6b, 0x91, 0x8c, 0x81, 0x0b, 0xfc, 0xff,
-	0xe1, 0xb0, 0x55, 0x96, 0x09, 0x9a, 0xc2, 0x61, 0xc1, 0x99, 0x50, 0x82, 0xe7, 0x66, 0xfc, 0xcf,
-	0x05, 0xfe, 0x30, 0xfc, 0x11, 0xd0, 0x12, 0x8e, 0x98, 0xcc, 0x13, 0x61, 0x84, 0xcc, 0xd7, 0x66,
-	0xaf, 0xf8, 0x78, 0xe0, 0x02, 0x7f, 0x34, 0xf7, 0x48, 0x4f, 0x5d, 0xb2, 0xe8, 0xac, 0xab, 0xbd,
-	0xe2, 0xe1, 0x39, 0xfb, 0xfd, 0xeb, 0x21, 0x78, 0xd1, 0x75, 0x0a, 0xb9, 0x56, 0x32, 0xd7, 0x7c,
-	0xbe, 0x82, 0x83, 0x40, 0xa7, 0x28, 0x80, 0x27, 0x6d, 0xd7, 0xde, 0xd8, 0xee, 0x6c, 0x72, 0xfb,
-	0x27, 0xee, 0x52, 0x1f, 0x1e, 0x0f, 0x5f, 0xd8, 0x39, 0x94, 0x18, 0x1c, 0x4b, 0x0c, 0x3e, 0x4b,
-	0x0c, 0xde, 0x2a, 0xec, 0x1c, 0x2b, 0xec, 0x7c, 0x54, 0xd8, 0x79, 0x22, 0xa9, 0x30, 0xcf, 0xdb,
-	0x98, 0x30, 0x99, 0x51, 0x26, 0x75, 0x26, 0x6d, 0xe6, 0xdd, 0x26, 0x8a, 0x35, 0x6d, 0x86, 0xdd,
-	0xb5, 0xd3, 0xd6, 0xef, 0xd5, 0xf1, 0xa9, 0xdd, 0xf4, 0xfe, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x72,
-	0xf5, 0x9f, 0x15, 0xbf, 0x01, 0x00, 0x00,
+	// 604 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcf, 0x6e, 0xd3, 0x30,
+	0x18, 0xaf, 0x97, 0x76, 0x5b, 0xbd, 0x3f, 0x42, 0x66, 0x88, 0x50, 0x8d, 0x34, 0xaa, 0x84, 0x14,
+	0x0e, 0x73, 0x58, 0x39, 0x71, 0x42, 0xb4, 0xa7, 0x49, 0xec, 0x62, 0x76, 0xe2, 0x12, 0x39, 0x89,
+	0x17, 0xac, 0x25, 0x71, 0x66, 0xbb, 0x68, 0x3b, 0xf3, 0x02, 0x7b, 0x0e, 0x0e, 0xbc, 0x00, 0x2f,
+	0xb0, 0xe3, 0xb8, 0x71, 0x62, 0xb0, 0xbe, 0x08, 0x8a, 0x93, 0x74, 0x1d, 0x94, 0x31, 0x24, 0x4e,
+	0xc9, 0xf7, 0xef, 0xf7, 0xf9, 0xfb, 0x7d, 0x3f, 0x1b, 0x6e, 0xab, 0xe3, 0x09, 0x8d, 0xfd, 0x28,
+	0xa5, 0x3c, 0xf3, 0xdf, 0xef, 0x86, 0x4c, 0xd3, 0x5d, 0x5f, 0x9f, 0xe0, 0x42, 0x0a, 0x2d, 0xd0,
+	0x7d, 0x13, 0xc5, 0x26, 0x8a, 0xeb, 0x68, 0x6f, 0x2b, 0x11, 0x89, 0x30, 0x71, 0xbf, 0xfc, 0xab,
+	0x52, 0x7b, 0xfd, 0x44, 0x88, 0x24, 0x65, 0xbe, 0xb1, 0xc2, 0xc9, 0xa1, 0xaf, 0x79, 0xc6, 0x94,
+	0xa6, 0x59, 0x51, 0x27, 0x38, 0x91, 0x50, 0x99, 0x50, 0x7e, 0x48, 0x15, 0x9b, 0x75, 0x8a, 0x04,
+	0xcf, 0x1b, 0x80, 0x45, 0x27, 0xa9, 0x3a, 0x9b, 0x84, 0xc1, 0xa7, 0x25, 0xb8, 0xba, 0xaf, 0x92,
+	0x71, 0xe9, 0x42, 0x8f, 0x21, 0xa4, 0x5c, 0xc6, 0x52, 0x14, 0x01, 0x8f, 0x6d, 0xe0, 0x02, 0xaf,
+	0x4d, 0xba, 0xb5, 0x67, 0x2f, 0x46, 0xdb, 0xb0, 0x2b, 0x59, 0xc4, 0x0b, 0xce, 0x72, 0x6d, 0x2f,
+	0xb9, 0xc0, 0xeb, 0x92, 0x6b, 0x07, 0xda, 0x83, 0x9b, 0x91, 0xc8, 0x63, 0xae, 0xb9, 0xc8, 0x03,
+	0x7d, 0x5a, 0x30, 0xdb, 0x72, 0x81, 0xb7, 0x39, 0x1c, 0xe0, 0x05, 0xf3, 0xe2, 0x71, 0x93, 0x7a,
+	0x70, 0x5a, 0x30, 0xb2, 0x11, 0xcd, 0x9b, 0xe8, 0x03, 0x80, 0x0f, 0x79, 0xce, 0x35, 0xa7, 0x69,
+	0x60, 0xca, 0x68, 0x98, 0xb2, 0xa0, 0x1c, 0x4b, 0xd9, 0x6d, 0xd7, 0xf2, 0xd6, 0x86, 0x8f, 0x70,
+	0x35, 0x38, 0x2e, 0x07, 0x9f, 0x03, 0xe5, 0xf9, 0xe8, 0xd9, 0xf9, 0xb7, 0x7e, 0xeb, 0xe3, 0x65,
+	0xdf, 0x4b, 0xb8, 0x7e, 0x37, 0x09, 0x71, 0x24, 0x32, 0xbf, 0x66, 0xa9, 0xfa, 0xec, 0xa8, 0xf8,
+	0xc8, 0x2f, 0x0f, 0xa8, 0x4c, 0x81, 0x22, 0x0f, 0xea, 0x5e, 0xe3, 0xa6, 0x95, 0x71, 0xa3, 0x2d,
+	0xd8, 0x29, 0xa4, 0x10, 0x87, 0x76, 0xc7, 0xb5, 0xbc, 0x75, 0x52, 0x19, 0x03, 0x04, 0xef, 0x35,
+	0x7c, 0x11, 0xa6, 0x0a, 0x91, 0x2b, 0x36, 0xf8, 0x62, 0x55, 0x4e, 0xc9, 0xa8, 0x66, 0xaf, 0x2a,
+	0xbe, 0x90, 0x0d, 0x57, 0xa2, 0xd2, 0x21, 0xa4, 0x61, 0xb2, 0x4b, 0x1a, 0x13, 0x45, 0x70, 0x99,
+	0x66, 0x62, 0x62, 0x48, 0xfc, 0xef, 0xc3, 0xd4, 0xd0, 0x68, 0x04, 0xe1, 0x8c, 0x54, 0x65, 0x5b,
+	0xae, 0x75, 0xc7, 0x55, 0xcc, 0x55, 0xa1, 0x3e, 0x5c, 0xcb, 0x98, 0x3c, 0x4a, 0x59, 0x20, 0x85,
+	0xd0, 0x76, 0xdb, 0x05, 0xde, 0x3a, 0x81, 0x95, 0x8b, 0x08, 0xa1, 0xd1, 0x18, 0x42, 0xa5, 0xa9,
+	0xd4, 0x41, 0xa9, 0x4b, 0xbb, 0xe3, 0x02, 0x6f, 0x6d, 0xd8, 0xc3, 0x95, 0x68, 0x71, 0x23, 0x5a,
+	0x7c, 0xd0, 0x88, 0x76, 0xb4, 0x5a, 0x8e, 0x73, 0x76, 0xd9, 0x07, 0xa4, 0x6b, 0xea, 0xca, 0x08,
+	0x7a, 0x09, 0x57, 0x59, 0x1e, 0x57, 0x10, 0xcb, 0xff, 0x00, 0xb1, 0xc2, 0xf2, 0xd8, 0x00, 0xbc,
+	0x81, 0xeb, 0x92, 0x1d, 0x4f, 0xb8, 0x64, 0x19, 0xcb, 0xb5, 0xb2, 0x57, 0x0c, 0xab, 0x4f, 0x6f,
+	0x1f, 0x96, 0x5c, 0x57, 0x8c, 0xda, 0x25, 0x26, 0xb9, 0x01, 0x32, 0x78, 0x01, 0xed, 0x5f, 0x57,
+	0xda, 0xec, 0xfb, 0x2f, 0xf7, 0x64, 0xf8, 0x19, 0x40, 0x6b, 0x5f, 0x25, 0x68, 0x1f, 0x76, 0xea,
+	0x7b, 0xb5, 0xf0, 0x28, 0x8d, 0x8c, 0x7a, 0x4f, 0x6e, 0x0d, 0xcf, 0xba, 0x32, 0xb8, 0x71, 0x53,
+	0x61, 0x7f, 0xae, 0x9b, 0x4f, 0xeb, 0xed, 0xdc, 0x29, 0xad, 0x69, 0x33, 0x7a, 0x7d, 0xfe, 0xc3,
+	0x69, 0x9d, 0x5f, 0x39, 0xe0, 0xe2, 0xca, 0x01, 0xdf, 0xaf, 0x1c, 0x70, 0x36, 0x75, 0x5a, 0x17,
+	0x53, 0xa7, 0xf5, 0x75, 0xea, 0xb4, 0xde, 0xe2, 0xdf, 0x84, 0x58, 0x62, 0xef, 0xa4, 0x34, 0x54,
+	0x7e, 0xf5, 0xd6, 0x9c, 0xd4, 0xaf, 0x8d, 0x11, 0x65, 0xb8, 0x6c, 0x56, 0xf8, 0xfc, 0x67, 0x00,
+	0x00, 0x00, 0xff, 0xff, 0xc3, 0x74, 0x9f, 0x4d, 0x13, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -150,6 +271,7 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type MsgClient interface {
 	Claim(ctx context.Context, in *MsgClaim, opts ...grpc.CallOption) (*MsgClaimResponse, error)
+	CreateAirdrop(ctx context.Context, in *MsgCreateAirdrop, opts ...grpc.CallOption) (*MsgCreateAirdropResponse, error)
 }
 
 type msgClient struct {
@@ -169,9 +291,19 @@ func (c *msgClient) Claim(ctx context.Context, in *MsgClaim, opts ...grpc.CallOp
 	return out, nil
 }
 
+func (c *msgClient) CreateAirdrop(ctx context.Context, in *MsgCreateAirdrop, opts ...grpc.CallOption) (*MsgCreateAirdropResponse, error) {
+	out := new(MsgCreateAirdropResponse)
+	err := c.cc.Invoke(ctx, "/squad.claim.v1beta1.Msg/CreateAirdrop", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	Claim(context.Context, *MsgClaim) (*MsgClaimResponse, error)
+	CreateAirdrop(context.Context, *MsgCreateAirdrop) (*MsgCreateAirdropResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -181,6 +313,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) Claim(ctx context.Context, req *MsgClaim) (*MsgClaimResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Claim not implemented")
 }
+func (*UnimplementedMsgServer) CreateAirdrop(ctx context.Context, req *MsgCreateAirdrop) (*MsgCreateAirdropResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAirdrop not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -204,6 +339,24 @@ func _Msg_Claim_Handler(srv interface{}, ctx context.Context, dec func(interface
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateAirdrop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateAirdrop)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateAirdrop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squad.claim.v1beta1.Msg/CreateAirdrop",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateAirdrop(ctx, req.(*MsgCreateAirdrop))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "squad.claim.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -212,6 +365,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Claim",
 			Handler:    _Msg_Claim_Handler,
 		},
+		{
+			MethodName: "CreateAirdrop",
+			Handler:    _Msg_CreateAirdrop_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "squad/claim/v1beta1/tx.proto",
@@ -237,6 +394,29 @@ func (m *MsgClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proof[iNdEx])
+			copy(dAtA[i:], m.Proof[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Proof[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.InitialClaimableCoins) > 0 {
+		for iNdEx := len(m.InitialClaimableCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InitialClaimableCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.ConditionType != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.ConditionType))
 		i--
@@ -280,6 +460,133 @@ func (m *MsgClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgCreateAirdrop) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateAirdrop) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateAirdrop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Requirements) > 0 {
+		for iNdEx := len(m.Requirements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requirements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTx(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTx(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Conditions) > 0 {
+		dAtA4 := make([]byte, len(m.Conditions)*10)
+		var j3 int
+		for _, num := range m.Conditions {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintTx(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateAirdropResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateAirdropResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateAirdropResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AirdropId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AirdropId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -307,6 +614,18 @@ func (m *MsgClaim) Size() (n int) {
 	if m.ConditionType != 0 {
 		n += 1 + sovTx(uint64(m.ConditionType))
 	}
+	if len(m.InitialClaimableCoins) > 0 {
+		for _, e := range m.InitialClaimableCoins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Proof) > 0 {
+		for _, b := range m.Proof {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -319,6 +638,58 @@ func (m *MsgClaimResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCreateAirdrop) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Conditions) > 0 {
+		l = 0
+		for _, e := range m.Conditions {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Requirements) > 0 {
+		for _, e := range m.Requirements {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreateAirdropResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AirdropId != 0 {
+		n += 1 + sovTx(uint64(m.AirdropId))
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -424,6 +795,72 @@ func (m *MsgClaim) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialClaimableCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitialClaimableCoins = append(m.InitialClaimableCoins, types.Coin{})
+			if err := m.InitialClaimableCoins[len(m.InitialClaimableCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof, make([]byte, postIndex-iNdEx))
+			copy(m.Proof[len(m.Proof)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -495,6 +932,394 @@ func (m *MsgClaimResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgCreateAirdrop) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateAirdrop: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateAirdrop: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v ConditionType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= ConditionType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Conditions = append(m.Conditions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Conditions) == 0 {
+					m.Conditions = make([]ConditionType, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v ConditionType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= ConditionType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Conditions = append(m.Conditions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleRoot = append(m.MerkleRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.MerkleRoot == nil {
+				m.MerkleRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requirements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requirements = append(m.Requirements, ConditionRequirement{})
+			if err := m.Requirements[len(m.Requirements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateAirdropResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateAirdropResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateAirdropResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AirdropId", wireType)
+			}
+			m.AirdropId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AirdropId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -29,9 +29,15 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	// Store new one or delete the existing one from liquidFarmByPoolId
 	// when it is added or removed in params by governance proposal.
 	for _, liquidFarm := range liquidFarmsInParams {
-		if _, found := liquidFarmByPoolId[liquidFarm.PoolId]; !found {
+		if liquidFarmInStore, found := liquidFarmByPoolId[liquidFarm.PoolId]; !found {
 			k.SetLiquidFarm(ctx, liquidFarm)
 		} else {
+			// Apply the auction mode changed by governance proposal to the upcoming auctions
+			if liquidFarmInStore.AuctionMode != liquidFarm.AuctionMode || liquidFarmInStore.CandleWindow != liquidFarm.CandleWindow {
+				liquidFarmInStore.AuctionMode = liquidFarm.AuctionMode
+				liquidFarmInStore.CandleWindow = liquidFarm.CandleWindow
+				k.SetLiquidFarm(ctx, liquidFarmInStore)
+			}
 			delete(liquidFarmByPoolId, liquidFarm.PoolId)
 		}
 	}
@@ -49,6 +55,48 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 		k.HandleRemovedLiquidFarm(ctx, liquidFarmByPoolId[poolId])
 	}
 
+	// Do the same for pair liquid farms. The parameters of the existing ones are updated as well.
+	pairLiquidFarmByPairId := map[uint64]types.PairLiquidFarm{} // PairId => PairLiquidFarm
+	for _, pairLiquidFarm := range k.GetPairLiquidFarmsInStore(ctx) {
+		pairLiquidFarmByPairId[pairLiquidFarm.PairId] = pairLiquidFarm
+	}
+	for _, pairLiquidFarm := range k.GetPairLiquidFarmsInParams(ctx) {
+		k.SetPairLiquidFarm(ctx, pairLiquidFarm)
+		delete(pairLiquidFarmByPairId, pairLiquidFarm.PairId)
+	}
+	var pairIds []uint64
+	for pairId := range pairLiquidFarmByPairId {
+		pairIds = append(pairIds, pairId)
+	}
+	sort.Slice(pairIds, func(i, j int) bool {
+		return pairIds[i] < pairIds[j]
+	})
+	for _, pairId := range pairIds {
+		k.HandleRemovedPairLiquidFarm(ctx, pairLiquidFarmByPairId[pairId])
+	}
+
+	// Do the same for vaults. The parameters of the existing ones are updated as well.
+	vaultByPoolId := map[uint64]types.Vault{} // PoolId => Vault
+	for _, vault := range k.GetVaultsInStore(ctx) {
+		vaultByPoolId[vault.PoolId] = vault
+	}
+	for _, vault := range k.GetVaultsInParams(ctx) {
+		k.SetVault(ctx, vault)
+		delete(vaultByPoolId, vault.PoolId)
+	}
+	var vaultPoolIds []uint64
+	for poolId := range vaultByPoolId {
+		vaultPoolIds = append(vaultPoolIds, poolId)
+	}
+	sort.Slice(vaultPoolIds, func(i, j int) bool {
+		return vaultPoolIds[i] < vaultPoolIds[j]
+	})
+	for _, poolId := range vaultPoolIds {
+		k.HandleRemovedVault(ctx, vaultByPoolId[poolId])
+	}
+
+	k.CompoundVaults(ctx)
+
 	y, m, d := ctx.BlockTime().Date()
 
 	endTime, found := k.GetLastRewardsAuctionEndTime(ctx)
@@ -75,6 +123,7 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 				}
 				k.CreateRewardsAuction(ctx, l.PoolId, nextEndTime)
 			}
+			k.AdvancePairRewardsAuctions(ctx, nextEndTime)
 			k.SetLastRewardsAuctionEndTime(ctx, nextEndTime)
 		}
 	}
@@ -33,6 +33,11 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		NewQueryBidsCmd(),
 		NewQueryRewardsCmd(),
 		NewQueryExchangeRateCmd(),
+		NewQueryPairLiquidFarmsCmd(),
+		NewQueryPairRewardsAuctionsCmd(),
+		NewQueryPairExchangeRateCmd(),
+		NewQueryVaultsCmd(),
+		NewQueryVaultCmd(),
 	)
 
 	return cmd
@@ -389,3 +394,209 @@ $ %s query %s exchange-rate 1
 
 	return cmd
 }
+
+func NewQueryPairLiquidFarmsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pair-liquidfarms",
+		Args:  cobra.NoArgs,
+		Short: "Query for all pair liquidfarms",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for all pair liquidfarms on a network.
+
+Example:
+$ %s query %s pair-liquidfarms
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PairLiquidFarms(cmd.Context(), &types.QueryPairLiquidFarmsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewQueryPairRewardsAuctionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pair-rewards-auctions [pair-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query all rewards auctions for the pair liquidfarm",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all rewards auctions for the pair liquidfarm on a network.
+
+Example:
+$ %s query %s pair-rewards-auctions 1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pairId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("failed to parse pair id: %w", err)
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PairRewardsAuctions(cmd.Context(), &types.QueryPairRewardsAuctionsRequest{
+				PairId:     pairId,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewQueryPairExchangeRateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pair-exchange-rate [pair-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the combined exchange rate for pair liquid farm",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the combined exchange rate, such as mint rate and burn rate for pair liquid farm.
+The rates are against the value of the basket of the pool coins measured in the quote coin of the pair.
+
+Example:
+$ %s query %s pair-exchange-rate 1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pairId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("failed to parse pair id: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ExchangeRate(cmd.Context(), &types.QueryExchangeRateRequest{
+				PairId: pairId,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewQueryVaultsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vaults",
+		Args:  cobra.NoArgs,
+		Short: "Query for all vaults",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for all auto-compounding vaults on a network.
+
+Example:
+$ %s query %s vaults
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Vaults(cmd.Context(), &types.QueryVaultsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewQueryVaultCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vault [pool-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the specific vault",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the specific auto-compounding vault on a network.
+
+Example:
+$ %s query %s vault 1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("failed to parse pool id: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Vault(cmd.Context(), &types.QueryVaultRequest{
+				PoolId: poolId,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
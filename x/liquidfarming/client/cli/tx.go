@@ -33,6 +33,12 @@ func GetTxCmd() *cobra.Command {
 		NewLiquidUnfarmAndWithdrawCmd(),
 		NewPlaceBidCmd(),
 		NewRefundBidCmd(),
+		NewPairLiquidFarmCmd(),
+		NewPairLiquidUnfarmCmd(),
+		NewPlacePairBidCmd(),
+		NewRefundPairBidCmd(),
+		NewVaultFarmCmd(),
+		NewVaultUnfarmCmd(),
 	)
 
 	if keeper.EnableAdvanceAuction {
@@ -281,6 +287,293 @@ $ %s tx %s refund-bid 1 1 --from mykey
 	return cmd
 }
 
+// NewPairLiquidFarmCmd implements the pair liquid farm command handler.
+func NewPairLiquidFarmCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pair-liquid-farm [pair-id] [amount]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Liquid farm pool coin of any pool in the pair and receive pair LFCoin by mint rate",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Liquid farm pool coin of any pool in the pair and receive pair LFCoin by mint rate.
+The pair LFCoin represents the share of the basket of the pool coins of all pools in the pair.
+The module auto compounds rewards into the most underweight pool of the basket for every auction period.
+
+Example:
+$ %s tx %s pair-liquid-farm 1 100000000pool2 --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pairId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("failed to parse pair id: %w", err)
+			}
+
+			farmingCoin, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid coin: %w", err)
+			}
+
+			msg := types.NewMsgPairLiquidFarm(
+				pairId,
+				clientCtx.GetFromAddress().String(),
+				farmingCoin,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewPairLiquidUnfarmCmd implements the pair liquid unfarm command handler.
+func NewPairLiquidUnfarmCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pair-liquid-unfarm [pair-id] [amount]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Liquid unfarm pair liquid farming coin (pair LFCoin)",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Liquid unfarm pair liquid farming coin (pair LFCoin) to receive the corresponding share of every pool coin in the basket.
+
+Example:
+$ %s tx %s pair-liquid-unfarm 1 100000lfpair1 --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pairId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("failed to parse pair id: %w", err)
+			}
+
+			unfarmingCoin, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid coin: %w", err)
+			}
+
+			msg := types.NewMsgPairLiquidUnfarm(
+				pairId,
+				clientCtx.GetFromAddress().String(),
+				unfarmingCoin,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewPlacePairBidCmd implements the place pair bid command handler.
+func NewPlacePairBidCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "place-pair-bid [auction-id] [pair-id] [amount]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Place a bid for a rewards auction of the pair liquid farm",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Place a bid for a rewards auction of the pair liquid farm.
+The bidding coin must be the pool coin of the pool selected when the auction is created.
+
+Example:
+$ %s tx %s place-pair-bid 1 1 10000000pool2 --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			auctionId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("failed to parse auction id: %w", err)
+			}
+
+			pairId, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("failed to parse pair id: %w", err)
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid bidding amount: %w", err)
+			}
+
+			msg := types.NewMsgPlacePairBid(
+				auctionId,
+				pairId,
+				clientCtx.GetFromAddress().String(),
+				amount,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRefundPairBidCmd implements the refund pair bid command handler.
+func NewRefundPairBidCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "refund-pair-bid [auction-id] [pair-id]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Refund a bid for a rewards auction of the pair liquid farm",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Refund a bid for a rewards auction of the pair liquid farm.
+
+Example:
+$ %s tx %s refund-pair-bid 1 1 --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			auctionId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("failed to parse auction id: %w", err)
+			}
+
+			pairId, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("failed to parse pair id: %w", err)
+			}
+
+			msg := types.NewMsgRefundPairBid(
+				auctionId,
+				pairId,
+				clientCtx.GetFromAddress().String(),
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewVaultFarmCmd implements the vault farm command handler.
+func NewVaultFarmCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vault-farm [pool-id] [amount]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Farm pool coin in the auto-compounding vault and receive vault coin",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Farm pool coin in the auto-compounding vault and receive vault coin.
+The vault farms your pool coin to the farm module for you and compounds the farming rewards
+into the pool by swapping them into the pair coins on a schedule, without an auction.
+
+Example:
+$ %s tx %s vault-farm 1 100000000pool1 --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("failed to parse pool id: %w", err)
+			}
+
+			farmingCoin, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid coin: %w", err)
+			}
+
+			msg := types.NewMsgVaultFarm(
+				poolId,
+				clientCtx.GetFromAddress().String(),
+				farmingCoin,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewVaultUnfarmCmd implements the vault unfarm command handler.
+func NewVaultUnfarmCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vault-unfarm [pool-id] [amount]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Unfarm vault coin to receive the corresponding pool coin",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Unfarm vault coin to receive the corresponding amount of pool coin.
+
+Example:
+$ %s tx %s vault-unfarm 1 100000lfvault1 --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("failed to parse pool id: %w", err)
+			}
+
+			unfarmingCoin, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid coin: %w", err)
+			}
+
+			msg := types.NewMsgVaultUnfarm(
+				poolId,
+				clientCtx.GetFromAddress().String(),
+				unfarmingCoin,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewAdvanceAuctionCmd implements the advance auction by 1 command handler.
 func NewAdvanceAuctionCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -60,6 +60,15 @@ func (k Keeper) PlaceBid(ctx sdk.Context, auctionId uint64, poolId uint64, bidde
 	k.SetBid(ctx, bid)
 	k.SetWinningBid(ctx, auction.Id, bid)
 
+	// Record the bid taking the lead to look up the winning bid at the closing time of the candle auction
+	if auction.AuctionMode == types.AuctionModeCandle {
+		sequence := uint64(1)
+		if lastLeadingBid, found := k.GetLastLeadingBid(ctx, auction.Id, poolId); found {
+			sequence = lastLeadingBid.Sequence + 1
+		}
+		k.SetLeadingBid(ctx, types.NewLeadingBid(auction.Id, sequence, bid, ctx.BlockTime()))
+	}
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypePlaceBid,
@@ -86,6 +95,11 @@ func (k Keeper) RefundBid(ctx sdk.Context, auctionId uint64, poolId uint64, bidd
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "winning bid can't be refunded")
 	}
 
+	// Any bid that has taken the lead in the candle window may win the candle auction
+	if auction.AuctionMode == types.AuctionModeCandle && !ctx.BlockTime().Before(auction.CandleWindowStartTime()) {
+		return sdkerrors.Wrapf(types.ErrRefundInCandleWindow, "candle window started at %s", auction.CandleWindowStartTime())
+	}
+
 	bid, found := k.GetBid(ctx, poolId, bidder)
 	if !found {
 		return sdkerrors.Wrap(sdkerrors.ErrNotFound, "bid not found")
@@ -110,13 +124,19 @@ func (k Keeper) RefundBid(ctx sdk.Context, auctionId uint64, poolId uint64, bidd
 }
 
 // CreateRewardsAuction creates new rewards auction and store it.
+// The auction mode is taken from the liquid farm of the pool.
 func (k Keeper) CreateRewardsAuction(ctx sdk.Context, poolId uint64, endTime time.Time) {
-	k.SetRewardsAuction(ctx, types.NewRewardsAuction(
+	auction := types.NewRewardsAuction(
 		k.getNextAuctionIdWithUpdate(ctx, poolId),
 		poolId,
 		ctx.BlockTime(),
 		endTime,
-	))
+	)
+	if liquidFarm, found := k.GetLiquidFarm(ctx, poolId); found && liquidFarm.AuctionMode == types.AuctionModeCandle {
+		auction.AuctionMode = liquidFarm.AuctionMode
+		auction.CandleWindow = liquidFarm.CandleWindow
+	}
+	k.SetRewardsAuction(ctx, auction)
 }
 
 // FinishRewardsAuction finishes ongoing rewards auction by looking up the existence of winning bid.
@@ -129,6 +149,13 @@ func (k Keeper) FinishRewardsAuction(ctx sdk.Context, auction types.RewardsAucti
 	truncatedRewards, _ := farmingRewards.TruncateDecimal() // TODO: farm module may use sdk.DecCoins for sdk.Coins in the future
 
 	winningBid, found := k.GetWinningBid(ctx, auction.Id, auction.PoolId)
+	if auction.AuctionMode == types.AuctionModeCandle {
+		var err error
+		winningBid, found, err = k.closeCandleAuction(ctx, &auction)
+		if err != nil {
+			return err
+		}
+	}
 	if !found {
 		k.skipRewardsAuction(ctx, truncatedRewards, auction)
 	} else {
@@ -162,6 +189,59 @@ func (k Keeper) FinishRewardsAuction(ctx sdk.Context, auction types.RewardsAucti
 	return nil
 }
 
+// closeCandleAuction determines the closing time of the candle auction from the block data and
+// returns the bid that had the lead at the closing time as the winning bid.
+// The winner's bid amount exceeding the winning bid is refunded, and all bids are refunded if
+// no bid had the lead at the closing time.
+func (k Keeper) closeCandleAuction(ctx sdk.Context, auction *types.RewardsAuction) (winningBid types.Bid, found bool, err error) {
+	auction.SetClosingTime(auction.CandleClosingTime(k.candleSeed(ctx, *auction)))
+
+	for _, leadingBid := range k.GetLeadingBidsByAuction(ctx, auction.Id, auction.PoolId) {
+		if !leadingBid.Time.After(auction.ClosingTime) {
+			winningBid, found = leadingBid.Bid, true
+		}
+		k.DeleteLeadingBid(ctx, leadingBid)
+	}
+
+	var bid types.Bid
+	if found {
+		bid, found = k.GetBid(ctx, auction.PoolId, winningBid.GetBidder())
+	}
+	if !found {
+		return types.Bid{}, false, k.refundAllBids(ctx, *auction, true)
+	}
+
+	// The winner may have placed a bigger bid after the closing time
+	if excess := bid.Amount.Sub(winningBid.Amount); excess.IsPositive() {
+		if err := k.bankKeeper.SendCoins(ctx, auction.GetPayingReserveAddress(), winningBid.GetBidder(), sdk.NewCoins(excess)); err != nil {
+			return types.Bid{}, false, err
+		}
+	}
+	k.SetWinningBid(ctx, auction.Id, winningBid)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCloseCandleAuction,
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(auction.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyAuctionId, strconv.FormatUint(auction.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyClosingTime, auction.ClosingTime.Format(time.RFC3339Nano)),
+			sdk.NewAttribute(types.AttributeKeyWinner, winningBid.Bidder),
+			sdk.NewAttribute(types.AttributeKeyWinningAmount, winningBid.Amount.String()),
+		),
+	})
+
+	return winningBid, true, nil
+}
+
+// candleSeed returns the seed to determine the closing time of the candle auction.
+// It is derived from the data of the block finishing the auction, which is unknown while bidding.
+func (k Keeper) candleSeed(ctx sdk.Context, auction types.RewardsAuction) []byte {
+	seed := append([]byte{}, ctx.HeaderHash()...)
+	seed = append(seed, ctx.BlockHeader().LastBlockId.Hash...)
+	seed = append(seed, sdk.Uint64ToBigEndian(auction.Id)...)
+	return append(seed, sdk.Uint64ToBigEndian(auction.PoolId)...)
+}
+
 // getNextAuctionIdWithUpdate increments rewards auction id by one and store it.
 func (k Keeper) getNextAuctionIdWithUpdate(ctx sdk.Context, poolId uint64) uint64 {
 	auctionId := k.GetLastRewardsAuctionId(ctx, poolId) + 1
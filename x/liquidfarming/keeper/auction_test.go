@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	utils "github.com/cosmosquad-labs/squad/v3/types"
@@ -352,3 +354,105 @@ func (s *KeeperTestSuite) TestFinishRewardsAuction_NoOneFarmed() {
 	// Ensure that received pool coin amount is greater than the original liquid farm amount
 	s.Require().True(s.getBalance(s.addr(1), pool.PoolCoinDenom).Amount.GT(sdk.NewInt(50_000_000)))
 }
+
+// [scenario]
+// The liquid farm is on the candle auction mode.
+// A bid is placed before the candle window and a bigger bid is placed at the end time of the auction,
+// which is always after the closing time of the auction.
+//
+// [expected results]
+// 1. Bids can't be refunded in the candle window
+// 2. The bid that had the lead at the closing time wins the auction
+// 3. The bid placed after the closing time is refunded
+func (s *KeeperTestSuite) TestFinishRewardsAuction_CandleMode() {
+	pair := s.createPairWithLastPrice(helperAddr, "denom1", "denom2", sdk.NewDec(1))
+	pool := s.createPool(helperAddr, pair.Id, utils.ParseCoins("100_000_000denom1, 100_000_000denom2"))
+
+	liquidFarm := s.createLiquidFarm(pool.Id, sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroDec())
+	liquidFarm.AuctionMode = types.AuctionModeCandle
+	liquidFarm.CandleWindow = time.Hour
+	params := s.keeper.GetParams(s.ctx)
+	params.LiquidFarms = []types.LiquidFarm{liquidFarm}
+	s.keeper.SetParams(s.ctx, params)
+	s.nextBlock()
+
+	s.nextAuction()
+
+	auction, found := s.keeper.GetLastRewardsAuction(s.ctx, pool.Id)
+	s.Require().True(found)
+	s.Require().Equal(types.AuctionModeCandle, auction.AuctionMode)
+	s.Require().Equal(time.Hour, auction.CandleWindow)
+
+	s.placeBid(pool.Id, s.addr(5), utils.ParseCoin("2_000_000pool1"), true)
+
+	s.ctx = s.ctx.WithBlockTime(auction.CandleWindowStartTime())
+	s.placeBid(pool.Id, s.addr(6), utils.ParseCoin("3_000_000pool1"), true)
+	err := s.keeper.RefundBid(s.ctx, auction.Id, pool.Id, s.addr(5))
+	s.Require().ErrorIs(err, types.ErrRefundInCandleWindow)
+
+	s.ctx = s.ctx.WithBlockTime(auction.EndTime)
+	s.placeBid(pool.Id, s.addr(7), utils.ParseCoin("4_000_000pool1"), true)
+	s.Require().Len(s.keeper.GetLeadingBidsByAuction(s.ctx, auction.Id, pool.Id), 3)
+
+	s.nextAuction()
+
+	auction, found = s.keeper.GetRewardsAuction(s.ctx, auction.Id, pool.Id)
+	s.Require().True(found)
+	s.Require().Equal(types.AuctionStatusFinished, auction.Status)
+	s.Require().False(auction.ClosingTime.Before(auction.CandleWindowStartTime()))
+	s.Require().True(auction.ClosingTime.Before(auction.EndTime))
+	s.Require().Empty(s.keeper.GetLeadingBidsByAuction(s.ctx, auction.Id, pool.Id))
+	s.Require().Empty(s.keeper.GetBidsByPoolId(s.ctx, pool.Id))
+
+	// The closing time is derived from the block data, so either of the first two bids wins
+	winner, winningAmt, loser := s.addr(6), sdk.NewInt(3_000_000), s.addr(5)
+	if auction.Winner == s.addr(5).String() {
+		winner, winningAmt, loser = s.addr(5), sdk.NewInt(2_000_000), s.addr(6)
+	}
+	s.Require().Equal(winner.String(), auction.Winner)
+	s.Require().Equal(winningAmt, auction.WinningAmount.Amount)
+	s.Require().True(s.getBalance(winner, "pool1").Amount.IsZero())
+	s.Require().False(s.getBalance(loser, "pool1").Amount.IsZero())
+	s.Require().True(s.getBalance(s.addr(7), "pool1").Amount.Equal(sdk.NewInt(4_000_000)))
+
+	position, found := s.app.LPFarmKeeper.GetPosition(s.ctx, types.LiquidFarmReserveAddress(pool.Id), pool.PoolCoinDenom)
+	s.Require().True(found)
+	s.Require().True(position.FarmingAmount.Equal(winningAmt))
+}
+
+// [scenario]
+// The liquid farm is on the candle auction mode and the only bid is placed
+// at the end time of the auction, which is after the closing time of the auction.
+//
+// [expected results]
+// 1. The auction is skipped
+// 2. The bid is refunded
+func (s *KeeperTestSuite) TestFinishRewardsAuction_CandleModeNoLeadingBid() {
+	pair := s.createPairWithLastPrice(helperAddr, "denom1", "denom2", sdk.NewDec(1))
+	pool := s.createPool(helperAddr, pair.Id, utils.ParseCoins("100_000_000denom1, 100_000_000denom2"))
+
+	liquidFarm := s.createLiquidFarm(pool.Id, sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroDec())
+	liquidFarm.AuctionMode = types.AuctionModeCandle
+	liquidFarm.CandleWindow = time.Hour
+	params := s.keeper.GetParams(s.ctx)
+	params.LiquidFarms = []types.LiquidFarm{liquidFarm}
+	s.keeper.SetParams(s.ctx, params)
+	s.nextBlock()
+
+	s.nextAuction()
+
+	auction, found := s.keeper.GetLastRewardsAuction(s.ctx, pool.Id)
+	s.Require().True(found)
+
+	s.ctx = s.ctx.WithBlockTime(auction.EndTime)
+	s.placeBid(pool.Id, s.addr(5), utils.ParseCoin("2_000_000pool1"), true)
+
+	s.nextAuction()
+
+	auction, found = s.keeper.GetRewardsAuction(s.ctx, auction.Id, pool.Id)
+	s.Require().True(found)
+	s.Require().Equal(types.AuctionStatusSkipped, auction.Status)
+	s.Require().True(s.getBalance(s.addr(5), "pool1").Amount.Equal(sdk.NewInt(2_000_000)))
+	_, found = s.keeper.GetWinningBid(s.ctx, auction.Id, pool.Id)
+	s.Require().False(found)
+}
@@ -35,6 +35,38 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	for _, record := range genState.WinningBidRecords {
 		k.SetWinningBid(ctx, record.AuctionId, record.WinningBid)
 	}
+
+	for _, leadingBid := range genState.LeadingBids {
+		k.SetLeadingBid(ctx, leadingBid)
+	}
+
+	for _, pairLiquidFarm := range genState.PairLiquidFarms {
+		k.SetPairLiquidFarm(ctx, pairLiquidFarm)
+	}
+
+	for _, record := range genState.LastPairRewardsAuctionIdRecords {
+		k.SetLastPairRewardsAuctionId(ctx, record.AuctionId, record.PairId)
+	}
+
+	for _, auction := range genState.PairRewardsAuctions {
+		k.SetPairRewardsAuction(ctx, auction)
+	}
+
+	for _, bid := range genState.PairBids {
+		k.SetPairBid(ctx, bid)
+	}
+
+	for _, record := range genState.PairWinningBidRecords {
+		k.SetPairWinningBid(ctx, record.AuctionId, record.WinningBid)
+	}
+
+	for _, vault := range genState.Vaults {
+		k.SetVault(ctx, vault)
+	}
+
+	for _, record := range genState.VaultCompoundingRecords {
+		k.SetVaultLastCompoundedAt(ctx, record.PoolId, record.LastCompoundedAt)
+	}
 }
 
 // ExportGenesis returns the module's exported genesis.
@@ -45,6 +77,12 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	if params.LiquidFarms == nil {
 		params.LiquidFarms = []types.LiquidFarm{}
 	}
+	if params.PairLiquidFarms == nil {
+		params.PairLiquidFarms = []types.PairLiquidFarm{}
+	}
+	if params.Vaults == nil {
+		params.Vaults = []types.Vault{}
+	}
 
 	poolIds := []uint64{}
 	for _, liquidFarm := range params.LiquidFarms {
@@ -72,6 +110,39 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		}
 	}
 
+	lastPairRewardsAuctionIdRecords := []types.LastPairRewardsAuctionIdRecord{}
+	pairBids := []types.Bid{}
+	pairWinningBidRecords := []types.WinningBidRecord{}
+	for _, pairLiquidFarm := range k.GetPairLiquidFarmsInStore(ctx) {
+		auctionId := k.GetLastPairRewardsAuctionId(ctx, pairLiquidFarm.PairId)
+		lastPairRewardsAuctionIdRecords = append(lastPairRewardsAuctionIdRecords, types.LastPairRewardsAuctionIdRecord{
+			PairId:    pairLiquidFarm.PairId,
+			AuctionId: auctionId,
+		})
+
+		pairBids = append(pairBids, k.GetPairBidsByPairId(ctx, pairLiquidFarm.PairId)...)
+
+		winningBid, found := k.GetPairWinningBid(ctx, auctionId, pairLiquidFarm.PairId)
+		if found {
+			pairWinningBidRecords = append(pairWinningBidRecords, types.WinningBidRecord{
+				AuctionId:  auctionId,
+				WinningBid: winningBid,
+			})
+		}
+	}
+
+	vaults := k.GetVaultsInStore(ctx)
+	vaultCompoundingRecords := []types.VaultCompoundingRecord{}
+	for _, vault := range vaults {
+		lastCompoundedAt, found := k.GetVaultLastCompoundedAt(ctx, vault.PoolId)
+		if found {
+			vaultCompoundingRecords = append(vaultCompoundingRecords, types.VaultCompoundingRecord{
+				PoolId:           vault.PoolId,
+				LastCompoundedAt: lastCompoundedAt,
+			})
+		}
+	}
+
 	var endTime *time.Time
 	tempEndTime, found := k.GetLastRewardsAuctionEndTime(ctx)
 	if found {
@@ -79,12 +150,20 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	}
 
 	return &types.GenesisState{
-		Params:                     params,
-		LastRewardsAuctionIdRecord: lastRewardsAuctionIdRecords,
-		LiquidFarms:                k.GetLiquidFarmsInStore(ctx),
-		RewardsAuctions:            k.GetAllRewardsAuctions(ctx),
-		Bids:                       bids,
-		WinningBidRecords:          winningBidRecords,
-		LastRewardsAuctionEndTime:  endTime,
+		Params:                          params,
+		LastRewardsAuctionIdRecord:      lastRewardsAuctionIdRecords,
+		LiquidFarms:                     k.GetLiquidFarmsInStore(ctx),
+		RewardsAuctions:                 k.GetAllRewardsAuctions(ctx),
+		Bids:                            bids,
+		WinningBidRecords:               winningBidRecords,
+		LastRewardsAuctionEndTime:       endTime,
+		LeadingBids:                     k.GetAllLeadingBids(ctx),
+		PairLiquidFarms:                 k.GetPairLiquidFarmsInStore(ctx),
+		LastPairRewardsAuctionIdRecords: lastPairRewardsAuctionIdRecords,
+		PairRewardsAuctions:             k.GetAllPairRewardsAuctions(ctx),
+		PairBids:                        pairBids,
+		PairWinningBidRecords:           pairWinningBidRecords,
+		Vaults:                          vaults,
+		VaultCompoundingRecords:         vaultCompoundingRecords,
 	}
 }
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	utils "github.com/cosmosquad-labs/squad/v3/types"
@@ -95,3 +97,78 @@ func (s *KeeperTestSuite) TestImportExportGenesis() {
 	})
 	s.Require().NoError(genState3.Validate())
 }
+
+func (s *KeeperTestSuite) TestImportExportGenesis_PairLiquidFarm() {
+	pair := s.createPairWithLastPrice(helperAddr, "denom1", "denom2", sdk.NewDec(1))
+	pool := s.createPool(helperAddr, pair.Id, utils.ParseCoins("100_000_000denom1, 100_000_000denom2"))
+
+	s.createPairLiquidFarm(pair.Id, sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroDec())
+
+	s.Require().NoError(s.app.BankKeeper.SendCoins(s.ctx, helperAddr, s.addr(1), utils.ParseCoins("1_000_000_000pool1")))
+	s.pairLiquidFarm(pair.Id, s.addr(1), utils.ParseCoin("1_000_000_000pool1"), false)
+	s.nextBlock()
+
+	// Move time to auctionTime so that rewards auction is created
+	s.nextAuction()
+
+	auction, found := s.keeper.GetLastPairRewardsAuction(s.ctx, pair.Id)
+	s.Require().True(found)
+
+	s.placePairBid(auction.Id, pair.Id, s.addr(4), sdk.NewInt64Coin(pool.PoolCoinDenom, 100_000), true)
+	s.placePairBid(auction.Id, pair.Id, s.addr(5), sdk.NewInt64Coin(pool.PoolCoinDenom, 200_000), true)
+	s.nextBlock()
+
+	// Export genesis state and verify
+	var genState *types.GenesisState
+	s.Require().NotPanics(func() {
+		genState = s.keeper.ExportGenesis(s.ctx)
+		s.Require().Len(genState.PairLiquidFarms, 1)
+		s.Require().Len(genState.LastPairRewardsAuctionIdRecords, 1)
+		s.Require().Len(genState.PairRewardsAuctions, 1)
+		s.Require().Len(genState.PairBids, 2)
+		s.Require().Len(genState.PairWinningBidRecords, 1)
+	})
+	s.Require().NoError(genState.Validate())
+
+	var genState2 types.GenesisState
+	bz := s.app.AppCodec().MustMarshalJSON(genState)
+	s.app.AppCodec().MustUnmarshalJSON(bz, &genState2)
+	s.keeper.InitGenesis(s.ctx, genState2)
+
+	var genState3 *types.GenesisState
+	s.Require().NotPanics(func() {
+		genState3 = s.keeper.ExportGenesis(s.ctx)
+		s.Require().Equal(*genState, *genState3)
+	})
+	s.Require().NoError(genState3.Validate())
+}
+
+func (s *KeeperTestSuite) TestImportExportGenesis_Vault() {
+	pair := s.createPairWithLastPrice(helperAddr, "denom1", "denom2", sdk.NewDec(1))
+	pool := s.createPool(helperAddr, pair.Id, utils.ParseCoins("100_000_000denom1, 100_000_000denom2"))
+	s.createVault(pool.Id, sdk.ZeroInt(), utils.ParseDec("0.1"), time.Hour)
+
+	s.Require().NoError(s.app.BankKeeper.SendCoins(s.ctx, helperAddr, s.addr(1), utils.ParseCoins("1_000_000_000pool1")))
+	s.vaultFarm(pool.Id, s.addr(1), utils.ParseCoin("1_000_000_000pool1"), false)
+	s.nextBlock()
+
+	var genState *types.GenesisState
+	s.Require().NotPanics(func() {
+		genState = s.keeper.ExportGenesis(s.ctx)
+		s.Require().Len(genState.Params.Vaults, 1)
+		s.Require().Len(genState.Vaults, 1)
+		s.Require().Len(genState.VaultCompoundingRecords, 1)
+	})
+	s.Require().NoError(genState.Validate())
+
+	var genState2 types.GenesisState
+	bz := s.app.AppCodec().MustMarshalJSON(genState)
+	s.app.AppCodec().MustUnmarshalJSON(bz, &genState2)
+	s.keeper.InitGenesis(s.ctx, genState2)
+
+	var genState3 *types.GenesisState
+	s.Require().NotPanics(func() {
+		genState3 = s.keeper.ExportGenesis(s.ctx)
+		s.Require().Equal(*genState, *genState3)
+	})
+}
@@ -241,11 +241,16 @@ func (k Querier) Rewards(c context.Context, req *types.QueryRewardsRequest) (*ty
 }
 
 // ExchangeRate queries exchange rate, such as mint rate and burn rate per 1 LFCoin.
+// When the pair id is given, it queries the combined exchange rate of the pair liquid farm.
 func (k Querier) ExchangeRate(c context.Context, req *types.QueryExchangeRateRequest) (*types.QueryExchangeRateResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
+	if req.PairId != 0 {
+		return k.pairExchangeRate(sdk.UnwrapSDKContext(c), req.PairId)
+	}
+
 	if req.PoolId == 0 {
 		return nil, status.Error(codes.InvalidArgument, "pool id cannot be 0")
 	}
@@ -279,3 +284,176 @@ func (k Querier) ExchangeRate(c context.Context, req *types.QueryExchangeRateReq
 
 	return &types.QueryExchangeRateResponse{ExchangeRate: res}, nil
 }
+
+// pairExchangeRate queries the combined exchange rate of the pair liquid farm.
+// The rates are against the value of the basket measured in the quote coin of the pair.
+func (k Querier) pairExchangeRate(ctx sdk.Context, pairId uint64) (*types.QueryExchangeRateResponse, error) {
+	pair, found := k.liquidityKeeper.GetPair(ctx, pairId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "pair %d not found", pairId)
+	}
+
+	res := types.ExchangeRateResponse{
+		MintRate: sdk.ZeroDec(),
+		BurnRate: sdk.ZeroDec(),
+	}
+	lfCoinTotalSupplyAmt := k.bankKeeper.GetSupply(ctx, types.PairLiquidFarmCoinDenom(pairId)).Amount
+
+	if !lfCoinTotalSupplyAmt.IsZero() {
+		price, err := k.pairPrice(ctx, pair)
+		if err != nil {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		basket := k.pairBasket(ctx, pair, price, k.pairFarmingCoins(ctx, pairId))
+
+		totalFarmingValue := types.TotalFarmingValue(basket)
+		if totalFarmingValue.IsPositive() {
+			// MintRate = LFCoinTotalSupply / TotalFarmingValue
+			res.MintRate = lfCoinTotalSupplyAmt.ToDec().Quo(totalFarmingValue)
+
+			// BurnRate = TotalFarmingValue / LFCoinTotalSupply
+			res.BurnRate = totalFarmingValue.QuoInt(lfCoinTotalSupplyAmt)
+		}
+	}
+
+	return &types.QueryExchangeRateResponse{ExchangeRate: res}, nil
+}
+
+// PairLiquidFarms queries all PairLiquidFarm objects.
+func (k Querier) PairLiquidFarms(c context.Context, req *types.QueryPairLiquidFarmsRequest) (*types.QueryPairLiquidFarmsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res := []types.PairLiquidFarmResponse{}
+	for _, pairLiquidFarm := range k.GetPairLiquidFarmsInStore(ctx) {
+		farmingCoins := k.pairFarmingCoins(ctx, pairLiquidFarm.PairId)
+		totalValue := sdk.ZeroDec()
+		if pair, found := k.liquidityKeeper.GetPair(ctx, pairLiquidFarm.PairId); found {
+			if price, err := k.pairPrice(ctx, pair); err == nil {
+				totalValue = types.TotalFarmingValue(k.pairBasket(ctx, pair, price, farmingCoins))
+			}
+		}
+
+		res = append(res, types.PairLiquidFarmResponse{
+			PairId:                   pairLiquidFarm.PairId,
+			LiquidFarmReserveAddress: types.PairLiquidFarmReserveAddress(pairLiquidFarm.PairId).String(),
+			LFCoinDenom:              types.PairLiquidFarmCoinDenom(pairLiquidFarm.PairId),
+			MinFarmAmount:            pairLiquidFarm.MinFarmAmount,
+			MinBidAmount:             pairLiquidFarm.MinBidAmount,
+			FarmingCoins:             farmingCoins,
+			TotalValue:               totalValue,
+		})
+	}
+
+	return &types.QueryPairLiquidFarmsResponse{PairLiquidFarms: res}, nil
+}
+
+// PairRewardsAuctions queries all RewardsAuction objects of the pair liquid farm.
+func (k Querier) PairRewardsAuctions(c context.Context, req *types.QueryPairRewardsAuctionsRequest) (*types.QueryPairRewardsAuctionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.PairId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "pair id cannot be 0")
+	}
+
+	if req.Status != "" && !(req.Status == types.AuctionStatusStarted.String() ||
+		req.Status == types.AuctionStatusFinished.String() ||
+		req.Status == types.AuctionStatusSkipped.String()) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid auction status %s", req.Status)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	auctionStore := prefix.NewStore(store, types.PairRewardsAuctionKeyPrefix)
+
+	// Filter auctions by descending order to show an ongoing auction first
+	if req.Pagination == nil {
+		req.Pagination = &query.PageRequest{}
+	}
+	req.Pagination.Reverse = true
+
+	var auctions []types.RewardsAuction
+	pageRes, err := query.FilteredPaginate(auctionStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		auction, err := types.UnmarshalRewardsAuction(k.cdc, value)
+		if err != nil {
+			return false, err
+		}
+
+		if auction.PairId != req.PairId {
+			return false, nil
+		}
+
+		if req.Status != "" && auction.Status.String() != req.Status {
+			return false, nil
+		}
+
+		if accumulate {
+			auctions = append(auctions, auction)
+		}
+
+		return true, nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPairRewardsAuctionsResponse{RewardAuctions: auctions, Pagination: pageRes}, nil
+}
+
+// Vaults queries all Vault objects.
+func (k Querier) Vaults(c context.Context, req *types.QueryVaultsRequest) (*types.QueryVaultsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res := []types.VaultResponse{}
+	for _, vault := range k.GetVaultsInStore(ctx) {
+		res = append(res, k.vaultResponse(ctx, vault))
+	}
+
+	return &types.QueryVaultsResponse{Vaults: res}, nil
+}
+
+// Vault queries the particular Vault object.
+func (k Querier) Vault(c context.Context, req *types.QueryVaultRequest) (*types.QueryVaultResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.PoolId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "pool id cannot be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	vault, found := k.GetVault(ctx, req.PoolId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "vault by pool id %d not found", req.PoolId)
+	}
+
+	return &types.QueryVaultResponse{Vault: k.vaultResponse(ctx, vault)}, nil
+}
+
+func (k Querier) vaultResponse(ctx sdk.Context, vault types.Vault) types.VaultResponse {
+	res := types.VaultResponse{
+		PoolId:              vault.PoolId,
+		VaultReserveAddress: types.VaultReserveAddress(vault.PoolId).String(),
+		VaultCoinDenom:      types.VaultCoinDenom(vault.PoolId),
+		MinFarmAmount:       vault.MinFarmAmount,
+		MaxSlippage:         vault.MaxSlippage,
+		CompoundingInterval: vault.CompoundingInterval,
+		TotalFarmingAmount:  k.vaultTotalFarmingAmount(ctx, vault.PoolId),
+	}
+	if lastCompoundedAt, found := k.GetVaultLastCompoundedAt(ctx, vault.PoolId); found {
+		res.LastCompoundedAt = &lastCompoundedAt
+	}
+	return res
+}
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	utils "github.com/cosmosquad-labs/squad/v3/types"
@@ -542,3 +544,80 @@ func (s *KeeperTestSuite) TestGRPCExchangeRate() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestGRPCVaults() {
+	pair := s.createPairWithLastPrice(helperAddr, "denom1", "denom2", sdk.NewDec(1))
+	pool := s.createPool(helperAddr, pair.Id, utils.ParseCoins("100_000_000denom1, 100_000_000denom2"))
+	minFarmAmt, maxSlippage := sdk.NewInt(10_000_000), utils.ParseDec("0.1")
+	s.createVault(pool.Id, minFarmAmt, maxSlippage, time.Hour)
+
+	s.Require().NoError(s.app.BankKeeper.SendCoins(s.ctx, helperAddr, s.addr(1), utils.ParseCoins("1_000_000_000pool1")))
+	s.vaultFarm(pool.Id, s.addr(1), utils.ParseCoin("1_000_000_000pool1"), false)
+
+	resp, err := s.querier.Vaults(sdk.WrapSDKContext(s.ctx), &types.QueryVaultsRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(resp.Vaults, 1)
+	s.Require().Equal(pool.Id, resp.Vaults[0].PoolId)
+	s.Require().Equal(types.VaultCoinDenom(pool.Id), resp.Vaults[0].VaultCoinDenom)
+	s.Require().Equal(sdk.NewInt(1_000_000_000), resp.Vaults[0].TotalFarmingAmount)
+	s.Require().Nil(resp.Vaults[0].LastCompoundedAt)
+
+	_, err = s.querier.Vaults(sdk.WrapSDKContext(s.ctx), nil)
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestGRPCVault() {
+	pair := s.createPairWithLastPrice(helperAddr, "denom1", "denom2", sdk.NewDec(1))
+	pool := s.createPool(helperAddr, pair.Id, utils.ParseCoins("100_000_000denom1, 100_000_000denom2"))
+	minFarmAmt, maxSlippage := sdk.NewInt(10_000_000), utils.ParseDec("0.1")
+	s.createVault(pool.Id, minFarmAmt, maxSlippage, time.Hour)
+	s.keeper.CompoundVaults(s.ctx)
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QueryVaultRequest
+		expectErr bool
+		postRun   func(*types.QueryVaultResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"happy case",
+			&types.QueryVaultRequest{
+				PoolId: pool.Id,
+			},
+			false,
+			func(resp *types.QueryVaultResponse) {
+				s.Require().Equal(types.VaultReserveAddress(pool.Id).String(), resp.Vault.VaultReserveAddress)
+				s.Require().Equal(minFarmAmt, resp.Vault.MinFarmAmount)
+				s.Require().Equal(maxSlippage, resp.Vault.MaxSlippage)
+				s.Require().Equal(time.Hour, resp.Vault.CompoundingInterval)
+				s.Require().True(resp.Vault.TotalFarmingAmount.IsZero())
+				s.Require().NotNil(resp.Vault.LastCompoundedAt)
+				s.Require().Equal(s.ctx.BlockTime(), *resp.Vault.LastCompoundedAt)
+			},
+		},
+		{
+			"query by invalid pool id",
+			&types.QueryVaultRequest{
+				PoolId: 5,
+			},
+			true,
+			nil,
+		},
+	} {
+		s.Run(tc.name, func() {
+			resp, err := s.querier.Vault(sdk.WrapSDKContext(s.ctx), tc.req)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
@@ -100,3 +100,18 @@ func (k Keeper) GetLiquidFarmsInParams(ctx sdk.Context) (liquidFarms []types.Liq
 	k.paramSpace.Get(ctx, types.KeyLiquidFarms, &liquidFarms)
 	return
 }
+
+func (k Keeper) GetPairLiquidFarmsInParams(ctx sdk.Context) (pairLiquidFarms []types.PairLiquidFarm) {
+	k.paramSpace.Get(ctx, types.KeyPairLiquidFarms, &pairLiquidFarms)
+	return
+}
+
+func (k Keeper) GetVaultsInParams(ctx sdk.Context) (vaults []types.Vault) {
+	k.paramSpace.Get(ctx, types.KeyVaults, &vaults)
+	return
+}
+
+func (k Keeper) GetPairPriceWindow(ctx sdk.Context) (window time.Duration) {
+	k.paramSpace.Get(ctx, types.KeyPairPriceWindow, &window)
+	return
+}
@@ -107,11 +107,21 @@ func (s *KeeperTestSuite) createPair(creator sdk.AccAddress, baseCoinDenom, quot
 func (s *KeeperTestSuite) createPairWithLastPrice(creator sdk.AccAddress, baseCoinDenom, quoteCoinDenom string, lastPrice sdk.Dec) liquiditytypes.Pair {
 	s.T().Helper()
 	pair := s.createPair(creator, baseCoinDenom, quoteCoinDenom)
-	pair.LastPrice = &lastPrice
-	s.app.LiquidityKeeper.SetPair(s.ctx, pair)
+	s.setPairLastPrice(&pair, lastPrice)
 	return pair
 }
 
+// setPairLastPrice sets the last price of the pair and the price history
+// as if the pair has been traded at the price for the whole pair price window.
+func (s *KeeperTestSuite) setPairLastPrice(pair *liquiditytypes.Pair, lastPrice sdk.Dec) {
+	s.T().Helper()
+	pair.LastPrice = &lastPrice
+	s.app.LiquidityKeeper.SetPair(s.ctx, *pair)
+	startTime := s.ctx.BlockTime().Add(-s.keeper.GetPairPriceWindow(s.ctx))
+	s.app.LiquidityKeeper.SetPriceAccumulator(
+		s.ctx, liquiditytypes.NewPriceAccumulator(pair.Id, startTime, sdk.ZeroDec(), lastPrice))
+}
+
 func (s *KeeperTestSuite) createPool(creator sdk.AccAddress, pairId uint64, depositCoins sdk.Coins) liquiditytypes.Pool {
 	s.T().Helper()
 	s.fundAddr(creator, s.app.LiquidityKeeper.GetPoolCreationFee(s.ctx).Add(depositCoins...))
@@ -140,6 +150,34 @@ func (s *KeeperTestSuite) createLiquidFarm(poolId uint64, minFarmAmt, minBidAmt
 	return liquidFarm
 }
 
+func (s *KeeperTestSuite) createRangedPool(creator sdk.AccAddress, pairId uint64, depositCoins sdk.Coins, minPrice, maxPrice, initialPrice sdk.Dec) liquiditytypes.Pool {
+	s.T().Helper()
+	s.fundAddr(creator, s.app.LiquidityKeeper.GetPoolCreationFee(s.ctx).Add(depositCoins...))
+	pool, err := s.app.LiquidityKeeper.CreateRangedPool(s.ctx, liquiditytypes.NewMsgCreateRangedPool(creator, pairId, depositCoins, minPrice, maxPrice, initialPrice))
+	s.Require().NoError(err)
+	return pool
+}
+
+func (s *KeeperTestSuite) createPairLiquidFarm(pairId uint64, minFarmAmt, minBidAmt sdk.Int, feeRate sdk.Dec) types.PairLiquidFarm {
+	s.T().Helper()
+	pairLiquidFarm := types.NewPairLiquidFarm(pairId, minFarmAmt, minBidAmt, feeRate)
+	params := s.keeper.GetParams(s.ctx)
+	params.PairLiquidFarms = append(params.PairLiquidFarms, pairLiquidFarm)
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.SetPairLiquidFarm(s.ctx, pairLiquidFarm)
+	return pairLiquidFarm
+}
+
+func (s *KeeperTestSuite) createVault(poolId uint64, minFarmAmt sdk.Int, maxSlippage sdk.Dec, compoundingInterval time.Duration) types.Vault {
+	s.T().Helper()
+	vault := types.NewVault(poolId, minFarmAmt, maxSlippage, compoundingInterval)
+	params := s.keeper.GetParams(s.ctx)
+	params.Vaults = append(params.Vaults, vault)
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.SetVault(s.ctx, vault)
+	return vault
+}
+
 func (s *KeeperTestSuite) createRewardsAuction(poolId uint64) {
 	s.T().Helper()
 	duration := s.keeper.GetRewardsAuctionDuration(s.ctx)
@@ -181,6 +219,34 @@ func (s *KeeperTestSuite) placeBid(poolId uint64, bidder sdk.AccAddress, bidding
 // Below are helper functions to write test code easily
 //
 
+func (s *KeeperTestSuite) pairLiquidFarm(pairId uint64, farmer sdk.AccAddress, farmingCoin sdk.Coin, fund bool) {
+	s.T().Helper()
+	if fund {
+		s.fundAddr(farmer, sdk.NewCoins(farmingCoin))
+	}
+	err := s.keeper.PairLiquidFarm(s.ctx, pairId, farmer, farmingCoin)
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) vaultFarm(poolId uint64, farmer sdk.AccAddress, farmingCoin sdk.Coin, fund bool) {
+	s.T().Helper()
+	if fund {
+		s.fundAddr(farmer, sdk.NewCoins(farmingCoin))
+	}
+	err := s.keeper.VaultFarm(s.ctx, poolId, farmer, farmingCoin)
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) placePairBid(auctionId, pairId uint64, bidder sdk.AccAddress, biddingCoin sdk.Coin, fund bool) types.Bid {
+	s.T().Helper()
+	if fund {
+		s.fundAddr(bidder, sdk.NewCoins(biddingCoin))
+	}
+	bid, err := s.keeper.PlacePairBid(s.ctx, auctionId, pairId, bidder, biddingCoin)
+	s.Require().NoError(err)
+	return bid
+}
+
 func (s *KeeperTestSuite) addr(addrNum int) sdk.AccAddress {
 	addr := make(sdk.AccAddress, 20)
 	binary.PutVarint(addr, int64(addrNum))
@@ -236,6 +236,9 @@ func (k Keeper) HandleRemovedLiquidFarm(ctx sdk.Context, liquidFarm types.Liquid
 		if err := k.refundAllBids(ctx, auction, true); err != nil {
 			panic(err)
 		}
+		for _, leadingBid := range k.GetLeadingBidsByAuction(ctx, auction.Id, auction.PoolId) {
+			k.DeleteLeadingBid(ctx, leadingBid)
+		}
 
 		auction.SetStatus(types.AuctionStatusFinished)
 		k.SetRewardsAuction(ctx, auction)
@@ -78,6 +78,72 @@ func (m msgServer) RefundBid(goCtx context.Context, msg *types.MsgRefundBid) (*t
 	return &types.MsgRefundBidResponse{}, nil
 }
 
+// PairLiquidFarm defines a method for farming pool coin of any pool of the pair and mint pair LFCoin for the farmer.
+func (m msgServer) PairLiquidFarm(goCtx context.Context, msg *types.MsgPairLiquidFarm) (*types.MsgPairLiquidFarmResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.PairLiquidFarm(ctx, msg.PairId, msg.GetFarmer(), msg.FarmingCoin); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPairLiquidFarmResponse{}, nil
+}
+
+// PairLiquidUnfarm defines a method for unfarming pair LFCoin to return the corresponding share of the pool coins.
+func (m msgServer) PairLiquidUnfarm(goCtx context.Context, msg *types.MsgPairLiquidUnfarm) (*types.MsgPairLiquidUnfarmResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := m.Keeper.PairLiquidUnfarm(ctx, msg.PairId, msg.GetFarmer(), msg.UnfarmingCoin); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPairLiquidUnfarmResponse{}, nil
+}
+
+// PlacePairBid defines a method for placing a bid for a rewards auction of the pair liquid farm.
+func (m msgServer) PlacePairBid(goCtx context.Context, msg *types.MsgPlacePairBid) (*types.MsgPlacePairBidResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := m.Keeper.PlacePairBid(ctx, msg.AuctionId, msg.PairId, msg.GetBidder(), msg.BiddingCoin); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPlacePairBidResponse{}, nil
+}
+
+// RefundPairBid defines a method for refunding the bid for the rewards auction of the pair liquid farm.
+func (m msgServer) RefundPairBid(goCtx context.Context, msg *types.MsgRefundPairBid) (*types.MsgRefundPairBidResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.RefundPairBid(ctx, msg.AuctionId, msg.PairId, msg.GetBidder()); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRefundPairBidResponse{}, nil
+}
+
+// VaultFarm defines a method for farming pool coin in the vault and mint vault coin for the farmer.
+func (m msgServer) VaultFarm(goCtx context.Context, msg *types.MsgVaultFarm) (*types.MsgVaultFarmResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.VaultFarm(ctx, msg.PoolId, msg.GetFarmer(), msg.FarmingCoin); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgVaultFarmResponse{}, nil
+}
+
+// VaultUnfarm defines a method for unfarming vault coin to return the corresponding amount of pool coin.
+func (m msgServer) VaultUnfarm(goCtx context.Context, msg *types.MsgVaultUnfarm) (*types.MsgVaultUnfarmResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := m.Keeper.VaultUnfarm(ctx, msg.PoolId, msg.GetFarmer(), msg.UnfarmingCoin); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgVaultUnfarmResponse{}, nil
+}
+
 // AdvanceAuction defines a method for advancing rewards auction by one.
 // This message is just for testing purpose and it shouldn't be used in production.
 func (k msgServer) AdvanceAuction(goCtx context.Context, msg *types.MsgAdvanceAuction) (*types.MsgAdvanceAuctionResponse, error) {
@@ -101,6 +167,7 @@ func (k msgServer) AdvanceAuction(goCtx context.Context, msg *types.MsgAdvanceAu
 				k.CreateRewardsAuction(ctx, l.PoolId, nextEndTime)
 			}
 		}
+		k.AdvancePairRewardsAuctions(ctx, nextEndTime)
 		k.SetLastRewardsAuctionEndTime(ctx, nextEndTime)
 	} else {
 		return nil, fmt.Errorf("AdvanceAuction is disabled")
@@ -0,0 +1,300 @@
+package keeper
+
+import (
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmosquad-labs/squad/v3/x/liquidfarming/types"
+)
+
+// PlacePairBid handles types.MsgPlacePairBid and stores bid object.
+func (k Keeper) PlacePairBid(ctx sdk.Context, auctionId uint64, pairId uint64, bidder sdk.AccAddress, biddingCoin sdk.Coin) (types.Bid, error) {
+	pairLiquidFarm, found := k.GetPairLiquidFarm(ctx, pairId)
+	if !found {
+		return types.Bid{}, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pair liquid farm by pair %d not found", pairId)
+	}
+
+	auction, found := k.GetPairRewardsAuction(ctx, auctionId, pairId)
+	if !found {
+		return types.Bid{}, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "auction by pair %d not found", pairId)
+	}
+
+	if auction.Status != types.AuctionStatusStarted {
+		return types.Bid{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "auction %d is not started", auctionId)
+	}
+
+	if auction.BiddingCoinDenom != biddingCoin.Denom {
+		return types.Bid{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "expected denom %s, but got %s", auction.BiddingCoinDenom, biddingCoin.Denom)
+	}
+
+	if biddingCoin.Amount.LT(pairLiquidFarm.MinBidAmount) {
+		return types.Bid{}, sdkerrors.Wrapf(types.ErrSmallerThanMinimumAmount, "%s is smaller than %s", biddingCoin.Amount, pairLiquidFarm.MinBidAmount)
+	}
+
+	winningBid, found := k.GetPairWinningBid(ctx, auctionId, pairId)
+	if found {
+		if biddingCoin.Amount.LTE(winningBid.Amount.Amount) {
+			return types.Bid{}, sdkerrors.Wrapf(types.ErrNotBiggerThanWinningBidAmount, "%s is not bigger than %s", biddingCoin.Amount, winningBid.Amount.Amount)
+		}
+	}
+
+	// Refund the previous bid if exists
+	previousBid, found := k.GetPairBid(ctx, pairId, bidder)
+	if found {
+		if err := k.bankKeeper.SendCoins(ctx, auction.GetPayingReserveAddress(), previousBid.GetBidder(), sdk.NewCoins(previousBid.Amount)); err != nil {
+			return types.Bid{}, err
+		}
+		k.DeletePairBid(ctx, previousBid)
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, bidder, auction.GetPayingReserveAddress(), sdk.NewCoins(biddingCoin)); err != nil {
+		return types.Bid{}, err
+	}
+
+	bid := types.NewPairBid(
+		pairId,
+		auction.PoolId,
+		bidder.String(),
+		biddingCoin,
+	)
+	k.SetPairBid(ctx, bid)
+	k.SetPairWinningBid(ctx, auction.Id, bid)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePlacePairBid,
+			sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(pairId, 10)),
+			sdk.NewAttribute(types.AttributeKeyAuctionId, strconv.FormatUint(auction.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyBidder, bidder.String()),
+			sdk.NewAttribute(types.AttributeKeyBiddingCoin, biddingCoin.String()),
+		),
+	})
+
+	return bid, nil
+}
+
+// RefundPairBid handles types.MsgRefundPairBid and refunds bid amount to the bidder and
+// delete the bid object.
+func (k Keeper) RefundPairBid(ctx sdk.Context, auctionId uint64, pairId uint64, bidder sdk.AccAddress) error {
+	auction, found := k.GetPairRewardsAuction(ctx, auctionId, pairId)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "auction by pair %d not found", pairId)
+	}
+
+	winningBid, found := k.GetPairWinningBid(ctx, auctionId, pairId)
+	if found && winningBid.Bidder == bidder.String() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "winning bid can't be refunded")
+	}
+
+	bid, found := k.GetPairBid(ctx, pairId, bidder)
+	if !found {
+		return sdkerrors.Wrap(sdkerrors.ErrNotFound, "bid not found")
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, auction.GetPayingReserveAddress(), bidder, sdk.NewCoins(bid.Amount)); err != nil {
+		return err
+	}
+
+	k.DeletePairBid(ctx, bid)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRefundPairBid,
+			sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(pairId, 10)),
+			sdk.NewAttribute(types.AttributeKeyBidder, bidder.String()),
+			sdk.NewAttribute(types.AttributeKeyRefundCoin, bid.Amount.String()),
+		),
+	})
+
+	return nil
+}
+
+// CreatePairRewardsAuction creates new rewards auction for the pair liquid farm and store it.
+// The pool coin of the most underweight pool in the basket is used for bidding,
+// so that the auction proceeds are compounded into the pool.
+func (k Keeper) CreatePairRewardsAuction(ctx sdk.Context, pairId uint64, endTime time.Time) error {
+	pair, found := k.liquidityKeeper.GetPair(ctx, pairId)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pair %d not found", pairId)
+	}
+
+	price, err := k.pairPrice(ctx, pair)
+	if err != nil {
+		return err
+	}
+	basket := k.pairBasket(ctx, pair, price, k.pairFarmingCoins(ctx, pairId))
+
+	poolId, found := types.MostUnderweightPool(basket)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrNoCompoundingPool, "pair %d", pairId)
+	}
+
+	auction := types.NewPairRewardsAuction(
+		k.getNextPairAuctionIdWithUpdate(ctx, pairId),
+		pairId,
+		poolId,
+		ctx.BlockTime(),
+		endTime,
+	)
+	k.SetPairRewardsAuction(ctx, auction)
+	return nil
+}
+
+// FinishPairRewardsAuction finishes ongoing rewards auction of the pair liquid farm by looking up the existence of winning bid.
+// Compound accumulated farming rewards for farmers and refund all bids that are placed for the auction if winning bid exists.
+// If not, update the auction status AuctionStatusSkipped.
+func (k Keeper) FinishPairRewardsAuction(ctx sdk.Context, auction types.RewardsAuction, feeRate sdk.Dec) error {
+	reserveAddr := types.PairLiquidFarmReserveAddress(auction.PairId)
+	farmingCoins := k.pairFarmingCoins(ctx, auction.PairId)
+	farmingRewards := sdk.DecCoins{}
+	for _, coin := range farmingCoins {
+		farmingRewards = farmingRewards.Add(k.lpfarmKeeper.Rewards(ctx, reserveAddr, coin.Denom)...)
+	}
+	truncatedRewards, _ := farmingRewards.TruncateDecimal()
+
+	winningBid, found := k.GetPairWinningBid(ctx, auction.Id, auction.PairId)
+	if !found {
+		auction.SetRewards(truncatedRewards)
+		auction.SetStatus(types.AuctionStatusSkipped)
+		k.SetPairRewardsAuction(ctx, auction)
+		return nil
+	}
+
+	if err := k.payoutPairRewards(ctx, auction.PairId, feeRate, farmingCoins, winningBid); err != nil {
+		return err
+	}
+
+	// Compound rewards into the pool of the bidding coin even if there is no one farmed.
+	if err := k.bankKeeper.SendCoins(ctx, auction.GetPayingReserveAddress(), reserveAddr, sdk.NewCoins(winningBid.Amount)); err != nil {
+		return err
+	}
+	withdrawnRewards, err := k.lpfarmKeeper.Farm(ctx, reserveAddr, winningBid.Amount)
+	if err != nil {
+		return err
+	}
+	if !withdrawnRewards.IsZero() {
+		withdrawnRewardsReserveAddr := types.PairWithdrawnRewardsReserveAddress(auction.PairId)
+		if err := k.bankKeeper.SendCoins(ctx, reserveAddr, withdrawnRewardsReserveAddr, withdrawnRewards); err != nil {
+			return err
+		}
+	}
+
+	if err := k.refundAllPairBids(ctx, auction, false); err != nil {
+		return err
+	}
+
+	auction.SetWinner(winningBid.Bidder)
+	auction.SetWinningAmount(winningBid.Amount)
+	auction.SetRewards(truncatedRewards)
+	auction.SetStatus(types.AuctionStatusFinished)
+	k.SetPairRewardsAuction(ctx, auction)
+
+	return nil
+}
+
+// AdvancePairRewardsAuctions finishes the ongoing rewards auctions of all pair liquid farms and
+// creates the next ones that end at the given end time.
+// A pair liquid farm skips the next auction when no pool of the pair can be selected to compound rewards into.
+func (k Keeper) AdvancePairRewardsAuctions(ctx sdk.Context, endTime time.Time) {
+	for _, l := range k.GetPairLiquidFarmsInStore(ctx) {
+		auction, found := k.GetLastPairRewardsAuction(ctx, l.PairId)
+		if found && auction.Status == types.AuctionStatusStarted {
+			if err := k.FinishPairRewardsAuction(ctx, auction, l.FeeRate); err != nil {
+				panic(err)
+			}
+		}
+		if err := k.CreatePairRewardsAuction(ctx, l.PairId, endTime); err != nil {
+			k.Logger(ctx).Info("skip creating pair rewards auction", "pair_id", l.PairId, "reason", err.Error())
+		}
+	}
+}
+
+// getNextPairAuctionIdWithUpdate increments rewards auction id of the pair liquid farm by one and store it.
+func (k Keeper) getNextPairAuctionIdWithUpdate(ctx sdk.Context, pairId uint64) uint64 {
+	auctionId := k.GetLastPairRewardsAuctionId(ctx, pairId) + 1
+	k.SetLastPairRewardsAuctionId(ctx, auctionId, pairId)
+	return auctionId
+}
+
+// refundAllPairBids refunds all bids at once as the rewards auction of the pair liquid farm is finished and delete all bids.
+func (k Keeper) refundAllPairBids(ctx sdk.Context, auction types.RewardsAuction, includeWinningBid bool) error {
+	winningBid, found := k.GetPairWinningBid(ctx, auction.Id, auction.PairId)
+	if !found {
+		winningBid = types.Bid{}
+	}
+
+	inputs := []banktypes.Input{}
+	outputs := []banktypes.Output{}
+
+	for _, bid := range k.GetPairBidsByPairId(ctx, auction.PairId) {
+		if includeWinningBid || bid.Bidder != winningBid.Bidder {
+			inputs = append(inputs, banktypes.NewInput(auction.GetPayingReserveAddress(), sdk.NewCoins(bid.Amount)))
+			outputs = append(outputs, banktypes.NewOutput(bid.GetBidder(), sdk.NewCoins(bid.Amount)))
+		}
+		k.DeletePairBid(ctx, bid)
+	}
+
+	if err := k.bankKeeper.InputOutputCoins(ctx, inputs, outputs); err != nil {
+		return err
+	}
+
+	if includeWinningBid {
+		k.DeletePairWinningBid(ctx, auction.Id, auction.PairId)
+	}
+
+	return nil
+}
+
+// payoutPairRewards pays accumulated farming rewards of all pool coins in the basket to the winner for the auction.
+// It harvests farming rewards from the farm module and deducts fees from the sum of rewards from
+// both withdrawn rewards reserve and pair liquid farm reserve accounts by the fee rate.
+func (k Keeper) payoutPairRewards(
+	ctx sdk.Context,
+	pairId uint64,
+	feeRate sdk.Dec,
+	farmingCoins sdk.Coins,
+	winningBid types.Bid,
+) error {
+	reserveAddr := types.PairLiquidFarmReserveAddress(pairId)
+	harvestedRewards := sdk.Coins{}
+	for _, coin := range farmingCoins {
+		withdrawnRewards, err := k.lpfarmKeeper.Harvest(ctx, reserveAddr, coin.Denom)
+		if err != nil {
+			return err
+		}
+		harvestedRewards = harvestedRewards.Add(withdrawnRewards...)
+	}
+
+	withdrawnRewardsReserveAddr := types.PairWithdrawnRewardsReserveAddress(pairId)
+	spendable := k.bankKeeper.SpendableCoins(ctx, withdrawnRewardsReserveAddr)
+	totalRewards := spendable.Add(harvestedRewards...)
+
+	if !totalRewards.IsZero() {
+		deducted, fees := types.DeductFees(totalRewards, feeRate)
+
+		if err := k.bankKeeper.SendCoins(ctx, withdrawnRewardsReserveAddr, reserveAddr, spendable); err != nil {
+			return err
+		}
+
+		if err := k.bankKeeper.SendCoins(ctx, reserveAddr, winningBid.GetBidder(), deducted); err != nil {
+			return err
+		}
+
+		if !fees.IsZero() {
+			feeCollectorAddr, err := sdk.AccAddressFromBech32(k.GetFeeCollector(ctx))
+			if err != nil {
+				return err
+			}
+
+			if err := k.bankKeeper.SendCoins(ctx, reserveAddr, feeCollectorAddr, fees); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,302 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmosquad-labs/squad/v3/x/liquidfarming/types"
+	liquiditytypes "github.com/cosmosquad-labs/squad/v3/x/liquidity/types"
+)
+
+// PairLiquidFarm handles types.MsgPairLiquidFarm to farm.
+// The farming coin can be the pool coin of any pool of the pair and the minting amount of
+// the pair LFCoin is determined by the value of the farming coin in the basket.
+// Values are measured at the TWAP of the pair rather than the last price, so that
+// a farmer can't mint more pair LFCoin than its share by moving the last price.
+func (k Keeper) PairLiquidFarm(ctx sdk.Context, pairId uint64, farmer sdk.AccAddress, farmingCoin sdk.Coin) error {
+	pairLiquidFarm, found := k.GetPairLiquidFarm(ctx, pairId)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pair liquid farm by pair %d not found", pairId)
+	}
+
+	pair, found := k.liquidityKeeper.GetPair(ctx, pairId)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pair %d not found", pairId)
+	}
+
+	poolId, err := liquiditytypes.ParsePoolCoinDenom(farmingCoin.Denom)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	pool, found := k.liquidityKeeper.GetPool(ctx, poolId)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pool %d not found", poolId)
+	}
+	if pool.PairId != pairId {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "pool %d is not in pair %d", poolId, pairId)
+	}
+	if pool.Disabled {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "pool %d is disabled", poolId)
+	}
+
+	if farmingCoin.Amount.LT(pairLiquidFarm.MinFarmAmount) {
+		return sdkerrors.Wrapf(types.ErrSmallerThanMinimumAmount, "%s is smaller than %s", farmingCoin.Amount, pairLiquidFarm.MinFarmAmount)
+	}
+
+	price, err := k.pairPrice(ctx, pair)
+	if err != nil {
+		return err
+	}
+	basket := k.pairBasket(ctx, pair, price, k.pairFarmingCoins(ctx, pairId))
+
+	rx, ry := k.liquidityKeeper.GetPoolBalances(ctx, pool)
+	farmingValue := types.PoolCoinValue(
+		farmingCoin.Amount,
+		k.liquidityKeeper.GetPoolCoinSupply(ctx, pool),
+		rx.Amount,
+		ry.Amount,
+		price,
+	)
+
+	lfCoinDenom := types.PairLiquidFarmCoinDenom(pairId)
+	lfCoinTotalSupplyAmt := k.bankKeeper.GetSupply(ctx, lfCoinDenom).Amount
+	mintingAmt := types.CalculatePairLiquidFarmAmount(
+		lfCoinTotalSupplyAmt,
+		types.TotalFarmingValue(basket),
+		farmingValue,
+	)
+	if !mintingAmt.IsPositive() {
+		return sdkerrors.Wrapf(types.ErrSmallerThanMinimumAmount, "%s is too small to mint %s", farmingCoin, lfCoinDenom)
+	}
+	mintingCoin := sdk.NewCoin(lfCoinDenom, mintingAmt)
+
+	reserveAddr := types.PairLiquidFarmReserveAddress(pairId)
+	if err := k.bankKeeper.SendCoins(ctx, farmer, reserveAddr, sdk.NewCoins(farmingCoin)); err != nil {
+		return err
+	}
+
+	// Mint new pair LFCoin amount and send it to the farmer
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(mintingCoin)); err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, farmer, sdk.NewCoins(mintingCoin)); err != nil {
+		return err
+	}
+
+	// Reserve account farms the farming coin amount for liquid farmer
+	withdrawnRewards, err := k.lpfarmKeeper.Farm(ctx, reserveAddr, farmingCoin)
+	if err != nil {
+		return err
+	}
+
+	// Keep in track of the rewards automatically withdrawn by the farm module
+	if !withdrawnRewards.IsZero() {
+		withdrawnRewardsReserveAddr := types.PairWithdrawnRewardsReserveAddress(pairId)
+		if err := k.bankKeeper.SendCoins(ctx, reserveAddr, withdrawnRewardsReserveAddr, withdrawnRewards); err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePairLiquidFarm,
+			sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(pairId, 10)),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyFarmer, farmer.String()),
+			sdk.NewAttribute(types.AttributeKeyFarmingCoin, farmingCoin.String()),
+			sdk.NewAttribute(types.AttributeKeyMintedCoin, mintingCoin.String()),
+			sdk.NewAttribute(types.AttributeKeyWithdrawnRewards, withdrawnRewards.String()),
+			sdk.NewAttribute(types.AttributeKeyLiquidFarmReserveAddress, reserveAddr.String()),
+		),
+	})
+
+	return nil
+}
+
+// PairLiquidUnfarm handles types.MsgPairLiquidUnfarm to unfarm pair LFCoin.
+// The farmer receives the share of every pool coin in the basket.
+// It doesn't validate if the pair liquid farm exists because farmers still need to be able to
+// unfarm their pair LFCoin in case the pair liquid farm object is removed in params by governance proposal.
+func (k Keeper) PairLiquidUnfarm(ctx sdk.Context, pairId uint64, farmer sdk.AccAddress, unfarmingCoin sdk.Coin) (unfarmedCoins sdk.Coins, err error) {
+	if _, found := k.liquidityKeeper.GetPair(ctx, pairId); !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pair %d not found", pairId)
+	}
+
+	reserveAddr := types.PairLiquidFarmReserveAddress(pairId)
+	lfCoinDenom := types.PairLiquidFarmCoinDenom(pairId)
+	lfCoinTotalSupplyAmt := k.bankKeeper.GetSupply(ctx, lfCoinDenom).Amount
+	if unfarmingCoin.Amount.GT(lfCoinTotalSupplyAmt) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "%s is bigger than the total supply %s", unfarmingCoin.Amount, lfCoinTotalSupplyAmt)
+	}
+
+	var farmingCoins sdk.Coins
+	_, found := k.GetPairLiquidFarm(ctx, pairId)
+	if found {
+		farmingCoins = k.pairFarmingCoins(ctx, pairId)
+	} else {
+		// Handle a case when the pair liquid farm is removed in params
+		// Since the reserve account must have unfarm all farmed coins from the farm module,
+		// the module must use the reserve account balance
+		farmingCoins = k.pairReserveCoins(ctx, pairId)
+	}
+
+	unfarmedCoins = types.CalculatePairLiquidUnfarmCoins(
+		lfCoinTotalSupplyAmt,
+		farmingCoins,
+		unfarmingCoin.Amount,
+	)
+	if unfarmedCoins.IsZero() {
+		return nil, sdkerrors.Wrapf(types.ErrSmallerThanMinimumAmount, "%s is too small to unfarm", unfarmingCoin)
+	}
+
+	withdrawnRewards := sdk.Coins{}
+	if found {
+		// Unfarm the farmed coins in the farm module and release them to the farmer
+		for _, coin := range unfarmedCoins {
+			rewards, err := k.lpfarmKeeper.Unfarm(ctx, reserveAddr, coin)
+			if err != nil {
+				return nil, err
+			}
+			withdrawnRewards = withdrawnRewards.Add(rewards...)
+		}
+	}
+
+	// Keep in track of the rewards automatically withdrawn by the farm module
+	if !withdrawnRewards.IsZero() {
+		withdrawnRewardsReserveAddr := types.PairWithdrawnRewardsReserveAddress(pairId)
+		if err := k.bankKeeper.SendCoins(ctx, reserveAddr, withdrawnRewardsReserveAddr, withdrawnRewards); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, reserveAddr, farmer, unfarmedCoins); err != nil {
+		return nil, err
+	}
+
+	// Burn the pair LFCoin amount
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, farmer, types.ModuleName, sdk.NewCoins(unfarmingCoin)); err != nil {
+		return nil, err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(unfarmingCoin)); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePairLiquidUnfarm,
+			sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(pairId, 10)),
+			sdk.NewAttribute(types.AttributeKeyFarmer, farmer.String()),
+			sdk.NewAttribute(types.AttributeKeyUnfarmingCoin, unfarmingCoin.String()),
+			sdk.NewAttribute(types.AttributeKeyUnfarmedCoins, unfarmedCoins.String()),
+		),
+	})
+
+	return unfarmedCoins, nil
+}
+
+// HandleRemovedPairLiquidFarm unfarms all farmed coins from the farm module to stop having
+// farming rewards accumulated and sends the harvested rewards to the fee collector.
+// It refunds all placed bids and updates an appropriate states.
+func (k Keeper) HandleRemovedPairLiquidFarm(ctx sdk.Context, pairLiquidFarm types.PairLiquidFarm) {
+	feeCollectorAddr, _ := sdk.AccAddressFromBech32(k.GetFeeCollector(ctx))
+	reserveAddr := types.PairLiquidFarmReserveAddress(pairLiquidFarm.PairId)
+	rewardsReserveAddr := types.PairWithdrawnRewardsReserveAddress(pairLiquidFarm.PairId)
+
+	// Unfarm all farmed coins to stop having rewards accumulated in the farm module and
+	// send the farming rewards to the fee collector.
+	for _, coin := range k.pairFarmingCoins(ctx, pairLiquidFarm.PairId) {
+		withdrawnRewards, err := k.lpfarmKeeper.Unfarm(ctx, reserveAddr, coin)
+		if err != nil {
+			panic(err)
+		}
+
+		if !withdrawnRewards.IsZero() {
+			if err := k.bankKeeper.SendCoins(ctx, reserveAddr, feeCollectorAddr, withdrawnRewards); err != nil {
+				panic(err)
+			}
+		}
+	}
+
+	// Send all auto withdrawn rewards by the farm module to the fee collector
+	rewardsReserveBalance := k.bankKeeper.SpendableCoins(ctx, rewardsReserveAddr)
+	if !rewardsReserveBalance.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, rewardsReserveAddr, feeCollectorAddr, rewardsReserveBalance); err != nil {
+			panic(err)
+		}
+	}
+
+	// Finish the ongoing rewards auction by refunding all bids and
+	// set status to AuctionStatusFinished
+	auction, found := k.GetLastPairRewardsAuction(ctx, pairLiquidFarm.PairId)
+	if found && auction.Status == types.AuctionStatusStarted {
+		if err := k.refundAllPairBids(ctx, auction, true); err != nil {
+			panic(err)
+		}
+
+		auction.SetStatus(types.AuctionStatusFinished)
+		k.SetPairRewardsAuction(ctx, auction)
+	}
+
+	k.DeletePairLiquidFarm(ctx, pairLiquidFarm)
+}
+
+// pairFarmingCoins returns the pool coins of the pair farmed in the farm module by the pair liquid farm.
+func (k Keeper) pairFarmingCoins(ctx sdk.Context, pairId uint64) sdk.Coins {
+	reserveAddr := types.PairLiquidFarmReserveAddress(pairId)
+	farmingCoins := sdk.Coins{}
+	for _, pool := range k.liquidityKeeper.GetPoolsByPair(ctx, pairId) {
+		position, found := k.lpfarmKeeper.GetPosition(ctx, reserveAddr, liquiditytypes.PoolCoinDenom(pool.Id))
+		if found {
+			farmingCoins = farmingCoins.Add(sdk.NewCoin(position.Denom, position.FarmingAmount))
+		}
+	}
+	return farmingCoins
+}
+
+// pairReserveCoins returns the pool coins of the pair held by the reserve account of the pair liquid farm.
+func (k Keeper) pairReserveCoins(ctx sdk.Context, pairId uint64) sdk.Coins {
+	spendable := k.bankKeeper.SpendableCoins(ctx, types.PairLiquidFarmReserveAddress(pairId))
+	reserveCoins := sdk.Coins{}
+	for _, pool := range k.liquidityKeeper.GetPoolsByPair(ctx, pairId) {
+		poolCoinDenom := liquiditytypes.PoolCoinDenom(pool.Id)
+		reserveCoins = reserveCoins.Add(sdk.NewCoin(poolCoinDenom, spendable.AmountOf(poolCoinDenom)))
+	}
+	return reserveCoins
+}
+
+// pairPrice returns the TWAP of the pair during the pair price window,
+// which is used to value the pool coins of the pair.
+func (k Keeper) pairPrice(ctx sdk.Context, pair liquiditytypes.Pair) (sdk.Dec, error) {
+	if pair.LastPrice == nil {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrPairLastPriceNotSet, "pair %d", pair.Id)
+	}
+	return k.liquidityKeeper.GetTWAP(ctx, pair.Id, k.GetPairPriceWindow(ctx))
+}
+
+// pairBasket returns the values of the pools of the pair and the values of the given
+// farming coins in the pools, which are measured in the quote coin at the given price.
+func (k Keeper) pairBasket(ctx sdk.Context, pair liquiditytypes.Pair, price sdk.Dec, farmingCoins sdk.Coins) []types.BasketPool {
+	basket := []types.BasketPool{}
+	for _, pool := range k.liquidityKeeper.GetPoolsByPair(ctx, pair.Id) {
+		rx, ry := k.liquidityKeeper.GetPoolBalances(ctx, pool)
+		poolCoinSupply := k.liquidityKeeper.GetPoolCoinSupply(ctx, pool)
+		farmingAmt := farmingCoins.AmountOf(liquiditytypes.PoolCoinDenom(pool.Id))
+
+		// A disabled pool still counts for the value of the basket but
+		// it never becomes the pool to compound rewards into
+		poolValue := sdk.ZeroDec()
+		if !pool.Disabled {
+			poolValue = types.PoolCoinValue(poolCoinSupply, poolCoinSupply, rx.Amount, ry.Amount, price)
+		}
+
+		basket = append(basket, types.BasketPool{
+			PoolId:       pool.Id,
+			PoolValue:    poolValue,
+			FarmingValue: types.PoolCoinValue(farmingAmt, poolCoinSupply, rx.Amount, ry.Amount, price),
+		})
+	}
+	return basket
+}
@@ -0,0 +1,281 @@
+package keeper_test
+
+import (
+	_ "github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/cosmosquad-labs/squad/v3/types"
+	"github.com/cosmosquad-labs/squad/v3/x/liquidfarming"
+	"github.com/cosmosquad-labs/squad/v3/x/liquidfarming/types"
+	liquiditytypes "github.com/cosmosquad-labs/squad/v3/x/liquidity/types"
+	lpfarmtypes "github.com/cosmosquad-labs/squad/v3/x/lpfarm/types"
+)
+
+func (s *KeeperTestSuite) TestPairLiquidFarm_Validation() {
+	err := s.keeper.PairLiquidFarm(s.ctx, 1, s.addr(0), utils.ParseCoin("100_000_000pool1"))
+	s.Require().EqualError(err, "pair liquid farm by pair 1 not found: not found")
+
+	pair := s.createPair(helperAddr, "denom1", "denom2")
+	s.createPool(helperAddr, pair.Id, utils.ParseCoins("100_000_000denom1, 100_000_000denom2"))
+	otherPair := s.createPairWithLastPrice(helperAddr, "denom3", "denom4", sdk.NewDec(1))
+	s.createPool(helperAddr, otherPair.Id, utils.ParseCoins("100_000_000denom3, 100_000_000denom4"))
+	s.createPairLiquidFarm(pair.Id, sdk.NewInt(100_000_000), sdk.ZeroInt(), sdk.ZeroDec())
+
+	err = s.keeper.PairLiquidFarm(s.ctx, pair.Id, helperAddr, utils.ParseCoin("1_000_000_000pool1"))
+	s.Require().EqualError(err, "pair 1: pair has no last price")
+
+	pair.LastPrice = utils.ParseDecP("1.0")
+	s.app.LiquidityKeeper.SetPair(s.ctx, pair)
+
+	// The pair has a last price but not enough price history for TWAP
+	err = s.keeper.PairLiquidFarm(s.ctx, pair.Id, helperAddr, utils.ParseCoin("1_000_000_000pool1"))
+	s.Require().ErrorIs(err, liquiditytypes.ErrInsufficientPriceHistory)
+
+	s.setPairLastPrice(&pair, sdk.NewDec(1))
+
+	for _, tc := range []struct {
+		name        string
+		farmer      sdk.AccAddress
+		farmingCoin sdk.Coin
+		expectedErr string
+	}{
+		{
+			"happy case",
+			helperAddr,
+			utils.ParseCoin("1_000_000_000pool1"),
+			"",
+		},
+		{
+			"pool not found",
+			helperAddr,
+			utils.ParseCoin("1_000_000_000pool3"),
+			"pool 3 not found: not found",
+		},
+		{
+			"pool of another pair",
+			helperAddr,
+			utils.ParseCoin("1_000_000_000pool2"),
+			"pool 2 is not in pair 1: invalid request",
+		},
+		{
+			"minimum farm amount",
+			helperAddr,
+			utils.ParseCoin("100pool1"),
+			"100 is smaller than 100000000: smaller than the minimum amount",
+		},
+		{
+			"insufficient funds",
+			s.addr(5),
+			utils.ParseCoin("500_000_000pool1"),
+			"0pool1 is smaller than 500000000pool1: insufficient funds",
+		},
+	} {
+		s.Run(tc.name, func() {
+			cacheCtx, _ := s.ctx.CacheContext()
+			err := s.keeper.PairLiquidFarm(cacheCtx, pair.Id, tc.farmer, tc.farmingCoin)
+			if tc.expectedErr == "" {
+				s.Require().NoError(err)
+			} else {
+				s.Require().EqualError(err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestPairLiquidFarm() {
+	pair := s.createPairWithLastPrice(helperAddr, "denom1", "denom2", sdk.NewDec(1))
+	pool1 := s.createPool(helperAddr, pair.Id, utils.ParseCoins("100_000_000denom1, 100_000_000denom2"))
+	pool2 := s.createRangedPool(
+		helperAddr, pair.Id, utils.ParseCoins("100_000_000denom1, 100_000_000denom2"),
+		utils.ParseDec("0.5"), utils.ParseDec("2.0"), utils.ParseDec("1.0"))
+	s.createPairLiquidFarm(pair.Id, sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroDec())
+
+	lfCoinDenom := types.PairLiquidFarmCoinDenom(pair.Id)
+	reserveAddr := types.PairLiquidFarmReserveAddress(pair.Id)
+
+	// The pool coins are sent from the pool creator so that the pool coin supply stays the same.
+	s.Require().NoError(s.app.BankKeeper.SendCoins(s.ctx, helperAddr, s.addr(1), utils.ParseCoins("10_000_000_000pool1")))
+	s.Require().NoError(s.app.BankKeeper.SendCoins(s.ctx, helperAddr, s.addr(2), utils.ParseCoins("10_000_000_000pool2")))
+
+	// Initial minting amount equals to the value of the farming coin.
+	// 200_000_000 * 10_000_000_000 / 1_000_000_000_000 = 2_000_000
+	s.pairLiquidFarm(pair.Id, s.addr(1), utils.ParseCoin("10_000_000_000pool1"), false)
+	s.Require().Equal(sdk.NewInt(2_000_000), s.getBalance(s.addr(1), lfCoinDenom).Amount)
+
+	position, found := s.app.LPFarmKeeper.GetPosition(s.ctx, reserveAddr, pool1.PoolCoinDenom)
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewInt(10_000_000_000), position.FarmingAmount)
+
+	// Farming the pool coin of the other pool of the pair mints by its value.
+	rx, ry := s.app.LiquidityKeeper.GetPoolBalances(s.ctx, pool2)
+	poolCoinSupply := s.app.LiquidityKeeper.GetPoolCoinSupply(s.ctx, pool2)
+	value := types.PoolCoinValue(sdk.NewInt(10_000_000_000), poolCoinSupply, rx.Amount, ry.Amount, sdk.NewDec(1))
+	s.pairLiquidFarm(pair.Id, s.addr(2), utils.ParseCoin("10_000_000_000pool2"), false)
+	s.Require().Equal(value.TruncateInt(), s.getBalance(s.addr(2), lfCoinDenom).Amount)
+
+	position, found = s.app.LPFarmKeeper.GetPosition(s.ctx, reserveAddr, pool2.PoolCoinDenom)
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewInt(10_000_000_000), position.FarmingAmount)
+
+	// Unfarming returns the share of every pool coin in the basket.
+	_, err := s.keeper.PairLiquidUnfarm(s.ctx, pair.Id, s.addr(1), sdk.NewInt64Coin(lfCoinDenom, 1_000_000))
+	s.Require().NoError(err)
+
+	lfCoinSupply := value.TruncateInt().Add(sdk.NewInt(2_000_000))
+	expectedAmt := sdk.NewInt(10_000_000_000).MulRaw(1_000_000).Quo(lfCoinSupply)
+	s.Require().Equal(expectedAmt, s.getBalance(s.addr(1), pool1.PoolCoinDenom).Amount)
+	s.Require().Equal(expectedAmt, s.getBalance(s.addr(1), pool2.PoolCoinDenom).Amount)
+	s.Require().Equal(sdk.NewInt(1_000_000), s.getBalance(s.addr(1), lfCoinDenom).Amount)
+
+	_, err = s.keeper.PairLiquidUnfarm(s.ctx, pair.Id, s.addr(1), sdk.NewInt64Coin(lfCoinDenom, 1_000_000_000))
+	s.Require().EqualError(err, "1000000000 is bigger than the total supply "+lfCoinSupply.SubRaw(1_000_000).String()+": insufficient funds")
+}
+
+func (s *KeeperTestSuite) TestPairLiquidFarm_LastPriceMoved() {
+	pair := s.createPairWithLastPrice(helperAddr, "denom1", "denom2", sdk.NewDec(1))
+	s.createPool(helperAddr, pair.Id, utils.ParseCoins("100_000_000denom1, 100_000_000denom2"))
+	pool2 := s.createRangedPool(
+		helperAddr, pair.Id, utils.ParseCoins("100_000_000denom1, 100_000_000denom2"),
+		utils.ParseDec("0.5"), utils.ParseDec("2.0"), utils.ParseDec("1.0"))
+	s.createPairLiquidFarm(pair.Id, sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroDec())
+
+	lfCoinDenom := types.PairLiquidFarmCoinDenom(pair.Id)
+	s.Require().NoError(s.app.BankKeeper.SendCoins(s.ctx, helperAddr, s.addr(1), utils.ParseCoins("10_000_000_000pool1")))
+	s.Require().NoError(s.app.BankKeeper.SendCoins(s.ctx, helperAddr, s.addr(2), utils.ParseCoins("10_000_000_000pool2")))
+	s.pairLiquidFarm(pair.Id, s.addr(1), utils.ParseCoin("10_000_000_000pool1"), false)
+
+	// The last price is moved within the current block, which must not affect
+	// the value of the farming coin since it is measured at the TWAP.
+	lastPrice := utils.ParseDec("1.9")
+	pair.LastPrice = &lastPrice
+	s.app.LiquidityKeeper.SetPair(s.ctx, pair)
+	s.app.LiquidityKeeper.UpdatePriceAccumulator(s.ctx, pair)
+
+	rx, ry := s.app.LiquidityKeeper.GetPoolBalances(s.ctx, pool2)
+	poolCoinSupply := s.app.LiquidityKeeper.GetPoolCoinSupply(s.ctx, pool2)
+	value := types.PoolCoinValue(sdk.NewInt(10_000_000_000), poolCoinSupply, rx.Amount, ry.Amount, sdk.NewDec(1))
+	s.pairLiquidFarm(pair.Id, s.addr(2), utils.ParseCoin("10_000_000_000pool2"), false)
+	s.Require().Equal(value.TruncateInt(), s.getBalance(s.addr(2), lfCoinDenom).Amount)
+}
+
+func (s *KeeperTestSuite) TestPairRewardsAuction() {
+	pair := s.createPairWithLastPrice(helperAddr, "denom1", "denom2", sdk.NewDec(1))
+	pool1 := s.createPool(helperAddr, pair.Id, utils.ParseCoins("100_000_000denom1, 100_000_000denom2"))
+	pool2 := s.createRangedPool(
+		helperAddr, pair.Id, utils.ParseCoins("100_000_000denom1, 100_000_000denom2"),
+		utils.ParseDec("0.5"), utils.ParseDec("2.0"), utils.ParseDec("1.0"))
+	plan := s.createPrivatePlan(s.addr(0), []lpfarmtypes.RewardAllocation{
+		{
+			PairId:        pair.Id,
+			RewardsPerDay: utils.ParseCoins("100_000_000stake"),
+		},
+	})
+	s.fundAddr(plan.GetFarmingPoolAddress(), utils.ParseCoins("100_000_000stake"))
+
+	s.createPairLiquidFarm(pair.Id, sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroDec())
+
+	s.Require().NoError(s.app.BankKeeper.SendCoins(s.ctx, helperAddr, s.addr(1), utils.ParseCoins("10_000_000_000pool1")))
+	s.pairLiquidFarm(pair.Id, s.addr(1), utils.ParseCoin("10_000_000_000pool1"), false)
+	s.nextBlock()
+
+	s.nextAuction()
+
+	// Only the pool coin of pool 1 is farmed, so pool 2 is the most underweight one
+	auction, found := s.keeper.GetLastPairRewardsAuction(s.ctx, pair.Id)
+	s.Require().True(found)
+	s.Require().Equal(types.AuctionStatusStarted, auction.Status)
+	s.Require().Equal(pool2.Id, auction.PoolId)
+	s.Require().Equal(pool2.PoolCoinDenom, auction.BiddingCoinDenom)
+
+	_, err := s.keeper.PlacePairBid(s.ctx, auction.Id, pair.Id, s.addr(2), sdk.NewInt64Coin(pool1.PoolCoinDenom, 10_000_000))
+	s.Require().EqualError(err, "expected denom pool2, but got pool1: invalid request")
+
+	s.Require().NoError(s.app.BankKeeper.SendCoins(s.ctx, helperAddr, s.addr(2), utils.ParseCoins("10_000_000pool2")))
+	s.Require().NoError(s.app.BankKeeper.SendCoins(s.ctx, helperAddr, s.addr(3), utils.ParseCoins("20_000_000pool2")))
+	s.placePairBid(auction.Id, pair.Id, s.addr(2), sdk.NewInt64Coin(pool2.PoolCoinDenom, 10_000_000), false)
+	s.placePairBid(auction.Id, pair.Id, s.addr(3), sdk.NewInt64Coin(pool2.PoolCoinDenom, 20_000_000), false)
+
+	_, err = s.keeper.PlacePairBid(s.ctx, auction.Id, pair.Id, s.addr(4), sdk.NewInt64Coin(pool2.PoolCoinDenom, 20_000_000))
+	s.Require().EqualError(err, "20000000 is not bigger than 20000000: not bigger than the winning bid amount")
+	s.nextBlock()
+
+	s.nextAuction()
+
+	auction, found = s.keeper.GetPairRewardsAuction(s.ctx, auction.Id, pair.Id)
+	s.Require().True(found)
+	s.Require().Equal(types.AuctionStatusFinished, auction.Status)
+	s.Require().Equal(s.addr(3).String(), auction.Winner)
+	s.Require().Len(s.keeper.GetAllPairRewardsAuctions(s.ctx), 2)
+
+	// Ensure that the losing bidder got their pool coin back and the winner received farming rewards
+	s.Require().Equal(sdk.NewInt(10_000_000), s.getBalance(s.addr(2), pool2.PoolCoinDenom).Amount)
+	s.Require().True(s.getBalance(s.addr(3), "stake").Amount.IsPositive())
+
+	// Ensure that the winning amount is compounded into the pool of the bidding coin
+	reserveAddr := types.PairLiquidFarmReserveAddress(pair.Id)
+	position, found := s.app.LPFarmKeeper.GetPosition(s.ctx, reserveAddr, pool2.PoolCoinDenom)
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewInt(20_000_000), position.FarmingAmount)
+}
+
+func (s *KeeperTestSuite) TestPairRewardsAuction_NoBid() {
+	pair := s.createPairWithLastPrice(helperAddr, "denom1", "denom2", sdk.NewDec(1))
+	s.createPool(helperAddr, pair.Id, utils.ParseCoins("100_000_000denom1, 100_000_000denom2"))
+	s.createPairLiquidFarm(pair.Id, sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroDec())
+	s.nextBlock()
+
+	s.nextAuction()
+
+	auction, found := s.keeper.GetLastPairRewardsAuction(s.ctx, pair.Id)
+	s.Require().True(found)
+	s.Require().Equal(types.AuctionStatusStarted, auction.Status)
+
+	s.nextAuction()
+
+	auction, found = s.keeper.GetPairRewardsAuction(s.ctx, auction.Id, pair.Id)
+	s.Require().True(found)
+	s.Require().Equal(types.AuctionStatusSkipped, auction.Status)
+}
+
+func (s *KeeperTestSuite) TestHandleRemovedPairLiquidFarm() {
+	pair := s.createPairWithLastPrice(helperAddr, "denom1", "denom2", sdk.NewDec(1))
+	pool := s.createPool(helperAddr, pair.Id, utils.ParseCoins("100_000_000denom1, 100_000_000denom2"))
+	s.createPairLiquidFarm(pair.Id, sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroDec())
+
+	s.Require().NoError(s.app.BankKeeper.SendCoins(s.ctx, helperAddr, s.addr(1), utils.ParseCoins("10_000_000_000pool1")))
+	s.pairLiquidFarm(pair.Id, s.addr(1), utils.ParseCoin("10_000_000_000pool1"), false)
+	s.nextBlock()
+
+	s.nextAuction()
+
+	auction, found := s.keeper.GetLastPairRewardsAuction(s.ctx, pair.Id)
+	s.Require().True(found)
+	s.Require().NoError(s.app.BankKeeper.SendCoins(s.ctx, helperAddr, s.addr(2), utils.ParseCoins("10_000_000pool1")))
+	s.placePairBid(auction.Id, pair.Id, s.addr(2), sdk.NewInt64Coin(pool.PoolCoinDenom, 10_000_000), false)
+
+	// Remove the pair liquid farm from params
+	params := s.keeper.GetParams(s.ctx)
+	params.PairLiquidFarms = []types.PairLiquidFarm{}
+	s.keeper.SetParams(s.ctx, params)
+	liquidfarming.BeginBlocker(s.ctx, s.keeper)
+
+	_, found = s.keeper.GetPairLiquidFarm(s.ctx, pair.Id)
+	s.Require().False(found)
+
+	auction, found = s.keeper.GetPairRewardsAuction(s.ctx, auction.Id, pair.Id)
+	s.Require().True(found)
+	s.Require().Equal(types.AuctionStatusFinished, auction.Status)
+	s.Require().Equal(sdk.NewInt(10_000_000), s.getBalance(s.addr(2), pool.PoolCoinDenom).Amount)
+
+	reserveAddr := types.PairLiquidFarmReserveAddress(pair.Id)
+	_, found = s.app.LPFarmKeeper.GetPosition(s.ctx, reserveAddr, pool.PoolCoinDenom)
+	s.Require().False(found)
+
+	// Farmers are still able to unfarm from the reserve account balance
+	lfCoinDenom := types.PairLiquidFarmCoinDenom(pair.Id)
+	unfarmedCoins, err := s.keeper.PairLiquidUnfarm(s.ctx, pair.Id, s.addr(1), s.getBalance(s.addr(1), lfCoinDenom))
+	s.Require().NoError(err)
+	s.Require().Equal(utils.ParseCoins("10_000_000_000pool1"), unfarmedCoins)
+	s.Require().True(s.getBalance(s.addr(1), lfCoinDenom).IsZero())
+}
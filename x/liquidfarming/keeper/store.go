@@ -213,6 +213,273 @@ func (k Keeper) DeleteWinningBid(ctx sdk.Context, auctionId, poolId uint64) {
 	store.Delete(types.GetWinningBidKey(auctionId, poolId))
 }
 
+// GetLastLeadingBid returns the last leading bid of the auction.
+func (k Keeper) GetLastLeadingBid(ctx sdk.Context, auctionId, poolId uint64) (leadingBid types.LeadingBid, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStoreReversePrefixIterator(store, types.GetLeadingBidsByAuctionPrefix(auctionId, poolId))
+	defer iter.Close()
+	if !iter.Valid() {
+		return leadingBid, false
+	}
+	k.cdc.MustUnmarshal(iter.Value(), &leadingBid)
+	return leadingBid, true
+}
+
+// SetLeadingBid stores the leading bid.
+func (k Keeper) SetLeadingBid(ctx sdk.Context, leadingBid types.LeadingBid) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&leadingBid)
+	store.Set(types.GetLeadingBidKey(leadingBid.AuctionId, leadingBid.Bid.PoolId, leadingBid.Sequence), bz)
+}
+
+// DeleteLeadingBid deletes the leading bid from the store.
+func (k Keeper) DeleteLeadingBid(ctx sdk.Context, leadingBid types.LeadingBid) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetLeadingBidKey(leadingBid.AuctionId, leadingBid.Bid.PoolId, leadingBid.Sequence))
+}
+
+// GetLeadingBidsByAuction returns all leading bids of the auction in the order of sequence.
+func (k Keeper) GetLeadingBidsByAuction(ctx sdk.Context, auctionId, poolId uint64) []types.LeadingBid {
+	leadingBids := []types.LeadingBid{}
+	k.IterateLeadingBids(ctx, types.GetLeadingBidsByAuctionPrefix(auctionId, poolId), func(leadingBid types.LeadingBid) (stop bool) {
+		leadingBids = append(leadingBids, leadingBid)
+		return false
+	})
+	return leadingBids
+}
+
+// GetAllLeadingBids returns all leading bids in the store.
+func (k Keeper) GetAllLeadingBids(ctx sdk.Context) []types.LeadingBid {
+	leadingBids := []types.LeadingBid{}
+	k.IterateLeadingBids(ctx, types.LeadingBidKeyPrefix, func(leadingBid types.LeadingBid) (stop bool) {
+		leadingBids = append(leadingBids, leadingBid)
+		return false
+	})
+	return leadingBids
+}
+
+// GetPairLiquidFarm returns pair liquid farm object by the given pair id.
+func (k Keeper) GetPairLiquidFarm(ctx sdk.Context, pairId uint64) (pairLiquidFarm types.PairLiquidFarm, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPairLiquidFarmKey(pairId))
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshal(bz, &pairLiquidFarm)
+	found = true
+	return
+}
+
+// GetPairLiquidFarmsInStore returns all pair liquid farm objects stored in the store.
+func (k Keeper) GetPairLiquidFarmsInStore(ctx sdk.Context) (pairLiquidFarms []types.PairLiquidFarm) {
+	pairLiquidFarms = []types.PairLiquidFarm{}
+	k.IteratePairLiquidFarms(ctx, func(pairLiquidFarm types.PairLiquidFarm) (stop bool) {
+		pairLiquidFarms = append(pairLiquidFarms, pairLiquidFarm)
+		return false
+	})
+	return pairLiquidFarms
+}
+
+// SetPairLiquidFarm stores pair liquid farm object with the given pair id.
+func (k Keeper) SetPairLiquidFarm(ctx sdk.Context, pairLiquidFarm types.PairLiquidFarm) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&pairLiquidFarm)
+	store.Set(types.GetPairLiquidFarmKey(pairLiquidFarm.PairId), bz)
+}
+
+// DeletePairLiquidFarm deletes the pair liquid farm object from the store.
+func (k Keeper) DeletePairLiquidFarm(ctx sdk.Context, pairLiquidFarm types.PairLiquidFarm) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPairLiquidFarmKey(pairLiquidFarm.PairId))
+}
+
+// GetLastPairRewardsAuctionId returns the last rewards auction id of the pair liquid farm.
+func (k Keeper) GetLastPairRewardsAuctionId(ctx sdk.Context, pairId uint64) uint64 {
+	var id uint64
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetLastPairRewardsAuctionIdKey(pairId))
+	if bz == nil {
+		id = 0 // initialize the auction id
+	} else {
+		val := gogotypes.UInt64Value{}
+		k.cdc.MustUnmarshal(bz, &val)
+		id = val.GetValue()
+	}
+	return id
+}
+
+// SetLastPairRewardsAuctionId stores the last rewards auction id of the pair liquid farm.
+func (k Keeper) SetLastPairRewardsAuctionId(ctx sdk.Context, auctionId uint64, pairId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: auctionId})
+	store.Set(types.GetLastPairRewardsAuctionIdKey(pairId), bz)
+}
+
+// GetLastPairRewardsAuction is a convenient method to look up last rewards auction id of the pair liquid farm
+// and returns the reward auction object.
+func (k Keeper) GetLastPairRewardsAuction(ctx sdk.Context, pairId uint64) (auction types.RewardsAuction, found bool) {
+	return k.GetPairRewardsAuction(ctx, k.GetLastPairRewardsAuctionId(ctx, pairId), pairId)
+}
+
+// GetPairRewardsAuction returns the reward auction object of the pair liquid farm
+// by the given auction id and pair id.
+func (k Keeper) GetPairRewardsAuction(ctx sdk.Context, auctionId uint64, pairId uint64) (auction types.RewardsAuction, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPairRewardsAuctionKey(auctionId, pairId))
+	if bz == nil {
+		return auction, false
+	}
+	auction = types.MustUnmarshalRewardsAuction(k.cdc, bz)
+	return auction, true
+}
+
+// GetAllPairRewardsAuctions returns all rewards auctions of the pair liquid farms in the store.
+func (k Keeper) GetAllPairRewardsAuctions(ctx sdk.Context) (auctions []types.RewardsAuction) {
+	auctions = []types.RewardsAuction{}
+	k.IteratePairRewardsAuctions(ctx, func(auction types.RewardsAuction) (stop bool) {
+		auctions = append(auctions, auction)
+		return false
+	})
+	return auctions
+}
+
+// SetPairRewardsAuction stores rewards auction of the pair liquid farm.
+func (k Keeper) SetPairRewardsAuction(ctx sdk.Context, auction types.RewardsAuction) {
+	store := ctx.KVStore(k.storeKey)
+	bz := types.MustMarshalRewardsAuction(k.cdc, auction)
+	store.Set(types.GetPairRewardsAuctionKey(auction.Id, auction.PairId), bz)
+}
+
+// GetPairBid returns the bid object for the rewards auction of the pair liquid farm
+// by the given pair id and bidder address.
+func (k Keeper) GetPairBid(ctx sdk.Context, pairId uint64, bidder sdk.AccAddress) (bid types.Bid, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPairBidKey(pairId, bidder))
+	if bz == nil {
+		return bid, false
+	}
+	k.cdc.MustUnmarshal(bz, &bid)
+	return bid, true
+}
+
+// SetPairBid stores a bid object for the rewards auction of the pair liquid farm.
+func (k Keeper) SetPairBid(ctx sdk.Context, bid types.Bid) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&bid)
+	store.Set(types.GetPairBidKey(bid.PairId, bid.GetBidder()), bz)
+}
+
+// DeletePairBid deletes the bid object for the rewards auction of the pair liquid farm.
+func (k Keeper) DeletePairBid(ctx sdk.Context, bid types.Bid) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPairBidKey(bid.PairId, bid.GetBidder()))
+}
+
+// GetPairBidsByPairId returns all bid objects for the rewards auction of the pair liquid farm by the pair id.
+func (k Keeper) GetPairBidsByPairId(ctx sdk.Context, pairId uint64) []types.Bid {
+	bids := []types.Bid{}
+	k.IteratePairBidsByPairId(ctx, pairId, func(bid types.Bid) (stop bool) {
+		bids = append(bids, bid)
+		return false
+	})
+	return bids
+}
+
+// GetPairWinningBid returns the winning bid object for the rewards auction of the pair liquid farm
+// by the given auction id and pair id.
+func (k Keeper) GetPairWinningBid(ctx sdk.Context, auctionId uint64, pairId uint64) (bid types.Bid, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPairWinningBidKey(auctionId, pairId))
+	if bz == nil {
+		return bid, false
+	}
+	k.cdc.MustUnmarshal(bz, &bid)
+	return bid, true
+}
+
+// SetPairWinningBid stores the winning bid for the rewards auction of the pair liquid farm with the auction id.
+func (k Keeper) SetPairWinningBid(ctx sdk.Context, auctionId uint64, bid types.Bid) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&bid)
+	store.Set(types.GetPairWinningBidKey(auctionId, bid.PairId), bz)
+}
+
+// DeletePairWinningBid deletes the winning bid for the rewards auction of the pair liquid farm from the store.
+func (k Keeper) DeletePairWinningBid(ctx sdk.Context, auctionId, pairId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPairWinningBidKey(auctionId, pairId))
+}
+
+// GetVault returns vault object by the given pool id.
+func (k Keeper) GetVault(ctx sdk.Context, poolId uint64) (vault types.Vault, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetVaultKey(poolId))
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshal(bz, &vault)
+	found = true
+	return
+}
+
+// GetVaultsInStore returns all vault objects stored in the store.
+func (k Keeper) GetVaultsInStore(ctx sdk.Context) (vaults []types.Vault) {
+	vaults = []types.Vault{}
+	k.IterateVaults(ctx, func(vault types.Vault) (stop bool) {
+		vaults = append(vaults, vault)
+		return false
+	})
+	return vaults
+}
+
+// SetVault stores vault object with the given pool id.
+func (k Keeper) SetVault(ctx sdk.Context, vault types.Vault) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&vault)
+	store.Set(types.GetVaultKey(vault.PoolId), bz)
+}
+
+// DeleteVault deletes the vault object from the store.
+func (k Keeper) DeleteVault(ctx sdk.Context, vault types.Vault) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetVaultKey(vault.PoolId))
+}
+
+// GetVaultLastCompoundedAt returns the last time the vault compounded.
+func (k Keeper) GetVaultLastCompoundedAt(ctx sdk.Context, poolId uint64) (t time.Time, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetVaultLastCompoundedAtKey(poolId))
+	if bz == nil {
+		return
+	}
+	var ts gogotypes.Timestamp
+	k.cdc.MustUnmarshal(bz, &ts)
+	var err error
+	t, err = gogotypes.TimestampFromProto(&ts)
+	if err != nil {
+		panic(err)
+	}
+	found = true
+	return
+}
+
+// SetVaultLastCompoundedAt stores the last time the vault compounded.
+func (k Keeper) SetVaultLastCompoundedAt(ctx sdk.Context, poolId uint64, t time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	ts, err := gogotypes.TimestampProto(t)
+	if err != nil {
+		panic(err)
+	}
+	bz := k.cdc.MustMarshal(ts)
+	store.Set(types.GetVaultLastCompoundedAtKey(poolId), bz)
+}
+
+// DeleteVaultLastCompoundedAt deletes the last time the vault compounded from the store.
+func (k Keeper) DeleteVaultLastCompoundedAt(ctx sdk.Context, poolId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetVaultLastCompoundedAtKey(poolId))
+}
+
 // IterateLiquidFarms iterates through all liquid farm objects
 // stored in the store and invokes callback function for each item.
 // Stops the iteration when the callback function for each time.
@@ -258,3 +525,82 @@ func (k Keeper) IterateBidsByPoolId(ctx sdk.Context, poolId uint64, cb func(bid
 		}
 	}
 }
+
+// IterateLeadingBids iterates through all leading bids with the given prefix and
+// invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IterateLeadingBids(ctx sdk.Context, prefix []byte, cb func(leadingBid types.LeadingBid) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var leadingBid types.LeadingBid
+		k.cdc.MustUnmarshal(iter.Value(), &leadingBid)
+		if cb(leadingBid) {
+			break
+		}
+	}
+}
+
+// IteratePairLiquidFarms iterates through all pair liquid farm objects
+// stored in the store and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IteratePairLiquidFarms(ctx sdk.Context, cb func(pairLiquidFarm types.PairLiquidFarm) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.PairLiquidFarmKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var pairLiquidFarm types.PairLiquidFarm
+		k.cdc.MustUnmarshal(iter.Value(), &pairLiquidFarm)
+		if cb(pairLiquidFarm) {
+			break
+		}
+	}
+}
+
+// IteratePairRewardsAuctions iterates over all the stored auctions of the pair liquid farms
+// and performs a callback function.
+// Stops iteration when callback returns true.
+func (k Keeper) IteratePairRewardsAuctions(ctx sdk.Context, cb func(auction types.RewardsAuction) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PairRewardsAuctionKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		auction := types.MustUnmarshalRewardsAuction(k.cdc, iterator.Value())
+		if cb(auction) {
+			break
+		}
+	}
+}
+
+// IteratePairBidsByPairId iterates through all bids for the rewards auction of the pair liquid farm
+// by pair id stored in the store and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IteratePairBidsByPairId(ctx sdk.Context, pairId uint64, cb func(bid types.Bid) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetPairBidByPairIdPrefix(pairId))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var bid types.Bid
+		k.cdc.MustUnmarshal(iter.Value(), &bid)
+		if cb(bid) {
+			break
+		}
+	}
+}
+
+// IterateVaults iterates through all vault objects
+// stored in the store and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IterateVaults(ctx sdk.Context, cb func(vault types.Vault) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.VaultKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var vault types.Vault
+		k.cdc.MustUnmarshal(iter.Value(), &vault)
+		if cb(vault) {
+			break
+		}
+	}
+}
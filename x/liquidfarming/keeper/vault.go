@@ -0,0 +1,423 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmosquad-labs/squad/v3/x/liquidfarming/types"
+	liquiditytypes "github.com/cosmosquad-labs/squad/v3/x/liquidity/types"
+)
+
+// VaultFarm handles types.MsgVaultFarm to farm the pool coin in the vault.
+// The farmer receives the vault coin that represents the share of the vault.
+// It is rejected while the deposit or the orders placed by the compounding of the vault
+// are not executed, since the coins escrowed by them aren't counted in the share.
+func (k Keeper) VaultFarm(ctx sdk.Context, poolId uint64, farmer sdk.AccAddress, farmingCoin sdk.Coin) error {
+	vault, found := k.GetVault(ctx, poolId)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "vault by pool %d not found", poolId)
+	}
+
+	pool, found := k.liquidityKeeper.GetPool(ctx, poolId)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pool %d not found", poolId)
+	}
+	if pool.Disabled {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "pool %d is disabled", poolId)
+	}
+
+	poolCoinDenom := liquiditytypes.PoolCoinDenom(poolId)
+	if farmingCoin.Denom != poolCoinDenom {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "expected denom %s, but got %s", poolCoinDenom, farmingCoin.Denom)
+	}
+
+	if farmingCoin.Amount.LT(vault.MinFarmAmount) {
+		return sdkerrors.Wrapf(types.ErrSmallerThanMinimumAmount, "%s is smaller than %s", farmingCoin.Amount, vault.MinFarmAmount)
+	}
+
+	if k.vaultCompoundingPending(ctx, poolId) {
+		return sdkerrors.Wrapf(types.ErrVaultCompounding, "vault by pool %d", poolId)
+	}
+
+	reserveAddr := types.VaultReserveAddress(poolId)
+	vaultCoinDenom := types.VaultCoinDenom(poolId)
+	vaultCoinTotalSupplyAmt := k.bankKeeper.GetSupply(ctx, vaultCoinDenom).Amount
+	mintingAmt := types.CalculateLiquidFarmAmount(
+		vaultCoinTotalSupplyAmt,
+		k.vaultTotalFarmingAmount(ctx, poolId),
+		farmingCoin.Amount,
+	)
+	if !mintingAmt.IsPositive() {
+		return sdkerrors.Wrapf(types.ErrSmallerThanMinimumAmount, "%s is too small to mint %s", farmingCoin, vaultCoinDenom)
+	}
+	mintingCoin := sdk.NewCoin(vaultCoinDenom, mintingAmt)
+
+	if err := k.bankKeeper.SendCoins(ctx, farmer, reserveAddr, sdk.NewCoins(farmingCoin)); err != nil {
+		return err
+	}
+
+	// Mint new vault coin amount and send it to the farmer
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(mintingCoin)); err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, farmer, sdk.NewCoins(mintingCoin)); err != nil {
+		return err
+	}
+
+	// Reserve account farms the farming coin amount for the farmer.
+	// The rewards automatically withdrawn by the farm module stay in the reserve account
+	// and they are compounded in the next compounding.
+	if _, err := k.lpfarmKeeper.Farm(ctx, reserveAddr, farmingCoin); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeVaultFarm,
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyFarmer, farmer.String()),
+			sdk.NewAttribute(types.AttributeKeyFarmingCoin, farmingCoin.String()),
+			sdk.NewAttribute(types.AttributeKeyMintedCoin, mintingCoin.String()),
+			sdk.NewAttribute(types.AttributeKeyVaultReserveAddress, reserveAddr.String()),
+		),
+	})
+
+	return nil
+}
+
+// VaultUnfarm handles types.MsgVaultUnfarm to unfarm the vault coin.
+// The farmer receives the share of the pool coin and the share of the pair coins
+// held by the reserve account, which are not deposited by the compounding yet.
+// It doesn't validate if the vault exists because farmers still need to be able to
+// unfarm their vault coin in case the vault is removed in params by governance proposal.
+// Like VaultFarm, it is rejected while the compounding of the vault is pending.
+func (k Keeper) VaultUnfarm(ctx sdk.Context, poolId uint64, farmer sdk.AccAddress, unfarmingCoin sdk.Coin) (unfarmedCoins sdk.Coins, err error) {
+	pool, found := k.liquidityKeeper.GetPool(ctx, poolId)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pool %d not found", poolId)
+	}
+
+	if k.vaultCompoundingPending(ctx, poolId) {
+		return nil, sdkerrors.Wrapf(types.ErrVaultCompounding, "vault by pool %d", poolId)
+	}
+
+	reserveAddr := types.VaultReserveAddress(poolId)
+	poolCoinDenom := liquiditytypes.PoolCoinDenom(poolId)
+	vaultCoinDenom := types.VaultCoinDenom(poolId)
+	vaultCoinTotalSupplyAmt := k.bankKeeper.GetSupply(ctx, vaultCoinDenom).Amount
+	if unfarmingCoin.Amount.GT(vaultCoinTotalSupplyAmt) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "%s is bigger than the total supply %s", unfarmingCoin.Amount, vaultCoinTotalSupplyAmt)
+	}
+
+	balances := k.bankKeeper.SpendableCoins(ctx, reserveAddr)
+	reserveAmt := balances.AmountOf(poolCoinDenom)
+	poolCoinAmt := reserveAmt
+	if position, found := k.lpfarmKeeper.GetPosition(ctx, reserveAddr, poolCoinDenom); found {
+		poolCoinAmt = poolCoinAmt.Add(position.FarmingAmount)
+	}
+	unfarmingAmt := types.CalculateLiquidUnfarmAmount(vaultCoinTotalSupplyAmt, poolCoinAmt, unfarmingCoin.Amount, sdk.ZeroInt())
+	unfarmedCoins = sdk.NewCoins(sdk.NewCoin(poolCoinDenom, unfarmingAmt))
+	pair, _ := k.liquidityKeeper.GetPair(ctx, pool.PairId)
+	for _, denom := range []string{pair.BaseCoinDenom, pair.QuoteCoinDenom} {
+		amt := types.CalculateLiquidUnfarmAmount(vaultCoinTotalSupplyAmt, balances.AmountOf(denom), unfarmingCoin.Amount, sdk.ZeroInt())
+		unfarmedCoins = unfarmedCoins.Add(sdk.NewCoin(denom, amt))
+	}
+	if unfarmedCoins.IsZero() {
+		return nil, sdkerrors.Wrapf(types.ErrSmallerThanMinimumAmount, "%s is too small to unfarm", unfarmingCoin)
+	}
+
+	// Release the pool coin held by the reserve account first and
+	// unfarm the rest from the farm module
+	if unfarmingAmt.GT(reserveAmt) {
+		if _, err := k.lpfarmKeeper.Unfarm(ctx, reserveAddr, sdk.NewCoin(poolCoinDenom, unfarmingAmt.Sub(reserveAmt))); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, reserveAddr, farmer, unfarmedCoins); err != nil {
+		return nil, err
+	}
+
+	// Burn the vault coin amount
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, farmer, types.ModuleName, sdk.NewCoins(unfarmingCoin)); err != nil {
+		return nil, err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(unfarmingCoin)); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeVaultUnfarm,
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyFarmer, farmer.String()),
+			sdk.NewAttribute(types.AttributeKeyUnfarmingCoin, unfarmingCoin.String()),
+			sdk.NewAttribute(types.AttributeKeyUnfarmedCoins, unfarmedCoins.String()),
+		),
+	})
+
+	return unfarmedCoins, nil
+}
+
+// HandleRemovedVault unfarms all farmed pool coin from the farm module to stop having
+// farming rewards accumulated and sends the rewards not compounded yet to the fee collector.
+// The pool coin stays in the reserve account so that farmers can unfarm their vault coin.
+func (k Keeper) HandleRemovedVault(ctx sdk.Context, vault types.Vault) {
+	feeCollectorAddr, _ := sdk.AccAddressFromBech32(k.GetFeeCollector(ctx))
+	reserveAddr := types.VaultReserveAddress(vault.PoolId)
+	poolCoinDenom := liquiditytypes.PoolCoinDenom(vault.PoolId)
+
+	position, found := k.lpfarmKeeper.GetPosition(ctx, reserveAddr, poolCoinDenom)
+	if found {
+		if _, err := k.lpfarmKeeper.Unfarm(ctx, reserveAddr, sdk.NewCoin(poolCoinDenom, position.FarmingAmount)); err != nil {
+			panic(err)
+		}
+	}
+
+	rewards := sdk.Coins{}
+	for _, coin := range k.bankKeeper.SpendableCoins(ctx, reserveAddr) {
+		if coin.Denom != poolCoinDenom {
+			rewards = rewards.Add(coin)
+		}
+	}
+	if !rewards.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, reserveAddr, feeCollectorAddr, rewards); err != nil {
+			panic(err)
+		}
+	}
+
+	k.DeleteVault(ctx, vault)
+	k.DeleteVaultLastCompoundedAt(ctx, vault.PoolId)
+}
+
+// CompoundVaults compounds the vaults whose compounding interval has passed since
+// their last compounding.
+func (k Keeper) CompoundVaults(ctx sdk.Context) {
+	for _, vault := range k.GetVaultsInStore(ctx) {
+		lastCompoundedAt, found := k.GetVaultLastCompoundedAt(ctx, vault.PoolId)
+		if found && ctx.BlockTime().Before(lastCompoundedAt.Add(vault.CompoundingInterval)) {
+			continue
+		}
+		// A new vault starts counting its interval from the first block it is seen
+		if found {
+			k.CompoundVault(ctx, vault)
+		}
+		k.SetVaultLastCompoundedAt(ctx, vault.PoolId, ctx.BlockTime())
+	}
+}
+
+// CompoundVault compounds the farming rewards of the vault into the pool.
+// It farms the pool coin minted by the previous compounding, harvests the rewards,
+// places market orders to swap the rewards into the pair coins and
+// deposits the pair coins to the pool.
+// Since the orders and the deposit are executed in the batch of the liquidity module,
+// their proceeds are handled in the next compounding.
+// A step that fails is skipped and retried in the next compounding.
+func (k Keeper) CompoundVault(ctx sdk.Context, vault types.Vault) {
+	pool, found := k.liquidityKeeper.GetPool(ctx, vault.PoolId)
+	if !found || pool.Disabled {
+		return
+	}
+	pair, _ := k.liquidityKeeper.GetPair(ctx, pool.PairId)
+
+	reserveAddr := types.VaultReserveAddress(vault.PoolId)
+	poolCoinDenom := liquiditytypes.PoolCoinDenom(vault.PoolId)
+	harvestedRewards := sdk.Coins{}
+
+	// Farm the pool coin held by the reserve account
+	farmingAmt := k.bankKeeper.SpendableCoins(ctx, reserveAddr).AmountOf(poolCoinDenom)
+	if farmingAmt.IsPositive() {
+		k.executeVaultStep(ctx, vault, "farm pool coin", func(ctx sdk.Context) error {
+			withdrawnRewards, err := k.lpfarmKeeper.Farm(ctx, reserveAddr, sdk.NewCoin(poolCoinDenom, farmingAmt))
+			if err != nil {
+				return err
+			}
+			harvestedRewards = harvestedRewards.Add(withdrawnRewards...)
+			return nil
+		})
+	}
+
+	if _, found := k.lpfarmKeeper.GetPosition(ctx, reserveAddr, poolCoinDenom); found {
+		k.executeVaultStep(ctx, vault, "harvest rewards", func(ctx sdk.Context) error {
+			withdrawnRewards, err := k.lpfarmKeeper.Harvest(ctx, reserveAddr, poolCoinDenom)
+			if err != nil {
+				return err
+			}
+			harvestedRewards = harvestedRewards.Add(withdrawnRewards...)
+			return nil
+		})
+	}
+
+	// Swap the rewards that are neither of the pair coins into one of them
+	balances := k.bankKeeper.SpendableCoins(ctx, reserveAddr)
+	for _, coin := range balances {
+		if coin.Denom == poolCoinDenom || coin.Denom == pair.BaseCoinDenom || coin.Denom == pair.QuoteCoinDenom {
+			continue
+		}
+		k.swapVaultRewards(ctx, vault, pair, coin)
+	}
+
+	// Deposit the pair coins with the ratio of the pool reserve
+	x := balances.AmountOf(pair.BaseCoinDenom)
+	y := balances.AmountOf(pair.QuoteCoinDenom)
+	rx, ry := k.liquidityKeeper.GetPoolBalances(ctx, pool)
+	depositX, depositY := types.BalancedDepositAmounts(x, y, rx.Amount, ry.Amount)
+	depositCoins := sdk.NewCoins(sdk.NewCoin(pair.BaseCoinDenom, depositX), sdk.NewCoin(pair.QuoteCoinDenom, depositY))
+	if !depositCoins.IsZero() {
+		k.executeVaultStep(ctx, vault, "deposit", func(ctx sdk.Context) error {
+			_, err := k.liquidityKeeper.Deposit(ctx, liquiditytypes.NewMsgDeposit(reserveAddr, vault.PoolId, depositCoins))
+			return err
+		})
+	}
+
+	// Swap the part of the surplus so that it can be deposited in the next compounding
+	if pair.LastPrice != nil {
+		rxValue := rx.Amount.ToDec().Mul(*pair.LastPrice)
+		ryValue := ry.Amount.ToDec()
+		if surplusX := x.Sub(depositX); surplusX.IsPositive() {
+			swapAmt := types.SurplusSwapAmount(surplusX, rxValue, ryValue)
+			k.placeVaultOrder(ctx, vault, pair, sdk.NewCoin(pair.BaseCoinDenom, swapAmt), pair.QuoteCoinDenom)
+		} else if surplusY := y.Sub(depositY); surplusY.IsPositive() {
+			swapAmt := types.SurplusSwapAmount(surplusY, ryValue, rxValue)
+			k.placeVaultOrder(ctx, vault, pair, sdk.NewCoin(pair.QuoteCoinDenom, swapAmt), pair.BaseCoinDenom)
+		}
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCompoundVault,
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(vault.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyVaultReserveAddress, reserveAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyHarvestedRewards, harvestedRewards.String()),
+			sdk.NewAttribute(types.AttributeKeyDepositCoins, depositCoins.String()),
+		),
+	})
+}
+
+// swapVaultRewards places a market order to swap the rewards coin into
+// either the quote coin or the base coin of the pair of the vault.
+func (k Keeper) swapVaultRewards(ctx sdk.Context, vault types.Vault, pair liquiditytypes.Pair, coin sdk.Coin) {
+	for _, denom := range []string{pair.QuoteCoinDenom, pair.BaseCoinDenom} {
+		if rewardsPair, found := k.liquidityKeeper.GetPairByDenoms(ctx, coin.Denom, denom); found {
+			k.placeVaultOrder(ctx, vault, rewardsPair, coin, denom)
+			return
+		}
+		if rewardsPair, found := k.liquidityKeeper.GetPairByDenoms(ctx, denom, coin.Denom); found {
+			k.placeVaultOrder(ctx, vault, rewardsPair, coin, denom)
+			return
+		}
+	}
+}
+
+// placeVaultOrder places a market order in the pair with the offer coin held by the vault.
+// The order isn't placed when the price of any pool in the pair deviates from the last price
+// of the pair by more than the max slippage of the vault.
+func (k Keeper) placeVaultOrder(ctx sdk.Context, vault types.Vault, pair liquiditytypes.Pair, offerCoin sdk.Coin, demandCoinDenom string) {
+	if !offerCoin.IsPositive() || pair.LastPrice == nil {
+		return
+	}
+
+	for _, pool := range k.liquidityKeeper.GetPoolsByPair(ctx, pair.Id) {
+		if pool.Disabled {
+			continue
+		}
+		rx, ry := k.liquidityKeeper.GetPoolBalances(ctx, pool)
+		ammPool := pool.AMMPool(rx.Amount, ry.Amount, k.liquidityKeeper.GetPoolCoinSupply(ctx, pool))
+		if ammPool.IsDepleted() {
+			continue
+		}
+		if types.PriceDeviates(ammPool.Price(), *pair.LastPrice, vault.MaxSlippage) {
+			k.Logger(ctx).Info("skip placing vault order", "pool_id", vault.PoolId, "pair_id", pair.Id, "reason", "price deviates from the last price")
+			return
+		}
+	}
+
+	dir := liquiditytypes.OrderDirectionSell
+	amt := offerCoin.Amount
+	if offerCoin.Denom == pair.QuoteCoinDenom {
+		_, highest := k.liquidityKeeper.PriceLimits(ctx, *pair.LastPrice)
+		dir = liquiditytypes.OrderDirectionBuy
+		amt = offerCoin.Amount.ToDec().QuoTruncate(highest).TruncateInt()
+	}
+
+	reserveAddr := types.VaultReserveAddress(vault.PoolId)
+	k.executeVaultStep(ctx, vault, "place order", func(ctx sdk.Context) error {
+		_, err := k.liquidityKeeper.MarketOrder(
+			ctx, liquiditytypes.NewMsgMarketOrder(reserveAddr, pair.Id, dir, offerCoin, demandCoinDenom, amt, 0))
+		return err
+	})
+}
+
+// executeVaultStep executes a step of the compounding in a cached context and
+// writes the result only when the step succeeds.
+func (k Keeper) executeVaultStep(ctx sdk.Context, vault types.Vault, step string, fn func(ctx sdk.Context) error) {
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := fn(cacheCtx); err != nil {
+		k.Logger(ctx).Info("skip vault compounding step", "pool_id", vault.PoolId, "step", step, "reason", err.Error())
+		return
+	}
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+}
+
+// vaultTotalFarmingAmount returns the amount of the pool coin the vault has,
+// which is the sum of the farming amount in the farm module and the balance of the reserve account.
+// The pair coins held by the reserve account, which are the proceeds of the compounding
+// orders or the surplus of the compounding deposit, are counted as the pool coin amount
+// they are worth in the pool reserve, since they are paid out in VaultUnfarm as well.
+func (k Keeper) vaultTotalFarmingAmount(ctx sdk.Context, poolId uint64) sdk.Int {
+	reserveAddr := types.VaultReserveAddress(poolId)
+	poolCoinDenom := liquiditytypes.PoolCoinDenom(poolId)
+	balances := k.bankKeeper.SpendableCoins(ctx, reserveAddr)
+	totalAmt := balances.AmountOf(poolCoinDenom)
+	if position, found := k.lpfarmKeeper.GetPosition(ctx, reserveAddr, poolCoinDenom); found {
+		totalAmt = totalAmt.Add(position.FarmingAmount)
+	}
+
+	pool, found := k.liquidityKeeper.GetPool(ctx, poolId)
+	if !found || pool.Disabled {
+		return totalAmt
+	}
+	pair, _ := k.liquidityKeeper.GetPair(ctx, pool.PairId)
+	x, y := balances.AmountOf(pair.BaseCoinDenom), balances.AmountOf(pair.QuoteCoinDenom)
+	if x.IsZero() && y.IsZero() {
+		return totalAmt
+	}
+	rx, ry := k.liquidityKeeper.GetPoolBalances(ctx, pool)
+	poolCoinSupply := k.liquidityKeeper.GetPoolCoinSupply(ctx, pool)
+	ammPool := pool.AMMPool(rx.Amount, ry.Amount, poolCoinSupply)
+	if ammPool.IsDepleted() {
+		return totalAmt
+	}
+	price := ammPool.Price()
+	poolValue := rx.Amount.ToDec().Mul(price).Add(ry.Amount.ToDec())
+	pairCoinsValue := x.ToDec().Mul(price).Add(y.ToDec())
+	return totalAmt.Add(pairCoinsValue.MulInt(poolCoinSupply).QuoTruncate(poolValue).TruncateInt())
+}
+
+// vaultCompoundingPending returns whether the vault has the deposit request or the orders
+// placed by the compounding, which are not executed yet.
+// Those are executed in the batch of the liquidity module in the same block.
+func (k Keeper) vaultCompoundingPending(ctx sdk.Context, poolId uint64) (pending bool) {
+	reserveAddr := types.VaultReserveAddress(poolId)
+	_ = k.liquidityKeeper.IterateDepositRequestsByDepositor(ctx, reserveAddr, func(req liquiditytypes.DepositRequest) (stop bool, err error) {
+		if req.Status == liquiditytypes.RequestStatusNotExecuted {
+			pending = true
+			return true, nil
+		}
+		return false, nil
+	})
+	if pending {
+		return true
+	}
+	_ = k.liquidityKeeper.IterateOrdersByOrderer(ctx, reserveAddr, func(order liquiditytypes.Order) (stop bool, err error) {
+		if order.Status.IsMatchable() {
+			pending = true
+			return true, nil
+		}
+		return false, nil
+	})
+	return pending
+}
@@ -0,0 +1,252 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/cosmosquad-labs/squad/v3/types"
+	"github.com/cosmosquad-labs/squad/v3/x/liquidfarming"
+	"github.com/cosmosquad-labs/squad/v3/x/liquidfarming/types"
+	lpfarmtypes "github.com/cosmosquad-labs/squad/v3/x/lpfarm/types"
+)
+
+func (s *KeeperTestSuite) TestVaultFarm_Validation() {
+	pair := s.createPairWithLastPrice(helperAddr, "denom1", "denom2", sdk.NewDec(1))
+	pool := s.createPool(helperAddr, pair.Id, utils.ParseCoins("100_000_000denom1, 100_000_000denom2"))
+
+	s.Require().NoError(s.app.BankKeeper.SendCoins(s.ctx, helperAddr, s.addr(1), utils.ParseCoins("1_000_000_000pool1")))
+
+	err := s.keeper.VaultFarm(s.ctx, pool.Id, s.addr(1), utils.ParseCoin("1_000_000_000pool1"))
+	s.Require().EqualError(err, "vault by pool 1 not found: not found")
+
+	s.createVault(pool.Id, sdk.NewInt(1_000_000), utils.ParseDec("0.1"), time.Hour)
+
+	err = s.keeper.VaultFarm(s.ctx, pool.Id, s.addr(1), utils.ParseCoin("100_000pool1"))
+	s.Require().EqualError(err, "100000 is smaller than 1000000: smaller than the minimum amount")
+
+	err = s.keeper.VaultFarm(s.ctx, pool.Id, s.addr(1), utils.ParseCoin("1_000_000denom1"))
+	s.Require().EqualError(err, "expected denom pool1, but got denom1: invalid request")
+
+	err = s.keeper.VaultFarm(s.ctx, pool.Id, s.addr(1), utils.ParseCoin("1_000_000_000pool1"))
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestVaultFarmAndUnfarm() {
+	pair := s.createPairWithLastPrice(helperAddr, "denom1", "denom2", sdk.NewDec(1))
+	pool := s.createPool(helperAddr, pair.Id, utils.ParseCoins("100_000_000denom1, 100_000_000denom2"))
+	s.createVault(pool.Id, sdk.ZeroInt(), utils.ParseDec("0.1"), time.Hour)
+
+	s.Require().NoError(s.app.BankKeeper.SendCoins(s.ctx, helperAddr, s.addr(1), utils.ParseCoins("1_000_000_000pool1")))
+	s.Require().NoError(s.app.BankKeeper.SendCoins(s.ctx, helperAddr, s.addr(2), utils.ParseCoins("500_000_000pool1")))
+	s.vaultFarm(pool.Id, s.addr(1), utils.ParseCoin("1_000_000_000pool1"), false)
+	s.vaultFarm(pool.Id, s.addr(2), utils.ParseCoin("500_000_000pool1"), false)
+
+	vaultCoinDenom := types.VaultCoinDenom(pool.Id)
+	s.Require().Equal(sdk.NewInt(1_000_000_000), s.getBalance(s.addr(1), vaultCoinDenom).Amount)
+	s.Require().Equal(sdk.NewInt(500_000_000), s.getBalance(s.addr(2), vaultCoinDenom).Amount)
+
+	reserveAddr := types.VaultReserveAddress(pool.Id)
+	position, found := s.app.LPFarmKeeper.GetPosition(s.ctx, reserveAddr, pool.PoolCoinDenom)
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewInt(1_500_000_000), position.FarmingAmount)
+
+	// The pool coin held by the reserve account, e.g. minted by the deposit of the compounding,
+	// increases the value of the vault coin
+	s.Require().NoError(s.app.BankKeeper.SendCoins(s.ctx, helperAddr, reserveAddr, utils.ParseCoins("300_000_000pool1")))
+
+	_, err := s.keeper.VaultUnfarm(s.ctx, pool.Id, s.addr(1), utils.ParseCoin("2_000_000_000lfvault1"))
+	s.Require().EqualError(err, "2000000000 is bigger than the total supply 1500000000: insufficient funds")
+
+	unfarmedCoins, err := s.keeper.VaultUnfarm(s.ctx, pool.Id, s.addr(2), utils.ParseCoin("500_000_000lfvault1"))
+	s.Require().NoError(err)
+	s.Require().Equal(utils.ParseCoins("600_000_000pool1"), unfarmedCoins)
+
+	// The reserve account balance is used first
+	s.Require().True(s.getBalance(reserveAddr, pool.PoolCoinDenom).IsZero())
+	position, found = s.app.LPFarmKeeper.GetPosition(s.ctx, reserveAddr, pool.PoolCoinDenom)
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewInt(1_200_000_000), position.FarmingAmount)
+
+	unfarmedCoins, err = s.keeper.VaultUnfarm(s.ctx, pool.Id, s.addr(1), utils.ParseCoin("1_000_000_000lfvault1"))
+	s.Require().NoError(err)
+	s.Require().Equal(utils.ParseCoins("1_200_000_000pool1"), unfarmedCoins)
+	s.Require().True(s.app.BankKeeper.GetSupply(s.ctx, vaultCoinDenom).IsZero())
+}
+
+func (s *KeeperTestSuite) TestVaultFarmAndUnfarm_PairCoins() {
+	pair := s.createPairWithLastPrice(helperAddr, "denom1", "denom2", sdk.NewDec(1))
+	pool := s.createPool(helperAddr, pair.Id, utils.ParseCoins("100_000_000denom1, 100_000_000denom2"))
+	s.createVault(pool.Id, sdk.ZeroInt(), utils.ParseDec("0.1"), time.Hour)
+
+	s.Require().NoError(s.app.BankKeeper.SendCoins(s.ctx, helperAddr, s.addr(1), utils.ParseCoins("1_000_000_000pool1")))
+	s.Require().NoError(s.app.BankKeeper.SendCoins(s.ctx, helperAddr, s.addr(2), utils.ParseCoins("1_000_000_000pool1")))
+	s.vaultFarm(pool.Id, s.addr(1), utils.ParseCoin("1_000_000_000pool1"), false)
+
+	// The pair coins not deposited yet by the compounding, worth 1_000_000_000pool1
+	// since the pool coin supply is 1_000_000_000_000
+	reserveAddr := types.VaultReserveAddress(pool.Id)
+	s.fundAddr(reserveAddr, utils.ParseCoins("100_000denom1, 100_000denom2"))
+
+	// The pair coins are counted in the share of the vault coin
+	s.vaultFarm(pool.Id, s.addr(2), utils.ParseCoin("1_000_000_000pool1"), false)
+	vaultCoinDenom := types.VaultCoinDenom(pool.Id)
+	s.Require().Equal(sdk.NewInt(500_000_000), s.getBalance(s.addr(2), vaultCoinDenom).Amount)
+
+	// The farmer receives the share of the pair coins as well
+	unfarmedCoins, err := s.keeper.VaultUnfarm(s.ctx, pool.Id, s.addr(1), utils.ParseCoin("1_000_000_000lfvault1"))
+	s.Require().NoError(err)
+	s.Require().Equal(utils.ParseCoins("1_333_333_333pool1, 66_666denom1, 66_666denom2"), unfarmedCoins)
+
+	unfarmedCoins, err = s.keeper.VaultUnfarm(s.ctx, pool.Id, s.addr(2), utils.ParseCoin("500_000_000lfvault1"))
+	s.Require().NoError(err)
+	s.Require().Equal(utils.ParseCoins("666_666_667pool1, 33_334denom1, 33_334denom2"), unfarmedCoins)
+	s.Require().True(s.getBalances(reserveAddr).IsZero())
+}
+
+func (s *KeeperTestSuite) TestVaultFarmAndUnfarm_CompoundingPending() {
+	pair := s.createPairWithLastPrice(helperAddr, "denom1", "denom2", sdk.NewDec(1))
+	pool := s.createPool(helperAddr, pair.Id, utils.ParseCoins("100_000_000denom1, 100_000_000denom2"))
+	vault := s.createVault(pool.Id, sdk.ZeroInt(), utils.ParseDec("0.1"), time.Hour)
+
+	s.Require().NoError(s.app.BankKeeper.SendCoins(s.ctx, helperAddr, s.addr(1), utils.ParseCoins("2_000_000_000pool1")))
+	s.vaultFarm(pool.Id, s.addr(1), utils.ParseCoin("1_000_000_000pool1"), false)
+
+	// The compounding places a deposit request and an order escrowing the pair coins
+	reserveAddr := types.VaultReserveAddress(pool.Id)
+	s.fundAddr(reserveAddr, utils.ParseCoins("1_000_000denom1, 3_000_000denom2"))
+	s.keeper.CompoundVault(s.ctx, vault)
+	s.Require().Len(s.app.LiquidityKeeper.GetDepositRequestsByDepositor(s.ctx, reserveAddr), 1)
+	s.Require().Len(s.app.LiquidityKeeper.GetOrdersByOrderer(s.ctx, reserveAddr), 1)
+
+	err := s.keeper.VaultFarm(s.ctx, pool.Id, s.addr(1), utils.ParseCoin("1_000_000_000pool1"))
+	s.Require().ErrorIs(err, types.ErrVaultCompounding)
+	_, err = s.keeper.VaultUnfarm(s.ctx, pool.Id, s.addr(1), utils.ParseCoin("1_000_000lfvault1"))
+	s.Require().ErrorIs(err, types.ErrVaultCompounding)
+
+	// The deposit and the order are executed in the batch
+	s.nextBlock()
+	s.vaultFarm(pool.Id, s.addr(1), utils.ParseCoin("1_000_000_000pool1"), false)
+	_, err = s.keeper.VaultUnfarm(s.ctx, pool.Id, s.addr(1), utils.ParseCoin("1_000_000lfvault1"))
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestCompoundVault() {
+	pair := s.createPairWithLastPrice(helperAddr, "denom1", "denom2", sdk.NewDec(1))
+	pool := s.createPool(helperAddr, pair.Id, utils.ParseCoins("100_000_000denom1, 100_000_000denom2"))
+
+	// The rewards are swapped into the quote coin through the pair of the rewards coin
+	rewardsPair := s.createPairWithLastPrice(helperAddr, "stake", "denom2", sdk.NewDec(1))
+	s.createPool(helperAddr, rewardsPair.Id, utils.ParseCoins("100_000_000stake, 100_000_000denom2"))
+
+	plan := s.createPrivatePlan(s.addr(0), []lpfarmtypes.RewardAllocation{
+		{
+			PairId:        pair.Id,
+			RewardsPerDay: utils.ParseCoins("1_000_000_000stake"),
+		},
+	})
+	s.fundAddr(plan.GetFarmingPoolAddress(), utils.ParseCoins("1_000_000_000stake"))
+
+	vault := s.createVault(pool.Id, sdk.ZeroInt(), utils.ParseDec("0.1"), time.Hour)
+	s.Require().NoError(s.app.BankKeeper.SendCoins(s.ctx, helperAddr, s.addr(1), utils.ParseCoins("1_000_000_000pool1")))
+	s.vaultFarm(pool.Id, s.addr(1), utils.ParseCoin("1_000_000_000pool1"), false)
+	s.nextBlock()
+
+	// It takes several compoundings for the rewards to go through the swaps and the deposit
+	for i := 0; i < 4; i++ {
+		s.nextBlock()
+		s.keeper.CompoundVault(s.ctx, vault)
+		s.nextBlock()
+	}
+
+	reserveAddr := types.VaultReserveAddress(pool.Id)
+	position, found := s.app.LPFarmKeeper.GetPosition(s.ctx, reserveAddr, pool.PoolCoinDenom)
+	s.Require().True(found)
+	s.Require().True(position.FarmingAmount.GT(sdk.NewInt(1_000_000_000)))
+
+	unfarmedCoins, err := s.keeper.VaultUnfarm(s.ctx, pool.Id, s.addr(1), utils.ParseCoin("1_000_000_000lfvault1"))
+	s.Require().NoError(err)
+	s.Require().True(unfarmedCoins.AmountOf(pool.PoolCoinDenom).GT(sdk.NewInt(1_000_000_000)))
+}
+
+func (s *KeeperTestSuite) TestCompoundVault_PriceDeviates() {
+	pair := s.createPairWithLastPrice(helperAddr, "denom1", "denom2", sdk.NewDec(1))
+	pool := s.createPool(helperAddr, pair.Id, utils.ParseCoins("100_000_000denom1, 100_000_000denom2"))
+	vault := s.createVault(pool.Id, sdk.ZeroInt(), utils.ParseDec("0.1"), time.Hour)
+
+	reserveAddr := types.VaultReserveAddress(pool.Id)
+	s.fundAddr(reserveAddr, utils.ParseCoins("1_000_000denom2"))
+
+	// The pool price deviates from the last price by more than the max slippage
+	lastPrice := utils.ParseDec("1.2")
+	pair.LastPrice = &lastPrice
+	s.app.LiquidityKeeper.SetPair(s.ctx, pair)
+
+	s.keeper.CompoundVault(s.ctx, vault)
+	s.Require().Empty(s.app.LiquidityKeeper.GetOrdersByOrderer(s.ctx, reserveAddr))
+
+	lastPrice = utils.ParseDec("1.05")
+	pair.LastPrice = &lastPrice
+	s.app.LiquidityKeeper.SetPair(s.ctx, pair)
+
+	s.keeper.CompoundVault(s.ctx, vault)
+	s.Require().Len(s.app.LiquidityKeeper.GetOrdersByOrderer(s.ctx, reserveAddr), 1)
+}
+
+func (s *KeeperTestSuite) TestCompoundVaults_Interval() {
+	pair := s.createPairWithLastPrice(helperAddr, "denom1", "denom2", sdk.NewDec(1))
+	pool := s.createPool(helperAddr, pair.Id, utils.ParseCoins("100_000_000denom1, 100_000_000denom2"))
+	s.createVault(pool.Id, sdk.ZeroInt(), utils.ParseDec("0.1"), time.Hour)
+
+	reserveAddr := types.VaultReserveAddress(pool.Id)
+	s.fundAddr(reserveAddr, utils.ParseCoins("1_000_000denom2"))
+
+	// The interval of a new vault starts from the first block
+	s.keeper.CompoundVaults(s.ctx)
+	lastCompoundedAt, found := s.keeper.GetVaultLastCompoundedAt(s.ctx, pool.Id)
+	s.Require().True(found)
+	s.Require().Equal(s.ctx.BlockTime(), lastCompoundedAt)
+	s.Require().Empty(s.app.LiquidityKeeper.GetOrdersByOrderer(s.ctx, reserveAddr))
+
+	s.ctx = s.ctx.WithBlockTime(lastCompoundedAt.Add(30 * time.Minute))
+	s.keeper.CompoundVaults(s.ctx)
+	s.Require().Empty(s.app.LiquidityKeeper.GetOrdersByOrderer(s.ctx, reserveAddr))
+
+	s.ctx = s.ctx.WithBlockTime(lastCompoundedAt.Add(time.Hour))
+	s.keeper.CompoundVaults(s.ctx)
+	s.Require().Len(s.app.LiquidityKeeper.GetOrdersByOrderer(s.ctx, reserveAddr), 1)
+	lastCompoundedAt, _ = s.keeper.GetVaultLastCompoundedAt(s.ctx, pool.Id)
+	s.Require().Equal(s.ctx.BlockTime(), lastCompoundedAt)
+}
+
+func (s *KeeperTestSuite) TestHandleRemovedVault() {
+	pair := s.createPairWithLastPrice(helperAddr, "denom1", "denom2", sdk.NewDec(1))
+	pool := s.createPool(helperAddr, pair.Id, utils.ParseCoins("100_000_000denom1, 100_000_000denom2"))
+	s.createVault(pool.Id, sdk.ZeroInt(), utils.ParseDec("0.1"), time.Hour)
+
+	s.Require().NoError(s.app.BankKeeper.SendCoins(s.ctx, helperAddr, s.addr(1), utils.ParseCoins("1_000_000_000pool1")))
+	s.vaultFarm(pool.Id, s.addr(1), utils.ParseCoin("1_000_000_000pool1"), false)
+
+	reserveAddr := types.VaultReserveAddress(pool.Id)
+	s.fundAddr(reserveAddr, utils.ParseCoins("1_000_000stake"))
+
+	// Remove the vault in params
+	params := s.keeper.GetParams(s.ctx)
+	params.Vaults = []types.Vault{}
+	s.keeper.SetParams(s.ctx, params)
+	liquidfarming.BeginBlocker(s.ctx, s.keeper)
+
+	_, found := s.keeper.GetVault(s.ctx, pool.Id)
+	s.Require().False(found)
+	_, found = s.app.LPFarmKeeper.GetPosition(s.ctx, reserveAddr, pool.PoolCoinDenom)
+	s.Require().False(found)
+	s.Require().Equal(utils.ParseCoins("1_000_000_000pool1"), s.getBalances(reserveAddr))
+
+	feeCollector, _ := sdk.AccAddressFromBech32(s.keeper.GetFeeCollector(s.ctx))
+	s.Require().True(s.getBalance(feeCollector, "stake").Amount.GTE(sdk.NewInt(1_000_000)))
+
+	// Farmers are still able to unfarm their vault coin
+	unfarmedCoins, err := s.keeper.VaultUnfarm(s.ctx, pool.Id, s.addr(1), utils.ParseCoin("1_000_000_000lfvault1"))
+	s.Require().NoError(err)
+	s.Require().Equal(utils.ParseCoins("1_000_000_000pool1"), unfarmedCoins)
+}
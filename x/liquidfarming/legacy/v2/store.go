@@ -0,0 +1,20 @@
+package v2
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/cosmosquad-labs/squad/v3/x/liquidfarming/types"
+)
+
+// MigrateParams sets the params added in v2 to their default values.
+func MigrateParams(ctx sdk.Context, paramSpace paramstypes.Subspace) {
+	paramSpace.Set(ctx, types.KeyPairLiquidFarms, types.DefaultPairLiquidFarms)
+	paramSpace.Set(ctx, types.KeyPairPriceWindow, types.DefaultPairPriceWindow)
+	paramSpace.Set(ctx, types.KeyVaults, types.DefaultVaults)
+}
+
+func MigrateStore(ctx sdk.Context, paramSpace paramstypes.Subspace) error {
+	MigrateParams(ctx, paramSpace)
+	return nil
+}
@@ -0,0 +1,50 @@
+package v2_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	chain "github.com/cosmosquad-labs/squad/v3/app"
+	v2liquidfarming "github.com/cosmosquad-labs/squad/v3/x/liquidfarming/legacy/v2"
+	"github.com/cosmosquad-labs/squad/v3/x/liquidfarming/types"
+)
+
+func TestMigrateParams(t *testing.T) {
+	encCfg := chain.MakeTestEncodingConfig()
+	paramsKey := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+
+	cms := store.NewCommitMultiStore(dbm.NewMemDB())
+	cms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, nil)
+	cms.MountStoreWithDB(paramsTKey, sdk.StoreTypeTransient, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+	ctx := sdk.NewContext(cms, tmproto.Header{}, false, log.NewNopLogger())
+
+	paramSpace := paramstypes.NewSubspace(
+		encCfg.Marshaler, encCfg.Amino, paramsKey, paramsTKey, types.ModuleName).WithKeyTable(types.ParamKeyTable())
+
+	// The params set before the migration are kept.
+	paramSpace.Set(ctx, types.KeyRewardsAuctionDuration, time.Hour)
+
+	require.NoError(t, v2liquidfarming.MigrateStore(ctx, paramSpace))
+
+	var rewardsAuctionDuration, pairPriceWindow time.Duration
+	var pairLiquidFarms []types.PairLiquidFarm
+	var vaults []types.Vault
+	paramSpace.Get(ctx, types.KeyRewardsAuctionDuration, &rewardsAuctionDuration)
+	paramSpace.Get(ctx, types.KeyPairLiquidFarms, &pairLiquidFarms)
+	paramSpace.Get(ctx, types.KeyPairPriceWindow, &pairPriceWindow)
+	paramSpace.Get(ctx, types.KeyVaults, &vaults)
+	require.Equal(t, time.Hour, rewardsAuctionDuration)
+	require.Empty(t, pairLiquidFarms)
+	require.Equal(t, types.DefaultPairPriceWindow, pairPriceWindow)
+	require.Empty(t, vaults)
+}
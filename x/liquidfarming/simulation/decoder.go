@@ -39,6 +39,37 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &bB)
 			return fmt.Sprintf("%v\n%v", bA, bB)
 
+		case bytes.Equal(kvA.Key[:1], types.LeadingBidKeyPrefix):
+			var bA, bB types.LeadingBid
+			cdc.MustUnmarshal(kvA.Value, &bA)
+			cdc.MustUnmarshal(kvB.Value, &bB)
+			return fmt.Sprintf("%v\n%v", bA, bB)
+
+		case bytes.Equal(kvA.Key[:1], types.PairLiquidFarmKeyPrefix):
+			var lA, lB types.PairLiquidFarm
+			cdc.MustUnmarshal(kvA.Value, &lA)
+			cdc.MustUnmarshal(kvB.Value, &lB)
+			return fmt.Sprintf("%v\n%v", lA, lB)
+
+		case bytes.Equal(kvA.Key[:1], types.PairRewardsAuctionKeyPrefix):
+			var rA, rB types.RewardsAuction
+			cdc.MustUnmarshal(kvA.Value, &rA)
+			cdc.MustUnmarshal(kvB.Value, &rB)
+			return fmt.Sprintf("%v\n%v", rA, rB)
+
+		case bytes.Equal(kvA.Key[:1], types.PairBidKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.PairWinningBidKeyPrefix):
+			var bA, bB types.Bid
+			cdc.MustUnmarshal(kvA.Value, &bA)
+			cdc.MustUnmarshal(kvB.Value, &bB)
+			return fmt.Sprintf("%v\n%v", bA, bB)
+
+		case bytes.Equal(kvA.Key[:1], types.VaultKeyPrefix):
+			var vA, vB types.Vault
+			cdc.MustUnmarshal(kvA.Value, &vA)
+			cdc.MustUnmarshal(kvB.Value, &vB)
+			return fmt.Sprintf("%v\n%v", vA, vB)
+
 		default:
 			panic(fmt.Sprintf("invalid liquid farm key prefix %X", kvA.Key[:1]))
 		}
@@ -21,6 +21,9 @@ func TestDecodeFarmingStore(t *testing.T) {
 	compoundingRewards := types.CompoundingRewards{}
 	rewardsAuction := types.RewardsAuction{}
 	bid := types.Bid{}
+	leadingBid := types.LeadingBid{}
+	pairLiquidFarm := types.PairLiquidFarm{}
+	vault := types.Vault{}
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
@@ -28,6 +31,12 @@ func TestDecodeFarmingStore(t *testing.T) {
 			{Key: types.CompoundingRewardsKeyPrefix, Value: cdc.MustMarshal(&compoundingRewards)},
 			{Key: types.RewardsAuctionKeyPrefix, Value: cdc.MustMarshal(&rewardsAuction)},
 			{Key: types.BidKeyPrefix, Value: cdc.MustMarshal(&bid)},
+			{Key: types.LeadingBidKeyPrefix, Value: cdc.MustMarshal(&leadingBid)},
+			{Key: types.PairLiquidFarmKeyPrefix, Value: cdc.MustMarshal(&pairLiquidFarm)},
+			{Key: types.PairRewardsAuctionKeyPrefix, Value: cdc.MustMarshal(&rewardsAuction)},
+			{Key: types.PairBidKeyPrefix, Value: cdc.MustMarshal(&bid)},
+			{Key: types.PairWinningBidKeyPrefix, Value: cdc.MustMarshal(&bid)},
+			{Key: types.VaultKeyPrefix, Value: cdc.MustMarshal(&vault)},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -40,6 +49,12 @@ func TestDecodeFarmingStore(t *testing.T) {
 		{"CompoundingRewards", fmt.Sprintf("%v\n%v", compoundingRewards, compoundingRewards)},
 		{"RewardsAuction", fmt.Sprintf("%v\n%v", rewardsAuction, rewardsAuction)},
 		{"Bid", fmt.Sprintf("%v\n%v", bid, bid)},
+		{"LeadingBid", fmt.Sprintf("%v\n%v", leadingBid, leadingBid)},
+		{"PairLiquidFarm", fmt.Sprintf("%v\n%v", pairLiquidFarm, pairLiquidFarm)},
+		{"PairRewardsAuction", fmt.Sprintf("%v\n%v", rewardsAuction, rewardsAuction)},
+		{"PairBid", fmt.Sprintf("%v\n%v", bid, bid)},
+		{"PairWinningBid", fmt.Sprintf("%v\n%v", bid, bid)},
+		{"Vault", fmt.Sprintf("%v\n%v", vault, vault)},
 		{"other", ""},
 	}
 	for i, tt := range tests {
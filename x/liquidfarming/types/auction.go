@@ -1,6 +1,7 @@
 package types
 
 import (
+	"crypto/sha256"
 	fmt "fmt"
 	time "time"
 
@@ -31,6 +32,21 @@ func NewRewardsAuction(
 	}
 }
 
+// NewPairRewardsAuction creates a new RewardsAuction for a pair liquid farm.
+// The pool coin of the given pool, which is the pool to compound rewards into, is used for bidding.
+func NewPairRewardsAuction(
+	id uint64,
+	pairId uint64,
+	poolId uint64,
+	startTime time.Time,
+	endTime time.Time,
+) RewardsAuction {
+	auction := NewRewardsAuction(id, poolId, startTime, endTime)
+	auction.PairId = pairId
+	auction.PayingReserveAddress = PairPayingReserveAddress(pairId).String()
+	return auction
+}
+
 // Validate validates RewardsAuction.
 func (a *RewardsAuction) Validate() error {
 	if a.PoolId == 0 {
@@ -74,6 +90,34 @@ func (a *RewardsAuction) SetRewards(rewards sdk.Coins) {
 	a.Rewards = rewards
 }
 
+// SetClosingTime sets the closing time of the candle auction.
+func (a *RewardsAuction) SetClosingTime(t time.Time) {
+	a.ClosingTime = t
+}
+
+// CandleWindowStartTime returns the start time of the candle window,
+// which is never before the start time of the auction.
+func (a RewardsAuction) CandleWindowStartTime() time.Time {
+	windowStartTime := a.EndTime.Add(-a.CandleWindow)
+	if windowStartTime.Before(a.StartTime) {
+		return a.StartTime
+	}
+	return windowStartTime
+}
+
+// CandleClosingTime returns the closing time of the candle auction derived from the seed.
+// The closing time lies within the candle window and can't be predicted before the seed is known.
+func (a RewardsAuction) CandleClosingTime(seed []byte) time.Time {
+	windowStartTime := a.CandleWindowStartTime()
+	window := a.EndTime.Sub(windowStartTime)
+	if window <= 0 {
+		return a.EndTime
+	}
+	hash := sha256.Sum256(seed)
+	offset := time.Duration(sdk.BigEndianToUint64(hash[:8]) % uint64(window))
+	return windowStartTime.Add(offset)
+}
+
 // GetPayingReserveAddress returns the paying reserve address in the form of sdk.AccAddress.
 func (a RewardsAuction) GetPayingReserveAddress() sdk.AccAddress {
 	addr, err := sdk.AccAddressFromBech32(a.PayingReserveAddress)
@@ -92,6 +136,13 @@ func NewBid(poolId uint64, bidder string, amount sdk.Coin) Bid {
 	}
 }
 
+// NewPairBid creates a new Bid for the rewards auction of a pair liquid farm.
+func NewPairBid(pairId, poolId uint64, bidder string, amount sdk.Coin) Bid {
+	bid := NewBid(poolId, bidder, amount)
+	bid.PairId = pairId
+	return bid
+}
+
 // GetBidder returns the bidder address in the form of sdk.AccAddress.
 func (b Bid) GetBidder() sdk.AccAddress {
 	addr, err := sdk.AccAddressFromBech32(b.Bidder)
@@ -118,6 +169,27 @@ func (b Bid) Validate() error {
 	return nil
 }
 
+// NewLeadingBid creates a new LeadingBid.
+func NewLeadingBid(auctionId, sequence uint64, bid Bid, t time.Time) LeadingBid {
+	return LeadingBid{
+		AuctionId: auctionId,
+		Sequence:  sequence,
+		Bid:       bid,
+		Time:      t,
+	}
+}
+
+// Validate validates LeadingBid.
+func (b LeadingBid) Validate() error {
+	if b.AuctionId == 0 {
+		return fmt.Errorf("auction id must not be 0")
+	}
+	if err := b.Bid.Validate(); err != nil {
+		return fmt.Errorf("invalid bid: %w", err)
+	}
+	return nil
+}
+
 // MustMarshalRewardsAuction marshals RewardsAuction and
 // it panics upon failure.
 func MustMarshalRewardsAuction(cdc codec.BinaryCodec, auction RewardsAuction) []byte {
@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -147,3 +148,30 @@ func TestBidValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestRewardsAuctionCandleClosingTime(t *testing.T) {
+	auction := types.NewRewardsAuction(
+		1,
+		1,
+		utils.ParseTime("2022-01-01T00:00:00Z"),
+		utils.ParseTime("2022-01-01T08:00:00Z"),
+	)
+	auction.AuctionMode = types.AuctionModeCandle
+	auction.CandleWindow = time.Hour
+	require.Equal(t, utils.ParseTime("2022-01-01T07:00:00Z"), auction.CandleWindowStartTime())
+
+	for i := 0; i < 100; i++ {
+		seed := sdk.Uint64ToBigEndian(uint64(i))
+		closingTime := auction.CandleClosingTime(seed)
+		require.False(t, closingTime.Before(auction.CandleWindowStartTime()))
+		require.True(t, closingTime.Before(auction.EndTime))
+		require.Equal(t, closingTime, auction.CandleClosingTime(seed))
+	}
+
+	// The candle window doesn't exceed the auction period
+	auction.CandleWindow = 24 * time.Hour
+	require.Equal(t, auction.StartTime, auction.CandleWindowStartTime())
+	closingTime := auction.CandleClosingTime([]byte("seed"))
+	require.False(t, closingTime.Before(auction.StartTime))
+	require.True(t, closingTime.Before(auction.EndTime))
+}
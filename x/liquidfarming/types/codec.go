@@ -15,6 +15,12 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgLiquidUnfarmAndWithdraw{}, "liquidfarming/MsgLiquidUnfarmAndWithdraw", nil)
 	cdc.RegisterConcrete(&MsgPlaceBid{}, "liquidfarming/MsgPlaceBid", nil)
 	cdc.RegisterConcrete(&MsgRefundBid{}, "liquidfarming/MsgRefundBid", nil)
+	cdc.RegisterConcrete(&MsgPairLiquidFarm{}, "liquidfarming/MsgPairLiquidFarm", nil)
+	cdc.RegisterConcrete(&MsgPairLiquidUnfarm{}, "liquidfarming/MsgPairLiquidUnfarm", nil)
+	cdc.RegisterConcrete(&MsgPlacePairBid{}, "liquidfarming/MsgPlacePairBid", nil)
+	cdc.RegisterConcrete(&MsgRefundPairBid{}, "liquidfarming/MsgRefundPairBid", nil)
+	cdc.RegisterConcrete(&MsgVaultFarm{}, "liquidfarming/MsgVaultFarm", nil)
+	cdc.RegisterConcrete(&MsgVaultUnfarm{}, "liquidfarming/MsgVaultUnfarm", nil)
 }
 
 // RegisterInterfaces registers the x/liquidfarming interfaces types with the interface registry
@@ -26,6 +32,12 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgLiquidUnfarmAndWithdraw{},
 		&MsgPlaceBid{},
 		&MsgRefundBid{},
+		&MsgPairLiquidFarm{},
+		&MsgPairLiquidUnfarm{},
+		&MsgPlacePairBid{},
+		&MsgRefundPairBid{},
+		&MsgVaultFarm{},
+		&MsgVaultUnfarm{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
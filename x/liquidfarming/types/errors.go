@@ -10,4 +10,8 @@ import (
 var (
 	ErrSmallerThanMinimumAmount      = sdkerrors.Register(ModuleName, 2, "smaller than the minimum amount")
 	ErrNotBiggerThanWinningBidAmount = sdkerrors.Register(ModuleName, 3, "not bigger than the winning bid amount")
+	ErrRefundInCandleWindow          = sdkerrors.Register(ModuleName, 4, "bid can't be refunded in the candle window")
+	ErrPairLastPriceNotSet           = sdkerrors.Register(ModuleName, 5, "pair has no last price")
+	ErrNoCompoundingPool             = sdkerrors.Register(ModuleName, 6, "no pool to compound rewards into")
+	ErrVaultCompounding              = sdkerrors.Register(ModuleName, 7, "vault compounding is outstanding")
 )
@@ -7,8 +7,17 @@ const (
 	EventTypeLiquidUnfarmAndWithdraw = "liquid_unfarm_and_withdraw"
 	EventTypePlaceBid                = "place_bid"
 	EventTypeRefundBid               = "refund_bid"
+	EventTypeCloseCandleAuction      = "close_candle_auction"
+	EventTypePairLiquidFarm          = "pair_liquid_farm"
+	EventTypePairLiquidUnfarm        = "pair_liquid_unfarm"
+	EventTypePlacePairBid            = "place_pair_bid"
+	EventTypeRefundPairBid           = "refund_pair_bid"
+	EventTypeVaultFarm               = "vault_farm"
+	EventTypeVaultUnfarm             = "vault_unfarm"
+	EventTypeCompoundVault           = "compound_vault"
 
 	AttributeKeyPoolId                   = "pool_id"
+	AttributeKeyPairId                   = "pair_id"
 	AttributeKeyAuctionId                = "auction_id"
 	AttributeKeyBidId                    = "bid_id"
 	AttributeKeyFarmer                   = "farmer"
@@ -21,4 +30,11 @@ const (
 	AttributeKeyUnfarmingCoin            = "unfarming_coin"
 	AttributeKeyUnfarmedCoin             = "unfarmed_coin"
 	AttributeKeyRefundCoin               = "refund_coin"
+	AttributeKeyClosingTime              = "closing_time"
+	AttributeKeyWinner                   = "winner"
+	AttributeKeyWinningAmount            = "winning_amount"
+	AttributeKeyUnfarmedCoins            = "unfarmed_coins"
+	AttributeKeyVaultReserveAddress      = "vault_reserve_address"
+	AttributeKeyHarvestedRewards         = "harvested_rewards"
+	AttributeKeyDepositCoins             = "deposit_coins"
 )
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
@@ -39,6 +41,17 @@ type LPFarmKeeper interface {
 
 // LiquidityKeeper defines the expected interface needed for the module.
 type LiquidityKeeper interface {
+	GetPair(ctx sdk.Context, id uint64) (pair liquiditytypes.Pair, found bool)
 	GetPool(ctx sdk.Context, id uint64) (pool liquiditytypes.Pool, found bool)
+	GetPoolsByPair(ctx sdk.Context, pairId uint64) (pools []liquiditytypes.Pool)
+	GetPoolBalances(ctx sdk.Context, pool liquiditytypes.Pool) (rx sdk.Coin, ry sdk.Coin)
+	GetPoolCoinSupply(ctx sdk.Context, pool liquiditytypes.Pool) sdk.Int
 	Withdraw(ctx sdk.Context, msg *liquiditytypes.MsgWithdraw) (liquiditytypes.WithdrawRequest, error)
+	GetPairByDenoms(ctx sdk.Context, baseCoinDenom, quoteCoinDenom string) (pair liquiditytypes.Pair, found bool)
+	PriceLimits(ctx sdk.Context, lastPrice sdk.Dec) (lowest, highest sdk.Dec)
+	MarketOrder(ctx sdk.Context, msg *liquiditytypes.MsgMarketOrder) (liquiditytypes.Order, error)
+	Deposit(ctx sdk.Context, msg *liquiditytypes.MsgDeposit) (liquiditytypes.DepositRequest, error)
+	GetTWAP(ctx sdk.Context, pairId uint64, window time.Duration) (twap sdk.Dec, err error)
+	IterateOrdersByOrderer(ctx sdk.Context, orderer sdk.AccAddress, cb func(order liquiditytypes.Order) (stop bool, err error)) error
+	IterateDepositRequestsByDepositor(ctx sdk.Context, depositor sdk.AccAddress, cb func(req liquiditytypes.DepositRequest) (stop bool, err error)) error
 }
@@ -7,13 +7,21 @@ import (
 // DefaultGenesis returns the default genesis state.
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Params:                     DefaultParams(),
-		LastRewardsAuctionIdRecord: []LastRewardsAuctionIdRecord{},
-		LiquidFarms:                []LiquidFarm{},
-		RewardsAuctions:            []RewardsAuction{},
-		Bids:                       []Bid{},
-		WinningBidRecords:          []WinningBidRecord{},
-		LastRewardsAuctionEndTime:  nil,
+		Params:                          DefaultParams(),
+		LastRewardsAuctionIdRecord:      []LastRewardsAuctionIdRecord{},
+		LiquidFarms:                     []LiquidFarm{},
+		RewardsAuctions:                 []RewardsAuction{},
+		Bids:                            []Bid{},
+		WinningBidRecords:               []WinningBidRecord{},
+		LastRewardsAuctionEndTime:       nil,
+		LeadingBids:                     []LeadingBid{},
+		PairLiquidFarms:                 []PairLiquidFarm{},
+		LastPairRewardsAuctionIdRecords: []LastPairRewardsAuctionIdRecord{},
+		PairRewardsAuctions:             []RewardsAuction{},
+		PairBids:                        []Bid{},
+		PairWinningBidRecords:           []WinningBidRecord{},
+		Vaults:                          []Vault{},
+		VaultCompoundingRecords:         []VaultCompoundingRecord{},
 	}
 }
 
@@ -57,5 +65,83 @@ func (gs GenesisState) Validate() error {
 		winningBidMap[record.AuctionId] = record.WinningBid
 	}
 
+	for _, leadingBid := range gs.LeadingBids {
+		if err := leadingBid.Validate(); err != nil {
+			return fmt.Errorf("invalid leading bid: %w", err)
+		}
+	}
+
+	for _, pairLiquidFarm := range gs.PairLiquidFarms {
+		if err := pairLiquidFarm.Validate(); err != nil {
+			return fmt.Errorf("invalid pair liquid farm %w", err)
+		}
+	}
+
+	for _, record := range gs.LastPairRewardsAuctionIdRecords {
+		if record.PairId == 0 {
+			return fmt.Errorf("pair id must not be 0")
+		}
+	}
+
+	for _, auction := range gs.PairRewardsAuctions {
+		if auction.PairId == 0 {
+			return fmt.Errorf("pair id of pair rewards auction must not be 0")
+		}
+		if err := auction.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, bid := range gs.PairBids {
+		if bid.PairId == 0 {
+			return fmt.Errorf("pair id of pair bid must not be 0")
+		}
+		if err := bid.Validate(); err != nil {
+			return err
+		}
+	}
+
+	pairWinningBidMap := map[uint64]map[uint64]struct{}{} // PairId => AuctionId => struct{}
+	for _, record := range gs.PairWinningBidRecords {
+		if record.AuctionId == 0 {
+			return fmt.Errorf("auction id must not be 0")
+		}
+
+		if record.WinningBid.PairId == 0 {
+			return fmt.Errorf("pair id of pair winning bid must not be 0")
+		}
+
+		if err := record.WinningBid.Validate(); err != nil {
+			return fmt.Errorf("invalid winning bid: %w", err)
+		}
+
+		auctionIds, ok := pairWinningBidMap[record.WinningBid.PairId]
+		if !ok {
+			auctionIds = map[uint64]struct{}{}
+			pairWinningBidMap[record.WinningBid.PairId] = auctionIds
+		}
+		if _, ok := auctionIds[record.AuctionId]; ok {
+			return fmt.Errorf("multiple winning bids at auction %d of pair %d", record.AuctionId, record.WinningBid.PairId)
+		}
+		auctionIds[record.AuctionId] = struct{}{}
+	}
+
+	for _, vault := range gs.Vaults {
+		if err := vault.Validate(); err != nil {
+			return fmt.Errorf("invalid vault %w", err)
+		}
+	}
+
+	vaultCompoundingRecordMap := map[uint64]struct{}{} // PoolId => struct{}
+	for _, record := range gs.VaultCompoundingRecords {
+		if record.PoolId == 0 {
+			return fmt.Errorf("pool id must not be 0")
+		}
+		if _, ok := vaultCompoundingRecordMap[record.PoolId]; ok {
+			return fmt.Errorf("multiple vault compounding records for pool %d", record.PoolId)
+		}
+		vaultCompoundingRecordMap[record.PoolId] = struct{}{}
+	}
+
 	return nil
 }
@@ -29,13 +29,21 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the liquidfarming module's genesis state.
 type GenesisState struct {
-	Params                     Params                       `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	LastRewardsAuctionIdRecord []LastRewardsAuctionIdRecord `protobuf:"bytes,2,rep,name=last_rewards_auction_id_record,json=lastRewardsAuctionIdRecord,proto3" json:"last_rewards_auction_id_record"`
-	LiquidFarms                []LiquidFarm                 `protobuf:"bytes,3,rep,name=liquid_farms,json=liquidFarms,proto3" json:"liquid_farms"`
-	RewardsAuctions            []RewardsAuction             `protobuf:"bytes,4,rep,name=rewards_auctions,json=rewardsAuctions,proto3" json:"rewards_auctions"`
-	Bids                       []Bid                        `protobuf:"bytes,5,rep,name=bids,proto3" json:"bids"`
-	WinningBidRecords          []WinningBidRecord           `protobuf:"bytes,6,rep,name=winning_bid_records,json=winningBidRecords,proto3" json:"winning_bid_records"`
-	LastRewardsAuctionEndTime  *time.Time                   `protobuf:"bytes,7,opt,name=last_rewards_auction_end_time,json=lastRewardsAuctionEndTime,proto3,stdtime" json:"last_rewards_auction_end_time,omitempty"`
+	Params                          Params                           `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	LastRewardsAuctionIdRecord      []LastRewardsAuctionIdRecord     `protobuf:"bytes,2,rep,name=last_rewards_auction_id_record,json=lastRewardsAuctionIdRecord,proto3" json:"last_rewards_auction_id_record"`
+	LiquidFarms                     []LiquidFarm                     `protobuf:"bytes,3,rep,name=liquid_farms,json=liquidFarms,proto3" json:"liquid_farms"`
+	RewardsAuctions                 []RewardsAuction                 `protobuf:"bytes,4,rep,name=rewards_auctions,json=rewardsAuctions,proto3" json:"rewards_auctions"`
+	Bids                            []Bid                            `protobuf:"bytes,5,rep,name=bids,proto3" json:"bids"`
+	WinningBidRecords               []WinningBidRecord               `protobuf:"bytes,6,rep,name=winning_bid_records,json=winningBidRecords,proto3" json:"winning_bid_records"`
+	LastRewardsAuctionEndTime       *time.Time                       `protobuf:"bytes,7,opt,name=last_rewards_auction_end_time,json=lastRewardsAuctionEndTime,proto3,stdtime" json:"last_rewards_auction_end_time,omitempty"`
+	LeadingBids                     []LeadingBid                     `protobuf:"bytes,8,rep,name=leading_bids,json=leadingBids,proto3" json:"leading_bids"`
+	PairLiquidFarms                 []PairLiquidFarm                 `protobuf:"bytes,9,rep,name=pair_liquid_farms,json=pairLiquidFarms,proto3" json:"pair_liquid_farms"`
+	LastPairRewardsAuctionIdRecords []LastPairRewardsAuctionIdRecord `protobuf:"bytes,10,rep,name=last_pair_rewards_auction_id_records,json=lastPairRewardsAuctionIdRecords,proto3" json:"last_pair_rewards_auction_id_records"`
+	PairRewardsAuctions             []RewardsAuction                 `protobuf:"bytes,11,rep,name=pair_rewards_auctions,json=pairRewardsAuctions,proto3" json:"pair_rewards_auctions"`
+	PairBids                        []Bid                            `protobuf:"bytes,12,rep,name=pair_bids,json=pairBids,proto3" json:"pair_bids"`
+	PairWinningBidRecords           []WinningBidRecord               `protobuf:"bytes,13,rep,name=pair_winning_bid_records,json=pairWinningBidRecords,proto3" json:"pair_winning_bid_records"`
+	Vaults                          []Vault                          `protobuf:"bytes,14,rep,name=vaults,proto3" json:"vaults"`
+	VaultCompoundingRecords         []VaultCompoundingRecord         `protobuf:"bytes,15,rep,name=vault_compounding_records,json=vaultCompoundingRecords,proto3" json:"vault_compounding_records"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -109,6 +117,44 @@ func (m *LastRewardsAuctionIdRecord) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_LastRewardsAuctionIdRecord proto.InternalMessageInfo
 
+type LastPairRewardsAuctionIdRecord struct {
+	PairId    uint64 `protobuf:"varint,1,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	AuctionId uint64 `protobuf:"varint,2,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+}
+
+func (m *LastPairRewardsAuctionIdRecord) Reset()         { *m = LastPairRewardsAuctionIdRecord{} }
+func (m *LastPairRewardsAuctionIdRecord) String() string { return proto.CompactTextString(m) }
+func (*LastPairRewardsAuctionIdRecord) ProtoMessage()    {}
+func (*LastPairRewardsAuctionIdRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90f9cf71ffd184b0, []int{2}
+}
+func (m *LastPairRewardsAuctionIdRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LastPairRewardsAuctionIdRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LastPairRewardsAuctionIdRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LastPairRewardsAuctionIdRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LastPairRewardsAuctionIdRecord.Merge(m, src)
+}
+func (m *LastPairRewardsAuctionIdRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *LastPairRewardsAuctionIdRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_LastPairRewardsAuctionIdRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LastPairRewardsAuctionIdRecord proto.InternalMessageInfo
+
 // WinningBidRecord defines a custom winning bid record that is required to be recorded
 // in genesis state.
 type WinningBidRecord struct {
@@ -120,7 +166,7 @@ func (m *WinningBidRecord) Reset()         { *m = WinningBidRecord{} }
 func (m *WinningBidRecord) String() string { return proto.CompactTextString(m) }
 func (*WinningBidRecord) ProtoMessage()    {}
 func (*WinningBidRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_90f9cf71ffd184b0, []int{2}
+	return fileDescriptor_90f9cf71ffd184b0, []int{3}
 }
 func (m *WinningBidRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -149,10 +195,51 @@ func (m *WinningBidRecord) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_WinningBidRecord proto.InternalMessageInfo
 
+// VaultCompoundingRecord defines the last time the vault compounded its farming rewards.
+type VaultCompoundingRecord struct {
+	PoolId           uint64    `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	LastCompoundedAt time.Time `protobuf:"bytes,2,opt,name=last_compounded_at,json=lastCompoundedAt,proto3,stdtime" json:"last_compounded_at"`
+}
+
+func (m *VaultCompoundingRecord) Reset()         { *m = VaultCompoundingRecord{} }
+func (m *VaultCompoundingRecord) String() string { return proto.CompactTextString(m) }
+func (*VaultCompoundingRecord) ProtoMessage()    {}
+func (*VaultCompoundingRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90f9cf71ffd184b0, []int{4}
+}
+func (m *VaultCompoundingRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VaultCompoundingRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VaultCompoundingRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VaultCompoundingRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VaultCompoundingRecord.Merge(m, src)
+}
+func (m *VaultCompoundingRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *VaultCompoundingRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_VaultCompoundingRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VaultCompoundingRecord proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "squad.liquidfarming.v1beta1.GenesisState")
 	proto.RegisterType((*LastRewardsAuctionIdRecord)(nil), "squad.liquidfarming.v1beta1.LastRewardsAuctionIdRecord")
+	proto.RegisterType((*LastPairRewardsAuctionIdRecord)(nil), "squad.liquidfarming.v1beta1.LastPairRewardsAuctionIdRecord")
 	proto.RegisterType((*WinningBidRecord)(nil), "squad.liquidfarming.v1beta1.WinningBidRecord")
+	proto.RegisterType((*VaultCompoundingRecord)(nil), "squad.liquidfarming.v1beta1.VaultCompoundingRecord")
 }
 
 func init() {
@@ -160,41 +247,55 @@ func init() {
 }
 
 var fileDescriptor_90f9cf71ffd184b0 = []byte{
-	// 533 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x4f, 0x6b, 0xd4, 0x40,
-	0x18, 0xc6, 0x93, 0x36, 0xdd, 0xea, 0x6c, 0xc1, 0x3a, 0x0a, 0xc6, 0x48, 0x93, 0x52, 0x0f, 0xae,
-	0x48, 0x13, 0x5a, 0x41, 0xa1, 0xb7, 0x06, 0xb4, 0x14, 0x3c, 0x2c, 0x51, 0x10, 0x44, 0x0c, 0x93,
-	0xcc, 0x34, 0x0e, 0x24, 0x99, 0x34, 0x33, 0x71, 0xf5, 0x28, 0x78, 0xf0, 0xd8, 0x83, 0x1f, 0xa0,
-	0x1f, 0xa7, 0xc7, 0x1e, 0x3d, 0xa9, 0xec, 0x5e, 0xfc, 0x18, 0x92, 0x99, 0x6c, 0xb7, 0x89, 0x36,
-	0x78, 0xcb, 0xbc, 0x79, 0x9e, 0xdf, 0x3b, 0xef, 0x9f, 0x04, 0x3c, 0xe4, 0xc7, 0x15, 0xc2, 0x5e,
-	0x4a, 0x8f, 0x2b, 0x8a, 0x8f, 0x50, 0x99, 0xd1, 0x3c, 0xf1, 0x3e, 0xec, 0x44, 0x44, 0xa0, 0x1d,
-	0x2f, 0x21, 0x39, 0xe1, 0x94, 0xbb, 0x45, 0xc9, 0x04, 0x83, 0xf7, 0xa4, 0xd4, 0x6d, 0x49, 0xdd,
-	0x46, 0x6a, 0xdd, 0x4e, 0x58, 0xc2, 0xa4, 0xce, 0xab, 0x9f, 0x94, 0xc5, 0x72, 0x12, 0xc6, 0x92,
-	0x94, 0x78, 0xf2, 0x14, 0x55, 0x47, 0x9e, 0xa0, 0x19, 0xe1, 0x02, 0x65, 0x45, 0x23, 0xf0, 0xfa,
-	0xd2, 0xb7, 0x33, 0x29, 0xc3, 0xa8, 0xcf, 0x50, 0xa0, 0x12, 0x65, 0xcd, 0x75, 0xb7, 0xbe, 0xad,
-	0x80, 0xb5, 0x03, 0x55, 0xc0, 0x4b, 0x81, 0x04, 0x81, 0xfb, 0x60, 0xa0, 0x04, 0xa6, 0xbe, 0xa9,
-	0x8f, 0x86, 0xbb, 0xf7, 0xdd, 0x9e, 0x82, 0xdc, 0xb1, 0x94, 0xfa, 0xc6, 0xd9, 0x0f, 0x47, 0x0b,
-	0x1a, 0x23, 0xfc, 0xac, 0x03, 0x3b, 0x45, 0x5c, 0x84, 0x25, 0x99, 0xa0, 0x12, 0xf3, 0x10, 0x55,
-	0xb1, 0xa0, 0x2c, 0x0f, 0x29, 0x0e, 0x4b, 0x12, 0xb3, 0x12, 0x9b, 0x4b, 0x9b, 0xcb, 0xa3, 0xe1,
-	0xee, 0xd3, 0x5e, 0xf6, 0x0b, 0xc4, 0x45, 0xa0, 0x08, 0xfb, 0x0a, 0x70, 0x88, 0x03, 0x69, 0x6f,
-	0xf2, 0x59, 0xe9, 0x95, 0x0a, 0x38, 0x06, 0x6b, 0x8a, 0x1a, 0xd6, 0x58, 0x6e, 0x2e, 0xcb, 0x84,
-	0x0f, 0xfa, 0x13, 0xca, 0xe8, 0x73, 0x54, 0x66, 0x4d, 0x82, 0x61, 0x7a, 0x11, 0xe1, 0xf0, 0x2d,
-	0x58, 0xef, 0xd4, 0xc3, 0x4d, 0x43, 0x52, 0x1f, 0xf5, 0x52, 0xdb, 0x17, 0x6c, 0xc8, 0x37, 0xca,
-	0x56, 0x94, 0xc3, 0x3d, 0x60, 0x44, 0x14, 0x73, 0x73, 0x45, 0x12, 0x37, 0x7b, 0x89, 0x3e, 0x9d,
-	0x77, 0x40, 0x7a, 0x60, 0x0c, 0x6e, 0x4d, 0x68, 0x9e, 0xd3, 0x3c, 0x09, 0xa3, 0x8b, 0x16, 0x73,
-	0x73, 0x20, 0x51, 0xdb, 0xbd, 0xa8, 0xd7, 0xca, 0xe7, 0xd3, 0x76, 0x67, 0x6f, 0x4e, 0x3a, 0x71,
-	0x0e, 0x23, 0xb0, 0xf1, 0xcf, 0x99, 0x92, 0x1c, 0x87, 0xf5, 0xbe, 0x9a, 0xab, 0x72, 0x5d, 0x2c,
-	0x57, 0x2d, 0xb3, 0x3b, 0x5f, 0x66, 0xf7, 0xd5, 0x7c, 0x99, 0x7d, 0xe3, 0xe4, 0xa7, 0xa3, 0x07,
-	0x77, 0xff, 0x9e, 0xda, 0xb3, 0x1c, 0xd7, 0xaa, 0xbd, 0x6b, 0x5f, 0x4f, 0x1d, 0xed, 0xf7, 0xa9,
-	0xa3, 0x6d, 0xbd, 0x03, 0xd6, 0xd5, 0xe3, 0x87, 0x77, 0xc0, 0x6a, 0xc1, 0x58, 0x1a, 0x52, 0x2c,
-	0x97, 0xd4, 0x08, 0x06, 0xf5, 0xf1, 0x10, 0xc3, 0x0d, 0x00, 0x16, 0xbb, 0x66, 0x2e, 0xc9, 0x77,
-	0xd7, 0xd1, 0xdc, 0x7d, 0x89, 0xff, 0x45, 0x07, 0xeb, 0xdd, 0xda, 0x3b, 0x6e, 0xbd, 0xe3, 0x86,
-	0x07, 0x60, 0x78, 0xa9, 0xcd, 0x92, 0xfe, 0xff, 0x93, 0x02, 0x8b, 0x8e, 0x2e, 0xae, 0xe1, 0x8f,
-	0xcf, 0xa6, 0xb6, 0x7e, 0x3e, 0xb5, 0xf5, 0x5f, 0x53, 0x5b, 0x3f, 0x99, 0xd9, 0xda, 0xf9, 0xcc,
-	0xd6, 0xbe, 0xcf, 0x6c, 0xed, 0xcd, 0x93, 0x84, 0x8a, 0xf7, 0x55, 0xe4, 0xc6, 0x2c, 0xf3, 0x62,
-	0xc6, 0x33, 0x26, 0xd3, 0x6c, 0xa7, 0x28, 0xe2, 0xcd, 0xdf, 0xe0, 0x63, 0xe7, 0xf3, 0x16, 0x9f,
-	0x0a, 0xc2, 0xa3, 0x81, 0xec, 0xfb, 0xe3, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x87, 0x50, 0xc1,
-	0xbf, 0xb2, 0x04, 0x00, 0x00,
+	// 760 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x4f, 0xd4, 0x5c,
+	0x14, 0x9d, 0xc2, 0x7c, 0xc3, 0xf0, 0xca, 0xf7, 0x01, 0x8f, 0x4f, 0x29, 0x63, 0xe8, 0x10, 0x34,
+	0x11, 0x63, 0x68, 0x03, 0x24, 0x9a, 0xe0, 0x46, 0x86, 0x28, 0x21, 0x71, 0x31, 0x19, 0x8d, 0x24,
+	0x46, 0x6d, 0x5e, 0xa7, 0xa5, 0xbe, 0xa4, 0xed, 0x2b, 0x7d, 0xaf, 0xa0, 0x4b, 0x13, 0x17, 0xee,
+	0xe4, 0x4f, 0x60, 0xef, 0x3f, 0xc2, 0x92, 0xa5, 0x2b, 0x35, 0xb0, 0xf1, 0xcf, 0x30, 0xbd, 0x6d,
+	0x67, 0xa6, 0xf3, 0xa3, 0xfc, 0xd8, 0xb5, 0xaf, 0xf7, 0x9c, 0x73, 0xdf, 0x3d, 0x87, 0xcb, 0xa0,
+	0x07, 0xfc, 0x20, 0x22, 0x96, 0xee, 0xd2, 0x83, 0x88, 0x5a, 0xfb, 0x24, 0xf4, 0xa8, 0xef, 0xe8,
+	0x87, 0x6b, 0xa6, 0x2d, 0xc8, 0x9a, 0xee, 0xd8, 0xbe, 0xcd, 0x29, 0xd7, 0x82, 0x90, 0x09, 0x86,
+	0xef, 0x40, 0xa9, 0x96, 0x2b, 0xd5, 0xd2, 0xd2, 0xda, 0xff, 0x0e, 0x73, 0x18, 0xd4, 0xe9, 0xf1,
+	0x53, 0x02, 0xa9, 0xd5, 0x1d, 0xc6, 0x1c, 0xd7, 0xd6, 0xe1, 0xcd, 0x8c, 0xf6, 0x75, 0x41, 0x3d,
+	0x9b, 0x0b, 0xe2, 0x05, 0x69, 0x81, 0x5e, 0x24, 0x9f, 0x57, 0x4a, 0x00, 0x2b, 0x45, 0x80, 0x80,
+	0x84, 0xc4, 0x4b, 0xdb, 0x5d, 0xfe, 0x2e, 0xa3, 0xa9, 0x9d, 0xe4, 0x02, 0x2f, 0x05, 0x11, 0x36,
+	0xde, 0x42, 0x95, 0xa4, 0x40, 0x91, 0x96, 0xa4, 0x15, 0x79, 0xfd, 0xae, 0x56, 0x70, 0x21, 0xad,
+	0x09, 0xa5, 0x8d, 0xf2, 0xe9, 0xcf, 0x7a, 0xa9, 0x95, 0x02, 0xf1, 0x67, 0x09, 0xa9, 0x2e, 0xe1,
+	0xc2, 0x08, 0xed, 0x23, 0x12, 0x5a, 0xdc, 0x20, 0x51, 0x5b, 0x50, 0xe6, 0x1b, 0xd4, 0x32, 0x42,
+	0xbb, 0xcd, 0x42, 0x4b, 0x19, 0x5b, 0x1a, 0x5f, 0x91, 0xd7, 0x1f, 0x17, 0x72, 0xbf, 0x20, 0x5c,
+	0xb4, 0x12, 0x86, 0xad, 0x84, 0x60, 0xd7, 0x6a, 0x01, 0x3c, 0xd5, 0xab, 0xb9, 0x23, 0x2b, 0x70,
+	0x13, 0x4d, 0x25, 0xac, 0x46, 0x4c, 0xcb, 0x95, 0x71, 0x10, 0xbc, 0x5f, 0x2c, 0x08, 0xa7, 0xcf,
+	0x49, 0xe8, 0xa5, 0x02, 0xb2, 0xdb, 0x39, 0xe1, 0xf8, 0x2d, 0x9a, 0xe9, 0xbb, 0x0f, 0x57, 0xca,
+	0xc0, 0xfa, 0xb0, 0x90, 0x35, 0xdf, 0x60, 0xca, 0x3c, 0x1d, 0xe6, 0x4e, 0x39, 0xde, 0x44, 0x65,
+	0x93, 0x5a, 0x5c, 0xf9, 0x07, 0x18, 0x97, 0x0a, 0x19, 0x1b, 0x34, 0x9b, 0x00, 0x60, 0x70, 0x1b,
+	0xcd, 0x1d, 0x51, 0xdf, 0xa7, 0xbe, 0x63, 0x98, 0x9d, 0x11, 0x73, 0xa5, 0x02, 0x54, 0xab, 0x85,
+	0x54, 0x7b, 0x09, 0xae, 0x41, 0xf3, 0x93, 0x9d, 0x3d, 0xea, 0x3b, 0xe7, 0xd8, 0x44, 0x8b, 0x43,
+	0x3d, 0xb5, 0x7d, 0xcb, 0x88, 0xf3, 0xaa, 0x4c, 0x40, 0x5c, 0x6a, 0x5a, 0x12, 0x66, 0x2d, 0x0b,
+	0xb3, 0xf6, 0x2a, 0x0b, 0x73, 0xa3, 0x7c, 0xfc, 0xab, 0x2e, 0xb5, 0x16, 0x06, 0x5d, 0x7b, 0xe6,
+	0x5b, 0x71, 0x15, 0x98, 0x66, 0x13, 0x2b, 0xbd, 0x08, 0x57, 0xaa, 0x57, 0x31, 0x2d, 0x01, 0x74,
+	0x67, 0x22, 0xbb, 0x9d, 0x13, 0x8e, 0xdf, 0xa1, 0xd9, 0x80, 0xd0, 0xd0, 0xc8, 0x65, 0x61, 0xf2,
+	0x0a, 0xae, 0x35, 0x09, 0x0d, 0x07, 0xf2, 0x30, 0x1d, 0xe4, 0x4e, 0x39, 0x3e, 0x96, 0xd0, 0x3d,
+	0x98, 0x0a, 0x88, 0x8c, 0x8c, 0x3b, 0x57, 0x10, 0x48, 0x3e, 0xb9, 0x34, 0xef, 0xb1, 0x6c, 0x61,
+	0xe6, 0xeb, 0x6e, 0x61, 0x15, 0xc7, 0x36, 0xba, 0x35, 0xac, 0x19, 0xae, 0xc8, 0x37, 0xcd, 0xea,
+	0x5c, 0x30, 0x20, 0xc7, 0xf1, 0x36, 0x9a, 0x04, 0x19, 0xf0, 0x69, 0xea, 0x5a, 0xa1, 0xad, 0xc6,
+	0x40, 0x70, 0xc7, 0x45, 0x0a, 0x90, 0x0c, 0x4b, 0xef, 0xbf, 0x37, 0x4f, 0x2f, 0x0c, 0x60, 0x6f,
+	0x20, 0xc1, 0x4f, 0x51, 0xe5, 0x90, 0x44, 0xae, 0xe0, 0xca, 0x7f, 0xc0, 0xbd, 0x5c, 0xc8, 0xfd,
+	0x3a, 0x2e, 0xcd, 0x16, 0x5b, 0x82, 0xc3, 0x11, 0x5a, 0x80, 0x27, 0xa3, 0xcd, 0xbc, 0x80, 0x45,
+	0x3e, 0x24, 0x35, 0x6b, 0x78, 0x1a, 0x48, 0x37, 0x2e, 0x27, 0xdd, 0xee, 0x82, 0x73, 0x6d, 0xcf,
+	0x1f, 0x0e, 0xfd, 0xca, 0x37, 0xab, 0x5f, 0x4f, 0xea, 0xa5, 0x3f, 0x27, 0xf5, 0xd2, 0xf2, 0x7b,
+	0x54, 0x1b, 0xbd, 0x15, 0xf1, 0x3c, 0x9a, 0x08, 0x18, 0x73, 0x0d, 0x6a, 0xc1, 0xee, 0x2e, 0xb7,
+	0x2a, 0xf1, 0xeb, 0xae, 0x85, 0x17, 0x11, 0xea, 0x66, 0x52, 0x19, 0x83, 0x6f, 0x93, 0x24, 0x43,
+	0xf7, 0xf0, 0x9b, 0x48, 0x2d, 0x4e, 0x21, 0x68, 0xc4, 0x96, 0xf5, 0x68, 0x10, 0x1a, 0x5e, 0x47,
+	0xe3, 0x8b, 0x84, 0x66, 0xfa, 0xcd, 0xe9, 0x43, 0x4b, 0x7d, 0x68, 0xbc, 0x83, 0xe4, 0x9e, 0x8c,
+	0x00, 0xfb, 0xd5, 0xf3, 0x86, 0xba, 0xcb, 0xac, 0xa7, 0x8d, 0x6f, 0x12, 0xba, 0x3d, 0xdc, 0x8e,
+	0xd1, 0x73, 0x6c, 0x21, 0x0c, 0x7f, 0xed, 0x99, 0xfd, 0xb6, 0x65, 0x10, 0x91, 0x76, 0x53, 0xb4,
+	0xf8, 0xaa, 0x71, 0x1f, 0xb0, 0xfc, 0x66, 0x62, 0xfc, 0x76, 0x07, 0xbe, 0x25, 0xba, 0x1d, 0x35,
+	0x9a, 0xa7, 0xe7, 0xaa, 0x74, 0x76, 0xae, 0x4a, 0xbf, 0xcf, 0x55, 0xe9, 0xf8, 0x42, 0x2d, 0x9d,
+	0x5d, 0xa8, 0xa5, 0x1f, 0x17, 0x6a, 0xe9, 0xcd, 0x23, 0x87, 0x8a, 0x0f, 0x91, 0xa9, 0xb5, 0x99,
+	0xa7, 0xb7, 0x19, 0xf7, 0x18, 0x5c, 0x7c, 0xd5, 0x25, 0x26, 0x4f, 0x7f, 0x1a, 0x7c, 0xec, 0xfb,
+	0x5f, 0x2f, 0x3e, 0x05, 0x36, 0x37, 0x2b, 0xd0, 0xcb, 0xc6, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff,
+	0x0a, 0xd6, 0x85, 0xcc, 0xbf, 0x08, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -217,6 +318,118 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.VaultCompoundingRecords) > 0 {
+		for iNdEx := len(m.VaultCompoundingRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VaultCompoundingRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.Vaults) > 0 {
+		for iNdEx := len(m.Vaults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vaults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.PairWinningBidRecords) > 0 {
+		for iNdEx := len(m.PairWinningBidRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PairWinningBidRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.PairBids) > 0 {
+		for iNdEx := len(m.PairBids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PairBids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.PairRewardsAuctions) > 0 {
+		for iNdEx := len(m.PairRewardsAuctions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PairRewardsAuctions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.LastPairRewardsAuctionIdRecords) > 0 {
+		for iNdEx := len(m.LastPairRewardsAuctionIdRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LastPairRewardsAuctionIdRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.PairLiquidFarms) > 0 {
+		for iNdEx := len(m.PairLiquidFarms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PairLiquidFarms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.LeadingBids) > 0 {
+		for iNdEx := len(m.LeadingBids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LeadingBids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if m.LastRewardsAuctionEndTime != nil {
 		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastRewardsAuctionEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastRewardsAuctionEndTime):])
 		if err1 != nil {
@@ -343,6 +556,39 @@ func (m *LastRewardsAuctionIdRecord) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *LastPairRewardsAuctionIdRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LastPairRewardsAuctionIdRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LastPairRewardsAuctionIdRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PairId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PairId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *WinningBidRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -381,6 +627,42 @@ func (m *WinningBidRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VaultCompoundingRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VaultCompoundingRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VaultCompoundingRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastCompoundedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastCompoundedAt):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintGenesis(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -434,6 +716,54 @@ func (m *GenesisState) Size() (n int) {
 		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastRewardsAuctionEndTime)
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.LeadingBids) > 0 {
+		for _, e := range m.LeadingBids {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PairLiquidFarms) > 0 {
+		for _, e := range m.PairLiquidFarms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LastPairRewardsAuctionIdRecords) > 0 {
+		for _, e := range m.LastPairRewardsAuctionIdRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PairRewardsAuctions) > 0 {
+		for _, e := range m.PairRewardsAuctions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PairBids) > 0 {
+		for _, e := range m.PairBids {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PairWinningBidRecords) > 0 {
+		for _, e := range m.PairWinningBidRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Vaults) > 0 {
+		for _, e := range m.Vaults {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VaultCompoundingRecords) > 0 {
+		for _, e := range m.VaultCompoundingRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -452,6 +782,21 @@ func (m *LastRewardsAuctionIdRecord) Size() (n int) {
 	return n
 }
 
+func (m *LastPairRewardsAuctionIdRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PairId != 0 {
+		n += 1 + sovGenesis(uint64(m.PairId))
+	}
+	if m.AuctionId != 0 {
+		n += 1 + sovGenesis(uint64(m.AuctionId))
+	}
+	return n
+}
+
 func (m *WinningBidRecord) Size() (n int) {
 	if m == nil {
 		return 0
@@ -466,6 +811,20 @@ func (m *WinningBidRecord) Size() (n int) {
 	return n
 }
 
+func (m *VaultCompoundingRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovGenesis(uint64(m.PoolId))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastCompoundedAt)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -740,25 +1099,297 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeadingBids", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LeadingBids = append(m.LeadingBids, LeadingBid{})
+			if err := m.LeadingBids[len(m.LeadingBids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairLiquidFarms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairLiquidFarms = append(m.PairLiquidFarms, PairLiquidFarm{})
+			if err := m.PairLiquidFarms[len(m.PairLiquidFarms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPairRewardsAuctionIdRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastPairRewardsAuctionIdRecords = append(m.LastPairRewardsAuctionIdRecords, LastPairRewardsAuctionIdRecord{})
+			if err := m.LastPairRewardsAuctionIdRecords[len(m.LastPairRewardsAuctionIdRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairRewardsAuctions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairRewardsAuctions = append(m.PairRewardsAuctions, RewardsAuction{})
+			if err := m.PairRewardsAuctions[len(m.PairRewardsAuctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairBids", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairBids = append(m.PairBids, Bid{})
+			if err := m.PairBids[len(m.PairBids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairWinningBidRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairWinningBidRecords = append(m.PairWinningBidRecords, WinningBidRecord{})
+			if err := m.PairWinningBidRecords[len(m.PairWinningBidRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vaults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vaults = append(m.Vaults, Vault{})
+			if err := m.Vaults[len(m.Vaults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultCompoundingRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultCompoundingRecords = append(m.VaultCompoundingRecords, VaultCompoundingRecord{})
+			if err := m.VaultCompoundingRecords[len(m.VaultCompoundingRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
 	return nil
 }
 func (m *LastRewardsAuctionIdRecord) Unmarshal(dAtA []byte) error {
@@ -849,6 +1480,94 @@ func (m *LastRewardsAuctionIdRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *LastPairRewardsAuctionIdRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LastPairRewardsAuctionIdRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LastPairRewardsAuctionIdRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+			}
+			m.PairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PairId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *WinningBidRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -951,6 +1670,108 @@ func (m *WinningBidRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *VaultCompoundingRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VaultCompoundingRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VaultCompoundingRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCompoundedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastCompoundedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -200,6 +200,25 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			"multiple winning bids at auction 1",
 		},
+		{
+			"invalid vault",
+			func(genState *types.GenesisState) {
+				genState.Vaults = []types.Vault{
+					types.NewVault(validPoolId, sdk.ZeroInt(), utils.ParseDec("0.1"), 0),
+				}
+			},
+			"invalid vault compounding interval must be positive: 0s",
+		},
+		{
+			"multiple vault compounding records",
+			func(genState *types.GenesisState) {
+				genState.VaultCompoundingRecords = []types.VaultCompoundingRecord{
+					{PoolId: validPoolId, LastCompoundedAt: utils.ParseTime("2022-01-01T00:00:00Z")},
+					{PoolId: validPoolId, LastCompoundedAt: utils.ParseTime("2022-01-02T00:00:00Z")},
+				}
+			},
+			"multiple vault compounding records for pool 1",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			genState := types.DefaultGenesis()
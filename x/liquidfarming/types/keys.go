@@ -30,6 +30,16 @@ var (
 	RewardsAuctionKeyPrefix       = []byte{0xe5}
 	BidKeyPrefix                  = []byte{0xe6}
 	WinningBidKeyPrefix           = []byte{0xe7}
+	LeadingBidKeyPrefix           = []byte{0xe8}
+
+	PairLiquidFarmKeyPrefix           = []byte{0xe9}
+	LastPairRewardsAuctionIdKeyPrefix = []byte{0xea}
+	PairRewardsAuctionKeyPrefix       = []byte{0xeb}
+	PairBidKeyPrefix                  = []byte{0xec}
+	PairWinningBidKeyPrefix           = []byte{0xed}
+
+	VaultKeyPrefix                 = []byte{0xee}
+	VaultLastCompoundedAtKeyPrefix = []byte{0xef}
 )
 
 // GetLastRewardsAuctionIdKey returns the store key to retrieve the last rewards auction
@@ -74,6 +84,66 @@ func GetWinningBidKey(auctionId uint64, poolId uint64) []byte {
 	return append(append(WinningBidKeyPrefix, sdk.Uint64ToBigEndian(auctionId)...), sdk.Uint64ToBigEndian(poolId)...)
 }
 
+// GetLeadingBidKey returns the store key to retrieve the leading bid
+// by the given auction id, pool id and sequence.
+func GetLeadingBidKey(auctionId, poolId, sequence uint64) []byte {
+	return append(GetLeadingBidsByAuctionPrefix(auctionId, poolId), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// GetLeadingBidsByAuctionPrefix returns the prefix to iterate all leading bids
+// by the given auction id and pool id.
+func GetLeadingBidsByAuctionPrefix(auctionId, poolId uint64) []byte {
+	return append(append(LeadingBidKeyPrefix, sdk.Uint64ToBigEndian(auctionId)...), sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// GetPairLiquidFarmKey returns the store key to retrieve the pair liquid farm object
+// by the given pair id.
+func GetPairLiquidFarmKey(pairId uint64) []byte {
+	return append(PairLiquidFarmKeyPrefix, sdk.Uint64ToBigEndian(pairId)...)
+}
+
+// GetLastPairRewardsAuctionIdKey returns the store key to retrieve the last rewards auction id
+// of the pair liquid farm by the given pair id.
+func GetLastPairRewardsAuctionIdKey(pairId uint64) []byte {
+	return append(LastPairRewardsAuctionIdKeyPrefix, sdk.Uint64ToBigEndian(pairId)...)
+}
+
+// GetPairRewardsAuctionKey returns the store key to retrieve the rewards auction object
+// of the pair liquid farm by the given auction id and pair id.
+func GetPairRewardsAuctionKey(auctionId, pairId uint64) []byte {
+	return append(append(PairRewardsAuctionKeyPrefix, sdk.Uint64ToBigEndian(auctionId)...), sdk.Uint64ToBigEndian(pairId)...)
+}
+
+// GetPairBidKey returns the store key to retrieve the bid object for the rewards auction
+// of the pair liquid farm by the given pair id and bidder address.
+func GetPairBidKey(pairId uint64, bidder sdk.AccAddress) []byte {
+	return append(GetPairBidByPairIdPrefix(pairId), address.MustLengthPrefix(bidder)...)
+}
+
+// GetPairBidByPairIdPrefix returns the prefix to iterate all bids
+// by the given pair id.
+func GetPairBidByPairIdPrefix(pairId uint64) []byte {
+	return append(PairBidKeyPrefix, sdk.Uint64ToBigEndian(pairId)...)
+}
+
+// GetPairWinningBidKey returns the store key to retrieve the winning bid for the rewards auction
+// of the pair liquid farm by the given auction id and pair id.
+func GetPairWinningBidKey(auctionId, pairId uint64) []byte {
+	return append(append(PairWinningBidKeyPrefix, sdk.Uint64ToBigEndian(auctionId)...), sdk.Uint64ToBigEndian(pairId)...)
+}
+
+// GetVaultKey returns the store key to retrieve the vault object
+// by the given pool id.
+func GetVaultKey(poolId uint64) []byte {
+	return append(VaultKeyPrefix, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// GetVaultLastCompoundedAtKey returns the store key to retrieve the last time
+// the vault compounded by the given pool id.
+func GetVaultLastCompoundedAtKey(poolId uint64) []byte {
+	return append(VaultLastCompoundedAtKeyPrefix, sdk.Uint64ToBigEndian(poolId)...)
+}
+
 // LengthPrefixTimeBytes returns length-prefixed bytes representation
 // of time.Time.
 func LengthPrefixTimeBytes(t time.Time) []byte {
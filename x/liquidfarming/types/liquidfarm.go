@@ -45,6 +45,15 @@ func (l LiquidFarm) Validate() error {
 	if l.FeeRate.IsNegative() {
 		return fmt.Errorf("fee rate must be 0 or positive value: %s", l.FeeRate)
 	}
+	if _, ok := AuctionMode_name[int32(l.AuctionMode)]; !ok {
+		return fmt.Errorf("invalid auction mode: %d", l.AuctionMode)
+	}
+	if l.CandleWindow < 0 {
+		return fmt.Errorf("candle window must be 0 or positive value: %s", l.CandleWindow)
+	}
+	if l.AuctionMode == AuctionModeCandle && l.CandleWindow == 0 {
+		return fmt.Errorf("candle window must be positive on candle auction mode")
+	}
 	return nil
 }
 
@@ -18,7 +18,9 @@ func TestLiquidFarm(t *testing.T) {
 		MinBidAmount:  sdk.ZeroInt(),
 		FeeRate:       sdk.ZeroDec(),
 	}
-	require.Equal(t, `fee_rate: "0.000000000000000000"
+	require.Equal(t, `auction_mode: AUCTION_MODE_OPEN
+candle_window: 0s
+fee_rate: "0.000000000000000000"
 min_bid_amount: "0"
 min_farm_amount: "0"
 pool_id: "1"
@@ -11,6 +11,7 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/regen-network/cosmos-proto"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -91,6 +92,16 @@ type RewardsAuction struct {
 	// rewards specifies the farming rewards for are accumulated in the farm module
 	// the value is determined when an auction is finished
 	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,10,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+	// auction_mode specifies the mode of the auction, which is taken from the liquid farm when the auction is created
+	AuctionMode AuctionMode `protobuf:"varint,11,opt,name=auction_mode,json=auctionMode,proto3,enum=squad.liquidfarming.v1beta1.AuctionMode" json:"auction_mode,omitempty"`
+	// candle_window specifies the window before the end time in which the auction is closed on AUCTION_MODE_CANDLE
+	CandleWindow time.Duration `protobuf:"bytes,12,opt,name=candle_window,json=candleWindow,proto3,stdduration" json:"candle_window"`
+	// closing_time specifies the time when the auction is closed on AUCTION_MODE_CANDLE
+	// the value is determined when an auction is finished
+	ClosingTime time.Time `protobuf:"bytes,13,opt,name=closing_time,json=closingTime,proto3,stdtime" json:"closing_time"`
+	// pair_id specifies the pair id of the pair liquid farm that the auction sells the rewards for
+	// pool_id then specifies the pool of the pair whose pool coin is used for bidding
+	PairId uint64 `protobuf:"varint,14,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
 }
 
 func (m *RewardsAuction) Reset()         { *m = RewardsAuction{} }
@@ -173,6 +184,8 @@ type Bid struct {
 	Bidder string `protobuf:"bytes,2,opt,name=bidder,proto3" json:"bidder,omitempty"`
 	// amount specifies the amount to place a bid
 	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
+	// pair_id specifies the pair id when the bid is placed for the rewards auction of a pair liquid farm
+	PairId uint64 `protobuf:"varint,4,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
 }
 
 func (m *Bid) Reset()         { *m = Bid{} }
@@ -208,11 +221,58 @@ func (m *Bid) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Bid proto.InternalMessageInfo
 
+// LeadingBid records a bid that has taken the lead of a rewards auction on AUCTION_MODE_CANDLE.
+// It is used to look up the winning bid at the closing time of the auction.
+type LeadingBid struct {
+	// auction_id specifies the auction id
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// sequence specifies the order of the leading bid in the auction
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// bid specifies the bid that has taken the lead
+	Bid Bid `protobuf:"bytes,3,opt,name=bid,proto3" json:"bid"`
+	// time specifies the block time when the bid has taken the lead
+	Time time.Time `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *LeadingBid) Reset()         { *m = LeadingBid{} }
+func (m *LeadingBid) String() string { return proto.CompactTextString(m) }
+func (*LeadingBid) ProtoMessage()    {}
+func (*LeadingBid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3445e3599d3c045, []int{3}
+}
+func (m *LeadingBid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LeadingBid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LeadingBid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LeadingBid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeadingBid.Merge(m, src)
+}
+func (m *LeadingBid) XXX_Size() int {
+	return m.Size()
+}
+func (m *LeadingBid) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeadingBid.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LeadingBid proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("squad.liquidfarming.v1beta1.AuctionStatus", AuctionStatus_name, AuctionStatus_value)
 	proto.RegisterType((*RewardsAuction)(nil), "squad.liquidfarming.v1beta1.RewardsAuction")
 	proto.RegisterType((*CompoundingRewards)(nil), "squad.liquidfarming.v1beta1.CompoundingRewards")
 	proto.RegisterType((*Bid)(nil), "squad.liquidfarming.v1beta1.Bid")
+	proto.RegisterType((*LeadingBid)(nil), "squad.liquidfarming.v1beta1.LeadingBid")
 }
 
 func init() {
@@ -220,51 +280,63 @@ func init() {
 }
 
 var fileDescriptor_b3445e3599d3c045 = []byte{
-	// 706 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcd, 0x4e, 0xeb, 0x46,
-	0x14, 0xb6, 0x93, 0x34, 0x21, 0x53, 0x11, 0x45, 0xa3, 0x14, 0x8c, 0x2b, 0x39, 0x16, 0x8b, 0x36,
-	0x42, 0xc5, 0x2e, 0x14, 0xb1, 0xe8, 0xa6, 0xca, 0xaf, 0x6a, 0x55, 0x4a, 0x91, 0x13, 0x36, 0xdd,
-	0x58, 0x76, 0x66, 0x08, 0x23, 0x62, 0x8f, 0xf1, 0xd8, 0x50, 0xde, 0x00, 0xb1, 0xe2, 0x05, 0x90,
-	0x90, 0xba, 0xeb, 0x93, 0xb0, 0x64, 0xd9, 0x76, 0x01, 0x2d, 0x3c, 0x40, 0x5f, 0xe1, 0x6a, 0xc6,
-	0x03, 0x92, 0xb9, 0x08, 0x71, 0xa5, 0xbb, 0xb2, 0xcf, 0xf9, 0xe6, 0x3b, 0xdf, 0xf9, 0x9b, 0x01,
-	0x36, 0x3b, 0xce, 0x7c, 0x64, 0x2f, 0xc8, 0x71, 0x46, 0xd0, 0x81, 0x9f, 0x84, 0x24, 0x9a, 0xdb,
-	0x27, 0x5b, 0x01, 0x4e, 0xfd, 0xad, 0xa2, 0xd7, 0x8a, 0x13, 0x9a, 0x52, 0xf8, 0xb5, 0x20, 0x58,
-	0x45, 0x48, 0x12, 0xf4, 0xd6, 0x9c, 0xce, 0xa9, 0x38, 0x67, 0xf3, 0xbf, 0x9c, 0xa2, 0xaf, 0xcd,
-	0x28, 0x0b, 0x29, 0xf3, 0x72, 0x20, 0x37, 0x24, 0x64, 0xe4, 0x96, 0x1d, 0xf8, 0x0c, 0x3f, 0xcb,
-	0xce, 0x28, 0x89, 0x24, 0xde, 0x9e, 0x53, 0x3a, 0x5f, 0x60, 0x5b, 0x58, 0x41, 0x76, 0x60, 0xa7,
-	0x24, 0xc4, 0x2c, 0xf5, 0xc3, 0x38, 0x3f, 0xb0, 0xfe, 0x77, 0x05, 0x34, 0x5c, 0x7c, 0xea, 0x27,
-	0x88, 0x75, 0xb3, 0x59, 0x4a, 0x68, 0x04, 0x1b, 0xa0, 0x44, 0x90, 0xa6, 0x9a, 0x6a, 0xa7, 0xe2,
-	0x96, 0x08, 0x82, 0xab, 0xa0, 0x16, 0x53, 0xba, 0xf0, 0x08, 0xd2, 0x4a, 0xc2, 0x59, 0xe5, 0xa6,
-	0x83, 0xe0, 0x77, 0x00, 0x06, 0x04, 0x21, 0x12, 0xcd, 0x3d, 0x2e, 0xe9, 0x21, 0x1c, 0xd1, 0x50,
-	0x2b, 0x9b, 0x6a, 0xa7, 0xee, 0x36, 0x25, 0xd2, 0xa7, 0x24, 0x1a, 0x70, 0x3f, 0xdc, 0x01, 0x2b,
-	0xb1, 0x7f, 0xc6, 0x0f, 0x27, 0x98, 0xe1, 0xe4, 0x04, 0x7b, 0x3e, 0x42, 0x09, 0x66, 0x4c, 0xab,
-	0x08, 0x46, 0x2b, 0x47, 0xdd, 0x1c, 0xec, 0xe6, 0x18, 0xec, 0x03, 0xc0, 0x52, 0x3f, 0x49, 0x3d,
-	0x9e, 0xb8, 0xf6, 0x85, 0xa9, 0x76, 0xbe, 0xdc, 0xd6, 0xad, 0xbc, 0x2a, 0xeb, 0xa9, 0x2a, 0x6b,
-	0xfa, 0x54, 0x55, 0x6f, 0xe9, 0xe6, 0xae, 0xad, 0x5c, 0xde, 0xb7, 0x55, 0xb7, 0x2e, 0x78, 0x1c,
-	0x81, 0x3f, 0x81, 0x25, 0x1c, 0xa1, 0x3c, 0x44, 0xf5, 0x13, 0x42, 0xd4, 0x70, 0x84, 0x44, 0x80,
-	0x1e, 0xa8, 0xb2, 0xd4, 0x4f, 0x33, 0xa6, 0xd5, 0x4c, 0xb5, 0xd3, 0xd8, 0xde, 0xb0, 0xde, 0x98,
-	0xa2, 0x25, 0x1b, 0x39, 0x11, 0x0c, 0x57, 0x32, 0xe1, 0x0a, 0xa8, 0x9e, 0x92, 0x28, 0xc2, 0x89,
-	0xb6, 0x24, 0xea, 0x95, 0x16, 0x3c, 0x06, 0x0d, 0xfe, 0xc7, 0x1b, 0xe3, 0x87, 0x34, 0x8b, 0x52,
-	0xad, 0x2e, 0x52, 0x5c, 0xb3, 0xe4, 0xa4, 0xf9, 0x6c, 0x9f, 0x63, 0xf3, 0x7e, 0xf6, 0x6c, 0x9e,
-	0xe1, 0x9f, 0xf7, 0xed, 0x6f, 0xe7, 0x24, 0x3d, 0xcc, 0x02, 0x6b, 0x46, 0x43, 0xb9, 0x16, 0xf2,
-	0xb3, 0xc9, 0xd0, 0x91, 0x9d, 0x9e, 0xc5, 0x98, 0x09, 0x82, 0xbb, 0x2c, 0x15, 0xba, 0x42, 0x00,
-	0x62, 0x50, 0x4b, 0xf2, 0x99, 0x6b, 0xc0, 0x2c, 0xbf, 0xad, 0xf5, 0xbd, 0xd4, 0xea, 0xbc, 0x53,
-	0x8b, 0xb9, 0x4f, 0xb1, 0xd7, 0x03, 0x00, 0xfb, 0x34, 0x8c, 0x69, 0x16, 0x21, 0x31, 0x58, 0xe1,
-	0x85, 0x23, 0x50, 0x95, 0x75, 0xf2, 0x15, 0xab, 0xf7, 0x2c, 0x2e, 0xf0, 0xcf, 0x5d, 0xfb, 0x9b,
-	0x77, 0x08, 0x38, 0x51, 0xea, 0x4a, 0xf6, 0x8f, 0x95, 0xf3, 0xeb, 0xb6, 0xb2, 0x7e, 0xad, 0x82,
-	0x72, 0xaf, 0xb8, 0xa4, 0x6a, 0x61, 0x49, 0x57, 0x40, 0x95, 0xaf, 0x22, 0x4e, 0xc4, 0xf2, 0xd6,
-	0x5d, 0x69, 0xc1, 0xe0, 0x39, 0x8d, 0xf2, 0x67, 0x6f, 0x77, 0x21, 0xc5, 0x8d, 0xff, 0x55, 0xb0,
-	0x5c, 0x58, 0x09, 0xb8, 0x03, 0xf4, 0xee, 0x7e, 0x7f, 0xea, 0xfc, 0x3a, 0xf6, 0x26, 0xd3, 0xee,
-	0x74, 0x7f, 0xe2, 0xed, 0x8f, 0x27, 0x7b, 0xc3, 0xbe, 0x33, 0x72, 0x86, 0x83, 0xa6, 0xa2, 0xb7,
-	0x2e, 0xae, 0xcc, 0x66, 0x81, 0x32, 0x26, 0x0b, 0x7e, 0x81, 0x5e, 0xb0, 0x26, 0xd3, 0xae, 0x3b,
-	0x1d, 0x0e, 0x9a, 0xaa, 0xae, 0x5d, 0x5c, 0x99, 0xad, 0x02, 0x63, 0xc2, 0xb7, 0x1f, 0x23, 0xb8,
-	0x0b, 0x56, 0x5f, 0xb0, 0x46, 0xce, 0xd8, 0x99, 0xfc, 0x3c, 0x1c, 0x34, 0x4b, 0xfa, 0xda, 0xc5,
-	0x95, 0xf9, 0x55, 0x81, 0x36, 0x22, 0x11, 0x61, 0x87, 0x18, 0xbd, 0xa6, 0xf6, 0x8b, 0xb3, 0xb7,
-	0x37, 0x1c, 0x34, 0xcb, 0xaf, 0xa9, 0x1d, 0x91, 0x38, 0xc6, 0x48, 0xaf, 0x9c, 0xff, 0x61, 0x28,
-	0xbd, 0xe9, 0xcd, 0x7f, 0x86, 0x72, 0xf3, 0x60, 0xa8, 0xb7, 0x0f, 0x86, 0xfa, 0xef, 0x83, 0xa1,
-	0x5e, 0x3e, 0x1a, 0xca, 0xed, 0xa3, 0xa1, 0xfc, 0xf5, 0x68, 0x28, 0xbf, 0xed, 0x7e, 0xd4, 0x46,
-	0x7e, 0x97, 0x36, 0x17, 0x7e, 0xc0, 0xe4, 0x6b, 0xfa, 0xfb, 0x8b, 0xf7, 0x54, 0xb4, 0x36, 0xa8,
-	0x8a, 0xbb, 0xfa, 0xc3, 0x87, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa2, 0xab, 0x3b, 0xe1, 0x73, 0x05,
+	// 898 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0xc6, 0xc6, 0x89, 0x27, 0x89, 0x65, 0x8d, 0x42, 0xba, 0x59, 0xc4, 0x7a, 0x95, 0x03,
+	0x58, 0x15, 0xdd, 0xa5, 0xa5, 0xaa, 0x2a, 0x2e, 0xc8, 0xff, 0x42, 0x57, 0x85, 0x10, 0xad, 0x1d,
+	0x21, 0x71, 0x59, 0xcd, 0x7a, 0xa6, 0xee, 0xa8, 0xde, 0x99, 0xcd, 0xce, 0x6e, 0x43, 0xbf, 0x41,
+	0x95, 0x53, 0x8f, 0x5c, 0x22, 0x21, 0x71, 0xe3, 0x1b, 0x70, 0xe4, 0x96, 0x63, 0xc5, 0x09, 0x71,
+	0x68, 0x21, 0xf9, 0x00, 0x7c, 0x05, 0x34, 0xb3, 0x63, 0xd3, 0x35, 0x51, 0x94, 0x48, 0x9c, 0xec,
+	0x37, 0x6f, 0x7e, 0xef, 0xcf, 0xef, 0xfd, 0xde, 0x2c, 0xf0, 0xc4, 0x51, 0x8e, 0xb0, 0x37, 0xa3,
+	0x47, 0x39, 0xc5, 0x4f, 0x50, 0x1a, 0x53, 0x36, 0xf5, 0x9e, 0xdf, 0x8d, 0x48, 0x86, 0xee, 0x96,
+	0x4f, 0xdd, 0x24, 0xe5, 0x19, 0x87, 0x1f, 0x28, 0x80, 0x5b, 0x76, 0x69, 0x80, 0xb5, 0x35, 0xe5,
+	0x53, 0xae, 0xee, 0x79, 0xf2, 0x5f, 0x01, 0xb1, 0x76, 0x26, 0x5c, 0xc4, 0x5c, 0x84, 0x85, 0xa3,
+	0x30, 0xb4, 0xcb, 0x2e, 0x2c, 0x2f, 0x42, 0x82, 0x2c, 0xd2, 0x4e, 0x38, 0x65, 0xda, 0xdf, 0x9e,
+	0x72, 0x3e, 0x9d, 0x11, 0x4f, 0x59, 0x51, 0xfe, 0xc4, 0xcb, 0x68, 0x4c, 0x44, 0x86, 0xe2, 0x64,
+	0x1e, 0x60, 0xf9, 0x02, 0xce, 0x53, 0x94, 0x51, 0x3e, 0x0f, 0xd0, 0xb9, 0xaa, 0xbf, 0x04, 0xa5,
+	0x28, 0xd6, 0xa5, 0xec, 0xfe, 0x56, 0x07, 0xcd, 0x80, 0x1c, 0xa3, 0x14, 0x8b, 0x6e, 0x3e, 0x91,
+	0x21, 0x60, 0x13, 0xac, 0x50, 0x6c, 0x1a, 0x8e, 0xd1, 0xa9, 0x05, 0x2b, 0x14, 0xc3, 0x5b, 0x60,
+	0x35, 0xe1, 0x7c, 0x16, 0x52, 0x6c, 0xae, 0xa8, 0xc3, 0xba, 0x34, 0x7d, 0x0c, 0x3f, 0x01, 0x30,
+	0xa2, 0x18, 0x53, 0x36, 0x0d, 0x65, 0xf1, 0x21, 0x26, 0x8c, 0xc7, 0x66, 0xd5, 0x31, 0x3a, 0x8d,
+	0xa0, 0xa5, 0x3d, 0x7d, 0x4e, 0xd9, 0x40, 0x9e, 0xc3, 0xfb, 0x60, 0x3b, 0x41, 0x2f, 0xe4, 0xe5,
+	0x94, 0x08, 0x92, 0x3e, 0x27, 0x21, 0xc2, 0x38, 0x25, 0x42, 0x98, 0x35, 0x85, 0xd8, 0x2a, 0xbc,
+	0x41, 0xe1, 0xec, 0x16, 0x3e, 0xd8, 0x07, 0x40, 0x64, 0x28, 0xcd, 0x42, 0x49, 0x81, 0xf9, 0x9e,
+	0x63, 0x74, 0xd6, 0xef, 0x59, 0x6e, 0xd1, 0xbe, 0x3b, 0x6f, 0xdf, 0x1d, 0xcf, 0xf9, 0xe9, 0xad,
+	0x9d, 0xbd, 0x69, 0x57, 0x5e, 0xbd, 0x6d, 0x1b, 0x41, 0x43, 0xe1, 0xa4, 0x07, 0x7e, 0x01, 0xd6,
+	0x08, 0xc3, 0x45, 0x88, 0xfa, 0x0d, 0x42, 0xac, 0x12, 0x86, 0x55, 0x80, 0x1e, 0xa8, 0x8b, 0x0c,
+	0x65, 0xb9, 0x30, 0x57, 0x1d, 0xa3, 0xd3, 0xbc, 0x77, 0xdb, 0xbd, 0x42, 0x0f, 0xae, 0x26, 0x72,
+	0xa4, 0x10, 0x81, 0x46, 0xc2, 0x6d, 0x50, 0x3f, 0xa6, 0x8c, 0x91, 0xd4, 0x5c, 0x53, 0xfd, 0x6a,
+	0x0b, 0x1e, 0x81, 0xa6, 0xfc, 0x27, 0x89, 0x41, 0x31, 0xcf, 0x59, 0x66, 0x36, 0x54, 0x89, 0x3b,
+	0xae, 0xd6, 0x8c, 0x54, 0xc9, 0x22, 0xb6, 0xe4, 0xb3, 0xe7, 0xc9, 0x0a, 0x7f, 0x7e, 0xdb, 0xfe,
+	0x78, 0x4a, 0xb3, 0xa7, 0x79, 0xe4, 0x4e, 0x78, 0xac, 0x05, 0xa6, 0x7f, 0xee, 0x08, 0xfc, 0xcc,
+	0xcb, 0x5e, 0x24, 0x44, 0x28, 0x40, 0xb0, 0xa9, 0x33, 0x74, 0x55, 0x02, 0x48, 0xc0, 0x6a, 0x5a,
+	0xcc, 0xdc, 0x04, 0x4e, 0xf5, 0xea, 0x5c, 0x9f, 0xea, 0x5c, 0x9d, 0x6b, 0xe6, 0x12, 0xc1, 0x3c,
+	0x36, 0x7c, 0x0c, 0x36, 0x50, 0x41, 0x45, 0x18, 0x73, 0x4c, 0xcc, 0x75, 0xc5, 0x5d, 0xe7, 0x3a,
+	0xdc, 0x7d, 0xcd, 0x31, 0x09, 0xd6, 0xd1, 0xbf, 0x06, 0x7c, 0x04, 0x36, 0x27, 0x88, 0xe1, 0x19,
+	0x09, 0x8f, 0x29, 0xc3, 0xfc, 0xd8, 0xdc, 0xd0, 0x2c, 0x2d, 0x0f, 0x72, 0xa0, 0x57, 0xa1, 0x98,
+	0xe3, 0x0f, 0x72, 0x8e, 0x1b, 0x05, 0xf2, 0x5b, 0x05, 0x84, 0x5f, 0x82, 0x8d, 0xc9, 0x8c, 0x0b,
+	0x49, 0xb8, 0x52, 0xc4, 0xe6, 0x0d, 0x14, 0xb1, 0xae, 0x91, 0x4a, 0x15, 0x72, 0x31, 0x10, 0x4d,
+	0xe5, 0x62, 0x34, 0xf5, 0x62, 0x20, 0x9a, 0xfa, 0x78, 0x37, 0x02, 0xb0, 0xcf, 0xe3, 0x84, 0xe7,
+	0x0c, 0x2b, 0x45, 0x17, 0x74, 0xec, 0x81, 0xba, 0x1e, 0xb0, 0xdc, 0xad, 0x46, 0xcf, 0x95, 0x51,
+	0xff, 0x78, 0xd3, 0xfe, 0xe8, 0x1a, 0xcc, 0xfa, 0x2c, 0x0b, 0x34, 0xfa, 0xf3, 0xda, 0xcb, 0x1f,
+	0xdb, 0x95, 0xdd, 0x5f, 0x0c, 0x50, 0xed, 0x95, 0xb7, 0xd3, 0x28, 0x6d, 0xe7, 0x36, 0xa8, 0xcb,
+	0x1d, 0x24, 0xa9, 0xda, 0xda, 0x46, 0xa0, 0x2d, 0x18, 0x2d, 0xca, 0xa8, 0xfe, 0xef, 0x3a, 0xd3,
+	0x91, 0xdf, 0x65, 0xa6, 0xf6, 0x2e, 0x33, 0xba, 0xf6, 0x5f, 0x0d, 0x00, 0xbe, 0x22, 0x48, 0x92,
+	0x23, 0x5b, 0xf8, 0x10, 0x80, 0xb9, 0x4e, 0x16, 0x5d, 0x34, 0xf4, 0x89, 0x8f, 0xa1, 0x05, 0xd6,
+	0x04, 0x39, 0xca, 0x09, 0x9b, 0x10, 0xfd, 0x00, 0x2d, 0x6c, 0xf8, 0x10, 0x54, 0x23, 0x8a, 0x75,
+	0x27, 0xce, 0x95, 0xca, 0xea, 0x51, 0xdc, 0xab, 0xc9, 0x86, 0x02, 0x09, 0x81, 0x0f, 0x41, 0x4d,
+	0x4d, 0xbf, 0x76, 0x83, 0xe9, 0x2b, 0x44, 0xd1, 0xc3, 0xed, 0xbf, 0x0d, 0xb0, 0x59, 0x5a, 0x74,
+	0x78, 0x1f, 0x58, 0xdd, 0xc3, 0xfe, 0xd8, 0xff, 0x66, 0x3f, 0x1c, 0x8d, 0xbb, 0xe3, 0xc3, 0x51,
+	0x78, 0xb8, 0x3f, 0x3a, 0x18, 0xf6, 0xfd, 0x3d, 0x7f, 0x38, 0x68, 0x55, 0xac, 0xad, 0x93, 0x53,
+	0xa7, 0x55, 0x82, 0xec, 0xd3, 0x99, 0x7c, 0x16, 0x97, 0x50, 0xa3, 0x71, 0x37, 0x18, 0x0f, 0x07,
+	0x2d, 0xc3, 0x32, 0x4f, 0x4e, 0x9d, 0xad, 0x12, 0x62, 0x24, 0xdf, 0x34, 0x82, 0xe1, 0x03, 0x70,
+	0x6b, 0x09, 0xb5, 0xe7, 0xef, 0xfb, 0xa3, 0x47, 0xc3, 0x41, 0x6b, 0xc5, 0xda, 0x39, 0x39, 0x75,
+	0xde, 0x2f, 0xc1, 0xf6, 0x28, 0xa3, 0xe2, 0x29, 0xc1, 0x97, 0x65, 0x7b, 0xec, 0x1f, 0x1c, 0x0c,
+	0x07, 0xad, 0xea, 0x65, 0xd9, 0x9e, 0xd1, 0x24, 0x21, 0xd8, 0xaa, 0xbd, 0xfc, 0xc9, 0xae, 0xf4,
+	0xc6, 0x67, 0x7f, 0xd9, 0x95, 0xb3, 0x73, 0xdb, 0x78, 0x7d, 0x6e, 0x1b, 0x7f, 0x9e, 0xdb, 0xc6,
+	0xab, 0x0b, 0xbb, 0xf2, 0xfa, 0xc2, 0xae, 0xfc, 0x7e, 0x61, 0x57, 0xbe, 0x7b, 0xf0, 0x1f, 0x8d,
+	0xc8, 0x59, 0xdc, 0x99, 0xa1, 0x48, 0xe8, 0xaf, 0xed, 0xf7, 0x4b, 0xdf, 0x23, 0xa5, 0x9b, 0xa8,
+	0xae, 0x18, 0xff, 0xec, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x32, 0x13, 0x43, 0x72, 0x93, 0x07,
 	0x00, 0x00,
 }
 
@@ -288,6 +360,32 @@ func (m *RewardsAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PairId != 0 {
+		i = encodeVarintLiquidfarming(dAtA, i, uint64(m.PairId))
+		i--
+		dAtA[i] = 0x70
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ClosingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ClosingTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintLiquidfarming(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x6a
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.CandleWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.CandleWindow):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintLiquidfarming(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x62
+	if m.AuctionMode != 0 {
+		i = encodeVarintLiquidfarming(dAtA, i, uint64(m.AuctionMode))
+		i--
+		dAtA[i] = 0x58
+	}
 	if len(m.Rewards) > 0 {
 		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -324,20 +422,20 @@ func (m *RewardsAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x38
 	}
-	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
-	if err2 != nil {
-		return 0, err2
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err4 != nil {
+		return 0, err4
 	}
-	i -= n2
-	i = encodeVarintLiquidfarming(dAtA, i, uint64(n2))
+	i -= n4
+	i = encodeVarintLiquidfarming(dAtA, i, uint64(n4))
 	i--
 	dAtA[i] = 0x32
-	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
-	if err3 != nil {
-		return 0, err3
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err5 != nil {
+		return 0, err5
 	}
-	i -= n3
-	i = encodeVarintLiquidfarming(dAtA, i, uint64(n3))
+	i -= n5
+	i = encodeVarintLiquidfarming(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.PayingReserveAddress) > 0 {
@@ -420,6 +518,11 @@ func (m *Bid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PairId != 0 {
+		i = encodeVarintLiquidfarming(dAtA, i, uint64(m.PairId))
+		i--
+		dAtA[i] = 0x20
+	}
 	{
 		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -445,6 +548,57 @@ func (m *Bid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LeadingBid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LeadingBid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LeadingBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintLiquidfarming(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Bid.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidfarming(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Sequence != 0 {
+		i = encodeVarintLiquidfarming(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintLiquidfarming(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintLiquidfarming(dAtA []byte, offset int, v uint64) int {
 	offset -= sovLiquidfarming(v)
 	base := offset
@@ -495,6 +649,16 @@ func (m *RewardsAuction) Size() (n int) {
 			n += 1 + l + sovLiquidfarming(uint64(l))
 		}
 	}
+	if m.AuctionMode != 0 {
+		n += 1 + sovLiquidfarming(uint64(m.AuctionMode))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.CandleWindow)
+	n += 1 + l + sovLiquidfarming(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ClosingTime)
+	n += 1 + l + sovLiquidfarming(uint64(l))
+	if m.PairId != 0 {
+		n += 1 + sovLiquidfarming(uint64(m.PairId))
+	}
 	return n
 }
 
@@ -524,6 +688,28 @@ func (m *Bid) Size() (n int) {
 	}
 	l = m.Amount.Size()
 	n += 1 + l + sovLiquidfarming(uint64(l))
+	if m.PairId != 0 {
+		n += 1 + sovLiquidfarming(uint64(m.PairId))
+	}
+	return n
+}
+
+func (m *LeadingBid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovLiquidfarming(uint64(m.AuctionId))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovLiquidfarming(uint64(m.Sequence))
+	}
+	l = m.Bid.Size()
+	n += 1 + l + sovLiquidfarming(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovLiquidfarming(uint64(l))
 	return n
 }
 
@@ -848,6 +1034,110 @@ func (m *RewardsAuction) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionMode", wireType)
+			}
+			m.AuctionMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidfarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionMode |= AuctionMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CandleWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidfarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidfarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidfarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.CandleWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosingTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidfarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidfarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidfarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ClosingTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+			}
+			m.PairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidfarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PairId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLiquidfarming(dAtA[iNdEx:])
@@ -1066,6 +1356,179 @@ func (m *Bid) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+			}
+			m.PairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidfarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PairId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidfarming(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidfarming
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LeadingBid) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidfarming
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LeadingBid: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LeadingBid: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidfarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidfarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidfarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidfarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidfarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidfarming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidfarming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidfarming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLiquidfarming(dAtA[iNdEx:])
@@ -13,6 +13,12 @@ var (
 	_ sdk.Msg = (*MsgLiquidUnfarmAndWithdraw)(nil)
 	_ sdk.Msg = (*MsgPlaceBid)(nil)
 	_ sdk.Msg = (*MsgRefundBid)(nil)
+	_ sdk.Msg = (*MsgPairLiquidFarm)(nil)
+	_ sdk.Msg = (*MsgPairLiquidUnfarm)(nil)
+	_ sdk.Msg = (*MsgPlacePairBid)(nil)
+	_ sdk.Msg = (*MsgRefundPairBid)(nil)
+	_ sdk.Msg = (*MsgVaultFarm)(nil)
+	_ sdk.Msg = (*MsgVaultUnfarm)(nil)
 	_ sdk.Msg = (*MsgAdvanceAuction)(nil)
 )
 
@@ -23,6 +29,12 @@ const (
 	TypeMsgLiquidUnfarmAndWithdraw = "liquid_unfarm_and_withdraw"
 	TypeMsgPlaceBid                = "place_bid"
 	TypeMsgRefundBid               = "refund_bid"
+	TypeMsgPairLiquidFarm          = "pair_liquid_farm"
+	TypeMsgPairLiquidUnfarm        = "pair_liquid_unfarm"
+	TypeMsgPlacePairBid            = "place_pair_bid"
+	TypeMsgRefundPairBid           = "refund_pair_bid"
+	TypeMsgVaultFarm               = "vault_farm"
+	TypeMsgVaultUnfarm             = "vault_unfarm"
 	TypeMsgAdvanceAuction          = "advance_auction"
 )
 
@@ -288,6 +300,319 @@ func (msg MsgRefundBid) GetBidder() sdk.AccAddress {
 	return addr
 }
 
+// NewMsgPairLiquidFarm creates a new MsgPairLiquidFarm
+func NewMsgPairLiquidFarm(pairId uint64, farmer string, farmingCoin sdk.Coin) *MsgPairLiquidFarm {
+	return &MsgPairLiquidFarm{
+		PairId:      pairId,
+		Farmer:      farmer,
+		FarmingCoin: farmingCoin,
+	}
+}
+
+func (msg MsgPairLiquidFarm) Route() string { return RouterKey }
+
+func (msg MsgPairLiquidFarm) Type() string { return TypeMsgPairLiquidFarm }
+
+func (msg MsgPairLiquidFarm) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Farmer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid farmer address: %v", err)
+	}
+	if msg.PairId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid pair id")
+	}
+	if err := msg.FarmingCoin.Validate(); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid farming coin: %v", err)
+	}
+	if !msg.FarmingCoin.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "farming coin must be positive")
+	}
+	if _, err := liquiditytypes.ParsePoolCoinDenom(msg.FarmingCoin.Denom); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "farming coin must be pool coin: %v", err)
+	}
+	return nil
+}
+
+func (msg MsgPairLiquidFarm) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgPairLiquidFarm) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Farmer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgPairLiquidFarm) GetFarmer() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Farmer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgPairLiquidUnfarm creates a new MsgPairLiquidUnfarm
+func NewMsgPairLiquidUnfarm(pairId uint64, farmer string, unfarmingCoin sdk.Coin) *MsgPairLiquidUnfarm {
+	return &MsgPairLiquidUnfarm{
+		PairId:        pairId,
+		Farmer:        farmer,
+		UnfarmingCoin: unfarmingCoin,
+	}
+}
+
+func (msg MsgPairLiquidUnfarm) Route() string { return RouterKey }
+
+func (msg MsgPairLiquidUnfarm) Type() string { return TypeMsgPairLiquidUnfarm }
+
+func (msg MsgPairLiquidUnfarm) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Farmer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid farmer address: %v", err)
+	}
+	if msg.PairId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid pair id")
+	}
+	if err := msg.UnfarmingCoin.Validate(); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid unfarming coin: %v", err)
+	}
+	if !msg.UnfarmingCoin.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unfarming coin must be positive")
+	}
+	expCoinDenom := PairLiquidFarmCoinDenom(msg.PairId)
+	if msg.UnfarmingCoin.Denom != expCoinDenom {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "expected denom %s, but got %s", expCoinDenom, msg.UnfarmingCoin.Denom)
+	}
+	return nil
+}
+
+func (msg MsgPairLiquidUnfarm) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgPairLiquidUnfarm) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Farmer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgPairLiquidUnfarm) GetFarmer() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Farmer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgPlacePairBid creates a new MsgPlacePairBid
+func NewMsgPlacePairBid(auctionId uint64, pairId uint64, bidder string, biddingCoin sdk.Coin) *MsgPlacePairBid {
+	return &MsgPlacePairBid{
+		AuctionId:   auctionId,
+		PairId:      pairId,
+		Bidder:      bidder,
+		BiddingCoin: biddingCoin,
+	}
+}
+
+func (msg MsgPlacePairBid) Route() string { return RouterKey }
+
+func (msg MsgPlacePairBid) Type() string { return TypeMsgPlacePairBid }
+
+func (msg MsgPlacePairBid) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Bidder); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid bidder address: %v", err)
+	}
+	if msg.AuctionId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid auction id")
+	}
+	if msg.PairId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid pair id")
+	}
+	if err := msg.BiddingCoin.Validate(); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid bidding coin: %v", err)
+	}
+	if !msg.BiddingCoin.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "bidding amount must be positive")
+	}
+	if _, err := liquiditytypes.ParsePoolCoinDenom(msg.BiddingCoin.Denom); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "bidding coin must be pool coin: %v", err)
+	}
+	return nil
+}
+
+func (msg MsgPlacePairBid) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgPlacePairBid) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgPlacePairBid) GetBidder() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgRefundPairBid creates a new MsgRefundPairBid
+func NewMsgRefundPairBid(auctionId uint64, pairId uint64, bidder string) *MsgRefundPairBid {
+	return &MsgRefundPairBid{
+		AuctionId: auctionId,
+		PairId:    pairId,
+		Bidder:    bidder,
+	}
+}
+
+func (msg MsgRefundPairBid) Route() string { return RouterKey }
+
+func (msg MsgRefundPairBid) Type() string { return TypeMsgRefundPairBid }
+
+func (msg MsgRefundPairBid) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Bidder); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid bidder address: %v", err)
+	}
+	if msg.AuctionId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid auction id")
+	}
+	if msg.PairId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid pair id")
+	}
+	return nil
+}
+
+func (msg MsgRefundPairBid) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgRefundPairBid) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgRefundPairBid) GetBidder() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgVaultFarm creates a new MsgVaultFarm
+func NewMsgVaultFarm(poolId uint64, farmer string, farmingCoin sdk.Coin) *MsgVaultFarm {
+	return &MsgVaultFarm{
+		PoolId:      poolId,
+		Farmer:      farmer,
+		FarmingCoin: farmingCoin,
+	}
+}
+
+func (msg MsgVaultFarm) Route() string { return RouterKey }
+
+func (msg MsgVaultFarm) Type() string { return TypeMsgVaultFarm }
+
+func (msg MsgVaultFarm) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Farmer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid farmer address: %v", err)
+	}
+	if msg.PoolId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid pool id")
+	}
+	if err := msg.FarmingCoin.Validate(); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid farming coin: %v", err)
+	}
+	if !msg.FarmingCoin.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "farming coin must be positive")
+	}
+	poolCoinDenom := liquiditytypes.PoolCoinDenom(msg.PoolId)
+	if poolCoinDenom != msg.FarmingCoin.Denom {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "expected denom %s, but got %s", poolCoinDenom, msg.FarmingCoin.Denom)
+	}
+	return nil
+}
+
+func (msg MsgVaultFarm) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgVaultFarm) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Farmer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgVaultFarm) GetFarmer() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Farmer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgVaultUnfarm creates a new MsgVaultUnfarm
+func NewMsgVaultUnfarm(poolId uint64, farmer string, unfarmingCoin sdk.Coin) *MsgVaultUnfarm {
+	return &MsgVaultUnfarm{
+		PoolId:        poolId,
+		Farmer:        farmer,
+		UnfarmingCoin: unfarmingCoin,
+	}
+}
+
+func (msg MsgVaultUnfarm) Route() string { return RouterKey }
+
+func (msg MsgVaultUnfarm) Type() string { return TypeMsgVaultUnfarm }
+
+func (msg MsgVaultUnfarm) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Farmer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid farmer address: %v", err)
+	}
+	if msg.PoolId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid pool id")
+	}
+	if err := msg.UnfarmingCoin.Validate(); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid unfarming coin: %v", err)
+	}
+	if !msg.UnfarmingCoin.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unfarming coin must be positive")
+	}
+	expCoinDenom := VaultCoinDenom(msg.PoolId)
+	if msg.UnfarmingCoin.Denom != expCoinDenom {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "expected denom %s, but got %s", expCoinDenom, msg.UnfarmingCoin.Denom)
+	}
+	return nil
+}
+
+func (msg MsgVaultUnfarm) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgVaultUnfarm) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Farmer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgVaultUnfarm) GetFarmer() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Farmer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
 // NewMsgAdvanceAuction creates a new MsgAdvanceAuction.
 func NewMsgAdvanceAuction(requesterAcc sdk.AccAddress) *MsgAdvanceAuction {
 	return &MsgAdvanceAuction{
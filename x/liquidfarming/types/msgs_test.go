@@ -310,3 +310,218 @@ func TestMsgRefundBid(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgPairLiquidFarm(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(msg *types.MsgPairLiquidFarm)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(msg *types.MsgPairLiquidFarm) {},
+			"",
+		},
+		{
+			"invalid pair id",
+			func(msg *types.MsgPairLiquidFarm) {
+				msg.PairId = 0
+			},
+			"invalid pair id: invalid request",
+		},
+		{
+			"invalid farmer",
+			func(msg *types.MsgPairLiquidFarm) {
+				msg.Farmer = "invalidaddr"
+			},
+			"invalid farmer address: decoding bech32 failed: invalid separator index -1: invalid address",
+		},
+		{
+			"invalid farming coin",
+			func(msg *types.MsgPairLiquidFarm) {
+				msg.FarmingCoin = sdk.NewInt64Coin("pool1", 0)
+			},
+			"farming coin must be positive: invalid request",
+		},
+		{
+			"invalid farming coin denom",
+			func(msg *types.MsgPairLiquidFarm) {
+				msg.FarmingCoin = sdk.NewInt64Coin("denom1", 100_000)
+			},
+			"farming coin must be pool coin: denom1 is not a pool coin denom: invalid request",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgPairLiquidFarm(1, testAddr.String(), utils.ParseCoin("1000000pool2"))
+			tc.malleate(msg)
+			require.Equal(t, types.TypeMsgPairLiquidFarm, msg.Type())
+			require.Equal(t, types.RouterKey, msg.Route())
+			require.Equal(t, sdk.MustSortJSON(types.ModuleCdc.MustMarshalJSON(msg)), msg.GetSignBytes())
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				signers := msg.GetSigners()
+				require.Len(t, signers, 1)
+				require.Equal(t, msg.GetFarmer(), signers[0])
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestMsgPairLiquidUnfarm(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(msg *types.MsgPairLiquidUnfarm)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(msg *types.MsgPairLiquidUnfarm) {},
+			"",
+		},
+		{
+			"invalid pair id",
+			func(msg *types.MsgPairLiquidUnfarm) {
+				msg.PairId = 0
+			},
+			"invalid pair id: invalid request",
+		},
+		{
+			"invalid unfarming coin",
+			func(msg *types.MsgPairLiquidUnfarm) {
+				msg.UnfarmingCoin = sdk.NewInt64Coin("lfpair1", 0)
+			},
+			"unfarming coin must be positive: invalid request",
+		},
+		{
+			"invalid unfarming coin denom",
+			func(msg *types.MsgPairLiquidUnfarm) {
+				msg.UnfarmingCoin = sdk.NewInt64Coin("lf1", 100_000)
+			},
+			"expected denom lfpair1, but got lf1: invalid request",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgPairLiquidUnfarm(1, testAddr.String(), utils.ParseCoin("1000000lfpair1"))
+			tc.malleate(msg)
+			require.Equal(t, types.TypeMsgPairLiquidUnfarm, msg.Type())
+			require.Equal(t, types.RouterKey, msg.Route())
+			require.Equal(t, sdk.MustSortJSON(types.ModuleCdc.MustMarshalJSON(msg)), msg.GetSignBytes())
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				signers := msg.GetSigners()
+				require.Len(t, signers, 1)
+				require.Equal(t, msg.GetFarmer(), signers[0])
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestMsgVaultFarm(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(msg *types.MsgVaultFarm)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(msg *types.MsgVaultFarm) {},
+			"",
+		},
+		{
+			"invalid pool id",
+			func(msg *types.MsgVaultFarm) {
+				msg.PoolId = 0
+			},
+			"invalid pool id: invalid request",
+		},
+		{
+			"invalid farming coin",
+			func(msg *types.MsgVaultFarm) {
+				msg.FarmingCoin = sdk.NewInt64Coin("pool1", 0)
+			},
+			"farming coin must be positive: invalid request",
+		},
+		{
+			"invalid farming coin denom",
+			func(msg *types.MsgVaultFarm) {
+				msg.FarmingCoin = sdk.NewInt64Coin("pool2", 100_000)
+			},
+			"expected denom pool1, but got pool2: invalid request",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgVaultFarm(1, testAddr.String(), utils.ParseCoin("1000000pool1"))
+			tc.malleate(msg)
+			require.Equal(t, types.TypeMsgVaultFarm, msg.Type())
+			require.Equal(t, types.RouterKey, msg.Route())
+			require.Equal(t, sdk.MustSortJSON(types.ModuleCdc.MustMarshalJSON(msg)), msg.GetSignBytes())
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				signers := msg.GetSigners()
+				require.Len(t, signers, 1)
+				require.Equal(t, msg.GetFarmer(), signers[0])
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestMsgVaultUnfarm(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(msg *types.MsgVaultUnfarm)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(msg *types.MsgVaultUnfarm) {},
+			"",
+		},
+		{
+			"invalid pool id",
+			func(msg *types.MsgVaultUnfarm) {
+				msg.PoolId = 0
+			},
+			"invalid pool id: invalid request",
+		},
+		{
+			"invalid unfarming coin",
+			func(msg *types.MsgVaultUnfarm) {
+				msg.UnfarmingCoin = sdk.NewInt64Coin("lfvault1", 0)
+			},
+			"unfarming coin must be positive: invalid request",
+		},
+		{
+			"invalid unfarming coin denom",
+			func(msg *types.MsgVaultUnfarm) {
+				msg.UnfarmingCoin = sdk.NewInt64Coin("lf1", 100_000)
+			},
+			"expected denom lfvault1, but got lf1: invalid request",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgVaultUnfarm(1, testAddr.String(), utils.ParseCoin("1000000lfvault1"))
+			tc.malleate(msg)
+			require.Equal(t, types.TypeMsgVaultUnfarm, msg.Type())
+			require.Equal(t, types.RouterKey, msg.Route())
+			require.Equal(t, sdk.MustSortJSON(types.ModuleCdc.MustMarshalJSON(msg)), msg.GetSignBytes())
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				signers := msg.GetSigners()
+				require.Len(t, signers, 1)
+				require.Equal(t, msg.GetFarmer(), signers[0])
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
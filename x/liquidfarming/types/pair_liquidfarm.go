@@ -0,0 +1,162 @@
+package types
+
+import (
+	fmt "fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	farmingtypes "github.com/cosmosquad-labs/squad/v3/x/farming/types"
+)
+
+const (
+	PairLiquidFarmReserveAccPrefix string = "PairLiquidFarmReserveAcc"
+)
+
+// NewPairLiquidFarm returns a new PairLiquidFarm.
+func NewPairLiquidFarm(pairId uint64, minFarmAmt, minBidAmount sdk.Int, feeRate sdk.Dec) PairLiquidFarm {
+	return PairLiquidFarm{
+		PairId:        pairId,
+		MinFarmAmount: minFarmAmt,
+		MinBidAmount:  minBidAmount,
+		FeeRate:       feeRate,
+	}
+}
+
+// Validate validates PairLiquidFarm.
+func (l PairLiquidFarm) Validate() error {
+	if l.PairId == 0 {
+		return fmt.Errorf("pair id must not be 0")
+	}
+	if l.MinBidAmount.IsNegative() {
+		return fmt.Errorf("minimum bid amount must be 0 or positive value: %s", l.MinBidAmount)
+	}
+	if l.MinFarmAmount.IsNegative() {
+		return fmt.Errorf("minimum farm amount must be 0 or positive value: %s", l.MinFarmAmount)
+	}
+	if l.FeeRate.IsNegative() {
+		return fmt.Errorf("fee rate must be 0 or positive value: %s", l.FeeRate)
+	}
+	return nil
+}
+
+// String returns a human-readable string representation of the PairLiquidFarm.
+func (l PairLiquidFarm) String() string {
+	out, _ := l.MarshalYAML()
+	return out.(string)
+}
+
+// MarshalYAML returns the YAML representation of a PairLiquidFarm.
+func (l PairLiquidFarm) MarshalYAML() (interface{}, error) {
+	bz, err := codec.MarshalYAML(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()), &l)
+	if err != nil {
+		return nil, err
+	}
+	return string(bz), err
+}
+
+// PairLiquidFarmCoinDenom returns a unique liquid farming coin denom for a PairLiquidFarm.
+func PairLiquidFarmCoinDenom(pairId uint64) string {
+	return fmt.Sprintf("lfpair%d", pairId)
+}
+
+// PairLiquidFarmReserveAddress returns the reserve address for a pair liquid farm with the given pair id.
+func PairLiquidFarmReserveAddress(pairId uint64) sdk.AccAddress {
+	return farmingtypes.DeriveAddress(
+		ReserveAddressType,
+		ModuleName,
+		strings.Join([]string{PairLiquidFarmReserveAccPrefix, strconv.FormatUint(pairId, 10)}, ModuleAddressNameSplitter),
+	)
+}
+
+// BasketPool holds the values of a pool of the pair, which are used to weigh
+// the basket of the pool coins farmed by a pair liquid farm.
+// The values are measured in the quote coin of the pair.
+type BasketPool struct {
+	PoolId       uint64
+	PoolValue    sdk.Dec // the value of the whole reserve of the pool; zero if the pool is disabled
+	FarmingValue sdk.Dec // the value of the pool coin farmed by the pair liquid farm
+}
+
+// PoolCoinValue returns the value of the pool coin amount in the quote coin of the pair.
+// PoolCoinValue = (ReserveX * Price + ReserveY) * PoolCoinAmount / PoolCoinSupply
+func PoolCoinValue(poolCoinAmt, poolCoinSupply, rx, ry sdk.Int, price sdk.Dec) sdk.Dec {
+	if !poolCoinSupply.IsPositive() {
+		return sdk.ZeroDec()
+	}
+	poolValue := rx.ToDec().Mul(price).Add(ry.ToDec())
+	return poolValue.MulInt(poolCoinAmt).QuoInt(poolCoinSupply)
+}
+
+// TotalFarmingValue returns the value of the whole basket.
+func TotalFarmingValue(pools []BasketPool) sdk.Dec {
+	total := sdk.ZeroDec()
+	for _, pool := range pools {
+		total = total.Add(pool.FarmingValue)
+	}
+	return total
+}
+
+// MostUnderweightPool returns the id of the pool whose weight in the basket falls short of
+// its weight in the pair the most. The weight of a pool in the pair is its share of the value of
+// all pools in the pair. The pool with the lowest id is returned when there are multiple candidates.
+func MostUnderweightPool(pools []BasketPool) (poolId uint64, found bool) {
+	totalPoolValue := sdk.ZeroDec()
+	for _, pool := range pools {
+		totalPoolValue = totalPoolValue.Add(pool.PoolValue)
+	}
+	if !totalPoolValue.IsPositive() {
+		return 0, false
+	}
+	totalFarmingValue := TotalFarmingValue(pools)
+
+	var maxShortfall sdk.Dec
+	for _, pool := range pools {
+		if !pool.PoolValue.IsPositive() {
+			continue
+		}
+		shortfall := pool.PoolValue.Quo(totalPoolValue)
+		if totalFarmingValue.IsPositive() {
+			shortfall = shortfall.Sub(pool.FarmingValue.Quo(totalFarmingValue))
+		}
+		if !found || shortfall.GT(maxShortfall) || (shortfall.Equal(maxShortfall) && pool.PoolId < poolId) {
+			poolId, maxShortfall, found = pool.PoolId, shortfall, true
+		}
+	}
+	return poolId, found
+}
+
+// CalculatePairLiquidFarmAmount calculates minting pair liquid farm amount.
+// MintingAmt = LFCoinTotalSupply / TotalFarmingValue * FarmingValue
+func CalculatePairLiquidFarmAmount(
+	lfCoinTotalSupplyAmt sdk.Int,
+	totalFarmingValue sdk.Dec,
+	newFarmingValue sdk.Dec,
+) sdk.Int {
+	if lfCoinTotalSupplyAmt.IsZero() || !totalFarmingValue.IsPositive() { // initial minting
+		return newFarmingValue.TruncateInt()
+	}
+	return newFarmingValue.MulInt(lfCoinTotalSupplyAmt).Quo(totalFarmingValue).TruncateInt()
+}
+
+// CalculatePairLiquidUnfarmCoins calculates unfarming pool coins, which are
+// the share of every pool coin in the basket.
+// UnfarmingCoinAmount = FarmingCoinAmount / LFCoinTotalSupply * LFCoinUnfarmingAmount
+func CalculatePairLiquidUnfarmCoins(
+	lfCoinTotalSupplyAmt sdk.Int,
+	farmingCoins sdk.Coins,
+	unfarmingAmt sdk.Int,
+) sdk.Coins {
+	if lfCoinTotalSupplyAmt.Equal(unfarmingAmt) { // last one to unfarm
+		return farmingCoins
+	}
+	unfarmingCoins := sdk.Coins{}
+	for _, coin := range farmingCoins {
+		amt := coin.Amount.Mul(unfarmingAmt).Quo(lfCoinTotalSupplyAmt)
+		unfarmingCoins = unfarmingCoins.Add(sdk.NewCoin(coin.Denom, amt))
+	}
+	return unfarmingCoins
+}
@@ -0,0 +1,129 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/cosmosquad-labs/squad/v3/types"
+	"github.com/cosmosquad-labs/squad/v3/x/liquidfarming/types"
+)
+
+func TestPoolCoinValue(t *testing.T) {
+	// (100 * 2 + 300) * 10 / 1000 = 5
+	value := types.PoolCoinValue(sdk.NewInt(10), sdk.NewInt(1000), sdk.NewInt(100), sdk.NewInt(300), sdk.NewDec(2))
+	require.Equal(t, sdk.NewDec(5), value)
+	require.Equal(t, sdk.ZeroDec(), types.PoolCoinValue(sdk.NewInt(10), sdk.ZeroInt(), sdk.NewInt(100), sdk.NewInt(300), sdk.NewDec(2)))
+}
+
+func TestMostUnderweightPool(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		pools      []types.BasketPool
+		expectedId uint64
+		found      bool
+	}{
+		{
+			"no pools",
+			[]types.BasketPool{},
+			0,
+			false,
+		},
+		{
+			"all pools are disabled",
+			[]types.BasketPool{
+				{1, sdk.ZeroDec(), sdk.NewDec(100)},
+			},
+			0,
+			false,
+		},
+		{
+			"nothing farmed",
+			[]types.BasketPool{
+				{1, sdk.NewDec(100), sdk.ZeroDec()},
+				{2, sdk.NewDec(300), sdk.ZeroDec()},
+			},
+			2,
+			true,
+		},
+		{
+			"tie",
+			[]types.BasketPool{
+				{2, sdk.NewDec(100), sdk.ZeroDec()},
+				{1, sdk.NewDec(100), sdk.ZeroDec()},
+			},
+			1,
+			true,
+		},
+		{
+			"underweight pool",
+			[]types.BasketPool{
+				{1, sdk.NewDec(100), sdk.NewDec(10)},
+				{2, sdk.NewDec(300), sdk.NewDec(90)},
+				{3, sdk.NewDec(100), sdk.ZeroDec()},
+			},
+			3,
+			true,
+		},
+		{
+			"disabled pool is skipped",
+			[]types.BasketPool{
+				{1, sdk.NewDec(100), sdk.NewDec(50)},
+				{2, sdk.ZeroDec(), sdk.ZeroDec()},
+				{3, sdk.NewDec(100), sdk.NewDec(10)},
+			},
+			3,
+			true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			poolId, found := types.MostUnderweightPool(tc.pools)
+			require.Equal(t, tc.found, found)
+			require.Equal(t, tc.expectedId, poolId)
+		})
+	}
+}
+
+func TestCalculatePairLiquidFarmAmount(t *testing.T) {
+	for _, tc := range []struct {
+		name              string
+		lfTotalSupplyAmt  sdk.Int
+		totalFarmingValue sdk.Dec
+		newFarmingValue   sdk.Dec
+		expectedAmt       sdk.Int
+	}{
+		{
+			name:              "initial minting",
+			lfTotalSupplyAmt:  sdk.ZeroInt(),
+			totalFarmingValue: sdk.ZeroDec(),
+			newFarmingValue:   utils.ParseDec("1000000.5"),
+			expectedAmt:       sdk.NewInt(1_000_000),
+		},
+		{
+			name:              "normal",
+			lfTotalSupplyAmt:  sdk.NewInt(1_000_000),
+			totalFarmingValue: sdk.NewDec(2_000_000),
+			newFarmingValue:   sdk.NewDec(500_000),
+			expectedAmt:       sdk.NewInt(250_000),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			mintingAmt := types.CalculatePairLiquidFarmAmount(
+				tc.lfTotalSupplyAmt,
+				tc.totalFarmingValue,
+				tc.newFarmingValue,
+			)
+			require.Equal(t, tc.expectedAmt, mintingAmt)
+		})
+	}
+}
+
+func TestCalculatePairLiquidUnfarmCoins(t *testing.T) {
+	farmingCoins := utils.ParseCoins("1_000_000pool1,3_000_000pool2")
+	require.Equal(t, farmingCoins, types.CalculatePairLiquidUnfarmCoins(sdk.NewInt(100), farmingCoins, sdk.NewInt(100)))
+	require.Equal(t,
+		utils.ParseCoins("100_000pool1,300_000pool2"),
+		types.CalculatePairLiquidUnfarmCoins(sdk.NewInt(100), farmingCoins, sdk.NewInt(10)))
+}
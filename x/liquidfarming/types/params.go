@@ -14,6 +14,9 @@ var (
 	KeyFeeCollector           = []byte("FeeCollector")
 	KeyRewardsAuctionDuration = []byte("RewardsAuctionDuration")
 	KeyLiquidFarms            = []byte("LiquidFarms")
+	KeyPairLiquidFarms        = []byte("PairLiquidFarms")
+	KeyVaults                 = []byte("Vaults")
+	KeyPairPriceWindow        = []byte("PairPriceWindow")
 )
 
 // Default parameters
@@ -21,6 +24,9 @@ var (
 	DefaultFeeCollector           = sdk.AccAddress(address.Module(ModuleName, []byte("FeeCollector")))
 	DefaultRewardsAuctionDuration = time.Hour * 8
 	DefaultLiquidFarms            = []LiquidFarm{}
+	DefaultPairLiquidFarms        = []PairLiquidFarm{}
+	DefaultVaults                 = []Vault{}
+	DefaultPairPriceWindow        = 30 * time.Minute
 )
 
 var _ paramstypes.ParamSet = (*Params)(nil)
@@ -36,6 +42,9 @@ func DefaultParams() Params {
 		FeeCollector:           DefaultFeeCollector.String(),
 		RewardsAuctionDuration: DefaultRewardsAuctionDuration,
 		LiquidFarms:            DefaultLiquidFarms,
+		PairLiquidFarms:        DefaultPairLiquidFarms,
+		Vaults:                 DefaultVaults,
+		PairPriceWindow:        DefaultPairPriceWindow,
 	}
 }
 
@@ -45,6 +54,9 @@ func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 		paramstypes.NewParamSetPair(KeyFeeCollector, &p.FeeCollector, validateFeeCollector),
 		paramstypes.NewParamSetPair(KeyRewardsAuctionDuration, &p.RewardsAuctionDuration, validateRewardsAuctionDuration),
 		paramstypes.NewParamSetPair(KeyLiquidFarms, &p.LiquidFarms, validateLiquidFarms),
+		paramstypes.NewParamSetPair(KeyPairLiquidFarms, &p.PairLiquidFarms, validatePairLiquidFarms),
+		paramstypes.NewParamSetPair(KeyVaults, &p.Vaults, validateVaults),
+		paramstypes.NewParamSetPair(KeyPairPriceWindow, &p.PairPriceWindow, validatePairPriceWindow),
 	}
 }
 
@@ -57,6 +69,9 @@ func (p Params) Validate() error {
 		{p.FeeCollector, validateFeeCollector},
 		{p.RewardsAuctionDuration, validateRewardsAuctionDuration},
 		{p.LiquidFarms, validateLiquidFarms},
+		{p.PairLiquidFarms, validatePairLiquidFarms},
+		{p.Vaults, validateVaults},
+		{p.PairPriceWindow, validatePairPriceWindow},
 	} {
 		if err := v.validator(v.value); err != nil {
 			return err
@@ -100,3 +115,50 @@ func validateLiquidFarms(i interface{}) error {
 	}
 	return nil
 }
+
+func validatePairLiquidFarms(i interface{}) error {
+	pairLiquidFarms, ok := i.([]PairLiquidFarm)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	pairIds := map[uint64]struct{}{}
+	for _, l := range pairLiquidFarms {
+		if err := l.Validate(); err != nil {
+			return fmt.Errorf("invalid pair liquid farm: %v", err)
+		}
+		if _, ok := pairIds[l.PairId]; ok {
+			return fmt.Errorf("duplicate pair liquid farm for pair %d", l.PairId)
+		}
+		pairIds[l.PairId] = struct{}{}
+	}
+	return nil
+}
+
+func validateVaults(i interface{}) error {
+	vaults, ok := i.([]Vault)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	poolIds := map[uint64]struct{}{}
+	for _, v := range vaults {
+		if err := v.Validate(); err != nil {
+			return fmt.Errorf("invalid vault: %v", err)
+		}
+		if _, ok := poolIds[v.PoolId]; ok {
+			return fmt.Errorf("duplicate vault for pool %d", v.PoolId)
+		}
+		poolIds[v.PoolId] = struct{}{}
+	}
+	return nil
+}
+
+func validatePairPriceWindow(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v <= 0 {
+		return fmt.Errorf("pair price window must be positive: %d", v)
+	}
+	return nil
+}
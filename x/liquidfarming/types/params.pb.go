@@ -29,11 +29,45 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// AuctionMode enumerates the valid modes of the rewards auctions.
+type AuctionMode int32
+
+const (
+	// AUCTION_MODE_OPEN defines the open ascending auction, where the highest bid at the end time wins
+	AuctionModeOpen AuctionMode = 0
+	// AUCTION_MODE_CANDLE defines the candle auction, where the highest bid at the closing time, which is randomly
+	// determined from the block data within the candle window when the auction is finished, wins
+	AuctionModeCandle AuctionMode = 1
+)
+
+var AuctionMode_name = map[int32]string{
+	0: "AUCTION_MODE_OPEN",
+	1: "AUCTION_MODE_CANDLE",
+}
+
+var AuctionMode_value = map[string]int32{
+	"AUCTION_MODE_OPEN":   0,
+	"AUCTION_MODE_CANDLE": 1,
+}
+
+func (x AuctionMode) String() string {
+	return proto.EnumName(AuctionMode_name, int32(x))
+}
+
+func (AuctionMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6012e16b27fcc811, []int{0}
+}
+
 // Params defines the parameters for the module.
 type Params struct {
-	FeeCollector           string        `protobuf:"bytes,1,opt,name=fee_collector,json=feeCollector,proto3" json:"fee_collector,omitempty"`
-	RewardsAuctionDuration time.Duration `protobuf:"bytes,2,opt,name=rewards_auction_duration,json=rewardsAuctionDuration,proto3,stdduration" json:"rewards_auction_duration"`
-	LiquidFarms            []LiquidFarm  `protobuf:"bytes,3,rep,name=liquid_farms,json=liquidFarms,proto3" json:"liquid_farms"`
+	FeeCollector           string           `protobuf:"bytes,1,opt,name=fee_collector,json=feeCollector,proto3" json:"fee_collector,omitempty"`
+	RewardsAuctionDuration time.Duration    `protobuf:"bytes,2,opt,name=rewards_auction_duration,json=rewardsAuctionDuration,proto3,stdduration" json:"rewards_auction_duration"`
+	LiquidFarms            []LiquidFarm     `protobuf:"bytes,3,rep,name=liquid_farms,json=liquidFarms,proto3" json:"liquid_farms"`
+	PairLiquidFarms        []PairLiquidFarm `protobuf:"bytes,4,rep,name=pair_liquid_farms,json=pairLiquidFarms,proto3" json:"pair_liquid_farms"`
+	Vaults                 []Vault          `protobuf:"bytes,5,rep,name=vaults,proto3" json:"vaults"`
+	// pair_price_window is the TWAP window of the pair price used to value the pool coins
+	// of pair liquid farms
+	PairPriceWindow time.Duration `protobuf:"bytes,6,opt,name=pair_price_window,json=pairPriceWindow,proto3,stdduration" json:"pair_price_window"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -77,6 +111,11 @@ type LiquidFarm struct {
 	MinFarmAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=min_farm_amount,json=minFarmAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_farm_amount"`
 	MinBidAmount  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=min_bid_amount,json=minBidAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_bid_amount"`
 	FeeRate       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=fee_rate,json=feeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_rate"`
+	// auction_mode specifies how the winner of the rewards auctions for the liquid farm is determined
+	AuctionMode AuctionMode `protobuf:"varint,5,opt,name=auction_mode,json=auctionMode,proto3,enum=squad.liquidfarming.v1beta1.AuctionMode" json:"auction_mode,omitempty"`
+	// candle_window specifies the window before the end time of the rewards auctions in which the auctions are closed
+	// at a random time on AUCTION_MODE_CANDLE
+	CandleWindow time.Duration `protobuf:"bytes,6,opt,name=candle_window,json=candleWindow,proto3,stdduration" json:"candle_window"`
 }
 
 func (m *LiquidFarm) Reset()      { *m = LiquidFarm{} }
@@ -111,9 +150,101 @@ func (m *LiquidFarm) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_LiquidFarm proto.InternalMessageInfo
 
+// PairLiquidFarm defines liquid farm object that spans every pool of the pair.
+// It mints a single LFCoin for the basket of the pool coins of the pair and compounds
+// the rewards auction proceeds into the most underweight pool of the basket.
+// See the technical spec for more detailed information.
+type PairLiquidFarm struct {
+	PairId        uint64                                 `protobuf:"varint,1,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	MinFarmAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=min_farm_amount,json=minFarmAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_farm_amount"`
+	MinBidAmount  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=min_bid_amount,json=minBidAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_bid_amount"`
+	FeeRate       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=fee_rate,json=feeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_rate"`
+}
+
+func (m *PairLiquidFarm) Reset()      { *m = PairLiquidFarm{} }
+func (*PairLiquidFarm) ProtoMessage() {}
+func (*PairLiquidFarm) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6012e16b27fcc811, []int{2}
+}
+func (m *PairLiquidFarm) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PairLiquidFarm) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PairLiquidFarm.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PairLiquidFarm) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PairLiquidFarm.Merge(m, src)
+}
+func (m *PairLiquidFarm) XXX_Size() int {
+	return m.Size()
+}
+func (m *PairLiquidFarm) XXX_DiscardUnknown() {
+	xxx_messageInfo_PairLiquidFarm.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PairLiquidFarm proto.InternalMessageInfo
+
+// Vault defines auto compounding vault object for the liquidity pool.
+// Instead of going through rewards auction, the vault swaps the farming rewards into
+// the coins of the pair, deposits them to the pool and farms the pool coin on its own.
+// See the technical spec for more detailed information.
+type Vault struct {
+	PoolId        uint64                                 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	MinFarmAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=min_farm_amount,json=minFarmAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_farm_amount"`
+	// max_slippage specifies the maximum deviation of the pool price from the last price of the pair
+	// under which the vault places swap orders
+	MaxSlippage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=max_slippage,json=maxSlippage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_slippage"`
+	// compounding_interval specifies the interval between the compounding of the vault
+	CompoundingInterval time.Duration `protobuf:"bytes,4,opt,name=compounding_interval,json=compoundingInterval,proto3,stdduration" json:"compounding_interval"`
+}
+
+func (m *Vault) Reset()      { *m = Vault{} }
+func (*Vault) ProtoMessage() {}
+func (*Vault) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6012e16b27fcc811, []int{3}
+}
+func (m *Vault) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Vault) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Vault.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Vault) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Vault.Merge(m, src)
+}
+func (m *Vault) XXX_Size() int {
+	return m.Size()
+}
+func (m *Vault) XXX_DiscardUnknown() {
+	xxx_messageInfo_Vault.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Vault proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterEnum("squad.liquidfarming.v1beta1.AuctionMode", AuctionMode_name, AuctionMode_value)
 	proto.RegisterType((*Params)(nil), "squad.liquidfarming.v1beta1.Params")
 	proto.RegisterType((*LiquidFarm)(nil), "squad.liquidfarming.v1beta1.LiquidFarm")
+	proto.RegisterType((*PairLiquidFarm)(nil), "squad.liquidfarming.v1beta1.PairLiquidFarm")
+	proto.RegisterType((*Vault)(nil), "squad.liquidfarming.v1beta1.Vault")
 }
 
 func init() {
@@ -121,37 +252,54 @@ func init() {
 }
 
 var fileDescriptor_6012e16b27fcc811 = []byte{
-	// 466 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x4f, 0x6b, 0xd4, 0x40,
-	0x18, 0xc6, 0x93, 0xee, 0xb2, 0xdd, 0xce, 0x6e, 0x15, 0x82, 0x68, 0xac, 0x90, 0x2c, 0x15, 0x74,
-	0x2f, 0x9d, 0xa1, 0x15, 0x3c, 0xf4, 0xd6, 0x58, 0x84, 0x05, 0x0f, 0x4b, 0x10, 0x0f, 0x82, 0x84,
-	0x49, 0x66, 0x12, 0x07, 0x33, 0x99, 0x74, 0x66, 0xe2, 0x9f, 0x6f, 0xe0, 0xd1, 0x63, 0x8f, 0x3d,
-	0xfa, 0x51, 0x7a, 0xec, 0x51, 0x3c, 0x54, 0xd9, 0xf5, 0x83, 0xc8, 0x4c, 0x92, 0x8a, 0x0a, 0x82,
-	0x3d, 0x65, 0xf2, 0xf2, 0xcc, 0xef, 0x79, 0x9f, 0x77, 0x5e, 0x30, 0x57, 0x27, 0x0d, 0x26, 0xa8,
-	0x64, 0x27, 0x0d, 0x23, 0x39, 0x96, 0x9c, 0x55, 0x05, 0x7a, 0xbb, 0x9f, 0x52, 0x8d, 0xf7, 0x51,
-	0x8d, 0x25, 0xe6, 0x0a, 0xd6, 0x52, 0x68, 0xe1, 0xdd, 0xb3, 0x4a, 0xf8, 0x9b, 0x12, 0x76, 0xca,
-	0x9d, 0xa0, 0x10, 0xa2, 0x28, 0x29, 0xb2, 0xd2, 0xb4, 0xc9, 0x11, 0x69, 0x24, 0xd6, 0x4c, 0x54,
-	0xed, 0xe5, 0x9d, 0x5b, 0x85, 0x28, 0x84, 0x3d, 0x22, 0x73, 0xea, 0xaa, 0x41, 0x26, 0x14, 0x17,
-	0x0a, 0xa5, 0x58, 0xd1, 0x2b, 0xd3, 0x4c, 0xb0, 0xee, 0xd6, 0xee, 0x0f, 0x17, 0x8c, 0x96, 0xb6,
-	0x07, 0xef, 0x3e, 0xd8, 0xce, 0x29, 0x4d, 0x32, 0x51, 0x96, 0x34, 0xd3, 0x42, 0xfa, 0xee, 0xcc,
-	0x9d, 0x6f, 0xc5, 0xd3, 0x9c, 0xd2, 0x27, 0x7d, 0xcd, 0x7b, 0x05, 0x7c, 0x49, 0xdf, 0x61, 0x49,
-	0x54, 0x82, 0x9b, 0xcc, 0xd8, 0x27, 0x7d, 0x1f, 0xfe, 0xc6, 0xcc, 0x9d, 0x4f, 0x0e, 0xee, 0xc2,
-	0xb6, 0x51, 0xd8, 0x37, 0x0a, 0x8f, 0x3b, 0x41, 0x34, 0x3e, 0xbf, 0x0c, 0x9d, 0xd3, 0x6f, 0xa1,
-	0x1b, 0xdf, 0xee, 0x20, 0x47, 0x2d, 0xa3, 0x57, 0x78, 0x4b, 0x30, 0x6d, 0xd3, 0x27, 0x26, 0xbe,
-	0xf2, 0x07, 0xb3, 0xc1, 0x7c, 0x72, 0xf0, 0x10, 0xfe, 0x63, 0x30, 0xf0, 0x99, 0xad, 0x3e, 0xc5,
-	0x92, 0x47, 0x43, 0x63, 0x10, 0x4f, 0xca, 0xab, 0x8a, 0x3a, 0x1c, 0x7e, 0x3c, 0x0b, 0x9d, 0xdd,
-	0xcf, 0x1b, 0x00, 0xfc, 0xd2, 0x79, 0x77, 0xc0, 0x66, 0x2d, 0x44, 0x99, 0x30, 0x62, 0x43, 0x0e,
-	0xe3, 0x91, 0xf9, 0x5d, 0x10, 0xef, 0x05, 0xb8, 0xc9, 0x59, 0x65, 0xcd, 0x13, 0xcc, 0x45, 0x53,
-	0x69, 0x9b, 0x6a, 0x2b, 0x82, 0x86, 0xfc, 0xf5, 0x32, 0x7c, 0x50, 0x30, 0xfd, 0xba, 0x49, 0x61,
-	0x26, 0x38, 0xea, 0x46, 0xdb, 0x7e, 0xf6, 0x14, 0x79, 0x83, 0xf4, 0x87, 0x9a, 0x2a, 0xb8, 0xa8,
-	0x74, 0xbc, 0xcd, 0x59, 0x65, 0xac, 0x8e, 0x2c, 0xc4, 0x7b, 0x0e, 0x6e, 0x18, 0x6e, 0xca, 0x48,
-	0x8f, 0x1d, 0x5c, 0x0b, 0x3b, 0xe5, 0xac, 0x8a, 0x18, 0xe9, 0xa8, 0x0b, 0x30, 0x36, 0x2f, 0x26,
-	0xb1, 0xa6, 0xfe, 0xf0, 0xbf, 0x79, 0xc7, 0x34, 0x8b, 0x37, 0x73, 0x4a, 0x63, 0xac, 0xe9, 0xe1,
-	0xd8, 0x8c, 0xe9, 0xf4, 0x2c, 0x74, 0xa2, 0xe5, 0xf9, 0x2a, 0x70, 0x2f, 0x56, 0x81, 0xfb, 0x7d,
-	0x15, 0xb8, 0x9f, 0xd6, 0x81, 0x73, 0xb1, 0x0e, 0x9c, 0x2f, 0xeb, 0xc0, 0x79, 0xf9, 0xf8, 0x2f,
-	0xa8, 0x79, 0x95, 0xbd, 0x12, 0xa7, 0x0a, 0xb5, 0x3b, 0xfe, 0xfe, 0x8f, 0x2d, 0xb7, 0x46, 0xe9,
-	0xc8, 0x6e, 0xc2, 0xa3, 0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x1f, 0x03, 0x0a, 0x90, 0x09, 0x03,
-	0x00, 0x00,
+	// 740 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x55, 0x4d, 0x4f, 0x13, 0x41,
+	0x18, 0xee, 0xd2, 0x52, 0x60, 0x5a, 0xbe, 0x06, 0xd4, 0xb5, 0x26, 0xdb, 0x06, 0x13, 0x6d, 0x30,
+	0xec, 0x06, 0x4c, 0x3c, 0x78, 0xb2, 0x1f, 0x18, 0x1b, 0x81, 0xd6, 0x15, 0x31, 0x31, 0x21, 0x9b,
+	0xe9, 0xee, 0x74, 0x9d, 0xb8, 0xbb, 0xb3, 0xec, 0x07, 0xe0, 0x3f, 0x20, 0x9c, 0x3c, 0x72, 0x21,
+	0x31, 0xf1, 0xaa, 0xbf, 0xc1, 0x2b, 0x47, 0x4e, 0xc6, 0x78, 0x40, 0x03, 0x7f, 0xc4, 0xcc, 0xec,
+	0x14, 0x5a, 0x49, 0x1a, 0xe4, 0xe0, 0xc5, 0xd3, 0xee, 0xbe, 0x79, 0xde, 0xe7, 0x7d, 0xe7, 0x79,
+	0x9e, 0xcd, 0x80, 0x72, 0xb8, 0x15, 0x23, 0x4b, 0x73, 0xc8, 0x56, 0x4c, 0xac, 0x0e, 0x0a, 0x5c,
+	0xe2, 0xd9, 0xda, 0xf6, 0x62, 0x1b, 0x47, 0x68, 0x51, 0xf3, 0x51, 0x80, 0xdc, 0x50, 0xf5, 0x03,
+	0x1a, 0x51, 0x78, 0x87, 0x23, 0xd5, 0x3e, 0xa4, 0x2a, 0x90, 0x05, 0xc5, 0xa6, 0xd4, 0x76, 0xb0,
+	0xc6, 0xa1, 0xed, 0xb8, 0xa3, 0x59, 0x71, 0x80, 0x22, 0x42, 0xbd, 0xa4, 0xb9, 0x30, 0x6b, 0x53,
+	0x9b, 0xf2, 0x57, 0x8d, 0xbd, 0x89, 0xaa, 0x62, 0xd2, 0xd0, 0xa5, 0xa1, 0xd6, 0x46, 0x21, 0x3e,
+	0x1f, 0x6a, 0x52, 0x22, 0xba, 0xe6, 0xbe, 0xa5, 0x41, 0xb6, 0xc5, 0x77, 0x80, 0x77, 0xc1, 0x78,
+	0x07, 0x63, 0xc3, 0xa4, 0x8e, 0x83, 0xcd, 0x88, 0x06, 0xb2, 0x54, 0x92, 0xca, 0x63, 0x7a, 0xbe,
+	0x83, 0x71, 0xad, 0x5b, 0x83, 0x9b, 0x40, 0x0e, 0xf0, 0x0e, 0x0a, 0xac, 0xd0, 0x40, 0xb1, 0xc9,
+	0xc6, 0x1b, 0xdd, 0x3d, 0xe4, 0xa1, 0x92, 0x54, 0xce, 0x2d, 0xdd, 0x56, 0x93, 0x45, 0xd5, 0xee,
+	0xa2, 0x6a, 0x5d, 0x00, 0xaa, 0xa3, 0x47, 0x27, 0xc5, 0xd4, 0xc1, 0xcf, 0xa2, 0xa4, 0xdf, 0x14,
+	0x24, 0x95, 0x84, 0xa3, 0x8b, 0x80, 0x2d, 0x90, 0x4f, 0x4e, 0x6f, 0xb0, 0xe3, 0x87, 0x72, 0xba,
+	0x94, 0x2e, 0xe7, 0x96, 0xee, 0xab, 0x03, 0x84, 0x51, 0x57, 0x78, 0xf5, 0x29, 0x0a, 0xdc, 0x6a,
+	0x86, 0x0d, 0xd0, 0x73, 0xce, 0x79, 0x25, 0x84, 0x9b, 0x60, 0xda, 0x47, 0x24, 0x30, 0xfa, 0x68,
+	0x33, 0x9c, 0xf6, 0xc1, 0x40, 0xda, 0x16, 0x22, 0xc1, 0x25, 0xea, 0x49, 0xbf, 0xaf, 0x1a, 0xc2,
+	0x27, 0x20, 0xbb, 0x8d, 0x62, 0x27, 0x0a, 0xe5, 0x61, 0xce, 0x39, 0x37, 0x90, 0x73, 0x83, 0x41,
+	0x05, 0x95, 0xe8, 0x83, 0x4d, 0xb1, 0xa0, 0x1f, 0x10, 0x13, 0x1b, 0x3b, 0xc4, 0xb3, 0xe8, 0x8e,
+	0x9c, 0xbd, 0xba, 0x94, 0x7c, 0xa5, 0x16, 0x6b, 0x7e, 0xcd, 0x7b, 0x1f, 0x67, 0xf6, 0x3e, 0x16,
+	0x53, 0x73, 0x5f, 0xd3, 0x00, 0x5c, 0x2c, 0x0a, 0x6f, 0x81, 0x11, 0x9f, 0x52, 0xc7, 0x20, 0x16,
+	0xb7, 0x35, 0xa3, 0x67, 0xd9, 0x67, 0xc3, 0x82, 0x1b, 0x60, 0xd2, 0x25, 0x1e, 0xd7, 0xc5, 0x40,
+	0x2e, 0x8d, 0xbd, 0x88, 0xfb, 0x38, 0x56, 0x55, 0xd9, 0x84, 0x1f, 0x27, 0xc5, 0x7b, 0x36, 0x89,
+	0xde, 0xc6, 0x6d, 0xd5, 0xa4, 0xae, 0x26, 0xc2, 0x94, 0x3c, 0x16, 0x42, 0xeb, 0x9d, 0x16, 0xbd,
+	0xf7, 0x71, 0xa8, 0x36, 0xbc, 0x48, 0x1f, 0x77, 0x89, 0xc7, 0x46, 0x55, 0x38, 0x09, 0x5c, 0x07,
+	0x13, 0x8c, 0xb7, 0x4d, 0xac, 0x2e, 0x6d, 0xfa, 0x5a, 0xb4, 0x79, 0x97, 0x78, 0x55, 0x62, 0x09,
+	0xd6, 0x06, 0x18, 0x65, 0x19, 0x0d, 0x50, 0x84, 0xe5, 0xcc, 0x5f, 0xf3, 0xd5, 0xb1, 0xa9, 0x8f,
+	0x74, 0x30, 0xd6, 0x51, 0x84, 0xe1, 0x73, 0x90, 0xef, 0x26, 0xd8, 0xa5, 0x16, 0x96, 0x87, 0x4b,
+	0x52, 0x79, 0x62, 0xa9, 0x3c, 0xd0, 0x3f, 0x11, 0xd7, 0x55, 0x6a, 0x61, 0x3d, 0x87, 0x2e, 0x3e,
+	0xe0, 0x33, 0x30, 0x6e, 0x22, 0xcf, 0x72, 0xae, 0x63, 0x60, 0x3e, 0xe9, 0x14, 0xee, 0x8d, 0x32,
+	0xf7, 0x0e, 0x98, 0x83, 0x9f, 0x87, 0xc0, 0x44, 0x7f, 0x08, 0xb9, 0x8b, 0x2c, 0x2b, 0x3d, 0x2e,
+	0x22, 0x12, 0xfc, 0xc7, 0x2e, 0xf6, 0xc8, 0xf5, 0x65, 0x08, 0x0c, 0xf3, 0xff, 0xeb, 0xdf, 0x67,
+	0xfd, 0x05, 0xc8, 0xbb, 0x68, 0xd7, 0x08, 0x1d, 0xe2, 0xfb, 0xc8, 0xc6, 0xd7, 0xd0, 0x88, 0x9d,
+	0x29, 0xe7, 0xa2, 0xdd, 0x97, 0x82, 0x02, 0x6e, 0x80, 0x59, 0x93, 0xba, 0x3e, 0x8d, 0x3d, 0x8b,
+	0x78, 0xb6, 0x41, 0xbc, 0x08, 0x07, 0xdb, 0xc8, 0xe1, 0x72, 0x5d, 0x31, 0x57, 0x33, 0x3d, 0x04,
+	0x0d, 0xd1, 0x7f, 0xa1, 0xd7, 0x3c, 0x05, 0xb9, 0x9e, 0x38, 0xc3, 0x79, 0x30, 0x5d, 0x79, 0x55,
+	0x5b, 0x6f, 0x34, 0xd7, 0x8c, 0xd5, 0x66, 0x7d, 0xd9, 0x68, 0xb6, 0x96, 0xd7, 0xa6, 0x52, 0x85,
+	0x99, 0xfd, 0xc3, 0xd2, 0x64, 0x0f, 0xae, 0xe9, 0x63, 0x0f, 0xaa, 0x60, 0xa6, 0x0f, 0x5b, 0xab,
+	0xac, 0xd5, 0x57, 0x96, 0xa7, 0xa4, 0xc2, 0x8d, 0xfd, 0xc3, 0xd2, 0x74, 0x0f, 0xba, 0xc6, 0x93,
+	0x5d, 0xc8, 0xec, 0x7d, 0x52, 0x52, 0xd5, 0xd6, 0xd1, 0xa9, 0x22, 0x1d, 0x9f, 0x2a, 0xd2, 0xaf,
+	0x53, 0x45, 0xfa, 0x70, 0xa6, 0xa4, 0x8e, 0xcf, 0x94, 0xd4, 0xf7, 0x33, 0x25, 0xf5, 0xe6, 0xd1,
+	0x25, 0x85, 0xd8, 0x3f, 0xb8, 0xe0, 0xa0, 0x76, 0xa8, 0x25, 0x97, 0xe7, 0xee, 0x1f, 0xd7, 0x27,
+	0x57, 0xad, 0x9d, 0xe5, 0xc7, 0x7f, 0xf8, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xe8, 0xb7, 0x2d, 0xa4,
+	0x62, 0x07, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -174,6 +322,42 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.PairPriceWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.PairPriceWindow):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	if len(m.Vaults) > 0 {
+		for iNdEx := len(m.Vaults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vaults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.PairLiquidFarms) > 0 {
+		for iNdEx := len(m.PairLiquidFarms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PairLiquidFarms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.LiquidFarms) > 0 {
 		for iNdEx := len(m.LiquidFarms) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -188,12 +372,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x1a
 		}
 	}
-	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RewardsAuctionDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RewardsAuctionDuration):])
-	if err1 != nil {
-		return 0, err1
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RewardsAuctionDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RewardsAuctionDuration):])
+	if err2 != nil {
+		return 0, err2
 	}
-	i -= n1
-	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i -= n2
+	i = encodeVarintParams(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0x12
 	if len(m.FeeCollector) > 0 {
@@ -222,6 +406,77 @@ func (m *LiquidFarm) MarshalTo(dAtA []byte) (int, error) {
 }
 
 func (m *LiquidFarm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.CandleWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.CandleWindow):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintParams(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x32
+	if m.AuctionMode != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AuctionMode))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.FeeRate.Size()
+		i -= size
+		if _, err := m.FeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MinBidAmount.Size()
+		i -= size
+		if _, err := m.MinBidAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MinFarmAmount.Size()
+		i -= size
+		if _, err := m.MinFarmAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PairLiquidFarm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PairLiquidFarm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PairLiquidFarm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -256,6 +511,62 @@ func (m *LiquidFarm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x12
+	if m.PairId != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PairId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Vault) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Vault) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Vault) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.CompoundingInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.CompoundingInterval):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintParams(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxSlippage.Size()
+		i -= size
+		if _, err := m.MaxSlippage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MinFarmAmount.Size()
+		i -= size
+		if _, err := m.MinFarmAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if m.PoolId != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.PoolId))
 		i--
@@ -293,6 +604,20 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	if len(m.PairLiquidFarms) > 0 {
+		for _, e := range m.PairLiquidFarms {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.Vaults) > 0 {
+		for _, e := range m.Vaults {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.PairPriceWindow)
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -311,6 +636,47 @@ func (m *LiquidFarm) Size() (n int) {
 	n += 1 + l + sovParams(uint64(l))
 	l = m.FeeRate.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if m.AuctionMode != 0 {
+		n += 1 + sovParams(uint64(m.AuctionMode))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.CandleWindow)
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *PairLiquidFarm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PairId != 0 {
+		n += 1 + sovParams(uint64(m.PairId))
+	}
+	l = m.MinFarmAmount.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MinBidAmount.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.FeeRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *Vault) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovParams(uint64(m.PoolId))
+	}
+	l = m.MinFarmAmount.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxSlippage.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.CompoundingInterval)
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -448,26 +814,127 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipParams(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthParams
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairLiquidFarms", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairLiquidFarms = append(m.PairLiquidFarms, PairLiquidFarm{})
+			if err := m.PairLiquidFarms[len(m.PairLiquidFarms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vaults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vaults = append(m.Vaults, Vault{})
+			if err := m.Vaults[len(m.Vaults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairPriceWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.PairPriceWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
 func (m *LiquidFarm) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -619,6 +1086,399 @@ func (m *LiquidFarm) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionMode", wireType)
+			}
+			m.AuctionMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionMode |= AuctionMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CandleWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.CandleWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PairLiquidFarm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PairLiquidFarm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PairLiquidFarm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+			}
+			m.PairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PairId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFarmAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinFarmAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBidAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBidAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Vault) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Vault: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Vault: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFarmAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinFarmAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlippage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSlippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompoundingInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.CompoundingInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
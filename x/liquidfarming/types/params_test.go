@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -75,6 +76,87 @@ func TestParams_Validate(t *testing.T) {
 			},
 			"invalid liquid farm: fee rate must be 0 or positive value: -1.000000000000000000",
 		},
+		{
+			"invalid auction mode in liquid farm",
+			func(params *types.Params) {
+				liquidFarm := types.NewLiquidFarm(1, sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroDec())
+				liquidFarm.AuctionMode = 2
+				params.LiquidFarms = []types.LiquidFarm{liquidFarm}
+			},
+			"invalid liquid farm: invalid auction mode: 2",
+		},
+		{
+			"zero candle window in liquid farm on candle auction mode",
+			func(params *types.Params) {
+				liquidFarm := types.NewLiquidFarm(1, sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroDec())
+				liquidFarm.AuctionMode = types.AuctionModeCandle
+				params.LiquidFarms = []types.LiquidFarm{liquidFarm}
+			},
+			"invalid liquid farm: candle window must be positive on candle auction mode",
+		},
+		{
+			"invalid pair id in pair liquid farm",
+			func(params *types.Params) {
+				params.PairLiquidFarms = []types.PairLiquidFarm{
+					types.NewPairLiquidFarm(0, sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroDec()),
+				}
+			},
+			"invalid pair liquid farm: pair id must not be 0",
+		},
+		{
+			"invalid fee rate in pair liquid farm",
+			func(params *types.Params) {
+				params.PairLiquidFarms = []types.PairLiquidFarm{
+					types.NewPairLiquidFarm(1, sdk.ZeroInt(), sdk.ZeroInt(), sdk.NewDec(-1)),
+				}
+			},
+			"invalid pair liquid farm: fee rate must be 0 or positive value: -1.000000000000000000",
+		},
+		{
+			"duplicate pair liquid farm",
+			func(params *types.Params) {
+				params.PairLiquidFarms = []types.PairLiquidFarm{
+					types.NewPairLiquidFarm(1, sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroDec()),
+					types.NewPairLiquidFarm(1, sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroDec()),
+				}
+			},
+			"duplicate pair liquid farm for pair 1",
+		},
+		{
+			"invalid max slippage in vault",
+			func(params *types.Params) {
+				params.Vaults = []types.Vault{
+					types.NewVault(1, sdk.ZeroInt(), sdk.OneDec(), time.Hour),
+				}
+			},
+			"invalid vault: max slippage must be in range [0, 1): 1.000000000000000000",
+		},
+		{
+			"invalid compounding interval in vault",
+			func(params *types.Params) {
+				params.Vaults = []types.Vault{
+					types.NewVault(1, sdk.ZeroInt(), sdk.NewDecWithPrec(1, 2), 0),
+				}
+			},
+			"invalid vault: compounding interval must be positive: 0s",
+		},
+		{
+			"duplicate vault",
+			func(params *types.Params) {
+				params.Vaults = []types.Vault{
+					types.NewVault(1, sdk.ZeroInt(), sdk.NewDecWithPrec(1, 2), time.Hour),
+					types.NewVault(1, sdk.ZeroInt(), sdk.NewDecWithPrec(1, 2), time.Hour),
+				}
+			},
+			"duplicate vault for pool 1",
+		},
+		{
+			"invalid pair price window",
+			func(params *types.Params) {
+				params.PairPriceWindow = 0
+			},
+			"pair price window must be positive: 0",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			params := types.DefaultParams()
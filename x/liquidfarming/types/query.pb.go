@@ -12,19 +12,24 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -705,6 +710,7 @@ func (m *QueryRewardsResponse) GetRewards() github_com_cosmos_cosmos_sdk_types.C
 // QueryExchangeRateRequest is request type for the Query/ExchangeRate RPC method.
 type QueryExchangeRateRequest struct {
 	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	PairId uint64 `protobuf:"varint,2,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
 }
 
 func (m *QueryExchangeRateRequest) Reset()         { *m = QueryExchangeRateRequest{} }
@@ -747,6 +753,13 @@ func (m *QueryExchangeRateRequest) GetPoolId() uint64 {
 	return 0
 }
 
+func (m *QueryExchangeRateRequest) GetPairId() uint64 {
+	if m != nil {
+		return m.PairId
+	}
+	return 0
+}
+
 // QueryExchangeRateResponse is response type for the Query/ExchangeRate RPC method.
 type QueryExchangeRateResponse struct {
 	ExchangeRate ExchangeRateResponse `protobuf:"bytes,1,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate"`
@@ -792,6 +805,374 @@ func (m *QueryExchangeRateResponse) GetExchangeRate() ExchangeRateResponse {
 	return ExchangeRateResponse{}
 }
 
+// QueryPairLiquidFarmsRequest is the request type for the Query/PairLiquidFarms RPC method.
+type QueryPairLiquidFarmsRequest struct {
+}
+
+func (m *QueryPairLiquidFarmsRequest) Reset()         { *m = QueryPairLiquidFarmsRequest{} }
+func (m *QueryPairLiquidFarmsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPairLiquidFarmsRequest) ProtoMessage()    {}
+func (*QueryPairLiquidFarmsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d6573e06fd69a92, []int{16}
+}
+func (m *QueryPairLiquidFarmsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPairLiquidFarmsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPairLiquidFarmsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPairLiquidFarmsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPairLiquidFarmsRequest.Merge(m, src)
+}
+func (m *QueryPairLiquidFarmsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPairLiquidFarmsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPairLiquidFarmsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPairLiquidFarmsRequest proto.InternalMessageInfo
+
+// QueryPairLiquidFarmsResponse is response type for the Query/PairLiquidFarms RPC method.
+type QueryPairLiquidFarmsResponse struct {
+	PairLiquidFarms []PairLiquidFarmResponse `protobuf:"bytes,1,rep,name=pair_liquid_farms,json=pairLiquidFarms,proto3" json:"pair_liquid_farms"`
+}
+
+func (m *QueryPairLiquidFarmsResponse) Reset()         { *m = QueryPairLiquidFarmsResponse{} }
+func (m *QueryPairLiquidFarmsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPairLiquidFarmsResponse) ProtoMessage()    {}
+func (*QueryPairLiquidFarmsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d6573e06fd69a92, []int{17}
+}
+func (m *QueryPairLiquidFarmsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPairLiquidFarmsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPairLiquidFarmsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPairLiquidFarmsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPairLiquidFarmsResponse.Merge(m, src)
+}
+func (m *QueryPairLiquidFarmsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPairLiquidFarmsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPairLiquidFarmsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPairLiquidFarmsResponse proto.InternalMessageInfo
+
+func (m *QueryPairLiquidFarmsResponse) GetPairLiquidFarms() []PairLiquidFarmResponse {
+	if m != nil {
+		return m.PairLiquidFarms
+	}
+	return nil
+}
+
+// QueryPairRewardsAuctionsRequest is request type for the Query/PairRewardsAuctions RPC method.
+type QueryPairRewardsAuctionsRequest struct {
+	PairId     uint64             `protobuf:"varint,1,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	Status     string             `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPairRewardsAuctionsRequest) Reset()         { *m = QueryPairRewardsAuctionsRequest{} }
+func (m *QueryPairRewardsAuctionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPairRewardsAuctionsRequest) ProtoMessage()    {}
+func (*QueryPairRewardsAuctionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d6573e06fd69a92, []int{18}
+}
+func (m *QueryPairRewardsAuctionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPairRewardsAuctionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPairRewardsAuctionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPairRewardsAuctionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPairRewardsAuctionsRequest.Merge(m, src)
+}
+func (m *QueryPairRewardsAuctionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPairRewardsAuctionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPairRewardsAuctionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPairRewardsAuctionsRequest proto.InternalMessageInfo
+
+func (m *QueryPairRewardsAuctionsRequest) GetPairId() uint64 {
+	if m != nil {
+		return m.PairId
+	}
+	return 0
+}
+
+func (m *QueryPairRewardsAuctionsRequest) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *QueryPairRewardsAuctionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPairRewardsAuctionsResponse is response type for the Query/PairRewardsAuctions RPC method.
+type QueryPairRewardsAuctionsResponse struct {
+	RewardAuctions []RewardsAuction    `protobuf:"bytes,1,rep,name=reward_auctions,json=rewardAuctions,proto3" json:"reward_auctions"`
+	Pagination     *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPairRewardsAuctionsResponse) Reset()         { *m = QueryPairRewardsAuctionsResponse{} }
+func (m *QueryPairRewardsAuctionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPairRewardsAuctionsResponse) ProtoMessage()    {}
+func (*QueryPairRewardsAuctionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d6573e06fd69a92, []int{19}
+}
+func (m *QueryPairRewardsAuctionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPairRewardsAuctionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPairRewardsAuctionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPairRewardsAuctionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPairRewardsAuctionsResponse.Merge(m, src)
+}
+func (m *QueryPairRewardsAuctionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPairRewardsAuctionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPairRewardsAuctionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPairRewardsAuctionsResponse proto.InternalMessageInfo
+
+func (m *QueryPairRewardsAuctionsResponse) GetRewardAuctions() []RewardsAuction {
+	if m != nil {
+		return m.RewardAuctions
+	}
+	return nil
+}
+
+func (m *QueryPairRewardsAuctionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryVaultsRequest is the request type for the Query/Vaults RPC method.
+type QueryVaultsRequest struct {
+}
+
+func (m *QueryVaultsRequest) Reset()         { *m = QueryVaultsRequest{} }
+func (m *QueryVaultsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVaultsRequest) ProtoMessage()    {}
+func (*QueryVaultsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d6573e06fd69a92, []int{20}
+}
+func (m *QueryVaultsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVaultsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVaultsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVaultsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVaultsRequest.Merge(m, src)
+}
+func (m *QueryVaultsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVaultsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVaultsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVaultsRequest proto.InternalMessageInfo
+
+// QueryVaultsResponse is response type for the Query/Vaults RPC method.
+type QueryVaultsResponse struct {
+	Vaults []VaultResponse `protobuf:"bytes,1,rep,name=vaults,proto3" json:"vaults"`
+}
+
+func (m *QueryVaultsResponse) Reset()         { *m = QueryVaultsResponse{} }
+func (m *QueryVaultsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVaultsResponse) ProtoMessage()    {}
+func (*QueryVaultsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d6573e06fd69a92, []int{21}
+}
+func (m *QueryVaultsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVaultsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVaultsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVaultsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVaultsResponse.Merge(m, src)
+}
+func (m *QueryVaultsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVaultsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVaultsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVaultsResponse proto.InternalMessageInfo
+
+func (m *QueryVaultsResponse) GetVaults() []VaultResponse {
+	if m != nil {
+		return m.Vaults
+	}
+	return nil
+}
+
+// QueryVaultRequest is the request type for the Query/Vault RPC method.
+type QueryVaultRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+}
+
+func (m *QueryVaultRequest) Reset()         { *m = QueryVaultRequest{} }
+func (m *QueryVaultRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVaultRequest) ProtoMessage()    {}
+func (*QueryVaultRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d6573e06fd69a92, []int{22}
+}
+func (m *QueryVaultRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVaultRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVaultRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVaultRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVaultRequest.Merge(m, src)
+}
+func (m *QueryVaultRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVaultRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVaultRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVaultRequest proto.InternalMessageInfo
+
+func (m *QueryVaultRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+// QueryVaultResponse is response type for the Query/Vault RPC method.
+type QueryVaultResponse struct {
+	Vault VaultResponse `protobuf:"bytes,1,opt,name=vault,proto3" json:"vault"`
+}
+
+func (m *QueryVaultResponse) Reset()         { *m = QueryVaultResponse{} }
+func (m *QueryVaultResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVaultResponse) ProtoMessage()    {}
+func (*QueryVaultResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d6573e06fd69a92, []int{23}
+}
+func (m *QueryVaultResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVaultResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVaultResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVaultResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVaultResponse.Merge(m, src)
+}
+func (m *QueryVaultResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVaultResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVaultResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVaultResponse proto.InternalMessageInfo
+
+func (m *QueryVaultResponse) GetVault() VaultResponse {
+	if m != nil {
+		return m.Vault
+	}
+	return VaultResponse{}
+}
+
 // LiquidFarmResponse is response type for the Query/LiquidFarm RPC method.
 type LiquidFarmResponse struct {
 	PoolId                   uint64                                 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
@@ -806,7 +1187,7 @@ func (m *LiquidFarmResponse) Reset()         { *m = LiquidFarmResponse{} }
 func (m *LiquidFarmResponse) String() string { return proto.CompactTextString(m) }
 func (*LiquidFarmResponse) ProtoMessage()    {}
 func (*LiquidFarmResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d6573e06fd69a92, []int{16}
+	return fileDescriptor_9d6573e06fd69a92, []int{24}
 }
 func (m *LiquidFarmResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -865,7 +1246,7 @@ func (m *ExchangeRateResponse) Reset()         { *m = ExchangeRateResponse{} }
 func (m *ExchangeRateResponse) String() string { return proto.CompactTextString(m) }
 func (*ExchangeRateResponse) ProtoMessage()    {}
 func (*ExchangeRateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d6573e06fd69a92, []int{17}
+	return fileDescriptor_9d6573e06fd69a92, []int{25}
 }
 func (m *ExchangeRateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -894,105 +1275,301 @@ func (m *ExchangeRateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ExchangeRateResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*QueryLiquidFarmsRequest)(nil), "squad.liquidfarming.v1beta1.QueryLiquidFarmsRequest")
-	proto.RegisterType((*QueryLiquidFarmsResponse)(nil), "squad.liquidfarming.v1beta1.QueryLiquidFarmsResponse")
-	proto.RegisterType((*QueryLiquidFarmRequest)(nil), "squad.liquidfarming.v1beta1.QueryLiquidFarmRequest")
-	proto.RegisterType((*QueryLiquidFarmResponse)(nil), "squad.liquidfarming.v1beta1.QueryLiquidFarmResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "squad.liquidfarming.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "squad.liquidfarming.v1beta1.QueryParamsResponse")
-	proto.RegisterType((*QueryRewardsAuctionsRequest)(nil), "squad.liquidfarming.v1beta1.QueryRewardsAuctionsRequest")
-	proto.RegisterType((*QueryRewardsAuctionsResponse)(nil), "squad.liquidfarming.v1beta1.QueryRewardsAuctionsResponse")
-	proto.RegisterType((*QueryRewardsAuctionRequest)(nil), "squad.liquidfarming.v1beta1.QueryRewardsAuctionRequest")
-	proto.RegisterType((*QueryRewardsAuctionResponse)(nil), "squad.liquidfarming.v1beta1.QueryRewardsAuctionResponse")
-	proto.RegisterType((*QueryBidsRequest)(nil), "squad.liquidfarming.v1beta1.QueryBidsRequest")
-	proto.RegisterType((*QueryBidsResponse)(nil), "squad.liquidfarming.v1beta1.QueryBidsResponse")
-	proto.RegisterType((*QueryRewardsRequest)(nil), "squad.liquidfarming.v1beta1.QueryRewardsRequest")
-	proto.RegisterType((*QueryRewardsResponse)(nil), "squad.liquidfarming.v1beta1.QueryRewardsResponse")
-	proto.RegisterType((*QueryExchangeRateRequest)(nil), "squad.liquidfarming.v1beta1.QueryExchangeRateRequest")
-	proto.RegisterType((*QueryExchangeRateResponse)(nil), "squad.liquidfarming.v1beta1.QueryExchangeRateResponse")
-	proto.RegisterType((*LiquidFarmResponse)(nil), "squad.liquidfarming.v1beta1.LiquidFarmResponse")
-	proto.RegisterType((*ExchangeRateResponse)(nil), "squad.liquidfarming.v1beta1.ExchangeRateResponse")
+// PairLiquidFarmResponse is response type for the Query/PairLiquidFarms RPC method.
+type PairLiquidFarmResponse struct {
+	PairId                   uint64                                 `protobuf:"varint,1,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	LiquidFarmReserveAddress string                                 `protobuf:"bytes,2,opt,name=liquid_farm_reserve_address,json=liquidFarmReserveAddress,proto3" json:"liquid_farm_reserve_address,omitempty"`
+	LFCoinDenom              string                                 `protobuf:"bytes,3,opt,name=lf_coin_denom,json=lfCoinDenom,proto3" json:"lf_coin_denom,omitempty"`
+	MinFarmAmount            github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=min_farm_amount,json=minFarmAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_farm_amount"`
+	MinBidAmount             github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=min_bid_amount,json=minBidAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_bid_amount"`
+	// farming_coins specifies the basket of the pool coins farmed by the pair liquid farm
+	FarmingCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=farming_coins,json=farmingCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"farming_coins"`
+	// total_value specifies the value of the basket in the quote coin of the pair
+	TotalValue github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=total_value,json=totalValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"total_value"`
 }
 
-func init() {
-	proto.RegisterFile("squad/liquidfarming/v1beta1/query.proto", fileDescriptor_9d6573e06fd69a92)
+func (m *PairLiquidFarmResponse) Reset()         { *m = PairLiquidFarmResponse{} }
+func (m *PairLiquidFarmResponse) String() string { return proto.CompactTextString(m) }
+func (*PairLiquidFarmResponse) ProtoMessage()    {}
+func (*PairLiquidFarmResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d6573e06fd69a92, []int{26}
+}
+func (m *PairLiquidFarmResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PairLiquidFarmResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PairLiquidFarmResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PairLiquidFarmResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PairLiquidFarmResponse.Merge(m, src)
+}
+func (m *PairLiquidFarmResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PairLiquidFarmResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PairLiquidFarmResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PairLiquidFarmResponse proto.InternalMessageInfo
+
+func (m *PairLiquidFarmResponse) GetPairId() uint64 {
+	if m != nil {
+		return m.PairId
+	}
+	return 0
+}
+
+func (m *PairLiquidFarmResponse) GetLiquidFarmReserveAddress() string {
+	if m != nil {
+		return m.LiquidFarmReserveAddress
+	}
+	return ""
+}
+
+func (m *PairLiquidFarmResponse) GetLFCoinDenom() string {
+	if m != nil {
+		return m.LFCoinDenom
+	}
+	return ""
+}
+
+func (m *PairLiquidFarmResponse) GetFarmingCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FarmingCoins
+	}
+	return nil
+}
+
+// VaultResponse is response type for the Query/Vaults RPC method.
+type VaultResponse struct {
+	PoolId              uint64                                 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	VaultReserveAddress string                                 `protobuf:"bytes,2,opt,name=vault_reserve_address,json=vaultReserveAddress,proto3" json:"vault_reserve_address,omitempty"`
+	VaultCoinDenom      string                                 `protobuf:"bytes,3,opt,name=vault_coin_denom,json=vaultCoinDenom,proto3" json:"vault_coin_denom,omitempty"`
+	MinFarmAmount       github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=min_farm_amount,json=minFarmAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_farm_amount"`
+	MaxSlippage         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=max_slippage,json=maxSlippage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_slippage"`
+	CompoundingInterval time.Duration                          `protobuf:"bytes,6,opt,name=compounding_interval,json=compoundingInterval,proto3,stdduration" json:"compounding_interval"`
+	// total_farming_amount specifies the amount of the pool coin held by the vault, including the farmed one
+	TotalFarmingAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=total_farming_amount,json=totalFarmingAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_farming_amount"`
+	LastCompoundedAt   *time.Time                             `protobuf:"bytes,8,opt,name=last_compounded_at,json=lastCompoundedAt,proto3,stdtime" json:"last_compounded_at,omitempty"`
+}
+
+func (m *VaultResponse) Reset()         { *m = VaultResponse{} }
+func (m *VaultResponse) String() string { return proto.CompactTextString(m) }
+func (*VaultResponse) ProtoMessage()    {}
+func (*VaultResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d6573e06fd69a92, []int{27}
+}
+func (m *VaultResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VaultResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VaultResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VaultResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VaultResponse.Merge(m, src)
+}
+func (m *VaultResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *VaultResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VaultResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VaultResponse proto.InternalMessageInfo
+
+func (m *VaultResponse) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *VaultResponse) GetVaultReserveAddress() string {
+	if m != nil {
+		return m.VaultReserveAddress
+	}
+	return ""
+}
+
+func (m *VaultResponse) GetVaultCoinDenom() string {
+	if m != nil {
+		return m.VaultCoinDenom
+	}
+	return ""
+}
+
+func (m *VaultResponse) GetCompoundingInterval() time.Duration {
+	if m != nil {
+		return m.CompoundingInterval
+	}
+	return 0
+}
+
+func (m *VaultResponse) GetLastCompoundedAt() *time.Time {
+	if m != nil {
+		return m.LastCompoundedAt
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryLiquidFarmsRequest)(nil), "squad.liquidfarming.v1beta1.QueryLiquidFarmsRequest")
+	proto.RegisterType((*QueryLiquidFarmsResponse)(nil), "squad.liquidfarming.v1beta1.QueryLiquidFarmsResponse")
+	proto.RegisterType((*QueryLiquidFarmRequest)(nil), "squad.liquidfarming.v1beta1.QueryLiquidFarmRequest")
+	proto.RegisterType((*QueryLiquidFarmResponse)(nil), "squad.liquidfarming.v1beta1.QueryLiquidFarmResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "squad.liquidfarming.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "squad.liquidfarming.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryRewardsAuctionsRequest)(nil), "squad.liquidfarming.v1beta1.QueryRewardsAuctionsRequest")
+	proto.RegisterType((*QueryRewardsAuctionsResponse)(nil), "squad.liquidfarming.v1beta1.QueryRewardsAuctionsResponse")
+	proto.RegisterType((*QueryRewardsAuctionRequest)(nil), "squad.liquidfarming.v1beta1.QueryRewardsAuctionRequest")
+	proto.RegisterType((*QueryRewardsAuctionResponse)(nil), "squad.liquidfarming.v1beta1.QueryRewardsAuctionResponse")
+	proto.RegisterType((*QueryBidsRequest)(nil), "squad.liquidfarming.v1beta1.QueryBidsRequest")
+	proto.RegisterType((*QueryBidsResponse)(nil), "squad.liquidfarming.v1beta1.QueryBidsResponse")
+	proto.RegisterType((*QueryRewardsRequest)(nil), "squad.liquidfarming.v1beta1.QueryRewardsRequest")
+	proto.RegisterType((*QueryRewardsResponse)(nil), "squad.liquidfarming.v1beta1.QueryRewardsResponse")
+	proto.RegisterType((*QueryExchangeRateRequest)(nil), "squad.liquidfarming.v1beta1.QueryExchangeRateRequest")
+	proto.RegisterType((*QueryExchangeRateResponse)(nil), "squad.liquidfarming.v1beta1.QueryExchangeRateResponse")
+	proto.RegisterType((*QueryPairLiquidFarmsRequest)(nil), "squad.liquidfarming.v1beta1.QueryPairLiquidFarmsRequest")
+	proto.RegisterType((*QueryPairLiquidFarmsResponse)(nil), "squad.liquidfarming.v1beta1.QueryPairLiquidFarmsResponse")
+	proto.RegisterType((*QueryPairRewardsAuctionsRequest)(nil), "squad.liquidfarming.v1beta1.QueryPairRewardsAuctionsRequest")
+	proto.RegisterType((*QueryPairRewardsAuctionsResponse)(nil), "squad.liquidfarming.v1beta1.QueryPairRewardsAuctionsResponse")
+	proto.RegisterType((*QueryVaultsRequest)(nil), "squad.liquidfarming.v1beta1.QueryVaultsRequest")
+	proto.RegisterType((*QueryVaultsResponse)(nil), "squad.liquidfarming.v1beta1.QueryVaultsResponse")
+	proto.RegisterType((*QueryVaultRequest)(nil), "squad.liquidfarming.v1beta1.QueryVaultRequest")
+	proto.RegisterType((*QueryVaultResponse)(nil), "squad.liquidfarming.v1beta1.QueryVaultResponse")
+	proto.RegisterType((*LiquidFarmResponse)(nil), "squad.liquidfarming.v1beta1.LiquidFarmResponse")
+	proto.RegisterType((*ExchangeRateResponse)(nil), "squad.liquidfarming.v1beta1.ExchangeRateResponse")
+	proto.RegisterType((*PairLiquidFarmResponse)(nil), "squad.liquidfarming.v1beta1.PairLiquidFarmResponse")
+	proto.RegisterType((*VaultResponse)(nil), "squad.liquidfarming.v1beta1.VaultResponse")
+}
+
+func init() {
+	proto.RegisterFile("squad/liquidfarming/v1beta1/query.proto", fileDescriptor_9d6573e06fd69a92)
 }
 
 var fileDescriptor_9d6573e06fd69a92 = []byte{
-	// 1147 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xdd, 0x6e, 0x1b, 0x45,
-	0x14, 0xce, 0x26, 0xa9, 0x43, 0x8e, 0xf3, 0x03, 0x43, 0xd4, 0xba, 0x4e, 0x71, 0xa2, 0xad, 0x68,
-	0xad, 0x56, 0xd9, 0x8d, 0x93, 0xd0, 0x9f, 0x08, 0x04, 0x71, 0x5b, 0x43, 0xd4, 0x5e, 0x84, 0x55,
-	0x55, 0x45, 0x15, 0x92, 0x19, 0x7b, 0x27, 0xee, 0x0a, 0xef, 0xae, 0xbd, 0xb3, 0x6e, 0x1b, 0x55,
-	0xb9, 0xe1, 0x09, 0x90, 0x90, 0x50, 0x9f, 0x80, 0x0b, 0xc4, 0x05, 0x3f, 0x8f, 0xc0, 0x05, 0xbd,
-	0xac, 0xd4, 0x1b, 0x84, 0x44, 0x40, 0x09, 0xbc, 0x07, 0x9a, 0xd9, 0xb3, 0xf1, 0x6e, 0xbc, 0xf5,
-	0xcf, 0xaa, 0x57, 0xb1, 0x67, 0xe6, 0x7c, 0xe7, 0xfb, 0xbe, 0x39, 0x3e, 0x73, 0x02, 0x97, 0x79,
-	0xbb, 0x43, 0x4d, 0xbd, 0x69, 0xb5, 0x3b, 0x96, 0xb9, 0x47, 0x3d, 0xdb, 0x72, 0x1a, 0xfa, 0xe3,
-	0x52, 0x8d, 0xf9, 0xb4, 0xa4, 0xb7, 0x3b, 0xcc, 0xdb, 0xd7, 0x5a, 0x9e, 0xeb, 0xbb, 0x64, 0x51,
-	0x1e, 0xd4, 0x62, 0x07, 0x35, 0x3c, 0x98, 0xbf, 0x52, 0x77, 0xb9, 0xed, 0x72, 0xbd, 0x46, 0x39,
-	0x0b, 0xa2, 0x4e, 0x30, 0x5a, 0xb4, 0x61, 0x39, 0xd4, 0xb7, 0x5c, 0x27, 0x00, 0xca, 0x17, 0xa2,
-	0x67, 0xc3, 0x53, 0x75, 0xd7, 0x0a, 0xf7, 0x17, 0x1a, 0x6e, 0xc3, 0x95, 0x1f, 0x75, 0xf1, 0x09,
-	0x57, 0x2f, 0x34, 0x5c, 0xb7, 0xd1, 0x64, 0x3a, 0x6d, 0x59, 0x3a, 0x75, 0x1c, 0xd7, 0x97, 0x90,
-	0x1c, 0x77, 0xf5, 0x7e, 0x2a, 0xe2, 0x94, 0x83, 0x80, 0x62, 0xbf, 0x80, 0x16, 0xf5, 0xa8, 0x8d,
-	0xd0, 0x2a, 0x85, 0x73, 0x9f, 0x0b, 0x41, 0xf7, 0xe4, 0xd1, 0x0a, 0xf5, 0x6c, 0x6e, 0xb0, 0x76,
-	0x87, 0x71, 0x9f, 0x54, 0x00, 0xba, 0xea, 0x72, 0xca, 0xb2, 0x52, 0xcc, 0xae, 0x5d, 0xd2, 0x02,
-	0x79, 0x9a, 0x90, 0xa7, 0x05, 0x06, 0x22, 0xae, 0xb6, 0x43, 0x1b, 0x0c, 0x63, 0x8d, 0x48, 0xa4,
-	0xea, 0x43, 0xae, 0x37, 0x05, 0x6f, 0xb9, 0x0e, 0x67, 0x64, 0x17, 0x66, 0x02, 0x92, 0x55, 0xc1,
-	0x92, 0xe7, 0x94, 0xe5, 0x89, 0x62, 0x76, 0x4d, 0xd7, 0xfa, 0xdc, 0x86, 0xd6, 0xc5, 0x09, 0x61,
-	0xca, 0x93, 0x2f, 0x0e, 0x97, 0xc6, 0x8c, 0x6c, 0xb3, 0x9b, 0x41, 0x2d, 0xc1, 0xd9, 0x53, 0x59,
-	0x43, 0x5d, 0xe7, 0x60, 0xaa, 0xe5, 0xba, 0xcd, 0xaa, 0x65, 0x4a, 0x51, 0x93, 0x46, 0x46, 0x7c,
-	0xdd, 0x36, 0xd5, 0x76, 0x8f, 0x17, 0x27, 0x3c, 0x1f, 0x40, 0x36, 0xc2, 0x13, 0xcd, 0x48, 0x49,
-	0x13, 0xba, 0x34, 0xd5, 0x05, 0x20, 0x32, 0xe5, 0x8e, 0xbc, 0x13, 0x64, 0xa8, 0xee, 0xc2, 0xbb,
-	0xb1, 0x55, 0x24, 0xb1, 0x05, 0x99, 0xe0, 0xee, 0x30, 0xff, 0xc5, 0xbe, 0xf9, 0x83, 0x60, 0xcc,
-	0x89, 0x81, 0xea, 0x77, 0x0a, 0x2c, 0x4a, 0x68, 0x83, 0x3d, 0xa1, 0x9e, 0xc9, 0xb7, 0x3a, 0x75,
-	0x59, 0x68, 0x83, 0xbc, 0x21, 0x67, 0x21, 0xc3, 0x7d, 0xea, 0x77, 0x78, 0x6e, 0x7c, 0x59, 0x29,
-	0x4e, 0x1b, 0xf8, 0xed, 0x54, 0x91, 0x4c, 0xa4, 0x2e, 0x92, 0xdf, 0x14, 0xb8, 0x90, 0x4c, 0x0c,
-	0xc5, 0x3f, 0x84, 0x79, 0x4f, 0x6e, 0x55, 0x29, 0x6e, 0x61, 0xb1, 0x5c, 0xed, 0xeb, 0x42, 0x1c,
-	0x0e, 0xdd, 0x98, 0x0b, 0x90, 0xc2, 0x1c, 0xe4, 0xd3, 0x98, 0x88, 0x71, 0x29, 0xe2, 0xf2, 0x40,
-	0x11, 0x01, 0xb1, 0x98, 0x8a, 0xfb, 0x90, 0x4f, 0x10, 0x11, 0x9a, 0xfb, 0x1e, 0x00, 0x72, 0xef,
-	0xfa, 0x3b, 0x8d, 0x2b, 0xdb, 0x66, 0xd4, 0xfb, 0xf1, 0x58, 0x5d, 0x3e, 0x49, 0xbc, 0xb3, 0xc8,
-	0x6f, 0x68, 0x2e, 0xee, 0x0c, 0x96, 0x47, 0x0a, 0x63, 0x66, 0x63, 0xc6, 0xa8, 0x1c, 0xde, 0x96,
-	0x89, 0xcb, 0x96, 0x39, 0xb8, 0x42, 0x2a, 0x09, 0x26, 0xa6, 0xa9, 0x84, 0xe7, 0x0a, 0xbc, 0x13,
-	0xc9, 0x8a, 0x22, 0x37, 0x61, 0xb2, 0x66, 0x99, 0xe1, 0x9d, 0x2f, 0xf7, 0x95, 0x56, 0xb6, 0x4c,
-	0xd4, 0x23, 0x63, 0xde, 0xdc, 0xf5, 0x6a, 0xf8, 0xbb, 0x44, 0xef, 0x06, 0x36, 0x94, 0x03, 0x58,
-	0x88, 0x9f, 0x47, 0x31, 0x0c, 0xa6, 0x02, 0xa3, 0x43, 0x3d, 0xe7, 0x63, 0x6c, 0x42, 0x1e, 0xb7,
-	0x5c, 0xcb, 0x29, 0xaf, 0x0a, 0x21, 0x3f, 0xfc, 0xbd, 0x54, 0x6c, 0x58, 0xfe, 0xa3, 0x4e, 0x4d,
-	0xab, 0xbb, 0xb6, 0x8e, 0x4f, 0x4c, 0xf0, 0x67, 0x85, 0x9b, 0x5f, 0xe9, 0xfe, 0x7e, 0x8b, 0x71,
-	0x19, 0xc0, 0x8d, 0x10, 0x5b, 0x5d, 0xc7, 0xc6, 0x7b, 0xe7, 0x69, 0xfd, 0x11, 0x75, 0x1a, 0xcc,
-	0xa0, 0x3e, 0x1b, 0xc8, 0x79, 0x1f, 0xce, 0x27, 0x04, 0x21, 0xf1, 0x2f, 0x60, 0x96, 0xe1, 0x7a,
-	0xd5, 0xa3, 0x3e, 0xc3, 0x4a, 0x2b, 0xf5, 0xbd, 0x8e, 0x24, 0x24, 0xbc, 0x9f, 0x19, 0x16, 0xd9,
-	0x53, 0x7f, 0x9e, 0x00, 0x92, 0xd0, 0x7b, 0x5f, 0x5b, 0x71, 0x1f, 0xc1, 0x62, 0xa4, 0x29, 0x57,
-	0x3d, 0xc6, 0x99, 0xf7, 0x98, 0x55, 0xa9, 0x69, 0x7a, 0x8c, 0x87, 0x8d, 0x2a, 0xd7, 0x8c, 0x22,
-	0x8a, 0x03, 0x5b, 0xc1, 0x3e, 0x59, 0x87, 0xd9, 0xe6, 0x5e, 0x55, 0x3c, 0xcd, 0x55, 0x93, 0x39,
-	0xae, 0x2d, 0xbb, 0xd7, 0x74, 0x79, 0xfe, 0xe8, 0x70, 0x29, 0x7b, 0xaf, 0x22, 0xbc, 0xbc, 0x2d,
-	0x96, 0x8d, 0x6c, 0x73, 0xef, 0xe4, 0x0b, 0x79, 0x00, 0xf3, 0xb6, 0xe5, 0x04, 0x09, 0xa9, 0xed,
-	0x76, 0x1c, 0x3f, 0x37, 0x29, 0xc3, 0x34, 0x21, 0xe8, 0xcf, 0xc3, 0xa5, 0x4b, 0x43, 0xdc, 0xd3,
-	0xb6, 0xe3, 0x1b, 0xb3, 0xb6, 0xe5, 0x08, 0x52, 0x5b, 0x12, 0x84, 0xdc, 0x87, 0x39, 0x81, 0x5b,
-	0xb3, 0xcc, 0x10, 0xf6, 0x4c, 0x2a, 0xd8, 0x19, 0xdb, 0x72, 0xca, 0x96, 0x89, 0xa8, 0x5f, 0xc2,
-	0x82, 0xef, 0xfa, 0xb4, 0x59, 0xc5, 0x3b, 0x09, 0xb1, 0x33, 0xa9, 0xb0, 0x89, 0xc4, 0xaa, 0x04,
-	0x50, 0x41, 0x06, 0xf5, 0x27, 0x05, 0x16, 0x12, 0x4b, 0xe5, 0x2e, 0x4c, 0xdb, 0x96, 0xe3, 0x77,
-	0xcb, 0x64, 0xb4, 0x7c, 0xb7, 0x59, 0xdd, 0x78, 0x4b, 0x00, 0x08, 0x50, 0x01, 0x56, 0xeb, 0x78,
-	0x4e, 0x00, 0x36, 0x9e, 0x0e, 0x4c, 0x00, 0x08, 0xb0, 0xb5, 0xff, 0xb2, 0x70, 0x46, 0x96, 0x38,
-	0x79, 0xae, 0x40, 0x26, 0x78, 0x26, 0x49, 0xff, 0xb7, 0xbc, 0xf7, 0x8d, 0xce, 0xaf, 0x0e, 0x1f,
-	0x10, 0x38, 0xa2, 0x5e, 0xfd, 0xfa, 0xd5, 0xbf, 0xdf, 0x8e, 0xbf, 0x4f, 0x2e, 0xea, 0x83, 0xa7,
-	0x33, 0xf2, 0xa3, 0x02, 0xd9, 0xc8, 0xc0, 0x44, 0x36, 0x06, 0xa7, 0xeb, 0x1d, 0xe1, 0xf2, 0x1f,
-	0x8c, 0x18, 0x85, 0x4c, 0x57, 0x25, 0xd3, 0x2b, 0xa4, 0x38, 0xe4, 0xe0, 0xc9, 0xc9, 0xaf, 0x0a,
-	0x40, 0x17, 0x89, 0xac, 0x8f, 0x92, 0x37, 0x24, 0xbb, 0x31, 0x5a, 0x10, 0x72, 0xdd, 0x94, 0x5c,
-	0x37, 0xc8, 0xda, 0xb0, 0x5c, 0xf5, 0x67, 0xd8, 0x4d, 0x0e, 0xc8, 0x2b, 0x05, 0xe6, 0x4f, 0xcd,
-	0x1b, 0xe4, 0xc6, 0x60, 0x16, 0xc9, 0xb3, 0x53, 0xfe, 0x66, 0x8a, 0x48, 0x14, 0x71, 0x57, 0x8a,
-	0xb8, 0x43, 0x6e, 0x8d, 0x2e, 0x42, 0xc7, 0x6e, 0x7f, 0x32, 0x16, 0x91, 0xbf, 0x14, 0x98, 0x8b,
-	0x27, 0x22, 0xd7, 0x47, 0xa5, 0x16, 0x6a, 0xba, 0x31, 0x7a, 0x20, 0x4a, 0xda, 0x95, 0x92, 0x0c,
-	0xb2, 0xf3, 0x06, 0x24, 0xe9, 0xcf, 0xba, 0x73, 0xd3, 0x01, 0xf9, 0x5e, 0x81, 0x49, 0x31, 0x1b,
-	0x90, 0x95, 0xc1, 0xe4, 0x22, 0x93, 0x4b, 0x5e, 0x1b, 0xf6, 0x38, 0x2a, 0xf8, 0x58, 0x2a, 0xb8,
-	0x49, 0xae, 0xa7, 0x50, 0x20, 0xe7, 0x8e, 0x5f, 0x14, 0x98, 0x42, 0x77, 0xc8, 0xea, 0xd0, 0x46,
-	0x86, 0x74, 0x4b, 0x23, 0x44, 0x20, 0xe3, 0xb2, 0x64, 0xfc, 0x21, 0xd9, 0x4c, 0xef, 0x39, 0xf9,
-	0x5d, 0x81, 0x99, 0x68, 0x43, 0x27, 0x43, 0xf4, 0x90, 0x84, 0x01, 0x23, 0x7f, 0x6d, 0xd4, 0x30,
-	0xd4, 0xf0, 0x99, 0xd4, 0x50, 0x26, 0x9f, 0xa4, 0xd0, 0x10, 0x9b, 0x4d, 0xca, 0x3b, 0x2f, 0x8e,
-	0x0a, 0xca, 0xcb, 0xa3, 0x82, 0xf2, 0xcf, 0x51, 0x41, 0xf9, 0xe6, 0xb8, 0x30, 0xf6, 0xf2, 0xb8,
-	0x30, 0xf6, 0xc7, 0x71, 0x61, 0xec, 0xe1, 0xb5, 0x9e, 0x37, 0x43, 0xa4, 0x5a, 0x69, 0xd2, 0x1a,
-	0xc7, 0xac, 0x4f, 0x4f, 0xe5, 0x95, 0xef, 0x48, 0x2d, 0x23, 0xff, 0x67, 0x5e, 0xff, 0x3f, 0x00,
-	0x00, 0xff, 0xff, 0x00, 0xd4, 0xb0, 0xf5, 0x56, 0x10, 0x00, 0x00,
+	// 1633 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0x4b, 0x6f, 0xd4, 0x56,
+	0x14, 0x8e, 0xc3, 0x64, 0x42, 0xce, 0xe4, 0x01, 0x37, 0x29, 0x0c, 0x03, 0x24, 0x91, 0x51, 0x21,
+	0x82, 0xc6, 0xce, 0x83, 0xb7, 0x40, 0x6d, 0x06, 0x08, 0xa4, 0xa0, 0x36, 0x4c, 0x51, 0x84, 0x10,
+	0xd2, 0xf4, 0x4e, 0xec, 0x0c, 0x56, 0xc7, 0xf6, 0xc4, 0xf6, 0x84, 0x20, 0x94, 0x4d, 0xa5, 0xae,
+	0xba, 0x41, 0xb4, 0x42, 0xa8, 0x9b, 0xee, 0xba, 0xa8, 0xba, 0xe8, 0x63, 0x59, 0x75, 0xd5, 0x45,
+	0x59, 0x22, 0xb1, 0xa9, 0x2a, 0x15, 0xaa, 0xc0, 0x0f, 0xa9, 0xee, 0xbd, 0xc7, 0x33, 0xf6, 0x3c,
+	0x3d, 0x56, 0x58, 0x54, 0xea, 0x2a, 0x99, 0x7b, 0xef, 0xf9, 0xce, 0xf7, 0x9d, 0x73, 0x7c, 0xee,
+	0x03, 0x8e, 0xb9, 0xeb, 0x15, 0xaa, 0xa9, 0x25, 0x63, 0xbd, 0x62, 0x68, 0x6b, 0xd4, 0x31, 0x0d,
+	0xab, 0xa8, 0x6e, 0xcc, 0x16, 0x74, 0x8f, 0xce, 0xaa, 0xeb, 0x15, 0xdd, 0x79, 0xa0, 0x94, 0x1d,
+	0xdb, 0xb3, 0xc9, 0x41, 0xbe, 0x50, 0x09, 0x2d, 0x54, 0x70, 0x61, 0xe6, 0xf8, 0xaa, 0xed, 0x9a,
+	0xb6, 0xab, 0x16, 0xa8, 0xab, 0x0b, 0xab, 0x2a, 0x46, 0x99, 0x16, 0x0d, 0x8b, 0x7a, 0x86, 0x6d,
+	0x09, 0xa0, 0xcc, 0x78, 0x70, 0xad, 0xbf, 0x6a, 0xd5, 0x36, 0xfc, 0xf9, 0xb1, 0xa2, 0x5d, 0xb4,
+	0xf9, 0xbf, 0x2a, 0xfb, 0x0f, 0x47, 0x0f, 0x15, 0x6d, 0xbb, 0x58, 0xd2, 0x55, 0x5a, 0x36, 0x54,
+	0x6a, 0x59, 0xb6, 0xc7, 0x21, 0x5d, 0x1f, 0x13, 0x67, 0xf9, 0xaf, 0x42, 0x65, 0x4d, 0xd5, 0x2a,
+	0x4e, 0xd0, 0xe7, 0x44, 0xfd, 0xbc, 0x67, 0x98, 0xba, 0xeb, 0x51, 0xb3, 0x8c, 0x0b, 0xd4, 0x76,
+	0x61, 0x08, 0x6b, 0x16, 0x06, 0x53, 0xed, 0x0c, 0xca, 0xd4, 0xa1, 0x26, 0x72, 0x93, 0x29, 0xec,
+	0xbf, 0xc9, 0x22, 0x72, 0x83, 0x2f, 0x5d, 0xa4, 0x8e, 0xe9, 0xe6, 0xf4, 0xf5, 0x8a, 0xee, 0x7a,
+	0x64, 0x11, 0xa0, 0x16, 0x9e, 0xb4, 0x34, 0x29, 0x4d, 0xa5, 0xe6, 0x8e, 0x2a, 0x22, 0x3e, 0x0a,
+	0x8b, 0x8f, 0x22, 0x32, 0x80, 0xb8, 0xca, 0x32, 0x2d, 0xea, 0x68, 0x9b, 0x0b, 0x58, 0xca, 0x1e,
+	0xa4, 0x1b, 0x5d, 0xb8, 0x65, 0xdb, 0x72, 0x75, 0x72, 0x1b, 0x06, 0x05, 0xc9, 0x3c, 0x63, 0xe9,
+	0xa6, 0xa5, 0xc9, 0x5d, 0x53, 0xa9, 0x39, 0x55, 0x69, 0x93, 0x4e, 0xa5, 0x86, 0xe3, 0xc3, 0x64,
+	0x13, 0xcf, 0x5e, 0x4e, 0xf4, 0xe4, 0x52, 0xa5, 0x9a, 0x07, 0x79, 0x16, 0xf6, 0xd5, 0x79, 0xf5,
+	0x75, 0xed, 0x87, 0xfe, 0xb2, 0x6d, 0x97, 0xf2, 0x86, 0xc6, 0x45, 0x25, 0x72, 0x49, 0xf6, 0x73,
+	0x49, 0x93, 0xd7, 0x1b, 0x62, 0x51, 0xe5, 0xb9, 0x02, 0xa9, 0x00, 0x4f, 0x0c, 0x46, 0x4c, 0x9a,
+	0x50, 0xa3, 0x29, 0x8f, 0x01, 0xe1, 0x2e, 0x97, 0x79, 0x4e, 0x90, 0xa1, 0x7c, 0x1b, 0x46, 0x43,
+	0xa3, 0x48, 0x62, 0x01, 0x92, 0x22, 0x77, 0xe8, 0xff, 0x48, 0x5b, 0xff, 0xc2, 0x18, 0x7d, 0xa2,
+	0xa1, 0xfc, 0x44, 0x82, 0x83, 0x1c, 0x3a, 0xa7, 0xdf, 0xa7, 0x8e, 0xe6, 0x2e, 0x54, 0x56, 0x79,
+	0xa5, 0x76, 0x8a, 0x0d, 0xd9, 0x07, 0x49, 0xd7, 0xa3, 0x5e, 0xc5, 0x4d, 0xf7, 0x4e, 0x4a, 0x53,
+	0x03, 0x39, 0xfc, 0x55, 0x57, 0x24, 0xbb, 0x62, 0x17, 0xc9, 0xef, 0x12, 0x1c, 0x6a, 0x4e, 0x0c,
+	0xc5, 0xdf, 0x81, 0x11, 0x87, 0x4f, 0xe5, 0x29, 0x4e, 0x61, 0xb1, 0x9c, 0x68, 0x1b, 0x85, 0x30,
+	0x1c, 0x46, 0x63, 0x58, 0x20, 0xf9, 0x3e, 0xc8, 0xd5, 0x90, 0x88, 0x5e, 0x2e, 0xe2, 0x58, 0x47,
+	0x11, 0x82, 0x58, 0x48, 0xc5, 0x2d, 0xc8, 0x34, 0x11, 0xe1, 0x07, 0xf7, 0x30, 0x00, 0x72, 0xaf,
+	0xc5, 0x77, 0x00, 0x47, 0x96, 0xb4, 0x60, 0xec, 0x7b, 0x43, 0x75, 0x79, 0xbf, 0x69, 0xce, 0x02,
+	0xdf, 0xd0, 0x70, 0x38, 0x32, 0x58, 0x1e, 0x31, 0x02, 0x33, 0x14, 0x0a, 0x8c, 0xec, 0xc2, 0x1e,
+	0xee, 0x38, 0x6b, 0x68, 0x9d, 0x2b, 0x64, 0xb1, 0x49, 0x10, 0xe3, 0x54, 0xc2, 0x53, 0x09, 0xf6,
+	0x06, 0xbc, 0xa2, 0xc8, 0xf3, 0x90, 0x28, 0x18, 0x9a, 0x9f, 0xf3, 0xc9, 0xb6, 0xd2, 0xb2, 0x86,
+	0x86, 0x7a, 0xb8, 0xcd, 0xce, 0xa5, 0x57, 0xc1, 0xef, 0x12, 0x63, 0xd7, 0xb1, 0xa1, 0x6c, 0xc1,
+	0x58, 0x78, 0x3d, 0x8a, 0xd1, 0xa1, 0x5f, 0x04, 0xda, 0xd7, 0x73, 0x20, 0xc4, 0xc6, 0xe7, 0x71,
+	0xc9, 0x36, 0xac, 0xec, 0x0c, 0x13, 0xf2, 0xfd, 0xab, 0x89, 0xa9, 0xa2, 0xe1, 0xdd, 0xab, 0x14,
+	0x94, 0x55, 0xdb, 0x54, 0x71, 0x8f, 0x12, 0x7f, 0xa6, 0x5d, 0xed, 0x33, 0xd5, 0x7b, 0x50, 0xd6,
+	0x5d, 0x6e, 0xe0, 0xe6, 0x7c, 0x6c, 0xf9, 0x06, 0x36, 0xde, 0x2b, 0x9b, 0xab, 0xf7, 0xa8, 0x55,
+	0xd4, 0x73, 0xd4, 0xd3, 0x3b, 0xa6, 0x91, 0x4d, 0x50, 0xc3, 0x09, 0x56, 0x21, 0x35, 0x9c, 0x25,
+	0x4d, 0x7e, 0x00, 0x07, 0x9a, 0xa0, 0xa1, 0xa2, 0xbb, 0x30, 0xa4, 0xe3, 0x78, 0xde, 0xa1, 0x9e,
+	0x8e, 0x25, 0x38, 0xdb, 0x36, 0x4f, 0xcd, 0x90, 0x30, 0x71, 0x83, 0x7a, 0x60, 0x4e, 0x3e, 0x8c,
+	0x1f, 0xc0, 0x32, 0x35, 0x9c, 0xc6, 0x8d, 0x4a, 0xfe, 0xc2, 0xef, 0x1d, 0x0d, 0xf3, 0xd5, 0x78,
+	0xef, 0xe5, 0x9a, 0x9a, 0x6c, 0x35, 0xf3, 0x1d, 0x7a, 0x68, 0x10, 0xb0, 0x8e, 0xe3, 0x48, 0x39,
+	0xec, 0x4e, 0xfe, 0x46, 0x82, 0x89, 0x2a, 0x8f, 0x36, 0x0d, 0x16, 0xc3, 0x2b, 0x05, 0xc3, 0xfb,
+	0xd6, 0x1b, 0xec, 0x1f, 0x12, 0x4c, 0xb6, 0x26, 0xf7, 0x5f, 0x6a, 0xb2, 0xfe, 0x9e, 0xb9, 0x42,
+	0x2b, 0x25, 0xaf, 0x5a, 0x04, 0x79, 0xfc, 0x36, 0xfd, 0x51, 0x54, 0x74, 0x0d, 0x92, 0x1b, 0x7c,
+	0x04, 0x85, 0x1c, 0x6f, 0x2b, 0x84, 0x1b, 0xd7, 0xa5, 0x19, 0xed, 0xe5, 0xf7, 0xb0, 0x2d, 0xe1,
+	0x9a, 0x0e, 0x9f, 0xfe, 0xdd, 0x20, 0xc9, 0x2a, 0x9b, 0x45, 0xe8, 0xe3, 0x68, 0xf8, 0x79, 0x74,
+	0x4f, 0x46, 0x98, 0xcb, 0x3f, 0xed, 0x02, 0xd2, 0xe4, 0x94, 0xd2, 0xf2, 0xa3, 0xbe, 0x08, 0x07,
+	0x03, 0xb5, 0x9f, 0x77, 0x74, 0x57, 0x77, 0x36, 0xf4, 0x3c, 0xd5, 0x34, 0x47, 0x77, 0xfd, 0x8a,
+	0x4b, 0x97, 0x82, 0x88, 0x6c, 0xc1, 0x82, 0x98, 0x27, 0xf3, 0x30, 0x54, 0x5a, 0xcb, 0xb3, 0x53,
+	0x70, 0x5e, 0xd3, 0x2d, 0xdb, 0xe4, 0x65, 0x38, 0x90, 0x1d, 0xd9, 0x7e, 0x39, 0x91, 0xba, 0xb1,
+	0xc8, 0xba, 0xce, 0x65, 0x36, 0x9c, 0x4b, 0x95, 0xd6, 0xaa, 0x3f, 0xc8, 0x0a, 0x8c, 0x98, 0x86,
+	0x25, 0x1c, 0x52, 0xd3, 0xae, 0x58, 0x5e, 0x3a, 0xc1, 0xcd, 0x14, 0xa6, 0xe4, 0xaf, 0x97, 0x13,
+	0x47, 0x23, 0x74, 0xb4, 0x25, 0xcb, 0xcb, 0x0d, 0x99, 0x86, 0xc5, 0x48, 0x2d, 0x70, 0x10, 0x72,
+	0x0b, 0x86, 0x19, 0x6e, 0xc1, 0xd0, 0x7c, 0xd8, 0xbe, 0x58, 0xb0, 0x83, 0xa6, 0x61, 0x65, 0x0d,
+	0x0d, 0x51, 0x3f, 0x85, 0x31, 0xcf, 0xf6, 0x68, 0x29, 0x8f, 0x59, 0xf0, 0xb1, 0x93, 0xb1, 0xb0,
+	0x09, 0xc7, 0x5a, 0x14, 0x50, 0xc2, 0x83, 0xfc, 0xa3, 0x04, 0x63, 0x4d, 0x7b, 0xe7, 0x75, 0x18,
+	0x30, 0x0d, 0xcb, 0xab, 0xf5, 0xcd, 0xee, 0xfc, 0x5d, 0xd6, 0x57, 0x73, 0xbb, 0x19, 0x00, 0x03,
+	0x65, 0x60, 0x85, 0x8a, 0x63, 0x09, 0xb0, 0xde, 0x78, 0x60, 0x0c, 0x80, 0xf7, 0xdd, 0x27, 0x09,
+	0xd8, 0xd7, 0xbc, 0x05, 0xb6, 0xee, 0x63, 0xff, 0x97, 0x5a, 0xa7, 0x52, 0x2b, 0xc3, 0x90, 0x5f,
+	0x64, 0x4c, 0xa7, 0x9b, 0x4e, 0xee, 0xfc, 0x19, 0x60, 0x10, 0x3d, 0xf0, 0x5f, 0xe4, 0x63, 0x48,
+	0x89, 0xe2, 0xde, 0xa0, 0xa5, 0x8a, 0x9e, 0xee, 0x8f, 0x55, 0x16, 0xc0, 0x21, 0x56, 0x18, 0x82,
+	0xfc, 0x6b, 0x02, 0x86, 0xc2, 0x9d, 0xad, 0x65, 0xeb, 0x99, 0x83, 0x77, 0x78, 0xcf, 0x6a, 0x51,
+	0x09, 0xa3, 0x1b, 0x08, 0x13, 0x2c, 0x82, 0x29, 0xd8, 0x23, 0x6c, 0xea, 0xeb, 0x20, 0x37, 0xcc,
+	0xc7, 0xdf, 0x7e, 0xe6, 0x6f, 0xc2, 0xa0, 0x49, 0x37, 0xf3, 0x6e, 0xc9, 0x28, 0x97, 0x69, 0x51,
+	0x8f, 0x91, 0x77, 0x16, 0xb2, 0x94, 0x49, 0x37, 0x3f, 0x41, 0x08, 0xb2, 0x02, 0x63, 0xab, 0xb6,
+	0x59, 0xb6, 0x2b, 0x96, 0xc6, 0x52, 0x6f, 0x58, 0x9e, 0xee, 0x6c, 0xd0, 0x12, 0xef, 0x30, 0x2c,
+	0xfb, 0xe2, 0x11, 0x40, 0xf1, 0x1f, 0x01, 0x94, 0xcb, 0xf8, 0x48, 0x90, 0xdd, 0xcd, 0xbc, 0x3e,
+	0x7d, 0x35, 0x21, 0xe5, 0x46, 0x03, 0x00, 0x4b, 0x68, 0xdf, 0xb2, 0x73, 0xf5, 0xef, 0x54, 0xe7,
+	0x22, 0x1f, 0x01, 0x29, 0x51, 0x97, 0x65, 0x43, 0x78, 0xd7, 0xb5, 0x3c, 0xf5, 0xd2, 0xbb, 0x39,
+	0xef, 0x4c, 0x03, 0xef, 0x5b, 0xfe, 0xe3, 0x45, 0x36, 0xf1, 0x88, 0x91, 0xde, 0xc3, 0x6c, 0x2f,
+	0x55, 0x4d, 0x17, 0xbc, 0xb9, 0xaf, 0x09, 0xf4, 0xf1, 0xcd, 0x91, 0x3c, 0x95, 0x20, 0x29, 0xee,
+	0xa9, 0xa4, 0xfd, 0x65, 0xba, 0xf1, 0x92, 0x9c, 0x99, 0x89, 0x6e, 0x20, 0x6a, 0x54, 0x3e, 0xf1,
+	0xf9, 0x8b, 0x37, 0x5f, 0xf5, 0xbe, 0x4b, 0x8e, 0xa8, 0x9d, 0x9f, 0x47, 0xc8, 0x0f, 0x12, 0xa4,
+	0x02, 0x87, 0x3b, 0x72, 0xb2, 0xb3, 0xbb, 0xc6, 0xa3, 0x69, 0xe6, 0x54, 0x97, 0x56, 0xc8, 0x74,
+	0x86, 0x33, 0x3d, 0x4e, 0xa6, 0x22, 0xbe, 0xfc, 0xb8, 0xe4, 0x17, 0x09, 0xa0, 0x86, 0x44, 0xe6,
+	0xbb, 0xf1, 0xeb, 0x93, 0x3d, 0xd9, 0x9d, 0x11, 0x72, 0x3d, 0xcf, 0xb9, 0x9e, 0x24, 0x73, 0x51,
+	0xb9, 0xaa, 0x0f, 0xb1, 0x53, 0x6c, 0x91, 0x17, 0x12, 0x8c, 0xd4, 0x9d, 0x45, 0xc9, 0xd9, 0xce,
+	0x2c, 0x9a, 0x9f, 0xad, 0x33, 0xe7, 0x62, 0x58, 0xa2, 0x88, 0xeb, 0x5c, 0xc4, 0x15, 0x72, 0xa9,
+	0x7b, 0x11, 0x2a, 0x5e, 0xb7, 0xaa, 0x47, 0x66, 0xf2, 0xb7, 0x04, 0xc3, 0x61, 0x47, 0xe4, 0x4c,
+	0xb7, 0xd4, 0x7c, 0x4d, 0x67, 0xbb, 0x37, 0x44, 0x49, 0xb7, 0xb9, 0xa4, 0x1c, 0x59, 0xde, 0x01,
+	0x49, 0xea, 0xc3, 0xda, 0xc3, 0xc5, 0x16, 0xf9, 0x4e, 0x82, 0x04, 0xbb, 0x9c, 0x93, 0xe9, 0xce,
+	0xe4, 0x02, 0x4f, 0x07, 0x19, 0x25, 0xea, 0x72, 0x54, 0xf0, 0x3e, 0x57, 0x70, 0x8e, 0x9c, 0x89,
+	0xa1, 0x80, 0x5f, 0xfc, 0x7f, 0x96, 0xa0, 0x1f, 0xa3, 0x43, 0x66, 0x22, 0x07, 0xd2, 0xa7, 0x3b,
+	0xdb, 0x85, 0x05, 0x32, 0xce, 0x72, 0xc6, 0x17, 0xc8, 0xf9, 0xf8, 0x31, 0x27, 0x8f, 0x7b, 0x61,
+	0x30, 0x78, 0x4e, 0x24, 0x11, 0x7a, 0x48, 0x93, 0x1b, 0x7e, 0xe6, 0x74, 0xb7, 0x66, 0xa8, 0xe1,
+	0xb1, 0xc4, 0x45, 0x7c, 0x29, 0xdd, 0xb9, 0x4a, 0xae, 0x74, 0xe8, 0x94, 0xd5, 0x3b, 0xb5, 0x2f,
+	0x46, 0x1c, 0x09, 0xb7, 0xd4, 0xd0, 0x63, 0x00, 0xf9, 0x20, 0x46, 0x38, 0xc2, 0x08, 0xbf, 0x49,
+	0x30, 0x52, 0x77, 0xbb, 0x8f, 0xd2, 0x28, 0x9a, 0x3f, 0x18, 0x44, 0x69, 0x14, 0x2d, 0x9e, 0x12,
+	0xe4, 0x53, 0x3c, 0x38, 0x2a, 0x99, 0xee, 0x2a, 0x32, 0xe4, 0x8d, 0x04, 0xa3, 0x4d, 0x2e, 0xde,
+	0xe4, 0x42, 0x34, 0x26, 0x2d, 0x1a, 0xde, 0xc5, 0x98, 0xd6, 0xa8, 0x65, 0x99, 0x6b, 0xf9, 0x90,
+	0x5c, 0x8b, 0x9b, 0xe5, 0x86, 0xce, 0xc7, 0xf6, 0x73, 0x71, 0x01, 0x8f, 0xb2, 0x9f, 0x87, 0x2e,
+	0xf0, 0x51, 0xf6, 0xf3, 0xf0, 0xdd, 0x3e, 0xe2, 0x7e, 0x2e, 0xae, 0xef, 0xe4, 0x5b, 0x09, 0xfa,
+	0xb8, 0x3d, 0x51, 0x22, 0x3a, 0xf2, 0x89, 0xa9, 0x91, 0xd7, 0x77, 0x55, 0x23, 0x82, 0x57, 0xad,
+	0xe2, 0xb3, 0xcb, 0xcf, 0xb6, 0xc7, 0xa5, 0xe7, 0xdb, 0xe3, 0xd2, 0x3f, 0xdb, 0xe3, 0xd2, 0xa3,
+	0xd7, 0xe3, 0x3d, 0xcf, 0x5f, 0x8f, 0xf7, 0xfc, 0xf9, 0x7a, 0xbc, 0xe7, 0xce, 0xe9, 0x86, 0xc3,
+	0x1b, 0xc3, 0x9d, 0x2e, 0xd1, 0x82, 0x8b, 0x2e, 0x36, 0xeb, 0x9c, 0xf0, 0x03, 0x5d, 0x21, 0xc9,
+	0x0f, 0x65, 0xf3, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x25, 0x76, 0xcb, 0x96, 0x47, 0x1b, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1022,7 +1599,16 @@ type QueryClient interface {
 	// Rewards returns all accumulated farming rewards for the liquid farm
 	Rewards(ctx context.Context, in *QueryRewardsRequest, opts ...grpc.CallOption) (*QueryRewardsResponse, error)
 	// ExchangeRate returns exchange rates (mint rate and burn rate) for the liquid farm
+	// The combined exchange rate of the pair liquid farm is returned when pair id is given
 	ExchangeRate(ctx context.Context, in *QueryExchangeRateRequest, opts ...grpc.CallOption) (*QueryExchangeRateResponse, error)
+	// PairLiquidFarms returns all pair liquid farms
+	PairLiquidFarms(ctx context.Context, in *QueryPairLiquidFarmsRequest, opts ...grpc.CallOption) (*QueryPairLiquidFarmsResponse, error)
+	// PairRewardsAuctions returns all rewards auctions that correspond to the given pair id
+	PairRewardsAuctions(ctx context.Context, in *QueryPairRewardsAuctionsRequest, opts ...grpc.CallOption) (*QueryPairRewardsAuctionsResponse, error)
+	// Vaults returns all vaults
+	Vaults(ctx context.Context, in *QueryVaultsRequest, opts ...grpc.CallOption) (*QueryVaultsResponse, error)
+	// Vault returns the specific vault
+	Vault(ctx context.Context, in *QueryVaultRequest, opts ...grpc.CallOption) (*QueryVaultResponse, error)
 }
 
 type queryClient struct {
@@ -1105,6 +1691,42 @@ func (c *queryClient) ExchangeRate(ctx context.Context, in *QueryExchangeRateReq
 	return out, nil
 }
 
+func (c *queryClient) PairLiquidFarms(ctx context.Context, in *QueryPairLiquidFarmsRequest, opts ...grpc.CallOption) (*QueryPairLiquidFarmsResponse, error) {
+	out := new(QueryPairLiquidFarmsResponse)
+	err := c.cc.Invoke(ctx, "/squad.liquidfarming.v1beta1.Query/PairLiquidFarms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PairRewardsAuctions(ctx context.Context, in *QueryPairRewardsAuctionsRequest, opts ...grpc.CallOption) (*QueryPairRewardsAuctionsResponse, error) {
+	out := new(QueryPairRewardsAuctionsResponse)
+	err := c.cc.Invoke(ctx, "/squad.liquidfarming.v1beta1.Query/PairRewardsAuctions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Vaults(ctx context.Context, in *QueryVaultsRequest, opts ...grpc.CallOption) (*QueryVaultsResponse, error) {
+	out := new(QueryVaultsResponse)
+	err := c.cc.Invoke(ctx, "/squad.liquidfarming.v1beta1.Query/Vaults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Vault(ctx context.Context, in *QueryVaultRequest, opts ...grpc.CallOption) (*QueryVaultResponse, error) {
+	out := new(QueryVaultResponse)
+	err := c.cc.Invoke(ctx, "/squad.liquidfarming.v1beta1.Query/Vault", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params returns parameters of the module
@@ -1122,7 +1744,16 @@ type QueryServer interface {
 	// Rewards returns all accumulated farming rewards for the liquid farm
 	Rewards(context.Context, *QueryRewardsRequest) (*QueryRewardsResponse, error)
 	// ExchangeRate returns exchange rates (mint rate and burn rate) for the liquid farm
+	// The combined exchange rate of the pair liquid farm is returned when pair id is given
 	ExchangeRate(context.Context, *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error)
+	// PairLiquidFarms returns all pair liquid farms
+	PairLiquidFarms(context.Context, *QueryPairLiquidFarmsRequest) (*QueryPairLiquidFarmsResponse, error)
+	// PairRewardsAuctions returns all rewards auctions that correspond to the given pair id
+	PairRewardsAuctions(context.Context, *QueryPairRewardsAuctionsRequest) (*QueryPairRewardsAuctionsResponse, error)
+	// Vaults returns all vaults
+	Vaults(context.Context, *QueryVaultsRequest) (*QueryVaultsResponse, error)
+	// Vault returns the specific vault
+	Vault(context.Context, *QueryVaultRequest) (*QueryVaultResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1153,6 +1784,18 @@ func (*UnimplementedQueryServer) Rewards(ctx context.Context, req *QueryRewardsR
 func (*UnimplementedQueryServer) ExchangeRate(ctx context.Context, req *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRate not implemented")
 }
+func (*UnimplementedQueryServer) PairLiquidFarms(ctx context.Context, req *QueryPairLiquidFarmsRequest) (*QueryPairLiquidFarmsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PairLiquidFarms not implemented")
+}
+func (*UnimplementedQueryServer) PairRewardsAuctions(ctx context.Context, req *QueryPairRewardsAuctionsRequest) (*QueryPairRewardsAuctionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PairRewardsAuctions not implemented")
+}
+func (*UnimplementedQueryServer) Vaults(ctx context.Context, req *QueryVaultsRequest) (*QueryVaultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Vaults not implemented")
+}
+func (*UnimplementedQueryServer) Vault(ctx context.Context, req *QueryVaultRequest) (*QueryVaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Vault not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1302,6 +1945,78 @@ func _Query_ExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PairLiquidFarms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPairLiquidFarmsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PairLiquidFarms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squad.liquidfarming.v1beta1.Query/PairLiquidFarms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PairLiquidFarms(ctx, req.(*QueryPairLiquidFarmsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PairRewardsAuctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPairRewardsAuctionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PairRewardsAuctions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squad.liquidfarming.v1beta1.Query/PairRewardsAuctions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PairRewardsAuctions(ctx, req.(*QueryPairRewardsAuctionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Vaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVaultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Vaults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squad.liquidfarming.v1beta1.Query/Vaults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Vaults(ctx, req.(*QueryVaultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Vault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVaultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Vault(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squad.liquidfarming.v1beta1.Query/Vault",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Vault(ctx, req.(*QueryVaultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "squad.liquidfarming.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1338,6 +2053,22 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ExchangeRate",
 			Handler:    _Query_ExchangeRate_Handler,
 		},
+		{
+			MethodName: "PairLiquidFarms",
+			Handler:    _Query_PairLiquidFarms_Handler,
+		},
+		{
+			MethodName: "PairRewardsAuctions",
+			Handler:    _Query_PairRewardsAuctions_Handler,
+		},
+		{
+			MethodName: "Vaults",
+			Handler:    _Query_Vaults_Handler,
+		},
+		{
+			MethodName: "Vault",
+			Handler:    _Query_Vault_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "squad/liquidfarming/v1beta1/query.proto",
@@ -1868,6 +2599,11 @@ func (m *QueryExchangeRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.PairId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PairId))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.PoolId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
 		i--
@@ -1909,7 +2645,7 @@ func (m *QueryExchangeRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *LiquidFarmResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryPairLiquidFarmsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1919,69 +2655,20 @@ func (m *LiquidFarmResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *LiquidFarmResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPairLiquidFarmsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LiquidFarmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPairLiquidFarmsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.TotalFarmingAmount.Size()
-		i -= size
-		if _, err := m.TotalFarmingAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.MinBidAmount.Size()
-		i -= size
-		if _, err := m.MinBidAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.MinFarmAmount.Size()
-		i -= size
-		if _, err := m.MinFarmAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.LFCoinDenom) > 0 {
-		i -= len(m.LFCoinDenom)
-		copy(dAtA[i:], m.LFCoinDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.LFCoinDenom)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.LiquidFarmReserveAddress) > 0 {
-		i -= len(m.LiquidFarmReserveAddress)
-		copy(dAtA[i:], m.LiquidFarmReserveAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.LiquidFarmReserveAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ExchangeRateResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryPairLiquidFarmsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1991,317 +2678,1708 @@ func (m *ExchangeRateResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ExchangeRateResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPairLiquidFarmsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ExchangeRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPairLiquidFarmsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.BurnRate.Size()
-		i -= size
-		if _, err := m.BurnRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.MintRate.Size()
-		i -= size
-		if _, err := m.MintRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.PairLiquidFarms) > 0 {
+		for iNdEx := len(m.PairLiquidFarms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PairLiquidFarms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryPairRewardsAuctionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryLiquidFarmsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryPairRewardsAuctionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPairRewardsAuctionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PairId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PairId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryLiquidFarmsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPairRewardsAuctionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPairRewardsAuctionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPairRewardsAuctionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.LiquidFarms) > 0 {
-		for _, e := range m.LiquidFarms {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.RewardAuctions) > 0 {
+		for iNdEx := len(m.RewardAuctions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardAuctions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryLiquidFarmRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+func (m *QueryVaultsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryLiquidFarmResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.LiquidFarm.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryVaultsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryVaultsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryVaultsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryRewardsAuctionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryVaultsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryRewardsAuctionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryVaultsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.RewardAuctions) > 0 {
-		for _, e := range m.RewardAuctions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Vaults) > 0 {
+		for iNdEx := len(m.Vaults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vaults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryRewardsAuctionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.AuctionId != 0 {
-		n += 1 + sovQuery(uint64(m.AuctionId))
-	}
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+func (m *QueryVaultRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryRewardsAuctionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.RewardAuction.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryVaultRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBidsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryVaultRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBidsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryVaultResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Bids) > 0 {
-		for _, e := range m.Bids {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Vault.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryRewardsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *LiquidFarmResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidFarmResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidFarmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	{
+		size := m.TotalFarmingAmount.Size()
+		i -= size
+		if _, err := m.TotalFarmingAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MinBidAmount.Size()
+		i -= size
+		if _, err := m.MinBidAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MinFarmAmount.Size()
+		i -= size
+		if _, err := m.MinFarmAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.LFCoinDenom) > 0 {
+		i -= len(m.LFCoinDenom)
+		copy(dAtA[i:], m.LFCoinDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LFCoinDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.LiquidFarmReserveAddress) > 0 {
+		i -= len(m.LiquidFarmReserveAddress)
+		copy(dAtA[i:], m.LiquidFarmReserveAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LiquidFarmReserveAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ExchangeRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangeRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExchangeRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Rewards) > 0 {
-		for _, e := range m.Rewards {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.BurnRate.Size()
+		i -= size
+		if _, err := m.BurnRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MintRate.Size()
+		i -= size
+		if _, err := m.MintRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryExchangeRateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+func (m *PairLiquidFarmResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryExchangeRateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ExchangeRate.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *PairLiquidFarmResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LiquidFarmResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *PairLiquidFarmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	{
+		size := m.TotalValue.Size()
+		i -= size
+		if _, err := m.TotalValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	l = len(m.LiquidFarmReserveAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.FarmingCoins) > 0 {
+		for iNdEx := len(m.FarmingCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FarmingCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	l = len(m.LFCoinDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.MinBidAmount.Size()
+		i -= size
+		if _, err := m.MinBidAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	l = m.MinFarmAmount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.MinBidAmount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.TotalFarmingAmount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MinFarmAmount.Size()
+		i -= size
+		if _, err := m.MinFarmAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.LFCoinDenom) > 0 {
+		i -= len(m.LFCoinDenom)
+		copy(dAtA[i:], m.LFCoinDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LFCoinDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.LiquidFarmReserveAddress) > 0 {
+		i -= len(m.LiquidFarmReserveAddress)
+		copy(dAtA[i:], m.LiquidFarmReserveAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LiquidFarmReserveAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PairId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PairId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ExchangeRateResponse) Size() (n int) {
-	if m == nil {
+func (m *VaultResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VaultResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VaultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastCompoundedAt != nil {
+		n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastCompoundedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastCompoundedAt):])
+		if err13 != nil {
+			return 0, err13
+		}
+		i -= n13
+		i = encodeVarintQuery(dAtA, i, uint64(n13))
+		i--
+		dAtA[i] = 0x42
+	}
+	{
+		size := m.TotalFarmingAmount.Size()
+		i -= size
+		if _, err := m.TotalFarmingAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	n14, err14 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.CompoundingInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.CompoundingInterval):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintQuery(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MaxSlippage.Size()
+		i -= size
+		if _, err := m.MaxSlippage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MinFarmAmount.Size()
+		i -= size
+		if _, err := m.MinFarmAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.VaultCoinDenom) > 0 {
+		i -= len(m.VaultCoinDenom)
+		copy(dAtA[i:], m.VaultCoinDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VaultCoinDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VaultReserveAddress) > 0 {
+		i -= len(m.VaultReserveAddress)
+		copy(dAtA[i:], m.VaultReserveAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VaultReserveAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryLiquidFarmsRequest) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.MintRate.Size()
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLiquidFarmsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LiquidFarms) > 0 {
+		for _, e := range m.LiquidFarms {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryLiquidFarmRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryLiquidFarmResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LiquidFarm.Size()
 	n += 1 + l + sovQuery(uint64(l))
-	l = m.BurnRate.Size()
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRewardsAuctionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRewardsAuctionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RewardAuctions) > 0 {
+		for _, e := range m.RewardAuctions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRewardsAuctionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovQuery(uint64(m.AuctionId))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryRewardsAuctionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RewardAuction.Size()
 	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryBidsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBidsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Bids) > 0 {
+		for _, e := range m.Bids {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryExchangeRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.PairId != 0 {
+		n += 1 + sovQuery(uint64(m.PairId))
+	}
+	return n
+}
+
+func (m *QueryExchangeRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPairLiquidFarmsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPairLiquidFarmsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PairLiquidFarms) > 0 {
+		for _, e := range m.PairLiquidFarms {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPairRewardsAuctionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PairId != 0 {
+		n += 1 + sovQuery(uint64(m.PairId))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPairRewardsAuctionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RewardAuctions) > 0 {
+		for _, e := range m.RewardAuctions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVaultsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryVaultsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Vaults) > 0 {
+		for _, e := range m.Vaults {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryVaultRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryVaultResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Vault.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *LiquidFarmResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.LiquidFarmReserveAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.LFCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.MinFarmAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MinBidAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalFarmingAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *ExchangeRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MintRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BurnRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *PairLiquidFarmResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PairId != 0 {
+		n += 1 + sovQuery(uint64(m.PairId))
+	}
+	l = len(m.LiquidFarmReserveAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.LFCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.MinFarmAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MinBidAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.FarmingCoins) > 0 {
+		for _, e := range m.FarmingCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.TotalValue.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *VaultResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.VaultReserveAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.VaultCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.MinFarmAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MaxSlippage.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.CompoundingInterval)
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalFarmingAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.LastCompoundedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastCompoundedAt)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryLiquidFarmsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidFarmsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidFarmsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidFarmsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidFarmsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidFarmsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidFarms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidFarms = append(m.LiquidFarms, LiquidFarmResponse{})
+			if err := m.LiquidFarms[len(m.LiquidFarms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidFarmRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidFarmRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidFarmRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidFarmResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidFarmResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidFarmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidFarm", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidFarm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardsAuctionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardsAuctionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardsAuctionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardsAuctionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardsAuctionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardsAuctionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardAuctions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardAuctions = append(m.RewardAuctions, RewardsAuction{})
+			if err := m.RewardAuctions[len(m.RewardAuctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryLiquidFarmsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRewardsAuctionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2324,17 +4402,17 @@ func (m *QueryLiquidFarmsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLiquidFarmsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRewardsAuctionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLiquidFarmsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRewardsAuctionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
 			}
-			var msglen int
+			m.AuctionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2344,28 +4422,30 @@ func (m *QueryLiquidFarmsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.AuctionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2387,7 +4467,7 @@ func (m *QueryLiquidFarmsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLiquidFarmsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRewardsAuctionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2410,15 +4490,15 @@ func (m *QueryLiquidFarmsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLiquidFarmsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRewardsAuctionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLiquidFarmsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRewardsAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidFarms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardAuction", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2445,8 +4525,7 @@ func (m *QueryLiquidFarmsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LiquidFarms = append(m.LiquidFarms, LiquidFarmResponse{})
-			if err := m.LiquidFarms[len(m.LiquidFarms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RewardAuction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2471,7 +4550,7 @@ func (m *QueryLiquidFarmsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLiquidFarmRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBidsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2494,10 +4573,10 @@ func (m *QueryLiquidFarmRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLiquidFarmRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBidsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLiquidFarmRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBidsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2519,6 +4598,42 @@ func (m *QueryLiquidFarmRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2540,7 +4655,7 @@ func (m *QueryLiquidFarmRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLiquidFarmResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBidsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2563,15 +4678,15 @@ func (m *QueryLiquidFarmResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLiquidFarmResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBidsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLiquidFarmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBidsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidFarm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bids", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2598,7 +4713,44 @@ func (m *QueryLiquidFarmResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LiquidFarm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Bids = append(m.Bids, Bid{})
+			if err := m.Bids[len(m.Bids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2623,7 +4775,7 @@ func (m *QueryLiquidFarmResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2646,12 +4798,31 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2673,7 +4844,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2696,15 +4867,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2731,7 +4902,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2756,7 +4928,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRewardsAuctionsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2779,10 +4951,10 @@ func (m *QueryRewardsAuctionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRewardsAuctionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryExchangeRateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRewardsAuctionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryExchangeRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2805,42 +4977,10 @@ func (m *QueryRewardsAuctionsRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Status = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
 			}
-			var msglen int
+			m.PairId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2850,28 +4990,11 @@ func (m *QueryRewardsAuctionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PairId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2893,7 +5016,7 @@ func (m *QueryRewardsAuctionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRewardsAuctionsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2916,15 +5039,15 @@ func (m *QueryRewardsAuctionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRewardsAuctionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryExchangeRateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRewardsAuctionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryExchangeRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardAuctions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2951,47 +5074,60 @@ func (m *QueryRewardsAuctionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardAuctions = append(m.RewardAuctions, RewardsAuction{})
-			if err := m.RewardAuctions[len(m.RewardAuctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPairLiquidFarmsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPairLiquidFarmsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPairLiquidFarmsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3013,7 +5149,7 @@ func (m *QueryRewardsAuctionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRewardsAuctionRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPairLiquidFarmsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3036,17 +5172,17 @@ func (m *QueryRewardsAuctionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRewardsAuctionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPairLiquidFarmsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRewardsAuctionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPairLiquidFarmsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairLiquidFarms", wireType)
 			}
-			m.AuctionId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3056,30 +5192,26 @@ func (m *QueryRewardsAuctionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AuctionId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairLiquidFarms = append(m.PairLiquidFarms, PairLiquidFarmResponse{})
+			if err := m.PairLiquidFarms[len(m.PairLiquidFarms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3101,7 +5233,7 @@ func (m *QueryRewardsAuctionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRewardsAuctionResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPairRewardsAuctionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3124,15 +5256,66 @@ func (m *QueryRewardsAuctionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRewardsAuctionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPairRewardsAuctionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRewardsAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPairRewardsAuctionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+			}
+			m.PairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PairId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardAuction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3159,7 +5342,10 @@ func (m *QueryRewardsAuctionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RewardAuction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3184,7 +5370,7 @@ func (m *QueryRewardsAuctionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBidsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPairRewardsAuctionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3207,17 +5393,17 @@ func (m *QueryBidsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBidsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPairRewardsAuctionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBidsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPairRewardsAuctionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardAuctions", wireType)
 			}
-			m.PoolId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3227,11 +5413,26 @@ func (m *QueryBidsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardAuctions = append(m.RewardAuctions, RewardsAuction{})
+			if err := m.RewardAuctions[len(m.RewardAuctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -3262,7 +5463,7 @@ func (m *QueryBidsRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -3289,7 +5490,7 @@ func (m *QueryBidsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBidsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVaultsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3312,49 +5513,65 @@ func (m *QueryBidsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBidsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVaultsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBidsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVaultsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bids", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVaultsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bids = append(m.Bids, Bid{})
-			if err := m.Bids[len(m.Bids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVaultsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVaultsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vaults", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3381,10 +5598,8 @@ func (m *QueryBidsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Vaults = append(m.Vaults, VaultResponse{})
+			if err := m.Vaults[len(m.Vaults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3409,7 +5624,7 @@ func (m *QueryBidsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRewardsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVaultRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3432,10 +5647,10 @@ func (m *QueryRewardsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRewardsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVaultRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVaultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3478,7 +5693,7 @@ func (m *QueryRewardsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVaultResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3501,15 +5716,15 @@ func (m *QueryRewardsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVaultResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVaultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vault", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3536,8 +5751,7 @@ func (m *QueryRewardsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rewards = append(m.Rewards, types.Coin{})
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Vault.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3562,7 +5776,7 @@ func (m *QueryRewardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
+func (m *LiquidFarmResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3585,10 +5799,10 @@ func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExchangeRateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: LiquidFarmResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExchangeRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LiquidFarmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3610,6 +5824,172 @@ func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidFarmReserveAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidFarmReserveAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LFCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LFCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFarmAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinFarmAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBidAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBidAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFarmingAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalFarmingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3631,7 +6011,7 @@ func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
+func (m *ExchangeRateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3644,27 +6024,61 @@ func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExchangeRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExchangeRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExchangeRateResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExchangeRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnRate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3674,22 +6088,23 @@ func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BurnRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3714,7 +6129,7 @@ func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidFarmResponse) Unmarshal(dAtA []byte) error {
+func (m *PairLiquidFarmResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3737,17 +6152,17 @@ func (m *LiquidFarmResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidFarmResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PairLiquidFarmResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidFarmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PairLiquidFarmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
 			}
-			m.PoolId = 0
+			m.PairId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3757,7 +6172,7 @@ func (m *LiquidFarmResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				m.PairId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3896,7 +6311,41 @@ func (m *LiquidFarmResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalFarmingAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FarmingCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FarmingCoins = append(m.FarmingCoins, types.Coin{})
+			if err := m.FarmingCoins[len(m.FarmingCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalValue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3924,7 +6373,7 @@ func (m *LiquidFarmResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalFarmingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3949,7 +6398,7 @@ func (m *LiquidFarmResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExchangeRateResponse) Unmarshal(dAtA []byte) error {
+func (m *VaultResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3972,15 +6421,34 @@ func (m *ExchangeRateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExchangeRateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: VaultResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExchangeRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VaultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultReserveAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4008,13 +6476,77 @@ func (m *ExchangeRateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MintRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.VaultReserveAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFarmAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinFarmAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlippage", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4042,7 +6574,110 @@ func (m *ExchangeRateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BurnRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxSlippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompoundingInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.CompoundingInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFarmingAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalFarmingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCompoundedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastCompoundedAt == nil {
+				m.LastCompoundedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastCompoundedAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -416,24 +416,25 @@ func (m *MsgRefundBidResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRefundBidResponse proto.InternalMessageInfo
 
-// MsgAdvanceAuction defines a message to advance rewards auction by one.
-type MsgAdvanceAuction struct {
-	// requester defines the bech32-encoded address of the requester
-	Requester string `protobuf:"bytes,1,opt,name=requester,proto3" json:"requester,omitempty"`
+// MsgPairLiquidFarm defines a SDK message for farming pool coin for a pair liquid farm.
+type MsgPairLiquidFarm struct {
+	PairId      uint64     `protobuf:"varint,1,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	Farmer      string     `protobuf:"bytes,2,opt,name=farmer,proto3" json:"farmer,omitempty"`
+	FarmingCoin types.Coin `protobuf:"bytes,3,opt,name=farming_coin,json=farmingCoin,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coin" json:"farming_coin"`
 }
 
-func (m *MsgAdvanceAuction) Reset()         { *m = MsgAdvanceAuction{} }
-func (m *MsgAdvanceAuction) String() string { return proto.CompactTextString(m) }
-func (*MsgAdvanceAuction) ProtoMessage()    {}
-func (*MsgAdvanceAuction) Descriptor() ([]byte, []int) {
+func (m *MsgPairLiquidFarm) Reset()         { *m = MsgPairLiquidFarm{} }
+func (m *MsgPairLiquidFarm) String() string { return proto.CompactTextString(m) }
+func (*MsgPairLiquidFarm) ProtoMessage()    {}
+func (*MsgPairLiquidFarm) Descriptor() ([]byte, []int) {
 	return fileDescriptor_db3f4cd082b3b711, []int{10}
 }
-func (m *MsgAdvanceAuction) XXX_Unmarshal(b []byte) error {
+func (m *MsgPairLiquidFarm) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAdvanceAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgPairLiquidFarm) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAdvanceAuction.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgPairLiquidFarm.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -443,34 +444,34 @@ func (m *MsgAdvanceAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *MsgAdvanceAuction) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAdvanceAuction.Merge(m, src)
+func (m *MsgPairLiquidFarm) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPairLiquidFarm.Merge(m, src)
 }
-func (m *MsgAdvanceAuction) XXX_Size() int {
+func (m *MsgPairLiquidFarm) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAdvanceAuction) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAdvanceAuction.DiscardUnknown(m)
+func (m *MsgPairLiquidFarm) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPairLiquidFarm.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAdvanceAuction proto.InternalMessageInfo
+var xxx_messageInfo_MsgPairLiquidFarm proto.InternalMessageInfo
 
-// MsgAdvanceAuctionResponse defines the Msg/AdvanceAuction response type.
-type MsgAdvanceAuctionResponse struct {
+// MsgPairLiquidFarmResponse defines the MsgPairLiquidFarmResponse response type.
+type MsgPairLiquidFarmResponse struct {
 }
 
-func (m *MsgAdvanceAuctionResponse) Reset()         { *m = MsgAdvanceAuctionResponse{} }
-func (m *MsgAdvanceAuctionResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgAdvanceAuctionResponse) ProtoMessage()    {}
-func (*MsgAdvanceAuctionResponse) Descriptor() ([]byte, []int) {
+func (m *MsgPairLiquidFarmResponse) Reset()         { *m = MsgPairLiquidFarmResponse{} }
+func (m *MsgPairLiquidFarmResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPairLiquidFarmResponse) ProtoMessage()    {}
+func (*MsgPairLiquidFarmResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_db3f4cd082b3b711, []int{11}
 }
-func (m *MsgAdvanceAuctionResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgPairLiquidFarmResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAdvanceAuctionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgPairLiquidFarmResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAdvanceAuctionResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgPairLiquidFarmResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -480,927 +481,3325 @@ func (m *MsgAdvanceAuctionResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgAdvanceAuctionResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAdvanceAuctionResponse.Merge(m, src)
+func (m *MsgPairLiquidFarmResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPairLiquidFarmResponse.Merge(m, src)
 }
-func (m *MsgAdvanceAuctionResponse) XXX_Size() int {
+func (m *MsgPairLiquidFarmResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAdvanceAuctionResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAdvanceAuctionResponse.DiscardUnknown(m)
+func (m *MsgPairLiquidFarmResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPairLiquidFarmResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAdvanceAuctionResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgPairLiquidFarmResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgLiquidFarm)(nil), "squad.liquidfarming.v1beta1.MsgLiquidFarm")
-	proto.RegisterType((*MsgLiquidFarmResponse)(nil), "squad.liquidfarming.v1beta1.MsgLiquidFarmResponse")
-	proto.RegisterType((*MsgLiquidUnfarm)(nil), "squad.liquidfarming.v1beta1.MsgLiquidUnfarm")
-	proto.RegisterType((*MsgLiquidUnfarmResponse)(nil), "squad.liquidfarming.v1beta1.MsgLiquidUnfarmResponse")
-	proto.RegisterType((*MsgLiquidUnfarmAndWithdraw)(nil), "squad.liquidfarming.v1beta1.MsgLiquidUnfarmAndWithdraw")
-	proto.RegisterType((*MsgLiquidUnfarmAndWithdrawResponse)(nil), "squad.liquidfarming.v1beta1.MsgLiquidUnfarmAndWithdrawResponse")
-	proto.RegisterType((*MsgPlaceBid)(nil), "squad.liquidfarming.v1beta1.MsgPlaceBid")
-	proto.RegisterType((*MsgPlaceBidResponse)(nil), "squad.liquidfarming.v1beta1.MsgPlaceBidResponse")
-	proto.RegisterType((*MsgRefundBid)(nil), "squad.liquidfarming.v1beta1.MsgRefundBid")
-	proto.RegisterType((*MsgRefundBidResponse)(nil), "squad.liquidfarming.v1beta1.MsgRefundBidResponse")
-	proto.RegisterType((*MsgAdvanceAuction)(nil), "squad.liquidfarming.v1beta1.MsgAdvanceAuction")
-	proto.RegisterType((*MsgAdvanceAuctionResponse)(nil), "squad.liquidfarming.v1beta1.MsgAdvanceAuctionResponse")
+// MsgPairLiquidUnfarm defines a SDK message for unfarming pair LFCoin.
+type MsgPairLiquidUnfarm struct {
+	PairId        uint64     `protobuf:"varint,1,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	Farmer        string     `protobuf:"bytes,2,opt,name=farmer,proto3" json:"farmer,omitempty"`
+	UnfarmingCoin types.Coin `protobuf:"bytes,3,opt,name=unfarming_coin,json=unfarmingCoin,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coin" json:"unfarming_coin"`
 }
 
-func init() {
-	proto.RegisterFile("squad/liquidfarming/v1beta1/tx.proto", fileDescriptor_db3f4cd082b3b711)
+func (m *MsgPairLiquidUnfarm) Reset()         { *m = MsgPairLiquidUnfarm{} }
+func (m *MsgPairLiquidUnfarm) String() string { return proto.CompactTextString(m) }
+func (*MsgPairLiquidUnfarm) ProtoMessage()    {}
+func (*MsgPairLiquidUnfarm) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db3f4cd082b3b711, []int{12}
 }
-
-var fileDescriptor_db3f4cd082b3b711 = []byte{
-	// 635 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x96, 0x4f, 0x6b, 0xd4, 0x40,
-	0x18, 0xc6, 0x77, 0xda, 0x5a, 0xcd, 0xdb, 0x3f, 0x62, 0x6c, 0xbb, 0x6d, 0xaa, 0x69, 0x59, 0x0a,
-	0xae, 0xc5, 0x26, 0xb6, 0x4a, 0x0b, 0x5e, 0xa4, 0x15, 0x84, 0x82, 0x0b, 0x25, 0x20, 0x82, 0x97,
-	0x32, 0xc9, 0xcc, 0xa6, 0x83, 0xbb, 0x99, 0xdd, 0x4c, 0x52, 0xdb, 0x6f, 0xe0, 0x51, 0xf0, 0x0b,
-	0x78, 0xf6, 0xee, 0xc9, 0xbb, 0x14, 0x4f, 0xc5, 0x93, 0x27, 0x95, 0xee, 0x17, 0x91, 0x24, 0xb3,
-	0xb3, 0xd9, 0x2d, 0x75, 0x77, 0x8b, 0x3d, 0x78, 0xda, 0x4c, 0xe6, 0x79, 0xdf, 0xe7, 0xf9, 0xc1,
-	0x3b, 0x93, 0x85, 0x15, 0xd1, 0x8c, 0x31, 0xb1, 0x6b, 0xac, 0x19, 0x33, 0x52, 0xc5, 0x61, 0x9d,
-	0x05, 0xbe, 0x7d, 0xb8, 0xee, 0xd2, 0x08, 0xaf, 0xdb, 0xd1, 0x91, 0xd5, 0x08, 0x79, 0xc4, 0xf5,
-	0xc5, 0x54, 0x65, 0x75, 0xa9, 0x2c, 0xa9, 0x32, 0x66, 0x7c, 0xee, 0xf3, 0x54, 0x67, 0x27, 0x4f,
-	0x59, 0x89, 0x61, 0x7a, 0x5c, 0xd4, 0xb9, 0xb0, 0x5d, 0x2c, 0xa8, 0x6a, 0xe8, 0x71, 0x16, 0xc8,
-	0xfd, 0xd5, 0xfc, 0x7e, 0x33, 0xa6, 0xe1, 0xb1, 0x52, 0x35, 0xb0, 0xcf, 0x02, 0x1c, 0x31, 0x2e,
-	0xb5, 0xa5, 0xcf, 0x08, 0xa6, 0x2a, 0xc2, 0x7f, 0x91, 0xda, 0x3f, 0xc7, 0x61, 0x5d, 0x2f, 0xc2,
-	0xf5, 0x06, 0xe7, 0xb5, 0x7d, 0x46, 0xe6, 0xd1, 0x32, 0x2a, 0x8f, 0x39, 0xe3, 0xc9, 0x72, 0x97,
-	0xe8, 0x73, 0x30, 0x9e, 0xe4, 0xa3, 0xe1, 0xfc, 0xc8, 0x32, 0x2a, 0x6b, 0x8e, 0x5c, 0xe9, 0x75,
-	0x98, 0x94, 0xb9, 0xf7, 0x93, 0x10, 0xf3, 0xa3, 0xcb, 0xa8, 0x3c, 0xb1, 0xb1, 0x60, 0x65, 0x29,
-	0xac, 0x24, 0x45, 0x1b, 0xc8, 0x7a, 0xc6, 0x59, 0xb0, 0x63, 0x9f, 0xfc, 0x5c, 0x2a, 0x7c, 0xfa,
-	0xb5, 0x74, 0xcf, 0x67, 0xd1, 0x41, 0xec, 0x5a, 0x1e, 0xaf, 0xdb, 0x32, 0x72, 0xf6, 0xb3, 0x26,
-	0xc8, 0x1b, 0x3b, 0x3a, 0x6e, 0x50, 0x91, 0x16, 0x38, 0x13, 0xb2, 0x7f, 0xb2, 0x78, 0x32, 0xf6,
-	0xee, 0xe3, 0x52, 0xa1, 0x54, 0x84, 0xd9, 0xae, 0xd8, 0x0e, 0x15, 0x0d, 0x1e, 0x08, 0x5a, 0xfa,
-	0x82, 0xe0, 0xa6, 0xda, 0x79, 0x19, 0x54, 0x2f, 0x85, 0xd4, 0x84, 0xe9, 0x38, 0xb8, 0x62, 0xa8,
-	0x29, 0xe5, 0x90, 0xc3, 0x5a, 0x80, 0x62, 0x4f, 0x78, 0x05, 0xf6, 0x15, 0x81, 0xd1, 0xb3, 0xb7,
-	0x1d, 0x90, 0x57, 0x2c, 0x3a, 0x20, 0x21, 0x7e, 0xfb, 0x1f, 0x31, 0xae, 0x40, 0xe9, 0x62, 0x0e,
-	0x85, 0xfb, 0x1d, 0xc1, 0x44, 0x45, 0xf8, 0x7b, 0x35, 0xec, 0xd1, 0x1d, 0x46, 0xf4, 0xbb, 0x00,
-	0x38, 0xf6, 0x92, 0xc9, 0xed, 0x20, 0x6a, 0xf2, 0xcd, 0x2e, 0xc9, 0xe3, 0x8f, 0xf4, 0xe2, 0xbb,
-	0x8c, 0x10, 0x1a, 0xa6, 0x78, 0x9a, 0x23, 0x57, 0xc9, 0xd4, 0x26, 0x4f, 0x0a, 0x7e, 0xec, 0xdf,
-	0x4f, 0xad, 0xec, 0x9f, 0x43, 0x9f, 0x85, 0xdb, 0x39, 0x26, 0xc5, 0x4a, 0x60, 0xb2, 0x22, 0x7c,
-	0x87, 0x56, 0xe3, 0x80, 0x5c, 0x01, 0xab, 0x34, 0x9f, 0x83, 0x99, 0xbc, 0x8b, 0x72, 0xdf, 0x82,
-	0x5b, 0x15, 0xe1, 0x6f, 0x93, 0x43, 0x1c, 0x78, 0x74, 0x3b, 0x73, 0xd1, 0xef, 0x80, 0x16, 0xd2,
-	0x66, 0x4c, 0x45, 0x44, 0xc3, 0x34, 0x81, 0xe6, 0x74, 0x5e, 0xc8, 0x86, 0x8b, 0xb0, 0x70, 0xae,
-	0xb0, 0xdd, 0x75, 0xe3, 0xdb, 0x35, 0x18, 0xad, 0x08, 0x5f, 0xaf, 0x01, 0xe4, 0x2e, 0x97, 0x55,
-	0xeb, 0x2f, 0xd7, 0x9d, 0xd5, 0x75, 0xa2, 0x8d, 0x8d, 0xc1, 0xb5, 0x6d, 0x57, 0x3d, 0x84, 0xc9,
-	0xae, 0x93, 0xff, 0x60, 0xb0, 0x1e, 0x99, 0xda, 0x78, 0x3c, 0x8c, 0x5a, 0x79, 0x7e, 0x40, 0x50,
-	0xbc, 0xe8, 0x54, 0x6e, 0x0d, 0xd3, 0x31, 0x57, 0x68, 0x3c, 0xbd, 0x64, 0xa1, 0x4a, 0x55, 0x85,
-	0x1b, 0xea, 0xec, 0x94, 0xfb, 0x35, 0x6b, 0x2b, 0x8d, 0x87, 0x83, 0x2a, 0x95, 0x0f, 0x03, 0xad,
-	0x33, 0xb8, 0xf7, 0xfb, 0x95, 0x2b, 0xa9, 0xb1, 0x3e, 0xb0, 0x54, 0x59, 0x1d, 0xc1, 0x74, 0xcf,
-	0x94, 0x5a, 0xfd, 0x9a, 0x74, 0xeb, 0x8d, 0xcd, 0xe1, 0xf4, 0x6d, 0xe7, 0x9d, 0xbd, 0x93, 0x33,
-	0x13, 0x9d, 0x9e, 0x99, 0xe8, 0xf7, 0x99, 0x89, 0xde, 0xb7, 0xcc, 0xc2, 0x69, 0xcb, 0x2c, 0xfc,
-	0x68, 0x99, 0x85, 0xd7, 0x9b, 0xe7, 0x6e, 0x83, 0xc4, 0x60, 0xad, 0x86, 0x5d, 0x61, 0x67, 0xdf,
-	0xff, 0xa3, 0x9e, 0x7f, 0x00, 0xe9, 0x0d, 0xe1, 0x8e, 0xa7, 0x9f, 0xdf, 0x47, 0x7f, 0x02, 0x00,
-	0x00, 0xff, 0xff, 0xaa, 0xc1, 0x45, 0x7f, 0x25, 0x08, 0x00, 0x00,
+func (m *MsgPairLiquidUnfarm) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPairLiquidUnfarm) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPairLiquidUnfarm.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPairLiquidUnfarm) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPairLiquidUnfarm.Merge(m, src)
+}
+func (m *MsgPairLiquidUnfarm) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPairLiquidUnfarm) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPairLiquidUnfarm.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgPairLiquidUnfarm proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// LiquidFarm defines a method for farming pool coin for a liquid farm
-	LiquidFarm(ctx context.Context, in *MsgLiquidFarm, opts ...grpc.CallOption) (*MsgLiquidFarmResponse, error)
-	// LiquidUnfarm defines a method for unfarming amount of LFCoin
-	LiquidUnfarm(ctx context.Context, in *MsgLiquidUnfarm, opts ...grpc.CallOption) (*MsgLiquidUnfarmResponse, error)
-	// LiquidUnfarmAndWithdraw defines a method for unfarming amount of LFCoin and withdraw pool coin from the pool
-	LiquidUnfarmAndWithdraw(ctx context.Context, in *MsgLiquidUnfarmAndWithdraw, opts ...grpc.CallOption) (*MsgLiquidUnfarmAndWithdrawResponse, error)
-	// PlaceBid defines a method for placing a bid for a rewards auction
-	PlaceBid(ctx context.Context, in *MsgPlaceBid, opts ...grpc.CallOption) (*MsgPlaceBidResponse, error)
-	// RefundBid defines a method for refunding the bid that is not winning for the auction
-	RefundBid(ctx context.Context, in *MsgRefundBid, opts ...grpc.CallOption) (*MsgRefundBidResponse, error)
-	// AdvanceAuction defines a method for advancing rewards auction by one.
-	// This Msg is defined just for testing purpose and it shouldn't be used in production.
-	AdvanceAuction(ctx context.Context, in *MsgAdvanceAuction, opts ...grpc.CallOption) (*MsgAdvanceAuctionResponse, error)
+// MsgPairLiquidUnfarmResponse defines the MsgPairLiquidUnfarmResponse response type.
+type MsgPairLiquidUnfarmResponse struct {
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgPairLiquidUnfarmResponse) Reset()         { *m = MsgPairLiquidUnfarmResponse{} }
+func (m *MsgPairLiquidUnfarmResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPairLiquidUnfarmResponse) ProtoMessage()    {}
+func (*MsgPairLiquidUnfarmResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db3f4cd082b3b711, []int{13}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgPairLiquidUnfarmResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) LiquidFarm(ctx context.Context, in *MsgLiquidFarm, opts ...grpc.CallOption) (*MsgLiquidFarmResponse, error) {
-	out := new(MsgLiquidFarmResponse)
-	err := c.cc.Invoke(ctx, "/squad.liquidfarming.v1beta1.Msg/LiquidFarm", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgPairLiquidUnfarmResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPairLiquidUnfarmResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgPairLiquidUnfarmResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPairLiquidUnfarmResponse.Merge(m, src)
+}
+func (m *MsgPairLiquidUnfarmResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPairLiquidUnfarmResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPairLiquidUnfarmResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) LiquidUnfarm(ctx context.Context, in *MsgLiquidUnfarm, opts ...grpc.CallOption) (*MsgLiquidUnfarmResponse, error) {
-	out := new(MsgLiquidUnfarmResponse)
-	err := c.cc.Invoke(ctx, "/squad.liquidfarming.v1beta1.Msg/LiquidUnfarm", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgPairLiquidUnfarmResponse proto.InternalMessageInfo
+
+// MsgPlacePairBid defines a SDK message for placing a bid for a rewards auction of a pair liquid farm.
+type MsgPlacePairBid struct {
+	AuctionId   uint64     `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	PairId      uint64     `protobuf:"varint,2,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	Bidder      string     `protobuf:"bytes,3,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	BiddingCoin types.Coin `protobuf:"bytes,4,opt,name=bidding_coin,json=biddingCoin,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coin" json:"bidding_coin"`
 }
 
-func (c *msgClient) LiquidUnfarmAndWithdraw(ctx context.Context, in *MsgLiquidUnfarmAndWithdraw, opts ...grpc.CallOption) (*MsgLiquidUnfarmAndWithdrawResponse, error) {
-	out := new(MsgLiquidUnfarmAndWithdrawResponse)
-	err := c.cc.Invoke(ctx, "/squad.liquidfarming.v1beta1.Msg/LiquidUnfarmAndWithdraw", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgPlacePairBid) Reset()         { *m = MsgPlacePairBid{} }
+func (m *MsgPlacePairBid) String() string { return proto.CompactTextString(m) }
+func (*MsgPlacePairBid) ProtoMessage()    {}
+func (*MsgPlacePairBid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db3f4cd082b3b711, []int{14}
+}
+func (m *MsgPlacePairBid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPlacePairBid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPlacePairBid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgPlacePairBid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPlacePairBid.Merge(m, src)
+}
+func (m *MsgPlacePairBid) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPlacePairBid) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPlacePairBid.DiscardUnknown(m)
 }
 
-func (c *msgClient) PlaceBid(ctx context.Context, in *MsgPlaceBid, opts ...grpc.CallOption) (*MsgPlaceBidResponse, error) {
-	out := new(MsgPlaceBidResponse)
-	err := c.cc.Invoke(ctx, "/squad.liquidfarming.v1beta1.Msg/PlaceBid", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgPlacePairBid proto.InternalMessageInfo
+
+// MsgPlacePairBidResponse defines the MsgPlacePairBidResponse response type.
+type MsgPlacePairBidResponse struct {
 }
 
-func (c *msgClient) RefundBid(ctx context.Context, in *MsgRefundBid, opts ...grpc.CallOption) (*MsgRefundBidResponse, error) {
-	out := new(MsgRefundBidResponse)
-	err := c.cc.Invoke(ctx, "/squad.liquidfarming.v1beta1.Msg/RefundBid", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgPlacePairBidResponse) Reset()         { *m = MsgPlacePairBidResponse{} }
+func (m *MsgPlacePairBidResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPlacePairBidResponse) ProtoMessage()    {}
+func (*MsgPlacePairBidResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db3f4cd082b3b711, []int{15}
+}
+func (m *MsgPlacePairBidResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPlacePairBidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPlacePairBidResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgPlacePairBidResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPlacePairBidResponse.Merge(m, src)
+}
+func (m *MsgPlacePairBidResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPlacePairBidResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPlacePairBidResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) AdvanceAuction(ctx context.Context, in *MsgAdvanceAuction, opts ...grpc.CallOption) (*MsgAdvanceAuctionResponse, error) {
-	out := new(MsgAdvanceAuctionResponse)
-	err := c.cc.Invoke(ctx, "/squad.liquidfarming.v1beta1.Msg/AdvanceAuction", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgPlacePairBidResponse proto.InternalMessageInfo
+
+// MsgRefundPairBid defines a SDK message for refunding the bid that is not winning for the pair rewards auction.
+type MsgRefundPairBid struct {
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	PairId    uint64 `protobuf:"varint,2,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	Bidder    string `protobuf:"bytes,3,opt,name=bidder,proto3" json:"bidder,omitempty"`
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// LiquidFarm defines a method for farming pool coin for a liquid farm
-	LiquidFarm(context.Context, *MsgLiquidFarm) (*MsgLiquidFarmResponse, error)
-	// LiquidUnfarm defines a method for unfarming amount of LFCoin
-	LiquidUnfarm(context.Context, *MsgLiquidUnfarm) (*MsgLiquidUnfarmResponse, error)
-	// LiquidUnfarmAndWithdraw defines a method for unfarming amount of LFCoin and withdraw pool coin from the pool
-	LiquidUnfarmAndWithdraw(context.Context, *MsgLiquidUnfarmAndWithdraw) (*MsgLiquidUnfarmAndWithdrawResponse, error)
-	// PlaceBid defines a method for placing a bid for a rewards auction
-	PlaceBid(context.Context, *MsgPlaceBid) (*MsgPlaceBidResponse, error)
-	// RefundBid defines a method for refunding the bid that is not winning for the auction
-	RefundBid(context.Context, *MsgRefundBid) (*MsgRefundBidResponse, error)
-	// AdvanceAuction defines a method for advancing rewards auction by one.
-	// This Msg is defined just for testing purpose and it shouldn't be used in production.
-	AdvanceAuction(context.Context, *MsgAdvanceAuction) (*MsgAdvanceAuctionResponse, error)
+func (m *MsgRefundPairBid) Reset()         { *m = MsgRefundPairBid{} }
+func (m *MsgRefundPairBid) String() string { return proto.CompactTextString(m) }
+func (*MsgRefundPairBid) ProtoMessage()    {}
+func (*MsgRefundPairBid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db3f4cd082b3b711, []int{16}
+}
+func (m *MsgRefundPairBid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRefundPairBid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRefundPairBid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRefundPairBid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefundPairBid.Merge(m, src)
+}
+func (m *MsgRefundPairBid) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRefundPairBid) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefundPairBid.DiscardUnknown(m)
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+var xxx_messageInfo_MsgRefundPairBid proto.InternalMessageInfo
+
+// MsgRefundPairBidResponse defines the MsgRefundPairBidResponse response type.
+type MsgRefundPairBidResponse struct {
 }
 
-func (*UnimplementedMsgServer) LiquidFarm(ctx context.Context, req *MsgLiquidFarm) (*MsgLiquidFarmResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LiquidFarm not implemented")
+func (m *MsgRefundPairBidResponse) Reset()         { *m = MsgRefundPairBidResponse{} }
+func (m *MsgRefundPairBidResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRefundPairBidResponse) ProtoMessage()    {}
+func (*MsgRefundPairBidResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db3f4cd082b3b711, []int{17}
 }
-func (*UnimplementedMsgServer) LiquidUnfarm(ctx context.Context, req *MsgLiquidUnfarm) (*MsgLiquidUnfarmResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LiquidUnfarm not implemented")
+func (m *MsgRefundPairBidResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) LiquidUnfarmAndWithdraw(ctx context.Context, req *MsgLiquidUnfarmAndWithdraw) (*MsgLiquidUnfarmAndWithdrawResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LiquidUnfarmAndWithdraw not implemented")
+func (m *MsgRefundPairBidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRefundPairBidResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) PlaceBid(ctx context.Context, req *MsgPlaceBid) (*MsgPlaceBidResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PlaceBid not implemented")
+func (m *MsgRefundPairBidResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefundPairBidResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) RefundBid(ctx context.Context, req *MsgRefundBid) (*MsgRefundBidResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RefundBid not implemented")
+func (m *MsgRefundPairBidResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) AdvanceAuction(ctx context.Context, req *MsgAdvanceAuction) (*MsgAdvanceAuctionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AdvanceAuction not implemented")
+func (m *MsgRefundPairBidResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefundPairBidResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgRefundPairBidResponse proto.InternalMessageInfo
+
+// MsgVaultFarm defines a SDK message for farming pool coin for a vault.
+type MsgVaultFarm struct {
+	PoolId      uint64     `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	Farmer      string     `protobuf:"bytes,2,opt,name=farmer,proto3" json:"farmer,omitempty"`
+	FarmingCoin types.Coin `protobuf:"bytes,3,opt,name=farming_coin,json=farmingCoin,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coin" json:"farming_coin"`
 }
 
-func _Msg_LiquidFarm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgLiquidFarm)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).LiquidFarm(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/squad.liquidfarming.v1beta1.Msg/LiquidFarm",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).LiquidFarm(ctx, req.(*MsgLiquidFarm))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgVaultFarm) Reset()         { *m = MsgVaultFarm{} }
+func (m *MsgVaultFarm) String() string { return proto.CompactTextString(m) }
+func (*MsgVaultFarm) ProtoMessage()    {}
+func (*MsgVaultFarm) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db3f4cd082b3b711, []int{18}
 }
-
-func _Msg_LiquidUnfarm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgLiquidUnfarm)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).LiquidUnfarm(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/squad.liquidfarming.v1beta1.Msg/LiquidUnfarm",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).LiquidUnfarm(ctx, req.(*MsgLiquidUnfarm))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgVaultFarm) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Msg_LiquidUnfarmAndWithdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgLiquidUnfarmAndWithdraw)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).LiquidUnfarmAndWithdraw(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/squad.liquidfarming.v1beta1.Msg/LiquidUnfarmAndWithdraw",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).LiquidUnfarmAndWithdraw(ctx, req.(*MsgLiquidUnfarmAndWithdraw))
+func (m *MsgVaultFarm) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVaultFarm.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-func _Msg_PlaceBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgPlaceBid)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).PlaceBid(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/squad.liquidfarming.v1beta1.Msg/PlaceBid",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).PlaceBid(ctx, req.(*MsgPlaceBid))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgVaultFarm) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVaultFarm.Merge(m, src)
 }
-
-func _Msg_RefundBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRefundBid)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RefundBid(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/squad.liquidfarming.v1beta1.Msg/RefundBid",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RefundBid(ctx, req.(*MsgRefundBid))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgVaultFarm) XXX_Size() int {
+	return m.Size()
 }
-
-func _Msg_AdvanceAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAdvanceAuction)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AdvanceAuction(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/squad.liquidfarming.v1beta1.Msg/AdvanceAuction",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AdvanceAuction(ctx, req.(*MsgAdvanceAuction))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgVaultFarm) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVaultFarm.DiscardUnknown(m)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "squad.liquidfarming.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "LiquidFarm",
-			Handler:    _Msg_LiquidFarm_Handler,
-		},
-		{
-			MethodName: "LiquidUnfarm",
-			Handler:    _Msg_LiquidUnfarm_Handler,
-		},
-		{
-			MethodName: "LiquidUnfarmAndWithdraw",
-			Handler:    _Msg_LiquidUnfarmAndWithdraw_Handler,
-		},
-		{
-			MethodName: "PlaceBid",
-			Handler:    _Msg_PlaceBid_Handler,
-		},
-		{
-			MethodName: "RefundBid",
-			Handler:    _Msg_RefundBid_Handler,
-		},
-		{
-			MethodName: "AdvanceAuction",
-			Handler:    _Msg_AdvanceAuction_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "squad/liquidfarming/v1beta1/tx.proto",
-}
+var xxx_messageInfo_MsgVaultFarm proto.InternalMessageInfo
 
-func (m *MsgLiquidFarm) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// MsgVaultFarmResponse defines the MsgVaultFarmResponse response type.
+type MsgVaultFarmResponse struct {
 }
 
-func (m *MsgLiquidFarm) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgVaultFarmResponse) Reset()         { *m = MsgVaultFarmResponse{} }
+func (m *MsgVaultFarmResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVaultFarmResponse) ProtoMessage()    {}
+func (*MsgVaultFarmResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db3f4cd082b3b711, []int{19}
 }
-
-func (m *MsgLiquidFarm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.FarmingCoin.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgVaultFarmResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVaultFarmResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVaultFarmResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Farmer) > 0 {
-		i -= len(m.Farmer)
-		copy(dAtA[i:], m.Farmer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Farmer)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgLiquidFarmResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgVaultFarmResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVaultFarmResponse.Merge(m, src)
 }
-
-func (m *MsgLiquidFarmResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgVaultFarmResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVaultFarmResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVaultFarmResponse.DiscardUnknown(m)
 }
 
-func (m *MsgLiquidFarmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgVaultFarmResponse proto.InternalMessageInfo
+
+// MsgVaultUnfarm defines a SDK message for unfarming vault coin.
+type MsgVaultUnfarm struct {
+	PoolId        uint64     `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	Farmer        string     `protobuf:"bytes,2,opt,name=farmer,proto3" json:"farmer,omitempty"`
+	UnfarmingCoin types.Coin `protobuf:"bytes,3,opt,name=unfarming_coin,json=unfarmingCoin,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coin" json:"unfarming_coin"`
 }
 
-func (m *MsgLiquidUnfarm) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgVaultUnfarm) Reset()         { *m = MsgVaultUnfarm{} }
+func (m *MsgVaultUnfarm) String() string { return proto.CompactTextString(m) }
+func (*MsgVaultUnfarm) ProtoMessage()    {}
+func (*MsgVaultUnfarm) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db3f4cd082b3b711, []int{20}
+}
+func (m *MsgVaultUnfarm) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVaultUnfarm) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVaultUnfarm.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgVaultUnfarm) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVaultUnfarm.Merge(m, src)
+}
+func (m *MsgVaultUnfarm) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVaultUnfarm) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVaultUnfarm.DiscardUnknown(m)
 }
 
-func (m *MsgLiquidUnfarm) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgVaultUnfarm proto.InternalMessageInfo
+
+// MsgVaultUnfarmResponse defines the MsgVaultUnfarmResponse response type.
+type MsgVaultUnfarmResponse struct {
 }
 
-func (m *MsgLiquidUnfarm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.UnfarmingCoin.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgVaultUnfarmResponse) Reset()         { *m = MsgVaultUnfarmResponse{} }
+func (m *MsgVaultUnfarmResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVaultUnfarmResponse) ProtoMessage()    {}
+func (*MsgVaultUnfarmResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db3f4cd082b3b711, []int{21}
+}
+func (m *MsgVaultUnfarmResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVaultUnfarmResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVaultUnfarmResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Farmer) > 0 {
-		i -= len(m.Farmer)
-		copy(dAtA[i:], m.Farmer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Farmer)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *MsgVaultUnfarmResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVaultUnfarmResponse.Merge(m, src)
+}
+func (m *MsgVaultUnfarmResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVaultUnfarmResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVaultUnfarmResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVaultUnfarmResponse proto.InternalMessageInfo
+
+// MsgAdvanceAuction defines a message to advance rewards auction by one.
+type MsgAdvanceAuction struct {
+	// requester defines the bech32-encoded address of the requester
+	Requester string `protobuf:"bytes,1,opt,name=requester,proto3" json:"requester,omitempty"`
+}
+
+func (m *MsgAdvanceAuction) Reset()         { *m = MsgAdvanceAuction{} }
+func (m *MsgAdvanceAuction) String() string { return proto.CompactTextString(m) }
+func (*MsgAdvanceAuction) ProtoMessage()    {}
+func (*MsgAdvanceAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db3f4cd082b3b711, []int{22}
+}
+func (m *MsgAdvanceAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAdvanceAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAdvanceAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgAdvanceAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAdvanceAuction.Merge(m, src)
+}
+func (m *MsgAdvanceAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAdvanceAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAdvanceAuction.DiscardUnknown(m)
 }
 
-func (m *MsgLiquidUnfarmResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgAdvanceAuction proto.InternalMessageInfo
+
+// MsgAdvanceAuctionResponse defines the Msg/AdvanceAuction response type.
+type MsgAdvanceAuctionResponse struct {
+}
+
+func (m *MsgAdvanceAuctionResponse) Reset()         { *m = MsgAdvanceAuctionResponse{} }
+func (m *MsgAdvanceAuctionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAdvanceAuctionResponse) ProtoMessage()    {}
+func (*MsgAdvanceAuctionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db3f4cd082b3b711, []int{23}
+}
+func (m *MsgAdvanceAuctionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAdvanceAuctionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAdvanceAuctionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgAdvanceAuctionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAdvanceAuctionResponse.Merge(m, src)
+}
+func (m *MsgAdvanceAuctionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAdvanceAuctionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAdvanceAuctionResponse.DiscardUnknown(m)
 }
 
-func (m *MsgLiquidUnfarmResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgAdvanceAuctionResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgLiquidFarm)(nil), "squad.liquidfarming.v1beta1.MsgLiquidFarm")
+	proto.RegisterType((*MsgLiquidFarmResponse)(nil), "squad.liquidfarming.v1beta1.MsgLiquidFarmResponse")
+	proto.RegisterType((*MsgLiquidUnfarm)(nil), "squad.liquidfarming.v1beta1.MsgLiquidUnfarm")
+	proto.RegisterType((*MsgLiquidUnfarmResponse)(nil), "squad.liquidfarming.v1beta1.MsgLiquidUnfarmResponse")
+	proto.RegisterType((*MsgLiquidUnfarmAndWithdraw)(nil), "squad.liquidfarming.v1beta1.MsgLiquidUnfarmAndWithdraw")
+	proto.RegisterType((*MsgLiquidUnfarmAndWithdrawResponse)(nil), "squad.liquidfarming.v1beta1.MsgLiquidUnfarmAndWithdrawResponse")
+	proto.RegisterType((*MsgPlaceBid)(nil), "squad.liquidfarming.v1beta1.MsgPlaceBid")
+	proto.RegisterType((*MsgPlaceBidResponse)(nil), "squad.liquidfarming.v1beta1.MsgPlaceBidResponse")
+	proto.RegisterType((*MsgRefundBid)(nil), "squad.liquidfarming.v1beta1.MsgRefundBid")
+	proto.RegisterType((*MsgRefundBidResponse)(nil), "squad.liquidfarming.v1beta1.MsgRefundBidResponse")
+	proto.RegisterType((*MsgPairLiquidFarm)(nil), "squad.liquidfarming.v1beta1.MsgPairLiquidFarm")
+	proto.RegisterType((*MsgPairLiquidFarmResponse)(nil), "squad.liquidfarming.v1beta1.MsgPairLiquidFarmResponse")
+	proto.RegisterType((*MsgPairLiquidUnfarm)(nil), "squad.liquidfarming.v1beta1.MsgPairLiquidUnfarm")
+	proto.RegisterType((*MsgPairLiquidUnfarmResponse)(nil), "squad.liquidfarming.v1beta1.MsgPairLiquidUnfarmResponse")
+	proto.RegisterType((*MsgPlacePairBid)(nil), "squad.liquidfarming.v1beta1.MsgPlacePairBid")
+	proto.RegisterType((*MsgPlacePairBidResponse)(nil), "squad.liquidfarming.v1beta1.MsgPlacePairBidResponse")
+	proto.RegisterType((*MsgRefundPairBid)(nil), "squad.liquidfarming.v1beta1.MsgRefundPairBid")
+	proto.RegisterType((*MsgRefundPairBidResponse)(nil), "squad.liquidfarming.v1beta1.MsgRefundPairBidResponse")
+	proto.RegisterType((*MsgVaultFarm)(nil), "squad.liquidfarming.v1beta1.MsgVaultFarm")
+	proto.RegisterType((*MsgVaultFarmResponse)(nil), "squad.liquidfarming.v1beta1.MsgVaultFarmResponse")
+	proto.RegisterType((*MsgVaultUnfarm)(nil), "squad.liquidfarming.v1beta1.MsgVaultUnfarm")
+	proto.RegisterType((*MsgVaultUnfarmResponse)(nil), "squad.liquidfarming.v1beta1.MsgVaultUnfarmResponse")
+	proto.RegisterType((*MsgAdvanceAuction)(nil), "squad.liquidfarming.v1beta1.MsgAdvanceAuction")
+	proto.RegisterType((*MsgAdvanceAuctionResponse)(nil), "squad.liquidfarming.v1beta1.MsgAdvanceAuctionResponse")
 }
 
-func (m *MsgLiquidUnfarmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterFile("squad/liquidfarming/v1beta1/tx.proto", fileDescriptor_db3f4cd082b3b711)
 }
 
-func (m *MsgLiquidUnfarmAndWithdraw) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var fileDescriptor_db3f4cd082b3b711 = []byte{
+	// 847 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0x4f, 0x4f, 0xd4, 0x5e,
+	0x14, 0x9d, 0x07, 0x84, 0xdf, 0x6f, 0xee, 0x00, 0x62, 0x05, 0x66, 0x28, 0x32, 0x90, 0x09, 0x89,
+	0x23, 0x42, 0xcb, 0x1f, 0x05, 0xe3, 0xc6, 0x80, 0x89, 0x09, 0x89, 0x93, 0x90, 0x49, 0xd4, 0xc4,
+	0x0d, 0x79, 0x33, 0xed, 0x94, 0x17, 0x67, 0xda, 0x99, 0xfe, 0x41, 0xd8, 0xb8, 0x36, 0x71, 0x63,
+	0xe2, 0x17, 0x70, 0xed, 0x5a, 0x17, 0xea, 0xc2, 0x9d, 0x61, 0x49, 0x5c, 0x18, 0x57, 0x6a, 0xe0,
+	0x8b, 0x98, 0xb6, 0x6f, 0xde, 0xbc, 0x76, 0xc0, 0xb6, 0x44, 0x22, 0xac, 0xa6, 0xaf, 0xef, 0xdc,
+	0x7b, 0xcf, 0x99, 0x9e, 0xde, 0xfb, 0x0a, 0x33, 0x56, 0xcb, 0xc1, 0x8a, 0x5c, 0x27, 0x2d, 0x87,
+	0x28, 0x35, 0x6c, 0x36, 0x88, 0xae, 0xc9, 0x3b, 0x8b, 0x15, 0xd5, 0xc6, 0x8b, 0xb2, 0xbd, 0x2b,
+	0x35, 0x4d, 0xc3, 0x36, 0x84, 0x09, 0x0f, 0x25, 0x05, 0x50, 0x12, 0x45, 0x89, 0x23, 0x9a, 0xa1,
+	0x19, 0x1e, 0x4e, 0x76, 0xaf, 0xfc, 0x10, 0x31, 0x5f, 0x35, 0xac, 0x86, 0x61, 0xc9, 0x15, 0x6c,
+	0xa9, 0x2c, 0x61, 0xd5, 0x20, 0x3a, 0xdd, 0x9f, 0xe5, 0xf7, 0x5b, 0x8e, 0x6a, 0xee, 0x31, 0x54,
+	0x13, 0x6b, 0x44, 0xc7, 0x36, 0x31, 0x28, 0xb6, 0xf0, 0x1e, 0xc1, 0x60, 0xc9, 0xd2, 0x1e, 0x78,
+	0xe5, 0xef, 0x63, 0xb3, 0x21, 0x64, 0xe1, 0xbf, 0xa6, 0x61, 0xd4, 0xb7, 0x88, 0x92, 0x43, 0xd3,
+	0xa8, 0xd8, 0x57, 0xee, 0x77, 0x97, 0x1b, 0x8a, 0x30, 0x06, 0xfd, 0x2e, 0x3f, 0xd5, 0xcc, 0xf5,
+	0x4c, 0xa3, 0x62, 0xba, 0x4c, 0x57, 0x42, 0x03, 0x06, 0x28, 0xef, 0x2d, 0x97, 0x44, 0xae, 0x77,
+	0x1a, 0x15, 0x33, 0x4b, 0xe3, 0x92, 0xcf, 0x42, 0x72, 0x59, 0xb4, 0x05, 0x49, 0xf7, 0x0c, 0xa2,
+	0xaf, 0xcb, 0xfb, 0x3f, 0xa6, 0x52, 0x6f, 0x7f, 0x4e, 0x5d, 0xd3, 0x88, 0xbd, 0xed, 0x54, 0xa4,
+	0xaa, 0xd1, 0x90, 0x29, 0x65, 0xff, 0x67, 0xde, 0x52, 0x9e, 0xca, 0xf6, 0x5e, 0x53, 0xb5, 0xbc,
+	0x80, 0x72, 0x86, 0xe6, 0x77, 0x17, 0x77, 0xfa, 0x5e, 0xbc, 0x99, 0x4a, 0x15, 0xb2, 0x30, 0x1a,
+	0xa0, 0x5d, 0x56, 0xad, 0xa6, 0xa1, 0x5b, 0x6a, 0xe1, 0x13, 0x82, 0x4b, 0x6c, 0xe7, 0xa1, 0x5e,
+	0x3b, 0x95, 0xa4, 0x16, 0x0c, 0x39, 0xfa, 0x19, 0x8b, 0x1a, 0x64, 0x15, 0x38, 0x59, 0xe3, 0x90,
+	0x0d, 0x91, 0x67, 0xc2, 0xbe, 0x20, 0x10, 0x43, 0x7b, 0x6b, 0xba, 0xf2, 0x98, 0xd8, 0xdb, 0x8a,
+	0x89, 0x9f, 0x5d, 0x20, 0x8d, 0x33, 0x50, 0x38, 0x59, 0x07, 0x93, 0xfb, 0x15, 0x41, 0xa6, 0x64,
+	0x69, 0x9b, 0x75, 0x5c, 0x55, 0xd7, 0x89, 0x22, 0x4c, 0x02, 0x60, 0xa7, 0xea, 0x3a, 0xb7, 0x23,
+	0x31, 0x4d, 0xef, 0x6c, 0x28, 0xbc, 0xfc, 0x9e, 0xb0, 0xfc, 0x0a, 0x51, 0x14, 0xd5, 0xf4, 0xe4,
+	0xa5, 0xcb, 0x74, 0xe5, 0xba, 0xd6, 0xbd, 0x62, 0xe2, 0xfb, 0xfe, 0xbe, 0x6b, 0x69, 0x7e, 0x4e,
+	0xfa, 0x28, 0x5c, 0xe1, 0x34, 0x31, 0xad, 0x0a, 0x0c, 0x94, 0x2c, 0xad, 0xac, 0xd6, 0x1c, 0x5d,
+	0x39, 0x03, 0xad, 0xb4, 0xf8, 0x18, 0x8c, 0xf0, 0x55, 0x58, 0xf5, 0x0f, 0x08, 0x2e, 0xbb, 0xac,
+	0x30, 0x31, 0x43, 0x6d, 0x00, 0x13, 0x93, 0xf7, 0x13, 0x26, 0xe6, 0x79, 0x6b, 0x03, 0x13, 0x30,
+	0xde, 0x45, 0x9d, 0x09, 0xfb, 0x8c, 0xfc, 0xbf, 0x9b, 0xed, 0x72, 0xed, 0x20, 0x91, 0xb4, 0x7f,
+	0xf6, 0xaa, 0x4c, 0xc2, 0xc4, 0x31, 0x02, 0x98, 0xc0, 0x6f, 0x7e, 0xaf, 0xf3, 0xfc, 0xe4, 0x82,
+	0x62, 0x7a, 0x87, 0x6a, 0xef, 0x09, 0x6b, 0x3f, 0x3f, 0xef, 0x89, 0xdf, 0x06, 0x79, 0x5d, 0x4c,
+	0xf3, 0x36, 0x0c, 0x33, 0x17, 0x9f, 0x91, 0x66, 0x4a, 0x42, 0x84, 0x5c, 0xb8, 0x12, 0x63, 0xf1,
+	0x0e, 0x79, 0xaf, 0xec, 0x23, 0xec, 0xd4, 0xed, 0x0b, 0x34, 0x35, 0xfd, 0x16, 0xc0, 0x58, 0x33,
+	0x39, 0x1f, 0x11, 0x0c, 0xb5, 0x37, 0x2e, 0xdc, 0xcc, 0xcc, 0xc1, 0x58, 0x90, 0x3b, 0x93, 0xb5,
+	0xea, 0x35, 0xb6, 0x35, 0x65, 0x07, 0xeb, 0x55, 0x75, 0xcd, 0xf7, 0x83, 0x70, 0x15, 0xd2, 0xa6,
+	0xda, 0x72, 0x54, 0xcb, 0x56, 0x4d, 0x4f, 0x5a, 0xba, 0xdc, 0xb9, 0x11, 0x68, 0x2b, 0xc1, 0xc0,
+	0x76, 0xd6, 0xa5, 0x97, 0x19, 0xe8, 0x2d, 0x59, 0x9a, 0x50, 0x07, 0xe0, 0xfa, 0xe5, 0xac, 0xf4,
+	0x87, 0x83, 0x9c, 0x14, 0x38, 0xab, 0x88, 0x4b, 0xf1, 0xb1, 0xed, 0xaa, 0x82, 0x09, 0x03, 0x81,
+	0x26, 0x36, 0x17, 0x2f, 0x87, 0x8f, 0x16, 0x6f, 0x26, 0x41, 0xb3, 0x9a, 0xaf, 0x11, 0x64, 0x4f,
+	0x3a, 0x6f, 0xac, 0x26, 0xc9, 0xc8, 0x05, 0x8a, 0x77, 0x4f, 0x19, 0xc8, 0x58, 0xd5, 0xe0, 0x7f,
+	0x76, 0x2a, 0x28, 0x46, 0x25, 0x6b, 0x23, 0xc5, 0x85, 0xb8, 0x48, 0x56, 0x87, 0x40, 0xba, 0x33,
+	0x92, 0xaf, 0x47, 0x85, 0x33, 0xa8, 0xb8, 0x18, 0x1b, 0xca, 0x4a, 0xed, 0xc2, 0x50, 0x68, 0xfc,
+	0x4a, 0x91, 0x74, 0x03, 0x78, 0x71, 0x25, 0x19, 0x9e, 0x55, 0x7e, 0x0e, 0xc3, 0x5d, 0xf3, 0x71,
+	0x21, 0x7e, 0x2e, 0x6a, 0xaf, 0xdb, 0x49, 0x23, 0x78, 0x5b, 0x07, 0xc6, 0xd7, 0x5c, 0xac, 0xc7,
+	0x44, 0xd1, 0xd1, 0xb6, 0x3e, 0x6e, 0x84, 0x08, 0x0e, 0x0c, 0x06, 0xe7, 0xc7, 0x7c, 0xbc, 0x27,
+	0xd6, 0xae, 0x7a, 0x2b, 0x11, 0x9c, 0xf7, 0x53, 0x67, 0x5e, 0x44, 0xfa, 0x89, 0x41, 0xa3, 0xfd,
+	0xd4, 0xd5, 0xcf, 0x05, 0x03, 0x32, 0x7c, 0x2f, 0xbf, 0x11, 0x2b, 0x03, 0x7d, 0x96, 0xcb, 0x09,
+	0xc0, 0xbc, 0x81, 0x43, 0x6d, 0x36, 0xd2, 0xc0, 0x41, 0x7c, 0xb4, 0x81, 0x8f, 0xef, 0xc6, 0xeb,
+	0x9b, 0xfb, 0x87, 0x79, 0x74, 0x70, 0x98, 0x47, 0xbf, 0x0e, 0xf3, 0xe8, 0xd5, 0x51, 0x3e, 0x75,
+	0x70, 0x94, 0x4f, 0x7d, 0x3f, 0xca, 0xa7, 0x9e, 0xac, 0x74, 0x4d, 0x15, 0xb7, 0xc0, 0x7c, 0x1d,
+	0x57, 0x2c, 0xd9, 0xff, 0x34, 0xdf, 0x0d, 0x7d, 0x9c, 0x7b, 0x93, 0xa6, 0xd2, 0xef, 0x7d, 0x19,
+	0x2f, 0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x39, 0x62, 0x46, 0xda, 0xc0, 0x0f, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// LiquidFarm defines a method for farming pool coin for a liquid farm
+	LiquidFarm(ctx context.Context, in *MsgLiquidFarm, opts ...grpc.CallOption) (*MsgLiquidFarmResponse, error)
+	// LiquidUnfarm defines a method for unfarming amount of LFCoin
+	LiquidUnfarm(ctx context.Context, in *MsgLiquidUnfarm, opts ...grpc.CallOption) (*MsgLiquidUnfarmResponse, error)
+	// LiquidUnfarmAndWithdraw defines a method for unfarming amount of LFCoin and withdraw pool coin from the pool
+	LiquidUnfarmAndWithdraw(ctx context.Context, in *MsgLiquidUnfarmAndWithdraw, opts ...grpc.CallOption) (*MsgLiquidUnfarmAndWithdrawResponse, error)
+	// PlaceBid defines a method for placing a bid for a rewards auction
+	PlaceBid(ctx context.Context, in *MsgPlaceBid, opts ...grpc.CallOption) (*MsgPlaceBidResponse, error)
+	// RefundBid defines a method for refunding the bid that is not winning for the auction
+	RefundBid(ctx context.Context, in *MsgRefundBid, opts ...grpc.CallOption) (*MsgRefundBidResponse, error)
+	// PairLiquidFarm defines a method for farming pool coin of any pool of the pair for a pair liquid farm
+	PairLiquidFarm(ctx context.Context, in *MsgPairLiquidFarm, opts ...grpc.CallOption) (*MsgPairLiquidFarmResponse, error)
+	// PairLiquidUnfarm defines a method for unfarming amount of pair LFCoin
+	PairLiquidUnfarm(ctx context.Context, in *MsgPairLiquidUnfarm, opts ...grpc.CallOption) (*MsgPairLiquidUnfarmResponse, error)
+	// PlacePairBid defines a method for placing a bid for a rewards auction of a pair liquid farm
+	PlacePairBid(ctx context.Context, in *MsgPlacePairBid, opts ...grpc.CallOption) (*MsgPlacePairBidResponse, error)
+	// RefundPairBid defines a method for refunding the bid that is not winning for the pair rewards auction
+	RefundPairBid(ctx context.Context, in *MsgRefundPairBid, opts ...grpc.CallOption) (*MsgRefundPairBidResponse, error)
+	// VaultFarm defines a method for farming pool coin for a vault
+	VaultFarm(ctx context.Context, in *MsgVaultFarm, opts ...grpc.CallOption) (*MsgVaultFarmResponse, error)
+	// VaultUnfarm defines a method for unfarming vault coin
+	VaultUnfarm(ctx context.Context, in *MsgVaultUnfarm, opts ...grpc.CallOption) (*MsgVaultUnfarmResponse, error)
+	// AdvanceAuction defines a method for advancing rewards auction by one.
+	// This Msg is defined just for testing purpose and it shouldn't be used in production.
+	AdvanceAuction(ctx context.Context, in *MsgAdvanceAuction, opts ...grpc.CallOption) (*MsgAdvanceAuctionResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) LiquidFarm(ctx context.Context, in *MsgLiquidFarm, opts ...grpc.CallOption) (*MsgLiquidFarmResponse, error) {
+	out := new(MsgLiquidFarmResponse)
+	err := c.cc.Invoke(ctx, "/squad.liquidfarming.v1beta1.Msg/LiquidFarm", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgLiquidUnfarmAndWithdraw) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) LiquidUnfarm(ctx context.Context, in *MsgLiquidUnfarm, opts ...grpc.CallOption) (*MsgLiquidUnfarmResponse, error) {
+	out := new(MsgLiquidUnfarmResponse)
+	err := c.cc.Invoke(ctx, "/squad.liquidfarming.v1beta1.Msg/LiquidUnfarm", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgLiquidUnfarmAndWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.UnfarmingCoin.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func (c *msgClient) LiquidUnfarmAndWithdraw(ctx context.Context, in *MsgLiquidUnfarmAndWithdraw, opts ...grpc.CallOption) (*MsgLiquidUnfarmAndWithdrawResponse, error) {
+	out := new(MsgLiquidUnfarmAndWithdrawResponse)
+	err := c.cc.Invoke(ctx, "/squad.liquidfarming.v1beta1.Msg/LiquidUnfarmAndWithdraw", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Farmer) > 0 {
-		i -= len(m.Farmer)
-		copy(dAtA[i:], m.Farmer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Farmer)))
-		i--
-		dAtA[i] = 0x12
+	return out, nil
+}
+
+func (c *msgClient) PlaceBid(ctx context.Context, in *MsgPlaceBid, opts ...grpc.CallOption) (*MsgPlaceBidResponse, error) {
+	out := new(MsgPlaceBidResponse)
+	err := c.cc.Invoke(ctx, "/squad.liquidfarming.v1beta1.Msg/PlaceBid", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+	return out, nil
+}
+
+func (c *msgClient) RefundBid(ctx context.Context, in *MsgRefundBid, opts ...grpc.CallOption) (*MsgRefundBidResponse, error) {
+	out := new(MsgRefundBidResponse)
+	err := c.cc.Invoke(ctx, "/squad.liquidfarming.v1beta1.Msg/RefundBid", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgLiquidUnfarmAndWithdrawResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) PairLiquidFarm(ctx context.Context, in *MsgPairLiquidFarm, opts ...grpc.CallOption) (*MsgPairLiquidFarmResponse, error) {
+	out := new(MsgPairLiquidFarmResponse)
+	err := c.cc.Invoke(ctx, "/squad.liquidfarming.v1beta1.Msg/PairLiquidFarm", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgLiquidUnfarmAndWithdrawResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) PairLiquidUnfarm(ctx context.Context, in *MsgPairLiquidUnfarm, opts ...grpc.CallOption) (*MsgPairLiquidUnfarmResponse, error) {
+	out := new(MsgPairLiquidUnfarmResponse)
+	err := c.cc.Invoke(ctx, "/squad.liquidfarming.v1beta1.Msg/PairLiquidUnfarm", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgLiquidUnfarmAndWithdrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) PlacePairBid(ctx context.Context, in *MsgPlacePairBid, opts ...grpc.CallOption) (*MsgPlacePairBidResponse, error) {
+	out := new(MsgPlacePairBidResponse)
+	err := c.cc.Invoke(ctx, "/squad.liquidfarming.v1beta1.Msg/PlacePairBid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgPlaceBid) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RefundPairBid(ctx context.Context, in *MsgRefundPairBid, opts ...grpc.CallOption) (*MsgRefundPairBidResponse, error) {
+	out := new(MsgRefundPairBidResponse)
+	err := c.cc.Invoke(ctx, "/squad.liquidfarming.v1beta1.Msg/RefundPairBid", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgPlaceBid) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) VaultFarm(ctx context.Context, in *MsgVaultFarm, opts ...grpc.CallOption) (*MsgVaultFarmResponse, error) {
+	out := new(MsgVaultFarmResponse)
+	err := c.cc.Invoke(ctx, "/squad.liquidfarming.v1beta1.Msg/VaultFarm", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgPlaceBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.BiddingCoin.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.Bidder) > 0 {
-		i -= len(m.Bidder)
-		copy(dAtA[i:], m.Bidder)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Bidder)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.AuctionId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.AuctionId))
-		i--
-		dAtA[i] = 0x8
+func (c *msgClient) VaultUnfarm(ctx context.Context, in *MsgVaultUnfarm, opts ...grpc.CallOption) (*MsgVaultUnfarmResponse, error) {
+	out := new(MsgVaultUnfarmResponse)
+	err := c.cc.Invoke(ctx, "/squad.liquidfarming.v1beta1.Msg/VaultUnfarm", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgPlaceBidResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AdvanceAuction(ctx context.Context, in *MsgAdvanceAuction, opts ...grpc.CallOption) (*MsgAdvanceAuctionResponse, error) {
+	out := new(MsgAdvanceAuctionResponse)
+	err := c.cc.Invoke(ctx, "/squad.liquidfarming.v1beta1.Msg/AdvanceAuction", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgPlaceBidResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// LiquidFarm defines a method for farming pool coin for a liquid farm
+	LiquidFarm(context.Context, *MsgLiquidFarm) (*MsgLiquidFarmResponse, error)
+	// LiquidUnfarm defines a method for unfarming amount of LFCoin
+	LiquidUnfarm(context.Context, *MsgLiquidUnfarm) (*MsgLiquidUnfarmResponse, error)
+	// LiquidUnfarmAndWithdraw defines a method for unfarming amount of LFCoin and withdraw pool coin from the pool
+	LiquidUnfarmAndWithdraw(context.Context, *MsgLiquidUnfarmAndWithdraw) (*MsgLiquidUnfarmAndWithdrawResponse, error)
+	// PlaceBid defines a method for placing a bid for a rewards auction
+	PlaceBid(context.Context, *MsgPlaceBid) (*MsgPlaceBidResponse, error)
+	// RefundBid defines a method for refunding the bid that is not winning for the auction
+	RefundBid(context.Context, *MsgRefundBid) (*MsgRefundBidResponse, error)
+	// PairLiquidFarm defines a method for farming pool coin of any pool of the pair for a pair liquid farm
+	PairLiquidFarm(context.Context, *MsgPairLiquidFarm) (*MsgPairLiquidFarmResponse, error)
+	// PairLiquidUnfarm defines a method for unfarming amount of pair LFCoin
+	PairLiquidUnfarm(context.Context, *MsgPairLiquidUnfarm) (*MsgPairLiquidUnfarmResponse, error)
+	// PlacePairBid defines a method for placing a bid for a rewards auction of a pair liquid farm
+	PlacePairBid(context.Context, *MsgPlacePairBid) (*MsgPlacePairBidResponse, error)
+	// RefundPairBid defines a method for refunding the bid that is not winning for the pair rewards auction
+	RefundPairBid(context.Context, *MsgRefundPairBid) (*MsgRefundPairBidResponse, error)
+	// VaultFarm defines a method for farming pool coin for a vault
+	VaultFarm(context.Context, *MsgVaultFarm) (*MsgVaultFarmResponse, error)
+	// VaultUnfarm defines a method for unfarming vault coin
+	VaultUnfarm(context.Context, *MsgVaultUnfarm) (*MsgVaultUnfarmResponse, error)
+	// AdvanceAuction defines a method for advancing rewards auction by one.
+	// This Msg is defined just for testing purpose and it shouldn't be used in production.
+	AdvanceAuction(context.Context, *MsgAdvanceAuction) (*MsgAdvanceAuctionResponse, error)
 }
 
-func (m *MsgPlaceBidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgRefundBid) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) LiquidFarm(ctx context.Context, req *MsgLiquidFarm) (*MsgLiquidFarmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidFarm not implemented")
 }
-
-func (m *MsgRefundBid) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) LiquidUnfarm(ctx context.Context, req *MsgLiquidUnfarm) (*MsgLiquidUnfarmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidUnfarm not implemented")
 }
-
-func (m *MsgRefundBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Bidder) > 0 {
-		i -= len(m.Bidder)
-		copy(dAtA[i:], m.Bidder)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Bidder)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.AuctionId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.AuctionId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) LiquidUnfarmAndWithdraw(ctx context.Context, req *MsgLiquidUnfarmAndWithdraw) (*MsgLiquidUnfarmAndWithdrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidUnfarmAndWithdraw not implemented")
 }
-
-func (m *MsgRefundBidResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) PlaceBid(ctx context.Context, req *MsgPlaceBid) (*MsgPlaceBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceBid not implemented")
 }
-
-func (m *MsgRefundBidResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) RefundBid(ctx context.Context, req *MsgRefundBid) (*MsgRefundBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundBid not implemented")
 }
-
-func (m *MsgRefundBidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) PairLiquidFarm(ctx context.Context, req *MsgPairLiquidFarm) (*MsgPairLiquidFarmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PairLiquidFarm not implemented")
 }
-
-func (m *MsgAdvanceAuction) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) PairLiquidUnfarm(ctx context.Context, req *MsgPairLiquidUnfarm) (*MsgPairLiquidUnfarmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PairLiquidUnfarm not implemented")
 }
-
-func (m *MsgAdvanceAuction) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) PlacePairBid(ctx context.Context, req *MsgPlacePairBid) (*MsgPlacePairBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlacePairBid not implemented")
 }
-
-func (m *MsgAdvanceAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Requester) > 0 {
-		i -= len(m.Requester)
-		copy(dAtA[i:], m.Requester)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Requester)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) RefundPairBid(ctx context.Context, req *MsgRefundPairBid) (*MsgRefundPairBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundPairBid not implemented")
 }
-
-func (m *MsgAdvanceAuctionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) VaultFarm(ctx context.Context, req *MsgVaultFarm) (*MsgVaultFarmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VaultFarm not implemented")
 }
-
-func (m *MsgAdvanceAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) VaultUnfarm(ctx context.Context, req *MsgVaultUnfarm) (*MsgVaultUnfarmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VaultUnfarm not implemented")
+}
+func (*UnimplementedMsgServer) AdvanceAuction(ctx context.Context, req *MsgAdvanceAuction) (*MsgAdvanceAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdvanceAuction not implemented")
 }
 
-func (m *MsgAdvanceAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func _Msg_LiquidFarm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLiquidFarm)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgLiquidFarm) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).LiquidFarm(ctx, in)
 	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovTx(uint64(m.PoolId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squad.liquidfarming.v1beta1.Msg/LiquidFarm",
 	}
-	l = len(m.Farmer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LiquidFarm(ctx, req.(*MsgLiquidFarm))
 	}
-	l = m.FarmingCoin.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgLiquidFarmResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_LiquidUnfarm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLiquidUnfarm)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgLiquidUnfarm) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).LiquidUnfarm(ctx, in)
 	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovTx(uint64(m.PoolId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squad.liquidfarming.v1beta1.Msg/LiquidUnfarm",
 	}
-	l = len(m.Farmer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LiquidUnfarm(ctx, req.(*MsgLiquidUnfarm))
 	}
-	l = m.UnfarmingCoin.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgLiquidUnfarmResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_LiquidUnfarmAndWithdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLiquidUnfarmAndWithdraw)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).LiquidUnfarmAndWithdraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squad.liquidfarming.v1beta1.Msg/LiquidUnfarmAndWithdraw",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LiquidUnfarmAndWithdraw(ctx, req.(*MsgLiquidUnfarmAndWithdraw))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgLiquidUnfarmAndWithdraw) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_PlaceBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPlaceBid)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovTx(uint64(m.PoolId))
+	if interceptor == nil {
+		return srv.(MsgServer).PlaceBid(ctx, in)
 	}
-	l = len(m.Farmer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squad.liquidfarming.v1beta1.Msg/PlaceBid",
 	}
-	l = m.UnfarmingCoin.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PlaceBid(ctx, req.(*MsgPlaceBid))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgLiquidUnfarmAndWithdrawResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RefundBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRefundBid)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).RefundBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squad.liquidfarming.v1beta1.Msg/RefundBid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RefundBid(ctx, req.(*MsgRefundBid))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgPlaceBid) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_PairLiquidFarm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPairLiquidFarm)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.AuctionId != 0 {
-		n += 1 + sovTx(uint64(m.AuctionId))
+	if interceptor == nil {
+		return srv.(MsgServer).PairLiquidFarm(ctx, in)
 	}
-	if m.PoolId != 0 {
-		n += 1 + sovTx(uint64(m.PoolId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squad.liquidfarming.v1beta1.Msg/PairLiquidFarm",
 	}
-	l = len(m.Bidder)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PairLiquidFarm(ctx, req.(*MsgPairLiquidFarm))
 	}
-	l = m.BiddingCoin.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgPlaceBidResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_PairLiquidUnfarm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPairLiquidUnfarm)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).PairLiquidUnfarm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squad.liquidfarming.v1beta1.Msg/PairLiquidUnfarm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PairLiquidUnfarm(ctx, req.(*MsgPairLiquidUnfarm))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRefundBid) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_PlacePairBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPlacePairBid)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.AuctionId != 0 {
-		n += 1 + sovTx(uint64(m.AuctionId))
+	if interceptor == nil {
+		return srv.(MsgServer).PlacePairBid(ctx, in)
 	}
-	if m.PoolId != 0 {
-		n += 1 + sovTx(uint64(m.PoolId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squad.liquidfarming.v1beta1.Msg/PlacePairBid",
 	}
-	l = len(m.Bidder)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PlacePairBid(ctx, req.(*MsgPlacePairBid))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRefundBidResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RefundPairBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRefundPairBid)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RefundPairBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squad.liquidfarming.v1beta1.Msg/RefundPairBid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RefundPairBid(ctx, req.(*MsgRefundPairBid))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_VaultFarm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVaultFarm)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VaultFarm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squad.liquidfarming.v1beta1.Msg/VaultFarm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VaultFarm(ctx, req.(*MsgVaultFarm))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_VaultUnfarm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVaultUnfarm)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VaultUnfarm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squad.liquidfarming.v1beta1.Msg/VaultUnfarm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VaultUnfarm(ctx, req.(*MsgVaultUnfarm))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AdvanceAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAdvanceAuction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AdvanceAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squad.liquidfarming.v1beta1.Msg/AdvanceAuction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AdvanceAuction(ctx, req.(*MsgAdvanceAuction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "squad.liquidfarming.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "LiquidFarm",
+			Handler:    _Msg_LiquidFarm_Handler,
+		},
+		{
+			MethodName: "LiquidUnfarm",
+			Handler:    _Msg_LiquidUnfarm_Handler,
+		},
+		{
+			MethodName: "LiquidUnfarmAndWithdraw",
+			Handler:    _Msg_LiquidUnfarmAndWithdraw_Handler,
+		},
+		{
+			MethodName: "PlaceBid",
+			Handler:    _Msg_PlaceBid_Handler,
+		},
+		{
+			MethodName: "RefundBid",
+			Handler:    _Msg_RefundBid_Handler,
+		},
+		{
+			MethodName: "PairLiquidFarm",
+			Handler:    _Msg_PairLiquidFarm_Handler,
+		},
+		{
+			MethodName: "PairLiquidUnfarm",
+			Handler:    _Msg_PairLiquidUnfarm_Handler,
+		},
+		{
+			MethodName: "PlacePairBid",
+			Handler:    _Msg_PlacePairBid_Handler,
+		},
+		{
+			MethodName: "RefundPairBid",
+			Handler:    _Msg_RefundPairBid_Handler,
+		},
+		{
+			MethodName: "VaultFarm",
+			Handler:    _Msg_VaultFarm_Handler,
+		},
+		{
+			MethodName: "VaultUnfarm",
+			Handler:    _Msg_VaultUnfarm_Handler,
+		},
+		{
+			MethodName: "AdvanceAuction",
+			Handler:    _Msg_AdvanceAuction_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "squad/liquidfarming/v1beta1/tx.proto",
+}
+
+func (m *MsgLiquidFarm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLiquidFarm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLiquidFarm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FarmingCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLiquidFarmResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLiquidFarmResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLiquidFarmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLiquidUnfarm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLiquidUnfarm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLiquidUnfarm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.UnfarmingCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLiquidUnfarmResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLiquidUnfarmResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLiquidUnfarmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLiquidUnfarmAndWithdraw) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLiquidUnfarmAndWithdraw) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLiquidUnfarmAndWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.UnfarmingCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLiquidUnfarmAndWithdrawResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLiquidUnfarmAndWithdrawResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLiquidUnfarmAndWithdrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlaceBid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceBid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BiddingCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlaceBidResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceBidResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceBidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefundBid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefundBid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefundBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefundBidResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefundBidResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefundBidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPairLiquidFarm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPairLiquidFarm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPairLiquidFarm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FarmingCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PairId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PairId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPairLiquidFarmResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPairLiquidFarmResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPairLiquidFarmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPairLiquidUnfarm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPairLiquidUnfarm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPairLiquidUnfarm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.UnfarmingCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PairId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PairId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPairLiquidUnfarmResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPairLiquidUnfarmResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPairLiquidUnfarmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlacePairBid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlacePairBid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlacePairBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BiddingCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PairId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PairId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlacePairBidResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlacePairBidResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlacePairBidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefundPairBid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefundPairBid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefundPairBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PairId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PairId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefundPairBidResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefundPairBidResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefundPairBidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVaultFarm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVaultFarm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVaultFarm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FarmingCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVaultFarmResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVaultFarmResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVaultFarmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVaultUnfarm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVaultUnfarm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVaultUnfarm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.UnfarmingCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVaultUnfarmResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVaultUnfarmResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVaultUnfarmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAdvanceAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAdvanceAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAdvanceAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Requester) > 0 {
+		i -= len(m.Requester)
+		copy(dAtA[i:], m.Requester)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Requester)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAdvanceAuctionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAdvanceAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAdvanceAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgLiquidFarm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.FarmingCoin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgLiquidFarmResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgLiquidUnfarm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.UnfarmingCoin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgLiquidUnfarmResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgLiquidUnfarmAndWithdraw) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.UnfarmingCoin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgLiquidUnfarmAndWithdrawResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPlaceBid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovTx(uint64(m.AuctionId))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.BiddingCoin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgPlaceBidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRefundBid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovTx(uint64(m.AuctionId))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRefundBidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPairLiquidFarm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PairId != 0 {
+		n += 1 + sovTx(uint64(m.PairId))
+	}
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.FarmingCoin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgPairLiquidFarmResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPairLiquidUnfarm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PairId != 0 {
+		n += 1 + sovTx(uint64(m.PairId))
+	}
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.UnfarmingCoin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgPairLiquidUnfarmResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPlacePairBid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovTx(uint64(m.AuctionId))
+	}
+	if m.PairId != 0 {
+		n += 1 + sovTx(uint64(m.PairId))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.BiddingCoin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgPlacePairBidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRefundPairBid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovTx(uint64(m.AuctionId))
+	}
+	if m.PairId != 0 {
+		n += 1 + sovTx(uint64(m.PairId))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRefundPairBidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgVaultFarm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.FarmingCoin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgVaultFarmResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgVaultUnfarm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.UnfarmingCoin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgVaultUnfarmResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAdvanceAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Requester)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAdvanceAuctionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgLiquidFarm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiquidFarm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiquidFarm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FarmingCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FarmingCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLiquidFarmResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiquidFarmResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiquidFarmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLiquidUnfarm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiquidUnfarm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiquidUnfarm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnfarmingCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnfarmingCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLiquidUnfarmResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiquidUnfarmResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiquidUnfarmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLiquidUnfarmAndWithdraw) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiquidUnfarmAndWithdraw: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiquidUnfarmAndWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnfarmingCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnfarmingCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLiquidUnfarmAndWithdrawResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiquidUnfarmAndWithdrawResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiquidUnfarmAndWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPlaceBid) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceBid: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceBid: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BiddingCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BiddingCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPlaceBidResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceBidResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRefundBid) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRefundBid: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRefundBid: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRefundBidResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRefundBidResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRefundBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPairLiquidFarm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPairLiquidFarm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPairLiquidFarm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+			}
+			m.PairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PairId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FarmingCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FarmingCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *MsgAdvanceAuction) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Requester)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *MsgAdvanceAuctionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgPairLiquidFarmResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPairLiquidFarmResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPairLiquidFarmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgLiquidFarm) Unmarshal(dAtA []byte) error {
+func (m *MsgPairLiquidUnfarm) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1423,17 +3822,17 @@ func (m *MsgLiquidFarm) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidFarm: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPairLiquidUnfarm: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidFarm: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPairLiquidUnfarm: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
 			}
-			m.PoolId = 0
+			m.PairId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1443,7 +3842,7 @@ func (m *MsgLiquidFarm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				m.PairId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1482,7 +3881,7 @@ func (m *MsgLiquidFarm) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FarmingCoin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnfarmingCoin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1509,7 +3908,7 @@ func (m *MsgLiquidFarm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FarmingCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UnfarmingCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1534,7 +3933,7 @@ func (m *MsgLiquidFarm) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLiquidFarmResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPairLiquidUnfarmResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1557,10 +3956,10 @@ func (m *MsgLiquidFarmResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidFarmResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPairLiquidUnfarmResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidFarmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPairLiquidUnfarmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1584,7 +3983,7 @@ func (m *MsgLiquidFarmResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLiquidUnfarm) Unmarshal(dAtA []byte) error {
+func (m *MsgPlacePairBid) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1607,17 +4006,17 @@ func (m *MsgLiquidUnfarm) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidUnfarm: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPlacePairBid: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidUnfarm: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPlacePairBid: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
 			}
-			m.PoolId = 0
+			m.AuctionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1627,14 +4026,33 @@ func (m *MsgLiquidUnfarm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				m.AuctionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+			}
+			m.PairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PairId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1662,11 +4080,11 @@ func (m *MsgLiquidUnfarm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Farmer = string(dAtA[iNdEx:postIndex])
+			m.Bidder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnfarmingCoin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BiddingCoin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1693,7 +4111,7 @@ func (m *MsgLiquidUnfarm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UnfarmingCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BiddingCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1718,7 +4136,7 @@ func (m *MsgLiquidUnfarm) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLiquidUnfarmResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPlacePairBidResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1741,10 +4159,10 @@ func (m *MsgLiquidUnfarmResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidUnfarmResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPlacePairBidResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidUnfarmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPlacePairBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1768,7 +4186,7 @@ func (m *MsgLiquidUnfarmResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLiquidUnfarmAndWithdraw) Unmarshal(dAtA []byte) error {
+func (m *MsgRefundPairBid) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1791,17 +4209,17 @@ func (m *MsgLiquidUnfarmAndWithdraw) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidUnfarmAndWithdraw: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRefundPairBid: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidUnfarmAndWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRefundPairBid: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
 			}
-			m.PoolId = 0
+			m.AuctionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1811,16 +4229,16 @@ func (m *MsgLiquidUnfarmAndWithdraw) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				m.AuctionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
 			}
-			var stringLen uint64
+			m.PairId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1830,29 +4248,16 @@ func (m *MsgLiquidUnfarmAndWithdraw) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PairId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Farmer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnfarmingCoin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1862,24 +4267,23 @@ func (m *MsgLiquidUnfarmAndWithdraw) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UnfarmingCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1902,7 +4306,7 @@ func (m *MsgLiquidUnfarmAndWithdraw) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLiquidUnfarmAndWithdrawResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRefundPairBidResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1925,10 +4329,10 @@ func (m *MsgLiquidUnfarmAndWithdrawResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidUnfarmAndWithdrawResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRefundPairBidResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidUnfarmAndWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRefundPairBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1952,7 +4356,7 @@ func (m *MsgLiquidUnfarmAndWithdrawResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPlaceBid) Unmarshal(dAtA []byte) error {
+func (m *MsgVaultFarm) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1975,32 +4379,13 @@ func (m *MsgPlaceBid) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPlaceBid: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVaultFarm: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPlaceBid: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVaultFarm: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
-			}
-			m.AuctionId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AuctionId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
@@ -2019,9 +4404,9 @@ func (m *MsgPlaceBid) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2049,11 +4434,11 @@ func (m *MsgPlaceBid) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bidder = string(dAtA[iNdEx:postIndex])
+			m.Farmer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BiddingCoin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FarmingCoin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2080,7 +4465,7 @@ func (m *MsgPlaceBid) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BiddingCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FarmingCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2105,7 +4490,7 @@ func (m *MsgPlaceBid) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPlaceBidResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgVaultFarmResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2128,10 +4513,10 @@ func (m *MsgPlaceBidResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPlaceBidResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVaultFarmResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPlaceBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVaultFarmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2155,7 +4540,7 @@ func (m *MsgPlaceBidResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRefundBid) Unmarshal(dAtA []byte) error {
+func (m *MsgVaultUnfarm) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2178,17 +4563,17 @@ func (m *MsgRefundBid) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRefundBid: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVaultUnfarm: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRefundBid: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVaultUnfarm: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.AuctionId = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2198,16 +4583,16 @@ func (m *MsgRefundBid) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AuctionId |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
 			}
-			m.PoolId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2217,16 +4602,29 @@ func (m *MsgRefundBid) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnfarmingCoin", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2236,23 +4634,24 @@ func (m *MsgRefundBid) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bidder = string(dAtA[iNdEx:postIndex])
+			if err := m.UnfarmingCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2275,7 +4674,7 @@ func (m *MsgRefundBid) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRefundBidResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgVaultUnfarmResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2298,10 +4697,10 @@ func (m *MsgRefundBidResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRefundBidResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVaultUnfarmResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRefundBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVaultUnfarmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
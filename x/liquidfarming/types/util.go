@@ -10,9 +10,11 @@ import (
 )
 
 const (
-	PayingReserveAddressPrefix           string = "PayingReserveAddress"
-	WithdrawnRewardsReserveAddressPrefix string = "WithdrawnRewardsReserveAddress"
-	ModuleAddressNameSplitter            string = "|"
+	PayingReserveAddressPrefix               string = "PayingReserveAddress"
+	WithdrawnRewardsReserveAddressPrefix     string = "WithdrawnRewardsReserveAddress"
+	PairPayingReserveAddressPrefix           string = "PairPayingReserveAddress"
+	PairWithdrawnRewardsReserveAddressPrefix string = "PairWithdrawnRewardsReserveAddress"
+	ModuleAddressNameSplitter                string = "|"
 
 	// The module uses the address type of 32 bytes length, but it can always be changed depending on Cosmos SDK's direction.
 	ReserveAddressType = farmingtypes.AddressType32Bytes
@@ -37,3 +39,23 @@ func WithdrawnRewardsReserveAddress(poolId uint64) sdk.AccAddress {
 		strings.Join([]string{WithdrawnRewardsReserveAddressPrefix, strconv.FormatUint(poolId, 10)}, ModuleAddressNameSplitter),
 	)
 }
+
+// PairPayingReserveAddress creates the paying reserve address in the form of sdk.AccAddress
+// with the given pair id.
+func PairPayingReserveAddress(pairId uint64) sdk.AccAddress {
+	return farmingtypes.DeriveAddress(
+		ReserveAddressType,
+		ModuleName,
+		strings.Join([]string{PairPayingReserveAddressPrefix, strconv.FormatUint(pairId, 10)}, ModuleAddressNameSplitter),
+	)
+}
+
+// PairWithdrawnRewardsReserveAddress creates the withdrawn rewards reserve address in the form of sdk.AccAddress
+// with the given pair id.
+func PairWithdrawnRewardsReserveAddress(pairId uint64) sdk.AccAddress {
+	return farmingtypes.DeriveAddress(
+		ReserveAddressType,
+		ModuleName,
+		strings.Join([]string{PairWithdrawnRewardsReserveAddressPrefix, strconv.FormatUint(pairId, 10)}, ModuleAddressNameSplitter),
+	)
+}
@@ -0,0 +1,112 @@
+package types
+
+import (
+	fmt "fmt"
+	"strconv"
+	"strings"
+	time "time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	farmingtypes "github.com/cosmosquad-labs/squad/v3/x/farming/types"
+)
+
+const (
+	VaultReserveAccPrefix string = "VaultReserveAcc"
+)
+
+// NewVault returns a new Vault.
+func NewVault(poolId uint64, minFarmAmt sdk.Int, maxSlippage sdk.Dec, compoundingInterval time.Duration) Vault {
+	return Vault{
+		PoolId:              poolId,
+		MinFarmAmount:       minFarmAmt,
+		MaxSlippage:         maxSlippage,
+		CompoundingInterval: compoundingInterval,
+	}
+}
+
+// Validate validates Vault.
+func (v Vault) Validate() error {
+	if v.PoolId == 0 {
+		return fmt.Errorf("pool id must not be 0")
+	}
+	if v.MinFarmAmount.IsNegative() {
+		return fmt.Errorf("minimum farm amount must be 0 or positive value: %s", v.MinFarmAmount)
+	}
+	if v.MaxSlippage.IsNegative() || v.MaxSlippage.GTE(sdk.OneDec()) {
+		return fmt.Errorf("max slippage must be in range [0, 1): %s", v.MaxSlippage)
+	}
+	if v.CompoundingInterval <= 0 {
+		return fmt.Errorf("compounding interval must be positive: %s", v.CompoundingInterval)
+	}
+	return nil
+}
+
+// String returns a human-readable string representation of the Vault.
+func (v Vault) String() string {
+	out, _ := v.MarshalYAML()
+	return out.(string)
+}
+
+// MarshalYAML returns the YAML representation of a Vault.
+func (v Vault) MarshalYAML() (interface{}, error) {
+	bz, err := codec.MarshalYAML(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()), &v)
+	if err != nil {
+		return nil, err
+	}
+	return string(bz), err
+}
+
+// VaultCoinDenom returns a unique vault coin denom for a Vault.
+func VaultCoinDenom(poolId uint64) string {
+	return fmt.Sprintf("lfvault%d", poolId)
+}
+
+// VaultReserveAddress returns the reserve address for a vault with the given pool id.
+func VaultReserveAddress(poolId uint64) sdk.AccAddress {
+	return farmingtypes.DeriveAddress(
+		ReserveAddressType,
+		ModuleName,
+		strings.Join([]string{VaultReserveAccPrefix, strconv.FormatUint(poolId, 10)}, ModuleAddressNameSplitter),
+	)
+}
+
+// BalancedDepositAmounts returns the amounts of the coins that can be deposited
+// to the pool with the ratio of the pool reserve.
+// The rest of the coins is the surplus, which needs to be swapped before depositing.
+func BalancedDepositAmounts(x, y, rx, ry sdk.Int) (depositX, depositY sdk.Int) {
+	switch {
+	case rx.IsZero() && ry.IsZero():
+		return sdk.ZeroInt(), sdk.ZeroInt()
+	case rx.IsZero():
+		return sdk.ZeroInt(), y
+	case ry.IsZero():
+		return x, sdk.ZeroInt()
+	case x.Mul(ry).GT(y.Mul(rx)): // x is surplus
+		return y.Mul(rx).Quo(ry), y
+	default: // y is surplus
+		return x, x.Mul(ry).Quo(rx)
+	}
+}
+
+// SurplusSwapAmount returns the amount of the surplus coin to be swapped into the other coin
+// so that the rest of the surplus and the swapped coin are in the ratio of the pool reserve.
+// The values of both reserves of the pool must be measured in the same coin.
+// SwapAmount = Surplus * OtherReserveValue / (SurplusReserveValue + OtherReserveValue)
+func SurplusSwapAmount(surplus sdk.Int, surplusReserveValue, otherReserveValue sdk.Dec) sdk.Int {
+	totalValue := surplusReserveValue.Add(otherReserveValue)
+	if !totalValue.IsPositive() {
+		return sdk.ZeroInt()
+	}
+	return otherReserveValue.MulInt(surplus).Quo(totalValue).TruncateInt()
+}
+
+// PriceDeviates returns whether the price deviates from the last price by more than the max slippage.
+func PriceDeviates(price, lastPrice, maxSlippage sdk.Dec) bool {
+	if !lastPrice.IsPositive() {
+		return true
+	}
+	return price.Sub(lastPrice).Abs().Quo(lastPrice).GT(maxSlippage)
+}
@@ -0,0 +1,58 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/cosmosquad-labs/squad/v3/types"
+	"github.com/cosmosquad-labs/squad/v3/x/liquidfarming/types"
+)
+
+func TestVaultCoinDenom(t *testing.T) {
+	require.Equal(t, "lfvault1", types.VaultCoinDenom(1))
+	require.NotEqual(t, types.VaultReserveAddress(1), types.LiquidFarmReserveAddress(1))
+}
+
+func TestBalancedDepositAmounts(t *testing.T) {
+	for _, tc := range []struct {
+		name                 string
+		x, y, rx, ry         int64
+		expectedX, expectedY int64
+	}{
+		{"empty pool", 100, 100, 0, 0, 0, 0},
+		{"balanced", 100, 300, 1000, 3000, 100, 300},
+		{"x is surplus", 200, 300, 1000, 3000, 100, 300},
+		{"y is surplus", 100, 500, 1000, 3000, 100, 300},
+		{"only x", 100, 0, 1000, 3000, 0, 0},
+		{"only y", 0, 100, 1000, 3000, 0, 0},
+		{"no x reserve", 100, 100, 0, 3000, 0, 100},
+		{"no y reserve", 100, 100, 1000, 0, 100, 0},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			x, y := types.BalancedDepositAmounts(
+				sdk.NewInt(tc.x), sdk.NewInt(tc.y), sdk.NewInt(tc.rx), sdk.NewInt(tc.ry))
+			require.True(sdk.IntEq(t, sdk.NewInt(tc.expectedX), x))
+			require.True(sdk.IntEq(t, sdk.NewInt(tc.expectedY), y))
+		})
+	}
+}
+
+func TestSurplusSwapAmount(t *testing.T) {
+	// The surplus is split in the ratio of the reserve values, 1:3
+	require.True(sdk.IntEq(t, sdk.NewInt(75), types.SurplusSwapAmount(sdk.NewInt(100), sdk.NewDec(1000), sdk.NewDec(3000))))
+	require.True(sdk.IntEq(t, sdk.NewInt(25), types.SurplusSwapAmount(sdk.NewInt(100), sdk.NewDec(3000), sdk.NewDec(1000))))
+	require.True(sdk.IntEq(t, sdk.ZeroInt(), types.SurplusSwapAmount(sdk.NewInt(100), sdk.ZeroDec(), sdk.ZeroDec())))
+}
+
+func TestPriceDeviates(t *testing.T) {
+	maxSlippage := utils.ParseDec("0.05")
+	require.False(t, types.PriceDeviates(utils.ParseDec("1.0"), utils.ParseDec("1.0"), maxSlippage))
+	require.False(t, types.PriceDeviates(utils.ParseDec("1.05"), utils.ParseDec("1.0"), maxSlippage))
+	require.False(t, types.PriceDeviates(utils.ParseDec("0.95"), utils.ParseDec("1.0"), maxSlippage))
+	require.True(t, types.PriceDeviates(utils.ParseDec("1.051"), utils.ParseDec("1.0"), maxSlippage))
+	require.True(t, types.PriceDeviates(utils.ParseDec("0.9"), utils.ParseDec("1.0"), maxSlippage))
+	require.True(t, types.PriceDeviates(utils.ParseDec("1.0"), sdk.ZeroDec(), maxSlippage))
+}
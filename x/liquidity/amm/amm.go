@@ -15,3 +15,9 @@ var (
 	MaxPoolPrice               = sdk.NewIntWithDecimal(1, 20).ToDec() // 10^20
 	MinRangedPoolPriceGapRatio = sdk.NewDecWithPrec(1, 3)             // 0.001, 0.1%
 )
+
+// The minimum and maximum amplification coefficient of stableswap pools.
+const (
+	MinAmplification uint64 = 1
+	MaxAmplification uint64 = 10000
+)
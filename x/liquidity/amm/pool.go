@@ -269,6 +269,20 @@ func ValidateRangedPoolParams(minPrice, maxPrice, initialPrice sdk.Dec) error {
 	if !initialPrice.IsPositive() {
 		return fmt.Errorf("initial price must be positive: %s", initialPrice)
 	}
+	if err := ValidatePriceRange(minPrice, maxPrice); err != nil {
+		return err
+	}
+	if initialPrice.LT(minPrice) {
+		return fmt.Errorf("initial price must not be lower than min price")
+	}
+	if initialPrice.GT(maxPrice) {
+		return fmt.Errorf("initial price must not be higher than max price")
+	}
+	return nil
+}
+
+// ValidatePriceRange validates the price range of a ranged pool.
+func ValidatePriceRange(minPrice, maxPrice sdk.Dec) error {
 	if minPrice.LT(MinPoolPrice) {
 		return fmt.Errorf("min price must not be lower than %s", MinPoolPrice)
 	}
@@ -284,12 +298,6 @@ func ValidateRangedPoolParams(minPrice, maxPrice, initialPrice sdk.Dec) error {
 	if maxPrice.Sub(minPrice).Quo(minPrice).LT(MinRangedPoolPriceGapRatio) {
 		return fmt.Errorf("min price and max price are too close")
 	}
-	if initialPrice.LT(minPrice) {
-		return fmt.Errorf("initial price must not be lower than min price")
-	}
-	if initialPrice.GT(maxPrice) {
-		return fmt.Errorf("initial price must not be higher than max price")
-	}
 	return nil
 }
 
@@ -0,0 +1,342 @@
+package amm
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/cosmosquad-labs/squad/v3/types"
+)
+
+var _ Pool = (*StableswapPool)(nil)
+
+// maxStableswapIterations is the maximum number of iterations used when
+// calculating the invariant of a stableswap pool.
+const maxStableswapIterations = 255
+
+var precisionMultiplier = new(big.Int).Exp(big.NewInt(10), big.NewInt(sdk.Precision), nil)
+
+// StableswapPool is a pool which follows the curve-style stableswap invariant
+// for two coins:
+//
+//	4A(x + y) + D = 4AD + D^3 / (4xy)
+//
+// where A is the amplification coefficient and D is the invariant.
+// The pool behaves like a constant sum pool near the balanced state(where the
+// pool price is 1) and like a constant product pool as the pool gets
+// imbalanced.
+// The higher the amplification coefficient is, the more the liquidity is
+// concentrated around the price of 1.
+type StableswapPool struct {
+	rx, ry sdk.Int
+	ps     sdk.Int
+	amp    uint64
+	d      sdk.Dec
+}
+
+// NewStableswapPool returns a new StableswapPool.
+func NewStableswapPool(rx, ry, ps sdk.Int, amp uint64) *StableswapPool {
+	return &StableswapPool{
+		rx:  rx,
+		ry:  ry,
+		ps:  ps,
+		amp: amp,
+		d:   StableswapInvariant(rx, ry, amp),
+	}
+}
+
+// CreateStableswapPool creates new StableswapPool from given inputs, while
+// validating the inputs.
+func CreateStableswapPool(rx, ry sdk.Int, amp uint64) (*StableswapPool, error) {
+	if !rx.IsPositive() || !ry.IsPositive() {
+		return nil, fmt.Errorf("cannot create stableswap pool with zero reserve amount")
+	}
+	if err := ValidateAmplification(amp); err != nil {
+		return nil, err
+	}
+	pool := NewStableswapPool(rx, ry, InitialPoolCoinSupply(rx, ry), amp)
+	p := pool.Price()
+	if p.LT(MinPoolPrice) {
+		return nil, fmt.Errorf("pool price is lower than min price %s", MinPoolPrice)
+	}
+	if p.GT(MaxPoolPrice) {
+		return nil, fmt.Errorf("pool price is greater than max price %s", MaxPoolPrice)
+	}
+	return pool, nil
+}
+
+// ValidateAmplification validates the amplification coefficient of
+// a stableswap pool.
+func ValidateAmplification(amp uint64) error {
+	if amp < MinAmplification || amp > MaxAmplification {
+		return fmt.Errorf("amplification must be in range [%d, %d]: %d", MinAmplification, MaxAmplification, amp)
+	}
+	return nil
+}
+
+// StableswapInvariant returns the invariant D of a stableswap pool.
+// D is calculated with Newton's method:
+//
+//	D' = (4A*S + 2*D_P) * D / ((4A - 1)*D + 3*D_P)
+//
+// where S = x + y and D_P = D^3 / (4xy).
+func StableswapInvariant(rx, ry sdk.Int, amp uint64) sdk.Dec {
+	s := rx.Add(ry).ToDec()
+	if rx.IsZero() || ry.IsZero() {
+		return s
+	}
+	x, y := rx.ToDec(), ry.ToDec()
+	ann := sdk.NewDec(int64(4 * amp))
+	d := s
+	for i := 0; i < maxStableswapIterations; i++ {
+		// r = D_P / D = (D / 2x) * (D / 2y)
+		r := d.Quo(x.MulInt64(2)).Mul(d.Quo(y.MulInt64(2)))
+		// D' = (4A*S + 2*r*D) / (4A - 1 + 3*r)
+		nextD := ann.Mul(s).Add(r.Mul(d).MulInt64(2)).Quo(ann.Sub(oneDec).Add(r.MulInt64(3)))
+		converged := nextD.Sub(d).Abs().LTE(oneDec)
+		d = nextD
+		if converged {
+			break
+		}
+	}
+	return d
+}
+
+// Balances returns the balances of the pool.
+func (pool *StableswapPool) Balances() (rx, ry sdk.Int) {
+	return pool.rx, pool.ry
+}
+
+// SetBalances sets StableswapPool's balances.
+// If derive is true, the invariant is recalculated from the new balances.
+func (pool *StableswapPool) SetBalances(rx, ry sdk.Int, derive bool) {
+	if derive {
+		pool.d = StableswapInvariant(rx, ry, pool.amp)
+	}
+	pool.rx = rx
+	pool.ry = ry
+}
+
+// PoolCoinSupply returns the pool coin supply.
+func (pool *StableswapPool) PoolCoinSupply() sdk.Int {
+	return pool.ps
+}
+
+// Amplification returns the amplification coefficient of the pool.
+func (pool *StableswapPool) Amplification() uint64 {
+	return pool.amp
+}
+
+// Invariant returns the invariant D of the pool.
+func (pool *StableswapPool) Invariant() sdk.Dec {
+	return pool.d
+}
+
+// Price returns the pool price, which is the marginal price of the pool
+// on the curve.
+func (pool *StableswapPool) Price() sdk.Dec {
+	if pool.rx.IsZero() || pool.ry.IsZero() {
+		panic("pool price is not defined for a depleted pool")
+	}
+	return pool.priceAt(pool.rx.ToDec().Quo(pool.d), pool.ry.ToDec().Quo(pool.d))
+}
+
+// priceAt returns the marginal price at the normalized point (x/D, y/D).
+// P = -dx/dy = (16A*x^2*y^2 + x) / (16A*x^2*y^2 + y)
+func (pool *StableswapPool) priceAt(x, y sdk.Dec) sdk.Dec {
+	xy := x.Mul(y)
+	q := xy.Mul(xy).MulInt64(int64(16 * pool.amp))
+	return q.Add(x).Quo(q.Add(y))
+}
+
+// otherReserve returns the normalized reserve amount of the other coin on
+// the curve for the normalized reserve amount of a coin.
+// Since the invariant is symmetric, it can be used for both x and y.
+// y^2 + (x + 1/4A - 1)*y - 1/(16A*x) = 0
+func (pool *StableswapPool) otherReserve(v sdk.Dec) sdk.Dec {
+	b := v.Add(oneDec.QuoInt64(int64(4 * pool.amp))).Sub(oneDec)
+	c := oneDec.Quo(v.MulInt64(int64(16 * pool.amp)))
+	disc := decSqrt(b.Mul(b).Add(c.MulInt64(4)))
+	if b.IsNegative() {
+		return disc.Sub(b).QuoInt64(2)
+	}
+	// Avoid the cancellation when b is positive.
+	return c.MulInt64(2).Quo(b.Add(disc))
+}
+
+// decSqrt returns the square root of x truncated to the decimal precision.
+// It is much faster than sdk.Dec.ApproxSqrt, which matters since the price
+// of a stableswap pool is searched iteratively.
+func decSqrt(x sdk.Dec) sdk.Dec {
+	i := new(big.Int).Mul(x.BigInt(), precisionMultiplier)
+	return sdk.NewDecFromBigIntWithPrec(i.Sqrt(i), sdk.Precision)
+}
+
+// priceAtX returns the price on the curve where the reserve amount of x coin
+// is x.
+func (pool *StableswapPool) priceAtX(x sdk.Int) sdk.Dec {
+	xNorm := x.ToDec().Quo(pool.d)
+	if !xNorm.IsPositive() {
+		return sdk.ZeroDec()
+	}
+	return pool.priceAt(xNorm, pool.otherReserve(xNorm))
+}
+
+// priceAtY returns the price on the curve where the reserve amount of y coin
+// is y.
+// It returns nil sdk.Dec when the price is too high to be represented.
+func (pool *StableswapPool) priceAtY(y sdk.Int) sdk.Dec {
+	yNorm := y.ToDec().Quo(pool.d)
+	if !yNorm.IsPositive() {
+		return sdk.Dec{}
+	}
+	return pool.priceAt(pool.otherReserve(yNorm), yNorm)
+}
+
+// reserveXAt returns the minimum reserve amount of x coin on the curve where
+// the price is not lower than given price.
+func (pool *StableswapPool) reserveXAt(price sdk.Dec) sdk.Int {
+	return searchInt(zeroInt, pool.rx, func(x sdk.Int) sdk.Dec {
+		return pool.priceAtX(x).Sub(price)
+	})
+}
+
+// reserveYAt returns the minimum reserve amount of y coin on the curve where
+// the price is not higher than given price.
+func (pool *StableswapPool) reserveYAt(price sdk.Dec) sdk.Int {
+	return searchInt(zeroInt, pool.ry, func(y sdk.Int) sdk.Dec {
+		p := pool.priceAtY(y)
+		if p.IsNil() {
+			return sdk.Dec{}
+		}
+		return price.Sub(p)
+	})
+}
+
+// searchInt returns the minimum integer v in (lo, hi] where f(v) is not
+// negative, assuming that f is increasing and f(hi) is not negative.
+// f may return nil sdk.Dec, which means negative infinity.
+// The search uses the regula falsi method with the Illinois modification,
+// and falls back to bisection when the secant can't be drawn.
+func searchInt(lo, hi sdk.Int, f func(sdk.Int) sdk.Dec) sdk.Int {
+	flo, fhi := f(lo), f(hi)
+	side := 0
+	for hi.Sub(lo).GT(sdk.OneInt()) {
+		var mid sdk.Int
+		if flo.IsNil() || fhi.IsNil() || !fhi.GT(flo) {
+			mid = lo.Add(hi).QuoRaw(2)
+		} else {
+			// mid = lo - flo * (hi - lo) / (fhi - flo)
+			mid = lo.Add(flo.Neg().MulInt(hi.Sub(lo)).Quo(fhi.Sub(flo)).TruncateInt())
+			if !mid.GT(lo) {
+				mid = lo.AddRaw(1)
+			} else if !mid.LT(hi) {
+				mid = hi.SubRaw(1)
+			}
+		}
+		fmid := f(mid)
+		if fmid.IsNil() || fmid.IsNegative() {
+			lo, flo = mid, fmid
+			if side == -1 && !fhi.IsNil() {
+				fhi = fhi.QuoInt64(2)
+			}
+			side = -1
+		} else {
+			hi, fhi = mid, fmid
+			if side == 1 && !flo.IsNil() {
+				flo = flo.QuoInt64(2)
+			}
+			side = 1
+		}
+	}
+	return hi
+}
+
+// IsDepleted returns whether the pool is depleted or not.
+func (pool *StableswapPool) IsDepleted() bool {
+	return pool.ps.IsZero() || pool.rx.IsZero() || pool.ry.IsZero()
+}
+
+// HighestBuyPrice returns the highest buy price of the pool.
+func (pool *StableswapPool) HighestBuyPrice() (price sdk.Dec, found bool) {
+	// The highest buy price is actually a bit lower than pool price,
+	// but it's not important for our matching logic.
+	return pool.Price(), true
+}
+
+// LowestSellPrice returns the lowest sell price of the pool.
+func (pool *StableswapPool) LowestSellPrice() (price sdk.Dec, found bool) {
+	// The lowest sell price is actually a bit higher than the pool price,
+	// but it's not important for our matching logic.
+	return pool.Price(), true
+}
+
+// BuyAmountOver returns the amount of buy orders for price greater than
+// or equal to given price.
+// The pool moves along the curve until its price reaches the given price,
+// and buys y coin with the x coin spent at the given price.
+func (pool *StableswapPool) BuyAmountOver(price sdk.Dec, _ bool) (amt sdk.Int) {
+	return pool.BuyAmountTo(price)
+}
+
+// SellAmountUnder returns the amount of sell orders for price less than
+// or equal to given price.
+// The pool moves along the curve until its price reaches the given price,
+// and sells the y coin at the given price.
+func (pool *StableswapPool) SellAmountUnder(price sdk.Dec, _ bool) (amt sdk.Int) {
+	return pool.SellAmountTo(price)
+}
+
+// BuyAmountTo returns the amount of buy orders of the pool for price,
+// where BuyAmountTo is used when the pool price is higher than the highest
+// price of the order book.
+func (pool *StableswapPool) BuyAmountTo(price sdk.Dec) (amt sdk.Int) {
+	origPrice := price
+	if price.LT(MinPoolPrice) {
+		price = MinPoolPrice
+	}
+	if price.GTE(pool.Price()) {
+		return zeroInt
+	}
+	dx := pool.rx.Sub(pool.reserveXAt(price))
+	if !dx.IsPositive() {
+		return zeroInt
+	}
+	utils.SafeMath(func() {
+		amt = dx.ToDec().QuoTruncate(origPrice).TruncateInt() // dy = dx / P
+		if amt.GT(MaxCoinAmount) {
+			amt = MaxCoinAmount
+		}
+	}, func() {
+		amt = MaxCoinAmount
+	})
+	return
+}
+
+// SellAmountTo returns the amount of sell orders of the pool for price,
+// where SellAmountTo is used when the pool price is lower than the lowest
+// price of the order book.
+func (pool *StableswapPool) SellAmountTo(price sdk.Dec) (amt sdk.Int) {
+	if price.GT(MaxPoolPrice) {
+		price = MaxPoolPrice
+	}
+	if price.LTE(pool.Price()) {
+		return zeroInt
+	}
+	amt = pool.ry.Sub(pool.reserveYAt(price))
+	if !amt.IsPositive() {
+		return zeroInt
+	}
+	return
+}
+
+func (pool *StableswapPool) Clone() Pool {
+	return &StableswapPool{
+		rx:  pool.rx,
+		ry:  pool.ry,
+		ps:  pool.ps,
+		amp: pool.amp,
+		d:   pool.d,
+	}
+}
@@ -0,0 +1,167 @@
+package amm_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/cosmosquad-labs/squad/v3/types"
+	"github.com/cosmosquad-labs/squad/v3/x/liquidity/amm"
+)
+
+func TestCreateStableswapPool(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		rx, ry      sdk.Int
+		amp         uint64
+		expectedErr string
+	}{
+		{
+			"happy case",
+			sdk.NewInt(1000000), sdk.NewInt(1000000), 100,
+			"",
+		},
+		{
+			"zero x amount",
+			sdk.NewInt(0), sdk.NewInt(1000000), 100,
+			"cannot create stableswap pool with zero reserve amount",
+		},
+		{
+			"zero y amount",
+			sdk.NewInt(1000000), sdk.NewInt(0), 100,
+			"cannot create stableswap pool with zero reserve amount",
+		},
+		{
+			"zero amplification",
+			sdk.NewInt(1000000), sdk.NewInt(1000000), 0,
+			"amplification must be in range [1, 10000]: 0",
+		},
+		{
+			"too large amplification",
+			sdk.NewInt(1000000), sdk.NewInt(1000000), 10001,
+			"amplification must be in range [1, 10000]: 10001",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			pool, err := amm.CreateStableswapPool(tc.rx, tc.ry, tc.amp)
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				rx, ry := pool.Balances()
+				require.True(sdk.IntEq(t, tc.rx, rx))
+				require.True(sdk.IntEq(t, tc.ry, ry))
+				require.True(sdk.IntEq(t, amm.InitialPoolCoinSupply(tc.rx, tc.ry), pool.PoolCoinSupply()))
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestStableswapInvariant(t *testing.T) {
+	// D equals to x + y when the pool is balanced.
+	d := amm.StableswapInvariant(sdk.NewInt(1000000), sdk.NewInt(1000000), 100)
+	require.True(sdk.DecEq(t, utils.ParseDec("2000000"), d))
+
+	// D gets closer to x + y as the amplification increases.
+	rx, ry := sdk.NewInt(1500000), sdk.NewInt(500000)
+	d1 := amm.StableswapInvariant(rx, ry, 1)
+	d2 := amm.StableswapInvariant(rx, ry, 100)
+	require.True(t, d1.LT(d2))
+	require.True(t, d2.LT(utils.ParseDec("2000000")))
+}
+
+func TestStableswapPool_Price(t *testing.T) {
+	for _, tc := range []struct {
+		rx, ry int64
+		amp    uint64
+		price  sdk.Dec
+	}{
+		{1000000, 1000000, 100, utils.ParseDec("1")},
+		{1500000, 500000, 100, utils.ParseDec("1.008827713145890352")},
+		{500000, 1500000, 100, utils.ParseDec("0.991249533462594576")},
+		{1500000, 500000, 1, utils.ParseDec("1.553136245051927785")},
+	} {
+		t.Run("", func(t *testing.T) {
+			pool := amm.NewStableswapPool(sdk.NewInt(tc.rx), sdk.NewInt(tc.ry), sdk.Int{}, tc.amp)
+			require.True(sdk.DecEq(t, tc.price, pool.Price()))
+		})
+	}
+}
+
+func TestStableswapPool_Concentration(t *testing.T) {
+	// A stableswap pool provides more liquidity around the price of 1 than
+	// a basic pool with the same reserves.
+	rx, ry := sdk.NewInt(1000000000), sdk.NewInt(1000000000)
+	basicPool := amm.NewBasicPool(rx, ry, sdk.Int{})
+	stableswapPool := amm.NewStableswapPool(rx, ry, sdk.Int{}, 100)
+
+	price := utils.ParseDec("0.999")
+	require.True(t, stableswapPool.BuyAmountTo(price).GT(basicPool.BuyAmountTo(price).MulRaw(10)))
+	price = utils.ParseDec("1.001")
+	require.True(t, stableswapPool.SellAmountTo(price).GT(basicPool.SellAmountTo(price).MulRaw(10)))
+}
+
+func TestStableswapPool_SwapToPrice(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+
+	for i := 0; i < 100; i++ {
+		rx := utils.RandomInt(r, sdk.NewInt(1_000000), sdk.NewInt(1000_000000))
+		ry := utils.RandomInt(r, sdk.NewInt(1_000000), sdk.NewInt(1000_000000))
+		amp := uint64(1 + r.Intn(1000))
+		pool := amm.NewStableswapPool(rx, ry, sdk.Int{}, amp)
+		poolPrice := pool.Price()
+
+		// After the pool buys to the price, the pool price reaches the price
+		// and the invariant doesn't decrease.
+		p := poolPrice.Mul(utils.RandomDec(r, utils.ParseDec("0.9"), utils.ParseDec("0.999")))
+		amt := pool.BuyAmountTo(p)
+		require.True(t, amt.IsPositive())
+		nextPool := amm.NewStableswapPool(rx.Sub(p.MulInt(amt).Ceil().TruncateInt()), ry.Add(amt), sdk.Int{}, amp)
+		require.True(t, nextPool.Price().LTE(p))
+		require.True(t, nextPool.Invariant().GTE(pool.Invariant()))
+
+		// After the pool sells to the price, the pool price reaches the price
+		// and the invariant doesn't decrease.
+		p = poolPrice.Mul(utils.RandomDec(r, utils.ParseDec("1.001"), utils.ParseDec("1.1")))
+		amt = pool.SellAmountTo(p)
+		require.True(t, amt.IsPositive())
+		nextPool = amm.NewStableswapPool(rx.Add(p.MulInt(amt).TruncateInt()), ry.Sub(amt), sdk.Int{}, amp)
+		require.True(t, nextPool.Price().GTE(p))
+		require.True(t, nextPool.Invariant().GTE(pool.Invariant()))
+	}
+}
+
+func TestStableswapPool_AmountsOutOfPrice(t *testing.T) {
+	pool := amm.NewStableswapPool(sdk.NewInt(1000000), sdk.NewInt(1000000), sdk.Int{}, 100)
+	require.True(sdk.IntEq(t, sdk.ZeroInt(), pool.BuyAmountTo(utils.ParseDec("1.0"))))
+	require.True(sdk.IntEq(t, sdk.ZeroInt(), pool.BuyAmountTo(utils.ParseDec("1.1"))))
+	require.True(sdk.IntEq(t, sdk.ZeroInt(), pool.SellAmountTo(utils.ParseDec("1.0"))))
+	require.True(sdk.IntEq(t, sdk.ZeroInt(), pool.SellAmountTo(utils.ParseDec("0.9"))))
+}
+
+func TestStableswapPoolOrders(t *testing.T) {
+	pool := amm.NewStableswapPool(sdk.NewInt(1000000000000), sdk.NewInt(950000000000), sdk.Int{}, 100)
+	poolPrice := pool.Price()
+	lowestPrice := poolPrice.Mul(sdk.NewDecWithPrec(9, 1))
+	highestPrice := poolPrice.Mul(sdk.NewDecWithPrec(11, 1))
+	orders := amm.PoolOrders(pool, amm.DefaultOrderer, lowestPrice, highestPrice, 4)
+	require.NotEmpty(t, orders)
+
+	rx, ry := pool.Balances()
+	x, y := sdk.ZeroInt(), sdk.ZeroInt()
+	for _, order := range orders {
+		switch order.GetDirection() {
+		case amm.Buy:
+			require.True(t, order.GetPrice().LTE(poolPrice))
+			x = x.Add(order.GetPrice().MulInt(order.GetAmount()).Ceil().TruncateInt())
+		case amm.Sell:
+			require.True(t, order.GetPrice().GTE(poolPrice))
+			y = y.Add(order.GetAmount())
+		}
+	}
+	require.True(t, x.LTE(rx))
+	require.True(t, y.LTE(ry))
+}
@@ -14,8 +14,21 @@ const (
 	FlagDenoms         = "denoms"
 	FlagOrderLifespan  = "order-lifespan"
 	FlagNumTicks       = "num-ticks"
+	FlagPrice          = "price"
+	FlagSwapFeeRate    = "swap-fee-rate"
+	FlagTimeInForce    = "time-in-force"
+	FlagPostOnly       = "post-only"
+	FlagAmount         = "amount"
 )
 
+func flagSetCreatePair() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagSwapFeeRate, "", "The swap fee rate of the pair, which must be one of the allowed swap fee rates; the global swap fee rate is used if not specified")
+
+	return fs
+}
+
 func flagSetPools() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 
@@ -57,3 +70,38 @@ func flagSetOrder() *flag.FlagSet {
 
 	return fs
 }
+
+func flagSetLimitOrder() *flag.FlagSet {
+	fs := flagSetOrder()
+
+	fs.String(FlagTimeInForce, "gtc", "Time-in-force option of the order (one of: gtc,ioc,fok); ioc refunds the unmatched remainder after the first batch and fok refunds the whole order if it cannot be fully matched in the first batch")
+	fs.Bool(FlagPostOnly, false, "Reject the order if it would cross the order book at placement; a post-only order is always treated as a maker order")
+
+	return fs
+}
+
+func flagSetAmendOrder() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagPrice, "", "The new order price; the order is replaced with a new order if the price is changed")
+	fs.String(FlagAmount, "", "The new open amount of the order, which must be smaller than the current open amount")
+	fs.Duration(FlagOrderLifespan, 0, "The new order lifespan from now, which must extend the order's expiration time; the order is replaced with a new order if the lifespan is changed")
+
+	return fs
+}
+
+func flagSetPositions() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagPairId, "", "The pair id")
+
+	return fs
+}
+
+func flagSetConditionalOrder() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagPrice, "", "The limit order price to be placed when the conditional order is triggered; a market order is placed if not specified")
+
+	return fs
+}
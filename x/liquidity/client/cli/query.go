@@ -37,6 +37,13 @@ func GetQueryCmd() *cobra.Command {
 		NewQueryOrdersCmd(),
 		NewQueryOrderCmd(),
 		NewQueryOrderBooksCmd(),
+		NewQueryPositionsCmd(),
+		NewQueryPositionCmd(),
+		NewQueryConditionalOrdersCmd(),
+		NewQueryConditionalOrderCmd(),
+		NewQuerySimulateRoutedSwapCmd(),
+		NewQueryCandlesCmd(),
+		NewQueryTWAPCmd(),
 	)
 
 	return cmd
@@ -709,3 +716,406 @@ $ %s query %s order-books 2,3
 
 	return cmd
 }
+
+// NewQueryPositionsCmd implements the positions query command.
+func NewQueryPositionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "positions [owner]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Query for all concentrated liquidity positions",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for all concentrated liquidity positions.
+If the owner is given, only positions owned by the owner are returned.
+
+Example:
+$ %s query %s positions
+$ %s query %s positions --pair-id=1
+$ %s query %s positions cosmos1...
+$ %s query %s positions --pair-id=1 cosmos1...
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			var pairId uint64
+			pairIdStr, _ := cmd.Flags().GetString(FlagPairId)
+			if pairIdStr != "" {
+				pairId, err = strconv.ParseUint(pairIdStr, 10, 64)
+				if err != nil {
+					return fmt.Errorf("parse pair id: %w", err)
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var res *types.QueryPositionsResponse
+			if len(args) == 0 {
+				res, err = queryClient.Positions(cmd.Context(), &types.QueryPositionsRequest{
+					PairId:     pairId,
+					Pagination: pageReq,
+				})
+			} else {
+				res, err = queryClient.PositionsByOwner(
+					cmd.Context(),
+					&types.QueryPositionsByOwnerRequest{
+						Owner:      args[0],
+						PairId:     pairId,
+						Pagination: pageReq,
+					})
+			}
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetPositions())
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewQueryPositionCmd implements the position query command.
+func NewQueryPositionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "position [position-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query details of the specific concentrated liquidity position",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query details of the specific concentrated liquidity position.
+
+Example:
+$ %s query %s position 1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			positionId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse position id: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Position(
+				cmd.Context(),
+				&types.QueryPositionRequest{
+					PositionId: positionId,
+				})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewQueryConditionalOrdersCmd implements the conditional orders query command.
+func NewQueryConditionalOrdersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "conditional-orders [orderer]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Query for all conditional orders in the pair",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for all conditional orders in the pair.
+
+Example:
+$ %s query %s conditional-orders cosmos1...
+$ %s query %s conditional-orders --pair-id=1 cosmos1...
+$ %s query %s conditional-orders --pair-id=1
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			var orderer *string
+			if len(args) > 0 {
+				orderer = &args[0]
+			}
+
+			var pairId uint64
+			pairIdStr, _ := cmd.Flags().GetString(FlagPairId)
+			if pairIdStr != "" {
+				pairId, err = strconv.ParseUint(pairIdStr, 10, 64)
+				if err != nil {
+					return fmt.Errorf("parse pair id: %w", err)
+				}
+			}
+			if orderer == nil && pairId == 0 {
+				return fmt.Errorf("either orderer or pair-id must be specified")
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var res *types.QueryConditionalOrdersResponse
+			if orderer == nil {
+				res, err = queryClient.ConditionalOrders(cmd.Context(), &types.QueryConditionalOrdersRequest{
+					PairId:     pairId,
+					Pagination: pageReq,
+				})
+			} else {
+				res, err = queryClient.ConditionalOrdersByOrderer(
+					cmd.Context(),
+					&types.QueryConditionalOrdersByOrdererRequest{
+						Orderer:    *orderer,
+						PairId:     pairId,
+						Pagination: pageReq,
+					})
+			}
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetOrders())
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewQueryConditionalOrderCmd implements the conditional order query command.
+func NewQueryConditionalOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "conditional-order [pair-id] [id]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query details of the specific conditional order",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query details of the specific conditional order.
+
+Example:
+$ %s query %s conditional-order 1 1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pairId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse pair id: %w", err)
+			}
+
+			id, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse conditional order id: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ConditionalOrder(
+				cmd.Context(),
+				&types.QueryConditionalOrderRequest{
+					PairId: pairId,
+					Id:     id,
+				})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewQuerySimulateRoutedSwapCmd implements the simulate routed swap query command.
+func NewQuerySimulateRoutedSwapCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-routed-swap [pair-ids] [offer-coin]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the expected result of a routed swap",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the expected result of a routed swap.
+The result is calculated against the current liquidity of the pools in each pair.
+
+Example:
+$ %s query %s simulate-routed-swap 1,2 1000000uatom
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var pairIds []uint64
+			for _, pairIdStr := range strings.Split(args[0], ",") {
+				pairId, err := strconv.ParseUint(pairIdStr, 10, 64)
+				if err != nil {
+					return fmt.Errorf("parse pair id: %w", err)
+				}
+				pairIds = append(pairIds, pairId)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SimulateRoutedSwap(
+				cmd.Context(),
+				&types.QuerySimulateRoutedSwapRequest{
+					PairIds:   pairIds,
+					OfferCoin: args[1],
+				})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewQueryCandlesCmd implements the candles query command.
+func NewQueryCandlesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "candles [pair-id] [interval]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query candles of the pair",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query candles of the pair aggregated from the recent trade records.
+Supported intervals are 1m, 1h and 1d.
+
+Example:
+$ %s query %s candles 1 1h
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pairId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse pair id: %w", err)
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Candles(
+				cmd.Context(),
+				&types.QueryCandlesRequest{
+					PairId:     pairId,
+					Interval:   args[1],
+					Pagination: pageReq,
+				})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewQueryTWAPCmd implements the twap query command.
+func NewQueryTWAPCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "twap [pair-id] [window]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the time-weighted average price of the pair",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the time-weighted average price of the pair during the window ending at the latest block time.
+The window must not be longer than the max twap window parameter.
+
+Example:
+$ %s query %s twap 1 1h
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pairId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse pair id: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TWAP(
+				cmd.Context(),
+				&types.QueryTWAPRequest{
+					PairId: pairId,
+					Window: args[1],
+				})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
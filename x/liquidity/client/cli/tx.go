@@ -12,6 +12,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	gov "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/cosmosquad-labs/squad/v3/x/liquidity/types"
 )
@@ -30,14 +32,23 @@ func GetTxCmd() *cobra.Command {
 		NewCreatePairCmd(),
 		NewCreatePoolCmd(),
 		NewCreateRangedPoolCmd(),
+		NewCreateStableswapPoolCmd(),
 		NewDepositCmd(),
 		NewWithdrawCmd(),
 		NewLimitOrderCmd(),
 		NewMarketOrderCmd(),
 		NewMMOrderCmd(),
 		NewCancelOrderCmd(),
+		NewAmendOrderCmd(),
 		NewCancelAllOrdersCmd(),
 		NewCancelMMOrderCmd(),
+		NewCreatePositionCmd(),
+		NewClosePositionCmd(),
+		NewDepositPositionCmd(),
+		NewWithdrawPositionCmd(),
+		NewConditionalOrderCmd(),
+		NewCancelConditionalOrderCmd(),
+		NewRoutedSwapCmd(),
 	)
 
 	return cmd
@@ -50,11 +61,14 @@ func NewCreatePairCmd() *cobra.Command {
 		Short: "Create a pair(market) for trading",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Create a pair(market) for trading.
+Optionally, the swap fee rate of the pair can be chosen from the allowed swap fee rates.
 
 Example:
 $ %s tx %s create-pair uatom stake --from mykey
+$ %s tx %s create-pair uatom stake --swap-fee-rate=0.003 --from mykey
 `,
 				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -68,10 +82,20 @@ $ %s tx %s create-pair uatom stake --from mykey
 
 			msg := types.NewMsgCreatePair(clientCtx.GetFromAddress(), baseCoinDenom, quoteCoinDenom)
 
+			swapFeeRateStr, _ := cmd.Flags().GetString(FlagSwapFeeRate)
+			if swapFeeRateStr != "" {
+				swapFeeRate, err := sdk.NewDecFromStr(swapFeeRateStr)
+				if err != nil {
+					return fmt.Errorf("invalid swap fee rate: %w", err)
+				}
+				msg.SwapFeeRate = &swapFeeRate
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().AddFlagSet(flagSetCreatePair())
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -180,6 +204,53 @@ $ %s tx %s create-ranged-pool 1 1000000000uatom,10000000000stake 1.3 2.5 1.5 --f
 	return cmd
 }
 
+func NewCreateStableswapPoolCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-stableswap-pool [pair-id] [deposit-coins] [amplification]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Create a stableswap liquidity pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a stableswap liquidity pool with coins and an amplification coefficient.
+Stableswap pools are suitable for pairs of pegged coins, which are expected to be traded around the price of 1.
+
+Example:
+$ %s tx %s create-stableswap-pool 1 1000000000ubcre,1000000000ucre 100 --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pairId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse pair id: %w", err)
+			}
+
+			depositCoins, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid deposit coins: %w", err)
+			}
+
+			amp, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse amplification: %w", err)
+			}
+
+			msg := types.NewMsgCreateStableswapPool(clientCtx.GetFromAddress(), pairId, depositCoins, amp)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func NewDepositCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "deposit [pool-id] [deposit-coins]",
@@ -279,6 +350,9 @@ $ %s tx %s limit-order 1 buy 5000stake uatom 0.5 10000 --from mykey
 $ %s tx %s limit-order 1 b 5000stake uatom 0.5 10000 --from mykey
 $ %s tx %s limit-order 1 sell 10000uatom stake 2.0 10000 --order-lifespan=10m --from mykey
 $ %s tx %s limit-order 1 s 10000uatom stake 2.0 10000 --order-lifespan=10m --from mykey
+$ %s tx %s limit-order 1 buy 5000stake uatom 0.5 10000 --time-in-force=ioc --from mykey
+$ %s tx %s limit-order 1 buy 5000stake uatom 0.5 10000 --time-in-force=fok --from mykey
+$ %s tx %s limit-order 1 buy 5000stake uatom 0.5 10000 --post-only --from mykey
 
 [pair-id]: pair id to swap with
 [direction]: order direction (one of: buy,b,sell,s)
@@ -291,6 +365,9 @@ $ %s tx %s limit-order 1 s 10000uatom stake 2.0 10000 --order-lifespan=10m --fro
 				version.AppName, types.ModuleName,
 				version.AppName, types.ModuleName,
 				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -331,6 +408,12 @@ $ %s tx %s limit-order 1 s 10000uatom stake 2.0 10000 --order-lifespan=10m --fro
 
 			orderLifespan, _ := cmd.Flags().GetDuration(FlagOrderLifespan)
 
+			timeInForceStr, _ := cmd.Flags().GetString(FlagTimeInForce)
+			timeInForce, err := parseTimeInForce(timeInForceStr)
+			if err != nil {
+				return fmt.Errorf("parse time in force: %w", err)
+			}
+
 			msg := types.NewMsgLimitOrder(
 				clientCtx.GetFromAddress(),
 				pairId,
@@ -341,12 +424,14 @@ $ %s tx %s limit-order 1 s 10000uatom stake 2.0 10000 --order-lifespan=10m --fro
 				amt,
 				orderLifespan,
 			)
+			msg.TimeInForce = timeInForce
+			msg.PostOnly, _ = cmd.Flags().GetBool(FlagPostOnly)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
-	cmd.Flags().AddFlagSet(flagSetOrder())
+	cmd.Flags().AddFlagSet(flagSetLimitOrder())
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -565,6 +650,83 @@ $ %s tx %s cancel-order 1 1 --from mykey
 	return cmd
 }
 
+func NewAmendOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "amend-order [pair-id] [order-id]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Amend a limit order",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Amend a limit order by reducing its amount, changing its price or extending its lifespan.
+At least one of the --price, --amount and --order-lifespan flags must be given.
+
+If only the amount is reduced, the order keeps its id and priority and the offer coin
+which is no longer needed is refunded immediately.
+Otherwise, the order is canceled and replaced with a new order.
+
+Example:
+$ %s tx %s amend-order 1 1 --amount=5000 --from mykey
+$ %s tx %s amend-order 1 1 --price=1.05 --order-lifespan=1h --from mykey
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pairId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			orderId, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			price := sdk.ZeroDec()
+			priceStr, _ := cmd.Flags().GetString(FlagPrice)
+			if priceStr != "" {
+				price, err = sdk.NewDecFromStr(priceStr)
+				if err != nil {
+					return fmt.Errorf("invalid price: %w", err)
+				}
+			}
+
+			amt := sdk.ZeroInt()
+			amtStr, _ := cmd.Flags().GetString(FlagAmount)
+			if amtStr != "" {
+				var ok bool
+				amt, ok = sdk.NewIntFromString(amtStr)
+				if !ok {
+					return fmt.Errorf("invalid amount: %s", amtStr)
+				}
+			}
+
+			orderLifespan, _ := cmd.Flags().GetDuration(FlagOrderLifespan)
+
+			msg := types.NewMsgAmendOrder(
+				clientCtx.GetFromAddress(),
+				pairId,
+				orderId,
+				price,
+				amt,
+				orderLifespan,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetAmendOrder())
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func NewCancelAllOrdersCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "cancel-all-orders [pair-ids]",
@@ -643,3 +805,531 @@ $ %s tx %s cancel-mm-order 1 --from mykey
 
 	return cmd
 }
+
+func NewCreatePositionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-position [pair-id] [deposit-coins] [min-price] [max-price]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Create a concentrated liquidity position",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a concentrated liquidity position with coins.
+The position provides liquidity only within the price range [min-price, max-price].
+Only needed amount of deposit coins are used, based on the pair's last price.
+
+Example:
+$ %s tx %s create-position 1 1000000000uatom,10000000000stake 0.9 1.1 --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pairId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse pair id: %w", err)
+			}
+
+			depositCoins, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid deposit coins: %w", err)
+			}
+
+			minPrice, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid min price: %w", err)
+			}
+
+			maxPrice, err := sdk.NewDecFromStr(args[3])
+			if err != nil {
+				return fmt.Errorf("invalid max price: %w", err)
+			}
+
+			msg := types.NewMsgCreatePosition(
+				clientCtx.GetFromAddress(), pairId, depositCoins, minPrice, maxPrice)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewClosePositionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "close-position [position-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Close a concentrated liquidity position",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Close a concentrated liquidity position and withdraw all coins in it.
+
+Example:
+$ %s tx %s close-position 1 --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			positionId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse position id: %w", err)
+			}
+
+			msg := types.NewMsgClosePosition(clientCtx.GetFromAddress(), positionId)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewDepositPositionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit-position [position-id] [deposit-coins]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Add liquidity to a concentrated liquidity position",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Add liquidity to a concentrated liquidity position with coins.
+Only needed amount of deposit coins are used, based on the ratio of the position's reserves.
+
+Example:
+$ %s tx %s deposit-position 1 1000000000uatom,10000000000stake --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			positionId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse position id: %w", err)
+			}
+
+			depositCoins, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid deposit coins: %w", err)
+			}
+
+			msg := types.NewMsgDepositPosition(clientCtx.GetFromAddress(), positionId, depositCoins)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewWithdrawPositionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-position [position-id] [ratio]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Withdraw a part of a concentrated liquidity position",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Withdraw a part of a concentrated liquidity position.
+The ratio must be positive and less than 1; use close-position to withdraw all coins in it.
+
+Example:
+$ %s tx %s withdraw-position 1 0.5 --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			positionId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse position id: %w", err)
+			}
+
+			ratio, err := sdk.NewDecFromStr(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid ratio: %w", err)
+			}
+
+			msg := types.NewMsgWithdrawPosition(clientCtx.GetFromAddress(), positionId, ratio)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewConditionalOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "conditional-order [pair-id] [type] [direction] [offer-coin] [demand-coin-denom] [trigger-price] [amount]",
+		Args:  cobra.ExactArgs(7),
+		Short: "Make a stop-loss or take-profit conditional order",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Make a stop-loss or take-profit conditional order.
+The offer coin is escrowed until the pair's last price reaches the trigger price.
+When triggered, a limit order is placed if --price flag is specified, otherwise a market order is placed.
+
+Example:
+$ %s tx %s conditional-order 1 stop-loss sell 10000uatom stake 1.8 10000 --order-lifespan=24h --from mykey
+$ %s tx %s conditional-order 1 sl s 10000uatom stake 1.8 10000 --price=1.75 --order-lifespan=24h --from mykey
+$ %s tx %s conditional-order 1 take-profit buy 5000stake uatom 0.4 10000 --price=0.4 --order-lifespan=24h --from mykey
+$ %s tx %s conditional-order 1 tp b 5000stake uatom 0.4 10000 --order-lifespan=24h --from mykey
+
+[pair-id]: pair id to swap with
+[type]: conditional order type (one of: stop-loss,sl,take-profit,tp)
+[direction]: order direction (one of: buy,b,sell,s)
+[offer-coin]: the amount of offer coin to swap
+[demand-coin-denom]: the denom to exchange with the offer coin
+[trigger-price]: the pair's last price at which the order is triggered
+[amount]: the amount of base coin to buy or sell
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pairId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse pair id: %w", err)
+			}
+
+			typ, err := parseConditionalOrderType(args[1])
+			if err != nil {
+				return fmt.Errorf("parse conditional order type: %w", err)
+			}
+
+			dir, err := parseOrderDirection(args[2])
+			if err != nil {
+				return fmt.Errorf("parse order direction: %w", err)
+			}
+
+			offerCoin, err := sdk.ParseCoinNormalized(args[3])
+			if err != nil {
+				return fmt.Errorf("invalid offer coin: %w", err)
+			}
+
+			demandCoinDenom := args[4]
+			if err := sdk.ValidateDenom(demandCoinDenom); err != nil {
+				return fmt.Errorf("invalid demand coin denom: %w", err)
+			}
+
+			triggerPrice, err := sdk.NewDecFromStr(args[5])
+			if err != nil {
+				return fmt.Errorf("invalid trigger price: %w", err)
+			}
+
+			amt, ok := sdk.NewIntFromString(args[6])
+			if !ok {
+				return fmt.Errorf("invalid amount: %s", args[6])
+			}
+
+			orderType := types.OrderTypeMarket
+			price := sdk.ZeroDec()
+			priceStr, _ := cmd.Flags().GetString(FlagPrice)
+			if priceStr != "" {
+				orderType = types.OrderTypeLimit
+				price, err = sdk.NewDecFromStr(priceStr)
+				if err != nil {
+					return fmt.Errorf("invalid price: %w", err)
+				}
+			}
+
+			orderLifespan, _ := cmd.Flags().GetDuration(FlagOrderLifespan)
+
+			msg := types.NewMsgConditionalOrder(
+				clientCtx.GetFromAddress(),
+				pairId,
+				typ,
+				orderType,
+				dir,
+				offerCoin,
+				demandCoinDenom,
+				triggerPrice,
+				price,
+				amt,
+				orderLifespan,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetConditionalOrder())
+	cmd.Flags().AddFlagSet(flagSetOrder())
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewCancelConditionalOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-conditional-order [pair-id] [order-id]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Cancel a conditional order",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel a conditional order and refund the escrowed offer coin.
+
+Example:
+$ %s tx %s cancel-conditional-order 1 1 --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pairId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse pair id: %w", err)
+			}
+
+			orderId, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse order id: %w", err)
+			}
+
+			msg := types.NewMsgCancelConditionalOrder(
+				clientCtx.GetFromAddress(),
+				pairId,
+				orderId,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewRoutedSwapCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "routed-swap [pair-ids] [offer-coin] [demand-coin-denom] [min-demand-amount]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Swap coins through multiple pairs",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Swap coins through multiple pairs atomically within a batch.
+The received coin from a pair is used as the offer coin for the next pair.
+The swap fails and the offer coin is refunded if the final received amount is
+smaller than the min demand amount.
+
+Example:
+$ %s tx %s routed-swap 1,2 1000000uatom uusd 990000 --from mykey
+
+[pair-ids]: comma separated pair ids which make up the swap route
+[offer-coin]: the amount of coin to swap
+[demand-coin-denom]: the denom of the coin to be received at the end of the route
+[min-demand-amount]: the minimum amount of the coin to be received
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var pairIds []uint64
+			for _, pairIdStr := range strings.Split(args[0], ",") {
+				pairId, err := strconv.ParseUint(pairIdStr, 10, 64)
+				if err != nil {
+					return fmt.Errorf("parse pair id: %w", err)
+				}
+				pairIds = append(pairIds, pairId)
+			}
+
+			offerCoin, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid offer coin: %w", err)
+			}
+
+			minDemandAmt, ok := sdk.NewIntFromString(args[3])
+			if !ok {
+				return fmt.Errorf("invalid min demand amount: %s", args[3])
+			}
+
+			msg := types.NewMsgRoutedSwap(
+				clientCtx.GetFromAddress(),
+				pairIds,
+				offerCoin,
+				args[2],
+				minDemandAmt,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdSubmitSwapFeeRateProposal implements the swap fee rate proposal command handler.
+func GetCmdSubmitSwapFeeRateProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap-fee-rate-proposal [proposal-file] [flags]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a swap fee rate proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a swap fee rate proposal along with an initial deposit. You can submit this governance proposal
+to change swap fee rates of pairs. A pair uses the global swap fee rate if its swap fee rate is omitted.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal swap-fee-rate-proposal <path/to/proposal.json> --from=<key_or_address> --deposit=<deposit_amount>
+
+Where proposal.json contains:
+
+{
+  "title": "Swap Fee Rate Proposal",
+  "description": "Change swap fee rates of stable pairs",
+  "changes": [
+    {
+      "pair_id": "1",
+      "swap_fee_rate": "0.000100000000000000"
+    },
+    {
+      "pair_id": "2"
+    }
+  ]
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ParseSwapFeeRateProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			content := types.NewSwapFeeRateProposal(proposal.Title, proposal.Description, proposal.Changes)
+
+			msg, err := gov.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
+
+func GetCmdSubmitAmplificationProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "amplification-proposal [proposal-file] [flags]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit an amplification proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit an amplification proposal along with an initial deposit. You can submit this governance proposal
+to change amplification coefficients of stableswap pools.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal amplification-proposal <path/to/proposal.json> --from=<key_or_address> --deposit=<deposit_amount>
+
+Where proposal.json contains:
+
+{
+  "title": "Amplification Proposal",
+  "description": "Increase the amplification of the bCRE/CRE pool",
+  "changes": [
+    {
+      "pool_id": "1",
+      "amplification": "200"
+    }
+  ]
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ParseAmplificationProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			content := types.NewAmplificationProposal(proposal.Title, proposal.Description, proposal.Changes)
+
+			msg, err := gov.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
@@ -2,8 +2,11 @@ package cli
 
 import (
 	"fmt"
+	"os"
 	"strings"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+
 	"github.com/cosmosquad-labs/squad/v3/x/liquidity/types"
 )
 
@@ -29,3 +32,62 @@ func parseOrderDirection(s string) (types.OrderDirection, error) {
 	}
 	return 0, fmt.Errorf("invalid order direction: %s", s)
 }
+
+// parseTimeInForce parses time-in-force string and returns
+// types.TimeInForce.
+func parseTimeInForce(s string) (types.TimeInForce, error) {
+	switch strings.ToLower(s) {
+	case "gtc", "good-til-canceled":
+		return types.TimeInForceGoodTilCanceled, nil
+	case "ioc", "immediate-or-cancel":
+		return types.TimeInForceImmediateOrCancel, nil
+	case "fok", "fill-or-kill":
+		return types.TimeInForceFillOrKill, nil
+	}
+	return 0, fmt.Errorf("invalid time in force: %s", s)
+}
+
+// parseConditionalOrderType parses conditional order type string and returns
+// types.ConditionalOrderType.
+func parseConditionalOrderType(s string) (types.ConditionalOrderType, error) {
+	switch strings.ToLower(s) {
+	case "stop-loss", "sl":
+		return types.ConditionalOrderTypeStopLoss, nil
+	case "take-profit", "tp":
+		return types.ConditionalOrderTypeTakeProfit, nil
+	}
+	return 0, fmt.Errorf("invalid conditional order type: %s", s)
+}
+
+// ParseSwapFeeRateProposal reads and parses a SwapFeeRateProposal from a file.
+func ParseSwapFeeRateProposal(cdc codec.JSONCodec, proposalFile string) (types.SwapFeeRateProposal, error) {
+	proposal := types.SwapFeeRateProposal{}
+
+	contents, err := os.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParseAmplificationProposal reads and parses an AmplificationProposal from
+// a file.
+func ParseAmplificationProposal(cdc codec.JSONCodec, proposalFile string) (types.AmplificationProposal, error) {
+	proposal := types.AmplificationProposal{}
+
+	contents, err := os.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
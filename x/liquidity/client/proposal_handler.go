@@ -0,0 +1,16 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/cosmosquad-labs/squad/v3/x/liquidity/client/cli"
+	"github.com/cosmosquad-labs/squad/v3/x/liquidity/client/rest"
+)
+
+// SwapFeeRateProposalHandler is the swap fee rate proposal command handler and
+// AmplificationProposalHandler is the amplification proposal command handler.
+// Note that rest.ProposalRESTHandler will be deprecated in the future.
+var (
+	SwapFeeRateProposalHandler   = govclient.NewProposalHandler(cli.GetCmdSubmitSwapFeeRateProposal, rest.SwapFeeRateProposalRESTHandler)
+	AmplificationProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitAmplificationProposal, rest.AmplificationProposalRESTHandler)
+)
@@ -0,0 +1,29 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+)
+
+// SwapFeeRateProposalRESTHandler returns a ProposalRESTHandler that exposes the swap fee rate proposal REST handler with a given sub-route.
+func SwapFeeRateProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "swap_fee_rate",
+		Handler:  postProposalHandlerFn(clientCtx),
+	}
+}
+
+// AmplificationProposalRESTHandler returns a ProposalRESTHandler that exposes the amplification proposal REST handler with a given sub-route.
+func AmplificationProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "amplification",
+		Handler:  postProposalHandlerFn(clientCtx),
+	}
+}
+
+func postProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+	}
+}
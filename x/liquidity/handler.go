@@ -3,6 +3,7 @@ package liquidity
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/cosmosquad-labs/squad/v3/x/liquidity/keeper"
 	"github.com/cosmosquad-labs/squad/v3/x/liquidity/types"
@@ -25,6 +26,9 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgCreateRangedPool:
 			res, err := msgServer.CreateRangedPool(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgCreateStableswapPool:
+			res, err := msgServer.CreateStableswapPool(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		case *types.MsgDeposit:
 			res, err := msgServer.Deposit(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
@@ -49,8 +53,48 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgCancelMMOrder:
 			res, err := msgServer.CancelMMOrder(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgCreatePosition:
+			res, err := msgServer.CreatePosition(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgClosePosition:
+			res, err := msgServer.ClosePosition(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgDepositPosition:
+			res, err := msgServer.DepositPosition(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgWithdrawPosition:
+			res, err := msgServer.WithdrawPosition(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgConditionalOrder:
+			res, err := msgServer.ConditionalOrder(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgCancelConditionalOrder:
+			res, err := msgServer.CancelConditionalOrder(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgRoutedSwap:
+			res, err := msgServer.RoutedSwap(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgAmendOrder:
+			res, err := msgServer.AmendOrder(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
 		}
 	}
 }
+
+// NewProposalHandler creates a governance handler to manage swap fee rates
+// of pairs and amplification coefficients of stableswap pools.
+func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.SwapFeeRateProposal:
+			return keeper.HandleSwapFeeRateProposal(ctx, k, c)
+		case *types.AmplificationProposal:
+			return keeper.HandleAmplificationProposal(ctx, k, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized liquidity proposal content type: %T", c)
+		}
+	}
+}
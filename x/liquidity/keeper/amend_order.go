@@ -0,0 +1,201 @@
+package keeper
+
+import (
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmosquad-labs/squad/v3/x/liquidity/amm"
+	"github.com/cosmosquad-labs/squad/v3/x/liquidity/types"
+)
+
+// ValidateMsgAmendOrder validates types.MsgAmendOrder with state and returns
+// the order and the amended price that is fit into ticks.
+func (k Keeper) ValidateMsgAmendOrder(ctx sdk.Context, msg *types.MsgAmendOrder) (order types.Order, price sdk.Dec, err error) {
+	var found bool
+	order, found = k.GetOrder(ctx, msg.PairId, msg.OrderId)
+	if !found {
+		return types.Order{}, sdk.Dec{},
+			sdkerrors.Wrapf(sdkerrors.ErrNotFound, "order %d not found in pair %d", msg.OrderId, msg.PairId)
+	}
+	if msg.Orderer != order.Orderer {
+		return types.Order{}, sdk.Dec{}, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "mismatching orderer")
+	}
+	if order.Type != types.OrderTypeLimit {
+		return types.Order{}, sdk.Dec{}, sdkerrors.Wrapf(types.ErrOrderNotAmendable, "%s order", order.Type)
+	}
+	if !order.Status.CanBeCanceled() {
+		return types.Order{}, sdk.Dec{}, sdkerrors.Wrapf(types.ErrOrderNotAmendable, "order status is %s", order.Status)
+	}
+
+	price = order.Price
+	if msg.AmendsPrice() {
+		tickPrec := int(k.GetTickPrecision(ctx))
+		switch order.Direction {
+		case types.OrderDirectionBuy:
+			price = amm.PriceToDownTick(msg.Price, tickPrec)
+		case types.OrderDirectionSell:
+			price = amm.PriceToUpTick(msg.Price, tickPrec)
+		}
+	}
+	if msg.AmendsAmount() && !msg.Amount.LT(order.OpenAmount) {
+		return types.Order{}, sdk.Dec{}, sdkerrors.Wrapf(
+			types.ErrOrderNotAmendable, "new amount %s must be smaller than the open amount %s", msg.Amount, order.OpenAmount)
+	}
+	if msg.AmendsLifespan() {
+		if expireAt := ctx.BlockTime().Add(msg.OrderLifespan); !expireAt.After(order.ExpireAt) {
+			return types.Order{}, sdk.Dec{}, sdkerrors.Wrapf(
+				types.ErrOrderNotAmendable, "new expiration time %s must be after %s",
+				expireAt.Format(time.RFC3339), order.ExpireAt.Format(time.RFC3339))
+		}
+	}
+	if !price.Equal(order.Price) || msg.AmendsLifespan() {
+		// Replacing the order is equivalent to cancelling it, so the same
+		// restriction applies.
+		pair, _ := k.GetPair(ctx, msg.PairId)
+		if order.BatchId == pair.CurrentBatchId {
+			return types.Order{}, sdk.Dec{}, types.ErrSameBatch
+		}
+	} else if !msg.AmendsAmount() {
+		return types.Order{}, sdk.Dec{}, sdkerrors.Wrap(types.ErrOrderNotAmendable, "nothing to amend")
+	}
+	return order, price, nil
+}
+
+// AmendOrder handles types.MsgAmendOrder and amends an order.
+// If only the amount of the order is reduced, the order is amended in place
+// and keeps its id and batch priority.
+// Otherwise, the order is canceled and replaced with a new order.
+// The resulting order is returned.
+func (k Keeper) AmendOrder(ctx sdk.Context, msg *types.MsgAmendOrder) (types.Order, error) {
+	order, price, err := k.ValidateMsgAmendOrder(ctx, msg)
+	if err != nil {
+		return types.Order{}, err
+	}
+
+	amendment := types.OrderAmendment{
+		Height:             ctx.BlockHeight(),
+		PreviousOrderId:    order.Id,
+		PreviousPrice:      order.Price,
+		PreviousOpenAmount: order.OpenAmount,
+		PreviousExpireAt:   order.ExpireAt,
+	}
+
+	openAmt := order.OpenAmount
+	if msg.AmendsAmount() {
+		openAmt = msg.Amount
+	}
+
+	var (
+		newOrder     types.Order
+		refundedCoin sdk.Coin
+	)
+	if price.Equal(order.Price) && !msg.AmendsLifespan() {
+		newOrder, refundedCoin, err = k.reduceOrderAmount(ctx, order, openAmt)
+	} else {
+		newOrder, err = k.replaceOrder(ctx, order, price, openAmt, msg.OrderLifespan)
+		refundedCoin = sdk.NewCoin(order.OfferCoin.Denom, sdk.ZeroInt())
+	}
+	if err != nil {
+		return types.Order{}, err
+	}
+
+	newOrder.Amendments = append(newOrder.Amendments, amendment)
+	k.SetOrder(ctx, newOrder)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAmendOrder,
+			sdk.NewAttribute(types.AttributeKeyOrderer, msg.Orderer),
+			sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(msg.PairId, 10)),
+			sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(msg.OrderId, 10)),
+			sdk.NewAttribute(types.AttributeKeyNewOrderId, strconv.FormatUint(newOrder.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyPrice, newOrder.Price.String()),
+			sdk.NewAttribute(types.AttributeKeyOpenAmount, newOrder.OpenAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyExpireAt, newOrder.ExpireAt.Format(time.RFC3339)),
+			sdk.NewAttribute(types.AttributeKeyBatchId, strconv.FormatUint(newOrder.BatchId, 10)),
+			sdk.NewAttribute(types.AttributeKeyRefundedCoins, refundedCoin.String()),
+		),
+	})
+
+	return newOrder, nil
+}
+
+// reduceOrderAmount reduces the open amount of the order in place and
+// refunds the offer coin which is no longer needed from the pair's escrow.
+func (k Keeper) reduceOrderAmount(ctx sdk.Context, order types.Order, openAmt sdk.Int) (types.Order, sdk.Coin, error) {
+	if types.IsTooSmallOrderAmount(openAmt, order.Price) {
+		return types.Order{}, sdk.Coin{}, types.ErrTooSmallOrder
+	}
+
+	var required sdk.Int
+	switch order.Direction {
+	case types.OrderDirectionBuy:
+		required = amm.OfferCoinAmount(amm.Buy, order.Price, openAmt)
+	case types.OrderDirectionSell:
+		required = amm.OfferCoinAmount(amm.Sell, order.Price, openAmt)
+	}
+	refundedCoin := sdk.NewCoin(order.RemainingOfferCoin.Denom, sdk.ZeroInt())
+	if order.RemainingOfferCoin.Amount.GT(required) {
+		refundedCoin.Amount = order.RemainingOfferCoin.Amount.Sub(required)
+	}
+
+	if refundedCoin.IsPositive() {
+		pair, _ := k.GetPair(ctx, order.PairId)
+		if err := k.bankKeeper.SendCoins(ctx, pair.GetEscrowAddress(), order.GetOrderer(), sdk.NewCoins(refundedCoin)); err != nil {
+			return types.Order{}, sdk.Coin{}, err
+		}
+	}
+
+	order.Amount = order.Amount.Sub(order.OpenAmount.Sub(openAmt))
+	order.OpenAmount = openAmt
+	order.OfferCoin = order.OfferCoin.Sub(refundedCoin)
+	order.RemainingOfferCoin = order.RemainingOfferCoin.Sub(refundedCoin)
+
+	return order, refundedCoin, nil
+}
+
+// replaceOrder cancels the order and places a new limit order with the
+// amended price, amount and lifespan.
+// If orderLifespan is zero, the new order expires at the same time as the
+// original order.
+func (k Keeper) replaceOrder(
+	ctx sdk.Context, order types.Order, price sdk.Dec, openAmt sdk.Int, orderLifespan time.Duration) (types.Order, error) {
+	if err := k.FinishOrder(ctx, order, types.OrderStatusCanceled); err != nil {
+		return types.Order{}, err
+	}
+
+	if orderLifespan == 0 {
+		if remaining := order.ExpireAt.Sub(ctx.BlockTime()); remaining > 0 {
+			orderLifespan = remaining
+		}
+	}
+
+	var offerCoin sdk.Coin
+	switch order.Direction {
+	case types.OrderDirectionBuy:
+		offerCoin = sdk.NewCoin(order.OfferCoin.Denom, amm.OfferCoinAmount(amm.Buy, price, openAmt))
+	case types.OrderDirectionSell:
+		offerCoin = sdk.NewCoin(order.OfferCoin.Denom, openAmt)
+	}
+
+	newOrder, err := k.LimitOrder(ctx, &types.MsgLimitOrder{
+		Orderer:         order.Orderer,
+		PairId:          order.PairId,
+		Direction:       order.Direction,
+		OfferCoin:       offerCoin,
+		DemandCoinDenom: order.ReceivedCoin.Denom,
+		Price:           price,
+		Amount:          openAmt,
+		OrderLifespan:   orderLifespan,
+		TimeInForce:     order.TimeInForce,
+		PostOnly:        order.PostOnly,
+	})
+	if err != nil {
+		return types.Order{}, err
+	}
+	newOrder.Amendments = order.Amendments
+	return newOrder, nil
+}
@@ -0,0 +1,167 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/cosmosquad-labs/squad/v3/types"
+	"github.com/cosmosquad-labs/squad/v3/x/liquidity/types"
+)
+
+func (s *KeeperTestSuite) amendOrder(
+	orderer sdk.AccAddress, pairId, orderId uint64, price sdk.Dec, amt sdk.Int, orderLifespan time.Duration) (types.Order, error) {
+	s.T().Helper()
+	msg := types.NewMsgAmendOrder(orderer, pairId, orderId, price, amt, orderLifespan)
+	s.Require().NoError(msg.ValidateBasic())
+	return s.keeper.AmendOrder(s.ctx, msg)
+}
+
+func (s *KeeperTestSuite) TestAmendOrder_ReduceAmount() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	orderer := s.addr(1)
+	order := s.buyLimitOrder(orderer, pair.Id, utils.ParseDec("1.0"), sdk.NewInt(1000000), time.Hour, true)
+	s.Require().True(s.getBalances(orderer).IsZero())
+
+	// The amount can be reduced even in the same batch, and the freed offer
+	// coin is refunded right away.
+	amended, err := s.amendOrder(orderer, pair.Id, order.Id, sdk.ZeroDec(), sdk.NewInt(400000), 0)
+	s.Require().NoError(err)
+	s.Require().Equal(order.Id, amended.Id)
+	s.Require().Equal(order.BatchId, amended.BatchId)
+	s.Require().True(intEq(sdk.NewInt(400000), amended.Amount))
+	s.Require().True(intEq(sdk.NewInt(400000), amended.OpenAmount))
+	s.Require().True(coinEq(utils.ParseCoin("400000denom2"), amended.RemainingOfferCoin))
+	s.Require().True(coinsEq(utils.ParseCoins("600000denom2"), s.getBalances(orderer)))
+
+	s.Require().Len(amended.Amendments, 1)
+	s.Require().Equal(order.Id, amended.Amendments[0].PreviousOrderId)
+	s.Require().True(intEq(sdk.NewInt(1000000), amended.Amendments[0].PreviousOpenAmount))
+
+	orders := s.keeper.GetOrdersByOrderer(s.ctx, orderer)
+	s.Require().Len(orders, 1)
+	s.Require().Len(orders[0].Amendments, 1)
+
+	s.sellLimitOrder(s.addr(2), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(1000000), time.Hour, true)
+	s.nextBlock()
+
+	s.Require().True(coinsEq(utils.ParseCoins("400000denom1,600000denom2"), s.getBalances(orderer)))
+}
+
+func (s *KeeperTestSuite) TestAmendOrder_ReducePartiallyMatched() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	orderer := s.addr(1)
+	order := s.sellLimitOrder(orderer, pair.Id, utils.ParseDec("1.0"), sdk.NewInt(1000000), time.Hour, true)
+	s.buyLimitOrder(s.addr(2), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(300000), 0, true)
+	s.nextBlock()
+
+	order, _ = s.keeper.GetOrder(s.ctx, pair.Id, order.Id)
+	s.Require().Equal(types.OrderStatusPartiallyMatched, order.Status)
+	s.Require().True(intEq(sdk.NewInt(700000), order.OpenAmount))
+
+	amended, err := s.amendOrder(orderer, pair.Id, order.Id, sdk.ZeroDec(), sdk.NewInt(200000), 0)
+	s.Require().NoError(err)
+	s.Require().True(intEq(sdk.NewInt(500000), amended.Amount))
+	s.Require().True(intEq(sdk.NewInt(200000), amended.OpenAmount))
+	s.Require().True(coinEq(utils.ParseCoin("200000denom1"), amended.RemainingOfferCoin))
+	s.Require().True(coinEq(utils.ParseCoin("500000denom1"), s.getBalance(orderer, "denom1")))
+}
+
+func (s *KeeperTestSuite) TestAmendOrder_Replace() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	orderer := s.addr(1)
+	order := s.sellLimitOrder(orderer, pair.Id, utils.ParseDec("1.1"), sdk.NewInt(1000000), time.Hour, true)
+
+	// Replacing an order in the same batch is not allowed.
+	_, err := s.amendOrder(orderer, pair.Id, order.Id, utils.ParseDec("1.2"), sdk.ZeroInt(), 0)
+	s.Require().ErrorIs(err, types.ErrSameBatch)
+
+	s.nextBlock()
+
+	amended, err := s.amendOrder(orderer, pair.Id, order.Id, utils.ParseDec("1.2"), sdk.NewInt(800000), 2*time.Hour)
+	s.Require().NoError(err)
+	s.Require().NotEqual(order.Id, amended.Id)
+	s.Require().True(decEq(utils.ParseDec("1.2"), amended.Price))
+	s.Require().True(intEq(sdk.NewInt(800000), amended.OpenAmount))
+	s.Require().Equal(s.ctx.BlockTime().Add(2*time.Hour), amended.ExpireAt)
+	s.Require().True(coinEq(utils.ParseCoin("200000denom1"), s.getBalance(orderer, "denom1")))
+
+	s.Require().Len(amended.Amendments, 1)
+	s.Require().Equal(order.Id, amended.Amendments[0].PreviousOrderId)
+	s.Require().True(decEq(utils.ParseDec("1.1"), amended.Amendments[0].PreviousPrice))
+	s.Require().Equal(order.ExpireAt, amended.Amendments[0].PreviousExpireAt)
+
+	order, _ = s.keeper.GetOrder(s.ctx, pair.Id, order.Id)
+	s.Require().Equal(types.OrderStatusCanceled, order.Status)
+	s.Require().Len(s.keeper.GetOrdersByOrderer(s.ctx, orderer), 2)
+
+	// The history is carried over when the order is amended again.
+	s.nextBlock()
+	amended2, err := s.amendOrder(orderer, pair.Id, amended.Id, sdk.ZeroDec(), sdk.NewInt(500000), 0)
+	s.Require().NoError(err)
+	s.Require().Equal(amended.Id, amended2.Id)
+	s.Require().Len(amended2.Amendments, 2)
+	s.Require().Equal(order.Id, amended2.Amendments[0].PreviousOrderId)
+	s.Require().Equal(amended.Id, amended2.Amendments[1].PreviousOrderId)
+}
+
+func (s *KeeperTestSuite) TestAmendOrder_Invalid() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	// Make the pair's last price for market orders.
+	s.buyLimitOrder(s.addr(3), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(1000000), 0, true)
+	s.sellLimitOrder(s.addr(4), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(1000000), 0, true)
+	s.nextBlock()
+
+	orderer := s.addr(1)
+	order := s.buyLimitOrder(orderer, pair.Id, utils.ParseDec("0.9"), sdk.NewInt(1000000), time.Hour, true)
+	marketOrder := s.buyMarketOrder(orderer, pair.Id, sdk.NewInt(1000000), time.Hour, true)
+	s.nextBlock()
+
+	for _, tc := range []struct {
+		name        string
+		orderer     sdk.AccAddress
+		orderId     uint64
+		price       sdk.Dec
+		amt         sdk.Int
+		lifespan    time.Duration
+		expectedErr string
+	}{
+		{
+			"wrong orderer",
+			s.addr(2), order.Id, sdk.ZeroDec(), sdk.NewInt(500000), 0,
+			"mismatching orderer: unauthorized",
+		},
+		{
+			"order not found",
+			orderer, 10, sdk.ZeroDec(), sdk.NewInt(500000), 0,
+			"order 10 not found in pair 1: not found",
+		},
+		{
+			"market order",
+			orderer, marketOrder.Id, sdk.ZeroDec(), sdk.NewInt(500000), 0,
+			"ORDER_TYPE_MARKET order: the order cannot be amended",
+		},
+		{
+			"amount not reduced",
+			orderer, order.Id, sdk.ZeroDec(), sdk.NewInt(1000000), 0,
+			"new amount 1000000 must be smaller than the open amount 1000000: the order cannot be amended",
+		},
+		{
+			"lifespan not extended",
+			orderer, order.Id, sdk.ZeroDec(), sdk.ZeroInt(), time.Minute,
+			"new expiration time 2022-01-01T00:01:10Z must be after 2022-01-01T01:00:05Z: the order cannot be amended",
+		},
+		{
+			"same price",
+			orderer, order.Id, utils.ParseDec("0.900001"), sdk.ZeroInt(), 0,
+			"nothing to amend: the order cannot be amended",
+		},
+	} {
+		_, err := s.amendOrder(tc.orderer, pair.Id, tc.orderId, tc.price, tc.amt, tc.lifespan)
+		s.Require().EqualError(err, tc.expectedErr, tc.name)
+	}
+}
@@ -7,14 +7,11 @@ import (
 )
 
 // ExecuteRequests executes all orders, deposit requests and withdraw requests.
-// ExecuteRequests also handles order expiration.
+// ExecuteRequests also handles order expiration and triggers conditional
+// orders.
+// Routed swap requests are executed within the matching of the pairs.
 func (k Keeper) ExecuteRequests(ctx sdk.Context) {
-	if err := k.IterateAllPairs(ctx, func(pair types.Pair) (stop bool, err error) {
-		if err := k.ExecuteMatching(ctx, pair); err != nil {
-			return false, err
-		}
-		return false, nil
-	}); err != nil {
+	if err := k.ExecuteMatchings(ctx); err != nil {
 		panic(err)
 	}
 	if err := k.IterateAllOrders(ctx, func(order types.Order) (stop bool, err error) {
@@ -75,4 +72,10 @@ func (k Keeper) DeleteOutdatedRequests(ctx sdk.Context) {
 		}
 		return false, nil
 	})
+	_ = k.IterateAllRoutedSwapRequests(ctx, func(req types.RoutedSwapRequest) (stop bool, err error) {
+		if req.Status.ShouldBeDeleted() {
+			k.DeleteRoutedSwapRequest(ctx, req)
+		}
+		return false, nil
+	})
 }
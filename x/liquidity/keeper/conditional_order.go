@@ -0,0 +1,300 @@
+package keeper
+
+import (
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmosquad-labs/squad/v3/x/liquidity/amm"
+	"github.com/cosmosquad-labs/squad/v3/x/liquidity/types"
+)
+
+// getNextConditionalOrderIdWithUpdate increments conditional order id by one and set it.
+func (k Keeper) getNextConditionalOrderIdWithUpdate(ctx sdk.Context) uint64 {
+	id := k.GetLastConditionalOrderId(ctx) + 1
+	k.SetLastConditionalOrderId(ctx, id)
+	return id
+}
+
+// ValidateMsgConditionalOrder validates types.MsgConditionalOrder with state and
+// returns the offer coin to be escrowed and the limit order price that is
+// fit into ticks.
+// For market orders, the returned price is zero since the order price is
+// determined when the conditional order is triggered.
+func (k Keeper) ValidateMsgConditionalOrder(ctx sdk.Context, msg *types.MsgConditionalOrder) (offerCoin sdk.Coin, price sdk.Dec, err error) {
+	spendable := k.bankKeeper.SpendableCoins(ctx, msg.GetOrderer())
+	if spendableAmt := spendable.AmountOf(msg.OfferCoin.Denom); spendableAmt.LT(msg.OfferCoin.Amount) {
+		return sdk.Coin{}, sdk.Dec{}, sdkerrors.Wrapf(
+			sdkerrors.ErrInsufficientFunds, "%s is smaller than %s",
+			sdk.NewCoin(msg.OfferCoin.Denom, spendableAmt), msg.OfferCoin)
+	}
+
+	tickPrec := int(k.GetTickPrecision(ctx))
+	maxOrderLifespan := k.GetMaxOrderLifespan(ctx)
+
+	if msg.OrderLifespan > maxOrderLifespan {
+		return sdk.Coin{}, sdk.Dec{},
+			sdkerrors.Wrapf(types.ErrTooLongOrderLifespan, "%s is longer than %s", msg.OrderLifespan, maxOrderLifespan)
+	}
+
+	pair, found := k.GetPair(ctx, msg.PairId)
+	if !found {
+		return sdk.Coin{}, sdk.Dec{}, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pair %d not found", msg.PairId)
+	}
+	if pair.LastPrice == nil {
+		return sdk.Coin{}, sdk.Dec{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "pair %d has no last price", pair.Id)
+	}
+
+	switch msg.Direction {
+	case types.OrderDirectionBuy:
+		if msg.OfferCoin.Denom != pair.QuoteCoinDenom || msg.DemandCoinDenom != pair.BaseCoinDenom {
+			return sdk.Coin{}, sdk.Dec{},
+				sdkerrors.Wrapf(types.ErrWrongPair, "denom pair (%s, %s) != (%s, %s)",
+					msg.DemandCoinDenom, msg.OfferCoin.Denom, pair.BaseCoinDenom, pair.QuoteCoinDenom)
+		}
+	case types.OrderDirectionSell:
+		if msg.OfferCoin.Denom != pair.BaseCoinDenom || msg.DemandCoinDenom != pair.QuoteCoinDenom {
+			return sdk.Coin{}, sdk.Dec{},
+				sdkerrors.Wrapf(types.ErrWrongPair, "denom pair (%s, %s) != (%s, %s)",
+					msg.OfferCoin.Denom, msg.DemandCoinDenom, pair.BaseCoinDenom, pair.QuoteCoinDenom)
+		}
+	}
+
+	lowestTick, highestTick := amm.LowestTick(tickPrec), amm.HighestTick(tickPrec)
+	switch {
+	case msg.TriggerPrice.GT(highestTick):
+		return sdk.Coin{}, sdk.Dec{}, sdkerrors.Wrapf(types.ErrPriceOutOfRange, "%s is higher than %s", msg.TriggerPrice, highestTick)
+	case msg.TriggerPrice.LT(lowestTick):
+		return sdk.Coin{}, sdk.Dec{}, sdkerrors.Wrapf(types.ErrPriceOutOfRange, "%s is lower than %s", msg.TriggerPrice, lowestTick)
+	}
+
+	order := types.ConditionalOrder{Type: msg.ConditionalOrderType, Direction: msg.Direction, TriggerPrice: msg.TriggerPrice}
+	if order.IsTriggered(*pair.LastPrice) {
+		return sdk.Coin{}, sdk.Dec{}, sdkerrors.Wrapf(
+			types.ErrTriggerPriceReached, "trigger price %s, last price %s", msg.TriggerPrice, pair.LastPrice)
+	}
+
+	switch msg.OrderType {
+	case types.OrderTypeLimit:
+		switch {
+		case msg.Price.GT(highestTick):
+			return sdk.Coin{}, sdk.Dec{}, sdkerrors.Wrapf(types.ErrPriceOutOfRange, "%s is higher than %s", msg.Price, highestTick)
+		case msg.Price.LT(lowestTick):
+			return sdk.Coin{}, sdk.Dec{}, sdkerrors.Wrapf(types.ErrPriceOutOfRange, "%s is lower than %s", msg.Price, lowestTick)
+		}
+		switch msg.Direction {
+		case types.OrderDirectionBuy:
+			price = amm.PriceToDownTick(msg.Price, tickPrec)
+			offerCoin = sdk.NewCoin(msg.OfferCoin.Denom, amm.OfferCoinAmount(amm.Buy, price, msg.Amount))
+		case types.OrderDirectionSell:
+			price = amm.PriceToUpTick(msg.Price, tickPrec)
+			offerCoin = sdk.NewCoin(msg.OfferCoin.Denom, msg.Amount)
+		}
+		if msg.OfferCoin.IsLT(offerCoin) {
+			return sdk.Coin{}, sdk.Dec{}, sdkerrors.Wrapf(
+				types.ErrInsufficientOfferCoin, "%s is smaller than %s", msg.OfferCoin, offerCoin)
+		}
+		if types.IsTooSmallOrderAmount(msg.Amount, price) {
+			return sdk.Coin{}, sdk.Dec{}, types.ErrTooSmallOrder
+		}
+	case types.OrderTypeMarket:
+		price = sdk.ZeroDec()
+		switch msg.Direction {
+		case types.OrderDirectionBuy:
+			// The whole offer coin is escrowed since the order price is not
+			// known until the conditional order is triggered.
+			offerCoin = msg.OfferCoin
+		case types.OrderDirectionSell:
+			offerCoin = sdk.NewCoin(msg.OfferCoin.Denom, msg.Amount)
+			if msg.OfferCoin.Amount.LT(msg.Amount) {
+				return sdk.Coin{}, sdk.Dec{}, sdkerrors.Wrapf(
+					types.ErrInsufficientOfferCoin, "%s is smaller than %s", msg.OfferCoin, offerCoin)
+			}
+		}
+		if types.IsTooSmallOrderAmount(msg.Amount, msg.TriggerPrice) {
+			return sdk.Coin{}, sdk.Dec{}, types.ErrTooSmallOrder
+		}
+	}
+
+	return offerCoin, price, nil
+}
+
+// ConditionalOrder handles types.MsgConditionalOrder and stores
+// types.ConditionalOrder.
+func (k Keeper) ConditionalOrder(ctx sdk.Context, msg *types.MsgConditionalOrder) (types.ConditionalOrder, error) {
+	offerCoin, price, err := k.ValidateMsgConditionalOrder(ctx, msg)
+	if err != nil {
+		return types.ConditionalOrder{}, err
+	}
+
+	refundedCoin := msg.OfferCoin.Sub(offerCoin)
+	pair, _ := k.GetPair(ctx, msg.PairId)
+	if err := k.bankKeeper.SendCoins(ctx, msg.GetOrderer(), pair.GetEscrowAddress(), sdk.NewCoins(offerCoin)); err != nil {
+		return types.ConditionalOrder{}, err
+	}
+
+	orderId := k.getNextConditionalOrderIdWithUpdate(ctx)
+	expireAt := ctx.BlockTime().Add(msg.OrderLifespan)
+	order := types.NewConditionalOrder(msg, orderId, offerCoin, price, expireAt, ctx.BlockHeight())
+	k.SetConditionalOrder(ctx, order)
+	k.SetConditionalOrderIndex(ctx, order)
+
+	ctx.GasMeter().ConsumeGas(k.GetOrderExtraGas(ctx), "OrderExtraGas")
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeConditionalOrder,
+			sdk.NewAttribute(types.AttributeKeyOrderer, msg.Orderer),
+			sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(msg.PairId, 10)),
+			sdk.NewAttribute(types.AttributeKeyConditionalType, msg.ConditionalOrderType.String()),
+			sdk.NewAttribute(types.AttributeKeyOrderType, msg.OrderType.String()),
+			sdk.NewAttribute(types.AttributeKeyOrderDirection, msg.Direction.String()),
+			sdk.NewAttribute(types.AttributeKeyOfferCoin, offerCoin.String()),
+			sdk.NewAttribute(types.AttributeKeyDemandCoinDenom, msg.DemandCoinDenom),
+			sdk.NewAttribute(types.AttributeKeyTriggerPrice, msg.TriggerPrice.String()),
+			sdk.NewAttribute(types.AttributeKeyPrice, price.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyConditionalOrderId, strconv.FormatUint(order.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyExpireAt, order.ExpireAt.Format(time.RFC3339)),
+			sdk.NewAttribute(types.AttributeKeyRefundedCoins, refundedCoin.String()),
+		),
+	})
+
+	return order, nil
+}
+
+// CancelConditionalOrder handles types.MsgCancelConditionalOrder and cancels
+// a conditional order, refunding the escrowed offer coin.
+func (k Keeper) CancelConditionalOrder(ctx sdk.Context, msg *types.MsgCancelConditionalOrder) error {
+	order, found := k.GetConditionalOrder(ctx, msg.PairId, msg.OrderId)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "conditional order %d not found in pair %d", msg.OrderId, msg.PairId)
+	}
+	if msg.Orderer != order.Orderer {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "mismatching orderer")
+	}
+
+	if err := k.refundConditionalOrder(ctx, order); err != nil {
+		return err
+	}
+	k.DeleteConditionalOrder(ctx, order)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelConditionalOrder,
+			sdk.NewAttribute(types.AttributeKeyOrderer, msg.Orderer),
+			sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(msg.PairId, 10)),
+			sdk.NewAttribute(types.AttributeKeyConditionalOrderId, strconv.FormatUint(msg.OrderId, 10)),
+			sdk.NewAttribute(types.AttributeKeyRefundedCoins, order.OfferCoin.String()),
+		),
+	})
+
+	return nil
+}
+
+// refundConditionalOrder sends the escrowed offer coin of the conditional
+// order back to the orderer.
+func (k Keeper) refundConditionalOrder(ctx sdk.Context, order types.ConditionalOrder) error {
+	pair, _ := k.GetPair(ctx, order.PairId)
+	return k.bankKeeper.SendCoins(ctx, pair.GetEscrowAddress(), order.GetOrderer(), sdk.NewCoins(order.OfferCoin))
+}
+
+// TriggerConditionalOrders turns conditional orders within the pair into
+// orders when the pair's last price reaches their trigger prices.
+// Expired conditional orders are deleted and their offer coins are refunded.
+// TriggerConditionalOrders should be called after the pair's matching, so
+// that orders are made with the updated last price and matched in the next
+// batch.
+func (k Keeper) TriggerConditionalOrders(ctx sdk.Context, pair types.Pair) error {
+	return k.IterateConditionalOrdersByPair(ctx, pair.Id, func(order types.ConditionalOrder) (stop bool, err error) {
+		if order.ExpiredAt(ctx.BlockTime()) {
+			if err := k.refundConditionalOrder(ctx, order); err != nil {
+				return false, err
+			}
+			k.DeleteConditionalOrder(ctx, order)
+
+			ctx.EventManager().EmitEvents(sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeConditionalOrderExpired,
+					sdk.NewAttribute(types.AttributeKeyOrderer, order.Orderer),
+					sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(order.PairId, 10)),
+					sdk.NewAttribute(types.AttributeKeyConditionalOrderId, strconv.FormatUint(order.Id, 10)),
+					sdk.NewAttribute(types.AttributeKeyRefundedCoins, order.OfferCoin.String()),
+				),
+			})
+			return false, nil
+		}
+
+		if pair.LastPrice == nil || !order.IsTriggered(*pair.LastPrice) {
+			return false, nil
+		}
+		newOrder, err := k.triggerConditionalOrder(ctx, pair, order)
+		if err != nil {
+			return false, err
+		}
+		pair.LastOrderId = newOrder.Id
+		return false, nil
+	})
+}
+
+// triggerConditionalOrder makes an order from the conditional order and
+// deletes the conditional order.
+func (k Keeper) triggerConditionalOrder(ctx sdk.Context, pair types.Pair, order types.ConditionalOrder) (types.Order, error) {
+	var (
+		offerCoin sdk.Coin
+		price     sdk.Dec
+	)
+	switch order.OrderType {
+	case types.OrderTypeLimit:
+		offerCoin, price = order.OfferCoin, order.Price
+	case types.OrderTypeMarket:
+		tickPrec := int(k.GetTickPrecision(ctx))
+		maxPriceLimitRatio := k.GetMaxPriceLimitRatio(ctx)
+		switch order.Direction {
+		case types.OrderDirectionBuy:
+			price = amm.PriceToDownTick(pair.LastPrice.Mul(sdk.OneDec().Add(maxPriceLimitRatio)), tickPrec)
+			// Lower the price if the escrowed offer coin isn't enough to buy
+			// the amount at the price.
+			if maxPrice := amm.PriceToDownTick(order.OfferCoin.Amount.ToDec().QuoInt(order.Amount), tickPrec); maxPrice.LT(price) {
+				price = maxPrice
+			}
+			offerCoin = sdk.NewCoin(order.OfferCoin.Denom, amm.OfferCoinAmount(amm.Buy, price, order.Amount))
+		case types.OrderDirectionSell:
+			price = amm.PriceToUpTick(pair.LastPrice.Mul(sdk.OneDec().Sub(maxPriceLimitRatio)), tickPrec)
+			offerCoin = order.OfferCoin
+		}
+	}
+
+	refundedCoin := order.OfferCoin.Sub(offerCoin)
+	if refundedCoin.IsPositive() {
+		if err := k.bankKeeper.SendCoins(ctx, pair.GetEscrowAddress(), order.GetOrderer(), sdk.NewCoins(refundedCoin)); err != nil {
+			return types.Order{}, err
+		}
+	}
+
+	orderId := k.getNextOrderIdWithUpdate(ctx, pair)
+	newOrder := types.NewOrder(
+		order.OrderType, orderId, pair, order.GetOrderer(), offerCoin, price, order.Amount, order.ExpireAt, ctx.BlockHeight())
+	k.SetOrder(ctx, newOrder)
+	k.SetOrderIndex(ctx, newOrder)
+	k.DeleteConditionalOrder(ctx, order)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeConditionalOrderTriggered,
+			sdk.NewAttribute(types.AttributeKeyOrderer, order.Orderer),
+			sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(order.PairId, 10)),
+			sdk.NewAttribute(types.AttributeKeyConditionalOrderId, strconv.FormatUint(order.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(newOrder.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyOrderType, newOrder.Type.String()),
+			sdk.NewAttribute(types.AttributeKeyOfferCoin, offerCoin.String()),
+			sdk.NewAttribute(types.AttributeKeyPrice, price.String()),
+			sdk.NewAttribute(types.AttributeKeyBatchId, strconv.FormatUint(newOrder.BatchId, 10)),
+			sdk.NewAttribute(types.AttributeKeyRefundedCoins, refundedCoin.String()),
+		),
+	})
+
+	return newOrder, nil
+}
@@ -0,0 +1,241 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/cosmosquad-labs/squad/v3/types"
+	"github.com/cosmosquad-labs/squad/v3/x/liquidity/types"
+)
+
+func (s *KeeperTestSuite) TestConditionalOrder() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pair.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair)
+
+	// A pair without last price.
+	pair2 := s.createPair(s.addr(0), "denom2", "denom3", true)
+
+	orderer := s.addr(1)
+	s.fundAddr(orderer, utils.ParseCoins("1000000000denom1,1000000000denom2,1000000000denom3"))
+
+	for _, tc := range []struct {
+		name        string
+		msg         *types.MsgConditionalOrder
+		expectedErr string
+	}{
+		{
+			"happy case",
+			types.NewMsgConditionalOrder(
+				orderer, pair.Id, types.ConditionalOrderTypeStopLoss, types.OrderTypeMarket, types.OrderDirectionSell,
+				utils.ParseCoin("1000000denom1"), "denom2", utils.ParseDec("0.9"), sdk.ZeroDec(), sdk.NewInt(1000000), time.Hour),
+			"",
+		},
+		{
+			"happy case 2",
+			types.NewMsgConditionalOrder(
+				orderer, pair.Id, types.ConditionalOrderTypeTakeProfit, types.OrderTypeLimit, types.OrderDirectionBuy,
+				utils.ParseCoin("1000000denom2"), "denom1", utils.ParseDec("0.9"), utils.ParseDec("0.9"), sdk.NewInt(1000000), time.Hour),
+			"",
+		},
+		{
+			"pair not found",
+			types.NewMsgConditionalOrder(
+				orderer, 3, types.ConditionalOrderTypeStopLoss, types.OrderTypeMarket, types.OrderDirectionSell,
+				utils.ParseCoin("1000000denom1"), "denom2", utils.ParseDec("0.9"), sdk.ZeroDec(), sdk.NewInt(1000000), time.Hour),
+			"pair 3 not found: not found",
+		},
+		{
+			"no last price",
+			types.NewMsgConditionalOrder(
+				orderer, pair2.Id, types.ConditionalOrderTypeStopLoss, types.OrderTypeMarket, types.OrderDirectionSell,
+				utils.ParseCoin("1000000denom2"), "denom3", utils.ParseDec("0.9"), sdk.ZeroDec(), sdk.NewInt(1000000), time.Hour),
+			"pair 2 has no last price: invalid request",
+		},
+		{
+			"wrong denom pair",
+			types.NewMsgConditionalOrder(
+				orderer, pair.Id, types.ConditionalOrderTypeStopLoss, types.OrderTypeMarket, types.OrderDirectionSell,
+				utils.ParseCoin("1000000denom3"), "denom2", utils.ParseDec("0.9"), sdk.ZeroDec(), sdk.NewInt(1000000), time.Hour),
+			"denom pair (denom3, denom2) != (denom1, denom2): wrong denom pair",
+		},
+		{
+			"trigger price already reached",
+			types.NewMsgConditionalOrder(
+				orderer, pair.Id, types.ConditionalOrderTypeStopLoss, types.OrderTypeMarket, types.OrderDirectionSell,
+				utils.ParseCoin("1000000denom1"), "denom2", utils.ParseDec("1.1"), sdk.ZeroDec(), sdk.NewInt(1000000), time.Hour),
+			"trigger price 1.100000000000000000, last price 1.000000000000000000: the trigger price is already reached",
+		},
+		{
+			"too long order lifespan",
+			types.NewMsgConditionalOrder(
+				orderer, pair.Id, types.ConditionalOrderTypeStopLoss, types.OrderTypeMarket, types.OrderDirectionSell,
+				utils.ParseCoin("1000000denom1"), "denom2", utils.ParseDec("0.9"), sdk.ZeroDec(), sdk.NewInt(1000000), 48*time.Hour),
+			"48h0m0s is longer than 24h0m0s: order lifespan is too long",
+		},
+		{
+			"insufficient funds",
+			types.NewMsgConditionalOrder(
+				orderer, pair.Id, types.ConditionalOrderTypeStopLoss, types.OrderTypeMarket, types.OrderDirectionSell,
+				utils.ParseCoin("10000000000denom1"), "denom2", utils.ParseDec("0.9"), sdk.ZeroDec(), sdk.NewInt(1000000), time.Hour),
+			"999000000denom1 is smaller than 10000000000denom1: insufficient funds",
+		},
+	} {
+		s.Run(tc.name, func() {
+			s.Require().NoError(tc.msg.ValidateBasic())
+			order, err := s.keeper.ConditionalOrder(s.ctx, tc.msg)
+			if tc.expectedErr == "" {
+				s.Require().NoError(err)
+				order2, found := s.keeper.GetConditionalOrder(s.ctx, order.PairId, order.Id)
+				s.Require().True(found)
+				s.Require().Equal(order, order2)
+			} else {
+				s.Require().EqualError(err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestConditionalOrderEscrow() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pair.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair)
+
+	// The excess offer coin of a limit order is not escrowed.
+	orderer := s.addr(1)
+	order := s.conditionalOrder(
+		orderer, pair.Id, types.ConditionalOrderTypeTakeProfit, types.OrderTypeLimit, types.OrderDirectionBuy,
+		utils.ParseCoin("1000000denom2"), utils.ParseDec("0.9"), utils.ParseDec("0.9"), sdk.NewInt(1000000), time.Hour, true)
+	s.Require().True(coinEq(utils.ParseCoin("900000denom2"), order.OfferCoin))
+	s.Require().True(coinsEq(utils.ParseCoins("100000denom2"), s.getBalances(orderer)))
+	s.Require().True(coinsEq(utils.ParseCoins("900000denom2"), s.getBalances(pair.GetEscrowAddress())))
+
+	// The whole offer coin of a market buy order is escrowed.
+	orderer2 := s.addr(2)
+	order2 := s.conditionalOrder(
+		orderer2, pair.Id, types.ConditionalOrderTypeStopLoss, types.OrderTypeMarket, types.OrderDirectionBuy,
+		utils.ParseCoin("1200000denom2"), utils.ParseDec("1.1"), sdk.ZeroDec(), sdk.NewInt(1000000), time.Hour, true)
+	s.Require().True(coinEq(utils.ParseCoin("1200000denom2"), order2.OfferCoin))
+	s.Require().True(s.getBalances(orderer2).IsZero())
+
+	s.Require().Len(s.keeper.GetConditionalOrdersByPair(s.ctx, pair.Id), 2)
+	s.Require().Len(s.keeper.GetConditionalOrdersByOrderer(s.ctx, orderer), 1)
+	s.Require().Equal(uint64(2), s.keeper.GetLastConditionalOrderId(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestCancelConditionalOrder() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pair.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair)
+
+	orderer := s.addr(1)
+	order := s.conditionalOrder(
+		orderer, pair.Id, types.ConditionalOrderTypeStopLoss, types.OrderTypeMarket, types.OrderDirectionSell,
+		utils.ParseCoin("1000000denom1"), utils.ParseDec("0.9"), sdk.ZeroDec(), sdk.NewInt(1000000), time.Hour, true)
+	s.Require().True(s.getBalances(orderer).IsZero())
+
+	err := s.keeper.CancelConditionalOrder(s.ctx, types.NewMsgCancelConditionalOrder(s.addr(2), pair.Id, order.Id))
+	s.Require().EqualError(err, "mismatching orderer: unauthorized")
+
+	err = s.keeper.CancelConditionalOrder(s.ctx, types.NewMsgCancelConditionalOrder(orderer, pair.Id, 10))
+	s.Require().EqualError(err, "conditional order 10 not found in pair 1: not found")
+
+	s.cancelConditionalOrder(orderer, pair.Id, order.Id)
+	s.Require().True(coinsEq(utils.ParseCoins("1000000denom1"), s.getBalances(orderer)))
+	s.Require().True(s.getBalances(pair.GetEscrowAddress()).IsZero())
+
+	_, found := s.keeper.GetConditionalOrder(s.ctx, pair.Id, order.Id)
+	s.Require().False(found)
+	s.Require().Empty(s.keeper.GetConditionalOrdersByOrderer(s.ctx, orderer))
+}
+
+func (s *KeeperTestSuite) TestStopLossOrderTriggered() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pair.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair)
+	s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000000denom1,1000000000denom2"), true)
+
+	orderer := s.addr(1)
+	order := s.conditionalOrder(
+		orderer, pair.Id, types.ConditionalOrderTypeStopLoss, types.OrderTypeMarket, types.OrderDirectionSell,
+		utils.ParseCoin("1000000denom1"), utils.ParseDec("0.95"), sdk.ZeroDec(), sdk.NewInt(1000000), time.Hour, true)
+
+	// The price doesn't reach the trigger price.
+	s.sellLimitOrder(s.addr(2), pair.Id, utils.ParseDec("0.97"), sdk.NewInt(1000000), 0, true)
+	s.nextBlock()
+	_, found := s.keeper.GetConditionalOrder(s.ctx, pair.Id, order.Id)
+	s.Require().True(found)
+
+	// The price goes down below the trigger price.
+	s.sellMarketOrder(s.addr(2), pair.Id, sdk.NewInt(100000000), 0, true)
+	s.nextBlock()
+	pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+	s.Require().True(pair.LastPrice.LTE(utils.ParseDec("0.95")))
+
+	_, found = s.keeper.GetConditionalOrder(s.ctx, pair.Id, order.Id)
+	s.Require().False(found)
+	orders := s.keeper.GetOrdersByOrderer(s.ctx, orderer)
+	s.Require().Len(orders, 1)
+	s.Require().Equal(types.OrderTypeMarket, orders[0].Type)
+	s.Require().True(coinEq(utils.ParseCoin("1000000denom1"), orders[0].OfferCoin))
+	s.Require().Equal(pair.LastOrderId, orders[0].Id)
+
+	// The triggered order is matched in the next batch.
+	s.nextBlock()
+	s.Require().True(s.getBalance(orderer, "denom1").IsZero())
+	s.Require().True(s.getBalance(orderer, "denom2").IsPositive())
+}
+
+func (s *KeeperTestSuite) TestTakeProfitOrderTriggered() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pair.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair)
+	s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000000denom1,1000000000denom2"), true)
+
+	orderer := s.addr(1)
+	order := s.conditionalOrder(
+		orderer, pair.Id, types.ConditionalOrderTypeTakeProfit, types.OrderTypeLimit, types.OrderDirectionSell,
+		utils.ParseCoin("1000000denom1"), utils.ParseDec("1.05"), utils.ParseDec("1.04"), sdk.NewInt(1000000), time.Hour, true)
+
+	// The price goes up above the trigger price.
+	s.buyMarketOrder(s.addr(2), pair.Id, sdk.NewInt(100000000), 0, true)
+	s.nextBlock()
+	pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+	s.Require().True(pair.LastPrice.GTE(utils.ParseDec("1.05")))
+
+	_, found := s.keeper.GetConditionalOrder(s.ctx, pair.Id, order.Id)
+	s.Require().False(found)
+	orders := s.keeper.GetOrdersByOrderer(s.ctx, orderer)
+	s.Require().Len(orders, 1)
+	s.Require().Equal(types.OrderTypeLimit, orders[0].Type)
+	s.Require().True(decEq(utils.ParseDec("1.04"), orders[0].Price))
+	s.Require().Equal(order.ExpireAt, orders[0].ExpireAt)
+
+	s.nextBlock()
+	s.Require().True(s.getBalance(orderer, "denom1").IsZero())
+	s.Require().True(s.getBalance(orderer, "denom2").Amount.GTE(sdk.NewInt(1040000)))
+}
+
+func (s *KeeperTestSuite) TestConditionalOrderExpired() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pair.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair)
+
+	orderer := s.addr(1)
+	order := s.conditionalOrder(
+		orderer, pair.Id, types.ConditionalOrderTypeStopLoss, types.OrderTypeMarket, types.OrderDirectionSell,
+		utils.ParseCoin("1000000denom1"), utils.ParseDec("0.9"), sdk.ZeroDec(), sdk.NewInt(1000000), 10*time.Second, true)
+
+	s.nextBlock()
+	_, found := s.keeper.GetConditionalOrder(s.ctx, pair.Id, order.Id)
+	s.Require().True(found)
+	s.Require().True(s.getBalances(orderer).IsZero())
+
+	s.nextBlock()
+	s.nextBlock()
+	_, found = s.keeper.GetConditionalOrder(s.ctx, pair.Id, order.Id)
+	s.Require().False(found)
+	s.Require().True(coinsEq(utils.ParseCoins("1000000denom1"), s.getBalances(orderer)))
+	s.Require().Empty(s.keeper.GetOrdersByOrderer(s.ctx, orderer))
+}
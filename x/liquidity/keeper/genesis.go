@@ -41,6 +41,28 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	for _, index := range genState.MarketMakingOrderIndexes {
 		k.SetMMOrderIndex(ctx, index)
 	}
+	k.SetLastPositionId(ctx, genState.LastPositionId)
+	for _, position := range genState.Positions {
+		k.SetPosition(ctx, position)
+		k.SetPositionIndex(ctx, position)
+		k.SetPositionsByPairIndex(ctx, position)
+		k.SetNumPositionsByPair(ctx, position.PairId, k.GetNumPositionsByPair(ctx, position.PairId)+1)
+	}
+	k.SetLastConditionalOrderId(ctx, genState.LastConditionalOrderId)
+	for _, order := range genState.ConditionalOrders {
+		k.SetConditionalOrder(ctx, order)
+		k.SetConditionalOrderIndex(ctx, order)
+	}
+	k.SetLastRoutedSwapRequestId(ctx, genState.LastRoutedSwapRequestId)
+	for _, req := range genState.RoutedSwapRequests {
+		k.SetRoutedSwapRequest(ctx, req)
+	}
+	for _, record := range genState.TradeRecords {
+		k.SetTradeRecord(ctx, record)
+	}
+	for _, acc := range genState.PriceAccumulators {
+		k.SetPriceAccumulator(ctx, acc)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -55,5 +77,13 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		WithdrawRequests:         k.GetAllWithdrawRequests(ctx),
 		Orders:                   k.GetAllOrders(ctx),
 		MarketMakingOrderIndexes: k.GetAllMMOrderIndexes(ctx),
+		LastPositionId:           k.GetLastPositionId(ctx),
+		Positions:                k.GetAllPositions(ctx),
+		LastConditionalOrderId:   k.GetLastConditionalOrderId(ctx),
+		ConditionalOrders:        k.GetAllConditionalOrders(ctx),
+		LastRoutedSwapRequestId:  k.GetLastRoutedSwapRequestId(ctx),
+		RoutedSwapRequests:       k.GetAllRoutedSwapRequests(ctx),
+		TradeRecords:             k.GetAllTradeRecords(ctx),
+		PriceAccumulators:        k.GetAllPriceAccumulators(ctx),
 	}
 }
@@ -33,11 +33,23 @@ func (s *KeeperTestSuite) TestImportExportGenesis() {
 	s.nextBlock()
 
 	s.buyLimitOrder(s.addr(2), pair.Id, utils.ParseDec("1.0"), newInt(10000), 0, true)
+	s.sellLimitOrder(s.addr(2), pair.Id, utils.ParseDec("1.0"), newInt(10000), 0, true)
 	s.nextBlock()
 
 	depositReq := s.deposit(s.addr(3), pool.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
 	withdrawReq := s.withdraw(s.addr(1), pool.Id, poolCoin)
 	order := s.sellLimitOrder(s.addr(3), pair.Id, utils.ParseDec("1.0"), newInt(1000), 0, true)
+	pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+	pair.LastPrice = utils.ParseDecP("1.0") // manually set last price
+	s.keeper.SetPair(s.ctx, pair)
+	position := s.createPosition(
+		s.addr(4), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"),
+		utils.ParseDec("0.9"), utils.ParseDec("1.1"), true)
+	conditionalOrder := s.conditionalOrder(
+		s.addr(5), pair.Id, types.ConditionalOrderTypeStopLoss, types.OrderTypeMarket, types.OrderDirectionSell,
+		utils.ParseCoin("1000000denom1"), utils.ParseDec("0.9"), sdk.ZeroDec(), newInt(1000000), time.Hour, true)
+	routedSwapReq := s.routedSwap(
+		s.addr(6), []uint64{pair.Id}, utils.ParseCoin("1000000denom1"), "denom2", newInt(900000), true)
 
 	genState := s.keeper.ExportGenesis(s.ctx)
 
@@ -62,6 +74,22 @@ func (s *KeeperTestSuite) TestImportExportGenesis() {
 	order2, found := s.keeper.GetOrder(s.ctx, order.PairId, order.Id)
 	s.Require().True(found)
 	s.Require().Equal(order, order2)
+	position2, found := s.keeper.GetPosition(s.ctx, position.Id)
+	s.Require().True(found)
+	s.Require().Equal(position, position2)
+	s.Require().Len(s.keeper.GetPositionsByOwner(s.ctx, s.addr(4)), 1)
+	s.Require().Len(s.keeper.GetPositionsByPair(s.ctx, pair.Id), 1)
+	conditionalOrder2, found := s.keeper.GetConditionalOrder(s.ctx, conditionalOrder.PairId, conditionalOrder.Id)
+	s.Require().True(found)
+	s.Require().Equal(conditionalOrder, conditionalOrder2)
+	s.Require().Len(s.keeper.GetConditionalOrdersByOrderer(s.ctx, s.addr(5)), 1)
+	routedSwapReq2, found := s.keeper.GetRoutedSwapRequest(s.ctx, routedSwapReq.Id)
+	s.Require().True(found)
+	s.Require().Equal(routedSwapReq, routedSwapReq2)
+	s.Require().NotEmpty(genState.TradeRecords)
+	s.Require().Equal(genState.TradeRecords, s.keeper.GetTradeRecordsByPair(s.ctx, pair.Id))
+	s.Require().NotEmpty(genState.PriceAccumulators)
+	s.Require().Equal(genState.PriceAccumulators, s.keeper.GetPriceAccumulatorsByPair(s.ctx, pair.Id))
 }
 
 func (s *KeeperTestSuite) TestImportExportGenesisEmpty() {
@@ -3,13 +3,16 @@ package keeper
 import (
 	"context"
 	"strconv"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmosquad-labs/squad/v3/x/liquidity/amm"
 	"github.com/cosmosquad-labs/squad/v3/x/liquidity/types"
@@ -493,7 +496,7 @@ func (k Querier) OrdersByOrderer(c context.Context, req *types.QueryOrdersByOrde
 	return &types.QueryOrdersResponse{Orders: orders, Pagination: pageRes}, nil
 }
 
-// OrderBooks queries virtual order books from user orders and pools.
+// OrderBooks queries virtual order books from user orders, pools and positions.
 func (k Querier) OrderBooks(c context.Context, req *types.QueryOrderBooksRequest) (*types.QueryOrderBooksResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
@@ -563,6 +566,15 @@ func (k Querier) OrderBooks(c context.Context, req *types.QueryOrderBooksRequest
 			ob.AddOrder(amm.PoolOrders(ammPool, amm.DefaultOrderer, lowestPrice, highestPrice, int(tickPrec))...)
 			return false, nil
 		})
+		_ = k.IteratePositionsByPair(ctx, pairId, func(position types.Position) (stop bool, err error) {
+			rx, ry := k.getPositionBalances(ctx, position, pair)
+			ammPool := position.AMMPool(rx.Amount, ry.Amount)
+			if ammPool.IsDepleted() {
+				return false, nil
+			}
+			ob.AddOrder(amm.PoolOrders(ammPool, amm.DefaultOrderer, lowestPrice, highestPrice, int(tickPrec))...)
+			return false, nil
+		})
 
 		ov := ob.MakeView()
 		ov.Match()
@@ -575,12 +587,326 @@ func (k Querier) OrderBooks(c context.Context, req *types.QueryOrderBooksRequest
 			})
 		}
 
-		pairs = append(
-			pairs, types.MakeOrderBookPairResponse(
-				pair.Id, ov, lowestPrice, highestPrice, int(tickPrec), configs...))
+		resp := types.MakeOrderBookPairResponse(
+			pair.Id, ov, lowestPrice, highestPrice, int(tickPrec), configs...)
+		resp.SwapFeeRate = k.GetPairSwapFeeRate(ctx, pair)
+		pairs = append(pairs, resp)
 	}
 
 	return &types.QueryOrderBooksResponse{
 		Pairs: pairs,
 	}, nil
 }
+
+// Positions queries all positions.
+func (k Querier) Positions(c context.Context, req *types.QueryPositionsRequest) (*types.QueryPositionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+
+	var keyPrefix []byte
+	var positionGetter func(key, value []byte) types.Position
+	switch {
+	case req.PairId == 0:
+		keyPrefix = types.PositionKeyPrefix
+		positionGetter = func(_, value []byte) types.Position {
+			return types.MustUnmarshalPosition(k.cdc, value)
+		}
+	default:
+		keyPrefix = types.GetPositionsByPairIndexKeyPrefix(req.PairId)
+		positionGetter = func(key, _ []byte) types.Position {
+			positionId := types.ParsePositionsByPairIndexKey(append(keyPrefix, key...))
+			position, _ := k.GetPosition(ctx, positionId)
+			return position
+		}
+	}
+
+	positionStore := prefix.NewStore(store, keyPrefix)
+
+	pairMap := map[uint64]types.Pair{}
+	var positionsRes []types.PositionResponse
+	pageRes, err := query.FilteredPaginate(positionStore, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		position := positionGetter(key, value)
+
+		if accumulate {
+			pair, ok := pairMap[position.PairId]
+			if !ok {
+				pair, _ = k.GetPair(ctx, position.PairId)
+				pairMap[position.PairId] = pair
+			}
+			rx, ry := k.getPositionBalances(ctx, position, pair)
+			positionsRes = append(positionsRes, types.NewPositionResponse(position, rx, ry))
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPositionsResponse{Positions: positionsRes, Pagination: pageRes}, nil
+}
+
+// Position queries the specific position.
+func (k Querier) Position(c context.Context, req *types.QueryPositionRequest) (*types.QueryPositionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.PositionId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "position id cannot be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	position, found := k.GetPosition(ctx, req.PositionId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "position %d doesn't exist", req.PositionId)
+	}
+
+	rx, ry := k.GetPositionBalances(ctx, position)
+	return &types.QueryPositionResponse{Position: types.NewPositionResponse(position, rx, ry)}, nil
+}
+
+// PositionsByOwner queries positions owned by an owner.
+func (k Querier) PositionsByOwner(c context.Context, req *types.QueryPositionsByOwnerRequest) (*types.QueryPositionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "owner address %s is invalid", req.Owner)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+
+	keyPrefix := types.GetPositionIndexKeyPrefix(owner)
+	positionStore := prefix.NewStore(store, keyPrefix)
+	var positionsRes []types.PositionResponse
+	pageRes, err := query.FilteredPaginate(positionStore, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		_, positionId := types.ParsePositionIndexKey(append(keyPrefix, key...))
+		position, _ := k.GetPosition(ctx, positionId)
+		if req.PairId != 0 && position.PairId != req.PairId {
+			return false, nil
+		}
+
+		if accumulate {
+			rx, ry := k.GetPositionBalances(ctx, position)
+			positionsRes = append(positionsRes, types.NewPositionResponse(position, rx, ry))
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPositionsResponse{Positions: positionsRes, Pagination: pageRes}, nil
+}
+
+// ConditionalOrders queries all conditional orders within the pair.
+func (k Querier) ConditionalOrders(c context.Context, req *types.QueryConditionalOrdersRequest) (*types.QueryConditionalOrdersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.PairId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "pair id cannot be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	orderStore := prefix.NewStore(store, types.GetConditionalOrdersByPairKeyPrefix(req.PairId))
+
+	var orders []types.ConditionalOrder
+	pageRes, err := query.Paginate(orderStore, req.Pagination, func(key, value []byte) error {
+		order, err := types.UnmarshalConditionalOrder(k.cdc, value)
+		if err != nil {
+			return err
+		}
+		orders = append(orders, order)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryConditionalOrdersResponse{ConditionalOrders: orders, Pagination: pageRes}, nil
+}
+
+// ConditionalOrder queries the specific conditional order.
+func (k Querier) ConditionalOrder(c context.Context, req *types.QueryConditionalOrderRequest) (*types.QueryConditionalOrderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.PairId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "pair id cannot be 0")
+	}
+
+	if req.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "id cannot be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	order, found := k.GetConditionalOrder(ctx, req.PairId, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "conditional order %d in pair %d not found", req.Id, req.PairId)
+	}
+
+	return &types.QueryConditionalOrderResponse{ConditionalOrder: order}, nil
+}
+
+// ConditionalOrdersByOrderer returns conditional orders made by an orderer.
+func (k Querier) ConditionalOrdersByOrderer(c context.Context, req *types.QueryConditionalOrdersByOrdererRequest) (*types.QueryConditionalOrdersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	orderer, err := sdk.AccAddressFromBech32(req.Orderer)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "orderer address %s is invalid", req.Orderer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+
+	keyPrefix := types.GetConditionalOrderIndexKeyPrefix(orderer)
+	orderStore := prefix.NewStore(store, keyPrefix)
+	var orders []types.ConditionalOrder
+	pageRes, err := query.FilteredPaginate(orderStore, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		_, pairId, orderId := types.ParseConditionalOrderIndexKey(append(keyPrefix, key...))
+		if req.PairId != 0 && pairId != req.PairId {
+			return false, nil
+		}
+
+		order, _ := k.GetConditionalOrder(ctx, pairId, orderId)
+
+		if accumulate {
+			orders = append(orders, order)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryConditionalOrdersResponse{ConditionalOrders: orders, Pagination: pageRes}, nil
+}
+
+// SimulateRoutedSwap returns the expected result of a routed swap.
+func (k Querier) SimulateRoutedSwap(c context.Context, req *types.QuerySimulateRoutedSwapRequest) (*types.QuerySimulateRoutedSwapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if len(req.PairIds) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "pair ids cannot be empty")
+	}
+
+	offerCoin, err := sdk.ParseCoinNormalized(req.OfferCoin)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid offer coin: %v", err)
+	}
+
+	if !offerCoin.IsPositive() {
+		return nil, status.Error(codes.InvalidArgument, "offer coin must be positive")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	demandCoin, refundedCoins, err := k.Keeper.SimulateRoutedSwap(ctx, req.PairIds, offerCoin)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return &types.QuerySimulateRoutedSwapResponse{
+		DemandCoin:    demandCoin,
+		RefundedCoins: refundedCoins,
+	}, nil
+}
+
+// Candles queries candles of the pair aggregated by the interval.
+func (k Querier) Candles(c context.Context, req *types.QueryCandlesRequest) (*types.QueryCandlesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.PairId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "pair id cannot be 0")
+	}
+
+	interval, err := types.ParseCandleInterval(req.Interval)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetPair(ctx, req.PairId); !found {
+		return nil, status.Errorf(codes.NotFound, "pair %d doesn't exist", req.PairId)
+	}
+
+	// Candles are aggregated on the fly, so they are paginated through a
+	// temporary store keyed by their start time.
+	candleStore := dbadapter.Store{DB: dbm.NewMemDB()}
+	for _, candle := range types.AggregateCandles(k.GetTradeRecordsByPair(ctx, req.PairId), interval) {
+		candle := candle
+		candleStore.Set(sdk.FormatTimeBytes(candle.StartTime), k.cdc.MustMarshal(&candle))
+	}
+
+	var candles []types.Candle
+	pageRes, err := query.Paginate(candleStore, req.Pagination, func(_, value []byte) error {
+		var candle types.Candle
+		if err := k.cdc.Unmarshal(value, &candle); err != nil {
+			return err
+		}
+		candles = append(candles, candle)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryCandlesResponse{Candles: candles, Pagination: pageRes}, nil
+}
+
+// TWAP queries the time-weighted average price of the pair.
+func (k Querier) TWAP(c context.Context, req *types.QueryTWAPRequest) (*types.QueryTWAPResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.PairId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "pair id cannot be 0")
+	}
+
+	window, err := time.ParseDuration(req.Window)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid window: %v", err)
+	}
+
+	if window <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "window must be positive")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetPair(ctx, req.PairId); !found {
+		return nil, status.Errorf(codes.NotFound, "pair %d doesn't exist", req.PairId)
+	}
+
+	twap, err := k.GetTWAP(ctx, req.PairId, window)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return &types.QueryTWAPResponse{Twap: twap}, nil
+}
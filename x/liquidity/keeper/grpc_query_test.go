@@ -4,6 +4,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	utils "github.com/cosmosquad-labs/squad/v3/types"
 	"github.com/cosmosquad-labs/squad/v3/x/liquidity"
@@ -936,6 +937,7 @@ func (s *KeeperTestSuite) TestGRPCOrderBooks() {
 				s.Require().Len(resp.Pairs, 1)
 				s.Require().EqualValues(pair.Id, resp.Pairs[0].PairId)
 				s.Require().True(decEq(utils.ParseDec("1.01"), resp.Pairs[0].BasePrice))
+				s.Require().True(decEq(s.keeper.GetSwapFeeRate(s.ctx), resp.Pairs[0].SwapFeeRate))
 				s.Require().Len(resp.Pairs[0].OrderBooks, 3)
 			},
 		},
@@ -1011,6 +1013,23 @@ func (s *KeeperTestSuite) TestGRPCOrderBooks() {
 	}
 }
 
+func (s *KeeperTestSuite) TestGRPCOrderBooks_SwapFeeRate() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pair.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair)
+	s.Require().NoError(s.keeper.SetPairSwapFeeRate(s.ctx, pair.Id, utils.ParseDecP("0.003")))
+
+	s.buyLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(1000000), time.Minute, true)
+
+	resp, err := s.querier.OrderBooks(sdk.WrapSDKContext(s.ctx), &types.QueryOrderBooksRequest{
+		PairIds:  []uint64{pair.Id},
+		NumTicks: 10,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(resp.Pairs, 1)
+	s.Require().True(decEq(utils.ParseDec("0.003"), resp.Pairs[0].SwapFeeRate))
+}
+
 func (s *KeeperTestSuite) TestEmptyOrderBook() {
 	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
 	pair.LastPrice = utils.ParseDecP("1.0") // manually set last price
@@ -1088,3 +1107,721 @@ func (s *KeeperTestSuite) TestSellOrdersOnlyOrderBook() {
 		}
 	}
 }
+
+func (s *KeeperTestSuite) TestGRPCPositions() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pair.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair)
+	pair2 := s.createPair(s.addr(0), "denom2", "denom3", true)
+	pair2.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair2)
+
+	position := s.createPosition(
+		s.addr(1), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"),
+		utils.ParseDec("0.9"), utils.ParseDec("1.1"), true)
+	s.createPosition(
+		s.addr(2), pair2.Id, utils.ParseCoins("1000000denom2,1000000denom3"),
+		utils.ParseDec("0.9"), utils.ParseDec("1.1"), true)
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QueryPositionsRequest
+		expectErr bool
+		postRun   func(*types.QueryPositionsResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"query all positions",
+			&types.QueryPositionsRequest{},
+			false,
+			func(resp *types.QueryPositionsResponse) {
+				s.Require().Len(resp.Positions, 2)
+			},
+		},
+		{
+			"query by pair id",
+			&types.QueryPositionsRequest{
+				PairId: pair.Id,
+			},
+			false,
+			func(resp *types.QueryPositionsResponse) {
+				s.Require().Len(resp.Positions, 1)
+				s.Require().Equal(position.Id, resp.Positions[0].Id)
+				s.Require().Equal(position.Owner, resp.Positions[0].Owner)
+				s.Require().Equal(position.ReserveAddress, resp.Positions[0].ReserveAddress)
+				s.Require().True(position.MinPrice.Equal(resp.Positions[0].MinPrice))
+				s.Require().True(position.MaxPrice.Equal(resp.Positions[0].MaxPrice))
+				rx, ry := s.keeper.GetPositionBalances(s.ctx, position)
+				s.Require().True(coinEq(rx, resp.Positions[0].Balances.QuoteCoin))
+				s.Require().True(coinEq(ry, resp.Positions[0].Balances.BaseCoin))
+			},
+		},
+	} {
+		s.Run(tc.name, func() {
+			resp, err := s.querier.Positions(sdk.WrapSDKContext(s.ctx), tc.req)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestGRPCPosition() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pair.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair)
+
+	position := s.createPosition(
+		s.addr(1), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"),
+		utils.ParseDec("0.9"), utils.ParseDec("1.1"), true)
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QueryPositionRequest
+		expectErr bool
+		postRun   func(*types.QueryPositionResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"invalid request",
+			&types.QueryPositionRequest{},
+			true,
+			nil,
+		},
+		{
+			"position not found",
+			&types.QueryPositionRequest{
+				PositionId: 10,
+			},
+			true,
+			nil,
+		},
+		{
+			"happy case",
+			&types.QueryPositionRequest{
+				PositionId: position.Id,
+			},
+			false,
+			func(resp *types.QueryPositionResponse) {
+				s.Require().Equal(position.Id, resp.Position.Id)
+				s.Require().Equal(position.PairId, resp.Position.PairId)
+				s.Require().Equal(position.Owner, resp.Position.Owner)
+				s.Require().NotNil(resp.Position.Price)
+			},
+		},
+	} {
+		s.Run(tc.name, func() {
+			resp, err := s.querier.Position(sdk.WrapSDKContext(s.ctx), tc.req)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestGRPCPositionsByOwner() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pair.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair)
+	pair2 := s.createPair(s.addr(0), "denom2", "denom3", true)
+	pair2.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair2)
+
+	position := s.createPosition(
+		s.addr(1), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"),
+		utils.ParseDec("0.9"), utils.ParseDec("1.1"), true)
+	position2 := s.createPosition(
+		s.addr(1), pair2.Id, utils.ParseCoins("1000000denom2,1000000denom3"),
+		utils.ParseDec("0.9"), utils.ParseDec("1.1"), true)
+	s.createPosition(
+		s.addr(2), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"),
+		utils.ParseDec("0.9"), utils.ParseDec("1.1"), true)
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QueryPositionsByOwnerRequest
+		expectErr bool
+		postRun   func(*types.QueryPositionsResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"invalid request",
+			&types.QueryPositionsByOwnerRequest{},
+			true,
+			nil,
+		},
+		{
+			"query positions by owner",
+			&types.QueryPositionsByOwnerRequest{
+				Owner: s.addr(1).String(),
+			},
+			false,
+			func(resp *types.QueryPositionsResponse) {
+				s.Require().Len(resp.Positions, 2)
+				s.Require().Equal(position.Id, resp.Positions[0].Id)
+				s.Require().Equal(position2.Id, resp.Positions[1].Id)
+			},
+		},
+		{
+			"no positions from an owner",
+			&types.QueryPositionsByOwnerRequest{
+				Owner: s.addr(3).String(),
+			},
+			false,
+			func(resp *types.QueryPositionsResponse) {
+				s.Require().Len(resp.Positions, 0)
+			},
+		},
+		{
+			"query by pair id",
+			&types.QueryPositionsByOwnerRequest{
+				Owner:  s.addr(1).String(),
+				PairId: pair2.Id,
+			},
+			false,
+			func(resp *types.QueryPositionsResponse) {
+				s.Require().Len(resp.Positions, 1)
+				s.Require().Equal(position2.Id, resp.Positions[0].Id)
+			},
+		},
+	} {
+		s.Run(tc.name, func() {
+			resp, err := s.querier.PositionsByOwner(sdk.WrapSDKContext(s.ctx), tc.req)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestGRPCConditionalOrders() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pair.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair)
+
+	order := s.conditionalOrder(
+		s.addr(1), pair.Id, types.ConditionalOrderTypeStopLoss, types.OrderTypeMarket, types.OrderDirectionSell,
+		utils.ParseCoin("1000000denom1"), utils.ParseDec("0.9"), sdk.ZeroDec(), sdk.NewInt(1000000), time.Hour, true)
+	order2 := s.conditionalOrder(
+		s.addr(2), pair.Id, types.ConditionalOrderTypeTakeProfit, types.OrderTypeLimit, types.OrderDirectionSell,
+		utils.ParseCoin("1000000denom1"), utils.ParseDec("1.1"), utils.ParseDec("1.1"), sdk.NewInt(1000000), time.Hour, true)
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QueryConditionalOrdersRequest
+		expectErr bool
+		postRun   func(*types.QueryConditionalOrdersResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"invalid request",
+			&types.QueryConditionalOrdersRequest{},
+			true,
+			nil,
+		},
+		{
+			"happy case",
+			&types.QueryConditionalOrdersRequest{
+				PairId: pair.Id,
+			},
+			false,
+			func(resp *types.QueryConditionalOrdersResponse) {
+				s.Require().Len(resp.ConditionalOrders, 2)
+				s.Require().Equal(order.Id, resp.ConditionalOrders[0].Id)
+				s.Require().Equal(order2.Id, resp.ConditionalOrders[1].Id)
+			},
+		},
+		{
+			"no conditional orders in the pair",
+			&types.QueryConditionalOrdersRequest{
+				PairId: 10,
+			},
+			false,
+			func(resp *types.QueryConditionalOrdersResponse) {
+				s.Require().Len(resp.ConditionalOrders, 0)
+			},
+		},
+	} {
+		s.Run(tc.name, func() {
+			resp, err := s.querier.ConditionalOrders(sdk.WrapSDKContext(s.ctx), tc.req)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestGRPCConditionalOrder() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pair.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair)
+
+	order := s.conditionalOrder(
+		s.addr(1), pair.Id, types.ConditionalOrderTypeStopLoss, types.OrderTypeMarket, types.OrderDirectionSell,
+		utils.ParseCoin("1000000denom1"), utils.ParseDec("0.9"), sdk.ZeroDec(), sdk.NewInt(1000000), time.Hour, true)
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QueryConditionalOrderRequest
+		expectErr bool
+		postRun   func(*types.QueryConditionalOrderResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"invalid request",
+			&types.QueryConditionalOrderRequest{},
+			true,
+			nil,
+		},
+		{
+			"conditional order not found",
+			&types.QueryConditionalOrderRequest{
+				PairId: pair.Id,
+				Id:     10,
+			},
+			true,
+			nil,
+		},
+		{
+			"happy case",
+			&types.QueryConditionalOrderRequest{
+				PairId: pair.Id,
+				Id:     order.Id,
+			},
+			false,
+			func(resp *types.QueryConditionalOrderResponse) {
+				s.Require().Equal(order, resp.ConditionalOrder)
+			},
+		},
+	} {
+		s.Run(tc.name, func() {
+			resp, err := s.querier.ConditionalOrder(sdk.WrapSDKContext(s.ctx), tc.req)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestGRPCConditionalOrdersByOrderer() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pair.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair)
+	pair2 := s.createPair(s.addr(0), "denom2", "denom3", true)
+	pair2.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair2)
+
+	order := s.conditionalOrder(
+		s.addr(1), pair.Id, types.ConditionalOrderTypeStopLoss, types.OrderTypeMarket, types.OrderDirectionSell,
+		utils.ParseCoin("1000000denom1"), utils.ParseDec("0.9"), sdk.ZeroDec(), sdk.NewInt(1000000), time.Hour, true)
+	order2 := s.conditionalOrder(
+		s.addr(1), pair2.Id, types.ConditionalOrderTypeStopLoss, types.OrderTypeMarket, types.OrderDirectionSell,
+		utils.ParseCoin("1000000denom2"), utils.ParseDec("0.9"), sdk.ZeroDec(), sdk.NewInt(1000000), time.Hour, true)
+	s.conditionalOrder(
+		s.addr(2), pair.Id, types.ConditionalOrderTypeStopLoss, types.OrderTypeMarket, types.OrderDirectionSell,
+		utils.ParseCoin("1000000denom1"), utils.ParseDec("0.9"), sdk.ZeroDec(), sdk.NewInt(1000000), time.Hour, true)
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QueryConditionalOrdersByOrdererRequest
+		expectErr bool
+		postRun   func(*types.QueryConditionalOrdersResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"invalid request",
+			&types.QueryConditionalOrdersByOrdererRequest{},
+			true,
+			nil,
+		},
+		{
+			"query conditional orders by orderer",
+			&types.QueryConditionalOrdersByOrdererRequest{
+				Orderer: s.addr(1).String(),
+			},
+			false,
+			func(resp *types.QueryConditionalOrdersResponse) {
+				s.Require().Len(resp.ConditionalOrders, 2)
+				s.Require().Equal(order.Id, resp.ConditionalOrders[0].Id)
+				s.Require().Equal(order2.Id, resp.ConditionalOrders[1].Id)
+			},
+		},
+		{
+			"no conditional orders from an orderer",
+			&types.QueryConditionalOrdersByOrdererRequest{
+				Orderer: s.addr(3).String(),
+			},
+			false,
+			func(resp *types.QueryConditionalOrdersResponse) {
+				s.Require().Len(resp.ConditionalOrders, 0)
+			},
+		},
+		{
+			"query by pair id",
+			&types.QueryConditionalOrdersByOrdererRequest{
+				Orderer: s.addr(1).String(),
+				PairId:  pair2.Id,
+			},
+			false,
+			func(resp *types.QueryConditionalOrdersResponse) {
+				s.Require().Len(resp.ConditionalOrders, 1)
+				s.Require().Equal(order2.Id, resp.ConditionalOrders[0].Id)
+			},
+		},
+	} {
+		s.Run(tc.name, func() {
+			resp, err := s.querier.ConditionalOrdersByOrderer(sdk.WrapSDKContext(s.ctx), tc.req)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestGRPCSimulateRoutedSwap() {
+	pair1 := s.createPair(s.addr(0), "denom1", "denom2", true)
+	s.createPool(s.addr(0), pair1.Id, utils.ParseCoins("1000000000denom1,1000000000denom2"), true)
+	pair1.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair1)
+	pair2 := s.createPair(s.addr(0), "denom3", "denom2", true)
+	s.createPool(s.addr(0), pair2.Id, utils.ParseCoins("1000000000denom2,1000000000denom3"), true)
+	pair2.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair2)
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QuerySimulateRoutedSwapRequest
+		expectErr bool
+		postRun   func(*types.QuerySimulateRoutedSwapResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"empty pair ids",
+			&types.QuerySimulateRoutedSwapRequest{
+				OfferCoin: "1000000denom1",
+			},
+			true,
+			nil,
+		},
+		{
+			"invalid offer coin",
+			&types.QuerySimulateRoutedSwapRequest{
+				PairIds:   []uint64{pair1.Id, pair2.Id},
+				OfferCoin: "denom1",
+			},
+			true,
+			nil,
+		},
+		{
+			"wrong route",
+			&types.QuerySimulateRoutedSwapRequest{
+				PairIds:   []uint64{pair2.Id, pair1.Id},
+				OfferCoin: "1000000denom1",
+			},
+			true,
+			nil,
+		},
+		{
+			"single pair",
+			&types.QuerySimulateRoutedSwapRequest{
+				PairIds:   []uint64{pair1.Id},
+				OfferCoin: "1000000denom1",
+			},
+			false,
+			func(resp *types.QuerySimulateRoutedSwapResponse) {
+				s.Require().Equal("denom2", resp.DemandCoin.Denom)
+				s.Require().True(resp.DemandCoin.Amount.GT(sdk.NewInt(990000)))
+				s.Require().True(resp.DemandCoin.Amount.LT(sdk.NewInt(1000000)))
+			},
+		},
+		{
+			"multiple pairs",
+			&types.QuerySimulateRoutedSwapRequest{
+				PairIds:   []uint64{pair1.Id, pair2.Id},
+				OfferCoin: "1000000denom1",
+			},
+			false,
+			func(resp *types.QuerySimulateRoutedSwapResponse) {
+				s.Require().Equal("denom3", resp.DemandCoin.Denom)
+				s.Require().True(resp.DemandCoin.Amount.GT(sdk.NewInt(990000)))
+				s.Require().True(resp.DemandCoin.Amount.LT(sdk.NewInt(1000000)))
+			},
+		},
+	} {
+		s.Run(tc.name, func() {
+			resp, err := s.querier.SimulateRoutedSwap(sdk.WrapSDKContext(s.ctx), tc.req)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestGRPCCandles() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	// Trade once a minute.
+	for _, price := range []string{"1.0", "1.05", "0.95"} {
+		s.sellLimitOrder(s.addr(1), pair.Id, utils.ParseDec(price), newInt(1000000), 0, true)
+		s.buyLimitOrder(s.addr(2), pair.Id, utils.ParseDec(price), newInt(1000000), 0, true)
+		for i := 0; i < 12; i++ {
+			s.nextBlock()
+		}
+	}
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QueryCandlesRequest
+		expectErr bool
+		postRun   func(*types.QueryCandlesResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"zero pair id",
+			&types.QueryCandlesRequest{
+				PairId:   0,
+				Interval: types.CandleInterval1m,
+			},
+			true,
+			nil,
+		},
+		{
+			"invalid interval",
+			&types.QueryCandlesRequest{
+				PairId:   pair.Id,
+				Interval: "5m",
+			},
+			true,
+			nil,
+		},
+		{
+			"pair not found",
+			&types.QueryCandlesRequest{
+				PairId:   10,
+				Interval: types.CandleInterval1m,
+			},
+			true,
+			nil,
+		},
+		{
+			"happy case",
+			&types.QueryCandlesRequest{
+				PairId:   pair.Id,
+				Interval: types.CandleInterval1h,
+			},
+			false,
+			func(resp *types.QueryCandlesResponse) {
+				s.Require().Len(resp.Candles, 1)
+				candle := resp.Candles[0]
+				s.Require().True(decEq(utils.ParseDec("1.0"), candle.Open))
+				s.Require().True(decEq(utils.ParseDec("1.05"), candle.High))
+				s.Require().True(decEq(utils.ParseDec("0.95"), candle.Low))
+				s.Require().True(decEq(utils.ParseDec("0.95"), candle.Close))
+				s.Require().True(intEq(newInt(3000000), candle.BaseVolume))
+			},
+		},
+		{
+			"pagination",
+			&types.QueryCandlesRequest{
+				PairId:   pair.Id,
+				Interval: types.CandleInterval1m,
+				Pagination: &query.PageRequest{
+					Limit:      2,
+					CountTotal: true,
+				},
+			},
+			false,
+			func(resp *types.QueryCandlesResponse) {
+				s.Require().Len(resp.Candles, 2)
+				s.Require().True(decEq(utils.ParseDec("1.0"), resp.Candles[0].Close))
+				s.Require().True(decEq(utils.ParseDec("1.05"), resp.Candles[1].Close))
+				s.Require().EqualValues(3, resp.Pagination.Total)
+
+				resp, err := s.querier.Candles(sdk.WrapSDKContext(s.ctx), &types.QueryCandlesRequest{
+					PairId:     pair.Id,
+					Interval:   types.CandleInterval1m,
+					Pagination: &query.PageRequest{Key: resp.Pagination.NextKey},
+				})
+				s.Require().NoError(err)
+				s.Require().Len(resp.Candles, 1)
+				s.Require().True(decEq(utils.ParseDec("0.95"), resp.Candles[0].Close))
+				s.Require().Nil(resp.Pagination.NextKey)
+			},
+		},
+		{
+			"pagination reverse",
+			&types.QueryCandlesRequest{
+				PairId:   pair.Id,
+				Interval: types.CandleInterval1m,
+				Pagination: &query.PageRequest{
+					Limit:   1,
+					Reverse: true,
+				},
+			},
+			false,
+			func(resp *types.QueryCandlesResponse) {
+				s.Require().Len(resp.Candles, 1)
+				s.Require().True(decEq(utils.ParseDec("0.95"), resp.Candles[0].Close))
+			},
+		},
+	} {
+		s.Run(tc.name, func() {
+			resp, err := s.querier.Candles(sdk.WrapSDKContext(s.ctx), tc.req)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestGRPCTWAP() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pair.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair)
+	s.nextBlock()
+	s.nextBlock()
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QueryTWAPRequest
+		expectErr bool
+		postRun   func(*types.QueryTWAPResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"zero pair id",
+			&types.QueryTWAPRequest{
+				PairId: 0,
+				Window: "5s",
+			},
+			true,
+			nil,
+		},
+		{
+			"invalid window",
+			&types.QueryTWAPRequest{
+				PairId: pair.Id,
+				Window: "5",
+			},
+			true,
+			nil,
+		},
+		{
+			"non-positive window",
+			&types.QueryTWAPRequest{
+				PairId: pair.Id,
+				Window: "0s",
+			},
+			true,
+			nil,
+		},
+		{
+			"pair not found",
+			&types.QueryTWAPRequest{
+				PairId: 10,
+				Window: "5s",
+			},
+			true,
+			nil,
+		},
+		{
+			"insufficient price history",
+			&types.QueryTWAPRequest{
+				PairId: pair.Id,
+				Window: "1h",
+			},
+			true,
+			nil,
+		},
+		{
+			"happy case",
+			&types.QueryTWAPRequest{
+				PairId: pair.Id,
+				Window: "5s",
+			},
+			false,
+			func(resp *types.QueryTWAPResponse) {
+				s.Require().True(decEq(utils.ParseDec("1.0"), resp.Twap))
+			},
+		},
+	} {
+		s.Run(tc.name, func() {
+			resp, err := s.querier.TWAP(sdk.WrapSDKContext(s.ctx), tc.req)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
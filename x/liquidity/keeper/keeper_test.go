@@ -125,6 +125,18 @@ func (s *KeeperTestSuite) createRangedPool(creator sdk.AccAddress, pairId uint64
 	return pool
 }
 
+func (s *KeeperTestSuite) createStableswapPool(creator sdk.AccAddress, pairId uint64, depositCoins sdk.Coins, amp uint64, fund bool) types.Pool {
+	s.T().Helper()
+	if fund {
+		s.fundAddr(creator, depositCoins.Add(s.keeper.GetPoolCreationFee(s.ctx)...))
+	}
+	msg := types.NewMsgCreateStableswapPool(creator, pairId, depositCoins, amp)
+	s.Require().NoError(msg.ValidateBasic())
+	pool, err := s.keeper.CreateStableswapPool(s.ctx, msg)
+	s.Require().NoError(err)
+	return pool
+}
+
 func (s *KeeperTestSuite) deposit(depositor sdk.AccAddress, poolId uint64, depositCoins sdk.Coins, fund bool) types.DepositRequest {
 	s.T().Helper()
 	if fund {
@@ -171,6 +183,36 @@ func (s *KeeperTestSuite) limitOrder(
 	return req
 }
 
+func (s *KeeperTestSuite) limitOrderWithTimeInForce(
+	orderer sdk.AccAddress, pairId uint64, dir types.OrderDirection,
+	price sdk.Dec, amt sdk.Int, timeInForce types.TimeInForce, fund bool) types.Order {
+	s.T().Helper()
+	pair, found := s.keeper.GetPair(s.ctx, pairId)
+	s.Require().True(found)
+	var ammDir amm.OrderDirection
+	var offerCoinDenom, demandCoinDenom string
+	switch dir {
+	case types.OrderDirectionBuy:
+		ammDir = amm.Buy
+		offerCoinDenom, demandCoinDenom = pair.QuoteCoinDenom, pair.BaseCoinDenom
+	case types.OrderDirectionSell:
+		ammDir = amm.Sell
+		offerCoinDenom, demandCoinDenom = pair.BaseCoinDenom, pair.QuoteCoinDenom
+	}
+	offerCoin := sdk.NewCoin(offerCoinDenom, amm.OfferCoinAmount(ammDir, price, amt))
+	if fund {
+		s.fundAddr(orderer, sdk.NewCoins(offerCoin))
+	}
+	msg := types.NewMsgLimitOrder(
+		orderer, pairId, dir, offerCoin, demandCoinDenom,
+		price, amt, 0)
+	msg.TimeInForce = timeInForce
+	s.Require().NoError(msg.ValidateBasic())
+	req, err := s.keeper.LimitOrder(s.ctx, msg)
+	s.Require().NoError(err)
+	return req
+}
+
 func (s *KeeperTestSuite) buyLimitOrder(
 	orderer sdk.AccAddress, pairId uint64, price sdk.Dec,
 	amt sdk.Int, orderLifespan time.Duration, fund bool) types.Order {
@@ -301,6 +343,67 @@ func (s *KeeperTestSuite) cancelAllOrders(orderer sdk.AccAddress, pairIds []uint
 	s.Require().NoError(err)
 }
 
+func (s *KeeperTestSuite) createPosition(owner sdk.AccAddress, pairId uint64, depositCoins sdk.Coins, minPrice, maxPrice sdk.Dec, fund bool) types.Position {
+	s.T().Helper()
+	if fund {
+		s.fundAddr(owner, depositCoins.Add(s.keeper.GetPositionCreationFee(s.ctx)...))
+	}
+	msg := types.NewMsgCreatePosition(owner, pairId, depositCoins, minPrice, maxPrice)
+	s.Require().NoError(msg.ValidateBasic())
+	position, err := s.keeper.CreatePosition(s.ctx, msg)
+	s.Require().NoError(err)
+	return position
+}
+
+func (s *KeeperTestSuite) closePosition(owner sdk.AccAddress, positionId uint64) {
+	s.T().Helper()
+	err := s.keeper.ClosePosition(s.ctx, types.NewMsgClosePosition(owner, positionId))
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) conditionalOrder(
+	orderer sdk.AccAddress, pairId uint64, typ types.ConditionalOrderType, orderType types.OrderType,
+	dir types.OrderDirection, offerCoin sdk.Coin, triggerPrice, price sdk.Dec, amt sdk.Int,
+	orderLifespan time.Duration, fund bool) types.ConditionalOrder {
+	s.T().Helper()
+	pair, found := s.keeper.GetPair(s.ctx, pairId)
+	s.Require().True(found)
+	demandCoinDenom := pair.QuoteCoinDenom
+	if dir == types.OrderDirectionBuy {
+		demandCoinDenom = pair.BaseCoinDenom
+	}
+	if fund {
+		s.fundAddr(orderer, sdk.NewCoins(offerCoin))
+	}
+	msg := types.NewMsgConditionalOrder(
+		orderer, pairId, typ, orderType, dir, offerCoin, demandCoinDenom,
+		triggerPrice, price, amt, orderLifespan)
+	s.Require().NoError(msg.ValidateBasic())
+	order, err := s.keeper.ConditionalOrder(s.ctx, msg)
+	s.Require().NoError(err)
+	return order
+}
+
+func (s *KeeperTestSuite) cancelConditionalOrder(orderer sdk.AccAddress, pairId, orderId uint64) {
+	s.T().Helper()
+	err := s.keeper.CancelConditionalOrder(s.ctx, types.NewMsgCancelConditionalOrder(orderer, pairId, orderId))
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) routedSwap(
+	orderer sdk.AccAddress, pairIds []uint64, offerCoin sdk.Coin, demandCoinDenom string,
+	minDemandAmt sdk.Int, fund bool) types.RoutedSwapRequest {
+	s.T().Helper()
+	if fund {
+		s.fundAddr(orderer, sdk.NewCoins(offerCoin))
+	}
+	msg := types.NewMsgRoutedSwap(orderer, pairIds, offerCoin, demandCoinDenom, minDemandAmt)
+	s.Require().NoError(msg.ValidateBasic())
+	req, err := s.keeper.RoutedSwap(s.ctx, msg)
+	s.Require().NoError(err)
+	return req
+}
+
 func coinEq(exp, got sdk.Coin) (bool, string, string, string) {
 	return exp.IsEqual(got), "expected:\t%v\ngot:\t\t%v", exp.String(), got.String()
 }
@@ -52,6 +52,17 @@ func (m msgServer) CreateRangedPool(goCtx context.Context, msg *types.MsgCreateR
 	return &types.MsgCreateRangedPoolResponse{}, nil
 }
 
+// CreateStableswapPool defines a method to create a stableswap pool.
+func (m msgServer) CreateStableswapPool(goCtx context.Context, msg *types.MsgCreateStableswapPool) (*types.MsgCreateStableswapPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := m.Keeper.CreateStableswapPool(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateStableswapPoolResponse{}, nil
+}
+
 // Deposit defines a method to deposit coins to the pool.
 func (m msgServer) Deposit(goCtx context.Context, msg *types.MsgDeposit) (*types.MsgDepositResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -139,3 +150,91 @@ func (m msgServer) CancelMMOrder(goCtx context.Context, msg *types.MsgCancelMMOr
 
 	return &types.MsgCancelMMOrderResponse{}, nil
 }
+
+// CreatePosition defines a method to create a concentrated liquidity position.
+func (m msgServer) CreatePosition(goCtx context.Context, msg *types.MsgCreatePosition) (*types.MsgCreatePositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := m.Keeper.CreatePosition(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreatePositionResponse{}, nil
+}
+
+// ClosePosition defines a method to close a concentrated liquidity position.
+func (m msgServer) ClosePosition(goCtx context.Context, msg *types.MsgClosePosition) (*types.MsgClosePositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.ClosePosition(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClosePositionResponse{}, nil
+}
+
+// DepositPosition defines a method to add liquidity to a concentrated liquidity position.
+func (m msgServer) DepositPosition(goCtx context.Context, msg *types.MsgDepositPosition) (*types.MsgDepositPositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := m.Keeper.DepositPosition(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDepositPositionResponse{}, nil
+}
+
+// WithdrawPosition defines a method to withdraw a part of a concentrated liquidity position.
+func (m msgServer) WithdrawPosition(goCtx context.Context, msg *types.MsgWithdrawPosition) (*types.MsgWithdrawPositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := m.Keeper.WithdrawPosition(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawPositionResponse{}, nil
+}
+
+// ConditionalOrder defines a method to make a stop-loss or take-profit order.
+func (m msgServer) ConditionalOrder(goCtx context.Context, msg *types.MsgConditionalOrder) (*types.MsgConditionalOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := m.Keeper.ConditionalOrder(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgConditionalOrderResponse{}, nil
+}
+
+// CancelConditionalOrder defines a method to cancel a conditional order.
+func (m msgServer) CancelConditionalOrder(goCtx context.Context, msg *types.MsgCancelConditionalOrder) (*types.MsgCancelConditionalOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.CancelConditionalOrder(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelConditionalOrderResponse{}, nil
+}
+
+// RoutedSwap defines a method to swap coins through multiple pairs.
+func (m msgServer) RoutedSwap(goCtx context.Context, msg *types.MsgRoutedSwap) (*types.MsgRoutedSwapResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := m.Keeper.RoutedSwap(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRoutedSwapResponse{}, nil
+}
+
+// AmendOrder defines a method to amend a limit order.
+func (m msgServer) AmendOrder(goCtx context.Context, msg *types.MsgAmendOrder) (*types.MsgAmendOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := m.Keeper.AmendOrder(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAmendOrderResponse{}, nil
+}
@@ -29,6 +29,18 @@ func (k Keeper) ValidateMsgCreatePair(ctx sdk.Context, msg *types.MsgCreatePair)
 	if _, found := k.GetPairByDenoms(ctx, msg.BaseCoinDenom, msg.QuoteCoinDenom); found {
 		return types.ErrPairAlreadyExists
 	}
+	if msg.SwapFeeRate != nil {
+		allowed := false
+		for _, rate := range k.GetAllowedSwapFeeRates(ctx) {
+			if rate.Equal(*msg.SwapFeeRate) {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return sdkerrors.Wrapf(types.ErrSwapFeeRateNotAllowed, "%s", msg.SwapFeeRate)
+		}
+	}
 	return nil
 }
 
@@ -48,6 +60,7 @@ func (k Keeper) CreatePair(ctx sdk.Context, msg *types.MsgCreatePair) (types.Pai
 
 	id := k.getNextPairIdWithUpdate(ctx)
 	pair := types.NewPair(id, msg.BaseCoinDenom, msg.QuoteCoinDenom)
+	pair.SwapFeeRate = msg.SwapFeeRate
 	k.SetPair(ctx, pair)
 	k.SetPairIndex(ctx, pair.BaseCoinDenom, pair.QuoteCoinDenom, pair.Id)
 	k.SetPairLookupIndex(ctx, pair.BaseCoinDenom, pair.QuoteCoinDenom, pair.Id)
@@ -61,8 +74,39 @@ func (k Keeper) CreatePair(ctx sdk.Context, msg *types.MsgCreatePair) (types.Pai
 			sdk.NewAttribute(types.AttributeKeyQuoteCoinDenom, msg.QuoteCoinDenom),
 			sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(pair.Id, 10)),
 			sdk.NewAttribute(types.AttributeKeyEscrowAddress, pair.EscrowAddress),
+			sdk.NewAttribute(types.AttributeKeySwapFeeRate, k.GetPairSwapFeeRate(ctx, pair).String()),
 		),
 	})
 
 	return pair, nil
 }
+
+// GetPairSwapFeeRate returns the swap fee rate applied to the pair.
+// The global swap fee rate is returned if the pair doesn't have its own rate.
+func (k Keeper) GetPairSwapFeeRate(ctx sdk.Context, pair types.Pair) sdk.Dec {
+	if pair.SwapFeeRate != nil {
+		return *pair.SwapFeeRate
+	}
+	return k.GetSwapFeeRate(ctx)
+}
+
+// SetPairSwapFeeRate changes the swap fee rate of the pair.
+// If rate is nil, the pair uses the global swap fee rate.
+func (k Keeper) SetPairSwapFeeRate(ctx sdk.Context, pairId uint64, rate *sdk.Dec) error {
+	pair, found := k.GetPair(ctx, pairId)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pair %d not found", pairId)
+	}
+	pair.SwapFeeRate = rate
+	k.SetPair(ctx, pair)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSwapFeeRateChanged,
+			sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(pair.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeySwapFeeRate, k.GetPairSwapFeeRate(ctx, pair).String()),
+		),
+	})
+
+	return nil
+}
@@ -89,6 +89,12 @@ func (k Keeper) GetMaxOrderLifespan(ctx sdk.Context) (maxLifespan time.Duration)
 	return
 }
 
+// GetSwapFeeRate returns the current global swap fee rate parameter.
+func (k Keeper) GetSwapFeeRate(ctx sdk.Context) (feeRate sdk.Dec) {
+	k.paramSpace.Get(ctx, types.KeySwapFeeRate, &feeRate)
+	return
+}
+
 // GetWithdrawFeeRate returns the current withdraw fee rate parameter.
 func (k Keeper) GetWithdrawFeeRate(ctx sdk.Context) (feeRate sdk.Dec) {
 	k.paramSpace.Get(ctx, types.KeyWithdrawFeeRate, &feeRate)
@@ -112,3 +118,45 @@ func (k Keeper) GetOrderExtraGas(ctx sdk.Context) (gas sdk.Gas) {
 	k.paramSpace.Get(ctx, types.KeyOrderExtraGas, &gas)
 	return
 }
+
+// GetTradeRecordRetention returns the current trade record retention parameter.
+func (k Keeper) GetTradeRecordRetention(ctx sdk.Context) (retention uint32) {
+	k.paramSpace.Get(ctx, types.KeyTradeRecordRetention, &retention)
+	return
+}
+
+// GetMaxTWAPWindow returns the current max twap window parameter.
+func (k Keeper) GetMaxTWAPWindow(ctx sdk.Context) (window time.Duration) {
+	k.paramSpace.Get(ctx, types.KeyMaxTWAPWindow, &window)
+	return
+}
+
+// GetAllowedSwapFeeRates returns the current allowed swap fee rates parameter.
+func (k Keeper) GetAllowedSwapFeeRates(ctx sdk.Context) (rates []sdk.Dec) {
+	k.paramSpace.Get(ctx, types.KeyAllowedSwapFeeRates, &rates)
+	return
+}
+
+// GetPoolSwapFeeRatio returns the current pool swap fee ratio parameter.
+func (k Keeper) GetPoolSwapFeeRatio(ctx sdk.Context) (ratio sdk.Dec) {
+	k.paramSpace.Get(ctx, types.KeyPoolSwapFeeRatio, &ratio)
+	return
+}
+
+// GetMakerFeeRate returns the current maker fee rate parameter.
+func (k Keeper) GetMakerFeeRate(ctx sdk.Context) (rate sdk.Dec) {
+	k.paramSpace.Get(ctx, types.KeyMakerFeeRate, &rate)
+	return
+}
+
+// GetTakerFeeRate returns the current taker fee rate parameter.
+func (k Keeper) GetTakerFeeRate(ctx sdk.Context) (rate sdk.Dec) {
+	k.paramSpace.Get(ctx, types.KeyTakerFeeRate, &rate)
+	return
+}
+
+// GetPositionCreationFee returns the current position creation fee parameter.
+func (k Keeper) GetPositionCreationFee(ctx sdk.Context) (fee sdk.Coins) {
+	k.paramSpace.Get(ctx, types.KeyPositionCreationFee, &fee)
+	return
+}
@@ -63,3 +63,11 @@ func (s *KeeperTestSuite) TestGetWithdrawExtraGas() {
 func (s *KeeperTestSuite) TestGetOrderExtraGas() {
 	s.Require().EqualValues(types.DefaultOrderExtraGas, s.keeper.GetOrderExtraGas(s.ctx))
 }
+
+func (s *KeeperTestSuite) TestGetTradeRecordRetention() {
+	s.Require().EqualValues(types.DefaultTradeRecordRetention, s.keeper.GetTradeRecordRetention(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestGetMaxTWAPWindow() {
+	s.Require().Equal(types.DefaultMaxTWAPWindow, s.keeper.GetMaxTWAPWindow(s.ctx))
+}
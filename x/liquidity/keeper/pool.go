@@ -280,6 +280,123 @@ func (k Keeper) CreateRangedPool(ctx sdk.Context, msg *types.MsgCreateRangedPool
 	return pool, nil
 }
 
+// ValidateMsgCreateStableswapPool validates types.MsgCreateStableswapPool.
+func (k Keeper) ValidateMsgCreateStableswapPool(ctx sdk.Context, msg *types.MsgCreateStableswapPool) error {
+	pair, found := k.GetPair(ctx, msg.PairId)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pair %d not found", msg.PairId)
+	}
+
+	minInitDepositAmt := k.GetMinInitialDepositAmount(ctx)
+	for _, coin := range msg.DepositCoins {
+		if coin.Denom != pair.BaseCoinDenom && coin.Denom != pair.QuoteCoinDenom {
+			return sdkerrors.Wrapf(types.ErrInvalidCoinDenom, "coin denom %s is not in the pair", coin.Denom)
+		}
+		minDepositCoin := sdk.NewCoin(coin.Denom, minInitDepositAmt)
+		if coin.IsLT(minDepositCoin) {
+			return sdkerrors.Wrapf(
+				types.ErrInsufficientDepositAmount, "%s is smaller than %s", coin, minDepositCoin)
+		}
+	}
+
+	numActivePools := 0
+	_ = k.IteratePoolsByPair(ctx, pair.Id, func(pool types.Pool) (stop bool, err error) {
+		if !pool.Disabled {
+			numActivePools++
+		}
+		return false, nil
+	})
+	if numActivePools >= types.MaxNumActivePoolsPerPair {
+		return types.ErrTooManyPools
+	}
+
+	return nil
+}
+
+// CreateStableswapPool handles types.MsgCreateStableswapPool and creates
+// a stableswap pool.
+func (k Keeper) CreateStableswapPool(ctx sdk.Context, msg *types.MsgCreateStableswapPool) (types.Pool, error) {
+	if err := k.ValidateMsgCreateStableswapPool(ctx, msg); err != nil {
+		return types.Pool{}, err
+	}
+
+	pair, _ := k.GetPair(ctx, msg.PairId)
+
+	x, y := msg.DepositCoins.AmountOf(pair.QuoteCoinDenom), msg.DepositCoins.AmountOf(pair.BaseCoinDenom)
+	ammPool, err := amm.CreateStableswapPool(x, y, msg.Amplification)
+	if err != nil {
+		return types.Pool{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	// Create and save the new pool object.
+	poolId := k.getNextPoolIdWithUpdate(ctx)
+	pool := types.NewStableswapPool(poolId, pair.Id, msg.GetCreator(), msg.Amplification)
+	k.SetPool(ctx, pool)
+	k.SetPoolByReserveIndex(ctx, pool)
+	k.SetPoolsByPairIndex(ctx, pool)
+
+	// Send deposit coins to the pool's reserve account.
+	creator := msg.GetCreator()
+	if err := k.bankKeeper.SendCoins(ctx, creator, pool.GetReserveAddress(), msg.DepositCoins); err != nil {
+		return types.Pool{}, err
+	}
+
+	// Send the pool creation fee to the fee collector.
+	if err := k.bankKeeper.SendCoins(ctx, creator, k.GetFeeCollector(ctx), k.GetPoolCreationFee(ctx)); err != nil {
+		return types.Pool{}, sdkerrors.Wrap(err, "insufficient pool creation fee")
+	}
+
+	// Mint and send pool coin to the creator.
+	// Minimum minting amount is params.MinInitialPoolCoinSupply.
+	ps := sdk.MaxInt(ammPool.PoolCoinSupply(), k.GetMinInitialPoolCoinSupply(ctx))
+	poolCoin := sdk.NewCoin(pool.PoolCoinDenom, ps)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(poolCoin)); err != nil {
+		return types.Pool{}, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, creator, sdk.NewCoins(poolCoin)); err != nil {
+		return types.Pool{}, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCreateStableswapPool,
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(msg.PairId, 10)),
+			sdk.NewAttribute(types.AttributeKeyDepositCoins, msg.DepositCoins.String()),
+			sdk.NewAttribute(types.AttributeKeyAmplification, strconv.FormatUint(msg.Amplification, 10)),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(pool.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyReserveAddress, pool.ReserveAddress),
+			sdk.NewAttribute(types.AttributeKeyMintedPoolCoin, poolCoin.String()),
+		),
+	})
+
+	return pool, nil
+}
+
+// SetPoolAmplification changes the amplification coefficient of
+// a stableswap pool.
+func (k Keeper) SetPoolAmplification(ctx sdk.Context, poolId uint64, amp uint64) error {
+	pool, found := k.GetPool(ctx, poolId)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pool %d not found", poolId)
+	}
+	if pool.Type != types.PoolTypeStableswap {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "pool %d is not a stableswap pool", poolId)
+	}
+	pool.Amplification = amp
+	k.SetPool(ctx, pool)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAmplificationChanged,
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(pool.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyAmplification, strconv.FormatUint(amp, 10)),
+		),
+	})
+
+	return nil
+}
+
 // ValidateMsgDeposit validates types.MsgDeposit.
 func (k Keeper) ValidateMsgDeposit(ctx sdk.Context, msg *types.MsgDeposit) error {
 	pool, found := k.GetPool(ctx, msg.PoolId)
@@ -289,7 +406,7 @@ func (k Keeper) ValidateMsgDeposit(ctx sdk.Context, msg *types.MsgDeposit) error
 	if pool.Disabled {
 		return types.ErrDisabledPool
 	}
-	if pool.Type == types.PoolTypeBasic && len(msg.DepositCoins) != 2 {
+	if (pool.Type == types.PoolTypeBasic || pool.Type == types.PoolTypeStableswap) && len(msg.DepositCoins) != 2 {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "wrong number of deposit coins: %d", len(msg.DepositCoins))
 	}
 
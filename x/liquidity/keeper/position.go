@@ -0,0 +1,272 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmosquad-labs/squad/v3/x/liquidity/amm"
+	"github.com/cosmosquad-labs/squad/v3/x/liquidity/types"
+)
+
+// getNextPositionIdWithUpdate increments position id by one and set it.
+func (k Keeper) getNextPositionIdWithUpdate(ctx sdk.Context) uint64 {
+	id := k.GetLastPositionId(ctx) + 1
+	k.SetLastPositionId(ctx, id)
+	return id
+}
+
+// GetPositionBalances returns the balances of the position.
+func (k Keeper) GetPositionBalances(ctx sdk.Context, position types.Position) (rx sdk.Coin, ry sdk.Coin) {
+	pair, _ := k.GetPair(ctx, position.PairId)
+	return k.getPositionBalances(ctx, position, pair)
+}
+
+// getPositionBalances returns the balances of the position.
+// It is used internally when caller already has types.Pair instance.
+func (k Keeper) getPositionBalances(ctx sdk.Context, position types.Position, pair types.Pair) (rx sdk.Coin, ry sdk.Coin) {
+	spendable := k.bankKeeper.SpendableCoins(ctx, position.GetReserveAddress())
+	rx = sdk.NewCoin(pair.QuoteCoinDenom, spendable.AmountOf(pair.QuoteCoinDenom))
+	ry = sdk.NewCoin(pair.BaseCoinDenom, spendable.AmountOf(pair.BaseCoinDenom))
+	return
+}
+
+// ValidateMsgCreatePosition validates types.MsgCreatePosition.
+func (k Keeper) ValidateMsgCreatePosition(ctx sdk.Context, msg *types.MsgCreatePosition) error {
+	tickPrec := k.GetTickPrecision(ctx)
+	if !amm.PriceToDownTick(msg.MinPrice, int(tickPrec)).Equal(msg.MinPrice) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "min price is not on ticks")
+	}
+	if !amm.PriceToDownTick(msg.MaxPrice, int(tickPrec)).Equal(msg.MaxPrice) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "max price is not on ticks")
+	}
+
+	lowestTick := amm.LowestTick(int(tickPrec))
+	if msg.MinPrice.LT(lowestTick) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "min price must not be less than %s", lowestTick)
+	}
+
+	pair, found := k.GetPair(ctx, msg.PairId)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pair %d not found", msg.PairId)
+	}
+	if pair.LastPrice == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "pair %d has no last price", pair.Id)
+	}
+
+	for _, coin := range msg.DepositCoins {
+		if coin.Denom != pair.BaseCoinDenom && coin.Denom != pair.QuoteCoinDenom {
+			return sdkerrors.Wrapf(types.ErrInvalidCoinDenom, "coin denom %s is not in the pair", coin.Denom)
+		}
+	}
+
+	if k.GetNumPositionsByPair(ctx, pair.Id) >= types.MaxNumPositionsPerPair {
+		return types.ErrTooManyPositions
+	}
+
+	return nil
+}
+
+// CreatePosition handles types.MsgCreatePosition and creates a concentrated
+// liquidity position.
+// The position starts at the pair's last price, clamped into the position's
+// price range, so a position whose range doesn't include the last price
+// accepts only one of the pair's coins.
+func (k Keeper) CreatePosition(ctx sdk.Context, msg *types.MsgCreatePosition) (types.Position, error) {
+	if err := k.ValidateMsgCreatePosition(ctx, msg); err != nil {
+		return types.Position{}, err
+	}
+
+	pair, _ := k.GetPair(ctx, msg.PairId)
+
+	initialPrice := *pair.LastPrice
+	if initialPrice.LT(msg.MinPrice) {
+		initialPrice = msg.MinPrice
+	} else if initialPrice.GT(msg.MaxPrice) {
+		initialPrice = msg.MaxPrice
+	}
+
+	x, y := msg.DepositCoins.AmountOf(pair.QuoteCoinDenom), msg.DepositCoins.AmountOf(pair.BaseCoinDenom)
+	ammPool, err := amm.CreateRangedPool(x, y, msg.MinPrice, msg.MaxPrice, initialPrice)
+	if err != nil {
+		return types.Position{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	ax, ay := ammPool.Balances()
+
+	minInitDepositAmt := k.GetMinInitialDepositAmount(ctx)
+	if ax.LT(minInitDepositAmt) && ay.LT(minInitDepositAmt) {
+		return types.Position{}, types.ErrInsufficientDepositAmount
+	}
+
+	// Create and save the new position object.
+	positionId := k.getNextPositionIdWithUpdate(ctx)
+	position := types.NewPosition(positionId, pair.Id, msg.GetOwner(), msg.MinPrice, msg.MaxPrice)
+	k.SetPosition(ctx, position)
+	k.SetPositionIndex(ctx, position)
+	k.SetPositionsByPairIndex(ctx, position)
+	k.SetNumPositionsByPair(ctx, pair.Id, k.GetNumPositionsByPair(ctx, pair.Id)+1)
+
+	// Send deposit coins to the position's reserve account.
+	depositCoins := sdk.NewCoins(
+		sdk.NewCoin(pair.QuoteCoinDenom, ax), sdk.NewCoin(pair.BaseCoinDenom, ay))
+	if err := k.bankKeeper.SendCoins(ctx, msg.GetOwner(), position.GetReserveAddress(), depositCoins); err != nil {
+		return types.Position{}, err
+	}
+
+	// Send the position creation fee to the fee collector.
+	feeCollector := k.GetFeeCollector(ctx)
+	positionCreationFee := k.GetPositionCreationFee(ctx)
+	if err := k.bankKeeper.SendCoins(ctx, msg.GetOwner(), feeCollector, positionCreationFee); err != nil {
+		return types.Position{}, sdkerrors.Wrap(err, "insufficient position creation fee")
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCreatePosition,
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.Owner),
+			sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(msg.PairId, 10)),
+			sdk.NewAttribute(types.AttributeKeyDepositCoins, msg.DepositCoins.String()),
+			sdk.NewAttribute(types.AttributeKeyAcceptedCoins, depositCoins.String()),
+			sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(position.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyReserveAddress, position.ReserveAddress),
+			sdk.NewAttribute(types.AttributeKeyMinPrice, msg.MinPrice.String()),
+			sdk.NewAttribute(types.AttributeKeyMaxPrice, msg.MaxPrice.String()),
+		),
+	})
+
+	return position, nil
+}
+
+// ClosePosition handles types.MsgClosePosition and closes the position,
+// sending all the remaining reserve coins back to the owner.
+func (k Keeper) ClosePosition(ctx sdk.Context, msg *types.MsgClosePosition) error {
+	position, found := k.GetPosition(ctx, msg.PositionId)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "position %d not found", msg.PositionId)
+	}
+	if msg.Owner != position.Owner {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "mismatching owner")
+	}
+
+	rx, ry := k.GetPositionBalances(ctx, position)
+	withdrawnCoins := sdk.NewCoins(rx, ry)
+	if !withdrawnCoins.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, position.GetReserveAddress(), msg.GetOwner(), withdrawnCoins); err != nil {
+			return err
+		}
+	}
+
+	k.DeletePosition(ctx, position)
+	k.SetNumPositionsByPair(ctx, position.PairId, k.GetNumPositionsByPair(ctx, position.PairId)-1)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeClosePosition,
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.Owner),
+			sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(position.PairId, 10)),
+			sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(position.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyWithdrawnCoins, withdrawnCoins.String()),
+		),
+	})
+
+	return nil
+}
+
+// DepositPosition handles types.MsgDepositPosition and adds liquidity to the
+// position.
+// Deposit coins are accepted in proportion to the position's reserves, so
+// that the position's price doesn't change.
+func (k Keeper) DepositPosition(ctx sdk.Context, msg *types.MsgDepositPosition) (acceptedCoins sdk.Coins, err error) {
+	position, found := k.GetPosition(ctx, msg.PositionId)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "position %d not found", msg.PositionId)
+	}
+	if msg.Owner != position.Owner {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "mismatching owner")
+	}
+
+	pair, _ := k.GetPair(ctx, position.PairId)
+	for _, coin := range msg.DepositCoins {
+		if coin.Denom != pair.BaseCoinDenom && coin.Denom != pair.QuoteCoinDenom {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidCoinDenom, "coin denom %s is not in the pair", coin.Denom)
+		}
+	}
+
+	rx, ry := k.getPositionBalances(ctx, position, pair)
+	if rx.IsZero() && ry.IsZero() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "position %d has no reserve", position.Id)
+	}
+	x, y := msg.DepositCoins.AmountOf(pair.QuoteCoinDenom), msg.DepositCoins.AmountOf(pair.BaseCoinDenom)
+
+	// ratio = min(x / rx, y / ry), ignoring the empty side of the reserves.
+	var ratio sdk.Dec
+	switch {
+	case rx.IsZero():
+		ratio = y.ToDec().QuoTruncate(ry.Amount.ToDec())
+	case ry.IsZero():
+		ratio = x.ToDec().QuoTruncate(rx.Amount.ToDec())
+	default:
+		ratio = sdk.MinDec(x.ToDec().QuoTruncate(rx.Amount.ToDec()), y.ToDec().QuoTruncate(ry.Amount.ToDec()))
+	}
+	ax := rx.Amount.ToDec().Mul(ratio).Ceil().TruncateInt()
+	ay := ry.Amount.ToDec().Mul(ratio).Ceil().TruncateInt()
+	if ax.IsZero() && ay.IsZero() {
+		return nil, types.ErrInsufficientDepositAmount
+	}
+
+	acceptedCoins = sdk.NewCoins(sdk.NewCoin(pair.QuoteCoinDenom, ax), sdk.NewCoin(pair.BaseCoinDenom, ay))
+	if err := k.bankKeeper.SendCoins(ctx, msg.GetOwner(), position.GetReserveAddress(), acceptedCoins); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDepositPosition,
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.Owner),
+			sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(position.PairId, 10)),
+			sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(position.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyDepositCoins, msg.DepositCoins.String()),
+			sdk.NewAttribute(types.AttributeKeyAcceptedCoins, acceptedCoins.String()),
+		),
+	})
+
+	return acceptedCoins, nil
+}
+
+// WithdrawPosition handles types.MsgWithdrawPosition and withdraws the given
+// ratio of the position's reserves to the owner.
+// The position stays open with the rest of its reserves.
+func (k Keeper) WithdrawPosition(ctx sdk.Context, msg *types.MsgWithdrawPosition) (withdrawnCoins sdk.Coins, err error) {
+	position, found := k.GetPosition(ctx, msg.PositionId)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "position %d not found", msg.PositionId)
+	}
+	if msg.Owner != position.Owner {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "mismatching owner")
+	}
+
+	rx, ry := k.GetPositionBalances(ctx, position)
+	x := rx.Amount.ToDec().MulTruncate(msg.Ratio).TruncateInt()
+	y := ry.Amount.ToDec().MulTruncate(msg.Ratio).TruncateInt()
+	withdrawnCoins = sdk.NewCoins(sdk.NewCoin(rx.Denom, x), sdk.NewCoin(ry.Denom, y))
+	if withdrawnCoins.IsZero() {
+		return nil, types.ErrTooSmallWithdrawAmount
+	}
+	if err := k.bankKeeper.SendCoins(ctx, position.GetReserveAddress(), msg.GetOwner(), withdrawnCoins); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeWithdrawPosition,
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.Owner),
+			sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(position.PairId, 10)),
+			sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(position.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyRatio, msg.Ratio.String()),
+			sdk.NewAttribute(types.AttributeKeyWithdrawnCoins, withdrawnCoins.String()),
+		),
+	})
+
+	return withdrawnCoins, nil
+}
@@ -0,0 +1,315 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	utils "github.com/cosmosquad-labs/squad/v3/types"
+	"github.com/cosmosquad-labs/squad/v3/x/liquidity/types"
+)
+
+func (s *KeeperTestSuite) TestCreatePosition() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pair.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair)
+
+	// A pair without last price.
+	pair2 := s.createPair(s.addr(0), "denom2", "denom3", true)
+
+	owner := s.addr(1)
+	s.fundAddr(owner, utils.ParseCoins("1000000000denom1,1000000000denom2,1000000000denom3,1000000000stake"))
+
+	for _, tc := range []struct {
+		name        string
+		msg         *types.MsgCreatePosition
+		expectedErr string
+	}{
+		{
+			"happy case",
+			types.NewMsgCreatePosition(
+				owner, pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"),
+				utils.ParseDec("0.9"), utils.ParseDec("1.1")),
+			"",
+		},
+		{
+			"single asset position",
+			types.NewMsgCreatePosition(
+				owner, pair.Id, utils.ParseCoins("1000000denom1"),
+				utils.ParseDec("1.1"), utils.ParseDec("1.2")),
+			"",
+		},
+		{
+			"pair not found",
+			types.NewMsgCreatePosition(
+				owner, 3, utils.ParseCoins("1000000denom1,1000000denom2"),
+				utils.ParseDec("0.9"), utils.ParseDec("1.1")),
+			"pair 3 not found: not found",
+		},
+		{
+			"no last price",
+			types.NewMsgCreatePosition(
+				owner, pair2.Id, utils.ParseCoins("1000000denom2,1000000denom3"),
+				utils.ParseDec("0.9"), utils.ParseDec("1.1")),
+			"pair 2 has no last price: invalid request",
+		},
+		{
+			"wrong coin denom",
+			types.NewMsgCreatePosition(
+				owner, pair.Id, utils.ParseCoins("1000000denom1,1000000denom3"),
+				utils.ParseDec("0.9"), utils.ParseDec("1.1")),
+			"coin denom denom3 is not in the pair: invalid coin denom",
+		},
+		{
+			"price not on ticks",
+			types.NewMsgCreatePosition(
+				owner, pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"),
+				utils.ParseDec("0.900001"), utils.ParseDec("1.1")),
+			"min price is not on ticks: invalid request",
+		},
+		{
+			"only unneeded coin is provided",
+			types.NewMsgCreatePosition(
+				owner, pair.Id, utils.ParseCoins("1000000denom2"),
+				utils.ParseDec("1.1"), utils.ParseDec("1.2")),
+			"insufficient deposit amount",
+		},
+	} {
+		s.Run(tc.name, func() {
+			s.Require().NoError(tc.msg.ValidateBasic())
+			position, err := s.keeper.CreatePosition(s.ctx, tc.msg)
+			if tc.expectedErr == "" {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.msg.Owner, position.Owner)
+				s.Require().Equal(types.PositionReserveAddress(position.Id).String(), position.ReserveAddress)
+				rx, ry := s.keeper.GetPositionBalances(s.ctx, position)
+				s.Require().True(rx.IsPositive() || ry.IsPositive())
+			} else {
+				s.Require().EqualError(err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestCreatePositionOutOfRange() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pair.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair)
+
+	// The price range is above the last price, so the position accepts
+	// only the base coin.
+	owner := s.addr(1)
+	position := s.createPosition(
+		owner, pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"),
+		utils.ParseDec("1.1"), utils.ParseDec("1.2"), true)
+	rx, ry := s.keeper.GetPositionBalances(s.ctx, position)
+	s.Require().True(coinEq(utils.ParseCoin("0denom2"), rx))
+	s.Require().True(coinEq(utils.ParseCoin("1000000denom1"), ry))
+	s.Require().True(coinsEq(utils.ParseCoins("1000000denom2"), s.getBalances(owner)))
+
+	// The price range is below the last price, so the position accepts
+	// only the quote coin.
+	owner2 := s.addr(2)
+	position2 := s.createPosition(
+		owner2, pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"),
+		utils.ParseDec("0.8"), utils.ParseDec("0.9"), true)
+	rx, ry = s.keeper.GetPositionBalances(s.ctx, position2)
+	s.Require().True(coinEq(utils.ParseCoin("1000000denom2"), rx))
+	s.Require().True(coinEq(utils.ParseCoin("0denom1"), ry))
+	s.Require().True(coinsEq(utils.ParseCoins("1000000denom1"), s.getBalances(owner2)))
+}
+
+func (s *KeeperTestSuite) TestTooManyPositions() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pair.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair)
+
+	for i := 0; i < types.MaxNumPositionsPerPair; i++ {
+		s.createPosition(
+			s.addr(1), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"),
+			utils.ParseDec("0.9"), utils.ParseDec("1.1"), true)
+	}
+
+	depositCoins := utils.ParseCoins("1000000denom1,1000000denom2")
+	s.fundAddr(s.addr(1), depositCoins)
+	_, err := s.keeper.CreatePosition(s.ctx, types.NewMsgCreatePosition(
+		s.addr(1), pair.Id, depositCoins, utils.ParseDec("0.9"), utils.ParseDec("1.1")))
+	s.Require().ErrorIs(err, types.ErrTooManyPositions)
+
+	// Closing a position makes room for a new one.
+	s.closePosition(s.addr(1), 1)
+	s.Require().EqualValues(types.MaxNumPositionsPerPair-1, s.keeper.GetNumPositionsByPair(s.ctx, pair.Id))
+	s.createPosition(
+		s.addr(1), pair.Id, depositCoins, utils.ParseDec("0.9"), utils.ParseDec("1.1"), true)
+	s.Require().EqualValues(types.MaxNumPositionsPerPair, s.keeper.GetNumPositionsByPair(s.ctx, pair.Id))
+}
+
+func (s *KeeperTestSuite) TestPositionCreationFee() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pair.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair)
+
+	params := s.keeper.GetParams(s.ctx)
+	params.PositionCreationFee = utils.ParseCoins("1000stake")
+	s.keeper.SetParams(s.ctx, params)
+
+	owner := s.addr(1)
+	depositCoins := utils.ParseCoins("1000000denom1,1000000denom2")
+	s.fundAddr(owner, depositCoins)
+	cacheCtx, _ := s.ctx.CacheContext()
+	_, err := s.keeper.CreatePosition(cacheCtx, types.NewMsgCreatePosition(
+		owner, pair.Id, depositCoins, utils.ParseDec("0.9"), utils.ParseDec("1.1")))
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	feeCollector := s.keeper.GetFeeCollector(s.ctx)
+	feeCollectorBalances := s.getBalances(feeCollector)
+	s.fundAddr(owner, utils.ParseCoins("1000stake"))
+	s.createPosition(owner, pair.Id, depositCoins, utils.ParseDec("0.9"), utils.ParseDec("1.1"), false)
+	s.Require().True(coinsEq(feeCollectorBalances.Add(utils.ParseCoin("1000stake")), s.getBalances(feeCollector)))
+	s.Require().True(s.getBalance(owner, "stake").IsZero())
+}
+
+func (s *KeeperTestSuite) TestClosePosition() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pair.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair)
+
+	owner := s.addr(1)
+	position := s.createPosition(
+		owner, pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"),
+		utils.ParseDec("0.9"), utils.ParseDec("1.1"), true)
+	rx, ry := s.keeper.GetPositionBalances(s.ctx, position)
+	ownerBalances := s.getBalances(owner)
+
+	err := s.keeper.ClosePosition(s.ctx, types.NewMsgClosePosition(s.addr(2), position.Id))
+	s.Require().EqualError(err, "mismatching owner: unauthorized")
+
+	err = s.keeper.ClosePosition(s.ctx, types.NewMsgClosePosition(owner, 10))
+	s.Require().EqualError(err, "position 10 not found: not found")
+
+	s.closePosition(owner, position.Id)
+	s.Require().True(coinsEq(ownerBalances.Add(sdk.NewCoins(rx, ry)...), s.getBalances(owner)))
+	s.Require().True(s.getBalances(position.GetReserveAddress()).IsZero())
+
+	_, found := s.keeper.GetPosition(s.ctx, position.Id)
+	s.Require().False(found)
+	s.Require().Empty(s.keeper.GetPositionsByOwner(s.ctx, owner))
+	s.Require().Empty(s.keeper.GetPositionsByPair(s.ctx, pair.Id))
+	s.Require().Zero(s.keeper.GetNumPositionsByPair(s.ctx, pair.Id))
+}
+
+func (s *KeeperTestSuite) TestDepositPosition() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pair.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair)
+
+	owner := s.addr(1)
+	position := s.createPosition(
+		owner, pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"),
+		utils.ParseDec("0.9"), utils.ParseDec("1.1"), true)
+	rx, ry := s.keeper.GetPositionBalances(s.ctx, position)
+	pool := position.AMMPool(rx.Amount, ry.Amount)
+
+	s.fundAddr(owner, utils.ParseCoins("5000000denom1,1000000denom2"))
+	_, err := s.keeper.DepositPosition(s.ctx, types.NewMsgDepositPosition(
+		s.addr(2), position.Id, utils.ParseCoins("1000000denom1")))
+	s.Require().EqualError(err, "mismatching owner: unauthorized")
+	_, err = s.keeper.DepositPosition(s.ctx, types.NewMsgDepositPosition(
+		owner, position.Id, utils.ParseCoins("1000000denom3")))
+	s.Require().EqualError(err, "coin denom denom3 is not in the pair: invalid coin denom")
+
+	// Coins are accepted in proportion to the reserves, doubling them.
+	acceptedCoins, err := s.keeper.DepositPosition(s.ctx, types.NewMsgDepositPosition(
+		owner, position.Id, utils.ParseCoins("5000000denom1,1000000denom2")))
+	s.Require().NoError(err)
+	s.Require().True(coinsEq(sdk.NewCoins(rx, ry), acceptedCoins))
+	s.Require().True(coinsEq(sdk.NewCoins(rx.Add(rx), ry.Add(ry)), s.getBalances(position.GetReserveAddress())))
+
+	// The position's price is not changed.
+	rx2, ry2 := s.keeper.GetPositionBalances(s.ctx, position)
+	s.Require().True(decEq(pool.Price(), position.AMMPool(rx2.Amount, ry2.Amount).Price()))
+}
+
+func (s *KeeperTestSuite) TestWithdrawPosition() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pair.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair)
+
+	owner := s.addr(1)
+	position := s.createPosition(
+		owner, pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"),
+		utils.ParseDec("0.9"), utils.ParseDec("1.1"), true)
+	rx, ry := s.keeper.GetPositionBalances(s.ctx, position)
+	ownerBalances := s.getBalances(owner)
+
+	_, err := s.keeper.WithdrawPosition(s.ctx, types.NewMsgWithdrawPosition(
+		s.addr(2), position.Id, utils.ParseDec("0.5")))
+	s.Require().EqualError(err, "mismatching owner: unauthorized")
+
+	withdrawnCoins, err := s.keeper.WithdrawPosition(s.ctx, types.NewMsgWithdrawPosition(
+		owner, position.Id, utils.ParseDec("0.25")))
+	s.Require().NoError(err)
+	expected := sdk.NewCoins(
+		sdk.NewCoin(rx.Denom, rx.Amount.QuoRaw(4)), sdk.NewCoin(ry.Denom, ry.Amount.QuoRaw(4)))
+	s.Require().True(coinsEq(expected, withdrawnCoins))
+	s.Require().True(coinsEq(ownerBalances.Add(expected...), s.getBalances(owner)))
+	s.Require().True(coinsEq(sdk.NewCoins(rx, ry).Sub(expected), s.getBalances(position.GetReserveAddress())))
+
+	// The position stays open.
+	_, found := s.keeper.GetPosition(s.ctx, position.Id)
+	s.Require().True(found)
+}
+
+func (s *KeeperTestSuite) TestPositionMatching() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pair.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair)
+
+	position := s.createPosition(
+		s.addr(1), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"),
+		utils.ParseDec("0.95"), utils.ParseDec("1.05"), true)
+	rx, ry := s.keeper.GetPositionBalances(s.ctx, position)
+
+	s.sellLimitOrder(s.addr(2), pair.Id, utils.ParseDec("0.98"), sdk.NewInt(100000), time.Hour, true)
+	s.nextBlock()
+
+	// The sell order is fully matched with the position's buy orders.
+	s.Require().True(s.getBalance(s.addr(2), "denom1").IsZero())
+	s.Require().True(s.getBalance(s.addr(2), "denom2").IsPositive())
+
+	rx2, ry2 := s.keeper.GetPositionBalances(s.ctx, position)
+	s.Require().True(rx2.IsLT(rx))
+	s.Require().True(coinEq(ry.AddAmount(sdk.NewInt(100000)), ry2))
+
+	pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+	s.Require().True(pair.LastPrice.LT(utils.ParseDec("1.0")))
+	s.Require().True(pair.LastPrice.GTE(utils.ParseDec("0.98")))
+}
+
+func (s *KeeperTestSuite) TestPositionsWithPool() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+
+	// Make the last price with the pool.
+	s.buyLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.01"), sdk.NewInt(1000), 0, true)
+	s.nextBlock()
+	pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+	s.Require().NotNil(pair.LastPrice)
+
+	// A narrow position provides much deeper liquidity near the price.
+	position := s.createPosition(
+		s.addr(2), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"),
+		utils.ParseDec("0.99"), utils.ParseDec("1.01"), true)
+	rx, _ := s.keeper.GetPositionBalances(s.ctx, position)
+
+	s.sellMarketOrder(s.addr(3), pair.Id, sdk.NewInt(100000), 0, true)
+	s.nextBlock()
+
+	s.Require().True(s.getBalance(s.addr(3), "denom1").IsZero())
+	rx2, _ := s.keeper.GetPositionBalances(s.ctx, position)
+	s.Require().True(rx2.IsLT(rx))
+
+	pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+	s.Require().True(pair.LastPrice.GTE(utils.ParseDec("0.99")))
+}
@@ -0,0 +1,78 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/cosmosquad-labs/squad/v3/types"
+	"github.com/cosmosquad-labs/squad/v3/x/liquidity"
+	"github.com/cosmosquad-labs/squad/v3/x/liquidity/amm"
+	"github.com/cosmosquad-labs/squad/v3/x/liquidity/types"
+)
+
+func (s *KeeperTestSuite) postOnlyOrder(
+	orderer sdk.AccAddress, pairId uint64, dir types.OrderDirection,
+	price sdk.Dec, amt sdk.Int, orderLifespan time.Duration) (types.Order, error) {
+	s.T().Helper()
+	pair, found := s.keeper.GetPair(s.ctx, pairId)
+	s.Require().True(found)
+	var offerCoin sdk.Coin
+	var demandCoinDenom string
+	switch dir {
+	case types.OrderDirectionBuy:
+		offerCoin = sdk.NewCoin(pair.QuoteCoinDenom, amm.OfferCoinAmount(amm.Buy, price, amt))
+		demandCoinDenom = pair.BaseCoinDenom
+	case types.OrderDirectionSell:
+		offerCoin = sdk.NewCoin(pair.BaseCoinDenom, amt)
+		demandCoinDenom = pair.QuoteCoinDenom
+	}
+	s.fundAddr(orderer, sdk.NewCoins(offerCoin))
+	msg := types.NewMsgLimitOrder(orderer, pairId, dir, offerCoin, demandCoinDenom, price, amt, orderLifespan)
+	msg.PostOnly = true
+	s.Require().NoError(msg.ValidateBasic())
+	return s.keeper.LimitOrder(s.ctx, msg)
+}
+
+func (s *KeeperTestSuite) TestPostOnlyOrder() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	s.sellLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(1000000), time.Hour, true)
+	s.buyLimitOrder(s.addr(1), pair.Id, utils.ParseDec("0.9"), sdk.NewInt(1000000), time.Hour, true)
+
+	// Orders crossing resting orders are rejected.
+	_, err := s.postOnlyOrder(s.addr(2), pair.Id, types.OrderDirectionBuy, utils.ParseDec("1.0"), sdk.NewInt(1000000), time.Hour)
+	s.Require().ErrorIs(err, types.ErrPostOnlyOrderCrosses)
+	_, err = s.postOnlyOrder(s.addr(2), pair.Id, types.OrderDirectionSell, utils.ParseDec("0.9"), sdk.NewInt(1000000), time.Hour)
+	s.Require().ErrorIs(err, types.ErrPostOnlyOrderCrosses)
+
+	// Orders not crossing the order book are accepted.
+	order, err := s.postOnlyOrder(s.addr(2), pair.Id, types.OrderDirectionBuy, utils.ParseDec("0.99"), sdk.NewInt(1000000), time.Hour)
+	s.Require().NoError(err)
+	s.Require().True(order.PostOnly)
+	_, err = s.postOnlyOrder(s.addr(2), pair.Id, types.OrderDirectionSell, utils.ParseDec("1.01"), sdk.NewInt(1000000), time.Hour)
+	s.Require().NoError(err)
+
+	// Crossing pools' orders is also rejected.
+	pair2 := s.createPair(s.addr(0), "denom3", "denom4", true)
+	s.createPool(s.addr(0), pair2.Id, utils.ParseCoins("1000000000denom3,1000000000denom4"), true)
+	_, err = s.postOnlyOrder(s.addr(2), pair2.Id, types.OrderDirectionBuy, utils.ParseDec("1.0"), sdk.NewInt(1000000), time.Hour)
+	s.Require().ErrorIs(err, types.ErrPostOnlyOrderCrosses)
+	_, err = s.postOnlyOrder(s.addr(2), pair2.Id, types.OrderDirectionBuy, utils.ParseDec("0.999"), sdk.NewInt(1000000), time.Hour)
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestPostOnlyOrder_Match() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	// A post-only order can still be matched by orders placed later in
+	// the same batch.
+	order, err := s.postOnlyOrder(s.addr(1), pair.Id, types.OrderDirectionSell, utils.ParseDec("1.0"), sdk.NewInt(1000000), time.Hour)
+	s.Require().NoError(err)
+	s.buyLimitOrder(s.addr(2), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(1000000), 0, true)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+
+	order, found := s.keeper.GetOrder(s.ctx, pair.Id, order.Id)
+	s.Require().True(found)
+	s.Require().Equal(types.OrderStatusCompleted, order.Status)
+}
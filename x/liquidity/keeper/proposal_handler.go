@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmosquad-labs/squad/v3/x/liquidity/types"
+)
+
+// HandleSwapFeeRateProposal is a handler for executing a swap fee rate proposal.
+func HandleSwapFeeRateProposal(ctx sdk.Context, k Keeper, proposal *types.SwapFeeRateProposal) error {
+	for _, change := range proposal.Changes {
+		if err := k.SetPairSwapFeeRate(ctx, change.PairId, change.SwapFeeRate); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// HandleAmplificationProposal is a handler for executing an amplification
+// proposal.
+func HandleAmplificationProposal(ctx sdk.Context, k Keeper, proposal *types.AmplificationProposal) error {
+	for _, change := range proposal.Changes {
+		if err := k.SetPoolAmplification(ctx, change.PoolId, change.Amplification); err != nil {
+			return err
+		}
+	}
+	return nil
+}
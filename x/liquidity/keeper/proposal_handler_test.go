@@ -0,0 +1,43 @@
+package keeper_test
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	utils "github.com/cosmosquad-labs/squad/v3/types"
+	"github.com/cosmosquad-labs/squad/v3/x/liquidity/keeper"
+	"github.com/cosmosquad-labs/squad/v3/x/liquidity/types"
+)
+
+func (s *KeeperTestSuite) TestSwapFeeRateProposal() {
+	pair1 := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pair2 := s.createPair(s.addr(0), "denom2", "denom3", true)
+
+	// Governance can set a swap fee rate out of the allowed set.
+	proposal := types.NewSwapFeeRateProposal("title", "description", []types.SwapFeeRateChange{
+		{PairId: pair1.Id, SwapFeeRate: utils.ParseDecP("0.002")},
+		{PairId: pair2.Id, SwapFeeRate: utils.ParseDecP("0.01")},
+	})
+	s.Require().NoError(proposal.ValidateBasic())
+	s.Require().NoError(keeper.HandleSwapFeeRateProposal(s.ctx, s.keeper, proposal))
+
+	pair1, _ = s.keeper.GetPair(s.ctx, pair1.Id)
+	s.Require().True(decEq(utils.ParseDec("0.002"), s.keeper.GetPairSwapFeeRate(s.ctx, pair1)))
+	pair2, _ = s.keeper.GetPair(s.ctx, pair2.Id)
+	s.Require().True(decEq(utils.ParseDec("0.01"), s.keeper.GetPairSwapFeeRate(s.ctx, pair2)))
+
+	// Resetting the rate makes the pair use the global swap fee rate.
+	proposal = types.NewSwapFeeRateProposal("title", "description", []types.SwapFeeRateChange{
+		{PairId: pair1.Id, SwapFeeRate: nil},
+	})
+	s.Require().NoError(keeper.HandleSwapFeeRateProposal(s.ctx, s.keeper, proposal))
+	pair1, _ = s.keeper.GetPair(s.ctx, pair1.Id)
+	s.Require().Nil(pair1.SwapFeeRate)
+	s.Require().True(decEq(s.keeper.GetSwapFeeRate(s.ctx), s.keeper.GetPairSwapFeeRate(s.ctx, pair1)))
+
+	// Pair not found.
+	proposal = types.NewSwapFeeRateProposal("title", "description", []types.SwapFeeRateChange{
+		{PairId: 10, SwapFeeRate: utils.ParseDecP("0.01")},
+	})
+	err := keeper.HandleSwapFeeRateProposal(s.ctx, s.keeper, proposal)
+	s.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+}
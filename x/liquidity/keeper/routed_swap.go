@@ -0,0 +1,483 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmosquad-labs/squad/v3/x/liquidity/amm"
+	"github.com/cosmosquad-labs/squad/v3/x/liquidity/types"
+)
+
+// getNextRoutedSwapRequestIdWithUpdate increments routed swap request id by
+// one and set it.
+func (k Keeper) getNextRoutedSwapRequestIdWithUpdate(ctx sdk.Context) uint64 {
+	id := k.GetLastRoutedSwapRequestId(ctx) + 1
+	k.SetLastRoutedSwapRequestId(ctx, id)
+	return id
+}
+
+// RoutePairs returns the pairs in the swap route along with the denom of the
+// coin which will be received at the end of the route.
+// Each pair in the route must have the denom of the coin from the previous
+// pair as either its base coin denom or quote coin denom.
+func (k Keeper) RoutePairs(ctx sdk.Context, pairIds []uint64, offerCoinDenom string) (pairs []types.Pair, demandCoinDenom string, err error) {
+	denom := offerCoinDenom
+	for _, pairId := range pairIds {
+		pair, found := k.GetPair(ctx, pairId)
+		if !found {
+			return nil, "", sdkerrors.Wrapf(sdkerrors.ErrNotFound, "pair %d not found", pairId)
+		}
+		switch denom {
+		case pair.BaseCoinDenom:
+			denom = pair.QuoteCoinDenom
+		case pair.QuoteCoinDenom:
+			denom = pair.BaseCoinDenom
+		default:
+			return nil, "", sdkerrors.Wrapf(
+				types.ErrWrongRoute, "pair %d does not have denom %s", pairId, denom)
+		}
+		if pair.LastPrice == nil {
+			return nil, "", sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "pair %d has no last price", pairId)
+		}
+		pairs = append(pairs, pair)
+	}
+	return pairs, denom, nil
+}
+
+// ValidateMsgRoutedSwap validates types.MsgRoutedSwap with state.
+func (k Keeper) ValidateMsgRoutedSwap(ctx sdk.Context, msg *types.MsgRoutedSwap) error {
+	spendable := k.bankKeeper.SpendableCoins(ctx, msg.GetOrderer())
+	if spendableAmt := spendable.AmountOf(msg.OfferCoin.Denom); spendableAmt.LT(msg.OfferCoin.Amount) {
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrInsufficientFunds, "%s is smaller than %s",
+			sdk.NewCoin(msg.OfferCoin.Denom, spendableAmt), msg.OfferCoin)
+	}
+
+	_, demandCoinDenom, err := k.RoutePairs(ctx, msg.PairIds, msg.OfferCoin.Denom)
+	if err != nil {
+		return err
+	}
+	if demandCoinDenom != msg.DemandCoinDenom {
+		return sdkerrors.Wrapf(
+			types.ErrWrongRoute, "route ends with %s, not %s", demandCoinDenom, msg.DemandCoinDenom)
+	}
+
+	return nil
+}
+
+// RoutedSwap handles types.MsgRoutedSwap and stores types.RoutedSwapRequest.
+func (k Keeper) RoutedSwap(ctx sdk.Context, msg *types.MsgRoutedSwap) (types.RoutedSwapRequest, error) {
+	if err := k.ValidateMsgRoutedSwap(ctx, msg); err != nil {
+		return types.RoutedSwapRequest{}, err
+	}
+
+	// The offer coin is escrowed in the first pair's escrow address.
+	pair, _ := k.GetPair(ctx, msg.PairIds[0])
+	if err := k.bankKeeper.SendCoins(ctx, msg.GetOrderer(), pair.GetEscrowAddress(), sdk.NewCoins(msg.OfferCoin)); err != nil {
+		return types.RoutedSwapRequest{}, err
+	}
+
+	requestId := k.getNextRoutedSwapRequestIdWithUpdate(ctx)
+	req := types.NewRoutedSwapRequest(msg, requestId, ctx.BlockHeight())
+	k.SetRoutedSwapRequest(ctx, req)
+
+	for range msg.PairIds {
+		ctx.GasMeter().ConsumeGas(k.GetOrderExtraGas(ctx), "OrderExtraGas")
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRoutedSwap,
+			sdk.NewAttribute(types.AttributeKeyOrderer, msg.Orderer),
+			sdk.NewAttribute(types.AttributeKeyPairIds, types.FormatUint64s(msg.PairIds)),
+			sdk.NewAttribute(types.AttributeKeyOfferCoin, msg.OfferCoin.String()),
+			sdk.NewAttribute(types.AttributeKeyDemandCoinDenom, msg.DemandCoinDenom),
+			sdk.NewAttribute(types.AttributeKeyMinDemandAmount, msg.MinDemandAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyRequestId, strconv.FormatUint(req.Id, 10)),
+		),
+	})
+
+	return req, nil
+}
+
+// routedSwapLeg is a leg of a routed swap request, which is matched in the
+// batch of its pair as a fill-or-kill order.
+type routedSwapLeg struct {
+	req       types.RoutedSwapRequest
+	offerCoin sdk.Coin
+	recipient sdk.AccAddress
+	order     *types.RoutedSwapOrder
+}
+
+// routedSwapLegError is returned when a leg of a routed swap request cannot
+// be fully matched.
+type routedSwapLegError struct {
+	requestId uint64
+	err       error
+}
+
+func (e *routedSwapLegError) Error() string {
+	return fmt.Sprintf("routed swap request %d: %s", e.requestId, e.err)
+}
+
+func (e *routedSwapLegError) Unwrap() error {
+	return e.err
+}
+
+// addRoutedSwapOrder turns the offer coin of the leg into a routed swap
+// order and adds it to the order book.
+// The amount and price of a buy order are determined by looking up the
+// order book view of the user orders and the pools' orders.
+func (k Keeper) addRoutedSwapOrder(ctx sdk.Context, pair types.Pair, ob *amm.OrderBook, pools []types.AMMOrderer, leg *routedSwapLeg) error {
+	tickPrec := int(k.GetTickPrecision(ctx))
+	lowestPrice, highestPrice := k.PriceLimits(ctx, *pair.LastPrice)
+
+	switch leg.offerCoin.Denom {
+	case pair.QuoteCoinDenom:
+		poolOb := amm.NewOrderBook()
+		for _, pool := range pools {
+			poolOb.AddOrder(amm.PoolOrders(pool, pool, lowestPrice, highestPrice, tickPrec)...)
+		}
+		ov := amm.MultipleOrderViews{ob.MakeView(), poolOb.MakeView()}
+		amt, price, found := buyableAmount(ov, leg.offerCoin.Amount, highestPrice, tickPrec)
+		if !found {
+			return &routedSwapLegError{
+				requestId: leg.req.Id,
+				err:       sdkerrors.Wrapf(types.ErrInsufficientLiquidity, "no sell liquidity in pair %d", pair.Id),
+			}
+		}
+		leg.order = types.NewRoutedSwapOrder(
+			leg.req.Id, leg.req.GetOrderer(), leg.recipient, amm.Buy, price, amt, leg.offerCoin, pair.BaseCoinDenom)
+	case pair.BaseCoinDenom:
+		leg.order = types.NewRoutedSwapOrder(
+			leg.req.Id, leg.req.GetOrderer(), leg.recipient, amm.Sell, lowestPrice, leg.offerCoin.Amount, leg.offerCoin, pair.QuoteCoinDenom)
+	default: // sanity check
+		return &routedSwapLegError{
+			requestId: leg.req.Id,
+			err:       sdkerrors.Wrapf(types.ErrWrongRoute, "pair %d does not have denom %s", pair.Id, leg.offerCoin.Denom),
+		}
+	}
+	ob.AddOrder(leg.order)
+	return nil
+}
+
+// buyableAmount walks up the sell side of the order view, starting from
+// the lowest sell price, and returns the amount of base coin that can be
+// bought with the offer coin amount and the price of the last tick reached.
+func buyableAmount(ov amm.OrderView, offerCoinAmt sdk.Int, highestPrice sdk.Dec, tickPrec int) (amt sdk.Int, price sdk.Dec, found bool) {
+	price, found = ov.LowestSellPrice()
+	if !found || price.GT(highestPrice) {
+		return sdk.Int{}, sdk.Dec{}, false
+	}
+	amt = sdk.ZeroInt()
+	remainingOfferCoinAmt := offerCoinAmt
+	accAmt := sdk.ZeroInt()
+	for {
+		sellAmt := ov.SellAmountUnder(price, true)
+		tickAmt := sellAmt.Sub(accAmt)
+		accAmt = sellAmt
+		if tickAmt.IsPositive() {
+			cost := price.MulInt(tickAmt).Ceil().TruncateInt()
+			if cost.GT(remainingOfferCoinAmt) {
+				amt = amt.Add(remainingOfferCoinAmt.ToDec().QuoTruncate(price).TruncateInt())
+				break
+			}
+			amt = amt.Add(tickAmt)
+			remainingOfferCoinAmt = remainingOfferCoinAmt.Sub(cost)
+		}
+		next := amm.UpTick(price, tickPrec)
+		if next.GT(highestPrice) {
+			break
+		}
+		price = next
+	}
+	if !amt.IsPositive() {
+		return sdk.Int{}, sdk.Dec{}, false
+	}
+	return amt, price, true
+}
+
+// ExecuteMatchings executes the matching of all pairs along with the routed
+// swap requests which are not executed yet.
+// Each leg of a routed swap is matched in the batch of its pair, in the
+// route order, so the pairs are matched in an order where every pair comes
+// after the pairs preceding it in any route.
+// A routed swap is atomic; if any leg is not fully matched or the final
+// received amount is less than the minimum demand amount, the matchings are
+// executed again without the routed swap and its offer coin is refunded.
+func (k Keeper) ExecuteMatchings(ctx sdk.Context) error {
+	var reqs []types.RoutedSwapRequest
+	if err := k.IterateAllRoutedSwapRequests(ctx, func(req types.RoutedSwapRequest) (stop bool, err error) {
+		if req.Status == types.RequestStatusNotExecuted {
+			reqs = append(reqs, req)
+		}
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	failed := map[uint64]struct{}{}
+	graph := map[uint64]map[uint64]struct{}{}
+	for _, req := range reqs {
+		_, demandCoinDenom, err := k.RoutePairs(ctx, req.PairIds, req.OfferCoin.Denom)
+		if err != nil || demandCoinDenom != req.DemandCoinDenom || !addRouteEdges(graph, req.PairIds) {
+			failed[req.Id] = struct{}{}
+		}
+	}
+
+	var pairIds []uint64
+	_ = k.IterateAllPairs(ctx, func(pair types.Pair) (stop bool, err error) {
+		pairIds = append(pairIds, pair.Id)
+		return false, nil
+	})
+	pairIds = sortPairIdsByRoutes(pairIds, graph)
+
+	var receivedCoins map[uint64]sdk.Coin
+	for {
+		var execReqs []types.RoutedSwapRequest
+		for _, req := range reqs {
+			if _, ok := failed[req.Id]; !ok {
+				execReqs = append(execReqs, req)
+			}
+		}
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+		var err error
+		receivedCoins, err = k.executeMatchings(cacheCtx, pairIds, execReqs)
+		if err != nil {
+			var legErr *routedSwapLegError
+			if errors.As(err, &legErr) {
+				failed[legErr.requestId] = struct{}{}
+				continue
+			}
+			return err
+		}
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		break
+	}
+
+	for _, req := range reqs {
+		if _, ok := failed[req.Id]; ok {
+			if err := k.bankKeeper.SendCoins(
+				ctx, types.PairEscrowAddress(req.PairIds[0]), req.GetOrderer(), sdk.NewCoins(req.OfferCoin)); err != nil {
+				return err
+			}
+			req.SetStatus(types.RequestStatusFailed)
+		} else {
+			req.ReceivedCoin = receivedCoins[req.Id]
+			req.SetStatus(types.RequestStatusSucceeded)
+		}
+		k.SetRoutedSwapRequest(ctx, req)
+
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeRoutedSwapResult,
+				sdk.NewAttribute(types.AttributeKeyRequestId, strconv.FormatUint(req.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyOrderer, req.Orderer),
+				sdk.NewAttribute(types.AttributeKeyPairIds, types.FormatUint64s(req.PairIds)),
+				sdk.NewAttribute(types.AttributeKeyOfferCoin, req.OfferCoin.String()),
+				sdk.NewAttribute(types.AttributeKeyReceivedCoin, req.ReceivedCoin.String()),
+				sdk.NewAttribute(types.AttributeKeyStatus, req.Status.String()),
+			),
+		})
+	}
+
+	return nil
+}
+
+// executeMatchings executes the matching of the pairs in the given order,
+// with the legs of the routed swap requests injected into their pairs.
+// It returns the coin received at the end of the route of each request.
+func (k Keeper) executeMatchings(ctx sdk.Context, pairIds []uint64, reqs []types.RoutedSwapRequest) (receivedCoins map[uint64]sdk.Coin, err error) {
+	type routeStep struct {
+		req  types.RoutedSwapRequest
+		step int
+	}
+	stepsByPairId := map[uint64][]routeStep{}
+	offerCoins := map[uint64]sdk.Coin{}
+	for _, req := range reqs {
+		for i, pairId := range req.PairIds {
+			stepsByPairId[pairId] = append(stepsByPairId[pairId], routeStep{req, i})
+		}
+		offerCoins[req.Id] = req.OfferCoin
+	}
+
+	receivedCoins = map[uint64]sdk.Coin{}
+	for _, pairId := range pairIds {
+		pair, _ := k.GetPair(ctx, pairId)
+		var legs []*routedSwapLeg
+		for _, rs := range stepsByPairId[pairId] {
+			recipient := rs.req.GetOrderer()
+			if rs.step < len(rs.req.PairIds)-1 {
+				recipient = types.PairEscrowAddress(rs.req.PairIds[rs.step+1])
+			}
+			legs = append(legs, &routedSwapLeg{
+				req:       rs.req,
+				offerCoin: offerCoins[rs.req.Id],
+				recipient: recipient,
+			})
+		}
+
+		takerSwapFeeRate := k.TakerSwapFeeRate(ctx, pair)
+		if err := k.executeMatching(ctx, pair, legs); err != nil {
+			return nil, err
+		}
+		for _, leg := range legs {
+			receivedCoin := sdk.NewCoin(leg.order.DemandCoinDenom, leg.order.ReceivedDemandCoinAmount)
+			receivedCoin = receivedCoin.Sub(types.SwapFee(receivedCoin, takerSwapFeeRate))
+			if leg.recipient.Equals(leg.req.GetOrderer()) {
+				if receivedCoin.Amount.LT(leg.req.MinDemandAmount) {
+					return nil, &routedSwapLegError{
+						requestId: leg.req.Id,
+						err: sdkerrors.Wrapf(
+							types.ErrTooSmallDemandAmount, "%s is smaller than %s", receivedCoin.Amount, leg.req.MinDemandAmount),
+					}
+				}
+				receivedCoins[leg.req.Id] = receivedCoin
+			}
+			offerCoins[leg.req.Id] = receivedCoin
+		}
+
+		pair, _ = k.GetPair(ctx, pairId) // reload the pair updated by the matching
+		if err := k.TriggerConditionalOrders(ctx, pair); err != nil {
+			return nil, err
+		}
+	}
+	return receivedCoins, nil
+}
+
+// addRouteEdges adds the edges between the consecutive pairs in the route
+// to the graph.
+// If any of the edges would make a cycle in the graph, none of the edges
+// are added and false is returned.
+func addRouteEdges(graph map[uint64]map[uint64]struct{}, pairIds []uint64) bool {
+	var added [][2]uint64
+	for i := 0; i < len(pairIds)-1; i++ {
+		from, to := pairIds[i], pairIds[i+1]
+		if _, ok := graph[from][to]; ok {
+			continue
+		}
+		if from == to || reachable(graph, to, from) {
+			for _, edge := range added {
+				delete(graph[edge[0]], edge[1])
+			}
+			return false
+		}
+		if graph[from] == nil {
+			graph[from] = map[uint64]struct{}{}
+		}
+		graph[from][to] = struct{}{}
+		added = append(added, [2]uint64{from, to})
+	}
+	return true
+}
+
+// reachable returns whether there is a path from src to dst in the graph.
+func reachable(graph map[uint64]map[uint64]struct{}, src, dst uint64) bool {
+	visited := map[uint64]struct{}{src: {}}
+	stack := []uint64{src}
+	for len(stack) > 0 {
+		node := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if node == dst {
+			return true
+		}
+		for next := range graph[node] {
+			if _, ok := visited[next]; !ok {
+				visited[next] = struct{}{}
+				stack = append(stack, next)
+			}
+		}
+	}
+	return false
+}
+
+// sortPairIdsByRoutes sorts the pair ids topologically by the edges of the
+// graph, preferring smaller pair ids.
+// The pair ids must be sorted in ascending order and the graph must be
+// acyclic.
+func sortPairIdsByRoutes(pairIds []uint64, graph map[uint64]map[uint64]struct{}) []uint64 {
+	if len(graph) == 0 {
+		return pairIds
+	}
+	inDegrees := map[uint64]int{}
+	for _, tos := range graph {
+		for to := range tos {
+			inDegrees[to]++
+		}
+	}
+	done := map[uint64]struct{}{}
+	sorted := make([]uint64, 0, len(pairIds))
+	for len(sorted) < len(pairIds) {
+		for _, pairId := range pairIds {
+			if _, ok := done[pairId]; ok || inDegrees[pairId] > 0 {
+				continue
+			}
+			done[pairId] = struct{}{}
+			sorted = append(sorted, pairId)
+			for to := range graph[pairId] {
+				inDegrees[to]--
+			}
+			break
+		}
+	}
+	return sorted
+}
+
+// SimulateRoutedSwap returns the expected demand coin of a routed swap
+// and the coins refunded during each leg, without changing the state.
+// Each leg is matched in the batch of its pair and its state changes are
+// applied before the next leg is simulated.
+func (k Keeper) SimulateRoutedSwap(ctx sdk.Context, pairIds []uint64, offerCoin sdk.Coin) (demandCoin sdk.Coin, refundedCoins sdk.Coins, err error) {
+	ctx, _ = ctx.CacheContext()
+	pairs, _, err := k.RoutePairs(ctx, pairIds, offerCoin.Denom)
+	if err != nil {
+		return sdk.Coin{}, nil, err
+	}
+
+	// The offer coin is minted to the first pair's escrow address, as if it
+	// has been escrowed by a routed swap request.
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(offerCoin)); err != nil {
+		return sdk.Coin{}, nil, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(
+		ctx, types.ModuleName, pairs[0].GetEscrowAddress(), sdk.NewCoins(offerCoin)); err != nil {
+		return sdk.Coin{}, nil, err
+	}
+
+	orderer := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	req := types.RoutedSwapRequest{
+		PairIds:   pairIds,
+		Orderer:   orderer.String(),
+		OfferCoin: offerCoin,
+	}
+	for i, pair := range pairs {
+		recipient := orderer
+		if i < len(pairs)-1 {
+			recipient = pairs[i+1].GetEscrowAddress()
+		}
+		leg := &routedSwapLeg{
+			req:       req,
+			offerCoin: offerCoin,
+			recipient: recipient,
+		}
+		takerSwapFeeRate := k.TakerSwapFeeRate(ctx, pair)
+		if err := k.executeMatching(ctx, pair, []*routedSwapLeg{leg}); err != nil {
+			var legErr *routedSwapLegError
+			if errors.As(err, &legErr) {
+				err = legErr.err
+			}
+			return sdk.Coin{}, nil, err
+		}
+		refundedCoins = refundedCoins.Add(sdk.NewCoins(offerCoin.SubAmount(leg.order.PaidOfferCoinAmount))...)
+		offerCoin = sdk.NewCoin(leg.order.DemandCoinDenom, leg.order.ReceivedDemandCoinAmount)
+		offerCoin = offerCoin.Sub(types.SwapFee(offerCoin, takerSwapFeeRate))
+	}
+	return offerCoin, refundedCoins, nil
+}
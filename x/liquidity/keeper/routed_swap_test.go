@@ -0,0 +1,255 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/cosmosquad-labs/squad/v3/types"
+	"github.com/cosmosquad-labs/squad/v3/x/liquidity"
+	"github.com/cosmosquad-labs/squad/v3/x/liquidity/types"
+)
+
+func (s *KeeperTestSuite) TestRoutedSwap() {
+	pair1 := s.createPair(s.addr(0), "denom1", "denom2", true)
+	s.createPool(s.addr(0), pair1.Id, utils.ParseCoins("1000000000denom1,1000000000denom2"), true)
+	pair1.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair1)
+	pair2 := s.createPair(s.addr(0), "denom3", "denom2", true)
+	s.createPool(s.addr(0), pair2.Id, utils.ParseCoins("1000000000denom2,1000000000denom3"), true)
+	pair2.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair2)
+	// A pair without last price.
+	pair3 := s.createPair(s.addr(0), "denom3", "denom4", true)
+
+	orderer := s.addr(1)
+	s.fundAddr(orderer, utils.ParseCoins("1000000000denom1,1000000000denom2,1000000000denom3"))
+
+	for _, tc := range []struct {
+		name        string
+		msg         *types.MsgRoutedSwap
+		expectedErr string
+	}{
+		{
+			"happy case",
+			types.NewMsgRoutedSwap(orderer, []uint64{pair1.Id, pair2.Id}, utils.ParseCoin("1000000denom1"), "denom3", sdk.NewInt(900000)),
+			"",
+		},
+		{
+			"reverse route",
+			types.NewMsgRoutedSwap(orderer, []uint64{pair2.Id, pair1.Id}, utils.ParseCoin("1000000denom3"), "denom1", sdk.NewInt(900000)),
+			"",
+		},
+		{
+			"pair not found",
+			types.NewMsgRoutedSwap(orderer, []uint64{pair1.Id, 4}, utils.ParseCoin("1000000denom1"), "denom3", sdk.NewInt(900000)),
+			"pair 4 not found: not found",
+		},
+		{
+			"wrong route",
+			types.NewMsgRoutedSwap(orderer, []uint64{pair2.Id, pair1.Id}, utils.ParseCoin("1000000denom1"), "denom3", sdk.NewInt(900000)),
+			"pair 2 does not have denom denom1: wrong swap route",
+		},
+		{
+			"wrong demand coin denom",
+			types.NewMsgRoutedSwap(orderer, []uint64{pair1.Id, pair2.Id}, utils.ParseCoin("1000000denom1"), "denom4", sdk.NewInt(900000)),
+			"route ends with denom3, not denom4: wrong swap route",
+		},
+		{
+			"no last price",
+			types.NewMsgRoutedSwap(orderer, []uint64{pair3.Id}, utils.ParseCoin("1000000denom3"), "denom4", sdk.NewInt(900000)),
+			"pair 3 has no last price: invalid request",
+		},
+		{
+			"insufficient funds",
+			types.NewMsgRoutedSwap(orderer, []uint64{pair1.Id, pair2.Id}, utils.ParseCoin("10000000000denom1"), "denom3", sdk.NewInt(900000)),
+			"1000000000denom1 is smaller than 10000000000denom1: insufficient funds",
+		},
+	} {
+		s.Run(tc.name, func() {
+			s.Require().NoError(tc.msg.ValidateBasic())
+			cacheCtx, _ := s.ctx.CacheContext()
+			req, err := s.keeper.RoutedSwap(cacheCtx, tc.msg)
+			if tc.expectedErr == "" {
+				s.Require().NoError(err)
+				s.Require().Equal(types.RequestStatusNotExecuted, req.Status)
+				s.Require().True(coinEq(sdk.NewCoin(tc.msg.DemandCoinDenom, sdk.ZeroInt()), req.ReceivedCoin))
+			} else {
+				s.Require().EqualError(err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestExecuteRoutedSwapRequest() {
+	pair1 := s.createPair(s.addr(0), "denom1", "denom2", true)
+	s.createPool(s.addr(0), pair1.Id, utils.ParseCoins("1000000000denom1,1000000000denom2"), true)
+	pair1.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair1)
+	pair2 := s.createPair(s.addr(0), "denom3", "denom2", true)
+	s.createPool(s.addr(0), pair2.Id, utils.ParseCoins("1000000000denom2,1000000000denom3"), true)
+	pair2.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair2)
+
+	orderer := s.addr(1)
+	simRes, err := s.querier.SimulateRoutedSwap(sdk.WrapSDKContext(s.ctx), &types.QuerySimulateRoutedSwapRequest{
+		PairIds:   []uint64{pair1.Id, pair2.Id},
+		OfferCoin: "1000000denom1",
+	})
+	s.Require().NoError(err)
+
+	req := s.routedSwap(orderer, []uint64{pair1.Id, pair2.Id}, utils.ParseCoin("1000000denom1"), "denom3", sdk.NewInt(990000), true)
+	s.Require().True(coinsEq(sdk.Coins{}, s.getBalances(orderer)))
+	s.Require().True(coinsEq(utils.ParseCoins("1000000denom1"), s.getBalances(pair1.GetEscrowAddress())))
+
+	liquidity.EndBlocker(s.ctx, s.keeper)
+
+	req, found := s.keeper.GetRoutedSwapRequest(s.ctx, req.Id)
+	s.Require().True(found)
+	s.Require().Equal(types.RequestStatusSucceeded, req.Status)
+	s.Require().True(coinEq(simRes.DemandCoin, req.ReceivedCoin))
+	s.Require().True(req.ReceivedCoin.Amount.GTE(sdk.NewInt(990000)))
+	s.Require().True(coinsEq(sdk.NewCoins(req.ReceivedCoin).Add(simRes.RefundedCoins...), s.getBalances(orderer)))
+
+	// Both pairs' last prices have been updated.
+	pair1, _ = s.keeper.GetPair(s.ctx, pair1.Id)
+	s.Require().True(pair1.LastPrice.LT(sdk.OneDec()))
+	pair2, _ = s.keeper.GetPair(s.ctx, pair2.Id)
+	s.Require().True(pair2.LastPrice.GT(sdk.OneDec()))
+
+	// The request is deleted in the next block.
+	s.nextBlock()
+	_, found = s.keeper.GetRoutedSwapRequest(s.ctx, req.Id)
+	s.Require().False(found)
+}
+
+func (s *KeeperTestSuite) TestExecuteRoutedSwapRequest_UserOrders() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000000denom1,1000000000denom2"), true)
+	pair.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair)
+
+	// A resting buy order at a better price than the pool's.
+	order := s.buyLimitOrder(s.addr(2), pair.Id, utils.ParseDec("1.05"), sdk.NewInt(1000000), time.Hour, true)
+
+	orderer := s.addr(1)
+	req := s.routedSwap(orderer, []uint64{pair.Id}, utils.ParseCoin("1000000denom1"), "denom2", sdk.NewInt(1), true)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+
+	// The routed swap is matched against the resting order in the batch.
+	req, _ = s.keeper.GetRoutedSwapRequest(s.ctx, req.Id)
+	s.Require().Equal(types.RequestStatusSucceeded, req.Status)
+	s.Require().True(coinsEq(sdk.NewCoins(req.ReceivedCoin), s.getBalances(orderer)))
+
+	order, _ = s.keeper.GetOrder(s.ctx, order.PairId, order.Id)
+	s.Require().Equal(types.OrderStatusCompleted, order.Status)
+	s.Require().True(s.getBalance(order.GetOrderer(), "denom1").Amount.IsPositive())
+}
+
+func (s *KeeperTestSuite) TestExecuteRoutedSwapRequest_PartiallyFilled() {
+	pair1 := s.createPair(s.addr(0), "denom1", "denom2", true)
+	s.createPool(s.addr(0), pair1.Id, utils.ParseCoins("1000000000denom1,1000000000denom2"), true)
+	pair1.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair1)
+	// The second pair has only a small resting buy order.
+	pair2 := s.createPair(s.addr(0), "denom2", "denom3", true)
+	pair2.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair2)
+	order := s.buyLimitOrder(s.addr(2), pair2.Id, utils.ParseDec("1.0"), sdk.NewInt(100000), time.Hour, true)
+
+	orderer := s.addr(1)
+	req := s.routedSwap(orderer, []uint64{pair1.Id, pair2.Id}, utils.ParseCoin("1000000denom1"), "denom3", sdk.NewInt(1), true)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+
+	// The first leg is reverted along with the second leg, which is filled
+	// only partly.
+	req, _ = s.keeper.GetRoutedSwapRequest(s.ctx, req.Id)
+	s.Require().Equal(types.RequestStatusFailed, req.Status)
+	s.Require().True(coinsEq(utils.ParseCoins("1000000denom1"), s.getBalances(orderer)))
+	s.Require().True(coinsEq(sdk.Coins{}, s.getBalances(pair1.GetEscrowAddress())))
+
+	pair1, _ = s.keeper.GetPair(s.ctx, pair1.Id)
+	s.Require().True(decEq(sdk.OneDec(), *pair1.LastPrice))
+	order, _ = s.keeper.GetOrder(s.ctx, order.PairId, order.Id)
+	s.Require().True(intEq(order.Amount, order.OpenAmount))
+}
+
+func (s *KeeperTestSuite) TestExecuteRoutedSwapRequest_PairOrder() {
+	pair1 := s.createPair(s.addr(0), "denom1", "denom2", true)
+	s.createPool(s.addr(0), pair1.Id, utils.ParseCoins("1000000000denom1,1000000000denom2"), true)
+	pair1.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair1)
+	pair2 := s.createPair(s.addr(0), "denom3", "denom2", true)
+	s.createPool(s.addr(0), pair2.Id, utils.ParseCoins("1000000000denom2,1000000000denom3"), true)
+	pair2.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair2)
+
+	// The route goes through the pairs in reverse id order.
+	req1 := s.routedSwap(s.addr(1), []uint64{pair2.Id, pair1.Id}, utils.ParseCoin("1000000denom3"), "denom1", sdk.NewInt(1), true)
+	// The route conflicts with the previous one.
+	req2 := s.routedSwap(s.addr(2), []uint64{pair1.Id, pair2.Id}, utils.ParseCoin("1000000denom1"), "denom3", sdk.NewInt(1), true)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+
+	req1, _ = s.keeper.GetRoutedSwapRequest(s.ctx, req1.Id)
+	s.Require().Equal(types.RequestStatusSucceeded, req1.Status)
+	s.Require().True(req1.ReceivedCoin.Amount.IsPositive())
+	s.Require().True(coinEq(req1.ReceivedCoin, s.getBalance(s.addr(1), "denom1")))
+
+	req2, _ = s.keeper.GetRoutedSwapRequest(s.ctx, req2.Id)
+	s.Require().Equal(types.RequestStatusFailed, req2.Status)
+	s.Require().True(coinsEq(utils.ParseCoins("1000000denom1"), s.getBalances(s.addr(2))))
+}
+
+func (s *KeeperTestSuite) TestExecuteRoutedSwapRequest_TooSmallDemandAmount() {
+	pair1 := s.createPair(s.addr(0), "denom1", "denom2", true)
+	s.createPool(s.addr(0), pair1.Id, utils.ParseCoins("1000000000denom1,1000000000denom2"), true)
+	pair1.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair1)
+	pair2 := s.createPair(s.addr(0), "denom3", "denom2", true)
+	s.createPool(s.addr(0), pair2.Id, utils.ParseCoins("1000000000denom2,1000000000denom3"), true)
+	pair2.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair2)
+
+	orderer := s.addr(1)
+	req := s.routedSwap(orderer, []uint64{pair1.Id, pair2.Id}, utils.ParseCoin("1000000denom1"), "denom3", sdk.NewInt(1000000), true)
+
+	liquidity.EndBlocker(s.ctx, s.keeper)
+
+	// The whole swap is reverted and the offer coin is refunded.
+	req, found := s.keeper.GetRoutedSwapRequest(s.ctx, req.Id)
+	s.Require().True(found)
+	s.Require().Equal(types.RequestStatusFailed, req.Status)
+	s.Require().True(coinEq(utils.ParseCoin("0denom3"), req.ReceivedCoin))
+	s.Require().True(coinsEq(utils.ParseCoins("1000000denom1"), s.getBalances(orderer)))
+	s.Require().True(coinsEq(sdk.Coins{}, s.getBalances(pair1.GetEscrowAddress())))
+	s.Require().True(coinsEq(sdk.Coins{}, s.getBalances(pair2.GetEscrowAddress())))
+
+	pair1, _ = s.keeper.GetPair(s.ctx, pair1.Id)
+	s.Require().True(decEq(sdk.OneDec(), *pair1.LastPrice))
+	pair2, _ = s.keeper.GetPair(s.ctx, pair2.Id)
+	s.Require().True(decEq(sdk.OneDec(), *pair2.LastPrice))
+}
+
+func (s *KeeperTestSuite) TestExecuteRoutedSwapRequest_NoLiquidity() {
+	pair1 := s.createPair(s.addr(0), "denom1", "denom2", true)
+	s.createPool(s.addr(0), pair1.Id, utils.ParseCoins("1000000000denom1,1000000000denom2"), true)
+	pair1.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair1)
+	pair2 := s.createPair(s.addr(0), "denom3", "denom2", true)
+	pool2 := s.createPool(s.addr(0), pair2.Id, utils.ParseCoins("1000000000denom2,1000000000denom3"), true)
+	pair2.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair2)
+
+	// Withdraw all liquidity from the second pair.
+	s.withdraw(s.addr(0), pool2.Id, s.getBalance(s.addr(0), pool2.PoolCoinDenom))
+	s.nextBlock()
+
+	orderer := s.addr(1)
+	req := s.routedSwap(orderer, []uint64{pair1.Id, pair2.Id}, utils.ParseCoin("1000000denom1"), "denom3", sdk.NewInt(1), true)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+
+	req, found := s.keeper.GetRoutedSwapRequest(s.ctx, req.Id)
+	s.Require().True(found)
+	s.Require().Equal(types.RequestStatusFailed, req.Status)
+	s.Require().True(coinsEq(utils.ParseCoins("1000000denom1"), s.getBalances(orderer)))
+}
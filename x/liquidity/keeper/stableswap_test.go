@@ -0,0 +1,112 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	utils "github.com/cosmosquad-labs/squad/v3/types"
+	"github.com/cosmosquad-labs/squad/v3/x/liquidity"
+	"github.com/cosmosquad-labs/squad/v3/x/liquidity/amm"
+	"github.com/cosmosquad-labs/squad/v3/x/liquidity/keeper"
+	"github.com/cosmosquad-labs/squad/v3/x/liquidity/types"
+)
+
+func (s *KeeperTestSuite) TestCreateStableswapPool() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	pool := s.createStableswapPool(s.addr(1), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), 100, true)
+	s.Require().Equal(types.PoolTypeStableswap, pool.Type)
+	s.Require().EqualValues(100, pool.Amplification)
+	s.Require().True(coinsEq(
+		utils.ParseCoins("1000000denom1,1000000denom2"),
+		s.app.BankKeeper.GetAllBalances(s.ctx, pool.GetReserveAddress())))
+	s.Require().True(coinEq(
+		utils.ParseCoin("1000000000000pool1"),
+		s.app.BankKeeper.GetBalance(s.ctx, s.addr(1), pool.PoolCoinDenom)))
+
+	ammPool := pool.AMMPool(sdk.NewInt(1000000), sdk.NewInt(1000000), sdk.Int{})
+	s.Require().True(decEq(utils.ParseDec("1"), ammPool.Price()))
+
+	// Multiple stableswap pools can exist in a pair, along with a basic pool.
+	s.createStableswapPool(s.addr(1), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), 50, true)
+	s.createPool(s.addr(1), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+
+	// Pair not found.
+	msg := types.NewMsgCreateStableswapPool(s.addr(1), 10, utils.ParseCoins("1000000denom1,1000000denom2"), 100)
+	_, err := s.keeper.CreateStableswapPool(s.ctx, msg)
+	s.Require().EqualError(err, "pair 10 not found: not found")
+
+	// Insufficient deposit amount.
+	s.fundAddr(s.addr(1), utils.ParseCoins("1000000denom1,1000000denom2,1000000stake"))
+	msg = types.NewMsgCreateStableswapPool(s.addr(1), pair.Id, utils.ParseCoins("999999denom1,999999denom2"), 100)
+	_, err = s.keeper.CreateStableswapPool(s.ctx, msg)
+	s.Require().EqualError(err, "999999denom1 is smaller than 1000000denom1: insufficient deposit amount")
+}
+
+func (s *KeeperTestSuite) TestStableswapPool_Swap() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createStableswapPool(s.addr(0), pair.Id, utils.ParseCoins("1000000000denom1,1000000000denom2"), 100, true)
+	pair.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair)
+
+	// The stableswap pool fills a large order with little slippage.
+	order := s.buyMarketOrder(s.addr(1), pair.Id, sdk.NewInt(100000000), 0, true)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+	order, found := s.keeper.GetOrder(s.ctx, order.PairId, order.Id)
+	s.Require().True(found)
+	s.Require().True(order.OpenAmount.IsZero())
+
+	pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+	s.Require().True(pair.LastPrice.LT(utils.ParseDec("1.01")))
+
+	rx, ry := s.keeper.GetPoolBalances(s.ctx, pool)
+	s.Require().True(rx.Amount.GT(sdk.NewInt(1000000000)))
+	s.Require().True(ry.Amount.LT(sdk.NewInt(1000000000)))
+	d := amm.StableswapInvariant(rx.Amount, ry.Amount, pool.Amplification)
+	s.Require().True(d.GTE(amm.StableswapInvariant(sdk.NewInt(1000000000), sdk.NewInt(1000000000), pool.Amplification)))
+}
+
+func (s *KeeperTestSuite) TestStableswapPool_DepositWithdraw() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createStableswapPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), 100, true)
+
+	s.deposit(s.addr(1), pool.Id, utils.ParseCoins("500000denom1,500000denom2"), true)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+	s.Require().True(coinEq(
+		utils.ParseCoin("500000000000pool1"),
+		s.app.BankKeeper.GetBalance(s.ctx, s.addr(1), pool.PoolCoinDenom)))
+
+	s.withdraw(s.addr(1), pool.Id, utils.ParseCoin("500000000000pool1"))
+	liquidity.EndBlocker(s.ctx, s.keeper)
+	s.Require().True(coinsEq(
+		utils.ParseCoins("499999denom1,499999denom2"),
+		s.app.BankKeeper.GetAllBalances(s.ctx, s.addr(1))))
+}
+
+func (s *KeeperTestSuite) TestAmplificationProposal() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pool := s.createStableswapPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), 100, true)
+	basicPool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000denom1,1000000denom2"), true)
+
+	proposal := types.NewAmplificationProposal("title", "description", []types.AmplificationChange{
+		{PoolId: pool.Id, Amplification: 500},
+	})
+	s.Require().NoError(proposal.ValidateBasic())
+	s.Require().NoError(keeper.HandleAmplificationProposal(s.ctx, s.keeper, proposal))
+	pool, _ = s.keeper.GetPool(s.ctx, pool.Id)
+	s.Require().EqualValues(500, pool.Amplification)
+
+	// Not a stableswap pool.
+	proposal = types.NewAmplificationProposal("title", "description", []types.AmplificationChange{
+		{PoolId: basicPool.Id, Amplification: 500},
+	})
+	err := keeper.HandleAmplificationProposal(s.ctx, s.keeper, proposal)
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	// Pool not found.
+	proposal = types.NewAmplificationProposal("title", "description", []types.AmplificationChange{
+		{PoolId: 10, Amplification: 500},
+	})
+	err = keeper.HandleAmplificationProposal(s.ctx, s.keeper, proposal)
+	s.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	gogotypes "github.com/gogo/protobuf/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -585,3 +587,558 @@ func (k Keeper) DeleteMMOrderIndex(ctx sdk.Context, index types.MMOrderIndex) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetMMOrderIndexKey(index.GetOrderer(), index.PairId))
 }
+
+// GetLastPositionId returns the last position id.
+func (k Keeper) GetLastPositionId(ctx sdk.Context) (id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastPositionIdKey)
+	if bz == nil {
+		id = 0 // initialize the position id
+	} else {
+		var val gogotypes.UInt64Value
+		k.cdc.MustUnmarshal(bz, &val)
+		id = val.GetValue()
+	}
+	return
+}
+
+// SetLastPositionId stores the last position id.
+func (k Keeper) SetLastPositionId(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: id})
+	store.Set(types.LastPositionIdKey, bz)
+}
+
+// GetNumPositionsByPair returns the number of positions in the pair.
+func (k Keeper) GetNumPositionsByPair(ctx sdk.Context, pairId uint64) (num uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetNumPositionsByPairKey(pairId))
+	if bz == nil {
+		return 0
+	}
+	var val gogotypes.UInt64Value
+	k.cdc.MustUnmarshal(bz, &val)
+	return val.GetValue()
+}
+
+// SetNumPositionsByPair stores the number of positions in the pair.
+func (k Keeper) SetNumPositionsByPair(ctx sdk.Context, pairId, num uint64) {
+	store := ctx.KVStore(k.storeKey)
+	if num == 0 {
+		store.Delete(types.GetNumPositionsByPairKey(pairId))
+		return
+	}
+	bz := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: num})
+	store.Set(types.GetNumPositionsByPairKey(pairId), bz)
+}
+
+// GetPosition returns position object for the given position id.
+func (k Keeper) GetPosition(ctx sdk.Context, id uint64) (position types.Position, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPositionKey(id))
+	if bz == nil {
+		return
+	}
+	position = types.MustUnmarshalPosition(k.cdc, bz)
+	return position, true
+}
+
+// SetPosition stores the particular position.
+func (k Keeper) SetPosition(ctx sdk.Context, position types.Position) {
+	store := ctx.KVStore(k.storeKey)
+	bz := types.MustMarshalPosition(k.cdc, position)
+	store.Set(types.GetPositionKey(position.Id), bz)
+}
+
+// SetPositionIndex stores a position by owner index key.
+func (k Keeper) SetPositionIndex(ctx sdk.Context, position types.Position) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPositionIndexKey(position.GetOwner(), position.Id), []byte{})
+}
+
+// SetPositionsByPairIndex stores a position by pair index key.
+func (k Keeper) SetPositionsByPairIndex(ctx sdk.Context, position types.Position) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPositionsByPairIndexKey(position.PairId, position.Id), []byte{})
+}
+
+// IterateAllPositions iterates over all the stored positions and performs a callback function.
+// Stops iteration when callback returns true.
+func (k Keeper) IterateAllPositions(ctx sdk.Context, cb func(position types.Position) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.PositionKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		position := types.MustUnmarshalPosition(k.cdc, iter.Value())
+		stop, err := cb(position)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
+// IteratePositionsByPair iterates over all the stored positions by the pair and performs a callback function.
+// Stops iteration when callback returns true.
+func (k Keeper) IteratePositionsByPair(ctx sdk.Context, pairId uint64, cb func(position types.Position) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetPositionsByPairIndexKeyPrefix(pairId))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		positionId := types.ParsePositionsByPairIndexKey(iter.Key())
+		position, _ := k.GetPosition(ctx, positionId)
+		stop, err := cb(position)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
+// IteratePositionsByOwner iterates through positions in the store by an owner
+// and call cb on each position.
+func (k Keeper) IteratePositionsByOwner(ctx sdk.Context, owner sdk.AccAddress, cb func(position types.Position) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetPositionIndexKeyPrefix(owner))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		_, positionId := types.ParsePositionIndexKey(iter.Key())
+		position, _ := k.GetPosition(ctx, positionId)
+		stop, err := cb(position)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
+// GetAllPositions returns all positions in the store.
+func (k Keeper) GetAllPositions(ctx sdk.Context) (positions []types.Position) {
+	positions = []types.Position{}
+	_ = k.IterateAllPositions(ctx, func(position types.Position) (stop bool, err error) {
+		positions = append(positions, position)
+		return false, nil
+	})
+	return
+}
+
+// GetPositionsByPair returns positions within the pair.
+func (k Keeper) GetPositionsByPair(ctx sdk.Context, pairId uint64) (positions []types.Position) {
+	_ = k.IteratePositionsByPair(ctx, pairId, func(position types.Position) (stop bool, err error) {
+		positions = append(positions, position)
+		return false, nil
+	})
+	return
+}
+
+// GetPositionsByOwner returns positions owned by the owner.
+func (k Keeper) GetPositionsByOwner(ctx sdk.Context, owner sdk.AccAddress) (positions []types.Position) {
+	_ = k.IteratePositionsByOwner(ctx, owner, func(position types.Position) (stop bool, err error) {
+		positions = append(positions, position)
+		return false, nil
+	})
+	return
+}
+
+// DeletePosition deletes a position and its indexes.
+func (k Keeper) DeletePosition(ctx sdk.Context, position types.Position) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPositionKey(position.Id))
+	store.Delete(types.GetPositionIndexKey(position.GetOwner(), position.Id))
+	store.Delete(types.GetPositionsByPairIndexKey(position.PairId, position.Id))
+}
+
+// GetLastConditionalOrderId returns the last conditional order id.
+func (k Keeper) GetLastConditionalOrderId(ctx sdk.Context) (id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastConditionalOrderIdKey)
+	if bz == nil {
+		id = 0 // initialize the conditional order id
+	} else {
+		var val gogotypes.UInt64Value
+		k.cdc.MustUnmarshal(bz, &val)
+		id = val.GetValue()
+	}
+	return
+}
+
+// SetLastConditionalOrderId stores the last conditional order id.
+func (k Keeper) SetLastConditionalOrderId(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: id})
+	store.Set(types.LastConditionalOrderIdKey, bz)
+}
+
+// GetConditionalOrder returns the particular conditional order.
+func (k Keeper) GetConditionalOrder(ctx sdk.Context, pairId, id uint64) (order types.ConditionalOrder, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetConditionalOrderKey(pairId, id))
+	if bz == nil {
+		return
+	}
+	order = types.MustUnmarshalConditionalOrder(k.cdc, bz)
+	return order, true
+}
+
+// SetConditionalOrder stores a conditional order.
+func (k Keeper) SetConditionalOrder(ctx sdk.Context, order types.ConditionalOrder) {
+	store := ctx.KVStore(k.storeKey)
+	bz := types.MustMarshalConditionalOrder(k.cdc, order)
+	store.Set(types.GetConditionalOrderKey(order.PairId, order.Id), bz)
+}
+
+func (k Keeper) SetConditionalOrderIndex(ctx sdk.Context, order types.ConditionalOrder) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetConditionalOrderIndexKey(order.GetOrderer(), order.PairId, order.Id), []byte{})
+}
+
+// IterateAllConditionalOrders iterates through all conditional orders in the
+// store and call cb for each conditional order.
+func (k Keeper) IterateAllConditionalOrders(ctx sdk.Context, cb func(order types.ConditionalOrder) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ConditionalOrderKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		order := types.MustUnmarshalConditionalOrder(k.cdc, iter.Value())
+		stop, err := cb(order)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
+// IterateConditionalOrdersByPair iterates through all the conditional orders
+// within the pair and call cb for each conditional order.
+func (k Keeper) IterateConditionalOrdersByPair(ctx sdk.Context, pairId uint64, cb func(order types.ConditionalOrder) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetConditionalOrdersByPairKeyPrefix(pairId))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		order := types.MustUnmarshalConditionalOrder(k.cdc, iter.Value())
+		stop, err := cb(order)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
+// IterateConditionalOrdersByOrderer iterates through conditional orders in
+// the store by an orderer and call cb on each conditional order.
+func (k Keeper) IterateConditionalOrdersByOrderer(ctx sdk.Context, orderer sdk.AccAddress, cb func(order types.ConditionalOrder) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetConditionalOrderIndexKeyPrefix(orderer))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		_, pairId, orderId := types.ParseConditionalOrderIndexKey(iter.Key())
+		order, _ := k.GetConditionalOrder(ctx, pairId, orderId)
+		stop, err := cb(order)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
+// GetAllConditionalOrders returns all conditional orders in the store.
+func (k Keeper) GetAllConditionalOrders(ctx sdk.Context) (orders []types.ConditionalOrder) {
+	orders = []types.ConditionalOrder{}
+	_ = k.IterateAllConditionalOrders(ctx, func(order types.ConditionalOrder) (stop bool, err error) {
+		orders = append(orders, order)
+		return false, nil
+	})
+	return
+}
+
+// GetConditionalOrdersByPair returns conditional orders within the pair.
+func (k Keeper) GetConditionalOrdersByPair(ctx sdk.Context, pairId uint64) (orders []types.ConditionalOrder) {
+	_ = k.IterateConditionalOrdersByPair(ctx, pairId, func(order types.ConditionalOrder) (stop bool, err error) {
+		orders = append(orders, order)
+		return false, nil
+	})
+	return
+}
+
+// GetConditionalOrdersByOrderer returns conditional orders by the orderer.
+func (k Keeper) GetConditionalOrdersByOrderer(ctx sdk.Context, orderer sdk.AccAddress) (orders []types.ConditionalOrder) {
+	_ = k.IterateConditionalOrdersByOrderer(ctx, orderer, func(order types.ConditionalOrder) (stop bool, err error) {
+		orders = append(orders, order)
+		return false, nil
+	})
+	return
+}
+
+// DeleteConditionalOrder deletes a conditional order and its index.
+func (k Keeper) DeleteConditionalOrder(ctx sdk.Context, order types.ConditionalOrder) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetConditionalOrderKey(order.PairId, order.Id))
+	store.Delete(types.GetConditionalOrderIndexKey(order.GetOrderer(), order.PairId, order.Id))
+}
+
+// GetLastRoutedSwapRequestId returns the last routed swap request id.
+func (k Keeper) GetLastRoutedSwapRequestId(ctx sdk.Context) (id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastRoutedSwapRequestIdKey)
+	if bz == nil {
+		id = 0 // initialize the routed swap request id
+	} else {
+		var val gogotypes.UInt64Value
+		k.cdc.MustUnmarshal(bz, &val)
+		id = val.GetValue()
+	}
+	return
+}
+
+// SetLastRoutedSwapRequestId stores the last routed swap request id.
+func (k Keeper) SetLastRoutedSwapRequestId(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: id})
+	store.Set(types.LastRoutedSwapRequestIdKey, bz)
+}
+
+// GetRoutedSwapRequest returns the particular routed swap request.
+func (k Keeper) GetRoutedSwapRequest(ctx sdk.Context, id uint64) (req types.RoutedSwapRequest, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetRoutedSwapRequestKey(id))
+	if bz == nil {
+		return
+	}
+	req = types.MustUnmarshalRoutedSwapRequest(k.cdc, bz)
+	return req, true
+}
+
+// SetRoutedSwapRequest stores a routed swap request.
+func (k Keeper) SetRoutedSwapRequest(ctx sdk.Context, req types.RoutedSwapRequest) {
+	store := ctx.KVStore(k.storeKey)
+	bz := types.MustMarshalRoutedSwapRequest(k.cdc, req)
+	store.Set(types.GetRoutedSwapRequestKey(req.Id), bz)
+}
+
+// IterateAllRoutedSwapRequests iterates through all routed swap requests in
+// the store and call cb for each request.
+func (k Keeper) IterateAllRoutedSwapRequests(ctx sdk.Context, cb func(req types.RoutedSwapRequest) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.RoutedSwapRequestKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		req := types.MustUnmarshalRoutedSwapRequest(k.cdc, iter.Value())
+		stop, err := cb(req)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
+// GetAllRoutedSwapRequests returns all routed swap requests in the store.
+func (k Keeper) GetAllRoutedSwapRequests(ctx sdk.Context) (reqs []types.RoutedSwapRequest) {
+	reqs = []types.RoutedSwapRequest{}
+	_ = k.IterateAllRoutedSwapRequests(ctx, func(req types.RoutedSwapRequest) (stop bool, err error) {
+		reqs = append(reqs, req)
+		return false, nil
+	})
+	return
+}
+
+// DeleteRoutedSwapRequest deletes a routed swap request.
+func (k Keeper) DeleteRoutedSwapRequest(ctx sdk.Context, req types.RoutedSwapRequest) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetRoutedSwapRequestKey(req.Id))
+}
+
+// GetTradeRecord returns the trade record of the pair in the batch.
+func (k Keeper) GetTradeRecord(ctx sdk.Context, pairId, batchId uint64) (record types.TradeRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetTradeRecordKey(pairId, batchId))
+	if bz == nil {
+		return
+	}
+	record = types.MustUnmarshalTradeRecord(k.cdc, bz)
+	return record, true
+}
+
+// SetTradeRecord stores a trade record.
+func (k Keeper) SetTradeRecord(ctx sdk.Context, record types.TradeRecord) {
+	store := ctx.KVStore(k.storeKey)
+	bz := types.MustMarshalTradeRecord(k.cdc, record)
+	store.Set(types.GetTradeRecordKey(record.PairId, record.BatchId), bz)
+}
+
+// IterateAllTradeRecords iterates through all trade records in the store and
+// call cb for each record.
+func (k Keeper) IterateAllTradeRecords(ctx sdk.Context, cb func(record types.TradeRecord) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.TradeRecordKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		record := types.MustUnmarshalTradeRecord(k.cdc, iter.Value())
+		stop, err := cb(record)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
+// IterateTradeRecordsByPair iterates through all the trade records within the
+// pair in ascending order of the batch id and call cb for each record.
+func (k Keeper) IterateTradeRecordsByPair(ctx sdk.Context, pairId uint64, cb func(record types.TradeRecord) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetTradeRecordsByPairKeyPrefix(pairId))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		record := types.MustUnmarshalTradeRecord(k.cdc, iter.Value())
+		stop, err := cb(record)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
+// GetAllTradeRecords returns all trade records in the store.
+func (k Keeper) GetAllTradeRecords(ctx sdk.Context) (records []types.TradeRecord) {
+	records = []types.TradeRecord{}
+	_ = k.IterateAllTradeRecords(ctx, func(record types.TradeRecord) (stop bool, err error) {
+		records = append(records, record)
+		return false, nil
+	})
+	return
+}
+
+// GetTradeRecordsByPair returns trade records within the pair.
+func (k Keeper) GetTradeRecordsByPair(ctx sdk.Context, pairId uint64) (records []types.TradeRecord) {
+	_ = k.IterateTradeRecordsByPair(ctx, pairId, func(record types.TradeRecord) (stop bool, err error) {
+		records = append(records, record)
+		return false, nil
+	})
+	return
+}
+
+// DeleteTradeRecord deletes a trade record.
+func (k Keeper) DeleteTradeRecord(ctx sdk.Context, record types.TradeRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTradeRecordKey(record.PairId, record.BatchId))
+}
+
+// GetPriceAccumulator returns the price accumulator of the pair at the time.
+func (k Keeper) GetPriceAccumulator(ctx sdk.Context, pairId uint64, t time.Time) (acc types.PriceAccumulator, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPriceAccumulatorKey(pairId, t))
+	if bz == nil {
+		return
+	}
+	acc = types.MustUnmarshalPriceAccumulator(k.cdc, bz)
+	return acc, true
+}
+
+// GetLatestPriceAccumulator returns the most recent price accumulator of the pair.
+func (k Keeper) GetLatestPriceAccumulator(ctx sdk.Context, pairId uint64) (acc types.PriceAccumulator, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStoreReversePrefixIterator(store, types.GetPriceAccumulatorsByPairKeyPrefix(pairId))
+	defer iter.Close()
+	if !iter.Valid() {
+		return
+	}
+	acc = types.MustUnmarshalPriceAccumulator(k.cdc, iter.Value())
+	return acc, true
+}
+
+// SetPriceAccumulator stores a price accumulator.
+func (k Keeper) SetPriceAccumulator(ctx sdk.Context, acc types.PriceAccumulator) {
+	store := ctx.KVStore(k.storeKey)
+	bz := types.MustMarshalPriceAccumulator(k.cdc, acc)
+	store.Set(types.GetPriceAccumulatorKey(acc.PairId, acc.Time), bz)
+}
+
+// IterateAllPriceAccumulators iterates through all price accumulators in the
+// store and call cb for each accumulator.
+func (k Keeper) IterateAllPriceAccumulators(ctx sdk.Context, cb func(acc types.PriceAccumulator) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.PriceAccumulatorKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		acc := types.MustUnmarshalPriceAccumulator(k.cdc, iter.Value())
+		stop, err := cb(acc)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
+// IteratePriceAccumulatorsByPair iterates through all the price accumulators
+// within the pair in ascending order of the time and call cb for each
+// accumulator.
+func (k Keeper) IteratePriceAccumulatorsByPair(ctx sdk.Context, pairId uint64, cb func(acc types.PriceAccumulator) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetPriceAccumulatorsByPairKeyPrefix(pairId))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		acc := types.MustUnmarshalPriceAccumulator(k.cdc, iter.Value())
+		stop, err := cb(acc)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
+// GetAllPriceAccumulators returns all price accumulators in the store.
+func (k Keeper) GetAllPriceAccumulators(ctx sdk.Context) (accs []types.PriceAccumulator) {
+	accs = []types.PriceAccumulator{}
+	_ = k.IterateAllPriceAccumulators(ctx, func(acc types.PriceAccumulator) (stop bool, err error) {
+		accs = append(accs, acc)
+		return false, nil
+	})
+	return
+}
+
+// GetPriceAccumulatorsByPair returns price accumulators within the pair.
+func (k Keeper) GetPriceAccumulatorsByPair(ctx sdk.Context, pairId uint64) (accs []types.PriceAccumulator) {
+	_ = k.IteratePriceAccumulatorsByPair(ctx, pairId, func(acc types.PriceAccumulator) (stop bool, err error) {
+		accs = append(accs, acc)
+		return false, nil
+	})
+	return
+}
+
+// DeletePriceAccumulator deletes a price accumulator.
+func (k Keeper) DeletePriceAccumulator(ctx sdk.Context, acc types.PriceAccumulator) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPriceAccumulatorKey(acc.PairId, acc.Time))
+}
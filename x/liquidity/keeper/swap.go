@@ -84,9 +84,44 @@ func (k Keeper) ValidateMsgLimitOrder(ctx sdk.Context, msg *types.MsgLimitOrder)
 		return sdk.Coin{}, sdk.Dec{}, types.ErrTooSmallOrder
 	}
 
+	if msg.PostOnly {
+		if crossPrice, crosses := k.crossingPrice(ctx, pair, msg.Direction, price); crosses {
+			return sdk.Coin{}, sdk.Dec{}, sdkerrors.Wrapf(
+				types.ErrPostOnlyOrderCrosses, "%s order at %s crosses %s", msg.Direction, price, crossPrice)
+		}
+	}
+
 	return offerCoin, price, nil
 }
 
+// crossingPrice returns the best opposite price in the pair's order book,
+// which consists of the matchable orders and the pools' orders, and whether
+// an order with the given direction and price would cross the price.
+func (k Keeper) crossingPrice(ctx sdk.Context, pair types.Pair, dir types.OrderDirection, price sdk.Dec) (crossPrice sdk.Dec, crosses bool) {
+	ob := amm.NewOrderBook()
+	_ = k.IterateOrdersByPair(ctx, pair.Id, func(order types.Order) (stop bool, err error) {
+		if order.Status.IsMatchable() {
+			ob.AddOrder(types.NewUserOrder(order))
+		}
+		return false, nil
+	})
+	ov := amm.MultipleOrderViews{ob.MakeView()}
+	for _, pool := range k.getAMMOrderers(ctx, pair) {
+		ov = append(ov, pool)
+	}
+
+	var found bool
+	switch dir {
+	case types.OrderDirectionBuy:
+		crossPrice, found = ov.LowestSellPrice()
+		crosses = found && crossPrice.LTE(price)
+	case types.OrderDirectionSell:
+		crossPrice, found = ov.HighestBuyPrice()
+		crosses = found && crossPrice.GTE(price)
+	}
+	return crossPrice, crosses
+}
+
 // LimitOrder handles types.MsgLimitOrder and stores types.Order.
 func (k Keeper) LimitOrder(ctx sdk.Context, msg *types.MsgLimitOrder) (types.Order, error) {
 	offerCoin, price, err := k.ValidateMsgLimitOrder(ctx, msg)
@@ -121,6 +156,8 @@ func (k Keeper) LimitOrder(ctx sdk.Context, msg *types.MsgLimitOrder) (types.Ord
 			sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(order.Id, 10)),
 			sdk.NewAttribute(types.AttributeKeyBatchId, strconv.FormatUint(order.BatchId, 10)),
 			sdk.NewAttribute(types.AttributeKeyExpireAt, order.ExpireAt.Format(time.RFC3339)),
+			sdk.NewAttribute(types.AttributeKeyTimeInForce, order.TimeInForce.String()),
+			sdk.NewAttribute(types.AttributeKeyPostOnly, strconv.FormatBool(order.PostOnly)),
 			sdk.NewAttribute(types.AttributeKeyRefundedCoins, refundedCoin.String()),
 		),
 	})
@@ -518,9 +555,21 @@ func (k Keeper) CancelMMOrder(ctx sdk.Context, msg *types.MsgCancelMMOrder) (can
 	return canceledOrderIds, nil
 }
 
+// ExecuteMatching matches the orders of the pair against each other and
+// the pools' orders.
+// Fill-or-kill orders which cannot be fully matched are excluded from the
+// matching, and the matching is repeated without them.
+// Immediate-or-cancel and fill-or-kill orders are finished right after the
+// matching, so they never remain in the order book after their first batch.
 func (k Keeper) ExecuteMatching(ctx sdk.Context, pair types.Pair) error {
-	ob := amm.NewOrderBook()
+	return k.executeMatching(ctx, pair, nil)
+}
 
+// executeMatching executes the matching of the pair along with the given
+// routed swap legs, each of which is matched as a fill-or-kill order.
+// It returns a *routedSwapLegError if any leg is not fully matched.
+func (k Keeper) executeMatching(ctx sdk.Context, pair types.Pair, legs []*routedSwapLeg) error {
+	var orders []types.Order
 	if err := k.IterateOrdersByPair(ctx, pair.Id, func(order types.Order) (stop bool, err error) {
 		switch order.Status {
 		case types.OrderStatusNotExecuted,
@@ -533,7 +582,7 @@ func (k Keeper) ExecuteMatching(ctx sdk.Context, pair types.Pair) error {
 				return false, nil
 			}
 			// TODO: add orders only when price is in the range?
-			ob.AddOrder(types.NewUserOrder(order))
+			orders = append(orders, order)
 			if order.Status == types.OrderStatusNotExecuted {
 				order.SetStatus(types.OrderStatusNotMatched)
 				k.SetOrder(ctx, order)
@@ -547,7 +596,103 @@ func (k Keeper) ExecuteMatching(ctx sdk.Context, pair types.Pair) error {
 		return err
 	}
 
-	var pools []*types.PoolOrderer
+	killed := map[uint64]struct{}{} // fill-or-kill orders excluded from the matching
+	for {
+		ob := amm.NewOrderBook()
+		for _, order := range orders {
+			if _, ok := killed[order.Id]; !ok {
+				ob.AddOrder(types.NewUserOrder(order))
+			}
+		}
+		pools := k.getAMMOrderers(ctx, pair)
+		for _, leg := range legs {
+			if err := k.addRoutedSwapOrder(ctx, pair, ob, pools, leg); err != nil {
+				return err
+			}
+		}
+
+		matchPrice, quoteCoinDiff, matched := k.Match(ctx, ob, pools, pair.LastPrice)
+		if matched {
+			obOrders := ob.Orders()
+			if killUnfilledOrders(obOrders, orders, killed) {
+				continue
+			}
+		}
+		for _, leg := range legs {
+			if !matched || leg.order.OpenAmount.IsPositive() {
+				return &routedSwapLegError{
+					requestId: leg.req.Id,
+					err: sdkerrors.Wrapf(
+						types.ErrInsufficientLiquidity, "offer coin %s is not fully matched in pair %d", leg.offerCoin, pair.Id),
+				}
+			}
+		}
+		if matched {
+			obOrders := ob.Orders()
+			swapFees, err := k.ApplyMatchResult(ctx, pair, obOrders, quoteCoinDiff)
+			if err != nil {
+				return err
+			}
+			pair.LastPrice = &matchPrice
+			k.RecordTrade(ctx, pair, obOrders, matchPrice, swapFees)
+		}
+		break
+	}
+	k.UpdatePriceAccumulator(ctx, pair)
+
+	for _, order := range orders {
+		if !order.TimeInForce.IsImmediate() {
+			continue
+		}
+		order, _ = k.GetOrder(ctx, pair.Id, order.Id) // reload the order updated by the matching
+		if order.Status.CanBeExpired() {
+			if err := k.FinishOrder(ctx, order, types.OrderStatusExpired); err != nil {
+				return err
+			}
+		}
+	}
+
+	pair.CurrentBatchId++
+	k.SetPair(ctx, pair)
+
+	return nil
+}
+
+// killUnfilledOrders marks fill-or-kill orders which are not fully matched
+// in the matching result as killed.
+// It returns true if there was any newly killed order, which means the
+// matching should be done again.
+func killUnfilledOrders(obOrders []amm.Order, orders []types.Order, killed map[uint64]struct{}) bool {
+	fokOrders := map[uint64]struct{}{}
+	for _, order := range orders {
+		if order.TimeInForce == types.TimeInForceFillOrKill {
+			fokOrders[order.Id] = struct{}{}
+		}
+	}
+	if len(fokOrders) == 0 {
+		return false
+	}
+	killedAny := false
+	for _, order := range obOrders {
+		userOrder, ok := order.(*types.UserOrder)
+		if !ok {
+			continue
+		}
+		if _, ok := fokOrders[userOrder.OrderId]; !ok {
+			continue
+		}
+		if userOrder.GetOpenAmount().IsPositive() {
+			killed[userOrder.OrderId] = struct{}{}
+			killedAny = true
+		}
+	}
+	return killedAny
+}
+
+// getAMMOrderers returns the orderers of the pair's pools and positions
+// which provide liquidity to the pair.
+// Depleted pools found during the iteration are marked as disabled.
+func (k Keeper) getAMMOrderers(ctx sdk.Context, pair types.Pair) (pools []types.AMMOrderer) {
 	_ = k.IteratePoolsByPair(ctx, pair.Id, func(pool types.Pool) (stop bool, err error) {
 		if pool.Disabled {
 			return false, nil
@@ -564,23 +709,21 @@ func (k Keeper) ExecuteMatching(ctx sdk.Context, pair types.Pair) error {
 		pools = append(pools, ammPool)
 		return false, nil
 	})
-
-	matchPrice, quoteCoinDiff, matched := k.Match(ctx, ob, pools, pair.LastPrice)
-	if matched {
-		orders := ob.Orders()
-		if err := k.ApplyMatchResult(ctx, pair, orders, quoteCoinDiff); err != nil {
-			return err
+	_ = k.IteratePositionsByPair(ctx, pair.Id, func(position types.Position) (stop bool, err error) {
+		rx, ry := k.getPositionBalances(ctx, position, pair)
+		ammPool := types.NewPositionOrderer(
+			position.AMMPool(rx.Amount, ry.Amount),
+			position.Id, position.GetReserveAddress(), pair.BaseCoinDenom, pair.QuoteCoinDenom)
+		if ammPool.IsDepleted() {
+			return false, nil
 		}
-		pair.LastPrice = &matchPrice
-	}
-
-	pair.CurrentBatchId++
-	k.SetPair(ctx, pair)
-
-	return nil
+		pools = append(pools, ammPool)
+		return false, nil
+	})
+	return pools
 }
 
-func (k Keeper) Match(ctx sdk.Context, ob *amm.OrderBook, pools []*types.PoolOrderer, lastPrice *sdk.Dec) (matchPrice sdk.Dec, quoteCoinDiff sdk.Int, matched bool) {
+func (k Keeper) Match(ctx sdk.Context, ob *amm.OrderBook, pools []types.AMMOrderer, lastPrice *sdk.Dec) (matchPrice sdk.Dec, quoteCoinDiff sdk.Int, matched bool) {
 	tickPrec := int(k.GetTickPrecision(ctx))
 	if lastPrice == nil {
 		ov := amm.MultipleOrderViews{ob.MakeView()}
@@ -614,25 +757,31 @@ func (k Keeper) Match(ctx sdk.Context, ob *amm.OrderBook, pools []*types.PoolOrd
 	return
 }
 
-func (k Keeper) ApplyMatchResult(ctx sdk.Context, pair types.Pair, orders []amm.Order, quoteCoinDiff sdk.Int) error {
+func (k Keeper) ApplyMatchResult(ctx sdk.Context, pair types.Pair, orders []amm.Order, quoteCoinDiff sdk.Int) (swapFees sdk.Coins, err error) {
+	swapFeeByOrder := k.orderSwapFees(ctx, pair, orders)
+	var rebates sdk.Coins
+
 	bulkOp := types.NewBulkSendCoinsOperation()
 	for _, order := range orders { // TODO: need optimization to filter matched orders only
-		order, ok := order.(*types.PoolOrder)
-		if !ok {
-			continue
-		}
 		if !order.IsMatched() {
 			continue
 		}
-		paidCoin := sdk.NewCoin(order.OfferCoinDenom, order.PaidOfferCoinAmount)
-		bulkOp.QueueSendCoins(order.ReserveAddress, pair.GetEscrowAddress(), sdk.NewCoins(paidCoin))
+		switch order := order.(type) {
+		case *types.PoolOrder:
+			paidCoin := sdk.NewCoin(order.OfferCoinDenom, order.PaidOfferCoinAmount)
+			bulkOp.QueueSendCoins(order.ReserveAddress, pair.GetEscrowAddress(), sdk.NewCoins(paidCoin))
+		case *types.PositionOrder:
+			paidCoin := sdk.NewCoin(order.OfferCoinDenom, order.PaidOfferCoinAmount)
+			bulkOp.QueueSendCoins(order.ReserveAddress, pair.GetEscrowAddress(), sdk.NewCoins(paidCoin))
+		}
 	}
 	if err := bulkOp.Run(ctx, k.bankKeeper); err != nil {
-		return err
+		return nil, err
 	}
 	bulkOp = types.NewBulkSendCoinsOperation()
+	// PoolMatchResult is used for both pools and positions.
 	type PoolMatchResult struct {
-		PoolId         uint64
+		Id             uint64
 		OrderDirection types.OrderDirection
 		PaidCoin       sdk.Coin
 		ReceivedCoin   sdk.Coin
@@ -640,6 +789,8 @@ func (k Keeper) ApplyMatchResult(ctx sdk.Context, pair types.Pair, orders []amm.
 	}
 	poolMatchResultById := map[uint64]*PoolMatchResult{}
 	var poolMatchResults []*PoolMatchResult
+	positionMatchResultById := map[uint64]*PoolMatchResult{}
+	var positionMatchResults []*PoolMatchResult
 	for _, order := range orders {
 		if !order.IsMatched() {
 			continue
@@ -651,21 +802,31 @@ func (k Keeper) ApplyMatchResult(ctx sdk.Context, pair types.Pair, orders []amm.
 		case *types.UserOrder:
 			paidCoin := sdk.NewCoin(order.OfferCoinDenom, order.PaidOfferCoinAmount)
 			receivedCoin := sdk.NewCoin(order.DemandCoinDenom, order.ReceivedDemandCoinAmount)
+			swapFee := sdk.NewCoin(order.DemandCoinDenom, sdk.ZeroInt())
+			rebate := sdk.NewCoin(order.DemandCoinDenom, sdk.ZeroInt())
+			if amt := swapFeeByOrder[order]; amt.IsNegative() {
+				rebate = sdk.NewCoin(order.DemandCoinDenom, amt.Neg())
+			} else {
+				swapFee = sdk.NewCoin(order.DemandCoinDenom, amt)
+			}
+			receivedCoin = receivedCoin.Sub(swapFee)
+			swapFees = swapFees.Add(sdk.NewCoins(swapFee)...)
+			rebates = rebates.Add(sdk.NewCoins(rebate)...)
 
 			o, _ := k.GetOrder(ctx, pair.Id, order.OrderId)
 			o.OpenAmount = o.OpenAmount.Sub(matchedAmt)
 			o.RemainingOfferCoin = o.RemainingOfferCoin.Sub(paidCoin)
-			o.ReceivedCoin = o.ReceivedCoin.Add(receivedCoin)
+			o.ReceivedCoin = o.ReceivedCoin.Add(receivedCoin).Add(rebate)
 
 			if o.OpenAmount.IsZero() {
 				if err := k.FinishOrder(ctx, o, types.OrderStatusCompleted); err != nil {
-					return err
+					return nil, err
 				}
 			} else {
 				o.SetStatus(types.OrderStatusPartiallyMatched)
 				k.SetOrder(ctx, o)
 			}
-			bulkOp.QueueSendCoins(pair.GetEscrowAddress(), order.Orderer, sdk.NewCoins(receivedCoin))
+			bulkOp.QueueSendCoins(pair.GetEscrowAddress(), order.Orderer, sdk.NewCoins(receivedCoin.Add(rebate)))
 
 			ctx.EventManager().EmitEvents(sdk.Events{
 				sdk.NewEvent(
@@ -677,6 +838,33 @@ func (k Keeper) ApplyMatchResult(ctx sdk.Context, pair types.Pair, orders []amm.
 					sdk.NewAttribute(types.AttributeKeyMatchedAmount, matchedAmt.String()),
 					sdk.NewAttribute(types.AttributeKeyPaidCoin, paidCoin.String()),
 					sdk.NewAttribute(types.AttributeKeyReceivedCoin, receivedCoin.String()),
+					sdk.NewAttribute(types.AttributeKeySwapFee, swapFee.String()),
+					sdk.NewAttribute(types.AttributeKeyRebate, rebate.String()),
+				),
+			})
+		case *types.RoutedSwapOrder:
+			paidCoin := sdk.NewCoin(order.OfferCoinDenom, order.PaidOfferCoinAmount)
+			receivedCoin := sdk.NewCoin(order.DemandCoinDenom, order.ReceivedDemandCoinAmount)
+			swapFee := sdk.NewCoin(order.DemandCoinDenom, swapFeeByOrder[order])
+			receivedCoin = receivedCoin.Sub(swapFee)
+			swapFees = swapFees.Add(sdk.NewCoins(swapFee)...)
+			refundedCoin := sdk.NewCoin(order.OfferCoinDenom, order.OfferCoinAmount.Sub(order.PaidOfferCoinAmount))
+
+			bulkOp.QueueSendCoins(pair.GetEscrowAddress(), order.Recipient, sdk.NewCoins(receivedCoin))
+			bulkOp.QueueSendCoins(pair.GetEscrowAddress(), order.Orderer, sdk.NewCoins(refundedCoin))
+
+			ctx.EventManager().EmitEvents(sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeRoutedSwapOrderMatched,
+					sdk.NewAttribute(types.AttributeKeyOrderDirection, types.OrderDirectionFromAMM(order.Direction).String()),
+					sdk.NewAttribute(types.AttributeKeyOrderer, order.Orderer.String()),
+					sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(pair.Id, 10)),
+					sdk.NewAttribute(types.AttributeKeyRequestId, strconv.FormatUint(order.RequestId, 10)),
+					sdk.NewAttribute(types.AttributeKeyMatchedAmount, matchedAmt.String()),
+					sdk.NewAttribute(types.AttributeKeyPaidCoin, paidCoin.String()),
+					sdk.NewAttribute(types.AttributeKeyReceivedCoin, receivedCoin.String()),
+					sdk.NewAttribute(types.AttributeKeySwapFee, swapFee.String()),
+					sdk.NewAttribute(types.AttributeKeyRefundedCoins, refundedCoin.String()),
 				),
 			})
 		case *types.PoolOrder:
@@ -688,7 +876,7 @@ func (k Keeper) ApplyMatchResult(ctx sdk.Context, pair types.Pair, orders []amm.
 			r, ok := poolMatchResultById[order.PoolId]
 			if !ok {
 				r = &PoolMatchResult{
-					PoolId:         order.PoolId,
+					Id:             order.PoolId,
 					OrderDirection: types.OrderDirectionFromAMM(order.Direction),
 					PaidCoin:       sdk.NewCoin(paidCoin.Denom, sdk.ZeroInt()),
 					ReceivedCoin:   sdk.NewCoin(receivedCoin.Denom, sdk.ZeroInt()),
@@ -704,13 +892,42 @@ func (k Keeper) ApplyMatchResult(ctx sdk.Context, pair types.Pair, orders []amm.
 			r.PaidCoin = r.PaidCoin.Add(paidCoin)
 			r.ReceivedCoin = r.ReceivedCoin.Add(receivedCoin)
 			r.MatchedAmount = r.MatchedAmount.Add(matchedAmt)
+		case *types.PositionOrder:
+			paidCoin := sdk.NewCoin(order.OfferCoinDenom, order.PaidOfferCoinAmount)
+			receivedCoin := sdk.NewCoin(order.DemandCoinDenom, order.ReceivedDemandCoinAmount)
+
+			bulkOp.QueueSendCoins(pair.GetEscrowAddress(), order.ReserveAddress, sdk.NewCoins(receivedCoin))
+
+			r, ok := positionMatchResultById[order.PositionId]
+			if !ok {
+				r = &PoolMatchResult{
+					Id:             order.PositionId,
+					OrderDirection: types.OrderDirectionFromAMM(order.Direction),
+					PaidCoin:       sdk.NewCoin(paidCoin.Denom, sdk.ZeroInt()),
+					ReceivedCoin:   sdk.NewCoin(receivedCoin.Denom, sdk.ZeroInt()),
+					MatchedAmount:  sdk.ZeroInt(),
+				}
+				positionMatchResultById[order.PositionId] = r
+				positionMatchResults = append(positionMatchResults, r)
+			}
+			dir := types.OrderDirectionFromAMM(order.Direction)
+			if r.OrderDirection != dir {
+				panic(fmt.Errorf("wrong order direction: %s != %s", dir, r.OrderDirection))
+			}
+			r.PaidCoin = r.PaidCoin.Add(paidCoin)
+			r.ReceivedCoin = r.ReceivedCoin.Add(receivedCoin)
+			r.MatchedAmount = r.MatchedAmount.Add(matchedAmt)
 		default:
 			panic(fmt.Errorf("invalid order type: %T", order))
 		}
 	}
 	bulkOp.QueueSendCoins(pair.GetEscrowAddress(), k.GetDustCollector(ctx), sdk.NewCoins(sdk.NewCoin(pair.QuoteCoinDenom, quoteCoinDiff)))
+	// Rebates are paid out of the taker fees in the escrow, so only the net
+	// swap fees are distributed.
+	swapFees = swapFees.Sub(rebates)
+	k.queueSwapFeeDistribution(ctx, bulkOp, pair, orders, swapFees)
 	if err := bulkOp.Run(ctx, k.bankKeeper); err != nil {
-		return err
+		return nil, err
 	}
 	for _, r := range poolMatchResults {
 		ctx.EventManager().EmitEvents(sdk.Events{
@@ -718,14 +935,27 @@ func (k Keeper) ApplyMatchResult(ctx sdk.Context, pair types.Pair, orders []amm.
 				types.EventTypePoolOrderMatched,
 				sdk.NewAttribute(types.AttributeKeyOrderDirection, r.OrderDirection.String()),
 				sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(pair.Id, 10)),
-				sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(r.PoolId, 10)),
+				sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(r.Id, 10)),
 				sdk.NewAttribute(types.AttributeKeyMatchedAmount, r.MatchedAmount.String()),
 				sdk.NewAttribute(types.AttributeKeyPaidCoin, r.PaidCoin.String()),
 				sdk.NewAttribute(types.AttributeKeyReceivedCoin, r.ReceivedCoin.String()),
 			),
 		})
 	}
-	return nil
+	for _, r := range positionMatchResults {
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypePositionOrderMatched,
+				sdk.NewAttribute(types.AttributeKeyOrderDirection, r.OrderDirection.String()),
+				sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(pair.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(r.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyMatchedAmount, r.MatchedAmount.String()),
+				sdk.NewAttribute(types.AttributeKeyPaidCoin, r.PaidCoin.String()),
+				sdk.NewAttribute(types.AttributeKeyReceivedCoin, r.ReceivedCoin.String()),
+			),
+		})
+	}
+	return swapFees, nil
 }
 
 func (k Keeper) FinishOrder(ctx sdk.Context, order types.Order, status types.OrderStatus) error {
@@ -756,6 +986,7 @@ func (k Keeper) FinishOrder(ctx sdk.Context, order types.Order, status types.Ord
 			sdk.NewAttribute(types.AttributeKeyRemainingOfferCoin, order.RemainingOfferCoin.String()),
 			sdk.NewAttribute(types.AttributeKeyReceivedCoin, order.ReceivedCoin.String()),
 			sdk.NewAttribute(types.AttributeKeyStatus, order.Status.String()),
+			sdk.NewAttribute(types.AttributeKeyTimeInForce, order.TimeInForce.String()),
 		),
 	})
 
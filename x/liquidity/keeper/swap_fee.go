@@ -0,0 +1,151 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmosquad-labs/squad/v3/x/liquidity/amm"
+	"github.com/cosmosquad-labs/squad/v3/x/liquidity/types"
+)
+
+// MakerSwapFeeRate returns the swap fee rate applied to maker orders in the
+// pair, which is the sum of the pair's swap fee rate and the maker fee rate.
+// A negative rate means a rebate.
+func (k Keeper) MakerSwapFeeRate(ctx sdk.Context, pair types.Pair) sdk.Dec {
+	return clampSwapFeeRate(k.GetPairSwapFeeRate(ctx, pair).Add(k.GetMakerFeeRate(ctx)))
+}
+
+// TakerSwapFeeRate returns the swap fee rate applied to taker orders in the
+// pair, which is the sum of the pair's swap fee rate and the taker fee rate.
+func (k Keeper) TakerSwapFeeRate(ctx sdk.Context, pair types.Pair) sdk.Dec {
+	return clampSwapFeeRate(k.GetPairSwapFeeRate(ctx, pair).Add(k.GetTakerFeeRate(ctx)))
+}
+
+// clampSwapFeeRate clamps the rate into [-1, 1], so that neither a fee nor
+// a rebate can exceed the received coin.
+func clampSwapFeeRate(rate sdk.Dec) sdk.Dec {
+	return sdk.MaxDec(sdk.OneDec().Neg(), sdk.MinDec(rate, sdk.OneDec()))
+}
+
+// orderSwapFees returns the swap fee amounts of the matched user orders and
+// routed swap orders.
+// A negative amount means a rebate paid to the maker order.
+// Routed swap orders always pay the taker swap fee rate.
+// Rebates are funded only by the swap fees paid by the taker orders matched
+// in the same batch, in the same denom, and they are scaled down
+// proportionally if those fees are insufficient.
+func (k Keeper) orderSwapFees(ctx sdk.Context, pair types.Pair, orders []amm.Order) map[amm.Order]sdk.Int {
+	makerSwapFeeRate := k.MakerSwapFeeRate(ctx, pair)
+	takerSwapFeeRate := k.TakerSwapFeeRate(ctx, pair)
+
+	swapFeeByOrder := map[amm.Order]sdk.Int{}
+	takerFeesByDenom := map[string]sdk.Int{}
+	rebatedByDenom := map[string]sdk.Int{}
+	addAmount := func(m map[string]sdk.Int, denom string, amt sdk.Int) {
+		prev, ok := m[denom]
+		if !ok {
+			prev = sdk.ZeroInt()
+		}
+		m[denom] = prev.Add(amt)
+	}
+	for _, order := range orders {
+		if !order.IsMatched() {
+			continue
+		}
+		switch order := order.(type) {
+		case *types.UserOrder:
+			o, _ := k.GetOrder(ctx, pair.Id, order.OrderId)
+			isMaker := o.IsMaker(pair.CurrentBatchId)
+			swapFeeRate := takerSwapFeeRate
+			if isMaker {
+				swapFeeRate = makerSwapFeeRate
+			}
+			swapFee := swapFeeRate.MulInt(order.ReceivedDemandCoinAmount).TruncateInt()
+			swapFeeByOrder[order] = swapFee
+			if swapFee.IsNegative() {
+				addAmount(rebatedByDenom, order.DemandCoinDenom, swapFee.Neg())
+			} else if !isMaker {
+				addAmount(takerFeesByDenom, order.DemandCoinDenom, swapFee)
+			}
+		case *types.RoutedSwapOrder:
+			swapFee := takerSwapFeeRate.MulInt(order.ReceivedDemandCoinAmount).TruncateInt()
+			swapFeeByOrder[order] = swapFee
+			addAmount(takerFeesByDenom, order.DemandCoinDenom, swapFee)
+		}
+	}
+	if len(rebatedByDenom) == 0 {
+		return swapFeeByOrder
+	}
+
+	for _, order := range orders {
+		order, ok := order.(*types.UserOrder)
+		if !ok || !order.IsMatched() {
+			continue
+		}
+		swapFee := swapFeeByOrder[order]
+		if !swapFee.IsNegative() {
+			continue
+		}
+		rebated := rebatedByDenom[order.DemandCoinDenom]
+		available, ok := takerFeesByDenom[order.DemandCoinDenom]
+		if !ok {
+			available = sdk.ZeroInt()
+		}
+		if rebated.GT(available) {
+			swapFeeByOrder[order] = swapFee.Mul(available).Quo(rebated)
+		}
+	}
+	return swapFeeByOrder
+}
+
+// queueSwapFeeDistribution queues the distribution of swap fees collected in
+// the pair's escrow.
+// The pool swap fee ratio of the fees is distributed to the reserves of the
+// pools and positions matched in the batch, proportionally to their matched amounts.
+// The rest of the fees goes to the fee collector.
+func (k Keeper) queueSwapFeeDistribution(
+	ctx sdk.Context, bulkOp *types.BulkSendCoinsOperation, pair types.Pair, orders []amm.Order, swapFees sdk.Coins) {
+	if swapFees.IsZero() {
+		return
+	}
+
+	var reserveAddrs []sdk.AccAddress
+	matchedAmtByReserveAddr := map[string]sdk.Int{}
+	totalMatchedAmt := sdk.ZeroInt()
+	for _, order := range orders {
+		if !order.IsMatched() {
+			continue
+		}
+		var reserveAddr sdk.AccAddress
+		switch order := order.(type) {
+		case *types.PoolOrder:
+			reserveAddr = order.ReserveAddress
+		case *types.PositionOrder:
+			reserveAddr = order.ReserveAddress
+		default:
+			continue
+		}
+		matchedAmt := order.GetAmount().Sub(order.GetOpenAmount())
+		prevAmt, ok := matchedAmtByReserveAddr[reserveAddr.String()]
+		if !ok {
+			reserveAddrs = append(reserveAddrs, reserveAddr)
+			prevAmt = sdk.ZeroInt()
+		}
+		matchedAmtByReserveAddr[reserveAddr.String()] = prevAmt.Add(matchedAmt)
+		totalMatchedAmt = totalMatchedAmt.Add(matchedAmt)
+	}
+
+	feeCollectorFees := swapFees
+	if totalMatchedAmt.IsPositive() {
+		poolSwapFeeRatio := k.GetPoolSwapFeeRatio(ctx)
+		for _, fee := range swapFees {
+			poolFeeAmt := poolSwapFeeRatio.MulInt(fee.Amount).TruncateInt()
+			for _, reserveAddr := range reserveAddrs {
+				matchedAmt := matchedAmtByReserveAddr[reserveAddr.String()]
+				poolFee := sdk.NewCoin(fee.Denom, poolFeeAmt.Mul(matchedAmt).Quo(totalMatchedAmt))
+				bulkOp.QueueSendCoins(pair.GetEscrowAddress(), reserveAddr, sdk.NewCoins(poolFee))
+				feeCollectorFees = feeCollectorFees.Sub(sdk.NewCoins(poolFee))
+			}
+		}
+	}
+	bulkOp.QueueSendCoins(pair.GetEscrowAddress(), k.GetFeeCollector(ctx), feeCollectorFees)
+}
@@ -0,0 +1,257 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/cosmosquad-labs/squad/v3/types"
+	"github.com/cosmosquad-labs/squad/v3/x/liquidity"
+	"github.com/cosmosquad-labs/squad/v3/x/liquidity/types"
+)
+
+func (s *KeeperTestSuite) TestCreatePair_SwapFeeRate() {
+	s.fundAddr(s.addr(0), s.keeper.GetPairCreationFee(s.ctx))
+	msg := types.NewMsgCreatePair(s.addr(0), "denom1", "denom2")
+	msg.SwapFeeRate = utils.ParseDecP("0.003")
+	s.Require().NoError(msg.ValidateBasic())
+	pair, err := s.keeper.CreatePair(s.ctx, msg)
+	s.Require().NoError(err)
+	s.Require().NotNil(pair.SwapFeeRate)
+	s.Require().True(decEq(utils.ParseDec("0.003"), s.keeper.GetPairSwapFeeRate(s.ctx, pair)))
+
+	// A swap fee rate not in the allowed set.
+	s.fundAddr(s.addr(0), s.keeper.GetPairCreationFee(s.ctx))
+	msg = types.NewMsgCreatePair(s.addr(0), "denom2", "denom3")
+	msg.SwapFeeRate = utils.ParseDecP("0.002")
+	s.Require().NoError(msg.ValidateBasic())
+	_, err = s.keeper.CreatePair(s.ctx, msg)
+	s.Require().ErrorIs(err, types.ErrSwapFeeRateNotAllowed)
+
+	// The pair without its own rate uses the global swap fee rate.
+	pair = s.createPair(s.addr(0), "denom2", "denom3", true)
+	s.Require().Nil(pair.SwapFeeRate)
+	s.Require().True(decEq(s.keeper.GetSwapFeeRate(s.ctx), s.keeper.GetPairSwapFeeRate(s.ctx, pair)))
+}
+
+func (s *KeeperTestSuite) TestSwapFee() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	s.Require().NoError(s.keeper.SetPairSwapFeeRate(s.ctx, pair.Id, utils.ParseDecP("0.01")))
+
+	feeCollector := s.keeper.GetFeeCollector(s.ctx)
+	feeCollectorBalances := s.getBalances(feeCollector)
+
+	s.sellLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(1000000), 0, true)
+	s.buyLimitOrder(s.addr(2), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(1000000), 0, true)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+
+	s.Require().True(coinsEq(utils.ParseCoins("990000denom2"), s.getBalances(s.addr(1))))
+	s.Require().True(coinsEq(utils.ParseCoins("990000denom1"), s.getBalances(s.addr(2))))
+
+	// Without any matched pool, all the swap fees go to the fee collector.
+	swapFees := utils.ParseCoins("10000denom1,10000denom2")
+	s.Require().True(coinsEq(feeCollectorBalances.Add(swapFees...), s.getBalances(feeCollector)))
+
+	records := s.keeper.GetTradeRecordsByPair(s.ctx, pair.Id)
+	s.Require().Len(records, 1)
+	s.Require().True(coinsEq(swapFees, records[0].SwapFees))
+}
+
+func (s *KeeperTestSuite) TestSwapFee_PoolShare() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	s.Require().NoError(s.keeper.SetPairSwapFeeRate(s.ctx, pair.Id, utils.ParseDecP("0.01")))
+	s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000000denom1,1000000000denom2"), true)
+	pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+	pair.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair)
+
+	feeCollector := s.keeper.GetFeeCollector(s.ctx)
+	feeCollectorBalance := s.getBalance(feeCollector, "denom2")
+
+	s.sellLimitOrder(s.addr(1), pair.Id, utils.ParseDec("0.99"), sdk.NewInt(1000000), 0, true)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+
+	records := s.keeper.GetTradeRecordsByPair(s.ctx, pair.Id)
+	s.Require().Len(records, 1)
+	swapFee := records[0].SwapFees.AmountOf("denom2")
+	s.Require().True(swapFee.IsPositive())
+
+	// Half of the swap fees goes to the matched pool, and the rest goes to
+	// the fee collector.
+	poolSwapFee := s.keeper.GetPoolSwapFeeRatio(s.ctx).MulInt(swapFee).TruncateInt()
+	s.Require().True(intEq(
+		feeCollectorBalance.Amount.Add(swapFee.Sub(poolSwapFee)),
+		s.getBalance(feeCollector, "denom2").Amount))
+
+}
+
+func (s *KeeperTestSuite) TestSwapFee_PositionShare() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	s.Require().NoError(s.keeper.SetPairSwapFeeRate(s.ctx, pair.Id, utils.ParseDecP("0.01")))
+	pool := s.createPool(s.addr(0), pair.Id, utils.ParseCoins("1000000000denom1,1000000000denom2"), true)
+	pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+	pair.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair)
+	position := s.createPosition(
+		s.addr(0), pair.Id, utils.ParseCoins("1000000000denom1,1000000000denom2"),
+		utils.ParseDec("0.5"), utils.ParseDec("2.0"), true)
+
+	feeCollector := s.keeper.GetFeeCollector(s.ctx)
+	feeCollectorBalance := s.getBalance(feeCollector, "denom2")
+	poolBalance := s.getBalance(pool.GetReserveAddress(), "denom2")
+	positionBalance := s.getBalance(position.GetReserveAddress(), "denom2")
+
+	s.sellLimitOrder(s.addr(1), pair.Id, utils.ParseDec("0.99"), sdk.NewInt(10000000), 0, true)
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	liquidity.EndBlocker(s.ctx, s.keeper)
+
+	records := s.keeper.GetTradeRecordsByPair(s.ctx, pair.Id)
+	s.Require().Len(records, 1)
+	swapFee := records[0].SwapFees.AmountOf("denom2")
+	s.Require().True(swapFee.IsPositive())
+
+	// Both the pool and the position are matched.
+	matchedAmts := map[string]sdk.Int{}
+	paidAmts := map[string]sdk.Int{}
+	for _, ev := range s.ctx.EventManager().Events() {
+		if ev.Type != types.EventTypePoolOrderMatched && ev.Type != types.EventTypePositionOrderMatched {
+			continue
+		}
+		for _, attr := range ev.Attributes {
+			switch string(attr.Key) {
+			case types.AttributeKeyMatchedAmount:
+				matchedAmts[ev.Type], _ = sdk.NewIntFromString(string(attr.Value))
+			case types.AttributeKeyPaidCoin:
+				paidAmts[ev.Type] = utils.ParseCoin(string(attr.Value)).Amount
+			}
+		}
+	}
+	poolMatchedAmt := matchedAmts[types.EventTypePoolOrderMatched]
+	positionMatchedAmt := matchedAmts[types.EventTypePositionOrderMatched]
+	s.Require().True(poolMatchedAmt.IsPositive())
+	s.Require().True(positionMatchedAmt.IsPositive())
+
+	// The pool swap fees are shared between the pool and the position
+	// proportionally to their matched amounts, and the rest goes to the fee
+	// collector.
+	poolSwapFee := s.keeper.GetPoolSwapFeeRatio(s.ctx).MulInt(swapFee).TruncateInt()
+	totalMatchedAmt := poolMatchedAmt.Add(positionMatchedAmt)
+	poolShare := poolSwapFee.Mul(poolMatchedAmt).Quo(totalMatchedAmt)
+	positionShare := poolSwapFee.Mul(positionMatchedAmt).Quo(totalMatchedAmt)
+	s.Require().True(positionShare.IsPositive())
+	s.Require().True(intEq(
+		poolBalance.Amount.Sub(paidAmts[types.EventTypePoolOrderMatched]).Add(poolShare),
+		s.getBalance(pool.GetReserveAddress(), "denom2").Amount))
+	s.Require().True(intEq(
+		positionBalance.Amount.Sub(paidAmts[types.EventTypePositionOrderMatched]).Add(positionShare),
+		s.getBalance(position.GetReserveAddress(), "denom2").Amount))
+	s.Require().True(intEq(
+		feeCollectorBalance.Amount.Add(swapFee.Sub(poolShare).Sub(positionShare)),
+		s.getBalance(feeCollector, "denom2").Amount))
+}
+
+func (s *KeeperTestSuite) TestSwapFee_RoutedSwap() {
+	pair1 := s.createPair(s.addr(0), "denom1", "denom2", true)
+	s.Require().NoError(s.keeper.SetPairSwapFeeRate(s.ctx, pair1.Id, utils.ParseDecP("0.003")))
+	s.createPool(s.addr(0), pair1.Id, utils.ParseCoins("1000000000denom1,1000000000denom2"), true)
+	pair1, _ = s.keeper.GetPair(s.ctx, pair1.Id)
+	pair1.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair1)
+	pair2 := s.createPair(s.addr(0), "denom3", "denom2", true)
+	s.Require().NoError(s.keeper.SetPairSwapFeeRate(s.ctx, pair2.Id, utils.ParseDecP("0.01")))
+	s.createPool(s.addr(0), pair2.Id, utils.ParseCoins("1000000000denom2,1000000000denom3"), true)
+	pair2, _ = s.keeper.GetPair(s.ctx, pair2.Id)
+	pair2.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair2)
+
+	offerCoin := utils.ParseCoin("1000000denom1")
+	expected, _, err := s.keeper.SimulateRoutedSwap(s.ctx, []uint64{pair1.Id, pair2.Id}, offerCoin)
+	s.Require().NoError(err)
+
+	s.routedSwap(s.addr(1), []uint64{pair1.Id, pair2.Id}, offerCoin, "denom3", sdk.NewInt(980000), true)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+
+	// The simulation takes the swap fees of all legs into account.
+	s.Require().True(coinEq(expected, s.getBalance(s.addr(1), "denom3")))
+	for _, pairId := range []uint64{pair1.Id, pair2.Id} {
+		records := s.keeper.GetTradeRecordsByPair(s.ctx, pairId)
+		s.Require().Len(records, 1)
+		s.Require().False(records[0].SwapFees.IsZero())
+	}
+}
+
+func (s *KeeperTestSuite) TestMakerTakerFee() {
+	params := s.keeper.GetParams(s.ctx)
+	params.MakerFeeRate = utils.ParseDec("0.001")
+	params.TakerFeeRate = utils.ParseDec("0.003")
+	s.keeper.SetParams(s.ctx, params)
+
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	s.Require().NoError(s.keeper.SetPairSwapFeeRate(s.ctx, pair.Id, utils.ParseDecP("0.01")))
+
+	// The sell order rests in the order book, so it becomes a maker.
+	s.sellLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(1000000), time.Hour, true)
+	s.nextBlock()
+	s.buyLimitOrder(s.addr(2), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(1000000), 0, true)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+
+	s.Require().True(coinsEq(utils.ParseCoins("989000denom2"), s.getBalances(s.addr(1))))
+	s.Require().True(coinsEq(utils.ParseCoins("987000denom1"), s.getBalances(s.addr(2))))
+
+	records := s.keeper.GetTradeRecordsByPair(s.ctx, pair.Id)
+	s.Require().Len(records, 1)
+	s.Require().True(coinsEq(utils.ParseCoins("13000denom1,11000denom2"), records[0].SwapFees))
+}
+
+func (s *KeeperTestSuite) TestMakerRebate() {
+	params := s.keeper.GetParams(s.ctx)
+	params.MakerFeeRate = utils.ParseDec("-0.002")
+	params.TakerFeeRate = utils.ParseDec("0.002")
+	s.keeper.SetParams(s.ctx, params)
+
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	feeCollector := s.keeper.GetFeeCollector(s.ctx)
+	s.fundAddr(feeCollector, utils.ParseCoins("1000000denom2"))
+
+	// A post-only order is a maker even in its first batch.
+	_, err := s.postOnlyOrder(s.addr(1), pair.Id, types.OrderDirectionSell, utils.ParseDec("1.0"), sdk.NewInt(1000000), time.Hour)
+	s.Require().NoError(err)
+	s.sellLimitOrder(s.addr(2), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(1000000), 0, true)
+	s.buyLimitOrder(s.addr(3), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(2000000), 0, true)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+
+	// The maker's rebate is funded by the taker fee in the same denom.
+	s.Require().True(coinsEq(utils.ParseCoins("1002000denom2"), s.getBalances(s.addr(1))))
+	s.Require().True(coinsEq(utils.ParseCoins("998000denom2"), s.getBalances(s.addr(2))))
+	s.Require().True(coinsEq(utils.ParseCoins("1996000denom1"), s.getBalances(s.addr(3))))
+	// The fee collector does not pay for the rebate.
+	s.Require().True(coinEq(utils.ParseCoin("4000denom1"), s.getBalance(feeCollector, "denom1")))
+	s.Require().True(coinEq(utils.ParseCoin("1000000denom2"), s.getBalance(feeCollector, "denom2")))
+
+	records := s.keeper.GetTradeRecordsByPair(s.ctx, pair.Id)
+	s.Require().Len(records, 1)
+	s.Require().True(coinsEq(utils.ParseCoins("4000denom1"), records[0].SwapFees))
+}
+
+func (s *KeeperTestSuite) TestMakerRebate_Insufficient() {
+	params := s.keeper.GetParams(s.ctx)
+	params.MakerFeeRate = utils.ParseDec("-0.002")
+	params.TakerFeeRate = utils.ParseDec("0.002")
+	s.keeper.SetParams(s.ctx, params)
+
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	feeCollector := s.keeper.GetFeeCollector(s.ctx)
+	s.fundAddr(feeCollector, utils.ParseCoins("1000000denom2"))
+
+	_, err := s.postOnlyOrder(s.addr(1), pair.Id, types.OrderDirectionSell, utils.ParseDec("1.0"), sdk.NewInt(1000000), time.Hour)
+	s.Require().NoError(err)
+	s.buyLimitOrder(s.addr(2), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(1000000), 0, true)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+
+	// No taker paid a fee in denom2 in the batch, so there is no rebate,
+	// even though the fee collector has enough balance.
+	s.Require().True(coinsEq(utils.ParseCoins("1000000denom2"), s.getBalances(s.addr(1))))
+	s.Require().True(coinsEq(utils.ParseCoins("998000denom1"), s.getBalances(s.addr(2))))
+	s.Require().True(coinEq(utils.ParseCoin("2000denom1"), s.getBalance(feeCollector, "denom1")))
+	s.Require().True(coinEq(utils.ParseCoin("1000000denom2"), s.getBalance(feeCollector, "denom2")))
+}
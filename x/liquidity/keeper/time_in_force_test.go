@@ -0,0 +1,84 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/cosmosquad-labs/squad/v3/types"
+	"github.com/cosmosquad-labs/squad/v3/x/liquidity"
+	"github.com/cosmosquad-labs/squad/v3/x/liquidity/types"
+)
+
+func (s *KeeperTestSuite) TestImmediateOrCancelOrder() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	s.sellLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(1000000), 0, true)
+	order := s.limitOrderWithTimeInForce(
+		s.addr(2), pair.Id, types.OrderDirectionBuy, utils.ParseDec("1.0"), sdk.NewInt(2000000),
+		types.TimeInForceImmediateOrCancel, true)
+	s.Require().Equal(types.TimeInForceImmediateOrCancel, order.TimeInForce)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+
+	// The order is partially matched and the remainder is refunded.
+	order, found := s.keeper.GetOrder(s.ctx, pair.Id, order.Id)
+	s.Require().True(found)
+	s.Require().Equal(types.OrderStatusExpired, order.Status)
+	s.Require().True(intEq(sdk.NewInt(1000000), order.OpenAmount))
+	s.Require().True(coinEq(utils.ParseCoin("1000000denom2"), order.RemainingOfferCoin))
+	s.Require().True(coinEq(
+		utils.ParseCoin("1000000denom2"), s.getBalance(s.addr(2), "denom2")))
+	s.Require().True(coinEq(
+		order.ReceivedCoin, s.getBalance(s.addr(2), "denom1")))
+
+	// An unmatched order is refunded as well.
+	liquidity.BeginBlocker(s.ctx, s.keeper) // delete finished orders
+	order = s.limitOrderWithTimeInForce(
+		s.addr(3), pair.Id, types.OrderDirectionBuy, utils.ParseDec("0.95"), sdk.NewInt(1000000),
+		types.TimeInForceImmediateOrCancel, true)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+	order, found = s.keeper.GetOrder(s.ctx, pair.Id, order.Id)
+	s.Require().True(found)
+	s.Require().Equal(types.OrderStatusExpired, order.Status)
+	s.Require().True(coinEq(
+		utils.ParseCoin("950000denom2"), s.getBalance(s.addr(3), "denom2")))
+}
+
+func (s *KeeperTestSuite) TestFillOrKillOrder() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	sellOrder := s.sellLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(1000000), time.Hour, true)
+	order := s.limitOrderWithTimeInForce(
+		s.addr(2), pair.Id, types.OrderDirectionBuy, utils.ParseDec("1.0"), sdk.NewInt(2000000),
+		types.TimeInForceFillOrKill, true)
+	buyOrder := s.buyLimitOrder(s.addr(3), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(500000), 0, true)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+
+	// The order cannot be fully matched, so it is refunded without any match.
+	order, found := s.keeper.GetOrder(s.ctx, pair.Id, order.Id)
+	s.Require().True(found)
+	s.Require().Equal(types.OrderStatusExpired, order.Status)
+	s.Require().True(intEq(sdk.NewInt(2000000), order.OpenAmount))
+	s.Require().True(coinEq(
+		utils.ParseCoin("2000000denom2"), s.getBalance(s.addr(2), "denom2")))
+	s.Require().True(s.getBalance(s.addr(2), "denom1").IsZero())
+
+	// Other orders are matched without the killed order.
+	buyOrder, _ = s.keeper.GetOrder(s.ctx, pair.Id, buyOrder.Id)
+	s.Require().Equal(types.OrderStatusCompleted, buyOrder.Status)
+	sellOrder, _ = s.keeper.GetOrder(s.ctx, pair.Id, sellOrder.Id)
+	s.Require().Equal(types.OrderStatusPartiallyMatched, sellOrder.Status)
+	s.Require().True(intEq(sdk.NewInt(500000), sellOrder.OpenAmount))
+
+	liquidity.BeginBlocker(s.ctx, s.keeper) // delete finished orders
+
+	// The order is completed when it can be fully matched.
+	order = s.limitOrderWithTimeInForce(
+		s.addr(4), pair.Id, types.OrderDirectionBuy, utils.ParseDec("1.0"), sdk.NewInt(500000),
+		types.TimeInForceFillOrKill, true)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+	order, found = s.keeper.GetOrder(s.ctx, pair.Id, order.Id)
+	s.Require().True(found)
+	s.Require().Equal(types.OrderStatusCompleted, order.Status)
+	s.Require().True(order.OpenAmount.IsZero())
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmosquad-labs/squad/v3/x/liquidity/amm"
+	"github.com/cosmosquad-labs/squad/v3/x/liquidity/types"
+)
+
+// RecordTrade records the matching result of the pair in the current batch.
+// The volumes are calculated from the matched buy orders.
+// If the pair already has a trade record in the current batch, the result is
+// merged into the record.
+// Trade records older than the retention are pruned afterwards.
+func (k Keeper) RecordTrade(ctx sdk.Context, pair types.Pair, orders []amm.Order, matchPrice sdk.Dec, swapFees sdk.Coins) {
+	retention := k.GetTradeRecordRetention(ctx)
+	if retention == 0 {
+		return
+	}
+
+	baseVolume, quoteVolume := sdk.ZeroInt(), sdk.ZeroInt()
+	for _, order := range orders {
+		if !order.IsMatched() || order.GetDirection() != amm.Buy {
+			continue
+		}
+		baseVolume = baseVolume.Add(order.GetAmount().Sub(order.GetOpenAmount()))
+		quoteVolume = quoteVolume.Add(order.GetPaidOfferCoinAmount())
+	}
+
+	record := types.NewTradeRecord(
+		pair.Id, pair.CurrentBatchId, ctx.BlockHeight(), ctx.BlockTime(), matchPrice,
+		baseVolume, quoteVolume, swapFees)
+	if prevRecord, found := k.GetTradeRecord(ctx, pair.Id, pair.CurrentBatchId); found {
+		prevRecord.Merge(record)
+		record = prevRecord
+	}
+	k.SetTradeRecord(ctx, record)
+
+	k.PruneTradeRecords(ctx, pair, retention)
+}
+
+// PruneTradeRecords deletes trade records of the pair which are older than
+// the latest retention batches.
+func (k Keeper) PruneTradeRecords(ctx sdk.Context, pair types.Pair, retention uint32) {
+	if pair.CurrentBatchId < uint64(retention) {
+		return
+	}
+	oldestBatchId := pair.CurrentBatchId - uint64(retention) + 1
+	_ = k.IterateTradeRecordsByPair(ctx, pair.Id, func(record types.TradeRecord) (stop bool, err error) {
+		if record.BatchId >= oldestBatchId {
+			return true, nil
+		}
+		k.DeleteTradeRecord(ctx, record)
+		return false, nil
+	})
+}
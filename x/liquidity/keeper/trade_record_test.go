@@ -0,0 +1,95 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/cosmosquad-labs/squad/v3/types"
+	"github.com/cosmosquad-labs/squad/v3/x/liquidity"
+)
+
+func (s *KeeperTestSuite) TestRecordTrade() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	s.sellLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(1000000), 0, true)
+	s.buyLimitOrder(s.addr(2), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(1000000), 0, true)
+	s.nextBlock()
+
+	records := s.keeper.GetTradeRecordsByPair(s.ctx, pair.Id)
+	s.Require().Len(records, 1)
+	record := records[0]
+	s.Require().Equal(pair.Id, record.PairId)
+	s.Require().Equal(pair.CurrentBatchId, record.BatchId)
+	s.Require().True(decEq(utils.ParseDec("1.0"), record.OpenPrice))
+	s.Require().True(decEq(utils.ParseDec("1.0"), record.ClosePrice))
+	s.Require().True(intEq(sdk.NewInt(1000000), record.BaseVolume))
+	s.Require().True(intEq(sdk.NewInt(1000000), record.QuoteVolume))
+
+	// No trade record for a batch without matching.
+	s.nextBlock()
+	s.Require().Len(s.keeper.GetTradeRecordsByPair(s.ctx, pair.Id), 1)
+}
+
+func (s *KeeperTestSuite) TestRecordTrade_Prune() {
+	params := s.keeper.GetParams(s.ctx)
+	params.TradeRecordRetention = 2
+	s.keeper.SetParams(s.ctx, params)
+
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	for _, price := range []string{"1.0", "1.1", "1.2"} {
+		s.sellLimitOrder(s.addr(1), pair.Id, utils.ParseDec(price), sdk.NewInt(1000000), 0, true)
+		s.buyLimitOrder(s.addr(2), pair.Id, utils.ParseDec(price), sdk.NewInt(1000000), 0, true)
+		s.nextBlock()
+	}
+
+	// Only the latest two records are kept.
+	records := s.keeper.GetTradeRecordsByPair(s.ctx, pair.Id)
+	s.Require().Len(records, 2)
+	s.Require().True(decEq(utils.ParseDec("1.1"), records[0].ClosePrice))
+	s.Require().True(decEq(utils.ParseDec("1.2"), records[1].ClosePrice))
+}
+
+func (s *KeeperTestSuite) TestRecordTrade_ZeroRetention() {
+	params := s.keeper.GetParams(s.ctx)
+	params.TradeRecordRetention = 0
+	s.keeper.SetParams(s.ctx, params)
+
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+
+	s.sellLimitOrder(s.addr(1), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(1000000), 0, true)
+	s.buyLimitOrder(s.addr(2), pair.Id, utils.ParseDec("1.0"), sdk.NewInt(1000000), 0, true)
+	s.nextBlock()
+
+	s.Require().Empty(s.keeper.GetTradeRecordsByPair(s.ctx, pair.Id))
+}
+
+func (s *KeeperTestSuite) TestRecordTrade_RoutedSwap() {
+	pair1 := s.createPair(s.addr(0), "denom1", "denom2", true)
+	s.createPool(s.addr(0), pair1.Id, utils.ParseCoins("1000000000denom1,1000000000denom2"), true)
+	pair1.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair1)
+	pair2 := s.createPair(s.addr(0), "denom3", "denom2", true)
+	s.createPool(s.addr(0), pair2.Id, utils.ParseCoins("1000000000denom2,1000000000denom3"), true)
+	pair2.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair2)
+
+	s.routedSwap(s.addr(1), []uint64{pair1.Id, pair2.Id}, utils.ParseCoin("1000000denom1"), "denom3", sdk.NewInt(990000), true)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+
+	// Each leg of the route is recorded in its pair.
+	for _, pairId := range []uint64{pair1.Id, pair2.Id} {
+		records := s.keeper.GetTradeRecordsByPair(s.ctx, pairId)
+		s.Require().Len(records, 1)
+		s.Require().True(records[0].BaseVolume.IsPositive())
+		s.Require().True(records[0].QuoteVolume.IsPositive())
+	}
+
+	// A failed routed swap leaves no trade record.
+	pair3 := s.createPair(s.addr(0), "denom4", "denom5", true)
+	s.createPool(s.addr(0), pair3.Id, utils.ParseCoins("1000000000denom4,1000000000denom5"), true)
+	pair3.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair3)
+	s.routedSwap(s.addr(1), []uint64{pair3.Id}, utils.ParseCoin("1000000denom4"), "denom5", sdk.NewInt(1000000), true)
+	liquidity.EndBlocker(s.ctx, s.keeper)
+	s.Require().Empty(s.keeper.GetTradeRecordsByPair(s.ctx, pair3.Id))
+}
@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmosquad-labs/squad/v3/x/liquidity/types"
+)
+
+// UpdatePriceAccumulator accumulates the pair's previous last price over the
+// time elapsed since the latest snapshot and stores a new snapshot with the
+// pair's current last price.
+// Snapshots which are no longer needed to calculate TWAP within the max
+// twap window are pruned afterwards.
+func (k Keeper) UpdatePriceAccumulator(ctx sdk.Context, pair types.Pair) {
+	if pair.LastPrice == nil {
+		return
+	}
+
+	now := ctx.BlockTime()
+	cumulativePrice := sdk.ZeroDec()
+	if latest, found := k.GetLatestPriceAccumulator(ctx, pair.Id); found {
+		if !now.After(latest.Time) {
+			// Only the last price changes within the same block time.
+			latest.LastPrice = *pair.LastPrice
+			k.SetPriceAccumulator(ctx, latest)
+			return
+		}
+		cumulativePrice = latest.CumulativePriceAt(now)
+	}
+	k.SetPriceAccumulator(ctx, types.NewPriceAccumulator(pair.Id, now, cumulativePrice, *pair.LastPrice))
+
+	k.PrunePriceAccumulators(ctx, pair.Id, now.Add(-k.GetMaxTWAPWindow(ctx)))
+}
+
+// PrunePriceAccumulators deletes the pair's price accumulators taken at or
+// before the given time, except the most recent one among them which is
+// still needed to calculate TWAP starting from that time.
+func (k Keeper) PrunePriceAccumulators(ctx sdk.Context, pairId uint64, before time.Time) {
+	var accs []types.PriceAccumulator
+	_ = k.IteratePriceAccumulatorsByPair(ctx, pairId, func(acc types.PriceAccumulator) (stop bool, err error) {
+		if acc.Time.After(before) {
+			return true, nil
+		}
+		accs = append(accs, acc)
+		return false, nil
+	})
+	for i := 0; i < len(accs)-1; i++ {
+		k.DeletePriceAccumulator(ctx, accs[i])
+	}
+}
+
+// GetTWAP returns the time-weighted average of the pair's last price during
+// the window ending at the current block time.
+func (k Keeper) GetTWAP(ctx sdk.Context, pairId uint64, window time.Duration) (twap sdk.Dec, err error) {
+	if window <= 0 {
+		return sdk.Dec{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "window must be positive: %s", window)
+	}
+	if maxWindow := k.GetMaxTWAPWindow(ctx); window > maxWindow {
+		return sdk.Dec{}, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "window is longer than the max twap window: %s > %s", window, maxWindow)
+	}
+
+	now := ctx.BlockTime()
+	startTime := now.Add(-window)
+	var start *types.PriceAccumulator
+	_ = k.IteratePriceAccumulatorsByPair(ctx, pairId, func(acc types.PriceAccumulator) (stop bool, err error) {
+		if acc.Time.After(startTime) {
+			return true, nil
+		}
+		start = &acc
+		return false, nil
+	})
+	if start == nil {
+		return sdk.Dec{}, sdkerrors.Wrapf(
+			types.ErrInsufficientPriceHistory, "pair %d has no price since %s", pairId, startTime.UTC())
+	}
+	latest, _ := k.GetLatestPriceAccumulator(ctx, pairId)
+
+	priceSum := latest.CumulativePriceAt(now).Sub(start.CumulativePriceAt(startTime))
+	return priceSum.Quo(types.DurationToSeconds(window)), nil
+}
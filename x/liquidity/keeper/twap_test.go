@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"time"
+
+	utils "github.com/cosmosquad-labs/squad/v3/types"
+	"github.com/cosmosquad-labs/squad/v3/x/liquidity/types"
+)
+
+func (s *KeeperTestSuite) TestGetTWAP() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pair.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair)
+
+	startTime := s.ctx.BlockTime()
+	for i := 0; i < 4; i++ {
+		s.nextBlock()
+	}
+	pair, _ = s.keeper.GetPair(s.ctx, pair.Id)
+	pair.LastPrice = utils.ParseDecP("2.0")
+	s.keeper.SetPair(s.ctx, pair)
+	s.nextBlock()
+	s.nextBlock()
+	s.Require().Equal(startTime.Add(30*time.Second), s.ctx.BlockTime())
+
+	// 10 seconds at 1.0 and 10 seconds at 2.0.
+	twap, err := s.keeper.GetTWAP(s.ctx, pair.Id, 20*time.Second)
+	s.Require().NoError(err)
+	s.Require().True(decEq(utils.ParseDec("1.5"), twap))
+
+	// 20 seconds at 1.0 and 10 seconds at 2.0.
+	twap, err = s.keeper.GetTWAP(s.ctx, pair.Id, 30*time.Second)
+	s.Require().NoError(err)
+	s.Require().True(decEq(utils.ParseDec("1.333333333333333333"), twap))
+
+	// The window in the middle of a block interval.
+	twap, err = s.keeper.GetTWAP(s.ctx, pair.Id, 8*time.Second)
+	s.Require().NoError(err)
+	s.Require().True(decEq(utils.ParseDec("2.0"), twap))
+
+	_, err = s.keeper.GetTWAP(s.ctx, pair.Id, 31*time.Second)
+	s.Require().EqualError(err, "pair 1 has no price since 2021-12-31 23:59:59 +0000 UTC: insufficient price history")
+
+	_, err = s.keeper.GetTWAP(s.ctx, pair.Id, 0)
+	s.Require().EqualError(err, "window must be positive: 0s: invalid request")
+
+	_, err = s.keeper.GetTWAP(s.ctx, pair.Id, 25*time.Hour)
+	s.Require().EqualError(err, "window is longer than the max twap window: 25h0m0s > 24h0m0s: invalid request")
+}
+
+func (s *KeeperTestSuite) TestGetTWAP_NoLastPrice() {
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	s.nextBlock()
+	s.nextBlock()
+
+	s.Require().Empty(s.keeper.GetPriceAccumulatorsByPair(s.ctx, pair.Id))
+	_, err := s.keeper.GetTWAP(s.ctx, pair.Id, 5*time.Second)
+	s.Require().ErrorIs(err, types.ErrInsufficientPriceHistory)
+}
+
+func (s *KeeperTestSuite) TestPrunePriceAccumulators() {
+	params := s.keeper.GetParams(s.ctx)
+	params.MaxTwapWindow = 10 * time.Second
+	s.keeper.SetParams(s.ctx, params)
+
+	pair := s.createPair(s.addr(0), "denom1", "denom2", true)
+	pair.LastPrice = utils.ParseDecP("1.0")
+	s.keeper.SetPair(s.ctx, pair)
+
+	for i := 0; i < 10; i++ {
+		s.nextBlock()
+	}
+
+	// Snapshots within the last 10 seconds and the one right before them
+	// are kept.
+	accs := s.keeper.GetPriceAccumulatorsByPair(s.ctx, pair.Id)
+	s.Require().Len(accs, 3)
+
+	twap, err := s.keeper.GetTWAP(s.ctx, pair.Id, 10*time.Second)
+	s.Require().NoError(err)
+	s.Require().True(decEq(utils.ParseDec("1.0"), twap))
+}
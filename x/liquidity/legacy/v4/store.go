@@ -0,0 +1,25 @@
+package v4
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/cosmosquad-labs/squad/v3/x/liquidity/types"
+)
+
+// MigrateParams sets the params added in v4 to their default values.
+func MigrateParams(ctx sdk.Context, paramSpace paramstypes.Subspace) {
+	paramSpace.Set(ctx, types.KeyTradeRecordRetention, types.DefaultTradeRecordRetention)
+	paramSpace.Set(ctx, types.KeyMaxTWAPWindow, types.DefaultMaxTWAPWindow)
+	paramSpace.Set(ctx, types.KeyAllowedSwapFeeRates, types.DefaultAllowedSwapFeeRates)
+	paramSpace.Set(ctx, types.KeyPoolSwapFeeRatio, types.DefaultPoolSwapFeeRatio)
+	paramSpace.Set(ctx, types.KeyMakerFeeRate, types.DefaultMakerFeeRate)
+	paramSpace.Set(ctx, types.KeyTakerFeeRate, types.DefaultTakerFeeRate)
+	paramSpace.Set(ctx, types.KeyPositionCreationFee, types.DefaultPositionCreationFee)
+}
+
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryCodec, paramSpace paramstypes.Subspace) error {
+	MigrateParams(ctx, paramSpace)
+	return nil
+}
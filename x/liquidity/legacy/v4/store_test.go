@@ -0,0 +1,62 @@
+package v4_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	chain "github.com/cosmosquad-labs/squad/v3/app"
+	v4liquidity "github.com/cosmosquad-labs/squad/v3/x/liquidity/legacy/v4"
+	"github.com/cosmosquad-labs/squad/v3/x/liquidity/types"
+)
+
+func TestMigrateParams(t *testing.T) {
+	encCfg := chain.MakeTestEncodingConfig()
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	paramsKey := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+
+	cms := store.NewCommitMultiStore(dbm.NewMemDB())
+	cms.MountStoreWithDB(storeKey, sdk.StoreTypeIAVL, nil)
+	cms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, nil)
+	cms.MountStoreWithDB(paramsTKey, sdk.StoreTypeTransient, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+	ctx := sdk.NewContext(cms, tmproto.Header{}, false, log.NewNopLogger())
+
+	paramSpace := paramstypes.NewSubspace(
+		encCfg.Marshaler, encCfg.Amino, paramsKey, paramsTKey, types.ModuleName).WithKeyTable(types.ParamKeyTable())
+
+	// The params set before the migration are kept.
+	paramSpace.Set(ctx, types.KeySwapFeeRate, sdk.NewDecWithPrec(3, 3))
+
+	require.NoError(t, v4liquidity.MigrateStore(ctx, storeKey, encCfg.Marshaler, paramSpace))
+
+	var swapFeeRate, poolSwapFeeRatio, makerFeeRate, takerFeeRate sdk.Dec
+	var allowedSwapFeeRates []sdk.Dec
+	var positionCreationFee sdk.Coins
+	var tradeRecordRetention uint32
+	var maxTWAPWindow time.Duration
+	paramSpace.Get(ctx, types.KeySwapFeeRate, &swapFeeRate)
+	paramSpace.Get(ctx, types.KeyTradeRecordRetention, &tradeRecordRetention)
+	paramSpace.Get(ctx, types.KeyMaxTWAPWindow, &maxTWAPWindow)
+	paramSpace.Get(ctx, types.KeyAllowedSwapFeeRates, &allowedSwapFeeRates)
+	paramSpace.Get(ctx, types.KeyPoolSwapFeeRatio, &poolSwapFeeRatio)
+	paramSpace.Get(ctx, types.KeyMakerFeeRate, &makerFeeRate)
+	paramSpace.Get(ctx, types.KeyTakerFeeRate, &takerFeeRate)
+	paramSpace.Get(ctx, types.KeyPositionCreationFee, &positionCreationFee)
+	require.Equal(t, sdk.NewDecWithPrec(3, 3), swapFeeRate)
+	require.Equal(t, types.DefaultTradeRecordRetention, tradeRecordRetention)
+	require.Equal(t, types.DefaultMaxTWAPWindow, maxTWAPWindow)
+	require.Equal(t, types.DefaultAllowedSwapFeeRates, allowedSwapFeeRates)
+	require.Equal(t, types.DefaultPoolSwapFeeRatio, poolSwapFeeRatio)
+	require.Equal(t, types.DefaultMakerFeeRate, makerFeeRate)
+	require.Equal(t, types.DefaultTakerFeeRate, takerFeeRate)
+	require.Equal(t, types.DefaultPositionCreationFee, positionCreationFee)
+}
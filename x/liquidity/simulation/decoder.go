@@ -49,6 +49,36 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &indexB)
 			return fmt.Sprintf("%v\n%v", indexA, indexB)
 
+		case bytes.Equal(kvA.Key[:1], types.PositionKeyPrefix):
+			var positionA, positionB types.Position
+			cdc.MustUnmarshal(kvA.Value, &positionA)
+			cdc.MustUnmarshal(kvB.Value, &positionB)
+			return fmt.Sprintf("%v\n%v", positionA, positionB)
+
+		case bytes.Equal(kvA.Key[:1], types.ConditionalOrderKeyPrefix):
+			var orderA, orderB types.ConditionalOrder
+			cdc.MustUnmarshal(kvA.Value, &orderA)
+			cdc.MustUnmarshal(kvB.Value, &orderB)
+			return fmt.Sprintf("%v\n%v", orderA, orderB)
+
+		case bytes.Equal(kvA.Key[:1], types.RoutedSwapRequestKeyPrefix):
+			var reqA, reqB types.RoutedSwapRequest
+			cdc.MustUnmarshal(kvA.Value, &reqA)
+			cdc.MustUnmarshal(kvB.Value, &reqB)
+			return fmt.Sprintf("%v\n%v", reqA, reqB)
+
+		case bytes.Equal(kvA.Key[:1], types.TradeRecordKeyPrefix):
+			var recordA, recordB types.TradeRecord
+			cdc.MustUnmarshal(kvA.Value, &recordA)
+			cdc.MustUnmarshal(kvB.Value, &recordB)
+			return fmt.Sprintf("%v\n%v", recordA, recordB)
+
+		case bytes.Equal(kvA.Key[:1], types.PriceAccumulatorKeyPrefix):
+			var accA, accB types.PriceAccumulator
+			cdc.MustUnmarshal(kvA.Value, &accA)
+			cdc.MustUnmarshal(kvB.Value, &accB)
+			return fmt.Sprintf("%v\n%v", accA, accB)
+
 		default:
 			panic(fmt.Sprintf("invalid liquidity key prefix %X", kvA.Key[:1]))
 		}
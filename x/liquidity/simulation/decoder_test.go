@@ -58,6 +58,38 @@ func TestDecodeLiquidityStore(t *testing.T) {
 		PairId:   1,
 		OrderIds: []uint64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20},
 	}
+	position := types.NewPosition(1, 1, utils.TestAddress(0), utils.ParseDec("0.5"), utils.ParseDec("2.0"))
+	conditionalOrder := types.ConditionalOrder{
+		Id:              1,
+		PairId:          1,
+		MsgHeight:       1,
+		Orderer:         utils.TestAddress(0).String(),
+		Type:            types.ConditionalOrderTypeStopLoss,
+		OrderType:       types.OrderTypeLimit,
+		Direction:       types.OrderDirectionSell,
+		OfferCoin:       utils.ParseCoin("1000000denom1"),
+		DemandCoinDenom: "denom2",
+		TriggerPrice:    utils.ParseDec("0.9"),
+		Price:           utils.ParseDec("0.89"),
+		Amount:          sdk.NewInt(1000000),
+		ExpireAt:        utils.ParseTime("2022-01-01T00:00:00Z"),
+	}
+	routedSwapReq := types.RoutedSwapRequest{
+		Id:              1,
+		MsgHeight:       1,
+		Orderer:         utils.TestAddress(0).String(),
+		PairIds:         []uint64{1, 2},
+		OfferCoin:       utils.ParseCoin("1000000denom1"),
+		DemandCoinDenom: "denom3",
+		MinDemandAmount: sdk.NewInt(990000),
+		ReceivedCoin:    utils.ParseCoin("0denom3"),
+		Status:          types.RequestStatusNotExecuted,
+	}
+	tradeRecord := types.NewTradeRecord(
+		1, 1, 1, utils.ParseTime("2022-01-01T00:00:00Z"), utils.ParseDec("1.0"),
+		sdk.NewInt(1000000), sdk.NewInt(1000000), sdk.Coins{})
+	priceAcc := types.NewPriceAccumulator(
+		1, utils.ParseTime("2022-01-01T00:00:00Z"), utils.ParseDec("100.0"), utils.ParseDec("1.0"))
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
@@ -67,6 +99,11 @@ func TestDecodeLiquidityStore(t *testing.T) {
 			{Key: types.WithdrawRequestKeyPrefix, Value: cdc.MustMarshal(&withdrawReq)},
 			{Key: types.OrderKeyPrefix, Value: cdc.MustMarshal(&order)},
 			{Key: types.MMOrderIndexKeyPrefix, Value: cdc.MustMarshal(&mmOrderIndex)},
+			{Key: types.PositionKeyPrefix, Value: cdc.MustMarshal(&position)},
+			{Key: types.ConditionalOrderKeyPrefix, Value: cdc.MustMarshal(&conditionalOrder)},
+			{Key: types.RoutedSwapRequestKeyPrefix, Value: cdc.MustMarshal(&routedSwapReq)},
+			{Key: types.TradeRecordKeyPrefix, Value: cdc.MustMarshal(&tradeRecord)},
+			{Key: types.PriceAccumulatorKeyPrefix, Value: cdc.MustMarshal(&priceAcc)},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -81,6 +118,11 @@ func TestDecodeLiquidityStore(t *testing.T) {
 		{"WithdrawRequest", fmt.Sprintf("%v\n%v", withdrawReq, withdrawReq)},
 		{"OrderRequest", fmt.Sprintf("%v\n%v", order, order)},
 		{"MMOrderIndex", fmt.Sprintf("%v\n%v", mmOrderIndex, mmOrderIndex)},
+		{"Position", fmt.Sprintf("%v\n%v", position, position)},
+		{"ConditionalOrder", fmt.Sprintf("%v\n%v", conditionalOrder, conditionalOrder)},
+		{"RoutedSwapRequest", fmt.Sprintf("%v\n%v", routedSwapReq, routedSwapReq)},
+		{"TradeRecord", fmt.Sprintf("%v\n%v", tradeRecord, tradeRecord)},
+		{"PriceAccumulator", fmt.Sprintf("%v\n%v", priceAcc, priceAcc)},
 		{"other", ""},
 	}
 	for i, tt := range tests {
@@ -6,6 +6,7 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // RegisterLegacyAminoCodec registers the necessary x/liquidity interfaces and concrete types
@@ -22,6 +23,17 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCancelOrder{}, "liquidity/MsgCancelOrder", nil)
 	cdc.RegisterConcrete(&MsgCancelAllOrders{}, "liquidity/MsgCancelAllOrders", nil)
 	cdc.RegisterConcrete(&MsgCancelMMOrder{}, "liquidity/MsgCancelMMOrder", nil)
+	cdc.RegisterConcrete(&MsgCreatePosition{}, "liquidity/MsgCreatePosition", nil)
+	cdc.RegisterConcrete(&MsgClosePosition{}, "liquidity/MsgClosePosition", nil)
+	cdc.RegisterConcrete(&MsgDepositPosition{}, "liquidity/MsgDepositPosition", nil)
+	cdc.RegisterConcrete(&MsgWithdrawPosition{}, "liquidity/MsgWithdrawPosition", nil)
+	cdc.RegisterConcrete(&MsgConditionalOrder{}, "liquidity/MsgConditionalOrder", nil)
+	cdc.RegisterConcrete(&MsgCancelConditionalOrder{}, "liquidity/MsgCancelConditionalOrder", nil)
+	cdc.RegisterConcrete(&MsgRoutedSwap{}, "liquidity/MsgRoutedSwap", nil)
+	cdc.RegisterConcrete(&MsgCreateStableswapPool{}, "liquidity/MsgCreateStableswapPool", nil)
+	cdc.RegisterConcrete(&MsgAmendOrder{}, "liquidity/MsgAmendOrder", nil)
+	cdc.RegisterConcrete(&SwapFeeRateProposal{}, "liquidity/SwapFeeRateProposal", nil)
+	cdc.RegisterConcrete(&AmplificationProposal{}, "liquidity/AmplificationProposal", nil)
 }
 
 // RegisterInterfaces registers the x/liquidity interfaces types with the
@@ -40,6 +52,21 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgCancelOrder{},
 		&MsgCancelAllOrders{},
 		&MsgCancelMMOrder{},
+		&MsgCreatePosition{},
+		&MsgClosePosition{},
+		&MsgDepositPosition{},
+		&MsgWithdrawPosition{},
+		&MsgConditionalOrder{},
+		&MsgCancelConditionalOrder{},
+		&MsgRoutedSwap{},
+		&MsgCreateStableswapPool{},
+		&MsgAmendOrder{},
+	)
+
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&SwapFeeRateProposal{},
+		&AmplificationProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
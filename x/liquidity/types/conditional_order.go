@@ -0,0 +1,138 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewConditionalOrder returns a new ConditionalOrder from MsgConditionalOrder.
+func NewConditionalOrder(
+	msg *MsgConditionalOrder, id uint64, offerCoin sdk.Coin, price sdk.Dec, expireAt time.Time, msgHeight int64) ConditionalOrder {
+	return ConditionalOrder{
+		Id:              id,
+		PairId:          msg.PairId,
+		MsgHeight:       msgHeight,
+		Orderer:         msg.Orderer,
+		Type:            msg.ConditionalOrderType,
+		OrderType:       msg.OrderType,
+		Direction:       msg.Direction,
+		OfferCoin:       offerCoin,
+		DemandCoinDenom: msg.DemandCoinDenom,
+		TriggerPrice:    msg.TriggerPrice,
+		Price:           price,
+		Amount:          msg.Amount,
+		ExpireAt:        expireAt,
+	}
+}
+
+func (order ConditionalOrder) GetOrderer() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(order.Orderer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// Validate validates ConditionalOrder for genesis.
+func (order ConditionalOrder) Validate() error {
+	if order.Id == 0 {
+		return fmt.Errorf("id must not be 0")
+	}
+	if order.PairId == 0 {
+		return fmt.Errorf("pair id must not be 0")
+	}
+	if order.MsgHeight == 0 {
+		return fmt.Errorf("message height must not be 0")
+	}
+	if _, err := sdk.AccAddressFromBech32(order.Orderer); err != nil {
+		return fmt.Errorf("invalid orderer address %s: %w", order.Orderer, err)
+	}
+	if !order.Type.IsValid() {
+		return fmt.Errorf("invalid conditional order type: %s", order.Type)
+	}
+	if order.OrderType != OrderTypeLimit && order.OrderType != OrderTypeMarket {
+		return fmt.Errorf("invalid order type: %s", order.OrderType)
+	}
+	if order.Direction != OrderDirectionBuy && order.Direction != OrderDirectionSell {
+		return fmt.Errorf("invalid direction: %s", order.Direction)
+	}
+	if err := order.OfferCoin.Validate(); err != nil {
+		return fmt.Errorf("invalid offer coin %s: %w", order.OfferCoin, err)
+	}
+	if order.OfferCoin.IsZero() {
+		return fmt.Errorf("offer coin must not be 0")
+	}
+	if err := sdk.ValidateDenom(order.DemandCoinDenom); err != nil {
+		return fmt.Errorf("invalid demand coin denom: %w", err)
+	}
+	if !order.TriggerPrice.IsPositive() {
+		return fmt.Errorf("trigger price must be positive: %s", order.TriggerPrice)
+	}
+	if order.OrderType == OrderTypeLimit && !order.Price.IsPositive() {
+		return fmt.Errorf("price must be positive: %s", order.Price)
+	}
+	if !order.Amount.IsPositive() {
+		return fmt.Errorf("amount must be positive: %s", order.Amount)
+	}
+	if order.ExpireAt.IsZero() {
+		return fmt.Errorf("no expiration info")
+	}
+	return nil
+}
+
+// ExpiredAt returns whether the conditional order should be deleted at given time.
+func (order ConditionalOrder) ExpiredAt(t time.Time) bool {
+	return !order.ExpireAt.After(t)
+}
+
+// IsTriggered returns whether the conditional order should be turned into
+// an order with the given last price.
+// A stop-loss order is triggered when the price moves against the order
+// direction, and a take-profit order is triggered when the price moves along
+// with the order direction.
+func (order ConditionalOrder) IsTriggered(lastPrice sdk.Dec) bool {
+	priceRises := order.Type == ConditionalOrderTypeStopLoss // for buy orders
+	if order.Direction == OrderDirectionSell {
+		priceRises = !priceRises
+	}
+	if priceRises {
+		return lastPrice.GTE(order.TriggerPrice)
+	}
+	return lastPrice.LTE(order.TriggerPrice)
+}
+
+// IsValid returns true if the ConditionalOrderType is one of:
+// ConditionalOrderTypeStopLoss, ConditionalOrderTypeTakeProfit.
+func (typ ConditionalOrderType) IsValid() bool {
+	switch typ {
+	case ConditionalOrderTypeStopLoss, ConditionalOrderTypeTakeProfit:
+		return true
+	default:
+		return false
+	}
+}
+
+// MustMarshalConditionalOrder returns the conditional order bytes.
+// It throws panic if it fails.
+func MustMarshalConditionalOrder(cdc codec.BinaryCodec, order ConditionalOrder) []byte {
+	return cdc.MustMarshal(&order)
+}
+
+// UnmarshalConditionalOrder returns the conditional order from bytes.
+func UnmarshalConditionalOrder(cdc codec.BinaryCodec, value []byte) (order ConditionalOrder, err error) {
+	err = cdc.Unmarshal(value, &order)
+	return order, err
+}
+
+// MustUnmarshalConditionalOrder returns the conditional order from bytes.
+// It throws panic if it fails.
+func MustUnmarshalConditionalOrder(cdc codec.BinaryCodec, value []byte) ConditionalOrder {
+	order, err := UnmarshalConditionalOrder(cdc, value)
+	if err != nil {
+		panic(err)
+	}
+	return order
+}
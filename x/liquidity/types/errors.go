@@ -26,4 +26,14 @@ var (
 	ErrTooLargePool              = sdkerrors.Register(ModuleName, 18, "too large pool")
 	ErrTooManyPools              = sdkerrors.Register(ModuleName, 19, "too many pools in the pair")
 	ErrPriceNotOnTicks           = sdkerrors.Register(ModuleName, 20, "price is not on ticks")
+	ErrTooManyPositions          = sdkerrors.Register(ModuleName, 21, "too many positions in the pair")
+	ErrTriggerPriceReached       = sdkerrors.Register(ModuleName, 22, "the trigger price is already reached")
+	ErrWrongRoute                = sdkerrors.Register(ModuleName, 23, "wrong swap route")
+	ErrInsufficientLiquidity     = sdkerrors.Register(ModuleName, 24, "insufficient liquidity in the pair")
+	ErrTooSmallDemandAmount      = sdkerrors.Register(ModuleName, 25, "demand amount is smaller than the minimum")
+	ErrInsufficientPriceHistory  = sdkerrors.Register(ModuleName, 26, "insufficient price history")
+	ErrSwapFeeRateNotAllowed     = sdkerrors.Register(ModuleName, 27, "swap fee rate not allowed")
+	ErrPostOnlyOrderCrosses      = sdkerrors.Register(ModuleName, 28, "post-only order would cross the order book")
+	ErrOrderNotAmendable         = sdkerrors.Register(ModuleName, 29, "the order cannot be amended")
+	ErrTooSmallWithdrawAmount    = sdkerrors.Register(ModuleName, 30, "too small withdraw amount")
 )
@@ -2,22 +2,44 @@ package types
 
 // Event types for the liquidity module.
 const (
-	EventTypeCreatePair       = "create_pair"
-	EventTypeCreatePool       = "create_pool"
-	EventTypeCreateRangedPool = "create_ranged_pool"
-	EventTypeDeposit          = "deposit"
-	EventTypeWithdraw         = "withdraw"
-	EventTypeLimitOrder       = "limit_order"
-	EventTypeMarketOrder      = "market_order"
-	EventTypeMMOrder          = "mm_order"
-	EventTypeCancelOrder      = "cancel_order"
-	EventTypeCancelAllOrders  = "cancel_all_orders"
-	EventTypeCancelMMOrder    = "cancel_mm_order"
-	EventTypeDepositResult    = "deposit_result"
-	EventTypeWithdrawalResult = "withdrawal_result"
-	EventTypeOrderResult      = "order_result"
-	EventTypeUserOrderMatched = "user_order_matched"
-	EventTypePoolOrderMatched = "pool_order_matched"
+	EventTypeCreatePair           = "create_pair"
+	EventTypeCreatePool           = "create_pool"
+	EventTypeCreateRangedPool     = "create_ranged_pool"
+	EventTypeDeposit              = "deposit"
+	EventTypeWithdraw             = "withdraw"
+	EventTypeLimitOrder           = "limit_order"
+	EventTypeMarketOrder          = "market_order"
+	EventTypeMMOrder              = "mm_order"
+	EventTypeCancelOrder          = "cancel_order"
+	EventTypeCancelAllOrders      = "cancel_all_orders"
+	EventTypeCancelMMOrder        = "cancel_mm_order"
+	EventTypeDepositResult        = "deposit_result"
+	EventTypeWithdrawalResult     = "withdrawal_result"
+	EventTypeOrderResult          = "order_result"
+	EventTypeUserOrderMatched     = "user_order_matched"
+	EventTypePoolOrderMatched     = "pool_order_matched"
+	EventTypeCreatePosition       = "create_position"
+	EventTypeClosePosition        = "close_position"
+	EventTypeDepositPosition      = "deposit_position"
+	EventTypeWithdrawPosition     = "withdraw_position"
+	EventTypePositionOrderMatched = "position_order_matched"
+
+	EventTypeConditionalOrder          = "conditional_order"
+	EventTypeCancelConditionalOrder    = "cancel_conditional_order"
+	EventTypeConditionalOrderTriggered = "conditional_order_triggered"
+	EventTypeConditionalOrderExpired   = "conditional_order_expired"
+
+	EventTypeRoutedSwap       = "routed_swap"
+	EventTypeRoutedSwapResult = "routed_swap_result"
+
+	EventTypeRoutedSwapOrderMatched = "routed_swap_order_matched"
+
+	EventTypeSwapFeeRateChanged = "swap_fee_rate_changed"
+
+	EventTypeCreateStableswapPool = "create_stableswap_pool"
+	EventTypeAmplificationChanged = "amplification_changed"
+
+	EventTypeAmendOrder = "amend_order"
 
 	AttributeKeyCreator            = "creator"
 	AttributeKeyDepositor          = "depositor"
@@ -53,4 +75,21 @@ const (
 	AttributeKeyStatus             = "status"
 	AttributeKeyMatchedAmount      = "matched_amount"
 	AttributeKeyPaidCoin           = "paid_coin"
+	AttributeKeyOwner              = "owner"
+	AttributeKeyPositionId         = "position_id"
+	AttributeKeyMinPrice           = "min_price"
+	AttributeKeyMaxPrice           = "max_price"
+	AttributeKeyConditionalOrderId = "conditional_order_id"
+	AttributeKeyConditionalType    = "conditional_order_type"
+	AttributeKeyOrderType          = "order_type"
+	AttributeKeyTriggerPrice       = "trigger_price"
+	AttributeKeyMinDemandAmount    = "min_demand_amount"
+	AttributeKeySwapFeeRate        = "swap_fee_rate"
+	AttributeKeySwapFee            = "swap_fee"
+	AttributeKeyAmplification      = "amplification"
+	AttributeKeyTimeInForce        = "time_in_force"
+	AttributeKeyPostOnly           = "post_only"
+	AttributeKeyRebate             = "rebate"
+	AttributeKeyNewOrderId         = "new_order_id"
+	AttributeKeyRatio              = "ratio"
 )
@@ -16,6 +16,14 @@ func DefaultGenesis() *GenesisState {
 		WithdrawRequests:         []WithdrawRequest{},
 		Orders:                   []Order{},
 		MarketMakingOrderIndexes: []MMOrderIndex{},
+		LastPositionId:           0,
+		Positions:                []Position{},
+		LastConditionalOrderId:   0,
+		ConditionalOrders:        []ConditionalOrder{},
+		LastRoutedSwapRequestId:  0,
+		RoutedSwapRequests:       []RoutedSwapRequest{},
+		TradeRecords:             []TradeRecord{},
+		PriceAccumulators:        []PriceAccumulator{},
 	}
 }
 
@@ -135,5 +143,105 @@ func (genState GenesisState) Validate() error {
 		}
 		orderSet[order.PairId][order.Id] = struct{}{}
 	}
+	positionSet := map[uint64]struct{}{}
+	for i, position := range genState.Positions {
+		if err := position.Validate(); err != nil {
+			return fmt.Errorf("invalid position at index %d: %w", i, err)
+		}
+		if position.Id > genState.LastPositionId {
+			return fmt.Errorf("position at index %d has an id greater than last position id: %d", i, position.Id)
+		}
+		if _, ok := pairMap[position.PairId]; !ok {
+			return fmt.Errorf("position at index %d has unknown pair id: %d", i, position.PairId)
+		}
+		if _, ok := positionSet[position.Id]; ok {
+			return fmt.Errorf("position at index %d has a duplicate position id: %d", i, position.Id)
+		}
+		positionSet[position.Id] = struct{}{}
+	}
+	conditionalOrderSet := map[uint64]struct{}{}
+	for i, order := range genState.ConditionalOrders {
+		if err := order.Validate(); err != nil {
+			return fmt.Errorf("invalid conditional order at index %d: %w", i, err)
+		}
+		if order.Id > genState.LastConditionalOrderId {
+			return fmt.Errorf("conditional order at index %d has an id greater than last conditional order id: %d", i, order.Id)
+		}
+		pair, ok := pairMap[order.PairId]
+		if !ok {
+			return fmt.Errorf("conditional order at index %d has unknown pair id: %d", i, order.PairId)
+		}
+		var offerCoinDenom, demandCoinDenom string
+		switch order.Direction {
+		case OrderDirectionBuy:
+			offerCoinDenom, demandCoinDenom = pair.QuoteCoinDenom, pair.BaseCoinDenom
+		case OrderDirectionSell:
+			offerCoinDenom, demandCoinDenom = pair.BaseCoinDenom, pair.QuoteCoinDenom
+		}
+		if order.OfferCoin.Denom != offerCoinDenom {
+			return fmt.Errorf("conditional order at index %d has wrong offer coin denom: %s != %s", i, order.OfferCoin.Denom, offerCoinDenom)
+		}
+		if order.DemandCoinDenom != demandCoinDenom {
+			return fmt.Errorf("conditional order at index %d has wrong demand coin denom: %s != %s", i, order.DemandCoinDenom, demandCoinDenom)
+		}
+		if _, ok := conditionalOrderSet[order.Id]; ok {
+			return fmt.Errorf("conditional order at index %d has a duplicate id: %d", i, order.Id)
+		}
+		conditionalOrderSet[order.Id] = struct{}{}
+	}
+	routedSwapReqSet := map[uint64]struct{}{}
+	for i, req := range genState.RoutedSwapRequests {
+		if err := req.Validate(); err != nil {
+			return fmt.Errorf("invalid routed swap request at index %d: %w", i, err)
+		}
+		if req.Id > genState.LastRoutedSwapRequestId {
+			return fmt.Errorf("routed swap request at index %d has an id greater than last routed swap request id: %d", i, req.Id)
+		}
+		for _, pairId := range req.PairIds {
+			if _, ok := pairMap[pairId]; !ok {
+				return fmt.Errorf("routed swap request at index %d has unknown pair id: %d", i, pairId)
+			}
+		}
+		if _, ok := routedSwapReqSet[req.Id]; ok {
+			return fmt.Errorf("routed swap request at index %d has a duplicate id: %d", i, req.Id)
+		}
+		routedSwapReqSet[req.Id] = struct{}{}
+	}
+	tradeRecordSet := map[uint64]map[uint64]struct{}{}
+	for i, record := range genState.TradeRecords {
+		if err := record.Validate(); err != nil {
+			return fmt.Errorf("invalid trade record at index %d: %w", i, err)
+		}
+		if _, ok := pairMap[record.PairId]; !ok {
+			return fmt.Errorf("trade record at index %d has unknown pair id: %d", i, record.PairId)
+		}
+		batchIdSet, ok := tradeRecordSet[record.PairId]
+		if !ok {
+			batchIdSet = map[uint64]struct{}{}
+			tradeRecordSet[record.PairId] = batchIdSet
+		}
+		if _, ok := batchIdSet[record.BatchId]; ok {
+			return fmt.Errorf("trade record at index %d has a duplicate batch id: %d", i, record.BatchId)
+		}
+		batchIdSet[record.BatchId] = struct{}{}
+	}
+	priceAccSet := map[uint64]map[int64]struct{}{}
+	for i, acc := range genState.PriceAccumulators {
+		if err := acc.Validate(); err != nil {
+			return fmt.Errorf("invalid price accumulator at index %d: %w", i, err)
+		}
+		if _, ok := pairMap[acc.PairId]; !ok {
+			return fmt.Errorf("price accumulator at index %d has unknown pair id: %d", i, acc.PairId)
+		}
+		timeSet, ok := priceAccSet[acc.PairId]
+		if !ok {
+			timeSet = map[int64]struct{}{}
+			priceAccSet[acc.PairId] = timeSet
+		}
+		if _, ok := timeSet[acc.Time.UnixNano()]; ok {
+			return fmt.Errorf("price accumulator at index %d has a duplicate time: %s", i, acc.Time)
+		}
+		timeSet[acc.Time.UnixNano()] = struct{}{}
+	}
 	return nil
 }
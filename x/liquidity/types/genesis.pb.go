@@ -25,15 +25,23 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the liquidity module's genesis state.
 type GenesisState struct {
-	Params                   Params            `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	LastPairId               uint64            `protobuf:"varint,2,opt,name=last_pair_id,json=lastPairId,proto3" json:"last_pair_id,omitempty"`
-	LastPoolId               uint64            `protobuf:"varint,3,opt,name=last_pool_id,json=lastPoolId,proto3" json:"last_pool_id,omitempty"`
-	Pairs                    []Pair            `protobuf:"bytes,4,rep,name=pairs,proto3" json:"pairs"`
-	Pools                    []Pool            `protobuf:"bytes,5,rep,name=pools,proto3" json:"pools"`
-	DepositRequests          []DepositRequest  `protobuf:"bytes,6,rep,name=deposit_requests,json=depositRequests,proto3" json:"deposit_requests"`
-	WithdrawRequests         []WithdrawRequest `protobuf:"bytes,7,rep,name=withdraw_requests,json=withdrawRequests,proto3" json:"withdraw_requests"`
-	Orders                   []Order           `protobuf:"bytes,8,rep,name=orders,proto3" json:"orders"`
-	MarketMakingOrderIndexes []MMOrderIndex    `protobuf:"bytes,9,rep,name=market_making_order_indexes,json=marketMakingOrderIndexes,proto3" json:"market_making_order_indexes"`
+	Params                   Params              `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	LastPairId               uint64              `protobuf:"varint,2,opt,name=last_pair_id,json=lastPairId,proto3" json:"last_pair_id,omitempty"`
+	LastPoolId               uint64              `protobuf:"varint,3,opt,name=last_pool_id,json=lastPoolId,proto3" json:"last_pool_id,omitempty"`
+	Pairs                    []Pair              `protobuf:"bytes,4,rep,name=pairs,proto3" json:"pairs"`
+	Pools                    []Pool              `protobuf:"bytes,5,rep,name=pools,proto3" json:"pools"`
+	DepositRequests          []DepositRequest    `protobuf:"bytes,6,rep,name=deposit_requests,json=depositRequests,proto3" json:"deposit_requests"`
+	WithdrawRequests         []WithdrawRequest   `protobuf:"bytes,7,rep,name=withdraw_requests,json=withdrawRequests,proto3" json:"withdraw_requests"`
+	Orders                   []Order             `protobuf:"bytes,8,rep,name=orders,proto3" json:"orders"`
+	MarketMakingOrderIndexes []MMOrderIndex      `protobuf:"bytes,9,rep,name=market_making_order_indexes,json=marketMakingOrderIndexes,proto3" json:"market_making_order_indexes"`
+	LastPositionId           uint64              `protobuf:"varint,10,opt,name=last_position_id,json=lastPositionId,proto3" json:"last_position_id,omitempty"`
+	Positions                []Position          `protobuf:"bytes,11,rep,name=positions,proto3" json:"positions"`
+	LastConditionalOrderId   uint64              `protobuf:"varint,12,opt,name=last_conditional_order_id,json=lastConditionalOrderId,proto3" json:"last_conditional_order_id,omitempty"`
+	ConditionalOrders        []ConditionalOrder  `protobuf:"bytes,13,rep,name=conditional_orders,json=conditionalOrders,proto3" json:"conditional_orders"`
+	LastRoutedSwapRequestId  uint64              `protobuf:"varint,14,opt,name=last_routed_swap_request_id,json=lastRoutedSwapRequestId,proto3" json:"last_routed_swap_request_id,omitempty"`
+	RoutedSwapRequests       []RoutedSwapRequest `protobuf:"bytes,15,rep,name=routed_swap_requests,json=routedSwapRequests,proto3" json:"routed_swap_requests"`
+	TradeRecords             []TradeRecord       `protobuf:"bytes,16,rep,name=trade_records,json=tradeRecords,proto3" json:"trade_records"`
+	PriceAccumulators        []PriceAccumulator  `protobuf:"bytes,17,rep,name=price_accumulators,json=priceAccumulators,proto3" json:"price_accumulators"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,34 +86,47 @@ func init() {
 }
 
 var fileDescriptor_ab1bc6eb0d271b49 = []byte{
-	// 430 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0x80, 0x6d, 0x9a, 0x06, 0xb8, 0x56, 0xa2, 0x9c, 0x90, 0x38, 0x15, 0x71, 0x8d, 0x90, 0xaa,
-	0x66, 0xc1, 0x56, 0xc3, 0x84, 0x04, 0x4b, 0x85, 0x84, 0x32, 0x44, 0x44, 0x61, 0x00, 0xc1, 0x60,
-	0x5d, 0x7a, 0x27, 0xf7, 0xa8, 0x9d, 0xe7, 0xdc, 0x3b, 0x93, 0xf6, 0x5f, 0xf0, 0xb3, 0x32, 0x76,
-	0x64, 0x42, 0x90, 0x2c, 0xfc, 0x0c, 0xe4, 0x3b, 0x53, 0x27, 0x83, 0x61, 0xb3, 0x9e, 0xbf, 0xef,
-	0x7b, 0x96, 0xfc, 0xc8, 0x31, 0xce, 0x4b, 0x21, 0xe3, 0x4c, 0xcf, 0x4b, 0x2d, 0xb5, 0xbd, 0x8e,
-	0xbf, 0x9e, 0x4e, 0x95, 0x15, 0xa7, 0x71, 0xaa, 0x66, 0x0a, 0x35, 0x46, 0x85, 0x01, 0x0b, 0xf4,
-	0xb1, 0xc3, 0xa2, 0x5b, 0x2c, 0xaa, 0xb1, 0xc3, 0x47, 0x29, 0xa4, 0xe0, 0x98, 0xb8, 0x7a, 0xf2,
-	0xf8, 0xe1, 0x49, 0x5b, 0xb5, 0x09, 0x38, 0xf0, 0xd9, 0xef, 0x0e, 0xd9, 0x7f, 0xeb, 0x37, 0xbd,
-	0xb7, 0xc2, 0x2a, 0xfa, 0x9a, 0x74, 0x0b, 0x61, 0x44, 0x8e, 0x2c, 0xec, 0x85, 0xfd, 0xbd, 0xc1,
-	0x51, 0xd4, 0xb2, 0x39, 0x1a, 0x3b, 0xec, 0xac, 0xb3, 0xfc, 0x71, 0x14, 0x4c, 0x6a, 0x89, 0xf6,
-	0xc8, 0x7e, 0x26, 0xd0, 0x26, 0x85, 0xd0, 0x26, 0xd1, 0x92, 0xdd, 0xe9, 0x85, 0xfd, 0xce, 0x84,
-	0x54, 0xb3, 0xb1, 0xd0, 0x66, 0x28, 0x1b, 0x02, 0x20, 0xab, 0x88, 0x9d, 0x0d, 0x02, 0x20, 0x1b,
-	0x4a, 0xfa, 0x92, 0xec, 0x56, 0x3a, 0xb2, 0x4e, 0x6f, 0xa7, 0xbf, 0x37, 0x78, 0xfa, 0x8f, 0x2f,
-	0xd0, 0xa6, 0xde, 0xef, 0x0d, 0xa7, 0x02, 0x64, 0xc8, 0x76, 0xff, 0xa7, 0x02, 0x64, 0xb7, 0x6a,
-	0x65, 0xd0, 0x8f, 0xe4, 0x40, 0xaa, 0x02, 0x50, 0xdb, 0xc4, 0xa8, 0x79, 0xa9, 0xd0, 0x22, 0xeb,
-	0xba, 0xca, 0x49, 0x6b, 0xe5, 0x8d, 0x17, 0x26, 0x9e, 0xaf, 0x7b, 0x0f, 0xe4, 0xd6, 0x14, 0xe9,
-	0x67, 0xf2, 0x70, 0xa1, 0xed, 0x85, 0x34, 0x62, 0xd1, 0xa4, 0xef, 0xba, 0x74, 0xbf, 0x35, 0xfd,
-	0xa1, 0x36, 0xb6, 0xdb, 0x07, 0x8b, 0xed, 0x31, 0xd2, 0x57, 0xa4, 0x0b, 0x46, 0x2a, 0x83, 0xec,
-	0x9e, 0x2b, 0xf2, 0xd6, 0xe2, 0xbb, 0x0a, 0xfb, 0xfb, 0xbb, 0xbc, 0x43, 0xbf, 0x90, 0x27, 0xb9,
-	0x30, 0x97, 0xca, 0x26, 0xb9, 0xb8, 0xd4, 0xb3, 0x34, 0x71, 0xf3, 0x44, 0xcf, 0xa4, 0xba, 0x52,
-	0xc8, 0xee, 0xbb, 0xe4, 0x71, 0x6b, 0x72, 0x34, 0x72, 0xd1, 0x61, 0x85, 0xd7, 0x65, 0xe6, 0x7b,
-	0x23, 0x97, 0x6b, 0xde, 0x2a, 0x3c, 0x1b, 0x2f, 0x7f, 0xf1, 0x60, 0xb9, 0xe2, 0xe1, 0xcd, 0x8a,
-	0x87, 0x3f, 0x57, 0x3c, 0xfc, 0xb6, 0xe6, 0xc1, 0xcd, 0x9a, 0x07, 0xdf, 0xd7, 0x3c, 0xf8, 0x34,
-	0x48, 0xb5, 0xbd, 0x28, 0xa7, 0xd1, 0x39, 0xe4, 0xf1, 0x39, 0x60, 0x0e, 0x6e, 0xe7, 0xf3, 0x4c,
-	0x4c, 0x31, 0xf6, 0xc7, 0x7c, 0xb5, 0x71, 0xce, 0xf6, 0xba, 0x50, 0x38, 0xed, 0xba, 0x1b, 0x7e,
-	0xf1, 0x27, 0x00, 0x00, 0xff, 0xff, 0x52, 0x8f, 0x2b, 0xb8, 0x44, 0x03, 0x00, 0x00,
+	// 628 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0x51, 0x4f, 0x13, 0x41,
+	0x14, 0x85, 0x5b, 0x81, 0x2a, 0x43, 0x81, 0x32, 0x21, 0x32, 0x42, 0x5c, 0xaa, 0x91, 0x50, 0x4d,
+	0x6c, 0x03, 0x3e, 0x91, 0xe8, 0x83, 0xa8, 0x31, 0x7d, 0x20, 0x34, 0xc5, 0x44, 0xa3, 0x89, 0x9b,
+	0xe9, 0xce, 0xa4, 0x8c, 0xec, 0x76, 0x96, 0xb9, 0xb3, 0x16, 0xfe, 0x85, 0xff, 0x4a, 0x1e, 0x79,
+	0xf4, 0xc9, 0x28, 0xfc, 0x11, 0x33, 0x77, 0xb7, 0x2c, 0x14, 0x17, 0xde, 0x9a, 0x33, 0xe7, 0x7c,
+	0xf7, 0x74, 0xee, 0x66, 0xc8, 0x1a, 0x1c, 0x26, 0x5c, 0xb4, 0x42, 0x75, 0x98, 0x28, 0xa1, 0xec,
+	0x71, 0xeb, 0xfb, 0x46, 0x4f, 0x5a, 0xbe, 0xd1, 0xea, 0xcb, 0x81, 0x04, 0x05, 0xcd, 0xd8, 0x68,
+	0xab, 0xe9, 0x12, 0xda, 0x9a, 0x17, 0xb6, 0x66, 0x66, 0x5b, 0x5e, 0xec, 0xeb, 0xbe, 0x46, 0x4f,
+	0xcb, 0xfd, 0x4a, 0xed, 0xcb, 0xeb, 0x45, 0xd4, 0x1c, 0x80, 0xc6, 0xc7, 0x3f, 0xa7, 0x49, 0xf5,
+	0x7d, 0x3a, 0x69, 0xcf, 0x72, 0x2b, 0xe9, 0x2b, 0x52, 0x89, 0xb9, 0xe1, 0x11, 0xb0, 0x72, 0xbd,
+	0xdc, 0x98, 0xd9, 0x5c, 0x6d, 0x16, 0x4c, 0x6e, 0x76, 0xd0, 0xb6, 0x3d, 0x79, 0xf2, 0x7b, 0xb5,
+	0xd4, 0xcd, 0x42, 0xb4, 0x4e, 0xaa, 0x21, 0x07, 0xeb, 0xc7, 0x5c, 0x19, 0x5f, 0x09, 0x76, 0xa7,
+	0x5e, 0x6e, 0x4c, 0x76, 0x89, 0xd3, 0x3a, 0x5c, 0x99, 0xb6, 0xc8, 0x1d, 0x5a, 0x87, 0xce, 0x31,
+	0x71, 0xc9, 0xa1, 0x75, 0xd8, 0x16, 0x74, 0x8b, 0x4c, 0xb9, 0x38, 0xb0, 0xc9, 0xfa, 0x44, 0x63,
+	0x66, 0xf3, 0xe1, 0x0d, 0x0d, 0x94, 0xc9, 0xe6, 0xa7, 0x09, 0x8c, 0x6a, 0x1d, 0x02, 0x9b, 0xba,
+	0x2d, 0xaa, 0x75, 0x78, 0x11, 0x75, 0x09, 0xfa, 0x89, 0xd4, 0x84, 0x8c, 0x35, 0x28, 0xeb, 0x1b,
+	0x79, 0x98, 0x48, 0xb0, 0xc0, 0x2a, 0x48, 0x59, 0x2f, 0xa4, 0xbc, 0x4d, 0x03, 0xdd, 0xd4, 0x9f,
+	0xf1, 0xe6, 0xc5, 0x15, 0x15, 0xe8, 0x17, 0xb2, 0x30, 0x54, 0x76, 0x5f, 0x18, 0x3e, 0xcc, 0xd1,
+	0x77, 0x11, 0xdd, 0x28, 0x44, 0x7f, 0xcc, 0x12, 0x57, 0xd9, 0xb5, 0xe1, 0x55, 0x19, 0xe8, 0x4b,
+	0x52, 0xd1, 0x46, 0x48, 0x03, 0xec, 0x1e, 0x12, 0xbd, 0x42, 0xe2, 0xae, 0xb3, 0x8d, 0xd6, 0x95,
+	0x66, 0xe8, 0x37, 0xb2, 0x12, 0x71, 0x73, 0x20, 0xad, 0x1f, 0xf1, 0x03, 0x35, 0xe8, 0xfb, 0xa8,
+	0xfb, 0x6a, 0x20, 0xe4, 0x91, 0x04, 0x36, 0x8d, 0xc8, 0xb5, 0x42, 0xe4, 0xce, 0x0e, 0x42, 0xdb,
+	0xce, 0x9e, 0x91, 0x59, 0xca, 0xdb, 0x41, 0x5c, 0x7e, 0x2a, 0x81, 0x36, 0x48, 0x2d, 0x5b, 0x3c,
+	0x28, 0xab, 0xf4, 0xc0, 0x2d, 0x9f, 0xe0, 0xf2, 0xe7, 0xd2, 0xe5, 0xa7, 0x72, 0x5b, 0xd0, 0x77,
+	0x64, 0x7a, 0x64, 0x02, 0x36, 0x83, 0x1d, 0x1e, 0xdd, 0xb0, 0xc9, 0xd4, 0x99, 0xcd, 0xcf, 0x93,
+	0x74, 0x8b, 0x3c, 0xc0, 0x81, 0x81, 0x1e, 0x08, 0x94, 0x78, 0x38, 0xfa, 0x7f, 0x82, 0x55, 0x71,
+	0xf2, 0x7d, 0x67, 0x78, 0x93, 0x9f, 0xa7, 0x85, 0x05, 0xfd, 0x4a, 0xe8, 0xb5, 0x14, 0xb0, 0x59,
+	0xac, 0xf2, 0xb4, 0xb0, 0xca, 0x38, 0x28, 0xab, 0xb4, 0x10, 0x8c, 0xe9, 0x6e, 0x6b, 0x2b, 0x58,
+	0xcd, 0xe8, 0xc4, 0x4a, 0xe1, 0xc3, 0x90, 0xc7, 0xa3, 0x4f, 0xc3, 0x95, 0x9b, 0xc3, 0x72, 0x4b,
+	0xce, 0xd2, 0x45, 0xc7, 0xde, 0x90, 0xc7, 0xd9, 0xca, 0xdb, 0x82, 0xf6, 0xc8, 0xe2, 0x7f, 0x82,
+	0xc0, 0xe6, 0xb1, 0xdf, 0xb3, 0xc2, 0x7e, 0xd7, 0x58, 0x59, 0x41, 0x6a, 0xc6, 0x0f, 0x80, 0xee,
+	0x92, 0x59, 0x6b, 0xb8, 0x90, 0xbe, 0x91, 0x81, 0x36, 0x02, 0x58, 0x0d, 0xe1, 0x4f, 0x0a, 0xe1,
+	0x1f, 0x9c, 0xbb, 0x8b, 0xe6, 0x0c, 0x5b, 0xb5, 0xb9, 0x04, 0xee, 0x4a, 0x63, 0xa3, 0x02, 0xe9,
+	0xf3, 0x20, 0x48, 0xa2, 0x24, 0xe4, 0x56, 0x1b, 0x60, 0x0b, 0xb7, 0x5c, 0x69, 0xc7, 0x45, 0x5e,
+	0xe7, 0x89, 0xd1, 0x95, 0xc6, 0x63, 0x3a, 0x6c, 0x77, 0x4e, 0xfe, 0x7a, 0xa5, 0x93, 0x33, 0xaf,
+	0x7c, 0x7a, 0xe6, 0x95, 0xff, 0x9c, 0x79, 0xe5, 0x1f, 0xe7, 0x5e, 0xe9, 0xf4, 0xdc, 0x2b, 0xfd,
+	0x3a, 0xf7, 0x4a, 0x9f, 0x37, 0xfb, 0xca, 0xee, 0x27, 0xbd, 0x66, 0xa0, 0xa3, 0x56, 0xa0, 0x21,
+	0xd2, 0x38, 0xf0, 0x79, 0xc8, 0x7b, 0xd0, 0x4a, 0xdf, 0xca, 0xa3, 0x4b, 0xaf, 0xa5, 0x3d, 0x8e,
+	0x25, 0xf4, 0x2a, 0xf8, 0x44, 0xbe, 0xf8, 0x17, 0x00, 0x00, 0xff, 0xff, 0x41, 0x8e, 0x8b, 0x74,
+	0xa3, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -128,6 +149,95 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PriceAccumulators) > 0 {
+		for iNdEx := len(m.PriceAccumulators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceAccumulators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.TradeRecords) > 0 {
+		for iNdEx := len(m.TradeRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TradeRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.RoutedSwapRequests) > 0 {
+		for iNdEx := len(m.RoutedSwapRequests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoutedSwapRequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.LastRoutedSwapRequestId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastRoutedSwapRequestId))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.ConditionalOrders) > 0 {
+		for iNdEx := len(m.ConditionalOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConditionalOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.LastConditionalOrderId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastConditionalOrderId))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.LastPositionId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastPositionId))
+		i--
+		dAtA[i] = 0x50
+	}
 	if len(m.MarketMakingOrderIndexes) > 0 {
 		for iNdEx := len(m.MarketMakingOrderIndexes) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -296,6 +406,45 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.LastPositionId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastPositionId))
+	}
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastConditionalOrderId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastConditionalOrderId))
+	}
+	if len(m.ConditionalOrders) > 0 {
+		for _, e := range m.ConditionalOrders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastRoutedSwapRequestId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastRoutedSwapRequestId))
+	}
+	if len(m.RoutedSwapRequests) > 0 {
+		for _, e := range m.RoutedSwapRequests {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TradeRecords) > 0 {
+		for _, e := range m.TradeRecords {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PriceAccumulators) > 0 {
+		for _, e := range m.PriceAccumulators {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -609,6 +758,233 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPositionId", wireType)
+			}
+			m.LastPositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastPositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Positions = append(m.Positions, Position{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastConditionalOrderId", wireType)
+			}
+			m.LastConditionalOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastConditionalOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConditionalOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConditionalOrders = append(m.ConditionalOrders, ConditionalOrder{})
+			if err := m.ConditionalOrders[len(m.ConditionalOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRoutedSwapRequestId", wireType)
+			}
+			m.LastRoutedSwapRequestId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastRoutedSwapRequestId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoutedSwapRequests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoutedSwapRequests = append(m.RoutedSwapRequests, RoutedSwapRequest{})
+			if err := m.RoutedSwapRequests[len(m.RoutedSwapRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TradeRecords = append(m.TradeRecords, TradeRecord{})
+			if err := m.TradeRecords[len(m.TradeRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceAccumulators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceAccumulators = append(m.PriceAccumulators, PriceAccumulator{})
+			if err := m.PriceAccumulators[len(m.PriceAccumulators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
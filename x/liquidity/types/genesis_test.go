@@ -48,6 +48,39 @@ func TestGenesisState_Validate(t *testing.T) {
 		ExpireAt:           utils.ParseTime("2022-02-01T00:00:00Z"),
 		Status:             types.OrderStatusPartiallyMatched,
 	}
+	position := types.NewPosition(
+		1, 1, sdk.AccAddress(crypto.AddressHash([]byte("owner"))), utils.ParseDec("0.9"), utils.ParseDec("1.1"))
+	conditionalOrder := types.ConditionalOrder{
+		Id:              1,
+		PairId:          1,
+		MsgHeight:       1,
+		Orderer:         sdk.AccAddress(crypto.AddressHash([]byte("orderer"))).String(),
+		Type:            types.ConditionalOrderTypeStopLoss,
+		OrderType:       types.OrderTypeMarket,
+		Direction:       types.OrderDirectionSell,
+		OfferCoin:       sdk.NewInt64Coin("denom1", 1000000),
+		DemandCoinDenom: "denom2",
+		TriggerPrice:    utils.ParseDec("0.9"),
+		Price:           sdk.ZeroDec(),
+		Amount:          sdk.NewInt(1000000),
+		ExpireAt:        utils.ParseTime("2022-02-01T00:00:00Z"),
+	}
+	routedSwapReq := types.RoutedSwapRequest{
+		Id:              1,
+		MsgHeight:       1,
+		Orderer:         sdk.AccAddress(crypto.AddressHash([]byte("orderer"))).String(),
+		PairIds:         []uint64{1},
+		OfferCoin:       sdk.NewInt64Coin("denom1", 1000000),
+		DemandCoinDenom: "denom2",
+		MinDemandAmount: sdk.NewInt(990000),
+		ReceivedCoin:    sdk.NewInt64Coin("denom2", 0),
+		Status:          types.RequestStatusNotExecuted,
+	}
+	tradeRecord := types.NewTradeRecord(
+		1, 1, 1, utils.ParseTime("2022-01-01T00:00:00Z"), utils.ParseDec("1.0"),
+		sdk.NewInt(1000000), sdk.NewInt(1000000), sdk.Coins{})
+	priceAcc := types.NewPriceAccumulator(
+		1, utils.ParseTime("2022-01-01T00:00:00Z"), utils.ParseDec("100.0"), utils.ParseDec("1.0"))
 
 	for _, tc := range []struct {
 		name        string
@@ -221,6 +254,146 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			"order at index 1 has a duplicate id: 1",
 		},
+		{
+			"invalid position",
+			func(genState *types.GenesisState) {
+				genState.Positions[0].ReserveAddress = testAddr.String()
+			},
+			"invalid position at index 0: wrong reserve address: " + testAddr.String(),
+		},
+		{
+			"wrong position id",
+			func(genState *types.GenesisState) {
+				genState.LastPositionId = 0
+			},
+			"position at index 0 has an id greater than last position id: 1",
+		},
+		{
+			"position of unknown pair",
+			func(genState *types.GenesisState) {
+				genState.Positions[0].PairId = 2
+			},
+			"position at index 0 has unknown pair id: 2",
+		},
+		{
+			"duplicate position",
+			func(genState *types.GenesisState) {
+				genState.Positions = []types.Position{position, position}
+			},
+			"position at index 1 has a duplicate position id: 1",
+		},
+		{
+			"invalid conditional order",
+			func(genState *types.GenesisState) {
+				genState.ConditionalOrders[0].Type = types.ConditionalOrderTypeUnspecified
+			},
+			"invalid conditional order at index 0: invalid conditional order type: CONDITIONAL_ORDER_TYPE_UNSPECIFIED",
+		},
+		{
+			"wrong conditional order id",
+			func(genState *types.GenesisState) {
+				genState.LastConditionalOrderId = 0
+			},
+			"conditional order at index 0 has an id greater than last conditional order id: 1",
+		},
+		{
+			"conditional order of unknown pair",
+			func(genState *types.GenesisState) {
+				genState.ConditionalOrders[0].PairId = 2
+			},
+			"conditional order at index 0 has unknown pair id: 2",
+		},
+		{
+			"conditional order with wrong offer coin denom",
+			func(genState *types.GenesisState) {
+				genState.ConditionalOrders[0].Direction = types.OrderDirectionBuy
+			},
+			"conditional order at index 0 has wrong offer coin denom: denom1 != denom2",
+		},
+		{
+			"duplicate conditional order",
+			func(genState *types.GenesisState) {
+				genState.ConditionalOrders = []types.ConditionalOrder{conditionalOrder, conditionalOrder}
+			},
+			"conditional order at index 1 has a duplicate id: 1",
+		},
+		{
+			"invalid routed swap request",
+			func(genState *types.GenesisState) {
+				genState.RoutedSwapRequests[0].PairIds = nil
+			},
+			"invalid routed swap request at index 0: pair ids must not be empty",
+		},
+		{
+			"wrong routed swap request id",
+			func(genState *types.GenesisState) {
+				genState.LastRoutedSwapRequestId = 0
+			},
+			"routed swap request at index 0 has an id greater than last routed swap request id: 1",
+		},
+		{
+			"routed swap request with unknown pair",
+			func(genState *types.GenesisState) {
+				genState.RoutedSwapRequests[0].PairIds = []uint64{1, 2}
+			},
+			"routed swap request at index 0 has unknown pair id: 2",
+		},
+		{
+			"routed swap request with wrong received coin denom",
+			func(genState *types.GenesisState) {
+				genState.RoutedSwapRequests[0].ReceivedCoin = sdk.NewInt64Coin("denom1", 0)
+			},
+			"invalid routed swap request at index 0: wrong received coin denom: denom1 != denom2",
+		},
+		{
+			"duplicate routed swap request",
+			func(genState *types.GenesisState) {
+				genState.RoutedSwapRequests = []types.RoutedSwapRequest{routedSwapReq, routedSwapReq}
+			},
+			"routed swap request at index 1 has a duplicate id: 1",
+		},
+		{
+			"invalid trade record",
+			func(genState *types.GenesisState) {
+				genState.TradeRecords[0].LowPrice = utils.ParseDec("1.1")
+			},
+			"invalid trade record at index 0: high price is lower than low price: 1.000000000000000000 < 1.100000000000000000",
+		},
+		{
+			"trade record with unknown pair",
+			func(genState *types.GenesisState) {
+				genState.TradeRecords[0].PairId = 2
+			},
+			"trade record at index 0 has unknown pair id: 2",
+		},
+		{
+			"duplicate trade record",
+			func(genState *types.GenesisState) {
+				genState.TradeRecords = []types.TradeRecord{tradeRecord, tradeRecord}
+			},
+			"trade record at index 1 has a duplicate batch id: 1",
+		},
+		{
+			"invalid price accumulator",
+			func(genState *types.GenesisState) {
+				genState.PriceAccumulators[0].LastPrice = sdk.ZeroDec()
+			},
+			"invalid price accumulator at index 0: last price must be positive: 0.000000000000000000",
+		},
+		{
+			"price accumulator with unknown pair",
+			func(genState *types.GenesisState) {
+				genState.PriceAccumulators[0].PairId = 2
+			},
+			"price accumulator at index 0 has unknown pair id: 2",
+		},
+		{
+			"duplicate price accumulator",
+			func(genState *types.GenesisState) {
+				genState.PriceAccumulators = []types.PriceAccumulator{priceAcc, priceAcc}
+			},
+			"price accumulator at index 1 has a duplicate time: 2022-01-01 00:00:00 +0000 UTC",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			genState := types.DefaultGenesis()
@@ -231,6 +404,14 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState.DepositRequests = []types.DepositRequest{depositReq}
 			genState.WithdrawRequests = []types.WithdrawRequest{withdrawReq}
 			genState.Orders = []types.Order{order}
+			genState.Positions = []types.Position{position}
+			genState.LastPositionId = 1
+			genState.ConditionalOrders = []types.ConditionalOrder{conditionalOrder}
+			genState.LastConditionalOrderId = 1
+			genState.RoutedSwapRequests = []types.RoutedSwapRequest{routedSwapReq}
+			genState.LastRoutedSwapRequestId = 1
+			genState.TradeRecords = []types.TradeRecord{tradeRecord}
+			genState.PriceAccumulators = []types.PriceAccumulator{priceAcc}
 			tc.malleate(genState)
 			err := genState.Validate()
 			if tc.expectedErr == "" {
@@ -2,6 +2,7 @@ package types
 
 import (
 	"bytes"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
@@ -22,8 +23,12 @@ const (
 )
 
 var (
-	LastPairIdKey = []byte{0xa0} // key for the latest pair id
-	LastPoolIdKey = []byte{0xa1} // key for the latest pool id
+	LastPairIdKey     = []byte{0xa0} // key for the latest pair id
+	LastPoolIdKey     = []byte{0xa1} // key for the latest pool id
+	LastPositionIdKey = []byte{0xa2} // key for the latest position id
+
+	LastConditionalOrderIdKey  = []byte{0xa3} // key for the latest conditional order id
+	LastRoutedSwapRequestIdKey = []byte{0xa4} // key for the latest routed swap request id
 
 	PairKeyPrefix               = []byte{0xa5}
 	PairIndexKeyPrefix          = []byte{0xa6}
@@ -40,6 +45,20 @@ var (
 	OrderKeyPrefix                = []byte{0xb2}
 	OrderIndexKeyPrefix           = []byte{0xb3}
 	MMOrderIndexKeyPrefix         = []byte{0xb6}
+
+	PositionKeyPrefix             = []byte{0xb7}
+	PositionIndexKeyPrefix        = []byte{0xb8}
+	PositionsByPairIndexKeyPrefix = []byte{0xb9}
+	NumPositionsByPairKeyPrefix   = []byte{0xbf}
+
+	ConditionalOrderKeyPrefix      = []byte{0xba}
+	ConditionalOrderIndexKeyPrefix = []byte{0xbb}
+
+	RoutedSwapRequestKeyPrefix = []byte{0xbc}
+
+	TradeRecordKeyPrefix = []byte{0xbd}
+
+	PriceAccumulatorKeyPrefix = []byte{0xbe}
 )
 
 // GetPairKey returns the store key to retrieve pair object from the pair id.
@@ -151,6 +170,63 @@ func GetMMOrderIndexKey(orderer sdk.AccAddress, pairId uint64) []byte {
 	return append(append(MMOrderIndexKeyPrefix, address.MustLengthPrefix(orderer)...), sdk.Uint64ToBigEndian(pairId)...)
 }
 
+// GetPositionKey returns the store key to retrieve position object from the position id.
+func GetPositionKey(positionId uint64) []byte {
+	return append(PositionKeyPrefix, sdk.Uint64ToBigEndian(positionId)...)
+}
+
+// GetPositionIndexKey returns the index key to map positions with an owner.
+func GetPositionIndexKey(owner sdk.AccAddress, positionId uint64) []byte {
+	return append(append(PositionIndexKeyPrefix, address.MustLengthPrefix(owner)...), sdk.Uint64ToBigEndian(positionId)...)
+}
+
+// GetPositionIndexKeyPrefix returns the index key prefix to iterate positions
+// by an owner.
+func GetPositionIndexKeyPrefix(owner sdk.AccAddress) []byte {
+	return append(PositionIndexKeyPrefix, address.MustLengthPrefix(owner)...)
+}
+
+// GetPositionsByPairIndexKey returns the index key to retrieve position id that is used to iterate positions.
+func GetPositionsByPairIndexKey(pairId, positionId uint64) []byte {
+	return append(append(PositionsByPairIndexKeyPrefix, sdk.Uint64ToBigEndian(pairId)...), sdk.Uint64ToBigEndian(positionId)...)
+}
+
+// GetPositionsByPairIndexKeyPrefix returns the store key to retrieve position id to iterate positions.
+func GetPositionsByPairIndexKeyPrefix(pairId uint64) []byte {
+	return append(PositionsByPairIndexKeyPrefix, sdk.Uint64ToBigEndian(pairId)...)
+}
+
+// GetNumPositionsByPairKey returns the store key to retrieve the number of
+// positions in the pair.
+func GetNumPositionsByPairKey(pairId uint64) []byte {
+	return append(NumPositionsByPairKeyPrefix, sdk.Uint64ToBigEndian(pairId)...)
+}
+
+// GetConditionalOrderKey returns the store key to retrieve conditional order
+// object from the pair id and conditional order id.
+func GetConditionalOrderKey(pairId, id uint64) []byte {
+	return append(append(ConditionalOrderKeyPrefix, sdk.Uint64ToBigEndian(pairId)...), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetConditionalOrdersByPairKeyPrefix returns the store key to iterate
+// conditional orders by pair.
+func GetConditionalOrdersByPairKeyPrefix(pairId uint64) []byte {
+	return append(ConditionalOrderKeyPrefix, sdk.Uint64ToBigEndian(pairId)...)
+}
+
+// GetConditionalOrderIndexKey returns the index key to map conditional orders
+// with an orderer.
+func GetConditionalOrderIndexKey(orderer sdk.AccAddress, pairId, orderId uint64) []byte {
+	return append(append(append(ConditionalOrderIndexKeyPrefix, address.MustLengthPrefix(orderer)...),
+		sdk.Uint64ToBigEndian(pairId)...), sdk.Uint64ToBigEndian(orderId)...)
+}
+
+// GetConditionalOrderIndexKeyPrefix returns the index key prefix to iterate
+// conditional orders by an orderer.
+func GetConditionalOrderIndexKeyPrefix(orderer sdk.AccAddress) []byte {
+	return append(ConditionalOrderIndexKeyPrefix, address.MustLengthPrefix(orderer)...)
+}
+
 // ParsePairsByDenomsIndexKey parses a pair by denom index key.
 func ParsePairsByDenomsIndexKey(key []byte) (denomA, denomB string, pairId uint64) {
 	if !bytes.HasPrefix(key, PairsByDenomsIndexKeyPrefix) {
@@ -216,6 +292,72 @@ func ParseOrderIndexKey(key []byte) (orderer sdk.AccAddress, pairId, orderId uin
 	return
 }
 
+// ParsePositionIndexKey parses a position index key.
+func ParsePositionIndexKey(key []byte) (owner sdk.AccAddress, positionId uint64) {
+	if !bytes.HasPrefix(key, PositionIndexKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+
+	addrLen := key[1]
+	owner = key[2 : 2+addrLen]
+	positionId = sdk.BigEndianToUint64(key[2+addrLen:])
+	return
+}
+
+// ParsePositionsByPairIndexKey parses a position id from the index key.
+func ParsePositionsByPairIndexKey(key []byte) (positionId uint64) {
+	if !bytes.HasPrefix(key, PositionsByPairIndexKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+
+	bytesLen := 8
+	positionId = sdk.BigEndianToUint64(key[1+bytesLen:])
+	return
+}
+
+// GetRoutedSwapRequestKey returns the store key to retrieve routed swap
+// request object by the request id.
+func GetRoutedSwapRequestKey(id uint64) []byte {
+	return append(RoutedSwapRequestKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTradeRecordKey returns the store key to retrieve trade record object
+// by the pair id and the batch id.
+func GetTradeRecordKey(pairId, batchId uint64) []byte {
+	return append(GetTradeRecordsByPairKeyPrefix(pairId), sdk.Uint64ToBigEndian(batchId)...)
+}
+
+// GetTradeRecordsByPairKeyPrefix returns the store key to iterate trade
+// records within the pair.
+func GetTradeRecordsByPairKeyPrefix(pairId uint64) []byte {
+	return append(TradeRecordKeyPrefix, sdk.Uint64ToBigEndian(pairId)...)
+}
+
+// GetPriceAccumulatorKey returns the store key to retrieve price accumulator
+// object by the pair id and the snapshot time.
+func GetPriceAccumulatorKey(pairId uint64, t time.Time) []byte {
+	return append(GetPriceAccumulatorsByPairKeyPrefix(pairId), sdk.FormatTimeBytes(t)...)
+}
+
+// GetPriceAccumulatorsByPairKeyPrefix returns the store key to iterate price
+// accumulators within the pair.
+func GetPriceAccumulatorsByPairKeyPrefix(pairId uint64) []byte {
+	return append(PriceAccumulatorKeyPrefix, sdk.Uint64ToBigEndian(pairId)...)
+}
+
+// ParseConditionalOrderIndexKey parses a conditional order index key.
+func ParseConditionalOrderIndexKey(key []byte) (orderer sdk.AccAddress, pairId, orderId uint64) {
+	if !bytes.HasPrefix(key, ConditionalOrderIndexKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+
+	addrLen := key[1]
+	orderer = key[2 : 2+addrLen]
+	pairId = sdk.BigEndianToUint64(key[2+addrLen : 2+addrLen+8])
+	orderId = sdk.BigEndianToUint64(key[2+addrLen+8:])
+	return
+}
+
 // LengthPrefixString returns length-prefixed bytes representation
 // of a string.
 func LengthPrefixString(s string) []byte {
@@ -3,12 +3,14 @@ package types_test
 import (
 	"bytes"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/tendermint/tendermint/crypto"
 
+	utils "github.com/cosmosquad-labs/squad/v3/types"
 	"github.com/cosmosquad-labs/squad/v3/x/liquidity/types"
 )
 
@@ -201,3 +203,47 @@ func (s *keysTestSuite) TestMMOrderIndexKey() {
 		0x5c, 0xbc, 0x50, 0xf2, 0x85, 0xf7, 0x7d, 0xff, 0x52, 0x9f, 0x25, 0x0, 0x0, 0x0, 0x0,
 		0x0, 0x0, 0x0, 0x1}, key)
 }
+
+func (s *keysTestSuite) TestGetConditionalOrderKey() {
+	s.Require().Equal([]byte{0xba, 0, 0, 0, 0, 0, 0, 0, 0x1, 0, 0,
+		0, 0, 0, 0, 0, 0x1}, types.GetConditionalOrderKey(1, 1))
+	s.Require().Equal([]byte{0xba, 0, 0, 0, 0, 0, 0, 0x3, 0xe8, 0,
+		0, 0, 0, 0, 0, 0x3, 0xe9}, types.GetConditionalOrderKey(1000, 1001))
+	s.Require().Equal([]byte{0xba, 0, 0, 0, 0, 0, 0, 0x3, 0xe8}, types.GetConditionalOrdersByPairKeyPrefix(1000))
+}
+
+func (s *keysTestSuite) TestConditionalOrderIndexKey() {
+	orderer := sdk.AccAddress(crypto.AddressHash([]byte("orderer")))
+	key := types.GetConditionalOrderIndexKey(orderer, 1, 2)
+	s.Require().Equal([]byte{0xbb, 0x14, 0x54, 0x7e, 0xfe, 0x47, 0x8f, 0xc9, 0xf9, 0x52, 0xb2,
+		0x5c, 0xbc, 0x50, 0xf2, 0x85, 0xf7, 0x7d, 0xff, 0x52, 0x9f, 0x25, 0, 0, 0, 0,
+		0, 0, 0, 0x1, 0, 0, 0, 0, 0, 0, 0, 0x2}, key)
+	s.Require().True(bytes.HasPrefix(key, types.GetConditionalOrderIndexKeyPrefix(orderer)))
+	orderer2, pairId, orderId := types.ParseConditionalOrderIndexKey(key)
+	s.Require().Equal(orderer, orderer2)
+	s.Require().Equal(uint64(1), pairId)
+	s.Require().Equal(uint64(2), orderId)
+}
+
+func (s *keysTestSuite) TestGetRoutedSwapRequestKey() {
+	s.Require().Equal([]byte{0xbc, 0, 0, 0, 0, 0, 0, 0, 0x1}, types.GetRoutedSwapRequestKey(1))
+	s.Require().Equal([]byte{0xbc, 0, 0, 0, 0, 0, 0, 0x3, 0xe8}, types.GetRoutedSwapRequestKey(1000))
+}
+
+func (s *keysTestSuite) TestGetTradeRecordKey() {
+	s.Require().Equal([]byte{0xbd, 0, 0, 0, 0, 0, 0, 0, 0x1, 0, 0,
+		0, 0, 0, 0, 0, 0x1}, types.GetTradeRecordKey(1, 1))
+	s.Require().Equal([]byte{0xbd, 0, 0, 0, 0, 0, 0, 0x3, 0xe8, 0,
+		0, 0, 0, 0, 0, 0x3, 0xe9}, types.GetTradeRecordKey(1000, 1001))
+	s.Require().Equal([]byte{0xbd, 0, 0, 0, 0, 0, 0, 0x3, 0xe8}, types.GetTradeRecordsByPairKeyPrefix(1000))
+}
+
+func (s *keysTestSuite) TestGetPriceAccumulatorKey() {
+	t := utils.ParseTime("2022-01-01T00:00:00Z")
+	s.Require().Equal(
+		append([]byte{0xbe, 0, 0, 0, 0, 0, 0, 0, 0x1}, sdk.FormatTimeBytes(t)...),
+		types.GetPriceAccumulatorKey(1, t))
+	s.Require().Equal([]byte{0xbe, 0, 0, 0, 0, 0, 0, 0x3, 0xe8}, types.GetPriceAccumulatorsByPairKeyPrefix(1000))
+	s.Require().True(bytes.Compare(
+		types.GetPriceAccumulatorKey(1, t), types.GetPriceAccumulatorKey(1, t.Add(time.Second))) < 0)
+}
@@ -40,18 +40,22 @@ const (
 	PoolTypeBasic PoolType = 1
 	// POOL_TYPE_RANGED specifies the ranged pool type
 	PoolTypeRanged PoolType = 2
+	// POOL_TYPE_STABLESWAP specifies the stableswap pool type
+	PoolTypeStableswap PoolType = 3
 )
 
 var PoolType_name = map[int32]string{
 	0: "POOL_TYPE_UNSPECIFIED",
 	1: "POOL_TYPE_BASIC",
 	2: "POOL_TYPE_RANGED",
+	3: "POOL_TYPE_STABLESWAP",
 }
 
 var PoolType_value = map[string]int32{
 	"POOL_TYPE_UNSPECIFIED": 0,
 	"POOL_TYPE_BASIC":       1,
 	"POOL_TYPE_RANGED":      2,
+	"POOL_TYPE_STABLESWAP":  3,
 }
 
 func (x PoolType) String() string {
@@ -214,6 +218,77 @@ func (OrderStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_8256f3e2df6bc8b8, []int{4}
 }
 
+// TimeInForce enumerates time-in-force options of orders.
+type TimeInForce int32
+
+const (
+	// TIME_IN_FORCE_GOOD_TIL_CANCELED specifies that the order remains in the order book
+	// until it is completed, canceled or expired
+	TimeInForceGoodTilCanceled TimeInForce = 0
+	// TIME_IN_FORCE_IMMEDIATE_OR_CANCEL specifies that the unmatched remainder of the
+	// order is refunded right after its first batch
+	TimeInForceImmediateOrCancel TimeInForce = 1
+	// TIME_IN_FORCE_FILL_OR_KILL specifies that the order is refunded if it cannot be
+	// fully matched in its first batch
+	TimeInForceFillOrKill TimeInForce = 2
+)
+
+var TimeInForce_name = map[int32]string{
+	0: "TIME_IN_FORCE_GOOD_TIL_CANCELED",
+	1: "TIME_IN_FORCE_IMMEDIATE_OR_CANCEL",
+	2: "TIME_IN_FORCE_FILL_OR_KILL",
+}
+
+var TimeInForce_value = map[string]int32{
+	"TIME_IN_FORCE_GOOD_TIL_CANCELED":   0,
+	"TIME_IN_FORCE_IMMEDIATE_OR_CANCEL": 1,
+	"TIME_IN_FORCE_FILL_OR_KILL":        2,
+}
+
+func (x TimeInForce) String() string {
+	return proto.EnumName(TimeInForce_name, int32(x))
+}
+
+func (TimeInForce) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8256f3e2df6bc8b8, []int{5}
+}
+
+// ConditionalOrderType enumerates conditional order types.
+type ConditionalOrderType int32
+
+const (
+	// CONDITIONAL_ORDER_TYPE_UNSPECIFIED specifies unknown conditional order type
+	ConditionalOrderTypeUnspecified ConditionalOrderType = 0
+	// CONDITIONAL_ORDER_TYPE_STOP_LOSS specifies stop-loss order type; a sell order is
+	// triggered when the price falls to the trigger price and a buy order is triggered
+	// when the price rises to the trigger price
+	ConditionalOrderTypeStopLoss ConditionalOrderType = 1
+	// CONDITIONAL_ORDER_TYPE_TAKE_PROFIT specifies take-profit order type; a sell order is
+	// triggered when the price rises to the trigger price and a buy order is triggered
+	// when the price falls to the trigger price
+	ConditionalOrderTypeTakeProfit ConditionalOrderType = 2
+)
+
+var ConditionalOrderType_name = map[int32]string{
+	0: "CONDITIONAL_ORDER_TYPE_UNSPECIFIED",
+	1: "CONDITIONAL_ORDER_TYPE_STOP_LOSS",
+	2: "CONDITIONAL_ORDER_TYPE_TAKE_PROFIT",
+}
+
+var ConditionalOrderType_value = map[string]int32{
+	"CONDITIONAL_ORDER_TYPE_UNSPECIFIED": 0,
+	"CONDITIONAL_ORDER_TYPE_STOP_LOSS":   1,
+	"CONDITIONAL_ORDER_TYPE_TAKE_PROFIT": 2,
+}
+
+func (x ConditionalOrderType) String() string {
+	return proto.EnumName(ConditionalOrderType_name, int32(x))
+}
+
+func (ConditionalOrderType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8256f3e2df6bc8b8, []int{6}
+}
+
 // Params defines the parameters for the liquidity module.
 type Params struct {
 	BatchSize                    uint32                                   `protobuf:"varint,1,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
@@ -232,6 +307,16 @@ type Params struct {
 	DepositExtraGas              github_com_cosmos_cosmos_sdk_types.Gas   `protobuf:"varint,14,opt,name=deposit_extra_gas,json=depositExtraGas,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Gas" json:"deposit_extra_gas"`
 	WithdrawExtraGas             github_com_cosmos_cosmos_sdk_types.Gas   `protobuf:"varint,15,opt,name=withdraw_extra_gas,json=withdrawExtraGas,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Gas" json:"withdraw_extra_gas"`
 	OrderExtraGas                github_com_cosmos_cosmos_sdk_types.Gas   `protobuf:"varint,16,opt,name=order_extra_gas,json=orderExtraGas,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Gas" json:"order_extra_gas"`
+	TradeRecordRetention         uint32                                   `protobuf:"varint,17,opt,name=trade_record_retention,json=tradeRecordRetention,proto3" json:"trade_record_retention,omitempty"`
+	MaxTwapWindow                time.Duration                            `protobuf:"bytes,18,opt,name=max_twap_window,json=maxTwapWindow,proto3,stdduration" json:"max_twap_window"`
+	AllowedSwapFeeRates          []github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,19,rep,name=allowed_swap_fee_rates,json=allowedSwapFeeRates,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"allowed_swap_fee_rates"`
+	PoolSwapFeeRatio             github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,20,opt,name=pool_swap_fee_ratio,json=poolSwapFeeRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"pool_swap_fee_ratio"`
+	// maker_fee_rate is added to the pair's swap fee rate for maker orders;
+	// a negative effective rate means a rebate
+	MakerFeeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,21,opt,name=maker_fee_rate,json=makerFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maker_fee_rate"`
+	// taker_fee_rate is added to the pair's swap fee rate for taker orders
+	TakerFeeRate        github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,22,opt,name=taker_fee_rate,json=takerFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"taker_fee_rate"`
+	PositionCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,23,rep,name=position_creation_fee,json=positionCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"position_creation_fee"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -276,6 +361,8 @@ type Pair struct {
 	LastOrderId    uint64                                  `protobuf:"varint,5,opt,name=last_order_id,json=lastOrderId,proto3" json:"last_order_id,omitempty"`
 	LastPrice      *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=last_price,json=lastPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"last_price,omitempty"`
 	CurrentBatchId uint64                                  `protobuf:"varint,7,opt,name=current_batch_id,json=currentBatchId,proto3" json:"current_batch_id,omitempty"`
+	// swap_fee_rate overrides the global swap fee rate for the pair if set
+	SwapFeeRate *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=swap_fee_rate,json=swapFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee_rate,omitempty"`
 }
 
 func (m *Pair) Reset()         { *m = Pair{} }
@@ -311,8 +398,8 @@ func (m *Pair) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Pair proto.InternalMessageInfo
 
-// Pool defines generic liquidity pool object which can be either a basic pool or a
-// ranged pool.
+// Pool defines generic liquidity pool object which can be either a basic pool,
+// a ranged pool or a stableswap pool.
 type Pool struct {
 	Type                  PoolType                                `protobuf:"varint,1,opt,name=type,proto3,enum=squad.liquidity.v1beta1.PoolType" json:"type,omitempty"`
 	Id                    uint64                                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
@@ -325,6 +412,8 @@ type Pool struct {
 	LastDepositRequestId  uint64                                  `protobuf:"varint,9,opt,name=last_deposit_request_id,json=lastDepositRequestId,proto3" json:"last_deposit_request_id,omitempty"`
 	LastWithdrawRequestId uint64                                  `protobuf:"varint,10,opt,name=last_withdraw_request_id,json=lastWithdrawRequestId,proto3" json:"last_withdraw_request_id,omitempty"`
 	Disabled              bool                                    `protobuf:"varint,11,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// amplification specifies the amplification coefficient of a stableswap pool
+	Amplification uint64 `protobuf:"varint,12,opt,name=amplification,proto3" json:"amplification,omitempty"`
 }
 
 func (m *Pool) Reset()         { *m = Pool{} }
@@ -488,6 +577,12 @@ type Order struct {
 	BatchId  uint64      `protobuf:"varint,13,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
 	ExpireAt time.Time   `protobuf:"bytes,14,opt,name=expire_at,json=expireAt,proto3,stdtime" json:"expire_at"`
 	Status   OrderStatus `protobuf:"varint,15,opt,name=status,proto3,enum=squad.liquidity.v1beta1.OrderStatus" json:"status,omitempty"`
+	// time_in_force specifies how long the order remains in the order book
+	TimeInForce TimeInForce `protobuf:"varint,16,opt,name=time_in_force,json=timeInForce,proto3,enum=squad.liquidity.v1beta1.TimeInForce" json:"time_in_force,omitempty"`
+	// post_only specifies whether the order was placed as a post-only order
+	PostOnly bool `protobuf:"varint,17,opt,name=post_only,json=postOnly,proto3" json:"post_only,omitempty"`
+	// amendments specifies the amendment history of the order
+	Amendments []OrderAmendment `protobuf:"bytes,18,rep,name=amendments,proto3" json:"amendments"`
 }
 
 func (m *Order) Reset()         { *m = Order{} }
@@ -523,6 +618,54 @@ func (m *Order) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Order proto.InternalMessageInfo
 
+// OrderAmendment defines a record of an amendment made to an order.
+type OrderAmendment struct {
+	// height specifies the block height when the order was amended
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// previous_order_id specifies the id of the order before the amendment;
+	// it differs from the order's id if the order was replaced by the amendment
+	PreviousOrderId uint64 `protobuf:"varint,2,opt,name=previous_order_id,json=previousOrderId,proto3" json:"previous_order_id,omitempty"`
+	// previous_price specifies the order price before the amendment
+	PreviousPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=previous_price,json=previousPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"previous_price"`
+	// previous_open_amount specifies the open amount of the order before the amendment
+	PreviousOpenAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=previous_open_amount,json=previousOpenAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"previous_open_amount"`
+	// previous_expire_at specifies the expiration time of the order before the amendment
+	PreviousExpireAt time.Time `protobuf:"bytes,5,opt,name=previous_expire_at,json=previousExpireAt,proto3,stdtime" json:"previous_expire_at"`
+}
+
+func (m *OrderAmendment) Reset()         { *m = OrderAmendment{} }
+func (m *OrderAmendment) String() string { return proto.CompactTextString(m) }
+func (*OrderAmendment) ProtoMessage()    {}
+func (*OrderAmendment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8256f3e2df6bc8b8, []int{6}
+}
+func (m *OrderAmendment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderAmendment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderAmendment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrderAmendment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderAmendment.Merge(m, src)
+}
+func (m *OrderAmendment) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderAmendment) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderAmendment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderAmendment proto.InternalMessageInfo
+
 // MMOrderIndex defines an index type to quickly find market making orders
 // from an orderer.
 type MMOrderIndex struct {
@@ -535,7 +678,7 @@ func (m *MMOrderIndex) Reset()         { *m = MMOrderIndex{} }
 func (m *MMOrderIndex) String() string { return proto.CompactTextString(m) }
 func (*MMOrderIndex) ProtoMessage()    {}
 func (*MMOrderIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8256f3e2df6bc8b8, []int{6}
+	return fileDescriptor_8256f3e2df6bc8b8, []int{7}
 }
 func (m *MMOrderIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -564,19 +707,333 @@ func (m *MMOrderIndex) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MMOrderIndex proto.InternalMessageInfo
 
+// Position defines a concentrated liquidity position.
+// A position provides liquidity within its own price range in a pair and
+// is owned by a single account, unlike pools which issue fungible pool coins.
+type Position struct {
+	Id             uint64                                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PairId         uint64                                 `protobuf:"varint,2,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	Owner          string                                 `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	ReserveAddress string                                 `protobuf:"bytes,4,opt,name=reserve_address,json=reserveAddress,proto3" json:"reserve_address,omitempty"`
+	MinPrice       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=min_price,json=minPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_price"`
+	MaxPrice       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=max_price,json=maxPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_price"`
+}
+
+func (m *Position) Reset()         { *m = Position{} }
+func (m *Position) String() string { return proto.CompactTextString(m) }
+func (*Position) ProtoMessage()    {}
+func (*Position) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8256f3e2df6bc8b8, []int{8}
+}
+func (m *Position) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Position) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Position.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Position) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Position.Merge(m, src)
+}
+func (m *Position) XXX_Size() int {
+	return m.Size()
+}
+func (m *Position) XXX_DiscardUnknown() {
+	xxx_messageInfo_Position.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Position proto.InternalMessageInfo
+
+// ConditionalOrder defines a stop-loss or take-profit order.
+// The offer coin of a conditional order is held in the pair's escrow until
+// the pair's last price reaches the trigger price, then the conditional order
+// turns into a limit or market order.
+type ConditionalOrder struct {
+	// id specifies the id of the conditional order
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// pair_id specifies the pair id
+	PairId uint64 `protobuf:"varint,2,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	// msg_height specifies the block height when the conditional order is stored
+	MsgHeight int64 `protobuf:"varint,3,opt,name=msg_height,json=msgHeight,proto3" json:"msg_height,omitempty"`
+	// orderer specifies the bech32-encoded address that makes a conditional order
+	Orderer string `protobuf:"bytes,4,opt,name=orderer,proto3" json:"orderer,omitempty"`
+	// type specifies the conditional order type; either stop-loss or take-profit
+	Type ConditionalOrderType `protobuf:"varint,5,opt,name=type,proto3,enum=squad.liquidity.v1beta1.ConditionalOrderType" json:"type,omitempty"`
+	// order_type specifies the type of the order made when triggered; either limit or market
+	OrderType OrderType `protobuf:"varint,6,opt,name=order_type,json=orderType,proto3,enum=squad.liquidity.v1beta1.OrderType" json:"order_type,omitempty"`
+	// direction specifies the order direction; either buy or sell
+	Direction OrderDirection `protobuf:"varint,7,opt,name=direction,proto3,enum=squad.liquidity.v1beta1.OrderDirection" json:"direction,omitempty"`
+	// offer_coin specifies the escrowed offer coin
+	OfferCoin types.Coin `protobuf:"bytes,8,opt,name=offer_coin,json=offerCoin,proto3" json:"offer_coin"`
+	// demand_coin_denom specifies the demand coin denom
+	DemandCoinDenom string `protobuf:"bytes,9,opt,name=demand_coin_denom,json=demandCoinDenom,proto3" json:"demand_coin_denom,omitempty"`
+	// trigger_price specifies the price at which the conditional order is triggered
+	TriggerPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=trigger_price,json=triggerPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"trigger_price"`
+	// price specifies the limit order price; not used for market orders
+	Price  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,12,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// expire_at specifies when the conditional order expires; the triggered order
+	// expires at the same time
+	ExpireAt time.Time `protobuf:"bytes,13,opt,name=expire_at,json=expireAt,proto3,stdtime" json:"expire_at"`
+}
+
+func (m *ConditionalOrder) Reset()         { *m = ConditionalOrder{} }
+func (m *ConditionalOrder) String() string { return proto.CompactTextString(m) }
+func (*ConditionalOrder) ProtoMessage()    {}
+func (*ConditionalOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8256f3e2df6bc8b8, []int{9}
+}
+func (m *ConditionalOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConditionalOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConditionalOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConditionalOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConditionalOrder.Merge(m, src)
+}
+func (m *ConditionalOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConditionalOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConditionalOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConditionalOrder proto.InternalMessageInfo
+
+// RoutedSwapRequest defines a multi-hop swap request across pairs.
+type RoutedSwapRequest struct {
+	// id specifies the id for the request
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// msg_height specifies the block height when the request is stored for the batch execution
+	MsgHeight int64 `protobuf:"varint,2,opt,name=msg_height,json=msgHeight,proto3" json:"msg_height,omitempty"`
+	// orderer specifies the bech32-encoded address that makes the swap
+	Orderer string `protobuf:"bytes,3,opt,name=orderer,proto3" json:"orderer,omitempty"`
+	// pair_ids specifies the path of pairs to swap through
+	PairIds []uint64 `protobuf:"varint,4,rep,packed,name=pair_ids,json=pairIds,proto3" json:"pair_ids,omitempty"`
+	// offer_coin specifies the offer coin escrowed in the first pair's escrow
+	OfferCoin types.Coin `protobuf:"bytes,5,opt,name=offer_coin,json=offerCoin,proto3" json:"offer_coin"`
+	// demand_coin_denom specifies the demand coin denom of the last pair
+	DemandCoinDenom string `protobuf:"bytes,6,opt,name=demand_coin_denom,json=demandCoinDenom,proto3" json:"demand_coin_denom,omitempty"`
+	// min_demand_amount specifies the minimum amount of the demand coin to receive
+	MinDemandAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=min_demand_amount,json=minDemandAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_demand_amount"`
+	// received_coin specifies the amount of the demand coin received
+	ReceivedCoin types.Coin    `protobuf:"bytes,8,opt,name=received_coin,json=receivedCoin,proto3" json:"received_coin"`
+	Status       RequestStatus `protobuf:"varint,9,opt,name=status,proto3,enum=squad.liquidity.v1beta1.RequestStatus" json:"status,omitempty"`
+}
+
+func (m *RoutedSwapRequest) Reset()         { *m = RoutedSwapRequest{} }
+func (m *RoutedSwapRequest) String() string { return proto.CompactTextString(m) }
+func (*RoutedSwapRequest) ProtoMessage()    {}
+func (*RoutedSwapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8256f3e2df6bc8b8, []int{10}
+}
+func (m *RoutedSwapRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoutedSwapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoutedSwapRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RoutedSwapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoutedSwapRequest.Merge(m, src)
+}
+func (m *RoutedSwapRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoutedSwapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoutedSwapRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoutedSwapRequest proto.InternalMessageInfo
+
+// TradeRecord defines the matching result of a pair in a batch.
+type TradeRecord struct {
+	PairId     uint64                                 `protobuf:"varint,1,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	BatchId    uint64                                 `protobuf:"varint,2,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	Height     int64                                  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Time       time.Time                              `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time"`
+	OpenPrice  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=open_price,json=openPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"open_price"`
+	HighPrice  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=high_price,json=highPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"high_price"`
+	LowPrice   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=low_price,json=lowPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"low_price"`
+	ClosePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=close_price,json=closePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"close_price"`
+	// base_volume specifies the matched amount of the base coin
+	BaseVolume github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=base_volume,json=baseVolume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"base_volume"`
+	// quote_volume specifies the amount of the quote coin paid by buy orders
+	QuoteVolume github_com_cosmos_cosmos_sdk_types.Int   `protobuf:"bytes,10,opt,name=quote_volume,json=quoteVolume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"quote_volume"`
+	SwapFees    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,11,rep,name=swap_fees,json=swapFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"swap_fees"`
+}
+
+func (m *TradeRecord) Reset()         { *m = TradeRecord{} }
+func (m *TradeRecord) String() string { return proto.CompactTextString(m) }
+func (*TradeRecord) ProtoMessage()    {}
+func (*TradeRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8256f3e2df6bc8b8, []int{11}
+}
+func (m *TradeRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TradeRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TradeRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TradeRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TradeRecord.Merge(m, src)
+}
+func (m *TradeRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TradeRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TradeRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TradeRecord proto.InternalMessageInfo
+
+// Candle defines trade records aggregated within a time interval.
+type Candle struct {
+	StartTime   time.Time                                `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	Open        github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,2,opt,name=open,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"open"`
+	High        github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,3,opt,name=high,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"high"`
+	Low         github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,4,opt,name=low,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"low"`
+	Close       github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,5,opt,name=close,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"close"`
+	BaseVolume  github_com_cosmos_cosmos_sdk_types.Int   `protobuf:"bytes,6,opt,name=base_volume,json=baseVolume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"base_volume"`
+	QuoteVolume github_com_cosmos_cosmos_sdk_types.Int   `protobuf:"bytes,7,opt,name=quote_volume,json=quoteVolume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"quote_volume"`
+	SwapFees    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=swap_fees,json=swapFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"swap_fees"`
+}
+
+func (m *Candle) Reset()         { *m = Candle{} }
+func (m *Candle) String() string { return proto.CompactTextString(m) }
+func (*Candle) ProtoMessage()    {}
+func (*Candle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8256f3e2df6bc8b8, []int{12}
+}
+func (m *Candle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Candle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Candle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Candle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Candle.Merge(m, src)
+}
+func (m *Candle) XXX_Size() int {
+	return m.Size()
+}
+func (m *Candle) XXX_DiscardUnknown() {
+	xxx_messageInfo_Candle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Candle proto.InternalMessageInfo
+
+// PriceAccumulator defines a snapshot of the cumulative price of a pair,
+// which is used to calculate the time-weighted average price.
+type PriceAccumulator struct {
+	PairId uint64    `protobuf:"varint,1,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	Time   time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	// cumulative_price is the sum of the pair's last price multiplied by the
+	// seconds during which the price was held
+	CumulativePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=cumulative_price,json=cumulativePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"cumulative_price"`
+	// last_price is the pair's last price at the time of the snapshot
+	LastPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=last_price,json=lastPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"last_price"`
+}
+
+func (m *PriceAccumulator) Reset()         { *m = PriceAccumulator{} }
+func (m *PriceAccumulator) String() string { return proto.CompactTextString(m) }
+func (*PriceAccumulator) ProtoMessage()    {}
+func (*PriceAccumulator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8256f3e2df6bc8b8, []int{13}
+}
+func (m *PriceAccumulator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceAccumulator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceAccumulator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceAccumulator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceAccumulator.Merge(m, src)
+}
+func (m *PriceAccumulator) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceAccumulator) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceAccumulator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceAccumulator proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("squad.liquidity.v1beta1.PoolType", PoolType_name, PoolType_value)
 	proto.RegisterEnum("squad.liquidity.v1beta1.OrderType", OrderType_name, OrderType_value)
 	proto.RegisterEnum("squad.liquidity.v1beta1.OrderDirection", OrderDirection_name, OrderDirection_value)
 	proto.RegisterEnum("squad.liquidity.v1beta1.RequestStatus", RequestStatus_name, RequestStatus_value)
 	proto.RegisterEnum("squad.liquidity.v1beta1.OrderStatus", OrderStatus_name, OrderStatus_value)
+	proto.RegisterEnum("squad.liquidity.v1beta1.TimeInForce", TimeInForce_name, TimeInForce_value)
+	proto.RegisterEnum("squad.liquidity.v1beta1.ConditionalOrderType", ConditionalOrderType_name, ConditionalOrderType_value)
 	proto.RegisterType((*Params)(nil), "squad.liquidity.v1beta1.Params")
 	proto.RegisterType((*Pair)(nil), "squad.liquidity.v1beta1.Pair")
 	proto.RegisterType((*Pool)(nil), "squad.liquidity.v1beta1.Pool")
 	proto.RegisterType((*DepositRequest)(nil), "squad.liquidity.v1beta1.DepositRequest")
 	proto.RegisterType((*WithdrawRequest)(nil), "squad.liquidity.v1beta1.WithdrawRequest")
 	proto.RegisterType((*Order)(nil), "squad.liquidity.v1beta1.Order")
+	proto.RegisterType((*OrderAmendment)(nil), "squad.liquidity.v1beta1.OrderAmendment")
 	proto.RegisterType((*MMOrderIndex)(nil), "squad.liquidity.v1beta1.MMOrderIndex")
+	proto.RegisterType((*Position)(nil), "squad.liquidity.v1beta1.Position")
+	proto.RegisterType((*ConditionalOrder)(nil), "squad.liquidity.v1beta1.ConditionalOrder")
+	proto.RegisterType((*RoutedSwapRequest)(nil), "squad.liquidity.v1beta1.RoutedSwapRequest")
+	proto.RegisterType((*TradeRecord)(nil), "squad.liquidity.v1beta1.TradeRecord")
+	proto.RegisterType((*Candle)(nil), "squad.liquidity.v1beta1.Candle")
+	proto.RegisterType((*PriceAccumulator)(nil), "squad.liquidity.v1beta1.PriceAccumulator")
 }
 
 func init() {
@@ -584,132 +1041,200 @@ func init() {
 }
 
 var fileDescriptor_8256f3e2df6bc8b8 = []byte{
-	// 1994 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4b, 0x6f, 0x1b, 0xd7,
-	0xf5, 0x17, 0x1f, 0xa2, 0xc8, 0x23, 0xf1, 0xa1, 0x6b, 0xc9, 0x1a, 0xd1, 0x0e, 0xc5, 0x08, 0xff,
-	0xd8, 0x82, 0x81, 0x50, 0x89, 0xfe, 0x4d, 0xd3, 0x02, 0xae, 0x01, 0x8a, 0x1c, 0x29, 0x44, 0x49,
-	0x89, 0x1e, 0x52, 0x6d, 0x1c, 0x14, 0x1d, 0x5c, 0xcd, 0x5c, 0x51, 0x17, 0x9a, 0x97, 0x67, 0x86,
-	0x96, 0x94, 0x55, 0x97, 0x05, 0x57, 0x59, 0x15, 0xdd, 0x70, 0xd3, 0x6e, 0x8a, 0x7e, 0x82, 0x2e,
-	0xba, 0xe9, 0xce, 0xcb, 0x2c, 0x8b, 0x2e, 0x92, 0xd6, 0x5e, 0x74, 0x55, 0xf4, 0x2b, 0x14, 0xf7,
-	0xde, 0x99, 0xe1, 0x90, 0x7e, 0xc0, 0x22, 0xe2, 0x95, 0x34, 0x67, 0xce, 0xef, 0x77, 0xee, 0x79,
-	0xde, 0x33, 0x84, 0xfb, 0xde, 0xd3, 0x21, 0xd6, 0x77, 0x0d, 0xfa, 0x74, 0x48, 0x75, 0xea, 0x5f,
-	0xef, 0x3e, 0xfb, 0xf4, 0x94, 0xf8, 0xf8, 0xd3, 0x89, 0xa4, 0xe6, 0xb8, 0xb6, 0x6f, 0xa3, 0x0d,
-	0xae, 0x58, 0x9b, 0x88, 0x03, 0xc5, 0xf2, 0xda, 0xc0, 0x1e, 0xd8, 0x5c, 0x67, 0x97, 0xfd, 0x27,
-	0xd4, 0xcb, 0x15, 0xcd, 0xf6, 0x4c, 0xdb, 0xdb, 0x3d, 0xc5, 0x1e, 0x89, 0x38, 0x35, 0x9b, 0x5a,
-	0xc1, 0xfb, 0xad, 0x81, 0x6d, 0x0f, 0x0c, 0xb2, 0xcb, 0x9f, 0x4e, 0x87, 0x67, 0xbb, 0x3e, 0x35,
-	0x89, 0xe7, 0x63, 0xd3, 0x09, 0x09, 0x66, 0x15, 0xf4, 0xa1, 0x8b, 0x7d, 0x6a, 0x07, 0x04, 0xdb,
-	0xcf, 0x01, 0x32, 0x5d, 0xec, 0x62, 0xd3, 0x43, 0x1f, 0x00, 0x9c, 0x62, 0x5f, 0x3b, 0x57, 0x3d,
-	0xfa, 0x35, 0x91, 0x12, 0xd5, 0xc4, 0x4e, 0x5e, 0xc9, 0x71, 0x49, 0x8f, 0x7e, 0x4d, 0xd0, 0x47,
-	0x50, 0xf0, 0xa9, 0x76, 0xa1, 0x3a, 0x2e, 0xd1, 0xa8, 0x47, 0x6d, 0x4b, 0x4a, 0x72, 0x95, 0x3c,
-	0x93, 0x76, 0x43, 0x21, 0xda, 0x83, 0xf5, 0x33, 0x42, 0x54, 0xcd, 0x36, 0x0c, 0xa2, 0xf9, 0xb6,
-	0xab, 0x62, 0x5d, 0x77, 0x89, 0xe7, 0x49, 0xa9, 0x6a, 0x62, 0x27, 0xa7, 0xdc, 0x3a, 0x23, 0xa4,
-	0x11, 0xbe, 0xab, 0x8b, 0x57, 0xe8, 0x47, 0x70, 0x5b, 0x1f, 0x7a, 0xfe, 0x6b, 0x40, 0x69, 0x0e,
-	0x5a, 0x63, 0x6f, 0x5f, 0x41, 0x59, 0x70, 0xd7, 0xa4, 0x96, 0x4a, 0x2d, 0xea, 0x53, 0x6c, 0xa8,
-	0x8e, 0x6d, 0x1b, 0x2a, 0x0b, 0x8d, 0xea, 0x0d, 0x1d, 0xc7, 0xb8, 0x96, 0x16, 0x19, 0x76, 0xbf,
-	0xf6, 0xfc, 0xbb, 0xad, 0x85, 0x7f, 0x7c, 0xb7, 0x75, 0x6f, 0x40, 0xfd, 0xf3, 0xe1, 0x69, 0x4d,
-	0xb3, 0xcd, 0xdd, 0x20, 0xa8, 0xe2, 0xcf, 0xc7, 0x9e, 0x7e, 0xb1, 0xeb, 0x5f, 0x3b, 0xc4, 0xab,
-	0xb5, 0x2c, 0x5f, 0x91, 0x4c, 0x6a, 0xb5, 0x04, 0x65, 0xd7, 0xb6, 0x8d, 0x86, 0x4d, 0xad, 0x1e,
-	0xe7, 0x43, 0x97, 0xb0, 0xea, 0x60, 0xea, 0xaa, 0x9a, 0x4b, 0x78, 0x04, 0xd5, 0x33, 0x42, 0xa4,
-	0x4c, 0x35, 0xb5, 0xb3, 0xbc, 0xb7, 0x59, 0x13, 0x5c, 0x35, 0x96, 0xa7, 0x30, 0xa5, 0x35, 0x86,
-	0xdd, 0xff, 0x84, 0xd9, 0xff, 0xf3, 0xf7, 0x5b, 0x3b, 0xef, 0x60, 0x9f, 0x01, 0x3c, 0xa5, 0xc8,
-	0xac, 0x34, 0x02, 0x23, 0x07, 0x84, 0x70, 0xc3, 0xdc, 0xb9, 0xb8, 0xe1, 0xa5, 0xf7, 0x61, 0x98,
-	0x39, 0x1c, 0x33, 0x7c, 0x01, 0xe5, 0x78, 0x84, 0x75, 0xe2, 0xd8, 0x1e, 0xf5, 0x55, 0x6c, 0xda,
-	0x43, 0xcb, 0x97, 0xb2, 0x73, 0xc5, 0x77, 0x63, 0x12, 0xdf, 0xa6, 0xe0, 0xab, 0x73, 0x3a, 0x84,
-	0x61, 0xdd, 0xc4, 0x57, 0xaa, 0xe3, 0x52, 0x8d, 0xa8, 0x06, 0x35, 0xa9, 0xaf, 0xf2, 0x4a, 0x95,
-	0x72, 0x37, 0xb6, 0xd3, 0x24, 0x9a, 0x82, 0x4c, 0x7c, 0xd5, 0x65, 0x5c, 0x6d, 0x46, 0xa5, 0x30,
-	0x26, 0x74, 0x08, 0x1f, 0x32, 0x13, 0xd6, 0xd0, 0x54, 0x4d, 0xec, 0x5e, 0x10, 0x5f, 0x35, 0xf1,
-	0x05, 0xb5, 0x06, 0xaa, 0xed, 0xea, 0xc4, 0x55, 0x59, 0x21, 0x7b, 0x12, 0xf0, 0xaa, 0xbe, 0x6b,
-	0xe2, 0xab, 0xa3, 0xa1, 0xd9, 0xe1, 0x6a, 0x1d, 0xae, 0x75, 0xcc, 0x94, 0xfa, 0x4c, 0x07, 0x3d,
-	0x06, 0x46, 0x1f, 0xc0, 0x0c, 0x7a, 0x46, 0x3c, 0x07, 0x5b, 0xd2, 0x72, 0x35, 0xc1, 0x53, 0x22,
-	0x5a, 0xae, 0x16, 0xb6, 0x5c, 0xad, 0x19, 0xb4, 0xdc, 0x7e, 0x96, 0xf9, 0xf0, 0xfb, 0xef, 0xb7,
-	0x12, 0x4a, 0xc9, 0xc4, 0x57, 0x9c, 0xaf, 0x1d, 0x80, 0x91, 0x02, 0x79, 0xef, 0x12, 0x3b, 0x2c,
-	0xb7, 0xcc, 0x6f, 0x22, 0xad, 0xcc, 0xe5, 0xf6, 0x32, 0x23, 0x39, 0x20, 0x44, 0xc1, 0x3e, 0x41,
-	0x5f, 0xc1, 0xea, 0x25, 0xf5, 0xcf, 0x75, 0x17, 0x5f, 0x4e, 0x78, 0xf3, 0x73, 0xf1, 0x16, 0x43,
-	0xa2, 0x18, 0x77, 0x58, 0x0f, 0xe4, 0xca, 0x77, 0xb1, 0x3a, 0xc0, 0x9e, 0x54, 0xa8, 0x26, 0x76,
-	0xd2, 0x37, 0xe2, 0x3e, 0xc4, 0x9e, 0x52, 0x0c, 0x88, 0x64, 0xc6, 0x73, 0x88, 0x3d, 0xf4, 0x2b,
-	0x40, 0xd1, 0xb9, 0x27, 0xe4, 0xc5, 0xb9, 0xc8, 0x4b, 0x21, 0x53, 0xc4, 0xfe, 0x0b, 0x28, 0x8a,
-	0xc4, 0x4d, 0xa8, 0x4b, 0x73, 0x51, 0xe7, 0x39, 0x4d, 0xc8, 0xbb, 0xfd, 0xa7, 0x24, 0xa4, 0xbb,
-	0x98, 0xba, 0xa8, 0x00, 0x49, 0xaa, 0xf3, 0x01, 0x9a, 0x56, 0x92, 0x54, 0x47, 0xf7, 0xa0, 0xc8,
-	0xda, 0x53, 0x0c, 0x27, 0x9d, 0x58, 0xb6, 0xc9, 0x47, 0x67, 0x4e, 0xc9, 0x33, 0x31, 0xeb, 0xbd,
-	0x26, 0x13, 0xa2, 0x1d, 0x28, 0x3d, 0x1d, 0xda, 0xfe, 0x94, 0xa2, 0x98, 0x9a, 0x05, 0x2e, 0x9f,
-	0x68, 0x7e, 0x04, 0x05, 0xe2, 0x69, 0xae, 0x7d, 0x39, 0x33, 0x28, 0xf3, 0x42, 0x1a, 0x4e, 0xc8,
-	0x6d, 0xc8, 0x1b, 0xd8, 0xf3, 0x83, 0x3a, 0xa5, 0x3a, 0x1f, 0x89, 0x69, 0x65, 0x99, 0x09, 0x79,
-	0xf5, 0xb5, 0x74, 0xd4, 0x02, 0xe0, 0x3a, 0xbc, 0xef, 0xa4, 0x0c, 0x2f, 0x8e, 0x07, 0x37, 0x28,
-	0x8c, 0x1c, 0x43, 0xf3, 0x46, 0x63, 0xe7, 0xd7, 0x86, 0xae, 0x4b, 0x2c, 0x5f, 0x15, 0x17, 0x09,
-	0xd5, 0xa5, 0x25, 0x6e, 0xb1, 0x10, 0xc8, 0xf7, 0x99, 0xb8, 0xa5, 0x6f, 0xff, 0x37, 0x05, 0x69,
-	0x36, 0x5d, 0xd1, 0x67, 0x90, 0x66, 0x54, 0x3c, 0x58, 0x85, 0xbd, 0x0f, 0x6b, 0x6f, 0xb8, 0x1d,
-	0x6b, 0x4c, 0xb9, 0x7f, 0xed, 0x10, 0x85, 0xab, 0x07, 0x11, 0x4e, 0x46, 0x11, 0xde, 0x80, 0x25,
-	0x3e, 0x9a, 0xa9, 0xce, 0x03, 0x96, 0x56, 0x32, 0xec, 0xb1, 0xa5, 0x23, 0x09, 0x96, 0xf8, 0xd4,
-	0xb4, 0xdd, 0x20, 0x42, 0xe1, 0x23, 0xba, 0x0f, 0x45, 0x97, 0x78, 0xc4, 0x7d, 0x46, 0xa2, 0x18,
-	0x2e, 0x8a, 0x58, 0x07, 0xe2, 0x30, 0x88, 0xf7, 0xa0, 0x38, 0xb9, 0x5a, 0x44, 0x52, 0x32, 0x22,
-	0xd8, 0x4e, 0x70, 0x3f, 0x88, 0x9c, 0x1c, 0x42, 0x8e, 0x0d, 0x4b, 0x11, 0xc7, 0xa5, 0x1b, 0xc7,
-	0x31, 0x6b, 0x52, 0x4b, 0x84, 0x91, 0x11, 0x85, 0x83, 0x30, 0x18, 0xb2, 0x37, 0x23, 0x0a, 0x06,
-	0x1f, 0xfa, 0x0c, 0x36, 0x78, 0x6a, 0xc3, 0x3e, 0x75, 0xc9, 0xd3, 0x21, 0xf1, 0x7c, 0x16, 0xa5,
-	0x1c, 0x8f, 0xd2, 0x1a, 0x7b, 0x1d, 0x4c, 0x61, 0x45, 0xbc, 0x6c, 0xe9, 0xe8, 0x73, 0x90, 0x38,
-	0x2c, 0x6a, 0xc1, 0x18, 0x0e, 0x38, 0x6e, 0x9d, 0xbd, 0xff, 0x65, 0xf0, 0x7a, 0x02, 0x2c, 0x43,
-	0x56, 0xa7, 0x1e, 0x3e, 0x35, 0x88, 0xce, 0x67, 0x61, 0x56, 0x89, 0x9e, 0xb7, 0xff, 0x9d, 0x82,
-	0xc2, 0xb4, 0xa5, 0x57, 0xda, 0x84, 0x25, 0x91, 0x05, 0x3a, 0xca, 0x6c, 0x86, 0x3d, 0xb6, 0x74,
-	0xb6, 0x98, 0x98, 0xde, 0x40, 0x3d, 0x27, 0x74, 0x70, 0xee, 0xf3, 0x04, 0xa7, 0x94, 0x9c, 0xe9,
-	0x0d, 0xbe, 0xe0, 0x02, 0x74, 0x17, 0x72, 0x81, 0x87, 0x51, 0x96, 0x27, 0x02, 0xe4, 0x40, 0x3e,
-	0xf4, 0x9f, 0x65, 0x90, 0x65, 0xf9, 0x07, 0xbf, 0x38, 0x57, 0x02, 0x0b, 0xfc, 0x09, 0xb9, 0x50,
-	0xc0, 0x9a, 0x46, 0x1c, 0x9f, 0xe8, 0x81, 0xc9, 0xf7, 0xb0, 0x24, 0xe4, 0x43, 0x13, 0xc2, 0x66,
-	0x0b, 0x4a, 0x26, 0xb5, 0x98, 0xc5, 0xa8, 0x56, 0x79, 0x0d, 0xbe, 0xd5, 0x6a, 0x9a, 0x59, 0x55,
-	0x0a, 0x02, 0x18, 0x2e, 0x3b, 0xe8, 0x11, 0x64, 0x3c, 0x1f, 0xfb, 0x43, 0x8f, 0xd7, 0x5e, 0x61,
-	0xef, 0xde, 0x1b, 0x9b, 0x32, 0x48, 0x64, 0x8f, 0x6b, 0x2b, 0x01, 0x6a, 0xfb, 0x3f, 0x49, 0x28,
-	0xce, 0xd4, 0xc6, 0x0f, 0x96, 0xea, 0x0a, 0x40, 0x58, 0x95, 0x24, 0xcc, 0x75, 0x4c, 0x82, 0x1e,
-	0x42, 0x6e, 0xe2, 0xff, 0xe2, 0xbb, 0xf9, 0x9f, 0x0d, 0xdb, 0x18, 0xf9, 0x10, 0xdd, 0x72, 0xd6,
-	0xfb, 0xcb, 0x5c, 0x21, 0xb2, 0x21, 0x52, 0x37, 0x89, 0xf7, 0xd2, 0x5c, 0xf1, 0xfe, 0x6b, 0x06,
-	0x16, 0xf9, 0x30, 0x47, 0x3f, 0x9e, 0x1a, 0xa6, 0xdb, 0x6f, 0xe4, 0x11, 0x8b, 0xcc, 0x1c, 0xd3,
-	0x74, 0x3a, 0x3b, 0xe9, 0xd9, 0xec, 0x48, 0xb0, 0xc4, 0x6f, 0x1a, 0xe2, 0x06, 0xa3, 0x34, 0x7c,
-	0x44, 0x32, 0xe4, 0x74, 0xea, 0x12, 0x8d, 0x6d, 0x41, 0x7c, 0x7a, 0x16, 0xf6, 0xee, 0xbf, 0xfd,
-	0x78, 0xcd, 0x50, 0x5d, 0x99, 0x20, 0xd1, 0x23, 0x00, 0xfb, 0xec, 0x8c, 0xb8, 0x37, 0xaa, 0xef,
-	0x1c, 0x87, 0xf0, 0x04, 0x3f, 0x86, 0x35, 0x97, 0x98, 0x98, 0x5a, 0x7c, 0xe7, 0x9b, 0x30, 0x65,
-	0xdf, 0x8d, 0x09, 0x45, 0xe0, 0xe3, 0x88, 0xb2, 0x09, 0x79, 0x97, 0x68, 0x84, 0x3e, 0x0b, 0x9a,
-	0x9d, 0x4f, 0xd6, 0x77, 0xe0, 0x5a, 0x09, 0x51, 0x01, 0xcb, 0xa2, 0x18, 0xf7, 0x30, 0xd7, 0x72,
-	0x26, 0xc0, 0xe8, 0x00, 0x32, 0xc1, 0x6a, 0xbe, 0x3c, 0xd7, 0x6a, 0x1e, 0xa0, 0xd1, 0x31, 0x2c,
-	0xdb, 0x0e, 0xb1, 0xc2, 0x3d, 0x7f, 0x65, 0x2e, 0x32, 0x60, 0x14, 0xc1, 0x6a, 0xbf, 0x09, 0xd9,
-	0x68, 0x21, 0xc8, 0xf3, 0x8a, 0x5a, 0x3a, 0x15, 0x9b, 0x00, 0xaa, 0x43, 0x8e, 0x5c, 0x39, 0xd4,
-	0x25, 0x2a, 0xf6, 0xf9, 0xfa, 0xb8, 0xbc, 0x57, 0x7e, 0x65, 0x81, 0xee, 0x87, 0x1f, 0xb5, 0x62,
-	0x83, 0xfe, 0x86, 0x6d, 0xd0, 0x59, 0x01, 0xab, 0xfb, 0xe8, 0x61, 0xd4, 0x40, 0x45, 0x5e, 0x59,
-	0xff, 0xf7, 0xf6, 0xca, 0x9a, 0x69, 0x9f, 0x5f, 0xc3, 0x4a, 0xa7, 0x23, 0x96, 0x21, 0x4b, 0x27,
-	0x57, 0xf1, 0x22, 0x4e, 0x4c, 0x17, 0x71, 0xac, 0x2d, 0x92, 0x53, 0x6d, 0x71, 0x07, 0x72, 0xe1,
-	0x86, 0xc5, 0x3e, 0x73, 0x53, 0x3b, 0x69, 0x25, 0x6b, 0x8b, 0xf5, 0xca, 0x7b, 0xf0, 0xbb, 0x04,
-	0x64, 0xc3, 0xed, 0x85, 0x7d, 0x1c, 0x77, 0x8f, 0x8f, 0xdb, 0x6a, 0xff, 0x49, 0x57, 0x56, 0x4f,
-	0x8e, 0x7a, 0x5d, 0xb9, 0xd1, 0x3a, 0x68, 0xc9, 0xcd, 0xd2, 0x42, 0x79, 0x63, 0x34, 0xae, 0xde,
-	0x0a, 0x15, 0x4f, 0x2c, 0xcf, 0x21, 0x1a, 0x3d, 0xa3, 0x84, 0x6f, 0x8f, 0x13, 0xcc, 0x7e, 0xbd,
-	0xd7, 0x6a, 0x94, 0x12, 0xe5, 0xd5, 0xd1, 0xb8, 0x9a, 0x0f, 0xb5, 0xf7, 0xb1, 0x47, 0x35, 0xb6,
-	0x7d, 0x4d, 0xf4, 0x94, 0xfa, 0xd1, 0xa1, 0xdc, 0x2c, 0x25, 0xcb, 0x68, 0x34, 0xae, 0x16, 0xa2,
-	0xed, 0x09, 0x5b, 0x03, 0xa2, 0x97, 0xd3, 0xbf, 0xfd, 0x63, 0x65, 0xe1, 0xc1, 0xdf, 0x12, 0x90,
-	0x8b, 0x26, 0x01, 0xfb, 0x04, 0x3f, 0x56, 0x9a, 0xb2, 0xf2, 0xba, 0xa3, 0x49, 0xa3, 0x71, 0x75,
-	0x2d, 0x52, 0x8d, 0x9f, 0x6d, 0x07, 0x4a, 0x31, 0x54, 0xbb, 0xd5, 0x69, 0xf5, 0x4b, 0x09, 0x61,
-	0x33, 0xd2, 0xe7, 0xdf, 0x5f, 0xe8, 0x01, 0xac, 0xc6, 0x34, 0x3b, 0x75, 0xe5, 0xe7, 0x72, 0xbf,
-	0x94, 0x2c, 0xdf, 0x1a, 0x8d, 0xab, 0xc5, 0x48, 0x55, 0x7c, 0x6d, 0xb1, 0xb5, 0x35, 0xae, 0xdb,
-	0x29, 0xa5, 0xca, 0xc5, 0xd1, 0xb8, 0xba, 0x3c, 0xd1, 0xeb, 0x04, 0x3e, 0xfc, 0x25, 0x01, 0x85,
-	0xe9, 0x71, 0x81, 0x1e, 0xc1, 0x1d, 0x01, 0x6e, 0xb6, 0x14, 0xb9, 0xd1, 0x6f, 0x1d, 0x1f, 0xcd,
-	0x78, 0xf3, 0xc1, 0x68, 0x5c, 0xdd, 0x9c, 0x06, 0xc5, 0x5d, 0xaa, 0xc1, 0xad, 0x59, 0xfc, 0xfe,
-	0xc9, 0x93, 0x52, 0xa2, 0xbc, 0x3e, 0x1a, 0x57, 0x57, 0xa7, 0x71, 0xfb, 0xc3, 0x6b, 0xf4, 0x09,
-	0xac, 0xcd, 0xea, 0xf7, 0xe4, 0x76, 0xbb, 0x94, 0x2c, 0xdf, 0x1e, 0x8d, 0xab, 0x68, 0x1a, 0xd0,
-	0x23, 0x86, 0x11, 0x1c, 0xfd, 0x37, 0x49, 0xc8, 0x4f, 0x0d, 0x74, 0xf4, 0x10, 0xca, 0x8a, 0xfc,
-	0xf8, 0x44, 0xee, 0xf5, 0xd5, 0x5e, 0xbf, 0xde, 0x3f, 0xe9, 0xcd, 0x1c, 0xfc, 0xee, 0x68, 0x5c,
-	0x95, 0xa6, 0x20, 0xf1, 0x73, 0xff, 0x0c, 0xee, 0xcc, 0xa0, 0x8f, 0x8e, 0xfb, 0xaa, 0xfc, 0xa5,
-	0xdc, 0x38, 0xe9, 0xcb, 0xcd, 0x52, 0xe2, 0x35, 0xf0, 0x23, 0xdb, 0x97, 0xaf, 0x88, 0x36, 0xf4,
-	0x89, 0x8e, 0x7e, 0x02, 0xd2, 0x0c, 0xbc, 0x77, 0xd2, 0x68, 0xc8, 0x72, 0x93, 0x57, 0x51, 0x79,
-	0x34, 0xae, 0xde, 0x9e, 0xc2, 0xf6, 0x86, 0x9a, 0x46, 0x88, 0x4e, 0x74, 0x56, 0xd3, 0x33, 0xc8,
-	0x83, 0x7a, 0xab, 0x2d, 0x37, 0x4b, 0x29, 0x51, 0xd3, 0x53, 0xb0, 0x03, 0x4c, 0x8d, 0xa8, 0x02,
-	0xff, 0x90, 0x82, 0xe5, 0x58, 0x4b, 0xb2, 0x33, 0x88, 0x50, 0xbe, 0xd6, 0x7d, 0x7e, 0x86, 0x98,
-	0x7a, 0xdc, 0xf9, 0x9f, 0xc2, 0xe6, 0x14, 0x72, 0xc6, 0xf5, 0x59, 0x68, 0xdc, 0xf1, 0xcf, 0x67,
-	0x8c, 0x32, 0x68, 0xa7, 0xde, 0x6f, 0x7c, 0xc1, 0x1d, 0xdf, 0x1c, 0x8d, 0xab, 0xeb, 0xd3, 0xc8,
-	0x0e, 0x9b, 0x5c, 0x44, 0x47, 0x0d, 0xa8, 0x4c, 0x01, 0xbb, 0x75, 0xa5, 0xdf, 0xaa, 0xb7, 0xdb,
-	0x4f, 0x22, 0x78, 0xaa, 0xbc, 0x35, 0x1a, 0x57, 0xef, 0xc4, 0xe0, 0x5d, 0xec, 0xfa, 0x14, 0x1b,
-	0xc6, 0x75, 0x48, 0x12, 0xb5, 0x5d, 0x40, 0xd2, 0x38, 0xee, 0x74, 0xdb, 0x32, 0x3b, 0x75, 0x3a,
-	0xd6, 0x76, 0x02, 0xdc, 0xb0, 0x4d, 0xc7, 0x20, 0xbe, 0x08, 0xf9, 0x34, 0xaa, 0x7e, 0xd4, 0x90,
-	0x59, 0xc8, 0x17, 0x45, 0xc8, 0xe3, 0x20, 0x6c, 0x69, 0xc4, 0x20, 0xfa, 0xa4, 0x4e, 0x03, 0x8c,
-	0xfc, 0x65, 0xb7, 0xa5, 0xc8, 0xcd, 0x52, 0x26, 0x56, 0xa7, 0x02, 0x22, 0xf3, 0xc1, 0x1a, 0x24,
-	0x69, 0xbf, 0xfb, 0xfc, 0x5f, 0x95, 0x85, 0xe7, 0x2f, 0x2a, 0x89, 0x6f, 0x5f, 0x54, 0x12, 0xff,
-	0x7c, 0x51, 0x49, 0x7c, 0xf3, 0xb2, 0xb2, 0xf0, 0xed, 0xcb, 0xca, 0xc2, 0xdf, 0x5f, 0x56, 0x16,
-	0xbe, 0xda, 0x7b, 0xe5, 0x36, 0x60, 0xa3, 0xf7, 0x63, 0x03, 0x9f, 0x7a, 0xbb, 0xe2, 0x27, 0xd1,
-	0xab, 0xd8, 0x8f, 0xa2, 0xfc, 0x76, 0x38, 0xcd, 0xf0, 0xb9, 0xfe, 0xff, 0xff, 0x0b, 0x00, 0x00,
-	0xff, 0xff, 0x1a, 0x69, 0xab, 0x91, 0x34, 0x15, 0x00, 0x00,
+	// 3078 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0x4b, 0x6f, 0x23, 0xc7,
+	0xb5, 0x16, 0x1f, 0xa2, 0xc8, 0x43, 0xf1, 0xa1, 0xd2, 0x63, 0x38, 0x9c, 0xb1, 0x44, 0xf3, 0xda,
+	0x63, 0x61, 0x00, 0x4b, 0xf6, 0x5c, 0xfb, 0xda, 0x06, 0x7c, 0x8d, 0x4b, 0x91, 0x94, 0xdc, 0x57,
+	0xa4, 0xc8, 0x69, 0x52, 0xb6, 0xc7, 0x48, 0xd2, 0x69, 0x75, 0x97, 0xa4, 0xc2, 0xf4, 0x83, 0xd3,
+	0xdd, 0x1c, 0x49, 0xde, 0x24, 0xcb, 0x80, 0x08, 0x10, 0x6f, 0x02, 0x64, 0xc3, 0x4d, 0xb2, 0xcb,
+	0x2f, 0xc8, 0x22, 0x9b, 0x2c, 0x02, 0xcc, 0xd2, 0x40, 0x36, 0x41, 0x90, 0xd8, 0xb1, 0xbd, 0xc8,
+	0x22, 0xc8, 0x26, 0xab, 0x6c, 0x02, 0x04, 0x55, 0xd5, 0x4f, 0x8e, 0x34, 0x96, 0x38, 0x9a, 0xd5,
+	0x4c, 0x57, 0x9d, 0xf3, 0x55, 0xd5, 0x39, 0xdf, 0x79, 0x54, 0x51, 0xf0, 0x9a, 0xfd, 0x68, 0x28,
+	0xab, 0x9b, 0x1a, 0x79, 0x34, 0x24, 0x2a, 0x71, 0xce, 0x36, 0x1f, 0xbf, 0x79, 0x80, 0x1d, 0xf9,
+	0xcd, 0x60, 0x64, 0x63, 0x60, 0x99, 0x8e, 0x89, 0x6e, 0x30, 0xc1, 0x8d, 0x60, 0xd8, 0x15, 0x2c,
+	0x2f, 0x1d, 0x99, 0x47, 0x26, 0x93, 0xd9, 0xa4, 0xff, 0xe3, 0xe2, 0xe5, 0x55, 0xc5, 0xb4, 0x75,
+	0xd3, 0xde, 0x3c, 0x90, 0x6d, 0xec, 0x63, 0x2a, 0x26, 0x31, 0xdc, 0xf9, 0xb5, 0x23, 0xd3, 0x3c,
+	0xd2, 0xf0, 0x26, 0xfb, 0x3a, 0x18, 0x1e, 0x6e, 0x3a, 0x44, 0xc7, 0xb6, 0x23, 0xeb, 0x03, 0x0f,
+	0x60, 0x52, 0x40, 0x1d, 0x5a, 0xb2, 0x43, 0x4c, 0x17, 0xa0, 0xfa, 0xdb, 0x02, 0xa4, 0xba, 0xb2,
+	0x25, 0xeb, 0x36, 0x7a, 0x09, 0xe0, 0x40, 0x76, 0x94, 0x63, 0xc9, 0x26, 0x9f, 0xe1, 0x52, 0xac,
+	0x12, 0x5b, 0xcf, 0x89, 0x19, 0x36, 0xd2, 0x23, 0x9f, 0x61, 0xf4, 0x2a, 0xe4, 0x1d, 0xa2, 0x3c,
+	0x94, 0x06, 0x16, 0x56, 0x88, 0x4d, 0x4c, 0xa3, 0x14, 0x67, 0x22, 0x39, 0x3a, 0xda, 0xf5, 0x06,
+	0xd1, 0x3d, 0x58, 0x3e, 0xc4, 0x58, 0x52, 0x4c, 0x4d, 0xc3, 0x8a, 0x63, 0x5a, 0x92, 0xac, 0xaa,
+	0x16, 0xb6, 0xed, 0x52, 0xa2, 0x12, 0x5b, 0xcf, 0x88, 0x8b, 0x87, 0x18, 0xd7, 0xbd, 0xb9, 0x1a,
+	0x9f, 0x42, 0x6f, 0xc1, 0x8a, 0x3a, 0xb4, 0x9d, 0x73, 0x94, 0x92, 0x4c, 0x69, 0x89, 0xce, 0x3e,
+	0xa5, 0x65, 0xc0, 0x6d, 0x9d, 0x18, 0x12, 0x31, 0x88, 0x43, 0x64, 0x4d, 0x1a, 0x98, 0xa6, 0x26,
+	0x51, 0xd3, 0x48, 0xf6, 0x70, 0x30, 0xd0, 0xce, 0x4a, 0xb3, 0x54, 0x77, 0x6b, 0xe3, 0xc9, 0x97,
+	0x6b, 0x33, 0x7f, 0xfa, 0x72, 0xed, 0xce, 0x11, 0x71, 0x8e, 0x87, 0x07, 0x1b, 0x8a, 0xa9, 0x6f,
+	0xba, 0x46, 0xe5, 0xff, 0xbc, 0x6e, 0xab, 0x0f, 0x37, 0x9d, 0xb3, 0x01, 0xb6, 0x37, 0x04, 0xc3,
+	0x11, 0x4b, 0x3a, 0x31, 0x04, 0x0e, 0xd9, 0x35, 0x4d, 0xad, 0x6e, 0x12, 0xa3, 0xc7, 0xf0, 0xd0,
+	0x09, 0x2c, 0x0c, 0x64, 0x62, 0x49, 0x8a, 0x85, 0x99, 0x05, 0xa5, 0x43, 0x8c, 0x4b, 0xa9, 0x4a,
+	0x62, 0x3d, 0x7b, 0xef, 0xe6, 0x06, 0xc7, 0xda, 0xa0, 0x7e, 0xf2, 0x5c, 0xba, 0x41, 0x75, 0xb7,
+	0xde, 0xa0, 0xeb, 0xff, 0xfa, 0xab, 0xb5, 0xf5, 0x4b, 0xac, 0x4f, 0x15, 0x6c, 0xb1, 0x40, 0x57,
+	0xa9, 0xbb, 0x8b, 0x6c, 0x63, 0xcc, 0x16, 0x66, 0x87, 0x0b, 0x2f, 0x3c, 0xf7, 0x22, 0x16, 0xa6,
+	0x07, 0x0e, 0x2d, 0xfc, 0x10, 0xca, 0x61, 0x0b, 0xab, 0x78, 0x60, 0xda, 0xc4, 0x91, 0x64, 0xdd,
+	0x1c, 0x1a, 0x4e, 0x29, 0x3d, 0x95, 0x7d, 0x6f, 0x04, 0xf6, 0x6d, 0x70, 0xbc, 0x1a, 0x83, 0x43,
+	0x32, 0x2c, 0xeb, 0xf2, 0xa9, 0x34, 0xb0, 0x88, 0x82, 0x25, 0x8d, 0xe8, 0xc4, 0x91, 0x18, 0x53,
+	0x4b, 0x99, 0x2b, 0xaf, 0xd3, 0xc0, 0x8a, 0x88, 0x74, 0xf9, 0xb4, 0x4b, 0xb1, 0x5a, 0x14, 0x4a,
+	0xa4, 0x48, 0x68, 0x07, 0x5e, 0xa6, 0x4b, 0x18, 0x43, 0x5d, 0xd2, 0x65, 0xeb, 0x21, 0x76, 0x24,
+	0x5d, 0x7e, 0x48, 0x8c, 0x23, 0xc9, 0xb4, 0x54, 0x6c, 0x49, 0x94, 0xc8, 0x76, 0x09, 0x18, 0xab,
+	0x6f, 0xeb, 0xf2, 0xe9, 0xde, 0x50, 0x6f, 0x33, 0xb1, 0x36, 0x93, 0xea, 0x50, 0xa1, 0x3e, 0x95,
+	0x41, 0xf7, 0x81, 0xc2, 0xbb, 0x6a, 0x1a, 0x39, 0xc4, 0xf6, 0x40, 0x36, 0x4a, 0xd9, 0x4a, 0x8c,
+	0xb9, 0x84, 0x87, 0xdc, 0x86, 0x17, 0x72, 0x1b, 0x0d, 0x37, 0xe4, 0xb6, 0xd2, 0xf4, 0x0c, 0xbf,
+	0xf8, 0x6a, 0x2d, 0x26, 0x16, 0x75, 0xf9, 0x94, 0xe1, 0xb5, 0x5c, 0x65, 0x24, 0x42, 0xce, 0x3e,
+	0x91, 0x07, 0xd4, 0xb7, 0xf4, 0xdc, 0xb8, 0x34, 0x3f, 0xd5, 0xb1, 0xb3, 0x14, 0x64, 0x1b, 0x63,
+	0x51, 0x76, 0x30, 0xfa, 0x14, 0x16, 0x4e, 0x88, 0x73, 0xac, 0x5a, 0xf2, 0x49, 0x80, 0x9b, 0x9b,
+	0x0a, 0xb7, 0xe0, 0x01, 0x85, 0xb0, 0x3d, 0x3e, 0xe0, 0x53, 0xc7, 0x92, 0xa5, 0x23, 0xd9, 0x2e,
+	0xe5, 0x2b, 0xb1, 0xf5, 0xe4, 0x95, 0xb0, 0x77, 0x64, 0x5b, 0x2c, 0xb8, 0x40, 0x4d, 0x8a, 0xb3,
+	0x23, 0xdb, 0xe8, 0x7b, 0x80, 0xfc, 0x7d, 0x07, 0xe0, 0x85, 0xa9, 0xc0, 0x8b, 0x1e, 0x92, 0x8f,
+	0xfe, 0x11, 0x14, 0xb8, 0xe3, 0x02, 0xe8, 0xe2, 0x54, 0xd0, 0x39, 0x06, 0xe3, 0xe3, 0xbe, 0x05,
+	0x2b, 0x8e, 0x25, 0xab, 0x58, 0xb2, 0xb0, 0x62, 0x5a, 0xaa, 0x64, 0x61, 0x07, 0x1b, 0xd4, 0xef,
+	0xa5, 0x05, 0x46, 0xa9, 0x25, 0x36, 0x2b, 0xb2, 0x49, 0xd1, 0x9b, 0x43, 0xbb, 0x50, 0xa0, 0x54,
+	0x72, 0xa8, 0xef, 0x4f, 0x88, 0xa1, 0x9a, 0x27, 0x25, 0x74, 0x79, 0x1e, 0xe5, 0x74, 0xf9, 0xb4,
+	0x7f, 0x22, 0x0f, 0x3e, 0x66, 0x9a, 0x48, 0x81, 0x15, 0x59, 0xd3, 0xcc, 0x13, 0xac, 0x4a, 0x11,
+	0x32, 0xd9, 0xa5, 0xc5, 0x4a, 0x62, 0x0a, 0xaf, 0x2f, 0xba, 0x68, 0xbd, 0x80, 0x54, 0x36, 0xfa,
+	0x3e, 0x2c, 0xb2, 0x74, 0x14, 0x5e, 0x81, 0x98, 0xa5, 0xa5, 0xa9, 0x78, 0x55, 0xa4, 0x50, 0x01,
+	0x3c, 0x31, 0x51, 0x1f, 0xf2, 0xba, 0xfc, 0x10, 0x5b, 0x01, 0x63, 0x97, 0xa7, 0x42, 0x9e, 0x67,
+	0x28, 0x1e, 0x5d, 0xfb, 0x90, 0x77, 0xa2, 0xa8, 0x2b, 0xd3, 0xa1, 0x3a, 0x61, 0xd4, 0x1f, 0xc1,
+	0x32, 0x63, 0x2e, 0x4d, 0xca, 0x91, 0xec, 0x7c, 0xe3, 0xfa, 0xb3, 0xf3, 0xa2, 0xb7, 0x52, 0x28,
+	0x43, 0x57, 0xff, 0x15, 0x87, 0x64, 0x57, 0x26, 0x16, 0xca, 0x43, 0x9c, 0xa8, 0xac, 0x68, 0x27,
+	0xc5, 0x38, 0x51, 0xd1, 0x1d, 0x28, 0xd0, 0x45, 0x79, 0x41, 0x54, 0xb1, 0x61, 0xea, 0xac, 0x5c,
+	0x67, 0xc4, 0x1c, 0x1d, 0xa6, 0x88, 0x0d, 0x3a, 0x88, 0xd6, 0xa1, 0xf8, 0x68, 0x68, 0x3a, 0x11,
+	0x41, 0x5e, 0xa9, 0xf3, 0x6c, 0x3c, 0x90, 0x7c, 0x15, 0xf2, 0xd8, 0x56, 0x2c, 0xf3, 0x64, 0xa2,
+	0x38, 0xe7, 0xf8, 0xa8, 0x57, 0x95, 0xab, 0x90, 0xd3, 0x64, 0xdb, 0x71, 0x73, 0x23, 0x51, 0x59,
+	0x19, 0x4e, 0x8a, 0x59, 0x3a, 0xc8, 0x32, 0x9e, 0xa0, 0x22, 0x01, 0x80, 0xc9, 0xb0, 0x5c, 0x5f,
+	0x4a, 0x31, 0x47, 0xdc, 0xbd, 0x82, 0x13, 0x32, 0x54, 0x9b, 0x25, 0x77, 0xba, 0x7f, 0x65, 0x68,
+	0x59, 0xd8, 0x70, 0x24, 0xde, 0xbc, 0x10, 0xb5, 0x34, 0xc7, 0x56, 0xcc, 0xbb, 0xe3, 0x5b, 0x74,
+	0x58, 0x50, 0xd1, 0xde, 0x64, 0x82, 0x4d, 0x5f, 0x79, 0xdd, 0x70, 0x72, 0xad, 0xfe, 0x3c, 0x09,
+	0x49, 0xda, 0x21, 0xa0, 0xb7, 0x21, 0x49, 0x45, 0x98, 0xf1, 0xf3, 0xf7, 0x5e, 0xde, 0xb8, 0xa0,
+	0xc3, 0xdb, 0xa0, 0xc2, 0xfd, 0xb3, 0x01, 0x16, 0x99, 0xb8, 0xeb, 0xb1, 0xb8, 0xef, 0xb1, 0x1b,
+	0x30, 0xc7, 0xda, 0x0b, 0xa2, 0x32, 0x07, 0x24, 0xc5, 0x14, 0xfd, 0x14, 0x54, 0x54, 0x82, 0x39,
+	0xc6, 0x2d, 0xd3, 0x72, 0x2d, 0xee, 0x7d, 0xa2, 0xd7, 0xa0, 0x60, 0x61, 0x1b, 0x5b, 0x8f, 0xb1,
+	0xef, 0x93, 0x59, 0xee, 0x3b, 0x77, 0xd8, 0x73, 0xca, 0x1d, 0x28, 0x04, 0xed, 0x11, 0x77, 0x72,
+	0x8a, 0x3b, 0x6f, 0xe0, 0xf6, 0x38, 0xdc, 0xc7, 0x3b, 0x90, 0xa1, 0x05, 0x9f, 0xfb, 0x65, 0xee,
+	0xca, 0xf6, 0x49, 0xeb, 0xc4, 0xe0, 0x6e, 0xa1, 0x40, 0x5e, 0x31, 0x9f, 0xc2, 0xd0, 0x69, 0xaf,
+	0x78, 0xa3, 0xb7, 0xe1, 0x06, 0xa3, 0x8a, 0x57, 0x6b, 0x2c, 0xfc, 0x68, 0x88, 0x6d, 0x87, 0x5a,
+	0x29, 0xc3, 0xac, 0xb4, 0x44, 0xa7, 0xdd, 0x4e, 0x42, 0xe4, 0x93, 0x82, 0x8a, 0xde, 0x81, 0x12,
+	0x53, 0xf3, 0xcb, 0x48, 0x48, 0x0f, 0x98, 0xde, 0x32, 0x9d, 0xff, 0xd8, 0x9d, 0x0e, 0x14, 0xcb,
+	0x90, 0x56, 0x89, 0x2d, 0x1f, 0x68, 0x58, 0x65, 0xf5, 0x3c, 0x2d, 0xfa, 0xdf, 0xe8, 0x15, 0xc8,
+	0xc9, 0xfa, 0x40, 0x23, 0x87, 0x44, 0x61, 0x01, 0xc8, 0x4a, 0x74, 0x52, 0x8c, 0x0e, 0x56, 0xff,
+	0x96, 0x80, 0x7c, 0x74, 0x3f, 0x4f, 0x05, 0x27, 0x75, 0x35, 0x75, 0x87, 0xef, 0xff, 0x14, 0xfd,
+	0x14, 0x54, 0xda, 0x82, 0xeb, 0xf6, 0x91, 0x74, 0x8c, 0xc9, 0xd1, 0xb1, 0xc3, 0x68, 0x90, 0x10,
+	0x33, 0xba, 0x7d, 0xf4, 0x21, 0x1b, 0x40, 0xb7, 0x21, 0xe3, 0xda, 0xc1, 0xe7, 0x42, 0x30, 0x80,
+	0x06, 0x90, 0xf3, 0xac, 0x44, 0xfd, 0x4c, 0xb9, 0x70, 0xed, 0x49, 0x68, 0xde, 0x5d, 0x81, 0x7d,
+	0x21, 0x0b, 0xf2, 0xb2, 0xa2, 0xe0, 0x81, 0x83, 0x55, 0x77, 0xc9, 0x17, 0xd0, 0x0e, 0xe7, 0xbc,
+	0x25, 0xf8, 0x9a, 0x02, 0x14, 0x75, 0x62, 0xd0, 0x15, 0x7d, 0x46, 0x33, 0xa6, 0x3e, 0x73, 0xd5,
+	0x24, 0x5d, 0x55, 0xcc, 0x73, 0x45, 0xaf, 0xad, 0x47, 0x1f, 0x40, 0xca, 0x76, 0x64, 0x67, 0x68,
+	0x33, 0x86, 0xe6, 0xef, 0xdd, 0xb9, 0x30, 0x74, 0x5d, 0x47, 0xf6, 0x98, 0xb4, 0xe8, 0x6a, 0x55,
+	0xff, 0x11, 0x87, 0xc2, 0x04, 0x83, 0xae, 0xcd, 0xd5, 0xab, 0x00, 0x1e, 0x77, 0xb1, 0xe7, 0xeb,
+	0xd0, 0x08, 0x7a, 0x1f, 0x32, 0xc1, 0xf9, 0x67, 0x2f, 0x77, 0xfe, 0xb4, 0x17, 0xec, 0xc8, 0x01,
+	0xbf, 0x9f, 0x33, 0x5e, 0x9c, 0xe7, 0xf2, 0xfe, 0x1a, 0xdc, 0x75, 0x81, 0xbd, 0xe7, 0xa6, 0xb2,
+	0xf7, 0x3f, 0xe7, 0x60, 0x96, 0x95, 0x10, 0xf4, 0x3f, 0x91, 0x94, 0x5b, 0xbd, 0x10, 0x87, 0xb7,
+	0xec, 0x53, 0xe4, 0xdc, 0xa8, 0x77, 0x92, 0x93, 0xde, 0x29, 0xc1, 0x1c, 0xab, 0x6f, 0xd8, 0x72,
+	0x13, 0xae, 0xf7, 0x89, 0x9a, 0x90, 0x51, 0x89, 0x85, 0x15, 0x96, 0x1f, 0x52, 0x6c, 0x7b, 0xaf,
+	0x3d, 0x7b, 0x7b, 0x0d, 0x4f, 0x5c, 0x0c, 0x34, 0xd1, 0x07, 0x00, 0xe6, 0xe1, 0x21, 0xb6, 0xae,
+	0xc4, 0xef, 0x0c, 0x53, 0x61, 0x0e, 0xbe, 0x0f, 0x4b, 0x16, 0xd6, 0x65, 0x62, 0xb0, 0xdb, 0x4d,
+	0x80, 0x94, 0xbe, 0x1c, 0x12, 0xf2, 0x95, 0x3b, 0x3e, 0x64, 0x03, 0x72, 0x16, 0x56, 0x30, 0x79,
+	0xec, 0x06, 0x3b, 0xcb, 0xbf, 0x97, 0xc0, 0x9a, 0xf7, 0xb4, 0x5c, 0x94, 0x59, 0x5e, 0x14, 0x60,
+	0xaa, 0xf6, 0x8b, 0x2b, 0xa3, 0x6d, 0x48, 0xb9, 0x97, 0xd0, 0xec, 0x54, 0x97, 0x50, 0x57, 0x1b,
+	0x75, 0x20, 0x6b, 0x0e, 0xb0, 0xe1, 0xdd, 0x68, 0xe7, 0xa7, 0x02, 0x03, 0x0a, 0xe1, 0x5e, 0x62,
+	0x6f, 0x42, 0xda, 0x6f, 0x43, 0x72, 0x8c, 0x51, 0x73, 0x07, 0x6e, 0xff, 0x51, 0x83, 0x0c, 0x3e,
+	0x1d, 0x10, 0x0b, 0x4b, 0xb2, 0xc3, 0x2e, 0x4a, 0xd9, 0x7b, 0xe5, 0xa7, 0x5a, 0xfc, 0xbe, 0xf7,
+	0x7c, 0xc3, 0x7b, 0xfc, 0xcf, 0x69, 0x8f, 0x9f, 0xe6, 0x6a, 0x35, 0x07, 0xbd, 0xef, 0x07, 0x50,
+	0x81, 0x31, 0xeb, 0x95, 0x67, 0x33, 0x2b, 0x1a, 0x3e, 0xe8, 0x43, 0xc8, 0x39, 0x44, 0xc7, 0x12,
+	0x31, 0xa4, 0x43, 0xd3, 0x52, 0x30, 0xbb, 0xf5, 0x3c, 0x0b, 0x84, 0x6e, 0x46, 0x30, 0xb6, 0xa9,
+	0xac, 0x98, 0x75, 0x82, 0x0f, 0x74, 0x8b, 0x26, 0x1f, 0xda, 0xe3, 0x19, 0xda, 0x19, 0xbb, 0xdc,
+	0xa4, 0x69, 0x6e, 0xb1, 0x9d, 0x8e, 0xa1, 0x9d, 0xa1, 0x36, 0x80, 0xac, 0x63, 0x43, 0xd5, 0xb1,
+	0xe1, 0xd8, 0x25, 0xc4, 0xd2, 0xca, 0x77, 0x84, 0x40, 0xcd, 0x93, 0x77, 0x29, 0x13, 0x02, 0xa8,
+	0x7e, 0x1d, 0x87, 0x7c, 0x54, 0x08, 0xad, 0x40, 0xca, 0x0d, 0xcc, 0x18, 0x0b, 0x4c, 0xf7, 0x0b,
+	0xdd, 0x85, 0x85, 0x81, 0x85, 0x1f, 0x13, 0x73, 0x68, 0x07, 0xed, 0x27, 0x0f, 0xf6, 0x82, 0x37,
+	0xe1, 0xb5, 0xa0, 0xfb, 0x90, 0xf7, 0x65, 0x39, 0x21, 0x13, 0x53, 0x11, 0x32, 0xe7, 0xa1, 0xf0,
+	0x76, 0xe5, 0x87, 0xb0, 0x14, 0x6c, 0x21, 0xc4, 0xac, 0xe4, 0x54, 0xcc, 0x42, 0xfe, 0xae, 0x03,
+	0x86, 0x89, 0xe0, 0x8f, 0x4a, 0x01, 0x9f, 0x66, 0xaf, 0xc0, 0xa7, 0xa2, 0xa7, 0xdf, 0x74, 0x79,
+	0x55, 0xfd, 0x01, 0xcc, 0xb7, 0xdb, 0xdc, 0x32, 0x86, 0x8a, 0x4f, 0xc3, 0xe9, 0x2d, 0x16, 0x4d,
+	0x6f, 0xa1, 0x84, 0x19, 0x8f, 0x24, 0xcc, 0x5b, 0x90, 0xf1, 0x4c, 0x6e, 0x97, 0x12, 0x95, 0xc4,
+	0x7a, 0x52, 0x4c, 0x9b, 0xdc, 0xd6, 0x76, 0xf5, 0xa7, 0x71, 0x48, 0x77, 0xdd, 0xdb, 0xcb, 0xb9,
+	0x15, 0xf2, 0x5c, 0xc8, 0x25, 0x98, 0x35, 0x4f, 0x0c, 0x6c, 0xb9, 0xf7, 0x11, 0xfe, 0x71, 0x5e,
+	0xcf, 0x9b, 0x3c, 0xb7, 0xe7, 0xdd, 0x0d, 0xf7, 0xb2, 0xb3, 0x53, 0x39, 0x37, 0xe8, 0x67, 0x77,
+	0xc3, 0xfd, 0x6c, 0x6a, 0x4a, 0x30, 0xb7, 0xa7, 0xad, 0xfe, 0x61, 0x16, 0x8a, 0x75, 0xd3, 0x50,
+	0x99, 0x3d, 0x64, 0x8d, 0x97, 0xb4, 0x4b, 0x9b, 0xe5, 0x3b, 0x1a, 0x87, 0x90, 0xef, 0x92, 0x51,
+	0xdf, 0xd5, 0xdc, 0xa2, 0x39, 0xcb, 0xc2, 0xfe, 0xf5, 0x0b, 0x43, 0x72, 0x72, 0x6b, 0xa1, 0xfa,
+	0x59, 0x03, 0x70, 0x9f, 0xca, 0x28, 0x50, 0xea, 0xd2, 0xd5, 0x97, 0x73, 0x83, 0xfe, 0x37, 0x5a,
+	0x20, 0xe7, 0xae, 0xa9, 0x40, 0xa6, 0xaf, 0x5c, 0x20, 0xef, 0xc2, 0x82, 0x8a, 0x75, 0xd9, 0x50,
+	0xc3, 0x77, 0x22, 0xf6, 0xd2, 0x28, 0x16, 0xf8, 0x44, 0x70, 0x2b, 0xea, 0x41, 0xce, 0xb1, 0xc8,
+	0xd1, 0x11, 0xb6, 0xa4, 0xe7, 0xa9, 0x5d, 0xf3, 0x2e, 0x08, 0x67, 0x94, 0x5f, 0x08, 0xb3, 0xd7,
+	0x53, 0x08, 0xe7, 0x9f, 0xab, 0x10, 0x46, 0x8a, 0x53, 0x6e, 0x9a, 0xe2, 0x54, 0xfd, 0x7d, 0x02,
+	0x16, 0x44, 0x73, 0xe8, 0xf0, 0xd7, 0xa2, 0x8b, 0xfa, 0xe1, 0x28, 0x7b, 0xe3, 0xcf, 0x60, 0x6f,
+	0x22, 0xca, 0xde, 0x9b, 0x90, 0x76, 0xe3, 0x81, 0x06, 0x3c, 0xcd, 0x2f, 0x73, 0x3c, 0x20, 0xec,
+	0x09, 0x2e, 0xcc, 0x5e, 0x0f, 0x17, 0x52, 0xe7, 0x73, 0xe1, 0x53, 0x58, 0xd0, 0x99, 0x0c, 0x93,
+	0x77, 0x6d, 0x3f, 0x37, 0x95, 0xed, 0x0b, 0x3a, 0x05, 0xa5, 0x38, 0x6e, 0x6a, 0x7f, 0xaa, 0xc3,
+	0x4a, 0x4f, 0xd3, 0x61, 0x05, 0x5d, 0x76, 0x66, 0xaa, 0x2e, 0xfb, 0x2f, 0xb3, 0x90, 0xed, 0x07,
+	0x2f, 0x95, 0xe1, 0x44, 0x14, 0x8b, 0x24, 0xa2, 0x70, 0xaf, 0x13, 0x8f, 0xf6, 0x3a, 0x41, 0x85,
+	0x4e, 0x44, 0x2a, 0xf4, 0xbb, 0x90, 0xa4, 0x7d, 0x04, 0xcb, 0x4c, 0x97, 0x65, 0x18, 0xd3, 0xa0,
+	0x5d, 0x05, 0xab, 0xa7, 0xcf, 0x93, 0xce, 0x33, 0x14, 0x81, 0x47, 0x5f, 0x1b, 0xe0, 0x98, 0x1c,
+	0x1d, 0x3f, 0x57, 0x42, 0xcf, 0x50, 0x04, 0xbf, 0x3c, 0x68, 0xe6, 0x49, 0xe4, 0xdd, 0xe4, 0xca,
+	0xe5, 0x41, 0x33, 0x4f, 0x38, 0x58, 0x07, 0xb2, 0x8a, 0x66, 0xda, 0x38, 0xf2, 0x7a, 0x72, 0x55,
+	0x38, 0x60, 0x10, 0x3e, 0x20, 0x7b, 0x0b, 0x7c, 0x6c, 0x6a, 0x43, 0x1d, 0x4f, 0xf1, 0x7b, 0x0a,
+	0xeb, 0x72, 0x29, 0xc4, 0x47, 0x0c, 0x01, 0xdd, 0x87, 0x79, 0xfe, 0x68, 0xe8, 0x22, 0xc2, 0x54,
+	0x88, 0x59, 0x86, 0xe1, 0x42, 0x1e, 0x43, 0xc6, 0x7b, 0x9d, 0xb3, 0x4b, 0xd9, 0xeb, 0xbf, 0x8b,
+	0xa6, 0xdd, 0xa7, 0x3b, 0xbb, 0xfa, 0xf7, 0x24, 0xa4, 0xea, 0xb2, 0xa1, 0x6a, 0x18, 0xd5, 0x01,
+	0x6c, 0x47, 0xb6, 0x1c, 0x89, 0x91, 0x32, 0x76, 0x05, 0x52, 0x66, 0x98, 0x1e, 0x9d, 0x41, 0x5b,
+	0x90, 0xa4, 0xbc, 0xe2, 0xcf, 0xab, 0x57, 0xf6, 0x13, 0xd3, 0xa5, 0x18, 0x94, 0x4c, 0x53, 0xf6,
+	0xa0, 0x4c, 0x17, 0xfd, 0x1f, 0x24, 0x34, 0xf3, 0x64, 0x8a, 0x4e, 0x93, 0x42, 0x50, 0x55, 0x5a,
+	0x92, 0x18, 0x6b, 0xa6, 0x0c, 0x2f, 0xae, 0x3c, 0xc9, 0xb6, 0xd4, 0xb5, 0xb3, 0x6d, 0xee, 0x9a,
+	0xd9, 0x96, 0x7e, 0x91, 0x6c, 0xfb, 0x59, 0x1c, 0x8a, 0x2c, 0x0a, 0x6b, 0x8a, 0x32, 0xd4, 0x87,
+	0x1a, 0x7b, 0xb7, 0xbd, 0x30, 0xa5, 0x7a, 0xf9, 0x31, 0x7e, 0xe5, 0xfc, 0xf8, 0x00, 0x8a, 0x2e,
+	0x3e, 0x79, 0x8c, 0x9f, 0xeb, 0x46, 0x53, 0x08, 0x70, 0xfc, 0x5c, 0x19, 0x7a, 0xad, 0x9f, 0x8e,
+	0x5f, 0xc1, 0x8b, 0xfd, 0xdd, 0x27, 0x31, 0x7a, 0x19, 0xe0, 0x4f, 0xe1, 0xe8, 0x1e, 0x2c, 0x77,
+	0x3b, 0x9d, 0x96, 0xd4, 0x7f, 0xd0, 0x6d, 0x4a, 0xfb, 0x7b, 0xbd, 0x6e, 0xb3, 0x2e, 0x6c, 0x0b,
+	0xcd, 0x46, 0x71, 0xa6, 0x7c, 0x63, 0x34, 0xae, 0x2c, 0x7a, 0x82, 0xfb, 0x86, 0x3d, 0xc0, 0x0a,
+	0x39, 0x24, 0x98, 0xfd, 0xb4, 0x11, 0xe8, 0x6c, 0xd5, 0x7a, 0x42, 0xbd, 0x18, 0x2b, 0x2f, 0x8c,
+	0xc6, 0x95, 0x9c, 0x27, 0xbd, 0x25, 0xdb, 0x44, 0x41, 0xeb, 0x50, 0x0c, 0xe4, 0xc4, 0xda, 0xde,
+	0x4e, 0xb3, 0x51, 0x8c, 0x97, 0xd1, 0x68, 0x5c, 0xc9, 0xfb, 0x4f, 0xf1, 0xb2, 0x71, 0x84, 0x55,
+	0xf4, 0x06, 0x2c, 0x05, 0x92, 0xbd, 0x7e, 0x6d, 0xab, 0xd5, 0xec, 0x7d, 0x5c, 0xeb, 0x16, 0x13,
+	0xe5, 0x95, 0xd1, 0xb8, 0x82, 0x3c, 0xe9, 0x9e, 0x23, 0x1f, 0x68, 0x98, 0xba, 0xb6, 0x9c, 0xfc,
+	0xc9, 0xaf, 0x56, 0x67, 0xee, 0xfe, 0x2e, 0x06, 0x19, 0xbf, 0xc9, 0x45, 0x6f, 0xc1, 0x4a, 0x47,
+	0x6c, 0x34, 0xc5, 0xf3, 0x0e, 0x53, 0x1a, 0x8d, 0x2b, 0x4b, 0xbe, 0x68, 0xf8, 0x34, 0xeb, 0x50,
+	0x0c, 0x69, 0xb5, 0x84, 0xb6, 0xd0, 0x2f, 0xc6, 0xf8, 0x2e, 0x7d, 0x79, 0xf6, 0x13, 0x36, 0x6d,
+	0x53, 0x42, 0x92, 0xed, 0x9a, 0xb8, 0xdb, 0xec, 0x17, 0xe3, 0xe5, 0xc5, 0xd1, 0xb8, 0x52, 0xf0,
+	0x45, 0xf9, 0x0f, 0xd6, 0xa8, 0x0a, 0xb9, 0xb0, 0x6c, 0xbb, 0x98, 0x28, 0x17, 0x46, 0xe3, 0x4a,
+	0x36, 0x90, 0x6b, 0xbb, 0x67, 0xf8, 0x4d, 0xcc, 0xbd, 0x5f, 0x37, 0x42, 0xbd, 0xf5, 0x2d, 0xae,
+	0xdc, 0x10, 0xc4, 0x66, 0xbd, 0x2f, 0x74, 0xf6, 0x26, 0x4e, 0xf3, 0xd2, 0x68, 0x5c, 0xb9, 0x19,
+	0x55, 0x0a, 0x1f, 0x69, 0x03, 0x16, 0x27, 0xf5, 0xb7, 0xf6, 0x1f, 0x14, 0x63, 0xe5, 0xe5, 0xd1,
+	0xb8, 0xb2, 0x10, 0xd5, 0xdb, 0x1a, 0x9e, 0x51, 0xf3, 0x4f, 0xca, 0xf7, 0x9a, 0xad, 0x56, 0x31,
+	0xce, 0xcd, 0x1f, 0x55, 0xe8, 0x61, 0x4d, 0x73, 0xb7, 0xfe, 0xe3, 0x38, 0xe4, 0x22, 0x3d, 0x0c,
+	0x7a, 0x1f, 0xca, 0x62, 0xf3, 0xfe, 0x7e, 0xb3, 0xd7, 0xa7, 0x6e, 0xec, 0xef, 0xf7, 0x26, 0x36,
+	0x7e, 0x7b, 0x34, 0xae, 0x94, 0x22, 0x2a, 0xe1, 0x7d, 0xff, 0x2f, 0xdc, 0x9a, 0xd0, 0xde, 0xeb,
+	0xf4, 0xa5, 0xe6, 0x27, 0xcd, 0xfa, 0x7e, 0xbf, 0xd9, 0x28, 0xc6, 0xce, 0x51, 0xdf, 0x33, 0x9d,
+	0xe6, 0x29, 0x56, 0x68, 0xd7, 0x8b, 0xde, 0x85, 0xd2, 0x84, 0x7a, 0x6f, 0xbf, 0x5e, 0x6f, 0x36,
+	0x1b, 0x8c, 0x77, 0xe5, 0xd1, 0xb8, 0xb2, 0x12, 0xd1, 0xed, 0x0d, 0x15, 0x05, 0x63, 0x15, 0xab,
+	0x34, 0x0a, 0x26, 0x34, 0xb7, 0x6b, 0x42, 0xab, 0xd9, 0x28, 0x26, 0x78, 0x14, 0x44, 0xd4, 0xb6,
+	0x65, 0xa2, 0x61, 0xd5, 0x35, 0xc1, 0x2f, 0x13, 0x90, 0x0d, 0xbd, 0xf5, 0xd0, 0x3d, 0x70, 0x53,
+	0x9e, 0x7b, 0x7c, 0xb6, 0x87, 0x90, 0x78, 0xf8, 0xf0, 0xef, 0xc1, 0xcd, 0x88, 0xe6, 0xc4, 0xd1,
+	0x27, 0x55, 0xc3, 0x07, 0x7f, 0x67, 0x62, 0x51, 0xaa, 0xda, 0xae, 0xf5, 0xeb, 0x1f, 0xb2, 0x83,
+	0xdf, 0x1c, 0x8d, 0x2b, 0xcb, 0x51, 0xcd, 0x36, 0x6d, 0x13, 0xb1, 0x8a, 0xea, 0xb0, 0x1a, 0x51,
+	0xec, 0xd6, 0xc4, 0xbe, 0x50, 0x6b, 0xb5, 0x1e, 0xf8, 0xea, 0x89, 0xf2, 0xda, 0x68, 0x5c, 0xb9,
+	0x15, 0x52, 0xef, 0xca, 0x96, 0x43, 0x64, 0x4d, 0x3b, 0xf3, 0x40, 0xfc, 0xb0, 0x73, 0x41, 0xea,
+	0x9d, 0x76, 0xb7, 0xd5, 0xa4, 0xbb, 0x4e, 0x86, 0xc2, 0x8e, 0x2b, 0xd7, 0x4d, 0x7d, 0xa0, 0x61,
+	0x87, 0x9b, 0x3c, 0xaa, 0x55, 0xdb, 0xab, 0x37, 0xa9, 0xc9, 0x67, 0xb9, 0xc9, 0xc3, 0x4a, 0xb2,
+	0xa1, 0x60, 0x8d, 0xa7, 0x89, 0x88, 0x4e, 0xf3, 0x93, 0xae, 0x20, 0x36, 0x1b, 0xc5, 0x54, 0x88,
+	0xa7, 0x5c, 0x85, 0xbf, 0xac, 0x78, 0x4e, 0xfa, 0x73, 0x0c, 0xb2, 0xa1, 0xb7, 0x34, 0x54, 0x87,
+	0xb5, 0xbe, 0xd0, 0x6e, 0x4a, 0xc2, 0x9e, 0xb4, 0xdd, 0x11, 0xeb, 0x4d, 0x69, 0xa7, 0xd3, 0x69,
+	0x48, 0x7d, 0xa1, 0x15, 0xec, 0x62, 0xa6, 0xbc, 0x3a, 0x1a, 0x57, 0xca, 0x21, 0xad, 0x1d, 0xd3,
+	0x54, 0xfb, 0x44, 0xf3, 0x37, 0xb3, 0x03, 0x2f, 0x47, 0x41, 0x84, 0x76, 0xbb, 0xd9, 0x10, 0x6a,
+	0xfd, 0xa6, 0xd4, 0x11, 0x5d, 0xa0, 0x62, 0xac, 0x5c, 0x19, 0x8d, 0x2b, 0xb7, 0x43, 0x30, 0x82,
+	0xae, 0x63, 0x95, 0xc8, 0x0e, 0xee, 0x58, 0x1c, 0x0a, 0xbd, 0x07, 0xe5, 0x28, 0xd0, 0xb6, 0xd0,
+	0x6a, 0x51, 0x8c, 0x5d, 0x81, 0xc5, 0x20, 0xf3, 0x5f, 0x08, 0x61, 0x9b, 0x68, 0x5a, 0xc7, 0xda,
+	0x25, 0x7e, 0x18, 0xfe, 0x3b, 0x06, 0x4b, 0xe7, 0xbd, 0x19, 0xa0, 0x5d, 0xa8, 0xd6, 0x3b, 0x7b,
+	0x0d, 0x81, 0x46, 0x74, 0x8d, 0x42, 0x5e, 0x90, 0x1c, 0xff, 0x6b, 0x34, 0xae, 0xac, 0x9d, 0x87,
+	0x10, 0xe6, 0xe7, 0x36, 0x54, 0x2e, 0x00, 0xeb, 0xf5, 0x3b, 0x5d, 0xa9, 0xd5, 0xe9, 0xf5, 0xbc,
+	0xe3, 0x9e, 0x07, 0xd5, 0x73, 0xcc, 0x41, 0xcb, 0xb4, 0x6d, 0xf4, 0xff, 0x17, 0x6e, 0xaa, 0x5f,
+	0xdb, 0x6d, 0x4a, 0x5d, 0xb1, 0xb3, 0x2d, 0xd0, 0xb4, 0x5a, 0x1d, 0x8d, 0x2b, 0xab, 0xe7, 0x21,
+	0xf5, 0xe5, 0x87, 0xb8, 0x6b, 0x99, 0x87, 0xc4, 0xe1, 0xe7, 0xdf, 0xea, 0x3e, 0xf9, 0x7a, 0x75,
+	0xe6, 0xc9, 0x37, 0xab, 0xb1, 0x2f, 0xbe, 0x59, 0x8d, 0xfd, 0xf5, 0x9b, 0xd5, 0xd8, 0xe7, 0xdf,
+	0xae, 0xce, 0x7c, 0xf1, 0xed, 0xea, 0xcc, 0x1f, 0xbf, 0x5d, 0x9d, 0xf9, 0xf4, 0xde, 0x53, 0x15,
+	0x92, 0xde, 0xc6, 0x5e, 0xd7, 0xe4, 0x03, 0x7b, 0x93, 0xff, 0xd1, 0xe0, 0x69, 0xe8, 0xcf, 0x06,
+	0x59, 0xc5, 0x3c, 0x48, 0xb1, 0x82, 0xff, 0xdf, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0xe7, 0x59,
+	0x91, 0x55, 0x56, 0x28, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -732,6 +1257,91 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PositionCreationFee) > 0 {
+		for iNdEx := len(m.PositionCreationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PositionCreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	{
+		size := m.TakerFeeRate.Size()
+		i -= size
+		if _, err := m.TakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xb2
+	{
+		size := m.MakerFeeRate.Size()
+		i -= size
+		if _, err := m.MakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	{
+		size := m.PoolSwapFeeRatio.Size()
+		i -= size
+		if _, err := m.PoolSwapFeeRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa2
+	if len(m.AllowedSwapFeeRates) > 0 {
+		for iNdEx := len(m.AllowedSwapFeeRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.AllowedSwapFeeRates[iNdEx].Size()
+				i -= size
+				if _, err := m.AllowedSwapFeeRates[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintLiquidity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxTwapWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxTwapWindow):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintLiquidity(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	if m.TradeRecordRetention != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.TradeRecordRetention))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
 	if m.OrderExtraGas != 0 {
 		i = encodeVarintLiquidity(dAtA, i, uint64(m.OrderExtraGas))
 		i--
@@ -769,12 +1379,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x62
-	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxOrderLifespan, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxOrderLifespan):])
-	if err1 != nil {
-		return 0, err1
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxOrderLifespan, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxOrderLifespan):])
+	if err2 != nil {
+		return 0, err2
 	}
-	i -= n1
-	i = encodeVarintLiquidity(dAtA, i, uint64(n1))
+	i -= n2
+	i = encodeVarintLiquidity(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0x5a
 	if m.MaxNumMarketMakingOrderTicks != 0 {
@@ -887,6 +1497,18 @@ func (m *Pair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SwapFeeRate != nil {
+		{
+			size := m.SwapFeeRate.Size()
+			i -= size
+			if _, err := m.SwapFeeRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintLiquidity(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.CurrentBatchId != 0 {
 		i = encodeVarintLiquidity(dAtA, i, uint64(m.CurrentBatchId))
 		i--
@@ -958,6 +1580,11 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Amplification != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.Amplification))
+		i--
+		dAtA[i] = 0x60
+	}
 	if m.Disabled {
 		i--
 		if m.Disabled {
@@ -1223,17 +1850,52 @@ func (m *Order) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.Status != 0 {
-		i = encodeVarintLiquidity(dAtA, i, uint64(m.Status))
-		i--
+	if len(m.Amendments) > 0 {
+		for iNdEx := len(m.Amendments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amendments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if m.PostOnly {
+		i--
+		if m.PostOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.TimeInForce != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.TimeInForce))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.Status != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.Status))
+		i--
 		dAtA[i] = 0x78
 	}
-	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ExpireAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpireAt):])
-	if err4 != nil {
-		return 0, err4
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ExpireAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpireAt):])
+	if err5 != nil {
+		return 0, err5
 	}
-	i -= n4
-	i = encodeVarintLiquidity(dAtA, i, uint64(n4))
+	i -= n5
+	i = encodeVarintLiquidity(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0x72
 	if m.BatchId != 0 {
@@ -1336,6 +1998,67 @@ func (m *Order) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OrderAmendment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderAmendment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderAmendment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.PreviousExpireAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.PreviousExpireAt):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintLiquidity(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.PreviousOpenAmount.Size()
+		i -= size
+		if _, err := m.PreviousOpenAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.PreviousPrice.Size()
+		i -= size
+		if _, err := m.PreviousPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PreviousOrderId != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.PreviousOrderId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MMOrderIndex) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1357,20 +2080,20 @@ func (m *MMOrderIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if len(m.OrderIds) > 0 {
-		dAtA9 := make([]byte, len(m.OrderIds)*10)
-		var j8 int
+		dAtA11 := make([]byte, len(m.OrderIds)*10)
+		var j10 int
 		for _, num := range m.OrderIds {
 			for num >= 1<<7 {
-				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j8++
+				j10++
 			}
-			dAtA9[j8] = uint8(num)
-			j8++
+			dAtA11[j10] = uint8(num)
+			j10++
 		}
-		i -= j8
-		copy(dAtA[i:], dAtA9[:j8])
-		i = encodeVarintLiquidity(dAtA, i, uint64(j8))
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintLiquidity(dAtA, i, uint64(j10))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -1389,307 +2112,2963 @@ func (m *MMOrderIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintLiquidity(dAtA []byte, offset int, v uint64) int {
-	offset -= sovLiquidity(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Position) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *Position) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Position) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BatchSize != 0 {
-		n += 1 + sovLiquidity(uint64(m.BatchSize))
-	}
-	if m.TickPrecision != 0 {
-		n += 1 + sovLiquidity(uint64(m.TickPrecision))
-	}
-	l = len(m.FeeCollectorAddress)
-	if l > 0 {
-		n += 1 + l + sovLiquidity(uint64(l))
-	}
-	l = len(m.DustCollectorAddress)
-	if l > 0 {
-		n += 1 + l + sovLiquidity(uint64(l))
-	}
-	l = m.MinInitialPoolCoinSupply.Size()
-	n += 1 + l + sovLiquidity(uint64(l))
-	if len(m.PairCreationFee) > 0 {
-		for _, e := range m.PairCreationFee {
-			l = e.Size()
-			n += 1 + l + sovLiquidity(uint64(l))
+	{
+		size := m.MaxPrice.Size()
+		i -= size
+		if _, err := m.MaxPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
 	}
-	if len(m.PoolCreationFee) > 0 {
-		for _, e := range m.PoolCreationFee {
-			l = e.Size()
-			n += 1 + l + sovLiquidity(uint64(l))
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MinPrice.Size()
+		i -= size
+		if _, err := m.MinPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
 	}
-	l = m.MinInitialDepositAmount.Size()
-	n += 1 + l + sovLiquidity(uint64(l))
-	l = m.MaxPriceLimitRatio.Size()
-	n += 1 + l + sovLiquidity(uint64(l))
-	if m.MaxNumMarketMakingOrderTicks != 0 {
-		n += 1 + sovLiquidity(uint64(m.MaxNumMarketMakingOrderTicks))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.ReserveAddress) > 0 {
+		i -= len(m.ReserveAddress)
+		copy(dAtA[i:], m.ReserveAddress)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.ReserveAddress)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxOrderLifespan)
-	n += 1 + l + sovLiquidity(uint64(l))
-	l = m.SwapFeeRate.Size()
-	n += 1 + l + sovLiquidity(uint64(l))
-	l = m.WithdrawFeeRate.Size()
-	n += 1 + l + sovLiquidity(uint64(l))
-	if m.DepositExtraGas != 0 {
-		n += 1 + sovLiquidity(uint64(m.DepositExtraGas))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.WithdrawExtraGas != 0 {
-		n += 1 + sovLiquidity(uint64(m.WithdrawExtraGas))
+	if m.PairId != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.PairId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.OrderExtraGas != 0 {
-		n += 2 + sovLiquidity(uint64(m.OrderExtraGas))
+	if m.Id != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Pair) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ConditionalOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ConditionalOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConditionalOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovLiquidity(uint64(m.Id))
+	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ExpireAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpireAt):])
+	if err12 != nil {
+		return 0, err12
 	}
-	l = len(m.BaseCoinDenom)
-	if l > 0 {
-		n += 1 + l + sovLiquidity(uint64(l))
+	i -= n12
+	i = encodeVarintLiquidity(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
 	}
-	l = len(m.QuoteCoinDenom)
-	if l > 0 {
-		n += 1 + l + sovLiquidity(uint64(l))
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
 	}
-	l = len(m.EscrowAddress)
-	if l > 0 {
-		n += 1 + l + sovLiquidity(uint64(l))
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.TriggerPrice.Size()
+		i -= size
+		if _, err := m.TriggerPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
 	}
-	if m.LastOrderId != 0 {
-		n += 1 + sovLiquidity(uint64(m.LastOrderId))
+	i--
+	dAtA[i] = 0x52
+	if len(m.DemandCoinDenom) > 0 {
+		i -= len(m.DemandCoinDenom)
+		copy(dAtA[i:], m.DemandCoinDenom)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.DemandCoinDenom)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	if m.LastPrice != nil {
-		l = m.LastPrice.Size()
-		n += 1 + l + sovLiquidity(uint64(l))
+	{
+		size, err := m.OfferCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
 	}
-	if m.CurrentBatchId != 0 {
-		n += 1 + sovLiquidity(uint64(m.CurrentBatchId))
+	i--
+	dAtA[i] = 0x42
+	if m.Direction != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x38
 	}
-	return n
-}
-
-func (m *Pool) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.OrderType != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.OrderType))
+		i--
+		dAtA[i] = 0x30
 	}
-	var l int
-	_ = l
 	if m.Type != 0 {
-		n += 1 + sovLiquidity(uint64(m.Type))
-	}
-	if m.Id != 0 {
-		n += 1 + sovLiquidity(uint64(m.Id))
-	}
-	if m.PairId != 0 {
-		n += 1 + sovLiquidity(uint64(m.PairId))
-	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovLiquidity(uint64(l))
-	}
-	l = len(m.ReserveAddress)
-	if l > 0 {
-		n += 1 + l + sovLiquidity(uint64(l))
-	}
-	l = len(m.PoolCoinDenom)
-	if l > 0 {
-		n += 1 + l + sovLiquidity(uint64(l))
-	}
-	if m.MinPrice != nil {
-		l = m.MinPrice.Size()
-		n += 1 + l + sovLiquidity(uint64(l))
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.MaxPrice != nil {
-		l = m.MaxPrice.Size()
-		n += 1 + l + sovLiquidity(uint64(l))
+	if len(m.Orderer) > 0 {
+		i -= len(m.Orderer)
+		copy(dAtA[i:], m.Orderer)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.Orderer)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.LastDepositRequestId != 0 {
-		n += 1 + sovLiquidity(uint64(m.LastDepositRequestId))
+	if m.MsgHeight != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.MsgHeight))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.LastWithdrawRequestId != 0 {
-		n += 1 + sovLiquidity(uint64(m.LastWithdrawRequestId))
+	if m.PairId != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.PairId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Disabled {
-		n += 2
+	if m.Id != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DepositRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RoutedSwapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RoutedSwapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoutedSwapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovLiquidity(uint64(m.Id))
+	if m.Status != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x48
 	}
-	if m.PoolId != 0 {
-		n += 1 + sovLiquidity(uint64(m.PoolId))
+	{
+		size, err := m.ReceivedCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
 	}
-	if m.MsgHeight != 0 {
-		n += 1 + sovLiquidity(uint64(m.MsgHeight))
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.MinDemandAmount.Size()
+		i -= size
+		if _, err := m.MinDemandAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
 	}
-	l = len(m.Depositor)
-	if l > 0 {
-		n += 1 + l + sovLiquidity(uint64(l))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.DemandCoinDenom) > 0 {
+		i -= len(m.DemandCoinDenom)
+		copy(dAtA[i:], m.DemandCoinDenom)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.DemandCoinDenom)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if len(m.DepositCoins) > 0 {
-		for _, e := range m.DepositCoins {
-			l = e.Size()
-			n += 1 + l + sovLiquidity(uint64(l))
+	{
+		size, err := m.OfferCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
 	}
-	if len(m.AcceptedCoins) > 0 {
-		for _, e := range m.AcceptedCoins {
-			l = e.Size()
-			n += 1 + l + sovLiquidity(uint64(l))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.PairIds) > 0 {
+		dAtA17 := make([]byte, len(m.PairIds)*10)
+		var j16 int
+		for _, num := range m.PairIds {
+			for num >= 1<<7 {
+				dAtA17[j16] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j16++
+			}
+			dAtA17[j16] = uint8(num)
+			j16++
 		}
+		i -= j16
+		copy(dAtA[i:], dAtA17[:j16])
+		i = encodeVarintLiquidity(dAtA, i, uint64(j16))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = m.MintedPoolCoin.Size()
-	n += 1 + l + sovLiquidity(uint64(l))
-	if m.Status != 0 {
-		n += 1 + sovLiquidity(uint64(m.Status))
+	if len(m.Orderer) > 0 {
+		i -= len(m.Orderer)
+		copy(dAtA[i:], m.Orderer)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.Orderer)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if m.MsgHeight != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.MsgHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *WithdrawRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TradeRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TradeRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TradeRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovLiquidity(uint64(m.Id))
+	if len(m.SwapFees) > 0 {
+		for iNdEx := len(m.SwapFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SwapFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
 	}
-	if m.PoolId != 0 {
-		n += 1 + sovLiquidity(uint64(m.PoolId))
+	{
+		size := m.QuoteVolume.Size()
+		i -= size
+		if _, err := m.QuoteVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
 	}
-	if m.MsgHeight != 0 {
-		n += 1 + sovLiquidity(uint64(m.MsgHeight))
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.BaseVolume.Size()
+		i -= size
+		if _, err := m.BaseVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
 	}
-	l = len(m.Withdrawer)
-	if l > 0 {
-		n += 1 + l + sovLiquidity(uint64(l))
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.ClosePrice.Size()
+		i -= size
+		if _, err := m.ClosePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
 	}
-	l = m.PoolCoin.Size()
-	n += 1 + l + sovLiquidity(uint64(l))
-	if len(m.WithdrawnCoins) > 0 {
-		for _, e := range m.WithdrawnCoins {
-			l = e.Size()
-			n += 1 + l + sovLiquidity(uint64(l))
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.LowPrice.Size()
+		i -= size
+		if _, err := m.LowPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
 	}
-	if m.Status != 0 {
-		n += 1 + sovLiquidity(uint64(m.Status))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.HighPrice.Size()
+		i -= size
+		if _, err := m.HighPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *Order) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.OpenPrice.Size()
+		i -= size
+		if _, err := m.OpenPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	if m.Type != 0 {
-		n += 1 + sovLiquidity(uint64(m.Type))
+	i--
+	dAtA[i] = 0x2a
+	n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err18 != nil {
+		return 0, err18
 	}
-	if m.Id != 0 {
-		n += 1 + sovLiquidity(uint64(m.Id))
+	i -= n18
+	i = encodeVarintLiquidity(dAtA, i, uint64(n18))
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.PairId != 0 {
-		n += 1 + sovLiquidity(uint64(m.PairId))
+	if m.BatchId != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.BatchId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.MsgHeight != 0 {
-		n += 1 + sovLiquidity(uint64(m.MsgHeight))
+	if m.PairId != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.PairId))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = len(m.Orderer)
-	if l > 0 {
+	return len(dAtA) - i, nil
+}
+
+func (m *Candle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Candle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Candle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SwapFees) > 0 {
+		for iNdEx := len(m.SwapFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SwapFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	{
+		size := m.QuoteVolume.Size()
+		i -= size
+		if _, err := m.QuoteVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.BaseVolume.Size()
+		i -= size
+		if _, err := m.BaseVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Close.Size()
+		i -= size
+		if _, err := m.Close.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Low.Size()
+		i -= size
+		if _, err := m.Low.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.High.Size()
+		i -= size
+		if _, err := m.High.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Open.Size()
+		i -= size
+		if _, err := m.Open.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n19, err19 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err19 != nil {
+		return 0, err19
+	}
+	i -= n19
+	i = encodeVarintLiquidity(dAtA, i, uint64(n19))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceAccumulator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceAccumulator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceAccumulator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.LastPrice.Size()
+		i -= size
+		if _, err := m.LastPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.CumulativePrice.Size()
+		i -= size
+		if _, err := m.CumulativePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n20, err20 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err20 != nil {
+		return 0, err20
+	}
+	i -= n20
+	i = encodeVarintLiquidity(dAtA, i, uint64(n20))
+	i--
+	dAtA[i] = 0x12
+	if m.PairId != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.PairId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLiquidity(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLiquidity(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BatchSize != 0 {
+		n += 1 + sovLiquidity(uint64(m.BatchSize))
+	}
+	if m.TickPrecision != 0 {
+		n += 1 + sovLiquidity(uint64(m.TickPrecision))
+	}
+	l = len(m.FeeCollectorAddress)
+	if l > 0 {
 		n += 1 + l + sovLiquidity(uint64(l))
 	}
-	if m.Direction != 0 {
-		n += 1 + sovLiquidity(uint64(m.Direction))
+	l = len(m.DustCollectorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
 	}
-	l = m.OfferCoin.Size()
+	l = m.MinInitialPoolCoinSupply.Size()
 	n += 1 + l + sovLiquidity(uint64(l))
-	l = m.RemainingOfferCoin.Size()
+	if len(m.PairCreationFee) > 0 {
+		for _, e := range m.PairCreationFee {
+			l = e.Size()
+			n += 1 + l + sovLiquidity(uint64(l))
+		}
+	}
+	if len(m.PoolCreationFee) > 0 {
+		for _, e := range m.PoolCreationFee {
+			l = e.Size()
+			n += 1 + l + sovLiquidity(uint64(l))
+		}
+	}
+	l = m.MinInitialDepositAmount.Size()
 	n += 1 + l + sovLiquidity(uint64(l))
-	l = m.ReceivedCoin.Size()
+	l = m.MaxPriceLimitRatio.Size()
 	n += 1 + l + sovLiquidity(uint64(l))
-	l = m.Price.Size()
+	if m.MaxNumMarketMakingOrderTicks != 0 {
+		n += 1 + sovLiquidity(uint64(m.MaxNumMarketMakingOrderTicks))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxOrderLifespan)
 	n += 1 + l + sovLiquidity(uint64(l))
-	l = m.Amount.Size()
+	l = m.SwapFeeRate.Size()
 	n += 1 + l + sovLiquidity(uint64(l))
-	l = m.OpenAmount.Size()
+	l = m.WithdrawFeeRate.Size()
 	n += 1 + l + sovLiquidity(uint64(l))
-	if m.BatchId != 0 {
-		n += 1 + sovLiquidity(uint64(m.BatchId))
+	if m.DepositExtraGas != 0 {
+		n += 1 + sovLiquidity(uint64(m.DepositExtraGas))
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpireAt)
-	n += 1 + l + sovLiquidity(uint64(l))
-	if m.Status != 0 {
-		n += 1 + sovLiquidity(uint64(m.Status))
+	if m.WithdrawExtraGas != 0 {
+		n += 1 + sovLiquidity(uint64(m.WithdrawExtraGas))
+	}
+	if m.OrderExtraGas != 0 {
+		n += 2 + sovLiquidity(uint64(m.OrderExtraGas))
+	}
+	if m.TradeRecordRetention != 0 {
+		n += 2 + sovLiquidity(uint64(m.TradeRecordRetention))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxTwapWindow)
+	n += 2 + l + sovLiquidity(uint64(l))
+	if len(m.AllowedSwapFeeRates) > 0 {
+		for _, e := range m.AllowedSwapFeeRates {
+			l = e.Size()
+			n += 2 + l + sovLiquidity(uint64(l))
+		}
+	}
+	l = m.PoolSwapFeeRatio.Size()
+	n += 2 + l + sovLiquidity(uint64(l))
+	l = m.MakerFeeRate.Size()
+	n += 2 + l + sovLiquidity(uint64(l))
+	l = m.TakerFeeRate.Size()
+	n += 2 + l + sovLiquidity(uint64(l))
+	if len(m.PositionCreationFee) > 0 {
+		for _, e := range m.PositionCreationFee {
+			l = e.Size()
+			n += 2 + l + sovLiquidity(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *MMOrderIndex) Size() (n int) {
+func (m *Pair) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Orderer)
+	if m.Id != 0 {
+		n += 1 + sovLiquidity(uint64(m.Id))
+	}
+	l = len(m.BaseCoinDenom)
 	if l > 0 {
 		n += 1 + l + sovLiquidity(uint64(l))
 	}
-	if m.PairId != 0 {
-		n += 1 + sovLiquidity(uint64(m.PairId))
+	l = len(m.QuoteCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
 	}
-	if len(m.OrderIds) > 0 {
-		l = 0
-		for _, e := range m.OrderIds {
-			l += sovLiquidity(uint64(e))
-		}
-		n += 1 + sovLiquidity(uint64(l)) + l
+	l = len(m.EscrowAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	if m.LastOrderId != 0 {
+		n += 1 + sovLiquidity(uint64(m.LastOrderId))
+	}
+	if m.LastPrice != nil {
+		l = m.LastPrice.Size()
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	if m.CurrentBatchId != 0 {
+		n += 1 + sovLiquidity(uint64(m.CurrentBatchId))
+	}
+	if m.SwapFeeRate != nil {
+		l = m.SwapFeeRate.Size()
+		n += 1 + l + sovLiquidity(uint64(l))
 	}
 	return n
 }
 
-func sovLiquidity(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozLiquidity(x uint64) (n int) {
-	return sovLiquidity(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *Pool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovLiquidity(uint64(m.Type))
+	}
+	if m.Id != 0 {
+		n += 1 + sovLiquidity(uint64(m.Id))
+	}
+	if m.PairId != 0 {
+		n += 1 + sovLiquidity(uint64(m.PairId))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	l = len(m.ReserveAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	l = len(m.PoolCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	if m.MinPrice != nil {
+		l = m.MinPrice.Size()
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	if m.MaxPrice != nil {
+		l = m.MaxPrice.Size()
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	if m.LastDepositRequestId != 0 {
+		n += 1 + sovLiquidity(uint64(m.LastDepositRequestId))
+	}
+	if m.LastWithdrawRequestId != 0 {
+		n += 1 + sovLiquidity(uint64(m.LastWithdrawRequestId))
+	}
+	if m.Disabled {
+		n += 2
+	}
+	if m.Amplification != 0 {
+		n += 1 + sovLiquidity(uint64(m.Amplification))
+	}
+	return n
+}
+
+func (m *DepositRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovLiquidity(uint64(m.Id))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovLiquidity(uint64(m.PoolId))
+	}
+	if m.MsgHeight != 0 {
+		n += 1 + sovLiquidity(uint64(m.MsgHeight))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	if len(m.DepositCoins) > 0 {
+		for _, e := range m.DepositCoins {
+			l = e.Size()
+			n += 1 + l + sovLiquidity(uint64(l))
+		}
+	}
+	if len(m.AcceptedCoins) > 0 {
+		for _, e := range m.AcceptedCoins {
+			l = e.Size()
+			n += 1 + l + sovLiquidity(uint64(l))
+		}
+	}
+	l = m.MintedPoolCoin.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovLiquidity(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *WithdrawRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovLiquidity(uint64(m.Id))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovLiquidity(uint64(m.PoolId))
+	}
+	if m.MsgHeight != 0 {
+		n += 1 + sovLiquidity(uint64(m.MsgHeight))
+	}
+	l = len(m.Withdrawer)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	l = m.PoolCoin.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	if len(m.WithdrawnCoins) > 0 {
+		for _, e := range m.WithdrawnCoins {
+			l = e.Size()
+			n += 1 + l + sovLiquidity(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovLiquidity(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *Order) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovLiquidity(uint64(m.Type))
+	}
+	if m.Id != 0 {
+		n += 1 + sovLiquidity(uint64(m.Id))
+	}
+	if m.PairId != 0 {
+		n += 1 + sovLiquidity(uint64(m.PairId))
+	}
+	if m.MsgHeight != 0 {
+		n += 1 + sovLiquidity(uint64(m.MsgHeight))
+	}
+	l = len(m.Orderer)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	if m.Direction != 0 {
+		n += 1 + sovLiquidity(uint64(m.Direction))
+	}
+	l = m.OfferCoin.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.RemainingOfferCoin.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.ReceivedCoin.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.OpenAmount.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	if m.BatchId != 0 {
+		n += 1 + sovLiquidity(uint64(m.BatchId))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpireAt)
+	n += 1 + l + sovLiquidity(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovLiquidity(uint64(m.Status))
+	}
+	if m.TimeInForce != 0 {
+		n += 2 + sovLiquidity(uint64(m.TimeInForce))
+	}
+	if m.PostOnly {
+		n += 3
+	}
+	if len(m.Amendments) > 0 {
+		for _, e := range m.Amendments {
+			l = e.Size()
+			n += 2 + l + sovLiquidity(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OrderAmendment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovLiquidity(uint64(m.Height))
+	}
+	if m.PreviousOrderId != 0 {
+		n += 1 + sovLiquidity(uint64(m.PreviousOrderId))
+	}
+	l = m.PreviousPrice.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.PreviousOpenAmount.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.PreviousExpireAt)
+	n += 1 + l + sovLiquidity(uint64(l))
+	return n
+}
+
+func (m *MMOrderIndex) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Orderer)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	if m.PairId != 0 {
+		n += 1 + sovLiquidity(uint64(m.PairId))
+	}
+	if len(m.OrderIds) > 0 {
+		l = 0
+		for _, e := range m.OrderIds {
+			l += sovLiquidity(uint64(e))
+		}
+		n += 1 + sovLiquidity(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *Position) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovLiquidity(uint64(m.Id))
+	}
+	if m.PairId != 0 {
+		n += 1 + sovLiquidity(uint64(m.PairId))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	l = len(m.ReserveAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	l = m.MinPrice.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.MaxPrice.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	return n
+}
+
+func (m *ConditionalOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovLiquidity(uint64(m.Id))
+	}
+	if m.PairId != 0 {
+		n += 1 + sovLiquidity(uint64(m.PairId))
+	}
+	if m.MsgHeight != 0 {
+		n += 1 + sovLiquidity(uint64(m.MsgHeight))
+	}
+	l = len(m.Orderer)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovLiquidity(uint64(m.Type))
+	}
+	if m.OrderType != 0 {
+		n += 1 + sovLiquidity(uint64(m.OrderType))
+	}
+	if m.Direction != 0 {
+		n += 1 + sovLiquidity(uint64(m.Direction))
+	}
+	l = m.OfferCoin.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = len(m.DemandCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	l = m.TriggerPrice.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpireAt)
+	n += 1 + l + sovLiquidity(uint64(l))
+	return n
+}
+
+func (m *RoutedSwapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovLiquidity(uint64(m.Id))
+	}
+	if m.MsgHeight != 0 {
+		n += 1 + sovLiquidity(uint64(m.MsgHeight))
+	}
+	l = len(m.Orderer)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	if len(m.PairIds) > 0 {
+		l = 0
+		for _, e := range m.PairIds {
+			l += sovLiquidity(uint64(e))
+		}
+		n += 1 + sovLiquidity(uint64(l)) + l
+	}
+	l = m.OfferCoin.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = len(m.DemandCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	l = m.MinDemandAmount.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.ReceivedCoin.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovLiquidity(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *TradeRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PairId != 0 {
+		n += 1 + sovLiquidity(uint64(m.PairId))
+	}
+	if m.BatchId != 0 {
+		n += 1 + sovLiquidity(uint64(m.BatchId))
+	}
+	if m.Height != 0 {
+		n += 1 + sovLiquidity(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.OpenPrice.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.HighPrice.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.LowPrice.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.ClosePrice.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.BaseVolume.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.QuoteVolume.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	if len(m.SwapFees) > 0 {
+		for _, e := range m.SwapFees {
+			l = e.Size()
+			n += 1 + l + sovLiquidity(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Candle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.Open.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.High.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.Low.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.Close.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.BaseVolume.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.QuoteVolume.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	if len(m.SwapFees) > 0 {
+		for _, e := range m.SwapFees {
+			l = e.Size()
+			n += 1 + l + sovLiquidity(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PriceAccumulator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PairId != 0 {
+		n += 1 + sovLiquidity(uint64(m.PairId))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.CumulativePrice.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.LastPrice.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	return n
+}
+
+func sovLiquidity(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLiquidity(x uint64) (n int) {
+	return sovLiquidity(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSize", wireType)
+			}
+			m.BatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickPrecision", wireType)
+			}
+			m.TickPrecision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickPrecision |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCollectorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeCollectorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DustCollectorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DustCollectorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinInitialPoolCoinSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinInitialPoolCoinSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairCreationFee = append(m.PairCreationFee, types.Coin{})
+			if err := m.PairCreationFee[len(m.PairCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolCreationFee = append(m.PoolCreationFee, types.Coin{})
+			if err := m.PoolCreationFee[len(m.PoolCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinInitialDepositAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinInitialDepositAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceLimitRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPriceLimitRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNumMarketMakingOrderTicks", wireType)
+			}
+			m.MaxNumMarketMakingOrderTicks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNumMarketMakingOrderTicks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOrderLifespan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxOrderLifespan, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WithdrawFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositExtraGas", wireType)
+			}
+			m.DepositExtraGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositExtraGas |= github_com_cosmos_cosmos_sdk_types.Gas(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawExtraGas", wireType)
+			}
+			m.WithdrawExtraGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WithdrawExtraGas |= github_com_cosmos_cosmos_sdk_types.Gas(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderExtraGas", wireType)
+			}
+			m.OrderExtraGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderExtraGas |= github_com_cosmos_cosmos_sdk_types.Gas(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeRecordRetention", wireType)
+			}
+			m.TradeRecordRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TradeRecordRetention |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTwapWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxTwapWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedSwapFeeRates", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.AllowedSwapFeeRates = append(m.AllowedSwapFeeRates, v)
+			if err := m.AllowedSwapFeeRates[len(m.AllowedSwapFeeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolSwapFeeRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolSwapFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PositionCreationFee = append(m.PositionCreationFee, types.Coin{})
+			if err := m.PositionCreationFee[len(m.PositionCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Pair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastOrderId", wireType)
+			}
+			m.LastOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.LastPrice = &v
+			if err := m.LastPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBatchId", wireType)
+			}
+			m.CurrentBatchId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentBatchId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.SwapFeeRate = &v
+			if err := m.SwapFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Pool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= PoolType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+			}
+			m.PairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PairId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReserveAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MinPrice = &v
+			if err := m.MinPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxPrice = &v
+			if err := m.MaxPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDepositRequestId", wireType)
+			}
+			m.LastDepositRequestId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastDepositRequestId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastWithdrawRequestId", wireType)
+			}
+			m.LastWithdrawRequestId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastWithdrawRequestId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Disabled = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amplification", wireType)
+			}
+			m.Amplification = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amplification |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DepositRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgHeight", wireType)
+			}
+			m.MsgHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositCoins = append(m.DepositCoins, types.Coin{})
+			if err := m.DepositCoins[len(m.DepositCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AcceptedCoins = append(m.AcceptedCoins, types.Coin{})
+			if err := m.AcceptedCoins[len(m.AcceptedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintedPoolCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintedPoolCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= RequestStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WithdrawRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WithdrawRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WithdrawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgHeight", wireType)
+			}
+			m.MsgHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Withdrawer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawnCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawnCoins = append(m.WithdrawnCoins, types.Coin{})
+			if err := m.WithdrawnCoins[len(m.WithdrawnCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= RequestStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *Order) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1712,17 +5091,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: Order: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Order: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			m.BatchSize = 0
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -1732,16 +5111,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BatchSize |= uint32(b&0x7F) << shift
+				m.Type |= OrderType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TickPrecision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.TickPrecision = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -1751,14 +5130,52 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TickPrecision |= uint32(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+			}
+			m.PairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PairId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgHeight", wireType)
+			}
+			m.MsgHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeCollectorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Orderer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1786,11 +5203,129 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeeCollectorAddress = string(dAtA[iNdEx:postIndex])
+			m.Orderer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= OrderDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DustCollectorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OfferCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingOfferCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingOfferCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReceivedCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1818,11 +5353,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DustCollectorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinInitialPoolCoinSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1850,13 +5387,66 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinInitialPoolCoinSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PairCreationFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OpenAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchId", wireType)
+			}
+			m.BatchId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1883,16 +5473,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PairCreationFee = append(m.PairCreationFee, types.Coin{})
-			if err := m.PairCreationFee[len(m.PairCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ExpireAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolCreationFee", wireType)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -1902,31 +5491,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Status |= OrderStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLiquidity
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidity
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PoolCreationFee = append(m.PoolCreationFee, types.Coin{})
-			if err := m.PoolCreationFee[len(m.PoolCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinInitialDepositAmount", wireType)
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
 			}
-			var stringLen uint64
+			m.TimeInForce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -1936,31 +5510,36 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TimeInForce |= TimeInForce(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLiquidity
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidity
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostOnly", wireType)
 			}
-			if err := m.MinInitialDepositAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 9:
+			m.PostOnly = bool(v != 0)
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceLimitRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amendments", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -1970,31 +5549,81 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLiquidity
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxPriceLimitRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amendments = append(m.Amendments, OrderAmendment{})
+			if err := m.Amendments[len(m.Amendments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderAmendment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderAmendment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderAmendment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxNumMarketMakingOrderTicks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.MaxNumMarketMakingOrderTicks = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2004,16 +5633,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxNumMarketMakingOrderTicks |= uint32(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxOrderLifespan", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousOrderId", wireType)
 			}
-			var msglen int
+			m.PreviousOrderId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2023,28 +5652,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PreviousOrderId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLiquidity
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidity
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxOrderLifespan, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 12:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapFeeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2072,13 +5687,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SwapFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PreviousPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawFeeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousOpenAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2106,15 +5721,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.WithdrawFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PreviousOpenAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositExtraGas", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousExpireAt", wireType)
 			}
-			m.DepositExtraGas = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2124,16 +5739,80 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DepositExtraGas |= github_com_cosmos_cosmos_sdk_types.Gas(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawExtraGas", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
 			}
-			m.WithdrawExtraGas = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.PreviousExpireAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MMOrderIndex) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MMOrderIndex: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MMOrderIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orderer", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2143,16 +5822,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WithdrawExtraGas |= github_com_cosmos_cosmos_sdk_types.Gas(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 16:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orderer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderExtraGas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
 			}
-			m.OrderExtraGas = 0
+			m.PairId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2162,11 +5854,87 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OrderExtraGas |= github_com_cosmos_cosmos_sdk_types.Gas(b&0x7F) << shift
+				m.PairId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLiquidity
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.OrderIds = append(m.OrderIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLiquidity
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthLiquidity
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthLiquidity
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.OrderIds) == 0 {
+					m.OrderIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLiquidity
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.OrderIds = append(m.OrderIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderIds", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLiquidity(dAtA[iNdEx:])
@@ -2188,7 +5956,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Pair) Unmarshal(dAtA []byte) error {
+func (m *Position) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2211,10 +5979,10 @@ func (m *Pair) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Pair: wiretype end group for non-group")
+			return fmt.Errorf("proto: Position: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Pair: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Position: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2237,10 +6005,10 @@ func (m *Pair) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseCoinDenom", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
 			}
-			var stringLen uint64
+			m.PairId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2250,27 +6018,14 @@ func (m *Pair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PairId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLiquidity
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidity
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BaseCoinDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteCoinDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2298,11 +6053,11 @@ func (m *Pair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QuoteCoinDenom = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EscrowAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2330,13 +6085,13 @@ func (m *Pair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EscrowAddress = string(dAtA[iNdEx:postIndex])
+			m.ReserveAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastOrderId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPrice", wireType)
 			}
-			m.LastOrderId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2346,14 +6101,29 @@ func (m *Pair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastOrderId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2381,31 +6151,10 @@ func (m *Pair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Dec
-			m.LastPrice = &v
-			if err := m.LastPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBatchId", wireType)
-			}
-			m.CurrentBatchId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLiquidity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CurrentBatchId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLiquidity(dAtA[iNdEx:])
@@ -2427,7 +6176,7 @@ func (m *Pair) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Pool) Unmarshal(dAtA []byte) error {
+func (m *ConditionalOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2450,13 +6199,102 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConditionalOrder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConditionalOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+			}
+			m.PairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PairId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgHeight", wireType)
+			}
+			m.MsgHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orderer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orderer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
@@ -2470,16 +6308,16 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= PoolType(b&0x7F) << shift
+				m.Type |= ConditionalOrderType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
 			}
-			m.Id = 0
+			m.OrderType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2489,16 +6327,16 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.OrderType |= OrderType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
 			}
-			m.PairId = 0
+			m.Direction = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2508,16 +6346,16 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PairId |= uint64(b&0x7F) << shift
+				m.Direction |= OrderDirection(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferCoin", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2527,27 +6365,28 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLiquidity
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			if err := m.OfferCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReserveAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DemandCoinDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2575,11 +6414,11 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReserveAddress = string(dAtA[iNdEx:postIndex])
+			m.DemandCoinDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolCoinDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2607,11 +6446,13 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolCoinDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.TriggerPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2639,15 +6480,13 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Dec
-			m.MinPrice = &v
-			if err := m.MinPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2675,17 +6514,15 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Dec
-			m.MaxPrice = &v
-			if err := m.MaxPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastDepositRequestId", wireType)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireAt", wireType)
 			}
-			m.LastDepositRequestId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2695,50 +6532,25 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastDepositRequestId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastWithdrawRequestId", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
 			}
-			m.LastWithdrawRequestId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLiquidity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastWithdrawRequestId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
 			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLiquidity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ExpireAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Disabled = bool(v != 0)
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLiquidity(dAtA[iNdEx:])
@@ -2760,7 +6572,7 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DepositRequest) Unmarshal(dAtA []byte) error {
+func (m *RoutedSwapRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2783,10 +6595,10 @@ func (m *DepositRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DepositRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RoutedSwapRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DepositRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RoutedSwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2809,25 +6621,6 @@ func (m *DepositRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLiquidity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MsgHeight", wireType)
 			}
@@ -2846,9 +6639,9 @@ func (m *DepositRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Orderer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2876,11 +6669,87 @@ func (m *DepositRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
+			m.Orderer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLiquidity
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PairIds = append(m.PairIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLiquidity
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthLiquidity
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthLiquidity
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PairIds) == 0 {
+					m.PairIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLiquidity
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PairIds = append(m.PairIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairIds", wireType)
+			}
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferCoin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2907,16 +6776,15 @@ func (m *DepositRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DepositCoins = append(m.DepositCoins, types.Coin{})
-			if err := m.DepositCoins[len(m.DepositCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OfferCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DemandCoinDenom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2926,29 +6794,61 @@ func (m *DepositRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLiquidity
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AcceptedCoins = append(m.AcceptedCoins, types.Coin{})
-			if err := m.AcceptedCoins[len(m.AcceptedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DemandCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDemandAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinDemandAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintedPoolCoin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedCoin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2975,11 +6875,11 @@ func (m *DepositRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MintedPoolCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ReceivedCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 9:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
@@ -3019,7 +6919,7 @@ func (m *DepositRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WithdrawRequest) Unmarshal(dAtA []byte) error {
+func (m *TradeRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3042,17 +6942,17 @@ func (m *WithdrawRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WithdrawRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TradeRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WithdrawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TradeRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
 			}
-			m.Id = 0
+			m.PairId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -3062,16 +6962,16 @@ func (m *WithdrawRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.PairId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchId", wireType)
 			}
-			m.PoolId = 0
+			m.BatchId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -3081,16 +6981,16 @@ func (m *WithdrawRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				m.BatchId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.MsgHeight = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -3100,16 +7000,16 @@ func (m *WithdrawRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MsgHeight |= int64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -3119,29 +7019,30 @@ func (m *WithdrawRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLiquidity
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Withdrawer = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolCoin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenPrice", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -3151,30 +7052,31 @@ func (m *WithdrawRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLiquidity
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PoolCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OpenPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawnCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HighPrice", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -3184,31 +7086,31 @@ func (m *WithdrawRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLiquidity
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WithdrawnCoins = append(m.WithdrawnCoins, types.Coin{})
-			if err := m.WithdrawnCoins[len(m.WithdrawnCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.HighPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowPrice", wireType)
 			}
-			m.Status = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -3218,66 +7120,31 @@ func (m *WithdrawRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= RequestStatus(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLiquidity(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLiquidity
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Order) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLiquidity
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.LowPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Order: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Order: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosePrice", wireType)
 			}
-			m.Type = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -3287,54 +7154,31 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= OrderType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
 			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLiquidity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.PairId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLiquidity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PairId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.ClosePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgHeight", wireType)
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseVolume", wireType)
 			}
-			m.MsgHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -3344,14 +7188,29 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MsgHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orderer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteVolume", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3379,13 +7238,15 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Orderer = string(dAtA[iNdEx:postIndex])
+			if err := m.QuoteVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFees", wireType)
 			}
-			m.Direction = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -3395,14 +7256,79 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Direction |= OrderDirection(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapFees = append(m.SwapFees, types.Coin{})
+			if err := m.SwapFees[len(m.SwapFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Candle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Candle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Candle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OfferCoin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3429,15 +7355,15 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OfferCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemainingOfferCoin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Open", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -3447,30 +7373,31 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLiquidity
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RemainingOfferCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Open.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedCoin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field High", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -3480,28 +7407,29 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLiquidity
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ReceivedCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.High.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Low", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3529,13 +7457,13 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Low.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Close", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3563,13 +7491,13 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Close.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OpenAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseVolume", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3597,15 +7525,15 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OpenAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BaseVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchId", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteVolume", wireType)
 			}
-			m.BatchId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -3615,14 +7543,29 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BatchId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QuoteVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpireAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3649,29 +7592,11 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ExpireAt, dAtA[iNdEx:postIndex]); err != nil {
+			m.SwapFees = append(m.SwapFees, types.Coin{})
+			if err := m.SwapFees[len(m.SwapFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLiquidity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= OrderStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLiquidity(dAtA[iNdEx:])
@@ -3693,7 +7618,7 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MMOrderIndex) Unmarshal(dAtA []byte) error {
+func (m *PriceAccumulator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3716,15 +7641,67 @@ func (m *MMOrderIndex) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MMOrderIndex: wiretype end group for non-group")
+			return fmt.Errorf("proto: PriceAccumulator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MMOrderIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PriceAccumulator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+			}
+			m.PairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PairId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orderer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3752,13 +7729,15 @@ func (m *MMOrderIndex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Orderer = string(dAtA[iNdEx:postIndex])
+			if err := m.CumulativePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPrice", wireType)
 			}
-			m.PairId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -3768,87 +7747,26 @@ func (m *MMOrderIndex) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PairId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowLiquidity
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.OrderIds = append(m.OrderIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowLiquidity
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthLiquidity
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthLiquidity
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.OrderIds) == 0 {
-					m.OrderIds = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowLiquidity
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.OrderIds = append(m.OrderIds, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderIds", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			if err := m.LastPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLiquidity(dAtA[iNdEx:])
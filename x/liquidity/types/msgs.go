@@ -13,6 +13,7 @@ var (
 	_ sdk.Msg = (*MsgCreatePair)(nil)
 	_ sdk.Msg = (*MsgCreatePool)(nil)
 	_ sdk.Msg = (*MsgCreateRangedPool)(nil)
+	_ sdk.Msg = (*MsgCreateStableswapPool)(nil)
 	_ sdk.Msg = (*MsgDeposit)(nil)
 	_ sdk.Msg = (*MsgWithdraw)(nil)
 	_ sdk.Msg = (*MsgLimitOrder)(nil)
@@ -21,6 +22,14 @@ var (
 	_ sdk.Msg = (*MsgCancelOrder)(nil)
 	_ sdk.Msg = (*MsgCancelAllOrders)(nil)
 	_ sdk.Msg = (*MsgCancelMMOrder)(nil)
+	_ sdk.Msg = (*MsgCreatePosition)(nil)
+	_ sdk.Msg = (*MsgClosePosition)(nil)
+	_ sdk.Msg = (*MsgDepositPosition)(nil)
+	_ sdk.Msg = (*MsgWithdrawPosition)(nil)
+	_ sdk.Msg = (*MsgConditionalOrder)(nil)
+	_ sdk.Msg = (*MsgCancelConditionalOrder)(nil)
+	_ sdk.Msg = (*MsgRoutedSwap)(nil)
+	_ sdk.Msg = (*MsgAmendOrder)(nil)
 )
 
 // Message types for the liquidity module
@@ -36,6 +45,16 @@ const (
 	TypeMsgCancelOrder      = "cancel_order"
 	TypeMsgCancelAllOrders  = "cancel_all_orders"
 	TypeMsgCancelMMOrder    = "cancel_mm_order"
+	TypeMsgCreatePosition   = "create_position"
+	TypeMsgClosePosition    = "close_position"
+	TypeMsgDepositPosition  = "deposit_position"
+	TypeMsgWithdrawPosition = "withdraw_position"
+
+	TypeMsgConditionalOrder       = "conditional_order"
+	TypeMsgCancelConditionalOrder = "cancel_conditional_order"
+	TypeMsgRoutedSwap             = "routed_swap"
+	TypeMsgCreateStableswapPool   = "create_stableswap_pool"
+	TypeMsgAmendOrder             = "amend_order"
 )
 
 // NewMsgCreatePair returns a new MsgCreatePair.
@@ -64,6 +83,11 @@ func (msg MsgCreatePair) ValidateBasic() error {
 	if msg.BaseCoinDenom == msg.QuoteCoinDenom {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "cannot use same denom for both base coin and quote coin")
 	}
+	if msg.SwapFeeRate != nil {
+		if err := ValidateSwapFeeRate(*msg.SwapFeeRate); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	}
 	return nil
 }
 
@@ -212,6 +236,69 @@ func (msg MsgCreateRangedPool) GetCreator() sdk.AccAddress {
 	return addr
 }
 
+// NewMsgCreateStableswapPool creates a new MsgCreateStableswapPool.
+func NewMsgCreateStableswapPool(
+	creator sdk.AccAddress,
+	pairId uint64,
+	depositCoins sdk.Coins,
+	amp uint64,
+) *MsgCreateStableswapPool {
+	return &MsgCreateStableswapPool{
+		Creator:       creator.String(),
+		PairId:        pairId,
+		DepositCoins:  depositCoins,
+		Amplification: amp,
+	}
+}
+
+func (msg MsgCreateStableswapPool) Route() string { return RouterKey }
+
+func (msg MsgCreateStableswapPool) Type() string { return TypeMsgCreateStableswapPool }
+
+func (msg MsgCreateStableswapPool) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address: %v", err)
+	}
+	if msg.PairId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pair id must not be 0")
+	}
+	if err := msg.DepositCoins.Validate(); err != nil {
+		return err
+	}
+	if len(msg.DepositCoins) != 2 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "wrong number of deposit coins: %d", len(msg.DepositCoins))
+	}
+	for _, coin := range msg.DepositCoins {
+		if coin.Amount.GT(amm.MaxCoinAmount) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "deposit coin %s is bigger than the max amount %s", coin, amm.MaxCoinAmount)
+		}
+	}
+	if err := amm.ValidateAmplification(msg.Amplification); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+func (msg MsgCreateStableswapPool) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCreateStableswapPool) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgCreateStableswapPool) GetCreator() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
 // NewMsgDeposit creates a new MsgDeposit.
 func NewMsgDeposit(
 	depositor sdk.AccAddress,
@@ -392,6 +479,12 @@ func (msg MsgLimitOrder) ValidateBasic() error {
 	if msg.OrderLifespan < 0 {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "order lifespan must not be negative: %s", msg.OrderLifespan)
 	}
+	if !msg.TimeInForce.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid time in force: %s", msg.TimeInForce)
+	}
+	if msg.PostOnly && msg.TimeInForce != TimeInForceGoodTilCanceled {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "post-only order must be good-til-canceled: %s", msg.TimeInForce)
+	}
 	return nil
 }
 
@@ -732,3 +825,566 @@ func (msg MsgCancelMMOrder) GetOrderer() sdk.AccAddress {
 	}
 	return addr
 }
+
+// NewMsgCreatePosition creates a new MsgCreatePosition.
+func NewMsgCreatePosition(
+	owner sdk.AccAddress,
+	pairId uint64,
+	depositCoins sdk.Coins,
+	minPrice sdk.Dec,
+	maxPrice sdk.Dec,
+) *MsgCreatePosition {
+	return &MsgCreatePosition{
+		Owner:        owner.String(),
+		PairId:       pairId,
+		DepositCoins: depositCoins,
+		MinPrice:     minPrice,
+		MaxPrice:     maxPrice,
+	}
+}
+
+func (msg MsgCreatePosition) Route() string { return RouterKey }
+
+func (msg MsgCreatePosition) Type() string { return TypeMsgCreatePosition }
+
+func (msg MsgCreatePosition) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address: %v", err)
+	}
+	if msg.PairId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pair id must not be 0")
+	}
+	if err := msg.DepositCoins.Validate(); err != nil {
+		return err
+	}
+	if len(msg.DepositCoins) == 0 || len(msg.DepositCoins) > 2 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "wrong number of deposit coins: %d", len(msg.DepositCoins))
+	}
+	for _, coin := range msg.DepositCoins {
+		if coin.Amount.GT(amm.MaxCoinAmount) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "deposit coin %s is bigger than the max amount %s", coin, amm.MaxCoinAmount)
+		}
+	}
+	if err := amm.ValidatePriceRange(msg.MinPrice, msg.MaxPrice); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+func (msg MsgCreatePosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCreatePosition) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgCreatePosition) GetOwner() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgClosePosition creates a new MsgClosePosition.
+func NewMsgClosePosition(
+	owner sdk.AccAddress,
+	positionId uint64,
+) *MsgClosePosition {
+	return &MsgClosePosition{
+		Owner:      owner.String(),
+		PositionId: positionId,
+	}
+}
+
+func (msg MsgClosePosition) Route() string { return RouterKey }
+
+func (msg MsgClosePosition) Type() string { return TypeMsgClosePosition }
+
+func (msg MsgClosePosition) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address: %v", err)
+	}
+	if msg.PositionId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "position id must not be 0")
+	}
+	return nil
+}
+
+func (msg MsgClosePosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgClosePosition) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgClosePosition) GetOwner() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgDepositPosition creates a new MsgDepositPosition.
+func NewMsgDepositPosition(
+	owner sdk.AccAddress,
+	positionId uint64,
+	depositCoins sdk.Coins,
+) *MsgDepositPosition {
+	return &MsgDepositPosition{
+		Owner:        owner.String(),
+		PositionId:   positionId,
+		DepositCoins: depositCoins,
+	}
+}
+
+func (msg MsgDepositPosition) Route() string { return RouterKey }
+
+func (msg MsgDepositPosition) Type() string { return TypeMsgDepositPosition }
+
+func (msg MsgDepositPosition) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address: %v", err)
+	}
+	if msg.PositionId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "position id must not be 0")
+	}
+	if err := msg.DepositCoins.Validate(); err != nil {
+		return err
+	}
+	if len(msg.DepositCoins) == 0 || len(msg.DepositCoins) > 2 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "wrong number of deposit coins: %d", len(msg.DepositCoins))
+	}
+	for _, coin := range msg.DepositCoins {
+		if coin.Amount.GT(amm.MaxCoinAmount) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "deposit coin %s is bigger than the max amount %s", coin, amm.MaxCoinAmount)
+		}
+	}
+	return nil
+}
+
+func (msg MsgDepositPosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgDepositPosition) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgDepositPosition) GetOwner() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgWithdrawPosition creates a new MsgWithdrawPosition.
+func NewMsgWithdrawPosition(
+	owner sdk.AccAddress,
+	positionId uint64,
+	ratio sdk.Dec,
+) *MsgWithdrawPosition {
+	return &MsgWithdrawPosition{
+		Owner:      owner.String(),
+		PositionId: positionId,
+		Ratio:      ratio,
+	}
+}
+
+func (msg MsgWithdrawPosition) Route() string { return RouterKey }
+
+func (msg MsgWithdrawPosition) Type() string { return TypeMsgWithdrawPosition }
+
+func (msg MsgWithdrawPosition) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address: %v", err)
+	}
+	if msg.PositionId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "position id must not be 0")
+	}
+	if msg.Ratio.IsNil() || !msg.Ratio.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "ratio must be positive: %s", msg.Ratio)
+	}
+	if !msg.Ratio.LT(sdk.OneDec()) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "ratio must be less than 1: %s", msg.Ratio)
+	}
+	return nil
+}
+
+func (msg MsgWithdrawPosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgWithdrawPosition) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgWithdrawPosition) GetOwner() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgConditionalOrder creates a new MsgConditionalOrder.
+func NewMsgConditionalOrder(
+	orderer sdk.AccAddress,
+	pairId uint64,
+	typ ConditionalOrderType,
+	orderType OrderType,
+	dir OrderDirection,
+	offerCoin sdk.Coin,
+	demandCoinDenom string,
+	triggerPrice sdk.Dec,
+	price sdk.Dec,
+	amt sdk.Int,
+	orderLifespan time.Duration,
+) *MsgConditionalOrder {
+	return &MsgConditionalOrder{
+		Orderer:              orderer.String(),
+		PairId:               pairId,
+		ConditionalOrderType: typ,
+		OrderType:            orderType,
+		Direction:            dir,
+		OfferCoin:            offerCoin,
+		DemandCoinDenom:      demandCoinDenom,
+		TriggerPrice:         triggerPrice,
+		Price:                price,
+		Amount:               amt,
+		OrderLifespan:        orderLifespan,
+	}
+}
+
+func (msg MsgConditionalOrder) Route() string { return RouterKey }
+
+func (msg MsgConditionalOrder) Type() string { return TypeMsgConditionalOrder }
+
+func (msg MsgConditionalOrder) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Orderer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid orderer address: %v", err)
+	}
+	if msg.PairId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pair id must not be 0")
+	}
+	if !msg.ConditionalOrderType.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid conditional order type: %s", msg.ConditionalOrderType)
+	}
+	if msg.Direction != OrderDirectionBuy && msg.Direction != OrderDirectionSell {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid order direction: %s", msg.Direction)
+	}
+	if err := sdk.ValidateDenom(msg.DemandCoinDenom); err != nil {
+		return sdkerrors.Wrap(err, "invalid demand coin denom")
+	}
+	if !msg.TriggerPrice.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "trigger price must be positive")
+	}
+	// For market orders, the trigger price is used to check the minimum
+	// offer coin amount.
+	price := msg.TriggerPrice
+	switch msg.OrderType {
+	case OrderTypeLimit:
+		if !msg.Price.IsPositive() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "price must be positive")
+		}
+		price = msg.Price
+	case OrderTypeMarket:
+		if !msg.Price.IsNil() && !msg.Price.IsZero() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "price must not be specified for market orders")
+		}
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid order type: %s", msg.OrderType)
+	}
+	if err := msg.OfferCoin.Validate(); err != nil {
+		return sdkerrors.Wrap(err, "invalid offer coin")
+	}
+	if msg.OfferCoin.Amount.LT(amm.MinCoinAmount) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "offer coin %s is smaller than the min amount %s", msg.OfferCoin, amm.MinCoinAmount)
+	}
+	if msg.OfferCoin.Amount.GT(amm.MaxCoinAmount) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "offer coin %s is bigger than the max amount %s", msg.OfferCoin, amm.MaxCoinAmount)
+	}
+	if msg.Amount.LT(amm.MinCoinAmount) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "order amount %s is smaller than the min amount %s", msg.Amount, amm.MinCoinAmount)
+	}
+	if msg.Amount.GT(amm.MaxCoinAmount) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "order amount %s is bigger than the max amount %s", msg.Amount, amm.MaxCoinAmount)
+	}
+	var minOfferCoin sdk.Coin
+	switch msg.Direction {
+	case OrderDirectionBuy:
+		minOfferCoin = sdk.NewCoin(msg.OfferCoin.Denom, amm.OfferCoinAmount(amm.Buy, price, msg.Amount))
+	case OrderDirectionSell:
+		minOfferCoin = sdk.NewCoin(msg.OfferCoin.Denom, msg.Amount)
+	}
+	if msg.OfferCoin.IsLT(minOfferCoin) {
+		return sdkerrors.Wrapf(ErrInsufficientOfferCoin, "%s is less than %s", msg.OfferCoin, minOfferCoin)
+	}
+	if msg.OfferCoin.Denom == msg.DemandCoinDenom {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "offer coin denom and demand coin denom must not be same")
+	}
+	if msg.OrderLifespan < 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "order lifespan must not be negative: %s", msg.OrderLifespan)
+	}
+	return nil
+}
+
+func (msg MsgConditionalOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgConditionalOrder) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Orderer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgConditionalOrder) GetOrderer() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Orderer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgCancelConditionalOrder creates a new MsgCancelConditionalOrder.
+func NewMsgCancelConditionalOrder(
+	orderer sdk.AccAddress,
+	pairId uint64,
+	orderId uint64,
+) *MsgCancelConditionalOrder {
+	return &MsgCancelConditionalOrder{
+		Orderer: orderer.String(),
+		PairId:  pairId,
+		OrderId: orderId,
+	}
+}
+
+func (msg MsgCancelConditionalOrder) Route() string { return RouterKey }
+
+func (msg MsgCancelConditionalOrder) Type() string { return TypeMsgCancelConditionalOrder }
+
+func (msg MsgCancelConditionalOrder) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Orderer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid orderer address: %v", err)
+	}
+	if msg.PairId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pair id must not be 0")
+	}
+	if msg.OrderId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "order id must not be 0")
+	}
+	return nil
+}
+
+func (msg MsgCancelConditionalOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCancelConditionalOrder) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Orderer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgCancelConditionalOrder) GetOrderer() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Orderer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgRoutedSwap returns a new MsgRoutedSwap.
+func NewMsgRoutedSwap(
+	orderer sdk.AccAddress,
+	pairIds []uint64,
+	offerCoin sdk.Coin,
+	demandCoinDenom string,
+	minDemandAmt sdk.Int,
+) *MsgRoutedSwap {
+	return &MsgRoutedSwap{
+		Orderer:         orderer.String(),
+		PairIds:         pairIds,
+		OfferCoin:       offerCoin,
+		DemandCoinDenom: demandCoinDenom,
+		MinDemandAmount: minDemandAmt,
+	}
+}
+
+func (msg MsgRoutedSwap) Route() string { return RouterKey }
+
+func (msg MsgRoutedSwap) Type() string { return TypeMsgRoutedSwap }
+
+func (msg MsgRoutedSwap) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Orderer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid orderer address: %v", err)
+	}
+	if len(msg.PairIds) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pair ids must not be empty")
+	}
+	if len(msg.PairIds) > MaxNumRoutedSwapHops {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "number of pair ids must not exceed %d", MaxNumRoutedSwapHops)
+	}
+	pairIdSet := map[uint64]struct{}{}
+	for _, pairId := range msg.PairIds {
+		if pairId == 0 {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pair id must not be 0")
+		}
+		if _, ok := pairIdSet[pairId]; ok {
+			return ErrDuplicatePairId
+		}
+		pairIdSet[pairId] = struct{}{}
+	}
+	if err := msg.OfferCoin.Validate(); err != nil {
+		return sdkerrors.Wrap(err, "invalid offer coin")
+	}
+	if msg.OfferCoin.Amount.LT(amm.MinCoinAmount) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "offer coin %s is smaller than the min amount %s", msg.OfferCoin, amm.MinCoinAmount)
+	}
+	if msg.OfferCoin.Amount.GT(amm.MaxCoinAmount) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "offer coin %s is bigger than the max amount %s", msg.OfferCoin, amm.MaxCoinAmount)
+	}
+	if err := sdk.ValidateDenom(msg.DemandCoinDenom); err != nil {
+		return sdkerrors.Wrap(err, "invalid demand coin denom")
+	}
+	if msg.OfferCoin.Denom == msg.DemandCoinDenom {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "offer coin denom and demand coin denom must not be same")
+	}
+	if msg.MinDemandAmount.IsNil() || msg.MinDemandAmount.IsNegative() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "min demand amount must not be negative")
+	}
+	return nil
+}
+
+func (msg MsgRoutedSwap) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgRoutedSwap) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Orderer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgRoutedSwap) GetOrderer() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Orderer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgAmendOrder returns a new MsgAmendOrder.
+func NewMsgAmendOrder(
+	orderer sdk.AccAddress,
+	pairId uint64,
+	orderId uint64,
+	price sdk.Dec,
+	amt sdk.Int,
+	orderLifespan time.Duration,
+) *MsgAmendOrder {
+	return &MsgAmendOrder{
+		Orderer:       orderer.String(),
+		PairId:        pairId,
+		OrderId:       orderId,
+		Price:         price,
+		Amount:        amt,
+		OrderLifespan: orderLifespan,
+	}
+}
+
+func (msg MsgAmendOrder) Route() string { return RouterKey }
+
+func (msg MsgAmendOrder) Type() string { return TypeMsgAmendOrder }
+
+func (msg MsgAmendOrder) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Orderer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid orderer address: %v", err)
+	}
+	if msg.PairId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pair id must not be 0")
+	}
+	if msg.OrderId == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "order id must not be 0")
+	}
+	if !msg.AmendsPrice() && !msg.AmendsAmount() && !msg.AmendsLifespan() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "at least one of price, amount and order lifespan must be amended")
+	}
+	if msg.AmendsPrice() && msg.Price.IsNegative() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "price must be positive")
+	}
+	if msg.AmendsAmount() {
+		if msg.Amount.LT(amm.MinCoinAmount) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "order amount %s is smaller than the min amount %s", msg.Amount, amm.MinCoinAmount)
+		}
+		if msg.Amount.GT(amm.MaxCoinAmount) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "order amount %s is bigger than the max amount %s", msg.Amount, amm.MaxCoinAmount)
+		}
+	}
+	if msg.OrderLifespan < 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "order lifespan must not be negative: %s", msg.OrderLifespan)
+	}
+	return nil
+}
+
+func (msg MsgAmendOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgAmendOrder) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Orderer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgAmendOrder) GetOrderer() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Orderer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// AmendsPrice returns whether the message amends the order price.
+func (msg MsgAmendOrder) AmendsPrice() bool {
+	return !msg.Price.IsNil() && !msg.Price.IsZero()
+}
+
+// AmendsAmount returns whether the message amends the order amount.
+func (msg MsgAmendOrder) AmendsAmount() bool {
+	return !msg.Amount.IsNil() && !msg.Amount.IsZero()
+}
+
+// AmendsLifespan returns whether the message amends the order lifespan.
+func (msg MsgAmendOrder) AmendsLifespan() bool {
+	return msg.OrderLifespan != 0
+}
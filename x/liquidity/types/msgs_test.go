@@ -10,6 +10,7 @@ import (
 	"github.com/tendermint/tendermint/crypto"
 
 	utils "github.com/cosmosquad-labs/squad/v3/types"
+	"github.com/cosmosquad-labs/squad/v3/x/liquidity/amm"
 	"github.com/cosmosquad-labs/squad/v3/x/liquidity/types"
 )
 
@@ -52,6 +53,13 @@ func TestMsgCreatePair(t *testing.T) {
 			},
 			"cannot use same denom for both base coin and quote coin: invalid request",
 		},
+		{
+			"invalid swap fee rate",
+			func(msg *types.MsgCreatePair) {
+				msg.SwapFeeRate = utils.ParseDecP("-0.01")
+			},
+			"swap fee rate must not be negative: -0.010000000000000000: invalid request",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			msg := types.NewMsgCreatePair(testAddr, "denom1", "denom2")
@@ -252,6 +260,79 @@ func TestMsgCreateRangedPool(t *testing.T) {
 	}
 }
 
+func TestMsgCreateStableswapPool(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(msg *types.MsgCreateStableswapPool)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(msg *types.MsgCreateStableswapPool) {},
+			"", // empty means no error expected
+		},
+		{
+			"invalid pair id",
+			func(msg *types.MsgCreateStableswapPool) {
+				msg.PairId = 0
+			},
+			"pair id must not be 0: invalid request",
+		},
+		{
+			"invalid creator",
+			func(msg *types.MsgCreateStableswapPool) {
+				msg.Creator = "invalidaddr"
+			},
+			"invalid creator address: decoding bech32 failed: invalid separator index -1: invalid address",
+		},
+		{
+			"invalid deposit coins",
+			func(msg *types.MsgCreateStableswapPool) {
+				msg.DepositCoins = utils.ParseCoins("1000000denom1")
+			},
+			"wrong number of deposit coins: 1: invalid request",
+		},
+		{
+			"too large deposit coins",
+			func(msg *types.MsgCreateStableswapPool) {
+				msg.DepositCoins = utils.ParseCoins("100000000000000000000000000000000000000000denom1,100000000000000000000000000000000000000000denom2")
+			},
+			"deposit coin 100000000000000000000000000000000000000000denom1 is bigger than the max amount 10000000000000000000000000000000000000000: invalid request",
+		},
+		{
+			"zero amplification",
+			func(msg *types.MsgCreateStableswapPool) {
+				msg.Amplification = 0
+			},
+			"amplification must be in range [1, 10000]: 0: invalid request",
+		},
+		{
+			"too large amplification",
+			func(msg *types.MsgCreateStableswapPool) {
+				msg.Amplification = 10001
+			},
+			"amplification must be in range [1, 10000]: 10001: invalid request",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgCreateStableswapPool(
+				testAddr, 1, utils.ParseCoins("1000000denom1,1000000denom2"), 100)
+			tc.malleate(msg)
+			require.Equal(t, types.TypeMsgCreateStableswapPool, msg.Type())
+			require.Equal(t, types.RouterKey, msg.Route())
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				signers := msg.GetSigners()
+				require.Len(t, signers, 1)
+				require.Equal(t, msg.GetCreator(), signers[0])
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
 func TestMsgDeposit(t *testing.T) {
 	testCases := []struct {
 		name        string
@@ -469,6 +550,35 @@ func TestMsgLimitOrder(t *testing.T) {
 			},
 			"order lifespan must not be negative: -1ns: invalid request",
 		},
+		{
+			"fill-or-kill order",
+			func(msg *types.MsgLimitOrder) {
+				msg.TimeInForce = types.TimeInForceFillOrKill
+			},
+			"",
+		},
+		{
+			"invalid time in force",
+			func(msg *types.MsgLimitOrder) {
+				msg.TimeInForce = 3
+			},
+			"invalid time in force: 3: invalid request",
+		},
+		{
+			"post-only order",
+			func(msg *types.MsgLimitOrder) {
+				msg.PostOnly = true
+			},
+			"",
+		},
+		{
+			"post-only immediate-or-cancel order",
+			func(msg *types.MsgLimitOrder) {
+				msg.PostOnly = true
+				msg.TimeInForce = types.TimeInForceImmediateOrCancel
+			},
+			"post-only order must be good-til-canceled: TIME_IN_FORCE_IMMEDIATE_OR_CANCEL: invalid request",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			msg := types.NewMsgLimitOrder(
@@ -882,3 +992,645 @@ func TestMsgCancelMMOrder(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgCreatePosition(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(msg *types.MsgCreatePosition)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(msg *types.MsgCreatePosition) {},
+			"",
+		},
+		{
+			"single coin deposit",
+			func(msg *types.MsgCreatePosition) {
+				msg.DepositCoins = utils.ParseCoins("1000000denom1")
+			},
+			"",
+		},
+		{
+			"invalid owner",
+			func(msg *types.MsgCreatePosition) {
+				msg.Owner = "invalidaddr"
+			},
+			"invalid owner address: decoding bech32 failed: invalid separator index -1: invalid address",
+		},
+		{
+			"zero pair id",
+			func(msg *types.MsgCreatePosition) {
+				msg.PairId = 0
+			},
+			"pair id must not be 0: invalid request",
+		},
+		{
+			"no deposit coins",
+			func(msg *types.MsgCreatePosition) {
+				msg.DepositCoins = sdk.Coins{}
+			},
+			"wrong number of deposit coins: 0: invalid request",
+		},
+		{
+			"too many deposit coins",
+			func(msg *types.MsgCreatePosition) {
+				msg.DepositCoins = utils.ParseCoins("1000000denom1,1000000denom2,1000000denom3")
+			},
+			"wrong number of deposit coins: 3: invalid request",
+		},
+		{
+			"too large deposit coin",
+			func(msg *types.MsgCreatePosition) {
+				msg.DepositCoins = sdk.NewCoins(sdk.NewCoin("denom1", amm.MaxCoinAmount.AddRaw(1)))
+			},
+			"deposit coin 10000000000000000000000000000000000000001denom1 is bigger than the max amount 10000000000000000000000000000000000000000: invalid request",
+		},
+		{
+			"max price lower than min price",
+			func(msg *types.MsgCreatePosition) {
+				msg.MinPrice = utils.ParseDec("1.1")
+				msg.MaxPrice = utils.ParseDec("0.9")
+			},
+			"max price must be higher than min price: invalid request",
+		},
+		{
+			"too close min price and max price",
+			func(msg *types.MsgCreatePosition) {
+				msg.MinPrice = utils.ParseDec("1.0")
+				msg.MaxPrice = utils.ParseDec("1.0001")
+			},
+			"min price and max price are too close: invalid request",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgCreatePosition(
+				sdk.AccAddress(crypto.AddressHash([]byte("owner"))), 1,
+				utils.ParseCoins("1000000denom1,1000000denom2"), utils.ParseDec("0.9"), utils.ParseDec("1.1"))
+			tc.malleate(msg)
+			require.Equal(t, types.TypeMsgCreatePosition, msg.Type())
+			require.Equal(t, types.RouterKey, msg.Route())
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				signers := msg.GetSigners()
+				require.Len(t, signers, 1)
+				require.Equal(t, msg.GetOwner(), signers[0])
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestMsgClosePosition(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(msg *types.MsgClosePosition)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(msg *types.MsgClosePosition) {},
+			"",
+		},
+		{
+			"invalid owner",
+			func(msg *types.MsgClosePosition) {
+				msg.Owner = "invalidaddr"
+			},
+			"invalid owner address: decoding bech32 failed: invalid separator index -1: invalid address",
+		},
+		{
+			"zero position id",
+			func(msg *types.MsgClosePosition) {
+				msg.PositionId = 0
+			},
+			"position id must not be 0: invalid request",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgClosePosition(sdk.AccAddress(crypto.AddressHash([]byte("owner"))), 1)
+			tc.malleate(msg)
+			require.Equal(t, types.TypeMsgClosePosition, msg.Type())
+			require.Equal(t, types.RouterKey, msg.Route())
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				signers := msg.GetSigners()
+				require.Len(t, signers, 1)
+				require.Equal(t, msg.GetOwner(), signers[0])
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestMsgDepositPosition(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(msg *types.MsgDepositPosition)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(msg *types.MsgDepositPosition) {},
+			"",
+		},
+		{
+			"invalid owner",
+			func(msg *types.MsgDepositPosition) {
+				msg.Owner = "invalidaddr"
+			},
+			"invalid owner address: decoding bech32 failed: invalid separator index -1: invalid address",
+		},
+		{
+			"zero position id",
+			func(msg *types.MsgDepositPosition) {
+				msg.PositionId = 0
+			},
+			"position id must not be 0: invalid request",
+		},
+		{
+			"no deposit coins",
+			func(msg *types.MsgDepositPosition) {
+				msg.DepositCoins = sdk.Coins{}
+			},
+			"wrong number of deposit coins: 0: invalid request",
+		},
+		{
+			"too many deposit coins",
+			func(msg *types.MsgDepositPosition) {
+				msg.DepositCoins = utils.ParseCoins("1000000denom1,1000000denom2,1000000denom3")
+			},
+			"wrong number of deposit coins: 3: invalid request",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgDepositPosition(
+				sdk.AccAddress(crypto.AddressHash([]byte("owner"))), 1, utils.ParseCoins("1000000denom1,1000000denom2"))
+			tc.malleate(msg)
+			require.Equal(t, types.TypeMsgDepositPosition, msg.Type())
+			require.Equal(t, types.RouterKey, msg.Route())
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				signers := msg.GetSigners()
+				require.Len(t, signers, 1)
+				require.Equal(t, msg.GetOwner(), signers[0])
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestMsgWithdrawPosition(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(msg *types.MsgWithdrawPosition)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(msg *types.MsgWithdrawPosition) {},
+			"",
+		},
+		{
+			"invalid owner",
+			func(msg *types.MsgWithdrawPosition) {
+				msg.Owner = "invalidaddr"
+			},
+			"invalid owner address: decoding bech32 failed: invalid separator index -1: invalid address",
+		},
+		{
+			"zero position id",
+			func(msg *types.MsgWithdrawPosition) {
+				msg.PositionId = 0
+			},
+			"position id must not be 0: invalid request",
+		},
+		{
+			"zero ratio",
+			func(msg *types.MsgWithdrawPosition) {
+				msg.Ratio = sdk.ZeroDec()
+			},
+			"ratio must be positive: 0.000000000000000000: invalid request",
+		},
+		{
+			"ratio of 1",
+			func(msg *types.MsgWithdrawPosition) {
+				msg.Ratio = sdk.OneDec()
+			},
+			"ratio must be less than 1: 1.000000000000000000: invalid request",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgWithdrawPosition(
+				sdk.AccAddress(crypto.AddressHash([]byte("owner"))), 1, utils.ParseDec("0.5"))
+			tc.malleate(msg)
+			require.Equal(t, types.TypeMsgWithdrawPosition, msg.Type())
+			require.Equal(t, types.RouterKey, msg.Route())
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				signers := msg.GetSigners()
+				require.Len(t, signers, 1)
+				require.Equal(t, msg.GetOwner(), signers[0])
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestMsgConditionalOrder(t *testing.T) {
+	orderLifespan := 20 * time.Second
+	for _, tc := range []struct {
+		name        string
+		malleate    func(msg *types.MsgConditionalOrder)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(msg *types.MsgConditionalOrder) {},
+			"", // empty means no error expected
+		},
+		{
+			"happy case with market order",
+			func(msg *types.MsgConditionalOrder) {
+				msg.OrderType = types.OrderTypeMarket
+				msg.Price = sdk.Dec{}
+			},
+			"",
+		},
+		{
+			"invalid orderer",
+			func(msg *types.MsgConditionalOrder) {
+				msg.Orderer = "invalidaddr"
+			},
+			"invalid orderer address: decoding bech32 failed: invalid separator index -1: invalid address",
+		},
+		{
+			"invalid pair id",
+			func(msg *types.MsgConditionalOrder) {
+				msg.PairId = 0
+			},
+			"pair id must not be 0: invalid request",
+		},
+		{
+			"invalid conditional order type",
+			func(msg *types.MsgConditionalOrder) {
+				msg.ConditionalOrderType = types.ConditionalOrderTypeUnspecified
+			},
+			"invalid conditional order type: CONDITIONAL_ORDER_TYPE_UNSPECIFIED: invalid request",
+		},
+		{
+			"invalid order type",
+			func(msg *types.MsgConditionalOrder) {
+				msg.OrderType = types.OrderTypeMM
+			},
+			"invalid order type: ORDER_TYPE_MM: invalid request",
+		},
+		{
+			"invalid direction",
+			func(msg *types.MsgConditionalOrder) {
+				msg.Direction = 0
+			},
+			"invalid order direction: ORDER_DIRECTION_UNSPECIFIED: invalid request",
+		},
+		{
+			"invalid trigger price",
+			func(msg *types.MsgConditionalOrder) {
+				msg.TriggerPrice = utils.ParseDec("0")
+			},
+			"trigger price must be positive: invalid request",
+		},
+		{
+			"invalid price",
+			func(msg *types.MsgConditionalOrder) {
+				msg.Price = utils.ParseDec("0")
+			},
+			"price must be positive: invalid request",
+		},
+		{
+			"price for market order",
+			func(msg *types.MsgConditionalOrder) {
+				msg.OrderType = types.OrderTypeMarket
+			},
+			"price must not be specified for market orders: invalid request",
+		},
+		{
+			"small offer coin amount",
+			func(msg *types.MsgConditionalOrder) {
+				msg.OfferCoin = utils.ParseCoin("10denom2")
+			},
+			"offer coin 10denom2 is smaller than the min amount 100: invalid request",
+		},
+		{
+			"insufficient offer coin amount",
+			func(msg *types.MsgConditionalOrder) {
+				msg.Price = utils.ParseDec("10")
+			},
+			"1000000denom2 is less than 10000000denom2: insufficient offer coin",
+		},
+		{
+			"insufficient offer coin amount for market order",
+			func(msg *types.MsgConditionalOrder) {
+				msg.OrderType = types.OrderTypeMarket
+				msg.Price = sdk.Dec{}
+				msg.TriggerPrice = utils.ParseDec("10")
+			},
+			"1000000denom2 is less than 10000000denom2: insufficient offer coin",
+		},
+		{
+			"same offer coin denom and demand coin denom",
+			func(msg *types.MsgConditionalOrder) {
+				msg.DemandCoinDenom = "denom2"
+			},
+			"offer coin denom and demand coin denom must not be same: invalid request",
+		},
+		{
+			"small order amount",
+			func(msg *types.MsgConditionalOrder) {
+				msg.Amount = newInt(10)
+			},
+			"order amount 10 is smaller than the min amount 100: invalid request",
+		},
+		{
+			"invalid order lifespan",
+			func(msg *types.MsgConditionalOrder) {
+				msg.OrderLifespan = -1
+			},
+			"order lifespan must not be negative: -1ns: invalid request",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgConditionalOrder(
+				testAddr, 1, types.ConditionalOrderTypeStopLoss, types.OrderTypeLimit, types.OrderDirectionBuy,
+				utils.ParseCoin("1000000denom2"), "denom1", utils.ParseDec("1.0"), utils.ParseDec("1.0"),
+				newInt(1000000), orderLifespan)
+			tc.malleate(msg)
+			require.Equal(t, types.TypeMsgConditionalOrder, msg.Type())
+			require.Equal(t, types.RouterKey, msg.Route())
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				signers := msg.GetSigners()
+				require.Len(t, signers, 1)
+				require.Equal(t, msg.GetOrderer(), signers[0])
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestMsgCancelConditionalOrder(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(msg *types.MsgCancelConditionalOrder)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(msg *types.MsgCancelConditionalOrder) {},
+			"", // empty means no error expected
+		},
+		{
+			"invalid orderer",
+			func(msg *types.MsgCancelConditionalOrder) {
+				msg.Orderer = "invalidaddr"
+			},
+			"invalid orderer address: decoding bech32 failed: invalid separator index -1: invalid address",
+		},
+		{
+			"invalid pair id",
+			func(msg *types.MsgCancelConditionalOrder) {
+				msg.PairId = 0
+			},
+			"pair id must not be 0: invalid request",
+		},
+		{
+			"invalid order id",
+			func(msg *types.MsgCancelConditionalOrder) {
+				msg.OrderId = 0
+			},
+			"order id must not be 0: invalid request",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgCancelConditionalOrder(testAddr, 1, 1)
+			tc.malleate(msg)
+			require.Equal(t, types.TypeMsgCancelConditionalOrder, msg.Type())
+			require.Equal(t, types.RouterKey, msg.Route())
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				signers := msg.GetSigners()
+				require.Len(t, signers, 1)
+				require.Equal(t, msg.GetOrderer(), signers[0])
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestMsgRoutedSwap(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(msg *types.MsgRoutedSwap)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(msg *types.MsgRoutedSwap) {},
+			"", // empty means no error expected
+		},
+		{
+			"invalid orderer",
+			func(msg *types.MsgRoutedSwap) {
+				msg.Orderer = "invalidaddr"
+			},
+			"invalid orderer address: decoding bech32 failed: invalid separator index -1: invalid address",
+		},
+		{
+			"empty pair ids",
+			func(msg *types.MsgRoutedSwap) {
+				msg.PairIds = nil
+			},
+			"pair ids must not be empty: invalid request",
+		},
+		{
+			"too many pair ids",
+			func(msg *types.MsgRoutedSwap) {
+				msg.PairIds = []uint64{1, 2, 3, 4, 5, 6}
+			},
+			"number of pair ids must not exceed 5: invalid request",
+		},
+		{
+			"invalid pair id",
+			func(msg *types.MsgRoutedSwap) {
+				msg.PairIds = []uint64{1, 0}
+			},
+			"pair id must not be 0: invalid request",
+		},
+		{
+			"duplicate pair id",
+			func(msg *types.MsgRoutedSwap) {
+				msg.PairIds = []uint64{1, 2, 1}
+			},
+			"duplicate pair id presents in the pair id list",
+		},
+		{
+			"invalid offer coin",
+			func(msg *types.MsgRoutedSwap) {
+				msg.OfferCoin = sdk.Coin{Denom: "denom1", Amount: sdk.NewInt(-1)}
+			},
+			"invalid offer coin: negative coin amount: -1",
+		},
+		{
+			"too small offer coin",
+			func(msg *types.MsgRoutedSwap) {
+				msg.OfferCoin = utils.ParseCoin("10denom1")
+			},
+			"offer coin 10denom1 is smaller than the min amount 100: invalid request",
+		},
+		{
+			"invalid demand coin denom",
+			func(msg *types.MsgRoutedSwap) {
+				msg.DemandCoinDenom = "invaliddenom!"
+			},
+			"invalid demand coin denom: invalid denom: invaliddenom!",
+		},
+		{
+			"same offer coin denom and demand coin denom",
+			func(msg *types.MsgRoutedSwap) {
+				msg.DemandCoinDenom = "denom1"
+			},
+			"offer coin denom and demand coin denom must not be same: invalid request",
+		},
+		{
+			"negative min demand amount",
+			func(msg *types.MsgRoutedSwap) {
+				msg.MinDemandAmount = sdk.NewInt(-1)
+			},
+			"min demand amount must not be negative: invalid request",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgRoutedSwap(testAddr, []uint64{1, 2}, utils.ParseCoin("1000000denom1"), "denom3", sdk.NewInt(990000))
+			tc.malleate(msg)
+			require.Equal(t, types.TypeMsgRoutedSwap, msg.Type())
+			require.Equal(t, types.RouterKey, msg.Route())
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				signers := msg.GetSigners()
+				require.Len(t, signers, 1)
+				require.Equal(t, msg.GetOrderer(), signers[0])
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestMsgAmendOrder(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(msg *types.MsgAmendOrder)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(msg *types.MsgAmendOrder) {},
+			"", // empty means no error expected
+		},
+		{
+			"only price",
+			func(msg *types.MsgAmendOrder) {
+				msg.Amount = sdk.ZeroInt()
+				msg.OrderLifespan = 0
+			},
+			"",
+		},
+		{
+			"only lifespan",
+			func(msg *types.MsgAmendOrder) {
+				msg.Price = sdk.Dec{}
+				msg.Amount = sdk.Int{}
+			},
+			"",
+		},
+		{
+			"invalid orderer",
+			func(msg *types.MsgAmendOrder) {
+				msg.Orderer = "invalidaddr"
+			},
+			"invalid orderer address: decoding bech32 failed: invalid separator index -1: invalid address",
+		},
+		{
+			"invalid pair id",
+			func(msg *types.MsgAmendOrder) {
+				msg.PairId = 0
+			},
+			"pair id must not be 0: invalid request",
+		},
+		{
+			"invalid order id",
+			func(msg *types.MsgAmendOrder) {
+				msg.OrderId = 0
+			},
+			"order id must not be 0: invalid request",
+		},
+		{
+			"nothing amended",
+			func(msg *types.MsgAmendOrder) {
+				msg.Price = sdk.ZeroDec()
+				msg.Amount = sdk.ZeroInt()
+				msg.OrderLifespan = 0
+			},
+			"at least one of price, amount and order lifespan must be amended: invalid request",
+		},
+		{
+			"negative price",
+			func(msg *types.MsgAmendOrder) {
+				msg.Price = utils.ParseDec("-1.0")
+			},
+			"price must be positive: invalid request",
+		},
+		{
+			"too small amount",
+			func(msg *types.MsgAmendOrder) {
+				msg.Amount = sdk.NewInt(10)
+			},
+			"order amount 10 is smaller than the min amount 100: invalid request",
+		},
+		{
+			"negative order lifespan",
+			func(msg *types.MsgAmendOrder) {
+				msg.OrderLifespan = -time.Hour
+			},
+			"order lifespan must not be negative: -1h0m0s: invalid request",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgAmendOrder(testAddr, 1, 1, utils.ParseDec("1.0"), sdk.NewInt(1000000), time.Hour)
+			tc.malleate(msg)
+			require.Equal(t, types.TypeMsgAmendOrder, msg.Type())
+			require.Equal(t, types.RouterKey, msg.Route())
+			err := msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				signers := msg.GetSigners()
+				require.Len(t, signers, 1)
+				require.Equal(t, msg.GetOrderer(), signers[0])
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
@@ -70,7 +70,7 @@ func (order *UserOrder) HasPriority(other amm.Order) bool {
 	switch other := other.(type) {
 	case *UserOrder:
 		return order.OrderId < other.OrderId
-	case *PoolOrder:
+	case *RoutedSwapOrder, *PoolOrder, *PositionOrder:
 		return true
 	default:
 		panic(fmt.Errorf("invalid order type: %T", other))
@@ -106,10 +106,12 @@ func (order *PoolOrder) HasPriority(other amm.Order) bool {
 		return order.BaseOrder.HasPriority(other)
 	}
 	switch other := other.(type) {
-	case *UserOrder:
+	case *UserOrder, *RoutedSwapOrder:
 		return false
 	case *PoolOrder:
 		return order.PoolId < other.PoolId
+	case *PositionOrder:
+		return true
 	default:
 		panic(fmt.Errorf("invalid order type: %T", other))
 	}
@@ -119,3 +121,86 @@ func (order *PoolOrder) String() string {
 	return fmt.Sprintf("PoolOrder(%d,%s,%s,%s)",
 		order.PoolId, order.Direction, order.Price, order.Amount)
 }
+
+type PositionOrder struct {
+	*amm.BaseOrder
+	PositionId                      uint64
+	ReserveAddress                  sdk.AccAddress
+	OfferCoinDenom, DemandCoinDenom string
+}
+
+func NewPositionOrder(
+	positionId uint64, reserveAddr sdk.AccAddress, dir amm.OrderDirection, price sdk.Dec, amt sdk.Int,
+	offerCoinDenom, demandCoinDenom string) *PositionOrder {
+	return &PositionOrder{
+		BaseOrder:       amm.NewBaseOrder(dir, price, amt, amm.OfferCoinAmount(dir, price, amt)),
+		PositionId:      positionId,
+		ReserveAddress:  reserveAddr,
+		OfferCoinDenom:  offerCoinDenom,
+		DemandCoinDenom: demandCoinDenom,
+	}
+}
+
+func (order *PositionOrder) HasPriority(other amm.Order) bool {
+	if !order.Amount.Equal(other.GetAmount()) {
+		return order.BaseOrder.HasPriority(other)
+	}
+	switch other := other.(type) {
+	case *UserOrder, *RoutedSwapOrder, *PoolOrder:
+		return false
+	case *PositionOrder:
+		return order.PositionId < other.PositionId
+	default:
+		panic(fmt.Errorf("invalid order type: %T", other))
+	}
+}
+
+func (order *PositionOrder) String() string {
+	return fmt.Sprintf("PositionOrder(%d,%s,%s,%s)",
+		order.PositionId, order.Direction, order.Price, order.Amount)
+}
+
+// RoutedSwapOrder is a leg of a routed swap request, which is matched as
+// a fill-or-kill order in the batch of the leg's pair.
+type RoutedSwapOrder struct {
+	*amm.BaseOrder
+	RequestId                       uint64
+	Orderer                         sdk.AccAddress
+	Recipient                       sdk.AccAddress // the next leg's pair escrow, or the orderer for the last leg
+	OfferCoinDenom, DemandCoinDenom string
+}
+
+// NewRoutedSwapOrder returns a new routed swap order.
+func NewRoutedSwapOrder(
+	requestId uint64, orderer, recipient sdk.AccAddress, dir amm.OrderDirection, price sdk.Dec, amt sdk.Int,
+	offerCoin sdk.Coin, demandCoinDenom string) *RoutedSwapOrder {
+	return &RoutedSwapOrder{
+		BaseOrder:       amm.NewBaseOrder(dir, price, amt, offerCoin.Amount),
+		RequestId:       requestId,
+		Orderer:         orderer,
+		Recipient:       recipient,
+		OfferCoinDenom:  offerCoin.Denom,
+		DemandCoinDenom: demandCoinDenom,
+	}
+}
+
+func (order *RoutedSwapOrder) HasPriority(other amm.Order) bool {
+	if !order.Amount.Equal(other.GetAmount()) {
+		return order.BaseOrder.HasPriority(other)
+	}
+	switch other := other.(type) {
+	case *UserOrder:
+		return false
+	case *RoutedSwapOrder:
+		return order.RequestId < other.RequestId
+	case *PoolOrder, *PositionOrder:
+		return true
+	default:
+		panic(fmt.Errorf("invalid order type: %T", other))
+	}
+}
+
+func (order *RoutedSwapOrder) String() string {
+	return fmt.Sprintf("RoutedSwapOrder(%d,%s,%s,%s)",
+		order.RequestId, order.Direction, order.Price, order.Amount)
+}
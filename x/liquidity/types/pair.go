@@ -54,6 +54,27 @@ func (pair Pair) Validate() error {
 	if pair.CurrentBatchId == 0 {
 		return fmt.Errorf("current batch id must not be 0")
 	}
+	if pair.SwapFeeRate != nil {
+		if err := ValidateSwapFeeRate(*pair.SwapFeeRate); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SwapFee returns the swap fee charged on the received coin.
+func SwapFee(receivedCoin sdk.Coin, swapFeeRate sdk.Dec) sdk.Coin {
+	return sdk.NewCoin(receivedCoin.Denom, swapFeeRate.MulInt(receivedCoin.Amount).TruncateInt())
+}
+
+// ValidateSwapFeeRate validates a swap fee rate of a pair.
+func ValidateSwapFeeRate(rate sdk.Dec) error {
+	if rate.IsNegative() {
+		return fmt.Errorf("swap fee rate must not be negative: %s", rate)
+	}
+	if !rate.LT(sdk.OneDec()) {
+		return fmt.Errorf("swap fee rate must be less than 1: %s", rate)
+	}
 	return nil
 }
 
@@ -63,6 +63,14 @@ func TestPair_Validate(t *testing.T) {
 			},
 			"current batch id must not be 0",
 		},
+		{
+			"",
+			func(pair *types.Pair) {
+				rate := sdk.NewDecWithPrec(-1, 2)
+				pair.SwapFeeRate = &rate
+			},
+			"swap fee rate must not be negative: -0.010000000000000000",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			pair := types.NewPair(1, "denom1", "denom2")
@@ -90,3 +98,19 @@ func TestPairEscrowAddress(t *testing.T) {
 		})
 	}
 }
+
+func TestSwapFee(t *testing.T) {
+	for _, tc := range []struct {
+		receivedCoin sdk.Coin
+		rate         sdk.Dec
+		expected     sdk.Coin
+	}{
+		{sdk.NewInt64Coin("denom1", 1000000), sdk.NewDecWithPrec(3, 3), sdk.NewInt64Coin("denom1", 3000)},
+		{sdk.NewInt64Coin("denom1", 999), sdk.NewDecWithPrec(3, 3), sdk.NewInt64Coin("denom1", 2)},
+		{sdk.NewInt64Coin("denom1", 1000000), sdk.ZeroDec(), sdk.NewInt64Coin("denom1", 0)},
+	} {
+		t.Run("", func(t *testing.T) {
+			require.True(t, tc.expected.IsEqual(types.SwapFee(tc.receivedCoin, tc.rate)))
+		})
+	}
+}
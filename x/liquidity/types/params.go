@@ -16,6 +16,8 @@ const (
 	DefaultTickPrecision                uint32 = 4
 	DefaultMaxNumMarketMakingOrderTicks        = 10
 	DefaultMaxOrderLifespan                    = 24 * time.Hour
+	DefaultTradeRecordRetention         uint32 = 17280 // about a day with 5s blocks
+	DefaultMaxTWAPWindow                       = 24 * time.Hour
 )
 
 // Liquidity params default values
@@ -28,22 +30,42 @@ var (
 	DefaultMinInitialDepositAmount  = sdk.NewInt(1000000)
 	DefaultMaxPriceLimitRatio       = sdk.NewDecWithPrec(1, 1) // 10%
 	DefaultSwapFeeRate              = sdk.ZeroDec()
+	DefaultPoolSwapFeeRatio         = sdk.NewDecWithPrec(5, 1) // 50%
+	DefaultMakerFeeRate             = sdk.ZeroDec()
+	DefaultTakerFeeRate             = sdk.ZeroDec()
+	DefaultPositionCreationFee      = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000))
 	DefaultWithdrawFeeRate          = sdk.ZeroDec()
 	DefaultDepositExtraGas          = sdk.Gas(60000)
 	DefaultWithdrawExtraGas         = sdk.Gas(64000)
 	DefaultOrderExtraGas            = sdk.Gas(37000)
+
+	// DefaultAllowedSwapFeeRates are the swap fee rates which can be chosen
+	// when creating a pair.
+	DefaultAllowedSwapFeeRates = []sdk.Dec{
+		sdk.NewDecWithPrec(1, 4), // 0.01%
+		sdk.NewDecWithPrec(5, 4), // 0.05%
+		sdk.NewDecWithPrec(3, 3), // 0.3%
+		sdk.NewDecWithPrec(1, 2), // 1%
+	}
 )
 
 // General constants
 const (
-	PoolReserveAddressPrefix  = "PoolReserveAddress"
-	PairEscrowAddressPrefix   = "PairEscrowAddress"
-	ModuleAddressNameSplitter = "|"
-	AddressType               = farmingtypes.AddressType32Bytes
+	PoolReserveAddressPrefix     = "PoolReserveAddress"
+	PositionReserveAddressPrefix = "PositionReserveAddress"
+	PairEscrowAddressPrefix      = "PairEscrowAddress"
+	ModuleAddressNameSplitter    = "|"
+	AddressType                  = farmingtypes.AddressType32Bytes
 
 	// MaxNumActivePoolsPerPair is the maximum number of active(not disabled)
 	// pools per pair.
 	MaxNumActivePoolsPerPair = 50
+
+	// MaxNumPositionsPerPair is the maximum number of positions per pair.
+	MaxNumPositionsPerPair = 200
+
+	// MaxNumRoutedSwapHops is the maximum number of pairs in a routed swap path.
+	MaxNumRoutedSwapHops = 5
 )
 
 var (
@@ -68,6 +90,13 @@ var (
 	KeyDepositExtraGas              = []byte("DepositExtraGas")
 	KeyWithdrawExtraGas             = []byte("WithdrawExtraGas")
 	KeyOrderExtraGas                = []byte("OrderExtraGas")
+	KeyTradeRecordRetention         = []byte("TradeRecordRetention")
+	KeyMaxTWAPWindow                = []byte("MaxTWAPWindow")
+	KeyAllowedSwapFeeRates          = []byte("AllowedSwapFeeRates")
+	KeyPoolSwapFeeRatio             = []byte("PoolSwapFeeRatio")
+	KeyMakerFeeRate                 = []byte("MakerFeeRate")
+	KeyTakerFeeRate                 = []byte("TakerFeeRate")
+	KeyPositionCreationFee          = []byte("PositionCreationFee")
 )
 
 var _ paramstypes.ParamSet = (*Params)(nil)
@@ -95,6 +124,13 @@ func DefaultParams() Params {
 		DepositExtraGas:              DefaultDepositExtraGas,
 		WithdrawExtraGas:             DefaultWithdrawExtraGas,
 		OrderExtraGas:                DefaultOrderExtraGas,
+		TradeRecordRetention:         DefaultTradeRecordRetention,
+		MaxTwapWindow:                DefaultMaxTWAPWindow,
+		AllowedSwapFeeRates:          DefaultAllowedSwapFeeRates,
+		PoolSwapFeeRatio:             DefaultPoolSwapFeeRatio,
+		MakerFeeRate:                 DefaultMakerFeeRate,
+		TakerFeeRate:                 DefaultTakerFeeRate,
+		PositionCreationFee:          DefaultPositionCreationFee,
 	}
 }
 
@@ -117,6 +153,13 @@ func (params *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 		paramstypes.NewParamSetPair(KeyDepositExtraGas, &params.DepositExtraGas, validateExtraGas),
 		paramstypes.NewParamSetPair(KeyWithdrawExtraGas, &params.WithdrawExtraGas, validateExtraGas),
 		paramstypes.NewParamSetPair(KeyOrderExtraGas, &params.OrderExtraGas, validateExtraGas),
+		paramstypes.NewParamSetPair(KeyTradeRecordRetention, &params.TradeRecordRetention, validateTradeRecordRetention),
+		paramstypes.NewParamSetPair(KeyMaxTWAPWindow, &params.MaxTwapWindow, validateMaxTWAPWindow),
+		paramstypes.NewParamSetPair(KeyAllowedSwapFeeRates, &params.AllowedSwapFeeRates, validateAllowedSwapFeeRates),
+		paramstypes.NewParamSetPair(KeyPoolSwapFeeRatio, &params.PoolSwapFeeRatio, validatePoolSwapFeeRatio),
+		paramstypes.NewParamSetPair(KeyMakerFeeRate, &params.MakerFeeRate, validateMakerFeeRate),
+		paramstypes.NewParamSetPair(KeyTakerFeeRate, &params.TakerFeeRate, validateTakerFeeRate),
+		paramstypes.NewParamSetPair(KeyPositionCreationFee, &params.PositionCreationFee, validatePositionCreationFee),
 	}
 }
 
@@ -142,6 +185,13 @@ func (params Params) Validate() error {
 		{params.DepositExtraGas, validateExtraGas},
 		{params.WithdrawExtraGas, validateExtraGas},
 		{params.OrderExtraGas, validateExtraGas},
+		{params.TradeRecordRetention, validateTradeRecordRetention},
+		{params.MaxTwapWindow, validateMaxTWAPWindow},
+		{params.AllowedSwapFeeRates, validateAllowedSwapFeeRates},
+		{params.PoolSwapFeeRatio, validatePoolSwapFeeRatio},
+		{params.MakerFeeRate, validateMakerFeeRate},
+		{params.TakerFeeRate, validateTakerFeeRate},
+		{params.PositionCreationFee, validatePositionCreationFee},
 	} {
 		if err := field.validateFunc(field.val); err != nil {
 			return err
@@ -327,3 +377,111 @@ func validateExtraGas(i interface{}) error {
 
 	return nil
 }
+
+func validateTradeRecordRetention(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMaxTWAPWindow(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("max twap window must be positive: %s", v)
+	}
+
+	return nil
+}
+
+func validateAllowedSwapFeeRates(i interface{}) error {
+	v, ok := i.([]sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for _, rate := range v {
+		if err := ValidateSwapFeeRate(rate); err != nil {
+			return fmt.Errorf("invalid allowed swap fee rate: %w", err)
+		}
+	}
+	for i := range v {
+		for j := i + 1; j < len(v); j++ {
+			if v[i].Equal(v[j]) {
+				return fmt.Errorf("duplicate allowed swap fee rate: %s", v[i])
+			}
+		}
+	}
+
+	return nil
+}
+
+func validatePoolSwapFeeRatio(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("pool swap fee ratio must not be negative: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("pool swap fee ratio must not be greater than 1: %s", v)
+	}
+
+	return nil
+}
+
+func validateMakerFeeRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if !v.GT(sdk.OneDec().Neg()) {
+		return fmt.Errorf("maker fee rate must be greater than -1: %s", v)
+	}
+
+	if !v.LT(sdk.OneDec()) {
+		return fmt.Errorf("maker fee rate must be less than 1: %s", v)
+	}
+
+	return nil
+}
+
+func validateTakerFeeRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("taker fee rate must not be negative: %s", v)
+	}
+
+	if !v.LT(sdk.OneDec()) {
+		return fmt.Errorf("taker fee rate must be less than 1: %s", v)
+	}
+
+	return nil
+}
+
+func validatePositionCreationFee(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("invalid position creation fee: %w", err)
+	}
+
+	return nil
+}
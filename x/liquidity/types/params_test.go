@@ -112,6 +112,76 @@ func TestParams_Validate(t *testing.T) {
 			},
 			"withdraw fee rate must not be negative: -1.000000000000000000",
 		},
+		{
+			"zero MaxTwapWindow",
+			func(params *types.Params) {
+				params.MaxTwapWindow = 0
+			},
+			"max twap window must be positive: 0s",
+		},
+		{
+			"empty AllowedSwapFeeRates",
+			func(params *types.Params) {
+				params.AllowedSwapFeeRates = nil
+			},
+			"",
+		},
+		{
+			"invalid AllowedSwapFeeRates",
+			func(params *types.Params) {
+				params.AllowedSwapFeeRates = []sdk.Dec{sdk.NewDec(1)}
+			},
+			"invalid allowed swap fee rate: swap fee rate must be less than 1: 1.000000000000000000",
+		},
+		{
+			"duplicate AllowedSwapFeeRates",
+			func(params *types.Params) {
+				params.AllowedSwapFeeRates = []sdk.Dec{sdk.NewDecWithPrec(3, 3), sdk.NewDecWithPrec(3, 3)}
+			},
+			"duplicate allowed swap fee rate: 0.003000000000000000",
+		},
+		{
+			"too large PoolSwapFeeRatio",
+			func(params *types.Params) {
+				params.PoolSwapFeeRatio = sdk.NewDecWithPrec(11, 1)
+			},
+			"pool swap fee ratio must not be greater than 1: 1.100000000000000000",
+		},
+		{
+			"negative MakerFeeRate",
+			func(params *types.Params) {
+				params.MakerFeeRate = sdk.NewDecWithPrec(-1, 3)
+			},
+			"",
+		},
+		{
+			"too small MakerFeeRate",
+			func(params *types.Params) {
+				params.MakerFeeRate = sdk.OneDec().Neg()
+			},
+			"maker fee rate must be greater than -1: -1.000000000000000000",
+		},
+		{
+			"too large MakerFeeRate",
+			func(params *types.Params) {
+				params.MakerFeeRate = sdk.OneDec()
+			},
+			"maker fee rate must be less than 1: 1.000000000000000000",
+		},
+		{
+			"negative TakerFeeRate",
+			func(params *types.Params) {
+				params.TakerFeeRate = sdk.NewDecWithPrec(-1, 3)
+			},
+			"taker fee rate must not be negative: -0.001000000000000000",
+		},
+		{
+			"too large TakerFeeRate",
+			func(params *types.Params) {
+				params.TakerFeeRate = sdk.OneDec()
+			},
+			"taker fee rate must be less than 1: 1.000000000000000000",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			params := types.DefaultParams()
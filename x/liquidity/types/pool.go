@@ -14,7 +14,7 @@ import (
 )
 
 var (
-	_ amm.Orderer = (*PoolOrderer)(nil)
+	_ AMMOrderer = (*PoolOrderer)(nil)
 
 	poolCoinDenomRegexp = regexp.MustCompile(`^pool([1-9]\d*)$`)
 )
@@ -78,6 +78,22 @@ func NewRangedPool(id, pairId uint64, creator sdk.AccAddress, minPrice, maxPrice
 	}
 }
 
+// NewStableswapPool returns a new stableswap pool object.
+func NewStableswapPool(id, pairId uint64, creator sdk.AccAddress, amp uint64) Pool {
+	return Pool{
+		Type:                  PoolTypeStableswap,
+		Id:                    id,
+		PairId:                pairId,
+		Creator:               creator.String(),
+		ReserveAddress:        PoolReserveAddress(id).String(),
+		PoolCoinDenom:         PoolCoinDenom(id),
+		LastDepositRequestId:  0,
+		LastWithdrawRequestId: 0,
+		Disabled:              false,
+		Amplification:         amp,
+	}
+}
+
 func (pool Pool) GetCreator() sdk.AccAddress {
 	if pool.Creator == "" {
 		return nil
@@ -111,6 +127,11 @@ func (pool Pool) Validate() error {
 	if err := sdk.ValidateDenom(pool.PoolCoinDenom); err != nil {
 		return fmt.Errorf("invalid pool coin denom: %w", err)
 	}
+	if pool.Type == PoolTypeStableswap {
+		if err := amm.ValidateAmplification(pool.Amplification); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -121,11 +142,20 @@ func (pool Pool) AMMPool(rx, ry, ps sdk.Int) amm.Pool {
 		return amm.NewBasicPool(rx, ry, ps)
 	case PoolTypeRanged:
 		return amm.NewRangedPool(rx, ry, ps, *pool.MinPrice, *pool.MaxPrice)
+	case PoolTypeStableswap:
+		return amm.NewStableswapPool(rx, ry, ps, pool.Amplification)
 	default:
 		panic(fmt.Errorf("invalid pool type: %s", pool.Type))
 	}
 }
 
+// AMMOrderer is an amm.Pool which makes its own orders.
+// Both pools and positions are represented as AMMOrderer during matching.
+type AMMOrderer interface {
+	amm.Pool
+	amm.Orderer
+}
+
 type PoolOrderer struct {
 	amm.Pool
 	Id                            uint64
@@ -0,0 +1,133 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	farmingtypes "github.com/cosmosquad-labs/squad/v3/x/farming/types"
+	"github.com/cosmosquad-labs/squad/v3/x/liquidity/amm"
+)
+
+var _ AMMOrderer = (*PositionOrderer)(nil)
+
+// PositionReserveAddress returns a unique position reserve account address for each position.
+func PositionReserveAddress(positionId uint64) sdk.AccAddress {
+	return farmingtypes.DeriveAddress(
+		AddressType,
+		ModuleName,
+		strings.Join([]string{PositionReserveAddressPrefix, strconv.FormatUint(positionId, 10)}, ModuleAddressNameSplitter),
+	)
+}
+
+// NewPosition returns a new position object.
+func NewPosition(id, pairId uint64, owner sdk.AccAddress, minPrice, maxPrice sdk.Dec) Position {
+	return Position{
+		Id:             id,
+		PairId:         pairId,
+		Owner:          owner.String(),
+		ReserveAddress: PositionReserveAddress(id).String(),
+		MinPrice:       minPrice,
+		MaxPrice:       maxPrice,
+	}
+}
+
+func (position Position) GetOwner() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(position.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+func (position Position) GetReserveAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(position.ReserveAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// Validate validates Position for genesis.
+func (position Position) Validate() error {
+	if position.Id == 0 {
+		return fmt.Errorf("position id must not be 0")
+	}
+	if position.PairId == 0 {
+		return fmt.Errorf("pair id must not be 0")
+	}
+	if _, err := sdk.AccAddressFromBech32(position.Owner); err != nil {
+		return fmt.Errorf("invalid owner address %s: %w", position.Owner, err)
+	}
+	if position.ReserveAddress != PositionReserveAddress(position.Id).String() {
+		return fmt.Errorf("wrong reserve address: %s", position.ReserveAddress)
+	}
+	if !position.MinPrice.IsPositive() {
+		return fmt.Errorf("min price must be positive: %s", position.MinPrice)
+	}
+	if !position.MaxPrice.GT(position.MinPrice) {
+		return fmt.Errorf("max price must be higher than min price")
+	}
+	return nil
+}
+
+// AMMPool constructs amm.Pool interface from Position.
+// A position is non-fungible, so the whole position is treated as
+// a single unit of pool coin.
+func (position Position) AMMPool(rx, ry sdk.Int) amm.Pool {
+	return amm.NewRangedPool(rx, ry, sdk.OneInt(), position.MinPrice, position.MaxPrice)
+}
+
+type PositionOrderer struct {
+	amm.Pool
+	Id                            uint64
+	ReserveAddress                sdk.AccAddress
+	BaseCoinDenom, QuoteCoinDenom string
+}
+
+func NewPositionOrderer(pool amm.Pool, id uint64, reserveAddr sdk.AccAddress, baseCoinDenom, quoteCoinDenom string) *PositionOrderer {
+	return &PositionOrderer{
+		Pool:           pool,
+		Id:             id,
+		ReserveAddress: reserveAddr,
+		BaseCoinDenom:  baseCoinDenom,
+		QuoteCoinDenom: quoteCoinDenom,
+	}
+}
+
+func (orderer *PositionOrderer) Order(dir amm.OrderDirection, price sdk.Dec, amt sdk.Int) amm.Order {
+	var offerCoinDenom, demandCoinDenom string
+	switch dir {
+	case amm.Buy:
+		offerCoinDenom, demandCoinDenom = orderer.QuoteCoinDenom, orderer.BaseCoinDenom
+	case amm.Sell:
+		offerCoinDenom, demandCoinDenom = orderer.BaseCoinDenom, orderer.QuoteCoinDenom
+	}
+	return NewPositionOrder(orderer.Id, orderer.ReserveAddress, dir, price, amt, offerCoinDenom, demandCoinDenom)
+}
+
+// MustMarshalPosition returns the position bytes.
+// It throws panic if it fails.
+func MustMarshalPosition(cdc codec.BinaryCodec, position Position) []byte {
+	return cdc.MustMarshal(&position)
+}
+
+// MustUnmarshalPosition return the unmarshalled position from bytes.
+// It throws panic if it fails.
+func MustUnmarshalPosition(cdc codec.BinaryCodec, value []byte) Position {
+	position, err := UnmarshalPosition(cdc, value)
+	if err != nil {
+		panic(err)
+	}
+
+	return position
+}
+
+// UnmarshalPosition returns the position from bytes.
+func UnmarshalPosition(cdc codec.BinaryCodec, value []byte) (position Position, err error) {
+	err = cdc.Unmarshal(value, &position)
+	return position, err
+}
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewPriceAccumulator returns a new PriceAccumulator.
+func NewPriceAccumulator(pairId uint64, t time.Time, cumulativePrice, lastPrice sdk.Dec) PriceAccumulator {
+	return PriceAccumulator{
+		PairId:          pairId,
+		Time:            t,
+		CumulativePrice: cumulativePrice,
+		LastPrice:       lastPrice,
+	}
+}
+
+// CumulativePriceAt returns the cumulative price at the given time,
+// assuming the last price has been held since the snapshot.
+func (acc PriceAccumulator) CumulativePriceAt(t time.Time) sdk.Dec {
+	return acc.CumulativePrice.Add(acc.LastPrice.Mul(DurationToSeconds(t.Sub(acc.Time))))
+}
+
+// Validate validates PriceAccumulator for genesis.
+func (acc PriceAccumulator) Validate() error {
+	if acc.PairId == 0 {
+		return fmt.Errorf("pair id must not be 0")
+	}
+	if acc.Time.IsZero() {
+		return fmt.Errorf("no time info")
+	}
+	if acc.CumulativePrice.IsNegative() {
+		return fmt.Errorf("cumulative price must not be negative: %s", acc.CumulativePrice)
+	}
+	if !acc.LastPrice.IsPositive() {
+		return fmt.Errorf("last price must be positive: %s", acc.LastPrice)
+	}
+	return nil
+}
+
+// DurationToSeconds returns the duration in seconds as sdk.Dec.
+func DurationToSeconds(d time.Duration) sdk.Dec {
+	return sdk.NewDecWithPrec(d.Nanoseconds(), 9)
+}
+
+// MustMarshalPriceAccumulator returns the price accumulator bytes.
+// It throws panic if it fails.
+func MustMarshalPriceAccumulator(cdc codec.BinaryCodec, acc PriceAccumulator) []byte {
+	return cdc.MustMarshal(&acc)
+}
+
+// UnmarshalPriceAccumulator returns the price accumulator from bytes.
+func UnmarshalPriceAccumulator(cdc codec.BinaryCodec, value []byte) (acc PriceAccumulator, err error) {
+	err = cdc.Unmarshal(value, &acc)
+	return acc, err
+}
+
+// MustUnmarshalPriceAccumulator returns the price accumulator from bytes.
+// It throws panic if it fails.
+func MustUnmarshalPriceAccumulator(cdc codec.BinaryCodec, value []byte) PriceAccumulator {
+	acc, err := UnmarshalPriceAccumulator(cdc, value)
+	if err != nil {
+		panic(err)
+	}
+	return acc
+}
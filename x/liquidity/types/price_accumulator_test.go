@@ -0,0 +1,25 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	utils "github.com/cosmosquad-labs/squad/v3/types"
+	"github.com/cosmosquad-labs/squad/v3/x/liquidity/types"
+)
+
+func TestPriceAccumulator_CumulativePriceAt(t *testing.T) {
+	acc := types.NewPriceAccumulator(
+		1, utils.ParseTime("2022-01-01T00:00:00Z"), utils.ParseDec("100.0"), utils.ParseDec("1.5"))
+
+	require.True(t, acc.CumulativePriceAt(acc.Time).Equal(utils.ParseDec("100.0")))
+	require.True(t, acc.CumulativePriceAt(acc.Time.Add(10*time.Second)).Equal(utils.ParseDec("115.0")))
+	require.True(t, acc.CumulativePriceAt(acc.Time.Add(1500*time.Millisecond)).Equal(utils.ParseDec("102.25")))
+}
+
+func TestDurationToSeconds(t *testing.T) {
+	require.True(t, types.DurationToSeconds(time.Hour).Equal(utils.ParseDec("3600")))
+	require.True(t, types.DurationToSeconds(time.Nanosecond).Equal(utils.ParseDec("0.000000001")))
+}
@@ -0,0 +1,135 @@
+package types
+
+import (
+	"fmt"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gov "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/cosmosquad-labs/squad/v3/x/liquidity/amm"
+)
+
+const (
+	ProposalTypeSwapFeeRate   string = "SwapFeeRate"
+	ProposalTypeAmplification string = "Amplification"
+)
+
+// Implements Proposal Interface
+var (
+	_ gov.Content = &SwapFeeRateProposal{}
+	_ gov.Content = &AmplificationProposal{}
+)
+
+func init() {
+	gov.RegisterProposalType(ProposalTypeSwapFeeRate)
+	gov.RegisterProposalTypeCodec(&SwapFeeRateProposal{}, "squad/SwapFeeRateProposal")
+	gov.RegisterProposalType(ProposalTypeAmplification)
+	gov.RegisterProposalTypeCodec(&AmplificationProposal{}, "squad/AmplificationProposal")
+}
+
+// NewSwapFeeRateProposal creates a new SwapFeeRateProposal object.
+func NewSwapFeeRateProposal(title, description string, changes []SwapFeeRateChange) *SwapFeeRateProposal {
+	return &SwapFeeRateProposal{
+		Title:       title,
+		Description: description,
+		Changes:     changes,
+	}
+}
+
+func (p *SwapFeeRateProposal) GetTitle() string { return p.Title }
+
+func (p *SwapFeeRateProposal) GetDescription() string { return p.Description }
+
+func (p *SwapFeeRateProposal) ProposalRoute() string { return RouterKey }
+
+func (p *SwapFeeRateProposal) ProposalType() string { return ProposalTypeSwapFeeRate }
+
+func (p *SwapFeeRateProposal) ValidateBasic() error {
+	if len(p.Changes) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "proposal changes must not be empty")
+	}
+
+	pairIdSet := map[uint64]struct{}{}
+	for _, change := range p.Changes {
+		if _, ok := pairIdSet[change.PairId]; ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate pair id: %d", change.PairId)
+		}
+		pairIdSet[change.PairId] = struct{}{}
+		if err := change.Validate(); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	}
+	return gov.ValidateAbstract(p)
+}
+
+func (p SwapFeeRateProposal) String() string {
+	return fmt.Sprintf(`Swap Fee Rate Proposal:
+  Title:       %s
+  Description: %s
+  Changes:     %v
+`, p.Title, p.Description, p.Changes)
+}
+
+// Validate validates SwapFeeRateChange.
+func (change SwapFeeRateChange) Validate() error {
+	if change.PairId == 0 {
+		return fmt.Errorf("pair id must not be 0")
+	}
+	if change.SwapFeeRate != nil {
+		if err := ValidateSwapFeeRate(*change.SwapFeeRate); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewAmplificationProposal creates a new AmplificationProposal object.
+func NewAmplificationProposal(title, description string, changes []AmplificationChange) *AmplificationProposal {
+	return &AmplificationProposal{
+		Title:       title,
+		Description: description,
+		Changes:     changes,
+	}
+}
+
+func (p *AmplificationProposal) GetTitle() string { return p.Title }
+
+func (p *AmplificationProposal) GetDescription() string { return p.Description }
+
+func (p *AmplificationProposal) ProposalRoute() string { return RouterKey }
+
+func (p *AmplificationProposal) ProposalType() string { return ProposalTypeAmplification }
+
+func (p *AmplificationProposal) ValidateBasic() error {
+	if len(p.Changes) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "proposal changes must not be empty")
+	}
+
+	poolIdSet := map[uint64]struct{}{}
+	for _, change := range p.Changes {
+		if _, ok := poolIdSet[change.PoolId]; ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate pool id: %d", change.PoolId)
+		}
+		poolIdSet[change.PoolId] = struct{}{}
+		if err := change.Validate(); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	}
+	return gov.ValidateAbstract(p)
+}
+
+func (p AmplificationProposal) String() string {
+	return fmt.Sprintf(`Amplification Proposal:
+  Title:       %s
+  Description: %s
+  Changes:     %v
+`, p.Title, p.Description, p.Changes)
+}
+
+// Validate validates AmplificationChange.
+func (change AmplificationChange) Validate() error {
+	if change.PoolId == 0 {
+		return fmt.Errorf("pool id must not be 0")
+	}
+	return amm.ValidateAmplification(change.Amplification)
+}
@@ -0,0 +1,145 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	utils "github.com/cosmosquad-labs/squad/v3/types"
+	"github.com/cosmosquad-labs/squad/v3/x/liquidity/types"
+)
+
+func TestSwapFeeRateProposal_ValidateBasic(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(proposal *types.SwapFeeRateProposal)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(proposal *types.SwapFeeRateProposal) {},
+			"",
+		},
+		{
+			"reset swap fee rate",
+			func(proposal *types.SwapFeeRateProposal) {
+				proposal.Changes[0].SwapFeeRate = nil
+			},
+			"",
+		},
+		{
+			"empty changes",
+			func(proposal *types.SwapFeeRateProposal) {
+				proposal.Changes = nil
+			},
+			"proposal changes must not be empty: invalid request",
+		},
+		{
+			"zero pair id",
+			func(proposal *types.SwapFeeRateProposal) {
+				proposal.Changes[0].PairId = 0
+			},
+			"pair id must not be 0: invalid request",
+		},
+		{
+			"duplicate pair id",
+			func(proposal *types.SwapFeeRateProposal) {
+				proposal.Changes = append(proposal.Changes, proposal.Changes[0])
+			},
+			"duplicate pair id: 1: invalid request",
+		},
+		{
+			"invalid swap fee rate",
+			func(proposal *types.SwapFeeRateProposal) {
+				proposal.Changes[0].SwapFeeRate = utils.ParseDecP("1.0")
+			},
+			"swap fee rate must be less than 1: 1.000000000000000000: invalid request",
+		},
+		{
+			"empty title",
+			func(proposal *types.SwapFeeRateProposal) {
+				proposal.Title = ""
+			},
+			"proposal title cannot be blank: invalid proposal content",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			proposal := types.NewSwapFeeRateProposal("title", "description", []types.SwapFeeRateChange{
+				{PairId: 1, SwapFeeRate: utils.ParseDecP("0.003")},
+			})
+			tc.malleate(proposal)
+			require.Equal(t, types.ProposalTypeSwapFeeRate, proposal.ProposalType())
+			require.Equal(t, types.RouterKey, proposal.ProposalRoute())
+			err := proposal.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestAmplificationProposal_ValidateBasic(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(proposal *types.AmplificationProposal)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(proposal *types.AmplificationProposal) {},
+			"",
+		},
+		{
+			"empty changes",
+			func(proposal *types.AmplificationProposal) {
+				proposal.Changes = nil
+			},
+			"proposal changes must not be empty: invalid request",
+		},
+		{
+			"zero pool id",
+			func(proposal *types.AmplificationProposal) {
+				proposal.Changes[0].PoolId = 0
+			},
+			"pool id must not be 0: invalid request",
+		},
+		{
+			"duplicate pool id",
+			func(proposal *types.AmplificationProposal) {
+				proposal.Changes = append(proposal.Changes, proposal.Changes[0])
+			},
+			"duplicate pool id: 1: invalid request",
+		},
+		{
+			"invalid amplification",
+			func(proposal *types.AmplificationProposal) {
+				proposal.Changes[0].Amplification = 0
+			},
+			"amplification must be in range [1, 10000]: 0: invalid request",
+		},
+		{
+			"empty title",
+			func(proposal *types.AmplificationProposal) {
+				proposal.Title = ""
+			},
+			"proposal title cannot be blank: invalid proposal content",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			proposal := types.NewAmplificationProposal("title", "description", []types.AmplificationChange{
+				{PoolId: 1, Amplification: 100},
+			})
+			tc.malleate(proposal)
+			require.Equal(t, types.ProposalTypeAmplification, proposal.ProposalType())
+			require.Equal(t, types.RouterKey, proposal.ProposalRoute())
+			err := proposal.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
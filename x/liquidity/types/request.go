@@ -153,6 +153,8 @@ func NewOrderForLimitOrder(msg *MsgLimitOrder, id uint64, pair Pair, offerCoin s
 		BatchId:            pair.CurrentBatchId,
 		ExpireAt:           expireAt,
 		Status:             OrderStatusNotExecuted,
+		TimeInForce:        msg.TimeInForce,
+		PostOnly:           msg.PostOnly,
 	}
 }
 
@@ -268,9 +270,19 @@ func (order Order) Validate() error {
 	if !order.Status.IsValid() {
 		return fmt.Errorf("invalid status: %s", order.Status)
 	}
+	if !order.TimeInForce.IsValid() {
+		return fmt.Errorf("invalid time in force: %s", order.TimeInForce)
+	}
 	return nil
 }
 
+// IsMaker returns whether the order is treated as a maker order when it is
+// matched in the batch with the given id.
+// Post-only orders and orders placed in earlier batches are maker orders.
+func (order Order) IsMaker(batchId uint64) bool {
+	return order.PostOnly || order.BatchId < batchId
+}
+
 // ExpiredAt returns whether the order should be deleted at given time.
 func (order Order) ExpiredAt(t time.Time) bool {
 	return !order.ExpireAt.After(t)
@@ -355,6 +367,25 @@ func (status OrderStatus) IsCanceledOrExpired() bool {
 	}
 }
 
+// IsValid returns true if the TimeInForce is one of:
+// TimeInForceGoodTilCanceled, TimeInForceImmediateOrCancel, TimeInForceFillOrKill.
+func (tif TimeInForce) IsValid() bool {
+	switch tif {
+	case TimeInForceGoodTilCanceled, TimeInForceImmediateOrCancel, TimeInForceFillOrKill:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsImmediate returns true if the TimeInForce is one of:
+// TimeInForceImmediateOrCancel, TimeInForceFillOrKill.
+// Orders with such time-in-force don't remain in the order book after
+// their first batch.
+func (tif TimeInForce) IsImmediate() bool {
+	return tif == TimeInForceImmediateOrCancel || tif == TimeInForceFillOrKill
+}
+
 // ShouldBeDeleted returns true if the OrderStatus is one of:
 // OrderStatusCompleted, OrderStatusCanceled, OrderStatusExpired.
 func (status OrderStatus) ShouldBeDeleted() bool {
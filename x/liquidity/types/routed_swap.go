@@ -0,0 +1,102 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewRoutedSwapRequest returns a new RoutedSwapRequest.
+func NewRoutedSwapRequest(msg *MsgRoutedSwap, id uint64, msgHeight int64) RoutedSwapRequest {
+	return RoutedSwapRequest{
+		Id:              id,
+		MsgHeight:       msgHeight,
+		Orderer:         msg.Orderer,
+		PairIds:         msg.PairIds,
+		OfferCoin:       msg.OfferCoin,
+		DemandCoinDenom: msg.DemandCoinDenom,
+		MinDemandAmount: msg.MinDemandAmount,
+		ReceivedCoin:    sdk.NewCoin(msg.DemandCoinDenom, sdk.ZeroInt()),
+		Status:          RequestStatusNotExecuted,
+	}
+}
+
+func (req RoutedSwapRequest) GetOrderer() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(req.Orderer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// Validate validates RoutedSwapRequest for genesis.
+func (req RoutedSwapRequest) Validate() error {
+	if req.Id == 0 {
+		return fmt.Errorf("id must not be 0")
+	}
+	if req.MsgHeight == 0 {
+		return fmt.Errorf("message height must not be 0")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Orderer); err != nil {
+		return fmt.Errorf("invalid orderer address %s: %w", req.Orderer, err)
+	}
+	if len(req.PairIds) == 0 {
+		return fmt.Errorf("pair ids must not be empty")
+	}
+	for _, pairId := range req.PairIds {
+		if pairId == 0 {
+			return fmt.Errorf("pair id must not be 0")
+		}
+	}
+	if err := req.OfferCoin.Validate(); err != nil {
+		return fmt.Errorf("invalid offer coin %s: %w", req.OfferCoin, err)
+	}
+	if req.OfferCoin.IsZero() {
+		return fmt.Errorf("offer coin must not be 0")
+	}
+	if err := sdk.ValidateDenom(req.DemandCoinDenom); err != nil {
+		return fmt.Errorf("invalid demand coin denom: %w", err)
+	}
+	if req.MinDemandAmount.IsNil() || req.MinDemandAmount.IsNegative() {
+		return fmt.Errorf("min demand amount must not be negative: %s", req.MinDemandAmount)
+	}
+	if err := req.ReceivedCoin.Validate(); err != nil {
+		return fmt.Errorf("invalid received coin %s: %w", req.ReceivedCoin, err)
+	}
+	if req.ReceivedCoin.Denom != req.DemandCoinDenom {
+		return fmt.Errorf("wrong received coin denom: %s != %s", req.ReceivedCoin.Denom, req.DemandCoinDenom)
+	}
+	if !req.Status.IsValid() {
+		return fmt.Errorf("invalid status: %s", req.Status)
+	}
+	return nil
+}
+
+// SetStatus sets the request's status.
+// SetStatus is to easily find locations where the status is changed.
+func (req *RoutedSwapRequest) SetStatus(status RequestStatus) {
+	req.Status = status
+}
+
+// MustMarshalRoutedSwapRequest returns the RoutedSwapRequest bytes.
+// It throws panic if it fails.
+func MustMarshalRoutedSwapRequest(cdc codec.BinaryCodec, req RoutedSwapRequest) []byte {
+	return cdc.MustMarshal(&req)
+}
+
+// UnmarshalRoutedSwapRequest returns the RoutedSwapRequest from bytes.
+func UnmarshalRoutedSwapRequest(cdc codec.BinaryCodec, value []byte) (req RoutedSwapRequest, err error) {
+	err = cdc.Unmarshal(value, &req)
+	return req, err
+}
+
+// MustUnmarshalRoutedSwapRequest returns the RoutedSwapRequest from bytes.
+// It throws panic if it fails.
+func MustUnmarshalRoutedSwapRequest(cdc codec.BinaryCodec, value []byte) RoutedSwapRequest {
+	req, err := UnmarshalRoutedSwapRequest(cdc, value)
+	if err != nil {
+		panic(err)
+	}
+	return req
+}
@@ -0,0 +1,140 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Candle intervals supported by the candle query.
+const (
+	CandleInterval1m = "1m"
+	CandleInterval1h = "1h"
+	CandleInterval1d = "1d"
+)
+
+// NewTradeRecord returns a new TradeRecord with a single match price.
+func NewTradeRecord(
+	pairId, batchId uint64, height int64, t time.Time, matchPrice sdk.Dec,
+	baseVolume, quoteVolume sdk.Int, swapFees sdk.Coins) TradeRecord {
+	return TradeRecord{
+		PairId:      pairId,
+		BatchId:     batchId,
+		Height:      height,
+		Time:        t,
+		OpenPrice:   matchPrice,
+		HighPrice:   matchPrice,
+		LowPrice:    matchPrice,
+		ClosePrice:  matchPrice,
+		BaseVolume:  baseVolume,
+		QuoteVolume: quoteVolume,
+		SwapFees:    swapFees,
+	}
+}
+
+// Merge merges a later trade record within the same batch into the record.
+func (record *TradeRecord) Merge(other TradeRecord) {
+	record.HighPrice = sdk.MaxDec(record.HighPrice, other.HighPrice)
+	record.LowPrice = sdk.MinDec(record.LowPrice, other.LowPrice)
+	record.ClosePrice = other.ClosePrice
+	record.BaseVolume = record.BaseVolume.Add(other.BaseVolume)
+	record.QuoteVolume = record.QuoteVolume.Add(other.QuoteVolume)
+	record.SwapFees = record.SwapFees.Add(other.SwapFees...)
+}
+
+// Validate validates TradeRecord for genesis.
+func (record TradeRecord) Validate() error {
+	if record.PairId == 0 {
+		return fmt.Errorf("pair id must not be 0")
+	}
+	if record.Time.IsZero() {
+		return fmt.Errorf("no time info")
+	}
+	for _, price := range []sdk.Dec{record.OpenPrice, record.HighPrice, record.LowPrice, record.ClosePrice} {
+		if !price.IsPositive() {
+			return fmt.Errorf("price must be positive: %s", price)
+		}
+	}
+	if record.HighPrice.LT(record.LowPrice) {
+		return fmt.Errorf("high price is lower than low price: %s < %s", record.HighPrice, record.LowPrice)
+	}
+	if record.BaseVolume.IsNegative() {
+		return fmt.Errorf("base volume must not be negative: %s", record.BaseVolume)
+	}
+	if record.QuoteVolume.IsNegative() {
+		return fmt.Errorf("quote volume must not be negative: %s", record.QuoteVolume)
+	}
+	if err := record.SwapFees.Validate(); err != nil {
+		return fmt.Errorf("invalid swap fees: %w", err)
+	}
+	return nil
+}
+
+// ParseCandleInterval parses the candle interval string and returns
+// the duration of the interval.
+func ParseCandleInterval(interval string) (time.Duration, error) {
+	switch interval {
+	case CandleInterval1m:
+		return time.Minute, nil
+	case CandleInterval1h:
+		return time.Hour, nil
+	case CandleInterval1d:
+		return 24 * time.Hour, nil
+	default:
+		return 0, fmt.Errorf("invalid candle interval: %s", interval)
+	}
+}
+
+// AggregateCandles aggregates trade records into candles of the interval.
+// The records must be sorted by the time in ascending order.
+func AggregateCandles(records []TradeRecord, interval time.Duration) []Candle {
+	candles := []Candle{}
+	for _, record := range records {
+		startTime := record.Time.UTC().Truncate(interval)
+		if len(candles) > 0 && candles[len(candles)-1].StartTime.Equal(startTime) {
+			candle := &candles[len(candles)-1]
+			candle.High = sdk.MaxDec(candle.High, record.HighPrice)
+			candle.Low = sdk.MinDec(candle.Low, record.LowPrice)
+			candle.Close = record.ClosePrice
+			candle.BaseVolume = candle.BaseVolume.Add(record.BaseVolume)
+			candle.QuoteVolume = candle.QuoteVolume.Add(record.QuoteVolume)
+			candle.SwapFees = candle.SwapFees.Add(record.SwapFees...)
+			continue
+		}
+		candles = append(candles, Candle{
+			StartTime:   startTime,
+			Open:        record.OpenPrice,
+			High:        record.HighPrice,
+			Low:         record.LowPrice,
+			Close:       record.ClosePrice,
+			BaseVolume:  record.BaseVolume,
+			QuoteVolume: record.QuoteVolume,
+			SwapFees:    record.SwapFees,
+		})
+	}
+	return candles
+}
+
+// MustMarshalTradeRecord returns the trade record bytes.
+// It throws panic if it fails.
+func MustMarshalTradeRecord(cdc codec.BinaryCodec, record TradeRecord) []byte {
+	return cdc.MustMarshal(&record)
+}
+
+// UnmarshalTradeRecord returns the trade record from bytes.
+func UnmarshalTradeRecord(cdc codec.BinaryCodec, value []byte) (record TradeRecord, err error) {
+	err = cdc.Unmarshal(value, &record)
+	return record, err
+}
+
+// MustUnmarshalTradeRecord returns the trade record from bytes.
+// It throws panic if it fails.
+func MustUnmarshalTradeRecord(cdc codec.BinaryCodec, value []byte) TradeRecord {
+	record, err := UnmarshalTradeRecord(cdc, value)
+	if err != nil {
+		panic(err)
+	}
+	return record
+}
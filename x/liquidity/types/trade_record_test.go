@@ -0,0 +1,87 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	utils "github.com/cosmosquad-labs/squad/v3/types"
+	"github.com/cosmosquad-labs/squad/v3/x/liquidity/types"
+)
+
+func TestTradeRecord_Merge(t *testing.T) {
+	record := types.NewTradeRecord(
+		1, 1, 1, utils.ParseTime("2022-01-01T00:00:00Z"), utils.ParseDec("1.0"),
+		sdk.NewInt(1000), sdk.NewInt(1000), sdk.Coins{})
+	record.Merge(types.NewTradeRecord(
+		1, 1, 1, utils.ParseTime("2022-01-01T00:00:00Z"), utils.ParseDec("0.9"),
+		sdk.NewInt(2000), sdk.NewInt(1800), utils.ParseCoins("10denom2")))
+
+	require.True(t, record.OpenPrice.Equal(utils.ParseDec("1.0")))
+	require.True(t, record.HighPrice.Equal(utils.ParseDec("1.0")))
+	require.True(t, record.LowPrice.Equal(utils.ParseDec("0.9")))
+	require.True(t, record.ClosePrice.Equal(utils.ParseDec("0.9")))
+	require.True(t, record.BaseVolume.Equal(sdk.NewInt(3000)))
+	require.True(t, record.QuoteVolume.Equal(sdk.NewInt(2800)))
+	require.True(t, record.SwapFees.IsEqual(utils.ParseCoins("10denom2")))
+}
+
+func TestParseCandleInterval(t *testing.T) {
+	for _, tc := range []struct {
+		interval    string
+		expected    time.Duration
+		expectedErr string
+	}{
+		{"1m", time.Minute, ""},
+		{"1h", time.Hour, ""},
+		{"1d", 24 * time.Hour, ""},
+		{"5m", 0, "invalid candle interval: 5m"},
+		{"", 0, "invalid candle interval: "},
+	} {
+		t.Run(tc.interval, func(t *testing.T) {
+			interval, err := types.ParseCandleInterval(tc.interval)
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, interval)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestAggregateCandles(t *testing.T) {
+	newRecord := func(batchId uint64, t string, price string, volume int64) types.TradeRecord {
+		return types.NewTradeRecord(
+			1, batchId, int64(batchId), utils.ParseTime(t), utils.ParseDec(price),
+			sdk.NewInt(volume), sdk.NewInt(volume), sdk.Coins{})
+	}
+	records := []types.TradeRecord{
+		newRecord(1, "2022-01-01T00:00:00Z", "1.0", 1000),
+		newRecord(2, "2022-01-01T00:00:30Z", "1.2", 2000),
+		newRecord(3, "2022-01-01T00:00:55Z", "0.8", 3000),
+		newRecord(4, "2022-01-01T00:01:00Z", "0.9", 4000),
+		newRecord(5, "2022-01-01T01:00:00Z", "1.1", 5000),
+	}
+
+	candles := types.AggregateCandles(records, time.Minute)
+	require.Len(t, candles, 3)
+	require.Equal(t, utils.ParseTime("2022-01-01T00:00:00Z"), candles[0].StartTime)
+	require.True(t, candles[0].Open.Equal(utils.ParseDec("1.0")))
+	require.True(t, candles[0].High.Equal(utils.ParseDec("1.2")))
+	require.True(t, candles[0].Low.Equal(utils.ParseDec("0.8")))
+	require.True(t, candles[0].Close.Equal(utils.ParseDec("0.8")))
+	require.True(t, candles[0].BaseVolume.Equal(sdk.NewInt(6000)))
+	require.Equal(t, utils.ParseTime("2022-01-01T00:01:00Z"), candles[1].StartTime)
+	require.True(t, candles[1].Close.Equal(utils.ParseDec("0.9")))
+	require.Equal(t, utils.ParseTime("2022-01-01T01:00:00Z"), candles[2].StartTime)
+
+	candles = types.AggregateCandles(records, time.Hour)
+	require.Len(t, candles, 2)
+	require.True(t, candles[0].Close.Equal(utils.ParseDec("0.9")))
+	require.True(t, candles[0].BaseVolume.Equal(sdk.NewInt(10000)))
+
+	require.Empty(t, types.AggregateCandles(nil, time.Minute))
+}
@@ -87,6 +87,29 @@ func NewPoolResponse(pool Pool, rx, ry sdk.Coin, poolCoinSupply sdk.Int) PoolRes
 		LastDepositRequestId:  pool.LastDepositRequestId,
 		LastWithdrawRequestId: pool.LastWithdrawRequestId,
 		Disabled:              pool.Disabled,
+		Amplification:         pool.Amplification,
+	}
+}
+
+// NewPositionResponse returns a new PositionResponse from arguments.
+func NewPositionResponse(position Position, rx, ry sdk.Coin) PositionResponse {
+	var price *sdk.Dec
+	if rx.IsPositive() || ry.IsPositive() {
+		p := position.AMMPool(rx.Amount, ry.Amount).Price()
+		price = &p
+	}
+	return PositionResponse{
+		Id:             position.Id,
+		PairId:         position.PairId,
+		Owner:          position.Owner,
+		ReserveAddress: position.ReserveAddress,
+		MinPrice:       position.MinPrice,
+		MaxPrice:       position.MaxPrice,
+		Price:          price,
+		Balances: PoolBalances{
+			BaseCoin:  ry,
+			QuoteCoin: rx,
+		},
 	}
 }
 
@@ -10,8 +10,12 @@ import (
 	"github.com/cosmosquad-labs/squad/v3/x/liquidstaking/types"
 )
 
-// BeginBlocker updates liquid validator set changes for the current block
+// BeginBlocker updates the performance scores and liquid validator set changes, matures
+// unstake tickets and takes the net amount snapshot for the current block
 func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+	k.UpdatePerformanceScores(ctx)
 	k.UpdateLiquidValidatorSet(ctx)
+	k.MatureUnstakeTickets(ctx)
+	k.TakeNetAmountSnapshot(ctx)
 }
@@ -0,0 +1,21 @@
+package cli
+
+// DONTCOVER
+
+import (
+	flag "github.com/spf13/pflag"
+)
+
+const (
+	FlagStartHeight = "start-height"
+	FlagEndHeight   = "end-height"
+)
+
+func flagSetNetAmountSnapshots() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.Int64(FlagStartHeight, 0, "The inclusive lower bound of the snapshot heights")
+	fs.Int64(FlagEndHeight, 0, "The inclusive upper bound of the snapshot heights; unbounded if not specified")
+
+	return fs
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -29,6 +30,11 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryLiquidValidators(),
 		GetCmdQueryStates(),
 		GetCmdQueryVotingPower(),
+		GetCmdQueryValidatorPreference(),
+		GetCmdQueryDelegationBreakdown(),
+		GetCmdQueryUnstakeTickets(),
+		GetCmdQueryUnstakeTicket(),
+		GetCmdQueryNetAmountSnapshots(),
 	)
 
 	return liquidValidatorQueryCmd
@@ -200,3 +206,225 @@ $ %s query %s voting-power %s1zaavvzxez0elundtn32qnk9lkm8kmcszzsv80v
 
 	return cmd
 }
+
+// GetCmdQueryValidatorPreference implements the query validator preference command.
+func GetCmdQueryValidatorPreference() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-preference [delegator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the liquid staker's validator preference",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the whitelisted validators and their weights the liquid staker prefers to liquid-stake to.
+
+Example:
+$ %s query %s validator-preference %s1zaavvzxez0elundtn32qnk9lkm8kmcszzsv80v
+`,
+				version.AppName, types.ModuleName, sdk.Bech32MainPrefix,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			delegator, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ValidatorPreference(
+				cmd.Context(),
+				&types.QueryValidatorPreferenceRequest{DelegatorAddress: delegator.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryDelegationBreakdown implements the query delegation breakdown command.
+func GetCmdQueryDelegationBreakdown() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegation-breakdown",
+		Args:  cobra.NoArgs,
+		Short: "Query the liquid tokens delegated according to the validator preferences and the target weights",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the liquid tokens of the liquid validators delegated according to the validator preferences
+and the target weights, respectively.
+
+Example:
+$ %s query %s delegation-breakdown
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DelegationBreakdown(
+				cmd.Context(),
+				&types.QueryDelegationBreakdownRequest{},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryUnstakeTickets implements the query unstake tickets command.
+func GetCmdQueryUnstakeTickets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unstake-tickets [delegator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the liquid staker's in-flight unstake tickets",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the in-flight unstake tickets of the liquid staker, which are deleted when they mature.
+
+Example:
+$ %s query %s unstake-tickets %s1zaavvzxez0elundtn32qnk9lkm8kmcszzsv80v
+`,
+				version.AppName, types.ModuleName, sdk.Bech32MainPrefix,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			delegator, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.UnstakeTickets(
+				cmd.Context(),
+				&types.QueryUnstakeTicketsRequest{DelegatorAddress: delegator.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryUnstakeTicket implements the query unstake ticket command.
+func GetCmdQueryUnstakeTicket() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unstake-ticket [ticket-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query an in-flight unstake ticket",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query an in-flight unstake ticket.
+
+Example:
+$ %s query %s unstake-ticket 1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			ticketId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse ticket id: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.UnstakeTicket(
+				cmd.Context(),
+				&types.QueryUnstakeTicketRequest{TicketId: ticketId},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryNetAmountSnapshots implements the query net amount snapshots command.
+func GetCmdQueryNetAmountSnapshots() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "net-amount-snapshots",
+		Args:  cobra.NoArgs,
+		Short: "Query the net amount state snapshots",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the net amount state snapshots within the height range, which show the history of the bToken exchange rate.
+
+Example:
+$ %s query %s net-amount-snapshots
+$ %s query %s net-amount-snapshots --start-height=100000 --end-height=200000
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			startHeight, _ := cmd.Flags().GetInt64(FlagStartHeight)
+			endHeight, _ := cmd.Flags().GetInt64(FlagEndHeight)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.NetAmountSnapshots(
+				cmd.Context(),
+				&types.QueryNetAmountSnapshotsRequest{StartHeight: startHeight, EndHeight: endHeight},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetNetAmountSnapshots())
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -31,6 +31,8 @@ func GetTxCmd() *cobra.Command {
 	liquidstakingTxCmd.AddCommand(
 		NewLiquidStakeCmd(),
 		NewLiquidUnstakeCmd(),
+		NewInstantLiquidUnstakeCmd(),
+		NewSetValidatorPreferenceCmd(),
 	)
 
 	return liquidstakingTxCmd
@@ -113,3 +115,99 @@ $ %s tx %s liquid-unstake 500stake --from mykey
 
 	return cmd
 }
+
+// NewInstantLiquidUnstakeCmd implements the instant liquid unstake command handler.
+func NewInstantLiquidUnstakeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "instant-liquid-unstake [amount]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Instantly liquid-unstake coin from the instant unstake buffer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Instantly liquid-unstake coin without waiting for the unbonding period.
+The unstaked coin is paid from the instant unstake buffer of the proxy account,
+with a higher fee rate than the normal liquid unstaking.
+
+Example:
+$ %s tx %s instant-liquid-unstake 500bstake --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			liquidStaker := clientCtx.GetFromAddress()
+
+			unstakingCoin, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgInstantLiquidUnstake(liquidStaker, unstakingCoin)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSetValidatorPreferenceCmd implements the set validator preference command handler.
+func NewSetValidatorPreferenceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-validator-preference [validator-address:weight]...",
+		Args:  cobra.ArbitraryArgs,
+		Short: "Set the whitelisted validators and their weights to liquid-stake to",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the whitelisted validators and their weights to liquid-stake to.
+Liquid stakes of the liquid staker are delegated only to the preferred validators with the preferred weights,
+instead of all the whitelisted validators with their target weights.
+The validator preference is removed if no validator is given.
+
+Example:
+$ %s tx %s set-validator-preference %svaloper1zaavvzxez0elundtn32qnk9lkm8kmcszzsv80v:10 %svaloper1ta6e9ke2h0ryz3hxn8xkvv7dy4xx2zsnesegcs:5 --from mykey
+$ %s tx %s set-validator-preference --from mykey
+`,
+				version.AppName, types.ModuleName, sdk.Bech32MainPrefix, sdk.Bech32MainPrefix,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			liquidStaker := clientCtx.GetFromAddress()
+
+			var validators []types.WhitelistedValidator
+			for _, arg := range args {
+				parts := strings.Split(arg, ":")
+				if len(parts) != 2 {
+					return fmt.Errorf("invalid validator preference %q, expected validator-address:weight", arg)
+				}
+				weight, ok := sdk.NewIntFromString(parts[1])
+				if !ok {
+					return fmt.Errorf("invalid weight: %s", parts[1])
+				}
+				validators = append(validators, types.WhitelistedValidator{
+					ValidatorAddress: parts[0],
+					TargetWeight:     weight,
+				})
+			}
+
+			msg := types.NewMsgSetValidatorPreference(liquidStaker, validators)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -22,6 +22,23 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		k.SetLiquidValidator(ctx, lv)
 	}
 
+	for _, pref := range genState.ValidatorPreferences {
+		k.SetValidatorPreference(ctx, pref)
+	}
+
+	for _, pd := range genState.PreferredDelegations {
+		k.SetPreferredDelegation(ctx, pd.GetValidator(), pd.Amount)
+	}
+
+	k.SetLastUnstakeTicketId(ctx, genState.LastUnstakeTicketId)
+	for _, ticket := range genState.UnstakeTickets {
+		k.SetUnstakeTicket(ctx, ticket)
+	}
+
+	for _, snapshot := range genState.NetAmountSnapshots {
+		k.SetNetAmountSnapshot(ctx, snapshot)
+	}
+
 	moduleAcc := k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
 	if moduleAcc == nil {
 		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
@@ -37,5 +54,7 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	}
 
 	liquidValidators := k.GetAllLiquidValidators(ctx)
-	return types.NewGenesisState(params, liquidValidators)
+	return types.NewGenesisState(
+		params, liquidValidators, k.GetAllValidatorPreferences(ctx), k.GetAllPreferredDelegations(ctx),
+		k.GetLastUnstakeTicketId(ctx), k.GetAllUnstakeTickets(ctx), k.GetAllNetAmountSnapshots(ctx))
 }
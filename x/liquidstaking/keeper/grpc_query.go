@@ -58,3 +58,80 @@ func (k Querier) VotingPower(c context.Context, req *types.QueryVotingPowerReque
 	}
 	return &types.QueryVotingPowerResponse{VotingPower: k.GetVotingPower(ctx, addr)}, nil
 }
+
+// ValidatorPreference queries the validator preference of the liquid staker.
+func (k Querier) ValidatorPreference(c context.Context, req *types.QueryValidatorPreferenceRequest) (*types.QueryValidatorPreferenceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid delegator address: %v", err)
+	}
+	pref, found := k.GetValidatorPreference(ctx, delAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "validator preference of %s not found", req.DelegatorAddress)
+	}
+	return &types.QueryValidatorPreferenceResponse{ValidatorPreference: pref}, nil
+}
+
+// DelegationBreakdown queries the liquid tokens delegated according to the validator preferences and the target weights.
+func (k Querier) DelegationBreakdown(c context.Context, req *types.QueryDelegationBreakdownRequest) (*types.QueryDelegationBreakdownResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	breakdowns, preferredTokens, defaultTokens := k.GetDelegationBreakdown(ctx)
+	return &types.QueryDelegationBreakdownResponse{
+		Validators:      breakdowns,
+		PreferredTokens: preferredTokens,
+		DefaultTokens:   defaultTokens,
+	}, nil
+}
+
+// UnstakeTickets queries the in-flight unstake tickets of the liquid staker.
+func (k Querier) UnstakeTickets(c context.Context, req *types.QueryUnstakeTicketsRequest) (*types.QueryUnstakeTicketsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid delegator address: %v", err)
+	}
+	return &types.QueryUnstakeTicketsResponse{UnstakeTickets: k.GetUnstakeTicketsByStaker(ctx, delAddr)}, nil
+}
+
+// UnstakeTicket queries the in-flight unstake ticket.
+func (k Querier) UnstakeTicket(c context.Context, req *types.QueryUnstakeTicketRequest) (*types.QueryUnstakeTicketResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.TicketId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "ticket id cannot be 0")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	ticket, found := k.GetUnstakeTicket(ctx, req.TicketId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "unstake ticket %d not found", req.TicketId)
+	}
+	return &types.QueryUnstakeTicketResponse{UnstakeTicket: ticket}, nil
+}
+
+// NetAmountSnapshots queries the net amount state snapshots within the height range.
+func (k Querier) NetAmountSnapshots(c context.Context, req *types.QueryNetAmountSnapshotsRequest) (*types.QueryNetAmountSnapshotsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.StartHeight < 0 || req.EndHeight < 0 {
+		return nil, status.Error(codes.InvalidArgument, "height must not be negative")
+	}
+	if req.EndHeight != 0 && req.EndHeight < req.StartHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "end height %d must not be less than start height %d", req.EndHeight, req.StartHeight)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryNetAmountSnapshotsResponse{
+		NetAmountSnapshots: k.GetNetAmountSnapshotsByRange(ctx, req.StartHeight, req.EndHeight),
+	}, nil
+}
@@ -65,4 +65,20 @@ func (s *KeeperTestSuite) TestGRPCQueries() {
 	respVotingPower, err = s.querier.VotingPower(sdk.WrapSDKContext(s.ctx), &types.QueryVotingPowerRequest{Voter: "invalidaddr"})
 	s.Require().Nil(respVotingPower)
 	s.Require().EqualError(err, "decoding bech32 failed: invalid separator index -1")
+
+	// Test NetAmountSnapshots grpc query
+	s.keeper.SetNetAmountSnapshot(s.ctx, types.NewNetAmountSnapshot(s.ctx.WithBlockHeight(10), resNetAmountState))
+	s.keeper.SetNetAmountSnapshot(s.ctx, types.NewNetAmountSnapshot(s.ctx.WithBlockHeight(20), resNetAmountState))
+	respSnapshots, err := s.querier.NetAmountSnapshots(sdk.WrapSDKContext(s.ctx), &types.QueryNetAmountSnapshotsRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(respSnapshots.NetAmountSnapshots, 2)
+
+	respSnapshots, err = s.querier.NetAmountSnapshots(sdk.WrapSDKContext(s.ctx), &types.QueryNetAmountSnapshotsRequest{StartHeight: 11, EndHeight: 20})
+	s.Require().NoError(err)
+	s.Require().Len(respSnapshots.NetAmountSnapshots, 1)
+	s.Require().EqualValues(20, respSnapshots.NetAmountSnapshots[0].Height)
+
+	respSnapshots, err = s.querier.NetAmountSnapshots(sdk.WrapSDKContext(s.ctx), &types.QueryNetAmountSnapshotsRequest{StartHeight: 20, EndHeight: 10})
+	s.Require().Nil(respSnapshots)
+	s.Require().ErrorIs(err, status.Error(codes.InvalidArgument, "end height 10 must not be less than start height 20"))
 }
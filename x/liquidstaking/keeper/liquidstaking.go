@@ -62,9 +62,17 @@ func (k Keeper) LiquidStake(
 		)
 	}
 
-	whitelistedValsMap := types.GetWhitelistedValsMap(params.WhitelistedValidators)
+	whitelistedValsMap := k.GetEffectiveWhitelistedValsMap(ctx, params)
 	activeVals := k.GetActiveLiquidValidators(ctx, whitelistedValsMap)
-	if activeVals.Len() == 0 || !activeVals.TotalWeight(whitelistedValsMap).IsPositive() {
+	pref, preferred := k.GetValidatorPreference(ctx, liquidStaker)
+	if preferred {
+		// delegate only to the active preferred validators with the preferred weights
+		whitelistedValsMap = pref.ValidatorsMap()
+		activeVals = activeVals.Listed(whitelistedValsMap)
+		if activeVals.Len() == 0 {
+			return sdk.ZeroDec(), sdk.ZeroInt(), types.ErrPreferredValidatorsNotActive
+		}
+	} else if activeVals.Len() == 0 || !activeVals.TotalWeight(whitelistedValsMap).IsPositive() {
 		return sdk.ZeroDec(), sdk.ZeroInt(), types.ErrActiveLiquidValidatorsNotExists
 	}
 
@@ -100,7 +108,13 @@ func (k Keeper) LiquidStake(
 	}
 
 	newShares, err = k.LiquidDelegate(ctx, proxyAcc, activeVals, stakingCoin.Amount, whitelistedValsMap)
-	return newShares, bTokenMintAmount, err
+	if err != nil {
+		return sdk.ZeroDec(), bTokenMintAmount, err
+	}
+	if preferred {
+		k.addPreferredDelegations(ctx, activeVals, stakingCoin.Amount, whitelistedValsMap)
+	}
+	return newShares, bTokenMintAmount, nil
 }
 
 // LiquidDelegate delegates staking amount to active validators by proxy account.
@@ -189,15 +203,33 @@ func (k Keeper) LiquidUnstake(
 		return time.Time{}, sdk.ZeroInt(), []stakingtypes.UnbondingDelegation{}, sdk.ZeroInt(), types.ErrLiquidValidatorsNotExists
 	}
 
+	// unbond from the preferred validators if the liquid staker has a validator preference, otherwise from the
+	// liquid tokens delegated according to the target weights, unless they are insufficient
+	pref, preferred := k.GetValidatorPreference(ctx, liquidStaker)
+	preferredTokenMap, totalPreferredTokens := k.GetPreferredTokenMap(ctx, liquidVals, liquidTokenMap)
+	unbondingVals, unbondingTokenMap, totalUnbondingTokens := liquidVals, liquidTokenMap, totalLiquidTokens
+	if preferred {
+		preferredVals := liquidVals.Listed(pref.ValidatorsMap())
+		if totalTokens, tokenMap := preferredVals.TotalLiquidTokens(ctx, k.stakingKeeper, false); totalTokens.GTE(unbondingAmountInt) {
+			unbondingVals, unbondingTokenMap, totalUnbondingTokens = preferredVals, tokenMap, totalTokens
+		}
+	} else if totalDefaultTokens := totalLiquidTokens.Sub(totalPreferredTokens); totalDefaultTokens.GTE(unbondingAmountInt) {
+		defaultTokenMap := map[string]sdk.Int{}
+		for _, val := range liquidVals {
+			defaultTokenMap[val.OperatorAddress] = liquidTokenMap[val.OperatorAddress].Sub(preferredTokenMap[val.OperatorAddress])
+		}
+		unbondingTokenMap, totalUnbondingTokens = defaultTokenMap, totalDefaultTokens
+	}
+
 	// crumb may occur due to a decimal error in dividing the unstaking bToken into the weight of liquid validators, it will remain in the NetAmount
-	unbondingAmounts, crumb := types.DivideByCurrentWeight(liquidVals, unbondingAmount, totalLiquidTokens, liquidTokenMap)
+	unbondingAmounts, crumb := types.DivideByCurrentWeight(unbondingVals, unbondingAmount, totalUnbondingTokens, unbondingTokenMap)
 	if !unbondingAmount.Sub(crumb).IsPositive() {
 		return time.Time{}, sdk.ZeroInt(), []stakingtypes.UnbondingDelegation{}, sdk.ZeroInt(), types.ErrTooSmallLiquidUnstakingAmount
 	}
 	totalReturnAmount := sdk.ZeroInt()
 	var ubdTime time.Time
 	var ubds []stakingtypes.UnbondingDelegation
-	for i, val := range liquidVals {
+	for i, val := range unbondingVals {
 		// skip zero weight liquid validator
 		if !unbondingAmounts[i].IsPositive() {
 			continue
@@ -220,10 +252,81 @@ func (k Keeper) LiquidUnstake(
 		}
 		ubds = append(ubds, ubd)
 		totalReturnAmount = totalReturnAmount.Add(returnAmount)
+
+		// the preferred liquid tokens are unbonded first for the liquid staker with a validator preference,
+		// and last for the others
+		if preferred {
+			k.reducePreferredDelegation(ctx, val.GetOperator(), returnAmount)
+		} else {
+			defaultTokens := liquidTokenMap[val.OperatorAddress].Sub(preferredTokenMap[val.OperatorAddress])
+			k.reducePreferredDelegation(ctx, val.GetOperator(), returnAmount.Sub(defaultTokens))
+		}
+	}
+	if len(ubds) > 0 {
+		k.createUnstakeTicket(ctx, liquidStaker, unstakingBtoken,
+			sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), totalReturnAmount), ubdTime)
 	}
 	return ubdTime, totalReturnAmount, ubds, sdk.ZeroInt(), nil
 }
 
+// InstantLiquidUnstake burns unstakingBtoken and pays the native token worth of it according to NetAmount
+// from the instant unstake buffer of proxy account, deducting the instant unstake fee.
+func (k Keeper) InstantLiquidUnstake(
+	ctx sdk.Context, proxyAcc, liquidStaker sdk.AccAddress, unstakingBtoken sdk.Coin,
+) (sdk.Int, error) {
+	params := k.GetParams(ctx)
+	if !params.InstantUnstakeBufferSize.IsPositive() {
+		return sdk.ZeroInt(), types.ErrInstantUnstakeDisabled
+	}
+
+	// check bond denomination
+	liquidBondDenom := k.LiquidBondDenom(ctx)
+	if unstakingBtoken.Denom != liquidBondDenom {
+		return sdk.ZeroInt(), sdkerrors.Wrapf(
+			types.ErrInvalidLiquidBondDenom, "invalid coin denomination: got %s, expected %s", unstakingBtoken.Denom, liquidBondDenom,
+		)
+	}
+
+	// Get NetAmount states
+	nas := k.GetNetAmountState(ctx)
+
+	if unstakingBtoken.Amount.GT(nas.BtokenTotalSupply) {
+		return sdk.ZeroInt(), types.ErrInvalidBTokenSupply
+	}
+
+	// UnstakedAmount = NetAmount * BTokenAmount/TotalSupply * (1-InstantUnstakeFeeRate)
+	unstakedAmount := types.BTokenToNativeToken(unstakingBtoken.Amount, nas.BtokenTotalSupply, nas.NetAmount)
+	unstakedAmount = types.DeductFeeRate(unstakedAmount, params.EffectiveInstantUnstakeFeeRate())
+	unstakedAmountInt := unstakedAmount.TruncateInt()
+
+	if !unstakedAmountInt.IsPositive() {
+		return sdk.ZeroInt(), types.ErrTooSmallLiquidUnstakingAmount
+	}
+
+	// the buffer can be used up to the balance of proxy account
+	if unstakedAmountInt.GT(nas.ProxyAccBalance) {
+		return sdk.ZeroInt(), sdkerrors.Wrapf(
+			types.ErrInsufficientInstantUnstakeBuffer, "%s is larger than %s", unstakedAmountInt, nas.ProxyAccBalance)
+	}
+
+	// burn btoken
+	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, liquidStaker, types.ModuleName, sdk.NewCoins(unstakingBtoken))
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
+	err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(unstakingBtoken))
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
+
+	err = k.bankKeeper.SendCoins(ctx, proxyAcc, liquidStaker,
+		sdk.NewCoins(sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), unstakedAmountInt)))
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
+	return unstakedAmountInt, nil
+}
+
 // LiquidUnbond unbond delegation shares to active validators by proxy account.
 func (k Keeper) LiquidUnbond(
 	ctx sdk.Context, proxyAcc, liquidStaker sdk.AccAddress, valAddr sdk.ValAddress, shares sdk.Dec, checkMaxEntries bool,
@@ -366,7 +469,9 @@ func (k Keeper) GetActiveLiquidValidators(ctx sdk.Context, whitelistedValsMap ty
 
 func (k Keeper) GetAllLiquidValidatorStates(ctx sdk.Context) (liquidValidatorStates []types.LiquidValidatorState) {
 	lvs := k.GetAllLiquidValidators(ctx)
-	whitelistedValsMap := k.GetParams(ctx).WhitelistedValsMap()
+	params := k.GetParams(ctx)
+	whitelistedValsMap := params.WhitelistedValsMap()
+	effectiveValsMap := k.GetEffectiveWhitelistedValsMap(ctx, params)
 	for _, lv := range lvs {
 		active := k.IsActiveLiquidValidator(ctx, lv, whitelistedValsMap)
 		lvState := types.LiquidValidatorState{
@@ -375,6 +480,7 @@ func (k Keeper) GetAllLiquidValidatorStates(ctx sdk.Context) (liquidValidatorSta
 			Status:          lv.GetStatus(active),
 			DelShares:       lv.GetDelShares(ctx, k.stakingKeeper),
 			LiquidTokens:    lv.GetLiquidTokens(ctx, k.stakingKeeper, false),
+			EffectiveWeight: lv.GetWeight(effectiveValsMap, active),
 		}
 		liquidValidatorStates = append(liquidValidatorStates, lvState)
 	}
@@ -390,9 +496,11 @@ func (k Keeper) GetLiquidValidatorState(ctx sdk.Context, addr sdk.ValAddress) (l
 			Status:          types.ValidatorStatusUnspecified,
 			DelShares:       sdk.ZeroDec(),
 			LiquidTokens:    sdk.ZeroInt(),
+			EffectiveWeight: sdk.ZeroInt(),
 		}, false
 	}
-	whitelistedValsMap := k.GetParams(ctx).WhitelistedValsMap()
+	params := k.GetParams(ctx)
+	whitelistedValsMap := params.WhitelistedValsMap()
 	active := k.IsActiveLiquidValidator(ctx, lv, whitelistedValsMap)
 	return types.LiquidValidatorState{
 		OperatorAddress: lv.OperatorAddress,
@@ -400,6 +508,7 @@ func (k Keeper) GetLiquidValidatorState(ctx sdk.Context, addr sdk.ValAddress) (l
 		Status:          lv.GetStatus(active),
 		DelShares:       lv.GetDelShares(ctx, k.stakingKeeper),
 		LiquidTokens:    lv.GetLiquidTokens(ctx, k.stakingKeeper, false),
+		EffectiveWeight: lv.GetWeight(k.GetEffectiveWhitelistedValsMap(ctx, params), active),
 	}, true
 }
 
@@ -429,3 +538,59 @@ func (k Keeper) GetWeightMap(ctx sdk.Context, liquidVals types.LiquidValidators,
 	}
 	return weightMap, totalWeight
 }
+
+// GetEffectiveWhitelistedValsMap returns the whitelisted validators map whose target weights are replaced with
+// the effective weights according to the weighting mode.
+// On the performance weighting mode, the performance scores updated every PerformanceUpdateInterval blocks are used,
+// so the effective weights don't change on every block.
+func (k Keeper) GetEffectiveWhitelistedValsMap(ctx sdk.Context, params types.Params) types.WhitelistedValsMap {
+	whitelistedValsMap := params.WhitelistedValsMap()
+	if params.WeightingMode != types.WeightingModePerformance {
+		return whitelistedValsMap
+	}
+	signedBlocksWindow := k.slashingKeeper.SignedBlocksWindow(ctx)
+	for addr, wv := range whitelistedValsMap {
+		score := sdk.ZeroDec()
+		if valAddr, err := sdk.ValAddressFromBech32(addr); err == nil {
+			score = k.getEffectivePerformanceScore(ctx, valAddr, signedBlocksWindow)
+		}
+		wv.TargetWeight = types.PerformanceWeight(wv.TargetWeight, score)
+		whitelistedValsMap[addr] = wv
+	}
+	return whitelistedValsMap
+}
+
+// getEffectivePerformanceScore returns the stored performance score of the validator, or calculates it if it is
+// not stored yet. A validator which is not found, jailed or tombstoned scores zero regardless of the stored score.
+func (k Keeper) getEffectivePerformanceScore(ctx sdk.Context, valAddr sdk.ValAddress, signedBlocksWindow int64) sdk.Dec {
+	score, found := k.GetPerformanceScore(ctx, valAddr)
+	if !found {
+		return k.CalcPerformanceScore(ctx, valAddr, signedBlocksWindow)
+	}
+	val, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found || val.IsJailed() || k.IsTombstoned(ctx, val) {
+		return sdk.ZeroDec()
+	}
+	return score
+}
+
+// CalcPerformanceScore returns the performance score of the validator based on its signing info and commission rate.
+// A validator which is not found or tombstoned scores zero.
+func (k Keeper) CalcPerformanceScore(ctx sdk.Context, valAddr sdk.ValAddress, signedBlocksWindow int64) sdk.Dec {
+	val, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.ZeroDec()
+	}
+	consPk, err := val.ConsPubKey()
+	if err != nil {
+		return sdk.ZeroDec()
+	}
+	missedBlocks := int64(0)
+	if info, found := k.slashingKeeper.GetValidatorSigningInfo(ctx, sdk.ConsAddress(consPk.Address())); found {
+		if info.Tombstoned {
+			return sdk.ZeroDec()
+		}
+		missedBlocks = info.MissedBlocksCounter
+	}
+	return types.PerformanceScore(missedBlocks, signedBlocksWindow, val.GetCommission(), val.IsJailed())
+}
@@ -329,3 +329,71 @@ func (s *KeeperTestSuite) TestLiquidUnstakeEdgeCases() {
 	s.Require().EqualValues(ubdTime, time.Time{})
 	s.Require().Len(ubds, 0)
 }
+
+func (s *KeeperTestSuite) TestInstantLiquidUnstake() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 2000000, 3000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(10)},
+	}
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+
+	liquidStaker := s.delAddrs[0]
+	s.Require().NoError(s.liquidStaking(liquidStaker, sdk.NewInt(100000000)))
+
+	// fail when instant unstaking is disabled
+	_, err := s.keeper.InstantLiquidUnstake(
+		s.ctx, types.LiquidStakingProxyAcc, liquidStaker, sdk.NewCoin(params.LiquidBondDenom, sdk.NewInt(1000000)))
+	s.Require().ErrorIs(err, types.ErrInstantUnstakeDisabled)
+
+	params.InstantUnstakeBufferSize = sdk.NewInt(10000000)
+	params.InstantUnstakeFeeRate = sdk.NewDecWithPrec(1, 2)
+	s.keeper.SetParams(s.ctx, params)
+
+	// fail when the buffer is empty
+	_, err = s.keeper.InstantLiquidUnstake(
+		s.ctx, types.LiquidStakingProxyAcc, liquidStaker, sdk.NewCoin(params.LiquidBondDenom, sdk.NewInt(1000000)))
+	s.Require().ErrorIs(err, types.ErrInsufficientInstantUnstakeBuffer)
+
+	// fill the buffer
+	s.fundAddr(types.LiquidStakingProxyAcc, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000000))))
+
+	// fail when invalid liquid bond denom
+	_, err = s.keeper.InstantLiquidUnstake(
+		s.ctx, types.LiquidStakingProxyAcc, liquidStaker, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000000)))
+	s.Require().ErrorIs(err, types.ErrInvalidLiquidBondDenom)
+
+	// fail when the unstaked amount exceeds the buffer
+	_, err = s.keeper.InstantLiquidUnstake(
+		s.ctx, types.LiquidStakingProxyAcc, liquidStaker, sdk.NewCoin(params.LiquidBondDenom, sdk.NewInt(20000000)))
+	s.Require().ErrorIs(err, types.ErrInsufficientInstantUnstakeBuffer)
+
+	nas := s.keeper.GetNetAmountState(s.ctx)
+	balanceBefore := s.app.BankKeeper.GetBalance(s.ctx, liquidStaker, sdk.DefaultBondDenom).Amount
+	unstakedAmt, err := s.keeper.InstantLiquidUnstake(
+		s.ctx, types.LiquidStakingProxyAcc, liquidStaker, sdk.NewCoin(params.LiquidBondDenom, sdk.NewInt(1000000)))
+	s.Require().NoError(err)
+
+	// UnstakedAmount = NetAmount * BTokenAmount/TotalSupply * (1-InstantUnstakeFeeRate)
+	expected := types.DeductFeeRate(
+		types.BTokenToNativeToken(sdk.NewInt(1000000), nas.BtokenTotalSupply, nas.NetAmount), params.InstantUnstakeFeeRate).TruncateInt()
+	s.Require().EqualValues(expected, unstakedAmt)
+	s.Require().EqualValues(sdk.NewInt(1089000), unstakedAmt)
+	s.Require().EqualValues(balanceBefore.Add(unstakedAmt), s.app.BankKeeper.GetBalance(s.ctx, liquidStaker, sdk.DefaultBondDenom).Amount)
+	s.Require().EqualValues(sdk.NewInt(99000000), s.app.BankKeeper.GetBalance(s.ctx, liquidStaker, params.LiquidBondDenom).Amount)
+	s.Require().EqualValues(sdk.NewInt(10000000).Sub(unstakedAmt), s.keeper.GetProxyAccBalance(s.ctx, types.LiquidStakingProxyAcc).Amount)
+
+	// UnstakeFeeRate is applied if it is higher than InstantUnstakeFeeRate
+	params.InstantUnstakeFeeRate = sdk.ZeroDec()
+	params.UnstakeFeeRate = sdk.NewDecWithPrec(2, 2)
+	s.keeper.SetParams(s.ctx, params)
+	nas = s.keeper.GetNetAmountState(s.ctx)
+	unstakedAmt, err = s.keeper.InstantLiquidUnstake(
+		s.ctx, types.LiquidStakingProxyAcc, liquidStaker, sdk.NewCoin(params.LiquidBondDenom, sdk.NewInt(1000000)))
+	s.Require().NoError(err)
+	expected = types.DeductFeeRate(
+		types.BTokenToNativeToken(sdk.NewInt(1000000), nas.BtokenTotalSupply, nas.NetAmount), params.UnstakeFeeRate).TruncateInt()
+	s.Require().EqualValues(expected, unstakedAmt)
+}
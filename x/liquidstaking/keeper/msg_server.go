@@ -7,6 +7,7 @@ package keeper
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -78,3 +79,54 @@ func (k msgServer) LiquidUnstake(goCtx context.Context, msg *types.MsgLiquidUnst
 		CompletionTime: completionTime,
 	}, nil
 }
+
+func (k msgServer) InstantLiquidUnstake(goCtx context.Context, msg *types.MsgInstantLiquidUnstake) (*types.MsgInstantLiquidUnstakeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	unstakedAmount, err := k.Keeper.InstantLiquidUnstake(ctx, types.LiquidStakingProxyAcc, msg.GetDelegator(), msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	unstakedCoin := sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), unstakedAmount)
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeMsgInstantLiquidUnstake,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyUnstakedAmount, unstakedCoin.String()),
+		),
+	})
+	return &types.MsgInstantLiquidUnstakeResponse{
+		UnstakedAmount: unstakedCoin,
+	}, nil
+}
+
+func (k msgServer) SetValidatorPreference(goCtx context.Context, msg *types.MsgSetValidatorPreference) (*types.MsgSetValidatorPreferenceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.Keeper.UpdateValidatorPreference(ctx, msg.GetDelegator(), msg.Validators); err != nil {
+		return nil, err
+	}
+
+	preferredVals := make([]string, 0, len(msg.Validators))
+	for _, v := range msg.Validators {
+		preferredVals = append(preferredVals, v.ValidatorAddress)
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeMsgSetValidatorPreference,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyPreferredValidators, strings.Join(preferredVals, ",")),
+		),
+	})
+	return &types.MsgSetValidatorPreferenceResponse{}, nil
+}
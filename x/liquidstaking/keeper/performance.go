@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmosquad-labs/squad/v3/x/liquidstaking/types"
+)
+
+// GetPerformanceScore returns the stored performance score of the validator.
+func (k Keeper) GetPerformanceScore(ctx sdk.Context, valAddr sdk.ValAddress) (score sdk.Dec, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPerformanceScoreKey(valAddr))
+	if bz == nil {
+		return sdk.Dec{}, false
+	}
+	var dp sdk.DecProto
+	k.cdc.MustUnmarshal(bz, &dp)
+	return dp.Dec, true
+}
+
+// SetPerformanceScore stores the performance score of the validator.
+func (k Keeper) SetPerformanceScore(ctx sdk.Context, valAddr sdk.ValAddress, score sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&sdk.DecProto{Dec: score})
+	store.Set(types.GetPerformanceScoreKey(valAddr), bz)
+}
+
+// DeletePerformanceScore deletes the performance score of the validator.
+func (k Keeper) DeletePerformanceScore(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPerformanceScoreKey(valAddr))
+}
+
+// IterateAllPerformanceScores iterates through all the stored performance scores.
+// Stops iteration when the callback function returns true.
+func (k Keeper) IterateAllPerformanceScores(ctx sdk.Context, cb func(valAddr sdk.ValAddress, score sdk.Dec) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PerformanceScoresKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var dp sdk.DecProto
+		k.cdc.MustUnmarshal(iterator.Value(), &dp)
+		if cb(types.ParsePerformanceScoreKey(iterator.Key()), dp.Dec) {
+			break
+		}
+	}
+}
+
+// UpdatePerformanceScores updates the performance scores of the whitelisted validators every
+// PerformanceUpdateInterval blocks on the performance weighting mode. The scores of newly whitelisted
+// validators are stored right away, and the scores of the validators no longer whitelisted are deleted.
+// All the scores are deleted on the other weighting modes.
+func (k Keeper) UpdatePerformanceScores(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	whitelistedValsMap := params.WhitelistedValsMap()
+	if params.WeightingMode != types.WeightingModePerformance {
+		whitelistedValsMap = types.WhitelistedValsMap{}
+	}
+
+	var valAddrsToDelete []sdk.ValAddress
+	k.IterateAllPerformanceScores(ctx, func(valAddr sdk.ValAddress, _ sdk.Dec) (stop bool) {
+		if !whitelistedValsMap.IsListed(valAddr.String()) {
+			valAddrsToDelete = append(valAddrsToDelete, valAddr)
+		}
+		return false
+	})
+	for _, valAddr := range valAddrsToDelete {
+		k.DeletePerformanceScore(ctx, valAddr)
+	}
+	if params.WeightingMode != types.WeightingModePerformance {
+		return
+	}
+
+	update := uint64(ctx.BlockHeight())%params.PerformanceUpdateInterval == 0
+	signedBlocksWindow := k.slashingKeeper.SignedBlocksWindow(ctx)
+	for _, wv := range params.WhitelistedValidators {
+		valAddr, err := sdk.ValAddressFromBech32(wv.ValidatorAddress)
+		if err != nil {
+			continue
+		}
+		if _, found := k.GetPerformanceScore(ctx, valAddr); found && !update {
+			continue
+		}
+		k.SetPerformanceScore(ctx, valAddr, k.CalcPerformanceScore(ctx, valAddr, signedBlocksWindow))
+	}
+}
@@ -0,0 +1,170 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmosquad-labs/squad/v3/x/liquidstaking/types"
+)
+
+// GetValidatorPreference returns the validator preference of the liquid staker.
+func (k Keeper) GetValidatorPreference(ctx sdk.Context, delAddr sdk.AccAddress) (pref types.ValidatorPreference, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetValidatorPreferenceKey(delAddr))
+	if bz == nil {
+		return pref, false
+	}
+	k.cdc.MustUnmarshal(bz, &pref)
+	return pref, true
+}
+
+// SetValidatorPreference stores the validator preference.
+func (k Keeper) SetValidatorPreference(ctx sdk.Context, pref types.ValidatorPreference) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&pref)
+	store.Set(types.GetValidatorPreferenceKey(pref.GetDelegator()), bz)
+}
+
+// DeleteValidatorPreference deletes the validator preference of the liquid staker.
+func (k Keeper) DeleteValidatorPreference(ctx sdk.Context, delAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetValidatorPreferenceKey(delAddr))
+}
+
+// GetAllValidatorPreferences returns all validator preferences.
+func (k Keeper) GetAllValidatorPreferences(ctx sdk.Context) (prefs []types.ValidatorPreference) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorPreferencesKey)
+	defer iterator.Close()
+
+	prefs = []types.ValidatorPreference{}
+	for ; iterator.Valid(); iterator.Next() {
+		var pref types.ValidatorPreference
+		k.cdc.MustUnmarshal(iterator.Value(), &pref)
+		prefs = append(prefs, pref)
+	}
+	return prefs
+}
+
+// GetPreferredDelegation returns the amount of liquid tokens delegated to the validator
+// according to the validator preferences.
+func (k Keeper) GetPreferredDelegation(ctx sdk.Context, valAddr sdk.ValAddress) sdk.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPreferredDelegationKey(valAddr))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+	var pd types.PreferredDelegation
+	k.cdc.MustUnmarshal(bz, &pd)
+	return pd.Amount
+}
+
+// SetPreferredDelegation stores the preferred delegation, or deletes it if the amount is not positive.
+func (k Keeper) SetPreferredDelegation(ctx sdk.Context, valAddr sdk.ValAddress, amt sdk.Int) {
+	if !amt.IsPositive() {
+		k.DeletePreferredDelegation(ctx, valAddr)
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&types.PreferredDelegation{
+		ValidatorAddress: valAddr.String(),
+		Amount:           amt,
+	})
+	store.Set(types.GetPreferredDelegationKey(valAddr), bz)
+}
+
+// DeletePreferredDelegation deletes the preferred delegation of the validator.
+func (k Keeper) DeletePreferredDelegation(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPreferredDelegationKey(valAddr))
+}
+
+// GetAllPreferredDelegations returns all preferred delegations.
+func (k Keeper) GetAllPreferredDelegations(ctx sdk.Context) (pds []types.PreferredDelegation) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PreferredDelegationsKey)
+	defer iterator.Close()
+
+	pds = []types.PreferredDelegation{}
+	for ; iterator.Valid(); iterator.Next() {
+		var pd types.PreferredDelegation
+		k.cdc.MustUnmarshal(iterator.Value(), &pd)
+		pds = append(pds, pd)
+	}
+	return pds
+}
+
+// UpdateValidatorPreference sets the validator preference of the liquid staker, or removes it if validators is empty.
+// All the preferred validators must be whitelisted.
+func (k Keeper) UpdateValidatorPreference(ctx sdk.Context, liquidStaker sdk.AccAddress, validators []types.WhitelistedValidator) error {
+	if len(validators) == 0 {
+		k.DeleteValidatorPreference(ctx, liquidStaker)
+		return nil
+	}
+
+	whitelistedValsMap := k.GetParams(ctx).WhitelistedValsMap()
+	for _, v := range validators {
+		if !whitelistedValsMap.IsListed(v.ValidatorAddress) {
+			return sdkerrors.Wrapf(types.ErrNotWhitelistedValidator, "validator %s", v.ValidatorAddress)
+		}
+	}
+
+	k.SetValidatorPreference(ctx, types.ValidatorPreference{
+		DelegatorAddress: liquidStaker.String(),
+		Validators:       validators,
+	})
+	return nil
+}
+
+// GetPreferredTokenMap returns the liquid tokens of the liquid validators delegated according to the validator
+// preferences, capped by the liquid tokens of each liquid validator since they could have been slashed.
+func (k Keeper) GetPreferredTokenMap(ctx sdk.Context, liquidVals types.LiquidValidators, liquidTokenMap map[string]sdk.Int) (map[string]sdk.Int, sdk.Int) {
+	preferredTokenMap := map[string]sdk.Int{}
+	totalPreferredTokens := sdk.ZeroInt()
+	for _, val := range liquidVals {
+		preferredTokens := sdk.MinInt(k.GetPreferredDelegation(ctx, val.GetOperator()), liquidTokenMap[val.OperatorAddress])
+		preferredTokenMap[val.OperatorAddress] = preferredTokens
+		totalPreferredTokens = totalPreferredTokens.Add(preferredTokens)
+	}
+	return preferredTokenMap, totalPreferredTokens
+}
+
+// GetDelegationBreakdown returns the liquid tokens of each liquid validator delegated according to the validator
+// preferences and the target weights, respectively.
+func (k Keeper) GetDelegationBreakdown(ctx sdk.Context) (breakdowns []types.ValidatorDelegationBreakdown, totalPreferredTokens, totalDefaultTokens sdk.Int) {
+	liquidVals := k.GetAllLiquidValidators(ctx)
+	totalLiquidTokens, liquidTokenMap := liquidVals.TotalLiquidTokens(ctx, k.stakingKeeper, false)
+	preferredTokenMap, totalPreferredTokens := k.GetPreferredTokenMap(ctx, liquidVals, liquidTokenMap)
+	breakdowns = []types.ValidatorDelegationBreakdown{}
+	for _, val := range liquidVals {
+		breakdowns = append(breakdowns, types.ValidatorDelegationBreakdown{
+			OperatorAddress: val.OperatorAddress,
+			PreferredTokens: preferredTokenMap[val.OperatorAddress],
+			DefaultTokens:   liquidTokenMap[val.OperatorAddress].Sub(preferredTokenMap[val.OperatorAddress]),
+		})
+	}
+	return breakdowns, totalPreferredTokens, totalLiquidTokens.Sub(totalPreferredTokens)
+}
+
+// addPreferredDelegations records the staking amount delegated to the preferred validators,
+// divided in the same way as LiquidDelegate.
+func (k Keeper) addPreferredDelegations(
+	ctx sdk.Context, activeVals types.ActiveLiquidValidators, stakingAmt sdk.Int, preferredValsMap types.WhitelistedValsMap) {
+	weightedAmt, crumb := types.DivideByWeight(activeVals, stakingAmt, preferredValsMap)
+	if len(weightedAmt) == 0 {
+		return
+	}
+	weightedAmt[0] = weightedAmt[0].Add(crumb)
+	for i, val := range activeVals {
+		k.SetPreferredDelegation(ctx, val.GetOperator(), k.GetPreferredDelegation(ctx, val.GetOperator()).Add(weightedAmt[i]))
+	}
+}
+
+// reducePreferredDelegation reduces the preferred delegation of the validator by the amount, down to zero.
+func (k Keeper) reducePreferredDelegation(ctx sdk.Context, valAddr sdk.ValAddress, amt sdk.Int) {
+	if !amt.IsPositive() {
+		return
+	}
+	preferred := k.GetPreferredDelegation(ctx, valAddr)
+	k.SetPreferredDelegation(ctx, valAddr, preferred.Sub(sdk.MinInt(preferred, amt)))
+}
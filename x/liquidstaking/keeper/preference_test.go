@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmosquad-labs/squad/v3/x/liquidstaking/types"
+)
+
+func (s *KeeperTestSuite) TestUpdateValidatorPreference() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000, 1000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(10)},
+	}
+	s.keeper.SetParams(s.ctx, params)
+
+	err := s.keeper.UpdateValidatorPreference(s.ctx, s.delAddrs[0], []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[2].String(), TargetWeight: sdk.NewInt(1)},
+	})
+	s.Require().ErrorIs(err, types.ErrNotWhitelistedValidator)
+	_, found := s.keeper.GetValidatorPreference(s.ctx, s.delAddrs[0])
+	s.Require().False(found)
+
+	validators := []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(1)},
+	}
+	s.Require().NoError(s.keeper.UpdateValidatorPreference(s.ctx, s.delAddrs[0], validators))
+	pref, found := s.keeper.GetValidatorPreference(s.ctx, s.delAddrs[0])
+	s.Require().True(found)
+	s.Require().Equal(s.delAddrs[0].String(), pref.DelegatorAddress)
+	s.Require().Equal(validators, pref.Validators)
+	s.Require().Len(s.keeper.GetAllValidatorPreferences(s.ctx), 1)
+
+	// an empty preference removes the validator preference
+	s.Require().NoError(s.keeper.UpdateValidatorPreference(s.ctx, s.delAddrs[0], nil))
+	_, found = s.keeper.GetValidatorPreference(s.ctx, s.delAddrs[0])
+	s.Require().False(found)
+}
+
+func (s *KeeperTestSuite) TestLiquidStakeWithValidatorPreference() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000, 1000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.MinLiquidStakingAmount = sdk.NewInt(10000)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[2].String(), TargetWeight: sdk.NewInt(10)},
+	}
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+
+	preferrer, follower := s.delAddrs[0], s.delAddrs[1]
+	s.Require().NoError(s.keeper.UpdateValidatorPreference(s.ctx, preferrer, []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(1)},
+	}))
+
+	s.Require().NoError(s.liquidStaking(preferrer, sdk.NewInt(100000)))
+	s.Require().NoError(s.liquidStaking(follower, sdk.NewInt(300000)))
+
+	_, liquidTokenMap := s.keeper.GetAllLiquidValidators(s.ctx).TotalLiquidTokens(s.ctx, s.app.StakingKeeper, false)
+	s.Require().EqualValues(sdk.NewInt(200000), liquidTokenMap[valOpers[0].String()])
+	s.Require().EqualValues(sdk.NewInt(100000), liquidTokenMap[valOpers[1].String()])
+	s.Require().EqualValues(sdk.NewInt(100000), liquidTokenMap[valOpers[2].String()])
+	s.Require().EqualValues(sdk.NewInt(100000), s.keeper.GetPreferredDelegation(s.ctx, valOpers[0]))
+
+	breakdowns, preferredTokens, defaultTokens := s.keeper.GetDelegationBreakdown(s.ctx)
+	s.Require().Len(breakdowns, 3)
+	s.Require().EqualValues(sdk.NewInt(100000), preferredTokens)
+	s.Require().EqualValues(sdk.NewInt(300000), defaultTokens)
+
+	// the preferred liquid tokens are not rebalanced
+	reds := s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().Len(reds, 0)
+
+	// the liquid staker with the validator preference unbonds from the preferred validators
+	_, _, ubds, _, err := s.keeper.LiquidUnstake(s.ctx, types.LiquidStakingProxyAcc, preferrer, sdk.NewCoin(params.LiquidBondDenom, sdk.NewInt(50000)))
+	s.Require().NoError(err)
+	s.Require().Len(ubds, 1)
+	s.Require().Equal(valOpers[0].String(), ubds[0].ValidatorAddress)
+	s.Require().EqualValues(sdk.NewInt(50000), s.keeper.GetPreferredDelegation(s.ctx, valOpers[0]))
+
+	// the others unbond from the liquid tokens delegated according to the target weights
+	_, _, ubds, _, err = s.keeper.LiquidUnstake(s.ctx, types.LiquidStakingProxyAcc, follower, sdk.NewCoin(params.LiquidBondDenom, sdk.NewInt(150000)))
+	s.Require().NoError(err)
+	s.Require().Len(ubds, 3)
+	s.Require().EqualValues(sdk.NewInt(50000), s.keeper.GetPreferredDelegation(s.ctx, valOpers[0]))
+	_, liquidTokenMap = s.keeper.GetAllLiquidValidators(s.ctx).TotalLiquidTokens(s.ctx, s.app.StakingKeeper, false)
+	s.Require().EqualValues(sdk.NewInt(100000), liquidTokenMap[valOpers[0].String()])
+	s.Require().EqualValues(sdk.NewInt(50000), liquidTokenMap[valOpers[1].String()])
+	s.Require().EqualValues(sdk.NewInt(50000), liquidTokenMap[valOpers[2].String()])
+
+	resp, err := s.querier.DelegationBreakdown(sdk.WrapSDKContext(s.ctx), &types.QueryDelegationBreakdownRequest{})
+	s.Require().NoError(err)
+	s.Require().EqualValues(sdk.NewInt(50000), resp.PreferredTokens)
+	s.Require().EqualValues(sdk.NewInt(150000), resp.DefaultTokens)
+
+	// the preferred delegation is dropped when the preferred validator is delisted
+	params.WhitelistedValidators = params.WhitelistedValidators[1:]
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().True(s.keeper.GetPreferredDelegation(s.ctx, valOpers[0]).IsZero())
+
+	err = s.liquidStaking(preferrer, sdk.NewInt(100000))
+	s.Require().ErrorIs(err, types.ErrPreferredValidatorsNotActive)
+}
@@ -62,11 +62,15 @@ func (k Keeper) Rebalance(ctx sdk.Context, proxyAcc sdk.AccAddress, liquidVals t
 		return []types.Redelegation{}
 	}
 
-	// calculate rebalancing target map
+	// calculate rebalancing target map, the liquid tokens delegated according to the validator preferences
+	// stay on their liquid validators and only the others are divided by the weights
+	preferredTokenMap, totalPreferredTokens := k.GetPreferredTokenMap(ctx, liquidVals, liquidTokenMap)
+	totalDefaultTokens := totalLiquidTokens.Sub(totalPreferredTokens)
 	targetMap := map[string]sdk.Int{}
 	totalTargetMap := sdk.ZeroInt()
 	for _, val := range liquidVals {
-		targetMap[val.OperatorAddress] = totalLiquidTokens.Mul(weightMap[val.OperatorAddress]).Quo(totalWeight)
+		targetMap[val.OperatorAddress] = totalDefaultTokens.Mul(weightMap[val.OperatorAddress]).Quo(totalWeight).
+			Add(preferredTokenMap[val.OperatorAddress])
 		totalTargetMap = totalTargetMap.Add(targetMap[val.OperatorAddress])
 	}
 	crumb := totalLiquidTokens.Sub(totalTargetMap)
@@ -130,12 +134,24 @@ func (k Keeper) Rebalance(ctx sdk.Context, proxyAcc sdk.AccAddress, liquidVals t
 	return redelegations
 }
 
-// WithdrawRewardsAndReStake withdraw rewards and re-staking when over threshold
+// RestakableBalance returns the balance of proxy account exceeding the instant unstake buffer size,
+// which can be re-staked. The buffer is refilled by withdrawn rewards and by RefillInstantUnstakeBuffer.
+func (k Keeper) RestakableBalance(ctx sdk.Context, proxyAcc sdk.AccAddress) sdk.Coin {
+	proxyAccBalance := k.GetProxyAccBalance(ctx, proxyAcc)
+	bufferSize := k.GetParams(ctx).InstantUnstakeBufferSize
+	if proxyAccBalance.Amount.LTE(bufferSize) {
+		return sdk.NewCoin(proxyAccBalance.Denom, sdk.ZeroInt())
+	}
+	return proxyAccBalance.SubAmount(bufferSize)
+}
+
+// WithdrawRewardsAndReStake withdraw rewards and re-staking when over threshold.
+// The balance of proxy account up to the instant unstake buffer size is kept to refill the buffer.
 func (k Keeper) WithdrawRewardsAndReStake(ctx sdk.Context, whitelistedValsMap types.WhitelistedValsMap) {
 	totalRemainingRewards, _, totalLiquidTokens := k.CheckDelegationStates(ctx, types.LiquidStakingProxyAcc)
 
 	// checking over types.RewardTrigger and execute GetRewards
-	proxyAccBalance := k.GetProxyAccBalance(ctx, types.LiquidStakingProxyAcc)
+	proxyAccBalance := k.RestakableBalance(ctx, types.LiquidStakingProxyAcc)
 	rewardsThreshold := types.RewardTrigger.Mul(totalLiquidTokens.ToDec())
 
 	// skip If it doesn't exceed the rewards threshold
@@ -147,7 +163,10 @@ func (k Keeper) WithdrawRewardsAndReStake(ctx sdk.Context, whitelistedValsMap ty
 	k.WithdrawLiquidRewards(ctx, types.LiquidStakingProxyAcc)
 
 	// re-staking with proxyAccBalance, due to auto-withdraw on add staking by f1
-	proxyAccBalance = k.GetProxyAccBalance(ctx, types.LiquidStakingProxyAcc)
+	proxyAccBalance = k.RestakableBalance(ctx, types.LiquidStakingProxyAcc)
+	if !proxyAccBalance.IsPositive() {
+		return
+	}
 
 	// skip when no active liquid validator
 	activeVals := k.GetActiveLiquidValidators(ctx, whitelistedValsMap)
@@ -177,12 +196,98 @@ func (k Keeper) WithdrawRewardsAndReStake(ctx sdk.Context, whitelistedValsMap ty
 		sdk.AttributeKeyAmount, proxyAccBalance.String())
 }
 
+// RefillInstantUnstakeBuffer unbonds the shortfall of the instant unstake buffer from the liquid validators
+// to the proxy account, so that the buffer is refilled when the unbonding completes.
+// The unbonding balance of proxy account already on the way is counted against the shortfall.
+func (k Keeper) RefillInstantUnstakeBuffer(ctx sdk.Context) {
+	bufferSize := k.GetParams(ctx).InstantUnstakeBufferSize
+	if !bufferSize.IsPositive() {
+		return
+	}
+
+	shortfall := bufferSize.Sub(k.GetProxyAccBalance(ctx, types.LiquidStakingProxyAcc).Amount)
+	for _, ubd := range k.stakingKeeper.GetAllUnbondingDelegations(ctx, types.LiquidStakingProxyAcc) {
+		for _, entry := range ubd.Entries {
+			shortfall = shortfall.Sub(entry.Balance)
+		}
+	}
+	if !shortfall.IsPositive() {
+		return
+	}
+
+	liquidVals := k.GetAllLiquidValidators(ctx)
+	totalLiquidTokens, liquidTokenMap := liquidVals.TotalLiquidTokens(ctx, k.stakingKeeper, false)
+	if !totalLiquidTokens.IsPositive() {
+		return
+	}
+	shortfall = sdk.MinInt(shortfall, totalLiquidTokens)
+
+	// unbond from the liquid tokens delegated according to the target weights, unless they are insufficient
+	preferredTokenMap, totalPreferredTokens := k.GetPreferredTokenMap(ctx, liquidVals, liquidTokenMap)
+	unbondingTokenMap, totalUnbondingTokens := liquidTokenMap, totalLiquidTokens
+	if totalDefaultTokens := totalLiquidTokens.Sub(totalPreferredTokens); totalDefaultTokens.GTE(shortfall) {
+		defaultTokenMap := map[string]sdk.Int{}
+		for _, val := range liquidVals {
+			defaultTokenMap[val.OperatorAddress] = liquidTokenMap[val.OperatorAddress].Sub(preferredTokenMap[val.OperatorAddress])
+		}
+		unbondingTokenMap, totalUnbondingTokens = defaultTokenMap, totalDefaultTokens
+	}
+	unbondingAmounts, _ := types.DivideByCurrentWeight(liquidVals, shortfall.ToDec(), totalUnbondingTokens, unbondingTokenMap)
+
+	cachedCtx, writeCache := ctx.CacheContext()
+	totalReturnAmount := sdk.ZeroInt()
+	var completionTime time.Time
+	for i, val := range liquidVals {
+		if !unbondingAmounts[i].TruncateInt().IsPositive() {
+			continue
+		}
+		shares, err := k.stakingKeeper.ValidateUnbondAmount(
+			cachedCtx, types.LiquidStakingProxyAcc, val.GetOperator(), unbondingAmounts[i].TruncateInt())
+		if err != nil {
+			k.Logger(ctx).Error("refilling instant unstake buffer failed", "error", err)
+			return
+		}
+		if !shares.IsPositive() {
+			continue
+		}
+		// the unbonding entry belongs to the proxy account, so it is counted on NetAmount until completed
+		var returnAmount sdk.Int
+		completionTime, returnAmount, _, err = k.LiquidUnbond(
+			cachedCtx, types.LiquidStakingProxyAcc, types.LiquidStakingProxyAcc, val.GetOperator(), shares, true)
+		if err != nil {
+			k.Logger(ctx).Error("refilling instant unstake buffer failed", "error", err)
+			return
+		}
+		totalReturnAmount = totalReturnAmount.Add(returnAmount)
+		defaultTokens := liquidTokenMap[val.OperatorAddress].Sub(preferredTokenMap[val.OperatorAddress])
+		k.reducePreferredDelegation(cachedCtx, val.GetOperator(), returnAmount.Sub(defaultTokens))
+	}
+	if !totalReturnAmount.IsPositive() {
+		return
+	}
+	writeCache()
+
+	unbondingAmount := sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), totalReturnAmount).String()
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRefillInstantUnstakeBuffer,
+			sdk.NewAttribute(types.AttributeKeyDelegator, types.LiquidStakingProxyAcc.String()),
+			sdk.NewAttribute(types.AttributeKeyUnbondingAmount, unbondingAmount),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+		),
+	})
+	k.Logger(ctx).Info(types.EventTypeRefillInstantUnstakeBuffer,
+		types.AttributeKeyDelegator, types.LiquidStakingProxyAcc.String(),
+		types.AttributeKeyUnbondingAmount, unbondingAmount,
+		types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339))
+}
+
 func (k Keeper) UpdateLiquidValidatorSet(ctx sdk.Context) []types.Redelegation {
 	logger := k.Logger(ctx)
 	params := k.GetParams(ctx)
 	liquidValidators := k.GetAllLiquidValidators(ctx)
 	liquidValsMap := liquidValidators.Map()
-	whitelistedValsMap := types.GetWhitelistedValsMap(params.WhitelistedValidators)
+	whitelistedValsMap := k.GetEffectiveWhitelistedValsMap(ctx, params)
 
 	// Set Liquid validators for added whitelist validators
 	for _, wv := range params.WhitelistedValidators {
@@ -204,6 +309,14 @@ func (k Keeper) UpdateLiquidValidatorSet(ctx sdk.Context) []types.Redelegation {
 		}
 	}
 
+	// the preferred delegations of inactive liquid validators are no longer kept, since they are unbonded
+	// and re-staked according to the target weights
+	for _, lv := range liquidValidators {
+		if !k.IsActiveLiquidValidator(ctx, lv, whitelistedValsMap) {
+			k.DeletePreferredDelegation(ctx, lv.GetOperator())
+		}
+	}
+
 	// rebalancing based updated liquid validators status with threshold, try by cachedCtx
 	// tombstone status also handled on Rebalance
 	reds := k.Rebalance(ctx, types.LiquidStakingProxyAcc, liquidValidators, whitelistedValsMap, types.RebalancingTrigger)
@@ -250,5 +363,8 @@ func (k Keeper) UpdateLiquidValidatorSet(ctx sdk.Context) []types.Redelegation {
 
 	// withdraw rewards and re-staking when over threshold
 	k.WithdrawRewardsAndReStake(ctx, whitelistedValsMap)
+
+	// unbond liquid tokens to the proxy account when the instant unstake buffer is short
+	k.RefillInstantUnstakeBuffer(ctx)
 	return reds
 }
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
@@ -544,3 +545,212 @@ func (s *KeeperTestSuite) TestRemoveAllLiquidValidator() {
 	s.Require().EqualValues(nasAfter2.ProxyAccBalance, nasAfter.ProxyAccBalance.Add(nasBefore.TotalLiquidTokens))
 	s.Require().EqualValues(nasAfter2.NetAmount.TruncateInt(), nasBefore.NetAmount.TruncateInt())
 }
+
+func (s *KeeperTestSuite) TestWithdrawRewardsAndReStakingWithInstantUnstakeBuffer() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000, 1000000})
+	params := s.keeper.GetParams(s.ctx)
+
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(10)},
+	}
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+
+	stakingAmt := sdk.NewInt(100000000)
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], stakingAmt))
+
+	// allocate rewards
+	s.advanceHeight(100, false)
+	totalRewards, totalDelShares, _ := s.keeper.CheckDelegationStates(s.ctx, types.LiquidStakingProxyAcc)
+	s.Require().True(totalRewards.IsPositive())
+
+	// the buffer is larger than the rewards, so all rewards are kept in the proxy account
+	bufferSize := totalRewards.TruncateInt().Add(sdk.NewInt(1000000))
+	params.InstantUnstakeBufferSize = bufferSize
+	s.keeper.SetParams(s.ctx, params)
+	whitelistedValsMap := types.GetWhitelistedValsMap(params.WhitelistedValidators)
+
+	// rewards are withdrawn to refill the buffer, but not re-staked
+	s.keeper.WithdrawRewardsAndReStake(s.ctx, whitelistedValsMap)
+	rewardsAfter, delSharesAfter, _ := s.keeper.CheckDelegationStates(s.ctx, types.LiquidStakingProxyAcc)
+	s.Require().EqualValues(sdk.ZeroDec(), rewardsAfter)
+	s.Require().EqualValues(totalDelShares, delSharesAfter)
+	s.Require().EqualValues(totalRewards.TruncateInt(), s.keeper.GetProxyAccBalance(s.ctx, types.LiquidStakingProxyAcc).Amount)
+
+	// shrink the buffer so that the balance exceeds it
+	params.InstantUnstakeBufferSize = sdk.NewInt(1000)
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.WithdrawRewardsAndReStake(s.ctx, whitelistedValsMap)
+	rewardsAfter, delSharesAfter, _ = s.keeper.CheckDelegationStates(s.ctx, types.LiquidStakingProxyAcc)
+	s.Require().EqualValues(sdk.ZeroDec(), rewardsAfter)
+	s.Require().True(delSharesAfter.GT(totalDelShares))
+
+	// the buffer is kept in the proxy account
+	s.Require().EqualValues(sdk.NewInt(1000), s.keeper.GetProxyAccBalance(s.ctx, types.LiquidStakingProxyAcc).Amount)
+}
+
+func (s *KeeperTestSuite) TestRefillInstantUnstakeBuffer() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000, 1000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(10)},
+	}
+	bufferSize := sdk.NewInt(10000000)
+	params.InstantUnstakeBufferSize = bufferSize
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+
+	liquidStaker := s.delAddrs[0]
+	s.Require().NoError(s.liquidStaking(liquidStaker, sdk.NewInt(100000000)))
+
+	// fill the buffer and drain it by instant liquid unstaking
+	s.fundAddr(types.LiquidStakingProxyAcc, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, bufferSize)))
+	for s.keeper.GetProxyAccBalance(s.ctx, types.LiquidStakingProxyAcc).Amount.GTE(sdk.NewInt(1000000)) {
+		_, err := s.keeper.InstantLiquidUnstake(
+			s.ctx, types.LiquidStakingProxyAcc, liquidStaker, sdk.NewCoin(params.LiquidBondDenom, sdk.NewInt(900000)))
+		s.Require().NoError(err)
+	}
+	proxyAccBalance := s.keeper.GetProxyAccBalance(s.ctx, types.LiquidStakingProxyAcc).Amount
+	_, err := s.keeper.InstantLiquidUnstake(
+		s.ctx, types.LiquidStakingProxyAcc, liquidStaker, sdk.NewCoin(params.LiquidBondDenom, sdk.NewInt(1000000)))
+	s.Require().ErrorIs(err, types.ErrInsufficientInstantUnstakeBuffer)
+
+	// the shortfall is unbonded to the proxy account, which doesn't change NetAmount
+	nas := s.keeper.GetNetAmountState(s.ctx)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	nasAfter := s.keeper.GetNetAmountState(s.ctx)
+	s.Require().EqualValues(nas.NetAmount, nasAfter.NetAmount)
+	s.Require().True(nasAfter.TotalLiquidTokens.LT(nas.TotalLiquidTokens))
+	s.Require().True(nasAfter.TotalUnbondingBalance.LTE(bufferSize.Sub(proxyAccBalance)))
+	s.Require().True(nasAfter.TotalUnbondingBalance.GTE(bufferSize.Sub(proxyAccBalance).SubRaw(2)))
+	ubds := s.app.StakingKeeper.GetUnbondingDelegations(s.ctx, types.LiquidStakingProxyAcc, 100)
+	s.Require().Len(ubds, 2)
+
+	// no more unbonding while the previous one is on the way
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().EqualValues(nasAfter.TotalUnbondingBalance, s.keeper.GetNetAmountState(s.ctx).TotalUnbondingBalance)
+
+	// the buffer is refilled when the unbonding completes
+	s.completeRedelegationUnbonding()
+	refilled := s.keeper.GetProxyAccBalance(s.ctx, types.LiquidStakingProxyAcc).Amount
+	s.Require().EqualValues(proxyAccBalance.Add(nasAfter.TotalUnbondingBalance), refilled)
+	s.Require().True(refilled.GTE(bufferSize.SubRaw(2)))
+	_, err = s.keeper.InstantLiquidUnstake(
+		s.ctx, types.LiquidStakingProxyAcc, liquidStaker, sdk.NewCoin(params.LiquidBondDenom, sdk.NewInt(1000000)))
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestPerformanceWeighting() {
+	_, valOpers, pks := s.CreateValidators([]int64{1000000, 1000000, 1000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.MinLiquidStakingAmount = sdk.NewInt(10000)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[2].String(), TargetWeight: sdk.NewInt(10)},
+	}
+	params.WeightingMode = types.WeightingModePerformance
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+
+	// the second validator missed the half of the signed blocks window
+	consAddr := sdk.ConsAddress(pks[1].Address())
+	info := slashingtypes.NewValidatorSigningInfo(
+		consAddr, s.ctx.BlockHeight(), 0, time.Unix(0, 0), false, s.app.SlashingKeeper.SignedBlocksWindow(s.ctx)/2)
+	s.app.SlashingKeeper.SetValidatorSigningInfo(s.ctx, consAddr, info)
+
+	// the third validator charges 20% commission
+	val, found := s.app.StakingKeeper.GetValidator(s.ctx, valOpers[2])
+	s.Require().True(found)
+	val.Commission.Rate = sdk.NewDecWithPrec(2, 1)
+	s.app.StakingKeeper.SetValidator(s.ctx, val)
+
+	states := s.keeper.GetAllLiquidValidatorStates(s.ctx)
+	s.Require().Len(states, 3)
+	for i, expected := range []sdk.Int{sdk.NewInt(10000000), sdk.NewInt(5000000), sdk.NewInt(8000000)} {
+		s.Require().EqualValues(sdk.NewInt(10), states[i].Weight)
+		s.Require().EqualValues(expected, states[i].EffectiveWeight)
+	}
+
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], sdk.NewInt(230000)))
+	_, liquidTokenMap := s.keeper.GetAllLiquidValidators(s.ctx).TotalLiquidTokens(s.ctx, s.app.StakingKeeper, false)
+	s.Require().EqualValues(sdk.NewInt(100000), liquidTokenMap[valOpers[0].String()])
+	s.Require().EqualValues(sdk.NewInt(50000), liquidTokenMap[valOpers[1].String()])
+	s.Require().EqualValues(sdk.NewInt(80000), liquidTokenMap[valOpers[2].String()])
+
+	// the tokens are rebalanced by the target weights on the static weighting mode
+	params.WeightingMode = types.WeightingModeStatic
+	s.keeper.SetParams(s.ctx, params)
+	reds := s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().Len(reds, 2)
+	states = s.keeper.GetAllLiquidValidatorStates(s.ctx)
+	for i, expected := range []sdk.Int{sdk.NewInt(76668), sdk.NewInt(76666), sdk.NewInt(76666)} {
+		s.Require().EqualValues(states[i].Weight, states[i].EffectiveWeight)
+		s.Require().EqualValues(expected, states[i].LiquidTokens)
+	}
+
+	// a jailed validator is weighted zero on the performance weighting mode
+	params.WeightingMode = types.WeightingModePerformance
+	s.keeper.SetParams(s.ctx, params)
+	s.app.StakingKeeper.Jail(s.ctx, consAddr)
+	state, found := s.keeper.GetLiquidValidatorState(s.ctx, valOpers[1])
+	s.Require().True(found)
+	s.Require().Equal(types.ValidatorStatusActive, state.Status)
+	s.Require().True(state.EffectiveWeight.IsZero())
+}
+
+func (s *KeeperTestSuite) TestPerformanceScoreUpdate() {
+	_, valOpers, pks := s.CreateValidators([]int64{1000000, 1000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(10)},
+	}
+	params.WeightingMode = types.WeightingModePerformance
+	params.PerformanceUpdateInterval = 100
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+
+	// the scores are stored right away for the newly whitelisted validators
+	s.ctx = s.ctx.WithBlockHeight(150)
+	s.keeper.UpdatePerformanceScores(s.ctx)
+	score, found := s.keeper.GetPerformanceScore(s.ctx, valOpers[1])
+	s.Require().True(found)
+	s.Require().EqualValues(sdk.OneDec(), score)
+
+	// the second validator missed the half of the signed blocks window
+	consAddr := sdk.ConsAddress(pks[1].Address())
+	info := slashingtypes.NewValidatorSigningInfo(
+		consAddr, s.ctx.BlockHeight(), 0, time.Unix(0, 0), false, s.app.SlashingKeeper.SignedBlocksWindow(s.ctx)/2)
+	s.app.SlashingKeeper.SetValidatorSigningInfo(s.ctx, consAddr, info)
+
+	// the effective weight doesn't change until the next update
+	s.ctx = s.ctx.WithBlockHeight(199)
+	s.keeper.UpdatePerformanceScores(s.ctx)
+	state, found := s.keeper.GetLiquidValidatorState(s.ctx, valOpers[1])
+	s.Require().True(found)
+	s.Require().EqualValues(sdk.NewInt(10000000), state.EffectiveWeight)
+
+	s.ctx = s.ctx.WithBlockHeight(200)
+	s.keeper.UpdatePerformanceScores(s.ctx)
+	state, _ = s.keeper.GetLiquidValidatorState(s.ctx, valOpers[1])
+	s.Require().EqualValues(sdk.NewInt(5000000), state.EffectiveWeight)
+
+	// the scores of the validators no longer whitelisted are deleted
+	params.WhitelistedValidators = params.WhitelistedValidators[:1]
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdatePerformanceScores(s.ctx)
+	_, found = s.keeper.GetPerformanceScore(s.ctx, valOpers[0])
+	s.Require().True(found)
+	_, found = s.keeper.GetPerformanceScore(s.ctx, valOpers[1])
+	s.Require().False(found)
+
+	// all the scores are deleted on the static weighting mode
+	params.WeightingMode = types.WeightingModeStatic
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdatePerformanceScores(s.ctx)
+	_, found = s.keeper.GetPerformanceScore(s.ctx, valOpers[0])
+	s.Require().False(found)
+}
@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmosquad-labs/squad/v3/x/liquidstaking/types"
+)
+
+// GetNetAmountSnapshot returns the net amount snapshot at the height.
+func (k Keeper) GetNetAmountSnapshot(ctx sdk.Context, height int64) (snapshot types.NetAmountSnapshot, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetNetAmountSnapshotKey(height))
+	if bz == nil {
+		return snapshot, false
+	}
+	k.cdc.MustUnmarshal(bz, &snapshot)
+	return snapshot, true
+}
+
+// SetNetAmountSnapshot stores the net amount snapshot.
+func (k Keeper) SetNetAmountSnapshot(ctx sdk.Context, snapshot types.NetAmountSnapshot) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&snapshot)
+	store.Set(types.GetNetAmountSnapshotKey(snapshot.Height), bz)
+}
+
+// DeleteNetAmountSnapshot deletes the net amount snapshot at the height.
+func (k Keeper) DeleteNetAmountSnapshot(ctx sdk.Context, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetNetAmountSnapshotKey(height))
+}
+
+// GetAllNetAmountSnapshots returns all net amount snapshots in ascending order of height.
+func (k Keeper) GetAllNetAmountSnapshots(ctx sdk.Context) []types.NetAmountSnapshot {
+	return k.GetNetAmountSnapshotsByRange(ctx, 0, 0)
+}
+
+// GetNetAmountSnapshotsByRange returns the net amount snapshots whose heights are within
+// [startHeight, endHeight] in ascending order of height. endHeight of zero means no upper bound.
+func (k Keeper) GetNetAmountSnapshotsByRange(ctx sdk.Context, startHeight, endHeight int64) (snapshots []types.NetAmountSnapshot) {
+	store := ctx.KVStore(k.storeKey)
+	end := sdk.PrefixEndBytes(types.NetAmountSnapshotsKey)
+	if endHeight > 0 {
+		end = types.GetNetAmountSnapshotKey(endHeight + 1)
+	}
+	iterator := store.Iterator(types.GetNetAmountSnapshotKey(startHeight), end)
+	defer iterator.Close()
+
+	snapshots = []types.NetAmountSnapshot{}
+	for ; iterator.Valid(); iterator.Next() {
+		var snapshot types.NetAmountSnapshot
+		k.cdc.MustUnmarshal(iterator.Value(), &snapshot)
+		snapshots = append(snapshots, snapshot)
+	}
+	return snapshots
+}
+
+// GetLastNetAmountSnapshot returns the latest net amount snapshot.
+func (k Keeper) GetLastNetAmountSnapshot(ctx sdk.Context) (snapshot types.NetAmountSnapshot, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStoreReversePrefixIterator(store, types.NetAmountSnapshotsKey)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return snapshot, false
+	}
+	k.cdc.MustUnmarshal(iterator.Value(), &snapshot)
+	return snapshot, true
+}
+
+// TakeNetAmountSnapshot records the net amount state every NetAmountSnapshotInterval blocks,
+// emits an event if the bToken value has dropped since the last snapshot and prunes the
+// snapshots exceeding MaxNetAmountSnapshots.
+func (k Keeper) TakeNetAmountSnapshot(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if params.NetAmountSnapshotInterval == 0 || uint64(ctx.BlockHeight())%params.NetAmountSnapshotInterval != 0 {
+		return
+	}
+
+	snapshot := types.NewNetAmountSnapshot(ctx, k.GetNetAmountState(ctx))
+	if prev, found := k.GetLastNetAmountSnapshot(ctx); found && snapshot.IsBTokenValueDropped(prev) {
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeBTokenValueDrop,
+				sdk.NewAttribute(types.AttributeKeyHeight, sdk.NewInt(snapshot.Height).String()),
+				sdk.NewAttribute(types.AttributeKeyPreviousMintRate, prev.MintRate.String()),
+				sdk.NewAttribute(types.AttributeKeyMintRate, snapshot.MintRate.String()),
+			),
+		})
+	}
+	k.SetNetAmountSnapshot(ctx, snapshot)
+	k.pruneNetAmountSnapshots(ctx, params.MaxNetAmountSnapshots)
+}
+
+// pruneNetAmountSnapshots deletes the oldest net amount snapshots exceeding maxSnapshots.
+// It iterates the keys from the latest one, so only the kept snapshots and the ones to be
+// deleted are visited, and the values are never unmarshaled.
+func (k Keeper) pruneNetAmountSnapshots(ctx sdk.Context, maxSnapshots uint32) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStoreReversePrefixIterator(store, types.NetAmountSnapshotsKey)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for n := uint32(0); iterator.Valid(); iterator.Next() {
+		if n < maxSnapshots {
+			n++
+			continue
+		}
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
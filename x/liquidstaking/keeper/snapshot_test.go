@@ -0,0 +1,99 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmosquad-labs/squad/v3/x/liquidstaking/types"
+)
+
+func (s *KeeperTestSuite) TestTakeNetAmountSnapshot() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000, 1000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.MinLiquidStakingAmount = sdk.NewInt(10000)
+	params.NetAmountSnapshotInterval = 10
+	params.MaxNetAmountSnapshots = 2
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[2].String(), TargetWeight: sdk.NewInt(10)},
+	}
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], sdk.NewInt(300000)))
+
+	// not on the snapshot interval
+	s.ctx = s.ctx.WithBlockHeight(115)
+	s.keeper.TakeNetAmountSnapshot(s.ctx)
+	s.Require().Empty(s.keeper.GetAllNetAmountSnapshots(s.ctx))
+
+	s.ctx = s.ctx.WithBlockHeight(120)
+	s.keeper.TakeNetAmountSnapshot(s.ctx)
+	snapshot, found := s.keeper.GetLastNetAmountSnapshot(s.ctx)
+	s.Require().True(found)
+	nas := s.keeper.GetNetAmountState(s.ctx)
+	s.Require().EqualValues(120, snapshot.Height)
+	s.Require().Equal(nas.MintRate, snapshot.MintRate)
+	s.Require().Equal(nas.NetAmount, snapshot.NetAmount)
+	s.Require().Equal(nas.TotalDelShares, snapshot.TotalDelShares)
+	s.Require().Equal(nas.TotalRemainingRewards, snapshot.TotalRemainingRewards)
+
+	// slashing the liquid validator drops the bToken value
+	val := s.app.StakingKeeper.Validator(s.ctx, valOpers[0])
+	consAddr, err := val.GetConsAddr()
+	s.Require().NoError(err)
+	s.app.StakingKeeper.Slash(s.ctx, consAddr, 120, val.GetConsensusPower(sdk.DefaultPowerReduction), sdk.NewDecWithPrec(1, 1))
+
+	s.ctx = s.ctx.WithBlockHeight(130).WithEventManager(sdk.NewEventManager())
+	s.keeper.TakeNetAmountSnapshot(s.ctx)
+	snapshot2, found := s.keeper.GetLastNetAmountSnapshot(s.ctx)
+	s.Require().True(found)
+	s.Require().EqualValues(130, snapshot2.Height)
+	s.Require().True(snapshot2.MintRate.GT(snapshot.MintRate))
+	s.Require().True(snapshot2.IsBTokenValueDropped(snapshot))
+
+	dropped := false
+	for _, event := range s.ctx.EventManager().Events() {
+		if event.Type == types.EventTypeBTokenValueDrop {
+			dropped = true
+		}
+	}
+	s.Require().True(dropped)
+
+	// the oldest snapshot is pruned
+	s.ctx = s.ctx.WithBlockHeight(140).WithEventManager(sdk.NewEventManager())
+	s.keeper.TakeNetAmountSnapshot(s.ctx)
+	snapshots := s.keeper.GetAllNetAmountSnapshots(s.ctx)
+	s.Require().Len(snapshots, 2)
+	s.Require().EqualValues(130, snapshots[0].Height)
+	s.Require().EqualValues(140, snapshots[1].Height)
+	for _, event := range s.ctx.EventManager().Events() {
+		s.Require().NotEqual(types.EventTypeBTokenValueDrop, event.Type)
+	}
+
+	s.Require().Len(s.keeper.GetNetAmountSnapshotsByRange(s.ctx, 131, 0), 1)
+	s.Require().Len(s.keeper.GetNetAmountSnapshotsByRange(s.ctx, 0, 139), 1)
+	s.Require().Len(s.keeper.GetNetAmountSnapshotsByRange(s.ctx, 130, 140), 2)
+}
+
+func (s *KeeperTestSuite) TestTakeNetAmountSnapshot_MaxSnapshotsDecreased() {
+	params := s.keeper.GetParams(s.ctx)
+	params.NetAmountSnapshotInterval = 10
+	params.MaxNetAmountSnapshots = 5
+	s.keeper.SetParams(s.ctx, params)
+
+	for height := int64(10); height <= 50; height += 10 {
+		s.ctx = s.ctx.WithBlockHeight(height)
+		s.keeper.TakeNetAmountSnapshot(s.ctx)
+	}
+	s.Require().Len(s.keeper.GetAllNetAmountSnapshots(s.ctx), 5)
+
+	// all the oldest snapshots exceeding the decreased max are pruned at once
+	params.MaxNetAmountSnapshots = 2
+	s.keeper.SetParams(s.ctx, params)
+	s.ctx = s.ctx.WithBlockHeight(60)
+	s.keeper.TakeNetAmountSnapshot(s.ctx)
+	snapshots := s.keeper.GetAllNetAmountSnapshots(s.ctx)
+	s.Require().Len(snapshots, 2)
+	s.Require().EqualValues(50, snapshots[0].Height)
+	s.Require().EqualValues(60, snapshots[1].Height)
+}
@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/gogo/protobuf/types"
+
+	"github.com/cosmosquad-labs/squad/v3/x/liquidstaking/types"
+)
+
+// GetLastUnstakeTicketId returns the last unstake ticket id.
+func (k Keeper) GetLastUnstakeTicketId(ctx sdk.Context) (id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastUnstakeTicketIdKey)
+	if bz == nil {
+		id = 0 // initialize the unstake ticket id
+	} else {
+		var val gogotypes.UInt64Value
+		k.cdc.MustUnmarshal(bz, &val)
+		id = val.GetValue()
+	}
+	return
+}
+
+// SetLastUnstakeTicketId stores the last unstake ticket id.
+func (k Keeper) SetLastUnstakeTicketId(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: id})
+	store.Set(types.LastUnstakeTicketIdKey, bz)
+}
+
+// getNextUnstakeTicketIdWithUpdate increments the last unstake ticket id and returns it.
+func (k Keeper) getNextUnstakeTicketIdWithUpdate(ctx sdk.Context) uint64 {
+	id := k.GetLastUnstakeTicketId(ctx) + 1
+	k.SetLastUnstakeTicketId(ctx, id)
+	return id
+}
+
+// GetUnstakeTicket returns the unstake ticket with the id.
+func (k Keeper) GetUnstakeTicket(ctx sdk.Context, id uint64) (ticket types.UnstakeTicket, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetUnstakeTicketKey(id))
+	if bz == nil {
+		return ticket, false
+	}
+	k.cdc.MustUnmarshal(bz, &ticket)
+	return ticket, true
+}
+
+// SetUnstakeTicket stores the unstake ticket with its index and queue keys.
+func (k Keeper) SetUnstakeTicket(ctx sdk.Context, ticket types.UnstakeTicket) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&ticket)
+	store.Set(types.GetUnstakeTicketKey(ticket.Id), bz)
+	store.Set(types.GetUnstakeTicketIndexKey(ticket.GetDelegator(), ticket.Id), []byte{})
+	store.Set(types.GetUnstakeTicketQueueKey(ticket.CompletionTime, ticket.Id), []byte{})
+}
+
+// DeleteUnstakeTicket deletes the unstake ticket with its index and queue keys.
+func (k Keeper) DeleteUnstakeTicket(ctx sdk.Context, ticket types.UnstakeTicket) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetUnstakeTicketKey(ticket.Id))
+	store.Delete(types.GetUnstakeTicketIndexKey(ticket.GetDelegator(), ticket.Id))
+	store.Delete(types.GetUnstakeTicketQueueKey(ticket.CompletionTime, ticket.Id))
+}
+
+// GetAllUnstakeTickets returns all unstake tickets.
+func (k Keeper) GetAllUnstakeTickets(ctx sdk.Context) (tickets []types.UnstakeTicket) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.UnstakeTicketsKey)
+	defer iterator.Close()
+
+	tickets = []types.UnstakeTicket{}
+	for ; iterator.Valid(); iterator.Next() {
+		var ticket types.UnstakeTicket
+		k.cdc.MustUnmarshal(iterator.Value(), &ticket)
+		tickets = append(tickets, ticket)
+	}
+	return tickets
+}
+
+// GetUnstakeTicketsByStaker returns the unstake tickets of the liquid staker.
+func (k Keeper) GetUnstakeTicketsByStaker(ctx sdk.Context, liquidStaker sdk.AccAddress) (tickets []types.UnstakeTicket) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetUnstakeTicketIndexKeyPrefix(liquidStaker))
+	defer iterator.Close()
+
+	tickets = []types.UnstakeTicket{}
+	for ; iterator.Valid(); iterator.Next() {
+		ticket, _ := k.GetUnstakeTicket(ctx, types.ParseUnstakeTicketIndexKey(iterator.Key()))
+		tickets = append(tickets, ticket)
+	}
+	return tickets
+}
+
+// createUnstakeTicket records a new unstake ticket for the liquid unstaking and returns it.
+func (k Keeper) createUnstakeTicket(
+	ctx sdk.Context, liquidStaker sdk.AccAddress, bTokenBurned, expectedAmt sdk.Coin, completionTime time.Time) types.UnstakeTicket {
+	ticket := types.NewUnstakeTicket(k.getNextUnstakeTicketIdWithUpdate(ctx), liquidStaker, bTokenBurned, expectedAmt, completionTime)
+	k.SetUnstakeTicket(ctx, ticket)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCreateUnstakeTicket,
+			sdk.NewAttribute(types.AttributeKeyTicketId, strconv.FormatUint(ticket.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyDelegator, ticket.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyBTokenBurnedAmount, ticket.BtokenBurned.String()),
+			sdk.NewAttribute(types.AttributeKeyExpectedAmount, ticket.ExpectedAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, ticket.CompletionTime.Format(time.RFC3339)),
+		),
+	})
+	return ticket
+}
+
+// MatureUnstakeTickets deletes the unstake tickets whose completion time has passed
+// and emits an event for each of them.
+func (k Keeper) MatureUnstakeTickets(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.UnstakeTicketQueueKey,
+		sdk.PrefixEndBytes(types.GetUnstakeTicketQueueTimeKeyPrefix(ctx.BlockTime())))
+	defer iterator.Close()
+
+	var tickets []types.UnstakeTicket
+	for ; iterator.Valid(); iterator.Next() {
+		ticket, found := k.GetUnstakeTicket(ctx, types.ParseUnstakeTicketQueueKey(iterator.Key()))
+		if found {
+			tickets = append(tickets, ticket)
+		}
+	}
+
+	for _, ticket := range tickets {
+		k.DeleteUnstakeTicket(ctx, ticket)
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeUnstakeTicketMatured,
+				sdk.NewAttribute(types.AttributeKeyTicketId, strconv.FormatUint(ticket.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyDelegator, ticket.DelegatorAddress),
+				sdk.NewAttribute(types.AttributeKeyExpectedAmount, ticket.ExpectedAmount.String()),
+				sdk.NewAttribute(types.AttributeKeyCompletionTime, ticket.CompletionTime.Format(time.RFC3339)),
+			),
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmosquad-labs/squad/v3/x/liquidstaking/types"
+)
+
+func (s *KeeperTestSuite) TestUnstakeTicket() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000, 1000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.MinLiquidStakingAmount = sdk.NewInt(10000)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[2].String(), TargetWeight: sdk.NewInt(10)},
+	}
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], sdk.NewInt(300000)))
+	s.Require().NoError(s.liquidStaking(s.delAddrs[1], sdk.NewInt(300000)))
+	s.Require().Empty(s.keeper.GetAllUnstakeTickets(s.ctx))
+
+	unstakingBToken := sdk.NewCoin(params.LiquidBondDenom, sdk.NewInt(30000))
+	ubdTime, unbondingAmt, _, _, err := s.keeper.LiquidUnstake(s.ctx, types.LiquidStakingProxyAcc, s.delAddrs[0], unstakingBToken)
+	s.Require().NoError(err)
+
+	ticket, found := s.keeper.GetUnstakeTicket(s.ctx, 1)
+	s.Require().True(found)
+	s.Require().Equal(s.delAddrs[0].String(), ticket.DelegatorAddress)
+	s.Require().Equal(unstakingBToken, ticket.BtokenBurned)
+	s.Require().Equal(sdk.NewCoin(sdk.DefaultBondDenom, unbondingAmt), ticket.ExpectedAmount)
+	s.Require().Equal(ubdTime, ticket.CompletionTime)
+	s.Require().Equal([]types.UnstakeTicket{ticket}, s.keeper.GetUnstakeTicketsByStaker(s.ctx, s.delAddrs[0]))
+	s.Require().Empty(s.keeper.GetUnstakeTicketsByStaker(s.ctx, s.delAddrs[1]))
+
+	// a later unstaking gets the next ticket id and completes later
+	s.ctx = s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1).WithBlockTime(s.ctx.BlockTime().Add(1))
+	_, _, _, _, err = s.keeper.LiquidUnstake(s.ctx, types.LiquidStakingProxyAcc, s.delAddrs[1], unstakingBToken)
+	s.Require().NoError(err)
+	ticket2, found := s.keeper.GetUnstakeTicket(s.ctx, 2)
+	s.Require().True(found)
+	s.Require().Equal(s.delAddrs[1].String(), ticket2.DelegatorAddress)
+	s.Require().True(ticket2.CompletionTime.After(ticket.CompletionTime))
+	s.Require().EqualValues(2, s.keeper.GetLastUnstakeTicketId(s.ctx))
+	s.Require().Len(s.keeper.GetAllUnstakeTickets(s.ctx), 2)
+
+	// not matured yet
+	s.keeper.MatureUnstakeTickets(s.ctx)
+	s.Require().Len(s.keeper.GetAllUnstakeTickets(s.ctx), 2)
+
+	// only the first ticket is matured
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager()).WithBlockTime(ticket.CompletionTime)
+	s.keeper.MatureUnstakeTickets(s.ctx)
+	_, found = s.keeper.GetUnstakeTicket(s.ctx, 1)
+	s.Require().False(found)
+	s.Require().Empty(s.keeper.GetUnstakeTicketsByStaker(s.ctx, s.delAddrs[0]))
+	s.Require().Equal([]types.UnstakeTicket{ticket2}, s.keeper.GetAllUnstakeTickets(s.ctx))
+
+	matured := 0
+	for _, event := range s.ctx.EventManager().Events() {
+		if event.Type == types.EventTypeUnstakeTicketMatured {
+			matured++
+		}
+	}
+	s.Require().Equal(1, matured)
+
+	s.ctx = s.ctx.WithBlockTime(ticket2.CompletionTime.Add(1))
+	s.keeper.MatureUnstakeTickets(s.ctx)
+	s.Require().Empty(s.keeper.GetAllUnstakeTickets(s.ctx))
+}
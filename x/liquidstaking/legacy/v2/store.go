@@ -0,0 +1,23 @@
+package v2
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/cosmosquad-labs/squad/v3/x/liquidstaking/types"
+)
+
+// MigrateParams sets the params added in v2 to their default values.
+func MigrateParams(ctx sdk.Context, paramSpace paramstypes.Subspace) {
+	paramSpace.Set(ctx, types.KeyInstantUnstakeBufferSize, types.DefaultInstantUnstakeBufferSize)
+	paramSpace.Set(ctx, types.KeyInstantUnstakeFeeRate, types.DefaultInstantUnstakeFeeRate)
+	paramSpace.Set(ctx, types.KeyWeightingMode, types.DefaultWeightingMode)
+	paramSpace.Set(ctx, types.KeyPerformanceUpdateInterval, types.DefaultPerformanceUpdateInterval)
+	paramSpace.Set(ctx, types.KeyNetAmountSnapshotInterval, types.DefaultNetAmountSnapshotInterval)
+	paramSpace.Set(ctx, types.KeyMaxNetAmountSnapshots, types.DefaultMaxNetAmountSnapshots)
+}
+
+func MigrateStore(ctx sdk.Context, paramSpace paramstypes.Subspace) error {
+	MigrateParams(ctx, paramSpace)
+	return nil
+}
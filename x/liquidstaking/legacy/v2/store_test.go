@@ -0,0 +1,57 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	chain "github.com/cosmosquad-labs/squad/v3/app"
+	v2liquidstaking "github.com/cosmosquad-labs/squad/v3/x/liquidstaking/legacy/v2"
+	"github.com/cosmosquad-labs/squad/v3/x/liquidstaking/types"
+)
+
+func TestMigrateParams(t *testing.T) {
+	encCfg := chain.MakeTestEncodingConfig()
+	paramsKey := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+
+	cms := store.NewCommitMultiStore(dbm.NewMemDB())
+	cms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, nil)
+	cms.MountStoreWithDB(paramsTKey, sdk.StoreTypeTransient, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+	ctx := sdk.NewContext(cms, tmproto.Header{}, false, log.NewNopLogger())
+
+	paramSpace := paramstypes.NewSubspace(
+		encCfg.Marshaler, encCfg.Amino, paramsKey, paramsTKey, types.ModuleName).WithKeyTable(types.ParamKeyTable())
+
+	// The params set before the migration are kept.
+	paramSpace.Set(ctx, types.KeyUnstakeFeeRate, sdk.NewDecWithPrec(1, 2))
+
+	require.NoError(t, v2liquidstaking.MigrateStore(ctx, paramSpace))
+
+	var unstakeFeeRate, instantUnstakeFeeRate sdk.Dec
+	var instantUnstakeBufferSize sdk.Int
+	var weightingMode types.WeightingMode
+	var performanceUpdateInterval, netAmountSnapshotInterval uint64
+	var maxNetAmountSnapshots uint32
+	paramSpace.Get(ctx, types.KeyUnstakeFeeRate, &unstakeFeeRate)
+	paramSpace.Get(ctx, types.KeyInstantUnstakeBufferSize, &instantUnstakeBufferSize)
+	paramSpace.Get(ctx, types.KeyInstantUnstakeFeeRate, &instantUnstakeFeeRate)
+	paramSpace.Get(ctx, types.KeyWeightingMode, &weightingMode)
+	paramSpace.Get(ctx, types.KeyPerformanceUpdateInterval, &performanceUpdateInterval)
+	paramSpace.Get(ctx, types.KeyNetAmountSnapshotInterval, &netAmountSnapshotInterval)
+	paramSpace.Get(ctx, types.KeyMaxNetAmountSnapshots, &maxNetAmountSnapshots)
+	require.Equal(t, sdk.NewDecWithPrec(1, 2), unstakeFeeRate)
+	require.Equal(t, types.DefaultInstantUnstakeBufferSize, instantUnstakeBufferSize)
+	require.Equal(t, types.DefaultInstantUnstakeFeeRate, instantUnstakeFeeRate)
+	require.Equal(t, types.DefaultWeightingMode, weightingMode)
+	require.Equal(t, types.DefaultPerformanceUpdateInterval, performanceUpdateInterval)
+	require.Equal(t, types.DefaultNetAmountSnapshotInterval, netAmountSnapshotInterval)
+	require.Equal(t, types.DefaultMaxNetAmountSnapshots, maxNetAmountSnapshots)
+}
@@ -21,6 +21,30 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvA.Value, &cB)
 			return fmt.Sprintf("%v\n%v", cA, cB)
 
+		case bytes.Equal(kvA.Key[:1], types.ValidatorPreferencesKey):
+			var cA, cB types.ValidatorPreference
+			cdc.MustUnmarshal(kvA.Value, &cA)
+			cdc.MustUnmarshal(kvB.Value, &cB)
+			return fmt.Sprintf("%v\n%v", cA, cB)
+
+		case bytes.Equal(kvA.Key[:1], types.UnstakeTicketsKey):
+			var cA, cB types.UnstakeTicket
+			cdc.MustUnmarshal(kvA.Value, &cA)
+			cdc.MustUnmarshal(kvB.Value, &cB)
+			return fmt.Sprintf("%v\n%v", cA, cB)
+
+		case bytes.Equal(kvA.Key[:1], types.NetAmountSnapshotsKey):
+			var cA, cB types.NetAmountSnapshot
+			cdc.MustUnmarshal(kvA.Value, &cA)
+			cdc.MustUnmarshal(kvB.Value, &cB)
+			return fmt.Sprintf("%v\n%v", cA, cB)
+
+		case bytes.Equal(kvA.Key[:1], types.PreferredDelegationsKey):
+			var cA, cB types.PreferredDelegation
+			cdc.MustUnmarshal(kvA.Value, &cA)
+			cdc.MustUnmarshal(kvB.Value, &cB)
+			return fmt.Sprintf("%v\n%v", cA, cB)
+
 		default:
 			panic(fmt.Sprintf("invalid liquidstaking key prefix %X", kvA.Key[:1]))
 		}
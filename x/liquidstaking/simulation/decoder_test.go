@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 
 	"github.com/cosmosquad-labs/squad/v3/x/liquidstaking/simulation"
@@ -22,9 +23,38 @@ func TestDecodeLiquidStakingStore(t *testing.T) {
 		OperatorAddress: "cosmosvaloper13w4ueuk80d3kmwk7ntlhp84fk0arlm3m9ammr5",
 	}
 
+	pref := types.ValidatorPreference{
+		DelegatorAddress: "cosmos1zaavvzxez0elundtn32qnk9lkm8kmcszzsv80v",
+		Validators: []types.WhitelistedValidator{
+			{ValidatorAddress: tc.OperatorAddress, TargetWeight: sdk.NewInt(10)},
+		},
+	}
+	pd := types.PreferredDelegation{
+		ValidatorAddress: tc.OperatorAddress,
+		Amount:           sdk.NewInt(1000000),
+	}
+	ticket := types.UnstakeTicket{
+		Id:               1,
+		DelegatorAddress: pref.DelegatorAddress,
+		BtokenBurned:     sdk.NewInt64Coin("bstake", 1000000),
+		ExpectedAmount:   sdk.NewInt64Coin("stake", 1000000),
+	}
+	snapshot := types.NetAmountSnapshot{
+		Height:                100,
+		MintRate:              sdk.OneDec(),
+		BtokenTotalSupply:     sdk.NewInt(1000000),
+		NetAmount:             sdk.NewDec(1000000),
+		TotalDelShares:        sdk.NewDec(1000000),
+		TotalRemainingRewards: sdk.ZeroDec(),
+	}
+
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{Key: types.LiquidValidatorsKey, Value: cdc.Marshaler.MustMarshal(&tc)},
+			{Key: types.ValidatorPreferencesKey, Value: cdc.Marshaler.MustMarshal(&pref)},
+			{Key: types.PreferredDelegationsKey, Value: cdc.Marshaler.MustMarshal(&pd)},
+			{Key: types.UnstakeTicketsKey, Value: cdc.Marshaler.MustMarshal(&ticket)},
+			{Key: types.NetAmountSnapshotsKey, Value: cdc.Marshaler.MustMarshal(&snapshot)},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -34,6 +64,10 @@ func TestDecodeLiquidStakingStore(t *testing.T) {
 		expectedLog string
 	}{
 		{"LiquidValidator", fmt.Sprintf("%v\n%v", tc, tc)},
+		{"ValidatorPreference", fmt.Sprintf("%v\n%v", pref, pref)},
+		{"PreferredDelegation", fmt.Sprintf("%v\n%v", pd, pd)},
+		{"UnstakeTicket", fmt.Sprintf("%v\n%v", ticket, ticket)},
+		{"NetAmountSnapshot", fmt.Sprintf("%v\n%v", snapshot, snapshot)},
 		{"other", ""},
 	}
 	for i, tt := range tests {
@@ -12,6 +12,8 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgLiquidStake{}, "liquidstaking/MsgLiquidStake", nil)
 	cdc.RegisterConcrete(&MsgLiquidUnstake{}, "liquidstaking/MsgLiquidUnstake", nil)
+	cdc.RegisterConcrete(&MsgInstantLiquidUnstake{}, "liquidstaking/MsgInstantLiquidUnstake", nil)
+	cdc.RegisterConcrete(&MsgSetValidatorPreference{}, "liquidstaking/MsgSetValidatorPreference", nil)
 }
 
 // RegisterInterfaces registers the x/liquidstaking interfaces types with the interface registry.
@@ -20,6 +22,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgLiquidStake{},
 		&MsgLiquidUnstake{},
+		&MsgInstantLiquidUnstake{},
+		&MsgSetValidatorPreference{},
 	)
 }
 
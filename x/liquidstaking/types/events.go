@@ -4,11 +4,17 @@ package types
 const (
 	EventTypeMsgLiquidStake             = TypeMsgLiquidStake
 	EventTypeMsgLiquidUnstake           = TypeMsgLiquidUnstake
+	EventTypeMsgInstantLiquidUnstake    = TypeMsgInstantLiquidUnstake
+	EventTypeMsgSetValidatorPreference  = TypeMsgSetValidatorPreference
 	EventTypeAddLiquidValidator         = "add_liquid_validator"
 	EventTypeRemoveLiquidValidator      = "remove_liquid_validator"
 	EventTypeBeginRebalancing           = "begin_rebalancing"
 	EventTypeReStake                    = "re_stake"
 	EventTypeUnbondInactiveLiquidTokens = "unbond_inactive_liquid_tokens"
+	EventTypeCreateUnstakeTicket        = "create_unstake_ticket"
+	EventTypeUnstakeTicketMatured       = "unstake_ticket_matured"
+	EventTypeBTokenValueDrop            = "btoken_value_drop"
+	EventTypeRefillInstantUnstakeBuffer = "refill_instant_unstake_buffer"
 
 	AttributeKeyDelegator             = "delegator"
 	AttributeKeyNewShares             = "new_shares"
@@ -16,9 +22,17 @@ const (
 	AttributeKeyCompletionTime        = "completion_time"
 	AttributeKeyUnbondingAmount       = "unbonding_amount"
 	AttributeKeyUnbondedAmount        = "unbonded_amount"
+	AttributeKeyUnstakedAmount        = "unstaked_amount"
 	AttributeKeyLiquidValidator       = "liquid_validator"
 	AttributeKeyRedelegationCount     = "redelegation_count"
 	AttributeKeyRedelegationFailCount = "redelegation_fail_count"
+	AttributeKeyPreferredValidators   = "preferred_validators"
+	AttributeKeyTicketId              = "ticket_id"
+	AttributeKeyBTokenBurnedAmount    = "btoken_burned_amount"
+	AttributeKeyExpectedAmount        = "expected_amount"
+	AttributeKeyHeight                = "height"
+	AttributeKeyMintRate              = "mint_rate"
+	AttributeKeyPreviousMintRate      = "previous_mint_rate"
 
 	AttributeValueCategory = ModuleName
 )
@@ -1,12 +1,24 @@
 package types
 
-import sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+import (
+	"fmt"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
 
 // NewGenesisState returns new GenesisState instance.
-func NewGenesisState(params Params, liquidValidators []LiquidValidator) *GenesisState {
+func NewGenesisState(
+	params Params, liquidValidators []LiquidValidator,
+	validatorPreferences []ValidatorPreference, preferredDelegations []PreferredDelegation,
+	lastUnstakeTicketId uint64, unstakeTickets []UnstakeTicket, netAmountSnapshots []NetAmountSnapshot) *GenesisState {
 	return &GenesisState{
-		Params:           params,
-		LiquidValidators: liquidValidators,
+		Params:               params,
+		LiquidValidators:     liquidValidators,
+		ValidatorPreferences: validatorPreferences,
+		PreferredDelegations: preferredDelegations,
+		LastUnstakeTicketId:  lastUnstakeTicketId,
+		UnstakeTickets:       unstakeTickets,
+		NetAmountSnapshots:   netAmountSnapshots,
 	}
 }
 
@@ -15,6 +27,11 @@ func DefaultGenesisState() *GenesisState {
 	return NewGenesisState(
 		DefaultParams(),
 		[]LiquidValidator{},
+		[]ValidatorPreference{},
+		[]PreferredDelegation{},
+		0,
+		[]UnstakeTicket{},
+		[]NetAmountSnapshot{},
 	)
 }
 
@@ -30,5 +47,52 @@ func ValidateGenesis(data GenesisState) error {
 				"invalid liquid validator %s: %v", lv, err)
 		}
 	}
+	delegatorSet := map[string]struct{}{}
+	for _, pref := range data.ValidatorPreferences {
+		if err := pref.Validate(); err != nil {
+			return fmt.Errorf("invalid validator preference of %s: %w", pref.DelegatorAddress, err)
+		}
+		if _, ok := delegatorSet[pref.DelegatorAddress]; ok {
+			return fmt.Errorf("duplicate validator preference of %s", pref.DelegatorAddress)
+		}
+		delegatorSet[pref.DelegatorAddress] = struct{}{}
+	}
+	validatorSet := map[string]struct{}{}
+	for _, pd := range data.PreferredDelegations {
+		if err := pd.Validate(); err != nil {
+			return fmt.Errorf("invalid preferred delegation: %w", err)
+		}
+		if _, ok := validatorSet[pd.ValidatorAddress]; ok {
+			return fmt.Errorf("duplicate preferred delegation of %s", pd.ValidatorAddress)
+		}
+		validatorSet[pd.ValidatorAddress] = struct{}{}
+	}
+	ticketIdSet := map[uint64]struct{}{}
+	for _, ticket := range data.UnstakeTickets {
+		if err := ticket.Validate(); err != nil {
+			return fmt.Errorf("invalid unstake ticket: %w", err)
+		}
+		if ticket.Id > data.LastUnstakeTicketId {
+			return fmt.Errorf("unstake ticket id %d is greater than the last unstake ticket id %d", ticket.Id, data.LastUnstakeTicketId)
+		}
+		if _, ok := ticketIdSet[ticket.Id]; ok {
+			return fmt.Errorf("duplicate unstake ticket id %d", ticket.Id)
+		}
+		ticketIdSet[ticket.Id] = struct{}{}
+	}
+	if len(data.NetAmountSnapshots) > int(data.Params.MaxNetAmountSnapshots) {
+		return fmt.Errorf("number of net amount snapshots %d exceeds the max net amount snapshots %d",
+			len(data.NetAmountSnapshots), data.Params.MaxNetAmountSnapshots)
+	}
+	snapshotHeightSet := map[int64]struct{}{}
+	for _, snapshot := range data.NetAmountSnapshots {
+		if err := snapshot.Validate(); err != nil {
+			return fmt.Errorf("invalid net amount snapshot: %w", err)
+		}
+		if _, ok := snapshotHeightSet[snapshot.Height]; ok {
+			return fmt.Errorf("duplicate net amount snapshot at height %d", snapshot.Height)
+		}
+		snapshotHeightSet[snapshot.Height] = struct{}{}
+	}
 	return nil
 }
@@ -26,8 +26,13 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the liquidstaking module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters for the liquidstaking module
-	Params           Params            `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	LiquidValidators []LiquidValidator `protobuf:"bytes,2,rep,name=liquid_validators,json=liquidValidators,proto3" json:"liquid_validators" yaml:"liquid_validators"`
+	Params               Params                `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	LiquidValidators     []LiquidValidator     `protobuf:"bytes,2,rep,name=liquid_validators,json=liquidValidators,proto3" json:"liquid_validators" yaml:"liquid_validators"`
+	ValidatorPreferences []ValidatorPreference `protobuf:"bytes,3,rep,name=validator_preferences,json=validatorPreferences,proto3" json:"validator_preferences" yaml:"validator_preferences"`
+	PreferredDelegations []PreferredDelegation `protobuf:"bytes,4,rep,name=preferred_delegations,json=preferredDelegations,proto3" json:"preferred_delegations" yaml:"preferred_delegations"`
+	LastUnstakeTicketId  uint64                `protobuf:"varint,5,opt,name=last_unstake_ticket_id,json=lastUnstakeTicketId,proto3" json:"last_unstake_ticket_id,omitempty" yaml:"last_unstake_ticket_id"`
+	UnstakeTickets       []UnstakeTicket       `protobuf:"bytes,6,rep,name=unstake_tickets,json=unstakeTickets,proto3" json:"unstake_tickets" yaml:"unstake_tickets"`
+	NetAmountSnapshots   []NetAmountSnapshot   `protobuf:"bytes,7,rep,name=net_amount_snapshots,json=netAmountSnapshots,proto3" json:"net_amount_snapshots" yaml:"net_amount_snapshots"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -72,25 +77,39 @@ func init() {
 }
 
 var fileDescriptor_6fde17d64c38d8d9 = []byte{
-	// 287 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x2c, 0x2e, 0x2c, 0x4d,
-	0x4c, 0xd1, 0xcf, 0xc9, 0x2c, 0x2c, 0xcd, 0x4c, 0x29, 0x2e, 0x49, 0xcc, 0xce, 0xcc, 0x4b, 0xd7,
-	0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x06, 0x2b, 0xd5, 0x43, 0x51, 0xaa, 0x07, 0x55, 0x2a,
-	0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xa7, 0x0f, 0x62, 0x41, 0xb4, 0x48, 0xe9, 0xe3, 0x33,
-	0x1d, 0xd5, 0x20, 0xb0, 0x06, 0xa5, 0x1b, 0x8c, 0x5c, 0x3c, 0xee, 0x10, 0x5b, 0x83, 0x4b, 0x12,
-	0x4b, 0x52, 0x85, 0x1c, 0xb9, 0xd8, 0x0a, 0x12, 0x8b, 0x12, 0x73, 0x8b, 0x25, 0x18, 0x15, 0x18,
-	0x35, 0xb8, 0x8d, 0x94, 0xf5, 0xf0, 0xb8, 0x42, 0x2f, 0x00, 0xac, 0xd4, 0x89, 0xe5, 0xc4, 0x3d,
-	0x79, 0x86, 0x20, 0xa8, 0x46, 0xa1, 0x6a, 0x2e, 0x41, 0x88, 0xea, 0xf8, 0xb2, 0xc4, 0x9c, 0xcc,
-	0x94, 0xc4, 0x92, 0xfc, 0xa2, 0x62, 0x09, 0x26, 0x05, 0x66, 0x0d, 0x6e, 0x23, 0x1d, 0xbc, 0xa6,
-	0xf9, 0x80, 0x45, 0xc3, 0x60, 0x9a, 0x9c, 0x14, 0x40, 0xc6, 0x7e, 0xba, 0x27, 0x2f, 0x51, 0x99,
-	0x98, 0x9b, 0x63, 0xa5, 0x84, 0x61, 0xa8, 0x52, 0x90, 0x40, 0x0e, 0xaa, 0x96, 0x62, 0x2b, 0x8e,
-	0x8e, 0x05, 0xf2, 0x0c, 0x2f, 0x16, 0xc8, 0x33, 0x38, 0x85, 0xac, 0x78, 0x24, 0xc7, 0x78, 0xe2,
-	0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70,
-	0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x66, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49,
-	0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0xc9, 0xf9, 0xc5, 0xb9, 0xf9, 0x60, 0x87, 0xe9, 0xe6, 0x24, 0x26,
-	0x15, 0x43, 0x03, 0xb1, 0x02, 0x2d, 0x18, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0xe1,
-	0x66, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x1d, 0x65, 0x3a, 0x4a, 0xc8, 0x01, 0x00, 0x00,
+	// 505 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x4f, 0x6b, 0xd4, 0x40,
+	0x18, 0xc6, 0x33, 0x76, 0x5d, 0x25, 0x15, 0xff, 0xc4, 0xb5, 0x84, 0x56, 0x93, 0x35, 0xf5, 0xb0,
+	0x8a, 0x26, 0xb6, 0x82, 0x87, 0xde, 0x1a, 0x04, 0x11, 0x44, 0x96, 0xb4, 0xf6, 0xe0, 0x25, 0xcc,
+	0x6e, 0xc6, 0x74, 0x68, 0x32, 0x93, 0xe6, 0x9d, 0x2c, 0x16, 0xcf, 0x82, 0xe0, 0xc5, 0x8f, 0xd0,
+	0xa3, 0x1f, 0xa5, 0xde, 0x7a, 0xf4, 0xb4, 0xc8, 0xee, 0xc5, 0x73, 0x3f, 0x81, 0xec, 0x4c, 0xba,
+	0x98, 0xdd, 0x10, 0xbc, 0x85, 0x37, 0xcf, 0xef, 0x79, 0x9e, 0x77, 0xe0, 0xd5, 0x1f, 0xc3, 0x71,
+	0x81, 0x23, 0x2f, 0xa1, 0xc7, 0x05, 0x8d, 0x40, 0xe0, 0x23, 0xca, 0x62, 0x6f, 0xb4, 0x35, 0x20,
+	0x02, 0x6f, 0x79, 0x31, 0x61, 0x04, 0x28, 0xb8, 0x59, 0xce, 0x05, 0x37, 0x36, 0xa4, 0xd4, 0xad,
+	0x48, 0xdd, 0x52, 0xba, 0xde, 0x89, 0x79, 0xcc, 0xa5, 0xce, 0x9b, 0x7d, 0x29, 0x64, 0xdd, 0x6b,
+	0x72, 0xaf, 0x1a, 0x49, 0xc0, 0xf9, 0xd9, 0xd6, 0x6f, 0xbc, 0x56, 0xa9, 0x7b, 0x02, 0x0b, 0x62,
+	0xec, 0xea, 0xed, 0x0c, 0xe7, 0x38, 0x05, 0x13, 0x75, 0x51, 0x6f, 0x75, 0x7b, 0xd3, 0x6d, 0x68,
+	0xe1, 0xf6, 0xa5, 0xd4, 0x6f, 0x9d, 0x8d, 0x6d, 0x2d, 0x28, 0x41, 0xe3, 0xb3, 0x7e, 0x47, 0xa9,
+	0xc3, 0x11, 0x4e, 0x68, 0x84, 0x05, 0xcf, 0xc1, 0xbc, 0xd2, 0x5d, 0xe9, 0xad, 0x6e, 0x3f, 0x6d,
+	0x74, 0x7b, 0x2b, 0xa7, 0x07, 0x97, 0x90, 0xdf, 0x9d, 0xd9, 0x5e, 0x8c, 0x6d, 0xf3, 0x04, 0xa7,
+	0xc9, 0x8e, 0xb3, 0x64, 0xea, 0x04, 0xb7, 0x93, 0x2a, 0x02, 0xc6, 0x37, 0xa4, 0xdf, 0x9b, 0x2b,
+	0xc2, 0x2c, 0x27, 0x1f, 0x49, 0x4e, 0xd8, 0x90, 0x80, 0xb9, 0x22, 0x1b, 0x3c, 0x6f, 0x6c, 0x30,
+	0x37, 0xea, 0xcf, 0x41, 0xff, 0x51, 0xd9, 0xe2, 0xbe, 0x6a, 0x51, 0x6b, 0xee, 0x04, 0x9d, 0xd1,
+	0x32, 0xaa, 0xda, 0x28, 0x59, 0x4e, 0xa2, 0x30, 0x22, 0x09, 0x89, 0xb1, 0xa0, 0x9c, 0x81, 0xd9,
+	0xfa, 0x8f, 0x36, 0xfd, 0x4b, 0xf2, 0xd5, 0x1c, 0x5c, 0x6c, 0x53, 0x6b, 0xee, 0x04, 0x9d, 0x6c,
+	0x19, 0x05, 0xe3, 0x40, 0x5f, 0x4b, 0x30, 0x88, 0xb0, 0x60, 0xb3, 0x24, 0x12, 0x0a, 0x3a, 0x3c,
+	0x22, 0x22, 0xa4, 0x91, 0x79, 0xb5, 0x8b, 0x7a, 0x2d, 0xff, 0xe1, 0xc5, 0xd8, 0x7e, 0x50, 0xbe,
+	0x75, 0xad, 0xce, 0x09, 0xee, 0xce, 0x7e, 0xbc, 0x57, 0xf3, 0x7d, 0x39, 0x7e, 0x13, 0x19, 0xa0,
+	0xdf, 0xaa, 0x4a, 0xc1, 0x6c, 0xcb, 0xf5, 0x9e, 0x34, 0xae, 0x57, 0xb1, 0xf1, 0xad, 0x72, 0xb1,
+	0x35, 0x55, 0x60, 0xc1, 0xd0, 0x09, 0x6e, 0x16, 0xff, 0xca, 0xc1, 0xf8, 0x82, 0xf4, 0x0e, 0x23,
+	0x22, 0xc4, 0x29, 0x2f, 0x98, 0x08, 0x81, 0xe1, 0x0c, 0x0e, 0xb9, 0x00, 0xf3, 0x9a, 0x8c, 0x76,
+	0x1b, 0xa3, 0xdf, 0x11, 0xb1, 0x2b, 0xb9, 0xbd, 0x12, 0xf3, 0x37, 0xcb, 0xf8, 0x0d, 0x15, 0x5f,
+	0xe7, 0xec, 0x04, 0x06, 0x5b, 0xe4, 0x60, 0xe7, 0xfa, 0xd7, 0x53, 0x5b, 0xfb, 0x73, 0x6a, 0x6b,
+	0xfe, 0xfe, 0x8f, 0x89, 0x85, 0xce, 0x26, 0x16, 0x3a, 0x9f, 0x58, 0xe8, 0xf7, 0xc4, 0x42, 0xdf,
+	0xa7, 0x96, 0x76, 0x3e, 0xb5, 0xb4, 0x5f, 0x53, 0x4b, 0xfb, 0xf0, 0x32, 0xa6, 0xe2, 0xb0, 0x18,
+	0xb8, 0x43, 0x9e, 0x7a, 0x43, 0x0e, 0x29, 0x97, 0xfd, 0x9e, 0x25, 0x78, 0x00, 0xe5, 0xd5, 0x7e,
+	0x5a, 0xb8, 0x5b, 0x71, 0x92, 0x11, 0x18, 0xb4, 0xe5, 0xa1, 0xbe, 0xf8, 0x1b, 0x00, 0x00, 0xff,
+	0xff, 0xe6, 0x71, 0xd8, 0x28, 0x39, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -113,6 +132,67 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.NetAmountSnapshots) > 0 {
+		for iNdEx := len(m.NetAmountSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NetAmountSnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.UnstakeTickets) > 0 {
+		for iNdEx := len(m.UnstakeTickets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnstakeTickets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.LastUnstakeTicketId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastUnstakeTicketId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.PreferredDelegations) > 0 {
+		for iNdEx := len(m.PreferredDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PreferredDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ValidatorPreferences) > 0 {
+		for iNdEx := len(m.ValidatorPreferences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorPreferences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.LiquidValidators) > 0 {
 		for iNdEx := len(m.LiquidValidators) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -165,6 +245,33 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ValidatorPreferences) > 0 {
+		for _, e := range m.ValidatorPreferences {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PreferredDelegations) > 0 {
+		for _, e := range m.PreferredDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastUnstakeTicketId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastUnstakeTicketId))
+	}
+	if len(m.UnstakeTickets) > 0 {
+		for _, e := range m.UnstakeTickets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.NetAmountSnapshots) > 0 {
+		for _, e := range m.NetAmountSnapshots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -270,6 +377,161 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPreferences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorPreferences = append(m.ValidatorPreferences, ValidatorPreference{})
+			if err := m.ValidatorPreferences[len(m.ValidatorPreferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreferredDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreferredDelegations = append(m.PreferredDelegations, PreferredDelegation{})
+			if err := m.PreferredDelegations[len(m.PreferredDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUnstakeTicketId", wireType)
+			}
+			m.LastUnstakeTicketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUnstakeTicketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnstakeTickets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnstakeTickets = append(m.UnstakeTickets, UnstakeTicket{})
+			if err := m.UnstakeTickets[len(m.UnstakeTickets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAmountSnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetAmountSnapshots = append(m.NetAmountSnapshots, NetAmountSnapshot{})
+			if err := m.NetAmountSnapshots[len(m.NetAmountSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -43,6 +43,59 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			"invalid liquid validator {}: empty address string is not allowed: invalid address",
 		},
+		{
+			"invalid validator preference",
+			func(genState *types.GenesisState) {
+				genState.ValidatorPreferences = []types.ValidatorPreference{
+					{
+						DelegatorAddress: "cosmos1zaavvzxez0elundtn32qnk9lkm8kmcszzsv80v",
+						Validators:       []types.WhitelistedValidator{},
+					},
+				}
+			},
+			"invalid validator preference of cosmos1zaavvzxez0elundtn32qnk9lkm8kmcszzsv80v: preferred validators must not be empty",
+		},
+		{
+			"duplicate preferred delegation",
+			func(genState *types.GenesisState) {
+				pd := types.PreferredDelegation{
+					ValidatorAddress: "cosmosvaloper13w4ueuk80d3kmwk7ntlhp84fk0arlm3m9ammr5",
+					Amount:           sdk.NewInt(1000000),
+				}
+				genState.PreferredDelegations = []types.PreferredDelegation{pd, pd}
+			},
+			"duplicate preferred delegation of cosmosvaloper13w4ueuk80d3kmwk7ntlhp84fk0arlm3m9ammr5",
+		},
+		{
+			"unstake ticket id exceeding the last unstake ticket id",
+			func(genState *types.GenesisState) {
+				genState.LastUnstakeTicketId = 1
+				genState.UnstakeTickets = []types.UnstakeTicket{
+					{
+						Id:               2,
+						DelegatorAddress: "cosmos1zaavvzxez0elundtn32qnk9lkm8kmcszzsv80v",
+						BtokenBurned:     sdk.NewInt64Coin("bstake", 1000),
+						ExpectedAmount:   sdk.NewInt64Coin("stake", 1000),
+					},
+				}
+			},
+			"unstake ticket id 2 is greater than the last unstake ticket id 1",
+		},
+		{
+			"duplicate net amount snapshot",
+			func(genState *types.GenesisState) {
+				snapshot := types.NetAmountSnapshot{
+					Height:                100,
+					MintRate:              sdk.OneDec(),
+					BtokenTotalSupply:     sdk.NewInt(1000000),
+					NetAmount:             sdk.NewDec(1000000),
+					TotalDelShares:        sdk.NewDec(1000000),
+					TotalRemainingRewards: sdk.ZeroDec(),
+				}
+				genState.NetAmountSnapshots = []types.NetAmountSnapshot{snapshot, snapshot}
+			},
+			"duplicate net amount snapshot at height 100",
+		},
 		{
 			"invalid params(UnstakeFeeRate)",
 			func(genState *types.GenesisState) {
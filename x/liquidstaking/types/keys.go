@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 )
@@ -21,7 +23,18 @@ const (
 
 var (
 	// Keys for store prefixes
-	LiquidValidatorsKey = []byte{0xc0} // prefix for each key to a liquid validator
+	LiquidValidatorsKey     = []byte{0xc0} // prefix for each key to a liquid validator
+	ValidatorPreferencesKey = []byte{0xc1} // prefix for each key to a validator preference
+	PreferredDelegationsKey = []byte{0xc2} // prefix for each key to a preferred delegation
+
+	LastUnstakeTicketIdKey = []byte{0xc3} // key for the latest unstake ticket id
+	UnstakeTicketsKey      = []byte{0xc4} // prefix for each key to an unstake ticket
+	UnstakeTicketIndexKey  = []byte{0xc5} // prefix for each key to index unstake tickets by liquid staker
+	UnstakeTicketQueueKey  = []byte{0xc6} // prefix for each key to queue unstake tickets by completion time
+
+	NetAmountSnapshotsKey = []byte{0xc7} // prefix for each key to a net amount snapshot
+
+	PerformanceScoresKey = []byte{0xc8} // prefix for each key to a validator's performance score
 )
 
 // GetLiquidValidatorKey creates the key for the liquid validator with address
@@ -29,3 +42,70 @@ var (
 func GetLiquidValidatorKey(operatorAddr sdk.ValAddress) []byte {
 	return append(LiquidValidatorsKey, address.MustLengthPrefix(operatorAddr)...)
 }
+
+// GetValidatorPreferenceKey creates the key for the validator preference with liquid staker address
+// VALUE: liquidstaking/ValidatorPreference
+func GetValidatorPreferenceKey(delegatorAddr sdk.AccAddress) []byte {
+	return append(ValidatorPreferencesKey, address.MustLengthPrefix(delegatorAddr)...)
+}
+
+// GetPreferredDelegationKey creates the key for the preferred delegation with validator address
+// VALUE: liquidstaking/PreferredDelegation
+func GetPreferredDelegationKey(operatorAddr sdk.ValAddress) []byte {
+	return append(PreferredDelegationsKey, address.MustLengthPrefix(operatorAddr)...)
+}
+
+// GetUnstakeTicketKey creates the key for the unstake ticket with id
+// VALUE: liquidstaking/UnstakeTicket
+func GetUnstakeTicketKey(id uint64) []byte {
+	return append(UnstakeTicketsKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetNetAmountSnapshotKey creates the key for the net amount snapshot with block height
+// VALUE: liquidstaking/NetAmountSnapshot
+func GetNetAmountSnapshotKey(height int64) []byte {
+	return append(NetAmountSnapshotsKey, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetPerformanceScoreKey creates the key for the performance score of the validator with address
+// VALUE: sdk.DecProto
+func GetPerformanceScoreKey(operatorAddr sdk.ValAddress) []byte {
+	return append(PerformanceScoresKey, address.MustLengthPrefix(operatorAddr)...)
+}
+
+// ParsePerformanceScoreKey parses the performance score key and returns the validator address
+func ParsePerformanceScoreKey(key []byte) sdk.ValAddress {
+	return key[len(PerformanceScoresKey)+1:]
+}
+
+// GetUnstakeTicketIndexKey creates the index key for the unstake ticket with liquid staker address and id
+// VALUE: nil
+func GetUnstakeTicketIndexKey(delegatorAddr sdk.AccAddress, id uint64) []byte {
+	return append(GetUnstakeTicketIndexKeyPrefix(delegatorAddr), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetUnstakeTicketIndexKeyPrefix creates the index key prefix to iterate unstake tickets by liquid staker
+func GetUnstakeTicketIndexKeyPrefix(delegatorAddr sdk.AccAddress) []byte {
+	return append(UnstakeTicketIndexKey, address.MustLengthPrefix(delegatorAddr)...)
+}
+
+// GetUnstakeTicketQueueKey creates the queue key for the unstake ticket with completion time and id
+// VALUE: nil
+func GetUnstakeTicketQueueKey(completionTime time.Time, id uint64) []byte {
+	return append(GetUnstakeTicketQueueTimeKeyPrefix(completionTime), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetUnstakeTicketQueueTimeKeyPrefix creates the queue key prefix for the unstake tickets with completion time
+func GetUnstakeTicketQueueTimeKeyPrefix(completionTime time.Time) []byte {
+	return append(UnstakeTicketQueueKey, sdk.FormatTimeBytes(completionTime)...)
+}
+
+// ParseUnstakeTicketIndexKey parses the unstake ticket index key and returns the ticket id
+func ParseUnstakeTicketIndexKey(key []byte) (id uint64) {
+	return sdk.BigEndianToUint64(key[len(key)-8:])
+}
+
+// ParseUnstakeTicketQueueKey parses the unstake ticket queue key and returns the ticket id
+func ParseUnstakeTicketQueueKey(key []byte) (id uint64) {
+	return sdk.BigEndianToUint64(key[len(key)-8:])
+}
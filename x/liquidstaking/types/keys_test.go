@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -8,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	farmingtypes "github.com/cosmosquad-labs/squad/v3/x/farming/types"
 
+	utils "github.com/cosmosquad-labs/squad/v3/types"
 	"github.com/cosmosquad-labs/squad/v3/x/liquidstaking/types"
 )
 
@@ -39,3 +41,18 @@ func (s *keysTestSuite) TestGetLiquidValidatorKey() {
 	s.Require().Equal([]byte{0xc0, 0x20, 0x37, 0xa2, 0x82, 0x32, 0xfe, 0xaf, 0x6f, 0x5, 0xd, 0x65, 0xc0, 0x6, 0x19, 0x5a, 0xd6, 0xf5, 0x67, 0x81, 0x39, 0x21, 0x9c, 0x2c, 0xc8, 0x8f, 0x2, 0xdc, 0x12, 0xfd, 0xeb, 0xb2, 0xa3, 0x6d}, types.GetLiquidValidatorKey(lv2.GetOperator()))
 	s.Require().Equal([]byte{0xc0, 0x20, 0x37, 0xa2, 0x82, 0x32, 0xfe, 0xaf, 0x6f, 0x5, 0xd, 0x65, 0xc0, 0x6, 0x19, 0x5a, 0xd6, 0xf5, 0x67, 0x81, 0x39, 0x21, 0x9c, 0x2c, 0xc8, 0x8f, 0x2, 0xdc, 0x12, 0xfd, 0xeb, 0xb2, 0xa3, 0x6d}, types.GetLiquidValidatorKey(valAddr2))
 }
+
+func (s *keysTestSuite) TestUnstakeTicketKeys() {
+	delAddr := farmingtypes.DeriveAddress(farmingtypes.AddressType20Bytes, types.ModuleName, "delegator")
+	completionTime := utils.ParseTime("2022-03-22T00:00:00Z")
+
+	s.Require().Equal([]byte{0xc4, 0, 0, 0, 0, 0, 0, 0, 0x5}, types.GetUnstakeTicketKey(5))
+
+	indexKey := types.GetUnstakeTicketIndexKey(delAddr, 5)
+	s.Require().True(bytes.HasPrefix(indexKey, types.GetUnstakeTicketIndexKeyPrefix(delAddr)))
+	s.Require().EqualValues(5, types.ParseUnstakeTicketIndexKey(indexKey))
+
+	queueKey := types.GetUnstakeTicketQueueKey(completionTime, 5)
+	s.Require().True(bytes.HasPrefix(queueKey, types.GetUnstakeTicketQueueTimeKeyPrefix(completionTime)))
+	s.Require().EqualValues(5, types.ParseUnstakeTicketQueueKey(queueKey))
+}
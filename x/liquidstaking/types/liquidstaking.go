@@ -72,6 +72,26 @@ func (v LiquidValidator) GetWeight(whitelistedValsMap WhitelistedValsMap, active
 	}
 }
 
+// PerformanceScore returns the performance score of a validator in the range of [0, 1], which is the ratio of
+// signed blocks in the signed blocks window multiplied by the commission rate complement.
+// A jailed validator scores zero.
+func PerformanceScore(missedBlocks, signedBlocksWindow int64, commissionRate sdk.Dec, jailed bool) sdk.Dec {
+	if jailed {
+		return sdk.ZeroDec()
+	}
+	uptime := sdk.OneDec()
+	if signedBlocksWindow > 0 {
+		uptime = sdk.MaxDec(sdk.ZeroDec(), uptime.Sub(sdk.NewDec(missedBlocks).QuoInt64(signedBlocksWindow)))
+	}
+	commissionFactor := sdk.MaxDec(sdk.ZeroDec(), sdk.OneDec().Sub(commissionRate))
+	return uptime.Mul(commissionFactor)
+}
+
+// PerformanceWeight returns the effective weight of the target weight scaled by the performance score.
+func PerformanceWeight(targetWeight sdk.Int, score sdk.Dec) sdk.Int {
+	return targetWeight.Mul(PerformanceWeightPrecision).ToDec().Mul(score).TruncateInt()
+}
+
 func (v LiquidValidator) GetStatus(activeCondition bool) ValidatorStatus {
 	if activeCondition {
 		return ValidatorStatusActive
@@ -163,6 +183,21 @@ func (avs ActiveLiquidValidators) TotalWeight(whitelistedValsMap WhitelistedVals
 	return totalWeight
 }
 
+// Listed returns the liquid validators which are listed in the given validators map.
+func (vs LiquidValidators) Listed(valsMap WhitelistedValsMap) (listed LiquidValidators) {
+	for _, val := range vs {
+		if valsMap.IsListed(val.OperatorAddress) {
+			listed = append(listed, val)
+		}
+	}
+	return listed
+}
+
+// Listed returns the active liquid validators which are listed in the given validators map.
+func (avs ActiveLiquidValidators) Listed(valsMap WhitelistedValsMap) ActiveLiquidValidators {
+	return ActiveLiquidValidators(LiquidValidators(avs).Listed(valsMap))
+}
+
 // NativeTokenToBToken returns bTokenTotalSupply * nativeTokenAmount / netAmount
 func NativeTokenToBToken(nativeTokenAmount, bTokenTotalSupplyAmount sdk.Int, netAmount sdk.Dec) (bTokenAmount sdk.Int) {
 	return bTokenTotalSupplyAmount.ToDec().MulTruncate(nativeTokenAmount.ToDec()).QuoTruncate(netAmount.TruncateDec()).TruncateInt()
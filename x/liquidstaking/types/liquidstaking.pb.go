@@ -5,22 +5,25 @@ package types
 
 import (
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
 	_ "github.com/regen-network/cosmos-proto"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -60,6 +63,35 @@ func (ValidatorStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_d74351e2d3b011d8, []int{0}
 }
 
+// WeightingMode enumerates the modes of deriving effective weights of liquid validators.
+type WeightingMode int32
+
+const (
+	// WEIGHTING_MODE_STATIC uses the target weights of the whitelisted validators as they are.
+	WeightingModeStatic WeightingMode = 0
+	// WEIGHTING_MODE_PERFORMANCE scales the target weights of the whitelisted validators by their performance scores,
+	// which reflect the missed blocks, jailing and commission rate of the validators.
+	WeightingModePerformance WeightingMode = 1
+)
+
+var WeightingMode_name = map[int32]string{
+	0: "WEIGHTING_MODE_STATIC",
+	1: "WEIGHTING_MODE_PERFORMANCE",
+}
+
+var WeightingMode_value = map[string]int32{
+	"WEIGHTING_MODE_STATIC":      0,
+	"WEIGHTING_MODE_PERFORMANCE": 1,
+}
+
+func (x WeightingMode) String() string {
+	return proto.EnumName(WeightingMode_name, int32(x))
+}
+
+func (WeightingMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d74351e2d3b011d8, []int{1}
+}
+
 // Params defines the set of params for the liquidstaking module.
 type Params struct {
 	// LiquidBondDenom specifies the denomination of the token receiving after LiquidStaking, The value is calculated
@@ -73,6 +105,25 @@ type Params struct {
 	// MinLiquidStakingAmount specifies the minimum number of coins to be staked to the active liquid validators on liquid
 	// staking to minimize decimal loss and consider gas efficiency.
 	MinLiquidStakingAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=min_liquid_staking_amount,json=minLiquidStakingAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_liquid_staking_amount" yaml:"min_liquid_staking_amount"`
+	// InstantUnstakeBufferSize specifies the amount of native tokens kept in the proxy account as a buffer for instant
+	// liquid unstaking. Rewards and completed unbondings of the proxy account refill the buffer before being re-staked.
+	// Instant liquid unstaking is disabled if it is zero.
+	InstantUnstakeBufferSize github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=instant_unstake_buffer_size,json=instantUnstakeBufferSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"instant_unstake_buffer_size" yaml:"instant_unstake_buffer_size"`
+	// InstantUnstakeFeeRate specifies the fee rate when instant liquid unstake is requested, paid by subtracting it from
+	// the unstaked amount. UnstakeFeeRate is applied instead if it is higher.
+	InstantUnstakeFeeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=instant_unstake_fee_rate,json=instantUnstakeFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"instant_unstake_fee_rate" yaml:"instant_unstake_fee_rate"`
+	// WeightingMode specifies how the effective weights of the active liquid validators are derived from their target
+	// weights, which are used for liquid staking, re-staking and rebalancing.
+	WeightingMode WeightingMode `protobuf:"varint,8,opt,name=weighting_mode,json=weightingMode,proto3,enum=squad.liquidstaking.v1beta1.WeightingMode" json:"weighting_mode,omitempty" yaml:"weighting_mode"`
+	// PerformanceUpdateInterval specifies the number of blocks between the updates of the validators' performance
+	// scores, which are used for the effective weights on the performance weighting mode.
+	PerformanceUpdateInterval uint64 `protobuf:"varint,11,opt,name=performance_update_interval,json=performanceUpdateInterval,proto3" json:"performance_update_interval,omitempty" yaml:"performance_update_interval"`
+	// NetAmountSnapshotInterval specifies the number of blocks between the net amount state snapshots. Snapshots are
+	// disabled if it is zero.
+	NetAmountSnapshotInterval uint64 `protobuf:"varint,9,opt,name=net_amount_snapshot_interval,json=netAmountSnapshotInterval,proto3" json:"net_amount_snapshot_interval,omitempty" yaml:"net_amount_snapshot_interval"`
+	// MaxNetAmountSnapshots specifies the maximum number of the net amount state snapshots kept in the history. The
+	// oldest snapshots are pruned when it is exceeded.
+	MaxNetAmountSnapshots uint32 `protobuf:"varint,10,opt,name=max_net_amount_snapshots,json=maxNetAmountSnapshots,proto3" json:"max_net_amount_snapshots,omitempty" yaml:"max_net_amount_snapshots"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -191,6 +242,90 @@ func (m *LiquidValidator) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_LiquidValidator proto.InternalMessageInfo
 
+// ValidatorPreference defines the whitelisted validators and their weights a liquid staker prefers to delegate to,
+// instead of all the whitelisted validators with their target weights.
+type ValidatorPreference struct {
+	// delegator_address defines the bech32-encoded address of the liquid staker
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	// validators defines the preferred validators and their weights
+	Validators []WhitelistedValidator `protobuf:"bytes,2,rep,name=validators,proto3" json:"validators" yaml:"validators"`
+}
+
+func (m *ValidatorPreference) Reset()         { *m = ValidatorPreference{} }
+func (m *ValidatorPreference) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPreference) ProtoMessage()    {}
+func (*ValidatorPreference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d74351e2d3b011d8, []int{3}
+}
+func (m *ValidatorPreference) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorPreference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPreference.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorPreference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPreference.Merge(m, src)
+}
+func (m *ValidatorPreference) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorPreference) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPreference.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorPreference proto.InternalMessageInfo
+
+// PreferredDelegation defines the amount of liquid tokens delegated to a liquid validator according to the validator
+// preferences of liquid stakers, which is excluded from rebalancing by the target weights.
+type PreferredDelegation struct {
+	// validator_address defines the bech32-encoded address of the liquid validator
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	// amount defines the amount of the preferred liquid tokens
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount" yaml:"amount"`
+}
+
+func (m *PreferredDelegation) Reset()         { *m = PreferredDelegation{} }
+func (m *PreferredDelegation) String() string { return proto.CompactTextString(m) }
+func (*PreferredDelegation) ProtoMessage()    {}
+func (*PreferredDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d74351e2d3b011d8, []int{4}
+}
+func (m *PreferredDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PreferredDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PreferredDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PreferredDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PreferredDelegation.Merge(m, src)
+}
+func (m *PreferredDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *PreferredDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PreferredDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PreferredDelegation proto.InternalMessageInfo
+
 // LiquidValidatorState is type LiquidValidator with state added to return to query results.
 type LiquidValidatorState struct {
 	// operator_address defines the address of the validator's operator; bech encoded in JSON.
@@ -203,13 +338,16 @@ type LiquidValidatorState struct {
 	DelShares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=del_shares,json=delShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"del_shares"`
 	// liquid_tokens define the token amount worth of delegation shares of the validator (slashing applied amount)
 	LiquidTokens github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=liquid_tokens,json=liquidTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"liquid_tokens"`
+	// effective_weight specifies the weight actually used for liquid staking, re-staking and rebalancing according to
+	// the weighting mode
+	EffectiveWeight github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=effective_weight,json=effectiveWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"effective_weight" yaml:"effective_weight"`
 }
 
 func (m *LiquidValidatorState) Reset()         { *m = LiquidValidatorState{} }
 func (m *LiquidValidatorState) String() string { return proto.CompactTextString(m) }
 func (*LiquidValidatorState) ProtoMessage()    {}
 func (*LiquidValidatorState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d74351e2d3b011d8, []int{3}
+	return fileDescriptor_d74351e2d3b011d8, []int{5}
 }
 func (m *LiquidValidatorState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -266,7 +404,7 @@ func (m *NetAmountState) Reset()         { *m = NetAmountState{} }
 func (m *NetAmountState) String() string { return proto.CompactTextString(m) }
 func (*NetAmountState) ProtoMessage()    {}
 func (*NetAmountState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d74351e2d3b011d8, []int{4}
+	return fileDescriptor_d74351e2d3b011d8, []int{6}
 }
 func (m *NetAmountState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -314,7 +452,7 @@ func (m *VotingPower) Reset()         { *m = VotingPower{} }
 func (m *VotingPower) String() string { return proto.CompactTextString(m) }
 func (*VotingPower) ProtoMessage()    {}
 func (*VotingPower) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d74351e2d3b011d8, []int{5}
+	return fileDescriptor_d74351e2d3b011d8, []int{7}
 }
 func (m *VotingPower) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -343,14 +481,121 @@ func (m *VotingPower) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_VotingPower proto.InternalMessageInfo
 
+// UnstakeTicket defines an in-flight liquid unstaking of a liquid staker, whose unbonding delegations are spread
+// across the liquid validators. It is deleted when the unbonding delegations mature.
+type UnstakeTicket struct {
+	// id specifies the unstake ticket id
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// delegator_address defines the bech32-encoded address of the liquid staker
+	DelegatorAddress string `protobuf:"bytes,2,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	// btoken_burned specifies the amount of bToken burned for the liquid unstaking
+	BtokenBurned types.Coin `protobuf:"bytes,3,opt,name=btoken_burned,json=btokenBurned,proto3" json:"btoken_burned" yaml:"btoken_burned"`
+	// expected_amount specifies the amount of native token expected to be received when the unbonding delegations
+	// mature, which could be reduced if the liquid validators get slashed in the meantime
+	ExpectedAmount types.Coin `protobuf:"bytes,4,opt,name=expected_amount,json=expectedAmount,proto3" json:"expected_amount" yaml:"expected_amount"`
+	// completion_time specifies the time when the unbonding delegations mature
+	CompletionTime time.Time `protobuf:"bytes,5,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time" yaml:"completion_time"`
+}
+
+func (m *UnstakeTicket) Reset()         { *m = UnstakeTicket{} }
+func (m *UnstakeTicket) String() string { return proto.CompactTextString(m) }
+func (*UnstakeTicket) ProtoMessage()    {}
+func (*UnstakeTicket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d74351e2d3b011d8, []int{8}
+}
+func (m *UnstakeTicket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnstakeTicket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnstakeTicket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnstakeTicket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnstakeTicket.Merge(m, src)
+}
+func (m *UnstakeTicket) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnstakeTicket) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnstakeTicket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnstakeTicket proto.InternalMessageInfo
+
+// NetAmountSnapshot defines a snapshot of the net amount state taken every NetAmountSnapshotInterval blocks, which
+// keeps track of the bToken exchange rate over time.
+type NetAmountSnapshot struct {
+	// height specifies the block height of the snapshot
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// time specifies the block time of the snapshot
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	// mint_rate is bTokenTotalSupply / NetAmount
+	MintRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=mint_rate,json=mintRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"mint_rate"`
+	// btoken_total_supply specifies the total supply of btoken(liquid_bond_denom)
+	BtokenTotalSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=btoken_total_supply,json=btokenTotalSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"btoken_total_supply"`
+	// net_amount is proxy account's native token balance + total liquid tokens + total remaining rewards + total
+	// unbonding balance
+	NetAmount github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=net_amount,json=netAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"net_amount"`
+	// total_del_shares define the delegation shares of all liquid validators
+	TotalDelShares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=total_del_shares,json=totalDelShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"total_del_shares"`
+	// total_remaining_rewards define the sum of remaining rewards of proxy account by all liquid validators
+	TotalRemainingRewards github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=total_remaining_rewards,json=totalRemainingRewards,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"total_remaining_rewards"`
+}
+
+func (m *NetAmountSnapshot) Reset()         { *m = NetAmountSnapshot{} }
+func (m *NetAmountSnapshot) String() string { return proto.CompactTextString(m) }
+func (*NetAmountSnapshot) ProtoMessage()    {}
+func (*NetAmountSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d74351e2d3b011d8, []int{9}
+}
+func (m *NetAmountSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NetAmountSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NetAmountSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NetAmountSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetAmountSnapshot.Merge(m, src)
+}
+func (m *NetAmountSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *NetAmountSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetAmountSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NetAmountSnapshot proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("squad.liquidstaking.v1beta1.ValidatorStatus", ValidatorStatus_name, ValidatorStatus_value)
+	proto.RegisterEnum("squad.liquidstaking.v1beta1.WeightingMode", WeightingMode_name, WeightingMode_value)
 	proto.RegisterType((*Params)(nil), "squad.liquidstaking.v1beta1.Params")
 	proto.RegisterType((*WhitelistedValidator)(nil), "squad.liquidstaking.v1beta1.WhitelistedValidator")
 	proto.RegisterType((*LiquidValidator)(nil), "squad.liquidstaking.v1beta1.LiquidValidator")
+	proto.RegisterType((*ValidatorPreference)(nil), "squad.liquidstaking.v1beta1.ValidatorPreference")
+	proto.RegisterType((*PreferredDelegation)(nil), "squad.liquidstaking.v1beta1.PreferredDelegation")
 	proto.RegisterType((*LiquidValidatorState)(nil), "squad.liquidstaking.v1beta1.LiquidValidatorState")
 	proto.RegisterType((*NetAmountState)(nil), "squad.liquidstaking.v1beta1.NetAmountState")
 	proto.RegisterType((*VotingPower)(nil), "squad.liquidstaking.v1beta1.VotingPower")
+	proto.RegisterType((*UnstakeTicket)(nil), "squad.liquidstaking.v1beta1.UnstakeTicket")
+	proto.RegisterType((*NetAmountSnapshot)(nil), "squad.liquidstaking.v1beta1.NetAmountSnapshot")
 }
 
 func init() {
@@ -358,76 +603,115 @@ func init() {
 }
 
 var fileDescriptor_d74351e2d3b011d8 = []byte{
-	// 1089 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x4f, 0x1b, 0x47,
-	0x14, 0xf6, 0x82, 0x43, 0x60, 0x12, 0xc0, 0x6c, 0x0c, 0x18, 0x93, 0xda, 0xd6, 0x4a, 0xad, 0xa2,
-	0xaa, 0xd8, 0x85, 0x4a, 0x39, 0x70, 0xaa, 0x89, 0x41, 0x75, 0x4a, 0x29, 0x5a, 0x1b, 0xd2, 0xe6,
-	0x90, 0xed, 0x78, 0x77, 0x30, 0x13, 0x76, 0x67, 0x36, 0x3b, 0xb3, 0x76, 0xb8, 0xf4, 0x1c, 0xd1,
-	0x43, 0xab, 0x9c, 0x7a, 0x41, 0x8a, 0x5a, 0xf5, 0xdf, 0xe8, 0x39, 0x97, 0x4a, 0x39, 0x56, 0x3d,
-	0x58, 0x15, 0x54, 0x6a, 0xcf, 0x9c, 0x7b, 0xa8, 0x76, 0x66, 0xfc, 0x9b, 0x46, 0xc2, 0xc1, 0x17,
-	0xef, 0xcc, 0xbc, 0xf7, 0x7d, 0xef, 0x7d, 0xef, 0xcd, 0x0f, 0x50, 0x60, 0xcf, 0x42, 0xe8, 0x14,
-	0x5c, 0xfc, 0x2c, 0xc4, 0x0e, 0xe3, 0xf0, 0x08, 0x93, 0x7a, 0xa1, 0xb1, 0x5a, 0x43, 0x1c, 0xae,
-	0xf6, 0xcf, 0xe6, 0xfd, 0x80, 0x72, 0xaa, 0x2f, 0x0b, 0x87, 0x7c, 0xff, 0x92, 0x72, 0x48, 0x27,
-	0xeb, 0xb4, 0x4e, 0x85, 0x5d, 0x21, 0xfa, 0x92, 0x2e, 0xe9, 0x25, 0x9b, 0x32, 0x8f, 0x32, 0x4b,
-	0x2e, 0xc8, 0x81, 0x5a, 0xca, 0xc8, 0x51, 0xa1, 0x06, 0x19, 0xea, 0xd0, 0xda, 0x14, 0x13, 0xb5,
-	0x9e, 0xad, 0x53, 0x5a, 0x77, 0x51, 0x41, 0x8c, 0x6a, 0xe1, 0x41, 0x81, 0x63, 0x0f, 0x31, 0x0e,
-	0x3d, 0x5f, 0x19, 0xc8, 0x3f, 0x7b, 0xa5, 0x8e, 0xc8, 0x0a, 0xf5, 0x11, 0x81, 0x3e, 0x6e, 0xac,
-	0x15, 0xa8, 0xcf, 0x31, 0x25, 0xac, 0x00, 0x09, 0xa1, 0x1c, 0x8a, 0x6f, 0x69, 0x68, 0x7c, 0x17,
-	0x07, 0x13, 0xbb, 0x30, 0x80, 0x1e, 0xd3, 0x3f, 0x03, 0x73, 0x32, 0x0b, 0xab, 0x46, 0x89, 0x63,
-	0x39, 0x88, 0x50, 0x2f, 0xa5, 0xe5, 0xb4, 0x7b, 0x53, 0x1b, 0x77, 0x2f, 0x5a, 0xd9, 0xd4, 0x31,
-	0xf4, 0xdc, 0x75, 0x63, 0xc8, 0xc4, 0x30, 0x67, 0xe5, 0xdc, 0x06, 0x25, 0x4e, 0x29, 0x9a, 0xd1,
-	0xbf, 0xd7, 0xc0, 0x42, 0xf3, 0x10, 0x73, 0xe4, 0x62, 0xc6, 0x91, 0x63, 0x35, 0xa0, 0x8b, 0x1d,
-	0xc8, 0x69, 0xc0, 0x52, 0x63, 0xb9, 0xf1, 0x7b, 0xb7, 0xd6, 0x56, 0xf3, 0x6f, 0x51, 0x2d, 0xff,
-	0xa8, 0xeb, 0xba, 0xdf, 0xf6, 0xdc, 0x78, 0xff, 0x75, 0x2b, 0x1b, 0xbb, 0x68, 0x65, 0xdf, 0x93,
-	0x61, 0x5c, 0x0e, 0x6f, 0x98, 0xf3, 0xcd, 0x4b, 0x9c, 0x99, 0xce, 0x40, 0x22, 0x24, 0x11, 0x0f,
-	0xb2, 0x0e, 0x10, 0xb2, 0x02, 0xc8, 0x51, 0x6a, 0x5c, 0xa4, 0x56, 0x8e, 0x70, 0xff, 0x68, 0x65,
-	0x3f, 0xa8, 0x63, 0x7e, 0x18, 0xd6, 0xf2, 0x36, 0xf5, 0x54, 0x49, 0xd4, 0xdf, 0x0a, 0x73, 0x8e,
-	0x0a, 0xfc, 0xd8, 0x47, 0x2c, 0x5f, 0x42, 0xf6, 0x45, 0x2b, 0xbb, 0x28, 0x23, 0x18, 0xc4, 0x33,
-	0xcc, 0x19, 0x35, 0xb5, 0x85, 0x90, 0x09, 0x39, 0xd2, 0x7f, 0xd1, 0xc0, 0x92, 0x87, 0x89, 0xa5,
-	0x24, 0x53, 0x69, 0x5a, 0xd0, 0xa3, 0x21, 0xe1, 0xa9, 0x1b, 0x82, 0xfe, 0xe9, 0xcb, 0xe2, 0xfc,
-	0xc3, 0x29, 0x63, 0xf5, 0x63, 0xf1, 0x33, 0x7e, 0x1a, 0xbb, 0xc9, 0x9c, 0xa3, 0x7c, 0x99, 0xf0,
-	0x2b, 0x84, 0x55, 0x26, 0xfc, 0xa2, 0x95, 0xcd, 0xc9, 0xb0, 0xfe, 0x97, 0xd0, 0x30, 0x17, 0x3c,
-	0x4c, 0xb6, 0xc5, 0x52, 0x45, 0xae, 0x14, 0xc5, 0xc2, 0xfa, 0xe4, 0x8b, 0x57, 0xd9, 0xd8, 0x8f,
-	0xaf, 0xb2, 0x31, 0xe3, 0x6f, 0x0d, 0x24, 0x2f, 0x53, 0x5f, 0x2f, 0x83, 0xb9, 0x8e, 0xca, 0x16,
-	0x74, 0x9c, 0x00, 0x31, 0x36, 0xdc, 0x1b, 0x43, 0x26, 0x86, 0x99, 0xe8, 0xcc, 0x15, 0xe5, 0x94,
-	0xfe, 0x2d, 0x98, 0xe6, 0x30, 0xa8, 0x23, 0x6e, 0x35, 0x11, 0xae, 0x1f, 0xf2, 0xd4, 0x98, 0x80,
-	0xf9, 0xfa, 0x65, 0x31, 0xf1, 0x30, 0x6e, 0xac, 0xbe, 0x93, 0x06, 0x49, 0x19, 0x47, 0x1f, 0xbe,
-	0x61, 0xde, 0x96, 0xe3, 0x47, 0x62, 0xb8, 0x1e, 0x8f, 0xb2, 0x35, 0x6c, 0x30, 0x2b, 0xa5, 0xe8,
-	0xe6, 0xb8, 0x05, 0x12, 0xd4, 0x47, 0xc1, 0x25, 0x29, 0x2e, 0x77, 0xab, 0x3e, 0x68, 0x61, 0x98,
-	0xb3, 0xed, 0x29, 0x95, 0xa0, 0x94, 0xf3, 0x9f, 0x88, 0xe4, 0xd7, 0x71, 0x90, 0x1c, 0x60, 0xa9,
-	0xf0, 0xa8, 0x33, 0xae, 0x89, 0x4a, 0x7f, 0x0a, 0x26, 0xfa, 0x44, 0x34, 0xaf, 0x43, 0xc4, 0x69,
-	0xb5, 0xc3, 0x94, 0x7a, 0x8a, 0x41, 0x2f, 0x81, 0x09, 0xc6, 0x21, 0x0f, 0x99, 0xd8, 0x38, 0x33,
-	0x6b, 0x1f, 0xbd, 0x75, 0x0f, 0xf7, 0x25, 0x1c, 0x32, 0x53, 0xf9, 0xea, 0x5f, 0x00, 0xe0, 0x20,
-	0xd7, 0x62, 0x87, 0x30, 0x40, 0x2c, 0x15, 0x17, 0x51, 0xe7, 0xaf, 0xb6, 0x05, 0xcd, 0x29, 0x07,
-	0xb9, 0x15, 0x01, 0xa0, 0x57, 0xc0, 0xb4, 0x6a, 0x76, 0x4e, 0x8f, 0x10, 0x61, 0x6a, 0x57, 0xe5,
-	0xaf, 0x96, 0xb4, 0x79, 0x5b, 0x82, 0x54, 0x05, 0x46, 0x4f, 0x01, 0xff, 0xbd, 0x01, 0x66, 0x76,
-	0x10, 0x97, 0xfb, 0x44, 0x96, 0xee, 0x73, 0x30, 0xe5, 0x61, 0xc2, 0xe5, 0x11, 0xa2, 0x8d, 0x14,
-	0xff, 0x64, 0x04, 0x20, 0x4e, 0x88, 0x27, 0xe0, 0x4e, 0x4d, 0x04, 0x6e, 0x71, 0xca, 0xa1, 0x6b,
-	0xb1, 0xd0, 0xf7, 0xdd, 0x63, 0x55, 0xcc, 0xab, 0x26, 0x31, 0x27, 0xa1, 0xaa, 0x11, 0x52, 0x45,
-	0x00, 0x45, 0x6a, 0x13, 0xc4, 0xdb, 0x27, 0xce, 0xf8, 0x68, 0x6a, 0x93, 0xb6, 0x00, 0xfa, 0x57,
-	0x20, 0x21, 0xe3, 0x7c, 0xe7, 0x12, 0xce, 0x08, 0x9c, 0x52, 0xa7, 0x8e, 0x4f, 0xc0, 0x1d, 0x89,
-	0x7c, 0x1d, 0xd5, 0x9c, 0x13, 0x50, 0xdb, 0x3d, 0x25, 0xd5, 0x0f, 0xc0, 0xa2, 0xc4, 0x0f, 0x90,
-	0x07, 0x31, 0x89, 0x4e, 0xc5, 0x00, 0x35, 0x61, 0xe0, 0xb0, 0xd4, 0xc4, 0x48, 0x09, 0xcc, 0x0b,
-	0x38, 0xb3, 0x8d, 0x66, 0x4a, 0xb0, 0x2e, 0x4f, 0x48, 0xa2, 0x1b, 0x32, 0xe2, 0xa9, 0x41, 0x17,
-	0x12, 0x1b, 0xa5, 0x6e, 0x8e, 0x94, 0x8b, 0xe4, 0xd9, 0x6b, 0xa3, 0x6d, 0x48, 0x30, 0xfd, 0x31,
-	0x98, 0xf3, 0x03, 0xfa, 0xfc, 0xd8, 0x82, 0xb6, 0xdd, 0x61, 0x98, 0x1c, 0x89, 0x61, 0x56, 0x00,
-	0x15, 0x6d, 0x5b, 0x61, 0x8b, 0xf6, 0xd7, 0x44, 0xfb, 0xff, 0x35, 0x06, 0x6e, 0xed, 0x53, 0x8e,
-	0x49, 0x7d, 0x97, 0x36, 0x51, 0xa0, 0x27, 0xc1, 0x8d, 0x06, 0xe5, 0x28, 0x90, 0x7d, 0x6f, 0xca,
-	0x81, 0xfe, 0x0d, 0x48, 0xb6, 0x6f, 0x9a, 0x86, 0x30, 0xb6, 0xfc, 0xc8, 0x7a, 0xc4, 0x2e, 0xd6,
-	0x15, 0x56, 0x2f, 0xaf, 0x07, 0x96, 0x07, 0xae, 0xb4, 0x3e, 0xa2, 0xf1, 0x91, 0x88, 0x52, 0x6e,
-	0xef, 0x55, 0xd8, 0x4b, 0xe7, 0x80, 0x85, 0xee, 0x4d, 0xd6, 0xc7, 0x14, 0x1f, 0x89, 0x29, 0xd9,
-	0x41, 0xeb, 0x61, 0xe9, 0x9e, 0x32, 0x1f, 0xfe, 0xa6, 0x81, 0xd9, 0x81, 0xf3, 0x52, 0xff, 0x14,
-	0xdc, 0xdd, 0x2f, 0x6e, 0x97, 0x4b, 0xc5, 0xea, 0x97, 0xa6, 0x55, 0xa9, 0x16, 0xab, 0x7b, 0x15,
-	0x6b, 0x6f, 0xa7, 0xb2, 0xbb, 0xf9, 0xa0, 0xbc, 0x55, 0xde, 0x2c, 0x25, 0x62, 0xe9, 0xcc, 0xc9,
-	0x69, 0x2e, 0x3d, 0xe0, 0xb6, 0x47, 0x98, 0x8f, 0x6c, 0x7c, 0x80, 0x91, 0xa3, 0xdf, 0x07, 0x8b,
-	0x43, 0x08, 0xc5, 0x07, 0xd5, 0xf2, 0xfe, 0x66, 0x42, 0x4b, 0x2f, 0x9d, 0x9c, 0xe6, 0xe6, 0x07,
-	0x9c, 0x8b, 0x36, 0xc7, 0x0d, 0xa4, 0xaf, 0x83, 0xa5, 0x21, 0xbf, 0xf2, 0x8e, 0xf2, 0x1c, 0x4b,
-	0x2f, 0x9f, 0x9c, 0xe6, 0x16, 0x07, 0x3c, 0xcb, 0x04, 0x0a, 0xdf, 0x74, 0xfc, 0xc5, 0xcf, 0x99,
-	0xd8, 0xc6, 0xee, 0xeb, 0xb3, 0x8c, 0xf6, 0xe6, 0x2c, 0xa3, 0xfd, 0x79, 0x96, 0xd1, 0x7e, 0x38,
-	0xcf, 0xc4, 0xde, 0x9c, 0x67, 0x62, 0xbf, 0x9f, 0x67, 0x62, 0x8f, 0xef, 0x0f, 0x29, 0x16, 0x5d,
-	0x21, 0x2b, 0x2e, 0xac, 0x31, 0xf5, 0xf0, 0x7e, 0x3e, 0xf0, 0xf4, 0x16, 0x2a, 0xd6, 0x26, 0xc4,
-	0x63, 0xf5, 0x93, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x56, 0x54, 0x96, 0x10, 0x9e, 0x0b, 0x00,
-	0x00,
+	// 1725 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcb, 0x6f, 0x1b, 0xc7,
+	0x19, 0xe7, 0x52, 0xb4, 0x2c, 0x8d, 0xcc, 0x87, 0x56, 0x2f, 0x92, 0x52, 0x49, 0x62, 0x83, 0xa6,
+	0x82, 0x51, 0x93, 0x95, 0x0a, 0x04, 0x85, 0xd1, 0x43, 0x49, 0x49, 0x4e, 0xe8, 0xda, 0xb2, 0x3a,
+	0xa4, 0xec, 0x36, 0x30, 0xb2, 0x1d, 0xee, 0x0e, 0xa9, 0x8d, 0x76, 0x67, 0x36, 0x3b, 0x43, 0x49,
+	0xce, 0xa1, 0xd7, 0x04, 0xba, 0x34, 0xf0, 0xa9, 0x17, 0xa1, 0x41, 0x8b, 0x1e, 0xfb, 0x7f, 0xf8,
+	0x52, 0x20, 0xc7, 0xa0, 0x05, 0xd8, 0xc2, 0x2e, 0xd0, 0x9e, 0x75, 0xee, 0xa1, 0xd8, 0x99, 0xe1,
+	0x63, 0x29, 0x45, 0x81, 0x64, 0x5a, 0x17, 0x71, 0x1e, 0xdf, 0xef, 0xf7, 0xbd, 0xf6, 0xfb, 0xbe,
+	0x5d, 0x50, 0x61, 0x9f, 0x75, 0x91, 0x5d, 0x71, 0x9d, 0xcf, 0xba, 0x8e, 0xcd, 0x38, 0x3a, 0x74,
+	0x48, 0xa7, 0x72, 0xb4, 0xd1, 0xc2, 0x1c, 0x6d, 0x44, 0x77, 0xcb, 0x7e, 0x40, 0x39, 0xd5, 0x57,
+	0x85, 0x40, 0x39, 0x7a, 0xa4, 0x04, 0xf2, 0x8b, 0x1d, 0xda, 0xa1, 0xe2, 0x5e, 0x25, 0xfc, 0x25,
+	0x45, 0xf2, 0x39, 0x8b, 0x32, 0x8f, 0x32, 0x53, 0x1e, 0xc8, 0x85, 0x3a, 0x2a, 0xc8, 0x55, 0xa5,
+	0x85, 0x18, 0x1e, 0xd0, 0x5a, 0xd4, 0x21, 0xea, 0xbc, 0xd8, 0xa1, 0xb4, 0xe3, 0xe2, 0x8a, 0x58,
+	0xb5, 0xba, 0xed, 0x0a, 0x77, 0x3c, 0xcc, 0x38, 0xf2, 0x7c, 0x75, 0x41, 0xfe, 0xb3, 0xee, 0x75,
+	0x30, 0xb9, 0x47, 0x7d, 0x4c, 0x90, 0xef, 0x1c, 0x6d, 0x56, 0xa8, 0xcf, 0x1d, 0x4a, 0x58, 0x05,
+	0x11, 0x42, 0x39, 0x12, 0xbf, 0xe5, 0x45, 0xa3, 0x37, 0x0b, 0xa6, 0xf7, 0x50, 0x80, 0x3c, 0xa6,
+	0x7f, 0x04, 0xe6, 0xa5, 0x15, 0x66, 0x8b, 0x12, 0xdb, 0xb4, 0x31, 0xa1, 0x5e, 0x56, 0x2b, 0x69,
+	0xeb, 0xb3, 0xb5, 0xb5, 0xf3, 0x5e, 0x31, 0xfb, 0x02, 0x79, 0xee, 0x7d, 0xe3, 0xc2, 0x15, 0x03,
+	0xa6, 0xe5, 0x5e, 0x8d, 0x12, 0x7b, 0x3b, 0xdc, 0xd1, 0x7f, 0xaf, 0x81, 0xe5, 0xe3, 0x03, 0x87,
+	0x63, 0xd7, 0x61, 0x1c, 0xdb, 0xe6, 0x11, 0x72, 0x1d, 0x1b, 0x71, 0x1a, 0xb0, 0x6c, 0xbc, 0x34,
+	0xb5, 0x3e, 0xb7, 0xb9, 0x51, 0xbe, 0xc2, 0x6b, 0xe5, 0x67, 0x43, 0xd1, 0xa7, 0x7d, 0xc9, 0xda,
+	0x0f, 0x5f, 0xf5, 0x8a, 0xb1, 0xf3, 0x5e, 0xf1, 0x07, 0x52, 0x8d, 0xcb, 0xe1, 0x0d, 0xb8, 0x74,
+	0x7c, 0x89, 0x30, 0xd3, 0x19, 0xc8, 0x74, 0x49, 0xc8, 0x83, 0xcd, 0x36, 0xc6, 0x66, 0x80, 0x38,
+	0xce, 0x4e, 0x09, 0xd3, 0xea, 0x21, 0xee, 0xdf, 0x7b, 0xc5, 0xf7, 0x3b, 0x0e, 0x3f, 0xe8, 0xb6,
+	0xca, 0x16, 0xf5, 0x54, 0x48, 0xd4, 0xbf, 0x7b, 0xcc, 0x3e, 0xac, 0xf0, 0x17, 0x3e, 0x66, 0xe5,
+	0x6d, 0x6c, 0x9d, 0xf7, 0x8a, 0x2b, 0x52, 0x83, 0x71, 0x3c, 0x03, 0xa6, 0xd4, 0xd6, 0x03, 0x8c,
+	0x21, 0xe2, 0x58, 0xff, 0x8b, 0x06, 0x72, 0x9e, 0x43, 0x4c, 0xe5, 0x32, 0x65, 0xa6, 0x89, 0x3c,
+	0xda, 0x25, 0x3c, 0x7b, 0x4b, 0xd0, 0x7f, 0xfa, 0xb2, 0xba, 0xf4, 0x70, 0xd6, 0xd8, 0xf8, 0x89,
+	0xf8, 0x33, 0xfe, 0x14, 0xbf, 0xcd, 0xec, 0xc3, 0x72, 0x9d, 0xf0, 0x6b, 0xa8, 0x55, 0x27, 0xfc,
+	0xbc, 0x57, 0x2c, 0x49, 0xb5, 0xbe, 0x93, 0xd0, 0x80, 0xcb, 0x9e, 0x43, 0x1e, 0x89, 0xa3, 0x86,
+	0x3c, 0xa9, 0x8a, 0x83, 0x50, 0xcf, 0x55, 0x27, 0x54, 0x9d, 0x70, 0xb3, 0x6f, 0x55, 0xab, 0xdb,
+	0x6e, 0xe3, 0xc0, 0x64, 0xce, 0xe7, 0x38, 0x3b, 0x2d, 0x34, 0x6d, 0xbf, 0xac, 0xa6, 0x1f, 0x4e,
+	0x19, 0x6f, 0xa5, 0xa3, 0x21, 0x75, 0xbc, 0x82, 0xcc, 0x80, 0x59, 0x75, 0xba, 0x2f, 0x0f, 0x6b,
+	0xe2, 0xac, 0xe1, 0x7c, 0x8e, 0xf5, 0x53, 0x0d, 0x64, 0xc7, 0x45, 0x07, 0xd1, 0xbc, 0x2d, 0x94,
+	0xfc, 0xd5, 0xb5, 0xa3, 0x59, 0xbc, 0x5c, 0xa5, 0x61, 0x54, 0x97, 0xa2, 0xfa, 0xf4, 0x83, 0xeb,
+	0x82, 0xd4, 0x31, 0x76, 0x3a, 0x07, 0x3c, 0xf4, 0xb0, 0x47, 0x6d, 0x9c, 0x9d, 0x29, 0x69, 0xeb,
+	0xa9, 0xcd, 0xbb, 0x57, 0xa7, 0x76, 0x5f, 0xe4, 0x31, 0xb5, 0x71, 0x2d, 0x77, 0xde, 0x2b, 0x2e,
+	0xa9, 0x7c, 0x8e, 0x60, 0x19, 0x30, 0x79, 0x3c, 0x7a, 0x53, 0x6f, 0x83, 0x55, 0x1f, 0x07, 0x6d,
+	0x1a, 0x78, 0x88, 0x58, 0xd8, 0xec, 0xfa, 0x36, 0xe2, 0xd8, 0x74, 0x08, 0xc7, 0xc1, 0x11, 0x72,
+	0xb3, 0x73, 0x25, 0x6d, 0x3d, 0x51, 0x7b, 0x7f, 0xe8, 0xe1, 0x2b, 0x2e, 0x1b, 0x30, 0x37, 0x72,
+	0xba, 0x2f, 0x0e, 0xeb, 0xea, 0x4c, 0x3f, 0x00, 0x6b, 0x04, 0x73, 0x95, 0x31, 0x26, 0x23, 0xc8,
+	0x67, 0x07, 0x94, 0x0f, 0x89, 0x66, 0x05, 0xd1, 0x8f, 0xce, 0x7b, 0xc5, 0xf7, 0x24, 0xd1, 0x55,
+	0xb7, 0x0d, 0x98, 0x23, 0x98, 0xcb, 0x24, 0x6b, 0xa8, 0xc3, 0x01, 0xd3, 0x73, 0x90, 0xf5, 0xd0,
+	0x89, 0x79, 0x89, 0x3c, 0xcb, 0x82, 0x92, 0xb6, 0x9e, 0xac, 0xbd, 0x37, 0x8c, 0xce, 0x77, 0xdd,
+	0x34, 0xe0, 0x92, 0x87, 0x4e, 0x76, 0xc7, 0x49, 0xd8, 0xfd, 0x99, 0x2f, 0xbf, 0x2e, 0xc6, 0xfe,
+	0xf0, 0x75, 0x31, 0x66, 0xfc, 0x47, 0x03, 0x8b, 0x97, 0x15, 0x14, 0xbd, 0x0e, 0xe6, 0x07, 0x85,
+	0xc3, 0x44, 0xb6, 0x1d, 0x60, 0xc6, 0x2e, 0x96, 0xbb, 0x0b, 0x57, 0x0c, 0x98, 0x19, 0xec, 0x55,
+	0xe5, 0x96, 0xfe, 0x3b, 0x90, 0xe4, 0x28, 0xe8, 0x60, 0x6e, 0xca, 0xa8, 0x65, 0xe3, 0x02, 0xe6,
+	0x37, 0x2f, 0xab, 0x99, 0x87, 0x09, 0x63, 0xe3, 0xad, 0x1e, 0x99, 0x45, 0xa9, 0x47, 0x04, 0xdf,
+	0x80, 0x77, 0xe4, 0x5a, 0xa6, 0xd3, 0xfd, 0x44, 0x68, 0xad, 0x61, 0x81, 0xb4, 0x7c, 0xba, 0x87,
+	0x36, 0x3e, 0x00, 0x19, 0xea, 0xe3, 0xe0, 0x12, 0x13, 0x57, 0x87, 0x85, 0x6c, 0xfc, 0x86, 0x01,
+	0xd3, 0xfd, 0x2d, 0x65, 0xa0, 0x74, 0xe7, 0x7f, 0x43, 0x92, 0x6f, 0x35, 0xb0, 0x30, 0xc0, 0xdf,
+	0x0b, 0x70, 0x1b, 0x07, 0x98, 0x58, 0x38, 0xf4, 0xa6, 0x8d, 0x5d, 0xdc, 0xb9, 0xda, 0x9b, 0x17,
+	0xae, 0x18, 0x30, 0x33, 0xd8, 0xeb, 0x7b, 0xd3, 0x05, 0x60, 0x12, 0x0d, 0x23, 0xa7, 0x1a, 0xc6,
+	0xfc, 0x58, 0x20, 0x99, 0x01, 0x47, 0xf0, 0x47, 0x4c, 0xfb, 0x87, 0x06, 0x16, 0xa4, 0x45, 0x01,
+	0xb6, 0xb7, 0xa5, 0x56, 0x0e, 0x25, 0x93, 0x4c, 0x14, 0x0a, 0xa6, 0x55, 0xf5, 0x97, 0x19, 0xf2,
+	0x6c, 0x62, 0xd5, 0x3f, 0x29, 0xb5, 0xe8, 0x97, 0x7a, 0x45, 0x33, 0x62, 0xdd, 0xab, 0x04, 0x58,
+	0x1c, 0x4b, 0x8f, 0x06, 0x0f, 0x0b, 0xd9, 0x84, 0x72, 0x44, 0xff, 0x14, 0x4c, 0x47, 0xb2, 0x1f,
+	0x4e, 0x22, 0xfb, 0x93, 0xa3, 0xd5, 0xd1, 0x80, 0x8a, 0x41, 0xdf, 0x06, 0xd3, 0x8c, 0x23, 0xde,
+	0x65, 0xa2, 0x89, 0xa7, 0x36, 0x7f, 0x7c, 0x65, 0x7a, 0x44, 0x0c, 0xee, 0x32, 0xa8, 0x64, 0xf5,
+	0xc7, 0x00, 0xd8, 0xd8, 0x35, 0xd9, 0x01, 0x0a, 0x30, 0xcb, 0x26, 0x84, 0xd6, 0xe5, 0xeb, 0x35,
+	0x10, 0x38, 0x6b, 0x63, 0xb7, 0x21, 0x00, 0xf4, 0x06, 0x48, 0xaa, 0xc6, 0xcb, 0xe9, 0x21, 0x26,
+	0x4c, 0x75, 0xf8, 0xf2, 0xf5, 0x8c, 0x86, 0x77, 0x24, 0x48, 0x53, 0x60, 0xe8, 0x5f, 0x68, 0x20,
+	0x83, 0xdb, 0x6d, 0x6c, 0x71, 0xe7, 0x08, 0xf7, 0xcb, 0x8b, 0x6c, 0xc8, 0xcf, 0x27, 0xe1, 0x60,
+	0x15, 0xdf, 0x71, 0x0a, 0x03, 0xa6, 0x07, 0x5b, 0xaa, 0xc8, 0x0c, 0x53, 0xe9, 0x7f, 0xb7, 0x40,
+	0x6a, 0x58, 0x73, 0x45, 0x12, 0xfd, 0x12, 0xcc, 0x7a, 0x0e, 0xe1, 0xb2, 0x15, 0x6b, 0x37, 0xf2,
+	0xe4, 0x4c, 0x08, 0x20, 0x5a, 0xeb, 0x27, 0x60, 0xa1, 0x25, 0x5c, 0x68, 0x72, 0xca, 0x91, 0x6b,
+	0xb2, 0xae, 0xef, 0xbb, 0x2f, 0x54, 0x5a, 0x5d, 0xd7, 0x9d, 0xf3, 0x12, 0xaa, 0x19, 0x22, 0x35,
+	0x04, 0x50, 0x18, 0xf7, 0x61, 0x33, 0x51, 0x63, 0xe0, 0xb5, 0xe3, 0x3e, 0xe8, 0x6c, 0xfa, 0xaf,
+	0x41, 0x46, 0xea, 0xf9, 0xd6, 0xc9, 0x94, 0x12, 0x38, 0xdb, 0x83, 0x8c, 0xfa, 0x04, 0x2c, 0x48,
+	0xe4, 0x49, 0xe4, 0xd5, 0xbc, 0x80, 0x7a, 0x34, 0x9a, 0x5c, 0x6d, 0xb0, 0x22, 0xf1, 0x03, 0xec,
+	0x21, 0x87, 0x84, 0xd3, 0x47, 0x80, 0x8f, 0x51, 0x60, 0x33, 0x95, 0x62, 0xd7, 0x35, 0x60, 0x49,
+	0xc0, 0xc1, 0x3e, 0x1a, 0x94, 0x60, 0x43, 0x9e, 0x2e, 0x09, 0xdf, 0x1b, 0x42, 0x9e, 0x16, 0x72,
+	0xc3, 0xf1, 0x43, 0x8d, 0x6d, 0xd7, 0xb5, 0x45, 0xf2, 0xec, 0xf7, 0xd1, 0x6a, 0x12, 0x4c, 0xff,
+	0x18, 0xcc, 0xfb, 0x01, 0x3d, 0x79, 0x61, 0x22, 0xcb, 0x1a, 0x30, 0xcc, 0xdc, 0x88, 0x21, 0x2d,
+	0x80, 0xaa, 0x96, 0xa5, 0xb0, 0x45, 0xfa, 0x6b, 0x22, 0xfd, 0xff, 0x1d, 0x07, 0x73, 0x4f, 0x69,
+	0x38, 0x9a, 0xed, 0xd1, 0x63, 0x1c, 0xe8, 0x8b, 0xe0, 0xd6, 0x11, 0xe5, 0x38, 0x90, 0x79, 0x0f,
+	0xe5, 0x42, 0xff, 0x2d, 0x58, 0xec, 0xcf, 0xdf, 0x47, 0xe2, 0xb2, 0xe9, 0x87, 0xb7, 0x6f, 0x98,
+	0xc5, 0xba, 0xc2, 0x1a, 0xe5, 0xf5, 0xc0, 0xea, 0xd8, 0xa0, 0x1f, 0x21, 0x9a, 0xba, 0x11, 0x51,
+	0xd6, 0x1d, 0x7d, 0x41, 0x18, 0xa5, 0xb3, 0xc1, 0xf2, 0xb0, 0xc7, 0x45, 0x98, 0x12, 0x37, 0x62,
+	0x5a, 0x1c, 0xa0, 0x8d, 0xb0, 0x8c, 0x54, 0x99, 0x3f, 0x4e, 0x81, 0xa4, 0x9a, 0xb9, 0x9b, 0x8e,
+	0x75, 0x88, 0xb9, 0x9e, 0x02, 0x71, 0xc7, 0x16, 0x5e, 0x4e, 0xc0, 0xb8, 0x63, 0x5f, 0x3e, 0x73,
+	0xc4, 0x6f, 0x34, 0x73, 0x3c, 0x07, 0x49, 0x55, 0x72, 0x5a, 0xdd, 0x80, 0x60, 0x5b, 0x78, 0x6f,
+	0x6e, 0x33, 0x57, 0x56, 0x6f, 0xe7, 0xe1, 0xfb, 0xf8, 0xa0, 0x9f, 0x6c, 0x51, 0x87, 0xd4, 0xd6,
+	0xd4, 0x78, 0xa1, 0xe6, 0xb3, 0x88, 0xb4, 0x01, 0xef, 0xc8, 0x75, 0x4d, 0x2c, 0xf5, 0x16, 0x48,
+	0xe3, 0x13, 0x1f, 0x5b, 0xe1, 0xcb, 0xaa, 0xaa, 0x3a, 0x89, 0xef, 0xc3, 0x2f, 0x28, 0xfc, 0x65,
+	0x55, 0xa0, 0xa3, 0xf2, 0x06, 0x4c, 0xf5, 0x77, 0x54, 0x15, 0xea, 0x80, 0xb4, 0x45, 0x3d, 0xdf,
+	0xc5, 0xe1, 0xcc, 0x62, 0x72, 0xc7, 0xc3, 0xa2, 0x4e, 0xcc, 0x6d, 0xe6, 0xcb, 0xf2, 0x9b, 0x41,
+	0xb9, 0xff, 0xcd, 0xa0, 0xdc, 0xec, 0x7f, 0x33, 0xa8, 0x19, 0x51, 0x92, 0x31, 0x00, 0xe3, 0xab,
+	0x7f, 0x16, 0x35, 0x98, 0x1a, 0xee, 0x86, 0x82, 0x23, 0x11, 0xfa, 0x6b, 0x02, 0xcc, 0x5f, 0x98,
+	0xbd, 0xf5, 0x65, 0x30, 0x7d, 0x20, 0xdb, 0x54, 0x18, 0xa9, 0x29, 0xa8, 0x56, 0xfa, 0xcf, 0x40,
+	0x42, 0x68, 0x15, 0xff, 0x5e, 0xad, 0x66, 0x42, 0xad, 0x04, 0xb7, 0x90, 0x88, 0x36, 0x97, 0xa9,
+	0x77, 0xd3, 0x5c, 0x12, 0xef, 0xa6, 0xb9, 0xdc, 0x7a, 0x17, 0xcd, 0x65, 0x7a, 0x22, 0xcd, 0xe5,
+	0x8a, 0xe2, 0x7f, 0x7b, 0x82, 0xc5, 0x7f, 0x98, 0x2f, 0x77, 0xff, 0xa6, 0x81, 0xf4, 0xd8, 0x2c,
+	0xa6, 0xff, 0x02, 0xac, 0x3d, 0xad, 0x3e, 0xaa, 0x6f, 0x57, 0x9b, 0x4f, 0xa0, 0xd9, 0x68, 0x56,
+	0x9b, 0xfb, 0x0d, 0x73, 0x7f, 0xb7, 0xb1, 0xb7, 0xb3, 0x55, 0x7f, 0x50, 0xdf, 0xd9, 0xce, 0xc4,
+	0xf2, 0x85, 0xd3, 0xb3, 0x52, 0x7e, 0x4c, 0x6c, 0x9f, 0x30, 0x1f, 0x5b, 0x4e, 0xdb, 0xc1, 0xb6,
+	0xfe, 0x01, 0x58, 0xb9, 0x80, 0x50, 0xdd, 0x6a, 0xd6, 0x9f, 0xee, 0x64, 0xb4, 0x7c, 0xee, 0xf4,
+	0xac, 0xb4, 0x34, 0x26, 0x5c, 0x15, 0x53, 0x8d, 0x7e, 0x1f, 0xe4, 0x2e, 0xc8, 0xd5, 0x77, 0x95,
+	0x64, 0x3c, 0xbf, 0x7a, 0x7a, 0x56, 0x5a, 0x19, 0x93, 0xac, 0x13, 0x24, 0x64, 0xf3, 0x89, 0x2f,
+	0xff, 0x5c, 0x88, 0xdd, 0xfd, 0x42, 0x03, 0xc9, 0xc8, 0x0b, 0xbd, 0xbe, 0x09, 0x96, 0x9e, 0xed,
+	0xd4, 0x3f, 0xfc, 0xa8, 0x59, 0xdf, 0xfd, 0xd0, 0x7c, 0xfc, 0x64, 0x7b, 0x47, 0x00, 0xd7, 0xb7,
+	0x32, 0xb1, 0xfc, 0xca, 0xe9, 0x59, 0x69, 0x21, 0x72, 0x3b, 0xc4, 0x74, 0x2c, 0xfd, 0xe7, 0x20,
+	0x3f, 0x26, 0xb3, 0xb7, 0x03, 0x1f, 0x3c, 0x81, 0x8f, 0xab, 0xbb, 0x5b, 0xa1, 0x09, 0x6b, 0xa7,
+	0x67, 0xa5, 0x6c, 0x44, 0x70, 0x6f, 0xf8, 0xfa, 0x2e, 0x35, 0xa9, 0xed, 0xbd, 0x7a, 0x5d, 0xd0,
+	0xbe, 0x79, 0x5d, 0xd0, 0xfe, 0xf5, 0xba, 0xa0, 0x7d, 0xf5, 0xa6, 0x10, 0xfb, 0xe6, 0x4d, 0x21,
+	0xf6, 0xed, 0x9b, 0x42, 0xec, 0xe3, 0x0f, 0x2e, 0x04, 0x2f, 0x1c, 0x94, 0xef, 0xb9, 0xa8, 0xc5,
+	0xd4, 0xa7, 0xce, 0x93, 0xb1, 0x8f, 0x9d, 0x22, 0xa0, 0xad, 0x69, 0xf1, 0x5c, 0xfe, 0xf4, 0xff,
+	0x01, 0x00, 0x00, 0xff, 0xff, 0xe6, 0x23, 0x8d, 0x46, 0x10, 0x15, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -450,6 +734,46 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PerformanceUpdateInterval != 0 {
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(m.PerformanceUpdateInterval))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MaxNetAmountSnapshots != 0 {
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(m.MaxNetAmountSnapshots))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.NetAmountSnapshotInterval != 0 {
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(m.NetAmountSnapshotInterval))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.WeightingMode != 0 {
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(m.WeightingMode))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.InstantUnstakeFeeRate.Size()
+		i -= size
+		if _, err := m.InstantUnstakeFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.InstantUnstakeBufferSize.Size()
+		i -= size
+		if _, err := m.InstantUnstakeBufferSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	{
 		size := m.MinLiquidStakingAmount.Size()
 		i -= size
@@ -564,6 +888,90 @@ func (m *LiquidValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorPreference) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPreference) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPreference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PreferredDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PreferredDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PreferredDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *LiquidValidatorState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -584,6 +992,16 @@ func (m *LiquidValidatorState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.EffectiveWeight.Size()
+		i -= size
+		if _, err := m.EffectiveWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	{
 		size := m.LiquidTokens.Size()
 		i -= size
@@ -792,39 +1210,204 @@ func (m *VotingPower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintLiquidstaking(dAtA []byte, offset int, v uint64) int {
-	offset -= sovLiquidstaking(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *UnstakeTicket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *UnstakeTicket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnstakeTicket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.LiquidBondDenom)
-	if l > 0 {
-		n += 1 + l + sovLiquidstaking(uint64(l))
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err1 != nil {
+		return 0, err1
 	}
-	if len(m.WhitelistedValidators) > 0 {
-		for _, e := range m.WhitelistedValidators {
-			l = e.Size()
-			n += 1 + l + sovLiquidstaking(uint64(l))
+	i -= n1
+	i = encodeVarintLiquidstaking(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.ExpectedAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
 	}
-	l = m.UnstakeFeeRate.Size()
-	n += 1 + l + sovLiquidstaking(uint64(l))
-	l = m.MinLiquidStakingAmount.Size()
-	n += 1 + l + sovLiquidstaking(uint64(l))
-	return n
-}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.BtokenBurned.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NetAmountSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NetAmountSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NetAmountSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalRemainingRewards.Size()
+		i -= size
+		if _, err := m.TotalRemainingRewards.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.TotalDelShares.Size()
+		i -= size
+		if _, err := m.TotalDelShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.NetAmount.Size()
+		i -= size
+		if _, err := m.NetAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.BtokenTotalSupply.Size()
+		i -= size
+		if _, err := m.BtokenTotalSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MintRate.Size()
+		i -= size
+		if _, err := m.MintRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintLiquidstaking(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLiquidstaking(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLiquidstaking(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LiquidBondDenom)
+	if l > 0 {
+		n += 1 + l + sovLiquidstaking(uint64(l))
+	}
+	if len(m.WhitelistedValidators) > 0 {
+		for _, e := range m.WhitelistedValidators {
+			l = e.Size()
+			n += 1 + l + sovLiquidstaking(uint64(l))
+		}
+	}
+	l = m.UnstakeFeeRate.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.MinLiquidStakingAmount.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.InstantUnstakeBufferSize.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.InstantUnstakeFeeRate.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	if m.WeightingMode != 0 {
+		n += 1 + sovLiquidstaking(uint64(m.WeightingMode))
+	}
+	if m.NetAmountSnapshotInterval != 0 {
+		n += 1 + sovLiquidstaking(uint64(m.NetAmountSnapshotInterval))
+	}
+	if m.MaxNetAmountSnapshots != 0 {
+		n += 1 + sovLiquidstaking(uint64(m.MaxNetAmountSnapshots))
+	}
+	if m.PerformanceUpdateInterval != 0 {
+		n += 1 + sovLiquidstaking(uint64(m.PerformanceUpdateInterval))
+	}
+	return n
+}
 
 func (m *WhitelistedValidator) Size() (n int) {
 	if m == nil {
@@ -854,6 +1437,40 @@ func (m *LiquidValidator) Size() (n int) {
 	return n
 }
 
+func (m *ValidatorPreference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstaking(uint64(l))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovLiquidstaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PreferredDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstaking(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	return n
+}
+
 func (m *LiquidValidatorState) Size() (n int) {
 	if m == nil {
 		return 0
@@ -873,6 +1490,8 @@ func (m *LiquidValidatorState) Size() (n int) {
 	n += 1 + l + sovLiquidstaking(uint64(l))
 	l = m.LiquidTokens.Size()
 	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.EffectiveWeight.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
 	return n
 }
 
@@ -920,13 +1539,817 @@ func (m *VotingPower) Size() (n int) {
 	return n
 }
 
-func sovLiquidstaking(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozLiquidstaking(x uint64) (n int) {
-	return sovLiquidstaking(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *UnstakeTicket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovLiquidstaking(uint64(m.Id))
+	}
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstaking(uint64(l))
+	}
+	l = m.BtokenBurned.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.ExpectedAmount.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	return n
+}
+
+func (m *NetAmountSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovLiquidstaking(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.MintRate.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.BtokenTotalSupply.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.NetAmount.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.TotalDelShares.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.TotalRemainingRewards.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	return n
+}
+
+func sovLiquidstaking(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLiquidstaking(x uint64) (n int) {
+	return sovLiquidstaking(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidBondDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidBondDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistedValidators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WhitelistedValidators = append(m.WhitelistedValidators, WhitelistedValidator{})
+			if err := m.WhitelistedValidators[len(m.WhitelistedValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnstakeFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnstakeFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinLiquidStakingAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinLiquidStakingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantUnstakeBufferSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InstantUnstakeBufferSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantUnstakeFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InstantUnstakeFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightingMode", wireType)
+			}
+			m.WeightingMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WeightingMode |= WeightingMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAmountSnapshotInterval", wireType)
+			}
+			m.NetAmountSnapshotInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NetAmountSnapshotInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNetAmountSnapshots", wireType)
+			}
+			m.MaxNetAmountSnapshots = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNetAmountSnapshots |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerformanceUpdateInterval", wireType)
+			}
+			m.PerformanceUpdateInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerformanceUpdateInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WhitelistedValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WhitelistedValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WhitelistedValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorPreference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorPreference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorPreference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, WhitelistedValidator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PreferredDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PreferredDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PreferredDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *LiquidValidatorState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -949,15 +2372,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: LiquidValidatorState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LiquidValidatorState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidBondDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -985,13 +2408,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LiquidBondDenom = string(dAtA[iNdEx:postIndex])
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistedValidators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstaking
@@ -1001,29 +2424,48 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLiquidstaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WhitelistedValidators = append(m.WhitelistedValidators, WhitelistedValidator{})
-			if err := m.WhitelistedValidators[len(m.WhitelistedValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ValidatorStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnstakeFeeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelShares", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1051,13 +2493,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UnstakeFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DelShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinLiquidStakingAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidTokens", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1085,7 +2527,41 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinLiquidStakingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LiquidTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EffectiveWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1110,7 +2586,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WhitelistedValidator) Unmarshal(dAtA []byte) error {
+func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1133,15 +2609,15 @@ func (m *WhitelistedValidator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WhitelistedValidator: wiretype end group for non-group")
+			return fmt.Errorf("proto: NetAmountState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WhitelistedValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NetAmountState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MintRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1169,11 +2645,13 @@ func (m *WhitelistedValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.MintRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetWeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BtokenTotalSupply", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1201,63 +2679,13 @@ func (m *WhitelistedValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TargetWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BtokenTotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLiquidstaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthLiquidstaking
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LiquidValidator) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLiquidstaking
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidValidator: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidValidator: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1285,61 +2713,13 @@ func (m *LiquidValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLiquidstaking(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.NetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthLiquidstaking
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LiquidValidatorState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLiquidstaking
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidValidatorState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidValidatorState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelShares", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1367,11 +2747,13 @@ func (m *LiquidValidatorState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.TotalDelShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLiquidTokens", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1399,15 +2781,15 @@ func (m *LiquidValidatorState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalLiquidTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRemainingRewards", wireType)
 			}
-			m.Status = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstaking
@@ -1417,14 +2799,29 @@ func (m *LiquidValidatorState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= ValidatorStatus(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalRemainingRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalUnbondingBalance", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1452,13 +2849,13 @@ func (m *LiquidValidatorState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DelShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalUnbondingBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyAccBalance", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1486,7 +2883,7 @@ func (m *LiquidValidatorState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LiquidTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ProxyAccBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1511,7 +2908,7 @@ func (m *LiquidValidatorState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NetAmountState) Unmarshal(dAtA []byte) error {
+func (m *VotingPower) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1534,15 +2931,15 @@ func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NetAmountState: wiretype end group for non-group")
+			return fmt.Errorf("proto: VotingPower: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NetAmountState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VotingPower: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1570,13 +2967,11 @@ func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MintRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtokenTotalSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingVotingPower", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1604,13 +2999,13 @@ func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BtokenTotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StakingVotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidStakingVotingPower", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1638,13 +3033,13 @@ func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LiquidStakingVotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorVotingPower", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1672,13 +3067,82 @@ func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalDelShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ValidatorVotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnstakeTicket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnstakeTicket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnstakeTicket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalLiquidTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1706,15 +3170,13 @@ func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalLiquidTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalRemainingRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BtokenBurned", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstaking
@@ -1724,31 +3186,30 @@ func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLiquidstaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalRemainingRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BtokenBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalUnbondingBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedAmount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstaking
@@ -1758,31 +3219,30 @@ func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLiquidstaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalUnbondingBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExpectedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProxyAccBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstaking
@@ -1792,23 +3252,22 @@ func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLiquidstaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProxyAccBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1833,7 +3292,7 @@ func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VotingPower) Unmarshal(dAtA []byte) error {
+func (m *NetAmountSnapshot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1856,15 +3315,67 @@ func (m *VotingPower) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VotingPower: wiretype end group for non-group")
+			return fmt.Errorf("proto: NetAmountSnapshot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VotingPower: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NetAmountSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1892,11 +3403,13 @@ func (m *VotingPower) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
+			if err := m.MintRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingVotingPower", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BtokenTotalSupply", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1924,13 +3437,13 @@ func (m *VotingPower) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StakingVotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BtokenTotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidStakingVotingPower", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1958,13 +3471,13 @@ func (m *VotingPower) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LiquidStakingVotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorVotingPower", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelShares", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1992,7 +3505,41 @@ func (m *VotingPower) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ValidatorVotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalDelShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRemainingRewards", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalRemainingRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -453,3 +453,28 @@ func (s *KeeperTestSuite) TestLiquidStake() {
 	alt, _ := avs.TotalActiveLiquidTokens(s.ctx, s.app.StakingKeeper, true)
 	s.Require().EqualValues(alt, sdk.NewInt(40001))
 }
+
+func TestPerformanceScore(t *testing.T) {
+	for _, tc := range []struct {
+		name               string
+		missedBlocks       int64
+		signedBlocksWindow int64
+		commissionRate     sdk.Dec
+		jailed             bool
+		expected           sdk.Dec
+	}{
+		{"perfect", 0, 100, sdk.ZeroDec(), false, sdk.OneDec()},
+		{"missed blocks", 25, 100, sdk.ZeroDec(), false, sdk.NewDecWithPrec(75, 2)},
+		{"commission", 0, 100, sdk.NewDecWithPrec(1, 1), false, sdk.NewDecWithPrec(9, 1)},
+		{"missed blocks and commission", 50, 100, sdk.NewDecWithPrec(2, 1), false, sdk.NewDecWithPrec(4, 1)},
+		{"no signed blocks window", 50, 0, sdk.ZeroDec(), false, sdk.OneDec()},
+		{"jailed", 0, 100, sdk.ZeroDec(), true, sdk.ZeroDec()},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			score := types.PerformanceScore(tc.missedBlocks, tc.signedBlocksWindow, tc.commissionRate, tc.jailed)
+			require.True(t, tc.expected.Equal(score), score.String())
+		})
+	}
+
+	require.EqualValues(t, sdk.NewInt(7500000), types.PerformanceWeight(sdk.NewInt(10), sdk.NewDecWithPrec(75, 2)))
+}
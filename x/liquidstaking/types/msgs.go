@@ -8,12 +8,16 @@ import (
 var (
 	_ sdk.Msg = (*MsgLiquidStake)(nil)
 	_ sdk.Msg = (*MsgLiquidUnstake)(nil)
+	_ sdk.Msg = (*MsgInstantLiquidUnstake)(nil)
+	_ sdk.Msg = (*MsgSetValidatorPreference)(nil)
 )
 
 // Message types for the liquidstaking module
 const (
-	TypeMsgLiquidStake   = "liquid_stake"
-	TypeMsgLiquidUnstake = "liquid_unstake"
+	TypeMsgLiquidStake            = "liquid_stake"
+	TypeMsgLiquidUnstake          = "liquid_unstake"
+	TypeMsgInstantLiquidUnstake   = "instant_liquid_unstake"
+	TypeMsgSetValidatorPreference = "set_validator_preference"
 )
 
 // NewMsgLiquidStake creates a new MsgLiquidStake.
@@ -111,3 +115,96 @@ func (msg MsgLiquidUnstake) GetDelegator() sdk.AccAddress {
 	}
 	return addr
 }
+
+// NewMsgInstantLiquidUnstake creates a new MsgInstantLiquidUnstake.
+func NewMsgInstantLiquidUnstake(
+	liquidStaker sdk.AccAddress,
+	amount sdk.Coin,
+) *MsgInstantLiquidUnstake {
+	return &MsgInstantLiquidUnstake{
+		DelegatorAddress: liquidStaker.String(),
+		Amount:           amount,
+	}
+}
+
+func (msg MsgInstantLiquidUnstake) Route() string { return RouterKey }
+
+func (msg MsgInstantLiquidUnstake) Type() string { return TypeMsgInstantLiquidUnstake }
+
+func (msg MsgInstantLiquidUnstake) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid delegator address %q: %v", msg.DelegatorAddress, err)
+	}
+	if ok := msg.Amount.IsZero(); ok {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unstaking amount must not be zero")
+	}
+	if err := msg.Amount.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (msg MsgInstantLiquidUnstake) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgInstantLiquidUnstake) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgInstantLiquidUnstake) GetDelegator() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgSetValidatorPreference creates a new MsgSetValidatorPreference.
+func NewMsgSetValidatorPreference(
+	liquidStaker sdk.AccAddress,
+	validators []WhitelistedValidator,
+) *MsgSetValidatorPreference {
+	return &MsgSetValidatorPreference{
+		DelegatorAddress: liquidStaker.String(),
+		Validators:       validators,
+	}
+}
+
+func (msg MsgSetValidatorPreference) Route() string { return RouterKey }
+
+func (msg MsgSetValidatorPreference) Type() string { return TypeMsgSetValidatorPreference }
+
+func (msg MsgSetValidatorPreference) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid delegator address %q: %v", msg.DelegatorAddress, err)
+	}
+	if err := validateWhitelistedValidators(msg.Validators); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+func (msg MsgSetValidatorPreference) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSetValidatorPreference) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgSetValidatorPreference) GetDelegator() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"fmt"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -89,3 +90,98 @@ func TestMsgLiquidUnstake(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgInstantLiquidUnstake(t *testing.T) {
+	delegatorAddr := sdk.AccAddress(crypto.AddressHash([]byte("delegatorAddr")))
+	stakingCoin := sdk.NewCoin("btoken", sdk.NewInt(1))
+
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgInstantLiquidUnstake
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgInstantLiquidUnstake(delegatorAddr, stakingCoin),
+		},
+		{
+			"invalid delegator address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgInstantLiquidUnstake(sdk.AccAddress{}, stakingCoin),
+		},
+		{
+			"unstaking amount must not be zero: invalid request",
+			types.NewMsgInstantLiquidUnstake(delegatorAddr, sdk.NewCoin("btoken", sdk.NewInt(0))),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgInstantLiquidUnstake{}, tc.msg)
+		require.Equal(t, types.TypeMsgInstantLiquidUnstake, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(types.ModuleCdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetDelegator(), signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgSetValidatorPreference(t *testing.T) {
+	delegatorAddr := sdk.AccAddress(crypto.AddressHash([]byte("delegatorAddr")))
+	valAddr := sdk.ValAddress(crypto.AddressHash([]byte("valAddr")))
+
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgSetValidatorPreference
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgSetValidatorPreference(delegatorAddr, []types.WhitelistedValidator{
+				{ValidatorAddress: valAddr.String(), TargetWeight: sdk.NewInt(1)},
+			}),
+		},
+		{
+			"", // empty preference removes the validator preference
+			types.NewMsgSetValidatorPreference(delegatorAddr, nil),
+		},
+		{
+			"invalid delegator address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgSetValidatorPreference(sdk.AccAddress{}, nil),
+		},
+		{
+			"liquidstaking validator target weight must be positive: 0: invalid request",
+			types.NewMsgSetValidatorPreference(delegatorAddr, []types.WhitelistedValidator{
+				{ValidatorAddress: valAddr.String(), TargetWeight: sdk.ZeroInt()},
+			}),
+		},
+		{
+			fmt.Sprintf("liquidstaking validator cannot be duplicated: %s: invalid request", valAddr),
+			types.NewMsgSetValidatorPreference(delegatorAddr, []types.WhitelistedValidator{
+				{ValidatorAddress: valAddr.String(), TargetWeight: sdk.NewInt(1)},
+				{ValidatorAddress: valAddr.String(), TargetWeight: sdk.NewInt(2)},
+			}),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgSetValidatorPreference{}, tc.msg)
+		require.Equal(t, types.TypeMsgSetValidatorPreference, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(types.ModuleCdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetDelegator(), signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
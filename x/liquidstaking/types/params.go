@@ -14,10 +14,16 @@ import (
 
 // Parameter store keys
 var (
-	KeyLiquidBondDenom        = []byte("LiquidBondDenom")
-	KeyWhitelistedValidators  = []byte("WhitelistedValidators")
-	KeyUnstakeFeeRate         = []byte("UnstakeFeeRate")
-	KeyMinLiquidStakingAmount = []byte("MinLiquidStakingAmount")
+	KeyLiquidBondDenom           = []byte("LiquidBondDenom")
+	KeyWhitelistedValidators     = []byte("WhitelistedValidators")
+	KeyUnstakeFeeRate            = []byte("UnstakeFeeRate")
+	KeyMinLiquidStakingAmount    = []byte("MinLiquidStakingAmount")
+	KeyInstantUnstakeBufferSize  = []byte("InstantUnstakeBufferSize")
+	KeyInstantUnstakeFeeRate     = []byte("InstantUnstakeFeeRate")
+	KeyWeightingMode             = []byte("WeightingMode")
+	KeyPerformanceUpdateInterval = []byte("PerformanceUpdateInterval")
+	KeyNetAmountSnapshotInterval = []byte("NetAmountSnapshotInterval")
+	KeyMaxNetAmountSnapshots     = []byte("MaxNetAmountSnapshots")
 
 	DefaultLiquidBondDenom = "bstake"
 
@@ -27,6 +33,24 @@ var (
 	// DefaultMinLiquidStakingAmount is the default minimum liquid staking amount.
 	DefaultMinLiquidStakingAmount = sdk.NewInt(1000000)
 
+	// DefaultInstantUnstakeBufferSize is the default instant unstake buffer size, which disables instant unstaking.
+	DefaultInstantUnstakeBufferSize = sdk.ZeroInt()
+
+	// DefaultInstantUnstakeFeeRate is the default instant unstake fee rate.
+	DefaultInstantUnstakeFeeRate = sdk.NewDecWithPrec(5, 3) // "0.005000000000000000"
+
+	// DefaultWeightingMode is the default weighting mode, which uses the target weights as they are.
+	DefaultWeightingMode = WeightingModeStatic
+
+	// DefaultPerformanceUpdateInterval is the default number of blocks between the performance score updates.
+	DefaultPerformanceUpdateInterval = uint64(14400)
+
+	// DefaultNetAmountSnapshotInterval is the default number of blocks between the net amount snapshots.
+	DefaultNetAmountSnapshotInterval = uint64(14400)
+
+	// DefaultMaxNetAmountSnapshots is the default maximum number of the net amount snapshots kept in the history.
+	DefaultMaxNetAmountSnapshots = uint32(90)
+
 	// Const variables
 
 	// RebalancingTrigger if the maximum difference and needed each redelegation amount exceeds it, asset rebalacing will be executed.
@@ -35,6 +59,10 @@ var (
 	// RewardTrigger If the sum of balance and the upcoming rewards of LiquidStakingProxyAcc exceeds it, the reward is automatically withdrawn and re-stake according to the weights.
 	RewardTrigger = sdk.NewDecWithPrec(1, 3) // "0.001000000000000000"
 
+	// PerformanceWeightPrecision is multiplied to the target weights on the performance weighting mode
+	// to keep the precision of the performance scores in the effective weights.
+	PerformanceWeightPrecision = sdk.NewInt(1000000)
+
 	// LiquidStakingProxyAcc is a proxy reserve account for delegation and undelegation.
 	LiquidStakingProxyAcc = farmingtypes.DeriveAddress(farmingtypes.AddressType32Bytes, ModuleName, "LiquidStakingProxyAcc")
 )
@@ -49,10 +77,16 @@ func ParamKeyTable() paramstypes.KeyTable {
 // DefaultParams returns the default liquidstaking module parameters.
 func DefaultParams() Params {
 	return Params{
-		WhitelistedValidators:  []WhitelistedValidator{},
-		LiquidBondDenom:        DefaultLiquidBondDenom,
-		UnstakeFeeRate:         DefaultUnstakeFeeRate,
-		MinLiquidStakingAmount: DefaultMinLiquidStakingAmount,
+		WhitelistedValidators:     []WhitelistedValidator{},
+		LiquidBondDenom:           DefaultLiquidBondDenom,
+		UnstakeFeeRate:            DefaultUnstakeFeeRate,
+		MinLiquidStakingAmount:    DefaultMinLiquidStakingAmount,
+		InstantUnstakeBufferSize:  DefaultInstantUnstakeBufferSize,
+		InstantUnstakeFeeRate:     DefaultInstantUnstakeFeeRate,
+		WeightingMode:             DefaultWeightingMode,
+		PerformanceUpdateInterval: DefaultPerformanceUpdateInterval,
+		NetAmountSnapshotInterval: DefaultNetAmountSnapshotInterval,
+		MaxNetAmountSnapshots:     DefaultMaxNetAmountSnapshots,
 	}
 }
 
@@ -63,6 +97,12 @@ func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 		paramstypes.NewParamSetPair(KeyWhitelistedValidators, &p.WhitelistedValidators, validateWhitelistedValidators),
 		paramstypes.NewParamSetPair(KeyUnstakeFeeRate, &p.UnstakeFeeRate, validateUnstakeFeeRate),
 		paramstypes.NewParamSetPair(KeyMinLiquidStakingAmount, &p.MinLiquidStakingAmount, validateMinLiquidStakingAmount),
+		paramstypes.NewParamSetPair(KeyInstantUnstakeBufferSize, &p.InstantUnstakeBufferSize, validateInstantUnstakeBufferSize),
+		paramstypes.NewParamSetPair(KeyInstantUnstakeFeeRate, &p.InstantUnstakeFeeRate, validateInstantUnstakeFeeRate),
+		paramstypes.NewParamSetPair(KeyWeightingMode, &p.WeightingMode, validateWeightingMode),
+		paramstypes.NewParamSetPair(KeyPerformanceUpdateInterval, &p.PerformanceUpdateInterval, validatePerformanceUpdateInterval),
+		paramstypes.NewParamSetPair(KeyNetAmountSnapshotInterval, &p.NetAmountSnapshotInterval, validateNetAmountSnapshotInterval),
+		paramstypes.NewParamSetPair(KeyMaxNetAmountSnapshots, &p.MaxNetAmountSnapshots, validateMaxNetAmountSnapshots),
 	}
 }
 
@@ -76,6 +116,12 @@ func (p Params) WhitelistedValsMap() WhitelistedValsMap {
 	return GetWhitelistedValsMap(p.WhitelistedValidators)
 }
 
+// EffectiveInstantUnstakeFeeRate returns the fee rate applied to instant liquid unstaking,
+// which is never lower than UnstakeFeeRate.
+func (p Params) EffectiveInstantUnstakeFeeRate() sdk.Dec {
+	return sdk.MaxDec(p.InstantUnstakeFeeRate, p.UnstakeFeeRate)
+}
+
 // Validate validates parameters.
 func (p Params) Validate() error {
 	for _, v := range []struct {
@@ -86,6 +132,12 @@ func (p Params) Validate() error {
 		{p.WhitelistedValidators, validateWhitelistedValidators},
 		{p.UnstakeFeeRate, validateUnstakeFeeRate},
 		{p.MinLiquidStakingAmount, validateMinLiquidStakingAmount},
+		{p.InstantUnstakeBufferSize, validateInstantUnstakeBufferSize},
+		{p.InstantUnstakeFeeRate, validateInstantUnstakeFeeRate},
+		{p.WeightingMode, validateWeightingMode},
+		{p.PerformanceUpdateInterval, validatePerformanceUpdateInterval},
+		{p.NetAmountSnapshotInterval, validateNetAmountSnapshotInterval},
+		{p.MaxNetAmountSnapshots, validateMaxNetAmountSnapshots},
 	} {
 		if err := v.validator(v.value); err != nil {
 			return err
@@ -176,3 +228,89 @@ func validateMinLiquidStakingAmount(i interface{}) error {
 
 	return nil
 }
+
+func validateInstantUnstakeBufferSize(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("instant unstake buffer size must not be nil")
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("instant unstake buffer size must not be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateInstantUnstakeFeeRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("instant unstake fee rate must not be nil")
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("instant unstake fee rate must not be negative: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("instant unstake fee rate too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateWeightingMode(i interface{}) error {
+	v, ok := i.(WeightingMode)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := WeightingMode_name[int32(v)]; !ok {
+		return fmt.Errorf("invalid weighting mode: %d", v)
+	}
+
+	return nil
+}
+
+func validatePerformanceUpdateInterval(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("performance update interval must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateNetAmountSnapshotInterval(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMaxNetAmountSnapshots(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("max net amount snapshots must be positive: %d", v)
+	}
+
+	return nil
+}
@@ -20,6 +20,12 @@ func TestParams(t *testing.T) {
 whitelisted_validators: []
 unstake_fee_rate: "0.001000000000000000"
 min_liquid_staking_amount: "1000000"
+instant_unstake_buffer_size: "0"
+instant_unstake_fee_rate: "0.005000000000000000"
+weighting_mode: 0
+performance_update_interval: 14400
+net_amount_snapshot_interval: 14400
+max_net_amount_snapshots: 90
 `
 	require.Equal(t, paramsStr, params.String())
 
@@ -35,6 +41,12 @@ whitelisted_validators:
   target_weight: "10"
 unstake_fee_rate: "0.001000000000000000"
 min_liquid_staking_amount: "1000000"
+instant_unstake_buffer_size: "0"
+instant_unstake_fee_rate: "0.005000000000000000"
+weighting_mode: 0
+performance_update_interval: 14400
+net_amount_snapshot_interval: 14400
+max_net_amount_snapshots: 90
 `
 	require.Equal(t, paramsStr, params.String())
 }
@@ -183,6 +195,69 @@ func TestValidateWhitelistedValidators(t *testing.T) {
 			},
 			"min liquid staking amount must not be negative: -1",
 		},
+		{
+			"nil instant unstake buffer size",
+			func(params *types.Params) {
+				params.InstantUnstakeBufferSize = sdk.Int{}
+			},
+			"instant unstake buffer size must not be nil",
+		},
+		{
+			"negative instant unstake buffer size",
+			func(params *types.Params) {
+				params.InstantUnstakeBufferSize = sdk.NewInt(-1)
+			},
+			"instant unstake buffer size must not be negative: -1",
+		},
+		{
+			"nil instant unstake fee rate",
+			func(params *types.Params) {
+				params.InstantUnstakeFeeRate = sdk.Dec{}
+			},
+			"instant unstake fee rate must not be nil",
+		},
+		{
+			"negative instant unstake fee rate",
+			func(params *types.Params) {
+				params.InstantUnstakeFeeRate = sdk.NewDec(-1)
+			},
+			"instant unstake fee rate must not be negative: -1.000000000000000000",
+		},
+		{
+			"too large instant unstake fee rate",
+			func(params *types.Params) {
+				params.InstantUnstakeFeeRate = sdk.MustNewDecFromStr("1.0000001")
+			},
+			"instant unstake fee rate too large: 1.000000100000000000",
+		},
+		{
+			"performance weighting mode",
+			func(params *types.Params) {
+				params.WeightingMode = types.WeightingModePerformance
+			},
+			"",
+		},
+		{
+			"invalid weighting mode",
+			func(params *types.Params) {
+				params.WeightingMode = 2
+			},
+			"invalid weighting mode: 2",
+		},
+		{
+			"zero performance update interval",
+			func(params *types.Params) {
+				params.PerformanceUpdateInterval = 0
+			},
+			"performance update interval must be positive: 0",
+		},
+		{
+			"zero max net amount snapshots",
+			func(params *types.Params) {
+				params.MaxNetAmountSnapshots = 0
+			},
+			"max net amount snapshots must be positive: 0",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			params := types.DefaultParams()
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate validates ValidatorPreference.
+func (pref ValidatorPreference) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(pref.DelegatorAddress); err != nil {
+		return fmt.Errorf("invalid delegator address %q: %w", pref.DelegatorAddress, err)
+	}
+	if len(pref.Validators) == 0 {
+		return fmt.Errorf("preferred validators must not be empty")
+	}
+	return validateWhitelistedValidators(pref.Validators)
+}
+
+func (pref ValidatorPreference) GetDelegator() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(pref.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// ValidatorsMap returns the preferred validators map whose target weights are the preferred weights.
+func (pref ValidatorPreference) ValidatorsMap() WhitelistedValsMap {
+	return GetWhitelistedValsMap(pref.Validators)
+}
+
+// Validate validates PreferredDelegation.
+func (pd PreferredDelegation) Validate() error {
+	if _, err := sdk.ValAddressFromBech32(pd.ValidatorAddress); err != nil {
+		return fmt.Errorf("invalid validator address %q: %w", pd.ValidatorAddress, err)
+	}
+	if pd.Amount.IsNil() || !pd.Amount.IsPositive() {
+		return fmt.Errorf("preferred delegation amount must be positive: %s", pd.Amount)
+	}
+	return nil
+}
+
+func (pd PreferredDelegation) GetValidator() sdk.ValAddress {
+	addr, err := sdk.ValAddressFromBech32(pd.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
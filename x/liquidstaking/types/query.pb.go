@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -366,637 +367,2814 @@ func (m *QueryVotingPowerResponse) GetVotingPower() VotingPower {
 	return VotingPower{}
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "squad.liquidstaking.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "squad.liquidstaking.v1beta1.QueryParamsResponse")
-	proto.RegisterType((*QueryLiquidValidatorsRequest)(nil), "squad.liquidstaking.v1beta1.QueryLiquidValidatorsRequest")
-	proto.RegisterType((*QueryLiquidValidatorsResponse)(nil), "squad.liquidstaking.v1beta1.QueryLiquidValidatorsResponse")
-	proto.RegisterType((*QueryStatesRequest)(nil), "squad.liquidstaking.v1beta1.QueryStatesRequest")
-	proto.RegisterType((*QueryStatesResponse)(nil), "squad.liquidstaking.v1beta1.QueryStatesResponse")
-	proto.RegisterType((*QueryVotingPowerRequest)(nil), "squad.liquidstaking.v1beta1.QueryVotingPowerRequest")
-	proto.RegisterType((*QueryVotingPowerResponse)(nil), "squad.liquidstaking.v1beta1.QueryVotingPowerResponse")
+// QueryValidatorPreferenceRequest is the request type for the Query/ValidatorPreference RPC method.
+type QueryValidatorPreferenceRequest struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("squad/liquidstaking/v1beta1/query.proto", fileDescriptor_bde0b1a18a9ea596)
+func (m *QueryValidatorPreferenceRequest) Reset()         { *m = QueryValidatorPreferenceRequest{} }
+func (m *QueryValidatorPreferenceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorPreferenceRequest) ProtoMessage()    {}
+func (*QueryValidatorPreferenceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bde0b1a18a9ea596, []int{8}
 }
-
-var fileDescriptor_bde0b1a18a9ea596 = []byte{
-	// 745 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4d, 0x6b, 0x13, 0x41,
-	0x18, 0xce, 0xa6, 0xb6, 0xe0, 0x44, 0xa4, 0xae, 0x05, 0xcb, 0x5a, 0xd7, 0x21, 0x45, 0xdb, 0xa2,
-	0xcd, 0x98, 0xb4, 0x0a, 0xd6, 0x53, 0x7a, 0xd0, 0x8b, 0x48, 0x1b, 0xa1, 0x88, 0x1e, 0xc2, 0x24,
-	0xfb, 0xba, 0x59, 0xdc, 0x9d, 0xd9, 0xec, 0xcc, 0xc6, 0x16, 0xf1, 0x22, 0x78, 0xd7, 0xe8, 0x1f,
-	0xf0, 0x37, 0x78, 0xf7, 0xdc, 0x63, 0xc1, 0x83, 0x9e, 0x44, 0x5a, 0xf1, 0x07, 0xf8, 0x0b, 0x24,
-	0xb3, 0x93, 0x34, 0x1f, 0x76, 0x53, 0x45, 0xf4, 0xd4, 0xe9, 0xbb, 0xf3, 0xbc, 0xcf, 0x33, 0xcf,
-	0xfb, 0x41, 0xd0, 0x82, 0x68, 0xc6, 0xd4, 0x21, 0xbe, 0xd7, 0x8c, 0x3d, 0x47, 0x48, 0xfa, 0xc4,
-	0x63, 0x2e, 0x69, 0x15, 0x6b, 0x20, 0x69, 0x91, 0x34, 0x63, 0x88, 0x76, 0x0a, 0x61, 0xc4, 0x25,
-	0x37, 0xcf, 0xab, 0x8b, 0x85, 0x81, 0x8b, 0x05, 0x7d, 0xd1, 0x9a, 0x73, 0x39, 0x77, 0x7d, 0x20,
-	0x34, 0xf4, 0x08, 0x65, 0x8c, 0x4b, 0x2a, 0x3d, 0xce, 0x44, 0x02, 0xb5, 0x48, 0x1a, 0xc7, 0x60,
-	0xc2, 0x04, 0x30, 0xe3, 0x72, 0x97, 0xab, 0x23, 0xe9, 0x9c, 0x74, 0x34, 0xf9, 0x53, 0x5f, 0x76,
-	0x81, 0x2d, 0xf3, 0x10, 0x18, 0x0d, 0xbd, 0x56, 0x89, 0xf0, 0x50, 0x51, 0x8d, 0xd2, 0xe6, 0x67,
-	0x90, 0xb9, 0xd9, 0x79, 0xc0, 0x06, 0x8d, 0x68, 0x20, 0x2a, 0xd0, 0x8c, 0x41, 0xc8, 0xfc, 0x03,
-	0x74, 0x76, 0x20, 0x2a, 0x42, 0xce, 0x04, 0x98, 0x65, 0x34, 0x15, 0xaa, 0xc8, 0xac, 0x81, 0x8d,
-	0xc5, 0x5c, 0x69, 0xbe, 0x90, 0xf2, 0xde, 0x42, 0x02, 0x5e, 0x3f, 0xb1, 0xfb, 0xe5, 0x62, 0xa6,
-	0xa2, 0x81, 0x79, 0x1b, 0xcd, 0xa9, 0xcc, 0x77, 0x15, 0x64, 0x8b, 0xfa, 0x9e, 0x43, 0x25, 0x8f,
-	0x7a, 0xcc, 0x2f, 0x0d, 0x74, 0xe1, 0x88, 0x0b, 0x5a, 0x84, 0x83, 0xce, 0x24, 0x7c, 0xd5, 0x56,
-	0xef, 0xe3, 0xac, 0x81, 0x27, 0x16, 0x73, 0xa5, 0x62, 0xaa, 0x9e, 0xa1, 0x8c, 0xf7, 0x25, 0x95,
-	0xa0, 0xd5, 0x4d, 0xfb, 0x43, 0x6c, 0x3d, 0x5f, 0xd4, 0xad, 0x9e, 0xba, 0x48, 0xfb, 0xd2, 0x8d,
-	0x6a, 0x49, 0x8f, 0xd0, 0x34, 0x03, 0x59, 0xa5, 0x01, 0x8f, 0x99, 0xac, 0x8a, 0xce, 0x47, 0xed,
-	0xd0, 0x95, 0x54, 0x45, 0xf7, 0x40, 0x96, 0x15, 0xa6, 0x5f, 0xcb, 0x69, 0x36, 0x10, 0xcd, 0x13,
-	0x74, 0x4e, 0x71, 0x6e, 0x71, 0xe9, 0x31, 0x77, 0x83, 0x3f, 0x85, 0x48, 0xcb, 0x31, 0x67, 0xd0,
-	0x64, 0x8b, 0x4b, 0x88, 0x14, 0xd9, 0xc9, 0x4a, 0xf2, 0x4f, 0x3e, 0x40, 0xb3, 0xa3, 0x00, 0xad,
-	0x74, 0x13, 0x9d, 0x6a, 0xa9, 0x70, 0x35, 0xec, 0xc4, 0xb5, 0xca, 0xc5, 0x54, 0x95, 0x7d, 0x79,
-	0xb4, 0xc4, 0x5c, 0xeb, 0x30, 0x54, 0x6a, 0xe7, 0xd0, 0xa4, 0xe2, 0x33, 0x3f, 0x64, 0xd1, 0x54,
-	0x52, 0x74, 0x93, 0xa4, 0x66, 0x1c, 0xed, 0x38, 0xeb, 0xda, 0xf1, 0x01, 0xc9, 0x53, 0xf2, 0x7b,
-	0x46, 0xbb, 0xfc, 0xce, 0xb0, 0x56, 0x2b, 0x20, 0xe3, 0x88, 0x09, 0x4c, 0x7d, 0x1f, 0xab, 0x26,
-	0x03, 0x09, 0x91, 0xc0, 0xfc, 0x31, 0x96, 0x0d, 0xc0, 0x49, 0x3e, 0xac, 0x13, 0xe2, 0x80, 0x3b,
-	0xb1, 0x0f, 0x85, 0xbc, 0x87, 0xec, 0xdb, 0x1e, 0x73, 0x30, 0x8f, 0x25, 0x0e, 0x78, 0x04, 0x98,
-	0xd6, 0x3a, 0xc7, 0x0e, 0x22, 0x69, 0x54, 0xf3, 0x4e, 0x43, 0xca, 0x50, 0xac, 0x11, 0xe2, 0x7a,
-	0xb2, 0x11, 0xd7, 0x0a, 0x75, 0x1e, 0x90, 0x3a, 0x17, 0x01, 0x57, 0x3a, 0x97, 0x7d, 0x5a, 0x13,
-	0x7a, 0x64, 0x65, 0x04, 0x40, 0x02, 0xea, 0x31, 0xb2, 0x3d, 0x34, 0xbe, 0x22, 0x84, 0xfa, 0x8b,
-	0x8f, 0xdf, 0xde, 0x64, 0x2f, 0x99, 0xf3, 0xa9, 0xf3, 0xad, 0x39, 0x7f, 0x64, 0xd1, 0xf4, 0x70,
-	0xdf, 0x9b, 0x37, 0xc7, 0x3b, 0x73, 0xc4, 0x30, 0x59, 0x6b, 0x7f, 0x02, 0xd5, 0xf6, 0x7e, 0x37,
-	0xda, 0xe5, 0xf7, 0x86, 0x75, 0xab, 0xdf, 0x5e, 0x6d, 0xe6, 0xe1, 0xdc, 0x8d, 0x71, 0x59, 0xa2,
-	0xa5, 0xa3, 0x5c, 0x1e, 0x49, 0xf5, 0x77, 0x0d, 0x5f, 0x32, 0x17, 0x52, 0x0d, 0xef, 0xe3, 0x7d,
-	0x3d, 0x81, 0x72, 0x7d, 0x2d, 0x6e, 0xae, 0x8e, 0x37, 0x6d, 0x74, 0x14, 0xad, 0xeb, 0xbf, 0x89,
-	0xd2, 0x2e, 0xbf, 0xcd, 0xb6, 0xcb, 0x9f, 0x0c, 0xab, 0xda, 0x75, 0x39, 0x19, 0x2c, 0xac, 0x86,
-	0xb3, 0x63, 0x6e, 0xd7, 0x51, 0xca, 0x9c, 0x5f, 0x9b, 0xbc, 0xd0, 0xab, 0x81, 0x1a, 0x7e, 0x2c,
-	0x1b, 0x54, 0xe2, 0x3a, 0x65, 0xb8, 0x06, 0x18, 0xb6, 0x21, 0xaa, 0x7b, 0x02, 0x9c, 0xff, 0x59,
-	0x89, 0x15, 0xb3, 0x98, 0x5e, 0x89, 0xbe, 0x75, 0x44, 0x9e, 0xa9, 0x47, 0x3c, 0x57, 0x9b, 0x24,
-	0xd9, 0xb1, 0xc7, 0xd9, 0x24, 0x03, 0x3b, 0xfa, 0x38, 0x9b, 0x64, 0x70, 0x7d, 0x77, 0x37, 0xc9,
-	0xd5, 0x6e, 0x11, 0xd4, 0x02, 0x1f, 0xd7, 0xdb, 0x4d, 0x74, 0x79, 0x8c, 0xa3, 0x1a, 0xf1, 0x6f,
-	0x37, 0x49, 0xa2, 0x7d, 0x7d, 0x63, 0x77, 0xdf, 0x36, 0xf6, 0xf6, 0x6d, 0xe3, 0xeb, 0xbe, 0x6d,
-	0xbc, 0x3a, 0xb0, 0x33, 0x7b, 0x07, 0x76, 0xe6, 0xf3, 0x81, 0x9d, 0x79, 0x78, 0x63, 0xac, 0x8a,
-	0x61, 0x6e, 0xb9, 0x13, 0x82, 0xa8, 0x4d, 0xa9, 0xdf, 0x0b, 0x2b, 0x3f, 0x03, 0x00, 0x00, 0xff,
-	0xff, 0xdb, 0xb9, 0x4a, 0x47, 0x0c, 0x09, 0x00, 0x00,
+func (m *QueryValidatorPreferenceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorPreferenceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorPreferenceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorPreferenceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorPreferenceRequest.Merge(m, src)
+}
+func (m *QueryValidatorPreferenceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorPreferenceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorPreferenceRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryValidatorPreferenceRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params returns parameters of the liquidstaking module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// LiquidValidators returns liquid validators with states of the liquidstaking module.
-	LiquidValidators(ctx context.Context, in *QueryLiquidValidatorsRequest, opts ...grpc.CallOption) (*QueryLiquidValidatorsResponse, error)
-	// VotingPower returns voting power of staking and liquid staking module's of the voter that can be exercised.
-	VotingPower(ctx context.Context, in *QueryVotingPowerRequest, opts ...grpc.CallOption) (*QueryVotingPowerResponse, error)
-	// States returns states of the liquidstaking module.
-	States(ctx context.Context, in *QueryStatesRequest, opts ...grpc.CallOption) (*QueryStatesResponse, error)
+func (m *QueryValidatorPreferenceRequest) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryValidatorPreferenceResponse is the response type for the Query/ValidatorPreference RPC method.
+type QueryValidatorPreferenceResponse struct {
+	ValidatorPreference ValidatorPreference `protobuf:"bytes,1,opt,name=validator_preference,json=validatorPreference,proto3" json:"validator_preference"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryValidatorPreferenceResponse) Reset()         { *m = QueryValidatorPreferenceResponse{} }
+func (m *QueryValidatorPreferenceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorPreferenceResponse) ProtoMessage()    {}
+func (*QueryValidatorPreferenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bde0b1a18a9ea596, []int{9}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/squad.liquidstaking.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryValidatorPreferenceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorPreferenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorPreferenceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryValidatorPreferenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorPreferenceResponse.Merge(m, src)
+}
+func (m *QueryValidatorPreferenceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorPreferenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorPreferenceResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) LiquidValidators(ctx context.Context, in *QueryLiquidValidatorsRequest, opts ...grpc.CallOption) (*QueryLiquidValidatorsResponse, error) {
-	out := new(QueryLiquidValidatorsResponse)
-	err := c.cc.Invoke(ctx, "/squad.liquidstaking.v1beta1.Query/LiquidValidators", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryValidatorPreferenceResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorPreferenceResponse) GetValidatorPreference() ValidatorPreference {
+	if m != nil {
+		return m.ValidatorPreference
 	}
-	return out, nil
+	return ValidatorPreference{}
 }
 
-func (c *queryClient) VotingPower(ctx context.Context, in *QueryVotingPowerRequest, opts ...grpc.CallOption) (*QueryVotingPowerResponse, error) {
-	out := new(QueryVotingPowerResponse)
-	err := c.cc.Invoke(ctx, "/squad.liquidstaking.v1beta1.Query/VotingPower", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryDelegationBreakdownRequest is the request type for the Query/DelegationBreakdown RPC method.
+type QueryDelegationBreakdownRequest struct {
 }
 
-func (c *queryClient) States(ctx context.Context, in *QueryStatesRequest, opts ...grpc.CallOption) (*QueryStatesResponse, error) {
-	out := new(QueryStatesResponse)
-	err := c.cc.Invoke(ctx, "/squad.liquidstaking.v1beta1.Query/States", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDelegationBreakdownRequest) Reset()         { *m = QueryDelegationBreakdownRequest{} }
+func (m *QueryDelegationBreakdownRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegationBreakdownRequest) ProtoMessage()    {}
+func (*QueryDelegationBreakdownRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bde0b1a18a9ea596, []int{10}
+}
+func (m *QueryDelegationBreakdownRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegationBreakdownRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegationBreakdownRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params returns parameters of the liquidstaking module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// LiquidValidators returns liquid validators with states of the liquidstaking module.
-	LiquidValidators(context.Context, *QueryLiquidValidatorsRequest) (*QueryLiquidValidatorsResponse, error)
-	// VotingPower returns voting power of staking and liquid staking module's of the voter that can be exercised.
-	VotingPower(context.Context, *QueryVotingPowerRequest) (*QueryVotingPowerResponse, error)
-	// States returns states of the liquidstaking module.
-	States(context.Context, *QueryStatesRequest) (*QueryStatesResponse, error)
+func (m *QueryDelegationBreakdownRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegationBreakdownRequest.Merge(m, src)
+}
+func (m *QueryDelegationBreakdownRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegationBreakdownRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegationBreakdownRequest.DiscardUnknown(m)
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+var xxx_messageInfo_QueryDelegationBreakdownRequest proto.InternalMessageInfo
+
+// QueryDelegationBreakdownResponse is the response type for the Query/DelegationBreakdown RPC method.
+type QueryDelegationBreakdownResponse struct {
+	Validators []ValidatorDelegationBreakdown `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators"`
+	// preferred_tokens is the total liquid tokens delegated according to the validator preferences
+	PreferredTokens github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=preferred_tokens,json=preferredTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"preferred_tokens"`
+	// default_tokens is the total liquid tokens delegated according to the target weights
+	DefaultTokens github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=default_tokens,json=defaultTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"default_tokens"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryDelegationBreakdownResponse) Reset()         { *m = QueryDelegationBreakdownResponse{} }
+func (m *QueryDelegationBreakdownResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegationBreakdownResponse) ProtoMessage()    {}
+func (*QueryDelegationBreakdownResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bde0b1a18a9ea596, []int{11}
 }
-func (*UnimplementedQueryServer) LiquidValidators(ctx context.Context, req *QueryLiquidValidatorsRequest) (*QueryLiquidValidatorsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LiquidValidators not implemented")
+func (m *QueryDelegationBreakdownResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) VotingPower(ctx context.Context, req *QueryVotingPowerRequest) (*QueryVotingPowerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VotingPower not implemented")
+func (m *QueryDelegationBreakdownResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegationBreakdownResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) States(ctx context.Context, req *QueryStatesRequest) (*QueryStatesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method States not implemented")
+func (m *QueryDelegationBreakdownResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegationBreakdownResponse.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryDelegationBreakdownResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegationBreakdownResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegationBreakdownResponse.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/squad.liquidstaking.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryDelegationBreakdownResponse proto.InternalMessageInfo
+
+func (m *QueryDelegationBreakdownResponse) GetValidators() []ValidatorDelegationBreakdown {
+	if m != nil {
+		return m.Validators
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_LiquidValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLiquidValidatorsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LiquidValidators(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/squad.liquidstaking.v1beta1.Query/LiquidValidators",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LiquidValidators(ctx, req.(*QueryLiquidValidatorsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// ValidatorDelegationBreakdown is the breakdown of the liquid tokens of a liquid validator.
+type ValidatorDelegationBreakdown struct {
+	// operator_address defines the bech32-encoded address of the validator operator
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// preferred_tokens is the liquid tokens delegated according to the validator preferences
+	PreferredTokens github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=preferred_tokens,json=preferredTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"preferred_tokens"`
+	// default_tokens is the liquid tokens delegated according to the target weights
+	DefaultTokens github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=default_tokens,json=defaultTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"default_tokens"`
 }
 
-func _Query_VotingPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryVotingPowerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).VotingPower(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/squad.liquidstaking.v1beta1.Query/VotingPower",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).VotingPower(ctx, req.(*QueryVotingPowerRequest))
+func (m *ValidatorDelegationBreakdown) Reset()         { *m = ValidatorDelegationBreakdown{} }
+func (m *ValidatorDelegationBreakdown) String() string { return proto.CompactTextString(m) }
+func (*ValidatorDelegationBreakdown) ProtoMessage()    {}
+func (*ValidatorDelegationBreakdown) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bde0b1a18a9ea596, []int{12}
+}
+func (m *ValidatorDelegationBreakdown) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorDelegationBreakdown) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorDelegationBreakdown.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *ValidatorDelegationBreakdown) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorDelegationBreakdown.Merge(m, src)
+}
+func (m *ValidatorDelegationBreakdown) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorDelegationBreakdown) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorDelegationBreakdown.DiscardUnknown(m)
 }
 
-func _Query_States_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStatesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).States(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/squad.liquidstaking.v1beta1.Query/States",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).States(ctx, req.(*QueryStatesRequest))
+var xxx_messageInfo_ValidatorDelegationBreakdown proto.InternalMessageInfo
+
+func (m *ValidatorDelegationBreakdown) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "squad.liquidstaking.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "LiquidValidators",
-			Handler:    _Query_LiquidValidators_Handler,
-		},
-		{
-			MethodName: "VotingPower",
-			Handler:    _Query_VotingPower_Handler,
-		},
-		{
-			MethodName: "States",
-			Handler:    _Query_States_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "squad/liquidstaking/v1beta1/query.proto",
+// QueryUnstakeTicketsRequest is the request type for the Query/UnstakeTickets RPC method.
+type QueryUnstakeTicketsRequest struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryUnstakeTicketsRequest) Reset()         { *m = QueryUnstakeTicketsRequest{} }
+func (m *QueryUnstakeTicketsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUnstakeTicketsRequest) ProtoMessage()    {}
+func (*QueryUnstakeTicketsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bde0b1a18a9ea596, []int{13}
+}
+func (m *QueryUnstakeTicketsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnstakeTicketsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnstakeTicketsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryUnstakeTicketsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnstakeTicketsRequest.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryUnstakeTicketsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnstakeTicketsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnstakeTicketsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryUnstakeTicketsRequest proto.InternalMessageInfo
+
+func (m *QueryUnstakeTicketsRequest) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryUnstakeTicketsResponse is the response type for the Query/UnstakeTickets RPC method.
+type QueryUnstakeTicketsResponse struct {
+	UnstakeTickets []UnstakeTicket `protobuf:"bytes,1,rep,name=unstake_tickets,json=unstakeTickets,proto3" json:"unstake_tickets"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryUnstakeTicketsResponse) Reset()         { *m = QueryUnstakeTicketsResponse{} }
+func (m *QueryUnstakeTicketsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUnstakeTicketsResponse) ProtoMessage()    {}
+func (*QueryUnstakeTicketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bde0b1a18a9ea596, []int{14}
+}
+func (m *QueryUnstakeTicketsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnstakeTicketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnstakeTicketsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryLiquidValidatorsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryUnstakeTicketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnstakeTicketsResponse.Merge(m, src)
 }
-
-func (m *QueryLiquidValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryUnstakeTicketsResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryLiquidValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryUnstakeTicketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnstakeTicketsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryLiquidValidatorsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryUnstakeTicketsResponse proto.InternalMessageInfo
+
+func (m *QueryUnstakeTicketsResponse) GetUnstakeTickets() []UnstakeTicket {
+	if m != nil {
+		return m.UnstakeTickets
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryLiquidValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryUnstakeTicketRequest is the request type for the Query/UnstakeTicket RPC method.
+type QueryUnstakeTicketRequest struct {
+	TicketId uint64 `protobuf:"varint,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
 }
 
-func (m *QueryLiquidValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.LiquidValidators) > 0 {
-		for iNdEx := len(m.LiquidValidators) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LiquidValidators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryUnstakeTicketRequest) Reset()         { *m = QueryUnstakeTicketRequest{} }
+func (m *QueryUnstakeTicketRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUnstakeTicketRequest) ProtoMessage()    {}
+func (*QueryUnstakeTicketRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bde0b1a18a9ea596, []int{15}
+}
+func (m *QueryUnstakeTicketRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnstakeTicketRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnstakeTicketRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryUnstakeTicketRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnstakeTicketRequest.Merge(m, src)
+}
+func (m *QueryUnstakeTicketRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnstakeTicketRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnstakeTicketRequest.DiscardUnknown(m)
 }
 
-func (m *QueryStatesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryUnstakeTicketRequest proto.InternalMessageInfo
+
+func (m *QueryUnstakeTicketRequest) GetTicketId() uint64 {
+	if m != nil {
+		return m.TicketId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryStatesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryUnstakeTicketResponse is the response type for the Query/UnstakeTicket RPC method.
+type QueryUnstakeTicketResponse struct {
+	UnstakeTicket UnstakeTicket `protobuf:"bytes,1,opt,name=unstake_ticket,json=unstakeTicket,proto3" json:"unstake_ticket"`
 }
 
-func (m *QueryStatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryUnstakeTicketResponse) Reset()         { *m = QueryUnstakeTicketResponse{} }
+func (m *QueryUnstakeTicketResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUnstakeTicketResponse) ProtoMessage()    {}
+func (*QueryUnstakeTicketResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bde0b1a18a9ea596, []int{16}
+}
+func (m *QueryUnstakeTicketResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnstakeTicketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnstakeTicketResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUnstakeTicketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnstakeTicketResponse.Merge(m, src)
+}
+func (m *QueryUnstakeTicketResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnstakeTicketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnstakeTicketResponse.DiscardUnknown(m)
 }
 
-func (m *QueryStatesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryUnstakeTicketResponse proto.InternalMessageInfo
+
+func (m *QueryUnstakeTicketResponse) GetUnstakeTicket() UnstakeTicket {
+	if m != nil {
+		return m.UnstakeTicket
 	}
-	return dAtA[:n], nil
+	return UnstakeTicket{}
 }
 
-func (m *QueryStatesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryNetAmountSnapshotsRequest is the request type for the Query/NetAmountSnapshots RPC method.
+type QueryNetAmountSnapshotsRequest struct {
+	// start_height specifies the inclusive lower bound of the snapshot heights
+	StartHeight int64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height specifies the inclusive upper bound of the snapshot heights, which is unbounded if it is zero
+	EndHeight int64 `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
 }
 
-func (m *QueryStatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.NetAmountState.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryNetAmountSnapshotsRequest) Reset()         { *m = QueryNetAmountSnapshotsRequest{} }
+func (m *QueryNetAmountSnapshotsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNetAmountSnapshotsRequest) ProtoMessage()    {}
+func (*QueryNetAmountSnapshotsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bde0b1a18a9ea596, []int{17}
+}
+func (m *QueryNetAmountSnapshotsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNetAmountSnapshotsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNetAmountSnapshotsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryVotingPowerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryNetAmountSnapshotsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNetAmountSnapshotsRequest.Merge(m, src)
 }
-
-func (m *QueryVotingPowerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryNetAmountSnapshotsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNetAmountSnapshotsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNetAmountSnapshotsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryVotingPowerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Voter) > 0 {
-		i -= len(m.Voter)
-		copy(dAtA[i:], m.Voter)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Voter)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryNetAmountSnapshotsRequest proto.InternalMessageInfo
+
+func (m *QueryNetAmountSnapshotsRequest) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryVotingPowerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryNetAmountSnapshotsRequest) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryVotingPowerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryNetAmountSnapshotsResponse is the response type for the Query/NetAmountSnapshots RPC method.
+type QueryNetAmountSnapshotsResponse struct {
+	NetAmountSnapshots []NetAmountSnapshot `protobuf:"bytes,1,rep,name=net_amount_snapshots,json=netAmountSnapshots,proto3" json:"net_amount_snapshots"`
 }
 
-func (m *QueryVotingPowerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.VotingPower.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryNetAmountSnapshotsResponse) Reset()         { *m = QueryNetAmountSnapshotsResponse{} }
+func (m *QueryNetAmountSnapshotsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNetAmountSnapshotsResponse) ProtoMessage()    {}
+func (*QueryNetAmountSnapshotsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bde0b1a18a9ea596, []int{18}
+}
+func (m *QueryNetAmountSnapshotsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNetAmountSnapshotsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNetAmountSnapshotsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryNetAmountSnapshotsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNetAmountSnapshotsResponse.Merge(m, src)
+}
+func (m *QueryNetAmountSnapshotsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNetAmountSnapshotsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNetAmountSnapshotsResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryNetAmountSnapshotsResponse proto.InternalMessageInfo
+
+func (m *QueryNetAmountSnapshotsResponse) GetNetAmountSnapshots() []NetAmountSnapshot {
+	if m != nil {
+		return m.NetAmountSnapshots
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "squad.liquidstaking.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "squad.liquidstaking.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryLiquidValidatorsRequest)(nil), "squad.liquidstaking.v1beta1.QueryLiquidValidatorsRequest")
+	proto.RegisterType((*QueryLiquidValidatorsResponse)(nil), "squad.liquidstaking.v1beta1.QueryLiquidValidatorsResponse")
+	proto.RegisterType((*QueryStatesRequest)(nil), "squad.liquidstaking.v1beta1.QueryStatesRequest")
+	proto.RegisterType((*QueryStatesResponse)(nil), "squad.liquidstaking.v1beta1.QueryStatesResponse")
+	proto.RegisterType((*QueryVotingPowerRequest)(nil), "squad.liquidstaking.v1beta1.QueryVotingPowerRequest")
+	proto.RegisterType((*QueryVotingPowerResponse)(nil), "squad.liquidstaking.v1beta1.QueryVotingPowerResponse")
+	proto.RegisterType((*QueryValidatorPreferenceRequest)(nil), "squad.liquidstaking.v1beta1.QueryValidatorPreferenceRequest")
+	proto.RegisterType((*QueryValidatorPreferenceResponse)(nil), "squad.liquidstaking.v1beta1.QueryValidatorPreferenceResponse")
+	proto.RegisterType((*QueryDelegationBreakdownRequest)(nil), "squad.liquidstaking.v1beta1.QueryDelegationBreakdownRequest")
+	proto.RegisterType((*QueryDelegationBreakdownResponse)(nil), "squad.liquidstaking.v1beta1.QueryDelegationBreakdownResponse")
+	proto.RegisterType((*ValidatorDelegationBreakdown)(nil), "squad.liquidstaking.v1beta1.ValidatorDelegationBreakdown")
+	proto.RegisterType((*QueryUnstakeTicketsRequest)(nil), "squad.liquidstaking.v1beta1.QueryUnstakeTicketsRequest")
+	proto.RegisterType((*QueryUnstakeTicketsResponse)(nil), "squad.liquidstaking.v1beta1.QueryUnstakeTicketsResponse")
+	proto.RegisterType((*QueryUnstakeTicketRequest)(nil), "squad.liquidstaking.v1beta1.QueryUnstakeTicketRequest")
+	proto.RegisterType((*QueryUnstakeTicketResponse)(nil), "squad.liquidstaking.v1beta1.QueryUnstakeTicketResponse")
+	proto.RegisterType((*QueryNetAmountSnapshotsRequest)(nil), "squad.liquidstaking.v1beta1.QueryNetAmountSnapshotsRequest")
+	proto.RegisterType((*QueryNetAmountSnapshotsResponse)(nil), "squad.liquidstaking.v1beta1.QueryNetAmountSnapshotsResponse")
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func init() {
+	proto.RegisterFile("squad/liquidstaking/v1beta1/query.proto", fileDescriptor_bde0b1a18a9ea596)
+}
+
+var fileDescriptor_bde0b1a18a9ea596 = []byte{
+	// 1467 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcf, 0x6f, 0x13, 0xc7,
+	0x17, 0xcf, 0xda, 0x80, 0xbe, 0x4c, 0x20, 0x84, 0x49, 0xa4, 0x6f, 0xba, 0x80, 0x19, 0x16, 0x41,
+	0xa0, 0x10, 0x2f, 0x09, 0x94, 0xdf, 0x55, 0xe5, 0xa8, 0xa2, 0x50, 0x55, 0x28, 0xb8, 0x40, 0x4b,
+	0x7b, 0xb0, 0xc6, 0xde, 0x89, 0xbd, 0x8a, 0x3d, 0xb3, 0xd9, 0x19, 0x3b, 0x41, 0x88, 0x4b, 0x55,
+	0x0e, 0xa8, 0xaa, 0xd4, 0xba, 0xbd, 0x54, 0x9c, 0xfa, 0x1f, 0xf4, 0x87, 0x7a, 0xe9, 0xa1, 0x67,
+	0x8e, 0x48, 0x3d, 0xb4, 0xea, 0x81, 0xa2, 0x50, 0xf5, 0x0f, 0xe8, 0xa5, 0x87, 0x5e, 0xaa, 0x9d,
+	0x99, 0xdd, 0xd8, 0xde, 0xf5, 0xda, 0x89, 0x10, 0x51, 0x4f, 0x71, 0xde, 0xcc, 0x7b, 0xef, 0xf3,
+	0x3e, 0x6f, 0x66, 0xe7, 0x33, 0x03, 0xa6, 0xf9, 0x72, 0x13, 0x3b, 0x76, 0xdd, 0x5d, 0x6e, 0xba,
+	0x0e, 0x17, 0x78, 0xc9, 0xa5, 0x55, 0xbb, 0x35, 0x5b, 0x26, 0x02, 0xcf, 0xda, 0xcb, 0x4d, 0xe2,
+	0xdf, 0xcd, 0x7b, 0x3e, 0x13, 0x0c, 0xee, 0x93, 0x13, 0xf3, 0x5d, 0x13, 0xf3, 0x7a, 0xa2, 0xb9,
+	0xbf, 0xca, 0x58, 0xb5, 0x4e, 0x6c, 0xec, 0xb9, 0x36, 0xa6, 0x94, 0x09, 0x2c, 0x5c, 0x46, 0xb9,
+	0x72, 0x35, 0xed, 0xb4, 0x1c, 0xdd, 0x01, 0x95, 0xc3, 0x64, 0x95, 0x55, 0x99, 0xfc, 0x69, 0x07,
+	0xbf, 0xb4, 0x55, 0xfd, 0xa9, 0xcc, 0x54, 0x09, 0x9d, 0x61, 0x1e, 0xa1, 0xd8, 0x73, 0x5b, 0x73,
+	0x36, 0xf3, 0x64, 0xaa, 0x78, 0x5a, 0x6b, 0x12, 0xc0, 0x1b, 0x41, 0x01, 0x0b, 0xd8, 0xc7, 0x0d,
+	0x5e, 0x24, 0xcb, 0x4d, 0xc2, 0x85, 0xf5, 0x3e, 0x98, 0xe8, 0xb2, 0x72, 0x8f, 0x51, 0x4e, 0x60,
+	0x01, 0xec, 0xf0, 0xa4, 0x65, 0xca, 0x40, 0xc6, 0xb1, 0xd1, 0xb9, 0xc3, 0xf9, 0x94, 0x7a, 0xf3,
+	0xca, 0x79, 0x7e, 0xdb, 0xe3, 0xa7, 0x07, 0x47, 0x8a, 0xda, 0xd1, 0xca, 0x81, 0xfd, 0x32, 0xf2,
+	0x3b, 0xd2, 0xe5, 0x36, 0xae, 0xbb, 0x0e, 0x16, 0xcc, 0x8f, 0x32, 0x3f, 0x30, 0xc0, 0x81, 0x3e,
+	0x13, 0x34, 0x08, 0x07, 0xec, 0x55, 0xf9, 0x4a, 0xad, 0x68, 0x70, 0xca, 0x40, 0xd9, 0x63, 0xa3,
+	0x73, 0xb3, 0xa9, 0x78, 0x7a, 0x22, 0xbe, 0x2b, 0xb0, 0x20, 0x1a, 0xdd, 0x78, 0xbd, 0x27, 0x5b,
+	0xc4, 0x8b, 0x9c, 0x15, 0xa1, 0xf3, 0x35, 0x2f, 0xa1, 0x55, 0x43, 0xfa, 0x10, 0x8c, 0x53, 0x22,
+	0x4a, 0xb8, 0xc1, 0x9a, 0x54, 0x94, 0x78, 0x30, 0xa8, 0x19, 0x3a, 0x91, 0x8a, 0xe8, 0x3a, 0x11,
+	0x05, 0xe9, 0xd3, 0x89, 0x65, 0x8c, 0x76, 0x59, 0x2d, 0x1b, 0xfc, 0x5f, 0xe6, 0xbc, 0xcd, 0x84,
+	0x4b, 0xab, 0x0b, 0x6c, 0x85, 0xf8, 0x1a, 0x0e, 0x9c, 0x04, 0xdb, 0x5b, 0x4c, 0x10, 0x5f, 0x26,
+	0xdb, 0x59, 0x54, 0xff, 0x58, 0x0d, 0x30, 0x15, 0x77, 0xd0, 0x48, 0x6f, 0x80, 0x5d, 0x2d, 0x69,
+	0x2e, 0x79, 0x81, 0x5d, 0xa3, 0x3c, 0x96, 0x8a, 0xb2, 0x23, 0x8e, 0x86, 0x38, 0xda, 0x5a, 0x37,
+	0x59, 0xd7, 0xc1, 0x41, 0x95, 0x2e, 0x24, 0x6f, 0xc1, 0x27, 0x8b, 0xc4, 0x27, 0xb4, 0x42, 0x42,
+	0x9c, 0x27, 0xc0, 0x5e, 0x87, 0xd4, 0x49, 0x35, 0x18, 0x2d, 0x61, 0xc7, 0xf1, 0x09, 0xe7, 0x1a,
+	0xf3, 0x78, 0x34, 0x50, 0x50, 0x76, 0xeb, 0x53, 0x03, 0xa0, 0xfe, 0x01, 0x75, 0x1d, 0x2e, 0x98,
+	0x8c, 0xba, 0x5f, 0xf2, 0xa2, 0x71, 0x5d, 0xcf, 0xa9, 0xf4, 0x7a, 0xe2, 0x71, 0x75, 0x5d, 0x13,
+	0xad, 0xf8, 0x90, 0x75, 0x48, 0xd7, 0xf7, 0xa6, 0x02, 0xea, 0x32, 0x3a, 0xef, 0x13, 0xbc, 0xe4,
+	0xb0, 0x15, 0x1a, 0x2e, 0x8b, 0x1f, 0x32, 0x1a, 0x72, 0xe2, 0x1c, 0x0d, 0xb9, 0x04, 0x40, 0x6c,
+	0xc1, 0x5e, 0x18, 0x0e, 0x68, 0x42, 0x58, 0x8d, 0xb8, 0x23, 0x24, 0xbc, 0x03, 0xc6, 0x15, 0x13,
+	0x3e, 0x71, 0x4a, 0x82, 0x2d, 0x11, 0xca, 0xa7, 0x32, 0x01, 0xc9, 0xf3, 0xf9, 0x60, 0xee, 0x6f,
+	0x4f, 0x0f, 0x1e, 0xad, 0xba, 0xa2, 0xd6, 0x2c, 0xe7, 0x2b, 0xac, 0x61, 0x57, 0x18, 0x6f, 0x30,
+	0xae, 0xff, 0xcc, 0x70, 0x67, 0xc9, 0x16, 0x77, 0x3d, 0xc2, 0xf3, 0xd7, 0xa8, 0x28, 0xee, 0x89,
+	0xe2, 0xdc, 0x94, 0x61, 0xe0, 0x2d, 0x30, 0xe6, 0x90, 0x45, 0xdc, 0xac, 0x8b, 0x30, 0x70, 0x76,
+	0x53, 0x81, 0x77, 0xeb, 0x28, 0x2a, 0xac, 0xf5, 0x8f, 0x01, 0xf6, 0xa7, 0x15, 0x09, 0x8f, 0x83,
+	0x71, 0xe6, 0x11, 0x3f, 0x61, 0xdd, 0xec, 0x09, 0xed, 0x7a, 0xd9, 0xfc, 0x07, 0xab, 0xbf, 0x06,
+	0x4c, 0xb9, 0x68, 0x6e, 0xd1, 0xa0, 0xf1, 0xe4, 0xa6, 0x5b, 0x59, 0x22, 0x82, 0x6f, 0x6a, 0xcf,
+	0xac, 0x82, 0x7d, 0x89, 0xa1, 0xf4, 0xd2, 0xbb, 0x03, 0xf6, 0x34, 0xd5, 0x48, 0x49, 0xa8, 0x21,
+	0xbd, 0xfe, 0x5e, 0x4d, 0x5d, 0x7f, 0x5d, 0xd1, 0xc2, 0xaf, 0x53, 0xb3, 0x2b, 0x85, 0x75, 0x1e,
+	0xbc, 0x12, 0xcf, 0x1c, 0xd6, 0xb0, 0x0f, 0xec, 0x54, 0xf9, 0x4a, 0xae, 0x23, 0xb1, 0x6f, 0x2b,
+	0xfe, 0x4f, 0x19, 0xae, 0x39, 0x56, 0x33, 0xa9, 0xfc, 0x08, 0xf2, 0x7b, 0x60, 0xac, 0x1b, 0xb2,
+	0xde, 0xda, 0x1b, 0x47, 0xbc, 0xbb, 0x0b, 0xb1, 0x55, 0x06, 0x39, 0x99, 0x76, 0xfd, 0xdb, 0x4b,
+	0xb1, 0xc7, 0x6b, 0x6c, 0x9d, 0xf9, 0x43, 0x60, 0x17, 0x17, 0xd8, 0x17, 0xa5, 0x1a, 0x71, 0xab,
+	0x35, 0x95, 0x38, 0x5b, 0x1c, 0x95, 0xb6, 0xab, 0xd2, 0x04, 0x0f, 0x00, 0x40, 0xa8, 0x13, 0x4e,
+	0xc8, 0xc8, 0x09, 0x3b, 0x09, 0x75, 0xd4, 0xb0, 0xf5, 0xd0, 0xd0, 0xdf, 0x8c, 0xa4, 0x24, 0xba,
+	0xc0, 0x45, 0x30, 0xd9, 0x79, 0x66, 0x84, 0xe3, 0xba, 0x31, 0xf9, 0x21, 0xcf, 0x0d, 0xed, 0xa6,
+	0x4b, 0x85, 0x34, 0x96, 0x6f, 0xee, 0x99, 0x09, 0xb6, 0x4b, 0x2c, 0xf0, 0xa7, 0x0c, 0xd8, 0xa1,
+	0xce, 0x64, 0x68, 0xa7, 0x86, 0x8f, 0x0b, 0x02, 0xf3, 0xd4, 0xf0, 0x0e, 0xaa, 0x3e, 0xeb, 0x89,
+	0xd1, 0x2e, 0x7c, 0x6d, 0x98, 0x67, 0x8a, 0x44, 0x34, 0x7d, 0xca, 0x11, 0xae, 0xd7, 0x91, 0xd4,
+	0x00, 0x44, 0x10, 0x9f, 0x23, 0xb6, 0x88, 0x44, 0x8d, 0x20, 0x15, 0x0f, 0xe9, 0x80, 0xa8, 0xc1,
+	0x9c, 0x66, 0x9d, 0xe4, 0x2d, 0x17, 0xe4, 0xae, 0xb8, 0xd4, 0x41, 0xac, 0x29, 0x50, 0x83, 0xf9,
+	0x04, 0xe1, 0x72, 0xf0, 0x33, 0xf0, 0x50, 0x3a, 0x02, 0xbe, 0x55, 0x13, 0xc2, 0xe3, 0x17, 0x6d,
+	0x3b, 0xb6, 0xf7, 0x02, 0x9c, 0x33, 0x75, 0x5c, 0xe6, 0x5a, 0x51, 0x09, 0x9f, 0x10, 0xbb, 0x81,
+	0x5d, 0x6a, 0xaf, 0xf6, 0xa8, 0x2b, 0xee, 0x91, 0xca, 0x47, 0x3f, 0xff, 0xf1, 0x45, 0xe6, 0x08,
+	0x3c, 0x9c, 0x2a, 0xbf, 0x74, 0xce, 0xbf, 0x32, 0x60, 0xbc, 0x57, 0x96, 0xc0, 0x0b, 0x83, 0x99,
+	0xe9, 0xa3, 0x75, 0xcc, 0x8b, 0x9b, 0x71, 0xd5, 0xf4, 0xfe, 0x69, 0xb4, 0x0b, 0xdf, 0x1b, 0xe6,
+	0xa5, 0x4e, 0x7a, 0x35, 0x99, 0xeb, 0x47, 0xc2, 0x00, 0x96, 0x05, 0x38, 0xde, 0x8f, 0xe5, 0x58,
+	0xa8, 0x17, 0x4b, 0xf8, 0x71, 0x38, 0x9d, 0x4a, 0x78, 0x47, 0xde, 0xcf, 0xb3, 0x60, 0xb4, 0x43,
+	0x81, 0xc0, 0x33, 0x83, 0x49, 0x8b, 0x2b, 0x25, 0xf3, 0xb5, 0x0d, 0x7a, 0x69, 0x96, 0xbf, 0xcc,
+	0xb4, 0x0b, 0xbf, 0x18, 0x66, 0x29, 0x64, 0x59, 0xe9, 0x1e, 0x24, 0xb5, 0x53, 0x40, 0x6e, 0xc8,
+	0x28, 0xa6, 0x4e, 0x32, 0xc9, 0xd3, 0x51, 0x0f, 0xa4, 0x36, 0x43, 0xa2, 0x86, 0x05, 0xaa, 0x60,
+	0x8a, 0xca, 0x04, 0x91, 0x55, 0xe2, 0x57, 0x5c, 0x4e, 0x9c, 0xad, 0xec, 0xc4, 0x69, 0x38, 0x9b,
+	0xde, 0x89, 0x0e, 0xb5, 0x68, 0xdf, 0x93, 0x45, 0xdc, 0x87, 0x5f, 0x65, 0xc1, 0x44, 0x82, 0x8a,
+	0x82, 0x97, 0x87, 0x60, 0xb9, 0xaf, 0x4a, 0x34, 0x5f, 0xdf, 0xa4, 0xb7, 0xee, 0xd5, 0xc7, 0x99,
+	0x76, 0xe1, 0x3b, 0xc3, 0x3c, 0x1b, 0xf6, 0x4a, 0x92, 0x1e, 0xce, 0x47, 0xeb, 0x1a, 0x31, 0x61,
+	0x53, 0x10, 0x3f, 0x6f, 0xad, 0x82, 0x99, 0x7e, 0x2d, 0x48, 0x8a, 0xf2, 0x82, 0xdb, 0x70, 0x15,
+	0x5e, 0x19, 0x6e, 0x43, 0x74, 0x88, 0x5d, 0x6e, 0xdf, 0x8b, 0x29, 0x84, 0xfb, 0xf0, 0x9b, 0x2c,
+	0x98, 0x48, 0x92, 0x52, 0x43, 0xf4, 0xa6, 0xbf, 0xc2, 0x1d, 0xa6, 0x37, 0x29, 0xda, 0xd7, 0x7a,
+	0x94, 0x69, 0x17, 0x9e, 0x1a, 0x66, 0xb9, 0xb3, 0x37, 0x9a, 0x7f, 0xa5, 0xa8, 0x90, 0xae, 0x84,
+	0x38, 0x08, 0x57, 0x2a, 0xcc, 0x77, 0x82, 0x2d, 0x24, 0x58, 0x7f, 0xfa, 0xe5, 0x96, 0x0b, 0x46,
+	0x05, 0xf6, 0xab, 0x44, 0xa0, 0x15, 0x79, 0xf2, 0xf2, 0xad, 0xee, 0xe3, 0xa0, 0xed, 0xe4, 0x44,
+	0x24, 0x95, 0xca, 0x51, 0x6b, 0x3e, 0xc9, 0x82, 0xb1, 0x6e, 0xe5, 0x06, 0xcf, 0x0d, 0xe6, 0x3b,
+	0x51, 0x36, 0x9a, 0xe7, 0x37, 0xee, 0xa8, 0x7b, 0xf4, 0x20, 0xd3, 0x2e, 0x7c, 0xdb, 0x71, 0xa2,
+	0x04, 0x8c, 0xb9, 0x74, 0x66, 0xb1, 0x1e, 0x10, 0x8b, 0xb4, 0x88, 0x42, 0x5a, 0x3f, 0xf6, 0xd9,
+	0x44, 0x3e, 0x98, 0xee, 0x47, 0x7e, 0x4f, 0x80, 0x17, 0x4b, 0xfb, 0xdb, 0xf0, 0x6a, 0x2a, 0xed,
+	0x0a, 0x5f, 0xe2, 0x86, 0xb1, 0x7b, 0x94, 0x31, 0x5c, 0xcb, 0x80, 0xdd, 0x5d, 0x14, 0xc1, 0xb3,
+	0x1b, 0xe4, 0x34, 0xec, 0xc5, 0xb9, 0x0d, 0xfb, 0xe9, 0x56, 0xfc, 0x6e, 0xb4, 0x0b, 0x8f, 0x0c,
+	0xf3, 0xc8, 0x30, 0xad, 0xd8, 0x3a, 0xd2, 0x2f, 0xc2, 0xf3, 0xa9, 0xa4, 0xf7, 0x10, 0x6b, 0xdf,
+	0x8b, 0xee, 0x02, 0xf7, 0xe1, 0xc3, 0x2c, 0x80, 0x71, 0x71, 0x0c, 0x2f, 0x0d, 0x66, 0xac, 0xaf,
+	0x6e, 0x37, 0x2f, 0x6f, 0xce, 0x59, 0x73, 0xfe, 0xb7, 0xd1, 0x2e, 0xfc, 0x68, 0x98, 0x6f, 0x74,
+	0x72, 0x4e, 0x89, 0x40, 0x4a, 0xa0, 0x23, 0xf9, 0xa8, 0x83, 0x22, 0x99, 0x8e, 0x56, 0x5c, 0x51,
+	0x73, 0xa9, 0x9c, 0xa5, 0x6e, 0x01, 0xc8, 0xc7, 0xb4, 0x4a, 0xf2, 0xd6, 0x0a, 0x38, 0xd9, 0xaf,
+	0x1b, 0x9d, 0xe1, 0xa2, 0x92, 0x5f, 0xea, 0xe7, 0x27, 0xe9, 0xc6, 0x21, 0xef, 0x05, 0xea, 0x41,
+	0x6b, 0x98, 0x7b, 0x41, 0xd7, 0x83, 0xd8, 0x30, 0xf7, 0x82, 0xee, 0xb7, 0xb2, 0xf0, 0x5e, 0x70,
+	0x32, 0xe4, 0x59, 0x12, 0x3b, 0x48, 0xa9, 0x2e, 0x83, 0xa3, 0x03, 0xf4, 0x91, 0xf6, 0x78, 0xb9,
+	0xf7, 0x02, 0x85, 0x7d, 0x7e, 0xe1, 0xf1, 0x5a, 0xce, 0x78, 0xb2, 0x96, 0x33, 0x9e, 0xad, 0xe5,
+	0x8c, 0xcf, 0x9e, 0xe7, 0x46, 0x9e, 0x3c, 0xcf, 0x8d, 0xfc, 0xfa, 0x3c, 0x37, 0xf2, 0xc1, 0xd9,
+	0x81, 0x28, 0x7a, 0x73, 0xcb, 0xd7, 0x82, 0xf2, 0x0e, 0xf9, 0x38, 0x7b, 0xfa, 0xdf, 0x00, 0x00,
+	0x00, 0xff, 0xff, 0x63, 0xce, 0xf6, 0xed, 0x79, 0x16, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params returns parameters of the liquidstaking module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// LiquidValidators returns liquid validators with states of the liquidstaking module.
+	LiquidValidators(ctx context.Context, in *QueryLiquidValidatorsRequest, opts ...grpc.CallOption) (*QueryLiquidValidatorsResponse, error)
+	// VotingPower returns voting power of staking and liquid staking module's of the voter that can be exercised.
+	VotingPower(ctx context.Context, in *QueryVotingPowerRequest, opts ...grpc.CallOption) (*QueryVotingPowerResponse, error)
+	// ValidatorPreference returns the validator preference of the liquid staker.
+	ValidatorPreference(ctx context.Context, in *QueryValidatorPreferenceRequest, opts ...grpc.CallOption) (*QueryValidatorPreferenceResponse, error)
+	// DelegationBreakdown returns the liquid tokens of the liquid validators delegated according to the validator
+	// preferences and the target weights, respectively.
+	DelegationBreakdown(ctx context.Context, in *QueryDelegationBreakdownRequest, opts ...grpc.CallOption) (*QueryDelegationBreakdownResponse, error)
+	// UnstakeTickets returns the in-flight unstake tickets of the liquid staker.
+	UnstakeTickets(ctx context.Context, in *QueryUnstakeTicketsRequest, opts ...grpc.CallOption) (*QueryUnstakeTicketsResponse, error)
+	// UnstakeTicket returns the in-flight unstake ticket.
+	UnstakeTicket(ctx context.Context, in *QueryUnstakeTicketRequest, opts ...grpc.CallOption) (*QueryUnstakeTicketResponse, error)
+	// NetAmountSnapshots returns the net amount state snapshots within the height range.
+	NetAmountSnapshots(ctx context.Context, in *QueryNetAmountSnapshotsRequest, opts ...grpc.CallOption) (*QueryNetAmountSnapshotsResponse, error)
+	// States returns states of the liquidstaking module.
+	States(ctx context.Context, in *QueryStatesRequest, opts ...grpc.CallOption) (*QueryStatesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/squad.liquidstaking.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryLiquidValidatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) LiquidValidators(ctx context.Context, in *QueryLiquidValidatorsRequest, opts ...grpc.CallOption) (*QueryLiquidValidatorsResponse, error) {
+	out := new(QueryLiquidValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/squad.liquidstaking.v1beta1.Query/LiquidValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryLiquidValidatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) VotingPower(ctx context.Context, in *QueryVotingPowerRequest, opts ...grpc.CallOption) (*QueryVotingPowerResponse, error) {
+	out := new(QueryVotingPowerResponse)
+	err := c.cc.Invoke(ctx, "/squad.liquidstaking.v1beta1.Query/VotingPower", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.LiquidValidators) > 0 {
-		for _, e := range m.LiquidValidators {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorPreference(ctx context.Context, in *QueryValidatorPreferenceRequest, opts ...grpc.CallOption) (*QueryValidatorPreferenceResponse, error) {
+	out := new(QueryValidatorPreferenceResponse)
+	err := c.cc.Invoke(ctx, "/squad.liquidstaking.v1beta1.Query/ValidatorPreference", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryStatesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) DelegationBreakdown(ctx context.Context, in *QueryDelegationBreakdownRequest, opts ...grpc.CallOption) (*QueryDelegationBreakdownResponse, error) {
+	out := new(QueryDelegationBreakdownResponse)
+	err := c.cc.Invoke(ctx, "/squad.liquidstaking.v1beta1.Query/DelegationBreakdown", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryStatesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) UnstakeTickets(ctx context.Context, in *QueryUnstakeTicketsRequest, opts ...grpc.CallOption) (*QueryUnstakeTicketsResponse, error) {
+	out := new(QueryUnstakeTicketsResponse)
+	err := c.cc.Invoke(ctx, "/squad.liquidstaking.v1beta1.Query/UnstakeTickets", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.NetAmountState.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryVotingPowerRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) UnstakeTicket(ctx context.Context, in *QueryUnstakeTicketRequest, opts ...grpc.CallOption) (*QueryUnstakeTicketResponse, error) {
+	out := new(QueryUnstakeTicketResponse)
+	err := c.cc.Invoke(ctx, "/squad.liquidstaking.v1beta1.Query/UnstakeTicket", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Voter)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) NetAmountSnapshots(ctx context.Context, in *QueryNetAmountSnapshotsRequest, opts ...grpc.CallOption) (*QueryNetAmountSnapshotsResponse, error) {
+	out := new(QueryNetAmountSnapshotsResponse)
+	err := c.cc.Invoke(ctx, "/squad.liquidstaking.v1beta1.Query/NetAmountSnapshots", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryVotingPowerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) States(ctx context.Context, in *QueryStatesRequest, opts ...grpc.CallOption) (*QueryStatesResponse, error) {
+	out := new(QueryStatesResponse)
+	err := c.cc.Invoke(ctx, "/squad.liquidstaking.v1beta1.Query/States", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.VotingPower.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params returns parameters of the liquidstaking module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// LiquidValidators returns liquid validators with states of the liquidstaking module.
+	LiquidValidators(context.Context, *QueryLiquidValidatorsRequest) (*QueryLiquidValidatorsResponse, error)
+	// VotingPower returns voting power of staking and liquid staking module's of the voter that can be exercised.
+	VotingPower(context.Context, *QueryVotingPowerRequest) (*QueryVotingPowerResponse, error)
+	// ValidatorPreference returns the validator preference of the liquid staker.
+	ValidatorPreference(context.Context, *QueryValidatorPreferenceRequest) (*QueryValidatorPreferenceResponse, error)
+	// DelegationBreakdown returns the liquid tokens of the liquid validators delegated according to the validator
+	// preferences and the target weights, respectively.
+	DelegationBreakdown(context.Context, *QueryDelegationBreakdownRequest) (*QueryDelegationBreakdownResponse, error)
+	// UnstakeTickets returns the in-flight unstake tickets of the liquid staker.
+	UnstakeTickets(context.Context, *QueryUnstakeTicketsRequest) (*QueryUnstakeTicketsResponse, error)
+	// UnstakeTicket returns the in-flight unstake ticket.
+	UnstakeTicket(context.Context, *QueryUnstakeTicketRequest) (*QueryUnstakeTicketResponse, error)
+	// NetAmountSnapshots returns the net amount state snapshots within the height range.
+	NetAmountSnapshots(context.Context, *QueryNetAmountSnapshotsRequest) (*QueryNetAmountSnapshotsResponse, error)
+	// States returns states of the liquidstaking module.
+	States(context.Context, *QueryStatesRequest) (*QueryStatesResponse, error)
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) LiquidValidators(ctx context.Context, req *QueryLiquidValidatorsRequest) (*QueryLiquidValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidValidators not implemented")
+}
+func (*UnimplementedQueryServer) VotingPower(ctx context.Context, req *QueryVotingPowerRequest) (*QueryVotingPowerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VotingPower not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorPreference(ctx context.Context, req *QueryValidatorPreferenceRequest) (*QueryValidatorPreferenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorPreference not implemented")
+}
+func (*UnimplementedQueryServer) DelegationBreakdown(ctx context.Context, req *QueryDelegationBreakdownRequest) (*QueryDelegationBreakdownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegationBreakdown not implemented")
+}
+func (*UnimplementedQueryServer) UnstakeTickets(ctx context.Context, req *QueryUnstakeTicketsRequest) (*QueryUnstakeTicketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnstakeTickets not implemented")
+}
+func (*UnimplementedQueryServer) UnstakeTicket(ctx context.Context, req *QueryUnstakeTicketRequest) (*QueryUnstakeTicketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnstakeTicket not implemented")
+}
+func (*UnimplementedQueryServer) NetAmountSnapshots(ctx context.Context, req *QueryNetAmountSnapshotsRequest) (*QueryNetAmountSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NetAmountSnapshots not implemented")
+}
+func (*UnimplementedQueryServer) States(ctx context.Context, req *QueryStatesRequest) (*QueryStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method States not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squad.liquidstaking.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LiquidValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiquidValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squad.liquidstaking.v1beta1.Query/LiquidValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidValidators(ctx, req.(*QueryLiquidValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VotingPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVotingPowerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VotingPower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squad.liquidstaking.v1beta1.Query/VotingPower",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VotingPower(ctx, req.(*QueryVotingPowerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorPreference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorPreferenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorPreference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squad.liquidstaking.v1beta1.Query/ValidatorPreference",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorPreference(ctx, req.(*QueryValidatorPreferenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegationBreakdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegationBreakdownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegationBreakdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squad.liquidstaking.v1beta1.Query/DelegationBreakdown",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegationBreakdown(ctx, req.(*QueryDelegationBreakdownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnstakeTickets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnstakeTicketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnstakeTickets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squad.liquidstaking.v1beta1.Query/UnstakeTickets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnstakeTickets(ctx, req.(*QueryUnstakeTicketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnstakeTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnstakeTicketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnstakeTicket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squad.liquidstaking.v1beta1.Query/UnstakeTicket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnstakeTicket(ctx, req.(*QueryUnstakeTicketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NetAmountSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNetAmountSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NetAmountSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squad.liquidstaking.v1beta1.Query/NetAmountSnapshots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NetAmountSnapshots(ctx, req.(*QueryNetAmountSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_States_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).States(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squad.liquidstaking.v1beta1.Query/States",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).States(ctx, req.(*QueryStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "squad.liquidstaking.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "LiquidValidators",
+			Handler:    _Query_LiquidValidators_Handler,
+		},
+		{
+			MethodName: "VotingPower",
+			Handler:    _Query_VotingPower_Handler,
+		},
+		{
+			MethodName: "ValidatorPreference",
+			Handler:    _Query_ValidatorPreference_Handler,
+		},
+		{
+			MethodName: "DelegationBreakdown",
+			Handler:    _Query_DelegationBreakdown_Handler,
+		},
+		{
+			MethodName: "UnstakeTickets",
+			Handler:    _Query_UnstakeTickets_Handler,
+		},
+		{
+			MethodName: "UnstakeTicket",
+			Handler:    _Query_UnstakeTicket_Handler,
+		},
+		{
+			MethodName: "NetAmountSnapshots",
+			Handler:    _Query_NetAmountSnapshots_Handler,
+		},
+		{
+			MethodName: "States",
+			Handler:    _Query_States_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "squad/liquidstaking/v1beta1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidValidatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidValidatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LiquidValidators) > 0 {
+		for iNdEx := len(m.LiquidValidators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LiquidValidators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.NetAmountState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVotingPowerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVotingPowerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVotingPowerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVotingPowerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVotingPowerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVotingPowerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.VotingPower.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorPreferenceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorPreferenceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorPreferenceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorPreferenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorPreferenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorPreferenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ValidatorPreference.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationBreakdownRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationBreakdownRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationBreakdownRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationBreakdownResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationBreakdownResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationBreakdownResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.DefaultTokens.Size()
+		i -= size
+		if _, err := m.DefaultTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.PreferredTokens.Size()
+		i -= size
+		if _, err := m.PreferredTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorDelegationBreakdown) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorDelegationBreakdown) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorDelegationBreakdown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.DefaultTokens.Size()
+		i -= size
+		if _, err := m.DefaultTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.PreferredTokens.Size()
+		i -= size
+		if _, err := m.PreferredTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnstakeTicketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnstakeTicketsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnstakeTicketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnstakeTicketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnstakeTicketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnstakeTicketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UnstakeTickets) > 0 {
+		for iNdEx := len(m.UnstakeTickets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnstakeTickets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnstakeTicketRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnstakeTicketRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnstakeTicketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TicketId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TicketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnstakeTicketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnstakeTicketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnstakeTicketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.UnstakeTicket.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNetAmountSnapshotsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNetAmountSnapshotsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNetAmountSnapshotsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNetAmountSnapshotsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNetAmountSnapshotsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNetAmountSnapshotsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NetAmountSnapshots) > 0 {
+		for iNdEx := len(m.NetAmountSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NetAmountSnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLiquidValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLiquidValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LiquidValidators) > 0 {
+		for _, e := range m.LiquidValidators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryStatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryStatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NetAmountState.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVotingPowerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVotingPowerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.VotingPower.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorPreferenceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorPreferenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ValidatorPreference.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDelegationBreakdownRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDelegationBreakdownResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.PreferredTokens.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.DefaultTokens.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *ValidatorDelegationBreakdown) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.PreferredTokens.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.DefaultTokens.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryUnstakeTicketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnstakeTicketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UnstakeTickets) > 0 {
+		for _, e := range m.UnstakeTickets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryUnstakeTicketRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TicketId != 0 {
+		n += 1 + sovQuery(uint64(m.TicketId))
+	}
+	return n
+}
+
+func (m *QueryUnstakeTicketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.UnstakeTicket.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryNetAmountSnapshotsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndHeight))
+	}
+	return n
+}
+
+func (m *QueryNetAmountSnapshotsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.NetAmountSnapshots) > 0 {
+		for _, e := range m.NetAmountSnapshots {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidValidatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidValidatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidValidatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidValidatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidValidators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidValidators = append(m.LiquidValidators, LiquidValidatorState{})
+			if err := m.LiquidValidators[len(m.LiquidValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAmountState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetAmountState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVotingPowerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVotingPowerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVotingPowerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVotingPowerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVotingPowerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVotingPowerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorPreferenceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorPreferenceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorPreferenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorPreferenceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorPreferenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorPreferenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPreference", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorPreference.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegationBreakdownRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegationBreakdownRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegationBreakdownRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegationBreakdownResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegationBreakdownResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegationBreakdownResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			if iNdEx >= l {
+			m.Validators = append(m.Validators, ValidatorDelegationBreakdown{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreferredTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.PreferredTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DefaultTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1018,7 +3196,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *ValidatorDelegationBreakdown) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1041,17 +3219,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorDelegationBreakdown: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorDelegationBreakdown: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1061,22 +3239,89 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreferredTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PreferredTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DefaultTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1101,7 +3346,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLiquidValidatorsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryUnstakeTicketsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1124,12 +3369,44 @@ func (m *QueryLiquidValidatorsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLiquidValidatorsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUnstakeTicketsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLiquidValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUnstakeTicketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1151,7 +3428,7 @@ func (m *QueryLiquidValidatorsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLiquidValidatorsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryUnstakeTicketsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1174,15 +3451,15 @@ func (m *QueryLiquidValidatorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLiquidValidatorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUnstakeTicketsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLiquidValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUnstakeTicketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidValidators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnstakeTickets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1209,8 +3486,8 @@ func (m *QueryLiquidValidatorsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LiquidValidators = append(m.LiquidValidators, LiquidValidatorState{})
-			if err := m.LiquidValidators[len(m.LiquidValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.UnstakeTickets = append(m.UnstakeTickets, UnstakeTicket{})
+			if err := m.UnstakeTickets[len(m.UnstakeTickets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1235,7 +3512,7 @@ func (m *QueryLiquidValidatorsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStatesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryUnstakeTicketRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1258,12 +3535,31 @@ func (m *QueryStatesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStatesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUnstakeTicketRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUnstakeTicketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TicketId", wireType)
+			}
+			m.TicketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TicketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1285,7 +3581,7 @@ func (m *QueryStatesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStatesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryUnstakeTicketResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1308,15 +3604,15 @@ func (m *QueryStatesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStatesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUnstakeTicketResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUnstakeTicketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetAmountState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnstakeTicket", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1343,7 +3639,7 @@ func (m *QueryStatesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NetAmountState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UnstakeTicket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1368,7 +3664,7 @@ func (m *QueryStatesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVotingPowerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryNetAmountSnapshotsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1391,17 +3687,17 @@ func (m *QueryVotingPowerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVotingPowerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNetAmountSnapshotsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVotingPowerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNetAmountSnapshotsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
 			}
-			var stringLen uint64
+			m.StartHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1411,24 +3707,30 @@ func (m *QueryVotingPowerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StartHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1450,7 +3752,7 @@ func (m *QueryVotingPowerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVotingPowerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryNetAmountSnapshotsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1473,15 +3775,15 @@ func (m *QueryVotingPowerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVotingPowerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNetAmountSnapshotsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVotingPowerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNetAmountSnapshotsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAmountSnapshots", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1508,7 +3810,8 @@ func (m *QueryVotingPowerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.VotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.NetAmountSnapshots = append(m.NetAmountSnapshots, NetAmountSnapshot{})
+			if err := m.NetAmountSnapshots[len(m.NetAmountSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewNetAmountSnapshot returns a new NetAmountSnapshot of the net amount state at the block.
+func NewNetAmountSnapshot(ctx sdk.Context, nas NetAmountState) NetAmountSnapshot {
+	return NetAmountSnapshot{
+		Height:                ctx.BlockHeight(),
+		Time:                  ctx.BlockTime(),
+		MintRate:              nas.MintRate,
+		BtokenTotalSupply:     nas.BtokenTotalSupply,
+		NetAmount:             nas.NetAmount,
+		TotalDelShares:        nas.TotalDelShares,
+		TotalRemainingRewards: nas.TotalRemainingRewards,
+	}
+}
+
+// Validate validates NetAmountSnapshot.
+func (snapshot NetAmountSnapshot) Validate() error {
+	if snapshot.Height <= 0 {
+		return fmt.Errorf("height must be positive: %d", snapshot.Height)
+	}
+	for _, v := range []struct {
+		name  string
+		value sdk.Dec
+	}{
+		{"mint rate", snapshot.MintRate},
+		{"net amount", snapshot.NetAmount},
+		{"total del shares", snapshot.TotalDelShares},
+		{"total remaining rewards", snapshot.TotalRemainingRewards},
+	} {
+		if v.value.IsNil() || v.value.IsNegative() {
+			return fmt.Errorf("%s must not be nil or negative: %s", v.name, v.value)
+		}
+	}
+	if snapshot.BtokenTotalSupply.IsNil() || snapshot.BtokenTotalSupply.IsNegative() {
+		return fmt.Errorf("btoken total supply must not be nil or negative: %s", snapshot.BtokenTotalSupply)
+	}
+	return nil
+}
+
+// IsBTokenValueDropped returns whether the bToken value has dropped since the previous snapshot,
+// which signals a slashing of the liquid validators. The bToken value drops when the mint rate rises,
+// since the net amount backing the bToken supply has decreased.
+func (snapshot NetAmountSnapshot) IsBTokenValueDropped(prev NetAmountSnapshot) bool {
+	return prev.MintRate.IsPositive() && snapshot.MintRate.GT(prev.MintRate)
+}
@@ -196,11 +196,177 @@ func (m *MsgLiquidUnstakeResponse) GetCompletionTime() time.Time {
 	return time.Time{}
 }
 
+// MsgInstantLiquidUnstake defines a SDK message for performing an instant liquid unstake, which is paid from the
+// instant unstake buffer of the proxy account.
+type MsgInstantLiquidUnstake struct {
+	DelegatorAddress string     `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	Amount           types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgInstantLiquidUnstake) Reset()         { *m = MsgInstantLiquidUnstake{} }
+func (m *MsgInstantLiquidUnstake) String() string { return proto.CompactTextString(m) }
+func (*MsgInstantLiquidUnstake) ProtoMessage()    {}
+func (*MsgInstantLiquidUnstake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b383094577eb1fe7, []int{4}
+}
+func (m *MsgInstantLiquidUnstake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInstantLiquidUnstake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInstantLiquidUnstake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgInstantLiquidUnstake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInstantLiquidUnstake.Merge(m, src)
+}
+func (m *MsgInstantLiquidUnstake) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInstantLiquidUnstake) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInstantLiquidUnstake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInstantLiquidUnstake proto.InternalMessageInfo
+
+// MsgInstantLiquidUnstakeResponse defines the Msg/InstantLiquidUnstake response type.
+type MsgInstantLiquidUnstakeResponse struct {
+	UnstakedAmount types.Coin `protobuf:"bytes,1,opt,name=unstaked_amount,json=unstakedAmount,proto3" json:"unstaked_amount"`
+}
+
+func (m *MsgInstantLiquidUnstakeResponse) Reset()         { *m = MsgInstantLiquidUnstakeResponse{} }
+func (m *MsgInstantLiquidUnstakeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgInstantLiquidUnstakeResponse) ProtoMessage()    {}
+func (*MsgInstantLiquidUnstakeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b383094577eb1fe7, []int{5}
+}
+func (m *MsgInstantLiquidUnstakeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInstantLiquidUnstakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInstantLiquidUnstakeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgInstantLiquidUnstakeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInstantLiquidUnstakeResponse.Merge(m, src)
+}
+func (m *MsgInstantLiquidUnstakeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInstantLiquidUnstakeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInstantLiquidUnstakeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInstantLiquidUnstakeResponse proto.InternalMessageInfo
+
+func (m *MsgInstantLiquidUnstakeResponse) GetUnstakedAmount() types.Coin {
+	if m != nil {
+		return m.UnstakedAmount
+	}
+	return types.Coin{}
+}
+
+// MsgSetValidatorPreference defines a SDK message for setting the validator preference of a liquid staker.
+// The validator preference is removed if validators is empty.
+type MsgSetValidatorPreference struct {
+	DelegatorAddress string                 `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	Validators       []WhitelistedValidator `protobuf:"bytes,2,rep,name=validators,proto3" json:"validators"`
+}
+
+func (m *MsgSetValidatorPreference) Reset()         { *m = MsgSetValidatorPreference{} }
+func (m *MsgSetValidatorPreference) String() string { return proto.CompactTextString(m) }
+func (*MsgSetValidatorPreference) ProtoMessage()    {}
+func (*MsgSetValidatorPreference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b383094577eb1fe7, []int{6}
+}
+func (m *MsgSetValidatorPreference) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetValidatorPreference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetValidatorPreference.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetValidatorPreference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetValidatorPreference.Merge(m, src)
+}
+func (m *MsgSetValidatorPreference) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetValidatorPreference) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetValidatorPreference.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetValidatorPreference proto.InternalMessageInfo
+
+// MsgSetValidatorPreferenceResponse defines the Msg/SetValidatorPreference response type.
+type MsgSetValidatorPreferenceResponse struct {
+}
+
+func (m *MsgSetValidatorPreferenceResponse) Reset()         { *m = MsgSetValidatorPreferenceResponse{} }
+func (m *MsgSetValidatorPreferenceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetValidatorPreferenceResponse) ProtoMessage()    {}
+func (*MsgSetValidatorPreferenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b383094577eb1fe7, []int{7}
+}
+func (m *MsgSetValidatorPreferenceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetValidatorPreferenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetValidatorPreferenceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetValidatorPreferenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetValidatorPreferenceResponse.Merge(m, src)
+}
+func (m *MsgSetValidatorPreferenceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetValidatorPreferenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetValidatorPreferenceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetValidatorPreferenceResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgLiquidStake)(nil), "squad.liquidstaking.v1beta1.MsgLiquidStake")
 	proto.RegisterType((*MsgLiquidStakeResponse)(nil), "squad.liquidstaking.v1beta1.MsgLiquidStakeResponse")
 	proto.RegisterType((*MsgLiquidUnstake)(nil), "squad.liquidstaking.v1beta1.MsgLiquidUnstake")
 	proto.RegisterType((*MsgLiquidUnstakeResponse)(nil), "squad.liquidstaking.v1beta1.MsgLiquidUnstakeResponse")
+	proto.RegisterType((*MsgInstantLiquidUnstake)(nil), "squad.liquidstaking.v1beta1.MsgInstantLiquidUnstake")
+	proto.RegisterType((*MsgInstantLiquidUnstakeResponse)(nil), "squad.liquidstaking.v1beta1.MsgInstantLiquidUnstakeResponse")
+	proto.RegisterType((*MsgSetValidatorPreference)(nil), "squad.liquidstaking.v1beta1.MsgSetValidatorPreference")
+	proto.RegisterType((*MsgSetValidatorPreferenceResponse)(nil), "squad.liquidstaking.v1beta1.MsgSetValidatorPreferenceResponse")
 }
 
 func init() {
@@ -208,35 +374,45 @@ func init() {
 }
 
 var fileDescriptor_b383094577eb1fe7 = []byte{
-	// 445 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x93, 0xc1, 0x6a, 0xd4, 0x40,
-	0x18, 0xc7, 0x33, 0x2a, 0xa5, 0xce, 0x62, 0xad, 0x41, 0x24, 0x8d, 0x92, 0x94, 0xe0, 0xa1, 0x20,
-	0x9d, 0xa1, 0x5b, 0x54, 0xe8, 0xcd, 0x78, 0x12, 0x5c, 0x90, 0xa8, 0x17, 0x2f, 0xcb, 0x24, 0x19,
-	0xc7, 0xa1, 0x49, 0xbe, 0x74, 0x67, 0x22, 0xed, 0x1b, 0x78, 0xec, 0x13, 0xc8, 0x3e, 0x4e, 0x8f,
-	0x3d, 0x7a, 0xaa, 0xb2, 0x7b, 0xf1, 0xe2, 0xc5, 0x27, 0x90, 0x64, 0x92, 0x68, 0x2a, 0x88, 0x7b,
-	0xeb, 0x2d, 0xdf, 0xfc, 0x7f, 0x33, 0xdf, 0x7f, 0xe6, 0x9f, 0x0f, 0x3f, 0x54, 0x47, 0x15, 0x4b,
-	0x69, 0x26, 0x8f, 0x2a, 0x99, 0x2a, 0xcd, 0x0e, 0x65, 0x21, 0xe8, 0xc7, 0xbd, 0x98, 0x6b, 0xb6,
-	0x47, 0xf5, 0x31, 0x29, 0x67, 0xa0, 0xc1, 0xbe, 0xdf, 0x50, 0x64, 0x40, 0x91, 0x96, 0x72, 0xef,
-	0x0a, 0x10, 0xd0, 0x70, 0xb4, 0xfe, 0x32, 0x5b, 0xdc, 0xad, 0x04, 0x54, 0x0e, 0x6a, 0x6a, 0x04,
-	0x53, 0xb4, 0x92, 0x67, 0x2a, 0x1a, 0x33, 0xc5, 0xfb, 0x5e, 0x09, 0xc8, 0xa2, 0xd5, 0x7d, 0x01,
-	0x20, 0x32, 0x4e, 0x9b, 0x2a, 0xae, 0xde, 0x53, 0x2d, 0x73, 0xae, 0x34, 0xcb, 0x4b, 0x03, 0x04,
-	0x9f, 0x11, 0xde, 0x98, 0x28, 0xf1, 0xb2, 0xb1, 0xf3, 0x5a, 0xb3, 0x43, 0x6e, 0xbf, 0xc0, 0x77,
-	0x52, 0x9e, 0x71, 0xc1, 0x34, 0xcc, 0xa6, 0x2c, 0x4d, 0x67, 0x5c, 0x29, 0x07, 0x6d, 0xa3, 0x9d,
-	0x9b, 0xe1, 0x83, 0x9f, 0x17, 0xbe, 0x73, 0xc2, 0xf2, 0xec, 0x20, 0xf8, 0x0b, 0x09, 0xa2, 0xcd,
-	0x7e, 0xed, 0x99, 0x59, 0xb2, 0x9f, 0xe2, 0x35, 0x96, 0x43, 0x55, 0x68, 0xe7, 0xda, 0x36, 0xda,
-	0x19, 0x8d, 0xb7, 0x48, 0xeb, 0xbe, 0xf6, 0xdb, 0xdd, 0x9a, 0x3c, 0x07, 0x59, 0x84, 0x37, 0xce,
-	0x2e, 0x7c, 0x2b, 0x6a, 0xf1, 0x83, 0xf5, 0x4f, 0x73, 0xdf, 0xfa, 0x3e, 0xf7, 0xad, 0xc0, 0xc1,
-	0xf7, 0x86, 0xfe, 0x22, 0xae, 0x4a, 0x28, 0x14, 0x0f, 0xe6, 0x08, 0x6f, 0xf6, 0xd2, 0xdb, 0x42,
-	0x5d, 0x41, 0xf3, 0x12, 0x3b, 0x97, 0x1d, 0x76, 0xf6, 0xed, 0x09, 0xbe, 0x9d, 0x40, 0x5e, 0x66,
-	0x5c, 0x4b, 0x28, 0xa6, 0x75, 0x2e, 0x8d, 0xcf, 0xd1, 0xd8, 0x25, 0x26, 0x34, 0xd2, 0x85, 0x46,
-	0xde, 0x74, 0xa1, 0x85, 0xeb, 0x75, 0xa3, 0xd3, 0xaf, 0x3e, 0x8a, 0x36, 0x7e, 0x6f, 0xae, 0xe5,
-	0xf1, 0x0f, 0x84, 0xaf, 0x4f, 0x94, 0xb0, 0x01, 0x8f, 0xfe, 0x0c, 0xf3, 0x11, 0xf9, 0xc7, 0xff,
-	0x46, 0x86, 0x2f, 0xeb, 0xee, 0xaf, 0x00, 0xf7, 0xf7, 0xa8, 0xf0, 0xad, 0x61, 0x04, 0xbb, 0xff,
-	0x77, 0x4a, 0x8b, 0xbb, 0x8f, 0x57, 0xc2, 0xbb, 0xb6, 0xe1, 0xab, 0xb3, 0x85, 0x87, 0xce, 0x17,
-	0x1e, 0xfa, 0xb6, 0xf0, 0xd0, 0xe9, 0xd2, 0xb3, 0xce, 0x97, 0x9e, 0xf5, 0x65, 0xe9, 0x59, 0xef,
-	0x9e, 0x08, 0xa9, 0x3f, 0x54, 0x31, 0x49, 0x20, 0x6f, 0x87, 0xa5, 0x3e, 0x7f, 0x37, 0x63, 0xb1,
-	0xa2, 0x66, 0x44, 0x8f, 0x2f, 0x0d, 0xa9, 0x3e, 0x29, 0xb9, 0x8a, 0xd7, 0x9a, 0xf7, 0xde, 0xff,
-	0x15, 0x00, 0x00, 0xff, 0xff, 0x0b, 0xf4, 0xec, 0xb6, 0xc8, 0x03, 0x00, 0x00,
+	// 603 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0xf5, 0xb4, 0x55, 0xd5, 0x6f, 0xa2, 0xaf, 0x2d, 0x51, 0x55, 0x1c, 0x83, 0xec, 0x60, 0x58,
+	0x44, 0x42, 0xb5, 0x95, 0x14, 0x8a, 0x54, 0x21, 0xa4, 0x86, 0x0d, 0x95, 0x88, 0x54, 0xa5, 0x40,
+	0x25, 0x36, 0xd1, 0x38, 0x9e, 0x4e, 0x47, 0xb1, 0x3d, 0x69, 0x66, 0x5c, 0xb5, 0x6f, 0x80, 0x90,
+	0x90, 0xf2, 0x04, 0x28, 0x3b, 0x5e, 0xa5, 0xec, 0xba, 0x64, 0x55, 0x50, 0xb2, 0x61, 0xcd, 0x13,
+	0x20, 0xdb, 0x63, 0x93, 0x94, 0x24, 0xfd, 0x11, 0x8b, 0xee, 0x3c, 0x73, 0xcf, 0xb9, 0xe7, 0xdc,
+	0x7b, 0xc7, 0x33, 0xf0, 0x11, 0x3f, 0x0c, 0x91, 0x6b, 0x7b, 0xf4, 0x30, 0xa4, 0x2e, 0x17, 0xa8,
+	0x45, 0x03, 0x62, 0x1f, 0x95, 0x1d, 0x2c, 0x50, 0xd9, 0x16, 0xc7, 0x56, 0xbb, 0xc3, 0x04, 0xcb,
+	0xdf, 0x8b, 0x51, 0xd6, 0x08, 0xca, 0x92, 0x28, 0x6d, 0x85, 0x30, 0xc2, 0x62, 0x9c, 0x1d, 0x7d,
+	0x25, 0x14, 0xad, 0xd0, 0x64, 0xdc, 0x67, 0xbc, 0x91, 0x04, 0x92, 0x85, 0x0c, 0xe9, 0xc9, 0xca,
+	0x76, 0x10, 0xc7, 0x99, 0x56, 0x93, 0xd1, 0x40, 0xc6, 0x0d, 0xc2, 0x18, 0xf1, 0xb0, 0x1d, 0xaf,
+	0x9c, 0x70, 0xdf, 0x16, 0xd4, 0xc7, 0x5c, 0x20, 0xbf, 0x2d, 0x01, 0xf6, 0x34, 0xd3, 0xa3, 0x26,
+	0x63, 0x82, 0xf9, 0x19, 0xc0, 0xc5, 0x1a, 0x27, 0xaf, 0xe3, 0xd0, 0xae, 0x40, 0x2d, 0x9c, 0xdf,
+	0x86, 0x77, 0x5c, 0xec, 0x61, 0x82, 0x04, 0xeb, 0x34, 0x90, 0xeb, 0x76, 0x30, 0xe7, 0x2a, 0x28,
+	0x82, 0xd2, 0x7f, 0xd5, 0xfb, 0xbf, 0xce, 0x0d, 0xf5, 0x04, 0xf9, 0xde, 0xa6, 0xf9, 0x17, 0xc4,
+	0xac, 0x2f, 0x67, 0x7b, 0x5b, 0xc9, 0x56, 0xfe, 0x19, 0x9c, 0x47, 0x3e, 0x0b, 0x03, 0xa1, 0xce,
+	0x14, 0x41, 0x29, 0x57, 0x29, 0x58, 0xb2, 0xdc, 0xa8, 0xc0, 0xb4, 0x4d, 0xd6, 0x4b, 0x46, 0x83,
+	0xea, 0xdc, 0xe9, 0xb9, 0xa1, 0xd4, 0x25, 0x7c, 0x73, 0xe1, 0x43, 0xcf, 0x50, 0x7e, 0xf6, 0x0c,
+	0xc5, 0x54, 0xe1, 0xea, 0xa8, 0xbf, 0x3a, 0xe6, 0x6d, 0x16, 0x70, 0x6c, 0xf6, 0x00, 0x5c, 0xce,
+	0x42, 0x6f, 0x03, 0x7e, 0x0b, 0xcd, 0x53, 0xa8, 0x5e, 0x74, 0x98, 0xda, 0xcf, 0xd7, 0xe0, 0x52,
+	0x93, 0xf9, 0x6d, 0x0f, 0x0b, 0xca, 0x82, 0x46, 0x34, 0xc8, 0xd8, 0x67, 0xae, 0xa2, 0x59, 0xc9,
+	0x94, 0xad, 0x74, 0xca, 0xd6, 0x9b, 0x74, 0xca, 0xd5, 0x85, 0x48, 0xa8, 0xfb, 0xdd, 0x00, 0xf5,
+	0xc5, 0x3f, 0xe4, 0x28, 0x6c, 0x7e, 0x01, 0xf0, 0x6e, 0x8d, 0x93, 0xed, 0x48, 0x25, 0x10, 0xb7,
+	0xb9, 0x29, 0x2d, 0x68, 0x4c, 0x30, 0x9a, 0xf5, 0xe6, 0x15, 0x5c, 0x0a, 0x93, 0x2d, 0xb7, 0x21,
+	0xe5, 0xc0, 0xd5, 0xe4, 0x16, 0x53, 0xde, 0x56, 0x4c, 0x33, 0xbf, 0x02, 0x58, 0xa8, 0x71, 0xb2,
+	0x8b, 0xc5, 0x3b, 0xe4, 0x51, 0x37, 0x2a, 0x65, 0xa7, 0x83, 0xf7, 0x71, 0x07, 0x07, 0xcd, 0x7f,
+	0xda, 0x98, 0x3d, 0x08, 0x8f, 0x52, 0x05, 0xae, 0xce, 0x14, 0x67, 0x4b, 0xb9, 0x4a, 0xd9, 0x9a,
+	0x72, 0x3b, 0x58, 0x7b, 0x07, 0x54, 0x60, 0x8f, 0x72, 0x81, 0xdd, 0xcc, 0x9b, 0xac, 0x62, 0x28,
+	0xd5, 0x50, 0xe3, 0x1e, 0xc2, 0x07, 0x13, 0x4b, 0x49, 0x5b, 0x57, 0xf9, 0x34, 0x07, 0x67, 0x6b,
+	0x9c, 0xe4, 0x19, 0xcc, 0x0d, 0xff, 0xd4, 0x8f, 0xa7, 0x5a, 0x19, 0xfd, 0xc3, 0xb4, 0xf5, 0x6b,
+	0x80, 0xb3, 0x99, 0x85, 0xf0, 0xff, 0xd1, 0x53, 0xb7, 0x76, 0xb5, 0x2c, 0x12, 0xae, 0x3d, 0xbd,
+	0x16, 0x3c, 0x93, 0xfd, 0x08, 0xe0, 0xca, 0xd8, 0x43, 0xff, 0xe4, 0xb2, 0x7c, 0xe3, 0x58, 0xda,
+	0xf3, 0x9b, 0xb0, 0x32, 0x33, 0x5d, 0x00, 0x57, 0x27, 0x1c, 0xb5, 0x8d, 0xcb, 0x12, 0x8f, 0xe7,
+	0x69, 0x2f, 0x6e, 0xc6, 0x4b, 0x2d, 0x55, 0x77, 0x4e, 0xfb, 0x3a, 0x38, 0xeb, 0xeb, 0xe0, 0x47,
+	0x5f, 0x07, 0xdd, 0x81, 0xae, 0x9c, 0x0d, 0x74, 0xe5, 0xdb, 0x40, 0x57, 0xde, 0x6f, 0x10, 0x2a,
+	0x0e, 0x42, 0xc7, 0x6a, 0x32, 0x5f, 0xbe, 0x42, 0x91, 0xd0, 0x9a, 0x87, 0x1c, 0x2e, 0x9f, 0x91,
+	0xe3, 0x0b, 0x0f, 0x89, 0x38, 0x69, 0x63, 0xee, 0xcc, 0xc7, 0xf7, 0xd2, 0xfa, 0xef, 0x00, 0x00,
+	0x00, 0xff, 0xff, 0x33, 0x5e, 0x59, 0x7a, 0x21, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -257,6 +433,12 @@ type MsgClient interface {
 	// LiquidUnstake defines a method for performing an undelegation of liquid staking from a
 	// delegate.
 	LiquidUnstake(ctx context.Context, in *MsgLiquidUnstake, opts ...grpc.CallOption) (*MsgLiquidUnstakeResponse, error)
+	// InstantLiquidUnstake defines a method for performing an instant liquid unstake, which is paid from the
+	// instant unstake buffer of the proxy account without waiting for the unbonding period.
+	InstantLiquidUnstake(ctx context.Context, in *MsgInstantLiquidUnstake, opts ...grpc.CallOption) (*MsgInstantLiquidUnstakeResponse, error)
+	// SetValidatorPreference defines a method for setting the whitelisted validators and their weights which the
+	// liquid staker's liquid stakes are delegated to.
+	SetValidatorPreference(ctx context.Context, in *MsgSetValidatorPreference, opts ...grpc.CallOption) (*MsgSetValidatorPreferenceResponse, error)
 }
 
 type msgClient struct {
@@ -285,6 +467,24 @@ func (c *msgClient) LiquidUnstake(ctx context.Context, in *MsgLiquidUnstake, opt
 	return out, nil
 }
 
+func (c *msgClient) InstantLiquidUnstake(ctx context.Context, in *MsgInstantLiquidUnstake, opts ...grpc.CallOption) (*MsgInstantLiquidUnstakeResponse, error) {
+	out := new(MsgInstantLiquidUnstakeResponse)
+	err := c.cc.Invoke(ctx, "/squad.liquidstaking.v1beta1.Msg/InstantLiquidUnstake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetValidatorPreference(ctx context.Context, in *MsgSetValidatorPreference, opts ...grpc.CallOption) (*MsgSetValidatorPreferenceResponse, error) {
+	out := new(MsgSetValidatorPreferenceResponse)
+	err := c.cc.Invoke(ctx, "/squad.liquidstaking.v1beta1.Msg/SetValidatorPreference", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// LiquidStake defines a method for performing a delegation of coins
@@ -293,6 +493,12 @@ type MsgServer interface {
 	// LiquidUnstake defines a method for performing an undelegation of liquid staking from a
 	// delegate.
 	LiquidUnstake(context.Context, *MsgLiquidUnstake) (*MsgLiquidUnstakeResponse, error)
+	// InstantLiquidUnstake defines a method for performing an instant liquid unstake, which is paid from the
+	// instant unstake buffer of the proxy account without waiting for the unbonding period.
+	InstantLiquidUnstake(context.Context, *MsgInstantLiquidUnstake) (*MsgInstantLiquidUnstakeResponse, error)
+	// SetValidatorPreference defines a method for setting the whitelisted validators and their weights which the
+	// liquid staker's liquid stakes are delegated to.
+	SetValidatorPreference(context.Context, *MsgSetValidatorPreference) (*MsgSetValidatorPreferenceResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -305,6 +511,12 @@ func (*UnimplementedMsgServer) LiquidStake(ctx context.Context, req *MsgLiquidSt
 func (*UnimplementedMsgServer) LiquidUnstake(ctx context.Context, req *MsgLiquidUnstake) (*MsgLiquidUnstakeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LiquidUnstake not implemented")
 }
+func (*UnimplementedMsgServer) InstantLiquidUnstake(ctx context.Context, req *MsgInstantLiquidUnstake) (*MsgInstantLiquidUnstakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstantLiquidUnstake not implemented")
+}
+func (*UnimplementedMsgServer) SetValidatorPreference(ctx context.Context, req *MsgSetValidatorPreference) (*MsgSetValidatorPreferenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetValidatorPreference not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -346,6 +558,42 @@ func _Msg_LiquidUnstake_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_InstantLiquidUnstake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInstantLiquidUnstake)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).InstantLiquidUnstake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squad.liquidstaking.v1beta1.Msg/InstantLiquidUnstake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InstantLiquidUnstake(ctx, req.(*MsgInstantLiquidUnstake))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetValidatorPreference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetValidatorPreference)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetValidatorPreference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squad.liquidstaking.v1beta1.Msg/SetValidatorPreference",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetValidatorPreference(ctx, req.(*MsgSetValidatorPreference))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "squad.liquidstaking.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -358,6 +606,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LiquidUnstake",
 			Handler:    _Msg_LiquidUnstake_Handler,
 		},
+		{
+			MethodName: "InstantLiquidUnstake",
+			Handler:    _Msg_InstantLiquidUnstake_Handler,
+		},
+		{
+			MethodName: "SetValidatorPreference",
+			Handler:    _Msg_SetValidatorPreference_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "squad/liquidstaking/v1beta1/tx.proto",
@@ -497,6 +753,146 @@ func (m *MsgLiquidUnstakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgInstantLiquidUnstake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInstantLiquidUnstake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInstantLiquidUnstake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInstantLiquidUnstakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInstantLiquidUnstakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInstantLiquidUnstakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.UnstakedAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetValidatorPreference) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetValidatorPreference) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetValidatorPreference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetValidatorPreferenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetValidatorPreferenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetValidatorPreferenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -558,11 +954,65 @@ func (m *MsgLiquidUnstakeResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *MsgInstantLiquidUnstake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgInstantLiquidUnstakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.UnstakedAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetValidatorPreference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetValidatorPreferenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *MsgLiquidStake) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -927,6 +1377,370 @@ func (m *MsgLiquidUnstakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgInstantLiquidUnstake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInstantLiquidUnstake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInstantLiquidUnstake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInstantLiquidUnstakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInstantLiquidUnstakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInstantLiquidUnstakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnstakedAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnstakedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetValidatorPreference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetValidatorPreference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetValidatorPreference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, WhitelistedValidator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetValidatorPreferenceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetValidatorPreferenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetValidatorPreferenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
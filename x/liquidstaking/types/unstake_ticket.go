@@ -0,0 +1,44 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewUnstakeTicket returns a new UnstakeTicket.
+func NewUnstakeTicket(id uint64, liquidStaker sdk.AccAddress, bTokenBurned, expectedAmt sdk.Coin, completionTime time.Time) UnstakeTicket {
+	return UnstakeTicket{
+		Id:               id,
+		DelegatorAddress: liquidStaker.String(),
+		BtokenBurned:     bTokenBurned,
+		ExpectedAmount:   expectedAmt,
+		CompletionTime:   completionTime,
+	}
+}
+
+// Validate validates UnstakeTicket.
+func (ticket UnstakeTicket) Validate() error {
+	if ticket.Id == 0 {
+		return fmt.Errorf("id must not be 0")
+	}
+	if _, err := sdk.AccAddressFromBech32(ticket.DelegatorAddress); err != nil {
+		return fmt.Errorf("invalid delegator address %q: %w", ticket.DelegatorAddress, err)
+	}
+	if err := ticket.BtokenBurned.Validate(); err != nil {
+		return fmt.Errorf("invalid btoken burned: %w", err)
+	}
+	if err := ticket.ExpectedAmount.Validate(); err != nil {
+		return fmt.Errorf("invalid expected amount: %w", err)
+	}
+	return nil
+}
+
+func (ticket UnstakeTicket) GetDelegator() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(ticket.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
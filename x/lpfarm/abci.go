@@ -13,6 +13,9 @@ import (
 func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 
+	if err := k.UnlockExpiredPositions(ctx); err != nil {
+		panic(err)
+	}
 	if err := k.TerminateEndedPlans(ctx); err != nil {
 		panic(err)
 	}
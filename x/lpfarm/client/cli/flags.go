@@ -0,0 +1,32 @@
+package cli
+
+// DONTCOVER
+
+import (
+	flag "github.com/spf13/pflag"
+)
+
+const (
+	FlagEmissionCurve = "emission-curve"
+	FlagDecayRate     = "decay-rate"
+	FlagDecayPeriod   = "decay-period"
+	FlagLockDuration  = "lock-duration"
+)
+
+func flagSetCreatePrivateHeightPlan() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagEmissionCurve, "flat", "The emission curve of the plan, which must be one of flat, linear and exponential")
+	fs.String(FlagDecayRate, "", "The rate by which the emission decays every decay period; used only for the exponential emission curve")
+	fs.Int64(FlagDecayPeriod, 0, "The number of blocks after which the emission decays; used only for the exponential emission curve")
+
+	return fs
+}
+
+func flagSetFarm() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.Duration(FlagLockDuration, 0, "The lockup duration for the position, which must be one of the lockup durations in the params")
+
+	return fs
+}
@@ -31,6 +31,7 @@ func GetQueryCmd() *cobra.Command {
 		NewQueryFarmCmd(),
 		NewQueryPositionsCmd(),
 		NewQueryPositionCmd(),
+		NewQueryLockCmd(),
 		NewQueryHistoricalRewardsCmd(),
 		NewQueryTotalRewardsCmd(),
 		NewQueryRewardsCmd(),
@@ -250,6 +251,41 @@ $ %s query %s position cosmos1... pool1
 	return cmd
 }
 
+// NewQueryLockCmd implements the lock query cmd.
+func NewQueryLockCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lock [farmer] [denom]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the lock status of a specific position managed by the farmer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the lock status of a specific position managed by the farmer.
+
+Example:
+$ %s query %s lock cosmos1... pool1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Lock(cmd.Context(), &types.QueryLockRequest{
+				Farmer: args[0],
+				Denom:  args[1],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // NewQueryHistoricalRewardsCmd implements the historical rewards query cmd.
 func NewQueryHistoricalRewardsCmd() *cobra.Command {
 	cmd := &cobra.Command{
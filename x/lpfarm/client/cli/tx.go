@@ -31,6 +31,7 @@ func GetTxCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		NewCreatePrivatePlanCmd(),
+		NewCreatePrivateHeightPlanCmd(),
 		NewFarmCmd(),
 		NewUnfarmCmd(),
 		NewHarvestCmd(),
@@ -117,6 +118,118 @@ $ %s tx %s create-private-plan "New Farming Plan" 2022-01-01T00:00:00Z 2023-01-0
 	return cmd
 }
 
+func NewCreatePrivateHeightPlanCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-private-height-plan [description] [start-height] [end-height] [reward-allocations...]",
+		Args:  cobra.MinimumNArgs(4),
+		Short: "Create a new private farming plan with height schedule",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a new private farming plan with height schedule.
+The plan allocates the total rewards of each reward allocation over the blocks
+in [start-height, end-height), following the emission curve.
+The newly created plan's farming pool address is automatically generated and
+will have no balances in the account initially.
+Manually send enough reward coins to the generated farming pool address to make
+sure that the rewards allocation happens.
+The plan's termination address is set to the plan creator.
+
+[description]: a brief description of the plan
+[start-height]: the block height at which the plan begins
+[end-height]: the block height at which the plan ends
+[reward-allocations...]: whitespace-separated list of the reward allocations
+
+A reward allocation is specified in one of the following formats:
+1. <denom>:<total_rewards>[:<max_rewards_per_block>]
+2. pair<pair-id>:<total_rewards>[:<max_rewards_per_block>]
+
+Example:
+$ %s tx %s create-private-height-plan "New Farming Plan" 100000 200000 pair1:1000000000stake pool2:500000000stake:10000stake --from mykey
+$ %s tx %s create-private-height-plan "Halving Plan" 100000 500000 pair1:1000000000stake --emission-curve=exponential --decay-rate=0.5 --decay-period=100000 --from mykey
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			description := args[0]
+			startHeight, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid start height: %w", err)
+			}
+			endHeight, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid end height: %w", err)
+			}
+			var rewardAllocs []types.RewardAllocation
+			for _, arg := range args[3:] {
+				chunks := strings.Split(arg, ":")
+				if len(chunks) != 2 && len(chunks) != 3 {
+					return fmt.Errorf("invalid reward allocation: %s", arg)
+				}
+				target := chunks[0]
+				totalRewards, err := sdk.ParseCoinsNormalized(chunks[1])
+				if err != nil {
+					return fmt.Errorf("invalid reward allocation: %s: %w", arg, err)
+				}
+				var rewardAlloc types.RewardAllocation
+				if strings.HasPrefix(target, "pair") {
+					pairId, err := strconv.ParseUint(strings.TrimPrefix(target, "pair"), 10, 64)
+					if err != nil {
+						return fmt.Errorf("invalid reward allocation: %s: %w", arg, err)
+					}
+					rewardAlloc = types.NewPairBudgetRewardAllocation(pairId, totalRewards)
+				} else {
+					rewardAlloc = types.NewDenomBudgetRewardAllocation(target, totalRewards)
+				}
+				if len(chunks) == 3 {
+					rewardAlloc.MaxRewardsPerBlock, err = sdk.ParseCoinsNormalized(chunks[2])
+					if err != nil {
+						return fmt.Errorf("invalid reward allocation: %s: %w", arg, err)
+					}
+				}
+				rewardAllocs = append(rewardAllocs, rewardAlloc)
+			}
+
+			emissionCurveStr, _ := cmd.Flags().GetString(FlagEmissionCurve)
+			var emissionCurve types.EmissionCurve
+			switch strings.ToLower(emissionCurveStr) {
+			case "flat":
+				emissionCurve = types.EmissionCurveFlat
+			case "linear":
+				emissionCurve = types.EmissionCurveLinear
+			case "exponential":
+				emissionCurve = types.EmissionCurveExponential
+			default:
+				return fmt.Errorf("invalid emission curve: %s", emissionCurveStr)
+			}
+			decayRate := sdk.ZeroDec()
+			if decayRateStr, _ := cmd.Flags().GetString(FlagDecayRate); decayRateStr != "" {
+				decayRate, err = sdk.NewDecFromStr(decayRateStr)
+				if err != nil {
+					return fmt.Errorf("invalid decay rate: %w", err)
+				}
+			}
+			decayPeriod, _ := cmd.Flags().GetInt64(FlagDecayPeriod)
+
+			msg := types.NewMsgCreatePrivateHeightPlan(
+				clientCtx.GetFromAddress(), description, rewardAllocs,
+				types.NewHeightSchedule(startHeight, endHeight, emissionCurve, decayRate, decayPeriod))
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetCreatePrivateHeightPlan())
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func NewFarmCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "farm [coin]",
@@ -124,11 +237,16 @@ func NewFarmCmd() *cobra.Command {
 		Short: "Start farming coin",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Start farming coin.
+With --lock-duration, the whole position is locked for the duration and
+can't be unfarmed until the lock expires, in exchange for boosted rewards.
+The lock duration must be one of the lockup durations in the params.
 
 Example:
 $ %s tx %s farm 1000000pool1 --from mykey
+$ %s tx %s farm 1000000pool1 --lock-duration=720h --from mykey
 `,
 				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -142,12 +260,15 @@ $ %s tx %s farm 1000000pool1 --from mykey
 				return fmt.Errorf("invalid coin: %w", err)
 			}
 
-			msg := types.NewMsgFarm(clientCtx.GetFromAddress(), coin)
+			lockDuration, _ := cmd.Flags().GetDuration(FlagLockDuration)
+
+			msg := types.NewMsgFarmWithLockup(clientCtx.GetFromAddress(), coin, lockDuration)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().AddFlagSet(flagSetFarm())
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -11,6 +13,35 @@ import (
 // The farmer's rewards accrued in the given coin's denom are sent to the farmer.
 // Farm creates a new farm object for the given coin's denom, if there wasn't.
 func (k Keeper) Farm(ctx sdk.Context, farmerAddr sdk.AccAddress, coin sdk.Coin) (withdrawnRewards sdk.Coins, err error) {
+	return k.FarmWithLockup(ctx, farmerAddr, coin, 0)
+}
+
+// FarmWithLockup locks the coin like Farm, and also locks the farmer's
+// position for the lockup duration if the duration is positive.
+// A locked position can't be unfarmed until the lock expires and its rewards
+// are boosted by the multiplier for the lockup duration.
+// The lockup applies to the whole position, including the amount farmed
+// before.
+func (k Keeper) FarmWithLockup(
+	ctx sdk.Context, farmerAddr sdk.AccAddress, coin sdk.Coin, lockDuration time.Duration,
+) (withdrawnRewards sdk.Coins, err error) {
+	position, positionFound := k.GetPosition(ctx, farmerAddr, coin.Denom)
+
+	var lockEndTime time.Time
+	if lockDuration > 0 {
+		if _, ok := types.LockupBoostMultiplier(k.GetLockupBoosts(ctx), lockDuration); !ok {
+			return nil, sdkerrors.Wrapf(
+				sdkerrors.ErrInvalidRequest, "lockup duration %s is not allowed", lockDuration)
+		}
+		lockEndTime = ctx.BlockTime().Add(lockDuration)
+		if positionFound && lockEndTime.Before(position.LockEndTime) {
+			return nil, sdkerrors.Wrapf(
+				sdkerrors.ErrInvalidRequest,
+				"lock end time %s must not be before the current lock end time %s",
+				lockEndTime, position.LockEndTime)
+		}
+	}
+
 	farmingReserveAddr := types.DeriveFarmingReserveAddress(coin.Denom)
 	if err := k.bankKeeper.SendCoins(
 		ctx, farmerAddr, farmingReserveAddr, sdk.NewCoins(coin)); err != nil {
@@ -22,13 +53,13 @@ func (k Keeper) Farm(ctx sdk.Context, farmerAddr sdk.AccAddress, coin sdk.Coin)
 		k.initializeFarm(ctx, coin.Denom)
 	}
 
-	position, found := k.GetPosition(ctx, farmerAddr, coin.Denom)
-	if !found {
+	if !positionFound {
 		k.incrementFarmPeriod(ctx, coin.Denom)
 		position = types.Position{
 			Farmer:        farmerAddr.String(),
 			Denom:         coin.Denom,
 			FarmingAmount: sdk.ZeroInt(),
+			BoostAmount:   sdk.ZeroInt(),
 		}
 	} else {
 		withdrawnRewards, err = k.withdrawRewards(ctx, position)
@@ -37,17 +68,30 @@ func (k Keeper) Farm(ctx sdk.Context, farmerAddr sdk.AccAddress, coin sdk.Coin)
 		}
 	}
 
+	if lockDuration > 0 {
+		if !position.LockEndTime.IsZero() {
+			k.DeleteLockupQueueEntry(ctx, position.LockEndTime, farmerAddr, coin.Denom)
+		}
+		position.LockDuration = lockDuration
+		position.LockEndTime = lockEndTime
+		k.SetLockupQueueEntry(ctx, lockEndTime, farmerAddr, coin.Denom)
+	}
+
 	farm, _ := k.GetFarm(ctx, coin.Denom)
 	farm.TotalFarmingAmount = farm.TotalFarmingAmount.Add(coin.Amount)
-	k.SetFarm(ctx, coin.Denom, farm)
 
+	position.FarmingStartTime = position.FarmingStartTimeAfterFarm(coin.Amount, ctx.BlockTime())
 	position.FarmingAmount = position.FarmingAmount.Add(coin.Amount)
+	k.updateBoostAmount(ctx, &farm, &position)
+	k.SetFarm(ctx, coin.Denom, farm)
 	k.updatePosition(ctx, position)
 
 	if err := ctx.EventManager().EmitTypedEvent(&types.EventFarm{
 		Farmer:           farmerAddr.String(),
 		Coin:             coin,
 		WithdrawnRewards: withdrawnRewards,
+		LockDuration:     position.LockDuration,
+		LockEndTime:      position.LockEndTime,
 	}); err != nil {
 		return nil, err
 	}
@@ -67,25 +111,30 @@ func (k Keeper) Unfarm(ctx sdk.Context, farmerAddr sdk.AccAddress, coin sdk.Coin
 	if position.FarmingAmount.LT(coin.Amount) {
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "not enough farming amount")
 	}
+	if position.IsLocked(ctx.BlockTime()) {
+		return nil, sdkerrors.Wrapf(
+			types.ErrPositionLocked, "position is locked until %s", position.LockEndTime)
+	}
 
 	withdrawnRewards, err = k.withdrawRewards(ctx, position)
 	if err != nil {
 		return nil, err
 	}
 
-	position.FarmingAmount = position.FarmingAmount.Sub(coin.Amount)
-	if position.FarmingAmount.IsZero() {
-		k.DeletePosition(ctx, farmerAddr, coin.Denom)
-	} else {
-		k.updatePosition(ctx, position)
-	}
-
 	farm, found := k.GetFarm(ctx, coin.Denom)
 	if !found {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrNotFound, "farm not found")
 	}
 	farm.TotalFarmingAmount = farm.TotalFarmingAmount.Sub(coin.Amount)
+
+	position.FarmingAmount = position.FarmingAmount.Sub(coin.Amount)
+	k.updateBoostAmount(ctx, &farm, &position)
 	k.SetFarm(ctx, coin.Denom, farm)
+	if position.FarmingAmount.IsZero() {
+		k.DeletePosition(ctx, farmerAddr, coin.Denom)
+	} else {
+		k.updatePosition(ctx, position)
+	}
 
 	farmingReserveAddr := types.DeriveFarmingReserveAddress(coin.Denom)
 	if err := k.bankKeeper.SendCoins(ctx, farmingReserveAddr, farmerAddr, sdk.NewCoins(coin)); err != nil {
@@ -115,6 +164,10 @@ func (k Keeper) Harvest(ctx sdk.Context, farmerAddr sdk.AccAddress, denom string
 		return nil, err
 	}
 
+	// Reflect the changes of the lockup boosts param, if any.
+	farm, _ := k.GetFarm(ctx, denom)
+	k.updateBoostAmount(ctx, &farm, &position)
+	k.SetFarm(ctx, denom, farm)
 	k.updatePosition(ctx, position)
 
 	if err := ctx.EventManager().EmitTypedEvent(&types.EventHarvest{
@@ -158,7 +211,7 @@ func (k Keeper) calculateRewards(ctx sdk.Context, position types.Position, endPe
 	}
 	startPeriod := position.PreviousPeriod
 	return k.rewardsBetweenPeriods(
-		ctx, position.Denom, startPeriod, endPeriod, position.FarmingAmount)
+		ctx, position.Denom, startPeriod, endPeriod, position.RewardWeight())
 }
 
 // initializeFarm creates a new farm object in the store, along with historical
@@ -169,6 +222,7 @@ func (k Keeper) initializeFarm(ctx sdk.Context, denom string) types.Farm {
 		CurrentRewards:     sdk.DecCoins{},
 		OutstandingRewards: sdk.DecCoins{},
 		Period:             1,
+		TotalBoostAmount:   sdk.ZeroInt(),
 	}
 	k.SetFarm(ctx, denom, farm)
 	k.SetHistoricalRewards(ctx, denom, 0, types.HistoricalRewards{
@@ -199,8 +253,8 @@ func (k Keeper) incrementFarmPeriod(ctx sdk.Context, denom string) (prevPeriod u
 		panic("farm not found")
 	}
 	unitRewards := sdk.DecCoins{}
-	if farm.TotalFarmingAmount.IsPositive() {
-		unitRewards = farm.CurrentRewards.QuoDecTruncate(sdk.NewDecFromInt(farm.TotalFarmingAmount))
+	if totalWeight := farm.TotalRewardWeight(); totalWeight.IsPositive() {
+		unitRewards = farm.CurrentRewards.QuoDecTruncate(sdk.NewDecFromInt(totalWeight))
 	}
 	hist, found := k.GetHistoricalRewards(ctx, denom, farm.Period-1)
 	if !found { // Sanity check
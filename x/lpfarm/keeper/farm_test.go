@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -134,6 +136,26 @@ func (s *KeeperTestSuite) TestFarm_MultipleTimes() {
 	s.Require().EqualValues(4, farm.Period)
 }
 
+func (s *KeeperTestSuite) TestFarm_FarmingStartTime() {
+	farmerAddr := utils.TestAddress(0)
+	startTime := s.ctx.BlockTime()
+	s.farm(farmerAddr, utils.ParseCoin("1000000pool1"))
+	position, _ := s.keeper.GetPosition(s.ctx, farmerAddr, "pool1")
+	s.Require().Equal(startTime, position.FarmingStartTime)
+
+	// Farming the same amount again moves the start time halfway.
+	s.ctx = s.ctx.WithBlockTime(startTime.Add(10 * 24 * time.Hour))
+	s.farm(farmerAddr, utils.ParseCoin("1000000pool1"))
+	position, _ = s.keeper.GetPosition(s.ctx, farmerAddr, "pool1")
+	s.Require().Equal(startTime.Add(5*24*time.Hour), position.FarmingStartTime)
+
+	// Unfarming doesn't change the start time.
+	s.ctx = s.ctx.WithBlockTime(startTime.Add(20 * 24 * time.Hour))
+	s.unfarm(farmerAddr, utils.ParseCoin("500000pool1"))
+	position, _ = s.keeper.GetPosition(s.ctx, farmerAddr, "pool1")
+	s.Require().Equal(startTime.Add(5*24*time.Hour), position.FarmingStartTime)
+}
+
 func (s *KeeperTestSuite) TestUnfarm() {
 	s.createPairWithLastPrice("denom1", "denom2", sdk.NewDec(1))
 	s.createPool(1, utils.ParseCoins("100_000000denom1,100_000000denom2"))
@@ -27,6 +27,9 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	}
 	for _, position := range genState.Positions {
 		k.SetPosition(ctx, position)
+		if !position.LockEndTime.IsZero() {
+			k.SetLockupQueueEntry(ctx, position.LockEndTime, sdk.MustAccAddressFromBech32(position.Farmer), position.Denom)
+		}
 	}
 	for _, hist := range genState.HistoricalRewards {
 		k.SetHistoricalRewards(ctx, hist.Denom, hist.Period, hist.HistoricalRewards)
@@ -75,7 +78,13 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 			return false
 		})
 
+	params := k.GetParams(ctx)
+	// Initialize objects to prevent from having nil slice
+	if params.LockupBoosts == nil {
+		params.LockupBoosts = []types.LockupBoost{}
+	}
+
 	return types.NewGenesisState(
-		k.GetParams(ctx), lastBlockTimePtr, lastPlanId, k.GetNumPrivatePlans(ctx),
+		params, lastBlockTimePtr, lastPlanId, k.GetNumPrivatePlans(ctx),
 		plans, farms, positions, hists)
 }
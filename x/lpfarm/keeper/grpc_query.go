@@ -127,6 +127,33 @@ func (k Querier) Position(c context.Context, req *types.QueryPositionRequest) (*
 	return &types.QueryPositionResponse{Position: position}, nil
 }
 
+func (k Querier) Lock(c context.Context, req *types.QueryLockRequest) (*types.QueryLockResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	farmerAddr, err := sdk.AccAddressFromBech32(req.Farmer)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid farmer address: %v", err)
+	}
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid denom: %v", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	position, found := k.GetPosition(ctx, farmerAddr, req.Denom)
+	if !found {
+		return nil, status.Error(codes.NotFound, "position not found")
+	}
+
+	return &types.QueryLockResponse{
+		Locked:          position.IsLocked(ctx.BlockTime()),
+		LockDuration:    position.LockDuration,
+		LockEndTime:     position.LockEndTime,
+		BoostMultiplier: k.PositionBoostMultiplier(ctx, position),
+		BoostAmount:     position.GetBoostAmount(),
+	}, nil
+}
+
 func (k Querier) HistoricalRewards(c context.Context, req *types.QueryHistoricalRewardsRequest) (*types.QueryHistoricalRewardsResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	utils "github.com/cosmosquad-labs/squad/v3/types"
@@ -293,6 +295,84 @@ func (s *KeeperTestSuite) TestGRPCPosition() {
 	}
 }
 
+func (s *KeeperTestSuite) TestGRPCLock() {
+	s.setupLockupFarm()
+
+	farmerAddr := utils.TestAddress(0)
+	s.farmWithLockup(farmerAddr, utils.ParseCoin("1_000000pool1"), time.Minute)
+	s.farm(farmerAddr, utils.ParseCoin("1_000000pool2"))
+
+	for _, tc := range []struct {
+		name        string
+		req         *types.QueryLockRequest
+		expectedErr string
+		postRun     func(resp *types.QueryLockResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			"rpc error: code = InvalidArgument desc = empty request",
+			nil,
+		},
+		{
+			"locked position",
+			&types.QueryLockRequest{
+				Farmer: farmerAddr.String(),
+				Denom:  "pool1",
+			},
+			"",
+			func(resp *types.QueryLockResponse) {
+				s.Require().True(resp.Locked)
+				s.Require().Equal(time.Minute, resp.LockDuration)
+				s.Require().Equal(s.ctx.BlockTime().Add(time.Minute), resp.LockEndTime)
+				s.assertEq(utils.ParseDec("3"), resp.BoostMultiplier)
+				s.assertEq(sdk.NewInt(2_000000), resp.BoostAmount)
+			},
+		},
+		{
+			"unlocked position",
+			&types.QueryLockRequest{
+				Farmer: farmerAddr.String(),
+				Denom:  "pool2",
+			},
+			"",
+			func(resp *types.QueryLockResponse) {
+				s.Require().False(resp.Locked)
+				s.assertEq(sdk.OneDec(), resp.BoostMultiplier)
+				s.assertEq(sdk.ZeroInt(), resp.BoostAmount)
+			},
+		},
+		{
+			"position not found",
+			&types.QueryLockRequest{
+				Farmer: farmerAddr.String(),
+				Denom:  "pool3",
+			},
+			"rpc error: code = NotFound desc = position not found",
+			nil,
+		},
+		{
+			"invalid farmer address",
+			&types.QueryLockRequest{
+				Farmer: "invalidaddr",
+				Denom:  "pool1",
+			},
+			"rpc error: code = InvalidArgument desc = invalid farmer address: decoding bech32 failed: invalid separator index -1",
+			nil,
+		},
+	} {
+		s.Run(tc.name, func() {
+			resp, err := s.querier.Lock(sdk.WrapSDKContext(s.ctx), tc.req)
+			if tc.expectedErr == "" {
+				s.Require().NoError(err)
+				tc.postRun(resp)
+			} else {
+				s.Require().EqualError(err, tc.expectedErr)
+			}
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestGRPCHistoricalRewards() {
 	s.createSamplePlans()
 	farmerAddr := utils.TestAddress(0)
@@ -13,6 +13,7 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 	ir.RegisterRoute(types.ModuleName, "current-rewards", OutstandingRewardsInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "can-withdraw", CanWithdrawInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "total-farming-amount", TotalFarmingAmountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "total-boost-amount", TotalBoostAmountInvariant(k))
 }
 
 func AllInvariants(k Keeper) sdk.Invariant {
@@ -29,7 +30,11 @@ func AllInvariants(k Keeper) sdk.Invariant {
 		if broken {
 			return
 		}
-		return TotalFarmingAmountInvariant(k)(ctx)
+		res, broken = TotalFarmingAmountInvariant(k)(ctx)
+		if broken {
+			return
+		}
+		return TotalBoostAmountInvariant(k)(ctx)
 	}
 }
 
@@ -166,3 +171,41 @@ func TotalFarmingAmountInvariant(k Keeper) sdk.Invariant {
 		), broken
 	}
 }
+
+// TotalBoostAmountInvariant checks that all farm's total boost amount are
+// equal to the sum of all the positions' boost amount which belong to the farm.
+func TotalBoostAmountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		totalBoostAmtByDenom := map[string]sdk.Int{}
+		boostAmtSumByDenom := map[string]sdk.Int{}
+		k.IterateAllFarms(ctx, func(denom string, farm types.Farm) (stop bool) {
+			totalBoostAmtByDenom[denom] = farm.GetTotalBoostAmount()
+			boostAmtSumByDenom[denom] = sdk.ZeroInt()
+			return false
+		})
+		k.IterateAllPositions(ctx, func(position types.Position) (stop bool) {
+			boostAmtSumByDenom[position.Denom] =
+				boostAmtSumByDenom[position.Denom].Add(position.GetBoostAmount())
+			return false
+		})
+		msg := ""
+		cnt := 0
+		for denom := range totalBoostAmtByDenom {
+			if !totalBoostAmtByDenom[denom].Equal(boostAmtSumByDenom[denom]) {
+				msg += fmt.Sprintf(
+					"\tfarm %s total boost amount %s != sum %s\n",
+					denom, totalBoostAmtByDenom[denom], boostAmtSumByDenom[denom],
+				)
+				cnt++
+			}
+		}
+		broken := cnt != 0
+		return sdk.FormatInvariant(
+			types.ModuleName, "total boost amount",
+			fmt.Sprintf(
+				"found %d farm(s) with wrong total boost amount\n%s",
+				cnt, msg,
+			),
+		), broken
+	}
+}
@@ -157,6 +157,17 @@ func (s *KeeperTestSuite) createPrivatePlan(rewardAllocs []types.RewardAllocatio
 	return plan
 }
 
+func (s *KeeperTestSuite) createPrivateHeightPlan(
+	rewardAllocs []types.RewardAllocation, heightSchedule types.HeightSchedule, initialFunds sdk.Coins) types.Plan {
+	s.T().Helper()
+	s.fundAddr(helperAddr, s.keeper.GetPrivatePlanCreationFee(s.ctx))
+	plan, err := s.keeper.CreatePrivateHeightPlan(
+		s.ctx, helperAddr, "", rewardAllocs, heightSchedule)
+	s.Require().NoError(err)
+	s.fundAddr(plan.GetFarmingPoolAddress(), initialFunds)
+	return plan
+}
+
 func (s *KeeperTestSuite) createPublicPlan(farmingPoolAddr sdk.AccAddress, rewardAllocs []types.RewardAllocation) types.Plan {
 	s.T().Helper()
 	plan, err := s.keeper.CreatePublicPlan(
@@ -173,6 +184,14 @@ func (s *KeeperTestSuite) farm(farmerAddr sdk.AccAddress, coin sdk.Coin) sdk.Coi
 	return withdrawnRewards
 }
 
+func (s *KeeperTestSuite) farmWithLockup(farmerAddr sdk.AccAddress, coin sdk.Coin, lockDuration time.Duration) sdk.Coins {
+	s.T().Helper()
+	s.fundAddr(farmerAddr, sdk.NewCoins(coin))
+	withdrawnRewards, err := s.keeper.FarmWithLockup(s.ctx, farmerAddr, coin, lockDuration)
+	s.Require().NoError(err)
+	return withdrawnRewards
+}
+
 func (s *KeeperTestSuite) unfarm(farmerAddr sdk.AccAddress, coin sdk.Coin) sdk.Coins {
 	s.T().Helper()
 	withdrawnRewards, err := s.keeper.Unfarm(s.ctx, farmerAddr, coin)
@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmosquad-labs/squad/v3/x/lpfarm/types"
+)
+
+// UnlockExpiredPositions unlocks the positions whose lock has expired by the
+// current block time.
+// The rewards accrued so far with the boost are sent to the farmer and the
+// boost is removed from the position.
+func (k Keeper) UnlockExpiredPositions(ctx sdk.Context) error {
+	type lockupQueueEntry struct {
+		lockEndTime time.Time
+		farmerAddr  sdk.AccAddress
+		denom       string
+	}
+	var entries []lockupQueueEntry
+	k.IterateLockupQueueUntil(ctx, ctx.BlockTime(), func(lockEndTime time.Time, farmerAddr sdk.AccAddress, denom string) (stop bool) {
+		entries = append(entries, lockupQueueEntry{lockEndTime, farmerAddr, denom})
+		return false
+	})
+
+	for _, entry := range entries {
+		k.DeleteLockupQueueEntry(ctx, entry.lockEndTime, entry.farmerAddr, entry.denom)
+		position, found := k.GetPosition(ctx, entry.farmerAddr, entry.denom)
+		if !found || !position.LockEndTime.Equal(entry.lockEndTime) { // Sanity check
+			continue
+		}
+
+		withdrawnRewards, err := k.withdrawRewards(ctx, position)
+		if err != nil {
+			return err
+		}
+
+		position.LockDuration = 0
+		position.LockEndTime = time.Time{}
+		farm, _ := k.GetFarm(ctx, entry.denom)
+		k.updateBoostAmount(ctx, &farm, &position)
+		k.SetFarm(ctx, entry.denom, farm)
+		k.updatePosition(ctx, position)
+
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventUnlockPosition{
+			Farmer:           position.Farmer,
+			Denom:            position.Denom,
+			WithdrawnRewards: withdrawnRewards,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PositionBoostMultiplier returns the boost multiplier currently applicable to
+// the position.
+// It is 1 if the position is not locked or its lockup duration is no longer
+// in the lockup boosts param.
+func (k Keeper) PositionBoostMultiplier(ctx sdk.Context, position types.Position) sdk.Dec {
+	if !position.IsLocked(ctx.BlockTime()) {
+		return sdk.OneDec()
+	}
+	multiplier, _ := types.LockupBoostMultiplier(k.GetLockupBoosts(ctx), position.LockDuration)
+	return multiplier
+}
+
+// updateBoostAmount updates the position's boost amount based on its
+// farming amount and lockup, along with the farm's total boost amount.
+// The caller must have settled the position's rewards before calling this,
+// since the position's reward weight changes.
+func (k Keeper) updateBoostAmount(ctx sdk.Context, farm *types.Farm, position *types.Position) {
+	boostAmt := types.BoostAmount(position.FarmingAmount, k.PositionBoostMultiplier(ctx, *position))
+	farm.TotalBoostAmount = farm.GetTotalBoostAmount().Sub(position.GetBoostAmount()).Add(boostAmt)
+	position.BoostAmount = boostAmt
+}
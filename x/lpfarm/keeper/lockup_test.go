@@ -0,0 +1,179 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/cosmosquad-labs/squad/v3/types"
+	"github.com/cosmosquad-labs/squad/v3/x/lpfarm/keeper"
+	"github.com/cosmosquad-labs/squad/v3/x/lpfarm/types"
+)
+
+func (s *KeeperTestSuite) setupLockupFarm() {
+	s.T().Helper()
+	s.keeper.SetLockupBoosts(s.ctx, []types.LockupBoost{
+		types.NewLockupBoost(30*time.Second, utils.ParseDec("2")),
+		types.NewLockupBoost(time.Minute, utils.ParseDec("3")),
+	})
+	s.createPairWithLastPrice("denom1", "denom2", sdk.NewDec(1))
+	s.createPool(1, utils.ParseCoins("100_000000denom1,100_000000denom2"))
+	s.createPrivatePlan([]types.RewardAllocation{
+		types.NewPairRewardAllocation(1, utils.ParseCoins("100_000000stake")),
+	}, utils.ParseCoins("10000_000000stake"))
+}
+
+func (s *KeeperTestSuite) assertInvariants() {
+	s.T().Helper()
+	res, broken := keeper.AllInvariants(s.keeper)(s.ctx)
+	s.Require().False(broken, res)
+}
+
+func (s *KeeperTestSuite) TestFarmWithLockup_Boost() {
+	s.setupLockupFarm()
+
+	farmerAddr1, farmerAddr2 := utils.TestAddress(0), utils.TestAddress(1)
+	s.farm(farmerAddr1, utils.ParseCoin("1_000000pool1"))
+	s.farmWithLockup(farmerAddr2, utils.ParseCoin("1_000000pool1"), 30*time.Second)
+
+	position, _ := s.keeper.GetPosition(s.ctx, farmerAddr2, "pool1")
+	s.assertEq(sdk.NewInt(1_000000), position.BoostAmount)
+	s.Require().Equal(30*time.Second, position.LockDuration)
+	s.Require().Equal(s.ctx.BlockTime().Add(30*time.Second), position.LockEndTime)
+	farm, _ := s.keeper.GetFarm(s.ctx, "pool1")
+	s.assertEq(sdk.NewInt(2_000000), farm.TotalFarmingAmount)
+	s.assertEq(sdk.NewInt(1_000000), farm.TotalBoostAmount)
+
+	s.nextBlock()
+	s.assertInvariants()
+
+	// The locked position earns twice the rewards of the unlocked position.
+	// Block rewards = 100_000000(stake) * 5(secs) / 86400(secs) ~= 5787(stake)
+	s.assertEq(utils.ParseDecCoins("1929stake"), s.rewards(farmerAddr1, "pool1"))
+	s.assertEq(utils.ParseDecCoins("3858stake"), s.rewards(farmerAddr2, "pool1"))
+}
+
+func (s *KeeperTestSuite) TestFarmWithLockup_NotAllowedDuration() {
+	s.setupLockupFarm()
+
+	farmerAddr := utils.TestAddress(0)
+	s.fundAddr(farmerAddr, utils.ParseCoins("1_000000pool1"))
+	_, err := s.keeper.FarmWithLockup(s.ctx, farmerAddr, utils.ParseCoin("1_000000pool1"), 45*time.Second)
+	s.Require().EqualError(err, "lockup duration 45s is not allowed: invalid request")
+}
+
+func (s *KeeperTestSuite) TestFarmWithLockup_EarlierLockEndTime() {
+	s.setupLockupFarm()
+
+	farmerAddr := utils.TestAddress(0)
+	s.farmWithLockup(farmerAddr, utils.ParseCoin("1_000000pool1"), time.Minute)
+	s.nextBlock()
+
+	s.fundAddr(farmerAddr, utils.ParseCoins("1_000000pool1"))
+	_, err := s.keeper.FarmWithLockup(s.ctx, farmerAddr, utils.ParseCoin("1_000000pool1"), 30*time.Second)
+	s.Require().EqualError(
+		err, "lock end time 2022-01-01 00:00:35 +0000 UTC must not be before "+
+			"the current lock end time 2022-01-01 00:01:00 +0000 UTC: invalid request")
+
+	// Extending the lock is allowed.
+	s.farmWithLockup(farmerAddr, utils.ParseCoin("1_000000pool1"), time.Minute)
+	position, _ := s.keeper.GetPosition(s.ctx, farmerAddr, "pool1")
+	s.Require().Equal(utils.ParseTime("2022-01-01T00:01:05Z"), position.LockEndTime)
+	// The whole position is boosted.
+	s.assertEq(sdk.NewInt(4_000000), position.BoostAmount)
+
+	// The position is still locked at the previous lock end time.
+	for s.ctx.BlockTime().Before(utils.ParseTime("2022-01-01T00:01:00Z")) {
+		s.nextBlock()
+	}
+	position, _ = s.keeper.GetPosition(s.ctx, farmerAddr, "pool1")
+	s.Require().True(position.IsLocked(s.ctx.BlockTime()))
+	s.nextBlock()
+	position, _ = s.keeper.GetPosition(s.ctx, farmerAddr, "pool1")
+	s.Require().False(position.IsLocked(s.ctx.BlockTime()))
+	s.assertInvariants()
+}
+
+func (s *KeeperTestSuite) TestUnfarm_Locked() {
+	s.setupLockupFarm()
+
+	farmerAddr := utils.TestAddress(0)
+	s.farmWithLockup(farmerAddr, utils.ParseCoin("1_000000pool1"), 30*time.Second)
+	// Farming without a lockup on a locked position locks the added amount, too.
+	s.farm(farmerAddr, utils.ParseCoin("1_000000pool1"))
+	position, _ := s.keeper.GetPosition(s.ctx, farmerAddr, "pool1")
+	s.assertEq(sdk.NewInt(2_000000), position.BoostAmount)
+
+	s.nextBlock()
+	_, err := s.keeper.Unfarm(s.ctx, farmerAddr, utils.ParseCoin("1_000000pool1"))
+	s.Require().EqualError(
+		err, "position is locked until 2022-01-01 00:00:30 +0000 UTC: position is locked")
+
+	for s.ctx.BlockTime().Before(utils.ParseTime("2022-01-01T00:00:30Z")) {
+		s.nextBlock()
+	}
+	s.assertInvariants()
+
+	// The position has been unlocked at the beginning of the block and its
+	// rewards have been withdrawn.
+	position, _ = s.keeper.GetPosition(s.ctx, farmerAddr, "pool1")
+	s.assertEq(sdk.ZeroInt(), position.BoostAmount)
+	s.Require().True(position.LockEndTime.IsZero())
+	s.Require().EqualValues(0, position.LockDuration)
+	farm, _ := s.keeper.GetFarm(s.ctx, "pool1")
+	s.assertEq(sdk.ZeroInt(), farm.TotalBoostAmount)
+	s.Require().True(s.getBalances(farmerAddr).AmountOf("stake").IsPositive())
+
+	s.unfarm(farmerAddr, utils.ParseCoin("2_000000pool1"))
+	s.assertEq(sdk.NewInt(2_000000), s.getBalances(farmerAddr).AmountOf("pool1"))
+	s.assertInvariants()
+}
+
+func (s *KeeperTestSuite) TestHarvest_LockupBoostsChanged() {
+	s.setupLockupFarm()
+
+	farmerAddr := utils.TestAddress(0)
+	s.farmWithLockup(farmerAddr, utils.ParseCoin("1_000000pool1"), time.Minute)
+	s.nextBlock()
+
+	// The lockup duration is removed from the params, so the position loses
+	// its boost when it's touched next time.
+	s.keeper.SetLockupBoosts(s.ctx, []types.LockupBoost{
+		types.NewLockupBoost(30*time.Second, utils.ParseDec("2")),
+	})
+	s.harvest(farmerAddr, "pool1")
+	position, _ := s.keeper.GetPosition(s.ctx, farmerAddr, "pool1")
+	s.assertEq(sdk.ZeroInt(), position.BoostAmount)
+	s.Require().True(position.IsLocked(s.ctx.BlockTime()))
+	s.assertInvariants()
+}
+
+func (s *KeeperTestSuite) TestImportExportGenesis_Lockup() {
+	s.setupLockupFarm()
+
+	farmerAddr := utils.TestAddress(0)
+	s.farmWithLockup(farmerAddr, utils.ParseCoin("1_000000pool1"), 30*time.Second)
+	s.nextBlock()
+
+	genState := s.keeper.ExportGenesis(s.ctx)
+	bz := s.app.AppCodec().MustMarshalJSON(genState)
+
+	s.SetupTest()
+	var genState2 types.GenesisState
+	s.app.AppCodec().MustUnmarshalJSON(bz, &genState2)
+	s.keeper.InitGenesis(s.ctx, genState2)
+	genState3 := s.keeper.ExportGenesis(s.ctx)
+	s.Require().Equal(*genState, *genState3)
+
+	// The lockup queue has been restored, too.
+	var farmerAddrs []sdk.AccAddress
+	s.keeper.IterateLockupQueueUntil(
+		s.ctx, utils.ParseTime("2022-01-01T00:00:30Z"),
+		func(lockEndTime time.Time, farmerAddr sdk.AccAddress, denom string) (stop bool) {
+			s.Require().Equal(utils.ParseTime("2022-01-01T00:00:30Z"), lockEndTime)
+			s.Require().Equal("pool1", denom)
+			farmerAddrs = append(farmerAddrs, farmerAddr)
+			return false
+		})
+	s.Require().Equal([]sdk.AccAddress{farmerAddr}, farmerAddrs)
+}
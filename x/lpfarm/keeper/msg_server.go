@@ -29,8 +29,14 @@ func (k msgServer) CreatePrivatePlan(goCtx context.Context, msg *types.MsgCreate
 		return nil, err
 	}
 
-	plan, err := k.Keeper.CreatePrivatePlan(
-		ctx, creatorAddr, msg.Description, msg.RewardAllocations, msg.StartTime, msg.EndTime)
+	var plan types.Plan
+	if msg.HeightSchedule != nil {
+		plan, err = k.Keeper.CreatePrivateHeightPlan(
+			ctx, creatorAddr, msg.Description, msg.RewardAllocations, *msg.HeightSchedule)
+	} else {
+		plan, err = k.Keeper.CreatePrivatePlan(
+			ctx, creatorAddr, msg.Description, msg.RewardAllocations, msg.StartTime, msg.EndTime)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -50,7 +56,7 @@ func (k msgServer) Farm(goCtx context.Context, msg *types.MsgFarm) (*types.MsgFa
 		return nil, err
 	}
 
-	withdrawnRewards, err := k.Keeper.Farm(ctx, farmerAddr, msg.Coin)
+	withdrawnRewards, err := k.Keeper.FarmWithLockup(ctx, farmerAddr, msg.Coin, msg.LockDuration)
 	if err != nil {
 		return nil, err
 	}
@@ -54,3 +54,12 @@ func (k Keeper) GetMaxBlockDuration(ctx sdk.Context) (d time.Duration) {
 func (k Keeper) SetMaxBlockDuration(ctx sdk.Context, d time.Duration) {
 	k.paramSpace.Set(ctx, types.KeyMaxBlockDuration, d)
 }
+
+func (k Keeper) GetLockupBoosts(ctx sdk.Context) (boosts []types.LockupBoost) {
+	k.paramSpace.Get(ctx, types.KeyLockupBoosts, &boosts)
+	return
+}
+
+func (k Keeper) SetLockupBoosts(ctx sdk.Context, boosts []types.LockupBoost) {
+	k.paramSpace.Set(ctx, types.KeyLockupBoosts, boosts)
+}
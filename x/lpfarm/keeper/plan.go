@@ -14,6 +14,24 @@ import (
 func (k Keeper) CreatePrivatePlan(
 	ctx sdk.Context, creatorAddr sdk.AccAddress, description string,
 	rewardAllocs []types.RewardAllocation, startTime, endTime time.Time,
+) (types.Plan, error) {
+	return k.createPrivatePlan(
+		ctx, creatorAddr, description, rewardAllocs, startTime, endTime, nil)
+}
+
+// CreatePrivateHeightPlan creates a new private farming plan with the height schedule.
+func (k Keeper) CreatePrivateHeightPlan(
+	ctx sdk.Context, creatorAddr sdk.AccAddress, description string,
+	rewardAllocs []types.RewardAllocation, heightSchedule types.HeightSchedule,
+) (types.Plan, error) {
+	return k.createPrivatePlan(
+		ctx, creatorAddr, description, rewardAllocs, time.Time{}, time.Time{}, &heightSchedule)
+}
+
+func (k Keeper) createPrivatePlan(
+	ctx sdk.Context, creatorAddr sdk.AccAddress, description string,
+	rewardAllocs []types.RewardAllocation, startTime, endTime time.Time,
+	heightSchedule *types.HeightSchedule,
 ) (types.Plan, error) {
 	if !k.CanCreatePrivatePlan(ctx) {
 		return types.Plan{}, sdkerrors.Wrapf(
@@ -35,7 +53,7 @@ func (k Keeper) CreatePrivatePlan(
 
 	plan, err := k.createPlan(
 		ctx, description, farmingPoolAddr, creatorAddr,
-		rewardAllocs, startTime, endTime, true)
+		rewardAllocs, startTime, endTime, heightSchedule, true)
 	if err != nil {
 		return types.Plan{}, err
 	}
@@ -59,15 +77,32 @@ func (k Keeper) CreatePublicPlan(
 ) (types.Plan, error) {
 	return k.createPlan(
 		ctx, description, farmingPoolAddr, farmingPoolAddr,
-		rewardAllocs, startTime, endTime, false)
+		rewardAllocs, startTime, endTime, nil, false)
+}
+
+// CreatePublicHeightPlan creates a new public farming plan with the height schedule.
+func (k Keeper) CreatePublicHeightPlan(
+	ctx sdk.Context, description string,
+	farmingPoolAddr sdk.AccAddress,
+	rewardAllocs []types.RewardAllocation, heightSchedule types.HeightSchedule,
+) (types.Plan, error) {
+	return k.createPlan(
+		ctx, description, farmingPoolAddr, farmingPoolAddr,
+		rewardAllocs, time.Time{}, time.Time{}, &heightSchedule, false)
 }
 
 func (k Keeper) createPlan(
 	ctx sdk.Context, description string, farmingPoolAddr, termAddr sdk.AccAddress,
-	rewardAllocs []types.RewardAllocation, startTime, endTime time.Time, isPrivate bool,
+	rewardAllocs []types.RewardAllocation, startTime, endTime time.Time,
+	heightSchedule *types.HeightSchedule, isPrivate bool,
 ) (types.Plan, error) {
-	// Check if end time > block time
-	if !endTime.After(ctx.BlockTime()) {
+	if heightSchedule != nil {
+		// Check if end height > block height
+		if heightSchedule.EndHeight <= ctx.BlockHeight() {
+			return types.Plan{}, sdkerrors.Wrap(
+				sdkerrors.ErrInvalidRequest, "end height is past")
+		}
+	} else if !endTime.After(ctx.BlockTime()) { // Check if end time > block time
 		return types.Plan{}, sdkerrors.Wrap(
 			sdkerrors.ErrInvalidRequest, "end time is past")
 	}
@@ -95,6 +130,7 @@ func (k Keeper) createPlan(
 	plan := types.NewPlan(
 		id, description, farmingPoolAddr, termAddr, rewardAllocs,
 		startTime, endTime, isPrivate)
+	plan.HeightSchedule = heightSchedule
 	k.SetPlan(ctx, plan)
 
 	if plan.IsPrivate {
@@ -105,13 +141,13 @@ func (k Keeper) createPlan(
 }
 
 // TerminateEndedPlans iterates through all plans and terminate the plans
-// which should be ended by the current block time.
+// which should be ended by the current block height or time.
 func (k Keeper) TerminateEndedPlans(ctx sdk.Context) (err error) {
 	k.IterateAllPlans(ctx, func(plan types.Plan) (stop bool) {
 		if plan.IsTerminated {
 			return false
 		}
-		if !ctx.BlockTime().Before(plan.EndTime) {
+		if plan.IsEnded(ctx.BlockHeight(), ctx.BlockTime()) {
 			if err = k.TerminatePlan(ctx, plan); err != nil {
 				return true
 			}
@@ -153,30 +189,36 @@ func (k Keeper) TerminatePlan(ctx sdk.Context, plan types.Plan) error {
 // AllocateRewards allocates the current block's rewards to the farms
 // based on active plans.
 func (k Keeper) AllocateRewards(ctx sdk.Context) error {
-	lastBlockTime, found := k.GetLastBlockTime(ctx)
-	if !found {
-		// For the very first block, just skip it.
-		return nil
-	}
-
-	blockDuration := ctx.BlockTime().Sub(lastBlockTime)
-	// Constrain the block duration to the max block duration param.
-	if maxBlockDuration := k.GetMaxBlockDuration(ctx); blockDuration > maxBlockDuration {
-		blockDuration = maxBlockDuration
-	}
-	// If the block duration is 0, skip this block for rewards allocation.
-	if blockDuration == 0 {
-		return nil
+	// For the very first block, the block duration is 0.
+	var blockDuration time.Duration
+	if lastBlockTime, found := k.GetLastBlockTime(ctx); found {
+		blockDuration = ctx.BlockTime().Sub(lastBlockTime)
+		// Constrain the block duration to the max block duration param.
+		if maxBlockDuration := k.GetMaxBlockDuration(ctx); blockDuration > maxBlockDuration {
+			blockDuration = maxBlockDuration
+		}
 	}
 
 	ck := newCachingKeeper(k)
 	ra := newRewardAllocator(ctx, k, ck)
 	k.IterateAllPlans(ctx, func(plan types.Plan) (stop bool) {
-		if plan.IsTerminated || !plan.IsActiveAt(ctx.BlockTime()) {
+		if plan.IsTerminated || !plan.IsActive(ctx.BlockHeight(), ctx.BlockTime()) {
 			return false // Skip
 		}
+		// If the block duration is 0, skip time based plans for rewards allocation.
+		// Plans with the height schedule allocate rewards every block regardless
+		// of the block duration.
+		if plan.HeightSchedule == nil && blockDuration == 0 {
+			return false
+		}
 		for _, rewardAlloc := range plan.RewardAllocations {
-			rewards := types.RewardsForBlock(rewardAlloc.RewardsPerDay, blockDuration)
+			var rewards sdk.DecCoins
+			if plan.HeightSchedule != nil {
+				rewards = plan.HeightSchedule.RewardsForHeight(rewardAlloc.TotalRewards, ctx.BlockHeight())
+			} else {
+				rewards = types.RewardsForBlock(rewardAlloc.RewardsPerDay, blockDuration)
+			}
+			rewards = types.CapRewards(rewards, rewardAlloc.MaxRewardsPerBlock)
 			// TODO: allocate sdk.DecCoins instead of sdk.Coins in future
 			truncatedRewards, _ := rewards.TruncateDecimal()
 			if truncatedRewards.IsAllPositive() {
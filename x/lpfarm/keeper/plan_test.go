@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	utils "github.com/cosmosquad-labs/squad/v3/types"
+	"github.com/cosmosquad-labs/squad/v3/x/lpfarm/keeper"
 	"github.com/cosmosquad-labs/squad/v3/x/lpfarm/types"
 )
 
@@ -337,3 +338,95 @@ func (s *KeeperTestSuite) TestAllocateRewards_ToPairAndDenom() {
 	// 4476stake(from plan 1, pool 2 has 77.35% shares)
 	s.assertEq(utils.ParseDecCoins("4476.007697921871stake"), s.rewards(farmerAddr, "pool2"))
 }
+
+func (s *KeeperTestSuite) TestCreatePrivateHeightPlan_PastEndHeight() {
+	s.createPairWithLastPrice("denom1", "denom2", sdk.NewDec(1))
+
+	creatorAddr := utils.TestAddress(0)
+	s.fundAddr(creatorAddr, s.keeper.GetPrivatePlanCreationFee(s.ctx))
+	_, err := s.keeper.CreatePrivateHeightPlan(
+		s.ctx, creatorAddr, "Farming Plan",
+		[]types.RewardAllocation{
+			types.NewPairBudgetRewardAllocation(1, utils.ParseCoins("100_000000stake")),
+		},
+		types.NewHeightSchedule(1, s.ctx.BlockHeight(), types.EmissionCurveFlat, sdk.ZeroDec(), 0))
+	s.Require().EqualError(err, "end height is past: invalid request")
+}
+
+func (s *KeeperTestSuite) TestAllocateRewards_HeightPlan() {
+	s.createPairWithLastPrice("denom1", "denom2", sdk.NewDec(1))
+	s.createPool(1, utils.ParseCoins("100_000000denom1,100_000000denom2"))
+
+	startHeight := s.ctx.BlockHeight() + 2
+	schedule := types.NewHeightSchedule(
+		startHeight, startHeight+10, types.EmissionCurveExponential, utils.ParseDec("0.5"), 3)
+	plan := s.createPrivateHeightPlan([]types.RewardAllocation{
+		types.NewPairBudgetRewardAllocation(1, utils.ParseCoins("1000_000000stake")),
+	}, schedule, utils.ParseCoins("1000_000000stake"))
+
+	farmerAddr := utils.TestAddress(0)
+	s.farm(farmerAddr, utils.ParseCoin("1_000000pool1"))
+
+	prevOutstanding := sdk.DecCoins{}
+	for s.ctx.BlockHeight() <= schedule.EndHeight {
+		s.nextBlock()
+
+		farm, _ := s.keeper.GetFarm(s.ctx, "pool1")
+		s.assertEq(
+			prevOutstanding.Add(schedule.RewardsForHeight(utils.ParseCoins("1000_000000stake"), s.ctx.BlockHeight())...),
+			farm.OutstandingRewards)
+		prevOutstanding = farm.OutstandingRewards
+
+		_, broken := keeper.AllInvariants(s.keeper)(s.ctx)
+		s.Require().False(broken)
+	}
+
+	// The whole budget has been allocated over the height range.
+	farm, _ := s.keeper.GetFarm(s.ctx, "pool1")
+	s.assertEq(utils.ParseDecCoins("1000_000000stake"), farm.OutstandingRewards)
+	s.Require().True(s.getBalances(plan.GetFarmingPoolAddress()).IsZero())
+
+	plan, _ = s.keeper.GetPlan(s.ctx, plan.Id)
+	s.Require().True(plan.IsTerminated)
+}
+
+func (s *KeeperTestSuite) TestAllocateRewards_HeightPlanMaxRewardsPerBlock() {
+	s.createPairWithLastPrice("denom1", "denom2", sdk.NewDec(1))
+	s.createPool(1, utils.ParseCoins("100_000000denom1,100_000000denom2"))
+
+	startHeight := s.ctx.BlockHeight() + 1
+	schedule := types.NewHeightSchedule(
+		startHeight, startHeight+10, types.EmissionCurveLinear, sdk.ZeroDec(), 0)
+	rewardAlloc := types.NewPairBudgetRewardAllocation(1, utils.ParseCoins("1000_000000stake"))
+	rewardAlloc.MaxRewardsPerBlock = utils.ParseCoins("150_000000stake")
+	plan := s.createPrivateHeightPlan(
+		[]types.RewardAllocation{rewardAlloc}, schedule, utils.ParseCoins("1000_000000stake"))
+
+	farmerAddr := utils.TestAddress(0)
+	s.farm(farmerAddr, utils.ParseCoin("1_000000pool1"))
+
+	// Block rewards without the cap are 181_818181stake, 163_636363stake, ...
+	s.nextBlock()
+	farm, _ := s.keeper.GetFarm(s.ctx, "pool1")
+	s.assertEq(utils.ParseDecCoins("150_000000stake"), farm.OutstandingRewards)
+	s.nextBlock()
+	farm, _ = s.keeper.GetFarm(s.ctx, "pool1")
+	s.assertEq(utils.ParseDecCoins("300_000000stake"), farm.OutstandingRewards)
+	s.nextBlock()
+	farm, _ = s.keeper.GetFarm(s.ctx, "pool1")
+	s.assertEq(utils.ParseDecCoins("445_454545stake"), farm.OutstandingRewards)
+
+	termBalances := s.getBalances(plan.GetTerminationAddress())
+	for s.ctx.BlockHeight() <= schedule.EndHeight {
+		s.nextBlock()
+	}
+
+	// The rewards capped are sent back to the termination address.
+	farm, _ = s.keeper.GetFarm(s.ctx, "pool1")
+	s.assertEq(utils.ParseDecCoins("954_545455stake"), farm.OutstandingRewards)
+	plan, _ = s.keeper.GetPlan(s.ctx, plan.Id)
+	s.Require().True(plan.IsTerminated)
+	s.Require().True(s.getBalances(plan.GetFarmingPoolAddress()).IsZero())
+	s.assertEq(
+		termBalances.Add(utils.ParseCoin("45_454545stake")), s.getBalances(plan.GetTerminationAddress()))
+}
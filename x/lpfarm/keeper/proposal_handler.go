@@ -11,9 +11,17 @@ import (
 func HandleFarmingPlanProposal(ctx sdk.Context, k Keeper, p *types.FarmingPlanProposal) error {
 	for _, req := range p.CreatePlanRequests {
 		farmingPoolAddr, _ := sdk.AccAddressFromBech32(req.FarmingPoolAddress)
-		if _, err := k.CreatePublicPlan(
-			ctx, req.Description, farmingPoolAddr,
-			req.RewardAllocations, req.StartTime, req.EndTime); err != nil {
+		var err error
+		if req.HeightSchedule != nil {
+			_, err = k.CreatePublicHeightPlan(
+				ctx, req.Description, farmingPoolAddr,
+				req.RewardAllocations, *req.HeightSchedule)
+		} else {
+			_, err = k.CreatePublicPlan(
+				ctx, req.Description, farmingPoolAddr,
+				req.RewardAllocations, req.StartTime, req.EndTime)
+		}
+		if err != nil {
 			return err
 		}
 	}
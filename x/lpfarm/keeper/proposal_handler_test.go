@@ -49,3 +49,29 @@ func (s *KeeperTestSuite) TestFarmingPlanProposalHandler() {
 	// It isn't possible to terminate private plans via FarmingPlanProposal.
 	s.Require().Error(s.govHandler(s.ctx, proposal))
 }
+
+func (s *KeeperTestSuite) TestFarmingPlanProposalHandler_HeightPlan() {
+	farmingPoolAddr := utils.TestAddress(0)
+	s.fundAddr(farmingPoolAddr, utils.ParseCoins("100_000000stake"))
+
+	pair := s.createPair("denom1", "denom2")
+	schedule := types.NewHeightSchedule(
+		10, 100, types.EmissionCurveExponential, utils.ParseDec("0.5"), 30)
+	createPlanReq := types.NewCreateHeightPlanRequest(
+		"Farming Plan #1", farmingPoolAddr,
+		[]types.RewardAllocation{
+			types.NewPairBudgetRewardAllocation(pair.Id, utils.ParseCoins("100_000000stake")),
+		}, schedule)
+	proposal := types.NewFarmingPlanProposal(
+		"Create a new public farming plan", "Description",
+		[]types.CreatePlanRequest{createPlanReq}, nil)
+	s.handleProposal(proposal)
+
+	plan, found := s.keeper.GetPlan(s.ctx, 1)
+	s.Require().True(found)
+	s.Require().False(plan.IsPrivate)
+	s.Require().NotNil(plan.HeightSchedule)
+	s.Require().Equal(schedule, *plan.HeightSchedule)
+	s.Require().True(plan.StartTime.IsZero())
+	s.Require().True(plan.EndTime.IsZero())
+}
@@ -194,3 +194,28 @@ func (k Keeper) IterateAllHistoricalRewards(ctx sdk.Context, cb func(denom strin
 		}
 	}
 }
+
+func (k Keeper) SetLockupQueueEntry(ctx sdk.Context, lockEndTime time.Time, farmerAddr sdk.AccAddress, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetLockupQueueKey(lockEndTime, farmerAddr, denom), []byte{})
+}
+
+func (k Keeper) DeleteLockupQueueEntry(ctx sdk.Context, lockEndTime time.Time, farmerAddr sdk.AccAddress, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetLockupQueueKey(lockEndTime, farmerAddr, denom))
+}
+
+// IterateLockupQueueUntil iterates through the lockup queue entries whose
+// lock end time is equal to or before the given time t.
+func (k Keeper) IterateLockupQueueUntil(ctx sdk.Context, t time.Time, cb func(lockEndTime time.Time, farmerAddr sdk.AccAddress, denom string) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(
+		types.LockupQueueKeyPrefix, sdk.PrefixEndBytes(types.GetLockupQueueTimeKeyPrefix(t)))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		lockEndTime, farmerAddr, denom := types.ParseLockupQueueKey(iter.Key())
+		if cb(lockEndTime, farmerAddr, denom) {
+			break
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package v2
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/cosmosquad-labs/squad/v3/x/lpfarm/types"
+)
+
+// MigrateParams sets the params added in v2 to their default values.
+func MigrateParams(ctx sdk.Context, paramSpace paramstypes.Subspace) {
+	paramSpace.Set(ctx, types.KeyLockupBoosts, types.DefaultLockupBoosts)
+}
+
+// MigratePositions sets the farming start time of the existing positions to
+// the current block time, since the time they actually started farming is
+// not recorded before v2.
+func MigratePositions(ctx sdk.Context, store sdk.KVStore, cdc codec.BinaryCodec) error {
+	iter := sdk.KVStorePrefixIterator(store, types.PositionKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var position types.Position
+		if err := cdc.Unmarshal(iter.Value(), &position); err != nil {
+			return err
+		}
+		position.FarmingStartTime = ctx.BlockTime()
+		bz, err := cdc.Marshal(&position)
+		if err != nil {
+			return err
+		}
+		store.Set(iter.Key(), bz)
+	}
+
+	return nil
+}
+
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryCodec, paramSpace paramstypes.Subspace) error {
+	MigrateParams(ctx, paramSpace)
+	store := ctx.KVStore(storeKey)
+	if err := MigratePositions(ctx, store, cdc); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	chain "github.com/cosmosquad-labs/squad/v3/app"
+	utils "github.com/cosmosquad-labs/squad/v3/types"
+	v2lpfarm "github.com/cosmosquad-labs/squad/v3/x/lpfarm/legacy/v2"
+	"github.com/cosmosquad-labs/squad/v3/x/lpfarm/types"
+)
+
+func TestMigrateParams(t *testing.T) {
+	encCfg := chain.MakeTestEncodingConfig()
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	paramsKey := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+
+	cms := store.NewCommitMultiStore(dbm.NewMemDB())
+	cms.MountStoreWithDB(storeKey, sdk.StoreTypeIAVL, nil)
+	cms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, nil)
+	cms.MountStoreWithDB(paramsTKey, sdk.StoreTypeTransient, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+	ctx := sdk.NewContext(cms, tmproto.Header{}, false, log.NewNopLogger())
+
+	paramSpace := paramstypes.NewSubspace(
+		encCfg.Marshaler, encCfg.Amino, paramsKey, paramsTKey, types.ModuleName).WithKeyTable(types.ParamKeyTable())
+
+	// The params set before the migration are kept.
+	paramSpace.Set(ctx, types.KeyMaxNumPrivatePlans, uint32(10))
+
+	require.NoError(t, v2lpfarm.MigrateStore(ctx, storeKey, encCfg.Marshaler, paramSpace))
+
+	var maxNumPrivatePlans uint32
+	var lockupBoosts []types.LockupBoost
+	paramSpace.Get(ctx, types.KeyMaxNumPrivatePlans, &maxNumPrivatePlans)
+	paramSpace.Get(ctx, types.KeyLockupBoosts, &lockupBoosts)
+	require.Equal(t, uint32(10), maxNumPrivatePlans)
+	require.Empty(t, lockupBoosts)
+}
+
+func TestMigratePositions(t *testing.T) {
+	cdc := chain.MakeTestEncodingConfig().Marshaler
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+	ctx = ctx.WithBlockTime(utils.ParseTime("2022-01-01T00:00:00Z"))
+	store := ctx.KVStore(storeKey)
+
+	farmerAddr := utils.TestAddress(0)
+	position := types.Position{
+		Farmer:              farmerAddr.String(),
+		Denom:               "pool1",
+		FarmingAmount:       sdk.NewInt(1000000),
+		PreviousPeriod:      1,
+		StartingBlockHeight: 100,
+		BoostAmount:         sdk.ZeroInt(),
+	}
+	key := types.GetPositionKey(farmerAddr, position.Denom)
+	store.Set(key, cdc.MustMarshal(&position))
+
+	require.NoError(t, v2lpfarm.MigratePositions(ctx, store, cdc))
+
+	position.FarmingStartTime = utils.ParseTime("2022-01-01T00:00:00Z")
+	require.Equal(t, cdc.MustMarshal(&position), store.Get(key))
+}
@@ -6,4 +6,5 @@ import (
 
 var (
 	ErrPlanAlreadyTerminated = sdkerrors.Register(ModuleName, 2, "plan is already terminated")
+	ErrPositionLocked        = sdkerrors.Register(ModuleName, 3, "position is locked")
 )
@@ -9,15 +9,20 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -68,6 +73,8 @@ type EventFarm struct {
 	Farmer           string                                   `protobuf:"bytes,1,opt,name=farmer,proto3" json:"farmer,omitempty"`
 	Coin             types.Coin                               `protobuf:"bytes,2,opt,name=coin,proto3" json:"coin"`
 	WithdrawnRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=withdrawn_rewards,json=withdrawnRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"withdrawn_rewards"`
+	LockDuration     time.Duration                            `protobuf:"bytes,4,opt,name=lock_duration,json=lockDuration,proto3,stdduration" json:"lock_duration"`
+	LockEndTime      time.Time                                `protobuf:"bytes,5,opt,name=lock_end_time,json=lockEndTime,proto3,stdtime" json:"lock_end_time"`
 }
 
 func (m *EventFarm) Reset()         { *m = EventFarm{} }
@@ -181,6 +188,45 @@ func (m *EventHarvest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EventHarvest proto.InternalMessageInfo
 
+type EventUnlockPosition struct {
+	Farmer           string                                   `protobuf:"bytes,1,opt,name=farmer,proto3" json:"farmer,omitempty"`
+	Denom            string                                   `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	WithdrawnRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=withdrawn_rewards,json=withdrawnRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"withdrawn_rewards"`
+}
+
+func (m *EventUnlockPosition) Reset()         { *m = EventUnlockPosition{} }
+func (m *EventUnlockPosition) String() string { return proto.CompactTextString(m) }
+func (*EventUnlockPosition) ProtoMessage()    {}
+func (*EventUnlockPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cf4308a5cf08ffe, []int{4}
+}
+func (m *EventUnlockPosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUnlockPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUnlockPosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventUnlockPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUnlockPosition.Merge(m, src)
+}
+func (m *EventUnlockPosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUnlockPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUnlockPosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUnlockPosition proto.InternalMessageInfo
+
 type EventTerminatePlan struct {
 	PlanId uint64 `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
 }
@@ -189,7 +235,7 @@ func (m *EventTerminatePlan) Reset()         { *m = EventTerminatePlan{} }
 func (m *EventTerminatePlan) String() string { return proto.CompactTextString(m) }
 func (*EventTerminatePlan) ProtoMessage()    {}
 func (*EventTerminatePlan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8cf4308a5cf08ffe, []int{4}
+	return fileDescriptor_8cf4308a5cf08ffe, []int{5}
 }
 func (m *EventTerminatePlan) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -223,41 +269,49 @@ func init() {
 	proto.RegisterType((*EventFarm)(nil), "squad.lpfarm.v1beta1.EventFarm")
 	proto.RegisterType((*EventUnfarm)(nil), "squad.lpfarm.v1beta1.EventUnfarm")
 	proto.RegisterType((*EventHarvest)(nil), "squad.lpfarm.v1beta1.EventHarvest")
+	proto.RegisterType((*EventUnlockPosition)(nil), "squad.lpfarm.v1beta1.EventUnlockPosition")
 	proto.RegisterType((*EventTerminatePlan)(nil), "squad.lpfarm.v1beta1.EventTerminatePlan")
 }
 
 func init() { proto.RegisterFile("squad/lpfarm/v1beta1/events.proto", fileDescriptor_8cf4308a5cf08ffe) }
 
 var fileDescriptor_8cf4308a5cf08ffe = []byte{
-	// 435 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x53, 0x4d, 0x6e, 0xd4, 0x30,
-	0x18, 0x8d, 0xe9, 0x30, 0xd5, 0xb8, 0x2c, 0xc0, 0x8a, 0x4a, 0xe8, 0xc2, 0x2d, 0xb3, 0x9a, 0xcd,
-	0xc4, 0x2d, 0x3d, 0x01, 0xad, 0x40, 0xb0, 0x40, 0xaa, 0x22, 0xd8, 0xb0, 0x89, 0x9c, 0xd8, 0x4d,
-	0x23, 0x12, 0x3b, 0xd8, 0x9e, 0xcc, 0xb0, 0xe0, 0x0e, 0x9c, 0x83, 0x03, 0x70, 0x86, 0x59, 0x8e,
-	0x10, 0x0b, 0x56, 0xfc, 0xcc, 0x5c, 0x04, 0xd9, 0x4e, 0x46, 0x23, 0x21, 0xd8, 0x22, 0xb1, 0x72,
-	0xbe, 0x7c, 0xcf, 0xdf, 0x7b, 0xcf, 0xfa, 0x1e, 0x7c, 0xa8, 0xdf, 0xce, 0x28, 0x23, 0x55, 0x73,
-	0x4d, 0x55, 0x4d, 0xda, 0xb3, 0x8c, 0x1b, 0x7a, 0x46, 0x78, 0xcb, 0x85, 0xd1, 0x71, 0xa3, 0xa4,
-	0x91, 0x28, 0x74, 0x90, 0xd8, 0x43, 0xe2, 0x0e, 0x72, 0x14, 0x16, 0xb2, 0x90, 0x0e, 0x40, 0xec,
-	0x97, 0xc7, 0x1e, 0xe1, 0x5c, 0xea, 0x5a, 0x6a, 0x92, 0x51, 0xcd, 0xb7, 0xd3, 0x72, 0x59, 0x0a,
-	0xdf, 0x1f, 0xbf, 0x87, 0x87, 0x4f, 0xec, 0xec, 0x4b, 0xc5, 0xa9, 0xe1, 0x57, 0xaa, 0x6c, 0xed,
-	0x51, 0x51, 0x81, 0x22, 0xb8, 0x9f, 0xdb, 0x9f, 0x52, 0x45, 0xe0, 0x04, 0x4c, 0x46, 0x49, 0x5f,
-	0xa2, 0xfb, 0x70, 0xbf, 0xa9, 0xa8, 0x48, 0x4b, 0x16, 0xdd, 0x3a, 0x01, 0x93, 0x41, 0x32, 0xb4,
-	0xe5, 0x73, 0x86, 0x4e, 0x61, 0x68, 0x25, 0x95, 0xa2, 0x48, 0x1b, 0x29, 0xab, 0x94, 0x32, 0xa6,
-	0xb8, 0xd6, 0xd1, 0x9e, 0xbb, 0x8f, 0xba, 0xde, 0x95, 0x94, 0xd5, 0x63, 0xdf, 0x19, 0x7f, 0x06,
-	0x70, 0xe4, 0xf8, 0x9f, 0x52, 0x55, 0xa3, 0x43, 0x38, 0xb4, 0x18, 0xde, 0x33, 0x76, 0x15, 0x3a,
-	0x87, 0x03, 0x2b, 0xd9, 0xb1, 0x1d, 0x3c, 0x7a, 0x10, 0x7b, 0x4f, 0xb1, 0xf5, 0xd4, 0xdb, 0x8f,
-	0x2f, 0x65, 0x29, 0x2e, 0x06, 0xcb, 0x6f, 0xc7, 0x41, 0xe2, 0xc0, 0x68, 0x01, 0xef, 0xcd, 0x4b,
-	0x73, 0xc3, 0x14, 0x9d, 0x8b, 0x54, 0xf1, 0x39, 0x55, 0xcc, 0x2a, 0xd9, 0xfb, 0xfb, 0x84, 0x53,
-	0x3b, 0xe1, 0xe3, 0xf7, 0xe3, 0x49, 0x51, 0x9a, 0x9b, 0x59, 0x16, 0xe7, 0xb2, 0x26, 0xdd, 0x13,
-	0xfa, 0x63, 0xaa, 0xd9, 0x1b, 0x62, 0xde, 0x35, 0x5c, 0xbb, 0x0b, 0x3a, 0xb9, 0xbb, 0x65, 0x49,
-	0x3c, 0xc9, 0xf8, 0x0b, 0x80, 0x07, 0xce, 0xd4, 0x2b, 0x71, 0xfd, 0x1f, 0xd9, 0xfa, 0x04, 0xe0,
-	0x1d, 0x67, 0xeb, 0x19, 0x55, 0x2d, 0xd7, 0xe6, 0x8f, 0xbe, 0x42, 0x78, 0x9b, 0x71, 0x21, 0x6b,
-	0x67, 0x6c, 0x94, 0xf8, 0xe2, 0x1f, 0x0a, 0x9f, 0x42, 0xe4, 0x74, 0xbf, 0xe4, 0x76, 0xff, 0xfa,
-	0xfd, 0xde, 0xd9, 0x62, 0xb0, 0xbb, 0xc5, 0x17, 0x2f, 0x96, 0x3f, 0x71, 0xb0, 0x5c, 0x63, 0xb0,
-	0x5a, 0x63, 0xf0, 0x63, 0x8d, 0xc1, 0x87, 0x0d, 0x0e, 0x56, 0x1b, 0x1c, 0x7c, 0xdd, 0xe0, 0xe0,
-	0x35, 0xf9, 0x4d, 0x88, 0x0d, 0xe3, 0xb4, 0xa2, 0x99, 0x26, 0x3e, 0xba, 0x8b, 0x3e, 0xbc, 0x4e,
-	0x55, 0x36, 0x74, 0x41, 0x3b, 0xff, 0x15, 0x00, 0x00, 0xff, 0xff, 0x07, 0x65, 0xf5, 0xeb, 0xd9,
-	0x03, 0x00, 0x00,
+	// 546 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x54, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0xcd, 0xb6, 0x69, 0x4a, 0x36, 0x45, 0x02, 0x13, 0x15, 0x93, 0x83, 0x53, 0x72, 0xca, 0x25,
+	0xde, 0x96, 0x7e, 0x01, 0x29, 0x45, 0xe5, 0x80, 0x14, 0x59, 0xe5, 0xc2, 0xc5, 0x5a, 0x67, 0x37,
+	0xae, 0x55, 0x7b, 0xd7, 0xec, 0x6e, 0x92, 0x72, 0xe0, 0x1f, 0x7a, 0xe4, 0x1b, 0xf8, 0x00, 0x6e,
+	0xdc, 0x73, 0xec, 0x81, 0x03, 0x27, 0x0a, 0x89, 0xc4, 0x77, 0xa0, 0x59, 0xdb, 0x51, 0x45, 0x05,
+	0x57, 0x04, 0x27, 0x67, 0x3c, 0x6f, 0xde, 0xbc, 0x99, 0x17, 0x0f, 0x7e, 0xac, 0xdf, 0x4c, 0x29,
+	0x23, 0x69, 0x3e, 0xa1, 0x2a, 0x23, 0xb3, 0x83, 0x88, 0x1b, 0x7a, 0x40, 0xf8, 0x8c, 0x0b, 0xa3,
+	0xfd, 0x5c, 0x49, 0x23, 0x9d, 0xb6, 0x85, 0xf8, 0x05, 0xc4, 0x2f, 0x21, 0x9d, 0x76, 0x2c, 0x63,
+	0x69, 0x01, 0x04, 0x7e, 0x15, 0xd8, 0x8e, 0x37, 0x96, 0x3a, 0x93, 0x9a, 0x44, 0x54, 0xf3, 0x35,
+	0xdb, 0x58, 0x26, 0xa2, 0xcc, 0x77, 0x63, 0x29, 0xe3, 0x94, 0x13, 0x1b, 0x45, 0xd3, 0x09, 0x31,
+	0x49, 0xc6, 0xb5, 0xa1, 0x59, 0x5e, 0x11, 0xfc, 0x0a, 0x60, 0x53, 0x45, 0x4d, 0x22, 0x4b, 0x82,
+	0xde, 0x3b, 0xbc, 0x7b, 0x0c, 0xe2, 0x8e, 0x14, 0xa7, 0x86, 0x8f, 0x54, 0x32, 0x83, 0x47, 0x4a,
+	0x85, 0xe3, 0xe2, 0xed, 0x31, 0xbc, 0x94, 0xca, 0x45, 0x7b, 0xa8, 0xdf, 0x0c, 0xaa, 0xd0, 0x79,
+	0x88, 0xb7, 0xf3, 0x94, 0x8a, 0x30, 0x61, 0xee, 0xc6, 0x1e, 0xea, 0xd7, 0x83, 0x06, 0x84, 0x2f,
+	0x98, 0xb3, 0x8f, 0xdb, 0x30, 0x53, 0x22, 0xe2, 0x30, 0x97, 0x32, 0x0d, 0x29, 0x63, 0x8a, 0x6b,
+	0xed, 0x6e, 0xda, 0x7a, 0xa7, 0xcc, 0x8d, 0xa4, 0x4c, 0x9f, 0x16, 0x99, 0xde, 0x8f, 0x0d, 0xdc,
+	0xb4, 0xfd, 0x9f, 0x53, 0x95, 0x39, 0xbb, 0xb8, 0x01, 0x18, 0x5e, 0x75, 0x2c, 0x23, 0xe7, 0x10,
+	0xd7, 0x61, 0x66, 0xdb, 0xad, 0xf5, 0xe4, 0x91, 0x5f, 0x2c, 0xc5, 0x87, 0xa5, 0x54, 0xfb, 0xf3,
+	0x8f, 0x64, 0x22, 0x86, 0xf5, 0xc5, 0xd7, 0x6e, 0x2d, 0xb0, 0x60, 0xe7, 0x02, 0xdf, 0x9f, 0x27,
+	0xe6, 0x8c, 0x29, 0x3a, 0x17, 0xa1, 0xe2, 0x73, 0xaa, 0x18, 0x28, 0xd9, 0xfc, 0x33, 0xc3, 0x3e,
+	0x30, 0x7c, 0xb8, 0xee, 0xf6, 0xe3, 0xc4, 0x9c, 0x4d, 0x23, 0x7f, 0x2c, 0x33, 0x52, 0x7a, 0x50,
+	0x3c, 0x06, 0x9a, 0x9d, 0x13, 0xf3, 0x36, 0xe7, 0xda, 0x16, 0xe8, 0xe0, 0xde, 0xba, 0x4b, 0x50,
+	0x34, 0x71, 0x4e, 0xf0, 0xdd, 0x54, 0x8e, 0xcf, 0xc3, 0x6a, 0xd5, 0x6e, 0xbd, 0xd4, 0x5d, 0x78,
+	0xe1, 0x57, 0x5e, 0xf8, 0xcf, 0x4a, 0xc0, 0xf0, 0x0e, 0x74, 0x7d, 0x7f, 0xdd, 0x45, 0xc1, 0x0e,
+	0x54, 0x56, 0xef, 0xd7, 0x4c, 0x5c, 0xb0, 0x10, 0x9c, 0x75, 0xb7, 0x2c, 0x53, 0xe7, 0x16, 0xd3,
+	0x69, 0x65, 0x7b, 0x41, 0x75, 0x09, 0x54, 0x2d, 0x28, 0x3d, 0x16, 0x0c, 0x72, 0xbd, 0xcf, 0x08,
+	0xb7, 0xec, 0xa2, 0x5f, 0x89, 0xc9, 0xff, 0xb3, 0xea, 0xde, 0x47, 0x84, 0x77, 0xec, 0x58, 0x27,
+	0x54, 0xcd, 0xb8, 0x36, 0xbf, 0x9d, 0xab, 0x8d, 0xb7, 0x18, 0x17, 0x32, 0xb3, 0x83, 0x35, 0x83,
+	0x22, 0xf8, 0x8b, 0xc2, 0x3f, 0x21, 0xfc, 0xa0, 0xf4, 0x03, 0x6c, 0x1a, 0x49, 0x9d, 0x58, 0xc7,
+	0xff, 0x15, 0xfd, 0x03, 0xec, 0x58, 0xf9, 0xa7, 0x1c, 0xbe, 0xe9, 0xea, 0x66, 0xdc, 0xb8, 0x0c,
+	0xe8, 0xe6, 0x65, 0x18, 0xbe, 0x5c, 0x7c, 0xf7, 0x6a, 0x8b, 0xa5, 0x87, 0xae, 0x96, 0x1e, 0xfa,
+	0xb6, 0xf4, 0xd0, 0xe5, 0xca, 0xab, 0x5d, 0xad, 0xbc, 0xda, 0x97, 0x95, 0x57, 0x7b, 0x4d, 0x6e,
+	0x09, 0x81, 0x0b, 0x39, 0x48, 0x69, 0xa4, 0x49, 0x71, 0x4f, 0x2f, 0xaa, 0x8b, 0x6a, 0x55, 0x45,
+	0x0d, 0xfb, 0xc7, 0x3f, 0xfc, 0x19, 0x00, 0x00, 0xff, 0xff, 0xea, 0x08, 0x16, 0x11, 0x6e, 0x05,
+	0x00, 0x00,
 }
 
 func (m *EventCreatePrivatePlan) Marshal() (dAtA []byte, err error) {
@@ -322,6 +376,22 @@ func (m *EventFarm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LockEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LockEndTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintEvents(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.LockDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.LockDuration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintEvents(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
 	if len(m.WithdrawnRewards) > 0 {
 		for iNdEx := len(m.WithdrawnRewards) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -461,6 +531,57 @@ func (m *EventHarvest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventUnlockPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUnlockPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUnlockPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawnRewards) > 0 {
+		for iNdEx := len(m.WithdrawnRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WithdrawnRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventTerminatePlan) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -538,6 +659,10 @@ func (m *EventFarm) Size() (n int) {
 			n += 1 + l + sovEvents(uint64(l))
 		}
 	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.LockDuration)
+	n += 1 + l + sovEvents(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LockEndTime)
+	n += 1 + l + sovEvents(uint64(l))
 	return n
 }
 
@@ -585,6 +710,29 @@ func (m *EventHarvest) Size() (n int) {
 	return n
 }
 
+func (m *EventUnlockPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.WithdrawnRewards) > 0 {
+		for _, e := range m.WithdrawnRewards {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *EventTerminatePlan) Size() (n int) {
 	if m == nil {
 		return 0
@@ -864,6 +1012,72 @@ func (m *EventFarm) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.LockDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LockEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1182,6 +1396,154 @@ func (m *EventHarvest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventUnlockPosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUnlockPosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUnlockPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawnRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawnRewards = append(m.WithdrawnRewards, types.Coin{})
+			if err := m.WithdrawnRewards[len(m.WithdrawnRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EventTerminatePlan) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -57,6 +57,10 @@ func (genState GenesisState) Validate() error {
 		if err := farm.Farm.OutstandingRewards.Validate(); err != nil {
 			return fmt.Errorf("invalid outstanding rewards: %w", err)
 		}
+		if !farm.Farm.TotalBoostAmount.IsNil() && farm.Farm.TotalBoostAmount.IsNegative() {
+			return fmt.Errorf(
+				"total boost amount must not be negative: %s", farm.Farm.TotalBoostAmount)
+		}
 		if farm.Farm.Period == 0 {
 			return fmt.Errorf("period must be positive")
 		}
@@ -83,6 +87,12 @@ func (genState GenesisState) Validate() error {
 			return fmt.Errorf(
 				"starting block height must be positive: %d", position.StartingBlockHeight)
 		}
+		if position.LockDuration < 0 {
+			return fmt.Errorf("lock duration must not be negative: %s", position.LockDuration)
+		}
+		if !position.BoostAmount.IsNil() && position.BoostAmount.IsNegative() {
+			return fmt.Errorf("boost amount must not be negative: %s", position.BoostAmount)
+		}
 		key := positionKey{position.Farmer, position.Denom}
 		if _, ok := positionKeySet[key]; ok {
 			return fmt.Errorf("duplicate position: %s, %s", position.Farmer, position.Denom)
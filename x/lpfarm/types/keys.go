@@ -2,6 +2,7 @@ package types
 
 import (
 	"bytes"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
@@ -31,6 +32,7 @@ var (
 	FarmKeyPrefix              = []byte{0xd4}
 	PositionKeyPrefix          = []byte{0xd5}
 	HistoricalRewardsKeyPrefix = []byte{0xd6}
+	LockupQueueKeyPrefix       = []byte{0xd7}
 )
 
 func GetPlanKey(id uint64) []byte {
@@ -61,6 +63,18 @@ func GetHistoricalRewardsByDenomKeyPrefix(denom string) []byte {
 	return append(HistoricalRewardsKeyPrefix, utils.LengthPrefixString(denom)...)
 }
 
+// GetLockupQueueKey returns a key for the lockup queue entry of a position
+// locked until the lock end time.
+func GetLockupQueueKey(lockEndTime time.Time, farmerAddr sdk.AccAddress, denom string) []byte {
+	return append(append(GetLockupQueueTimeKeyPrefix(lockEndTime), address.MustLengthPrefix(farmerAddr)...), denom...)
+}
+
+// GetLockupQueueTimeKeyPrefix returns a key prefix for iterating through
+// the lockup queue entries of the positions locked until the lock end time.
+func GetLockupQueueTimeKeyPrefix(lockEndTime time.Time) []byte {
+	return append(LockupQueueKeyPrefix, sdk.FormatTimeBytes(lockEndTime)...)
+}
+
 func ParseFarmKey(key []byte) (denom string) {
 	if !bytes.HasPrefix(key, FarmKeyPrefix) {
 		panic("key does not have proper prefix")
@@ -88,3 +102,18 @@ func ParseHistoricalRewardsKey(key []byte) (denom string, period uint64) {
 	period = sdk.BigEndianToUint64(key[2+denomLen:])
 	return
 }
+
+func ParseLockupQueueKey(key []byte) (lockEndTime time.Time, farmerAddr sdk.AccAddress, denom string) {
+	if !bytes.HasPrefix(key, LockupQueueKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+	timeLen := len(sdk.FormatTimeBytes(time.Time{}))
+	lockEndTime, err := sdk.ParseTimeBytes(key[1 : 1+timeLen])
+	if err != nil {
+		panic(err)
+	}
+	farmerAddrLen := key[1+timeLen]
+	farmerAddr = key[2+timeLen : 2+timeLen+int(farmerAddrLen)]
+	denom = string(key[2+timeLen+int(farmerAddrLen):])
+	return
+}
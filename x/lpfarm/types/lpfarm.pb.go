@@ -30,11 +30,51 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// EmissionCurve enumerates the curves of the emission of a plan with height schedule.
+type EmissionCurve int32
+
+const (
+	// EMISSION_CURVE_UNSPECIFIED defines the default emission curve
+	EmissionCurveNil EmissionCurve = 0
+	// EMISSION_CURVE_FLAT defines the curve emitting the same rewards for every block
+	EmissionCurveFlat EmissionCurve = 1
+	// EMISSION_CURVE_LINEAR defines the curve whose emission decreases linearly to zero
+	EmissionCurveLinear EmissionCurve = 2
+	// EMISSION_CURVE_EXPONENTIAL defines the curve whose emission is multiplied by
+	// the decay rate every decay period
+	EmissionCurveExponential EmissionCurve = 3
+)
+
+var EmissionCurve_name = map[int32]string{
+	0: "EMISSION_CURVE_UNSPECIFIED",
+	1: "EMISSION_CURVE_FLAT",
+	2: "EMISSION_CURVE_LINEAR",
+	3: "EMISSION_CURVE_EXPONENTIAL",
+}
+
+var EmissionCurve_value = map[string]int32{
+	"EMISSION_CURVE_UNSPECIFIED": 0,
+	"EMISSION_CURVE_FLAT":        1,
+	"EMISSION_CURVE_LINEAR":      2,
+	"EMISSION_CURVE_EXPONENTIAL": 3,
+}
+
+func (x EmissionCurve) String() string {
+	return proto.EnumName(EmissionCurve_name, int32(x))
+}
+
+func (EmissionCurve) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8bbcbc26532440fb, []int{0}
+}
+
 type Params struct {
 	PrivatePlanCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=private_plan_creation_fee,json=privatePlanCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"private_plan_creation_fee"`
 	FeeCollector           string                                   `protobuf:"bytes,2,opt,name=fee_collector,json=feeCollector,proto3" json:"fee_collector,omitempty"`
 	MaxNumPrivatePlans     uint32                                   `protobuf:"varint,3,opt,name=max_num_private_plans,json=maxNumPrivatePlans,proto3" json:"max_num_private_plans,omitempty"`
 	MaxBlockDuration       time.Duration                            `protobuf:"bytes,4,opt,name=max_block_duration,json=maxBlockDuration,proto3,stdduration" json:"max_block_duration"`
+	// lockup_boosts is the list of the allowed lockup durations for farming
+	// positions, along with the reward multiplier for each of them.
+	LockupBoosts []LockupBoost `protobuf:"bytes,5,rep,name=lockup_boosts,json=lockupBoosts,proto3" json:"lockup_boosts"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -70,6 +110,46 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+// LockupBoost defines the reward multiplier for the farming positions locked
+// for the lockup duration.
+type LockupBoost struct {
+	Duration   time.Duration                          `protobuf:"bytes,1,opt,name=duration,proto3,stdduration" json:"duration"`
+	Multiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=multiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"multiplier"`
+}
+
+func (m *LockupBoost) Reset()         { *m = LockupBoost{} }
+func (m *LockupBoost) String() string { return proto.CompactTextString(m) }
+func (*LockupBoost) ProtoMessage()    {}
+func (*LockupBoost) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bbcbc26532440fb, []int{1}
+}
+func (m *LockupBoost) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockupBoost) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockupBoost.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockupBoost) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockupBoost.Merge(m, src)
+}
+func (m *LockupBoost) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockupBoost) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockupBoost.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockupBoost proto.InternalMessageInfo
+
 type Plan struct {
 	Id                 uint64             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Description        string             `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
@@ -80,13 +160,17 @@ type Plan struct {
 	EndTime            time.Time          `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
 	IsPrivate          bool               `protobuf:"varint,8,opt,name=is_private,json=isPrivate,proto3" json:"is_private,omitempty"`
 	IsTerminated       bool               `protobuf:"varint,9,opt,name=is_terminated,json=isTerminated,proto3" json:"is_terminated,omitempty"`
+	// height_schedule makes the plan allocate the total rewards of each reward
+	// allocation over a block height range instead of rewards per day.
+	// start_time and end_time are not used when it is set.
+	HeightSchedule *HeightSchedule `protobuf:"bytes,10,opt,name=height_schedule,json=heightSchedule,proto3" json:"height_schedule,omitempty"`
 }
 
 func (m *Plan) Reset()         { *m = Plan{} }
 func (m *Plan) String() string { return proto.CompactTextString(m) }
 func (*Plan) ProtoMessage()    {}
 func (*Plan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8bbcbc26532440fb, []int{1}
+	return fileDescriptor_8bbcbc26532440fb, []int{2}
 }
 func (m *Plan) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -115,17 +199,64 @@ func (m *Plan) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Plan proto.InternalMessageInfo
 
+type HeightSchedule struct {
+	StartHeight   int64         `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	EndHeight     int64         `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	EmissionCurve EmissionCurve `protobuf:"varint,3,opt,name=emission_curve,json=emissionCurve,proto3,enum=squad.lpfarm.v1beta1.EmissionCurve" json:"emission_curve,omitempty"`
+	// decay_rate is the ratio of the emission after every decay_period blocks
+	// to the emission before, used by EMISSION_CURVE_EXPONENTIAL.
+	DecayRate   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=decay_rate,json=decayRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"decay_rate"`
+	DecayPeriod int64                                  `protobuf:"varint,5,opt,name=decay_period,json=decayPeriod,proto3" json:"decay_period,omitempty"`
+}
+
+func (m *HeightSchedule) Reset()         { *m = HeightSchedule{} }
+func (m *HeightSchedule) String() string { return proto.CompactTextString(m) }
+func (*HeightSchedule) ProtoMessage()    {}
+func (*HeightSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bbcbc26532440fb, []int{3}
+}
+func (m *HeightSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HeightSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HeightSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HeightSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeightSchedule.Merge(m, src)
+}
+func (m *HeightSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *HeightSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeightSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeightSchedule proto.InternalMessageInfo
+
 type RewardAllocation struct {
 	Denom         string                                   `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 	PairId        uint64                                   `protobuf:"varint,2,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
 	RewardsPerDay github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=rewards_per_day,json=rewardsPerDay,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards_per_day"`
+	// total_rewards is the budget allocated over the height range of a plan with height schedule
+	TotalRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=total_rewards,json=totalRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_rewards"`
+	// max_rewards_per_block caps the rewards allocated for a block; the denoms not in it are not capped
+	MaxRewardsPerBlock github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=max_rewards_per_block,json=maxRewardsPerBlock,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_rewards_per_block"`
 }
 
 func (m *RewardAllocation) Reset()         { *m = RewardAllocation{} }
 func (m *RewardAllocation) String() string { return proto.CompactTextString(m) }
 func (*RewardAllocation) ProtoMessage()    {}
 func (*RewardAllocation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8bbcbc26532440fb, []int{2}
+	return fileDescriptor_8bbcbc26532440fb, []int{4}
 }
 func (m *RewardAllocation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -159,13 +290,16 @@ type Farm struct {
 	CurrentRewards     github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=current_rewards,json=currentRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"current_rewards"`
 	OutstandingRewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=outstanding_rewards,json=outstandingRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"outstanding_rewards"`
 	Period             uint64                                      `protobuf:"varint,4,opt,name=period,proto3" json:"period,omitempty"`
+	// total_boost_amount is the sum of the boost amounts of all positions in the
+	// farm.
+	TotalBoostAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=total_boost_amount,json=totalBoostAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_boost_amount"`
 }
 
 func (m *Farm) Reset()         { *m = Farm{} }
 func (m *Farm) String() string { return proto.CompactTextString(m) }
 func (*Farm) ProtoMessage()    {}
 func (*Farm) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8bbcbc26532440fb, []int{3}
+	return fileDescriptor_8bbcbc26532440fb, []int{5}
 }
 func (m *Farm) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -200,13 +334,23 @@ type Position struct {
 	FarmingAmount       github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=farming_amount,json=farmingAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"farming_amount"`
 	PreviousPeriod      uint64                                 `protobuf:"varint,4,opt,name=previous_period,json=previousPeriod,proto3" json:"previous_period,omitempty"`
 	StartingBlockHeight int64                                  `protobuf:"varint,5,opt,name=starting_block_height,json=startingBlockHeight,proto3" json:"starting_block_height,omitempty"`
+	// lock_duration is the lockup duration the position has been locked for.
+	LockDuration time.Duration `protobuf:"bytes,6,opt,name=lock_duration,json=lockDuration,proto3,stdduration" json:"lock_duration"`
+	// lock_end_time is the time until which the position cannot be unfarmed.
+	LockEndTime time.Time `protobuf:"bytes,7,opt,name=lock_end_time,json=lockEndTime,proto3,stdtime" json:"lock_end_time"`
+	// boost_amount is the additional farming amount the position is rewarded
+	// for by its lockup.
+	BoostAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=boost_amount,json=boostAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"boost_amount"`
+	// farming_start_time is the time the position has started farming,
+	// averaged by the farming amounts added over time.
+	FarmingStartTime time.Time `protobuf:"bytes,9,opt,name=farming_start_time,json=farmingStartTime,proto3,stdtime" json:"farming_start_time"`
 }
 
 func (m *Position) Reset()         { *m = Position{} }
 func (m *Position) String() string { return proto.CompactTextString(m) }
 func (*Position) ProtoMessage()    {}
 func (*Position) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8bbcbc26532440fb, []int{4}
+	return fileDescriptor_8bbcbc26532440fb, []int{6}
 }
 func (m *Position) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -244,7 +388,7 @@ func (m *HistoricalRewards) Reset()         { *m = HistoricalRewards{} }
 func (m *HistoricalRewards) String() string { return proto.CompactTextString(m) }
 func (*HistoricalRewards) ProtoMessage()    {}
 func (*HistoricalRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8bbcbc26532440fb, []int{5}
+	return fileDescriptor_8bbcbc26532440fb, []int{7}
 }
 func (m *HistoricalRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -274,8 +418,11 @@ func (m *HistoricalRewards) XXX_DiscardUnknown() {
 var xxx_messageInfo_HistoricalRewards proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterEnum("squad.lpfarm.v1beta1.EmissionCurve", EmissionCurve_name, EmissionCurve_value)
 	proto.RegisterType((*Params)(nil), "squad.lpfarm.v1beta1.Params")
+	proto.RegisterType((*LockupBoost)(nil), "squad.lpfarm.v1beta1.LockupBoost")
 	proto.RegisterType((*Plan)(nil), "squad.lpfarm.v1beta1.Plan")
+	proto.RegisterType((*HeightSchedule)(nil), "squad.lpfarm.v1beta1.HeightSchedule")
 	proto.RegisterType((*RewardAllocation)(nil), "squad.lpfarm.v1beta1.RewardAllocation")
 	proto.RegisterType((*Farm)(nil), "squad.lpfarm.v1beta1.Farm")
 	proto.RegisterType((*Position)(nil), "squad.lpfarm.v1beta1.Position")
@@ -285,66 +432,93 @@ func init() {
 func init() { proto.RegisterFile("squad/lpfarm/v1beta1/lpfarm.proto", fileDescriptor_8bbcbc26532440fb) }
 
 var fileDescriptor_8bbcbc26532440fb = []byte{
-	// 932 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcf, 0x72, 0x1b, 0x35,
-	0x18, 0xb7, 0x6c, 0xc7, 0xb1, 0xd5, 0xc6, 0x69, 0x15, 0x27, 0xdd, 0x66, 0xc0, 0x36, 0x66, 0xa6,
-	0xf5, 0x0c, 0xd3, 0xdd, 0xa6, 0x7d, 0x00, 0x26, 0x76, 0x26, 0xd3, 0x1e, 0x60, 0xcc, 0x4e, 0x7b,
-	0x81, 0x83, 0x90, 0x77, 0x65, 0x47, 0xd3, 0xdd, 0xd5, 0x22, 0x69, 0x43, 0xc2, 0x0d, 0x0e, 0xcc,
-	0x70, 0xeb, 0x91, 0x67, 0xe0, 0x01, 0x38, 0x71, 0xe2, 0x94, 0x63, 0x8f, 0x0c, 0x87, 0x16, 0x92,
-	0x2b, 0x0f, 0xc1, 0x48, 0x2b, 0xd9, 0xc6, 0xed, 0xa1, 0x30, 0xf4, 0x64, 0xeb, 0xfb, 0xfb, 0xfb,
-	0xbe, 0xdf, 0x6f, 0x25, 0xf8, 0x81, 0xfc, 0xaa, 0x20, 0x71, 0x90, 0xe4, 0x33, 0x22, 0xd2, 0xe0,
-	0xf4, 0x60, 0x4a, 0x15, 0x39, 0xb0, 0x47, 0x3f, 0x17, 0x5c, 0x71, 0xd4, 0x31, 0x21, 0xbe, 0xb5,
-	0xd9, 0x90, 0xfd, 0xce, 0x9c, 0xcf, 0xb9, 0x09, 0x08, 0xf4, 0xbf, 0x32, 0x76, 0xbf, 0x1b, 0x71,
-	0x99, 0x72, 0x19, 0x4c, 0x89, 0xa4, 0x8b, 0x6a, 0x11, 0x67, 0x99, 0xf5, 0xf7, 0xe6, 0x9c, 0xcf,
-	0x13, 0x1a, 0x98, 0xd3, 0xb4, 0x98, 0x05, 0x8a, 0xa5, 0x54, 0x2a, 0x92, 0xe6, 0xae, 0xc0, 0x7a,
-	0x40, 0x5c, 0x08, 0xa2, 0x18, 0xb7, 0x05, 0x06, 0xbf, 0x54, 0x61, 0x63, 0x42, 0x04, 0x49, 0x25,
-	0xfa, 0x1e, 0xc0, 0xdb, 0xb9, 0x60, 0xa7, 0x44, 0x51, 0x9c, 0x27, 0x24, 0xc3, 0x91, 0xa0, 0x26,
-	0x14, 0xcf, 0x28, 0xf5, 0x40, 0xbf, 0x36, 0xbc, 0xf6, 0xe0, 0xb6, 0x5f, 0x02, 0xf2, 0x35, 0x20,
-	0x87, 0xdd, 0x1f, 0x73, 0x96, 0x8d, 0xee, 0x5f, 0xbc, 0xec, 0x55, 0x7e, 0x7a, 0xd5, 0x1b, 0xce,
-	0x99, 0x3a, 0x29, 0xa6, 0x7e, 0xc4, 0xd3, 0xc0, 0xa2, 0x2f, 0x7f, 0xee, 0xc9, 0xf8, 0x59, 0xa0,
-	0xce, 0x73, 0x2a, 0x4d, 0x82, 0x0c, 0xf7, 0x6c, 0xb7, 0x49, 0x42, 0xb2, 0xb1, 0xed, 0x75, 0x4c,
-	0x29, 0xfa, 0x10, 0x6e, 0xcd, 0x28, 0xc5, 0x11, 0x4f, 0x12, 0x1a, 0x29, 0x2e, 0xbc, 0x6a, 0x1f,
-	0x0c, 0x5b, 0xe1, 0xf5, 0x19, 0xa5, 0x63, 0x67, 0x43, 0x07, 0x70, 0x37, 0x25, 0x67, 0x38, 0x2b,
-	0x52, 0xbc, 0x0a, 0x5a, 0x7a, 0xb5, 0x3e, 0x18, 0x6e, 0x85, 0x28, 0x25, 0x67, 0x9f, 0x16, 0xe9,
-	0x64, 0xd9, 0x41, 0xa2, 0xcf, 0xa0, 0xb6, 0xe2, 0x69, 0xc2, 0xa3, 0x67, 0xd8, 0xed, 0xc1, 0xab,
-	0xf7, 0x81, 0x19, 0xac, 0x5c, 0x94, 0xef, 0x16, 0xe5, 0x1f, 0xd9, 0x80, 0x51, 0x53, 0x0f, 0xf6,
-	0xe3, 0xab, 0x1e, 0x08, 0x6f, 0xa4, 0xe4, 0x6c, 0xa4, 0xb3, 0x9d, 0x6f, 0xf0, 0x6b, 0x0d, 0xd6,
-	0x75, 0x71, 0xd4, 0x86, 0x55, 0x16, 0x7b, 0xa0, 0x0f, 0x86, 0xf5, 0xb0, 0xca, 0x62, 0xd4, 0x87,
-	0xd7, 0x62, 0x2a, 0x23, 0xc1, 0x72, 0xd3, 0xa4, 0x9c, 0x60, 0xd5, 0x84, 0xee, 0xc3, 0x8e, 0x16,
-	0x00, 0xcb, 0xe6, 0x38, 0xe7, 0x3c, 0xc1, 0x24, 0x8e, 0x05, 0x95, 0x25, 0xfe, 0x56, 0x88, 0xac,
-	0x6f, 0xc2, 0x79, 0x72, 0x58, 0x7a, 0x50, 0x00, 0x77, 0x14, 0xd5, 0xd6, 0x92, 0x15, 0x97, 0x50,
-	0x2f, 0x13, 0x56, 0x5c, 0x2e, 0xe1, 0x0b, 0x88, 0x04, 0xfd, 0x9a, 0x88, 0x18, 0x93, 0x24, 0xe1,
-	0x91, 0xf1, 0x49, 0x6f, 0xc3, 0x30, 0x79, 0xc7, 0x7f, 0x93, 0x0c, 0xfd, 0xd0, 0xc4, 0x1f, 0x2e,
-	0xc2, 0x47, 0x75, 0x3d, 0x7d, 0x78, 0x53, 0xac, 0xd9, 0x25, 0x1a, 0x43, 0x28, 0x15, 0x11, 0x0a,
-	0x6b, 0xc9, 0x79, 0x0d, 0xb3, 0xc5, 0xfd, 0xd7, 0xb6, 0xf8, 0xc4, 0xe9, 0xb1, 0x5c, 0xe3, 0x73,
-	0xbd, 0xc6, 0x96, 0xc9, 0xd3, 0x1e, 0xf4, 0x31, 0x6c, 0xd2, 0x2c, 0x2e, 0x4b, 0x6c, 0xfe, 0x8b,
-	0x12, 0x9b, 0x34, 0x8b, 0x4d, 0x81, 0xf7, 0x21, 0x64, 0xd2, 0x29, 0xc0, 0x6b, 0xf6, 0xc1, 0xb0,
-	0x19, 0xb6, 0x98, 0xb4, 0xbc, 0x6b, 0x29, 0x31, 0x89, 0xdd, 0x6a, 0x68, 0xec, 0xb5, 0x4c, 0xc4,
-	0x75, 0x26, 0x9f, 0x2c, 0x6c, 0x83, 0x9f, 0x01, 0xbc, 0xb1, 0x3e, 0x37, 0xea, 0xc0, 0x8d, 0x98,
-	0x66, 0x3c, 0x35, 0x9c, 0xb6, 0xc2, 0xf2, 0x80, 0x6e, 0xc1, 0xcd, 0x9c, 0x30, 0x81, 0x59, 0x6c,
-	0x28, 0xad, 0x87, 0x0d, 0x7d, 0x7c, 0x1c, 0x23, 0x09, 0xb7, 0xcb, 0x15, 0x49, 0x9c, 0x53, 0x81,
-	0x63, 0x72, 0xee, 0xd5, 0xfe, 0xff, 0x2f, 0x66, 0xcb, 0xf6, 0x98, 0x50, 0x71, 0x44, 0xce, 0x07,
-	0xdf, 0xd6, 0x60, 0xfd, 0x98, 0x88, 0x14, 0x7d, 0x09, 0x3b, 0x8a, 0x2b, 0x92, 0x60, 0xa7, 0x28,
-	0x92, 0xf2, 0x22, 0x53, 0x25, 0xf6, 0x91, 0xaf, 0xfb, 0xfc, 0xfe, 0xb2, 0x77, 0xe7, 0x2d, 0xfa,
-	0x3c, 0xce, 0x54, 0x88, 0x4c, 0xad, 0xe3, 0xb2, 0xd4, 0xa1, 0xa9, 0x84, 0xbe, 0x81, 0xdb, 0x51,
-	0x21, 0x04, 0xcd, 0x14, 0xb6, 0x18, 0xbc, 0xaa, 0x99, 0xef, 0xbd, 0x37, 0xce, 0x77, 0x44, 0x23,
-	0x33, 0xe2, 0x43, 0x3b, 0xe2, 0x47, 0x6f, 0xd1, 0xda, 0xe6, 0xc8, 0xb0, 0x6d, 0x3b, 0x95, 0x9c,
-	0x48, 0xf4, 0x1d, 0x80, 0x3b, 0xbc, 0x50, 0x52, 0x91, 0x2c, 0xd6, 0xc3, 0x39, 0x00, 0xb5, 0x77,
-	0x05, 0x00, 0xad, 0x74, 0x73, 0x20, 0xf6, 0x60, 0x23, 0xa7, 0x82, 0xf1, 0xd8, 0x7c, 0x6f, 0x9a,
-	0x78, 0x73, 0x1a, 0xfc, 0x05, 0x60, 0x73, 0xc2, 0x25, 0x33, 0xa2, 0xd9, 0x83, 0x0d, 0xcd, 0x00,
-	0x15, 0x56, 0x35, 0xf6, 0xb4, 0x14, 0x53, 0x75, 0x55, 0x4c, 0x4f, 0x61, 0x7b, 0x8d, 0xaf, 0xda,
-	0x7f, 0xe2, 0x6b, 0x6b, 0xf6, 0x0f, 0xaa, 0xee, 0xc2, 0xed, 0x5c, 0xd0, 0x53, 0xc6, 0x0b, 0xa3,
-	0xc5, 0x25, 0xe4, 0xb6, 0x33, 0x4f, 0x8c, 0x15, 0x3d, 0x80, 0xbb, 0xe6, 0x4b, 0xd4, 0x00, 0xca,
-	0x4b, 0xf1, 0x84, 0xb2, 0xf9, 0x89, 0xf2, 0x36, 0xfa, 0x60, 0x58, 0x0b, 0x77, 0x9c, 0xd3, 0x5c,
-	0x79, 0x8f, 0x8c, 0x6b, 0x70, 0x01, 0xe0, 0xcd, 0x47, 0x4c, 0x2a, 0x2e, 0x58, 0x44, 0x12, 0xb7,
-	0x9c, 0x1f, 0x00, 0xbc, 0x15, 0x15, 0x69, 0x91, 0x10, 0xc5, 0x4e, 0x29, 0x2e, 0x32, 0xb6, 0x94,
-	0x09, 0x78, 0x57, 0x2c, 0xed, 0x2e, 0x3b, 0x3e, 0xcd, 0xd8, 0x42, 0x2d, 0x77, 0xf5, 0x97, 0x38,
-	0xa3, 0x82, 0x66, 0x91, 0x7e, 0x43, 0xf4, 0x5a, 0xab, 0xe6, 0x49, 0x68, 0x2f, 0xcc, 0x63, 0x6d,
-	0x1d, 0x7d, 0x72, 0xf1, 0x67, 0xb7, 0x72, 0x71, 0xd9, 0x05, 0x2f, 0x2e, 0xbb, 0xe0, 0x8f, 0xcb,
-	0x2e, 0x78, 0x7e, 0xd5, 0xad, 0xbc, 0xb8, 0xea, 0x56, 0x7e, 0xbb, 0xea, 0x56, 0x3e, 0x0f, 0x5e,
-	0x83, 0xa2, 0xaf, 0xcb, 0x7b, 0x09, 0x99, 0xca, 0xa0, 0x7c, 0xe3, 0xcf, 0xdc, 0x2b, 0x6f, 0x70,
-	0x4d, 0x1b, 0xe6, 0xc2, 0x7a, 0xf8, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x5b, 0xa1, 0x02, 0x6c,
-	0x02, 0x08, 0x00, 0x00,
+	// 1367 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xf7, 0xda, 0x8e, 0x1b, 0x4f, 0x6c, 0xc7, 0x9d, 0x24, 0xad, 0x6b, 0xf5, 0xeb, 0x38, 0xee,
+	0x57, 0x6d, 0x04, 0xaa, 0xdd, 0x1f, 0x1c, 0x91, 0x50, 0xec, 0x38, 0xaa, 0x51, 0xe2, 0xba, 0x9b,
+	0x04, 0x21, 0x40, 0x5a, 0xc6, 0xbb, 0x63, 0x67, 0xd4, 0xdd, 0x9d, 0x65, 0x66, 0x37, 0x24, 0x1c,
+	0x38, 0x70, 0x40, 0x90, 0x53, 0xb9, 0x71, 0x09, 0x17, 0x0e, 0x20, 0xfe, 0x92, 0x1c, 0x7b, 0x44,
+	0x1c, 0x5a, 0x68, 0xcf, 0xfc, 0x0f, 0x68, 0x7e, 0xac, 0x7f, 0xa4, 0x39, 0xa4, 0x51, 0x7a, 0xb2,
+	0xe7, 0xcd, 0xe7, 0xbd, 0x79, 0xef, 0xf3, 0x7e, 0xcc, 0x2c, 0x58, 0xe1, 0x5f, 0x45, 0xc8, 0x69,
+	0xb8, 0xc1, 0x00, 0x31, 0xaf, 0xb1, 0x7f, 0xbf, 0x8f, 0x43, 0x74, 0x5f, 0x2f, 0xeb, 0x01, 0xa3,
+	0x21, 0x85, 0x8b, 0x12, 0x52, 0xd7, 0x32, 0x0d, 0x29, 0x2f, 0x0e, 0xe9, 0x90, 0x4a, 0x40, 0x43,
+	0xfc, 0x53, 0xd8, 0x72, 0xc5, 0xa6, 0xdc, 0xa3, 0xbc, 0xd1, 0x47, 0x1c, 0x8f, 0xac, 0xd9, 0x94,
+	0xf8, 0x7a, 0x7f, 0x79, 0x48, 0xe9, 0xd0, 0xc5, 0x0d, 0xb9, 0xea, 0x47, 0x83, 0x46, 0x48, 0x3c,
+	0xcc, 0x43, 0xe4, 0x05, 0xb1, 0x81, 0xd3, 0x00, 0x27, 0x62, 0x28, 0x24, 0x54, 0x1b, 0xa8, 0x1d,
+	0xa5, 0x40, 0xa6, 0x87, 0x18, 0xf2, 0x38, 0xfc, 0xde, 0x00, 0x37, 0x02, 0x46, 0xf6, 0x51, 0x88,
+	0xad, 0xc0, 0x45, 0xbe, 0x65, 0x33, 0x2c, 0xa1, 0xd6, 0x00, 0xe3, 0x92, 0x51, 0x4d, 0xad, 0xce,
+	0x3d, 0xb8, 0x51, 0x57, 0x0e, 0xd5, 0x85, 0x43, 0xb1, 0xef, 0xf5, 0x16, 0x25, 0x7e, 0xf3, 0xde,
+	0xc9, 0x8b, 0xe5, 0xc4, 0x1f, 0x2f, 0x97, 0x57, 0x87, 0x24, 0xdc, 0x8b, 0xfa, 0x75, 0x9b, 0x7a,
+	0x0d, 0xed, 0xbd, 0xfa, 0xb9, 0xcb, 0x9d, 0xa7, 0x8d, 0xf0, 0x30, 0xc0, 0x5c, 0x2a, 0x70, 0xf3,
+	0x9a, 0x3e, 0xad, 0xe7, 0x22, 0xbf, 0xa5, 0xcf, 0xda, 0xc0, 0x18, 0xde, 0x02, 0xf9, 0x01, 0xc6,
+	0x96, 0x4d, 0x5d, 0x17, 0xdb, 0x21, 0x65, 0xa5, 0x64, 0xd5, 0x58, 0xcd, 0x9a, 0xb9, 0x01, 0xc6,
+	0xad, 0x58, 0x06, 0xef, 0x83, 0x25, 0x0f, 0x1d, 0x58, 0x7e, 0xe4, 0x59, 0x93, 0x4e, 0xf3, 0x52,
+	0xaa, 0x6a, 0xac, 0xe6, 0x4d, 0xe8, 0xa1, 0x83, 0x6e, 0xe4, 0xf5, 0xc6, 0x27, 0x70, 0xf8, 0x04,
+	0x08, 0xa9, 0xd5, 0x77, 0xa9, 0xfd, 0xd4, 0x8a, 0x79, 0x28, 0xa5, 0xab, 0x86, 0x0c, 0x4c, 0x11,
+	0x55, 0x8f, 0x89, 0xaa, 0xaf, 0x6b, 0x40, 0x73, 0x56, 0x04, 0xf6, 0xf3, 0xcb, 0x65, 0xc3, 0x2c,
+	0x7a, 0xe8, 0xa0, 0x29, 0xb4, 0xe3, 0x3d, 0xb8, 0x09, 0xf2, 0x62, 0x1d, 0x05, 0x56, 0x9f, 0x52,
+	0x1e, 0xf2, 0xd2, 0x8c, 0xa4, 0x69, 0xa5, 0x7e, 0x56, 0x8e, 0xeb, 0x9b, 0x12, 0xda, 0x14, 0xc8,
+	0x66, 0x5a, 0x58, 0x35, 0x73, 0xee, 0x58, 0xc4, 0x6b, 0xbf, 0x18, 0x60, 0x6e, 0x02, 0x03, 0x3f,
+	0x02, 0xb3, 0x23, 0x37, 0x8d, 0xf3, 0xbb, 0x39, 0x52, 0x82, 0x5d, 0x00, 0xbc, 0xc8, 0x0d, 0x49,
+	0xe0, 0x12, 0xac, 0x69, 0x6c, 0xd6, 0x05, 0xee, 0xaf, 0x17, 0xcb, 0xb7, 0xcf, 0x91, 0xa7, 0x75,
+	0x6c, 0x9b, 0x13, 0x16, 0x6a, 0x3f, 0xa5, 0x41, 0x5a, 0x70, 0x09, 0x0b, 0x20, 0x49, 0x1c, 0xe9,
+	0x53, 0xda, 0x4c, 0x12, 0x07, 0x56, 0xc1, 0x9c, 0x83, 0xb9, 0xcd, 0x48, 0x20, 0x9d, 0x55, 0x09,
+	0x9b, 0x14, 0xc1, 0x7b, 0x60, 0x51, 0x70, 0x41, 0xfc, 0xa1, 0x15, 0x50, 0xea, 0x5a, 0xc8, 0x71,
+	0x18, 0xe6, 0x2a, 0x5d, 0x59, 0x13, 0xea, 0xbd, 0x1e, 0xa5, 0xee, 0x9a, 0xda, 0x81, 0x0d, 0xb0,
+	0x10, 0x62, 0x21, 0x55, 0x45, 0x18, 0x2b, 0xa4, 0x95, 0xc2, 0xc4, 0x56, 0xac, 0xf0, 0x39, 0x80,
+	0x0c, 0x7f, 0x8d, 0x98, 0x63, 0x21, 0xd7, 0xa5, 0xb6, 0xdc, 0x8b, 0x33, 0x72, 0xfb, 0xec, 0x8c,
+	0x98, 0x12, 0xbf, 0x36, 0x82, 0xeb, 0xb4, 0x5c, 0x65, 0xa7, 0xe4, 0x1c, 0xb6, 0x00, 0xe0, 0x21,
+	0x62, 0xa1, 0x25, 0x3a, 0xac, 0x94, 0x91, 0xd9, 0x28, 0xbf, 0x91, 0x8d, 0x9d, 0xb8, 0xfd, 0x54,
+	0x3a, 0x9e, 0x89, 0x74, 0x64, 0xa5, 0x9e, 0xd8, 0x11, 0x09, 0xc5, 0xbe, 0xa3, 0x4c, 0x5c, 0x79,
+	0x0b, 0x13, 0x57, 0xb0, 0xef, 0x48, 0x03, 0xff, 0x03, 0x80, 0xf0, 0xb8, 0xe0, 0x4b, 0xb3, 0x55,
+	0x63, 0x75, 0xd6, 0xcc, 0x12, 0xae, 0xcb, 0x5c, 0x74, 0x0e, 0xe1, 0x56, 0x4c, 0x0d, 0x76, 0x4a,
+	0x59, 0x89, 0xc8, 0x11, 0xbe, 0x33, 0x92, 0xc1, 0x2d, 0x30, 0xbf, 0x87, 0xc9, 0x70, 0x2f, 0xb4,
+	0xb8, 0xbd, 0x87, 0x9d, 0xc8, 0xc5, 0x25, 0x20, 0x7d, 0xf9, 0xff, 0xd9, 0x1c, 0x3d, 0x92, 0xe0,
+	0x6d, 0x8d, 0x35, 0x0b, 0x7b, 0x53, 0xeb, 0xda, 0xb3, 0x24, 0x28, 0x4c, 0x43, 0xe0, 0x0a, 0xc8,
+	0x29, 0xae, 0x14, 0x54, 0xd6, 0x49, 0xca, 0x9c, 0x93, 0x32, 0x05, 0x15, 0x81, 0x08, 0x26, 0x34,
+	0x20, 0x29, 0x01, 0x59, 0xec, 0x3b, 0x7a, 0xfb, 0x63, 0x50, 0xc0, 0x1e, 0xe1, 0x5c, 0x24, 0xde,
+	0x8e, 0xd8, 0x3e, 0x96, 0x75, 0x52, 0x78, 0x70, 0xeb, 0x6c, 0x17, 0xdb, 0x1a, 0xdb, 0x12, 0x50,
+	0x33, 0x8f, 0x27, 0x97, 0x70, 0x0b, 0x00, 0x07, 0xdb, 0xe8, 0xd0, 0x62, 0x82, 0xb3, 0xf4, 0x85,
+	0x9a, 0x20, 0x2b, 0x2d, 0x98, 0x82, 0xe3, 0x15, 0x90, 0x53, 0xe6, 0x02, 0xcc, 0x08, 0x75, 0x4a,
+	0x33, 0x2a, 0x38, 0x29, 0xeb, 0x49, 0x51, 0xed, 0xf7, 0x14, 0x28, 0x9e, 0xae, 0x2c, 0xb8, 0x08,
+	0x66, 0x1c, 0xec, 0x53, 0x4f, 0xb2, 0x91, 0x35, 0xd5, 0x02, 0x5e, 0x07, 0x57, 0x02, 0x44, 0x98,
+	0x45, 0x1c, 0x49, 0x42, 0xda, 0xcc, 0x88, 0x65, 0xc7, 0x81, 0x1c, 0xcc, 0xab, 0x22, 0xe4, 0xe2,
+	0x20, 0xcb, 0x41, 0x87, 0xa5, 0xd4, 0xe5, 0x8f, 0xe0, 0xbc, 0x3e, 0xa3, 0x87, 0xd9, 0x3a, 0x3a,
+	0x84, 0x01, 0xc8, 0x87, 0x34, 0x44, 0xae, 0xa5, 0xc5, 0xa5, 0xf4, 0xe5, 0x1f, 0x99, 0x93, 0x27,
+	0x28, 0x7a, 0x38, 0xfc, 0x56, 0x8d, 0xf1, 0xc9, 0x50, 0xe5, 0x7c, 0xd6, 0x6d, 0x7b, 0xa9, 0x27,
+	0x8b, 0xe9, 0x6f, 0x8e, 0xe2, 0x95, 0x83, 0xbc, 0xf6, 0x32, 0x05, 0xd2, 0x1b, 0x88, 0x79, 0xf0,
+	0x4b, 0xb0, 0xa8, 0x42, 0x8f, 0xa7, 0x14, 0xf2, 0x68, 0xe4, 0xab, 0xda, 0x7d, 0xbb, 0x7a, 0xe9,
+	0xf8, 0xa1, 0x09, 0xa5, 0xad, 0x0d, 0x65, 0x6a, 0x4d, 0x5a, 0x82, 0xdf, 0x80, 0x79, 0x3b, 0x62,
+	0x0c, 0xfb, 0xe1, 0x88, 0xde, 0xa4, 0x0c, 0xf2, 0xe6, 0x99, 0x41, 0xae, 0x63, 0x5b, 0xc6, 0xf9,
+	0x50, 0xc7, 0xf9, 0xfe, 0xf9, 0x4a, 0x55, 0x85, 0x5a, 0xd0, 0x27, 0xc5, 0x34, 0x7f, 0x67, 0x80,
+	0x05, 0x1a, 0x85, 0x3c, 0x44, 0xbe, 0x23, 0x82, 0x8b, 0x1d, 0x48, 0xbd, 0x2b, 0x07, 0xe0, 0xc4,
+	0x69, 0xb1, 0x13, 0xd7, 0x40, 0x46, 0xf7, 0x4c, 0x5a, 0x97, 0xba, 0x5c, 0xc1, 0x2f, 0x80, 0xa2,
+	0x4b, 0xdd, 0xa1, 0x31, 0xf1, 0x33, 0x17, 0x22, 0xbe, 0x28, 0x2d, 0xc9, 0xeb, 0x53, 0xd1, 0x5e,
+	0xfb, 0x2d, 0x0d, 0x66, 0x7b, 0x94, 0x13, 0xd9, 0x84, 0xd7, 0x40, 0x46, 0xe4, 0x17, 0x33, 0xdd,
+	0x85, 0x7a, 0x35, 0x6e, 0xce, 0xe4, 0x64, 0x73, 0xee, 0x82, 0xc2, 0xa9, 0x6a, 0x48, 0x5d, 0xc8,
+	0xa9, 0xfc, 0x60, 0xaa, 0x10, 0xee, 0x80, 0xf9, 0x80, 0xe1, 0x7d, 0x42, 0x23, 0x6e, 0x4d, 0x11,
+	0x52, 0x88, 0xc5, 0x6a, 0x8e, 0xc0, 0x07, 0x60, 0x49, 0xce, 0x4c, 0xe1, 0x80, 0x7a, 0xb5, 0xe8,
+	0x79, 0xa9, 0x66, 0xce, 0x42, 0xbc, 0x29, 0x4b, 0x59, 0x4f, 0xce, 0x47, 0xea, 0x45, 0x32, 0x7e,
+	0xdf, 0x64, 0xce, 0xff, 0x70, 0xc8, 0x4d, 0xbd, 0x6d, 0x62, 0x4b, 0x17, 0xba, 0xb1, 0xe6, 0x84,
+	0x6a, 0x5b, 0xdf, 0x5a, 0x4f, 0x40, 0x6e, 0x2a, 0xb5, 0xb3, 0x17, 0x62, 0x71, 0xae, 0x3f, 0xce,
+	0x2a, 0x34, 0x41, 0xfc, 0x64, 0xb0, 0x26, 0xae, 0xe5, 0xec, 0x5b, 0x78, 0x58, 0xd4, 0xfa, 0xdb,
+	0xf1, 0xed, 0x5c, 0x3b, 0x31, 0xc0, 0xd5, 0x47, 0x84, 0x87, 0x94, 0x11, 0x7b, 0x3c, 0xa1, 0x7e,
+	0x34, 0xc0, 0x75, 0x3b, 0xf2, 0x22, 0x17, 0x85, 0x64, 0x1f, 0x5b, 0x91, 0x4f, 0xc6, 0xfd, 0x6b,
+	0xbc, 0xab, 0xf6, 0x59, 0x1a, 0x9f, 0xb8, 0xeb, 0x93, 0x51, 0x1b, 0xdf, 0x11, 0x97, 0xc2, 0x00,
+	0x33, 0xec, 0xdb, 0xe2, 0x7d, 0x2c, 0xb8, 0x4c, 0xca, 0xe7, 0x6e, 0x61, 0x24, 0x6e, 0x09, 0xe9,
+	0x7b, 0xff, 0x1a, 0x20, 0x3f, 0x75, 0x29, 0xc2, 0x0f, 0x40, 0xb9, 0xbd, 0xd5, 0xd9, 0xde, 0xee,
+	0x3c, 0xee, 0x5a, 0xad, 0x5d, 0xf3, 0x93, 0xb6, 0xb5, 0xdb, 0xdd, 0xee, 0xb5, 0x5b, 0x9d, 0x8d,
+	0x4e, 0x7b, 0xbd, 0x98, 0x28, 0x2f, 0x1e, 0x1d, 0x57, 0x8b, 0x53, 0x2a, 0x5d, 0xe2, 0xc2, 0x3a,
+	0x58, 0x38, 0xa5, 0xb5, 0xb1, 0xb9, 0xb6, 0x53, 0x34, 0xca, 0x4b, 0x47, 0xc7, 0xd5, 0xab, 0x53,
+	0xf0, 0x0d, 0x17, 0x85, 0xa2, 0x62, 0x4f, 0xe1, 0x37, 0x3b, 0xdd, 0xf6, 0x9a, 0x59, 0x4c, 0x96,
+	0xaf, 0x1f, 0x1d, 0x57, 0x17, 0xa6, 0x34, 0x36, 0x89, 0x8f, 0x11, 0x83, 0x1f, 0xbe, 0xe1, 0x59,
+	0xfb, 0xd3, 0xde, 0xe3, 0x6e, 0xbb, 0xbb, 0xd3, 0x59, 0xdb, 0x2c, 0xa6, 0xca, 0x37, 0x8f, 0x8e,
+	0xab, 0xa5, 0x29, 0xc5, 0xf6, 0x41, 0x40, 0x7d, 0xec, 0x87, 0x04, 0xb9, 0xe5, 0xf4, 0x0f, 0xbf,
+	0x56, 0x12, 0xcd, 0xad, 0x93, 0x7f, 0x2a, 0x89, 0x93, 0x57, 0x15, 0xe3, 0xf9, 0xab, 0x8a, 0xf1,
+	0xf7, 0xab, 0x8a, 0xf1, 0xec, 0x75, 0x25, 0xf1, 0xfc, 0x75, 0x25, 0xf1, 0xe7, 0xeb, 0x4a, 0xe2,
+	0xb3, 0xc6, 0x1b, 0xd4, 0x8b, 0x47, 0xc4, 0x5d, 0x17, 0xf5, 0x79, 0x43, 0x7d, 0xaf, 0x1d, 0xc4,
+	0x5f, 0x6c, 0x32, 0x0f, 0xfd, 0x8c, 0xac, 0x9c, 0x87, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0xd8,
+	0x8c, 0xb6, 0xf4, 0xce, 0x0d, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -367,6 +541,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.LockupBoosts) > 0 {
+		for iNdEx := len(m.LockupBoosts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockupBoosts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLpfarm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxBlockDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxBlockDuration):])
 	if err1 != nil {
 		return 0, err1
@@ -404,6 +592,47 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LockupBoost) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockupBoost) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockupBoost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Multiplier.Size()
+		i -= size
+		if _, err := m.Multiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLpfarm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintLpfarm(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Plan) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -424,6 +653,18 @@ func (m *Plan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.HeightSchedule != nil {
+		{
+			size, err := m.HeightSchedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLpfarm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	if m.IsTerminated {
 		i--
 		if m.IsTerminated {
@@ -444,20 +685,20 @@ func (m *Plan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x40
 	}
-	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
-	if err2 != nil {
-		return 0, err2
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err4 != nil {
+		return 0, err4
 	}
-	i -= n2
-	i = encodeVarintLpfarm(dAtA, i, uint64(n2))
+	i -= n4
+	i = encodeVarintLpfarm(dAtA, i, uint64(n4))
 	i--
 	dAtA[i] = 0x3a
-	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
-	if err3 != nil {
-		return 0, err3
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err5 != nil {
+		return 0, err5
 	}
-	i -= n3
-	i = encodeVarintLpfarm(dAtA, i, uint64(n3))
+	i -= n5
+	i = encodeVarintLpfarm(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0x32
 	if len(m.RewardAllocations) > 0 {
@@ -503,6 +744,59 @@ func (m *Plan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HeightSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeightSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HeightSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DecayPeriod != 0 {
+		i = encodeVarintLpfarm(dAtA, i, uint64(m.DecayPeriod))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.DecayRate.Size()
+		i -= size
+		if _, err := m.DecayRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLpfarm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.EmissionCurve != 0 {
+		i = encodeVarintLpfarm(dAtA, i, uint64(m.EmissionCurve))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintLpfarm(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintLpfarm(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *RewardAllocation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -523,6 +817,34 @@ func (m *RewardAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MaxRewardsPerBlock) > 0 {
+		for iNdEx := len(m.MaxRewardsPerBlock) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxRewardsPerBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLpfarm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.TotalRewards) > 0 {
+		for iNdEx := len(m.TotalRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLpfarm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.RewardsPerDay) > 0 {
 		for iNdEx := len(m.RewardsPerDay) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -572,6 +894,16 @@ func (m *Farm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.TotalBoostAmount.Size()
+		i -= size
+		if _, err := m.TotalBoostAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLpfarm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	if m.Period != 0 {
 		i = encodeVarintLpfarm(dAtA, i, uint64(m.Period))
 		i--
@@ -638,6 +970,40 @@ func (m *Position) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.FarmingStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.FarmingStartTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintLpfarm(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.BoostAmount.Size()
+		i -= size
+		if _, err := m.BoostAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLpfarm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LockEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LockEndTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintLpfarm(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x3a
+	n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.LockDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.LockDuration):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintLpfarm(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x32
 	if m.StartingBlockHeight != 0 {
 		i = encodeVarintLpfarm(dAtA, i, uint64(m.StartingBlockHeight))
 		i--
@@ -749,6 +1115,25 @@ func (m *Params) Size() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxBlockDuration)
 	n += 1 + l + sovLpfarm(uint64(l))
+	if len(m.LockupBoosts) > 0 {
+		for _, e := range m.LockupBoosts {
+			l = e.Size()
+			n += 1 + l + sovLpfarm(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LockupBoost) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovLpfarm(uint64(l))
+	l = m.Multiplier.Size()
+	n += 1 + l + sovLpfarm(uint64(l))
 	return n
 }
 
@@ -789,6 +1174,33 @@ func (m *Plan) Size() (n int) {
 	if m.IsTerminated {
 		n += 2
 	}
+	if m.HeightSchedule != nil {
+		l = m.HeightSchedule.Size()
+		n += 1 + l + sovLpfarm(uint64(l))
+	}
+	return n
+}
+
+func (m *HeightSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovLpfarm(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovLpfarm(uint64(m.EndHeight))
+	}
+	if m.EmissionCurve != 0 {
+		n += 1 + sovLpfarm(uint64(m.EmissionCurve))
+	}
+	l = m.DecayRate.Size()
+	n += 1 + l + sovLpfarm(uint64(l))
+	if m.DecayPeriod != 0 {
+		n += 1 + sovLpfarm(uint64(m.DecayPeriod))
+	}
 	return n
 }
 
@@ -811,6 +1223,18 @@ func (m *RewardAllocation) Size() (n int) {
 			n += 1 + l + sovLpfarm(uint64(l))
 		}
 	}
+	if len(m.TotalRewards) > 0 {
+		for _, e := range m.TotalRewards {
+			l = e.Size()
+			n += 1 + l + sovLpfarm(uint64(l))
+		}
+	}
+	if len(m.MaxRewardsPerBlock) > 0 {
+		for _, e := range m.MaxRewardsPerBlock {
+			l = e.Size()
+			n += 1 + l + sovLpfarm(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -837,6 +1261,8 @@ func (m *Farm) Size() (n int) {
 	if m.Period != 0 {
 		n += 1 + sovLpfarm(uint64(m.Period))
 	}
+	l = m.TotalBoostAmount.Size()
+	n += 1 + l + sovLpfarm(uint64(l))
 	return n
 }
 
@@ -862,6 +1288,14 @@ func (m *Position) Size() (n int) {
 	if m.StartingBlockHeight != 0 {
 		n += 1 + sovLpfarm(uint64(m.StartingBlockHeight))
 	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.LockDuration)
+	n += 1 + l + sovLpfarm(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LockEndTime)
+	n += 1 + l + sovLpfarm(uint64(l))
+	l = m.BoostAmount.Size()
+	n += 1 + l + sovLpfarm(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.FarmingStartTime)
+	n += 1 + l + sovLpfarm(uint64(l))
 	return n
 }
 
@@ -1036,17 +1470,168 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLpfarm(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthLpfarm
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockupBoosts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLpfarm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLpfarm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLpfarm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockupBoosts = append(m.LockupBoosts, LockupBoost{})
+			if err := m.LockupBoosts[len(m.LockupBoosts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLpfarm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLpfarm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockupBoost) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLpfarm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockupBoost: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockupBoost: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLpfarm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLpfarm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLpfarm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLpfarm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLpfarm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLpfarm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Multiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLpfarm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLpfarm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -1270,9 +1855,225 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLpfarm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLpfarm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLpfarm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsPrivate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLpfarm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsPrivate = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsTerminated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLpfarm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsTerminated = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeightSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLpfarm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLpfarm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLpfarm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HeightSchedule == nil {
+				m.HeightSchedule = &HeightSchedule{}
+			}
+			if err := m.HeightSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLpfarm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLpfarm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HeightSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLpfarm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HeightSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HeightSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLpfarm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLpfarm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionCurve", wireType)
+			}
+			m.EmissionCurve = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLpfarm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EmissionCurve |= EmissionCurve(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayRate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLpfarm
@@ -1282,50 +2083,31 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLpfarm
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLpfarm
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DecayRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsPrivate", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLpfarm
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsPrivate = bool(v != 0)
-		case 9:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsTerminated", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayPeriod", wireType)
 			}
-			var v int
+			m.DecayPeriod = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLpfarm
@@ -1335,12 +2117,11 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.DecayPeriod |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsTerminated = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLpfarm(dAtA[iNdEx:])
@@ -1476,6 +2257,74 @@ func (m *RewardAllocation) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLpfarm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLpfarm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLpfarm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalRewards = append(m.TotalRewards, types.Coin{})
+			if err := m.TotalRewards[len(m.TotalRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRewardsPerBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLpfarm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLpfarm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLpfarm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxRewardsPerBlock = append(m.MaxRewardsPerBlock, types.Coin{})
+			if err := m.MaxRewardsPerBlock[len(m.MaxRewardsPerBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLpfarm(dAtA[iNdEx:])
@@ -1647,6 +2496,40 @@ func (m *Farm) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBoostAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLpfarm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLpfarm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLpfarm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalBoostAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLpfarm(dAtA[iNdEx:])
@@ -1833,6 +2716,139 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLpfarm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLpfarm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLpfarm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.LockDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLpfarm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLpfarm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLpfarm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LockEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoostAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLpfarm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLpfarm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLpfarm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BoostAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FarmingStartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLpfarm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLpfarm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLpfarm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.FarmingStartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLpfarm(dAtA[iNdEx:])
@@ -35,6 +35,19 @@ func NewMsgCreatePrivatePlan(
 	}
 }
 
+// NewMsgCreatePrivateHeightPlan creates a new MsgCreatePrivatePlan for a plan
+// with the height schedule.
+func NewMsgCreatePrivateHeightPlan(
+	creatorAddr sdk.AccAddress, description string, rewardAllocations []RewardAllocation,
+	heightSchedule HeightSchedule) *MsgCreatePrivatePlan {
+	return &MsgCreatePrivatePlan{
+		Creator:           creatorAddr.String(),
+		Description:       description,
+		RewardAllocations: rewardAllocations,
+		HeightSchedule:    &heightSchedule,
+	}
+}
+
 func (msg MsgCreatePrivatePlan) Route() string { return RouterKey }
 func (msg MsgCreatePrivatePlan) Type() string  { return TypeMsgCreatePrivatePlan }
 
@@ -60,6 +73,7 @@ func (msg MsgCreatePrivatePlan) ValidateBasic() error {
 	dummyPlan := NewPlan(
 		1, msg.Description, validAddr, validAddr,
 		msg.RewardAllocations, msg.StartTime, msg.EndTime, true)
+	dummyPlan.HeightSchedule = msg.HeightSchedule
 	if err := dummyPlan.Validate(); err != nil {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
@@ -82,6 +96,16 @@ func NewMsgFarm(farmerAddr sdk.AccAddress, coin sdk.Coin) *MsgFarm {
 	}
 }
 
+// NewMsgFarmWithLockup creates a new MsgFarm which locks the position for
+// the lockup duration.
+func NewMsgFarmWithLockup(farmerAddr sdk.AccAddress, coin sdk.Coin, lockDuration time.Duration) *MsgFarm {
+	return &MsgFarm{
+		Farmer:       farmerAddr.String(),
+		Coin:         coin,
+		LockDuration: lockDuration,
+	}
+}
+
 func (msg MsgFarm) Route() string { return RouterKey }
 func (msg MsgFarm) Type() string  { return TypeMsgFarm }
 
@@ -107,6 +131,9 @@ func (msg MsgFarm) ValidateBasic() error {
 	if !msg.Coin.IsPositive() {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "non-positive coin: %s", msg.Coin)
 	}
+	if msg.LockDuration < 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "negative lock duration: %s", msg.LockDuration)
+	}
 	return nil
 }
 
@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
@@ -42,6 +43,20 @@ func TestMsgFarm(t *testing.T) {
 			},
 			"non-positive coin: 0pool1: invalid request",
 		},
+		{
+			"lock duration",
+			func(msg *types.MsgFarm) {
+				msg.LockDuration = time.Hour
+			},
+			"",
+		},
+		{
+			"negative lock duration",
+			func(msg *types.MsgFarm) {
+				msg.LockDuration = -time.Hour
+			},
+			"negative lock duration: -1h0m0s: invalid request",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			msg := types.NewMsgFarm(utils.TestAddress(0), utils.ParseCoin("1000_000000pool1"))
@@ -16,6 +16,7 @@ var (
 	KeyFeeCollector           = []byte("FeeCollector")
 	KeyMaxNumPrivatePlans     = []byte("MaxNumPrivatePlans")
 	KeyMaxBlockDuration       = []byte("MaxBlockDuration")
+	KeyLockupBoosts           = []byte("LockupBoosts")
 )
 
 const (
@@ -28,6 +29,7 @@ const (
 var (
 	DefaultPrivatePlanCreationFee = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100_000000))
 	DefaultFeeCollectorAddress    = sdk.AccAddress(address.Module(ModuleName, []byte("FeeCollector")))
+	DefaultLockupBoosts           = []LockupBoost{}
 
 	RewardsPoolAddress = sdk.AccAddress(address.Module(ModuleName, []byte("RewardsPool")))
 )
@@ -43,6 +45,7 @@ func DefaultParams() Params {
 		FeeCollector:           DefaultFeeCollectorAddress.String(),
 		MaxNumPrivatePlans:     DefaultMaxNumPrivatePlans,
 		MaxBlockDuration:       DefaultMaxBlockDuration,
+		LockupBoosts:           DefaultLockupBoosts,
 	}
 }
 
@@ -53,6 +56,7 @@ func (params *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 		paramstypes.NewParamSetPair(KeyFeeCollector, &params.FeeCollector, validateFeeCollector),
 		paramstypes.NewParamSetPair(KeyMaxNumPrivatePlans, &params.MaxNumPrivatePlans, validateMaxNumPrivatePlans),
 		paramstypes.NewParamSetPair(KeyMaxBlockDuration, &params.MaxBlockDuration, validateMaxBlockDuration),
+		paramstypes.NewParamSetPair(KeyLockupBoosts, &params.LockupBoosts, validateLockupBoosts),
 	}
 }
 
@@ -66,6 +70,7 @@ func (params Params) Validate() error {
 		{params.FeeCollector, validateFeeCollector},
 		{params.MaxNumPrivatePlans, validateMaxNumPrivatePlans},
 		{params.MaxBlockDuration, validateMaxBlockDuration},
+		{params.LockupBoosts, validateLockupBoosts},
 	} {
 		if err := field.validateFunc(field.val); err != nil {
 			return err
@@ -115,3 +120,21 @@ func validateMaxBlockDuration(i interface{}) error {
 	}
 	return nil
 }
+
+func validateLockupBoosts(i interface{}) error {
+	v, ok := i.([]LockupBoost)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	durationSet := map[time.Duration]struct{}{}
+	for _, boost := range v {
+		if err := boost.Validate(); err != nil {
+			return fmt.Errorf("invalid lockup boost: %w", err)
+		}
+		if _, ok := durationSet[boost.Duration]; ok {
+			return fmt.Errorf("duplicate lockup duration: %s", boost.Duration)
+		}
+		durationSet[boost.Duration] = struct{}{}
+	}
+	return nil
+}
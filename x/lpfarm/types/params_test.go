@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -57,6 +58,44 @@ func TestParams_Validate(t *testing.T) {
 			},
 			"max block duration must be positive",
 		},
+		{
+			"valid lockup boosts",
+			func(params *types.Params) {
+				params.LockupBoosts = []types.LockupBoost{
+					types.NewLockupBoost(7*24*time.Hour, utils.ParseDec("1.2")),
+					types.NewLockupBoost(30*24*time.Hour, utils.ParseDec("1.5")),
+				}
+			},
+			"",
+		},
+		{
+			"zero lockup duration",
+			func(params *types.Params) {
+				params.LockupBoosts = []types.LockupBoost{
+					types.NewLockupBoost(0, utils.ParseDec("1.2")),
+				}
+			},
+			"invalid lockup boost: lockup duration must be positive: 0s",
+		},
+		{
+			"too small boost multiplier",
+			func(params *types.Params) {
+				params.LockupBoosts = []types.LockupBoost{
+					types.NewLockupBoost(time.Hour, utils.ParseDec("0.9")),
+				}
+			},
+			"invalid lockup boost: boost multiplier must not be less than 1: 0.900000000000000000",
+		},
+		{
+			"duplicate lockup duration",
+			func(params *types.Params) {
+				params.LockupBoosts = []types.LockupBoost{
+					types.NewLockupBoost(time.Hour, utils.ParseDec("1.2")),
+					types.NewLockupBoost(time.Hour, utils.ParseDec("1.5")),
+				}
+			},
+			"duplicate lockup duration: 1h0m0s",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			params := types.DefaultParams()
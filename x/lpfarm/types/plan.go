@@ -29,12 +29,42 @@ func NewPlan(
 	}
 }
 
+// NewHeightPlan creates a new Plan with the height schedule.
+func NewHeightPlan(
+	id uint64, description string, farmingPoolAddr, termAddr sdk.AccAddress,
+	rewardAllocs []RewardAllocation, heightSchedule HeightSchedule,
+	isPrivate bool) Plan {
+	plan := NewPlan(
+		id, description, farmingPoolAddr, termAddr, rewardAllocs,
+		time.Time{}, time.Time{}, isPrivate)
+	plan.HeightSchedule = &heightSchedule
+	return plan
+}
+
 // IsActiveAt returns whether the plan is active(being able to distribute rewards)
 // at given time t.
 func (plan Plan) IsActiveAt(t time.Time) bool {
 	return !plan.StartTime.After(t) && plan.EndTime.After(t)
 }
 
+// IsActive returns whether the plan is active at given block height and time.
+// A plan with the height schedule is active within its height range and
+// the others are active within their time range.
+func (plan Plan) IsActive(height int64, t time.Time) bool {
+	if plan.HeightSchedule != nil {
+		return plan.HeightSchedule.IsActiveAt(height)
+	}
+	return plan.IsActiveAt(t)
+}
+
+// IsEnded returns whether the plan should be ended at given block height and time.
+func (plan Plan) IsEnded(height int64, t time.Time) bool {
+	if plan.HeightSchedule != nil {
+		return height >= plan.HeightSchedule.EndHeight
+	}
+	return !t.Before(plan.EndTime)
+}
+
 func (plan Plan) Validate() error {
 	if plan.Id == 0 {
 		return fmt.Errorf("plan id must be positive")
@@ -55,10 +85,19 @@ func (plan Plan) Validate() error {
 				plan.FarmingPoolAddress, plan.TerminationAddress)
 		}
 	}
-	if err := ValidateRewardAllocations(plan.RewardAllocations); err != nil {
+	if plan.HeightSchedule != nil {
+		if err := plan.HeightSchedule.Validate(); err != nil {
+			return fmt.Errorf("invalid height schedule: %w", err)
+		}
+	}
+	if err := ValidateRewardAllocations(plan.RewardAllocations, plan.HeightSchedule); err != nil {
 		return fmt.Errorf("invalid reward allocations: %w", err)
 	}
-	if !plan.StartTime.Before(plan.EndTime) {
+	if plan.HeightSchedule != nil {
+		if !plan.StartTime.IsZero() || !plan.EndTime.IsZero() {
+			return fmt.Errorf("start time and end time must not be set for a plan with height schedule")
+		}
+	} else if !plan.StartTime.Before(plan.EndTime) {
 		return fmt.Errorf("end time must be after start time")
 	}
 	return nil
@@ -96,10 +135,30 @@ func NewDenomRewardAllocation(denom string, rewardsPerDay sdk.Coins) RewardAlloc
 	}
 }
 
+// NewPairBudgetRewardAllocation creates a new RewardAllocation for a pair,
+// which allocates the total rewards over the height range of a plan with height schedule.
+func NewPairBudgetRewardAllocation(pairId uint64, totalRewards sdk.Coins) RewardAllocation {
+	return RewardAllocation{
+		PairId:       pairId,
+		TotalRewards: totalRewards,
+	}
+}
+
+// NewDenomBudgetRewardAllocation creates a new RewardAllocation for a target denom,
+// which allocates the total rewards over the height range of a plan with height schedule.
+func NewDenomBudgetRewardAllocation(denom string, totalRewards sdk.Coins) RewardAllocation {
+	return RewardAllocation{
+		Denom:        denom,
+		TotalRewards: totalRewards,
+	}
+}
+
 // ValidateRewardAllocations validates a slice of RewardAllocation.
 // It also checks whether there's any duplication of pair id among the
 // reward allocations.
-func ValidateRewardAllocations(rewardAllocs []RewardAllocation) error {
+// The reward allocations of a plan with height schedule must have total rewards
+// instead of rewards per day.
+func ValidateRewardAllocations(rewardAllocs []RewardAllocation, heightSchedule *HeightSchedule) error {
 	if len(rewardAllocs) == 0 {
 		return fmt.Errorf("empty reward allocations")
 	}
@@ -125,6 +184,27 @@ func ValidateRewardAllocations(rewardAllocs []RewardAllocation) error {
 			}
 			pairIdSet[rewardAlloc.PairId] = struct{}{}
 		}
+		if err := rewardAlloc.MaxRewardsPerBlock.Validate(); err != nil {
+			return fmt.Errorf("invalid max rewards per block: %w", err)
+		}
+		if heightSchedule != nil {
+			if !rewardAlloc.RewardsPerDay.Empty() {
+				return fmt.Errorf("rewards per day must not be set for a plan with height schedule")
+			}
+			if err := rewardAlloc.TotalRewards.Validate(); err != nil {
+				return fmt.Errorf("invalid total rewards: %w", err)
+			}
+			if rewardAlloc.TotalRewards.Empty() {
+				return fmt.Errorf("total rewards must be set for a plan with height schedule")
+			}
+			if err := heightSchedule.validateTotalRewards(rewardAlloc.TotalRewards); err != nil {
+				return err
+			}
+			continue
+		}
+		if !rewardAlloc.TotalRewards.Empty() {
+			return fmt.Errorf("total rewards must not be set for a plan without height schedule")
+		}
 		if err := rewardAlloc.RewardsPerDay.Validate(); err != nil {
 			return fmt.Errorf("invalid rewards per day: %w", err)
 		}
@@ -141,6 +141,20 @@ func TestPlan_Validate(t *testing.T) {
 			},
 			"end time must be after start time",
 		},
+		{
+			"total rewards without height schedule",
+			func(plan *types.Plan) {
+				plan.RewardAllocations[0].TotalRewards = utils.ParseCoins("100_000000stake")
+			},
+			"invalid reward allocations: total rewards must not be set for a plan without height schedule",
+		},
+		{
+			"invalid max rewards per block",
+			func(plan *types.Plan) {
+				plan.RewardAllocations[0].MaxRewardsPerBlock = sdk.Coins{utils.ParseCoin("0stake")}
+			},
+			"invalid reward allocations: invalid max rewards per block: coin 0stake amount is not positive",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			plan := types.NewPlan(
@@ -178,3 +192,92 @@ func TestPlan_IsActiveAt(t *testing.T) {
 	require.True(t, plan.IsActiveAt(utils.ParseTime("2022-12-31T23:59:59Z")))
 	require.False(t, plan.IsActiveAt(utils.ParseTime("2023-01-01T00:00:00Z")))
 }
+
+func TestHeightPlan_Validate(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(plan *types.Plan)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(plan *types.Plan) {},
+			"",
+		},
+		{
+			"invalid height schedule",
+			func(plan *types.Plan) {
+				plan.HeightSchedule.EndHeight = plan.HeightSchedule.StartHeight
+			},
+			"invalid height schedule: end height must be bigger than start height: 100 <= 100",
+		},
+		{
+			"rewards per day with height schedule",
+			func(plan *types.Plan) {
+				plan.RewardAllocations[0].RewardsPerDay = utils.ParseCoins("100_000000stake")
+			},
+			"invalid reward allocations: rewards per day must not be set for a plan with height schedule",
+		},
+		{
+			"empty total rewards",
+			func(plan *types.Plan) {
+				plan.RewardAllocations[0].TotalRewards = nil
+			},
+			"invalid reward allocations: total rewards must be set for a plan with height schedule",
+		},
+		{
+			"invalid total rewards",
+			func(plan *types.Plan) {
+				plan.RewardAllocations[0].TotalRewards = sdk.Coins{utils.ParseCoin("0stake")}
+			},
+			"invalid reward allocations: invalid total rewards: coin 0stake amount is not positive",
+		},
+		{
+			"too much total rewards",
+			func(plan *types.Plan) {
+				plan.RewardAllocations[0].TotalRewards = utils.ParseCoins("57896044618658097711785492504343953926634992332820282019728792003956564819967stake")
+			},
+			"invalid reward allocations: too much total rewards",
+		},
+		{
+			"start time set",
+			func(plan *types.Plan) {
+				plan.StartTime = utils.ParseTime("2022-01-01T00:00:00Z")
+			},
+			"start time and end time must not be set for a plan with height schedule",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			plan := types.NewHeightPlan(
+				1, "Farming Plan", utils.TestAddress(0), utils.TestAddress(1),
+				[]types.RewardAllocation{
+					types.NewPairBudgetRewardAllocation(1, utils.ParseCoins("100_000000stake")),
+					types.NewPairBudgetRewardAllocation(2, utils.ParseCoins("200_000000stake")),
+				},
+				types.NewHeightSchedule(100, 200, types.EmissionCurveFlat, sdk.ZeroDec(), 0), true)
+			tc.malleate(&plan)
+			err := plan.Validate()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestPlan_IsActive(t *testing.T) {
+	plan := types.NewHeightPlan(
+		1, "Farming Plan", utils.TestAddress(0), utils.TestAddress(0),
+		[]types.RewardAllocation{
+			types.NewPairBudgetRewardAllocation(1, utils.ParseCoins("100_000000stake")),
+		},
+		types.NewHeightSchedule(100, 200, types.EmissionCurveFlat, sdk.ZeroDec(), 0), false)
+	now := utils.ParseTime("2022-01-01T00:00:00Z")
+	require.False(t, plan.IsActive(99, now))
+	require.True(t, plan.IsActive(100, now))
+	require.True(t, plan.IsActive(199, now))
+	require.False(t, plan.IsActive(200, now))
+	require.False(t, plan.IsEnded(199, now))
+	require.True(t, plan.IsEnded(200, now))
+}
@@ -74,6 +74,19 @@ func NewCreatePlanRequest(
 	}
 }
 
+// NewCreateHeightPlanRequest creates a new CreatePlanRequest for a plan
+// with the height schedule.
+func NewCreateHeightPlanRequest(
+	description string, farmingPoolAddr sdk.AccAddress,
+	rewardAllocs []RewardAllocation, heightSchedule HeightSchedule) CreatePlanRequest {
+	return CreatePlanRequest{
+		Description:        description,
+		FarmingPoolAddress: farmingPoolAddr.String(),
+		RewardAllocations:  rewardAllocs,
+		HeightSchedule:     &heightSchedule,
+	}
+}
+
 func (req CreatePlanRequest) Validate() error {
 	farmingPoolAddr, err := sdk.AccAddressFromBech32(req.FarmingPoolAddress)
 	if err != nil {
@@ -82,6 +95,7 @@ func (req CreatePlanRequest) Validate() error {
 	dummyPlan := NewPlan(
 		1, req.Description, farmingPoolAddr, farmingPoolAddr,
 		req.RewardAllocations, req.StartTime, req.EndTime, false)
+	dummyPlan.HeightSchedule = req.HeightSchedule
 	if err := dummyPlan.Validate(); err != nil {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
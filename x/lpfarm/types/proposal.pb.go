@@ -72,6 +72,7 @@ type CreatePlanRequest struct {
 	RewardAllocations  []RewardAllocation `protobuf:"bytes,3,rep,name=reward_allocations,json=rewardAllocations,proto3" json:"reward_allocations"`
 	StartTime          time.Time          `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
 	EndTime            time.Time          `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	HeightSchedule     *HeightSchedule    `protobuf:"bytes,6,opt,name=height_schedule,json=heightSchedule,proto3" json:"height_schedule,omitempty"`
 }
 
 func (m *CreatePlanRequest) Reset()         { *m = CreatePlanRequest{} }
@@ -155,37 +156,39 @@ func init() {
 }
 
 var fileDescriptor_6ed4e21e4efbb40b = []byte{
-	// 483 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x4d, 0x8f, 0xd3, 0x30,
-	0x10, 0x4d, 0xba, 0xd9, 0x2f, 0xf7, 0xb4, 0x26, 0x68, 0xab, 0x1e, 0xd2, 0x52, 0x24, 0xa8, 0x90,
-	0x88, 0xd9, 0xe5, 0xc6, 0x05, 0x6d, 0x57, 0x42, 0xe2, 0x80, 0x54, 0x45, 0x7b, 0x82, 0x43, 0xe4,
-	0xc4, 0x6e, 0x36, 0x92, 0x13, 0x7b, 0x6d, 0x97, 0x8f, 0x7f, 0xb1, 0x47, 0x4e, 0x88, 0x9f, 0x53,
-	0x71, 0xda, 0x23, 0x27, 0x3e, 0xda, 0x3f, 0x82, 0x6c, 0x27, 0x08, 0xb5, 0xb9, 0x70, 0xcb, 0xcc,
-	0x7b, 0x33, 0x6f, 0xe6, 0x65, 0x0c, 0x1e, 0xaa, 0x9b, 0x25, 0x26, 0x88, 0x89, 0x05, 0x96, 0x15,
-	0x7a, 0x7f, 0x96, 0x51, 0x8d, 0xcf, 0x90, 0x90, 0x5c, 0x70, 0x85, 0x59, 0x2c, 0x24, 0xd7, 0x1c,
-	0x86, 0x96, 0x14, 0x3b, 0x52, 0xdc, 0x90, 0x86, 0x61, 0xc1, 0x0b, 0x6e, 0x09, 0xc8, 0x7c, 0x39,
-	0xee, 0xf0, 0x41, 0x67, 0xc3, 0xa6, 0xd4, 0x51, 0x46, 0x05, 0xe7, 0x05, 0xa3, 0xc8, 0x46, 0xd9,
-	0x72, 0x81, 0x74, 0x59, 0x51, 0xa5, 0x71, 0x25, 0x1c, 0x61, 0xf2, 0xa5, 0x07, 0xee, 0xbd, 0xc2,
-	0xb2, 0x2a, 0xeb, 0x62, 0xce, 0x70, 0x3d, 0x6f, 0xa6, 0x81, 0x21, 0xd8, 0xd7, 0xa5, 0x66, 0x74,
-	0xe0, 0x8f, 0xfd, 0xe9, 0x71, 0xe2, 0x02, 0x38, 0x06, 0x7d, 0x42, 0x55, 0x2e, 0x4b, 0xa1, 0x4b,
-	0x5e, 0x0f, 0x7a, 0x16, 0xfb, 0x37, 0x05, 0x53, 0x10, 0xe6, 0x92, 0x62, 0x4d, 0x53, 0xc1, 0x70,
-	0x9d, 0x4a, 0x7a, 0xb3, 0xa4, 0x4a, 0xab, 0xc1, 0xde, 0x78, 0x6f, 0xda, 0x3f, 0x7f, 0x1c, 0x77,
-	0xad, 0x17, 0x5f, 0xda, 0x0a, 0xa3, 0x9f, 0x38, 0xfe, 0x2c, 0x58, 0xfd, 0x18, 0x79, 0x09, 0xcc,
-	0xb7, 0x01, 0x05, 0xaf, 0xc1, 0xa9, 0xa6, 0x66, 0xde, 0x5d, 0x8d, 0xc0, 0x6a, 0x3c, 0xe9, 0xd6,
-	0xb8, 0x6a, 0x8b, 0x76, 0x65, 0xee, 0xeb, 0x0e, 0x4c, 0xbd, 0x08, 0x3e, 0x7f, 0x1d, 0x79, 0x93,
-	0x6f, 0x3d, 0x70, 0xb2, 0x33, 0xdf, 0xb6, 0x11, 0xfe, 0xae, 0x11, 0xcf, 0x40, 0xb8, 0x70, 0xbe,
-	0xa6, 0x82, 0x73, 0x96, 0x62, 0x42, 0x24, 0x55, 0xaa, 0xf1, 0x0c, 0x36, 0xd8, 0x9c, 0x73, 0x76,
-	0xe1, 0x10, 0xf8, 0x0e, 0x40, 0x49, 0x3f, 0x60, 0x49, 0x52, 0xcc, 0x18, 0xcf, 0xb1, 0x69, 0xd3,
-	0x1a, 0xf7, 0xa8, 0x7b, 0xa9, 0xc4, 0xf2, 0x2f, 0xfe, 0xd2, 0x9b, 0x85, 0x4e, 0xe4, 0x56, 0x5e,
-	0xc1, 0x4b, 0x00, 0x94, 0xc6, 0x52, 0xa7, 0xe6, 0x00, 0x06, 0xc1, 0xd8, 0x9f, 0xf6, 0xcf, 0x87,
-	0xb1, 0xbb, 0x8e, 0xb8, 0xbd, 0x8e, 0xf8, 0xaa, 0xbd, 0x8e, 0xd9, 0x91, 0x69, 0x74, 0xfb, 0x73,
-	0xe4, 0x27, 0xc7, 0xb6, 0xce, 0x20, 0xf0, 0x25, 0x38, 0xa2, 0x35, 0x71, 0x2d, 0xf6, 0xff, 0xa3,
-	0xc5, 0x21, 0xad, 0x89, 0xc9, 0x4f, 0x10, 0x08, 0xbb, 0xfe, 0x03, 0x3c, 0x05, 0x87, 0xf6, 0x57,
-	0x96, 0xc4, 0x5a, 0x19, 0x24, 0x07, 0x26, 0x7c, 0x4d, 0x66, 0x6f, 0x56, 0xbf, 0x23, 0x6f, 0xb5,
-	0x8e, 0xfc, 0xbb, 0x75, 0xe4, 0xff, 0x5a, 0x47, 0xfe, 0xed, 0x26, 0xf2, 0xee, 0x36, 0x91, 0xf7,
-	0x7d, 0x13, 0x79, 0x6f, 0x51, 0x51, 0xea, 0xeb, 0x65, 0x16, 0xe7, 0xbc, 0x42, 0x39, 0x57, 0x15,
-	0xb7, 0x26, 0x3d, 0x65, 0x38, 0x53, 0xc8, 0xbd, 0x8d, 0x8f, 0xed, 0xeb, 0xd0, 0x9f, 0x04, 0x55,
-	0xd9, 0x81, 0x1d, 0xf3, 0xf9, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd7, 0x8f, 0x25, 0x7c, 0x8b,
+	// 515 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x4d, 0x6f, 0xd3, 0x30,
+	0x18, 0xc7, 0x93, 0xb5, 0xeb, 0x36, 0x57, 0x02, 0xcd, 0x04, 0xad, 0xea, 0x21, 0x2d, 0x05, 0x41,
+	0x85, 0x44, 0xcc, 0xc6, 0x8d, 0x0b, 0x5a, 0x27, 0x21, 0x38, 0x4c, 0xaa, 0xc2, 0x4e, 0x70, 0x88,
+	0x9c, 0xd8, 0x4d, 0x22, 0x39, 0x71, 0x66, 0x3b, 0xbc, 0x7c, 0x8b, 0x1d, 0xe1, 0x82, 0xf8, 0x38,
+	0x3d, 0xee, 0xc8, 0x89, 0x97, 0xf6, 0x8b, 0xa0, 0xd8, 0x09, 0x82, 0x36, 0x97, 0xdd, 0xfc, 0xf8,
+	0xf9, 0x3d, 0xff, 0xe7, 0xc5, 0x8f, 0xc1, 0x7d, 0x79, 0x59, 0x62, 0x82, 0x58, 0xb1, 0xc0, 0x22,
+	0x43, 0xef, 0x8f, 0x43, 0xaa, 0xf0, 0x31, 0x2a, 0x04, 0x2f, 0xb8, 0xc4, 0xcc, 0x2b, 0x04, 0x57,
+	0x1c, 0x3a, 0x1a, 0xf2, 0x0c, 0xe4, 0xd5, 0xd0, 0xd0, 0x89, 0x79, 0xcc, 0x35, 0x80, 0xaa, 0x93,
+	0x61, 0x87, 0xf7, 0x5a, 0x05, 0xeb, 0x50, 0x83, 0x8c, 0x62, 0xce, 0x63, 0x46, 0x91, 0xb6, 0xc2,
+	0x72, 0x81, 0x54, 0x9a, 0x51, 0xa9, 0x70, 0x56, 0x18, 0x60, 0xf2, 0x75, 0x07, 0xdc, 0x79, 0x89,
+	0x45, 0x96, 0xe6, 0xf1, 0x9c, 0xe1, 0x7c, 0x5e, 0x57, 0x03, 0x1d, 0xb0, 0xab, 0x52, 0xc5, 0xe8,
+	0xc0, 0x1e, 0xdb, 0xd3, 0x03, 0xdf, 0x18, 0x70, 0x0c, 0xfa, 0x84, 0xca, 0x48, 0xa4, 0x85, 0x4a,
+	0x79, 0x3e, 0xd8, 0xd1, 0xbe, 0x7f, 0xaf, 0x60, 0x00, 0x9c, 0x48, 0x50, 0xac, 0x68, 0x50, 0x30,
+	0x9c, 0x07, 0x82, 0x5e, 0x96, 0x54, 0x2a, 0x39, 0xe8, 0x8c, 0x3b, 0xd3, 0xfe, 0xc9, 0x23, 0xaf,
+	0xad, 0x3d, 0xef, 0x4c, 0x47, 0x54, 0xf9, 0x7d, 0xc3, 0xcf, 0xba, 0xcb, 0x1f, 0x23, 0xcb, 0x87,
+	0xd1, 0xa6, 0x43, 0xc2, 0x04, 0x1c, 0x29, 0x5a, 0xd5, 0xbb, 0x9d, 0xa3, 0xab, 0x73, 0x3c, 0x6e,
+	0xcf, 0x71, 0xd1, 0x04, 0x6d, 0xa7, 0xb9, 0xab, 0x5a, 0x7c, 0xf2, 0x79, 0xf7, 0xf3, 0xb7, 0x91,
+	0x35, 0xf9, 0xd2, 0x01, 0x87, 0x5b, 0xf5, 0x6d, 0x0e, 0xc2, 0xde, 0x1e, 0xc4, 0x53, 0xe0, 0x2c,
+	0xcc, 0x5c, 0x83, 0x82, 0x73, 0x16, 0x60, 0x42, 0x04, 0x95, 0xb2, 0x9e, 0x19, 0xac, 0x7d, 0x73,
+	0xce, 0xd9, 0xa9, 0xf1, 0xc0, 0x77, 0x00, 0x0a, 0xfa, 0x01, 0x0b, 0x12, 0x60, 0xc6, 0x78, 0x84,
+	0x2b, 0x99, 0x66, 0x70, 0x0f, 0xdb, 0x9b, 0xf2, 0x35, 0x7f, 0xfa, 0x17, 0xaf, 0x1b, 0x3a, 0x14,
+	0x1b, 0xf7, 0x12, 0x9e, 0x01, 0x20, 0x15, 0x16, 0x2a, 0xa8, 0x16, 0x60, 0xd0, 0x1d, 0xdb, 0xd3,
+	0xfe, 0xc9, 0xd0, 0x33, 0xdb, 0xe1, 0x35, 0xdb, 0xe1, 0x5d, 0x34, 0xdb, 0x31, 0xdb, 0xaf, 0x84,
+	0xae, 0x7e, 0x8e, 0x6c, 0xff, 0x40, 0xc7, 0x55, 0x1e, 0xf8, 0x02, 0xec, 0xd3, 0x9c, 0x18, 0x89,
+	0xdd, 0x1b, 0x48, 0xec, 0xd1, 0x9c, 0x68, 0x81, 0x73, 0x70, 0x3b, 0xa1, 0x69, 0x9c, 0xa8, 0x40,
+	0x46, 0x09, 0x25, 0x25, 0xa3, 0x83, 0x9e, 0xd6, 0x79, 0xd0, 0xde, 0xdf, 0x2b, 0x0d, 0xbf, 0xa9,
+	0x59, 0xff, 0x56, 0xf2, 0x9f, 0x3d, 0x41, 0xc0, 0x69, 0x7b, 0x56, 0x78, 0x04, 0xf6, 0xf4, 0x66,
+	0xa4, 0x44, 0xbf, 0x4c, 0xd7, 0xef, 0x55, 0xe6, 0x6b, 0x32, 0x3b, 0x5f, 0xfe, 0x76, 0xad, 0xe5,
+	0xca, 0xb5, 0xaf, 0x57, 0xae, 0xfd, 0x6b, 0xe5, 0xda, 0x57, 0x6b, 0xd7, 0xba, 0x5e, 0xbb, 0xd6,
+	0xf7, 0xb5, 0x6b, 0xbd, 0x45, 0x71, 0xaa, 0x92, 0x32, 0xf4, 0x22, 0x9e, 0xa1, 0x88, 0xcb, 0x8c,
+	0xeb, 0x9a, 0x9e, 0x30, 0x1c, 0x4a, 0x64, 0xbe, 0xda, 0xc7, 0xe6, 0xb3, 0xa9, 0x4f, 0x05, 0x95,
+	0x61, 0x4f, 0x77, 0xfd, 0xec, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x0b, 0xd7, 0x58, 0x23, 0xda,
 	0x03, 0x00, 0x00,
 }
 
@@ -274,21 +277,33 @@ func (m *CreatePlanRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
-	if err1 != nil {
-		return 0, err1
+	if m.HeightSchedule != nil {
+		{
+			size, err := m.HeightSchedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProposal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
-	i -= n1
-	i = encodeVarintProposal(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x2a
-	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
 	if err2 != nil {
 		return 0, err2
 	}
 	i -= n2
 	i = encodeVarintProposal(dAtA, i, uint64(n2))
 	i--
+	dAtA[i] = 0x2a
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintProposal(dAtA, i, uint64(n3))
+	i--
 	dAtA[i] = 0x22
 	if len(m.RewardAllocations) > 0 {
 		for iNdEx := len(m.RewardAllocations) - 1; iNdEx >= 0; iNdEx-- {
@@ -413,6 +428,10 @@ func (m *CreatePlanRequest) Size() (n int) {
 	n += 1 + l + sovProposal(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
 	n += 1 + l + sovProposal(uint64(l))
+	if m.HeightSchedule != nil {
+		l = m.HeightSchedule.Size()
+		n += 1 + l + sovProposal(uint64(l))
+	}
 	return n
 }
 
@@ -809,6 +828,42 @@ func (m *CreatePlanRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeightSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HeightSchedule == nil {
+				m.HeightSchedule = &HeightSchedule{}
+			}
+			if err := m.HeightSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposal(dAtA[iNdEx:])
@@ -12,19 +12,24 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -584,6 +589,122 @@ func (m *QueryPositionResponse) GetPosition() Position {
 	return Position{}
 }
 
+type QueryLockRequest struct {
+	Farmer string `protobuf:"bytes,1,opt,name=farmer,proto3" json:"farmer,omitempty"`
+	Denom  string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryLockRequest) Reset()         { *m = QueryLockRequest{} }
+func (m *QueryLockRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLockRequest) ProtoMessage()    {}
+func (*QueryLockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_53e4850c614c4db5, []int{12}
+}
+func (m *QueryLockRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLockRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLockRequest.Merge(m, src)
+}
+func (m *QueryLockRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLockRequest proto.InternalMessageInfo
+
+func (m *QueryLockRequest) GetFarmer() string {
+	if m != nil {
+		return m.Farmer
+	}
+	return ""
+}
+
+func (m *QueryLockRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+type QueryLockResponse struct {
+	// locked is whether the position is currently locked.
+	Locked       bool          `protobuf:"varint,1,opt,name=locked,proto3" json:"locked,omitempty"`
+	LockDuration time.Duration `protobuf:"bytes,2,opt,name=lock_duration,json=lockDuration,proto3,stdduration" json:"lock_duration"`
+	LockEndTime  time.Time     `protobuf:"bytes,3,opt,name=lock_end_time,json=lockEndTime,proto3,stdtime" json:"lock_end_time"`
+	// boost_multiplier is the reward multiplier currently applied to the position.
+	BoostMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=boost_multiplier,json=boostMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"boost_multiplier"`
+	BoostAmount     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=boost_amount,json=boostAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"boost_amount"`
+}
+
+func (m *QueryLockResponse) Reset()         { *m = QueryLockResponse{} }
+func (m *QueryLockResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLockResponse) ProtoMessage()    {}
+func (*QueryLockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_53e4850c614c4db5, []int{13}
+}
+func (m *QueryLockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLockResponse.Merge(m, src)
+}
+func (m *QueryLockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLockResponse proto.InternalMessageInfo
+
+func (m *QueryLockResponse) GetLocked() bool {
+	if m != nil {
+		return m.Locked
+	}
+	return false
+}
+
+func (m *QueryLockResponse) GetLockDuration() time.Duration {
+	if m != nil {
+		return m.LockDuration
+	}
+	return 0
+}
+
+func (m *QueryLockResponse) GetLockEndTime() time.Time {
+	if m != nil {
+		return m.LockEndTime
+	}
+	return time.Time{}
+}
+
 type QueryHistoricalRewardsRequest struct {
 	Denom      string             `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
@@ -593,7 +714,7 @@ func (m *QueryHistoricalRewardsRequest) Reset()         { *m = QueryHistoricalRe
 func (m *QueryHistoricalRewardsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryHistoricalRewardsRequest) ProtoMessage()    {}
 func (*QueryHistoricalRewardsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_53e4850c614c4db5, []int{12}
+	return fileDescriptor_53e4850c614c4db5, []int{14}
 }
 func (m *QueryHistoricalRewardsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -645,7 +766,7 @@ func (m *QueryHistoricalRewardsResponse) Reset()         { *m = QueryHistoricalR
 func (m *QueryHistoricalRewardsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryHistoricalRewardsResponse) ProtoMessage()    {}
 func (*QueryHistoricalRewardsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_53e4850c614c4db5, []int{13}
+	return fileDescriptor_53e4850c614c4db5, []int{15}
 }
 func (m *QueryHistoricalRewardsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -696,7 +817,7 @@ func (m *QueryTotalRewardsRequest) Reset()         { *m = QueryTotalRewardsReque
 func (m *QueryTotalRewardsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalRewardsRequest) ProtoMessage()    {}
 func (*QueryTotalRewardsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_53e4850c614c4db5, []int{14}
+	return fileDescriptor_53e4850c614c4db5, []int{16}
 }
 func (m *QueryTotalRewardsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -740,7 +861,7 @@ func (m *QueryTotalRewardsResponse) Reset()         { *m = QueryTotalRewardsResp
 func (m *QueryTotalRewardsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalRewardsResponse) ProtoMessage()    {}
 func (*QueryTotalRewardsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_53e4850c614c4db5, []int{15}
+	return fileDescriptor_53e4850c614c4db5, []int{17}
 }
 func (m *QueryTotalRewardsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -785,7 +906,7 @@ func (m *QueryRewardsRequest) Reset()         { *m = QueryRewardsRequest{} }
 func (m *QueryRewardsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRewardsRequest) ProtoMessage()    {}
 func (*QueryRewardsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_53e4850c614c4db5, []int{16}
+	return fileDescriptor_53e4850c614c4db5, []int{18}
 }
 func (m *QueryRewardsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -836,7 +957,7 @@ func (m *QueryRewardsResponse) Reset()         { *m = QueryRewardsResponse{} }
 func (m *QueryRewardsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRewardsResponse) ProtoMessage()    {}
 func (*QueryRewardsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_53e4850c614c4db5, []int{17}
+	return fileDescriptor_53e4850c614c4db5, []int{19}
 }
 func (m *QueryRewardsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -882,7 +1003,7 @@ func (m *HistoricalRewardsResponse) Reset()         { *m = HistoricalRewardsResp
 func (m *HistoricalRewardsResponse) String() string { return proto.CompactTextString(m) }
 func (*HistoricalRewardsResponse) ProtoMessage()    {}
 func (*HistoricalRewardsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_53e4850c614c4db5, []int{18}
+	return fileDescriptor_53e4850c614c4db5, []int{20}
 }
 func (m *HistoricalRewardsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -945,6 +1066,8 @@ func init() {
 	proto.RegisterType((*QueryPositionsResponse)(nil), "squad.lpfarm.v1beta1.QueryPositionsResponse")
 	proto.RegisterType((*QueryPositionRequest)(nil), "squad.lpfarm.v1beta1.QueryPositionRequest")
 	proto.RegisterType((*QueryPositionResponse)(nil), "squad.lpfarm.v1beta1.QueryPositionResponse")
+	proto.RegisterType((*QueryLockRequest)(nil), "squad.lpfarm.v1beta1.QueryLockRequest")
+	proto.RegisterType((*QueryLockResponse)(nil), "squad.lpfarm.v1beta1.QueryLockResponse")
 	proto.RegisterType((*QueryHistoricalRewardsRequest)(nil), "squad.lpfarm.v1beta1.QueryHistoricalRewardsRequest")
 	proto.RegisterType((*QueryHistoricalRewardsResponse)(nil), "squad.lpfarm.v1beta1.QueryHistoricalRewardsResponse")
 	proto.RegisterType((*QueryTotalRewardsRequest)(nil), "squad.lpfarm.v1beta1.QueryTotalRewardsRequest")
@@ -957,71 +1080,85 @@ func init() {
 func init() { proto.RegisterFile("squad/lpfarm/v1beta1/query.proto", fileDescriptor_53e4850c614c4db5) }
 
 var fileDescriptor_53e4850c614c4db5 = []byte{
-	// 1024 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x97, 0x4f, 0x6f, 0x1b, 0xc5,
-	0x1b, 0xc7, 0x33, 0xae, 0xe3, 0x34, 0x4f, 0x7f, 0x3f, 0x20, 0x83, 0x9b, 0xa6, 0x26, 0xdd, 0x86,
-	0x2d, 0x8d, 0x4d, 0x4c, 0x76, 0x53, 0xa7, 0xaa, 0x10, 0x27, 0x94, 0x54, 0x85, 0xdc, 0xa8, 0x05,
-	0x07, 0xe0, 0x10, 0xad, 0xed, 0xa9, 0xb3, 0xaa, 0xbd, 0xb3, 0xd9, 0x5d, 0xb7, 0x54, 0x51, 0x84,
-	0x44, 0x11, 0x82, 0x1b, 0x12, 0x42, 0xea, 0x05, 0x71, 0xe2, 0xc2, 0x2b, 0xe0, 0x25, 0xf4, 0x46,
-	0x11, 0x17, 0x4e, 0x80, 0x12, 0x0e, 0xbc, 0x0c, 0x34, 0x33, 0xcf, 0xac, 0xff, 0xed, 0xae, 0x17,
-	0x14, 0xc4, 0x29, 0xd9, 0x9d, 0xef, 0xf3, 0x7c, 0x3f, 0xcf, 0x33, 0xe3, 0x79, 0x6c, 0x58, 0x0b,
-	0x0f, 0x07, 0x4e, 0xc7, 0xee, 0xf9, 0xf7, 0x9c, 0xa0, 0x6f, 0x3f, 0xb8, 0xd1, 0x62, 0x91, 0x73,
-	0xc3, 0x3e, 0x1c, 0xb0, 0xe0, 0x91, 0xe5, 0x07, 0x3c, 0xe2, 0xb4, 0x2c, 0x15, 0x96, 0x52, 0x58,
-	0xa8, 0xa8, 0x94, 0xbb, 0xbc, 0xcb, 0xa5, 0xc0, 0x16, 0xff, 0x29, 0x6d, 0x65, 0xb5, 0xcb, 0x79,
-	0xb7, 0xc7, 0x6c, 0xc7, 0x77, 0x6d, 0xc7, 0xf3, 0x78, 0xe4, 0x44, 0x2e, 0xf7, 0x42, 0x5c, 0x35,
-	0xda, 0x3c, 0xec, 0xf3, 0xd0, 0x6e, 0x39, 0x21, 0x8b, 0xad, 0xda, 0xdc, 0xf5, 0x70, 0x7d, 0x63,
-	0x74, 0x5d, 0x22, 0xc4, 0x2a, 0xdf, 0xe9, 0xba, 0x9e, 0x4c, 0x86, 0xda, 0x97, 0x13, 0xb9, 0x11,
-	0x52, 0x4a, 0xcc, 0x32, 0xd0, 0xbb, 0x22, 0xc9, 0x3b, 0x4e, 0xe0, 0xf4, 0xc3, 0x26, 0x3b, 0x1c,
-	0xb0, 0x30, 0x32, 0xef, 0xc2, 0x8b, 0x63, 0x6f, 0x43, 0x9f, 0x7b, 0x21, 0xa3, 0x6f, 0x40, 0xc9,
-	0x97, 0x6f, 0x56, 0xc8, 0x1a, 0xa9, 0x5d, 0x68, 0xac, 0x5a, 0x49, 0x65, 0x5b, 0x2a, 0x6a, 0xa7,
-	0xf8, 0xf4, 0xd7, 0xab, 0x73, 0x4d, 0x8c, 0x30, 0x3f, 0x84, 0x25, 0x95, 0xb2, 0xe7, 0x78, 0xda,
-	0x87, 0xde, 0x01, 0x18, 0x42, 0x63, 0xd2, 0x75, 0x4b, 0x55, 0x68, 0x89, 0x0a, 0x2d, 0xd5, 0xe4,
-	0x61, 0xe6, 0x2e, 0xc3, 0xd8, 0xe6, 0x48, 0xa4, 0xf9, 0x35, 0xd1, 0x65, 0xa8, 0xec, 0xc8, 0x7b,
-	0x0b, 0xe6, 0x7d, 0xf1, 0x62, 0x85, 0xac, 0x9d, 0xab, 0x5d, 0x68, 0x54, 0x52, 0x70, 0x7b, 0x8e,
-	0x87, 0xb0, 0x4a, 0x4e, 0xdf, 0x1a, 0xc3, 0x2a, 0x48, 0xac, 0xea, 0x4c, 0x2c, 0x65, 0x3a, 0xc6,
-	0x55, 0x87, 0x17, 0x62, 0x2c, 0x5d, 0xf3, 0x25, 0x58, 0x10, 0x2e, 0xfb, 0x6e, 0x47, 0x16, 0x5c,
-	0x6c, 0x96, 0xc4, 0xe3, 0x5e, 0xc7, 0xdc, 0x1b, 0xe9, 0x50, 0x5c, 0xc2, 0x4d, 0x28, 0x8a, 0x65,
-	0xec, 0xcd, 0xec, 0x0a, 0xa4, 0xda, 0xac, 0xa1, 0xef, 0x1d, 0x27, 0xe8, 0x6b, 0xdf, 0x32, 0xcc,
-	0x77, 0x98, 0xc7, 0xfb, 0x32, 0xd5, 0x62, 0x53, 0x3d, 0xc4, 0xa6, 0x4a, 0x39, 0x34, 0x15, 0xf9,
-	0xb3, 0x4d, 0x45, 0x84, 0x36, 0x15, 0x0b, 0xe6, 0x43, 0xb8, 0xa8, 0xf8, 0x79, 0xe8, 0xca, 0x13,
-	0xad, 0x9d, 0x97, 0xa1, 0x24, 0x04, 0x2c, 0x40, 0x6b, 0x7c, 0x9a, 0xd8, 0xfd, 0xc2, 0x3f, 0xde,
-	0xfd, 0xef, 0x08, 0x2c, 0x4f, 0x3a, 0x63, 0x25, 0x3b, 0xb0, 0xe8, 0xeb, 0x97, 0x78, 0x0a, 0x8c,
-	0x94, 0x1e, 0xa2, 0x0c, 0x4b, 0x1a, 0x86, 0x9d, 0xdd, 0x69, 0xb8, 0x0d, 0xe5, 0x31, 0xcc, 0x59,
-	0xfd, 0x89, 0x77, 0xac, 0x30, 0xba, 0x63, 0xef, 0x4f, 0xb4, 0x39, 0xae, 0xf5, 0x4d, 0x38, 0xaf,
-	0xa1, 0x71, 0xe7, 0xf2, 0x95, 0x1a, 0x47, 0x99, 0xc7, 0x70, 0x45, 0xa6, 0x7e, 0xdb, 0x0d, 0x23,
-	0x1e, 0xb8, 0x6d, 0xa7, 0xd7, 0x64, 0x0f, 0x9d, 0xa0, 0x13, 0x66, 0x9e, 0xa1, 0x33, 0xdb, 0xc7,
-	0x1f, 0x09, 0x18, 0x69, 0xfe, 0x58, 0x63, 0x07, 0xe8, 0x41, 0xbc, 0xb8, 0x1f, 0xa8, 0x55, 0xdc,
-	0x58, 0x3b, 0xb9, 0xda, 0xd4, 0x64, 0x58, 0xfe, 0xd2, 0xc1, 0xa4, 0xe0, 0xec, 0x76, 0xbc, 0x01,
-	0x2b, 0xb2, 0xa0, 0x77, 0x79, 0x34, 0xd5, 0xcb, 0x94, 0x5d, 0x37, 0x3f, 0x27, 0x70, 0x39, 0x21,
-	0x08, 0x1b, 0x70, 0x1f, 0x16, 0xc6, 0xab, 0x5e, 0x1d, 0xe3, 0xd2, 0x44, 0xb7, 0x59, 0x7b, 0x97,
-	0xbb, 0xde, 0xce, 0xb6, 0x28, 0xf1, 0xfb, 0xdf, 0xae, 0xd6, 0xbb, 0x6e, 0x74, 0x30, 0x68, 0x59,
-	0x6d, 0xde, 0xb7, 0x71, 0x80, 0xa8, 0x3f, 0x9b, 0x61, 0xe7, 0xbe, 0x1d, 0x3d, 0xf2, 0x59, 0xa8,
-	0x63, 0xc2, 0xa6, 0x76, 0x30, 0x77, 0x71, 0x0c, 0xe4, 0x23, 0x4f, 0x39, 0xaf, 0x8f, 0x09, 0x1e,
-	0xfb, 0xff, 0xb4, 0x94, 0x3f, 0x09, 0x5c, 0x4e, 0x3f, 0x56, 0xcb, 0x50, 0xf2, 0x59, 0xe0, 0xf2,
-	0xe1, 0x95, 0x2c, 0x9f, 0xe8, 0x17, 0x04, 0x2e, 0xb5, 0x07, 0xfd, 0x41, 0xcf, 0x89, 0xdc, 0x07,
-	0x6c, 0x7f, 0xe0, 0xb9, 0x51, 0x7c, 0xe8, 0x0a, 0xff, 0x16, 0xf3, 0xc5, 0xa1, 0xe3, 0x7b, 0x9e,
-	0x1b, 0xe9, 0x43, 0x59, 0x85, 0xe7, 0x03, 0x76, 0x8f, 0x05, 0xcc, 0x6b, 0xb3, 0xfd, 0x36, 0x1f,
-	0x78, 0xd1, 0xca, 0xb9, 0x35, 0x52, 0xfb, 0x7f, 0xf3, 0xb9, 0xf8, 0xf5, 0xae, 0x78, 0xdb, 0xf8,
-	0x09, 0x60, 0x5e, 0x36, 0x9c, 0x3e, 0x26, 0x50, 0x52, 0xc3, 0x98, 0xd6, 0x92, 0x3f, 0x1c, 0xd3,
-	0xb3, 0xbf, 0xf2, 0x6a, 0x0e, 0xa5, 0x6a, 0x9b, 0xf9, 0xca, 0x27, 0x3f, 0xff, 0xf1, 0x55, 0xc1,
-	0xa0, 0xab, 0x76, 0xe2, 0x17, 0x0d, 0x35, 0xf9, 0xe9, 0xc7, 0x30, 0x2f, 0xc7, 0x32, 0xad, 0x66,
-	0x65, 0x1e, 0xf9, 0x5a, 0x50, 0xa9, 0xcd, 0x16, 0x22, 0xc1, 0x35, 0x49, 0x70, 0x85, 0xbe, 0x94,
-	0x42, 0x20, 0x7d, 0x3f, 0x23, 0x50, 0x14, 0x61, 0x74, 0x7d, 0x46, 0x5e, 0xed, 0x5f, 0x9d, 0xa9,
-	0x43, 0xfb, 0x4d, 0x69, 0x5f, 0xa5, 0xd7, 0x33, 0xec, 0xed, 0x23, 0x1c, 0xf7, 0xc7, 0xf4, 0x53,
-	0x02, 0x45, 0x31, 0x36, 0x33, 0x41, 0x46, 0x66, 0x76, 0x26, 0xc8, 0xe8, 0xc4, 0x36, 0xeb, 0x12,
-	0xe4, 0x3a, 0xbd, 0x96, 0x0c, 0x22, 0x1e, 0x42, 0xfb, 0x48, 0x7e, 0x20, 0x8f, 0xe9, 0x13, 0x02,
-	0x8b, 0xf1, 0xa8, 0xa4, 0xf5, 0xac, 0x62, 0x27, 0x46, 0x79, 0xe5, 0xb5, 0x7c, 0x62, 0xa4, 0xda,
-	0x92, 0x54, 0x1b, 0xb4, 0x96, 0xd2, 0x1e, 0x1d, 0x60, 0x1f, 0xa9, 0x1b, 0xe4, 0x98, 0x7e, 0x43,
-	0xe0, 0xbc, 0xce, 0x43, 0x37, 0x72, 0x98, 0x69, 0xb0, 0x7a, 0x2e, 0x2d, 0x72, 0xbd, 0x2e, 0xb9,
-	0x1a, 0x74, 0x2b, 0x2f, 0x57, 0xdc, 0xba, 0x1f, 0x08, 0x2c, 0x4d, 0x5d, 0x23, 0x74, 0x3b, 0xc3,
-	0x3c, 0x6d, 0x96, 0x56, 0x6e, 0xfe, 0xbd, 0xa0, 0x7c, 0xe8, 0xd3, 0xc3, 0x31, 0x46, 0xff, 0x96,
-	0xc0, 0xff, 0x46, 0x47, 0x0a, 0xb5, 0x32, 0x00, 0x12, 0x06, 0x56, 0xc5, 0xce, 0xad, 0x47, 0x56,
-	0x4b, 0xb2, 0xd6, 0xe8, 0x7a, 0x32, 0x6b, 0x0c, 0xa8, 0x37, 0xff, 0x09, 0x81, 0x05, 0x0d, 0x97,
-	0x75, 0x0b, 0x4d, 0x70, 0x6d, 0xe4, 0x91, 0x22, 0xd2, 0x2d, 0x89, 0xb4, 0x45, 0xad, 0x7c, 0x48,
-	0xba, 0x79, 0x3b, 0x7b, 0x4f, 0x4f, 0x0c, 0xf2, 0xec, 0xc4, 0x20, 0xbf, 0x9f, 0x18, 0xe4, 0xcb,
-	0x53, 0x63, 0xee, 0xd9, 0xa9, 0x31, 0xf7, 0xcb, 0xa9, 0x31, 0xf7, 0x81, 0x3d, 0x75, 0xb5, 0x8b,
-	0xc4, 0x9b, 0x3d, 0xa7, 0x15, 0xa2, 0xc7, 0x47, 0xda, 0x45, 0xde, 0xf3, 0xad, 0x92, 0xfc, 0xe1,
-	0xb5, 0xfd, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x15, 0xfb, 0xa9, 0xf5, 0x55, 0x0e, 0x00, 0x00,
+	// 1238 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xb8, 0x8e, 0x9b, 0xbe, 0xb4, 0xb4, 0x19, 0xd2, 0xd4, 0x31, 0xa9, 0x13, 0xb6, 0x34,
+	0x31, 0x09, 0xd9, 0x4d, 0x93, 0x52, 0x10, 0x12, 0x52, 0x71, 0x42, 0x69, 0x24, 0x90, 0xc8, 0xaa,
+	0x1c, 0x0a, 0x07, 0x6b, 0x6d, 0x4f, 0x9c, 0x55, 0xec, 0x9d, 0xcd, 0xfe, 0x69, 0xa9, 0xa2, 0x08,
+	0x89, 0x22, 0x04, 0xb7, 0x4a, 0x08, 0x94, 0x0b, 0xe2, 0xc4, 0x85, 0x4f, 0xc0, 0x47, 0xe8, 0x8d,
+	0x4a, 0x5c, 0x10, 0x87, 0x16, 0x25, 0x1c, 0x10, 0x12, 0xdf, 0x01, 0xcd, 0xbf, 0xf5, 0xda, 0xb1,
+	0xd7, 0x9b, 0x2a, 0x88, 0x93, 0xbd, 0x33, 0xef, 0xfd, 0x7e, 0xbf, 0xf7, 0xe6, 0xcd, 0x9b, 0x07,
+	0x33, 0xfe, 0x4e, 0x68, 0xd5, 0x8d, 0xa6, 0xbb, 0x69, 0x79, 0x2d, 0xe3, 0xde, 0xb5, 0x2a, 0x09,
+	0xac, 0x6b, 0xc6, 0x4e, 0x48, 0xbc, 0x07, 0xba, 0xeb, 0xd1, 0x80, 0xe2, 0x71, 0x6e, 0xa1, 0x0b,
+	0x0b, 0x5d, 0x5a, 0x14, 0xc6, 0x1b, 0xb4, 0x41, 0xb9, 0x81, 0xc1, 0xfe, 0x09, 0xdb, 0xc2, 0x54,
+	0x83, 0xd2, 0x46, 0x93, 0x18, 0x96, 0x6b, 0x1b, 0x96, 0xe3, 0xd0, 0xc0, 0x0a, 0x6c, 0xea, 0xf8,
+	0x72, 0xb7, 0x58, 0xa3, 0x7e, 0x8b, 0xfa, 0x46, 0xd5, 0xf2, 0x49, 0x44, 0x55, 0xa3, 0xb6, 0x23,
+	0xf7, 0xe7, 0xe3, 0xfb, 0x5c, 0x42, 0x64, 0xe5, 0x5a, 0x0d, 0xdb, 0xe1, 0x60, 0xd2, 0x76, 0x5a,
+	0x32, 0xf1, 0xaf, 0x6a, 0xb8, 0x69, 0x04, 0x76, 0x8b, 0xf8, 0x81, 0xd5, 0x72, 0x15, 0x59, 0xb7,
+	0x41, 0x3d, 0xf4, 0xe2, 0x00, 0x2f, 0xf7, 0x0c, 0x5c, 0x46, 0xc9, 0x4d, 0xb4, 0x71, 0xc0, 0x1b,
+	0x4c, 0xc5, 0x87, 0x96, 0x67, 0xb5, 0x7c, 0x93, 0xec, 0x84, 0xc4, 0x0f, 0xb4, 0x0d, 0x78, 0xb1,
+	0x63, 0xd5, 0x77, 0xa9, 0xe3, 0x13, 0xfc, 0x16, 0xe4, 0x5c, 0xbe, 0x92, 0x47, 0x33, 0xa8, 0x34,
+	0xba, 0x3c, 0xa5, 0xf7, 0xca, 0x9b, 0x2e, 0xbc, 0xca, 0xd9, 0xc7, 0x4f, 0xa7, 0x87, 0x4c, 0xe9,
+	0xa1, 0x7d, 0x02, 0x63, 0x02, 0xb2, 0x69, 0x39, 0x8a, 0x07, 0xdf, 0x02, 0x68, 0x47, 0x2d, 0x41,
+	0x67, 0x75, 0x91, 0x22, 0x9d, 0xa5, 0x48, 0x17, 0xa7, 0xd4, 0x46, 0x6e, 0x10, 0xe9, 0x6b, 0xc6,
+	0x3c, 0xb5, 0x6f, 0x91, 0x0a, 0x43, 0xa0, 0x4b, 0xbd, 0x37, 0x60, 0xd8, 0x65, 0x0b, 0x79, 0x34,
+	0x73, 0xaa, 0x34, 0xba, 0x5c, 0xe8, 0x23, 0xb7, 0x69, 0x39, 0x52, 0xac, 0x30, 0xc7, 0xef, 0x75,
+	0xc8, 0xca, 0x70, 0x59, 0x73, 0x03, 0x65, 0x09, 0xd2, 0x0e, 0x5d, 0x0b, 0x70, 0x21, 0x92, 0xa5,
+	0x62, 0xbe, 0x04, 0xa7, 0x19, 0x4b, 0xc5, 0xae, 0xf3, 0x80, 0xb3, 0x66, 0x8e, 0x7d, 0xae, 0xd7,
+	0xb5, 0xf5, 0x58, 0x86, 0xa2, 0x10, 0xae, 0x43, 0x96, 0x6d, 0xcb, 0xdc, 0x0c, 0x8e, 0x80, 0x5b,
+	0x6b, 0x25, 0xc9, 0x7b, 0xcb, 0xf2, 0x5a, 0x8a, 0x77, 0x1c, 0x86, 0xeb, 0xc4, 0xa1, 0x2d, 0x0e,
+	0x75, 0xc6, 0x14, 0x1f, 0x11, 0xa9, 0xb0, 0x6c, 0x93, 0x32, 0xfc, 0x64, 0x52, 0xe6, 0xa1, 0x48,
+	0xd9, 0x86, 0x76, 0x1f, 0x2e, 0x0a, 0xfd, 0xd4, 0xb7, 0xf9, 0x95, 0x50, 0xcc, 0x13, 0x90, 0x63,
+	0x06, 0xc4, 0x93, 0xd4, 0xf2, 0xab, 0xeb, 0xf4, 0x33, 0xcf, 0x7d, 0xfa, 0x3f, 0x22, 0x98, 0xe8,
+	0x66, 0x96, 0x91, 0x94, 0xe1, 0x8c, 0xab, 0x16, 0x65, 0x15, 0x14, 0xfb, 0xe4, 0x50, 0x9a, 0xc9,
+	0x90, 0xda, 0x6e, 0x27, 0x57, 0x0d, 0x6b, 0x30, 0xde, 0x21, 0x73, 0x50, 0x7e, 0xa2, 0x13, 0xcb,
+	0xc4, 0x4f, 0xec, 0x6e, 0x57, 0x9a, 0xa3, 0x58, 0x6f, 0xc2, 0x88, 0x12, 0x2d, 0x4f, 0x2e, 0x5d,
+	0xa8, 0x91, 0x97, 0x76, 0x53, 0x96, 0xcd, 0xfb, 0xb4, 0xb6, 0xfd, 0x7c, 0xe2, 0xfe, 0xc9, 0xc8,
+	0x7a, 0x12, 0x10, 0x52, 0xd9, 0x04, 0xe4, 0x9a, 0xb4, 0xb6, 0x4d, 0x44, 0xc5, 0x8f, 0x98, 0xf2,
+	0x0b, 0xdf, 0x86, 0x73, 0xec, 0x5f, 0x45, 0xb5, 0x2d, 0x99, 0xdc, 0x49, 0x5d, 0xf4, 0x35, 0x5d,
+	0xf5, 0x35, 0x7d, 0x4d, 0x1a, 0x94, 0x47, 0x98, 0xe2, 0xfd, 0x67, 0xd3, 0xc8, 0x3c, 0xcb, 0x3c,
+	0xd5, 0x7a, 0x84, 0x44, 0x9c, 0x7a, 0x85, 0x75, 0xc9, 0xfc, 0x29, 0x59, 0xba, 0xdd, 0x48, 0x77,
+	0x54, 0x0b, 0x15, 0x50, 0x8f, 0x18, 0xd4, 0x28, 0x73, 0x7d, 0xd7, 0xa9, 0xb3, 0x3d, 0x7c, 0x17,
+	0x2e, 0x54, 0x29, 0xf5, 0x83, 0x4a, 0x2b, 0x6c, 0x06, 0xb6, 0xdb, 0xb4, 0x89, 0x97, 0xcf, 0xb2,
+	0x10, 0xcb, 0x3a, 0x73, 0xf8, 0xfd, 0xe9, 0xf4, 0x6c, 0xc3, 0x0e, 0xb6, 0xc2, 0xaa, 0x5e, 0xa3,
+	0x2d, 0x43, 0x76, 0x73, 0xf1, 0xb3, 0xe8, 0xd7, 0xb7, 0x8d, 0xe0, 0x81, 0x4b, 0x7c, 0x7d, 0x8d,
+	0xd4, 0xcc, 0xf3, 0x1c, 0xe7, 0x83, 0x08, 0x06, 0x6f, 0xc0, 0x59, 0x01, 0x6d, 0xb5, 0x68, 0xe8,
+	0x04, 0xf9, 0xe1, 0x63, 0xc3, 0xae, 0x3b, 0x81, 0x39, 0xca, 0x31, 0xde, 0xe1, 0x10, 0xda, 0x1e,
+	0x5c, 0xe6, 0xe9, 0xbe, 0x6d, 0xfb, 0x01, 0xf5, 0xec, 0x9a, 0xd5, 0x34, 0xc9, 0x7d, 0xcb, 0xab,
+	0xfb, 0x89, 0xb7, 0xfe, 0xc4, 0x6e, 0xde, 0x2f, 0x08, 0x8a, 0xfd, 0xf8, 0xe5, 0xd9, 0xd7, 0x01,
+	0x6f, 0x45, 0x9b, 0x15, 0x4f, 0xec, 0xca, 0xab, 0x68, 0xf4, 0xae, 0xcf, 0xbe, 0x60, 0xb2, 0x60,
+	0xc7, 0xb6, 0xba, 0x0d, 0x4e, 0xee, 0x8e, 0x2e, 0x43, 0x9e, 0x07, 0x74, 0x87, 0x06, 0x47, 0x72,
+	0xd9, 0xe7, 0x2a, 0x68, 0x5f, 0x21, 0x98, 0xec, 0xe1, 0x24, 0x13, 0xb0, 0x0d, 0xa7, 0x3b, 0xa3,
+	0x9e, 0xea, 0xd0, 0xa5, 0x14, 0xad, 0x91, 0xda, 0x2a, 0xb5, 0x9d, 0xf2, 0x0a, 0x0b, 0xf1, 0xa7,
+	0x67, 0xd3, 0x0b, 0xe9, 0xaa, 0x8c, 0xf9, 0xf8, 0xa6, 0x62, 0xd0, 0x56, 0xe5, 0xc3, 0x9d, 0x4e,
+	0x79, 0x9f, 0x4b, 0xfc, 0x10, 0xc9, 0x46, 0xf5, 0xbf, 0x86, 0xf2, 0x17, 0x82, 0xc9, 0xfe, 0x65,
+	0x35, 0x01, 0x39, 0x97, 0x78, 0x36, 0x6d, 0x3f, 0xa2, 0xfc, 0x0b, 0x7f, 0x8d, 0xe0, 0x52, 0x2d,
+	0x6c, 0x85, 0x4d, 0x2b, 0xb0, 0xef, 0x91, 0x4a, 0xe8, 0xd8, 0x41, 0x54, 0x74, 0x99, 0xff, 0x4a,
+	0xf3, 0xc5, 0x36, 0xe3, 0x47, 0x8e, 0x1d, 0xa8, 0xa2, 0x9c, 0x83, 0xf3, 0x1e, 0xd9, 0x24, 0x1e,
+	0x71, 0x6a, 0xa4, 0x52, 0xe3, 0x57, 0x9e, 0xb5, 0xa5, 0x73, 0xe6, 0x0b, 0xd1, 0xf2, 0x2a, 0x5b,
+	0x5d, 0xfe, 0x7b, 0x14, 0x86, 0x79, 0xc2, 0xf1, 0x43, 0x04, 0x39, 0x31, 0x3e, 0xe1, 0x52, 0xef,
+	0xcb, 0x71, 0x74, 0x5a, 0x2b, 0xbc, 0x9a, 0xc2, 0x52, 0xa4, 0x4d, 0x7b, 0xe5, 0xf3, 0x5f, 0xff,
+	0xfc, 0x26, 0x53, 0xc4, 0x53, 0x46, 0xcf, 0xd1, 0x50, 0xcc, 0x6a, 0xf8, 0x33, 0x18, 0xe6, 0x83,
+	0x14, 0x9e, 0x4b, 0x42, 0x8e, 0x0d, 0x72, 0x85, 0xd2, 0x60, 0x43, 0xa9, 0xe0, 0x0a, 0x57, 0x70,
+	0x19, 0xbf, 0xd4, 0x47, 0x01, 0xe7, 0xfd, 0x12, 0x41, 0x96, 0xb9, 0xe1, 0xd9, 0x01, 0xb8, 0x8a,
+	0x7f, 0x6e, 0xa0, 0x9d, 0xa4, 0x5f, 0xe4, 0xf4, 0x73, 0xf8, 0x6a, 0x02, 0xbd, 0xb1, 0x2b, 0x07,
+	0xb4, 0x3d, 0xfc, 0x05, 0x82, 0x2c, 0x1b, 0x74, 0x12, 0x85, 0xc4, 0xa6, 0xac, 0x44, 0x21, 0xf1,
+	0x19, 0x4b, 0x5b, 0xe0, 0x42, 0xae, 0xe2, 0x2b, 0xbd, 0x85, 0xb0, 0x0f, 0xdf, 0xd8, 0xe5, 0x17,
+	0x72, 0x0f, 0xef, 0x23, 0x38, 0x13, 0x0d, 0x37, 0x78, 0x21, 0x29, 0xd8, 0xae, 0xe1, 0xab, 0xf0,
+	0x5a, 0x3a, 0x63, 0xa9, 0x6a, 0x89, 0xab, 0x9a, 0xc7, 0xa5, 0x3e, 0xe9, 0x51, 0x0e, 0xc6, 0xae,
+	0xe8, 0x20, 0x7b, 0xf8, 0x7b, 0x04, 0x23, 0x0a, 0x07, 0xcf, 0xa7, 0x20, 0x53, 0xc2, 0x16, 0x52,
+	0xd9, 0x4a, 0x5d, 0x6f, 0x72, 0x5d, 0xcb, 0x78, 0x29, 0xad, 0xae, 0x28, 0x75, 0xdf, 0x21, 0xc8,
+	0xb2, 0x61, 0x24, 0xf1, 0x04, 0x63, 0x03, 0x4f, 0xe2, 0x09, 0xc6, 0xa7, 0x1a, 0xed, 0x6d, 0xae,
+	0xe9, 0x0d, 0xfc, 0xfa, 0x71, 0x35, 0x19, 0x6c, 0xde, 0xc0, 0x3f, 0x23, 0x18, 0x3b, 0xd2, 0xdf,
+	0xf0, 0x4a, 0x02, 0x7b, 0xbf, 0x47, 0xbe, 0x70, 0xfd, 0x78, 0x4e, 0xe9, 0x72, 0x7a, 0xf4, 0xd5,
+	0x8e, 0x72, 0xfa, 0x03, 0x82, 0xb3, 0xf1, 0xb7, 0x0e, 0xeb, 0x09, 0x02, 0x7a, 0xbc, 0xa4, 0x05,
+	0x23, 0xb5, 0xbd, 0xd4, 0xaa, 0x73, 0xad, 0x25, 0x3c, 0xdb, 0x5b, 0x6b, 0x24, 0x50, 0x55, 0xe5,
+	0x3e, 0x82, 0xd3, 0x4a, 0x5c, 0x52, 0x7b, 0xec, 0xd2, 0x35, 0x9f, 0xc6, 0x54, 0x4a, 0xba, 0xc1,
+	0x25, 0x2d, 0x61, 0x3d, 0x9d, 0x24, 0x95, 0xbc, 0xf2, 0xfa, 0xe3, 0x83, 0x22, 0x7a, 0x72, 0x50,
+	0x44, 0x7f, 0x1c, 0x14, 0xd1, 0xa3, 0xc3, 0xe2, 0xd0, 0x93, 0xc3, 0xe2, 0xd0, 0x6f, 0x87, 0xc5,
+	0xa1, 0x8f, 0x8d, 0x23, 0x6f, 0x0e, 0x03, 0x5e, 0x6c, 0x5a, 0x55, 0x5f, 0x72, 0x7c, 0xaa, 0x58,
+	0xf8, 0x03, 0x54, 0xcd, 0xf1, 0xb1, 0x76, 0xe5, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xce, 0x98,
+	0x63, 0xbc, 0xe1, 0x10, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1042,6 +1179,7 @@ type QueryClient interface {
 	Farm(ctx context.Context, in *QueryFarmRequest, opts ...grpc.CallOption) (*QueryFarmResponse, error)
 	Positions(ctx context.Context, in *QueryPositionsRequest, opts ...grpc.CallOption) (*QueryPositionsResponse, error)
 	Position(ctx context.Context, in *QueryPositionRequest, opts ...grpc.CallOption) (*QueryPositionResponse, error)
+	Lock(ctx context.Context, in *QueryLockRequest, opts ...grpc.CallOption) (*QueryLockResponse, error)
 	HistoricalRewards(ctx context.Context, in *QueryHistoricalRewardsRequest, opts ...grpc.CallOption) (*QueryHistoricalRewardsResponse, error)
 	TotalRewards(ctx context.Context, in *QueryTotalRewardsRequest, opts ...grpc.CallOption) (*QueryTotalRewardsResponse, error)
 	Rewards(ctx context.Context, in *QueryRewardsRequest, opts ...grpc.CallOption) (*QueryRewardsResponse, error)
@@ -1109,6 +1247,15 @@ func (c *queryClient) Position(ctx context.Context, in *QueryPositionRequest, op
 	return out, nil
 }
 
+func (c *queryClient) Lock(ctx context.Context, in *QueryLockRequest, opts ...grpc.CallOption) (*QueryLockResponse, error) {
+	out := new(QueryLockResponse)
+	err := c.cc.Invoke(ctx, "/squad.lpfarm.v1beta1.Query/Lock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) HistoricalRewards(ctx context.Context, in *QueryHistoricalRewardsRequest, opts ...grpc.CallOption) (*QueryHistoricalRewardsResponse, error) {
 	out := new(QueryHistoricalRewardsResponse)
 	err := c.cc.Invoke(ctx, "/squad.lpfarm.v1beta1.Query/HistoricalRewards", in, out, opts...)
@@ -1144,6 +1291,7 @@ type QueryServer interface {
 	Farm(context.Context, *QueryFarmRequest) (*QueryFarmResponse, error)
 	Positions(context.Context, *QueryPositionsRequest) (*QueryPositionsResponse, error)
 	Position(context.Context, *QueryPositionRequest) (*QueryPositionResponse, error)
+	Lock(context.Context, *QueryLockRequest) (*QueryLockResponse, error)
 	HistoricalRewards(context.Context, *QueryHistoricalRewardsRequest) (*QueryHistoricalRewardsResponse, error)
 	TotalRewards(context.Context, *QueryTotalRewardsRequest) (*QueryTotalRewardsResponse, error)
 	Rewards(context.Context, *QueryRewardsRequest) (*QueryRewardsResponse, error)
@@ -1171,6 +1319,9 @@ func (*UnimplementedQueryServer) Positions(ctx context.Context, req *QueryPositi
 func (*UnimplementedQueryServer) Position(ctx context.Context, req *QueryPositionRequest) (*QueryPositionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Position not implemented")
 }
+func (*UnimplementedQueryServer) Lock(ctx context.Context, req *QueryLockRequest) (*QueryLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lock not implemented")
+}
 func (*UnimplementedQueryServer) HistoricalRewards(ctx context.Context, req *QueryHistoricalRewardsRequest) (*QueryHistoricalRewardsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HistoricalRewards not implemented")
 }
@@ -1293,6 +1444,24 @@ func _Query_Position_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Lock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Lock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squad.lpfarm.v1beta1.Query/Lock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Lock(ctx, req.(*QueryLockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_HistoricalRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryHistoricalRewardsRequest)
 	if err := dec(in); err != nil {
@@ -1375,6 +1544,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Position",
 			Handler:    _Query_Position_Handler,
 		},
+		{
+			MethodName: "Lock",
+			Handler:    _Query_Lock_Handler,
+		},
 		{
 			MethodName: "HistoricalRewards",
 			Handler:    _Query_HistoricalRewards_Handler,
@@ -1817,6 +1990,112 @@ func (m *QueryPositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryLockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BoostAmount.Size()
+		i -= size
+		if _, err := m.BoostAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.BoostMultiplier.Size()
+		i -= size
+		if _, err := m.BoostMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LockEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LockEndTime):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintQuery(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x1a
+	n10, err10 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.LockDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.LockDuration):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintQuery(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x12
+	if m.Locked {
+		i--
+		if m.Locked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryHistoricalRewardsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2270,6 +2549,43 @@ func (m *QueryPositionResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryLockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Locked {
+		n += 2
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.LockDuration)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LockEndTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BoostMultiplier.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BoostAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *QueryHistoricalRewardsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3484,6 +3800,324 @@ func (m *QueryPositionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryLockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Farmer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Locked = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.LockDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LockEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoostMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BoostMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoostAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BoostAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryHistoricalRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
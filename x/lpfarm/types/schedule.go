@@ -0,0 +1,164 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	utils "github.com/cosmosquad-labs/squad/v3/types"
+)
+
+// NewHeightSchedule creates a new HeightSchedule.
+func NewHeightSchedule(
+	startHeight, endHeight int64, emissionCurve EmissionCurve,
+	decayRate sdk.Dec, decayPeriod int64) HeightSchedule {
+	return HeightSchedule{
+		StartHeight:   startHeight,
+		EndHeight:     endHeight,
+		EmissionCurve: emissionCurve,
+		DecayRate:     decayRate,
+		DecayPeriod:   decayPeriod,
+	}
+}
+
+// Validate validates HeightSchedule.
+func (schedule HeightSchedule) Validate() error {
+	if schedule.StartHeight <= 0 {
+		return fmt.Errorf("start height must be positive: %d", schedule.StartHeight)
+	}
+	if schedule.EndHeight <= schedule.StartHeight {
+		return fmt.Errorf("end height must be bigger than start height: %d <= %d", schedule.EndHeight, schedule.StartHeight)
+	}
+	switch schedule.EmissionCurve {
+	case EmissionCurveFlat, EmissionCurveLinear:
+		if !schedule.DecayRate.IsNil() && !schedule.DecayRate.IsZero() {
+			return fmt.Errorf("decay rate must not be set for emission curve %s", schedule.EmissionCurve)
+		}
+		if schedule.DecayPeriod != 0 {
+			return fmt.Errorf("decay period must not be set for emission curve %s", schedule.EmissionCurve)
+		}
+	case EmissionCurveExponential:
+		if schedule.DecayRate.IsNil() || !schedule.DecayRate.IsPositive() || schedule.DecayRate.GTE(sdk.OneDec()) {
+			return fmt.Errorf("decay rate must be in range (0, 1): %s", schedule.DecayRate)
+		}
+		if schedule.DecayPeriod <= 0 {
+			return fmt.Errorf("decay period must be positive: %d", schedule.DecayPeriod)
+		}
+	default:
+		return fmt.Errorf("invalid emission curve: %s", schedule.EmissionCurve)
+	}
+	return nil
+}
+
+// IsActiveAt returns whether the schedule allocates rewards at given height.
+func (schedule HeightSchedule) IsActiveAt(height int64) bool {
+	return schedule.StartHeight <= height && height < schedule.EndHeight
+}
+
+// NumBlocks returns the number of blocks in the height range of the schedule.
+func (schedule HeightSchedule) NumBlocks() int64 {
+	return schedule.EndHeight - schedule.StartHeight
+}
+
+// RewardsForHeight returns the rewards to be allocated at given height out of
+// the total rewards of the schedule.
+// The rewards are calculated as the difference of the cumulative emissions,
+// so the sum of the rewards over the whole height range is exactly the total rewards.
+func (schedule HeightSchedule) RewardsForHeight(totalRewards sdk.Coins, height int64) sdk.DecCoins {
+	if !schedule.IsActiveAt(height) {
+		return sdk.DecCoins{}
+	}
+	elapsed := height - schedule.StartHeight
+	totalWeight := schedule.CumulativeWeight(schedule.NumBlocks())
+	prev := cumulativeRewards(totalRewards, schedule.CumulativeWeight(elapsed), totalWeight)
+	next := cumulativeRewards(totalRewards, schedule.CumulativeWeight(elapsed+1), totalWeight)
+	// The cumulative weight never decreases, but never let the rewards be
+	// negative in any case since this is called in BeginBlock.
+	rewards, hasNeg := next.SafeSub(prev)
+	if hasNeg {
+		return sdk.DecCoins{}
+	}
+	return sdk.NewDecCoinsFromCoins(rewards...)
+}
+
+// CumulativeWeight returns the sum of the emission weights of the first n blocks.
+func (schedule HeightSchedule) CumulativeWeight(n int64) sdk.Dec {
+	switch schedule.EmissionCurve {
+	case EmissionCurveLinear:
+		// The weight of i-th block(0-based) is NumBlocks - i.
+		// W(n) = n * NumBlocks - n * (n - 1) / 2
+		numBlocks := sdk.NewInt(schedule.NumBlocks())
+		nInt := sdk.NewInt(n)
+		return nInt.Mul(numBlocks).Sub(nInt.Mul(nInt.SubRaw(1)).QuoRaw(2)).ToDec()
+	case EmissionCurveExponential:
+		// The weight of i-th block(0-based) is DecayRate^(i / DecayPeriod).
+		// The sum of the weights of the first k periods is
+		// S(k) = DecayPeriod * (1 - DecayRate^k) / (1 - DecayRate), and
+		// W(n) = S(k) + r * (S(k+1) - S(k)) / DecayPeriod,
+		// where k = n / DecayPeriod, r = n % DecayPeriod.
+		// Interpolating between S(k) and S(k+1), instead of adding r * DecayRate^k,
+		// keeps W non-decreasing in spite of the rounding errors.
+		k, r := n/schedule.DecayPeriod, n%schedule.DecayPeriod
+		s := schedule.periodsWeight(k)
+		if r == 0 {
+			return s
+		}
+		delta := schedule.periodsWeight(k + 1).Sub(s)
+		if delta.IsNegative() { // Sanity check
+			delta = sdk.ZeroDec()
+		}
+		return s.Add(delta.MulInt64(r).QuoInt64(schedule.DecayPeriod))
+	default: // EmissionCurveFlat
+		return sdk.NewDec(n)
+	}
+}
+
+// periodsWeight returns the sum of the emission weights of the first k
+// decay periods of the exponential emission curve.
+func (schedule HeightSchedule) periodsWeight(k int64) sdk.Dec {
+	pow := schedule.DecayRate.Power(uint64(k))
+	return sdk.OneDec().Sub(pow).Quo(sdk.OneDec().Sub(schedule.DecayRate)).MulInt64(schedule.DecayPeriod)
+}
+
+// cumulativeRewards returns the truncated portion of the total rewards
+// by the ratio of the weight to the total weight.
+func cumulativeRewards(totalRewards sdk.Coins, weight, totalWeight sdk.Dec) sdk.Coins {
+	rewards := sdk.Coins{}
+	for _, coin := range totalRewards {
+		amt := coin.Amount.ToDec().Mul(weight).QuoTruncate(totalWeight).TruncateInt()
+		rewards = rewards.Add(sdk.NewCoin(coin.Denom, amt))
+	}
+	return rewards
+}
+
+// validateTotalRewards validates the total rewards of a reward allocation
+// against the schedule.
+func (schedule HeightSchedule) validateTotalRewards(totalRewards sdk.Coins) error {
+	overflow := false
+	utils.SafeMath(func() {
+		schedule.RewardsForHeight(totalRewards, schedule.StartHeight)
+		schedule.RewardsForHeight(totalRewards, schedule.EndHeight-1)
+	}, func() {
+		overflow = true
+	})
+	if overflow {
+		return fmt.Errorf("too much total rewards")
+	}
+	return nil
+}
+
+// CapRewards returns the rewards capped by the max rewards.
+// The denoms not in the max rewards are not capped.
+func CapRewards(rewards sdk.DecCoins, maxRewards sdk.Coins) sdk.DecCoins {
+	if maxRewards.IsZero() {
+		return rewards
+	}
+	capped := sdk.DecCoins{}
+	for _, reward := range rewards {
+		if maxAmt := maxRewards.AmountOf(reward.Denom); maxAmt.IsPositive() && reward.Amount.GT(maxAmt.ToDec()) {
+			reward.Amount = maxAmt.ToDec()
+		}
+		capped = capped.Add(reward)
+	}
+	return capped
+}
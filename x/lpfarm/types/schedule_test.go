@@ -0,0 +1,204 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	utils "github.com/cosmosquad-labs/squad/v3/types"
+	"github.com/cosmosquad-labs/squad/v3/x/lpfarm/types"
+)
+
+func TestHeightSchedule_Validate(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		malleate    func(schedule *types.HeightSchedule)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(schedule *types.HeightSchedule) {},
+			"",
+		},
+		{
+			"zero start height",
+			func(schedule *types.HeightSchedule) {
+				schedule.StartHeight = 0
+			},
+			"start height must be positive: 0",
+		},
+		{
+			"end height not bigger than start height",
+			func(schedule *types.HeightSchedule) {
+				schedule.EndHeight = 50
+			},
+			"end height must be bigger than start height: 50 <= 100",
+		},
+		{
+			"decay rate with linear curve",
+			func(schedule *types.HeightSchedule) {
+				schedule.EmissionCurve = types.EmissionCurveLinear
+				schedule.DecayRate = sdk.NewDecWithPrec(5, 1)
+			},
+			"decay rate must not be set for emission curve EMISSION_CURVE_LINEAR",
+		},
+		{
+			"decay period with flat curve",
+			func(schedule *types.HeightSchedule) {
+				schedule.DecayPeriod = 10
+			},
+			"decay period must not be set for emission curve EMISSION_CURVE_FLAT",
+		},
+		{
+			"exponential curve",
+			func(schedule *types.HeightSchedule) {
+				schedule.EmissionCurve = types.EmissionCurveExponential
+				schedule.DecayRate = sdk.NewDecWithPrec(5, 1)
+				schedule.DecayPeriod = 10
+			},
+			"",
+		},
+		{
+			"invalid decay rate",
+			func(schedule *types.HeightSchedule) {
+				schedule.EmissionCurve = types.EmissionCurveExponential
+				schedule.DecayRate = sdk.OneDec()
+				schedule.DecayPeriod = 10
+			},
+			"decay rate must be in range (0, 1): 1.000000000000000000",
+		},
+		{
+			"invalid decay period",
+			func(schedule *types.HeightSchedule) {
+				schedule.EmissionCurve = types.EmissionCurveExponential
+				schedule.DecayRate = sdk.NewDecWithPrec(5, 1)
+			},
+			"decay period must be positive: 0",
+		},
+		{
+			"unspecified emission curve",
+			func(schedule *types.HeightSchedule) {
+				schedule.EmissionCurve = types.EmissionCurveNil
+			},
+			"invalid emission curve: EMISSION_CURVE_UNSPECIFIED",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			schedule := types.NewHeightSchedule(100, 200, types.EmissionCurveFlat, sdk.ZeroDec(), 0)
+			tc.malleate(&schedule)
+			err := schedule.Validate()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestHeightSchedule_RewardsForHeight(t *testing.T) {
+	totalRewards := utils.ParseCoins("1000_000000stake,777uatom")
+	for _, tc := range []struct {
+		name       string
+		schedule   types.HeightSchedule
+		declining  bool
+		firstBlock sdk.DecCoins
+	}{
+		{
+			"flat",
+			types.NewHeightSchedule(100, 1100, types.EmissionCurveFlat, sdk.ZeroDec(), 0),
+			false,
+			utils.ParseDecCoins("1_000000stake"),
+		},
+		{
+			"linear",
+			types.NewHeightSchedule(100, 1100, types.EmissionCurveLinear, sdk.ZeroDec(), 0),
+			true,
+			utils.ParseDecCoins("1998001stake,1uatom"),
+		},
+		{
+			"halving",
+			types.NewHeightSchedule(100, 1100, types.EmissionCurveExponential, sdk.NewDecWithPrec(5, 1), 250),
+			true,
+			utils.ParseDecCoins("2133333stake,1uatom"),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.firstBlock, tc.schedule.RewardsForHeight(totalRewards, tc.schedule.StartHeight))
+			require.True(t, tc.schedule.RewardsForHeight(totalRewards, tc.schedule.StartHeight-1).IsZero())
+			require.True(t, tc.schedule.RewardsForHeight(totalRewards, tc.schedule.EndHeight).IsZero())
+
+			sum := sdk.DecCoins{}
+			prev := sdk.DecCoins{}
+			for height := tc.schedule.StartHeight; height < tc.schedule.EndHeight; height++ {
+				rewards := tc.schedule.RewardsForHeight(totalRewards, height)
+				if tc.declining && height > tc.schedule.StartHeight {
+					// Allow a rounding error of 1 per block.
+					for _, reward := range rewards {
+						require.True(t, reward.Amount.LTE(prev.AmountOf(reward.Denom).Add(sdk.OneDec())))
+					}
+				}
+				sum = sum.Add(rewards...)
+				prev = rewards
+			}
+			require.Equal(t, sdk.NewDecCoinsFromCoins(totalRewards...), sum)
+		})
+	}
+}
+
+func TestCapRewards(t *testing.T) {
+	rewards := utils.ParseDecCoins("100stake,200uatom")
+	require.Equal(t, rewards, types.CapRewards(rewards, nil))
+	require.Equal(t,
+		utils.ParseDecCoins("50stake,200uatom"),
+		types.CapRewards(rewards, utils.ParseCoins("50stake")))
+	require.Equal(t,
+		utils.ParseDecCoins("100stake,200uatom"),
+		types.CapRewards(rewards, utils.ParseCoins("500stake,1000uusd")))
+}
+
+func TestHeightSchedule_RewardsForHeight_Monotonic(t *testing.T) {
+	totalRewards := utils.ParseCoins("7000000000000000000000stake")
+	for _, tc := range []struct {
+		name     string
+		schedule types.HeightSchedule
+	}{
+		{
+			"decay period 2",
+			types.NewHeightSchedule(1, 5001, types.EmissionCurveExponential, sdk.NewDecWithPrec(9, 1), 2),
+		},
+		{
+			"decay period 7",
+			types.NewHeightSchedule(1, 5001, types.EmissionCurveExponential, sdk.NewDecWithPrec(9, 1), 7),
+		},
+		{
+			"decay period 1",
+			types.NewHeightSchedule(1, 5001, types.EmissionCurveExponential, sdk.NewDecWithPrec(5, 1), 1),
+		},
+		{
+			"linear",
+			types.NewHeightSchedule(1, 5001, types.EmissionCurveLinear, sdk.ZeroDec(), 0),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			prevWeight := sdk.ZeroDec()
+			for n := int64(0); n <= tc.schedule.NumBlocks(); n++ {
+				weight := tc.schedule.CumulativeWeight(n)
+				require.True(t, weight.GTE(prevWeight), "cumulative weight decreased at %d: %s < %s", n, weight, prevWeight)
+				prevWeight = weight
+			}
+
+			sum := sdk.DecCoins{}
+			for height := tc.schedule.StartHeight; height < tc.schedule.EndHeight; height++ {
+				var rewards sdk.DecCoins
+				require.NotPanics(t, func() {
+					rewards = tc.schedule.RewardsForHeight(totalRewards, height)
+				})
+				require.False(t, rewards.IsAnyNegative())
+				sum = sum.Add(rewards...)
+			}
+			require.Equal(t, sdk.NewDecCoinsFromCoins(totalRewards...), sum)
+		})
+	}
+}
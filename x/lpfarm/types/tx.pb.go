@@ -15,6 +15,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -40,6 +41,7 @@ type MsgCreatePrivatePlan struct {
 	RewardAllocations []RewardAllocation `protobuf:"bytes,3,rep,name=reward_allocations,json=rewardAllocations,proto3" json:"reward_allocations"`
 	StartTime         time.Time          `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
 	EndTime           time.Time          `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	HeightSchedule    *HeightSchedule    `protobuf:"bytes,6,opt,name=height_schedule,json=heightSchedule,proto3" json:"height_schedule,omitempty"`
 }
 
 func (m *MsgCreatePrivatePlan) Reset()         { *m = MsgCreatePrivatePlan{} }
@@ -116,6 +118,9 @@ var xxx_messageInfo_MsgCreatePrivatePlanResponse proto.InternalMessageInfo
 type MsgFarm struct {
 	Farmer string     `protobuf:"bytes,1,opt,name=farmer,proto3" json:"farmer,omitempty"`
 	Coin   types.Coin `protobuf:"bytes,2,opt,name=coin,proto3" json:"coin"`
+	// lock_duration is the lockup duration for the position, which must be one of
+	// the lockup durations in params. Zero means no lockup.
+	LockDuration time.Duration `protobuf:"bytes,3,opt,name=lock_duration,json=lockDuration,proto3,stdduration" json:"lock_duration"`
 }
 
 func (m *MsgFarm) Reset()         { *m = MsgFarm{} }
@@ -352,48 +357,53 @@ func init() {
 func init() { proto.RegisterFile("squad/lpfarm/v1beta1/tx.proto", fileDescriptor_65c9fbdac6d3143b) }
 
 var fileDescriptor_65c9fbdac6d3143b = []byte{
-	// 654 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x8e, 0xdb, 0x34, 0x69, 0x27, 0x07, 0xc8, 0x2a, 0x82, 0x60, 0x51, 0x27, 0x44, 0x02, 0x22,
-	0xa4, 0xda, 0x6d, 0x7a, 0xe3, 0x82, 0xda, 0x4a, 0x08, 0x24, 0x22, 0x55, 0x16, 0x45, 0x08, 0x0e,
-	0xd1, 0xc6, 0xde, 0xba, 0x16, 0xb6, 0xd7, 0xec, 0x6c, 0x7f, 0x78, 0x06, 0x04, 0xea, 0x73, 0x70,
-	0xe7, 0x1d, 0x7a, 0xec, 0x91, 0x13, 0x85, 0xf6, 0x11, 0x78, 0x01, 0xb4, 0xeb, 0x1f, 0x10, 0x4d,
-	0x5a, 0x90, 0x90, 0xe0, 0x64, 0xcf, 0x7c, 0xdf, 0x7c, 0xf6, 0x7c, 0x33, 0x6b, 0xc3, 0x22, 0xbe,
-	0xde, 0xa5, 0xbe, 0x13, 0xa5, 0xdb, 0x54, 0xc4, 0xce, 0xde, 0xca, 0x98, 0x49, 0xba, 0xe2, 0xc8,
-	0x03, 0x3b, 0x15, 0x5c, 0x72, 0xd2, 0xd2, 0xb0, 0x9d, 0xc1, 0x76, 0x0e, 0x9b, 0xad, 0x80, 0x07,
-	0x5c, 0x13, 0x1c, 0x75, 0x97, 0x71, 0x4d, 0xcb, 0xe3, 0x18, 0x73, 0x74, 0xc6, 0x14, 0x59, 0xa9,
-	0xe4, 0xf1, 0x30, 0xc9, 0xf1, 0x4e, 0xc0, 0x79, 0x10, 0x31, 0x47, 0x47, 0xe3, 0xdd, 0x6d, 0x47,
-	0x86, 0x31, 0x43, 0x49, 0xe3, 0x34, 0x27, 0xdc, 0x9a, 0xf8, 0x2e, 0xf9, 0xb3, 0x35, 0xa5, 0xf7,
-	0x71, 0x06, 0x5a, 0x43, 0x0c, 0x36, 0x04, 0xa3, 0x92, 0x6d, 0x8a, 0x70, 0x4f, 0x5d, 0x22, 0x9a,
-	0x90, 0x36, 0xd4, 0x3d, 0x95, 0xe4, 0xa2, 0x6d, 0x74, 0x8d, 0xfe, 0x82, 0x5b, 0x84, 0xa4, 0x0b,
-	0x0d, 0x9f, 0xa1, 0x27, 0xc2, 0x54, 0x86, 0x3c, 0x69, 0xcf, 0x68, 0xf4, 0xe7, 0x14, 0x79, 0x09,
-	0x44, 0xb0, 0x7d, 0x2a, 0xfc, 0x11, 0x8d, 0x22, 0xee, 0x51, 0x95, 0xc4, 0xf6, 0x6c, 0x77, 0xb6,
-	0xdf, 0x18, 0xdc, 0xb1, 0x27, 0x39, 0x60, 0xbb, 0x9a, 0xbf, 0x56, 0xd2, 0xd7, 0xab, 0x47, 0x9f,
-	0x3b, 0x15, 0xb7, 0x29, 0x7e, 0xc9, 0x23, 0xd9, 0x00, 0x40, 0x49, 0x85, 0x1c, 0xa9, 0x6e, 0xdb,
-	0xd5, 0xae, 0xd1, 0x6f, 0x0c, 0x4c, 0x3b, 0xb3, 0xc2, 0x2e, 0xac, 0xb0, 0x9f, 0x16, 0x56, 0xac,
-	0xcf, 0x2b, 0xa1, 0xc3, 0x93, 0x8e, 0xe1, 0x2e, 0xe8, 0x3a, 0x85, 0x90, 0x07, 0x30, 0xcf, 0x12,
-	0x3f, 0x93, 0x98, 0xfb, 0x03, 0x89, 0x3a, 0x4b, 0x7c, 0x95, 0xef, 0x85, 0x70, 0x73, 0x92, 0x6d,
-	0x2e, 0xc3, 0x94, 0x27, 0xc8, 0xc8, 0x75, 0xa8, 0xa7, 0x11, 0x4d, 0x46, 0xa1, 0xaf, 0xed, 0xab,
-	0xba, 0x35, 0x15, 0x3e, 0xf6, 0xc9, 0x32, 0xb4, 0x54, 0xe3, 0x61, 0x12, 0x8c, 0x52, 0xce, 0xa3,
-	0x11, 0xf5, 0x7d, 0xc1, 0x10, 0x73, 0x1b, 0x49, 0x8e, 0x6d, 0x72, 0x1e, 0xad, 0x65, 0x48, 0xef,
-	0x19, 0xd4, 0x87, 0x18, 0x3c, 0xa4, 0x22, 0x26, 0xd7, 0xa0, 0xa6, 0x08, 0xac, 0x98, 0x49, 0x1e,
-	0x91, 0x55, 0xa8, 0xaa, 0xbd, 0xd0, 0x22, 0x8d, 0xc1, 0x0d, 0x3b, 0x5b, 0x1c, 0x5b, 0x2d, 0x4e,
-	0xe9, 0xf0, 0x06, 0x0f, 0x0b, 0x57, 0x35, 0xb9, 0xf7, 0xd6, 0x80, 0x2b, 0xb9, 0x70, 0xf9, 0xda,
-	0x07, 0xd0, 0xdc, 0x0f, 0xe5, 0x8e, 0x2f, 0xe8, 0x7e, 0x32, 0xca, 0xbc, 0xc7, 0xb6, 0xa1, 0x07,
-	0x77, 0x81, 0xea, 0xb2, 0x52, 0xfd, 0x70, 0xd2, 0xe9, 0x07, 0xa1, 0xdc, 0xd9, 0x1d, 0xdb, 0x1e,
-	0x8f, 0x9d, 0x7c, 0x77, 0xb3, 0xcb, 0x12, 0xfa, 0xaf, 0x1c, 0xf9, 0x26, 0x65, 0xa8, 0x0b, 0xd0,
-	0xbd, 0x5a, 0x3e, 0x25, 0x1b, 0x3c, 0xf6, 0x9e, 0xc3, 0xc2, 0x10, 0x83, 0xad, 0x64, 0xfb, 0xaf,
-	0xf7, 0xf9, 0xce, 0x80, 0x66, 0x29, 0xfd, 0x1f, 0x74, 0x7a, 0x1f, 0x60, 0x88, 0xc1, 0x23, 0x2a,
-	0xf6, 0x18, 0xca, 0xa9, 0xad, 0xb6, 0x60, 0xce, 0x67, 0x09, 0x8f, 0xf3, 0xc5, 0xc8, 0x82, 0xde,
-	0x7b, 0x03, 0xc8, 0x8f, 0xe2, 0x7f, 0xdf, 0xcc, 0xe0, 0xdb, 0x0c, 0xcc, 0x0e, 0x31, 0x20, 0x08,
-	0xcd, 0xf3, 0xdf, 0x90, 0x7b, 0x93, 0xcf, 0xfa, 0xa4, 0x83, 0x63, 0x0e, 0x7e, 0x9f, 0x5b, 0xb6,
-	0xfd, 0x04, 0xaa, 0xfa, 0x58, 0x2c, 0x4e, 0xad, 0x55, 0xb0, 0x79, 0xfb, 0x42, 0xb8, 0x54, 0x73,
-	0xa1, 0x96, 0xaf, 0x5f, 0x67, 0x6a, 0x41, 0x46, 0x30, 0xef, 0x5e, 0x42, 0x28, 0x35, 0xb7, 0xa0,
-	0x5e, 0x0c, 0xba, 0x3b, 0xb5, 0x26, 0x67, 0x98, 0xfd, 0xcb, 0x18, 0x85, 0xec, 0xfa, 0xf0, 0xe8,
-	0xab, 0x55, 0x39, 0x3a, 0xb5, 0x8c, 0xe3, 0x53, 0xcb, 0xf8, 0x72, 0x6a, 0x19, 0x87, 0x67, 0x56,
-	0xe5, 0xf8, 0xcc, 0xaa, 0x7c, 0x3a, 0xb3, 0x2a, 0x2f, 0x9c, 0x73, 0xf3, 0x54, 0xb2, 0x4b, 0x11,
-	0x1d, 0xa3, 0x93, 0xfd, 0x11, 0x0e, 0x8a, 0x7f, 0x82, 0x1e, 0xee, 0xb8, 0xa6, 0xbf, 0x79, 0xab,
-	0xdf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xee, 0x8f, 0x00, 0x55, 0xbc, 0x06, 0x00, 0x00,
+	// 729 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0x4d, 0x6f, 0xd3, 0x4a,
+	0x14, 0x8d, 0x9b, 0x34, 0x69, 0x6f, 0xde, 0x7b, 0x7d, 0x19, 0x45, 0x60, 0x22, 0xea, 0x84, 0x88,
+	0x8f, 0x08, 0xa9, 0x76, 0x9b, 0xee, 0xd8, 0xa0, 0xb6, 0x08, 0x15, 0x89, 0x48, 0x95, 0xa1, 0x12,
+	0x82, 0x85, 0x35, 0xb1, 0xa7, 0x8e, 0x55, 0xc7, 0x63, 0x66, 0x9c, 0xb6, 0xec, 0xd9, 0x21, 0x50,
+	0x97, 0x2c, 0x59, 0xf3, 0x4b, 0xba, 0xec, 0x92, 0x15, 0x85, 0xf6, 0x27, 0xf0, 0x07, 0xd0, 0x8c,
+	0xc7, 0x06, 0xda, 0xa4, 0x05, 0x09, 0x09, 0x56, 0xf6, 0x9d, 0x73, 0xee, 0x99, 0xb9, 0xe7, 0xde,
+	0xb1, 0x61, 0x9e, 0x3f, 0x1f, 0x61, 0xcf, 0x0a, 0xe3, 0x2d, 0xcc, 0x86, 0xd6, 0xce, 0x52, 0x9f,
+	0x24, 0x78, 0xc9, 0x4a, 0xf6, 0xcc, 0x98, 0xd1, 0x84, 0xa2, 0xba, 0x84, 0xcd, 0x14, 0x36, 0x15,
+	0xdc, 0xa8, 0xfb, 0xd4, 0xa7, 0x92, 0x60, 0x89, 0xb7, 0x94, 0xdb, 0x30, 0x5c, 0xca, 0x87, 0x94,
+	0x5b, 0x7d, 0xcc, 0x49, 0xae, 0xe4, 0xd2, 0x20, 0x52, 0x78, 0xd3, 0xa7, 0xd4, 0x0f, 0x89, 0x25,
+	0xa3, 0xfe, 0x68, 0xcb, 0x4a, 0x82, 0x21, 0xe1, 0x09, 0x1e, 0xc6, 0x99, 0xc0, 0x69, 0x82, 0x37,
+	0x62, 0x38, 0x09, 0x68, 0x26, 0x70, 0x6d, 0xec, 0x59, 0xd5, 0xd9, 0x24, 0xa5, 0xfd, 0xb2, 0x08,
+	0xf5, 0x1e, 0xf7, 0xd7, 0x18, 0xc1, 0x09, 0xd9, 0x60, 0xc1, 0x8e, 0x78, 0x84, 0x38, 0x42, 0x3a,
+	0x54, 0x5c, 0xb1, 0x48, 0x99, 0xae, 0xb5, 0xb4, 0xce, 0xac, 0x9d, 0x85, 0xa8, 0x05, 0x55, 0x8f,
+	0x70, 0x97, 0x05, 0xb1, 0xd8, 0x4a, 0x9f, 0x92, 0xe8, 0xf7, 0x4b, 0xe8, 0x19, 0x20, 0x46, 0x76,
+	0x31, 0xf3, 0x1c, 0x1c, 0x86, 0xd4, 0x95, 0x47, 0xe2, 0x7a, 0xb1, 0x55, 0xec, 0x54, 0xbb, 0x37,
+	0xcd, 0x71, 0x0e, 0x99, 0xb6, 0xe4, 0xaf, 0xe4, 0xf4, 0xd5, 0xd2, 0xc1, 0xc7, 0x66, 0xc1, 0xae,
+	0xb1, 0x53, 0xeb, 0x1c, 0xad, 0x01, 0xf0, 0x04, 0xb3, 0xc4, 0x11, 0x6e, 0xe8, 0xa5, 0x96, 0xd6,
+	0xa9, 0x76, 0x1b, 0x66, 0xea, 0x84, 0x99, 0x39, 0x61, 0x3e, 0xce, 0xac, 0x5a, 0x9d, 0x11, 0x42,
+	0xfb, 0x47, 0x4d, 0xcd, 0x9e, 0x95, 0x79, 0x02, 0x41, 0x77, 0x61, 0x86, 0x44, 0x5e, 0x2a, 0x31,
+	0xfd, 0x0b, 0x12, 0x15, 0x12, 0x79, 0x52, 0xa0, 0x07, 0x73, 0x03, 0x12, 0xf8, 0x83, 0xc4, 0xe1,
+	0xee, 0x80, 0x78, 0xa3, 0x90, 0xe8, 0x65, 0xa9, 0x73, 0x7d, 0x7c, 0x7d, 0xeb, 0x92, 0xfc, 0x48,
+	0x71, 0xed, 0xff, 0x06, 0x3f, 0xc4, 0xed, 0x00, 0xae, 0x8e, 0xeb, 0x82, 0x4d, 0x78, 0x4c, 0x23,
+	0x4e, 0xd0, 0x65, 0xa8, 0xc4, 0x21, 0x8e, 0x9c, 0xc0, 0x93, 0xdd, 0x28, 0xd9, 0x65, 0x11, 0x3e,
+	0xf0, 0xd0, 0x22, 0xd4, 0xc5, 0x3e, 0x41, 0xe4, 0x3b, 0x31, 0xa5, 0xa1, 0x83, 0x3d, 0x8f, 0x11,
+	0xce, 0x55, 0x57, 0x90, 0xc2, 0x36, 0x28, 0x0d, 0x57, 0x52, 0xa4, 0xfd, 0x4e, 0x83, 0x4a, 0x8f,
+	0xfb, 0xf7, 0x31, 0x1b, 0xa2, 0x4b, 0x50, 0x16, 0x0c, 0x92, 0xf5, 0x58, 0x45, 0x68, 0x19, 0x4a,
+	0x62, 0x0e, 0xa5, 0x4a, 0xb5, 0x7b, 0xc5, 0x4c, 0x07, 0xd5, 0x14, 0x83, 0x9a, 0x57, 0xb4, 0x46,
+	0x83, 0xac, 0x4b, 0x92, 0x8c, 0xd6, 0xe1, 0xdf, 0x90, 0xba, 0xdb, 0x4e, 0x36, 0x84, 0x7a, 0x51,
+	0x65, 0x9f, 0x36, 0xf6, 0x9e, 0x22, 0xa4, 0xbe, 0xbe, 0x15, 0xbe, 0xfe, 0x23, 0x32, 0xb3, 0xf5,
+	0xf6, 0x2b, 0x0d, 0xe6, 0xd4, 0x11, 0x73, 0x07, 0xf6, 0xa0, 0xb6, 0x1b, 0x24, 0x03, 0x8f, 0xe1,
+	0xdd, 0xc8, 0x49, 0xa7, 0x82, 0xeb, 0x9a, 0x1c, 0xa9, 0x73, 0xce, 0xb7, 0x28, 0x76, 0x78, 0x7f,
+	0xd4, 0xec, 0xf8, 0x41, 0x32, 0x18, 0xf5, 0x4d, 0x97, 0x0e, 0x2d, 0x75, 0xeb, 0xd2, 0xc7, 0x02,
+	0xf7, 0xb6, 0xad, 0xe4, 0x45, 0x4c, 0xb8, 0x4c, 0xe0, 0xf6, 0xff, 0xf9, 0x2e, 0xe9, 0x48, 0xf2,
+	0xf6, 0x13, 0x98, 0xed, 0x71, 0x7f, 0x33, 0xda, 0xfa, 0xdd, 0x8e, 0xb5, 0x5f, 0x6b, 0x50, 0xcb,
+	0xa5, 0xff, 0x82, 0x4a, 0xef, 0x00, 0xf4, 0xb8, 0xbf, 0x8e, 0xd9, 0x0e, 0xe1, 0xc9, 0xc4, 0x52,
+	0xeb, 0x30, 0xed, 0x91, 0x88, 0x0e, 0xd5, 0x8c, 0xa5, 0x41, 0xfb, 0x8d, 0x06, 0xe8, 0x5b, 0xf2,
+	0x9f, 0x2f, 0xa6, 0xfb, 0x65, 0x0a, 0x8a, 0x3d, 0xee, 0x23, 0x0e, 0xb5, 0xb3, 0x5f, 0xb7, 0xdb,
+	0xe3, 0x6f, 0xe9, 0xb8, 0x3b, 0xd8, 0xe8, 0xfe, 0x3c, 0x37, 0x2f, 0xfb, 0x21, 0x94, 0xe4, 0x05,
+	0x9b, 0x9f, 0x98, 0x2b, 0xe0, 0xc6, 0x8d, 0x73, 0xe1, 0x5c, 0xcd, 0x86, 0xb2, 0x1a, 0xbf, 0xe6,
+	0xc4, 0x84, 0x94, 0xd0, 0xb8, 0x75, 0x01, 0x21, 0xd7, 0xdc, 0x84, 0x4a, 0xd6, 0xe8, 0xd6, 0xc4,
+	0x1c, 0xc5, 0x68, 0x74, 0x2e, 0x62, 0x64, 0xb2, 0xab, 0xbd, 0x83, 0xcf, 0x46, 0xe1, 0xe0, 0xd8,
+	0xd0, 0x0e, 0x8f, 0x0d, 0xed, 0xd3, 0xb1, 0xa1, 0xed, 0x9f, 0x18, 0x85, 0xc3, 0x13, 0xa3, 0xf0,
+	0xe1, 0xc4, 0x28, 0x3c, 0xb5, 0xce, 0xf4, 0x53, 0xc8, 0x2e, 0x84, 0xb8, 0xcf, 0xad, 0xf4, 0x5f,
+	0xb5, 0x97, 0xfd, 0xad, 0x64, 0x73, 0xfb, 0x65, 0xf9, 0xd1, 0x58, 0xfe, 0x1a, 0x00, 0x00, 0xff,
+	0xff, 0x6a, 0xdd, 0xd3, 0xd2, 0x76, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -604,21 +614,33 @@ func (m *MsgCreatePrivatePlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
-	if err1 != nil {
-		return 0, err1
+	if m.HeightSchedule != nil {
+		{
+			size, err := m.HeightSchedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
-	i -= n1
-	i = encodeVarintTx(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x2a
-	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
 	if err2 != nil {
 		return 0, err2
 	}
 	i -= n2
 	i = encodeVarintTx(dAtA, i, uint64(n2))
 	i--
+	dAtA[i] = 0x2a
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i--
 	dAtA[i] = 0x22
 	if len(m.RewardAllocations) > 0 {
 		for iNdEx := len(m.RewardAllocations) - 1; iNdEx >= 0; iNdEx-- {
@@ -706,6 +728,14 @@ func (m *MsgFarm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.LockDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.LockDuration):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintTx(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
 	{
 		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -949,6 +979,10 @@ func (m *MsgCreatePrivatePlan) Size() (n int) {
 	n += 1 + l + sovTx(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
 	n += 1 + l + sovTx(uint64(l))
+	if m.HeightSchedule != nil {
+		l = m.HeightSchedule.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -980,6 +1014,8 @@ func (m *MsgFarm) Size() (n int) {
 	}
 	l = m.Coin.Size()
 	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.LockDuration)
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
@@ -1259,6 +1295,42 @@ func (m *MsgCreatePrivatePlan) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeightSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HeightSchedule == nil {
+				m.HeightSchedule = &HeightSchedule{}
+			}
+			if err := m.HeightSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1475,6 +1547,39 @@ func (m *MsgFarm) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.LockDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
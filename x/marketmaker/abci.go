@@ -0,0 +1,17 @@
+package marketmaker
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmosquad-labs/squad/v3/x/marketmaker/keeper"
+	"github.com/cosmosquad-labs/squad/v3/x/marketmaker/types"
+)
+
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	k.ScoreMarketMakers(ctx)
+}
@@ -21,3 +21,12 @@ func flagSetMarketMakers() *flag.FlagSet {
 
 	return fs
 }
+
+func flagSetScores() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagAddress, "", "The market maker address")
+	fs.String(FlagPairId, "", "The pair id")
+
+	return fs
+}
@@ -31,7 +31,9 @@ func GetQueryCmd() *cobra.Command {
 	mmQueryCmd.AddCommand(
 		GetCmdQueryParams(),
 		GetQueryMarketMakersCmd(),
+		GetQueryScoresCmd(),
 		GetCmdQueryIncentive(),
+		GetCmdQueryIncentiveStreams(),
 	)
 	return mmQueryCmd
 }
@@ -135,6 +137,68 @@ $ %s query %s marketmakers --eligible=true...
 	return cmd
 }
 
+// GetQueryScoresCmd implements the market maker scores query command.
+func GetQueryScoresCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scores",
+		Args:  cobra.NoArgs,
+		Short: "Query on-chain scores of the market makers",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query on-chain scores of the market makers in the current incentive period.
+
+Example:
+$ %s query %s scores
+$ %s query %s scores --pair-id=1
+$ %s query %s scores --address=...
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pairIdStr, _ := cmd.Flags().GetString(FlagPairId)
+			mmAddr, _ := cmd.Flags().GetString(FlagAddress)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryScoresRequest{
+				Address:    mmAddr,
+				Pagination: pageReq,
+			}
+			if pairIdStr != "" {
+				pairId, err := strconv.ParseUint(pairIdStr, 10, 64)
+				if err != nil {
+					return fmt.Errorf("parse pair id: %w", err)
+				}
+				req.PairId = pairId
+			}
+
+			res, err := queryClient.Scores(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetScores())
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "scores")
+
+	return cmd
+}
+
 // GetCmdQueryIncentive implements the query market maker claimable incentive command.
 func GetCmdQueryIncentive() *cobra.Command {
 	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
@@ -179,3 +243,61 @@ $ %s query %s incentive %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
 
 	return cmd
 }
+
+// GetCmdQueryIncentiveStreams implements the incentive streams query command.
+func GetCmdQueryIncentiveStreams() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "incentive-streams [mm-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query incentive streams of a market maker",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query incentive streams of a market maker with the vested, unvested and claimed totals.
+
+Example:
+$ %s query %s incentive-streams %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+$ %s query %s incentive-streams %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --pair-id=1
+`,
+				version.AppName, types.ModuleName, bech32PrefixAccAddr,
+				version.AppName, types.ModuleName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			mmAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryIncentiveStreamsRequest{
+				Address: mmAddr.String(),
+			}
+			pairIdStr, _ := cmd.Flags().GetString(FlagPairId)
+			if pairIdStr != "" {
+				pairId, err := strconv.ParseUint(pairIdStr, 10, 64)
+				if err != nil {
+					return fmt.Errorf("parse pair id: %w", err)
+				}
+				req.PairId = pairId
+			}
+
+			resp, err := queryClient.IncentiveStreams(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	cmd.Flags().String(FlagPairId, "", "The pair id")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
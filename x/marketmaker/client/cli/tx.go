@@ -117,7 +117,7 @@ $ %s tx %s claim --from mykey`,
 	return cmd
 }
 
-// GetCmdSubmitMarketMakerProposal implements the inclusion/exclusion/rejection/distribution for market maker command handler.
+// GetCmdSubmitMarketMakerProposal implements the inclusion/exclusion/rejection/distribution/slashing for market maker command handler.
 func GetCmdSubmitMarketMakerProposal() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "market-maker-proposal [proposal-file] [flags]",
@@ -125,7 +125,7 @@ func GetCmdSubmitMarketMakerProposal() *cobra.Command {
 		Short: "Submit a market maker proposal",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Submit a market maker proposal along with an initial deposit. You can submit this governance proposal
-to include, exclude, reject, and incentivize distribution for market makers. The proposal details must be supplied via a JSON file. A JSON file to add request proposal is 
+to include, exclude, reject, slash, and incentivize distribution for market makers. The proposal details must be supplied via a JSON file. A JSON file to add request proposal is 
 provided below.
 
 Example:
@@ -160,6 +160,12 @@ Where proposal.json contains:
         }
       ]
     }
+  ],
+  "slashings": [
+    {
+      "address": "cosmos1vqac3p8fl4kez7ehjz8eltugd2fm67pckpl7pn",
+      "pair_id": "3"
+    }
   ]
 }
 `,
@@ -194,6 +200,7 @@ Where proposal.json contains:
 				proposal.Exclusions,
 				proposal.Rejections,
 				proposal.Distributions,
+				proposal.Slashings,
 			)
 
 			from := clientCtx.GetFromAddress()
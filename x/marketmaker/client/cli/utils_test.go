@@ -44,6 +44,12 @@ func TestParseMarketMakerProposal(t *testing.T) {
         }
       ]
     }
+  ],
+  "slashings": [
+    {
+      "address": "cosmos1vqac3p8fl4kez7ehjz8eltugd2fm67pckpl7pn",
+      "pair_id": "3"
+    }
   ]
 }
 `)
@@ -60,4 +66,6 @@ func TestParseMarketMakerProposal(t *testing.T) {
 	require.Equal(t, uint64(1), proposal.Distributions[0].PairId)
 	require.Equal(t, "cosmos1vqac3p8fl4kez7ehjz8eltugd2fm67pckpl7pn", proposal.Distributions[0].Address)
 	require.Equal(t, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100000000))), proposal.Distributions[0].Amount)
+	require.Equal(t, uint64(3), proposal.Slashings[0].PairId)
+	require.Equal(t, "cosmos1vqac3p8fl4kez7ehjz8eltugd2fm67pckpl7pn", proposal.Slashings[0].Address)
 }
@@ -24,7 +24,7 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		panic(err)
 	}
 
-	if err := k.ValidateIncentiveReservedAmount(ctx, genState.Incentives); err != nil {
+	if err := k.ValidateIncentiveReservedAmount(ctx, genState.Incentives, genState.IncentiveStreams); err != nil {
 		panic(err)
 	}
 
@@ -51,6 +51,19 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		k.SetDeposit(ctx, record.GetAccAddress(), record.PairId, record.Amount)
 	}
 
+	for _, score := range genState.Scores {
+		k.SetScore(ctx, score)
+	}
+
+	if genState.ScoringState != nil {
+		k.SetScoringState(ctx, *genState.ScoringState)
+	}
+
+	for _, stream := range genState.IncentiveStreams {
+		k.SetIncentiveStream(ctx, stream)
+	}
+	k.SetLastIncentiveStreamId(ctx, genState.LastIncentiveStreamId)
+
 	writeCache()
 }
 
@@ -66,11 +79,21 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	mms := k.GetAllMarketMakers(ctx)
 	incentives := k.GetAllIncentives(ctx)
 	depositRecords := k.GetAllDepositRecords(ctx)
+	scores := k.GetAllScores(ctx)
+
+	var scoringState *types.ScoringState
+	if state, found := k.GetScoringState(ctx); found {
+		scoringState = &state
+	}
 
 	return types.NewGenesisState(
 		params,
 		mms,
 		incentives,
 		depositRecords,
+		scores,
+		scoringState,
+		k.GetAllIncentiveStreams(ctx),
+		k.GetLastIncentiveStreamId(ctx),
 	)
 }
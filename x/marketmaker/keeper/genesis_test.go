@@ -3,6 +3,7 @@ package keeper_test
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	chain "github.com/cosmosquad-labs/squad/v3/app"
 	"github.com/cosmosquad-labs/squad/v3/x/marketmaker/types"
 
 	_ "github.com/stretchr/testify/suite"
@@ -51,7 +52,7 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 		[]types.MarketMakerHandle{
 			{Address: mmAddr.String(), PairId: 1},
 			{Address: mmAddr2.String(), PairId: 3}},
-		nil, nil, nil)
+		nil, nil, nil, nil)
 	suite.handleProposal(proposal)
 
 	// distribute incentive
@@ -69,7 +70,7 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 				PairId:  3,
 				Amount:  incentiveCoins,
 			},
-		})
+		}, nil)
 	suite.handleProposal(proposal)
 
 	mms := k.GetAllMarketMakers(ctx)
@@ -97,3 +98,43 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	incentives = suite.keeper.GetAllIncentives(ctx)
 	suite.Require().Len(incentives, 2)
 }
+
+func (suite *KeeperTestSuite) TestImportExportGenesis_IncentiveStreams() {
+	k := suite.keeper
+	mmAddr := suite.addrs[0]
+
+	params := k.GetParams(suite.ctx)
+	params.IncentiveBudgetAddress = suite.addrs[5].String()
+	params.IncentiveVestingPeriod = types.Day
+	k.SetParams(suite.ctx, params)
+
+	err := k.ApplyMarketMaker(suite.ctx, mmAddr, []uint64{1})
+	suite.Require().NoError(err)
+	proposal := types.NewMarketMakerProposal("title", "description",
+		[]types.MarketMakerHandle{{Address: mmAddr.String(), PairId: 1}}, nil, nil,
+		[]types.IncentiveDistribution{
+			{
+				Address: mmAddr.String(),
+				PairId:  1,
+				Amount:  sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 500000000)),
+			},
+		}, nil)
+	suite.handleProposal(proposal)
+
+	genState := k.ExportGenesis(suite.ctx)
+	suite.Require().Len(genState.IncentiveStreams, 1)
+	suite.Require().EqualValues(1, genState.LastIncentiveStreamId)
+
+	bz := suite.app.AppCodec().MustMarshalJSON(genState)
+	suite.SetupTest()
+	var genState2 types.GenesisState
+	suite.app.AppCodec().MustUnmarshalJSON(bz, &genState2)
+	// fund the reserve account as the incentive streams are imported
+	err = chain.FundAccount(suite.app.BankKeeper, suite.ctx, types.ClaimableIncentiveReserveAcc,
+		sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 500000000)))
+	suite.Require().NoError(err)
+	suite.keeper.InitGenesis(suite.ctx, genState2)
+
+	suite.Require().Equal(genState.IncentiveStreams, suite.keeper.GetAllIncentiveStreams(suite.ctx))
+	suite.Require().EqualValues(1, suite.keeper.GetLastIncentiveStreamId(suite.ctx))
+}
@@ -107,6 +107,72 @@ func (k Querier) MarketMakers(c context.Context, req *types.QueryMarketMakersReq
 	return &types.QueryMarketMakersResponse{Marketmakers: mmsRes, Pagination: pageRes}, nil
 }
 
+// Scores queries market maker scores in the current incentive period.
+func (k Querier) Scores(c context.Context, req *types.QueryScoresRequest) (*types.QueryScoresResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var mmAddr sdk.AccAddress
+	var err error
+	if req.Address != "" {
+		mmAddr, err = sdk.AccAddressFromBech32(req.Address)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var scoringState *types.ScoringState
+	if state, found := k.GetScoringState(ctx); found {
+		scoringState = &state
+	}
+
+	// query specific market maker score case
+	if !mmAddr.Empty() && req.PairId != 0 {
+		score, found := k.GetScore(ctx, req.PairId, mmAddr)
+		if !found {
+			return &types.QueryScoresResponse{ScoringState: scoringState}, nil
+		}
+		return &types.QueryScoresResponse{
+			Scores:       []types.MarketMakerScore{score},
+			ScoringState: scoringState,
+		}, nil
+	}
+
+	store := ctx.KVStore(k.storeKey)
+
+	var keyPrefix = types.ScoreKeyPrefix
+	if req.PairId != 0 {
+		keyPrefix = types.GetScoresByPairIdPrefix(req.PairId)
+	}
+
+	scoreStore := prefix.NewStore(store, keyPrefix)
+
+	var scoresRes []types.MarketMakerScore
+	pageRes, err := query.FilteredPaginate(scoreStore, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		var score types.MarketMakerScore
+		if err := k.cdc.Unmarshal(value, &score); err != nil {
+			return false, err
+		}
+
+		if !mmAddr.Empty() && score.Address != mmAddr.String() {
+			return false, nil
+		}
+
+		if accumulate {
+			scoresRes = append(scoresRes, score)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryScoresResponse{Scores: scoresRes, ScoringState: scoringState, Pagination: pageRes}, nil
+}
+
 // Incentive queries all queued stakings of the farmer.
 func (k Querier) Incentive(c context.Context, req *types.QueryIncentiveRequest) (*types.QueryIncentiveResponse, error) {
 	if req == nil || req.Address == "" {
@@ -128,3 +194,37 @@ func (k Querier) Incentive(c context.Context, req *types.QueryIncentiveRequest)
 
 	return &types.QueryIncentiveResponse{Incentive: incentive}, nil
 }
+
+// IncentiveStreams queries the incentive streams of the market maker with
+// the vested, unvested and claimed totals.
+func (k Querier) IncentiveStreams(c context.Context, req *types.QueryIncentiveStreamsRequest) (*types.QueryIncentiveStreamsResponse, error) {
+	if req == nil || req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	mmAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.QueryIncentiveStreamsResponse{
+		Streams:  []types.IncentiveStream{},
+		Vested:   sdk.Coins{},
+		Unvested: sdk.Coins{},
+		Claimed:  sdk.Coins{},
+	}
+	k.IterateIncentiveStreamsByAddr(ctx, mmAddr, func(stream types.IncentiveStream) (stop bool) {
+		if req.PairId != 0 && stream.PairId != req.PairId {
+			return false
+		}
+		res.Streams = append(res.Streams, stream)
+		res.Vested = res.Vested.Add(stream.Vested(ctx.BlockTime())...)
+		res.Unvested = res.Unvested.Add(stream.Unvested(ctx.BlockTime())...)
+		res.Claimed = res.Claimed.Add(stream.Claimed...)
+		return false
+	})
+
+	return res, nil
+}
@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
+	utils "github.com/cosmosquad-labs/squad/v3/types"
 	"github.com/cosmosquad-labs/squad/v3/x/marketmaker/types"
 )
 
@@ -31,7 +32,7 @@ func (suite *KeeperTestSuite) TestGRPCMarketMakers() {
 		[]types.MarketMakerHandle{
 			{Address: mmAddr.String(), PairId: 3},
 			{Address: mmAddr2.String(), PairId: 3}},
-		nil, nil, nil)
+		nil, nil, nil, nil)
 	suite.handleProposal(proposal)
 
 	for _, tc := range []struct {
@@ -193,7 +194,7 @@ func (suite *KeeperTestSuite) TestGRPCIncentive() {
 				PairId:  1,
 				Amount:  incentiveCoins,
 			},
-		})
+		}, nil)
 	suite.handleProposal(proposal)
 
 	for _, tc := range []struct {
@@ -248,3 +249,101 @@ func (suite *KeeperTestSuite) TestGRPCIncentive() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestGRPCIncentiveStreams() {
+	k := suite.keeper
+	mmAddr := suite.addrs[0]
+	suite.ctx = suite.ctx.WithBlockTime(utils.ParseTime("2023-01-01T00:00:00Z"))
+	startTime := suite.ctx.BlockTime()
+
+	params := k.GetParams(suite.ctx)
+	params.IncentiveBudgetAddress = suite.addrs[5].String()
+	params.IncentiveVestingPeriod = 10 * types.Day
+	k.SetParams(suite.ctx, params)
+
+	err := k.ApplyMarketMaker(suite.ctx, mmAddr, []uint64{1, 2})
+	suite.Require().NoError(err)
+	incentiveCoins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000_000))
+	proposal := types.NewMarketMakerProposal("title", "description",
+		[]types.MarketMakerHandle{
+			{Address: mmAddr.String(), PairId: 1},
+			{Address: mmAddr.String(), PairId: 2},
+		}, nil, nil,
+		[]types.IncentiveDistribution{
+			{Address: mmAddr.String(), PairId: 1, Amount: incentiveCoins},
+			{Address: mmAddr.String(), PairId: 2, Amount: incentiveCoins},
+		}, nil)
+	suite.handleProposal(proposal)
+
+	suite.ctx = suite.ctx.WithBlockTime(startTime.Add(types.Day))
+	suite.Require().NoError(k.ClaimIncentives(suite.ctx, mmAddr))
+	suite.ctx = suite.ctx.WithBlockTime(startTime.Add(5 * types.Day))
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QueryIncentiveStreamsRequest
+		expectErr bool
+		postRun   func(*types.QueryIncentiveStreamsResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"empty address",
+			&types.QueryIncentiveStreamsRequest{},
+			true,
+			nil,
+		},
+		{
+			"invalid address",
+			&types.QueryIncentiveStreamsRequest{Address: "invalid"},
+			true,
+			nil,
+		},
+		{
+			"all streams",
+			&types.QueryIncentiveStreamsRequest{Address: mmAddr.String()},
+			false,
+			func(resp *types.QueryIncentiveStreamsResponse) {
+				suite.Require().Len(resp.Streams, 2)
+				suite.Require().Equal(utils.ParseCoins("1000000000stake"), resp.Vested)
+				suite.Require().Equal(utils.ParseCoins("1000000000stake"), resp.Unvested)
+				suite.Require().Equal(utils.ParseCoins("200000000stake"), resp.Claimed)
+			},
+		},
+		{
+			"by pair id",
+			&types.QueryIncentiveStreamsRequest{Address: mmAddr.String(), PairId: 2},
+			false,
+			func(resp *types.QueryIncentiveStreamsResponse) {
+				suite.Require().Len(resp.Streams, 1)
+				suite.Require().EqualValues(2, resp.Streams[0].PairId)
+				suite.Require().Equal(utils.ParseCoins("500000000stake"), resp.Vested)
+				suite.Require().Equal(utils.ParseCoins("500000000stake"), resp.Unvested)
+				suite.Require().Equal(utils.ParseCoins("100000000stake"), resp.Claimed)
+			},
+		},
+		{
+			"no streams",
+			&types.QueryIncentiveStreamsRequest{Address: suite.addrs[1].String()},
+			false,
+			func(resp *types.QueryIncentiveStreamsResponse) {
+				suite.Require().Empty(resp.Streams)
+				suite.Require().True(resp.Vested.IsZero())
+			},
+		},
+	} {
+		suite.Run(tc.name, func() {
+			resp, err := suite.querier.IncentiveStreams(sdk.WrapSDKContext(suite.ctx), tc.req)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
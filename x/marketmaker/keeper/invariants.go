@@ -47,10 +47,10 @@ func DepositReservedAmountInvariant(k Keeper) sdk.Invariant {
 // IncentiveReservedAmountInvariant checks that the balance of StakingReserveAcc greater than the amount of staked, Queued coins in all staking objects.
 func IncentiveReservedAmountInvariant(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
-		err := k.ValidateIncentiveReservedAmount(ctx, k.GetAllIncentives(ctx))
+		err := k.ValidateIncentiveReservedAmount(ctx, k.GetAllIncentives(ctx), k.GetAllIncentiveStreams(ctx))
 		broken := err != nil
 		return sdk.FormatInvariant(types.ModuleName, "claimable incentive reserved amount",
-			"the balance of ClaimableIncentiveReserveAcc less than the amount queued in all incentive objects",
+			"the balance of ClaimableIncentiveReserveAcc less than the amount queued in all incentive and incentive stream objects",
 		), broken
 	}
 }
@@ -43,9 +43,21 @@ func (suite *KeeperTestSuite) TestDepositReservedAmountInvariant() {
 	mmPair2, found := k.GetMarketMaker(ctx, mmAddr, 2)
 	suite.True(found)
 
-	// manipulate eligible of the market maker to break invariant
+	// eligible market maker keeps the deposit, must not be broken.
 	mmPair2.Eligible = true
 	k.SetMarketMaker(ctx, mmPair2)
+	_, broken = keeper.DepositReservedAmountInvariant(k)(ctx)
+	suite.Require().False(broken)
+
+	// manipulate force deleting the deposit of the eligible market maker to break invariant
+	deposit, _ := k.GetDeposit(ctx, mmAddr, 2)
+	k.DeleteDeposit(ctx, mmAddr, 2)
+	_, broken = keeper.DepositReservedAmountInvariant(k)(ctx)
+	suite.Require().True(broken)
+	k.SetDeposit(ctx, mmAddr, 2, deposit.Amount)
+
+	// manipulate force deleting the market maker to break invariant
+	k.DeleteMarketMaker(ctx, mmAddr, 2)
 
 	// broken deposit reserved count invariant
 	_, broken = keeper.DepositReservedAmountInvariant(k)(ctx)
@@ -99,7 +111,7 @@ func (suite *KeeperTestSuite) TestIncentiveReservedAmountInvariant() {
 		{Address: mmAddr.String(), PairId: 1},
 		{Address: mmAddr.String(), PairId: 2},
 		{Address: mmAddr2.String(), PairId: 3},
-	}, nil, nil, nil)
+	}, nil, nil, nil, nil)
 	suite.handleProposal(proposal)
 
 	incentiveAmount := sdk.NewInt(500000000)
@@ -123,7 +135,7 @@ func (suite *KeeperTestSuite) TestIncentiveReservedAmountInvariant() {
 				PairId:  3,
 				Amount:  incentiveCoins,
 			},
-		})
+		}, nil)
 	suite.handleProposal(proposal)
 
 	balanceReserveAcc := suite.app.BankKeeper.GetAllBalances(ctx, types.ClaimableIncentiveReserveAcc)
@@ -199,9 +211,21 @@ func (suite *KeeperTestSuite) TestDepositRecordsInvariant() {
 	mmPair2, found := k.GetMarketMaker(ctx, mmAddr, 2)
 	suite.True(found)
 
-	// manipulate eligible of the market maker to break invariant
+	// eligible market maker keeps the deposit, must not be broken.
 	mmPair2.Eligible = true
 	k.SetMarketMaker(ctx, mmPair2)
+	_, broken = keeper.DepositRecordsInvariant(k)(ctx)
+	suite.Require().False(broken)
+
+	// manipulate force deleting the deposit of the eligible market maker to break invariant
+	deposit, _ := k.GetDeposit(ctx, mmAddr, 2)
+	k.DeleteDeposit(ctx, mmAddr, 2)
+	_, broken = keeper.DepositRecordsInvariant(k)(ctx)
+	suite.Require().True(broken)
+	k.SetDeposit(ctx, mmAddr, 2, deposit.Amount)
+
+	// manipulate force deleting the market maker to break invariant
+	k.DeleteMarketMaker(ctx, mmAddr, 2)
 
 	// broken deposit record invariant
 	_, broken = keeper.DepositRecordsInvariant(k)(ctx)
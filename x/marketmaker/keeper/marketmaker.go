@@ -3,8 +3,10 @@ package keeper
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/cosmosquad-labs/squad/v3/x/marketmaker/types"
 )
@@ -231,6 +233,179 @@ func (k Keeper) GetAllIncentives(ctx sdk.Context) []types.Incentive {
 	return incentives
 }
 
+// GetScore returns the market maker score for a given pair id and address.
+func (k Keeper) GetScore(ctx sdk.Context, pairId uint64, mmAddr sdk.AccAddress) (score types.MarketMakerScore, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetScoreKey(pairId, mmAddr))
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshal(bz, &score)
+	found = true
+	return
+}
+
+// SetScore sets a market maker score.
+func (k Keeper) SetScore(ctx sdk.Context, score types.MarketMakerScore) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&score)
+	store.Set(types.GetScoreKey(score.PairId, score.GetAccAddress()), bz)
+}
+
+// DeleteScore deletes market maker score for a given pair id and address.
+func (k Keeper) DeleteScore(ctx sdk.Context, pairId uint64, mmAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetScoreKey(pairId, mmAddr))
+}
+
+// IterateScores iterates through all market maker scores
+// stored in the store and invokes callback function for each item.
+// Stops the iteration when the callback function returns true.
+func (k Keeper) IterateScores(ctx sdk.Context, cb func(score types.MarketMakerScore) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ScoreKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var record types.MarketMakerScore
+		k.cdc.MustUnmarshal(iter.Value(), &record)
+		if cb(record) {
+			break
+		}
+	}
+}
+
+// GetAllScores returns all market maker scores
+func (k Keeper) GetAllScores(ctx sdk.Context) []types.MarketMakerScore {
+	scores := []types.MarketMakerScore{}
+	k.IterateScores(ctx, func(score types.MarketMakerScore) (stop bool) {
+		scores = append(scores, score)
+		return false
+	})
+	return scores
+}
+
+// GetScoringState returns the market maker scoring state.
+func (k Keeper) GetScoringState(ctx sdk.Context) (state types.ScoringState, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ScoringStateKey)
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshal(bz, &state)
+	found = true
+	return
+}
+
+// SetScoringState sets the market maker scoring state.
+func (k Keeper) SetScoringState(ctx sdk.Context, state types.ScoringState) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&state)
+	store.Set(types.ScoringStateKey, bz)
+}
+
+// DeleteScoringState deletes the market maker scoring state.
+func (k Keeper) DeleteScoringState(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ScoringStateKey)
+}
+
+// GetLastIncentiveStreamId returns the last incentive stream id.
+func (k Keeper) GetLastIncentiveStreamId(ctx sdk.Context) (id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastIncentiveStreamIdKey)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetLastIncentiveStreamId sets the last incentive stream id.
+func (k Keeper) SetLastIncentiveStreamId(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastIncentiveStreamIdKey, sdk.Uint64ToBigEndian(id))
+}
+
+// getNextIncentiveStreamIdWithUpdate increments the last incentive stream id
+// and returns it.
+func (k Keeper) getNextIncentiveStreamIdWithUpdate(ctx sdk.Context) uint64 {
+	id := k.GetLastIncentiveStreamId(ctx) + 1
+	k.SetLastIncentiveStreamId(ctx, id)
+	return id
+}
+
+// GetIncentiveStream returns the incentive stream of the market maker.
+func (k Keeper) GetIncentiveStream(ctx sdk.Context, mmAddr sdk.AccAddress, streamId uint64) (stream types.IncentiveStream, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetIncentiveStreamKey(mmAddr, streamId))
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshal(bz, &stream)
+	found = true
+	return
+}
+
+// SetIncentiveStream sets an incentive stream.
+func (k Keeper) SetIncentiveStream(ctx sdk.Context, stream types.IncentiveStream) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&stream)
+	store.Set(types.GetIncentiveStreamKey(stream.GetAccAddress(), stream.Id), bz)
+}
+
+// DeleteIncentiveStream deletes an incentive stream.
+func (k Keeper) DeleteIncentiveStream(ctx sdk.Context, mmAddr sdk.AccAddress, streamId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetIncentiveStreamKey(mmAddr, streamId))
+}
+
+// IterateIncentiveStreams iterates through all incentive streams.
+func (k Keeper) IterateIncentiveStreams(ctx sdk.Context, cb func(stream types.IncentiveStream) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.IncentiveStreamKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var stream types.IncentiveStream
+		k.cdc.MustUnmarshal(iter.Value(), &stream)
+		if cb(stream) {
+			break
+		}
+	}
+}
+
+// IterateIncentiveStreamsByAddr iterates through all incentive streams of the market maker.
+func (k Keeper) IterateIncentiveStreamsByAddr(ctx sdk.Context, mmAddr sdk.AccAddress, cb func(stream types.IncentiveStream) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetIncentiveStreamsByAddrPrefix(mmAddr))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var stream types.IncentiveStream
+		k.cdc.MustUnmarshal(iter.Value(), &stream)
+		if cb(stream) {
+			break
+		}
+	}
+}
+
+// GetAllIncentiveStreams returns all incentive streams.
+func (k Keeper) GetAllIncentiveStreams(ctx sdk.Context) []types.IncentiveStream {
+	streams := []types.IncentiveStream{}
+	k.IterateIncentiveStreams(ctx, func(stream types.IncentiveStream) (stop bool) {
+		streams = append(streams, stream)
+		return false
+	})
+	return streams
+}
+
+// GetIncentiveStreamsByAddr returns all incentive streams of the market maker.
+func (k Keeper) GetIncentiveStreamsByAddr(ctx sdk.Context, mmAddr sdk.AccAddress) []types.IncentiveStream {
+	streams := []types.IncentiveStream{}
+	k.IterateIncentiveStreamsByAddr(ctx, mmAddr, func(stream types.IncentiveStream) (stop bool) {
+		streams = append(streams, stream)
+		return false
+	})
+	return streams
+}
+
 func (k Keeper) ApplyMarketMaker(ctx sdk.Context, mmAddr sdk.AccAddress, pairIds []uint64) error {
 	params := k.GetParams(ctx)
 	incentivePairsMap := params.IncentivePairsMap()
@@ -283,23 +458,61 @@ func (k Keeper) ApplyMarketMaker(ctx sdk.Context, mmAddr sdk.AccAddress, pairIds
 	return nil
 }
 
+// ClaimIncentives claims the market maker's claimable incentives and the
+// vested amount of the market maker's incentive streams at once.
 func (k Keeper) ClaimIncentives(ctx sdk.Context, mmAddr sdk.AccAddress) error {
+	claimable := sdk.Coins{}
 	incentive, found := k.GetIncentive(ctx, mmAddr)
-	if !found {
+	if found {
+		claimable = claimable.Add(incentive.Claimable...)
+	}
+
+	var streams []types.IncentiveStream
+	k.IterateIncentiveStreamsByAddr(ctx, mmAddr, func(stream types.IncentiveStream) (stop bool) {
+		if streamClaimable := stream.Claimable(ctx.BlockTime()); !streamClaimable.IsZero() {
+			claimable = claimable.Add(streamClaimable...)
+			streams = append(streams, stream)
+		}
+		return false
+	})
+	if claimable.IsZero() {
 		return types.ErrEmptyClaimableIncentive
 	}
 
-	if err := k.bankKeeper.SendCoins(ctx, types.ClaimableIncentiveReserveAcc, mmAddr, incentive.Claimable); err != nil {
+	var probationErr error
+	k.IterateMarketMakersByAddr(ctx, mmAddr, func(mm types.MarketMaker) (stop bool) {
+		if mm.OnProbation(ctx.BlockTime()) {
+			probationErr = sdkerrors.Wrapf(types.ErrMarketMakerOnProbation,
+				"pair %d until %s", mm.PairId, mm.ProbationEndTime.Format(time.RFC3339))
+			return true
+		}
+		return false
+	})
+	if probationErr != nil {
+		return probationErr
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, types.ClaimableIncentiveReserveAcc, mmAddr, claimable); err != nil {
 		return err
 	}
 
-	k.DeleteIncentive(ctx, mmAddr)
+	if found {
+		k.DeleteIncentive(ctx, mmAddr)
+	}
+	for _, stream := range streams {
+		stream.Claimed = stream.Claimed.Add(stream.Claimable(ctx.BlockTime())...)
+		if stream.IsCompleted() {
+			k.DeleteIncentiveStream(ctx, mmAddr, stream.Id)
+		} else {
+			k.SetIncentiveStream(ctx, stream)
+		}
+	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeClaimIncentives,
 			sdk.NewAttribute(types.AttributeKeyAddress, mmAddr.String()),
-			sdk.NewAttribute(sdk.AttributeKeyAmount, incentive.Claimable.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, claimable.String()),
 		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -316,9 +529,7 @@ func (k Keeper) ValidateDepositReservedAmount(ctx sdk.Context) error {
 	depositCount := 0
 	var totalAmt sdk.Coins
 	k.IterateMarketMakers(ctx, func(mm types.MarketMaker) (stop bool) {
-		if !mm.Eligible {
-			mmCount += 1
-		}
+		mmCount += 1
 		return false
 	})
 	k.IterateDeposits(ctx, func(id types.Deposit) (stop bool) {
@@ -326,6 +537,7 @@ func (k Keeper) ValidateDepositReservedAmount(ctx sdk.Context) error {
 		totalAmt = totalAmt.Add(id.Amount...)
 		return false
 	})
+	// every market maker keeps its deposit until excluded or rejected
 	if mmCount != depositCount {
 		return fmt.Errorf("market maker number differs from the actual value; have %d, want %d", mmCount, depositCount)
 	}
@@ -339,11 +551,14 @@ func (k Keeper) ValidateDepositReservedAmount(ctx sdk.Context) error {
 	return nil
 }
 
-func (k Keeper) ValidateIncentiveReservedAmount(ctx sdk.Context, incentives []types.Incentive) error {
+func (k Keeper) ValidateIncentiveReservedAmount(ctx sdk.Context, incentives []types.Incentive, streams []types.IncentiveStream) error {
 	var totalClaimable sdk.Coins
 	for _, record := range incentives {
 		totalClaimable = totalClaimable.Add(record.Claimable...)
 	}
+	for _, stream := range streams {
+		totalClaimable = totalClaimable.Add(stream.Amount.Sub(stream.Claimed)...)
+	}
 	if !totalClaimable.Empty() {
 		reserveBalance := k.bankKeeper.GetAllBalances(ctx, types.ClaimableIncentiveReserveAcc)
 		if !reserveBalance.IsAllGTE(totalClaimable) {
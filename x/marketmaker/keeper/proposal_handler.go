@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -23,6 +24,12 @@ func HandleMarketMakerProposal(ctx sdk.Context, k Keeper, proposal *types.Market
 		}
 	}
 
+	if proposal.Slashings != nil {
+		if err := k.SlashMarketMakers(ctx, proposal.Slashings); err != nil {
+			return err
+		}
+	}
+
 	if proposal.Exclusions != nil {
 		if err := k.ExcludeMarketMakers(ctx, proposal.Exclusions); err != nil {
 			return err
@@ -53,15 +60,10 @@ func (k Keeper) IncludeMarketMakers(ctx sdk.Context, proposals []types.MarketMak
 		if mm.Eligible {
 			return sdkerrors.Wrapf(types.ErrInvalidInclusion, "%s is already eligible market maker", p.Address)
 		}
+		// the deposit is kept while the market maker is eligible, so that it can be slashed
 		mm.Eligible = true
 		k.SetMarketMaker(ctx, mm)
 
-		// refund deposit amount
-		err = k.RefundDeposit(ctx, mmAddr, p.PairId)
-		if err != nil {
-			return err
-		}
-
 		ctx.EventManager().EmitEvents(sdk.Events{
 			sdk.NewEvent(
 				types.EventTypeIncludeMarketMaker,
@@ -90,6 +92,16 @@ func (k Keeper) ExcludeMarketMakers(ctx sdk.Context, proposals []types.MarketMak
 		}
 
 		k.DeleteMarketMaker(ctx, mmAddr, p.PairId)
+		k.DeleteScore(ctx, p.PairId, mmAddr)
+
+		if err := k.ForfeitUnvestedIncentives(ctx, mmAddr, p.PairId); err != nil {
+			return err
+		}
+
+		// refund the rest of deposit amount
+		if err := k.RefundDeposit(ctx, mmAddr, p.PairId); err != nil {
+			return err
+		}
 
 		ctx.EventManager().EmitEvents(sdk.Events{
 			sdk.NewEvent(
@@ -138,6 +150,64 @@ func (k Keeper) RejectMarketMakers(ctx sdk.Context, proposals []types.MarketMake
 	return nil
 }
 
+// SlashMarketMakers is a handler for slash eligible market makers.
+func (k Keeper) SlashMarketMakers(ctx sdk.Context, proposals []types.MarketMakerHandle) error {
+	for _, p := range proposals {
+		mmAddr, err := sdk.AccAddressFromBech32(p.Address)
+		if err != nil {
+			return err
+		}
+		mm, found := k.GetMarketMaker(ctx, mmAddr, p.PairId)
+		if !found {
+			return sdkerrors.Wrapf(types.ErrNotExistMarketMaker, "%s is not market maker", p.Address)
+		}
+
+		if !mm.Eligible {
+			return sdkerrors.Wrapf(types.ErrInvalidSlashing, "%s is not eligible market maker", p.Address)
+		}
+
+		if err := k.SlashMarketMaker(ctx, mm); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SlashMarketMaker slashes the SlashFraction of the market maker's deposit to
+// the incentive budget and puts the market maker on probation for the ProbationDuration.
+func (k Keeper) SlashMarketMaker(ctx sdk.Context, mm types.MarketMaker) error {
+	params := k.GetParams(ctx)
+	mmAddr := mm.GetAccAddress()
+
+	slashed := sdk.Coins{}
+	deposit, found := k.GetDeposit(ctx, mmAddr, mm.PairId)
+	if found {
+		slashed, _ = sdk.NewDecCoinsFromCoins(deposit.Amount...).MulDecTruncate(params.SlashFraction).TruncateDecimal()
+		if !slashed.IsZero() {
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, params.IncentiveBudgetAcc(), slashed); err != nil {
+				return err
+			}
+			// the deposit record is kept even if fully slashed, until the market maker is excluded
+			k.SetDeposit(ctx, mmAddr, mm.PairId, deposit.Amount.Sub(slashed))
+		}
+	}
+
+	probationEndTime := ctx.BlockTime().Add(params.ProbationDuration)
+	mm.ProbationEndTime = &probationEndTime
+	k.SetMarketMaker(ctx, mm)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSlashMarketMaker,
+			sdk.NewAttribute(types.AttributeKeyAddress, mm.Address),
+			sdk.NewAttribute(types.AttributeKeyPairId, fmt.Sprintf("%d", mm.PairId)),
+			sdk.NewAttribute(types.AttributeKeySlashedAmount, slashed.String()),
+			sdk.NewAttribute(types.AttributeKeyProbationEndTime, probationEndTime.Format(time.RFC3339)),
+		),
+	})
+	return nil
+}
+
 // DistributeMarketMakerIncentives is a handler for distribute incentives to eligible market makers.
 func (k Keeper) DistributeMarketMakerIncentives(ctx sdk.Context, proposals []types.IncentiveDistribution) error {
 	params := k.GetParams(ctx)
@@ -161,6 +231,14 @@ func (k Keeper) DistributeMarketMakerIncentives(ctx sdk.Context, proposals []typ
 	}
 
 	for _, p := range proposals {
+		// the incentive unlocks linearly over the vesting period if set
+		if params.IncentiveVestingPeriod > 0 {
+			k.SetIncentiveStream(ctx, types.NewIncentiveStream(
+				k.getNextIncentiveStreamIdWithUpdate(ctx), p.GetAccAddress(), p.PairId, p.Amount,
+				ctx.BlockTime(), params.IncentiveVestingPeriod))
+			continue
+		}
+
 		incentive, found := k.GetIncentive(ctx, p.GetAccAddress())
 		if !found {
 			incentive.Claimable = sdk.Coins{}
@@ -194,3 +272,47 @@ func (k Keeper) RefundDeposit(ctx sdk.Context, mmAddr sdk.AccAddress, pairId uin
 	k.DeleteDeposit(ctx, mmAddr, pairId)
 	return nil
 }
+
+// ForfeitUnvestedIncentives returns the unvested amount of the market maker's
+// incentive streams for the pair to the incentive budget, since the market
+// maker is no longer eligible. The vested amount remains claimable.
+func (k Keeper) ForfeitUnvestedIncentives(ctx sdk.Context, mmAddr sdk.AccAddress, pairId uint64) error {
+	var streams []types.IncentiveStream
+	k.IterateIncentiveStreamsByAddr(ctx, mmAddr, func(stream types.IncentiveStream) (stop bool) {
+		if stream.PairId == pairId {
+			streams = append(streams, stream)
+		}
+		return false
+	})
+
+	forfeited := sdk.Coins{}
+	for _, stream := range streams {
+		forfeited = forfeited.Add(stream.Unvested(ctx.BlockTime())...)
+		stream.Amount = stream.Vested(ctx.BlockTime())
+		stream.EndTime = ctx.BlockTime()
+		if stream.IsCompleted() {
+			k.DeleteIncentiveStream(ctx, mmAddr, stream.Id)
+		} else {
+			k.SetIncentiveStream(ctx, stream)
+		}
+	}
+
+	if forfeited.IsZero() {
+		return nil
+	}
+	params := k.GetParams(ctx)
+	if err := k.bankKeeper.SendCoins(ctx, types.ClaimableIncentiveReserveAcc, params.IncentiveBudgetAcc(), forfeited); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeForfeitIncentives,
+			sdk.NewAttribute(types.AttributeKeyAddress, mmAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyPairId, fmt.Sprintf("%d", pairId)),
+			sdk.NewAttribute(types.AttributeKeyBudgetAddress, params.IncentiveBudgetAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, forfeited.String()),
+		),
+	})
+	return nil
+}
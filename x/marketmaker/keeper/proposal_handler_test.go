@@ -1,10 +1,14 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	_ "github.com/stretchr/testify/suite"
 
+	utils "github.com/cosmosquad-labs/squad/v3/types"
+	"github.com/cosmosquad-labs/squad/v3/x/marketmaker/keeper"
 	"github.com/cosmosquad-labs/squad/v3/x/marketmaker/types"
 )
 
@@ -31,7 +35,7 @@ func (suite *KeeperTestSuite) TestMarketMakerProposal() {
 	suite.False(mm.Eligible)
 
 	// include market maker
-	proposal := types.NewMarketMakerProposal("title", "description", []types.MarketMakerHandle{{Address: mmAddr.String(), PairId: 1}}, nil, nil, nil)
+	proposal := types.NewMarketMakerProposal("title", "description", []types.MarketMakerHandle{{Address: mmAddr.String(), PairId: 1}}, nil, nil, nil, nil)
 	suite.handleProposal(proposal)
 
 	mm, found = k.GetMarketMaker(ctx, mmAddr, 1)
@@ -39,44 +43,46 @@ func (suite *KeeperTestSuite) TestMarketMakerProposal() {
 	suite.True(mm.Eligible)
 
 	// fail include market maker already eligible
-	proposal = types.NewMarketMakerProposal("title", "description", []types.MarketMakerHandle{{Address: mmAddr.String(), PairId: 1}}, nil, nil, nil)
+	proposal = types.NewMarketMakerProposal("title", "description", []types.MarketMakerHandle{{Address: mmAddr.String(), PairId: 1}}, nil, nil, nil, nil)
 	err = proposal.ValidateBasic()
 	suite.Require().NoError(err)
 	err = suite.govHandler(suite.ctx, proposal)
 	suite.Require().ErrorIs(err, types.ErrInvalidInclusion)
 
-	// refunded deposit amount
+	// not refunded when inclusion
 	balanceAfterModuleAcc2 := suite.app.BankKeeper.GetAllBalances(ctx, types.DepositReserveAcc)
 	balanceAfterMM2 := suite.app.BankKeeper.GetAllBalances(ctx, mmAddr)
-	suite.EqualValues(sdk.NewCoins(), balanceAfterModuleAcc2)
-	suite.EqualValues(balanceBeforeMM, balanceAfterMM2)
+	suite.EqualValues(balanceAfterModuleAcc, balanceAfterModuleAcc2)
+	suite.EqualValues(balanceAfterMM, balanceAfterMM2)
+	_, found = k.GetDeposit(ctx, mmAddr, 1)
+	suite.True(found)
 
 	// fail include not existed market maker
-	proposal = types.NewMarketMakerProposal("title", "description", []types.MarketMakerHandle{{Address: mmAddr.String(), PairId: 5}}, nil, nil, nil)
+	proposal = types.NewMarketMakerProposal("title", "description", []types.MarketMakerHandle{{Address: mmAddr.String(), PairId: 5}}, nil, nil, nil, nil)
 	err = proposal.ValidateBasic()
 	suite.Require().NoError(err)
 	err = suite.govHandler(suite.ctx, proposal)
 	suite.Require().ErrorIs(err, types.ErrNotExistMarketMaker)
 
 	// fail reject market maker already eligible
-	proposal = types.NewMarketMakerProposal("title", "description", nil, nil, []types.MarketMakerHandle{{Address: mmAddr.String(), PairId: 1}}, nil)
+	proposal = types.NewMarketMakerProposal("title", "description", nil, nil, []types.MarketMakerHandle{{Address: mmAddr.String(), PairId: 1}}, nil, nil)
 	err = proposal.ValidateBasic()
 	suite.Require().NoError(err)
 	err = suite.govHandler(suite.ctx, proposal)
 	suite.Require().ErrorIs(err, types.ErrInvalidRejection)
 
 	// fail reject market maker not exist
-	proposal = types.NewMarketMakerProposal("title", "description", nil, nil, []types.MarketMakerHandle{{Address: mmAddr.String(), PairId: 20}}, nil)
+	proposal = types.NewMarketMakerProposal("title", "description", nil, nil, []types.MarketMakerHandle{{Address: mmAddr.String(), PairId: 20}}, nil, nil)
 	err = proposal.ValidateBasic()
 	suite.Require().NoError(err)
 	err = suite.govHandler(suite.ctx, proposal)
 	suite.Require().ErrorIs(err, types.ErrNotExistMarketMaker)
 
 	// exclude market maker
-	proposal = types.NewMarketMakerProposal("title", "description", nil, []types.MarketMakerHandle{{Address: mmAddr.String(), PairId: 1}}, nil, nil)
+	proposal = types.NewMarketMakerProposal("title", "description", nil, []types.MarketMakerHandle{{Address: mmAddr.String(), PairId: 1}}, nil, nil, nil)
 	suite.handleProposal(proposal)
 
-	// not refunded when exclusion
+	// refunded when exclusion
 	balanceAfterModuleAcc3 := suite.app.BankKeeper.GetAllBalances(ctx, types.DepositReserveAcc)
 	balanceAfterMM3 := suite.app.BankKeeper.GetAllBalances(ctx, mmAddr)
 	suite.EqualValues(sdk.NewCoins(), balanceAfterModuleAcc3)
@@ -86,7 +92,7 @@ func (suite *KeeperTestSuite) TestMarketMakerProposal() {
 	suite.False(found)
 
 	// fail exclude not existed market maker
-	proposal = types.NewMarketMakerProposal("title", "description", nil, []types.MarketMakerHandle{{Address: mmAddr.String(), PairId: 5}}, nil, nil)
+	proposal = types.NewMarketMakerProposal("title", "description", nil, []types.MarketMakerHandle{{Address: mmAddr.String(), PairId: 5}}, nil, nil, nil)
 	err = proposal.ValidateBasic()
 	suite.Require().NoError(err)
 	err = suite.govHandler(suite.ctx, proposal)
@@ -97,14 +103,14 @@ func (suite *KeeperTestSuite) TestMarketMakerProposal() {
 	suite.Require().NoError(err)
 
 	// fail exclude not eligible market maker
-	proposal = types.NewMarketMakerProposal("title", "description", nil, []types.MarketMakerHandle{{Address: mmAddr.String(), PairId: 2}}, nil, nil)
+	proposal = types.NewMarketMakerProposal("title", "description", nil, []types.MarketMakerHandle{{Address: mmAddr.String(), PairId: 2}}, nil, nil, nil)
 	err = proposal.ValidateBasic()
 	suite.Require().NoError(err)
 	err = suite.govHandler(suite.ctx, proposal)
 	suite.Require().ErrorIs(err, types.ErrInvalidExclusion)
 
 	// reject market maker
-	proposal = types.NewMarketMakerProposal("title", "description", nil, nil, []types.MarketMakerHandle{{Address: mmAddr.String(), PairId: 2}}, nil)
+	proposal = types.NewMarketMakerProposal("title", "description", nil, nil, []types.MarketMakerHandle{{Address: mmAddr.String(), PairId: 2}}, nil, nil)
 	suite.handleProposal(proposal)
 
 	// refunded when rejection
@@ -114,28 +120,28 @@ func (suite *KeeperTestSuite) TestMarketMakerProposal() {
 	suite.EqualValues(balanceBeforeMM, balanceAfterMM4)
 
 	// fail invalid market maker address
-	proposal = types.NewMarketMakerProposal("title", "description", []types.MarketMakerHandle{{Address: "invalidaddr", PairId: 1}}, nil, nil, nil)
+	proposal = types.NewMarketMakerProposal("title", "description", []types.MarketMakerHandle{{Address: "invalidaddr", PairId: 1}}, nil, nil, nil, nil)
 	err = proposal.ValidateBasic()
 	suite.Require().Error(err)
 	err = suite.govHandler(suite.ctx, proposal)
 	suite.Require().Error(err)
-	proposal = types.NewMarketMakerProposal("title", "description", nil, []types.MarketMakerHandle{{Address: "invalidaddr", PairId: 1}}, nil, nil)
+	proposal = types.NewMarketMakerProposal("title", "description", nil, []types.MarketMakerHandle{{Address: "invalidaddr", PairId: 1}}, nil, nil, nil)
 	err = proposal.ValidateBasic()
 	suite.Require().Error(err)
 	err = suite.govHandler(suite.ctx, proposal)
 	suite.Require().Error(err)
 
 	// fail empty market maker proposal
-	proposal = types.NewMarketMakerProposal("title", "description", nil, nil, nil, nil)
+	proposal = types.NewMarketMakerProposal("title", "description", nil, nil, nil, nil, nil)
 	err = proposal.ValidateBasic()
 	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
 
 	// fail due to duplicated market maker
-	proposal = types.NewMarketMakerProposal("title", "description", []types.MarketMakerHandle{{Address: mmAddr.String(), PairId: 2}, {Address: mmAddr.String(), PairId: 2}}, nil, nil, nil)
+	proposal = types.NewMarketMakerProposal("title", "description", []types.MarketMakerHandle{{Address: mmAddr.String(), PairId: 2}, {Address: mmAddr.String(), PairId: 2}}, nil, nil, nil, nil)
 	err = proposal.ValidateBasic()
 	suite.Require().Error(err)
 
-	proposal = types.NewMarketMakerProposal("title", "description", []types.MarketMakerHandle{{Address: mmAddr.String(), PairId: 2}}, []types.MarketMakerHandle{{Address: mmAddr.String(), PairId: 2}}, nil, nil)
+	proposal = types.NewMarketMakerProposal("title", "description", []types.MarketMakerHandle{{Address: mmAddr.String(), PairId: 2}}, []types.MarketMakerHandle{{Address: mmAddr.String(), PairId: 2}}, nil, nil, nil)
 	err = proposal.ValidateBasic()
 	suite.Require().Error(err)
 }
@@ -160,7 +166,7 @@ func (suite *KeeperTestSuite) TestMarketMakerProposalDistribution() {
 	suite.ErrorIs(err, types.ErrEmptyClaimableIncentive)
 
 	// include market maker
-	proposal := types.NewMarketMakerProposal("title", "description", []types.MarketMakerHandle{{Address: mmAddr.String(), PairId: 1}}, nil, nil, nil)
+	proposal := types.NewMarketMakerProposal("title", "description", []types.MarketMakerHandle{{Address: mmAddr.String(), PairId: 1}}, nil, nil, nil, nil)
 	suite.handleProposal(proposal)
 
 	// no incentive yet
@@ -182,7 +188,7 @@ func (suite *KeeperTestSuite) TestMarketMakerProposalDistribution() {
 				PairId:  1,
 				Amount:  sdk.Coins{},
 			},
-		})
+		}, nil)
 	err = proposal.ValidateBasic()
 	suite.Require().Error(err)
 
@@ -194,7 +200,7 @@ func (suite *KeeperTestSuite) TestMarketMakerProposalDistribution() {
 				PairId:  1,
 				Amount:  incentiveCoins,
 			},
-		})
+		}, nil)
 	suite.handleProposal(proposal)
 
 	balanceAfterMM := suite.app.BankKeeper.GetAllBalances(ctx, mmAddr)
@@ -223,14 +229,14 @@ func (suite *KeeperTestSuite) TestMarketMakerProposalDistribution() {
 				PairId:  2,
 				Amount:  incentiveCoins,
 			},
-		})
+		}, nil)
 	err = proposal.ValidateBasic()
 	suite.Require().NoError(err)
 	err = suite.govHandler(suite.ctx, proposal)
 	suite.Require().ErrorIs(err, types.ErrNotEligibleMarketMaker)
 
 	// include market maker
-	proposal = types.NewMarketMakerProposal("title", "description", []types.MarketMakerHandle{{Address: mmAddr.String(), PairId: 2}}, nil, nil, nil)
+	proposal = types.NewMarketMakerProposal("title", "description", []types.MarketMakerHandle{{Address: mmAddr.String(), PairId: 2}}, nil, nil, nil, nil)
 	suite.handleProposal(proposal)
 
 	// submit incentive distribution proposal again with multiple pairs with eligible market makers
@@ -246,14 +252,15 @@ func (suite *KeeperTestSuite) TestMarketMakerProposalDistribution() {
 				PairId:  2,
 				Amount:  incentiveCoins,
 			},
-		})
+		}, nil)
 	suite.handleProposal(proposal)
 
 	balanceAfterMM = suite.app.BankKeeper.GetAllBalances(ctx, mmAddr)
 	balanceAfterBudget = suite.app.BankKeeper.GetAllBalances(ctx, params.IncentiveBudgetAcc())
 	balanceAfterReserveAcc = suite.app.BankKeeper.GetAllBalances(ctx, types.ClaimableIncentiveReserveAcc)
 
-	suite.Require().EqualValues(balanceAfterMM, balanceInitMM)
+	// deposits of both eligible market makers are still reserved
+	suite.Require().EqualValues(balanceAfterMM, balanceInitMM.Sub(params.DepositAmount).Sub(params.DepositAmount))
 	suite.Require().EqualValues(balanceAfterBudget, balanceBeforeBudget.Sub(incentiveCoins).Sub(incentiveCoins).Sub(incentiveCoins))
 	suite.Require().EqualValues(balanceAfterReserveAcc, balanceBeforeReserveAcc.Add(incentiveCoins...).Add(incentiveCoins...).Add(incentiveCoins...))
 
@@ -267,7 +274,8 @@ func (suite *KeeperTestSuite) TestMarketMakerProposalDistribution() {
 	suite.NoError(err)
 	balanceAfterMM = suite.app.BankKeeper.GetAllBalances(ctx, mmAddr)
 	balanceAfterReserveAcc = suite.app.BankKeeper.GetAllBalances(ctx, types.ClaimableIncentiveReserveAcc)
-	suite.Equal(balanceAfterMM, balanceInitMM.Add(sdk.NewCoin(sdk.DefaultBondDenom, incentiveAmount.MulRaw(3))))
+	suite.Equal(balanceAfterMM, balanceInitMM.Sub(params.DepositAmount).Sub(params.DepositAmount).
+		Add(sdk.NewCoin(sdk.DefaultBondDenom, incentiveAmount.MulRaw(3))))
 	suite.Equal(balanceBeforeReserveAcc, balanceAfterReserveAcc)
 
 	// claimed all incentives, no object
@@ -282,9 +290,9 @@ func (suite *KeeperTestSuite) TestMarketMakerProposalDistribution() {
 				PairId:  2,
 				Amount:  incentiveCoins,
 			},
-		})
+		}, nil)
 	suite.handleProposal(proposal)
-	proposal = types.NewMarketMakerProposal("title", "description", nil, []types.MarketMakerHandle{{Address: mmAddr.String(), PairId: 2}}, nil, nil)
+	proposal = types.NewMarketMakerProposal("title", "description", nil, []types.MarketMakerHandle{{Address: mmAddr.String(), PairId: 2}}, nil, nil, nil)
 	suite.handleProposal(proposal)
 	err = k.ClaimIncentives(ctx, mmAddr)
 	suite.NoError(err)
@@ -310,7 +318,7 @@ func (suite *KeeperTestSuite) TestMarketMakerProposalHugeCase() {
 		suite.Require().NoError(err)
 	}
 
-	proposal := types.NewMarketMakerProposal("title", "description", nil, nil, nil, nil)
+	proposal := types.NewMarketMakerProposal("title", "description", nil, nil, nil, nil, nil)
 
 	// include, distribute market maker
 	for i := 0; i < 20; i++ {
@@ -376,7 +384,7 @@ func (suite *KeeperTestSuite) TestMarketMakerProposalAfterResetIncentivePair() {
 	suite.False(mm.Eligible)
 
 	// include market maker
-	proposal := types.NewMarketMakerProposal("title", "description", []types.MarketMakerHandle{{Address: mmAddr.String(), PairId: 1}}, nil, nil, nil)
+	proposal := types.NewMarketMakerProposal("title", "description", []types.MarketMakerHandle{{Address: mmAddr.String(), PairId: 1}}, nil, nil, nil, nil)
 	suite.handleProposal(proposal)
 
 	mm, found = k.GetMarketMaker(ctx, mmAddr, 1)
@@ -394,7 +402,7 @@ func (suite *KeeperTestSuite) TestMarketMakerProposalAfterResetIncentivePair() {
 				PairId:  1,
 				Amount:  incentiveCoins,
 			},
-		})
+		}, nil)
 	suite.handleProposal(proposal)
 
 	incentive, found := k.GetIncentive(ctx, mmAddr)
@@ -406,13 +414,13 @@ func (suite *KeeperTestSuite) TestMarketMakerProposalAfterResetIncentivePair() {
 	suite.NoError(err)
 
 	// exclude market maker
-	proposal = types.NewMarketMakerProposal("title", "description", nil, []types.MarketMakerHandle{{Address: mmAddr.String(), PairId: 1}}, nil, nil)
+	proposal = types.NewMarketMakerProposal("title", "description", nil, []types.MarketMakerHandle{{Address: mmAddr.String(), PairId: 1}}, nil, nil, nil)
 	suite.handleProposal(proposal)
 	_, found = k.GetMarketMaker(ctx, mmAddr, 1)
 	suite.False(found)
 
 	// reject market maker
-	proposal = types.NewMarketMakerProposal("title", "description", nil, nil, []types.MarketMakerHandle{{Address: mmAddr.String(), PairId: 2}}, nil)
+	proposal = types.NewMarketMakerProposal("title", "description", nil, nil, []types.MarketMakerHandle{{Address: mmAddr.String(), PairId: 2}}, nil, nil)
 	suite.handleProposal(proposal)
 }
 
@@ -445,16 +453,23 @@ func (suite *KeeperTestSuite) TestRefundDepositWhenAmountChanged() {
 	suite.EqualValues(balanceBeforeModuleAcc.Add(params.DepositAmount...).
 		Add(types.DefaultDepositAmount...), balanceAfterModuleAcc)
 
-	// include market maker
-	proposal := types.NewMarketMakerProposal("title", "description", []types.MarketMakerHandle{{Address: mmAddr.String(), PairId: 1}}, nil, nil, nil)
+	// include market makers
+	proposal := types.NewMarketMakerProposal("title", "description", []types.MarketMakerHandle{
+		{Address: mmAddr.String(), PairId: 1},
+		{Address: mmAddr.String(), PairId: 2},
+	}, nil, nil, nil, nil)
+	suite.handleProposal(proposal)
+
+	// exclude market maker
+	proposal = types.NewMarketMakerProposal("title", "description", nil, []types.MarketMakerHandle{{Address: mmAddr.String(), PairId: 1}}, nil, nil, nil)
 	suite.handleProposal(proposal)
 
 	// refunded initial deposit amount
 	balanceAfterModuleAcc2 := suite.app.BankKeeper.GetAllBalances(ctx, types.DepositReserveAcc)
 	suite.EqualValues(params.DepositAmount, balanceAfterModuleAcc2)
 
-	// include market maker
-	proposal = types.NewMarketMakerProposal("title", "description", []types.MarketMakerHandle{{Address: mmAddr.String(), PairId: 2}}, nil, nil, nil)
+	// exclude market maker
+	proposal = types.NewMarketMakerProposal("title", "description", nil, []types.MarketMakerHandle{{Address: mmAddr.String(), PairId: 2}}, nil, nil, nil)
 	suite.handleProposal(proposal)
 
 	// refunded changed deposit amount
@@ -495,8 +510,15 @@ func (suite *KeeperTestSuite) TestRefundDepositWhenAmountZero() {
 		suite.EqualValues(balanceBeforeModuleAcc, balanceAfterModuleAcc)
 		suite.EqualValues(balanceBeforeMMAddr, balanceAfterMMAddr)
 
-		// include market maker
-		proposal := types.NewMarketMakerProposal("title", "description", []types.MarketMakerHandle{{Address: mmAddr.String(), PairId: 1}}, nil, nil, nil)
+		// include market makers
+		proposal := types.NewMarketMakerProposal("title", "description", []types.MarketMakerHandle{
+			{Address: mmAddr.String(), PairId: 1},
+			{Address: mmAddr.String(), PairId: 2},
+		}, nil, nil, nil, nil)
+		suite.handleProposal(proposal)
+
+		// exclude market maker
+		proposal = types.NewMarketMakerProposal("title", "description", nil, []types.MarketMakerHandle{{Address: mmAddr.String(), PairId: 1}}, nil, nil, nil)
 		suite.handleProposal(proposal)
 
 		_, found = k.GetDeposit(ctx, mmAddr, 1)
@@ -506,8 +528,8 @@ func (suite *KeeperTestSuite) TestRefundDepositWhenAmountZero() {
 		balanceAfterModuleAcc2 := suite.app.BankKeeper.GetAllBalances(ctx, types.DepositReserveAcc)
 		suite.EqualValues(sdk.Coins{}, balanceAfterModuleAcc2)
 
-		// include market maker
-		proposal = types.NewMarketMakerProposal("title", "description", []types.MarketMakerHandle{{Address: mmAddr.String(), PairId: 2}}, nil, nil, nil)
+		// exclude market maker
+		proposal = types.NewMarketMakerProposal("title", "description", nil, []types.MarketMakerHandle{{Address: mmAddr.String(), PairId: 2}}, nil, nil, nil)
 		suite.handleProposal(proposal)
 
 		_, found = k.GetDeposit(ctx, mmAddr, 2)
@@ -522,3 +544,224 @@ func (suite *KeeperTestSuite) TestRefundDepositWhenAmountZero() {
 	test(sdk.Coins(nil), suite.addrs[1])
 	test(nil, suite.addrs[2])
 }
+
+func (suite *KeeperTestSuite) TestMarketMakerProposalSlashing() {
+	k := suite.keeper
+	mmAddr := suite.addrs[0]
+
+	// set incentive budget
+	params := k.GetParams(suite.ctx)
+	params.IncentiveBudgetAddress = suite.addrs[5].String()
+	k.SetParams(suite.ctx, params)
+
+	// apply and include market maker
+	err := k.ApplyMarketMaker(suite.ctx, mmAddr, []uint64{1, 2})
+	suite.Require().NoError(err)
+	proposal := types.NewMarketMakerProposal("title", "description", []types.MarketMakerHandle{{Address: mmAddr.String(), PairId: 1}}, nil, nil, nil, nil)
+	suite.handleProposal(proposal)
+
+	incentiveCoins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 500000000))
+	proposal = types.NewMarketMakerProposal("title", "description", nil, nil, nil,
+		[]types.IncentiveDistribution{
+			{
+				Address: mmAddr.String(),
+				PairId:  1,
+				Amount:  incentiveCoins,
+			},
+		}, nil)
+	suite.handleProposal(proposal)
+
+	balanceBeforeBudget := suite.app.BankKeeper.GetAllBalances(suite.ctx, params.IncentiveBudgetAcc())
+
+	// fail slash not eligible market maker
+	proposal = types.NewMarketMakerProposal("title", "description", nil, nil, nil, nil, []types.MarketMakerHandle{{Address: mmAddr.String(), PairId: 2}})
+	err = proposal.ValidateBasic()
+	suite.Require().NoError(err)
+	err = suite.govHandler(suite.ctx, proposal)
+	suite.Require().ErrorIs(err, types.ErrInvalidSlashing)
+
+	// fail slash not existed market maker
+	proposal = types.NewMarketMakerProposal("title", "description", nil, nil, nil, nil, []types.MarketMakerHandle{{Address: mmAddr.String(), PairId: 5}})
+	err = proposal.ValidateBasic()
+	suite.Require().NoError(err)
+	err = suite.govHandler(suite.ctx, proposal)
+	suite.Require().ErrorIs(err, types.ErrNotExistMarketMaker)
+
+	// slash market maker
+	proposal = types.NewMarketMakerProposal("title", "description", nil, nil, nil, nil, []types.MarketMakerHandle{{Address: mmAddr.String(), PairId: 1}})
+	suite.handleProposal(proposal)
+
+	slashed := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100000000))
+	deposit, found := k.GetDeposit(suite.ctx, mmAddr, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(params.DepositAmount.Sub(slashed), deposit.Amount)
+	suite.Require().Equal(balanceBeforeBudget.Add(slashed...), suite.app.BankKeeper.GetAllBalances(suite.ctx, params.IncentiveBudgetAcc()))
+
+	mm, found := k.GetMarketMaker(suite.ctx, mmAddr, 1)
+	suite.Require().True(found)
+	suite.Require().True(mm.Eligible)
+	suite.Require().NotNil(mm.ProbationEndTime)
+	suite.Require().Equal(suite.ctx.BlockTime().Add(params.ProbationDuration), *mm.ProbationEndTime)
+
+	_, broken := keeper.AllInvariants(k)(suite.ctx)
+	suite.Require().False(broken)
+
+	// fail claim incentives on probation
+	err = k.ClaimIncentives(suite.ctx, mmAddr)
+	suite.Require().ErrorIs(err, types.ErrMarketMakerOnProbation)
+
+	// claim incentives after the probation
+	suite.ctx = suite.ctx.WithBlockTime(*mm.ProbationEndTime)
+	balanceBeforeMM := suite.app.BankKeeper.GetAllBalances(suite.ctx, mmAddr)
+	err = k.ClaimIncentives(suite.ctx, mmAddr)
+	suite.Require().NoError(err)
+	suite.Require().Equal(balanceBeforeMM.Add(incentiveCoins...), suite.app.BankKeeper.GetAllBalances(suite.ctx, mmAddr))
+
+	// slash and exclude market maker at once, the rest of deposit is refunded
+	balanceBeforeMM = suite.app.BankKeeper.GetAllBalances(suite.ctx, mmAddr)
+	proposal = types.NewMarketMakerProposal("title", "description", nil,
+		[]types.MarketMakerHandle{{Address: mmAddr.String(), PairId: 1}}, nil, nil,
+		[]types.MarketMakerHandle{{Address: mmAddr.String(), PairId: 1}})
+	suite.handleProposal(proposal)
+
+	remaining := params.DepositAmount.Sub(slashed).Sub(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 90000000)))
+	suite.Require().Equal(balanceBeforeMM.Add(remaining...), suite.app.BankKeeper.GetAllBalances(suite.ctx, mmAddr))
+	_, found = k.GetDeposit(suite.ctx, mmAddr, 1)
+	suite.Require().False(found)
+	_, found = k.GetMarketMaker(suite.ctx, mmAddr, 1)
+	suite.Require().False(found)
+
+	_, broken = keeper.AllInvariants(k)(suite.ctx)
+	suite.Require().False(broken)
+}
+
+func (suite *KeeperTestSuite) TestMarketMakerProposalSlashingWholeDeposit() {
+	k := suite.keeper
+	mmAddr := suite.addrs[0]
+
+	params := k.GetParams(suite.ctx)
+	params.SlashFraction = sdk.OneDec()
+	k.SetParams(suite.ctx, params)
+
+	err := k.ApplyMarketMaker(suite.ctx, mmAddr, []uint64{1})
+	suite.Require().NoError(err)
+	proposal := types.NewMarketMakerProposal("title", "description", []types.MarketMakerHandle{{Address: mmAddr.String(), PairId: 1}}, nil, nil, nil, nil)
+	suite.handleProposal(proposal)
+
+	proposal = types.NewMarketMakerProposal("title", "description", nil, nil, nil, nil, []types.MarketMakerHandle{{Address: mmAddr.String(), PairId: 1}})
+	suite.handleProposal(proposal)
+
+	// the deposit record is kept even if fully slashed
+	deposit, found := k.GetDeposit(suite.ctx, mmAddr, 1)
+	suite.Require().True(found)
+	suite.Require().True(deposit.Amount.IsZero())
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, types.DepositReserveAcc).IsZero())
+
+	// slash again without deposit only extends the probation
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Hour))
+	suite.handleProposal(proposal)
+	mm, found := k.GetMarketMaker(suite.ctx, mmAddr, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(suite.ctx.BlockTime().Add(params.ProbationDuration), *mm.ProbationEndTime)
+
+	// exclude market maker without deposit
+	proposal = types.NewMarketMakerProposal("title", "description", nil, []types.MarketMakerHandle{{Address: mmAddr.String(), PairId: 1}}, nil, nil, nil)
+	suite.handleProposal(proposal)
+	_, found = k.GetDeposit(suite.ctx, mmAddr, 1)
+	suite.Require().False(found)
+
+	_, broken := keeper.AllInvariants(k)(suite.ctx)
+	suite.Require().False(broken)
+}
+
+func (suite *KeeperTestSuite) TestMarketMakerProposalDistributionVesting() {
+	k := suite.keeper
+	mmAddr := suite.addrs[0]
+	suite.ctx = suite.ctx.WithBlockTime(utils.ParseTime("2023-01-01T00:00:00Z"))
+	startTime := suite.ctx.BlockTime()
+
+	// set incentive budget and vesting period
+	params := k.GetParams(suite.ctx)
+	params.IncentiveBudgetAddress = suite.addrs[5].String()
+	params.IncentiveVestingPeriod = 10 * types.Day
+	k.SetParams(suite.ctx, params)
+
+	err := k.ApplyMarketMaker(suite.ctx, mmAddr, []uint64{1, 2})
+	suite.Require().NoError(err)
+	proposal := types.NewMarketMakerProposal("title", "description", []types.MarketMakerHandle{
+		{Address: mmAddr.String(), PairId: 1},
+		{Address: mmAddr.String(), PairId: 2},
+	}, nil, nil, nil, nil)
+	suite.handleProposal(proposal)
+
+	incentiveCoins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000_000))
+	proposal = types.NewMarketMakerProposal("title", "description", nil, nil, nil,
+		[]types.IncentiveDistribution{
+			{Address: mmAddr.String(), PairId: 1, Amount: incentiveCoins},
+			{Address: mmAddr.String(), PairId: 2, Amount: incentiveCoins},
+		}, nil)
+	suite.handleProposal(proposal)
+
+	// distributed incentives are streamed instead of being claimable at once
+	_, found := k.GetIncentive(suite.ctx, mmAddr)
+	suite.Require().False(found)
+	streams := k.GetIncentiveStreamsByAddr(suite.ctx, mmAddr)
+	suite.Require().Len(streams, 2)
+	suite.Require().EqualValues(2, k.GetLastIncentiveStreamId(suite.ctx))
+	suite.Require().Equal(startTime.Add(10*types.Day), streams[0].EndTime)
+
+	// nothing vested yet
+	err = k.ClaimIncentives(suite.ctx, mmAddr)
+	suite.Require().ErrorIs(err, types.ErrEmptyClaimableIncentive)
+
+	// claim vested incentives
+	suite.ctx = suite.ctx.WithBlockTime(startTime.Add(types.Day))
+	balanceBeforeMM := suite.app.BankKeeper.GetAllBalances(suite.ctx, mmAddr)
+	err = k.ClaimIncentives(suite.ctx, mmAddr)
+	suite.Require().NoError(err)
+	vested := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 200_000_000))
+	suite.Require().Equal(balanceBeforeMM.Add(vested...), suite.app.BankKeeper.GetAllBalances(suite.ctx, mmAddr))
+
+	stream, found := k.GetIncentiveStream(suite.ctx, mmAddr, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100_000_000)), stream.Claimed)
+
+	_, broken := keeper.AllInvariants(k)(suite.ctx)
+	suite.Require().False(broken)
+
+	// the unvested amount of the excluded market maker's streams is forfeited
+	suite.ctx = suite.ctx.WithBlockTime(startTime.Add(2 * types.Day))
+	balanceBeforeBudget := suite.app.BankKeeper.GetAllBalances(suite.ctx, params.IncentiveBudgetAcc())
+	proposal = types.NewMarketMakerProposal("title", "description", nil, []types.MarketMakerHandle{{Address: mmAddr.String(), PairId: 1}}, nil, nil, nil)
+	suite.handleProposal(proposal)
+
+	forfeited := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 800_000_000))
+	suite.Require().Equal(balanceBeforeBudget.Add(forfeited...), suite.app.BankKeeper.GetAllBalances(suite.ctx, params.IncentiveBudgetAcc()))
+	stream, found = k.GetIncentiveStream(suite.ctx, mmAddr, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 200_000_000)), stream.Amount)
+	suite.Require().Equal(suite.ctx.BlockTime(), stream.EndTime)
+
+	_, broken = keeper.AllInvariants(k)(suite.ctx)
+	suite.Require().False(broken)
+
+	// the vested amount remains claimable after the exclusion,
+	// and the fully claimed stream is deleted
+	balanceBeforeMM = suite.app.BankKeeper.GetAllBalances(suite.ctx, mmAddr)
+	err = k.ClaimIncentives(suite.ctx, mmAddr)
+	suite.Require().NoError(err)
+	suite.Require().Equal(balanceBeforeMM.Add(vested...), suite.app.BankKeeper.GetAllBalances(suite.ctx, mmAddr))
+	_, found = k.GetIncentiveStream(suite.ctx, mmAddr, 1)
+	suite.Require().False(found)
+
+	// the stream of the eligible market maker keeps vesting until the end
+	suite.ctx = suite.ctx.WithBlockTime(startTime.Add(20 * types.Day))
+	balanceBeforeMM = suite.app.BankKeeper.GetAllBalances(suite.ctx, mmAddr)
+	err = k.ClaimIncentives(suite.ctx, mmAddr)
+	suite.Require().NoError(err)
+	suite.Require().Equal(
+		balanceBeforeMM.Add(sdk.NewInt64Coin(sdk.DefaultBondDenom, 800_000_000)),
+		suite.app.BankKeeper.GetAllBalances(suite.ctx, mmAddr))
+	suite.Require().Empty(k.GetAllIncentiveStreams(suite.ctx))
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, types.ClaimableIncentiveReserveAcc).IsZero())
+}
@@ -0,0 +1,260 @@
+package keeper
+
+import (
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	liquiditytypes "github.com/cosmosquad-labs/squad/v3/x/liquidity/types"
+	"github.com/cosmosquad-labs/squad/v3/x/marketmaker/types"
+)
+
+// ScoreMarketMakers samples the orders of eligible market makers at each
+// batch of the liquidity module and closes the hours, days and incentive
+// periods of the scoring as the block time passes.
+// It does nothing if the on-chain scoring is disabled.
+func (k Keeper) ScoreMarketMakers(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if !params.ScoringEnabled() {
+		return
+	}
+
+	batchSize := k.liquidityKeeper.GetBatchSize(ctx)
+	if ctx.BlockHeight()%int64(batchSize) != 0 {
+		return
+	}
+
+	hourStartTime := ctx.BlockTime().Truncate(time.Hour)
+	state, found := k.GetScoringState(ctx)
+	if !found {
+		state = types.NewScoringState(hourStartTime.Truncate(types.Day), hourStartTime)
+	} else if hourStartTime.After(state.HourStartTime) {
+		dayEnded := hourStartTime.Truncate(types.Day).After(state.HourStartTime)
+		k.endHour(ctx, params.Common, dayEnded)
+		if !hourStartTime.Before(state.PeriodStartTime.Add(params.IncentivePeriod())) {
+			k.endIncentivePeriod(ctx, params)
+			state.PeriodStartTime = hourStartTime.Truncate(types.Day)
+		}
+		state.HourStartTime = hourStartTime
+	}
+	k.SetScoringState(ctx, state)
+
+	k.sampleMarketMakerOrders(ctx, params, batchSize)
+}
+
+// sampleMarketMakerOrders measures the orders of eligible market makers
+// for each incentive pair and records them to the market makers' scores.
+func (k Keeper) sampleMarketMakerOrders(ctx sdk.Context, params types.Params, batchSize uint32) {
+	for _, pair := range params.IncentivePairs {
+		if !pair.HasScoringCriteria() || pair.UpdateTime.After(ctx.BlockTime()) {
+			continue
+		}
+
+		var mms []types.MarketMaker
+		k.IterateMarketMakersByPairId(ctx, pair.PairId, func(mm types.MarketMaker) (stop bool) {
+			if mm.Eligible {
+				mms = append(mms, mm)
+			}
+			return false
+		})
+
+		scores := make([]types.MarketMakerScore, len(mms))
+		points := make([]sdk.Dec, len(mms))
+		totalPoint := sdk.ZeroDec()
+		for i, mm := range mms {
+			mmAddr := mm.GetAccAddress()
+			score, found := k.GetScore(ctx, pair.PairId, mmAddr)
+			if !found {
+				score = types.NewMarketMakerScore(mmAddr, pair.PairId)
+			}
+
+			m, ok := types.MeasureOrders(k.openMMOrders(ctx, mmAddr, pair.PairId), params.Common, pair.MinDepth)
+			live := ok && m.IsLive(pair)
+			score.HourMetrics.Record(m, live, batchSize)
+			score.DayMetrics.Record(m, live, batchSize)
+
+			points[i] = sdk.ZeroDec()
+			if live {
+				points[i] = m.Point
+				totalPoint = totalPoint.Add(m.Point)
+			}
+			scores[i] = score
+		}
+
+		for i, score := range scores {
+			if totalPoint.IsPositive() && points[i].IsPositive() {
+				score.PointShare = score.PointShare.Add(points[i].Quo(totalPoint))
+			}
+			k.SetScore(ctx, score)
+		}
+	}
+}
+
+// openMMOrders returns the market maker's market making orders in the pair
+// which are still open.
+func (k Keeper) openMMOrders(ctx sdk.Context, mmAddr sdk.AccAddress, pairId uint64) (orders []liquiditytypes.Order) {
+	index, found := k.liquidityKeeper.GetMMOrderIndex(ctx, mmAddr, pairId)
+	if !found {
+		return nil
+	}
+	for _, orderId := range index.OrderIds {
+		order, found := k.liquidityKeeper.GetOrder(ctx, pairId, orderId)
+		if !found || !order.Status.IsMatchable() || order.ExpiredAt(ctx.BlockTime()) {
+			continue
+		}
+		orders = append(orders, order)
+	}
+	return orders
+}
+
+// endHour closes the current hour of all market maker scores.
+// Eligible market makers whose downtime in the hour exceeded MaxTotalDowntime
+// are slashed, unless they are already on probation.
+// A downtime spanning consecutive hours is slashed only at its first hour.
+func (k Keeper) endHour(ctx sdk.Context, common types.Common, dayEnded bool) {
+	for _, score := range k.GetAllScores(ctx) {
+		down := score.HourMetrics.Downtime > common.MaxTotalDowntime
+		if down && !score.Down {
+			k.slashDownMarketMaker(ctx, score)
+		}
+		score.Down = down
+		score.EndHour(common, dayEnded)
+		k.SetScore(ctx, score)
+	}
+}
+
+// slashDownMarketMaker slashes the market maker of the score if the market
+// maker is eligible and not on probation.
+func (k Keeper) slashDownMarketMaker(ctx sdk.Context, score types.MarketMakerScore) {
+	mm, found := k.GetMarketMaker(ctx, score.GetAccAddress(), score.PairId)
+	if !found || !mm.Eligible || mm.OnProbation(ctx.BlockTime()) {
+		return
+	}
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := k.SlashMarketMaker(cacheCtx, mm); err != nil {
+		k.Logger(ctx).Error("failed to slash market maker", "address", mm.Address, "pair_id", mm.PairId, "error", err)
+		return
+	}
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+}
+
+// endIncentivePeriod distributes the incentive period budget to the market
+// makers who satisfied the uptime requirement in the incentive period, in
+// proportion to their final scores within each pair's share of the budget.
+// All market maker scores are reset afterwards, except whether the market
+// maker is down, so that a downtime over the end of the period isn't slashed twice.
+func (k Keeper) endIncentivePeriod(ctx sdk.Context, params types.Params) {
+	budget := sdk.NewDecCoinsFromCoins(k.incentivePeriodBudget(ctx, params)...)
+	totalWeight := sdk.ZeroDec()
+	for _, pair := range params.IncentivePairs {
+		if !pair.IncentiveWeight.IsNil() && pair.IncentiveWeight.IsPositive() {
+			totalWeight = totalWeight.Add(pair.IncentiveWeight)
+		}
+	}
+	incentivePairs := params.IncentivePairsMap()
+	period := params.IncentivePeriod()
+
+	// Scores are sorted by pair id since the pair id comes first in the key.
+	scores := k.GetAllScores(ctx)
+	var distrs []types.IncentiveDistribution
+	for start := 0; start < len(scores); {
+		end := start
+		for end < len(scores) && scores[end].PairId == scores[start].PairId {
+			end++
+		}
+		distrs = append(distrs, k.pairIncentiveDistributions(
+			ctx, params.Common, incentivePairs, scores[start:end], budget, totalWeight, period)...)
+		start = end
+	}
+
+	for _, score := range scores {
+		if score.Down {
+			newScore := types.NewMarketMakerScore(score.GetAccAddress(), score.PairId)
+			newScore.Down = true
+			k.SetScore(ctx, newScore)
+			continue
+		}
+		k.DeleteScore(ctx, score.PairId, score.GetAccAddress())
+	}
+
+	if len(distrs) == 0 {
+		return
+	}
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := k.DistributeMarketMakerIncentives(cacheCtx, distrs); err != nil {
+		k.Logger(ctx).Error("failed to distribute market maker incentives", "error", err)
+		return
+	}
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+}
+
+// incentivePeriodBudget returns the amount of the incentive budget to be
+// distributed at the end of an incentive period, which is params.IncentivePeriodBudget
+// capped by the spendable balance of the incentive budget account.
+// The rest of the balance is left for the distributions by governance proposals.
+func (k Keeper) incentivePeriodBudget(ctx sdk.Context, params types.Params) sdk.Coins {
+	spendable := k.bankKeeper.SpendableCoins(ctx, params.IncentiveBudgetAcc())
+	budget := sdk.Coins{}
+	for _, coin := range params.IncentivePeriodBudget {
+		amt := sdk.MinInt(coin.Amount, spendable.AmountOf(coin.Denom))
+		if amt.IsPositive() {
+			budget = budget.Add(sdk.NewCoin(coin.Denom, amt))
+		}
+	}
+	return budget
+}
+
+// pairIncentiveDistributions returns the incentive distributions for the
+// market makers of a pair, given their scores.
+func (k Keeper) pairIncentiveDistributions(
+	ctx sdk.Context, common types.Common, incentivePairs map[uint64]types.IncentivePair,
+	scores []types.MarketMakerScore, budget sdk.DecCoins, totalWeight sdk.Dec, period time.Duration,
+) (distrs []types.IncentiveDistribution) {
+	pairId := scores[0].PairId
+	finalScores := make([]sdk.Dec, len(scores))
+	totalScore := sdk.ZeroDec()
+	for i, score := range scores {
+		finalScores[i] = sdk.ZeroDec()
+		mm, found := k.GetMarketMaker(ctx, score.GetAccAddress(), pairId)
+		if found && mm.Eligible && score.LiveDays >= common.MinDays {
+			finalScores[i] = score.FinalScore(period)
+			totalScore = totalScore.Add(finalScores[i])
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeScoreMarketMaker,
+				sdk.NewAttribute(types.AttributeKeyAddress, score.Address),
+				sdk.NewAttribute(types.AttributeKeyPairId, strconv.FormatUint(pairId, 10)),
+				sdk.NewAttribute(types.AttributeKeyTotalLiveHours, strconv.FormatUint(uint64(score.TotalLiveHours), 10)),
+				sdk.NewAttribute(types.AttributeKeyLiveDays, strconv.FormatUint(uint64(score.LiveDays), 10)),
+				sdk.NewAttribute(types.AttributeKeyScore, finalScores[i].String()),
+			),
+		)
+	}
+
+	pair, ok := incentivePairs[pairId]
+	if !ok || pair.IncentiveWeight.IsNil() || !pair.IncentiveWeight.IsPositive() || !totalScore.IsPositive() {
+		return nil
+	}
+
+	pairBudget := budget.MulDecTruncate(pair.IncentiveWeight.QuoTruncate(totalWeight))
+	for i, score := range scores {
+		if !finalScores[i].IsPositive() {
+			continue
+		}
+		amt, _ := pairBudget.MulDecTruncate(finalScores[i].QuoTruncate(totalScore)).TruncateDecimal()
+		if amt.IsZero() {
+			continue
+		}
+		distrs = append(distrs, types.IncentiveDistribution{
+			Address: score.Address,
+			PairId:  pairId,
+			Amount:  amt,
+		})
+	}
+	return distrs
+}
@@ -0,0 +1,395 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	chain "github.com/cosmosquad-labs/squad/v3/app"
+	utils "github.com/cosmosquad-labs/squad/v3/types"
+	liquiditytypes "github.com/cosmosquad-labs/squad/v3/x/liquidity/types"
+	"github.com/cosmosquad-labs/squad/v3/x/marketmaker/types"
+)
+
+// setupScoring creates a pair and enables the on-chain scoring for it with the given
+// common criteria and returns the pair.
+// The incentive budget is funded twice the incentive period budget.
+func (suite *KeeperTestSuite) setupScoring(common types.Common) liquiditytypes.Pair {
+	suite.T().Helper()
+	suite.ctx = suite.ctx.WithBlockHeight(1).WithBlockTime(utils.ParseTime("2023-01-01T00:00:00Z"))
+
+	pair, err := suite.app.LiquidityKeeper.CreatePair(
+		suite.ctx, liquiditytypes.NewMsgCreatePair(suite.addrs[0], denom1, denom2))
+	suite.Require().NoError(err)
+
+	params := suite.keeper.GetParams(suite.ctx)
+	params.Common = common
+	params.IncentivePeriodDays = 1
+	params.IncentivePeriodBudget = sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_000_000))
+	params.IncentivePairs = []types.IncentivePair{
+		{
+			PairId:          pair.Id,
+			IncentiveWeight: sdk.MustNewDecFromStr("0.5"),
+			MaxSpread:       sdk.MustNewDecFromStr("0.05"),
+			MinWidth:        sdk.MustNewDecFromStr("0.01"),
+			MinDepth:        sdk.NewInt(100_000),
+		},
+	}
+	suite.keeper.SetParams(suite.ctx, params)
+
+	err = chain.FundAccount(suite.app.BankKeeper, suite.ctx, params.IncentiveBudgetAcc(),
+		sdk.NewCoins(sdk.NewInt64Coin(denom3, 2_000_000)))
+	suite.Require().NoError(err)
+	return pair
+}
+
+// eligibleMarketMaker sets an eligible market maker of the pair, whose
+// deposit has been fully slashed.
+func (suite *KeeperTestSuite) eligibleMarketMaker(mmAddr sdk.AccAddress, pairId uint64) {
+	suite.T().Helper()
+	suite.keeper.SetMarketMaker(suite.ctx, types.MarketMaker{
+		Address:  mmAddr.String(),
+		PairId:   pairId,
+		Eligible: true,
+	})
+	suite.keeper.SetDeposit(suite.ctx, mmAddr, pairId, sdk.Coins{})
+}
+
+func (suite *KeeperTestSuite) mmOrder(mmAddr sdk.AccAddress, pairId uint64, spread string) {
+	suite.T().Helper()
+	halfSpread := sdk.MustNewDecFromStr(spread).QuoInt64(2)
+	width := sdk.MustNewDecFromStr("0.02")
+	_, err := suite.app.LiquidityKeeper.MMOrder(suite.ctx, liquiditytypes.NewMsgMMOrder(
+		mmAddr, pairId,
+		sdk.OneDec().Add(halfSpread).Add(width), sdk.OneDec().Add(halfSpread), sdk.NewInt(1_000_000),
+		sdk.OneDec().Sub(halfSpread), sdk.OneDec().Sub(halfSpread).Sub(width), sdk.NewInt(1_000_000),
+		24*time.Hour))
+	suite.Require().NoError(err)
+}
+
+// scoreAt runs the scoring at the given block time.
+func (suite *KeeperTestSuite) scoreAt(t time.Time) {
+	suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1).WithBlockTime(t)
+	suite.keeper.ScoreMarketMakers(suite.ctx)
+}
+
+func (suite *KeeperTestSuite) TestScoreMarketMakers_Disabled() {
+	suite.ctx = suite.ctx.WithBlockHeight(1).WithBlockTime(utils.ParseTime("2023-01-01T00:00:00Z"))
+	suite.keeper.ScoreMarketMakers(suite.ctx)
+
+	_, found := suite.keeper.GetScoringState(suite.ctx)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestScoreMarketMakers() {
+	common := types.DefaultCommon
+	common.MinHours = 20
+	common.MinDays = 1
+	pair := suite.setupScoring(common)
+
+	mm1, mm2, mm3 := suite.addrs[0], suite.addrs[1], suite.addrs[2]
+	for _, mmAddr := range []sdk.AccAddress{mm1, mm2, mm3} {
+		suite.eligibleMarketMaker(mmAddr, pair.Id)
+	}
+	suite.mmOrder(mm1, pair.Id, "0.02")
+	suite.mmOrder(mm2, pair.Id, "0.04")
+
+	startTime := suite.ctx.BlockTime()
+	suite.keeper.ScoreMarketMakers(suite.ctx)
+	state, found := suite.keeper.GetScoringState(suite.ctx)
+	suite.Require().True(found)
+	suite.Require().Equal(startTime, state.PeriodStartTime)
+	suite.Require().Equal(startTime, state.HourStartTime)
+
+	for h := 0; h < 24; h++ {
+		// mm3 starts to place orders after the first half of the day.
+		if h == 12 {
+			suite.mmOrder(mm3, pair.Id, "0.02")
+		}
+		suite.scoreAt(startTime.Add(time.Duration(h)*time.Hour + 30*time.Minute))
+	}
+
+	score1, found := suite.keeper.GetScore(suite.ctx, pair.Id, mm1)
+	suite.Require().True(found)
+	suite.Require().EqualValues(1, score1.HourMetrics.Samples)
+	suite.Require().EqualValues(25, score1.DayMetrics.Samples)
+	suite.Require().EqualValues(23, score1.LiveHours)
+	score3, found := suite.keeper.GetScore(suite.ctx, pair.Id, mm3)
+	suite.Require().True(found)
+	suite.Require().EqualValues(11, score3.LiveHours)
+	suite.Require().EqualValues(13, score3.DayMetrics.Downtime)
+	suite.Require().True(score1.PointShare.GT(score3.PointShare))
+
+	// The incentive period ends.
+	suite.scoreAt(startTime.Add(types.Day))
+
+	state, _ = suite.keeper.GetScoringState(suite.ctx)
+	suite.Require().Equal(startTime.Add(types.Day), state.PeriodStartTime)
+	suite.Require().Len(suite.keeper.GetAllScores(suite.ctx), 3) // scores sampled in the new period
+
+	incentive1, found := suite.keeper.GetIncentive(suite.ctx, mm1)
+	suite.Require().True(found)
+	incentive2, found := suite.keeper.GetIncentive(suite.ctx, mm2)
+	suite.Require().True(found)
+	// mm3 didn't achieve a live day.
+	_, found = suite.keeper.GetIncentive(suite.ctx, mm3)
+	suite.Require().False(found)
+
+	// The whole period budget is distributed since the pair is the only one
+	// with incentive weight.
+	amt1, amt2 := incentive1.Claimable.AmountOf(denom3), incentive2.Claimable.AmountOf(denom3)
+	suite.Require().True(amt1.GT(amt2))
+	suite.Require().True(amt1.Add(amt2).LTE(sdk.NewInt(1_000_000)))
+	suite.Require().True(amt1.Add(amt2).GTE(sdk.NewInt(999_998)))
+	suite.Require().Equal(amt1.Add(amt2), suite.app.BankKeeper.GetBalance(
+		suite.ctx, types.ClaimableIncentiveReserveAcc, denom3).Amount)
+	// The rest of the incentive budget is kept for the other distributions.
+	params := suite.keeper.GetParams(suite.ctx)
+	suite.Require().Equal(sdk.NewInt(2_000_000).Sub(amt1).Sub(amt2), suite.app.BankKeeper.GetBalance(
+		suite.ctx, params.IncentiveBudgetAcc(), denom3).Amount)
+}
+
+func (suite *KeeperTestSuite) TestScoreMarketMakers_NotLive() {
+	common := types.DefaultCommon
+	common.MinHours = 1
+	common.MinDays = 1
+	pair := suite.setupScoring(common)
+
+	mm1, mm2 := suite.addrs[0], suite.addrs[1]
+	for _, mmAddr := range []sdk.AccAddress{mm1, mm2} {
+		suite.eligibleMarketMaker(mmAddr, pair.Id)
+	}
+	suite.mmOrder(mm1, pair.Id, "0.02")
+	suite.mmOrder(mm2, pair.Id, "0.1") // too wide spread
+
+	startTime := suite.ctx.BlockTime()
+	suite.keeper.ScoreMarketMakers(suite.ctx)
+	score2, _ := suite.keeper.GetScore(suite.ctx, pair.Id, mm2)
+	suite.Require().EqualValues(1, score2.HourMetrics.Samples)
+	suite.Require().EqualValues(0, score2.HourMetrics.LiveSamples)
+	suite.Require().True(score2.PointShare.IsZero())
+	score1, _ := suite.keeper.GetScore(suite.ctx, pair.Id, mm1)
+	suite.Require().Equal(sdk.OneDec(), score1.PointShare)
+
+	suite.scoreAt(startTime.Add(types.Day))
+
+	incentive1, found := suite.keeper.GetIncentive(suite.ctx, mm1)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denom3, 1_000_000)), incentive1.Claimable)
+	_, found = suite.keeper.GetIncentive(suite.ctx, mm2)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestScoreMarketMakers_Exclusion() {
+	pair := suite.setupScoring(types.DefaultCommon)
+
+	mmAddr := suite.addrs[0]
+	suite.eligibleMarketMaker(mmAddr, pair.Id)
+	suite.mmOrder(mmAddr, pair.Id, "0.02")
+	suite.keeper.ScoreMarketMakers(suite.ctx)
+	_, found := suite.keeper.GetScore(suite.ctx, pair.Id, mmAddr)
+	suite.Require().True(found)
+
+	suite.handleProposal(types.NewMarketMakerProposal("title", "description", nil,
+		[]types.MarketMakerHandle{{Address: mmAddr.String(), PairId: pair.Id}}, nil, nil, nil))
+	_, found = suite.keeper.GetScore(suite.ctx, pair.Id, mmAddr)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestScoreMarketMakers_Slashing() {
+	common := types.DefaultCommon
+	common.MaxTotalDowntime = 1
+	pair := suite.setupScoring(common)
+	params := suite.keeper.GetParams(suite.ctx)
+
+	mm1, mm2 := suite.addrs[0], suite.addrs[1]
+	for _, mmAddr := range []sdk.AccAddress{mm1, mm2} {
+		suite.Require().NoError(suite.keeper.ApplyMarketMaker(suite.ctx, mmAddr, []uint64{pair.Id}))
+	}
+	suite.handleProposal(types.NewMarketMakerProposal("title", "description", []types.MarketMakerHandle{
+		{Address: mm1.String(), PairId: pair.Id},
+		{Address: mm2.String(), PairId: pair.Id},
+	}, nil, nil, nil, nil))
+	suite.mmOrder(mm1, pair.Id, "0.02")
+	suite.mmOrder(mm2, pair.Id, "0.1") // too wide spread
+
+	startTime := suite.ctx.BlockTime()
+	budgetBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, params.IncentiveBudgetAcc())
+	suite.keeper.ScoreMarketMakers(suite.ctx)
+	suite.scoreAt(startTime.Add(30 * time.Minute))
+
+	// mm2's downtime exceeds MaxTotalDowntime in the hour.
+	suite.scoreAt(startTime.Add(time.Hour))
+
+	slashed := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100_000_000))
+	deposit, found := suite.keeper.GetDeposit(suite.ctx, mm2, pair.Id)
+	suite.Require().True(found)
+	suite.Require().Equal(params.DepositAmount.Sub(slashed), deposit.Amount)
+	suite.Require().Equal(budgetBefore.Add(slashed...), suite.app.BankKeeper.GetAllBalances(suite.ctx, params.IncentiveBudgetAcc()))
+	mm, _ := suite.keeper.GetMarketMaker(suite.ctx, mm2, pair.Id)
+	suite.Require().True(mm.OnProbation(suite.ctx.BlockTime()))
+	suite.Require().Equal(suite.ctx.BlockTime().Add(params.ProbationDuration), *mm.ProbationEndTime)
+
+	deposit, _ = suite.keeper.GetDeposit(suite.ctx, mm1, pair.Id)
+	suite.Require().Equal(params.DepositAmount, deposit.Amount)
+	mm, _ = suite.keeper.GetMarketMaker(suite.ctx, mm1, pair.Id)
+	suite.Require().Nil(mm.ProbationEndTime)
+
+	// mm2 is not slashed again while on probation.
+	suite.scoreAt(startTime.Add(time.Hour + 30*time.Minute))
+	suite.scoreAt(startTime.Add(2 * time.Hour))
+	deposit, _ = suite.keeper.GetDeposit(suite.ctx, mm2, pair.Id)
+	suite.Require().Equal(params.DepositAmount.Sub(slashed), deposit.Amount)
+}
+
+func (suite *KeeperTestSuite) TestScoreMarketMakers_SlashingOncePerDowntime() {
+	common := types.DefaultCommon
+	common.MaxTotalDowntime = 1
+	pair := suite.setupScoring(common)
+	params := suite.keeper.GetParams(suite.ctx)
+	params.ProbationDuration = 0
+	suite.keeper.SetParams(suite.ctx, params)
+
+	mmAddr := suite.addrs[0]
+	suite.Require().NoError(suite.keeper.ApplyMarketMaker(suite.ctx, mmAddr, []uint64{pair.Id}))
+	suite.handleProposal(types.NewMarketMakerProposal("title", "description", []types.MarketMakerHandle{
+		{Address: mmAddr.String(), PairId: pair.Id},
+	}, nil, nil, nil, nil))
+	suite.mmOrder(mmAddr, pair.Id, "0.1") // too wide spread
+
+	startTime := suite.ctx.BlockTime()
+	suite.keeper.ScoreMarketMakers(suite.ctx)
+	suite.scoreAt(startTime.Add(30 * time.Minute))
+	suite.scoreAt(startTime.Add(time.Hour))
+
+	slashed := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100_000_000))
+	deposit, _ := suite.keeper.GetDeposit(suite.ctx, mmAddr, pair.Id)
+	suite.Require().Equal(params.DepositAmount.Sub(slashed), deposit.Amount)
+
+	// The downtime continues in the next hour, but it is not slashed again
+	// even without probation.
+	suite.scoreAt(startTime.Add(time.Hour + 30*time.Minute))
+	suite.scoreAt(startTime.Add(2 * time.Hour))
+	deposit, _ = suite.keeper.GetDeposit(suite.ctx, mmAddr, pair.Id)
+	suite.Require().Equal(params.DepositAmount.Sub(slashed), deposit.Amount)
+
+	// The market maker recovers in the next hour as the max spread widens.
+	params.IncentivePairs[0].MaxSpread = sdk.MustNewDecFromStr("0.2")
+	suite.keeper.SetParams(suite.ctx, params)
+	suite.scoreAt(startTime.Add(2*time.Hour + 30*time.Minute))
+	suite.scoreAt(startTime.Add(3 * time.Hour))
+	score, _ := suite.keeper.GetScore(suite.ctx, pair.Id, mmAddr)
+	suite.Require().False(score.Down)
+
+	// A new downtime is slashed again.
+	params.IncentivePairs[0].MaxSpread = sdk.MustNewDecFromStr("0.05")
+	suite.keeper.SetParams(suite.ctx, params)
+	suite.scoreAt(startTime.Add(3*time.Hour + 30*time.Minute))
+	suite.scoreAt(startTime.Add(3*time.Hour + 45*time.Minute))
+	suite.scoreAt(startTime.Add(4 * time.Hour))
+	slashed = slashed.Add(sdk.NewInt64Coin(sdk.DefaultBondDenom, 90_000_000))
+	deposit, _ = suite.keeper.GetDeposit(suite.ctx, mmAddr, pair.Id)
+	suite.Require().Equal(params.DepositAmount.Sub(slashed), deposit.Amount)
+}
+
+func (suite *KeeperTestSuite) TestImportExportGenesis_Scores() {
+	pair := suite.setupScoring(types.DefaultCommon)
+
+	mmAddr := suite.addrs[0]
+	suite.eligibleMarketMaker(mmAddr, pair.Id)
+	suite.mmOrder(mmAddr, pair.Id, "0.02")
+	suite.keeper.ScoreMarketMakers(suite.ctx)
+	suite.scoreAt(suite.ctx.BlockTime().Add(time.Hour))
+
+	genState := suite.keeper.ExportGenesis(suite.ctx)
+	suite.Require().Len(genState.Scores, 1)
+	suite.Require().NotNil(genState.ScoringState)
+
+	var genState2 types.GenesisState
+	bz := suite.app.AppCodec().MustMarshalJSON(genState)
+	suite.app.AppCodec().MustUnmarshalJSON(bz, &genState2)
+	suite.keeper.InitGenesis(suite.ctx, genState2)
+
+	score, found := suite.keeper.GetScore(suite.ctx, pair.Id, mmAddr)
+	suite.Require().True(found)
+	suite.Require().EqualValues(1, score.LiveHours)
+	suite.Require().EqualValues(1, score.HourMetrics.Samples)
+	suite.Require().Equal(*genState, *suite.keeper.ExportGenesis(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestGRPCScores() {
+	pair := suite.setupScoring(types.DefaultCommon)
+
+	mm1, mm2 := suite.addrs[0], suite.addrs[1]
+	for _, mmAddr := range []sdk.AccAddress{mm1, mm2} {
+		suite.eligibleMarketMaker(mmAddr, pair.Id)
+	}
+	suite.keeper.ScoreMarketMakers(suite.ctx)
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QueryScoresRequest
+		expectErr bool
+		postRun   func(*types.QueryScoresResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"query all",
+			&types.QueryScoresRequest{},
+			false,
+			func(resp *types.QueryScoresResponse) {
+				suite.Require().Len(resp.Scores, 2)
+				suite.Require().NotNil(resp.ScoringState)
+				suite.Require().Equal(suite.ctx.BlockTime(), resp.ScoringState.PeriodStartTime)
+			},
+		},
+		{
+			"query by pair id",
+			&types.QueryScoresRequest{PairId: pair.Id},
+			false,
+			func(resp *types.QueryScoresResponse) {
+				suite.Require().Len(resp.Scores, 2)
+			},
+		},
+		{
+			"query by address",
+			&types.QueryScoresRequest{Address: mm2.String()},
+			false,
+			func(resp *types.QueryScoresResponse) {
+				suite.Require().Len(resp.Scores, 1)
+				suite.Require().Equal(mm2.String(), resp.Scores[0].Address)
+			},
+		},
+		{
+			"query by address and pair id",
+			&types.QueryScoresRequest{Address: mm1.String(), PairId: pair.Id},
+			false,
+			func(resp *types.QueryScoresResponse) {
+				suite.Require().Len(resp.Scores, 1)
+				suite.Require().Equal(mm1.String(), resp.Scores[0].Address)
+				suite.Require().EqualValues(1, resp.Scores[0].HourMetrics.Samples)
+			},
+		},
+		{
+			"invalid address",
+			&types.QueryScoresRequest{Address: "invalidaddr"},
+			true,
+			nil,
+		},
+	} {
+		suite.Run(tc.name, func() {
+			resp, err := suite.querier.Scores(sdk.WrapSDKContext(suite.ctx), tc.req)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	chain "github.com/cosmosquad-labs/squad/v3/app"
+	v2marketmaker "github.com/cosmosquad-labs/squad/v3/x/marketmaker/legacy/v2"
+	"github.com/cosmosquad-labs/squad/v3/x/marketmaker/types"
+)
+
+func TestMigrateParams(t *testing.T) {
+	encCfg := chain.MakeTestEncodingConfig()
+	paramsKey := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+
+	cms := store.NewCommitMultiStore(dbm.NewMemDB())
+	cms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, nil)
+	cms.MountStoreWithDB(paramsTKey, sdk.StoreTypeTransient, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+	ctx := sdk.NewContext(cms, tmproto.Header{}, false, log.NewNopLogger())
+
+	paramSpace := paramstypes.NewSubspace(
+		encCfg.Marshaler, encCfg.Amino, paramsKey, paramsTKey, types.ModuleName).WithKeyTable(types.ParamKeyTable())
+
+	// The params set before the migration are kept.
+	depositAmount := sdk.NewCoins(sdk.NewInt64Coin("stake", 5000000))
+	paramSpace.Set(ctx, types.KeyDepositAmount, depositAmount)
+
+	require.NoError(t, v2marketmaker.MigrateStore(ctx, paramSpace))
+
+	var depositAmount2 sdk.Coins
+	var incentivePeriodDays uint32
+	var incentivePeriodBudget sdk.Coins
+	paramSpace.Get(ctx, types.KeyDepositAmount, &depositAmount2)
+	paramSpace.Get(ctx, types.KeyIncentivePeriodDays, &incentivePeriodDays)
+	paramSpace.Get(ctx, types.KeyIncentivePeriodBudget, &incentivePeriodBudget)
+	require.Equal(t, depositAmount, depositAmount2)
+	require.Equal(t, types.DefaultIncentivePeriodDays, incentivePeriodDays)
+	require.Equal(t, types.DefaultIncentivePeriodBudget, incentivePeriodBudget)
+}
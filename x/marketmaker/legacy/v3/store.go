@@ -0,0 +1,52 @@
+package v3
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/cosmosquad-labs/squad/v3/x/marketmaker/types"
+)
+
+// MigrateParams sets the params added in v3 to their default values.
+func MigrateParams(ctx sdk.Context, paramSpace paramstypes.Subspace) {
+	paramSpace.Set(ctx, types.KeySlashFraction, types.DefaultSlashFraction)
+	paramSpace.Set(ctx, types.KeyProbationDuration, types.DefaultProbationDuration)
+	paramSpace.Set(ctx, types.KeyIncentiveVestingPeriod, types.DefaultIncentiveVestingPeriod)
+}
+
+// MigrateDeposits sets an empty deposit for the market makers which have been
+// included before v3, since their deposits were refunded on the inclusion.
+// Since v3, every market maker keeps its deposit until excluded or rejected.
+func MigrateDeposits(store sdk.KVStore, cdc codec.BinaryCodec) error {
+	iter := sdk.KVStorePrefixIterator(store, types.MarketMakerKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var mm types.MarketMaker
+		if err := cdc.Unmarshal(iter.Value(), &mm); err != nil {
+			return err
+		}
+		key := types.GetDepositKey(mm.GetAccAddress(), mm.PairId)
+		if store.Has(key) {
+			continue
+		}
+		deposit := types.Deposit{Amount: sdk.Coins{}}
+		bz, err := cdc.Marshal(&deposit)
+		if err != nil {
+			return err
+		}
+		store.Set(key, bz)
+	}
+
+	return nil
+}
+
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryCodec, paramSpace paramstypes.Subspace) error {
+	MigrateParams(ctx, paramSpace)
+	store := ctx.KVStore(storeKey)
+	if err := MigrateDeposits(store, cdc); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package v3_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	chain "github.com/cosmosquad-labs/squad/v3/app"
+	utils "github.com/cosmosquad-labs/squad/v3/types"
+	v3marketmaker "github.com/cosmosquad-labs/squad/v3/x/marketmaker/legacy/v3"
+	"github.com/cosmosquad-labs/squad/v3/x/marketmaker/types"
+)
+
+func TestMigrateParams(t *testing.T) {
+	encCfg := chain.MakeTestEncodingConfig()
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	paramsKey := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+
+	cms := store.NewCommitMultiStore(dbm.NewMemDB())
+	cms.MountStoreWithDB(storeKey, sdk.StoreTypeIAVL, nil)
+	cms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, nil)
+	cms.MountStoreWithDB(paramsTKey, sdk.StoreTypeTransient, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+	ctx := sdk.NewContext(cms, tmproto.Header{}, false, log.NewNopLogger())
+
+	paramSpace := paramstypes.NewSubspace(
+		encCfg.Marshaler, encCfg.Amino, paramsKey, paramsTKey, types.ModuleName).WithKeyTable(types.ParamKeyTable())
+
+	// The params set before the migration are kept.
+	paramSpace.Set(ctx, types.KeyIncentivePeriodDays, uint32(30))
+
+	require.NoError(t, v3marketmaker.MigrateStore(ctx, storeKey, encCfg.Marshaler, paramSpace))
+
+	var incentivePeriodDays uint32
+	var slashFraction sdk.Dec
+	var probationDuration time.Duration
+	var incentiveVestingPeriod time.Duration
+	paramSpace.Get(ctx, types.KeyIncentivePeriodDays, &incentivePeriodDays)
+	paramSpace.Get(ctx, types.KeySlashFraction, &slashFraction)
+	paramSpace.Get(ctx, types.KeyProbationDuration, &probationDuration)
+	paramSpace.Get(ctx, types.KeyIncentiveVestingPeriod, &incentiveVestingPeriod)
+	require.Equal(t, uint32(30), incentivePeriodDays)
+	require.Equal(t, types.DefaultSlashFraction, slashFraction)
+	require.Equal(t, types.DefaultProbationDuration, probationDuration)
+	require.Equal(t, types.DefaultIncentiveVestingPeriod, incentiveVestingPeriod)
+}
+
+func TestMigrateDeposits(t *testing.T) {
+	cdc := chain.MakeTestEncodingConfig().Marshaler
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(storeKey)
+
+	// mm1 was included before v3 so its deposit was refunded, while mm2 has
+	// applied and not been included yet.
+	mm1, mm2 := utils.TestAddress(0), utils.TestAddress(1)
+	for _, mm := range []types.MarketMaker{
+		{Address: mm1.String(), PairId: 1, Eligible: true},
+		{Address: mm2.String(), PairId: 1, Eligible: false},
+	} {
+		store.Set(types.GetMarketMakerKey(mm.GetAccAddress(), mm.PairId), cdc.MustMarshal(&mm))
+	}
+	deposit2 := types.Deposit{Amount: utils.ParseCoins("1000000000stake")}
+	store.Set(types.GetDepositKey(mm2, 1), cdc.MustMarshal(&deposit2))
+
+	require.NoError(t, v3marketmaker.MigrateDeposits(store, cdc))
+
+	var deposit types.Deposit
+	cdc.MustUnmarshal(store.Get(types.GetDepositKey(mm1, 1)), &deposit)
+	require.True(t, deposit.Amount.IsZero())
+	cdc.MustUnmarshal(store.Get(types.GetDepositKey(mm2, 1)), &deposit)
+	require.Equal(t, deposit2.Amount, deposit.Amount)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 
 	"github.com/cosmosquad-labs/squad/v3/x/marketmaker/types"
@@ -31,6 +32,27 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &iB)
 			return fmt.Sprintf("%v\n%v", iA, iB)
 
+		case bytes.Equal(kvA.Key[:1], types.ScoreKeyPrefix):
+			var sA, sB types.MarketMakerScore
+			cdc.MustUnmarshal(kvA.Value, &sA)
+			cdc.MustUnmarshal(kvB.Value, &sB)
+			return fmt.Sprintf("%v\n%v", sA, sB)
+
+		case bytes.Equal(kvA.Key[:1], types.ScoringStateKey):
+			var sA, sB types.ScoringState
+			cdc.MustUnmarshal(kvA.Value, &sA)
+			cdc.MustUnmarshal(kvB.Value, &sB)
+			return fmt.Sprintf("%v\n%v", sA, sB)
+
+		case bytes.Equal(kvA.Key[:1], types.IncentiveStreamKeyPrefix):
+			var sA, sB types.IncentiveStream
+			cdc.MustUnmarshal(kvA.Value, &sA)
+			cdc.MustUnmarshal(kvB.Value, &sB)
+			return fmt.Sprintf("%v\n%v", sA, sB)
+
+		case bytes.Equal(kvA.Key[:1], types.LastIncentiveStreamIdKey):
+			return fmt.Sprintf("%v\n%v", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
 		default:
 			panic(fmt.Sprintf("invalid marketmaker key prefix %X", kvA.Key[:1]))
 		}
@@ -20,12 +20,14 @@ func TestDecodeMarketMakerStore(t *testing.T) {
 	mm := types.MarketMaker{}
 	deposit := types.Deposit{}
 	incentive := types.Incentive{}
+	stream := types.IncentiveStream{}
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{Key: types.MarketMakerKeyPrefix, Value: cdc.MustMarshal(&mm)},
 			{Key: types.DepositKeyPrefix, Value: cdc.MustMarshal(&deposit)},
 			{Key: types.IncentiveKeyPrefix, Value: cdc.MustMarshal(&incentive)},
+			{Key: types.IncentiveStreamKeyPrefix, Value: cdc.MustMarshal(&stream)},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -37,6 +39,7 @@ func TestDecodeMarketMakerStore(t *testing.T) {
 		{"MarketMaker", fmt.Sprintf("%v\n%v", mm, mm)},
 		{"Deposit", fmt.Sprintf("%v\n%v", deposit, deposit)},
 		{"Incentive", fmt.Sprintf("%v\n%v", incentive, incentive)},
+		{"IncentiveStream", fmt.Sprintf("%v\n%v", stream, stream)},
 		{"other", ""},
 	}
 	for i, tt := range tests {
@@ -56,6 +56,7 @@ func SimulateMarketMakerProposal(bk types.BankKeeper, k keeper.Keeper) simtypes.
 		distributions := []types.IncentiveDistribution{}
 		exclusions := []types.MarketMakerHandle{}
 		rejections := []types.MarketMakerHandle{}
+		slashings := []types.MarketMakerHandle{}
 
 		mms := k.GetAllMarketMakers(ctx)
 		for _, mm := range mms {
@@ -86,6 +87,14 @@ func SimulateMarketMakerProposal(bk types.BankKeeper, k keeper.Keeper) simtypes.
 				spendableIncentives = spendableIncentives.Sub(incentive)
 			}
 
+			// slashing
+			if mm.Eligible && simtypes.RandIntBetween(r, 0, 7) == 1 {
+				slashings = append(slashings, types.MarketMakerHandle{
+					Address: mm.Address,
+					PairId:  mm.PairId,
+				})
+			}
+
 			// exclusion
 			if mm.Eligible && simtypes.RandIntBetween(r, 0, 7) == 1 {
 				exclusions = append(exclusions, types.MarketMakerHandle{
@@ -95,7 +104,8 @@ func SimulateMarketMakerProposal(bk types.BankKeeper, k keeper.Keeper) simtypes.
 			}
 		}
 
-		if len(inclusions) == 0 && len(exclusions) == 0 && len(rejections) == 0 && len(distributions) == 0 {
+		if len(inclusions) == 0 && len(exclusions) == 0 && len(rejections) == 0 &&
+			len(distributions) == 0 && len(slashings) == 0 {
 			return nil
 		}
 
@@ -106,6 +116,7 @@ func SimulateMarketMakerProposal(bk types.BankKeeper, k keeper.Keeper) simtypes.
 			exclusions,
 			rejections,
 			distributions,
+			slashings,
 		)
 		// force execute proposal to avoid waiting voting period
 		err = keeper.HandleMarketMakerProposal(ctx, k, proposal)
@@ -4,6 +4,7 @@ import (
 	"math/rand"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmosquad-labs/squad/v3/app/params"
@@ -73,6 +74,11 @@ func TestProposalContents(t *testing.T) {
 		Eligible: true,
 	})
 
+	// eligible market makers keep their deposits
+	for _, mm := range app.MarketMakerKeeper.GetAllMarketMakers(ctx) {
+		app.MarketMakerKeeper.SetDeposit(ctx, mm.GetAccAddress(), mm.PairId, sdk.Coins{})
+	}
+
 	// execute ProposalContents function
 	weightedProposalContent := simulation.ProposalContents(app.BankKeeper, app.MarketMakerKeeper)
 	require.Len(t, weightedProposalContent, 3)
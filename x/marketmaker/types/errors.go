@@ -16,4 +16,6 @@ var (
 	ErrInvalidExclusion        = sdkerrors.Register(ModuleName, 9, "invalid exclusion, not eligible")
 	ErrInvalidRejection        = sdkerrors.Register(ModuleName, 10, "invalid rejection, already eligible")
 	ErrNotEligibleMarketMaker  = sdkerrors.Register(ModuleName, 11, "invalid distribution, not eligible")
+	ErrMarketMakerOnProbation  = sdkerrors.Register(ModuleName, 12, "market maker on probation")
+	ErrInvalidSlashing         = sdkerrors.Register(ModuleName, 13, "invalid slashing, not eligible")
 )
@@ -8,12 +8,20 @@ const (
 	EventTypeExcludeMarketMaker   = "exclude_market_maker"
 	EventTypeRejectMarketMaker    = "reject_market_maker"
 	EventTypeDistributeIncentives = "distribute_incentives"
+	EventTypeScoreMarketMaker     = "score_market_maker"
+	EventTypeSlashMarketMaker     = "slash_market_maker"
+	EventTypeForfeitIncentives    = "forfeit_incentives"
 
-	AttributeKeyAddress         = "address"
-	AttributeKeyPairIds         = "pair_ids"
-	AttributeKeyPairId          = "pair_id"
-	AttributeKeyBudgetAddress   = "budget_address"
-	AttributeKeyTotalIncentives = "total_incentives"
+	AttributeKeyAddress          = "address"
+	AttributeKeyPairIds          = "pair_ids"
+	AttributeKeyPairId           = "pair_id"
+	AttributeKeyBudgetAddress    = "budget_address"
+	AttributeKeyTotalIncentives  = "total_incentives"
+	AttributeKeyTotalLiveHours   = "total_live_hours"
+	AttributeKeyLiveDays         = "live_days"
+	AttributeKeyScore            = "score"
+	AttributeKeySlashedAmount    = "slashed_amount"
+	AttributeKeyProbationEndTime = "probation_end_time"
 
 	AttributeValueCategory = ModuleName
 )
@@ -3,6 +3,8 @@ package types
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	liquiditytypes "github.com/cosmosquad-labs/squad/v3/x/liquidity/types"
 )
 
 // BankKeeper defines the expected bank send keeper
@@ -21,3 +23,10 @@ type AccountKeeper interface {
 	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
 	GetModuleAddress(name string) sdk.AccAddress
 }
+
+// LiquidityKeeper defines the expected liquidity keeper
+type LiquidityKeeper interface {
+	GetBatchSize(ctx sdk.Context) (batchSize uint32)
+	GetOrder(ctx sdk.Context, pairId, id uint64) (order liquiditytypes.Order, found bool)
+	GetMMOrderIndex(ctx sdk.Context, orderer sdk.AccAddress, pairId uint64) (index liquiditytypes.MMOrderIndex, found bool)
+}
@@ -7,12 +7,17 @@ import (
 // NewGenesisState returns new GenesisState.
 func NewGenesisState(
 	params Params, marketMakers []MarketMaker, incentives []Incentive, depositRecords []DepositRecord,
+	scores []MarketMakerScore, scoringState *ScoringState, incentiveStreams []IncentiveStream, lastIncentiveStreamId uint64,
 ) *GenesisState {
 	return &GenesisState{
-		Params:         params,
-		MarketMakers:   marketMakers,
-		Incentives:     incentives,
-		DepositRecords: depositRecords,
+		Params:                params,
+		MarketMakers:          marketMakers,
+		Incentives:            incentives,
+		DepositRecords:        depositRecords,
+		Scores:                scores,
+		ScoringState:          scoringState,
+		IncentiveStreams:      incentiveStreams,
+		LastIncentiveStreamId: lastIncentiveStreamId,
 	}
 }
 
@@ -23,6 +28,10 @@ func DefaultGenesisState() *GenesisState {
 		[]MarketMaker{},
 		[]Incentive{},
 		[]DepositRecord{},
+		[]MarketMakerScore{},
+		nil,
+		[]IncentiveStream{},
+		0,
 	)
 }
 
@@ -53,35 +62,67 @@ func ValidateGenesis(data GenesisState) error {
 	if err := ValidateDepositRecords(data.MarketMakers, data.DepositRecords); err != nil {
 		return err
 	}
+
+	scoreMap := map[string]struct{}{}
+	for _, score := range data.Scores {
+		if err := score.Validate(); err != nil {
+			return err
+		}
+		key := fmt.Sprintf("%s/%d", score.Address, score.PairId)
+		if _, ok := scoreMap[key]; ok {
+			return fmt.Errorf("duplicate market maker score: %s, %d", score.Address, score.PairId)
+		}
+		scoreMap[key] = struct{}{}
+	}
+
+	if data.ScoringState != nil {
+		if err := data.ScoringState.Validate(); err != nil {
+			return err
+		}
+	}
+
+	streamIdMap := map[uint64]struct{}{}
+	for _, stream := range data.IncentiveStreams {
+		if err := stream.Validate(); err != nil {
+			return err
+		}
+		if stream.Id > data.LastIncentiveStreamId {
+			return fmt.Errorf("incentive stream id must not be greater than the last incentive stream id: %d > %d",
+				stream.Id, data.LastIncentiveStreamId)
+		}
+		if _, ok := streamIdMap[stream.Id]; ok {
+			return fmt.Errorf("duplicate incentive stream id: %d", stream.Id)
+		}
+		streamIdMap[stream.Id] = struct{}{}
+	}
 	return nil
 }
 
 func ValidateDepositRecords(mms []MarketMaker, DepositRecords []DepositRecord) error {
-	// not eligible market maker must have deposit record
+	// market maker must have deposit record, since eligible market makers
+	// keep the rest of their deposits until excluded
 	for _, mm := range mms {
-		if !mm.Eligible {
-			found := false
-			for _, record := range DepositRecords {
-				if record.PairId == mm.PairId && record.Address == mm.Address {
-					found = true
-				}
-			}
-			if !found {
-				return fmt.Errorf("deposit invariant failed, not eligible market maker must have deposit record")
+		found := false
+		for _, record := range DepositRecords {
+			if record.PairId == mm.PairId && record.Address == mm.Address {
+				found = true
 			}
 		}
+		if !found {
+			return fmt.Errorf("deposit invariant failed, market maker must have deposit record")
+		}
 	}
 
-	// deposit record's market maker must not be eligible
+	// deposit record's market maker must exist
 	for _, record := range DepositRecords {
 		found := false
 		for _, mm := range mms {
-			if !mm.Eligible && record.PairId == mm.PairId && record.Address == mm.Address {
+			if record.PairId == mm.PairId && record.Address == mm.Address {
 				found = true
 			}
 		}
 		if !found {
-			return fmt.Errorf("deposit invariant failed, deposit record's market maker must not be eligible")
+			return fmt.Errorf("deposit invariant failed, deposit record's market maker must exist")
 		}
 	}
 	return nil
@@ -30,10 +30,14 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the marketmaker module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters for the marketmaker module
-	Params         Params          `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	MarketMakers   []MarketMaker   `protobuf:"bytes,2,rep,name=market_makers,json=marketMakers,proto3" json:"market_makers" yaml:"market_makers"`
-	Incentives     []Incentive     `protobuf:"bytes,3,rep,name=incentives,proto3" json:"incentives" yaml:"incentives"`
-	DepositRecords []DepositRecord `protobuf:"bytes,4,rep,name=deposit_records,json=depositRecords,proto3" json:"deposit_records" yaml:"deposit_records"`
+	Params                Params             `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	MarketMakers          []MarketMaker      `protobuf:"bytes,2,rep,name=market_makers,json=marketMakers,proto3" json:"market_makers" yaml:"market_makers"`
+	Incentives            []Incentive        `protobuf:"bytes,3,rep,name=incentives,proto3" json:"incentives" yaml:"incentives"`
+	DepositRecords        []DepositRecord    `protobuf:"bytes,4,rep,name=deposit_records,json=depositRecords,proto3" json:"deposit_records" yaml:"deposit_records"`
+	Scores                []MarketMakerScore `protobuf:"bytes,5,rep,name=scores,proto3" json:"scores" yaml:"scores"`
+	ScoringState          *ScoringState      `protobuf:"bytes,6,opt,name=scoring_state,json=scoringState,proto3" json:"scoring_state,omitempty" yaml:"scoring_state"`
+	IncentiveStreams      []IncentiveStream  `protobuf:"bytes,7,rep,name=incentive_streams,json=incentiveStreams,proto3" json:"incentive_streams" yaml:"incentive_streams"`
+	LastIncentiveStreamId uint64             `protobuf:"varint,8,opt,name=last_incentive_stream_id,json=lastIncentiveStreamId,proto3" json:"last_incentive_stream_id,omitempty" yaml:"last_incentive_stream_id"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,34 +82,42 @@ func init() {
 }
 
 var fileDescriptor_b3123f4b7efa9ae4 = []byte{
-	// 418 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x41, 0x8b, 0xd3, 0x40,
-	0x14, 0xc7, 0x13, 0xbb, 0x2c, 0x92, 0x5d, 0x15, 0xc3, 0x22, 0xc9, 0x22, 0x93, 0x35, 0x88, 0x16,
-	0xc4, 0x0c, 0xbb, 0x7a, 0xda, 0x8b, 0x10, 0x04, 0xf1, 0xb0, 0x20, 0xf1, 0xe6, 0x25, 0x4c, 0x92,
-	0x31, 0x0e, 0xdb, 0xc9, 0x64, 0xf3, 0xa6, 0xc5, 0x7e, 0x03, 0x8f, 0x1e, 0x3d, 0xf6, 0xe8, 0x47,
-	0xe9, 0xb1, 0x47, 0x4f, 0x45, 0xd2, 0x8b, 0x67, 0x3f, 0x81, 0x64, 0x66, 0xdc, 0xa6, 0x85, 0xf6,
-	0x36, 0xf3, 0xde, 0xef, 0xfd, 0xff, 0xff, 0x61, 0x9e, 0xf3, 0x1c, 0x6e, 0xc6, 0xa4, 0xc0, 0x9c,
-	0x34, 0xd7, 0x54, 0x72, 0x72, 0x4d, 0x1b, 0x3c, 0x39, 0xcf, 0xa8, 0x24, 0xe7, 0xb8, 0xa4, 0x15,
-	0x05, 0x06, 0x51, 0xdd, 0x08, 0x29, 0x5c, 0x5f, 0x81, 0x51, 0x0f, 0x8c, 0x0c, 0x78, 0xea, 0x97,
-	0x42, 0x94, 0x23, 0x8a, 0x15, 0x98, 0x8d, 0x3f, 0x63, 0x52, 0x4d, 0xf5, 0xd4, 0xe9, 0x49, 0x29,
-	0x4a, 0xa1, 0x8e, 0xb8, 0x3b, 0x99, 0xaa, 0x9f, 0x0b, 0xe0, 0x02, 0x52, 0xdd, 0xd0, 0x17, 0xd3,
-	0x42, 0xfa, 0x86, 0x33, 0x02, 0xf4, 0x36, 0x49, 0x2e, 0x58, 0x65, 0xfa, 0x2f, 0x76, 0xe7, 0xed,
-	0x47, 0xd3, 0x70, 0xb0, 0x1d, 0x4c, 0x32, 0x4e, 0x41, 0x12, 0x5e, 0x6b, 0x20, 0xfc, 0x31, 0x70,
-	0x8e, 0xdf, 0xe9, 0x67, 0x7e, 0x94, 0x44, 0x52, 0xf7, 0x8d, 0x73, 0x58, 0x93, 0x86, 0x70, 0xf0,
-	0xec, 0x33, 0x7b, 0x78, 0x74, 0xf1, 0x24, 0xda, 0xf9, 0xec, 0xe8, 0x83, 0x02, 0xe3, 0x83, 0xf9,
-	0x32, 0xb0, 0x12, 0x33, 0xe6, 0x32, 0xe7, 0x9e, 0x66, 0x53, 0x05, 0x83, 0x77, 0xe7, 0x6c, 0x30,
-	0x3c, 0xba, 0x78, 0xb6, 0x47, 0xe7, 0x4a, 0xd5, 0xae, 0xba, 0x5a, 0xfc, 0xb8, 0x13, 0xfb, 0xbb,
-	0x0c, 0x4e, 0xa6, 0x84, 0x8f, 0x2e, 0xc3, 0x0d, 0xa9, 0x30, 0x39, 0xe6, 0x6b, 0x14, 0xdc, 0xd4,
-	0x71, 0x58, 0x95, 0xd3, 0x4a, 0xb2, 0x09, 0x05, 0x6f, 0xa0, 0x7c, 0x9e, 0xee, 0xf1, 0x79, 0xff,
-	0x1f, 0x8e, 0x7d, 0xe3, 0xf2, 0x50, 0xbb, 0xac, 0x55, 0xc2, 0xa4, 0x27, 0xe9, 0xde, 0x38, 0x0f,
-	0x0a, 0x5a, 0x0b, 0x60, 0x32, 0x6d, 0x68, 0x2e, 0x9a, 0x02, 0xbc, 0x03, 0xe5, 0x32, 0xdc, 0xe3,
-	0xf2, 0x56, 0x4f, 0x24, 0x6a, 0x20, 0x46, 0xc6, 0xe9, 0x91, 0x76, 0xda, 0x92, 0x0b, 0x93, 0xfb,
-	0x45, 0x1f, 0x87, 0xcb, 0xbb, 0xdf, 0x66, 0x81, 0xf5, 0x67, 0x16, 0x58, 0x71, 0xf2, 0xb3, 0x45,
-	0xf6, 0xbc, 0x45, 0xf6, 0xa2, 0x45, 0xf6, 0xef, 0x16, 0xd9, 0xdf, 0x57, 0xc8, 0x5a, 0xac, 0x90,
-	0xf5, 0x6b, 0x85, 0xac, 0x4f, 0xaf, 0x4b, 0x26, 0xbf, 0x8c, 0xb3, 0x28, 0x17, 0xdc, 0xec, 0x4f,
-	0x17, 0xe8, 0xe5, 0x88, 0x64, 0x80, 0xf5, 0x86, 0x7c, 0xdd, 0xd8, 0x11, 0x39, 0xad, 0x29, 0x64,
-	0x87, 0xea, 0xd7, 0x5f, 0xfd, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x65, 0x3e, 0x39, 0xbf, 0xf5, 0x02,
-	0x00, 0x00,
+	// 553 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0x6d, 0x1a, 0x42, 0xb5, 0x4d, 0x80, 0x5a, 0x2d, 0x72, 0x2a, 0x64, 0x07, 0x83, 0x68,
+	0x44, 0x85, 0xad, 0x16, 0x4e, 0xbd, 0x20, 0x59, 0x48, 0xa8, 0x87, 0x4a, 0x68, 0x73, 0xab, 0x90,
+	0xac, 0xb5, 0xbd, 0x35, 0xab, 0xc6, 0xde, 0xd4, 0xb3, 0xa9, 0xc8, 0x1b, 0x70, 0xe4, 0x11, 0x7a,
+	0xe4, 0x05, 0x78, 0x87, 0x1e, 0x7b, 0xe4, 0x14, 0xa1, 0xe4, 0xc2, 0xb9, 0x4f, 0x50, 0x79, 0x77,
+	0xdb, 0xfc, 0x91, 0x12, 0xf5, 0xb6, 0xde, 0xf9, 0xcd, 0xf7, 0xcd, 0x78, 0x76, 0xd0, 0x2e, 0x9c,
+	0x0f, 0x48, 0x1a, 0xe4, 0xa4, 0x3c, 0xa3, 0x22, 0x27, 0x67, 0xb4, 0x0c, 0x2e, 0xf6, 0x63, 0x2a,
+	0xc8, 0x7e, 0x90, 0xd1, 0x82, 0x02, 0x03, 0xbf, 0x5f, 0x72, 0xc1, 0xad, 0x96, 0x04, 0xfd, 0x19,
+	0xd0, 0xd7, 0xe0, 0x4e, 0x2b, 0xe3, 0x3c, 0xeb, 0xd1, 0x40, 0x82, 0xf1, 0xe0, 0x34, 0x20, 0xc5,
+	0x50, 0x65, 0xed, 0x6c, 0x65, 0x3c, 0xe3, 0xf2, 0x18, 0x54, 0x27, 0x7d, 0xdb, 0x4a, 0x38, 0xe4,
+	0x1c, 0x22, 0x15, 0x50, 0x1f, 0x3a, 0xe4, 0xa8, 0xaf, 0x20, 0x26, 0x40, 0xef, 0x2b, 0x49, 0x38,
+	0x2b, 0x74, 0x7c, 0x6f, 0x79, 0xbd, 0xb3, 0xa5, 0x29, 0xd8, 0x5d, 0x2c, 0x4c, 0xb0, 0x9c, 0x82,
+	0x20, 0x79, 0x5f, 0x01, 0xde, 0x9f, 0x3a, 0x6a, 0x7c, 0x51, 0x6d, 0x76, 0x05, 0x11, 0xd4, 0xfa,
+	0x84, 0xea, 0x7d, 0x52, 0x92, 0x1c, 0x6c, 0xb3, 0x6d, 0x76, 0x36, 0x0e, 0x5e, 0xf9, 0x4b, 0xdb,
+	0xf6, 0xbf, 0x4a, 0x30, 0xac, 0x5d, 0x8d, 0x5c, 0x03, 0xeb, 0x34, 0x8b, 0xa1, 0xa6, 0x62, 0x23,
+	0x09, 0x83, 0xfd, 0xa8, 0xbd, 0xd6, 0xd9, 0x38, 0x78, 0xbb, 0x42, 0xe7, 0x58, 0xde, 0x1d, 0x57,
+	0x77, 0xe1, 0xcb, 0x4a, 0xec, 0x66, 0xe4, 0x6e, 0x0d, 0x49, 0xde, 0x3b, 0xf4, 0xe6, 0xa4, 0x3c,
+	0xdc, 0xc8, 0xa7, 0x28, 0x58, 0x11, 0x42, 0xac, 0x48, 0x68, 0x21, 0xd8, 0x05, 0x05, 0x7b, 0x4d,
+	0xfa, 0xbc, 0x59, 0xe1, 0x73, 0x74, 0x07, 0x87, 0x2d, 0xed, 0xb2, 0xa9, 0x5c, 0xa6, 0x2a, 0x1e,
+	0x9e, 0x91, 0xb4, 0xce, 0xd1, 0xb3, 0x94, 0xf6, 0x39, 0x30, 0x11, 0x95, 0x34, 0xe1, 0x65, 0x0a,
+	0x76, 0x4d, 0xba, 0x74, 0x56, 0xb8, 0x7c, 0x56, 0x19, 0x58, 0x26, 0x84, 0x8e, 0x76, 0x7a, 0xa1,
+	0x9c, 0x16, 0xe4, 0x3c, 0xfc, 0x34, 0x9d, 0xc5, 0xc1, 0x3a, 0x41, 0x75, 0x48, 0x78, 0x49, 0xc1,
+	0x7e, 0x2c, 0x9d, 0xf6, 0x1e, 0xf6, 0xdf, 0xba, 0x55, 0x4e, 0xb8, 0xad, 0xcd, 0x9a, 0xca, 0x4c,
+	0x09, 0x79, 0x58, 0x2b, 0x5a, 0xa7, 0xa8, 0x59, 0x9d, 0x58, 0x91, 0x45, 0x50, 0x0d, 0xdb, 0xae,
+	0xcb, 0x11, 0xef, 0xae, 0xb0, 0xe8, 0x2a, 0x5e, 0xbe, 0x8d, 0xd0, 0x9e, 0xce, 0x65, 0x4e, 0xc7,
+	0xc3, 0x0d, 0x98, 0xe1, 0xac, 0x21, 0xda, 0xbc, 0xff, 0x89, 0x11, 0x88, 0x92, 0x56, 0xcf, 0xe9,
+	0x89, 0x6c, 0xe7, 0xdd, 0x43, 0xc6, 0xd3, 0x95, 0x29, 0x61, 0x5b, 0x77, 0x63, 0x2f, 0x0c, 0xe9,
+	0x4e, 0xd2, 0xc3, 0xcf, 0xd9, 0x7c, 0x0a, 0x58, 0xdf, 0x90, 0xdd, 0x23, 0x20, 0xa2, 0x45, 0x38,
+	0x62, 0xa9, 0xbd, 0xde, 0x36, 0x3b, 0xb5, 0xf0, 0xf5, 0xcd, 0xc8, 0x75, 0x95, 0xe2, 0x32, 0xd2,
+	0xc3, 0xdb, 0x55, 0x68, 0xa1, 0x9e, 0xa3, 0xf4, 0x70, 0xfd, 0xe7, 0xa5, 0x6b, 0xfc, 0xbf, 0x74,
+	0x8d, 0x10, 0xff, 0x1e, 0x3b, 0xe6, 0xd5, 0xd8, 0x31, 0xaf, 0xc7, 0x8e, 0xf9, 0x6f, 0xec, 0x98,
+	0xbf, 0x26, 0x8e, 0x71, 0x3d, 0x71, 0x8c, 0xbf, 0x13, 0xc7, 0x38, 0xf9, 0x98, 0x31, 0xf1, 0x7d,
+	0x10, 0xfb, 0x09, 0xcf, 0xf5, 0x72, 0x57, 0x4d, 0xbf, 0xef, 0x91, 0x18, 0x02, 0xb5, 0xbe, 0x3f,
+	0xe6, 0x16, 0x58, 0x0c, 0xfb, 0x14, 0xe2, 0xba, 0x5c, 0xc9, 0x0f, 0xb7, 0x01, 0x00, 0x00, 0xff,
+	0xff, 0x54, 0xed, 0x78, 0x5e, 0x92, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -128,6 +140,51 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastIncentiveStreamId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastIncentiveStreamId))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.IncentiveStreams) > 0 {
+		for iNdEx := len(m.IncentiveStreams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IncentiveStreams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.ScoringState != nil {
+		{
+			size, err := m.ScoringState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Scores) > 0 {
+		for iNdEx := len(m.Scores) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Scores[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.DepositRecords) > 0 {
 		for iNdEx := len(m.DepositRecords) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -220,6 +277,25 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Scores) > 0 {
+		for _, e := range m.Scores {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.ScoringState != nil {
+		l = m.ScoringState.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.IncentiveStreams) > 0 {
+		for _, e := range m.IncentiveStreams {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastIncentiveStreamId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastIncentiveStreamId))
+	}
 	return n
 }
 
@@ -393,6 +469,129 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scores", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scores = append(m.Scores, MarketMakerScore{})
+			if err := m.Scores[len(m.Scores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScoringState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScoringState == nil {
+				m.ScoringState = &ScoringState{}
+			}
+			if err := m.ScoringState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentiveStreams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IncentiveStreams = append(m.IncentiveStreams, IncentiveStream{})
+			if err := m.IncentiveStreams[len(m.IncentiveStreams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastIncentiveStreamId", wireType)
+			}
+			m.LastIncentiveStreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastIncentiveStreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
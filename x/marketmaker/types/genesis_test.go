@@ -1,12 +1,14 @@
 package types_test
 
 import (
+	"fmt"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/crypto"
 
+	utils "github.com/cosmosquad-labs/squad/v3/types"
 	"github.com/cosmosquad-labs/squad/v3/x/marketmaker/types"
 )
 
@@ -111,7 +113,20 @@ func TestValidateGenesis(t *testing.T) {
 					},
 				}
 			},
-			"deposit invariant failed, not eligible market maker must have deposit record",
+			"deposit invariant failed, market maker must have deposit record",
+		},
+		{
+			"deposit record invariant fail 3",
+			func(genState *types.GenesisState) {
+				genState.MarketMakers = []types.MarketMaker{
+					{
+						Address:  mmAddr.String(),
+						PairId:   1,
+						Eligible: true,
+					},
+				}
+			},
+			"deposit invariant failed, market maker must have deposit record",
 		},
 		{
 			"deposit record invariant fail 2",
@@ -124,7 +139,100 @@ func TestValidateGenesis(t *testing.T) {
 					},
 				}
 			},
-			"deposit invariant failed, deposit record's market maker must not be eligible",
+			"deposit invariant failed, deposit record's market maker must exist",
+		},
+		{
+			"eligible market maker with deposit record",
+			func(genState *types.GenesisState) {
+				genState.MarketMakers = []types.MarketMaker{
+					{
+						Address:  mmAddr.String(),
+						PairId:   1,
+						Eligible: true,
+					},
+				}
+				genState.DepositRecords = []types.DepositRecord{
+					{
+						Address: mmAddr.String(),
+						PairId:  1,
+						Amount:  sdk.Coins{},
+					},
+				}
+			},
+			"",
+		},
+		{
+			"valid scores",
+			func(genState *types.GenesisState) {
+				genState.Scores = []types.MarketMakerScore{
+					types.NewMarketMakerScore(mmAddr, 1),
+					types.NewMarketMakerScore(mmAddr, 2),
+				}
+			},
+			"",
+		},
+		{
+			"duplicate scores",
+			func(genState *types.GenesisState) {
+				genState.Scores = []types.MarketMakerScore{
+					types.NewMarketMakerScore(mmAddr, 1),
+					types.NewMarketMakerScore(mmAddr, 1),
+				}
+			},
+			fmt.Sprintf("duplicate market maker score: %s, 1", mmAddr),
+		},
+		{
+			"invalid score",
+			func(genState *types.GenesisState) {
+				score := types.NewMarketMakerScore(mmAddr, 1)
+				score.PointShare = sdk.NewDec(-1)
+				genState.Scores = []types.MarketMakerScore{score}
+			},
+			"point share must not be negative: -1.000000000000000000",
+		},
+		{
+			"invalid scoring state",
+			func(genState *types.GenesisState) {
+				state := types.NewScoringState(
+					utils.ParseTime("2023-01-02T00:00:00Z"), utils.ParseTime("2023-01-01T00:00:00Z"))
+				genState.ScoringState = &state
+			},
+			"hour start time must not be before period start time: 2023-01-01 00:00:00 +0000 UTC < 2023-01-02 00:00:00 +0000 UTC",
+		},
+		{
+			"valid incentive streams",
+			func(genState *types.GenesisState) {
+				startTime := utils.ParseTime("2023-01-01T00:00:00Z")
+				genState.IncentiveStreams = []types.IncentiveStream{
+					types.NewIncentiveStream(1, mmAddr, 1, utils.ParseCoins("1000stake"), startTime, types.Day),
+					types.NewIncentiveStream(2, mmAddr, 2, utils.ParseCoins("1000stake"), startTime, types.Day),
+				}
+				genState.LastIncentiveStreamId = 2
+			},
+			"",
+		},
+		{
+			"incentive stream id greater than last id",
+			func(genState *types.GenesisState) {
+				startTime := utils.ParseTime("2023-01-01T00:00:00Z")
+				genState.IncentiveStreams = []types.IncentiveStream{
+					types.NewIncentiveStream(2, mmAddr, 1, utils.ParseCoins("1000stake"), startTime, types.Day),
+				}
+				genState.LastIncentiveStreamId = 1
+			},
+			"incentive stream id must not be greater than the last incentive stream id: 2 > 1",
+		},
+		{
+			"duplicate incentive stream id",
+			func(genState *types.GenesisState) {
+				startTime := utils.ParseTime("2023-01-01T00:00:00Z")
+				genState.IncentiveStreams = []types.IncentiveStream{
+					types.NewIncentiveStream(1, mmAddr, 1, utils.ParseCoins("1000stake"), startTime, types.Day),
+					types.NewIncentiveStream(1, mmAddr, 2, utils.ParseCoins("1000stake"), startTime, types.Day),
+				}
+				genState.LastIncentiveStreamId = 1
+			},
+			"duplicate incentive stream id: 1",
 		},
 	}
 	for _, tc := range testCases {
@@ -0,0 +1,85 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewIncentiveStream returns a new IncentiveStream which unlocks the amount
+// linearly from the start time for the vesting period.
+func NewIncentiveStream(
+	id uint64, mmAddr sdk.AccAddress, pairId uint64, amt sdk.Coins, startTime time.Time, vestingPeriod time.Duration,
+) IncentiveStream {
+	return IncentiveStream{
+		Id:        id,
+		Address:   mmAddr.String(),
+		PairId:    pairId,
+		Amount:    amt,
+		Claimed:   sdk.Coins{},
+		StartTime: startTime,
+		EndTime:   startTime.Add(vestingPeriod),
+	}
+}
+
+func (stream IncentiveStream) GetAccAddress() sdk.AccAddress {
+	return GetAccAddress(stream.Address)
+}
+
+// Vested returns the amount of the stream unlocked at the given time,
+// including the claimed amount.
+func (stream IncentiveStream) Vested(t time.Time) sdk.Coins {
+	if !t.Before(stream.EndTime) {
+		return stream.Amount
+	}
+	if !t.After(stream.StartTime) {
+		return sdk.Coins{}
+	}
+	ratio := sdk.NewDec(int64(t.Sub(stream.StartTime))).QuoInt64(int64(stream.EndTime.Sub(stream.StartTime)))
+	vested, _ := sdk.NewDecCoinsFromCoins(stream.Amount...).MulDecTruncate(ratio).TruncateDecimal()
+	return vested
+}
+
+// Unvested returns the amount of the stream not unlocked yet at the given time.
+func (stream IncentiveStream) Unvested(t time.Time) sdk.Coins {
+	return stream.Amount.Sub(stream.Vested(t))
+}
+
+// Claimable returns the amount of the stream unlocked but not claimed yet
+// at the given time.
+func (stream IncentiveStream) Claimable(t time.Time) sdk.Coins {
+	claimable, _ := stream.Vested(t).SafeSub(stream.Claimed)
+	return claimable
+}
+
+// IsCompleted returns whether the whole amount of the stream has been claimed.
+func (stream IncentiveStream) IsCompleted() bool {
+	return stream.Amount.IsAllLTE(stream.Claimed)
+}
+
+// Validate validates IncentiveStream.
+func (stream IncentiveStream) Validate() error {
+	if stream.Id == 0 {
+		return fmt.Errorf("incentive stream id must not be 0")
+	}
+	if err := ValidateMarketMaker(stream.Address, stream.PairId); err != nil {
+		return err
+	}
+	if err := stream.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid amount: %w", err)
+	}
+	if stream.Amount.IsZero() {
+		return fmt.Errorf("amount must not be zero")
+	}
+	if err := stream.Claimed.Validate(); err != nil {
+		return fmt.Errorf("invalid claimed amount: %w", err)
+	}
+	if !stream.Claimed.IsAllLTE(stream.Amount) {
+		return fmt.Errorf("claimed amount must not be greater than amount: %s > %s", stream.Claimed, stream.Amount)
+	}
+	if stream.EndTime.Before(stream.StartTime) {
+		return fmt.Errorf("end time must not be before start time: %s < %s", stream.EndTime, stream.StartTime)
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+
+	utils "github.com/cosmosquad-labs/squad/v3/types"
+	"github.com/cosmosquad-labs/squad/v3/x/marketmaker/types"
+)
+
+func TestIncentiveStream(t *testing.T) {
+	mmAddr := sdk.AccAddress(crypto.AddressHash([]byte("mm1")))
+	startTime := utils.ParseTime("2023-01-01T00:00:00Z")
+	stream := types.NewIncentiveStream(
+		1, mmAddr, 1, utils.ParseCoins("1000denom1,300denom2"), startTime, 10*types.Day)
+	require.Equal(t, startTime.Add(10*types.Day), stream.EndTime)
+
+	for _, tc := range []struct {
+		t        time.Time
+		vested   string
+		unvested string
+	}{
+		{startTime.Add(-time.Hour), "", "1000denom1,300denom2"},
+		{startTime, "", "1000denom1,300denom2"},
+		{startTime.Add(types.Day), "100denom1,30denom2", "900denom1,270denom2"},
+		{startTime.Add(time.Hour), "4denom1,1denom2", "996denom1,299denom2"},
+		{startTime.Add(10 * types.Day), "1000denom1,300denom2", ""},
+		{startTime.Add(20 * types.Day), "1000denom1,300denom2", ""},
+	} {
+		require.Equal(t, utils.ParseCoins(tc.vested).String(), stream.Vested(tc.t).String(), tc.t)
+		require.Equal(t, utils.ParseCoins(tc.unvested).String(), stream.Unvested(tc.t).String(), tc.t)
+	}
+
+	stream.Claimed = utils.ParseCoins("100denom1,30denom2")
+	require.Equal(t, utils.ParseCoins("100denom1,30denom2"), stream.Claimable(startTime.Add(2*types.Day)))
+	require.True(t, stream.Claimable(startTime.Add(types.Day)).IsZero())
+	require.False(t, stream.IsCompleted())
+
+	stream.Claimed = stream.Amount
+	require.True(t, stream.IsCompleted())
+
+	// A stream without vesting period vests immediately.
+	stream = types.NewIncentiveStream(1, mmAddr, 1, utils.ParseCoins("1000denom1"), startTime, 0)
+	require.Equal(t, utils.ParseCoins("1000denom1"), stream.Vested(startTime))
+}
+
+func TestIncentiveStream_Validate(t *testing.T) {
+	mmAddr := sdk.AccAddress(crypto.AddressHash([]byte("mm1")))
+	startTime := utils.ParseTime("2023-01-01T00:00:00Z")
+
+	for _, tc := range []struct {
+		name        string
+		malleate    func(stream *types.IncentiveStream)
+		expectedErr string
+	}{
+		{
+			"happy case",
+			func(stream *types.IncentiveStream) {},
+			"",
+		},
+		{
+			"zero id",
+			func(stream *types.IncentiveStream) {
+				stream.Id = 0
+			},
+			"incentive stream id must not be 0",
+		},
+		{
+			"zero pair id",
+			func(stream *types.IncentiveStream) {
+				stream.PairId = 0
+			},
+			"invalid pair id",
+		},
+		{
+			"zero amount",
+			func(stream *types.IncentiveStream) {
+				stream.Amount = sdk.Coins{}
+			},
+			"amount must not be zero",
+		},
+		{
+			"too much claimed",
+			func(stream *types.IncentiveStream) {
+				stream.Claimed = utils.ParseCoins("1001denom1")
+			},
+			"claimed amount must not be greater than amount: 1001denom1 > 1000denom1",
+		},
+		{
+			"end time before start time",
+			func(stream *types.IncentiveStream) {
+				stream.EndTime = startTime.Add(-time.Second)
+			},
+			"end time must not be before start time: 2022-12-31 23:59:59 +0000 UTC < 2023-01-01 00:00:00 +0000 UTC",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			stream := types.NewIncentiveStream(1, mmAddr, 1, utils.ParseCoins("1000denom1"), startTime, types.Day)
+			tc.malleate(&stream)
+			err := stream.Validate()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
@@ -28,6 +28,12 @@ var (
 	DepositKeyPrefix                  = []byte{0xc2}
 
 	IncentiveKeyPrefix = []byte{0xc5}
+
+	ScoreKeyPrefix  = []byte{0xc6}
+	ScoringStateKey = []byte{0xc7}
+
+	IncentiveStreamKeyPrefix = []byte{0xc8}
+	LastIncentiveStreamIdKey = []byte{0xc9}
 )
 
 // GetMarketMakerKey returns a key for a market maker record.
@@ -50,6 +56,28 @@ func GetIncentiveKey(mmAddr sdk.AccAddress) []byte {
 	return append(IncentiveKeyPrefix, mmAddr...)
 }
 
+// GetIncentiveStreamKey returns kv indexing key of the incentive stream.
+func GetIncentiveStreamKey(mmAddr sdk.AccAddress, streamId uint64) []byte {
+	return append(append(IncentiveStreamKeyPrefix, address.MustLengthPrefix(mmAddr)...), sdk.Uint64ToBigEndian(streamId)...)
+}
+
+// GetIncentiveStreamsByAddrPrefix returns a key prefix used to iterate
+// incentive streams by a market maker address.
+func GetIncentiveStreamsByAddrPrefix(mmAddr sdk.AccAddress) []byte {
+	return append(IncentiveStreamKeyPrefix, address.MustLengthPrefix(mmAddr)...)
+}
+
+// GetScoreKey returns kv indexing key of the market maker score.
+func GetScoreKey(pairId uint64, mmAddr sdk.AccAddress) []byte {
+	return append(append(ScoreKeyPrefix, sdk.Uint64ToBigEndian(pairId)...), address.MustLengthPrefix(mmAddr)...)
+}
+
+// GetScoresByPairIdPrefix returns a key prefix used to iterate
+// market maker scores by a pair id.
+func GetScoresByPairIdPrefix(pairId uint64) []byte {
+	return append(ScoreKeyPrefix, sdk.Uint64ToBigEndian(pairId)...)
+}
+
 // GetMarketMakerByAddrPrefix returns a key prefix used to iterate
 // market makers by a address.
 func GetMarketMakerByAddrPrefix(mmAddr sdk.AccAddress) []byte {
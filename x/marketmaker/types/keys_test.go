@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"bytes"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -127,3 +128,14 @@ func (s *keysTestSuite) TestGetDepositKey() {
 		s.Require().Equal(tc.mmAddr, mmAddr)
 	}
 }
+
+func (s *keysTestSuite) TestGetScoreKey() {
+	s.Require().Equal([]byte{0xc6, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1, 0x14, 0xa6, 0xad, 0xc1, 0x49, 0x46, 0xc1, 0x1b, 0x25, 0x83, 0x23, 0xb4, 0x32, 0x29, 0x8e, 0xe2, 0xd8, 0x95, 0x3b, 0xee, 0xa}, types.GetScoreKey(1, addr1))
+	s.Require().True(bytes.HasPrefix(types.GetScoreKey(1, addr1), types.GetScoresByPairIdPrefix(1)))
+	s.Require().False(bytes.HasPrefix(types.GetScoreKey(2, addr1), types.GetScoresByPairIdPrefix(1)))
+}
+
+func (s *keysTestSuite) TestGetIncentiveStreamKey() {
+	s.Require().Equal([]byte{0xc8, 0x14, 0xa6, 0xad, 0xc1, 0x49, 0x46, 0xc1, 0x1b, 0x25, 0x83, 0x23, 0xb4, 0x32, 0x29, 0x8e, 0xe2, 0xd8, 0x95, 0x3b, 0xee, 0xa, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1}, types.GetIncentiveStreamKey(addr1, 1))
+	s.Require().True(bytes.HasPrefix(types.GetIncentiveStreamKey(addr1, 1), types.GetIncentiveStreamsByAddrPrefix(addr1)))
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -26,6 +27,11 @@ func (mm MarketMaker) Validate() error {
 	return ValidateMarketMaker(mm.Address, mm.PairId)
 }
 
+// OnProbation returns whether the market maker is on probation at the given time.
+func (mm MarketMaker) OnProbation(t time.Time) bool {
+	return mm.ProbationEndTime != nil && t.Before(*mm.ProbationEndTime)
+}
+
 func (i Incentive) GetAccAddress() sdk.AccAddress {
 	return GetAccAddress(i.Address)
 }
@@ -11,6 +11,7 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/regen-network/cosmos-proto"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -41,6 +42,21 @@ type Params struct {
 	Common Common `protobuf:"bytes,3,opt,name=common,proto3" json:"common" yaml:"common"`
 	// Include the pairs that are incentive target pairs and the variables used in market maker scoring system
 	IncentivePairs []IncentivePair `protobuf:"bytes,4,rep,name=incentive_pairs,json=incentivePairs,proto3" json:"incentive_pairs" yaml:"incentive_pairs"`
+	// incentive_period_days is the number of days in an incentive period over which market makers are
+	// scored on-chain. Zero disables on-chain scoring.
+	IncentivePeriodDays uint32 `protobuf:"varint,5,opt,name=incentive_period_days,json=incentivePeriodDays,proto3" json:"incentive_period_days,omitempty" yaml:"incentive_period_days"`
+	// slash_fraction is the fraction of a market maker's deposit to be slashed to the incentive budget
+	// when the market maker violates the rules
+	SlashFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=slash_fraction,json=slashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction" yaml:"slash_fraction"`
+	// probation_duration is the duration of the probation of a slashed market maker, during which
+	// the market maker can't claim incentives
+	ProbationDuration time.Duration `protobuf:"bytes,7,opt,name=probation_duration,json=probationDuration,proto3,stdduration" json:"probation_duration" yaml:"probation_duration"`
+	// incentive_vesting_period is the period over which distributed incentives unlock linearly,
+	// zero makes distributed incentives claimable immediately
+	IncentiveVestingPeriod time.Duration `protobuf:"bytes,8,opt,name=incentive_vesting_period,json=incentiveVestingPeriod,proto3,stdduration" json:"incentive_vesting_period" yaml:"incentive_vesting_period"`
+	// incentive_period_budget is the maximum amount of the incentive budget distributed by the on-chain
+	// scoring at the end of each incentive period, the rest of the budget is kept for other distributions
+	IncentivePeriodBudget github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=incentive_period_budget,json=incentivePeriodBudget,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"incentive_period_budget" yaml:"incentive_period_budget"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -217,6 +233,8 @@ type MarketMaker struct {
 	Address  string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
 	PairId   uint64 `protobuf:"varint,2,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty" yaml:"pair_id"`
 	Eligible bool   `protobuf:"varint,3,opt,name=eligible,proto3" json:"eligible,omitempty" yaml:"eligible"`
+	// probation_end_time is the time until which the market maker is on probation
+	ProbationEndTime *time.Time `protobuf:"bytes,4,opt,name=probation_end_time,json=probationEndTime,proto3,stdtime" json:"probation_end_time,omitempty" yaml:"probation_end_time"`
 }
 
 func (m *MarketMaker) Reset()         { *m = MarketMaker{} }
@@ -329,6 +347,201 @@ func (m *DepositRecord) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DepositRecord proto.InternalMessageInfo
 
+// MarketMakerMetrics defines the measures of market maker orders accumulated over an hour or a day.
+type MarketMakerMetrics struct {
+	// samples is the number of batches in which the orders were sampled
+	Samples uint32 `protobuf:"varint,1,opt,name=samples,proto3" json:"samples,omitempty" yaml:"samples"`
+	// live_samples is the number of samples satisfying the order requirements
+	LiveSamples uint32 `protobuf:"varint,2,opt,name=live_samples,json=liveSamples,proto3" json:"live_samples,omitempty" yaml:"live_samples"`
+	// downtime is the total number of blocks without valid orders
+	Downtime uint32 `protobuf:"varint,3,opt,name=downtime,proto3" json:"downtime,omitempty" yaml:"downtime"`
+	// max_downtime is the longest consecutive number of blocks without valid orders
+	MaxDowntime uint32 `protobuf:"varint,4,opt,name=max_downtime,json=maxDowntime,proto3" json:"max_downtime,omitempty" yaml:"max_downtime"`
+	// current_downtime is the consecutive number of blocks without valid orders as of the last sample
+	CurrentDowntime uint32 `protobuf:"varint,5,opt,name=current_downtime,json=currentDowntime,proto3" json:"current_downtime,omitempty" yaml:"current_downtime"`
+	// spread_sum is the sum of spreads of the live samples
+	SpreadSum github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=spread_sum,json=spreadSum,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"spread_sum" yaml:"spread_sum"`
+	// width_sum is the sum of the smaller of ask and bid widths of the live samples
+	WidthSum github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=width_sum,json=widthSum,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"width_sum" yaml:"width_sum"`
+	// depth_sum is the sum of the smaller of ask and bid depths of the live samples
+	DepthSum github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=depth_sum,json=depthSum,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"depth_sum" yaml:"depth_sum"`
+}
+
+func (m *MarketMakerMetrics) Reset()         { *m = MarketMakerMetrics{} }
+func (m *MarketMakerMetrics) String() string { return proto.CompactTextString(m) }
+func (*MarketMakerMetrics) ProtoMessage()    {}
+func (*MarketMakerMetrics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bbea9ddeaf9fb816, []int{7}
+}
+func (m *MarketMakerMetrics) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketMakerMetrics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketMakerMetrics.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarketMakerMetrics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketMakerMetrics.Merge(m, src)
+}
+func (m *MarketMakerMetrics) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketMakerMetrics) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketMakerMetrics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketMakerMetrics proto.InternalMessageInfo
+
+// MarketMakerScore defines the on-chain scoring record of an eligible market maker for a pair
+// within the current incentive period.
+type MarketMakerScore struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	PairId  uint64 `protobuf:"varint,2,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty" yaml:"pair_id"`
+	// hour_metrics is the metrics accumulated within the current hour
+	HourMetrics MarketMakerMetrics `protobuf:"bytes,3,opt,name=hour_metrics,json=hourMetrics,proto3" json:"hour_metrics" yaml:"hour_metrics"`
+	// day_metrics is the metrics accumulated within the current day
+	DayMetrics MarketMakerMetrics `protobuf:"bytes,4,opt,name=day_metrics,json=dayMetrics,proto3" json:"day_metrics" yaml:"day_metrics"`
+	// live_hours is the number of live hours within the current day
+	LiveHours uint32 `protobuf:"varint,5,opt,name=live_hours,json=liveHours,proto3" json:"live_hours,omitempty" yaml:"live_hours"`
+	// total_live_hours is the number of live hours within the current incentive period
+	TotalLiveHours uint32 `protobuf:"varint,6,opt,name=total_live_hours,json=totalLiveHours,proto3" json:"total_live_hours,omitempty" yaml:"total_live_hours"`
+	// live_days is the number of live days within the current incentive period
+	LiveDays uint32 `protobuf:"varint,7,opt,name=live_days,json=liveDays,proto3" json:"live_days,omitempty" yaml:"live_days"`
+	// point_share is the sum of the market maker's share of the pair's liquidity points of each sample
+	PointShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=point_share,json=pointShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"point_share" yaml:"point_share"`
+	// down is whether the downtime of the market maker exceeded MaxTotalDowntime in the last hour,
+	// so that a downtime spanning multiple hours is slashed only once
+	Down bool `protobuf:"varint,9,opt,name=down,proto3" json:"down,omitempty" yaml:"down"`
+}
+
+func (m *MarketMakerScore) Reset()         { *m = MarketMakerScore{} }
+func (m *MarketMakerScore) String() string { return proto.CompactTextString(m) }
+func (*MarketMakerScore) ProtoMessage()    {}
+func (*MarketMakerScore) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bbea9ddeaf9fb816, []int{8}
+}
+func (m *MarketMakerScore) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketMakerScore) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketMakerScore.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarketMakerScore) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketMakerScore.Merge(m, src)
+}
+func (m *MarketMakerScore) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketMakerScore) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketMakerScore.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketMakerScore proto.InternalMessageInfo
+
+// ScoringState defines the time frame of the on-chain market maker scoring.
+type ScoringState struct {
+	// period_start_time is the start time of the current incentive period
+	PeriodStartTime time.Time `protobuf:"bytes,1,opt,name=period_start_time,json=periodStartTime,proto3,stdtime" json:"period_start_time" yaml:"period_start_time"`
+	// hour_start_time is the start time of the hour being recorded
+	HourStartTime time.Time `protobuf:"bytes,2,opt,name=hour_start_time,json=hourStartTime,proto3,stdtime" json:"hour_start_time" yaml:"hour_start_time"`
+}
+
+func (m *ScoringState) Reset()         { *m = ScoringState{} }
+func (m *ScoringState) String() string { return proto.CompactTextString(m) }
+func (*ScoringState) ProtoMessage()    {}
+func (*ScoringState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bbea9ddeaf9fb816, []int{9}
+}
+func (m *ScoringState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScoringState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScoringState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScoringState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScoringState.Merge(m, src)
+}
+func (m *ScoringState) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScoringState) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScoringState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScoringState proto.InternalMessageInfo
+
+// IncentiveStream defines an incentive distributed to a market maker which unlocks linearly over time.
+type IncentiveStream struct {
+	Id      uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" yaml:"id"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	PairId  uint64 `protobuf:"varint,3,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty" yaml:"pair_id"`
+	// amount is the total amount of the stream
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount" yaml:"amount"`
+	// claimed is the amount already claimed from the stream
+	Claimed   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=claimed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"claimed" yaml:"claimed"`
+	StartTime time.Time                                `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	EndTime   time.Time                                `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
+}
+
+func (m *IncentiveStream) Reset()         { *m = IncentiveStream{} }
+func (m *IncentiveStream) String() string { return proto.CompactTextString(m) }
+func (*IncentiveStream) ProtoMessage()    {}
+func (*IncentiveStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bbea9ddeaf9fb816, []int{10}
+}
+func (m *IncentiveStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IncentiveStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IncentiveStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IncentiveStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IncentiveStream.Merge(m, src)
+}
+func (m *IncentiveStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *IncentiveStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_IncentiveStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IncentiveStream proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Params)(nil), "squad.marketmaker.v1beta1.Params")
 	proto.RegisterType((*Common)(nil), "squad.marketmaker.v1beta1.Common")
@@ -337,6 +550,10 @@ func init() {
 	proto.RegisterType((*MarketMaker)(nil), "squad.marketmaker.v1beta1.MarketMaker")
 	proto.RegisterType((*Deposit)(nil), "squad.marketmaker.v1beta1.Deposit")
 	proto.RegisterType((*DepositRecord)(nil), "squad.marketmaker.v1beta1.DepositRecord")
+	proto.RegisterType((*MarketMakerMetrics)(nil), "squad.marketmaker.v1beta1.MarketMakerMetrics")
+	proto.RegisterType((*MarketMakerScore)(nil), "squad.marketmaker.v1beta1.MarketMakerScore")
+	proto.RegisterType((*ScoringState)(nil), "squad.marketmaker.v1beta1.ScoringState")
+	proto.RegisterType((*IncentiveStream)(nil), "squad.marketmaker.v1beta1.IncentiveStream")
 }
 
 func init() {
@@ -344,68 +561,111 @@ func init() {
 }
 
 var fileDescriptor_bbea9ddeaf9fb816 = []byte{
-	// 966 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xc6, 0xc6, 0x89, 0xc7, 0x75, 0x9a, 0x4e, 0xd3, 0xd6, 0x09, 0xc2, 0x1b, 0x06, 0x09,
-	0x59, 0x2a, 0x5d, 0x2b, 0x85, 0x53, 0x6e, 0xd9, 0x58, 0x82, 0x08, 0x05, 0xa2, 0xa5, 0x52, 0x25,
-	0x10, 0x5a, 0x8d, 0xbd, 0x83, 0x3d, 0x8a, 0x67, 0x67, 0xbb, 0x33, 0x6e, 0x9c, 0x03, 0x9c, 0x41,
-	0x5c, 0x7a, 0xe4, 0x58, 0x89, 0x1b, 0xff, 0x02, 0x37, 0xc4, 0xa1, 0xc7, 0x1e, 0x11, 0x07, 0x17,
-	0x25, 0x17, 0xe0, 0xe8, 0xbf, 0x00, 0xcd, 0x0f, 0xef, 0xae, 0x83, 0x4a, 0x30, 0x12, 0xea, 0xc9,
-	0xb3, 0xf3, 0xde, 0xfb, 0xbe, 0x79, 0xef, 0x7d, 0xf3, 0xc6, 0xe0, 0xae, 0x78, 0x34, 0xc6, 0x51,
-	0x87, 0xe1, 0xf4, 0x84, 0x48, 0x86, 0x4f, 0x48, 0xda, 0x79, 0xbc, 0xdb, 0x23, 0x12, 0xef, 0x16,
-	0xf7, 0xbc, 0x24, 0xe5, 0x92, 0xc3, 0x2d, 0xed, 0xec, 0x15, 0x0d, 0xd6, 0x79, 0x7b, 0x73, 0xc0,
-	0x07, 0x5c, 0x7b, 0x75, 0xd4, 0xca, 0x04, 0x6c, 0x6f, 0xf5, 0xb9, 0x60, 0x5c, 0x84, 0xc6, 0x60,
-	0x3e, 0xac, 0xa9, 0x65, 0xbe, 0x3a, 0x3d, 0x2c, 0x48, 0x46, 0xd9, 0xe7, 0x34, 0xb6, 0x76, 0x77,
-	0xc0, 0xf9, 0x60, 0x44, 0x3a, 0xfa, 0xab, 0x37, 0xfe, 0xa2, 0x23, 0x29, 0x23, 0x42, 0x62, 0x96,
-	0x18, 0x07, 0xf4, 0x73, 0x19, 0x54, 0x8f, 0x71, 0x8a, 0x99, 0x80, 0x9f, 0x83, 0x26, 0x8d, 0xfb,
-	0x24, 0x96, 0xf4, 0x31, 0x09, 0x7b, 0xe3, 0x68, 0x40, 0x64, 0x88, 0xa3, 0x28, 0x25, 0x42, 0x34,
-	0x9d, 0x1d, 0xa7, 0x5d, 0xf3, 0xdf, 0x9a, 0x4d, 0x5d, 0xf7, 0x0c, 0xb3, 0xd1, 0x1e, 0x7a, 0x99,
-	0x27, 0x0a, 0x6e, 0x67, 0x26, 0x5f, 0x5b, 0xf6, 0x8d, 0x01, 0x7e, 0xeb, 0x80, 0xf5, 0x88, 0x24,
-	0x5c, 0x50, 0x19, 0x62, 0xc6, 0xc7, 0xb1, 0x6c, 0xae, 0xec, 0x94, 0xdb, 0xf5, 0xfb, 0x5b, 0x9e,
-	0x4d, 0x49, 0x25, 0x31, 0x2f, 0x85, 0x77, 0xc0, 0x69, 0xec, 0x1f, 0x3e, 0x9b, 0xba, 0xa5, 0xd9,
-	0xd4, 0xbd, 0x65, 0x48, 0x17, 0xc3, 0xd1, 0x0f, 0x2f, 0xdc, 0xf6, 0x80, 0xca, 0xe1, 0xb8, 0xe7,
-	0xf5, 0x39, 0xb3, 0x85, 0xb1, 0x3f, 0xf7, 0x44, 0x74, 0xd2, 0x91, 0x67, 0x09, 0x11, 0x1a, 0x49,
-	0x04, 0x0d, 0x1b, 0xbc, 0xaf, 0x63, 0xe1, 0x31, 0xa8, 0xf6, 0x39, 0x63, 0x3c, 0x6e, 0x96, 0x77,
-	0x9c, 0x76, 0xfd, 0xfe, 0x9b, 0xde, 0x4b, 0xbb, 0xe2, 0x1d, 0x68, 0x47, 0xff, 0x96, 0x3d, 0x4c,
-	0xc3, 0x1c, 0xc6, 0x84, 0xa3, 0xc0, 0xe2, 0xc0, 0x47, 0xe0, 0x7a, 0x5e, 0x94, 0x04, 0xd3, 0x54,
-	0x34, 0x2b, 0x3a, 0xbf, 0xf6, 0x3f, 0x40, 0x1f, 0xce, 0x23, 0x8e, 0x31, 0x4d, 0xfd, 0x96, 0x65,
-	0xb8, 0x7d, 0xb9, 0xc6, 0x1a, 0x0e, 0x05, 0xeb, 0xb4, 0xe8, 0x2e, 0xf6, 0xd6, 0xbe, 0x7e, 0xea,
-	0x96, 0xbe, 0x7b, 0xea, 0x96, 0xd0, 0x1f, 0x65, 0x50, 0x35, 0xc7, 0x84, 0x0c, 0xac, 0x33, 0x1a,
-	0x87, 0x3c, 0x21, 0x71, 0x98, 0x62, 0x49, 0xb9, 0x6d, 0xde, 0xfb, 0x0a, 0xfc, 0xd7, 0xa9, 0xfb,
-	0xf6, 0xbf, 0x28, 0x59, 0x97, 0xf4, 0xf3, 0xaa, 0x2f, 0xa2, 0xa1, 0xe0, 0x1a, 0xa3, 0xf1, 0xc7,
-	0x09, 0x89, 0x03, 0xf5, 0x09, 0xbf, 0x02, 0x9b, 0x99, 0x43, 0x44, 0x12, 0x39, 0xb4, 0xa4, 0x2b,
-	0x9a, 0xf4, 0x68, 0x69, 0xd2, 0xd7, 0x2f, 0x91, 0x16, 0x30, 0x51, 0x70, 0xc3, 0x52, 0x77, 0xd5,
-	0xa6, 0xe1, 0xdf, 0x03, 0xd7, 0x18, 0x9e, 0x84, 0x11, 0x3f, 0x8d, 0x95, 0xb6, 0x75, 0x3b, 0x1b,
-	0xfe, 0x9d, 0xd9, 0xd4, 0xbd, 0x69, 0x91, 0x0a, 0x56, 0x14, 0xd4, 0x19, 0x9e, 0x74, 0xed, 0x17,
-	0xfc, 0x10, 0x40, 0x65, 0x95, 0x5c, 0xe2, 0x51, 0x8e, 0x50, 0xd1, 0x08, 0x6f, 0xcc, 0xa6, 0xee,
-	0x56, 0x8e, 0xb0, 0xe8, 0x83, 0x82, 0x0d, 0x86, 0x27, 0x0f, 0xd4, 0x5e, 0x06, 0xb6, 0x0b, 0x6a,
-	0xea, 0xd0, 0x43, 0x3e, 0x4e, 0x45, 0xf3, 0x35, 0x8d, 0xb1, 0x39, 0x9b, 0xba, 0x1b, 0x79, 0x3e,
-	0xda, 0x84, 0x82, 0x35, 0x46, 0xe3, 0x0f, 0xd4, 0x12, 0x7a, 0x40, 0xad, 0xc3, 0x08, 0x9f, 0x89,
-	0x66, 0x55, 0x47, 0xdc, 0x9c, 0x4d, 0xdd, 0xeb, 0x79, 0x84, 0xb2, 0xa0, 0x60, 0x95, 0xd1, 0xb8,
-	0x8b, 0xcf, 0xc4, 0x5e, 0x45, 0xf5, 0x1b, 0xfd, 0x58, 0x01, 0x8d, 0x05, 0xdd, 0xc0, 0xbb, 0x60,
-	0x55, 0x29, 0x24, 0xa4, 0x91, 0xee, 0x75, 0xc5, 0x87, 0xb3, 0xa9, 0xbb, 0x6e, 0x60, 0xac, 0x01,
-	0x05, 0x55, 0xb5, 0x3a, 0x8c, 0xe0, 0x67, 0xa0, 0x3e, 0x4e, 0x22, 0x2c, 0x49, 0xa8, 0xb3, 0x5d,
-	0xd1, 0xf2, 0xdf, 0xf6, 0xcc, 0xa0, 0xf0, 0xe6, 0x83, 0xc2, 0x7b, 0x30, 0x1f, 0x14, 0x99, 0x2a,
-	0xa1, 0x01, 0x2c, 0x04, 0xa3, 0x27, 0x2f, 0x5c, 0x27, 0x00, 0x66, 0x47, 0x05, 0x40, 0x09, 0x36,
-	0x72, 0xd5, 0x9e, 0x12, 0x3a, 0x18, 0x4a, 0xdd, 0x91, 0x9a, 0xb9, 0xca, 0x4b, 0x29, 0xe1, 0xce,
-	0xe5, 0x5b, 0x60, 0xf0, 0x50, 0x90, 0xdf, 0xb3, 0x87, 0x7a, 0x07, 0xf6, 0x00, 0x50, 0x3d, 0x12,
-	0x49, 0x4a, 0x70, 0xa4, 0xfb, 0x57, 0xf3, 0x0f, 0x96, 0xe6, 0xbb, 0x91, 0x77, 0xdb, 0x20, 0xa1,
-	0xa0, 0xc6, 0xf0, 0xe4, 0x13, 0xbd, 0x86, 0xa1, 0x69, 0xef, 0x29, 0x8d, 0xe4, 0x50, 0xb7, 0xb7,
-	0xe6, 0xfb, 0x4b, 0x53, 0x14, 0xc4, 0xa0, 0x81, 0x8c, 0x18, 0x1e, 0xaa, 0xe5, 0x9c, 0x40, 0xeb,
-	0x5d, 0xab, 0x61, 0x39, 0x82, 0xc3, 0x58, 0x2e, 0x12, 0x68, 0x20, 0x43, 0xa0, 0xaf, 0x8b, 0x55,
-	0xcf, 0x4f, 0x0e, 0xa8, 0x65, 0xea, 0x81, 0xef, 0x80, 0xd5, 0xc5, 0x11, 0x5f, 0x50, 0x4e, 0x36,
-	0xd1, 0xe7, 0x2e, 0xf0, 0x4b, 0x50, 0xeb, 0x8f, 0x30, 0x65, 0xb8, 0x37, 0x22, 0x57, 0x0f, 0xef,
-	0xae, 0xd5, 0x8d, 0x3d, 0x53, 0x16, 0xb9, 0xdc, 0xdc, 0xce, 0x19, 0xcd, 0xb8, 0xfb, 0x5d, 0x25,
-	0xf1, 0xbd, 0x03, 0xea, 0x47, 0x7a, 0x9c, 0x1e, 0xa9, 0x71, 0xba, 0x64, 0x1a, 0x85, 0xeb, 0xb2,
-	0x72, 0xe5, 0x75, 0xe9, 0x80, 0x35, 0x32, 0xa2, 0x03, 0xaa, 0x52, 0x56, 0x4a, 0x5e, 0x2b, 0xde,
-	0xd1, 0xb9, 0x05, 0x05, 0x99, 0x53, 0xe1, 0x94, 0xdf, 0x38, 0x60, 0xb5, 0x6b, 0x5e, 0x1d, 0x28,
-	0x41, 0xd5, 0x3e, 0x7a, 0xce, 0x55, 0x75, 0xdb, 0x5f, 0x7c, 0x67, 0xfe, 0xcb, 0x63, 0x67, 0xb9,
-	0x0a, 0x67, 0xf9, 0xd3, 0x01, 0x0d, 0x7b, 0x96, 0x80, 0xf4, 0x79, 0x1a, 0xfd, 0x9f, 0x35, 0xcb,
-	0x93, 0x2d, 0xbf, 0x8a, 0x64, 0xfd, 0x8f, 0x9e, 0x9d, 0xb7, 0x9c, 0xe7, 0xe7, 0x2d, 0xe7, 0xb7,
-	0xf3, 0x96, 0xf3, 0xe4, 0xa2, 0x55, 0x7a, 0x7e, 0xd1, 0x2a, 0xfd, 0x72, 0xd1, 0x2a, 0x7d, 0xfa,
-	0xde, 0xdf, 0x50, 0xd5, 0xd3, 0x7c, 0x6f, 0x84, 0x7b, 0xa2, 0x63, 0xfe, 0xc3, 0x4d, 0x16, 0xfe,
-	0xc5, 0x69, 0x9e, 0x5e, 0x55, 0x4f, 0xc5, 0x77, 0xff, 0x0a, 0x00, 0x00, 0xff, 0xff, 0xf9, 0xce,
-	0x5a, 0xa5, 0xe7, 0x09, 0x00, 0x00,
+	// 1652 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcb, 0x6f, 0x1b, 0x45,
+	0x18, 0xcf, 0x3a, 0xae, 0x1f, 0xe3, 0x38, 0x8f, 0x69, 0xd3, 0x3a, 0x29, 0xf5, 0xa6, 0x53, 0x40,
+	0x91, 0x4a, 0x6d, 0xa5, 0xf4, 0x94, 0x5b, 0xb7, 0x6e, 0x21, 0x82, 0x40, 0x35, 0xae, 0x28, 0x02,
+	0xa1, 0xd5, 0xd8, 0x3b, 0x75, 0x96, 0x78, 0x77, 0xdd, 0xdd, 0x71, 0x93, 0x1c, 0x40, 0x1c, 0x41,
+	0x48, 0xa8, 0xc7, 0x1e, 0x7b, 0x81, 0x03, 0x07, 0xfe, 0x01, 0x6e, 0x9c, 0x7a, 0xec, 0x11, 0x71,
+	0x70, 0x51, 0x7b, 0x01, 0x8e, 0xfe, 0x0b, 0xd0, 0x3c, 0x76, 0x77, 0x6c, 0xd3, 0x1a, 0xa7, 0x2a,
+	0x9c, 0xb2, 0x33, 0xdf, 0xe3, 0x37, 0x33, 0xdf, 0xef, 0x7b, 0x38, 0xe0, 0x62, 0x74, 0xb7, 0x4f,
+	0x9c, 0xba, 0x47, 0xc2, 0x7d, 0xca, 0x3c, 0xb2, 0x4f, 0xc3, 0xfa, 0xbd, 0xad, 0x16, 0x65, 0x64,
+	0x4b, 0xdf, 0xab, 0xf5, 0xc2, 0x80, 0x05, 0x70, 0x4d, 0x28, 0xd7, 0x74, 0x81, 0x52, 0x5e, 0x3f,
+	0xd5, 0x09, 0x3a, 0x81, 0xd0, 0xaa, 0xf3, 0x2f, 0x69, 0xb0, 0xbe, 0xd6, 0x0e, 0x22, 0x2f, 0x88,
+	0x6c, 0x29, 0x90, 0x0b, 0x25, 0xaa, 0xca, 0x55, 0xbd, 0x45, 0x22, 0x9a, 0x40, 0xb6, 0x03, 0xd7,
+	0x8f, 0xe5, 0x9d, 0x20, 0xe8, 0x74, 0x69, 0x5d, 0xac, 0x5a, 0xfd, 0x3b, 0x75, 0xa7, 0x1f, 0x12,
+	0xe6, 0x06, 0xb1, 0xdc, 0x1c, 0x97, 0x33, 0xd7, 0xa3, 0x11, 0x23, 0x5e, 0x4f, 0x2a, 0xa0, 0x1f,
+	0x0a, 0x20, 0x77, 0x93, 0x84, 0xc4, 0x8b, 0xe0, 0x67, 0xa0, 0xe2, 0xfa, 0x6d, 0xea, 0x33, 0xf7,
+	0x1e, 0xb5, 0x5b, 0x7d, 0xa7, 0x43, 0x99, 0x4d, 0x1c, 0x27, 0xa4, 0x51, 0x54, 0x31, 0x36, 0x8c,
+	0xcd, 0xa2, 0x75, 0x61, 0x38, 0x30, 0xcd, 0x23, 0xe2, 0x75, 0xb7, 0xd1, 0xf3, 0x34, 0x11, 0x3e,
+	0x9d, 0x88, 0x2c, 0x21, 0xb9, 0x2a, 0x05, 0xf0, 0x5b, 0x03, 0x2c, 0x3a, 0xb4, 0x17, 0x44, 0x2e,
+	0xb3, 0x89, 0x17, 0xf4, 0x7d, 0x56, 0xc9, 0x6c, 0xcc, 0x6f, 0x96, 0x2e, 0xaf, 0xd5, 0xd4, 0x95,
+	0xf9, 0x25, 0xe3, 0xa7, 0xaa, 0x5d, 0x0b, 0x5c, 0xdf, 0xda, 0x79, 0x34, 0x30, 0xe7, 0x86, 0x03,
+	0x73, 0x55, 0x82, 0x8e, 0x9a, 0xa3, 0x1f, 0x9f, 0x98, 0x9b, 0x1d, 0x97, 0xed, 0xf5, 0x5b, 0xb5,
+	0x76, 0xe0, 0xa9, 0x87, 0x53, 0x7f, 0x2e, 0x45, 0xce, 0x7e, 0x9d, 0x1d, 0xf5, 0x68, 0x24, 0x3c,
+	0x45, 0xb8, 0xac, 0x8c, 0xaf, 0x0a, 0x5b, 0x78, 0x13, 0xe4, 0xda, 0x81, 0xe7, 0x05, 0x7e, 0x65,
+	0x7e, 0xc3, 0xd8, 0x2c, 0x5d, 0x3e, 0x5f, 0x7b, 0x6e, 0xd4, 0x6a, 0xd7, 0x84, 0xa2, 0xb5, 0xaa,
+	0x0e, 0x53, 0x96, 0x87, 0x91, 0xe6, 0x08, 0x2b, 0x3f, 0xf0, 0x2e, 0x58, 0x4a, 0x1f, 0xa5, 0x47,
+	0xdc, 0x30, 0xaa, 0x64, 0xc5, 0xfd, 0x36, 0x5f, 0xe0, 0x7a, 0x27, 0xb6, 0xb8, 0x49, 0xdc, 0xd0,
+	0xaa, 0x2a, 0x84, 0xd3, 0xe3, 0x6f, 0x2c, 0xdc, 0x21, 0xbc, 0xe8, 0xea, 0xea, 0x11, 0xbc, 0x05,
+	0x56, 0x35, 0x1d, 0x1a, 0xba, 0x81, 0x63, 0x3b, 0xe4, 0x28, 0xaa, 0x9c, 0xd8, 0x30, 0x36, 0xcb,
+	0xd6, 0xc6, 0x70, 0x60, 0xbe, 0x36, 0xe1, 0x2a, 0x55, 0x43, 0xf8, 0x64, 0xea, 0x50, 0x6c, 0x37,
+	0xc8, 0x51, 0x04, 0x7d, 0xb0, 0x18, 0x75, 0x49, 0xb4, 0x67, 0xdf, 0x09, 0x49, 0x9b, 0x73, 0xa9,
+	0x92, 0x13, 0xd1, 0x7f, 0x87, 0x9f, 0xee, 0xb7, 0x81, 0xf9, 0xe6, 0xbf, 0x78, 0xf3, 0x06, 0x6d,
+	0xa7, 0x61, 0x1b, 0xf5, 0x86, 0x70, 0x59, 0x6c, 0xdc, 0x50, 0x6b, 0x18, 0x00, 0xd8, 0x0b, 0x83,
+	0x96, 0xa0, 0xad, 0x1d, 0xf3, 0xb7, 0x92, 0x17, 0x61, 0x59, 0xab, 0x49, 0x02, 0xd7, 0x62, 0x02,
+	0xd7, 0x1a, 0x4a, 0xc1, 0x7a, 0x43, 0x3d, 0xd6, 0x9a, 0x04, 0x99, 0x74, 0x81, 0x1e, 0x3c, 0x31,
+	0x0d, 0xbc, 0x92, 0x08, 0x62, 0x4b, 0xf8, 0x95, 0xa1, 0x33, 0xfd, 0x1e, 0x8d, 0x98, 0xeb, 0x77,
+	0xd4, 0xc3, 0x54, 0x0a, 0xd3, 0x70, 0x2f, 0x2a, 0xdc, 0x89, 0x44, 0x18, 0x75, 0x24, 0xd1, 0xd3,
+	0x64, 0xf8, 0x48, 0x4a, 0xe5, 0x3b, 0xc3, 0xef, 0x0d, 0x70, 0x66, 0x22, 0x26, 0x32, 0x93, 0x2a,
+	0xc5, 0x69, 0x59, 0x81, 0xd5, 0x09, 0xaa, 0xcf, 0x89, 0xad, 0xf4, 0x33, 0x5b, 0x7a, 0xac, 0x8e,
+	0x31, 0x41, 0xe6, 0xee, 0x76, 0xe1, 0xeb, 0x87, 0xe6, 0xdc, 0x83, 0x87, 0xe6, 0x1c, 0xfa, 0x73,
+	0x1e, 0xe4, 0x64, 0x22, 0x40, 0x0f, 0x2c, 0x7a, 0xae, 0x6f, 0x07, 0x3d, 0xea, 0xdb, 0xe2, 0x51,
+	0x54, 0x79, 0x38, 0x36, 0x41, 0x46, 0xbd, 0x21, 0xbc, 0xe0, 0xb9, 0xfe, 0x87, 0x3d, 0xea, 0x63,
+	0xbe, 0x84, 0x5f, 0x82, 0x53, 0x89, 0x82, 0x43, 0x7b, 0x6c, 0x4f, 0x81, 0x66, 0x04, 0xe8, 0xee,
+	0xcc, 0xa0, 0x67, 0xc7, 0x40, 0x35, 0x9f, 0x08, 0xaf, 0x28, 0xe8, 0x06, 0xdf, 0x94, 0xf8, 0xdb,
+	0x60, 0xc1, 0x23, 0x87, 0xb6, 0x13, 0x1c, 0xf8, 0xbc, 0x7a, 0x8a, 0x82, 0x51, 0xb6, 0xce, 0x0c,
+	0x07, 0xe6, 0x49, 0xe5, 0x49, 0x93, 0x22, 0x5c, 0xf2, 0xc8, 0x61, 0x43, 0xad, 0xe0, 0x7b, 0x00,
+	0x72, 0x29, 0x0b, 0x18, 0xe9, 0xa6, 0x1e, 0xb2, 0xc2, 0xc3, 0xb9, 0x94, 0xbc, 0x93, 0x3a, 0x08,
+	0x2f, 0x7b, 0xe4, 0xf0, 0x16, 0xdf, 0x4b, 0x9c, 0x6d, 0x81, 0x22, 0x3f, 0xf4, 0x5e, 0xd0, 0x0f,
+	0xe3, 0x14, 0x3f, 0x35, 0x1c, 0x98, 0xcb, 0xe9, 0x7d, 0x84, 0x08, 0xe1, 0x82, 0xe7, 0xfa, 0xef,
+	0xf2, 0x4f, 0x58, 0x03, 0xfc, 0x5b, 0x16, 0x85, 0x9c, 0xb0, 0x38, 0x39, 0x1c, 0x98, 0x4b, 0xa9,
+	0x85, 0xac, 0x03, 0x79, 0xcf, 0xf5, 0x79, 0xee, 0x6f, 0x67, 0x79, 0xbc, 0xd1, 0xcf, 0x59, 0x50,
+	0x1e, 0xa9, 0x4c, 0xf0, 0x22, 0xc8, 0xf3, 0x1a, 0x64, 0xbb, 0x8e, 0x88, 0x75, 0xd6, 0x82, 0xc3,
+	0x81, 0xb9, 0xa8, 0x32, 0x4f, 0x0a, 0x10, 0xce, 0xf1, 0xaf, 0x1d, 0x07, 0x7e, 0x0a, 0x4a, 0xfd,
+	0x9e, 0x43, 0x18, 0xb5, 0xc5, 0x6d, 0x33, 0x22, 0xa3, 0xd6, 0x27, 0x32, 0xea, 0x56, 0xdc, 0x8a,
+	0x92, 0xba, 0x07, 0xa5, 0x43, 0xcd, 0x18, 0xdd, 0xe7, 0x59, 0x04, 0xe4, 0x0e, 0x37, 0x80, 0x0c,
+	0x2c, 0xa7, 0x84, 0x3f, 0xa0, 0x6e, 0x67, 0x8f, 0x89, 0x88, 0x14, 0x65, 0xb3, 0x98, 0x89, 0x09,
+	0x67, 0xc6, 0x13, 0x48, 0xfa, 0x43, 0x38, 0xad, 0xe4, 0xb7, 0xc5, 0x0e, 0x6c, 0x01, 0xc0, 0x63,
+	0x14, 0xf5, 0x42, 0x4a, 0x1c, 0x11, 0xbf, 0xa2, 0x75, 0x6d, 0x66, 0xbc, 0x95, 0x34, 0xda, 0xd2,
+	0x13, 0xc2, 0x45, 0x8f, 0x1c, 0x36, 0xc5, 0x37, 0xb4, 0x65, 0x78, 0x0f, 0x5c, 0x87, 0xed, 0x89,
+	0xf0, 0x16, 0x2d, 0x6b, 0x66, 0x08, 0x8d, 0x0c, 0xc2, 0x91, 0x24, 0xc3, 0x6d, 0xfe, 0x19, 0x03,
+	0x08, 0xbe, 0xab, 0x9a, 0x3e, 0x0b, 0xc0, 0x8e, 0xcf, 0x46, 0x01, 0x84, 0x23, 0x09, 0x20, 0xd2,
+	0x45, 0xb1, 0xe7, 0x17, 0x03, 0x14, 0x13, 0xf6, 0xc0, 0xb7, 0x40, 0x7e, 0x74, 0x88, 0xd0, 0x98,
+	0x93, 0xcc, 0x0c, 0xb1, 0x0a, 0xfc, 0x02, 0x14, 0xdb, 0x5d, 0xe2, 0x7a, 0xa4, 0xd5, 0xa5, 0xd3,
+	0xc7, 0x83, 0x86, 0xe2, 0x8d, 0x3a, 0x53, 0x62, 0x39, 0x5b, 0xe9, 0x4b, 0x11, 0x65, 0xb9, 0xfb,
+	0x83, 0x5f, 0xe2, 0x7e, 0x06, 0x94, 0x76, 0x45, 0xc3, 0xde, 0xe5, 0x0d, 0x7b, 0xc6, 0x6b, 0x68,
+	0xe9, 0x92, 0x99, 0x9a, 0x2e, 0x75, 0x50, 0xa0, 0x5d, 0xb7, 0xe3, 0xf2, 0x2b, 0x73, 0x26, 0x17,
+	0xf4, 0x1c, 0x8d, 0x25, 0x08, 0x27, 0x4a, 0x70, 0x5f, 0x6f, 0x98, 0xd4, 0x77, 0xec, 0xa4, 0xa8,
+	0xbc, 0x38, 0xcd, 0xce, 0xff, 0x53, 0xb7, 0x8c, 0xed, 0x65, 0xa6, 0x2d, 0x27, 0x82, 0xeb, 0xbe,
+	0xc3, 0x2d, 0xb5, 0x27, 0xf9, 0xc6, 0x00, 0xf9, 0x86, 0x1c, 0xa2, 0x20, 0x03, 0x39, 0x35, 0xc3,
+	0x19, 0xd3, 0x82, 0x74, 0x75, 0x74, 0x6c, 0x3a, 0xce, 0xec, 0xa6, 0xb0, 0xb4, 0xb3, 0xfc, 0x65,
+	0x80, 0xb2, 0x3a, 0x0b, 0xa6, 0xed, 0x20, 0x74, 0x5e, 0x65, 0x80, 0xd2, 0xcb, 0xce, 0xff, 0x2f,
+	0x97, 0x7d, 0x92, 0x05, 0x50, 0xe3, 0xe2, 0x2e, 0x65, 0xa1, 0xdb, 0x8e, 0xf8, 0x8d, 0x23, 0xe2,
+	0xf5, 0xba, 0x54, 0xde, 0xb8, 0xac, 0xdf, 0x41, 0x09, 0x10, 0x8e, 0x55, 0x78, 0x17, 0xeb, 0xf2,
+	0x12, 0x17, 0x9b, 0x64, 0xc6, 0xbb, 0x98, 0x2e, 0x45, 0xb8, 0xc4, 0x97, 0x4d, 0x65, 0x5b, 0x07,
+	0x85, 0xb1, 0xee, 0xa7, 0x31, 0x34, 0xed, 0x58, 0x89, 0xd2, 0x44, 0xcb, 0xcc, 0xce, 0xd0, 0x32,
+	0x6f, 0x80, 0xe5, 0x76, 0x3f, 0x0c, 0xa9, 0xcf, 0x52, 0x7b, 0xd9, 0xec, 0xce, 0xa6, 0x25, 0x7b,
+	0x5c, 0x03, 0xe1, 0x25, 0xb5, 0x95, 0xf8, 0x69, 0x01, 0x20, 0x8b, 0xac, 0x1d, 0xf5, 0x3d, 0x55,
+	0xee, 0x8e, 0x5d, 0xb2, 0x53, 0x4f, 0x08, 0x17, 0xe5, 0xa2, 0xd9, 0xf7, 0x78, 0x45, 0x15, 0x55,
+	0x56, 0x40, 0xe4, 0x5f, 0xae, 0x64, 0x27, 0x8e, 0x10, 0x2e, 0x88, 0x6f, 0x05, 0x20, 0xc7, 0x13,
+	0x0e, 0x50, 0x78, 0xb9, 0x92, 0x9d, 0x38, 0xe2, 0x91, 0xe2, 0xdf, 0xcd, 0xbe, 0xa7, 0x4a, 0xf6,
+	0x77, 0x27, 0xc0, 0xb2, 0xc6, 0xb0, 0x66, 0x3b, 0x08, 0xe9, 0xab, 0xcc, 0x28, 0x0f, 0x2c, 0xf0,
+	0x51, 0xc5, 0xf6, 0x24, 0x95, 0xd5, 0x6f, 0xb0, 0x4b, 0x2f, 0xf8, 0xa1, 0x34, 0xc9, 0x7f, 0xeb,
+	0xac, 0xca, 0x35, 0x45, 0x29, 0xdd, 0x21, 0xc2, 0x25, 0xbe, 0x8c, 0x33, 0xe5, 0x73, 0x50, 0x72,
+	0xc8, 0x51, 0x82, 0x96, 0x3d, 0x0e, 0xda, 0xfa, 0xe8, 0x8c, 0xa2, 0xf9, 0x43, 0x18, 0x38, 0xe4,
+	0x28, 0xc6, 0xba, 0x02, 0x80, 0xc8, 0x24, 0x7d, 0x4a, 0x5b, 0x4d, 0x89, 0x94, 0xca, 0x10, 0x2e,
+	0xf2, 0x85, 0x9c, 0xd3, 0xae, 0x83, 0x65, 0x39, 0xff, 0x69, 0xb6, 0xb9, 0x71, 0xd2, 0x8f, 0x6b,
+	0x20, 0xbc, 0x28, 0xb6, 0xde, 0x4f, 0xdc, 0x6c, 0x01, 0xe1, 0x53, 0xce, 0x7b, 0xf9, 0xf1, 0x09,
+	0x31, 0x11, 0x21, 0x5c, 0xe0, 0xdf, 0xe2, 0xd7, 0x1e, 0x05, 0xa5, 0x5e, 0xe0, 0xfa, 0xcc, 0x8e,
+	0xf6, 0x48, 0x48, 0x15, 0xc7, 0x1a, 0x33, 0x93, 0x58, 0x3d, 0x8b, 0xe6, 0x0a, 0x61, 0x20, 0x56,
+	0x4d, 0xbe, 0x80, 0x17, 0x40, 0x96, 0xe7, 0x6a, 0xa5, 0x28, 0x1a, 0xdc, 0xd2, 0x70, 0x60, 0x96,
+	0xd2, 0xf2, 0x81, 0xb0, 0x10, 0x8e, 0xd6, 0xf7, 0x05, 0xce, 0x42, 0xd7, 0xef, 0x34, 0x19, 0x61,
+	0x14, 0x76, 0xc1, 0x8a, 0xfa, 0x75, 0x13, 0x31, 0x12, 0x32, 0xd9, 0xf2, 0x8c, 0xa9, 0x2d, 0xef,
+	0x75, 0x15, 0xb5, 0x8a, 0x3a, 0xde, 0xb8, 0x0b, 0xd9, 0xf5, 0x96, 0xe4, 0x7e, 0x93, 0x6f, 0x8b,
+	0x21, 0xf3, 0x0e, 0x58, 0x12, 0x74, 0xd2, 0xb0, 0xa6, 0x4f, 0xb1, 0x68, 0xf4, 0xd7, 0xfb, 0x98,
+	0x03, 0x89, 0x54, 0xe6, 0xbb, 0x09, 0x8e, 0xca, 0xbe, 0x9f, 0xb2, 0x60, 0x29, 0x19, 0x98, 0x9a,
+	0x2c, 0xa4, 0xc4, 0x83, 0xe7, 0x40, 0x26, 0x99, 0xb5, 0xcb, 0xc3, 0x81, 0x59, 0x54, 0xa3, 0xaa,
+	0x83, 0x70, 0xc6, 0x1d, 0xe9, 0x76, 0x99, 0x99, 0x72, 0x73, 0x7e, 0x86, 0x6e, 0x97, 0xfd, 0xef,
+	0xba, 0x1d, 0x3c, 0x00, 0x79, 0x31, 0x86, 0x51, 0xa7, 0x72, 0x62, 0x1a, 0xac, 0xa5, 0x60, 0x17,
+	0xb5, 0xb1, 0x8f, 0x3a, 0xb3, 0xe1, 0xc6, 0x68, 0xf0, 0x63, 0x00, 0xb4, 0x28, 0xe7, 0xa6, 0x46,
+	0xf9, 0x9c, 0x02, 0x8f, 0x1b, 0xc3, 0x58, 0x80, 0x8b, 0x51, 0x42, 0x22, 0x0c, 0x0a, 0xc9, 0x70,
+	0x96, 0x9f, 0xea, 0x37, 0xae, 0x66, 0xf1, 0xdc, 0x37, 0x32, 0x96, 0xe5, 0xa9, 0x9a, 0xc6, 0x04,
+	0x61, 0xac, 0x0f, 0x1e, 0x3d, 0xad, 0x1a, 0x8f, 0x9f, 0x56, 0x8d, 0xdf, 0x9f, 0x56, 0x8d, 0xfb,
+	0xcf, 0xaa, 0x73, 0x8f, 0x9f, 0x55, 0xe7, 0x7e, 0x7d, 0x56, 0x9d, 0xfb, 0xe4, 0xca, 0xc4, 0x03,
+	0xf0, 0x1a, 0x77, 0xa9, 0x4b, 0x5a, 0x51, 0x5d, 0xfe, 0x0f, 0xf3, 0x70, 0xe4, 0xbf, 0x98, 0xe2,
+	0x49, 0x5a, 0x39, 0x71, 0x9e, 0xb7, 0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x3b, 0xff, 0xf0, 0xca,
+	0xe7, 0x14, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -428,6 +688,51 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.IncentivePeriodBudget) > 0 {
+		for iNdEx := len(m.IncentivePeriodBudget) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IncentivePeriodBudget[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarketmaker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.IncentiveVestingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.IncentiveVestingPeriod):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintMarketmaker(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x42
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ProbationDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ProbationDuration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintMarketmaker(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarketmaker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.IncentivePeriodDays != 0 {
+		i = encodeVarintMarketmaker(dAtA, i, uint64(m.IncentivePeriodDays))
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.IncentivePairs) > 0 {
 		for iNdEx := len(m.IncentivePairs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -599,12 +904,12 @@ func (m *IncentivePair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x1a
-	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdateTime):])
-	if err2 != nil {
-		return 0, err2
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdateTime):])
+	if err4 != nil {
+		return 0, err4
 	}
-	i -= n2
-	i = encodeVarintMarketmaker(dAtA, i, uint64(n2))
+	i -= n4
+	i = encodeVarintMarketmaker(dAtA, i, uint64(n4))
 	i--
 	dAtA[i] = 0x12
 	if m.PairId != 0 {
@@ -679,6 +984,16 @@ func (m *MarketMaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ProbationEndTime != nil {
+		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ProbationEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ProbationEndTime):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintMarketmaker(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.Eligible {
 		i--
 		if m.Eligible {
@@ -790,112 +1105,418 @@ func (m *DepositRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintMarketmaker(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMarketmaker(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MarketMakerMetrics) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MarketMakerMetrics) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketMakerMetrics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.IncentiveBudgetAddress)
-	if l > 0 {
-		n += 1 + l + sovMarketmaker(uint64(l))
+	{
+		size := m.DepthSum.Size()
+		i -= size
+		if _, err := m.DepthSum.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarketmaker(dAtA, i, uint64(size))
 	}
-	if len(m.DepositAmount) > 0 {
-		for _, e := range m.DepositAmount {
-			l = e.Size()
-			n += 1 + l + sovMarketmaker(uint64(l))
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.WidthSum.Size()
+		i -= size
+		if _, err := m.WidthSum.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintMarketmaker(dAtA, i, uint64(size))
 	}
-	l = m.Common.Size()
-	n += 1 + l + sovMarketmaker(uint64(l))
-	if len(m.IncentivePairs) > 0 {
-		for _, e := range m.IncentivePairs {
-			l = e.Size()
-			n += 1 + l + sovMarketmaker(uint64(l))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.SpreadSum.Size()
+		i -= size
+		if _, err := m.SpreadSum.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintMarketmaker(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *Common) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x32
+	if m.CurrentDowntime != 0 {
+		i = encodeVarintMarketmaker(dAtA, i, uint64(m.CurrentDowntime))
+		i--
+		dAtA[i] = 0x28
 	}
-	var l int
-	_ = l
-	l = m.MinOpenRatio.Size()
-	n += 1 + l + sovMarketmaker(uint64(l))
-	l = m.MinOpenDepthRatio.Size()
-	n += 1 + l + sovMarketmaker(uint64(l))
 	if m.MaxDowntime != 0 {
-		n += 1 + sovMarketmaker(uint64(m.MaxDowntime))
+		i = encodeVarintMarketmaker(dAtA, i, uint64(m.MaxDowntime))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.MaxTotalDowntime != 0 {
-		n += 1 + sovMarketmaker(uint64(m.MaxTotalDowntime))
+	if m.Downtime != 0 {
+		i = encodeVarintMarketmaker(dAtA, i, uint64(m.Downtime))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.MinHours != 0 {
-		n += 1 + sovMarketmaker(uint64(m.MinHours))
+	if m.LiveSamples != 0 {
+		i = encodeVarintMarketmaker(dAtA, i, uint64(m.LiveSamples))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.MinDays != 0 {
-		n += 1 + sovMarketmaker(uint64(m.MinDays))
+	if m.Samples != 0 {
+		i = encodeVarintMarketmaker(dAtA, i, uint64(m.Samples))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *IncentivePair) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PairId != 0 {
-		n += 1 + sovMarketmaker(uint64(m.PairId))
+func (m *MarketMakerScore) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdateTime)
-	n += 1 + l + sovMarketmaker(uint64(l))
-	l = m.IncentiveWeight.Size()
-	n += 1 + l + sovMarketmaker(uint64(l))
-	l = m.MaxSpread.Size()
-	n += 1 + l + sovMarketmaker(uint64(l))
-	l = m.MinWidth.Size()
-	n += 1 + l + sovMarketmaker(uint64(l))
-	l = m.MinDepth.Size()
-	n += 1 + l + sovMarketmaker(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Incentive) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MarketMakerScore) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketMakerScore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovMarketmaker(uint64(l))
+	if m.Down {
+		i--
+		if m.Down {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
 	}
-	if len(m.Claimable) > 0 {
-		for _, e := range m.Claimable {
-			l = e.Size()
-			n += 1 + l + sovMarketmaker(uint64(l))
+	{
+		size := m.PointShare.Size()
+		i -= size
+		if _, err := m.PointShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintMarketmaker(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *MarketMaker) Size() (n int) {
-	if m == nil {
+	i--
+	dAtA[i] = 0x42
+	if m.LiveDays != 0 {
+		i = encodeVarintMarketmaker(dAtA, i, uint64(m.LiveDays))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.TotalLiveHours != 0 {
+		i = encodeVarintMarketmaker(dAtA, i, uint64(m.TotalLiveHours))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.LiveHours != 0 {
+		i = encodeVarintMarketmaker(dAtA, i, uint64(m.LiveHours))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.DayMetrics.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarketmaker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.HourMetrics.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarketmaker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PairId != 0 {
+		i = encodeVarintMarketmaker(dAtA, i, uint64(m.PairId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMarketmaker(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScoringState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScoringState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScoringState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.HourStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.HourStartTime):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintMarketmaker(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x12
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.PeriodStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.PeriodStartTime):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintMarketmaker(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *IncentiveStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IncentiveStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IncentiveStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintMarketmaker(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x3a
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintMarketmaker(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x32
+	if len(m.Claimed) > 0 {
+		for iNdEx := len(m.Claimed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Claimed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarketmaker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarketmaker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.PairId != 0 {
+		i = encodeVarintMarketmaker(dAtA, i, uint64(m.PairId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMarketmaker(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintMarketmaker(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMarketmaker(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMarketmaker(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IncentiveBudgetAddress)
+	if l > 0 {
+		n += 1 + l + sovMarketmaker(uint64(l))
+	}
+	if len(m.DepositAmount) > 0 {
+		for _, e := range m.DepositAmount {
+			l = e.Size()
+			n += 1 + l + sovMarketmaker(uint64(l))
+		}
+	}
+	l = m.Common.Size()
+	n += 1 + l + sovMarketmaker(uint64(l))
+	if len(m.IncentivePairs) > 0 {
+		for _, e := range m.IncentivePairs {
+			l = e.Size()
+			n += 1 + l + sovMarketmaker(uint64(l))
+		}
+	}
+	if m.IncentivePeriodDays != 0 {
+		n += 1 + sovMarketmaker(uint64(m.IncentivePeriodDays))
+	}
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovMarketmaker(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.ProbationDuration)
+	n += 1 + l + sovMarketmaker(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.IncentiveVestingPeriod)
+	n += 1 + l + sovMarketmaker(uint64(l))
+	if len(m.IncentivePeriodBudget) > 0 {
+		for _, e := range m.IncentivePeriodBudget {
+			l = e.Size()
+			n += 1 + l + sovMarketmaker(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Common) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinOpenRatio.Size()
+	n += 1 + l + sovMarketmaker(uint64(l))
+	l = m.MinOpenDepthRatio.Size()
+	n += 1 + l + sovMarketmaker(uint64(l))
+	if m.MaxDowntime != 0 {
+		n += 1 + sovMarketmaker(uint64(m.MaxDowntime))
+	}
+	if m.MaxTotalDowntime != 0 {
+		n += 1 + sovMarketmaker(uint64(m.MaxTotalDowntime))
+	}
+	if m.MinHours != 0 {
+		n += 1 + sovMarketmaker(uint64(m.MinHours))
+	}
+	if m.MinDays != 0 {
+		n += 1 + sovMarketmaker(uint64(m.MinDays))
+	}
+	return n
+}
+
+func (m *IncentivePair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PairId != 0 {
+		n += 1 + sovMarketmaker(uint64(m.PairId))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdateTime)
+	n += 1 + l + sovMarketmaker(uint64(l))
+	l = m.IncentiveWeight.Size()
+	n += 1 + l + sovMarketmaker(uint64(l))
+	l = m.MaxSpread.Size()
+	n += 1 + l + sovMarketmaker(uint64(l))
+	l = m.MinWidth.Size()
+	n += 1 + l + sovMarketmaker(uint64(l))
+	l = m.MinDepth.Size()
+	n += 1 + l + sovMarketmaker(uint64(l))
+	return n
+}
+
+func (m *Incentive) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMarketmaker(uint64(l))
+	}
+	if len(m.Claimable) > 0 {
+		for _, e := range m.Claimable {
+			l = e.Size()
+			n += 1 + l + sovMarketmaker(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MarketMaker) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -910,6 +1531,10 @@ func (m *MarketMaker) Size() (n int) {
 	if m.Eligible {
 		n += 2
 	}
+	if m.ProbationEndTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ProbationEndTime)
+		n += 1 + l + sovMarketmaker(uint64(l))
+	}
 	return n
 }
 
@@ -925,38 +1550,1034 @@ func (m *Deposit) Size() (n int) {
 			n += 1 + l + sovMarketmaker(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *DepositRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMarketmaker(uint64(l))
+	}
+	if m.PairId != 0 {
+		n += 1 + sovMarketmaker(uint64(m.PairId))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovMarketmaker(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MarketMakerMetrics) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Samples != 0 {
+		n += 1 + sovMarketmaker(uint64(m.Samples))
+	}
+	if m.LiveSamples != 0 {
+		n += 1 + sovMarketmaker(uint64(m.LiveSamples))
+	}
+	if m.Downtime != 0 {
+		n += 1 + sovMarketmaker(uint64(m.Downtime))
+	}
+	if m.MaxDowntime != 0 {
+		n += 1 + sovMarketmaker(uint64(m.MaxDowntime))
+	}
+	if m.CurrentDowntime != 0 {
+		n += 1 + sovMarketmaker(uint64(m.CurrentDowntime))
+	}
+	l = m.SpreadSum.Size()
+	n += 1 + l + sovMarketmaker(uint64(l))
+	l = m.WidthSum.Size()
+	n += 1 + l + sovMarketmaker(uint64(l))
+	l = m.DepthSum.Size()
+	n += 1 + l + sovMarketmaker(uint64(l))
+	return n
+}
+
+func (m *MarketMakerScore) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMarketmaker(uint64(l))
+	}
+	if m.PairId != 0 {
+		n += 1 + sovMarketmaker(uint64(m.PairId))
+	}
+	l = m.HourMetrics.Size()
+	n += 1 + l + sovMarketmaker(uint64(l))
+	l = m.DayMetrics.Size()
+	n += 1 + l + sovMarketmaker(uint64(l))
+	if m.LiveHours != 0 {
+		n += 1 + sovMarketmaker(uint64(m.LiveHours))
+	}
+	if m.TotalLiveHours != 0 {
+		n += 1 + sovMarketmaker(uint64(m.TotalLiveHours))
+	}
+	if m.LiveDays != 0 {
+		n += 1 + sovMarketmaker(uint64(m.LiveDays))
+	}
+	l = m.PointShare.Size()
+	n += 1 + l + sovMarketmaker(uint64(l))
+	if m.Down {
+		n += 2
+	}
+	return n
+}
+
+func (m *ScoringState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.PeriodStartTime)
+	n += 1 + l + sovMarketmaker(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.HourStartTime)
+	n += 1 + l + sovMarketmaker(uint64(l))
+	return n
+}
+
+func (m *IncentiveStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMarketmaker(uint64(m.Id))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMarketmaker(uint64(l))
+	}
+	if m.PairId != 0 {
+		n += 1 + sovMarketmaker(uint64(m.PairId))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovMarketmaker(uint64(l))
+		}
+	}
+	if len(m.Claimed) > 0 {
+		for _, e := range m.Claimed {
+			l = e.Size()
+			n += 1 + l + sovMarketmaker(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovMarketmaker(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovMarketmaker(uint64(l))
+	return n
+}
+
+func sovMarketmaker(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMarketmaker(x uint64) (n int) {
+	return sovMarketmaker(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarketmaker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentiveBudgetAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketmaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarketmaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketmaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IncentiveBudgetAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketmaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarketmaker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketmaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositAmount = append(m.DepositAmount, types.Coin{})
+			if err := m.DepositAmount[len(m.DepositAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Common", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketmaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarketmaker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketmaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Common.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentivePairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketmaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarketmaker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketmaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IncentivePairs = append(m.IncentivePairs, IncentivePair{})
+			if err := m.IncentivePairs[len(m.IncentivePairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentivePeriodDays", wireType)
+			}
+			m.IncentivePeriodDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketmaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IncentivePeriodDays |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketmaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarketmaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketmaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProbationDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketmaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarketmaker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketmaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.ProbationDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentiveVestingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketmaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarketmaker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketmaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.IncentiveVestingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentivePeriodBudget", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketmaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarketmaker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketmaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IncentivePeriodBudget = append(m.IncentivePeriodBudget, types.Coin{})
+			if err := m.IncentivePeriodBudget[len(m.IncentivePeriodBudget)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarketmaker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarketmaker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Common) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarketmaker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Common: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Common: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinOpenRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketmaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarketmaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketmaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinOpenRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinOpenDepthRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketmaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarketmaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketmaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinOpenDepthRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDowntime", wireType)
+			}
+			m.MaxDowntime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketmaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDowntime |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalDowntime", wireType)
+			}
+			m.MaxTotalDowntime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketmaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTotalDowntime |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinHours", wireType)
+			}
+			m.MinHours = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketmaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinHours |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDays", wireType)
+			}
+			m.MinDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketmaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinDays |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarketmaker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarketmaker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IncentivePair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarketmaker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IncentivePair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IncentivePair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+			}
+			m.PairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketmaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PairId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketmaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarketmaker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketmaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentiveWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketmaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarketmaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketmaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IncentiveWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSpread", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketmaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarketmaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketmaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSpread.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinWidth", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketmaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarketmaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketmaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinWidth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDepth", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketmaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarketmaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketmaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinDepth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarketmaker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarketmaker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Incentive) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarketmaker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Incentive: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Incentive: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketmaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarketmaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketmaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketmaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarketmaker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketmaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claimable = append(m.Claimable, types.Coin{})
+			if err := m.Claimable[len(m.Claimable)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarketmaker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarketmaker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *DepositRecord) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovMarketmaker(uint64(l))
-	}
-	if m.PairId != 0 {
-		n += 1 + sovMarketmaker(uint64(m.PairId))
-	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovMarketmaker(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovMarketmaker(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMarketmaker(x uint64) (n int) {
-	return sovMarketmaker(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *MarketMaker) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -979,15 +2600,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: MarketMaker: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MarketMaker: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncentiveBudgetAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1015,13 +2636,222 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IncentiveBudgetAddress = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+			}
+			m.PairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketmaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PairId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eligible", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketmaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Eligible = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProbationEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketmaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarketmaker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketmaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProbationEndTime == nil {
+				m.ProbationEndTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ProbationEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarketmaker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarketmaker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Deposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarketmaker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Deposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketmaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarketmaker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketmaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarketmaker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarketmaker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DepositRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarketmaker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarketmaker
@@ -1031,31 +2861,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMarketmaker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMarketmaker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DepositAmount = append(m.DepositAmount, types.Coin{})
-			if err := m.DepositAmount[len(m.DepositAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Common", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
 			}
-			var msglen int
+			m.PairId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarketmaker
@@ -1065,28 +2893,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PairId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMarketmaker
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMarketmaker
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Common.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncentivePairs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1113,8 +2927,8 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IncentivePairs = append(m.IncentivePairs, IncentivePair{})
-			if err := m.IncentivePairs[len(m.IncentivePairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1139,7 +2953,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Common) Unmarshal(dAtA []byte) error {
+func (m *MarketMakerMetrics) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1162,17 +2976,17 @@ func (m *Common) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Common: wiretype end group for non-group")
+			return fmt.Errorf("proto: MarketMakerMetrics: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Common: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MarketMakerMetrics: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinOpenRatio", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Samples", wireType)
 			}
-			var stringLen uint64
+			m.Samples = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarketmaker
@@ -1182,31 +2996,16 @@ func (m *Common) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Samples |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMarketmaker
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMarketmaker
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.MinOpenRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinOpenDepthRatio", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiveSamples", wireType)
 			}
-			var stringLen uint64
+			m.LiveSamples = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarketmaker
@@ -1216,31 +3015,16 @@ func (m *Common) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.LiveSamples |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMarketmaker
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMarketmaker
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.MinOpenDepthRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxDowntime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Downtime", wireType)
 			}
-			m.MaxDowntime = 0
+			m.Downtime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarketmaker
@@ -1250,16 +3034,16 @@ func (m *Common) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxDowntime |= uint32(b&0x7F) << shift
+				m.Downtime |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalDowntime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDowntime", wireType)
 			}
-			m.MaxTotalDowntime = 0
+			m.MaxDowntime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarketmaker
@@ -1269,16 +3053,16 @@ func (m *Common) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxTotalDowntime |= uint32(b&0x7F) << shift
+				m.MaxDowntime |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinHours", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentDowntime", wireType)
 			}
-			m.MinHours = 0
+			m.CurrentDowntime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarketmaker
@@ -1288,16 +3072,16 @@ func (m *Common) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinHours |= uint32(b&0x7F) << shift
+				m.CurrentDowntime |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinDays", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpreadSum", wireType)
 			}
-			m.MinDays = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarketmaker
@@ -1307,11 +3091,94 @@ func (m *Common) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinDays |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarketmaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketmaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpreadSum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WidthSum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketmaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarketmaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketmaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WidthSum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepthSum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketmaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarketmaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketmaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DepthSum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMarketmaker(dAtA[iNdEx:])
@@ -1333,7 +3200,7 @@ func (m *Common) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IncentivePair) Unmarshal(dAtA []byte) error {
+func (m *MarketMakerScore) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1356,13 +3223,45 @@ func (m *IncentivePair) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IncentivePair: wiretype end group for non-group")
+			return fmt.Errorf("proto: MarketMakerScore: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IncentivePair: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MarketMakerScore: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketmaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarketmaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketmaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
 			}
@@ -1381,9 +3280,9 @@ func (m *IncentivePair) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HourMetrics", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1410,15 +3309,15 @@ func (m *IncentivePair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.HourMetrics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncentiveWeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DayMetrics", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarketmaker
@@ -1428,31 +3327,49 @@ func (m *IncentivePair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMarketmaker
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMarketmaker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.IncentiveWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DayMetrics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSpread", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiveHours", wireType)
+			}
+			m.LiveHours = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketmaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LiveHours |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var stringLen uint64
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLiveHours", wireType)
+			}
+			m.TotalLiveHours = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarketmaker
@@ -1462,29 +3379,33 @@ func (m *IncentivePair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TotalLiveHours |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMarketmaker
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMarketmaker
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiveDays", wireType)
 			}
-			if err := m.MaxSpread.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.LiveDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketmaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LiveDays |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinWidth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PointShare", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1512,15 +3433,15 @@ func (m *IncentivePair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinWidth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PointShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinDepth", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Down", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarketmaker
@@ -1530,26 +3451,12 @@ func (m *IncentivePair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMarketmaker
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMarketmaker
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.MinDepth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Down = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMarketmaker(dAtA[iNdEx:])
@@ -1571,7 +3478,7 @@ func (m *IncentivePair) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Incentive) Unmarshal(dAtA []byte) error {
+func (m *ScoringState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1594,17 +3501,17 @@ func (m *Incentive) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Incentive: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScoringState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Incentive: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScoringState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodStartTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarketmaker
@@ -1614,27 +3521,28 @@ func (m *Incentive) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMarketmaker
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMarketmaker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.PeriodStartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Claimable", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HourStartTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1661,8 +3569,7 @@ func (m *Incentive) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Claimable = append(m.Claimable, types.Coin{})
-			if err := m.Claimable[len(m.Claimable)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.HourStartTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1687,7 +3594,7 @@ func (m *Incentive) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MarketMaker) Unmarshal(dAtA []byte) error {
+func (m *IncentiveStream) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1710,13 +3617,32 @@ func (m *MarketMaker) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MarketMaker: wiretype end group for non-group")
+			return fmt.Errorf("proto: IncentiveStream: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MarketMaker: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IncentiveStream: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketmaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
@@ -1748,7 +3674,7 @@ func (m *MarketMaker) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
 			}
@@ -1767,11 +3693,11 @@ func (m *MarketMaker) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Eligible", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarketmaker
@@ -1781,65 +3707,29 @@ func (m *MarketMaker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Eligible = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMarketmaker(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMarketmaker
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Deposit) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMarketmaker
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketmaker
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Deposit: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1866,66 +3756,16 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Claimed = append(m.Claimed, types.Coin{})
+			if err := m.Claimed[len(m.Claimed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMarketmaker(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMarketmaker
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DepositRecord) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMarketmaker
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DepositRecord: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DepositRecord: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarketmaker
@@ -1935,46 +3775,28 @@ func (m *DepositRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMarketmaker
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMarketmaker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
-			}
-			m.PairId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMarketmaker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PairId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 3:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2001,8 +3823,7 @@ func (m *DepositRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/tendermint/tendermint/crypto"
 	"gopkg.in/yaml.v2"
@@ -23,6 +24,11 @@ var (
 	KeyDepositAmount          = []byte("DepositAmount")
 	KeyCommon                 = []byte("Common")
 	KeyIncentivePairs         = []byte("IncentivePairs")
+	KeyIncentivePeriodDays    = []byte("IncentivePeriodDays")
+	KeySlashFraction          = []byte("SlashFraction")
+	KeyProbationDuration      = []byte("ProbationDuration")
+	KeyIncentiveVestingPeriod = []byte("IncentiveVestingPeriod")
+	KeyIncentivePeriodBudget  = []byte("IncentivePeriodBudget")
 
 	DefaultIncentiveBudgetAddress = farmingtypes.DeriveAddress(AddressType, farmingtypes.ModuleName, "ecosystem_incentive_mm")
 	DefaultDepositAmount          = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000000000)))
@@ -34,6 +40,11 @@ var (
 		MinHours:          uint32(16),
 		MinDays:           uint32(22),
 	}
+	DefaultIncentivePeriodDays    = uint32(0)
+	DefaultSlashFraction          = sdk.NewDecWithPrec(1, 1) // 10%
+	DefaultProbationDuration      = 7 * Day
+	DefaultIncentiveVestingPeriod = time.Duration(0)
+	DefaultIncentivePeriodBudget  = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000000000)))
 
 	ClaimableIncentiveReserveAcc = farmingtypes.DeriveAddress(AddressType, ModuleName, ClaimableIncentiveReserveAccName)
 	DepositReserveAcc            = sdk.AccAddress(crypto.AddressHash([]byte(ModuleName)))
@@ -53,6 +64,11 @@ func DefaultParams() Params {
 		DepositAmount:          DefaultDepositAmount,
 		Common:                 DefaultCommon,
 		IncentivePairs:         []IncentivePair{},
+		IncentivePeriodDays:    DefaultIncentivePeriodDays,
+		SlashFraction:          DefaultSlashFraction,
+		ProbationDuration:      DefaultProbationDuration,
+		IncentiveVestingPeriod: DefaultIncentiveVestingPeriod,
+		IncentivePeriodBudget:  DefaultIncentivePeriodBudget,
 	}
 }
 
@@ -63,6 +79,11 @@ func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 		paramstypes.NewParamSetPair(KeyDepositAmount, &p.DepositAmount, validateDepositAmount),
 		paramstypes.NewParamSetPair(KeyCommon, &p.Common, validateCommon),
 		paramstypes.NewParamSetPair(KeyIncentivePairs, &p.IncentivePairs, validateIncentivePairs),
+		paramstypes.NewParamSetPair(KeyIncentivePeriodDays, &p.IncentivePeriodDays, validateIncentivePeriodDays),
+		paramstypes.NewParamSetPair(KeySlashFraction, &p.SlashFraction, validateSlashFraction),
+		paramstypes.NewParamSetPair(KeyProbationDuration, &p.ProbationDuration, validateProbationDuration),
+		paramstypes.NewParamSetPair(KeyIncentiveVestingPeriod, &p.IncentiveVestingPeriod, validateIncentiveVestingPeriod),
+		paramstypes.NewParamSetPair(KeyIncentivePeriodBudget, &p.IncentivePeriodBudget, validateIncentivePeriodBudget),
 	}
 }
 
@@ -71,6 +92,16 @@ func (p Params) IncentiveBudgetAcc() sdk.AccAddress {
 	return acc
 }
 
+// ScoringEnabled returns whether the on-chain market maker scoring is enabled.
+func (p Params) ScoringEnabled() bool {
+	return p.IncentivePeriodDays > 0
+}
+
+// IncentivePeriod returns the duration of an incentive period.
+func (p Params) IncentivePeriod() time.Duration {
+	return time.Duration(p.IncentivePeriodDays) * Day
+}
+
 func (p Params) IncentivePairsMap() map[uint64]IncentivePair {
 	iMap := make(map[uint64]IncentivePair)
 	for _, pair := range p.IncentivePairs {
@@ -94,6 +125,11 @@ func (p Params) Validate() error {
 		{p.IncentiveBudgetAddress, validateIncentiveBudgetAddress},
 		{p.DepositAmount, validateDepositAmount},
 		{p.IncentivePairs, validateIncentivePairs},
+		{p.IncentivePeriodDays, validateIncentivePeriodDays},
+		{p.SlashFraction, validateSlashFraction},
+		{p.ProbationDuration, validateProbationDuration},
+		{p.IncentiveVestingPeriod, validateIncentiveVestingPeriod},
+		{p.IncentivePeriodBudget, validateIncentivePeriodBudget},
 	} {
 		if err := v.validator(v.value); err != nil {
 			return err
@@ -157,3 +193,67 @@ func validateIncentivePairs(i interface{}) error {
 	}
 	return nil
 }
+
+func validateIncentivePeriodDays(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateSlashFraction(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("slash fraction must not be nil")
+	}
+
+	if v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("slash fraction must be between 0 and 1: %s", v)
+	}
+
+	return nil
+}
+
+func validateProbationDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("probation duration must not be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateIncentiveVestingPeriod(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("incentive vesting period must not be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateIncentivePeriodBudget(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("invalid incentive period budget: %w", err)
+	}
+
+	return nil
+}
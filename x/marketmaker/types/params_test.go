@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
@@ -31,6 +32,13 @@ common:
   min_hours: 16
   min_days: 22
 incentive_pairs: []
+incentive_period_days: 0
+slash_fraction: "0.100000000000000000"
+probation_duration: 168h0m0s
+incentive_vesting_period: 0s
+incentive_period_budget:
+- denom: stake
+  amount: "1000000000"
 `
 
 	require.Equal(t, paramsStr, defaultParams.String())
@@ -129,6 +137,69 @@ func TestParamsValidate(t *testing.T) {
 			},
 			"",
 		},
+		{
+			"IncentivePeriodDays",
+			func(params *types.Params) {
+				params.IncentivePeriodDays = 30
+			},
+			"",
+		},
+		{
+			"ZeroSlashFraction",
+			func(params *types.Params) {
+				params.SlashFraction = sdk.ZeroDec()
+			},
+			"",
+		},
+		{
+			"NegativeSlashFraction",
+			func(params *types.Params) {
+				params.SlashFraction = sdk.NewDec(-1)
+			},
+			"slash fraction must be between 0 and 1: -1.000000000000000000",
+		},
+		{
+			"TooLargeSlashFraction",
+			func(params *types.Params) {
+				params.SlashFraction = sdk.NewDecWithPrec(11, 1)
+			},
+			"slash fraction must be between 0 and 1: 1.100000000000000000",
+		},
+		{
+			"NegativeProbationDuration",
+			func(params *types.Params) {
+				params.ProbationDuration = -time.Hour
+			},
+			"probation duration must not be negative: -1h0m0s",
+		},
+		{
+			"IncentiveVestingPeriod",
+			func(params *types.Params) {
+				params.IncentiveVestingPeriod = 30 * types.Day
+			},
+			"",
+		},
+		{
+			"NegativeIncentiveVestingPeriod",
+			func(params *types.Params) {
+				params.IncentiveVestingPeriod = -time.Hour
+			},
+			"incentive vesting period must not be negative: -1h0m0s",
+		},
+		{
+			"IncentivePeriodBudget",
+			func(params *types.Params) {
+				params.IncentivePeriodBudget = sdk.NewCoins(sdk.NewInt64Coin("stake", 1000000))
+			},
+			"",
+		},
+		{
+			"InvalidIncentivePeriodBudget",
+			func(params *types.Params) {
+				params.IncentivePeriodBudget = sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.ZeroInt()}}
+			},
+			"invalid incentive period budget: coin 0stake amount is not positive",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -27,6 +27,7 @@ func NewMarketMakerProposal(
 	exclusions []MarketMakerHandle,
 	rejections []MarketMakerHandle,
 	distributions []IncentiveDistribution,
+	slashings []MarketMakerHandle,
 ) *MarketMakerProposal {
 	return &MarketMakerProposal{
 		Title:         title,
@@ -35,6 +36,7 @@ func NewMarketMakerProposal(
 		Exclusions:    exclusions,
 		Rejections:    rejections,
 		Distributions: distributions,
+		Slashings:     slashings,
 	}
 }
 
@@ -47,7 +49,8 @@ func (p *MarketMakerProposal) ProposalRoute() string { return RouterKey }
 func (p *MarketMakerProposal) ProposalType() string { return ProposalTypeMarketMaker }
 
 func (p *MarketMakerProposal) ValidateBasic() error {
-	if len(p.Inclusions) == 0 && len(p.Exclusions) == 0 && len(p.Rejections) == 0 && len(p.Distributions) == 0 {
+	if len(p.Inclusions) == 0 && len(p.Exclusions) == 0 && len(p.Rejections) == 0 &&
+		len(p.Distributions) == 0 && len(p.Slashings) == 0 {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "proposal request must not be empty")
 	}
 
@@ -84,6 +87,18 @@ func (p *MarketMakerProposal) ValidateBasic() error {
 		}
 	}
 
+	// a market maker can be slashed and excluded at once, so slashings are checked separately
+	slashMap := make(map[MarketMakerHandle]struct{})
+	for _, mm := range p.Slashings {
+		if _, ok := slashMap[mm]; ok {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "market maker can't be duplicated")
+		}
+		slashMap[mm] = struct{}{}
+		if err := mm.Validate(); err != nil {
+			return err
+		}
+	}
+
 	for _, dp := range p.Distributions {
 		if err := dp.Validate(); err != nil {
 			return err
@@ -100,5 +115,6 @@ func (p MarketMakerProposal) String() string {
   Exclusions:    %v
   Rejections:    %v
   Distributions: %v
-`, p.Title, p.Description, p.Inclusions, p.Exclusions, p.Rejections, p.Distributions)
+  Slashings:     %v
+`, p.Title, p.Description, p.Inclusions, p.Exclusions, p.Rejections, p.Distributions, p.Slashings)
 }
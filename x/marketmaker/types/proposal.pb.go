@@ -40,6 +40,7 @@ type MarketMakerProposal struct {
 	Rejections []MarketMakerHandle `protobuf:"bytes,5,rep,name=rejections,proto3" json:"rejections" yaml:"rejections"`
 	// distribute claimable incentive to eligible market makers
 	Distributions []IncentiveDistribution `protobuf:"bytes,6,rep,name=distributions,proto3" json:"distributions" yaml:"distributions"`
+	Slashings     []MarketMakerHandle     `protobuf:"bytes,7,rep,name=slashings,proto3" json:"slashings" yaml:"slashings"`
 }
 
 func (m *MarketMakerProposal) Reset()      { *m = MarketMakerProposal{} }
@@ -162,40 +163,42 @@ func init() {
 }
 
 var fileDescriptor_3f2fb9dccf50d3c6 = []byte{
-	// 525 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0x31, 0x6f, 0xd4, 0x30,
-	0x14, 0xc7, 0x13, 0xda, 0x5e, 0xc1, 0x55, 0x91, 0x1a, 0x0e, 0xe9, 0xae, 0x42, 0xc9, 0xc9, 0xd3,
-	0x49, 0x6d, 0x13, 0x0a, 0x4c, 0xdd, 0x08, 0x0c, 0x74, 0x28, 0x42, 0x19, 0x59, 0x2a, 0x27, 0x31,
-	0xc1, 0x5c, 0x62, 0x07, 0xdb, 0xa9, 0xae, 0xdf, 0xa0, 0x23, 0x23, 0x63, 0x67, 0x3e, 0x49, 0xc7,
-	0x8e, 0x4c, 0x07, 0xba, 0x5b, 0x98, 0xef, 0x13, 0xa0, 0xd8, 0xe6, 0xce, 0xa7, 0x52, 0x96, 0x8a,
-	0x29, 0x7e, 0x79, 0xff, 0xf7, 0xff, 0xd9, 0x4f, 0xef, 0x81, 0xa1, 0xf8, 0xdc, 0xa0, 0x3c, 0xaa,
-	0x10, 0x1f, 0x61, 0x59, 0xa1, 0x11, 0xe6, 0xd1, 0xd9, 0x61, 0x8a, 0x25, 0x3a, 0x8c, 0x6a, 0xce,
-	0x6a, 0x26, 0x50, 0x19, 0xd6, 0x9c, 0x49, 0xe6, 0xf5, 0x95, 0x32, 0xb4, 0x94, 0xa1, 0x51, 0xee,
-	0x76, 0x0b, 0x56, 0x30, 0xa5, 0x8a, 0xda, 0x93, 0x2e, 0xd8, 0xed, 0x67, 0x4c, 0x54, 0x4c, 0x9c,
-	0xea, 0x84, 0x0e, 0x4c, 0xca, 0xd7, 0x51, 0x94, 0x22, 0x81, 0x17, 0xbc, 0x8c, 0x11, 0x6a, 0xf2,
-	0x7b, 0xb7, 0xdf, 0xca, 0xe6, 0x6b, 0x71, 0x50, 0x30, 0x56, 0x94, 0x38, 0x52, 0x51, 0xda, 0x7c,
-	0x88, 0x24, 0xa9, 0xb0, 0x90, 0xa8, 0xaa, 0xb5, 0x00, 0x5e, 0xac, 0x83, 0x47, 0x27, 0xaa, 0xec,
-	0xa4, 0x2d, 0x7b, 0x67, 0xde, 0xe5, 0x75, 0xc1, 0x86, 0x24, 0xb2, 0xc4, 0x3d, 0x77, 0xe0, 0x0e,
-	0x1f, 0x24, 0x3a, 0xf0, 0x06, 0x60, 0x2b, 0xc7, 0x22, 0xe3, 0xa4, 0x96, 0x84, 0xd1, 0xde, 0x3d,
-	0x95, 0xb3, 0x7f, 0x79, 0x05, 0x00, 0x84, 0x66, 0x65, 0x23, 0x08, 0xa3, 0xa2, 0xb7, 0x36, 0x58,
-	0x1b, 0x6e, 0x3d, 0xdb, 0x0f, 0x6f, 0x6d, 0x4f, 0x68, 0xb1, 0xdf, 0x20, 0x9a, 0x97, 0x38, 0xee,
-	0x5f, 0x4d, 0x02, 0x67, 0x3e, 0x09, 0x76, 0xce, 0x51, 0x55, 0x1e, 0xc1, 0xa5, 0x1b, 0x4c, 0x2c,
-	0xeb, 0x16, 0x84, 0xc7, 0x0b, 0xd0, 0xfa, 0xdd, 0x41, 0x4b, 0x37, 0x98, 0x58, 0xd6, 0x2d, 0x88,
-	0xe3, 0x4f, 0x38, 0x93, 0x0a, 0xb4, 0x71, 0x77, 0xd0, 0xd2, 0x0d, 0x26, 0x96, 0xb5, 0x27, 0xc1,
-	0x76, 0x4e, 0x84, 0xe4, 0x24, 0x6d, 0x34, 0xab, 0xa3, 0x58, 0x4f, 0xff, 0xc1, 0x3a, 0xa6, 0x19,
-	0xa6, 0x92, 0x9c, 0xe1, 0xd7, 0x56, 0x61, 0xfc, 0xc4, 0xf0, 0xba, 0x9a, 0xb7, 0x62, 0x0a, 0x93,
-	0x55, 0xc8, 0xd1, 0xfd, 0x8b, 0xcb, 0xc0, 0xf9, 0x7a, 0x19, 0x38, 0x70, 0x0c, 0x76, 0x6e, 0xdc,
-	0xdd, 0xdb, 0x07, 0x9b, 0x28, 0xcf, 0x39, 0x16, 0x42, 0x4f, 0x42, 0xec, 0xcd, 0x27, 0xc1, 0x43,
-	0x6d, 0x6c, 0x12, 0x30, 0xf9, 0x23, 0xf1, 0xf6, 0xc0, 0x66, 0x8d, 0x08, 0x3f, 0x25, 0xb9, 0x9a,
-	0x8d, 0x75, 0x5b, 0x6d, 0x12, 0x30, 0xe9, 0xb4, 0xa7, 0xe3, 0x5c, 0x93, 0x7f, 0xb5, 0xe4, 0xb9,
-	0x0b, 0x1e, 0xff, 0xf5, 0x29, 0xff, 0x11, 0xef, 0x49, 0xd0, 0x41, 0x15, 0x6b, 0xa8, 0x34, 0x53,
-	0xda, 0x0f, 0xcd, 0x1a, 0xb6, 0x8b, 0xb7, 0xe8, 0xf0, 0x2b, 0x46, 0x68, 0xfc, 0xd2, 0x34, 0x74,
-	0xdb, 0x80, 0x55, 0x19, 0xfc, 0xf6, 0x23, 0x18, 0x16, 0x44, 0x7e, 0x6c, 0xd2, 0x30, 0x63, 0x95,
-	0x59, 0x62, 0xf3, 0x39, 0x10, 0xf9, 0x28, 0x92, 0xe7, 0x35, 0x16, 0xca, 0x41, 0x24, 0x86, 0xb5,
-	0x7c, 0x74, 0xfc, 0xf6, 0x6a, 0xea, 0xbb, 0xd7, 0x53, 0xdf, 0xfd, 0x39, 0xf5, 0xdd, 0x2f, 0x33,
-	0xdf, 0xb9, 0x9e, 0xf9, 0xce, 0xf7, 0x99, 0xef, 0xbc, 0x7f, 0x71, 0xc3, 0xb5, 0x1d, 0x80, 0x83,
-	0x12, 0xa5, 0x22, 0xd2, 0xcb, 0x3f, 0x5e, 0x59, 0x7f, 0xc5, 0x49, 0x3b, 0x6a, 0xa1, 0x9f, 0xff,
-	0x0e, 0x00, 0x00, 0xff, 0xff, 0x6c, 0xc1, 0x74, 0x00, 0xb6, 0x04, 0x00, 0x00,
+	// 552 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0x31, 0x73, 0xd3, 0x30,
+	0x14, 0xc7, 0x6d, 0x9a, 0x26, 0x54, 0xbd, 0x72, 0xd4, 0x84, 0xbb, 0xa4, 0xc7, 0xd9, 0x39, 0x4d,
+	0xb9, 0x6b, 0x6b, 0x53, 0x60, 0xea, 0x46, 0x60, 0xa0, 0x43, 0x39, 0xce, 0x23, 0x4b, 0x4f, 0xb6,
+	0x84, 0x2b, 0x62, 0x4b, 0xc6, 0x92, 0x7b, 0xe9, 0x37, 0x60, 0x64, 0x64, 0xec, 0xcc, 0x27, 0xe9,
+	0xd8, 0x91, 0x29, 0x70, 0xc9, 0xc2, 0xc4, 0x90, 0x4f, 0xc0, 0x59, 0x12, 0xb1, 0x73, 0xa5, 0x0c,
+	0xf4, 0x98, 0xac, 0xe7, 0xf7, 0x7f, 0xff, 0x9f, 0xf5, 0x2c, 0x3d, 0x30, 0x14, 0x1f, 0x4a, 0x84,
+	0x83, 0x0c, 0x15, 0x63, 0x22, 0x33, 0x34, 0x26, 0x45, 0x70, 0x76, 0x10, 0x11, 0x89, 0x0e, 0x82,
+	0xbc, 0xe0, 0x39, 0x17, 0x28, 0xf5, 0xf3, 0x82, 0x4b, 0xee, 0xf4, 0x95, 0xd2, 0x6f, 0x28, 0x7d,
+	0xa3, 0xdc, 0xe9, 0x26, 0x3c, 0xe1, 0x4a, 0x15, 0x54, 0x2b, 0x5d, 0xb0, 0xd3, 0x8f, 0xb9, 0xc8,
+	0xb8, 0x38, 0xd1, 0x09, 0x1d, 0x98, 0x94, 0xab, 0xa3, 0x20, 0x42, 0x82, 0x2c, 0x79, 0x31, 0xa7,
+	0xcc, 0xe4, 0x77, 0x6f, 0xfe, 0xaa, 0x26, 0x5f, 0x8b, 0xbd, 0x84, 0xf3, 0x24, 0x25, 0x81, 0x8a,
+	0xa2, 0xf2, 0x5d, 0x20, 0x69, 0x46, 0x84, 0x44, 0x59, 0xae, 0x05, 0xf0, 0x67, 0x0b, 0x3c, 0x38,
+	0x56, 0x65, 0xc7, 0x55, 0xd9, 0x1b, 0xb3, 0x2f, 0xa7, 0x0b, 0xd6, 0x25, 0x95, 0x29, 0xe9, 0xd9,
+	0x03, 0x7b, 0xb8, 0x11, 0xea, 0xc0, 0x19, 0x80, 0x4d, 0x4c, 0x44, 0x5c, 0xd0, 0x5c, 0x52, 0xce,
+	0x7a, 0x77, 0x54, 0xae, 0xf9, 0xca, 0x49, 0x00, 0xa0, 0x2c, 0x4e, 0x4b, 0x41, 0x39, 0x13, 0xbd,
+	0xb5, 0xc1, 0xda, 0x70, 0xf3, 0xc9, 0x9e, 0x7f, 0x63, 0x7b, 0xfc, 0x06, 0xfb, 0x15, 0x62, 0x38,
+	0x25, 0xa3, 0xfe, 0xe5, 0xd4, 0xb3, 0x16, 0x53, 0x6f, 0xfb, 0x1c, 0x65, 0xe9, 0x21, 0xac, 0xdd,
+	0x60, 0xd8, 0xb0, 0xae, 0x40, 0x64, 0xb2, 0x04, 0xb5, 0x6e, 0x0f, 0xaa, 0xdd, 0x60, 0xd8, 0xb0,
+	0xae, 0x40, 0x05, 0x79, 0x4f, 0x62, 0xa9, 0x40, 0xeb, 0xb7, 0x07, 0xd5, 0x6e, 0x30, 0x6c, 0x58,
+	0x3b, 0x12, 0x6c, 0x61, 0x2a, 0x64, 0x41, 0xa3, 0x52, 0xb3, 0xda, 0x8a, 0xf5, 0xf8, 0x2f, 0xac,
+	0x23, 0x16, 0x13, 0x26, 0xe9, 0x19, 0x79, 0xd9, 0x28, 0x1c, 0x3d, 0x32, 0xbc, 0xae, 0xe6, 0xad,
+	0x98, 0xc2, 0x70, 0x15, 0xe2, 0x60, 0xb0, 0x21, 0x52, 0x24, 0x4e, 0x29, 0x4b, 0x44, 0xaf, 0xf3,
+	0x0f, 0xbb, 0xeb, 0x19, 0xda, 0x7d, 0x4d, 0x5b, 0x9a, 0xc1, 0xb0, 0x36, 0x3e, 0xbc, 0xfb, 0xf1,
+	0xc2, 0xb3, 0x3e, 0x5f, 0x78, 0x16, 0x9c, 0x80, 0xed, 0x6b, 0x1e, 0xce, 0x1e, 0xe8, 0x20, 0x8c,
+	0x0b, 0x22, 0x84, 0x3e, 0x6f, 0x23, 0x67, 0x31, 0xf5, 0xee, 0x69, 0x43, 0x93, 0x80, 0xe1, 0x6f,
+	0x89, 0xb3, 0x0b, 0x3a, 0x39, 0xa2, 0xc5, 0x09, 0xc5, 0xea, 0x04, 0xb6, 0x9a, 0x6a, 0x93, 0x80,
+	0x61, 0xbb, 0x5a, 0x1d, 0x61, 0x4d, 0xfe, 0x51, 0x91, 0x17, 0x36, 0x78, 0xf8, 0xc7, 0x86, 0xfd,
+	0x47, 0xbc, 0x23, 0x41, 0x1b, 0x65, 0xbc, 0x64, 0xd2, 0xdc, 0x85, 0xbe, 0x6f, 0x2e, 0x7b, 0x75,
+	0xbd, 0x97, 0x5d, 0x7d, 0xc1, 0x29, 0x1b, 0x3d, 0x37, 0x8d, 0xdc, 0x32, 0x60, 0x55, 0x06, 0xbf,
+	0x7c, 0xf3, 0x86, 0x09, 0x95, 0xa7, 0x65, 0xe4, 0xc7, 0x3c, 0x33, 0xa3, 0xc2, 0x3c, 0xf6, 0x05,
+	0x1e, 0x07, 0xf2, 0x3c, 0x27, 0x42, 0x39, 0x88, 0xd0, 0xb0, 0xea, 0x4d, 0x8f, 0x5e, 0x5f, 0xce,
+	0x5c, 0xfb, 0x6a, 0xe6, 0xda, 0xdf, 0x67, 0xae, 0xfd, 0x69, 0xee, 0x5a, 0x57, 0x73, 0xd7, 0xfa,
+	0x3a, 0x77, 0xad, 0xb7, 0xcf, 0xae, 0xb9, 0x56, 0x3f, 0x7d, 0x3f, 0x45, 0x91, 0x08, 0xf4, 0x88,
+	0x99, 0xac, 0x0c, 0x19, 0xc5, 0x89, 0xda, 0x6a, 0x6c, 0x3c, 0xfd, 0x15, 0x00, 0x00, 0xff, 0xff,
+	0xdd, 0xe9, 0x5e, 0xba, 0x1c, 0x05, 0x00, 0x00,
 }
 
 func (m *MarketMakerProposal) Marshal() (dAtA []byte, err error) {
@@ -218,6 +221,20 @@ func (m *MarketMakerProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Slashings) > 0 {
+		for iNdEx := len(m.Slashings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Slashings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProposal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.Distributions) > 0 {
 		for iNdEx := len(m.Distributions) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -424,6 +441,12 @@ func (m *MarketMakerProposal) Size() (n int) {
 			n += 1 + l + sovProposal(uint64(l))
 		}
 	}
+	if len(m.Slashings) > 0 {
+		for _, e := range m.Slashings {
+			l = e.Size()
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -700,6 +723,40 @@ func (m *MarketMakerProposal) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Slashings = append(m.Slashings, MarketMakerHandle{})
+			if err := m.Slashings[len(m.Slashings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposal(dAtA[iNdEx:])
@@ -31,6 +31,7 @@ func TestMarketMakerProposal_ValidateBasic(t *testing.T) {
 				proposal.Exclusions = []types.MarketMakerHandle{}
 				proposal.Rejections = []types.MarketMakerHandle{}
 				proposal.Distributions = []types.IncentiveDistribution{}
+				proposal.Slashings = []types.MarketMakerHandle{}
 			},
 			"proposal request must not be empty: invalid request",
 		},
@@ -79,6 +80,26 @@ func TestMarketMakerProposal_ValidateBasic(t *testing.T) {
 			},
 			"market maker can't be duplicated: invalid request",
 		},
+		{
+			"duplicated market maker on slashing",
+			func(proposal *types.MarketMakerProposal) {
+				proposal.Slashings = []types.MarketMakerHandle{
+					{Address: mm2.String(), PairId: 4},
+					{Address: mm2.String(), PairId: 4},
+				}
+			},
+			"market maker can't be duplicated: invalid request",
+		},
+		{
+			"slashing only",
+			func(proposal *types.MarketMakerProposal) {
+				proposal.Inclusions = nil
+				proposal.Exclusions = nil
+				proposal.Rejections = nil
+				proposal.Distributions = nil
+			},
+			"",
+		},
 		{
 			"zero pair id",
 			func(proposal *types.MarketMakerProposal) {
@@ -138,6 +159,9 @@ func TestMarketMakerProposal_ValidateBasic(t *testing.T) {
 						PairId:  2,
 						Amount:  sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000000))),
 					},
+				},
+				[]types.MarketMakerHandle{
+					{Address: mm2.String(), PairId: 4},
 				})
 			tc.malleate(proposal)
 			err := proposal.ValidateBasic()
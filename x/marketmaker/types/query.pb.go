@@ -7,7 +7,8 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-sdk/codec/types"
-	_ "github.com/cosmos/cosmos-sdk/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -330,6 +331,254 @@ func (m *QueryIncentiveResponse) GetIncentive() Incentive {
 	return Incentive{}
 }
 
+// QueryScoresRequest is the request type for the Query/Scores RPC method.
+type QueryScoresRequest struct {
+	Address    string             `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	PairId     uint64             `protobuf:"varint,2,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryScoresRequest) Reset()         { *m = QueryScoresRequest{} }
+func (m *QueryScoresRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScoresRequest) ProtoMessage()    {}
+func (*QueryScoresRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d370b66f25c44c1, []int{6}
+}
+func (m *QueryScoresRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScoresRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScoresRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScoresRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScoresRequest.Merge(m, src)
+}
+func (m *QueryScoresRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScoresRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScoresRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScoresRequest proto.InternalMessageInfo
+
+func (m *QueryScoresRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryScoresRequest) GetPairId() uint64 {
+	if m != nil {
+		return m.PairId
+	}
+	return 0
+}
+
+func (m *QueryScoresRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryScoresResponse is the response type for the Query/Scores RPC method.
+type QueryScoresResponse struct {
+	Scores       []MarketMakerScore `protobuf:"bytes,1,rep,name=scores,proto3" json:"scores"`
+	ScoringState *ScoringState      `protobuf:"bytes,2,opt,name=scoring_state,json=scoringState,proto3" json:"scoring_state,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryScoresResponse) Reset()         { *m = QueryScoresResponse{} }
+func (m *QueryScoresResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScoresResponse) ProtoMessage()    {}
+func (*QueryScoresResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d370b66f25c44c1, []int{7}
+}
+func (m *QueryScoresResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScoresResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScoresResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScoresResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScoresResponse.Merge(m, src)
+}
+func (m *QueryScoresResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScoresResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScoresResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScoresResponse proto.InternalMessageInfo
+
+func (m *QueryScoresResponse) GetScores() []MarketMakerScore {
+	if m != nil {
+		return m.Scores
+	}
+	return nil
+}
+
+func (m *QueryScoresResponse) GetScoringState() *ScoringState {
+	if m != nil {
+		return m.ScoringState
+	}
+	return nil
+}
+
+func (m *QueryScoresResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryIncentiveStreamsRequest is the request type for the Query/IncentiveStreams RPC method.
+type QueryIncentiveStreamsRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	PairId  uint64 `protobuf:"varint,2,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+}
+
+func (m *QueryIncentiveStreamsRequest) Reset()         { *m = QueryIncentiveStreamsRequest{} }
+func (m *QueryIncentiveStreamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIncentiveStreamsRequest) ProtoMessage()    {}
+func (*QueryIncentiveStreamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d370b66f25c44c1, []int{8}
+}
+func (m *QueryIncentiveStreamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIncentiveStreamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIncentiveStreamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIncentiveStreamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIncentiveStreamsRequest.Merge(m, src)
+}
+func (m *QueryIncentiveStreamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIncentiveStreamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIncentiveStreamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIncentiveStreamsRequest proto.InternalMessageInfo
+
+func (m *QueryIncentiveStreamsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryIncentiveStreamsRequest) GetPairId() uint64 {
+	if m != nil {
+		return m.PairId
+	}
+	return 0
+}
+
+// QueryIncentiveStreamsResponse is the response type for the Query/IncentiveStreams RPC method.
+type QueryIncentiveStreamsResponse struct {
+	Streams []IncentiveStream `protobuf:"bytes,1,rep,name=streams,proto3" json:"streams"`
+	// vested is the total amount unlocked so far, including the claimed amount
+	Vested github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=vested,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"vested"`
+	// unvested is the total amount not unlocked yet
+	Unvested github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=unvested,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"unvested"`
+	// claimed is the total amount already claimed
+	Claimed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=claimed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"claimed"`
+}
+
+func (m *QueryIncentiveStreamsResponse) Reset()         { *m = QueryIncentiveStreamsResponse{} }
+func (m *QueryIncentiveStreamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIncentiveStreamsResponse) ProtoMessage()    {}
+func (*QueryIncentiveStreamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d370b66f25c44c1, []int{9}
+}
+func (m *QueryIncentiveStreamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIncentiveStreamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIncentiveStreamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIncentiveStreamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIncentiveStreamsResponse.Merge(m, src)
+}
+func (m *QueryIncentiveStreamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIncentiveStreamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIncentiveStreamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIncentiveStreamsResponse proto.InternalMessageInfo
+
+func (m *QueryIncentiveStreamsResponse) GetStreams() []IncentiveStream {
+	if m != nil {
+		return m.Streams
+	}
+	return nil
+}
+
+func (m *QueryIncentiveStreamsResponse) GetVested() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Vested
+	}
+	return nil
+}
+
+func (m *QueryIncentiveStreamsResponse) GetUnvested() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Unvested
+	}
+	return nil
+}
+
+func (m *QueryIncentiveStreamsResponse) GetClaimed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Claimed
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "squad.marketmaker.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "squad.marketmaker.v1beta1.QueryParamsResponse")
@@ -337,6 +586,10 @@ func init() {
 	proto.RegisterType((*QueryMarketMakersResponse)(nil), "squad.marketmaker.v1beta1.QueryMarketMakersResponse")
 	proto.RegisterType((*QueryIncentiveRequest)(nil), "squad.marketmaker.v1beta1.QueryIncentiveRequest")
 	proto.RegisterType((*QueryIncentiveResponse)(nil), "squad.marketmaker.v1beta1.QueryIncentiveResponse")
+	proto.RegisterType((*QueryScoresRequest)(nil), "squad.marketmaker.v1beta1.QueryScoresRequest")
+	proto.RegisterType((*QueryScoresResponse)(nil), "squad.marketmaker.v1beta1.QueryScoresResponse")
+	proto.RegisterType((*QueryIncentiveStreamsRequest)(nil), "squad.marketmaker.v1beta1.QueryIncentiveStreamsRequest")
+	proto.RegisterType((*QueryIncentiveStreamsResponse)(nil), "squad.marketmaker.v1beta1.QueryIncentiveStreamsResponse")
 }
 
 func init() {
@@ -344,70 +597,89 @@ func init() {
 }
 
 var fileDescriptor_6d370b66f25c44c1 = []byte{
-	// 994 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x96, 0x4f, 0x6f, 0xdc, 0xc4,
-	0x1b, 0xc7, 0xe3, 0xec, 0x36, 0xfd, 0x65, 0xda, 0x9f, 0x84, 0x86, 0x16, 0x36, 0x16, 0xda, 0x4e,
-	0xcd, 0x9f, 0x14, 0x68, 0xec, 0xdd, 0x24, 0x55, 0xa5, 0xa0, 0x0a, 0x25, 0x12, 0x81, 0x54, 0xb4,
-	0x2a, 0x8b, 0xc4, 0xa1, 0x08, 0x85, 0xb1, 0xfd, 0xc4, 0x19, 0x6a, 0xcf, 0x38, 0x33, 0xe3, 0x40,
-	0x54, 0x45, 0x42, 0xa8, 0x47, 0x0e, 0x55, 0x78, 0x15, 0x1c, 0x2b, 0x24, 0xce, 0x1c, 0x7b, 0xac,
-	0x84, 0x84, 0x38, 0xa1, 0x28, 0xe9, 0x95, 0xf7, 0x80, 0x3c, 0x1e, 0x6f, 0x76, 0xd3, 0x6c, 0x36,
-	0xbd, 0x21, 0x71, 0x5a, 0x8f, 0xfd, 0x3c, 0xdf, 0xe7, 0x33, 0xcf, 0x7c, 0xfd, 0x78, 0xd1, 0xdb,
-	0x6a, 0xab, 0xa0, 0x71, 0x90, 0x51, 0xf9, 0x00, 0x74, 0x46, 0x1f, 0x80, 0x0c, 0xb6, 0xbb, 0x21,
-	0x68, 0xda, 0x0d, 0xb6, 0x0a, 0x90, 0x3b, 0x7e, 0x2e, 0x85, 0x16, 0x78, 0xc6, 0x84, 0xf9, 0x03,
-	0x61, 0xbe, 0x0d, 0x73, 0xdf, 0x1f, 0xad, 0x30, 0x18, 0x6e, 0x74, 0xdc, 0x99, 0x48, 0xa8, 0x4c,
-	0xa8, 0x75, 0xb3, 0x0a, 0xaa, 0x85, 0x7d, 0xf4, 0x5e, 0xb5, 0x0a, 0x42, 0xaa, 0xa0, 0xaa, 0xdd,
-	0xd7, 0xc9, 0x69, 0xc2, 0x38, 0xd5, 0x4c, 0x70, 0x1b, 0xdb, 0x1e, 0x8c, 0xad, 0xa3, 0x22, 0xc1,
-	0xea, 0xe7, 0x97, 0x12, 0x91, 0x88, 0xaa, 0x46, 0x79, 0x55, 0x17, 0x4f, 0x84, 0x48, 0x52, 0x08,
-	0xcc, 0x2a, 0x2c, 0x36, 0x02, 0xca, 0xed, 0xfe, 0xdc, 0x2b, 0xc7, 0x1f, 0x69, 0x96, 0x81, 0xd2,
-	0x34, 0xcb, 0x6d, 0xc0, 0x1b, 0x36, 0x80, 0xe6, 0x2c, 0xa0, 0x9c, 0x0b, 0x6d, 0x70, 0x6a, 0xf6,
-	0xea, 0x27, 0x9a, 0x4b, 0x80, 0xcf, 0x89, 0x1c, 0x38, 0xcd, 0xd9, 0xf6, 0x7c, 0x20, 0x72, 0x13,
-	0xf3, 0x62, 0xbc, 0x77, 0x09, 0xe1, 0xcf, 0xca, 0x1d, 0xde, 0xa3, 0x92, 0x66, 0xaa, 0x07, 0x5b,
-	0x05, 0x28, 0xed, 0x7d, 0x81, 0x5e, 0x1d, 0xba, 0xab, 0x72, 0xc1, 0x15, 0xe0, 0x0f, 0xd1, 0x54,
-	0x6e, 0xee, 0xb4, 0x1c, 0xe2, 0x5c, 0xbb, 0x30, 0x7f, 0xd5, 0x1f, 0x79, 0x18, 0x7e, 0x95, 0xba,
-	0xd2, 0x7c, 0xfa, 0xd7, 0x95, 0x89, 0x9e, 0x4d, 0xf3, 0x9e, 0x38, 0xa8, 0x65, 0x84, 0xef, 0x98,
-	0x8c, 0x3b, 0x65, 0x46, 0x5d, 0x14, 0xb7, 0xd0, 0x79, 0x1a, 0xc7, 0x12, 0x54, 0x25, 0x3f, 0xdd,
-	0xab, 0x97, 0xf8, 0x75, 0x74, 0x3e, 0xa7, 0x4c, 0xae, 0xb3, 0xb8, 0x35, 0x49, 0x9c, 0x6b, 0xcd,
-	0x52, 0x8f, 0xc9, 0xb5, 0x18, 0xbb, 0xe8, 0x7f, 0x90, 0xb2, 0x84, 0x85, 0x29, 0xb4, 0x1a, 0x26,
-	0xa7, 0xbf, 0xc6, 0xab, 0x08, 0x1d, 0x9d, 0x56, 0xab, 0x69, 0x80, 0xdf, 0xf1, 0xed, 0x41, 0x97,
-	0xc7, 0xe5, 0x57, 0xb6, 0x3a, 0x02, 0x4e, 0xc0, 0xa2, 0xf4, 0x06, 0x32, 0xbd, 0x5f, 0x1d, 0x34,
-	0x73, 0x02, 0xb3, 0x6d, 0xc9, 0x3d, 0x74, 0x71, 0x60, 0xf7, 0x25, 0x79, 0xc3, 0xd4, 0x19, 0xdd,
-	0x98, 0x01, 0x19, 0xdb, 0x9d, 0x21, 0x05, 0xfc, 0xf1, 0x10, 0xf7, 0xa4, 0xe1, 0x9e, 0x1d, 0xcb,
-	0x5d, 0xe1, 0x0c, 0x81, 0x77, 0xd1, 0x65, 0xc3, 0xbd, 0xc6, 0x23, 0xe0, 0x9a, 0x6d, 0xc3, 0xd8,
-	0x46, 0x7b, 0x21, 0x7a, 0xed, 0x78, 0x8a, 0xdd, 0xe7, 0x27, 0x68, 0x9a, 0xd5, 0x37, 0xed, 0xe9,
-	0xbf, 0x75, 0xca, 0x26, 0xfb, 0x02, 0x76, 0x8b, 0x47, 0xc9, 0xf3, 0x8f, 0xff, 0x8f, 0xce, 0x99,
-	0x22, 0xf8, 0xb7, 0x49, 0x34, 0x55, 0xd9, 0x04, 0xcf, 0x9d, 0xa2, 0xf5, 0xa2, 0x3f, 0x5d, 0xff,
-	0xac, 0xe1, 0x15, 0xbd, 0xf7, 0x87, 0xb3, 0xb7, 0xfc, 0xb3, 0xe3, 0x76, 0x7b, 0xa0, 0x0b, 0xc9,
-	0x15, 0xa1, 0x69, 0x4a, 0x8c, 0x25, 0x41, 0x83, 0x54, 0x44, 0x6c, 0x10, 0xbd, 0x09, 0x64, 0x40,
-	0x8d, 0x64, 0x22, 0x2e, 0x52, 0xf0, 0x3d, 0x8d, 0xda, 0xab, 0x8c, 0xc7, 0x44, 0x14, 0x9a, 0x64,
-	0x42, 0x02, 0xa1, 0x61, 0x79, 0x59, 0x86, 0x57, 0x9e, 0xc6, 0xbd, 0x4d, 0xad, 0x73, 0xb5, 0x14,
-	0x04, 0x09, 0xd3, 0x9b, 0x45, 0xe8, 0x47, 0x22, 0xb3, 0xe3, 0xa4, 0x44, 0x9c, 0x4b, 0x69, 0xa8,
-	0x82, 0x6a, 0x30, 0x85, 0xa9, 0x08, 0x83, 0x8c, 0x32, 0x1e, 0x7c, 0x37, 0x34, 0xa4, 0x54, 0x0e,
-	0x51, 0xd0, 0xb9, 0xb9, 0x5e, 0x09, 0xfa, 0x59, 0xfc, 0xc3, 0xef, 0xcf, 0x7f, 0x9a, 0x7c, 0x13,
-	0x5f, 0x0d, 0x46, 0x0f, 0x34, 0x5b, 0x7c, 0xbf, 0x89, 0x2e, 0x0e, 0xfa, 0x12, 0x2f, 0x8c, 0xeb,
-	0xcc, 0x09, 0x6f, 0x9e, 0xbb, 0xf8, 0x72, 0x49, 0xb6, 0xa9, 0xcf, 0x1b, 0x7b, 0xcb, 0x4f, 0x1a,
-	0xee, 0x07, 0xfd, 0xa6, 0x92, 0x94, 0x29, 0x5d, 0x36, 0xb3, 0x6c, 0x6f, 0xa5, 0x45, 0x2a, 0x53,
-	0x93, 0x6f, 0x99, 0xde, 0x24, 0x47, 0xde, 0x24, 0x12, 0x54, 0x91, 0x6a, 0xdf, 0xdb, 0x44, 0x73,
-	0xa3, 0xda, 0x6b, 0x5c, 0x4e, 0x28, 0x8f, 0x09, 0x48, 0x29, 0x24, 0x89, 0x44, 0x0c, 0x0a, 0xdf,
-	0x3c, 0x73, 0xb7, 0xb5, 0x04, 0xa8, 0xba, 0x1d, 0x8b, 0x48, 0xdd, 0x7e, 0xe4, 0xa0, 0xc6, 0x62,
-	0xa7, 0x83, 0x77, 0xd1, 0x85, 0x15, 0x1a, 0x93, 0x7a, 0xd2, 0x71, 0xf4, 0x0a, 0xcd, 0xf3, 0x94,
-	0x45, 0x86, 0x2b, 0xf8, 0x46, 0x09, 0x8e, 0xef, 0x3f, 0xf4, 0xca, 0x62, 0xde, 0xd2, 0xc2, 0x75,
-	0x2f, 0x03, 0xa5, 0x68, 0x02, 0xde, 0x92, 0x27, 0xf3, 0xa8, 0x22, 0x59, 0x32, 0x28, 0xe4, 0x16,
-	0x59, 0xe3, 0xdb, 0x34, 0x65, 0xf1, 0xb2, 0x4c, 0x8a, 0x0c, 0xb8, 0x26, 0x31, 0xa8, 0x88, 0xdc,
-	0x22, 0x90, 0xe5, 0x7a, 0x87, 0x48, 0x5b, 0xe0, 0xba, 0x17, 0x83, 0xa6, 0x2c, 0x55, 0xde, 0xd2,
-	0x97, 0x5f, 0xed, 0xde, 0xfe, 0xde, 0x41, 0x8d, 0x1b, 0x9d, 0x0e, 0xde, 0x41, 0x97, 0xd7, 0xb8,
-	0x06, 0xc9, 0x69, 0x4a, 0x3e, 0x07, 0xb9, 0x0d, 0x92, 0x7c, 0x54, 0x8a, 0x7b, 0x5f, 0x9f, 0x00,
-	0xf4, 0x69, 0x0d, 0xd4, 0x1d, 0x4b, 0x64, 0x25, 0x6b, 0x14, 0x23, 0x39, 0x8c, 0x60, 0xcc, 0xf5,
-	0x2e, 0x9e, 0x0d, 0xce, 0xf4, 0xb5, 0x54, 0x78, 0xff, 0x1c, 0x9a, 0xee, 0xbf, 0xce, 0xb8, 0x33,
-	0xce, 0x2a, 0xc7, 0xa7, 0x8d, 0xdb, 0x7d, 0x89, 0x0c, 0xeb, 0xac, 0xbf, 0x9b, 0x7b, 0xcb, 0xbf,
-	0x34, 0x5d, 0xbf, 0x76, 0xd6, 0xb1, 0x97, 0x73, 0x56, 0x91, 0x28, 0xa5, 0x2c, 0xa3, 0x61, 0x0a,
-	0xa4, 0x3f, 0x58, 0xfe, 0x83, 0x66, 0xfa, 0xd1, 0x60, 0x2c, 0xe2, 0x47, 0xce, 0x30, 0x47, 0x71,
-	0x02, 0x07, 0xad, 0x39, 0x6e, 0x9c, 0xce, 0x71, 0x57, 0xe8, 0x55, 0x51, 0xf0, 0xb8, 0x06, 0xe8,
-	0xf7, 0x97, 0xd8, 0x8f, 0x04, 0xe1, 0x42, 0x93, 0x8d, 0x32, 0xe4, 0x5f, 0xea, 0xed, 0x0e, 0xf6,
-	0x4f, 0xf1, 0x76, 0x7f, 0x3f, 0xc1, 0x43, 0xbb, 0xa1, 0xdd, 0x95, 0xbb, 0x4f, 0x0f, 0xda, 0xce,
-	0xb3, 0x83, 0xb6, 0xb3, 0x7f, 0xd0, 0x76, 0x1e, 0x1f, 0xb6, 0x27, 0x9e, 0x1d, 0xb6, 0x27, 0xfe,
-	0x3c, 0x6c, 0x4f, 0xdc, 0x5f, 0x1c, 0xeb, 0x8c, 0xe1, 0x51, 0xae, 0x77, 0x72, 0x50, 0xe1, 0x94,
-	0xf9, 0x6f, 0xb5, 0xf0, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf4, 0x90, 0x39, 0x7f, 0xd3, 0x0a,
-	0x00, 0x00,
+	// 1310 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0x4f, 0x8c, 0x14, 0xc5,
+	0x17, 0xde, 0xde, 0x1e, 0x76, 0xa1, 0xe0, 0x97, 0x90, 0xfa, 0x81, 0xec, 0x76, 0x70, 0x28, 0xda,
+	0x3f, 0x20, 0x30, 0xdd, 0xb3, 0xb0, 0x08, 0x59, 0x43, 0xcc, 0xae, 0x11, 0xdd, 0x0d, 0x10, 0x98,
+	0x4d, 0x4c, 0xc4, 0x98, 0xb5, 0xa6, 0xbb, 0xe8, 0x2d, 0xe9, 0xae, 0x6a, 0xba, 0xaa, 0x57, 0x37,
+	0x84, 0xc4, 0x18, 0xe2, 0xc9, 0x44, 0x5d, 0x4f, 0xde, 0xbc, 0xea, 0x8d, 0x98, 0x78, 0xe6, 0xc8,
+	0x4d, 0x12, 0x13, 0xf5, 0xa4, 0x04, 0xb8, 0x7a, 0xf6, 0x6a, 0xba, 0xaa, 0x7a, 0x66, 0x7a, 0x77,
+	0x67, 0x67, 0x40, 0x12, 0x49, 0xe4, 0x34, 0x5d, 0xd5, 0xef, 0x7d, 0xef, 0xab, 0xf7, 0xbe, 0xae,
+	0xf7, 0x32, 0xe0, 0x25, 0x71, 0x2d, 0xc7, 0xa1, 0x9f, 0xe0, 0xec, 0x2a, 0x91, 0x09, 0xbe, 0x4a,
+	0x32, 0x7f, 0x65, 0xaa, 0x4d, 0x24, 0x9e, 0xf2, 0xaf, 0xe5, 0x24, 0x5b, 0xf5, 0xd2, 0x8c, 0x4b,
+	0x0e, 0x27, 0x95, 0x99, 0xd7, 0x63, 0xe6, 0x19, 0x33, 0xe7, 0x68, 0x7f, 0x84, 0x5e, 0x73, 0x85,
+	0xe3, 0x4c, 0x06, 0x5c, 0x24, 0x5c, 0x2c, 0xa9, 0x95, 0xaf, 0x17, 0xe6, 0xd5, 0x11, 0xbd, 0xf2,
+	0xdb, 0x58, 0x10, 0x1d, 0xbb, 0x83, 0x93, 0xe2, 0x88, 0x32, 0x2c, 0x29, 0x67, 0xc6, 0xb6, 0xde,
+	0x6b, 0x5b, 0x5a, 0x05, 0x9c, 0x96, 0xef, 0xf7, 0x44, 0x3c, 0xe2, 0x3a, 0x46, 0xf1, 0x54, 0x06,
+	0x8f, 0x38, 0x8f, 0x62, 0xe2, 0xab, 0x55, 0x3b, 0xbf, 0xe2, 0x63, 0x66, 0xce, 0xe7, 0x1c, 0x58,
+	0xff, 0x4a, 0xd2, 0x84, 0x08, 0x89, 0x93, 0xd4, 0x18, 0xec, 0x37, 0x06, 0x38, 0xa5, 0x3e, 0x66,
+	0x8c, 0x4b, 0x45, 0xa7, 0xe4, 0xae, 0x7f, 0x82, 0x46, 0x44, 0x58, 0x83, 0xa7, 0x84, 0xe1, 0x94,
+	0xae, 0x1c, 0xf7, 0x79, 0xaa, 0x6c, 0x36, 0xda, 0xbb, 0x7b, 0x00, 0xbc, 0x54, 0x9c, 0xf0, 0x22,
+	0xce, 0x70, 0x22, 0x5a, 0xe4, 0x5a, 0x4e, 0x84, 0x74, 0xdf, 0x01, 0xff, 0xaf, 0xec, 0x8a, 0x94,
+	0x33, 0x41, 0xe0, 0xeb, 0x60, 0x2c, 0x55, 0x3b, 0x13, 0x16, 0xb2, 0x0e, 0xef, 0x3c, 0x7e, 0xd0,
+	0xeb, 0x5b, 0x0c, 0x4f, 0xbb, 0xce, 0xd5, 0xee, 0xfc, 0x7e, 0x60, 0xa4, 0x65, 0xdc, 0xdc, 0x5b,
+	0x16, 0x98, 0x50, 0xc0, 0xe7, 0x95, 0xc7, 0xf9, 0xc2, 0xa3, 0x0c, 0x0a, 0x27, 0xc0, 0x38, 0x0e,
+	0xc3, 0x8c, 0x08, 0x0d, 0xbf, 0xa3, 0x55, 0x2e, 0xe1, 0x3e, 0x30, 0x9e, 0x62, 0x9a, 0x2d, 0xd1,
+	0x70, 0x62, 0x14, 0x59, 0x87, 0x6b, 0x05, 0x1e, 0xcd, 0xe6, 0x43, 0xe8, 0x80, 0xed, 0x24, 0xa6,
+	0x11, 0x6d, 0xc7, 0x64, 0xc2, 0x56, 0x3e, 0x9d, 0x35, 0x3c, 0x0b, 0x40, 0xb7, 0x5a, 0x13, 0x35,
+	0x45, 0xf8, 0x65, 0xcf, 0x14, 0xba, 0x28, 0x97, 0xa7, 0x65, 0xd5, 0x25, 0x1c, 0x11, 0x43, 0xa5,
+	0xd5, 0xe3, 0xe9, 0xfe, 0x68, 0x81, 0xc9, 0x4d, 0x38, 0x9b, 0x94, 0x5c, 0x04, 0xbb, 0x7a, 0x4e,
+	0x5f, 0x30, 0xb7, 0x55, 0x9c, 0xfe, 0x89, 0xe9, 0x81, 0x31, 0xd9, 0xa9, 0x20, 0xc0, 0xb7, 0x2a,
+	0xbc, 0x47, 0x15, 0xef, 0x43, 0x03, 0x79, 0x6b, 0x3a, 0x15, 0xe2, 0x53, 0x60, 0xaf, 0xe2, 0x3d,
+	0xcf, 0x02, 0xc2, 0x24, 0x5d, 0x21, 0x03, 0x13, 0xed, 0xb6, 0xc1, 0x73, 0xeb, 0x5d, 0xcc, 0x39,
+	0xdf, 0x06, 0x3b, 0x68, 0xb9, 0x69, 0xaa, 0xff, 0xe2, 0x16, 0x87, 0xec, 0x00, 0x98, 0x23, 0x76,
+	0x9d, 0xdd, 0x2f, 0x2c, 0x23, 0xb9, 0xc5, 0x80, 0x67, 0xe4, 0x9f, 0x54, 0xbf, 0x5a, 0x61, 0xfb,
+	0xb1, 0x2b, 0xfc, 0x97, 0x65, 0xe4, 0x5e, 0x32, 0x32, 0x67, 0x9e, 0x07, 0x63, 0x42, 0xed, 0x98,
+	0xaa, 0x1e, 0x1d, 0xae, 0xaa, 0x0a, 0xa5, 0x14, 0xbe, 0x06, 0x80, 0xe7, 0xc0, 0xff, 0x8a, 0x27,
+	0xca, 0xa2, 0x25, 0x21, 0xb1, 0x24, 0x9d, 0xba, 0xf6, 0x47, 0x5c, 0xd4, 0xf6, 0x8b, 0x85, 0x79,
+	0x6b, 0x97, 0xe8, 0x59, 0xad, 0x93, 0x88, 0xfd, 0xf8, 0x12, 0xb9, 0x04, 0xf6, 0x57, 0xeb, 0xbd,
+	0x28, 0x33, 0xd2, 0xbd, 0x07, 0x1e, 0xa3, 0x28, 0xee, 0xb7, 0x36, 0x78, 0xbe, 0x0f, 0xa6, 0x49,
+	0xeb, 0x02, 0x18, 0x17, 0x7a, 0xcb, 0xe4, 0xf5, 0xc8, 0x30, 0x42, 0xd2, 0x28, 0x26, 0xad, 0x25,
+	0x00, 0x0c, 0xc0, 0xd8, 0x0a, 0x11, 0x92, 0x14, 0x2c, 0x0a, 0xa8, 0xc9, 0x4a, 0x16, 0x4a, 0x90,
+	0x37, 0x38, 0x65, 0x73, 0xcd, 0xc2, 0xf3, 0xfb, 0x3f, 0x0e, 0x1c, 0x8e, 0xa8, 0x5c, 0xce, 0xdb,
+	0x5e, 0xc0, 0x13, 0x73, 0xed, 0x9b, 0x9f, 0x86, 0x08, 0xaf, 0xfa, 0x72, 0x35, 0x25, 0x42, 0x39,
+	0x88, 0x96, 0x81, 0x86, 0x11, 0xd8, 0x9e, 0x33, 0x13, 0xc6, 0x7e, 0xf2, 0x61, 0x3a, 0xe0, 0x90,
+	0x80, 0xf1, 0x20, 0xc6, 0x34, 0x21, 0xe1, 0x44, 0xed, 0xc9, 0xc7, 0x29, 0xb1, 0x8f, 0x7f, 0xb6,
+	0x0f, 0x6c, 0x53, 0x25, 0x82, 0xb7, 0x47, 0xc1, 0x98, 0xbe, 0xa8, 0x61, 0x63, 0x8b, 0x22, 0x6c,
+	0xec, 0x10, 0x8e, 0x37, 0xac, 0xb9, 0x2e, 0xba, 0xfb, 0x8b, 0xb5, 0x36, 0xfb, 0x9d, 0xe5, 0x4c,
+	0xb5, 0x88, 0xcc, 0x33, 0x26, 0x10, 0x8e, 0x63, 0xa4, 0x9a, 0x02, 0x91, 0x24, 0x13, 0x88, 0x5f,
+	0x41, 0x72, 0x99, 0xa0, 0x1e, 0x34, 0x94, 0xf0, 0x30, 0x8f, 0x89, 0xe7, 0x4a, 0x50, 0x3f, 0x4b,
+	0x59, 0x88, 0x78, 0x2e, 0x51, 0xc2, 0x33, 0x82, 0x70, 0xbb, 0x78, 0x2c, 0xcc, 0x75, 0x57, 0x81,
+	0xad, 0x65, 0x29, 0x53, 0x31, 0xe3, 0xfb, 0x1b, 0x52, 0x51, 0x50, 0x6c, 0xc4, 0xb8, 0x2d, 0x7c,
+	0x3d, 0x1a, 0xb4, 0x63, 0xde, 0xf6, 0x13, 0x4c, 0x99, 0xff, 0x71, 0x65, 0x4c, 0x10, 0x29, 0x09,
+	0xfc, 0xe6, 0xa9, 0x25, 0x0d, 0xe8, 0x25, 0xe1, 0xa7, 0x3f, 0x3f, 0xfc, 0x7a, 0xf4, 0x05, 0x78,
+	0xd0, 0xef, 0x3f, 0x52, 0x98, 0xe0, 0xf7, 0x6a, 0x60, 0x57, 0x6f, 0x67, 0x80, 0x27, 0x06, 0x65,
+	0x66, 0x93, 0xde, 0xe7, 0x4c, 0x3f, 0x9a, 0x93, 0x49, 0xea, 0x43, 0x7b, 0x6d, 0xf6, 0x96, 0xed,
+	0xbc, 0xd6, 0x49, 0x2a, 0x8a, 0xa9, 0x90, 0x45, 0x32, 0x8b, 0xf4, 0x6a, 0x2c, 0xa4, 0xdb, 0x0a,
+	0xfa, 0x88, 0xca, 0x65, 0xd4, 0xfd, 0xf4, 0x51, 0x46, 0x44, 0x1e, 0x4b, 0xcf, 0x5d, 0x06, 0x8d,
+	0x7e, 0xe9, 0x55, 0x97, 0x08, 0xc2, 0x2c, 0x44, 0x24, 0xcb, 0x78, 0x86, 0x02, 0x1e, 0x12, 0x01,
+	0x4f, 0x0d, 0x9d, 0x6d, 0x99, 0x11, 0xa2, 0xb3, 0x1d, 0xf2, 0x40, 0x2c, 0xdc, 0xb4, 0x80, 0x3d,
+	0xdd, 0x6c, 0xc2, 0x1b, 0x60, 0xe7, 0x1c, 0x0e, 0x51, 0x39, 0x6b, 0x30, 0xb0, 0x1b, 0xa7, 0x69,
+	0x4c, 0x03, 0xc5, 0xcb, 0xff, 0x50, 0x70, 0x06, 0x2f, 0x5f, 0x77, 0x8b, 0x60, 0xee, 0xcc, 0x89,
+	0x63, 0x6e, 0x42, 0x84, 0xc0, 0x11, 0x71, 0x67, 0xdc, 0x2c, 0x0d, 0x34, 0x93, 0x19, 0x45, 0x05,
+	0x9d, 0x41, 0xf3, 0x6c, 0x05, 0xc7, 0x34, 0x9c, 0xcd, 0xa2, 0x3c, 0x21, 0x4c, 0xa2, 0x90, 0x88,
+	0x00, 0x9d, 0x41, 0x24, 0x49, 0xe5, 0x2a, 0xca, 0x4c, 0x80, 0x63, 0x6e, 0x48, 0x24, 0xa6, 0xb1,
+	0x70, 0x67, 0xde, 0x7b, 0xff, 0xc6, 0xc2, 0x27, 0x16, 0xb0, 0x4f, 0x36, 0x9b, 0x70, 0x15, 0xec,
+	0x9d, 0x67, 0x92, 0x64, 0x0c, 0xc7, 0x68, 0x91, 0x64, 0x2b, 0x24, 0x43, 0x6f, 0x16, 0xe0, 0xee,
+	0x07, 0x9b, 0x10, 0x3a, 0x57, 0x12, 0x9a, 0x1a, 0xc8, 0xc8, 0x40, 0x96, 0x54, 0x14, 0x64, 0x95,
+	0x82, 0x12, 0xd7, 0x2b, 0xf0, 0x90, 0x3f, 0xd4, 0xbc, 0x2a, 0xe0, 0xaf, 0x35, 0x30, 0xa6, 0x5b,
+	0xd3, 0xe0, 0xaf, 0xb4, 0xd2, 0x54, 0x07, 0x7f, 0xa5, 0xd5, 0x8e, 0xe7, 0xfe, 0x69, 0xaf, 0xcd,
+	0xde, 0xb6, 0x9d, 0x85, 0x8d, 0x82, 0xe2, 0xac, 0x11, 0x2c, 0x63, 0xca, 0x90, 0x6e, 0x68, 0xc5,
+	0xd6, 0x33, 0x7d, 0x3d, 0xcd, 0xfa, 0xda, 0xfa, 0xf2, 0x32, 0x63, 0xc9, 0xbd, 0x6d, 0x60, 0x47,
+	0xa7, 0xc3, 0xc2, 0xe6, 0x20, 0xb5, 0xac, 0x9f, 0x24, 0x9d, 0xa9, 0x47, 0xf0, 0x28, 0x25, 0x56,
+	0x5b, 0x9b, 0xfd, 0xa1, 0xe6, 0x78, 0xa5, 0xc4, 0xd6, 0x5d, 0xfb, 0x87, 0x04, 0x52, 0x6d, 0x0a,
+	0xb7, 0x63, 0x82, 0x3a, 0x43, 0xe3, 0x7f, 0x50, 0x46, 0x9f, 0x2b, 0x1a, 0xd3, 0xf0, 0xa6, 0x55,
+	0xe5, 0x91, 0x6f, 0xc2, 0x03, 0x97, 0x3c, 0x4e, 0x6e, 0xcd, 0xe3, 0x02, 0x97, 0x67, 0x79, 0xce,
+	0xc2, 0x92, 0x40, 0x27, 0xbf, 0xc8, 0x8c, 0x75, 0x88, 0x71, 0x89, 0xae, 0x14, 0x26, 0x4f, 0xa9,
+	0xaa, 0x9b, 0xd0, 0xdb, 0x42, 0xd5, 0x9d, 0xf3, 0xf8, 0xd7, 0xcd, 0x81, 0x6e, 0xc0, 0x6f, 0xb6,
+	0x81, 0xdd, 0xeb, 0x47, 0x51, 0x78, 0x6a, 0x68, 0xdd, 0x56, 0x07, 0x62, 0xe7, 0xf4, 0xa3, 0x3b,
+	0x1a, 0xdd, 0x7f, 0x55, 0x5b, 0x9b, 0xfd, 0xc9, 0x76, 0xde, 0xed, 0xaf, 0xfb, 0x6e, 0x35, 0xcc,
+	0x74, 0xab, 0xef, 0xd4, 0xc2, 0x50, 0xcf, 0x87, 0xc7, 0x50, 0x39, 0x29, 0x2a, 0xb5, 0x9b, 0x71,
+	0x0e, 0x49, 0x2e, 0x71, 0x2c, 0x9e, 0xdd, 0xb4, 0xff, 0x96, 0x26, 0x4f, 0xc3, 0x57, 0x87, 0xd1,
+	0xe4, 0x92, 0xa9, 0x6a, 0x57, 0x9b, 0x73, 0x17, 0xee, 0xdc, 0xaf, 0x5b, 0x77, 0xef, 0xd7, 0xad,
+	0x7b, 0xf7, 0xeb, 0xd6, 0x97, 0x0f, 0xea, 0x23, 0x77, 0x1f, 0xd4, 0x47, 0x7e, 0x7b, 0x50, 0x1f,
+	0xb9, 0x3c, 0x3d, 0xb0, 0x24, 0xd5, 0x01, 0x56, 0xcd, 0xf9, 0xed, 0x31, 0xf5, 0x9f, 0xce, 0x89,
+	0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x9e, 0x4e, 0xee, 0xf0, 0x4b, 0x13, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -426,8 +698,12 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// MarketMakers returns all market makers.
 	MarketMakers(ctx context.Context, in *QueryMarketMakersRequest, opts ...grpc.CallOption) (*QueryMarketMakersResponse, error)
+	// Scores returns on-chain scores of market makers in the current incentive period.
+	Scores(ctx context.Context, in *QueryScoresRequest, opts ...grpc.CallOption) (*QueryScoresResponse, error)
 	// Incentive returns a specific incentive.
 	Incentive(ctx context.Context, in *QueryIncentiveRequest, opts ...grpc.CallOption) (*QueryIncentiveResponse, error)
+	// IncentiveStreams returns the incentive streams of a market maker.
+	IncentiveStreams(ctx context.Context, in *QueryIncentiveStreamsRequest, opts ...grpc.CallOption) (*QueryIncentiveStreamsResponse, error)
 }
 
 type queryClient struct {
@@ -456,6 +732,15 @@ func (c *queryClient) MarketMakers(ctx context.Context, in *QueryMarketMakersReq
 	return out, nil
 }
 
+func (c *queryClient) Scores(ctx context.Context, in *QueryScoresRequest, opts ...grpc.CallOption) (*QueryScoresResponse, error) {
+	out := new(QueryScoresResponse)
+	err := c.cc.Invoke(ctx, "/squad.marketmaker.v1beta1.Query/Scores", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Incentive(ctx context.Context, in *QueryIncentiveRequest, opts ...grpc.CallOption) (*QueryIncentiveResponse, error) {
 	out := new(QueryIncentiveResponse)
 	err := c.cc.Invoke(ctx, "/squad.marketmaker.v1beta1.Query/Incentive", in, out, opts...)
@@ -465,14 +750,27 @@ func (c *queryClient) Incentive(ctx context.Context, in *QueryIncentiveRequest,
 	return out, nil
 }
 
+func (c *queryClient) IncentiveStreams(ctx context.Context, in *QueryIncentiveStreamsRequest, opts ...grpc.CallOption) (*QueryIncentiveStreamsResponse, error) {
+	out := new(QueryIncentiveStreamsResponse)
+	err := c.cc.Invoke(ctx, "/squad.marketmaker.v1beta1.Query/IncentiveStreams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params returns parameters of the marketmaker module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// MarketMakers returns all market makers.
 	MarketMakers(context.Context, *QueryMarketMakersRequest) (*QueryMarketMakersResponse, error)
+	// Scores returns on-chain scores of market makers in the current incentive period.
+	Scores(context.Context, *QueryScoresRequest) (*QueryScoresResponse, error)
 	// Incentive returns a specific incentive.
 	Incentive(context.Context, *QueryIncentiveRequest) (*QueryIncentiveResponse, error)
+	// IncentiveStreams returns the incentive streams of a market maker.
+	IncentiveStreams(context.Context, *QueryIncentiveStreamsRequest) (*QueryIncentiveStreamsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -485,9 +783,15 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) MarketMakers(ctx context.Context, req *QueryMarketMakersRequest) (*QueryMarketMakersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MarketMakers not implemented")
 }
+func (*UnimplementedQueryServer) Scores(ctx context.Context, req *QueryScoresRequest) (*QueryScoresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Scores not implemented")
+}
 func (*UnimplementedQueryServer) Incentive(ctx context.Context, req *QueryIncentiveRequest) (*QueryIncentiveResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Incentive not implemented")
 }
+func (*UnimplementedQueryServer) IncentiveStreams(ctx context.Context, req *QueryIncentiveStreamsRequest) (*QueryIncentiveStreamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncentiveStreams not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -529,6 +833,24 @@ func _Query_MarketMakers_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Scores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScoresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Scores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squad.marketmaker.v1beta1.Query/Scores",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Scores(ctx, req.(*QueryScoresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Incentive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryIncentiveRequest)
 	if err := dec(in); err != nil {
@@ -547,6 +869,24 @@ func _Query_Incentive_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_IncentiveStreams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIncentiveStreamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IncentiveStreams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/squad.marketmaker.v1beta1.Query/IncentiveStreams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IncentiveStreams(ctx, req.(*QueryIncentiveStreamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "squad.marketmaker.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -559,10 +899,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "MarketMakers",
 			Handler:    _Query_MarketMakers_Handler,
 		},
+		{
+			MethodName: "Scores",
+			Handler:    _Query_Scores_Handler,
+		},
 		{
 			MethodName: "Incentive",
 			Handler:    _Query_Incentive_Handler,
 		},
+		{
+			MethodName: "IncentiveStreams",
+			Handler:    _Query_IncentiveStreams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "squad/marketmaker/v1beta1/query.proto",
@@ -790,49 +1138,271 @@ func (m *QueryIncentiveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryScoresRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryScoresRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryMarketMakersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryScoresRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
 	if m.PairId != 0 {
-		n += 1 + sovQuery(uint64(m.PairId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.PairId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScoresResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScoresResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScoresResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ScoringState != nil {
+		{
+			size, err := m.ScoringState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Scores) > 0 {
+		for iNdEx := len(m.Scores) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Scores[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIncentiveStreamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIncentiveStreamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIncentiveStreamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PairId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PairId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIncentiveStreamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIncentiveStreamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIncentiveStreamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Claimed) > 0 {
+		for iNdEx := len(m.Claimed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Claimed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Unvested) > 0 {
+		for iNdEx := len(m.Unvested) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unvested[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Vested) > 0 {
+		for iNdEx := len(m.Vested) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vested[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Streams) > 0 {
+		for iNdEx := len(m.Streams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Streams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMarketMakersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PairId != 0 {
+		n += 1 + sovQuery(uint64(m.PairId))
 	}
 	l = len(m.Eligible)
 	if l > 0 {
@@ -881,20 +1451,534 @@ func (m *QueryIncentiveResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	l = m.Incentive.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
+	var l int
+	_ = l
+	l = m.Incentive.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryScoresRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PairId != 0 {
+		n += 1 + sovQuery(uint64(m.PairId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScoresResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Scores) > 0 {
+		for _, e := range m.Scores {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.ScoringState != nil {
+		l = m.ScoringState.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIncentiveStreamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PairId != 0 {
+		n += 1 + sovQuery(uint64(m.PairId))
+	}
+	return n
+}
+
+func (m *QueryIncentiveStreamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Streams) > 0 {
+		for _, e := range m.Streams {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Vested) > 0 {
+		for _, e := range m.Vested {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Unvested) > 0 {
+		for _, e := range m.Unvested {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Claimed) > 0 {
+		for _, e := range m.Claimed {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMarketMakersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMarketMakersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMarketMakersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+			}
+			m.PairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PairId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eligible", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Eligible = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMarketMakersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMarketMakersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMarketMakersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Marketmakers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Marketmakers = append(m.Marketmakers, MarketMaker{})
+			if err := m.Marketmakers[len(m.Marketmakers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryIncentiveRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -917,12 +2001,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIncentiveRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIncentiveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -944,7 +2060,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIncentiveResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -967,15 +2083,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIncentiveResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIncentiveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Incentive", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1002,7 +2118,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Incentive.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1027,7 +2143,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMarketMakersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryScoresRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1050,10 +2166,10 @@ func (m *QueryMarketMakersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMarketMakersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScoresRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMarketMakersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScoresRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1108,38 +2224,6 @@ func (m *QueryMarketMakersRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Eligible", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Eligible = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -1196,7 +2280,7 @@ func (m *QueryMarketMakersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMarketMakersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryScoresResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1219,15 +2303,15 @@ func (m *QueryMarketMakersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMarketMakersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScoresResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMarketMakersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScoresResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Marketmakers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scores", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1254,12 +2338,48 @@ func (m *QueryMarketMakersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Marketmakers = append(m.Marketmakers, MarketMaker{})
-			if err := m.Marketmakers[len(m.Marketmakers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Scores = append(m.Scores, MarketMakerScore{})
+			if err := m.Scores[len(m.Scores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScoringState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScoringState == nil {
+				m.ScoringState = &ScoringState{}
+			}
+			if err := m.ScoringState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -1316,7 +2436,7 @@ func (m *QueryMarketMakersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIncentiveRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryIncentiveStreamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1339,10 +2459,10 @@ func (m *QueryIncentiveRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIncentiveRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIncentiveStreamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIncentiveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIncentiveStreamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1377,6 +2497,25 @@ func (m *QueryIncentiveRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+			}
+			m.PairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PairId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1398,7 +2537,7 @@ func (m *QueryIncentiveRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIncentiveResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIncentiveStreamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1421,15 +2560,15 @@ func (m *QueryIncentiveResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIncentiveResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIncentiveStreamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIncentiveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIncentiveStreamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Incentive", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Streams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1456,7 +2595,110 @@ func (m *QueryIncentiveResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Incentive.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Streams = append(m.Streams, IncentiveStream{})
+			if err := m.Streams[len(m.Streams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vested = append(m.Vested, types.Coin{})
+			if err := m.Vested[len(m.Vested)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unvested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unvested = append(m.Unvested, types.Coin{})
+			if err := m.Unvested[len(m.Unvested)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claimed = append(m.Claimed, types.Coin{})
+			if err := m.Claimed[len(m.Claimed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,243 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	liquiditytypes "github.com/cosmosquad-labs/squad/v3/x/liquidity/types"
+)
+
+// Day is the length of a day used by the market maker scoring.
+const Day = 24 * time.Hour
+
+// Measure defines the measures of a market maker's orders sampled in a batch.
+type Measure struct {
+	MidPrice sdk.Dec
+	Spread   sdk.Dec
+	AskWidth sdk.Dec
+	BidWidth sdk.Dec
+	AskDepth sdk.Int
+	BidDepth sdk.Int
+	// Point is the two-sided liquidity point of the orders.
+	Point sdk.Dec
+}
+
+// MeasureOrders measures open market maker orders following the scoring system.
+// It returns false if there are no valid orders on either side or if the
+// orders on both sides are crossed.
+func MeasureOrders(orders []liquiditytypes.Order, common Common, minDepth sdk.Int) (m Measure, ok bool) {
+	var asks, bids []liquiditytypes.Order
+	for _, order := range orders {
+		switch order.Direction {
+		case liquiditytypes.OrderDirectionSell:
+			asks = append(asks, order)
+		case liquiditytypes.OrderDirectionBuy:
+			bids = append(bids, order)
+		}
+	}
+	sort.SliceStable(asks, func(i, j int) bool {
+		return asks[i].Price.LT(asks[j].Price)
+	})
+	sort.SliceStable(bids, func(i, j int) bool {
+		return bids[i].Price.GT(bids[j].Price)
+	})
+
+	minOpenDepth := common.MinOpenDepthRatio.MulInt(minDepth)
+	asks = referenceTicks(asks, common.MinOpenRatio, minOpenDepth)
+	bids = referenceTicks(bids, common.MinOpenRatio, minOpenDepth)
+	if len(asks) == 0 || len(bids) == 0 {
+		return Measure{}, false
+	}
+
+	bestAsk, bestBid := asks[0].Price, bids[0].Price
+	if !bestAsk.GT(bestBid) {
+		return Measure{}, false
+	}
+
+	m.MidPrice = bestAsk.Add(bestBid).QuoInt64(2)
+	m.Spread = bestAsk.Sub(bestBid).Quo(m.MidPrice)
+	m.AskWidth = asks[len(asks)-1].Price.Sub(bestAsk).Quo(m.MidPrice)
+	m.BidWidth = bestBid.Sub(bids[len(bids)-1].Price).Quo(m.MidPrice)
+
+	askPoint, bidPoint := sdk.ZeroDec(), sdk.ZeroDec()
+	m.AskDepth, m.BidDepth = sdk.ZeroInt(), sdk.ZeroInt()
+	for _, order := range asks {
+		m.AskDepth = m.AskDepth.Add(order.OpenAmount)
+		d := order.Price.Sub(m.MidPrice).Quo(m.MidPrice)
+		askPoint = askPoint.Add(order.OpenAmount.ToDec().Quo(d).Quo(d))
+	}
+	for _, order := range bids {
+		m.BidDepth = m.BidDepth.Add(order.OpenAmount)
+		d := m.MidPrice.Sub(order.Price).Quo(m.MidPrice)
+		bidPoint = bidPoint.Add(order.OpenAmount.ToDec().Quo(d).Quo(d))
+	}
+	m.Point = sdk.MinDec(askPoint, bidPoint).TruncateDec()
+	return m, true
+}
+
+// referenceTicks returns the ticks starting from the reference tick, which is
+// the first tick whose open amount is equal or larger than either minOpenRatio
+// of its original amount or minOpenDepth.
+// The ticks must be sorted from the closest to the farthest to the mid price.
+func referenceTicks(ticks []liquiditytypes.Order, minOpenRatio, minOpenDepth sdk.Dec) []liquiditytypes.Order {
+	for i, tick := range ticks {
+		openAmt := tick.OpenAmount.ToDec()
+		if openAmt.GTE(minOpenRatio.MulInt(tick.Amount)) || openAmt.GTE(minOpenDepth) {
+			return ticks[i:]
+		}
+	}
+	return nil
+}
+
+// IsLive returns whether the measure satisfies the order requirements of
+// the incentive pair.
+func (m Measure) IsLive(pair IncentivePair) bool {
+	return m.Spread.LTE(pair.MaxSpread) &&
+		sdk.MinDec(m.AskWidth, m.BidWidth).GTE(pair.MinWidth) &&
+		sdk.MinInt(m.AskDepth, m.BidDepth).GTE(pair.MinDepth)
+}
+
+// HasScoringCriteria returns whether the incentive pair has all the order
+// requirements needed to score market makers on-chain.
+func (pair IncentivePair) HasScoringCriteria() bool {
+	return !pair.MaxSpread.IsNil() && !pair.MinWidth.IsNil() && !pair.MinDepth.IsNil()
+}
+
+// NewMarketMakerMetrics returns a new empty MarketMakerMetrics.
+func NewMarketMakerMetrics() MarketMakerMetrics {
+	return MarketMakerMetrics{
+		SpreadSum: sdk.ZeroDec(),
+		WidthSum:  sdk.ZeroDec(),
+		DepthSum:  sdk.ZeroInt(),
+	}
+}
+
+// Record records a sample to the metrics.
+// blocks is the number of blocks the sample represents, which is counted
+// as downtime if the sample is not live.
+func (metrics *MarketMakerMetrics) Record(m Measure, live bool, blocks uint32) {
+	metrics.Samples++
+	if live {
+		metrics.LiveSamples++
+		metrics.CurrentDowntime = 0
+		metrics.SpreadSum = metrics.SpreadSum.Add(m.Spread)
+		metrics.WidthSum = metrics.WidthSum.Add(sdk.MinDec(m.AskWidth, m.BidWidth))
+		metrics.DepthSum = metrics.DepthSum.Add(sdk.MinInt(m.AskDepth, m.BidDepth))
+		return
+	}
+	metrics.Downtime += blocks
+	metrics.CurrentDowntime += blocks
+	if metrics.CurrentDowntime > metrics.MaxDowntime {
+		metrics.MaxDowntime = metrics.CurrentDowntime
+	}
+}
+
+// IsLiveHour returns whether the metrics recorded for an hour satisfy
+// the uptime requirement.
+func (metrics MarketMakerMetrics) IsLiveHour(common Common) bool {
+	return metrics.LiveSamples > 0 &&
+		metrics.MaxDowntime <= common.MaxDowntime &&
+		metrics.Downtime <= common.MaxTotalDowntime
+}
+
+// Validate validates MarketMakerMetrics.
+func (metrics MarketMakerMetrics) Validate() error {
+	if metrics.LiveSamples > metrics.Samples {
+		return fmt.Errorf("live samples must not be greater than samples: %d > %d", metrics.LiveSamples, metrics.Samples)
+	}
+	if metrics.SpreadSum.IsNil() || metrics.SpreadSum.IsNegative() {
+		return fmt.Errorf("spread sum must not be negative: %s", metrics.SpreadSum)
+	}
+	if metrics.WidthSum.IsNil() || metrics.WidthSum.IsNegative() {
+		return fmt.Errorf("width sum must not be negative: %s", metrics.WidthSum)
+	}
+	if metrics.DepthSum.IsNil() || metrics.DepthSum.IsNegative() {
+		return fmt.Errorf("depth sum must not be negative: %s", metrics.DepthSum)
+	}
+	return nil
+}
+
+// NewMarketMakerScore returns a new empty MarketMakerScore.
+func NewMarketMakerScore(mmAddr sdk.AccAddress, pairId uint64) MarketMakerScore {
+	return MarketMakerScore{
+		Address:     mmAddr.String(),
+		PairId:      pairId,
+		HourMetrics: NewMarketMakerMetrics(),
+		DayMetrics:  NewMarketMakerMetrics(),
+		PointShare:  sdk.ZeroDec(),
+	}
+}
+
+func (score MarketMakerScore) GetAccAddress() sdk.AccAddress {
+	return GetAccAddress(score.Address)
+}
+
+// Validate validates MarketMakerScore.
+func (score MarketMakerScore) Validate() error {
+	if err := ValidateMarketMaker(score.Address, score.PairId); err != nil {
+		return err
+	}
+	if err := score.HourMetrics.Validate(); err != nil {
+		return fmt.Errorf("invalid hour metrics: %w", err)
+	}
+	if err := score.DayMetrics.Validate(); err != nil {
+		return fmt.Errorf("invalid day metrics: %w", err)
+	}
+	if score.PointShare.IsNil() || score.PointShare.IsNegative() {
+		return fmt.Errorf("point share must not be negative: %s", score.PointShare)
+	}
+	return nil
+}
+
+// EndHour closes the current hour of the score and starts a new one.
+// If dayEnded is true, the current day is closed as well.
+func (score *MarketMakerScore) EndHour(common Common, dayEnded bool) {
+	if score.HourMetrics.IsLiveHour(common) {
+		score.LiveHours++
+		score.TotalLiveHours++
+	}
+	score.HourMetrics = NewMarketMakerMetrics()
+	if dayEnded {
+		if score.LiveHours >= common.MinHours {
+			score.LiveDays++
+		}
+		score.LiveHours = 0
+		score.DayMetrics = NewMarketMakerMetrics()
+	}
+}
+
+// Uptime returns the ratio of live hours to the total hours in the
+// incentive period.
+func (score MarketMakerScore) Uptime(period time.Duration) sdk.Dec {
+	hours := int64(period / time.Hour)
+	if hours == 0 {
+		return sdk.ZeroDec()
+	}
+	return sdk.MinDec(sdk.NewDec(int64(score.TotalLiveHours)).QuoInt64(hours), sdk.OneDec())
+}
+
+// FinalScore returns the final score of the market maker in the incentive
+// period, which is the cube of the uptime multiplied by the point share.
+func (score MarketMakerScore) FinalScore(period time.Duration) sdk.Dec {
+	return score.Uptime(period).Power(3).Mul(score.PointShare)
+}
+
+// NewScoringState returns a new ScoringState.
+func NewScoringState(periodStartTime, hourStartTime time.Time) ScoringState {
+	return ScoringState{
+		PeriodStartTime: periodStartTime,
+		HourStartTime:   hourStartTime,
+	}
+}
+
+// Validate validates ScoringState.
+func (state ScoringState) Validate() error {
+	if state.HourStartTime.Before(state.PeriodStartTime) {
+		return fmt.Errorf("hour start time must not be before period start time: %s < %s",
+			state.HourStartTime, state.PeriodStartTime)
+	}
+	return nil
+}
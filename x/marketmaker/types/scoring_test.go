@@ -0,0 +1,184 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	liquiditytypes "github.com/cosmosquad-labs/squad/v3/x/liquidity/types"
+	"github.com/cosmosquad-labs/squad/v3/x/marketmaker/types"
+)
+
+func newTick(dir liquiditytypes.OrderDirection, price string, amt, openAmt int64) liquiditytypes.Order {
+	return liquiditytypes.Order{
+		Direction:  dir,
+		Price:      sdk.MustNewDecFromStr(price),
+		Amount:     sdk.NewInt(amt),
+		OpenAmount: sdk.NewInt(openAmt),
+	}
+}
+
+// Examples are taken from the market maker scoring system document.
+func TestMeasureOrders(t *testing.T) {
+	pair := types.IncentivePair{
+		PairId:          1,
+		IncentiveWeight: sdk.MustNewDecFromStr("0.1"),
+		MaxSpread:       sdk.MustNewDecFromStr("0.012"),
+		MinWidth:        sdk.MustNewDecFromStr("0.002"),
+		MinDepth:        sdk.NewInt(100),
+	}
+	buy, sell := liquiditytypes.OrderDirectionBuy, liquiditytypes.OrderDirectionSell
+
+	for _, tc := range []struct {
+		name     string
+		orders   []liquiditytypes.Order
+		ok       bool
+		live     bool
+		spread   string
+		askWidth string
+		bidWidth string
+		askDepth int64
+		bidDepth int64
+	}{
+		{
+			"market maker A, block 1",
+			[]liquiditytypes.Order{
+				newTick(sell, "9.99", 50, 50), newTick(sell, "9.98", 50, 50),
+				newTick(sell, "9.97", 50, 50), newTick(sell, "9.96", 50, 50),
+				newTick(buy, "9.93", 40, 40), newTick(buy, "9.92", 40, 40),
+				newTick(buy, "9.91", 40, 40), newTick(buy, "9.90", 40, 40),
+			},
+			true, true,
+			"0.003016591251885370", "0.003016591251885370", "0.003016591251885370",
+			200, 160,
+		},
+		{
+			"market maker A, block 2",
+			[]liquiditytypes.Order{
+				newTick(sell, "9.99", 50, 50), newTick(sell, "9.98", 50, 50),
+				newTick(sell, "9.97", 50, 50), newTick(sell, "9.96", 50, 40),
+				newTick(buy, "9.93", 40, 0), newTick(buy, "9.92", 40, 5),
+				newTick(buy, "9.91", 40, 40), newTick(buy, "9.90", 40, 40),
+			},
+			true, false,
+			"0.005032712632108707", "0.003019627579265224", "0.001006542526421741",
+			190, 80,
+		},
+		{
+			"market maker B, block 2",
+			[]liquiditytypes.Order{
+				newTick(sell, "9.99", 75, 75), newTick(sell, "9.98", 75, 75), newTick(sell, "9.97", 75, 75),
+				newTick(buy, "9.92", 80, 20), newTick(buy, "9.91", 80, 80), newTick(buy, "9.90", 80, 80),
+			},
+			true, true,
+			"0.005027652086475616", "0.002011060834590246", "0.002011060834590246",
+			225, 180,
+		},
+		{
+			"one-sided orders",
+			[]liquiditytypes.Order{
+				newTick(sell, "9.99", 75, 75), newTick(sell, "9.98", 75, 75),
+			},
+			false, false, "", "", "", 0, 0,
+		},
+		{
+			"crossed orders",
+			[]liquiditytypes.Order{
+				newTick(sell, "9.90", 75, 75), newTick(buy, "9.95", 75, 75),
+			},
+			false, false, "", "", "", 0, 0,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			m, ok := types.MeasureOrders(tc.orders, types.DefaultCommon, pair.MinDepth)
+			require.Equal(t, tc.ok, ok)
+			if !ok {
+				return
+			}
+			require.Equal(t, tc.live, m.IsLive(pair))
+			require.Equal(t, tc.spread, m.Spread.String())
+			require.Equal(t, tc.askWidth, m.AskWidth.String())
+			require.Equal(t, tc.bidWidth, m.BidWidth.String())
+			require.Equal(t, tc.askDepth, m.AskDepth.Int64())
+			require.Equal(t, tc.bidDepth, m.BidDepth.Int64())
+			require.True(t, m.Point.IsPositive())
+		})
+	}
+}
+
+func TestMeasureOrders_Point(t *testing.T) {
+	buy, sell := liquiditytypes.OrderDirectionBuy, liquiditytypes.OrderDirectionSell
+	orders := []liquiditytypes.Order{
+		newTick(sell, "1.01", 100, 100), newTick(sell, "1.02", 100, 100),
+		newTick(buy, "0.99", 100, 100), newTick(buy, "0.98", 100, 50),
+	}
+	m, ok := types.MeasureOrders(orders, types.DefaultCommon, sdk.NewInt(100))
+	require.True(t, ok)
+	// ask point = 100/0.01^2 + 100/0.02^2 = 1250000
+	// bid point = 100/0.01^2 + 50/0.02^2 = 1125000
+	require.Equal(t, sdk.NewDec(1125000), m.Point)
+}
+
+func TestMarketMakerScore_EndHour(t *testing.T) {
+	common := types.DefaultCommon // MaxDowntime: 20, MaxTotalDowntime: 100, MinHours: 16
+	m := types.Measure{
+		Spread:   sdk.MustNewDecFromStr("0.01"),
+		AskWidth: sdk.MustNewDecFromStr("0.02"),
+		BidWidth: sdk.MustNewDecFromStr("0.03"),
+		AskDepth: sdk.NewInt(100),
+		BidDepth: sdk.NewInt(200),
+	}
+
+	score := types.NewMarketMakerScore(sdk.AccAddress("addr1"), 1)
+	score.HourMetrics.Record(m, true, 1)
+	for i := 0; i < 20; i++ {
+		score.HourMetrics.Record(types.Measure{}, false, 1)
+	}
+	score.HourMetrics.Record(m, true, 1)
+	require.EqualValues(t, 22, score.HourMetrics.Samples)
+	require.EqualValues(t, 2, score.HourMetrics.LiveSamples)
+	require.EqualValues(t, 20, score.HourMetrics.MaxDowntime)
+	require.EqualValues(t, 0, score.HourMetrics.CurrentDowntime)
+	require.Equal(t, sdk.MustNewDecFromStr("0.02"), score.HourMetrics.SpreadSum)
+	require.Equal(t, sdk.MustNewDecFromStr("0.04"), score.HourMetrics.WidthSum)
+	require.Equal(t, sdk.NewInt(200), score.HourMetrics.DepthSum)
+
+	score.EndHour(common, false)
+	require.EqualValues(t, 1, score.LiveHours)
+	require.EqualValues(t, 1, score.TotalLiveHours)
+	require.Equal(t, types.NewMarketMakerMetrics(), score.HourMetrics)
+
+	// Too long consecutive downtime.
+	score.HourMetrics.Record(m, true, 1)
+	score.HourMetrics.Record(types.Measure{}, false, 21)
+	score.EndHour(common, false)
+	require.EqualValues(t, 1, score.LiveHours)
+
+	// The day is not live since live hours are less than MinHours.
+	score.EndHour(common, true)
+	require.EqualValues(t, 0, score.LiveHours)
+	require.EqualValues(t, 0, score.LiveDays)
+
+	for i := 0; i < 16; i++ {
+		score.HourMetrics.Record(m, true, 1)
+		score.EndHour(common, i == 15)
+	}
+	require.EqualValues(t, 0, score.LiveHours)
+	require.EqualValues(t, 17, score.TotalLiveHours)
+	require.EqualValues(t, 1, score.LiveDays)
+}
+
+func TestMarketMakerScore_FinalScore(t *testing.T) {
+	score := types.NewMarketMakerScore(sdk.AccAddress("addr1"), 1)
+	score.PointShare = sdk.NewDec(1000)
+
+	score.TotalLiveHours = 24
+	require.Equal(t, sdk.NewDec(125), score.FinalScore(2*types.Day))
+
+	score.TotalLiveHours = 48
+	require.Equal(t, sdk.NewDec(1000), score.FinalScore(2*types.Day))
+
+	require.True(t, score.FinalScore(time.Minute).IsZero())
+}